@@ -199,6 +199,8 @@ func IsSystemTx(tx sdk.Tx, isAuthorizedSigner func(string) bool) bool {
 		signer = msg.Signer
 	case *xmsgtypes.MsgVoteOnObservedInboundTx:
 		signer = msg.Signer
+	case *xmsgtypes.MsgProveInboundTx:
+		signer = msg.Signer
 	case *xmsgtypes.MsgVoteOnObservedOutboundTx:
 		signer = msg.Signer
 	case *xmsgtypes.MsgAddToOutTxTracker:
@@ -691,8 +691,10 @@ func NewPellApp(
 		*stakingKeeper,
 		app.SlashingKeeper,
 		app.AuthorityKeeper,
-		app.LightclientKeeper,
+		&app.LightclientKeeper,
 	)
+	// the lightclient keeper looks up the chains in the chain registry of the relayer keeper
+	app.LightclientKeeper.SetRelayerKeeper(app.RelayerKeeper)
 
 	app.StakingKeeper = stakingKeeper
 	// register the staking hooks
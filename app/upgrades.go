@@ -2,46 +2,52 @@ package app
 
 import (
 	"context"
+	"fmt"
 
 	storetypes "cosmossdk.io/store/types"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
-
-	xsecuritytypes "github.com/0xPellNetwork/aegis/x/xsecurity/types"
 )
 
-const releaseVersion = "v1.4"
+// Upgrade defines the store changes, module migrations and state fixes of a release.
+// Each release declares its upgrade in its own upgrades_<version>.go file and adds it to Upgrades.
+type Upgrade struct {
+	// Name is the name of the upgrade plan
+	Name string
 
-func (app *PellApp) RegisterUpgradeHandlers() {
-	app.UpgradeKeeper.SetUpgradeHandler(
-		releaseVersion,
-		func(ctx context.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
-			// Debug: Log original fromVM
-			app.Logger().Info("=== Upgrade Handler Debug ===")
-			app.Logger().Info("Original fromVM", "count", len(fromVM))
-			for name, version := range fromVM {
-				app.Logger().Info("Original module version", "module", name, "version", version)
-			}
+	// StoreUpgrades lists the stores added, renamed and deleted by the release
+	StoreUpgrades storetypes.StoreUpgrades
 
-			// Get current version map of all modules
-			currentVersionMap := app.ModuleManager.GetVersionMap()
-			app.Logger().Info("Current version map", "count", len(currentVersionMap))
+	// PinModuleVersions sets all the modules to their current consensus version before the migrations,
+	// so that only the modules listed in FromVersions are migrated
+	PinModuleVersions bool
 
-			// Set all existing modules to their current consensus versions
-			for moduleName, version := range currentVersionMap {
-				fromVM[moduleName] = version
-				app.Logger().Info("Set existing module version", "module", moduleName, "version", version)
-			}
+	// FromVersions overrides the consensus version the listed modules are migrated from
+	FromVersions map[string]uint64
+
+	// StateFix applies custom state changes once the module migrations have run
+	StateFix func(ctx sdk.Context, app *PellApp) error
+}
 
-			// Only truly new modules need initialization
-			fromVM[xsecuritytypes.ModuleName] = 1
-			app.Logger().Info("Set new module version", "module", xsecuritytypes.ModuleName, "version", 1)
+// Upgrades contains all the upgrades of the chain, in release order
+var Upgrades = []Upgrade{
+	upgradeV1_4,
+	upgradeV1_5,
+}
 
-			app.Logger().Info("Final fromVM", "count", len(fromVM))
+// RegisterUpgradeHandlers registers the handlers of all the upgrades and sets the store loader
+// of the upgrade scheduled on disk
+func (app *PellApp) RegisterUpgradeHandlers() {
+	registered := make(map[string]bool, len(Upgrades))
+	for _, upgrade := range Upgrades {
+		if registered[upgrade.Name] {
+			panic(fmt.Sprintf("upgrade %s registered twice", upgrade.Name))
+		}
+		registered[upgrade.Name] = true
 
-			return app.ModuleManager.RunMigrations(ctx, app.configurator, fromVM)
-		},
-	)
+		app.UpgradeKeeper.SetUpgradeHandler(upgrade.Name, app.upgradeHandler(upgrade))
+	}
 
 	// Configure store upgrades for new modules
 	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
@@ -49,15 +55,53 @@ func (app *PellApp) RegisterUpgradeHandlers() {
 		panic(err)
 	}
 
-	if upgradeInfo.Name == releaseVersion && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
-		storeUpgrades := storetypes.StoreUpgrades{
-			Added: []string{xsecuritytypes.StoreKey},
+	if app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		return
+	}
+
+	for _, upgrade := range Upgrades {
+		if upgrade.Name != upgradeInfo.Name {
+			continue
 		}
 
+		storeUpgrades := upgrade.StoreUpgrades
+
 		// Use upgrade store loader for the initial loading of all stores when app starts,
 		// it checks if version == upgradeHeight and applies store upgrades before loading the stores,
 		// so that new stores start with the correct version (the current height of chain),
 		// instead the default which is the latest version that store last committed i.e 0 for new stores.
 		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
+		return
+	}
+}
+
+// upgradeHandler returns the handler running the module migrations and the state fix of the upgrade
+func (app *PellApp) upgradeHandler(upgrade Upgrade) upgradetypes.UpgradeHandler {
+	return func(ctx context.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		logger := app.Logger().With("upgrade", upgrade.Name)
+
+		if upgrade.PinModuleVersions {
+			for moduleName, version := range app.ModuleManager.GetVersionMap() {
+				fromVM[moduleName] = version
+			}
+		}
+		for moduleName, version := range upgrade.FromVersions {
+			logger.Info("migrating module", "module", moduleName, "from", version)
+			fromVM[moduleName] = version
+		}
+
+		toVM, err := app.ModuleManager.RunMigrations(ctx, app.configurator, fromVM)
+		if err != nil {
+			return nil, err
+		}
+
+		if upgrade.StateFix != nil {
+			if err := upgrade.StateFix(sdk.UnwrapSDKContext(ctx), app); err != nil {
+				return nil, fmt.Errorf("upgrade %s state fix failed: %w", upgrade.Name, err)
+			}
+		}
+
+		logger.Info("upgrade applied", "modules", len(toVM))
+		return toVM, nil
 	}
 }
@@ -0,0 +1,63 @@
+package app_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/app"
+	"github.com/0xPellNetwork/aegis/testutil/sample"
+	"github.com/0xPellNetwork/aegis/testutil/simapp"
+	relayertypes "github.com/0xPellNetwork/aegis/x/relayer/types"
+	xmsgtypes "github.com/0xPellNetwork/aegis/x/xmsg/types"
+)
+
+func TestUpgrades(t *testing.T) {
+	t.Run("should have unique names", func(t *testing.T) {
+		names := make(map[string]bool, len(app.Upgrades))
+		for _, upgrade := range app.Upgrades {
+			require.NotEmpty(t, upgrade.Name)
+			require.False(t, names[upgrade.Name], "duplicate upgrade %s", upgrade.Name)
+			names[upgrade.Name] = true
+		}
+	})
+
+	for _, upgrade := range app.Upgrades {
+		t.Run("should apply upgrade "+upgrade.Name, func(t *testing.T) {
+			simapp.RunUpgrade(t, upgrade)
+		})
+	}
+}
+
+func TestUpgradeV1_5(t *testing.T) {
+	idx := slices.IndexFunc(app.Upgrades, func(upgrade app.Upgrade) bool { return upgrade.Name == "v1.5" })
+	require.NotEqual(t, -1, idx)
+	upgrade := app.Upgrades[idx]
+
+	pellApp, ctx := simapp.SetupAtPreviousVersion(t, upgrade)
+
+	// xmsg stored before the indexes were introduced
+	xmsg := sample.Xmsg_pell(t, "index")
+	pellApp.XmsgKeeper.SetXmsgByEventIndex(ctx, xmsg.Index, *xmsg)
+
+	// jailing params not stored before the upgrade
+	params := pellApp.RelayerKeeper.GetParamsIfExists(ctx)
+	params.PenaltyWindowBlocks = 0
+	params.JailDurationBlocks = 0
+	pellApp.RelayerKeeper.SetParams(ctx, params)
+
+	simapp.ApplyUpgrade(t, pellApp, ctx, upgrade)
+
+	require.NotEmpty(t, pellApp.RelayerKeeper.GetChainList(ctx))
+	params = pellApp.RelayerKeeper.GetParamsIfExists(ctx)
+	require.Equal(t, relayertypes.DefaultPenaltyWindowBlocks, params.PenaltyWindowBlocks)
+	require.Equal(t, relayertypes.DefaultJailDurationBlocks, params.JailDurationBlocks)
+
+	res, err := pellApp.XmsgKeeper.XmsgsByFilter(ctx, &xmsgtypes.QueryXmsgsByFilterRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Xmsgs, 1)
+	require.Equal(t, xmsg.Index, res.Xmsgs[0].Index)
+
+	require.Equal(t, pellApp.RestakingKeeper.GetEpochNumber(ctx), pellApp.RestakingKeeper.GetSharesHistoryPrunedBefore(ctx))
+}
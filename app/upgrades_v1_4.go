@@ -0,0 +1,19 @@
+package app
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	xsecuritytypes "github.com/0xPellNetwork/aegis/x/xsecurity/types"
+)
+
+// upgradeV1_4 adds the xsecurity module, the existing modules are not migrated
+var upgradeV1_4 = Upgrade{
+	Name: "v1.4",
+	StoreUpgrades: storetypes.StoreUpgrades{
+		Added: []string{xsecuritytypes.StoreKey},
+	},
+	PinModuleVersions: true,
+	FromVersions: map[string]uint64{
+		xsecuritytypes.ModuleName: 1,
+	},
+}
@@ -0,0 +1,19 @@
+package app
+
+import (
+	relayertypes "github.com/0xPellNetwork/aegis/x/relayer/types"
+	restakingtypes "github.com/0xPellNetwork/aegis/x/restaking/types"
+	xmsgtypes "github.com/0xPellNetwork/aegis/x/xmsg/types"
+)
+
+// upgradeV1_5 seeds the relayer chain registry and jailing params, builds the xmsg indexes
+// and backfills the restaking shares history and staker delegations
+var upgradeV1_5 = Upgrade{
+	Name:              "v1.5",
+	PinModuleVersions: true,
+	FromVersions: map[string]uint64{
+		relayertypes.ModuleName:   7,
+		xmsgtypes.ModuleName:      1,
+		restakingtypes.ModuleName: 1,
+	},
+}
@@ -14,7 +14,6 @@ import (
 	"github.com/rs/zerolog"
 	"github.com/spf13/cobra"
 
-	"github.com/0xPellNetwork/aegis/pkg/chains"
 	evmobserver "github.com/0xPellNetwork/aegis/relayer/chains/evm/observer"
 	"github.com/0xPellNetwork/aegis/relayer/config"
 	pctx "github.com/0xPellNetwork/aegis/relayer/context"
@@ -84,7 +83,7 @@ func debugCmd(_ *cobra.Command, args []string) error {
 		return errors.Wrap(err, "failed to update app context")
 	}
 
-	chain, exist := chains.GetChainByChainId(chainID)
+	chain, exist := appContext.PellCoreContext().GetEnabledChain(chainID)
 	if !exist {
 		return fmt.Errorf("invalid chain id")
 	}
@@ -96,7 +95,7 @@ func debugCmd(_ *cobra.Command, args []string) error {
 
 	var ballotIdentifier string
 
-	if chains.IsEVMChain(chain.Id) {
+	if chain.IsEVMChain() {
 		ob := evmobserver.ChainClient{}
 		ob.WithPellcoreClient(client)
 		var ethRPC *ethrpc.EthRPC
@@ -108,7 +107,7 @@ func debugCmd(_ *cobra.Command, args []string) error {
 				if err != nil {
 					return err
 				}
-				chain, _ := chains.GetChainByChainId(chainID)
+				chain, _ := appContext.PellCoreContext().GetEnabledChain(chainID)
 				ob.WithEvmClient(client)
 				ob.WithEvmJSONRPC(ethRPC)
 				ob.WithChain(chain)
@@ -24,6 +24,8 @@ import (
 
 	"github.com/0xPellNetwork/aegis/pkg/authz"
 	"github.com/0xPellNetwork/aegis/pkg/constant"
+	"github.com/0xPellNetwork/aegis/relayer/admin"
+	"github.com/0xPellNetwork/aegis/relayer/compliance"
 	"github.com/0xPellNetwork/aegis/relayer/config"
 	appcontext "github.com/0xPellNetwork/aegis/relayer/context"
 	clientlogs "github.com/0xPellNetwork/aegis/relayer/logs"
@@ -153,6 +155,15 @@ func start(_ *cobra.Command, _ []string) error {
 
 	go pellcoreClient.UpdateAppContextWorker(ctx)
 
+	// Setup the compliance screening of inbound and outbound txs
+	screener, err := compliance.NewScreenerFromConfig(cfg.ComplianceConfig, pellcoreClient, masterLogger)
+	if err != nil {
+		startLogger.Error().Err(err).Msg("NewScreenerFromConfig error")
+		return err
+	}
+	compliance.SetScreener(screener)
+	startLogger.Info().Strs("providers", screener.Providers()).Msg("compliance screening is ready")
+
 	// Generate TSS address . The Tss address is generated through Keygen ceremony. The TSS key is used to sign all outbound transactions .
 	// The hotkeyPk is private key for the Hotkey. The Hotkey is used to sign all inbound transactions
 	// Each node processes a portion of the key stored in ~/.pellcored/.tss by default . Custom location can be specified in config file during init.
@@ -302,6 +313,21 @@ func start(_ *cobra.Command, _ []string) error {
 		return err
 	}
 
+	// Start the admin api if enabled, it allows operators to control the orchestrator without restarting the client
+	if cfg.AdminAPIConfig.IsEnabled() {
+		adminServer, err := admin.NewServer(ctx, cfg.AdminAPIConfig, maestro, masterLogger)
+		if err != nil {
+			startLogger.Error().Err(err).Msg("Unable to create admin api server")
+			return err
+		}
+		go func() {
+			if err := adminServer.Start(); err != nil {
+				startLogger.Error().Err(err).Msg("adminServer error")
+			}
+		}()
+		defer adminServer.Stop() // nolint:errcheck
+	}
+
 	startLogger.Info().Msgf("Pellclientd is running...")
 
 	// ========================================================
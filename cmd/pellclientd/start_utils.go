@@ -66,9 +66,19 @@ func maskCfg(cfg config.Config) string {
 	}
 	maskedCfg.EVMChainConfigs = map[int64]config.EVMConfig{}
 	for key, val := range cfg.EVMChainConfigs {
+		// fallback endpoints are reduced to their host
+		endpoints := make([]string, 0, len(val.Endpoints))
+		for _, endpoint := range val.Endpoints {
+			if endpointURL, err := url.Parse(endpoint); err == nil {
+				endpoint = endpointURL.Hostname()
+			}
+			endpoints = append(endpoints, endpoint)
+		}
 		maskedCfg.EVMChainConfigs[key] = config.EVMConfig{
-			Chain:    val.Chain,
-			Endpoint: val.Endpoint,
+			Chain:     val.Chain,
+			Endpoint:  val.Endpoint,
+			Endpoints: endpoints,
+			RPCQuorum: val.RPCQuorum,
 		}
 	}
 
@@ -86,6 +96,7 @@ func maskCfg(cfg config.Config) string {
 
 	maskedCfg.BitcoinConfig.RPCUsername = ""
 	maskedCfg.BitcoinConfig.RPCPassword = ""
+	maskedCfg.AdminAPIConfig.Token = ""
 
 	return maskedCfg.String()
 }
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"cosmossdk.io/log"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/0xPellNetwork/aegis/app"
+)
+
+const flagAppDBBackend = "app-db-backend"
+
+// CmdCheckInvariants runs the registered invariants against the latest committed state of the node
+// the node must be stopped since the application database is opened directly
+func CmdCheckInvariants(appCreator servertypes.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "invariants [module] [route]",
+		Short: "Check the module invariants on the latest committed state",
+		Long: `Check the module invariants on the latest committed state of the node.
+The invariants can be restricted to a module, or to a single route of a module.
+
+Example:
+$ pellcored debug invariants
+$ pellcored debug invariants xmsg
+$ pellcored debug invariants xmsg chain-nonces`,
+		Args: cobra.MaximumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			vp := viper.New()
+			if err := vp.BindPFlags(cmd.Flags()); err != nil {
+				return err
+			}
+			home := vp.GetString(flags.FlagHome)
+
+			db, err := dbm.NewDB("application", server.GetAppDBBackend(vp), filepath.Join(home, "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			pellApp, ok := appCreator(log.NewNopLogger(), db, nil, vp).(*app.PellApp)
+			if !ok {
+				return fmt.Errorf("unexpected application type")
+			}
+			height := pellApp.LastBlockHeight()
+			if height == 0 {
+				return fmt.Errorf("no committed state found in %s", home)
+			}
+			ctx := pellApp.NewUncachedContext(false, cmtproto.Header{Height: height})
+
+			var checked, broken int
+			for _, ir := range pellApp.CrisisKeeper.Routes() {
+				if len(args) > 0 && ir.ModuleName != args[0] {
+					continue
+				}
+				if len(args) > 1 && ir.Route != args[1] {
+					continue
+				}
+				checked++
+
+				// run each invariant on a cached context so that the state is never written
+				cacheCtx, _ := ctx.CacheContext()
+				res, stop := ir.Invar(cacheCtx)
+				if stop {
+					broken++
+					cmd.Printf("%s: broken\n%s\n", ir.FullRoute(), res)
+					continue
+				}
+				cmd.Printf("%s: ok\n", ir.FullRoute())
+			}
+
+			if checked == 0 {
+				return fmt.Errorf("no invariant registered for %v", args)
+			}
+			if broken > 0 {
+				return fmt.Errorf("%d of %d invariants broken at height %d", broken, checked, height)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flagAppDBBackend, "", "The type of database for application and snapshots databases")
+	return cmd
+}
@@ -44,6 +44,7 @@ var regularPellTest = []string{
 	e2etests.TEST_DVS_EJECT_OPERATORS,
 	e2etests.TEST_BRIDGE_PELL_INBOUND,
 	e2etests.TEST_BRIDGE_PELL_OUTBOUND,
+	e2etests.TEST_BRIDGE_PELL_OUTBOUND_REVERT,
 	e2etests.TEST_DVS_SYNC_GROUP_FAILED,
 	// LST Token dual staking
 	e2etests.TEST_LST_SET_VOTING_POWER_RATIO,
@@ -12,12 +12,16 @@ import (
 	"github.com/0xPellNetwork/contracts/pkg/contracts/pell_evm/pelldelegationmanager.sol"
 	"github.com/0xPellNetwork/contracts/pkg/contracts/service_evm/omnioperatorsharesmanager.sol"
 	"github.com/0xPellNetwork/contracts/pkg/contracts/staking_evm/core/v3/delegationmanager.sol"
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 
 	"github.com/0xPellNetwork/aegis/e2e/runner"
 	"github.com/0xPellNetwork/aegis/e2e/utils"
+	pevmtypes "github.com/0xPellNetwork/aegis/x/pevm/types"
+	relayertypes "github.com/0xPellNetwork/aegis/x/relayer/types"
+	xmsgtypes "github.com/0xPellNetwork/aegis/x/xmsg/types"
 )
 
 // TODO: support multi chain test case
@@ -633,3 +637,109 @@ func TestBridgePellOutbound(r *runner.Runner, _ []string) {
 		}
 	}
 }
+
+// TestBridgePellOutboundRevert bridges pell with a destination gas limit too low to execute,
+// the failed outbound is reverted on pell chain through the onRevert of the connector.
+// The connector keeps the gas fee of the failed outbound converted to apell and refunds the rest.
+func TestBridgePellOutboundRevert(r *runner.Runner, _ []string) {
+	deployerPrivKey, err := crypto.HexToECDSA(TestDeployerPrivKey)
+	if err != nil {
+		panic(err)
+	}
+
+	chainId, err := r.PEVMClient.ChainID(context.Background())
+	if err != nil {
+		panic(err)
+	}
+	deployerTransact, err := bind.NewKeyedTransactorWithChainID(deployerPrivKey, chainId)
+	utils.Assert(err == nil, err)
+	deployerTransact.GasLimit = 1000000
+
+	systemContract, err := r.PellClients.FungibleClient.SystemContract(r.Ctx, &pevmtypes.QueryGetSystemContractRequest{})
+	if err != nil {
+		panic(err)
+	}
+	connector := common.HexToAddress(systemContract.SystemContract.Connector)
+
+	for chainID := range r.MultiEVM {
+		receiver := common.HexToAddress(TestDeployerEvmAddr)
+
+		connectorBalanceBefore, err := r.PEVMClient.BalanceAt(r.Ctx, connector, nil)
+		if err != nil {
+			panic(err)
+		}
+
+		destinationGasLimit := big.NewInt(1000)
+		deployerTransact.Value = big.NewInt(100000000000000000)
+		tx, err := r.PellContracts.Gateway.BridgePell(deployerTransact, big.NewInt(chainID), receiver.Bytes(), destinationGasLimit)
+		if err != nil {
+			panic(err)
+		}
+		r.Logger.Info("Bridge outbound revert tx hash: %s", tx.Hash().Hex())
+
+		// check receipt
+		receipt := utils.MustWaitForTxReceipt(r.Ctx, r.PEVMClient, tx, r.Logger, r.ReceiptTimeout)
+		utils.Assert(receipt.Status == 1, "TestBridgePellOutboundRevert BridgePell tx failed")
+
+		xmsg := utils.WaitXmsgMinedByInTxHash(r.Ctx, tx.Hash().Hex(), r.PellClients.XmsgClient, r.Logger, WaitCrossChainTime*time.Second)
+		r.Logger.Info("TestBridgePellOutboundRevert xmsg index %s, status %s, message %s",
+			xmsg.Index, xmsg.XmsgStatus.Status.String(), xmsg.XmsgStatus.StatusMessage)
+
+		if xmsg.XmsgStatus.Status != xmsgtypes.XmsgStatus_REVERTED {
+			panic(fmt.Sprintf("TestBridgePellOutboundRevert failed: expected status %s, got %s: %s",
+				xmsgtypes.XmsgStatus_REVERTED.String(), xmsg.XmsgStatus.Status.String(), xmsg.XmsgStatus.StatusMessage))
+		}
+		utils.Assert(len(xmsg.OutboundTxParams) == 2, "TestBridgePellOutboundRevert: expected the failed outbound and the revert")
+		failedOutbound, revertOutbound := xmsg.OutboundTxParams[0], xmsg.OutboundTxParams[1]
+
+		// the sender is a contract, so the revert is executed through the onRevert of the connector
+		senderCode, err := r.PEVMClient.CodeAt(r.Ctx, common.HexToAddress(xmsg.InboundTxParams.Sender), nil)
+		if err != nil {
+			panic(err)
+		}
+		utils.Assert(len(senderCode) > 0, "TestBridgePellOutboundRevert: expected a contract sender")
+
+		// #nosec G115 block height always positive
+		revertHeight := new(big.Int).SetUint64(revertOutbound.OutboundTxExternalHeight)
+		logs, err := r.PEVMClient.FilterLogs(r.Ctx, ethereum.FilterQuery{
+			FromBlock: revertHeight,
+			ToBlock:   revertHeight,
+			Addresses: []common.Address{connector},
+		})
+		if err != nil {
+			panic(err)
+		}
+		onRevertCalled := false
+		for _, log := range logs {
+			if log.TxHash == common.HexToHash(revertOutbound.OutboundTxHash) {
+				onRevertCalled = true
+				break
+			}
+		}
+		utils.Assert(onRevertCalled, fmt.Sprintf("TestBridgePellOutboundRevert: no onRevert log of the connector in tx %s",
+			revertOutbound.OutboundTxHash))
+
+		// the refund is the value sent minus the gas fee of the failed outbound at the gas token pell rate of the chain
+		chainParams, err := r.PellClients.RelayerClient.GetChainParamsForChain(r.Ctx, &relayertypes.QueryGetChainParamsForChainRequest{
+			ChainId: chainID,
+		})
+		if err != nil {
+			panic(err)
+		}
+		gasFee, _ := chainParams.ChainParams.GasTokenToApell(failedOutbound.GasFee())
+		pellSent := xmsg.InboundTxParams.InboundPellTx.GetPellSent()
+		expectedRefund := pellSent.RemainingValue(gasFee).BigInt()
+
+		// the value is locked in the connector when sent and the refund leaves it on revert
+		connectorBalanceAfter, err := r.PEVMClient.BalanceAt(r.Ctx, connector, nil)
+		if err != nil {
+			panic(err)
+		}
+		kept := new(big.Int).Sub(connectorBalanceAfter, connectorBalanceBefore)
+		refund := new(big.Int).Sub(pellSent.PellValue.BigInt(), kept)
+		r.Logger.Info("TestBridgePellOutboundRevert refund %s, expected %s, gas fee %s apell", refund, expectedRefund, gasFee)
+		if refund.Cmp(expectedRefund) != 0 {
+			panic(fmt.Sprintf("TestBridgePellOutboundRevert failed: expected refund %s, got %s", expectedRefund, refund))
+		}
+	}
+}
@@ -32,6 +32,7 @@ const (
 	TEST_DVS_EJECT_OPERATORS                    = "pell_dvs_eject_operators"
 	TEST_BRIDGE_PELL_INBOUND                    = "pell_bridge_pell_inbound"
 	TEST_BRIDGE_PELL_OUTBOUND                   = "pell_bridge_pell_outbound"
+	TEST_BRIDGE_PELL_OUTBOUND_REVERT            = "pell_bridge_pell_outbound_revert"
 	TEST_DVS_SYNC_GROUP_FAILED                  = "pell_dvs_sync_group_failed"
 	// LST Token dual staking
 	TEST_LST_SET_VOTING_POWER_RATIO                  = "lst_set_voting_power_ratio"
@@ -274,6 +275,14 @@ var AllE2ETests = []runner.E2ETest{
 		},
 		TestBridgePellOutbound,
 	),
+	runner.NewE2ETest(
+		TEST_BRIDGE_PELL_OUTBOUND_REVERT,
+		"pell contract: TestBridgePellOutboundRevert",
+		[]runner.ArgDefinition{
+			{Description: "test pell bridge outbound revert", DefaultValue: "10000000000000000008"},
+		},
+		TestBridgePellOutboundRevert,
+	),
 	runner.NewE2ETest(
 		TEST_DVS_SYNC_GROUP_FAILED,
 		"pell contract: TestDVSSyncGroupFailed",
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/btcsuite/btcd/chaincfg"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 )
 
@@ -25,11 +26,55 @@ func (chain Chain) IsExternalChain() bool {
 	return chain.Network != NetWork_PELL
 }
 
+// IsEVMChain returns true if the chain runs an EVM
+func (chain Chain) IsEVMChain() bool {
+	return chain.VmType == VMType_EVM
+}
+
+// IsBitcoinChain returns true if the chain is a Bitcoin chain
+func (chain Chain) IsBitcoinChain() bool {
+	return chain.Network == NetWork_BTC
+}
+
+// BitcoinNetParams returns the Bitcoin network parameters of the chain from its network type
+func (chain Chain) BitcoinNetParams() (*chaincfg.Params, error) {
+	if !chain.IsBitcoinChain() {
+		return nil, fmt.Errorf("chain (%d) is not a bitcoin chain", chain.Id)
+	}
+	switch chain.NetworkType {
+	case NetWorkType_MAINNET:
+		return &chaincfg.MainNetParams, nil
+	case NetWorkType_TESTNET:
+		return &chaincfg.TestNet3Params, nil
+	case NetWorkType_PRIVNET:
+		return &chaincfg.RegressionNetParams, nil
+	default:
+		return nil, fmt.Errorf("network type %s not supported for bitcoin chain (%d)", chain.NetworkType, chain.Id)
+	}
+}
+
+// Validate checks the chain can be stored in the chain registry
+func (chain Chain) Validate() error {
+	if chain.Id <= 0 {
+		return fmt.Errorf("invalid chain id %d", chain.Id)
+	}
+	if _, ok := NetWork_name[int32(chain.Network)]; !ok {
+		return fmt.Errorf("invalid network %d", chain.Network)
+	}
+	if _, ok := NetWorkType_name[int32(chain.NetworkType)]; !ok {
+		return fmt.Errorf("invalid network type %d", chain.NetworkType)
+	}
+	if _, ok := VMType_name[int32(chain.VmType)]; !ok {
+		return fmt.Errorf("invalid vm type %d", chain.VmType)
+	}
+	return nil
+}
+
 // EncodeAddress bytes representations of address
 // on EVM chain, it is 20Bytes
 // on Bitcoin chain, it is P2WPKH address, []byte(bech32 encoded string)
 func (chain Chain) EncodeAddress(b []byte) (string, error) {
-	if IsEVMChain(chain.Id) {
+	if chain.IsEVMChain() {
 		addr := ethcommon.BytesToAddress(b)
 		if addr == (ethcommon.Address{}) {
 			return "", fmt.Errorf("invalid EVM address")
@@ -42,7 +87,11 @@ func (chain Chain) EncodeAddress(b []byte) (string, error) {
 
 // DecodeAddress decode the address string to bytes
 func (chain Chain) DecodeAddress(addr string) ([]byte, error) {
-	return DecodeAddressFromChainID(chain.Id, addr)
+	if chain.IsEVMChain() {
+		return ethcommon.HexToAddress(addr).Bytes(), nil
+	}
+
+	return nil, fmt.Errorf("chain (%d) not supported", chain.Id)
 }
 
 // DecodeAddressFromChainID decode the address string to bytes
@@ -84,7 +133,7 @@ func IsHeaderSupportedEvmChain(chainID int64) bool {
 
 // SupportMerkleProof returns true if the chain supports block header-based verification
 func (chain Chain) SupportMerkleProof() bool {
-	return IsEVMChain(chain.Id)
+	return chain.IsEVMChain()
 }
 
 // // IsEthereumChain returns true if the chain is an Ethereum chain
@@ -0,0 +1,124 @@
+package chains_test
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/pkg/chains"
+)
+
+func TestChain_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		chain   chains.Chain
+		wantErr bool
+	}{
+		{
+			name:  "valid chain",
+			chain: chains.SepoliaChain(),
+		},
+		{
+			name: "invalid chain id",
+			chain: chains.Chain{
+				Id:          0,
+				Network:     chains.NetWork_ETH,
+				NetworkType: chains.NetWorkType_TESTNET,
+				VmType:      chains.VMType_EVM,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid network",
+			chain: chains.Chain{
+				Id:          1000,
+				Network:     chains.NetWork(1000),
+				NetworkType: chains.NetWorkType_TESTNET,
+				VmType:      chains.VMType_EVM,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid network type",
+			chain: chains.Chain{
+				Id:          1000,
+				Network:     chains.NetWork_ETH,
+				NetworkType: chains.NetWorkType(1000),
+				VmType:      chains.VMType_EVM,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid vm type",
+			chain: chains.Chain{
+				Id:          1000,
+				Network:     chains.NetWork_ETH,
+				NetworkType: chains.NetWorkType_TESTNET,
+				VmType:      chains.VMType(1000),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.chain.Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestChain_IsEVMChain(t *testing.T) {
+	require.True(t, chains.SepoliaChain().IsEVMChain())
+	require.False(t, chains.Chain{Id: 18332, Network: chains.NetWork_BTC, VmType: chains.VMType_NO_VM}.IsEVMChain())
+}
+
+func TestChain_BitcoinNetParams(t *testing.T) {
+	btcTestnet, found := chains.GetChainByChainId(18332)
+	require.True(t, found)
+	require.True(t, btcTestnet.IsBitcoinChain())
+	params, err := btcTestnet.BitcoinNetParams()
+	require.NoError(t, err)
+	require.Equal(t, &chaincfg.TestNet3Params, params)
+
+	btcRegtest, found := chains.GetChainByChainId(18444)
+	require.True(t, found)
+	params, err = btcRegtest.BitcoinNetParams()
+	require.NoError(t, err)
+	require.Equal(t, &chaincfg.RegressionNetParams, params)
+
+	btcMainnet := chains.Chain{Id: 8332, Network: chains.NetWork_BTC, NetworkType: chains.NetWorkType_MAINNET}
+	params, err = btcMainnet.BitcoinNetParams()
+	require.NoError(t, err)
+	require.Equal(t, &chaincfg.MainNetParams, params)
+
+	require.False(t, chains.SepoliaChain().IsBitcoinChain())
+	_, err = chains.SepoliaChain().BitcoinNetParams()
+	require.Error(t, err)
+}
+
+func TestGetChainFromList(t *testing.T) {
+	newChain := chains.Chain{
+		Id:          1000,
+		Network:     chains.NetWork_ETH,
+		NetworkType: chains.NetWorkType_TESTNET,
+		VmType:      chains.VMType_EVM,
+	}
+	chainList := []chains.Chain{chains.SepoliaChain(), newChain}
+
+	chain, found := chains.GetChainFromList(chainList, 1000)
+	require.True(t, found)
+	require.Equal(t, newChain, chain)
+
+	_, found = chains.GetChainFromList(chainList, chains.EthChain().Id)
+	require.False(t, found)
+
+	// the static list is not used for lookups in a given list
+	_, found = chains.GetChainByChainId(1000)
+	require.False(t, found)
+}
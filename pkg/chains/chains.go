@@ -7,6 +7,8 @@ func (c Chain) ChainName() string {
 }
 
 // ChainsList returns a list of default chains
+// it is only used to seed the chain registry at genesis, the chain registry in the relayer module state
+// is the source of truth for the supported chains
 func ChainsList() []Chain {
 	return []Chain{
 		{
@@ -168,8 +170,21 @@ func ChainsList() []Chain {
 }
 
 func FindChains(filter func(Chain) bool) []Chain {
+	return FindChainsInList(ChainsList(), filter)
+}
+
+func FindChain(filter func(Chain) bool) (Chain, bool) {
+	return FindChainInList(ChainsList(), filter)
+}
+
+func GetChainByChainId(id int64) (Chain, bool) {
+	return FindChain(func(c Chain) bool { return c.Id == id })
+}
+
+// FindChainsInList returns all the chains of the list matching the filter
+func FindChainsInList(chainList []Chain, filter func(Chain) bool) []Chain {
 	res := make([]Chain, 0)
-	for _, v := range ChainsList() {
+	for _, v := range chainList {
 		if filter(v) {
 			res = append(res, v)
 		}
@@ -178,8 +193,9 @@ func FindChains(filter func(Chain) bool) []Chain {
 	return res
 }
 
-func FindChain(filter func(Chain) bool) (Chain, bool) {
-	for _, v := range ChainsList() {
+// FindChainInList returns the first chain of the list matching the filter
+func FindChainInList(chainList []Chain, filter func(Chain) bool) (Chain, bool) {
+	for _, v := range chainList {
 		if filter(v) {
 			return v, true
 		}
@@ -188,8 +204,9 @@ func FindChain(filter func(Chain) bool) (Chain, bool) {
 	return Chain{}, false
 }
 
-func GetChainByChainId(id int64) (Chain, bool) {
-	return FindChain(func(c Chain) bool { return c.Id == id })
+// GetChainFromList returns the chain with the given chain id from the list
+func GetChainFromList(chainList []Chain, id int64) (Chain, bool) {
+	return FindChainInList(chainList, func(c Chain) bool { return c.Id == id })
 }
 
 func PrivnetChainList() []Chain {
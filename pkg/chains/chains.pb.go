@@ -217,6 +217,9 @@ type Chain struct {
 	Network     NetWork     `protobuf:"varint,2,opt,name=network,proto3,enum=pkg.chains.NetWork" json:"network,omitempty"`
 	NetworkType NetWorkType `protobuf:"varint,3,opt,name=network_type,json=networkType,proto3,enum=pkg.chains.NetWorkType" json:"network_type,omitempty"`
 	VmType      VMType      `protobuf:"varint,4,opt,name=vm_type,json=vmType,proto3,enum=pkg.chains.VMType" json:"vm_type,omitempty"`
+	// deprecated chains are kept for historical lookups but can no longer be
+	// supported
+	IsDeprecated bool `protobuf:"varint,5,opt,name=is_deprecated,json=isDeprecated,proto3" json:"is_deprecated,omitempty"`
 }
 
 func (m *Chain) Reset()         { *m = Chain{} }
@@ -280,6 +283,13 @@ func (m *Chain) GetVmType() VMType {
 	return VMType_NO_VM
 }
 
+func (m *Chain) GetIsDeprecated() bool {
+	if m != nil {
+		return m.IsDeprecated
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterEnum("pkg.chains.ReceiveStatus", ReceiveStatus_name, ReceiveStatus_value)
 	proto.RegisterEnum("pkg.chains.NetWork", NetWork_name, NetWork_value)
@@ -291,39 +301,40 @@ func init() {
 func init() { proto.RegisterFile("pkg/chains/chains.proto", fileDescriptor_37ad35e0488e8bbc) }
 
 var fileDescriptor_37ad35e0488e8bbc = []byte{
-	// 506 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0xbf, 0x6e, 0xdb, 0x3c,
-	0x1c, 0x14, 0xe5, 0x3f, 0x72, 0x68, 0xc7, 0xdf, 0x0f, 0xfc, 0x0a, 0xc4, 0xc8, 0x20, 0x04, 0x9d,
-	0xd2, 0x04, 0xb5, 0x8b, 0x76, 0x6b, 0x27, 0x4a, 0x66, 0x1c, 0x01, 0xa2, 0x64, 0x50, 0xb4, 0x0b,
-	0x77, 0x09, 0x1c, 0x47, 0x50, 0x04, 0xc7, 0x91, 0x61, 0x2b, 0x6e, 0xf3, 0x16, 0x7d, 0x88, 0x0e,
-	0xdd, 0xfa, 0x1a, 0x1d, 0x03, 0x74, 0xe9, 0x58, 0xd8, 0x2f, 0x52, 0x90, 0x52, 0x90, 0x0c, 0x9d,
-	0xf8, 0xbb, 0xd3, 0xdd, 0x91, 0x3c, 0x11, 0x1f, 0x2c, 0xe7, 0x49, 0x6f, 0x76, 0x3d, 0x4d, 0x6f,
-	0xd7, 0xe5, 0xd2, 0x5d, 0xae, 0xb2, 0x3c, 0x23, 0x78, 0x39, 0x4f, 0xba, 0x05, 0x73, 0xf8, 0x22,
-	0xc9, 0x92, 0x4c, 0xd3, 0x3d, 0x35, 0x15, 0x8a, 0x97, 0x3f, 0x10, 0xae, 0xb9, 0x4a, 0x40, 0xda,
-	0xd8, 0x4c, 0xaf, 0x3a, 0xe8, 0x08, 0x1d, 0x57, 0x84, 0x99, 0x5e, 0x91, 0xd7, 0xd8, 0xba, 0x8d,
-	0xf3, 0xcf, 0xd9, 0x6a, 0xde, 0x31, 0x8f, 0xd0, 0x71, 0xfb, 0xed, 0xff, 0xdd, 0xa7, 0xb4, 0x6e,
-	0x10, 0xe7, 0x1f, 0xb3, 0xd5, 0x5c, 0x3c, 0x6a, 0xc8, 0x7b, 0xdc, 0x2a, 0xc7, 0x8b, 0xfc, 0x7e,
-	0x19, 0x77, 0x2a, 0xda, 0x73, 0xf0, 0x0f, 0x8f, 0xbc, 0x5f, 0xc6, 0xa2, 0x59, 0x8a, 0x15, 0x20,
-	0xa7, 0xd8, 0xda, 0x2c, 0x0a, 0x5b, 0x55, 0xdb, 0xc8, 0x73, 0xdb, 0x98, 0x6b, 0x47, 0x7d, 0xb3,
-	0x50, 0xeb, 0xc9, 0x07, 0xbc, 0x2f, 0xe2, 0x59, 0x9c, 0x6e, 0xe2, 0x28, 0x9f, 0xe6, 0x77, 0x6b,
-	0xd2, 0xc4, 0x96, 0x2b, 0x18, 0x95, 0xac, 0x0f, 0x86, 0x02, 0xd1, 0xc8, 0x75, 0x59, 0x14, 0x01,
-	0x22, 0x18, 0xd7, 0xcf, 0xa8, 0xe7, 0xb3, 0x3e, 0x98, 0x87, 0xd5, 0xef, 0xdf, 0x6c, 0x74, 0xf2,
-	0x0b, 0x61, 0xab, 0x3c, 0x06, 0xb1, 0x70, 0x85, 0xc9, 0x73, 0x30, 0x48, 0x03, 0x57, 0x87, 0xcc,
-	0xf7, 0x01, 0x29, 0xca, 0x91, 0x2e, 0x98, 0x2a, 0x66, 0x18, 0xfa, 0x93, 0x41, 0x18, 0x40, 0x45,
-	0xb3, 0x91, 0x0b, 0x55, 0x95, 0x37, 0x08, 0x99, 0xf0, 0x3d, 0xa8, 0xa9, 0x99, 0x8f, 0xb8, 0x43,
-	0x3d, 0xa8, 0x2b, 0xf5, 0x80, 0x06, 0xd4, 0x3d, 0x67, 0x60, 0xa9, 0x0f, 0x0e, 0x0d, 0x1d, 0xea,
-	0x40, 0x43, 0x9f, 0x86, 0x0d, 0x43, 0xdf, 0xa3, 0xb0, 0xa7, 0xb6, 0xa1, 0x3c, 0x9c, 0x00, 0x26,
-	0x2d, 0xdc, 0x08, 0x87, 0xd2, 0xe3, 0x5e, 0xc4, 0xa1, 0xa9, 0x78, 0x87, 0x46, 0x0c, 0x5a, 0x6a,
-	0x72, 0x43, 0xc1, 0x60, 0x5f, 0xa7, 0xd3, 0x40, 0xfa, 0x0c, 0xda, 0x4a, 0x4d, 0x85, 0xe3, 0x49,
-	0x31, 0xe2, 0xf0, 0x9f, 0x42, 0x8e, 0x27, 0x7d, 0x3a, 0x61, 0x02, 0xa0, 0xbc, 0xd5, 0x19, 0x6e,
-	0x3e, 0xeb, 0x56, 0xed, 0xca, 0xa9, 0x17, 0x04, 0x4c, 0x16, 0x85, 0x48, 0x16, 0x49, 0x05, 0x90,
-	0xbe, 0x96, 0xf0, 0xc6, 0x0a, 0x98, 0x6a, 0x8f, 0x3e, 0xd3, 0x73, 0xa5, 0xcc, 0x39, 0xc5, 0xf5,
-	0xa2, 0x6c, 0xb2, 0x87, 0x6b, 0x41, 0x78, 0x31, 0xe6, 0x60, 0xe8, 0x9a, 0xc6, 0xbc, 0x28, 0x27,
-	0x1a, 0xf3, 0xc7, 0x2a, 0x1d, 0xf7, 0xe7, 0xd6, 0x46, 0x0f, 0x5b, 0x1b, 0xfd, 0xd9, 0xda, 0xe8,
-	0xeb, 0xce, 0x36, 0x1e, 0x76, 0xb6, 0xf1, 0x7b, 0x67, 0x1b, 0x9f, 0x5e, 0x25, 0x69, 0x7e, 0x7d,
-	0x77, 0xd9, 0x9d, 0x65, 0x8b, 0xde, 0x9b, 0x2f, 0xc3, 0xf8, 0xe6, 0x26, 0x28, 0x7e, 0x76, 0x6f,
-	0x1a, 0x27, 0xe9, 0xba, 0xf7, 0xf4, 0x5a, 0x2f, 0xeb, 0xfa, 0x15, 0xbe, 0xfb, 0x1b, 0x00, 0x00,
-	0xff, 0xff, 0x42, 0x85, 0x9e, 0x6d, 0xc2, 0x02, 0x00, 0x00,
+	// 528 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0xf5, 0x38, 0x3f, 0x4e, 0x27, 0x3f, 0xdf, 0x68, 0x3e, 0xa4, 0x46, 0x5d, 0x58, 0x11, 0x6c,
+	0x42, 0x2b, 0x12, 0x04, 0x3b, 0x58, 0x8d, 0x9d, 0x69, 0x6a, 0xc9, 0x3f, 0xd1, 0x78, 0x12, 0x14,
+	0x36, 0x51, 0x9a, 0x8c, 0x5c, 0x2b, 0x4d, 0x6d, 0x25, 0x6e, 0xa0, 0x6f, 0xc1, 0x43, 0xb0, 0xe0,
+	0x51, 0x58, 0x56, 0x82, 0x05, 0x4b, 0x94, 0xbc, 0x08, 0x9a, 0xb1, 0x4b, 0xbb, 0x60, 0xe5, 0x7b,
+	0x8e, 0xcf, 0x39, 0x77, 0xe6, 0xce, 0x85, 0xc7, 0xe9, 0x2a, 0xea, 0x2f, 0xae, 0xe6, 0xf1, 0xcd,
+	0xb6, 0xf8, 0xf4, 0xd2, 0x4d, 0x92, 0x25, 0x18, 0xa6, 0xab, 0xa8, 0x97, 0x33, 0x27, 0xcf, 0xa2,
+	0x24, 0x4a, 0x14, 0xdd, 0x97, 0x55, 0xae, 0x78, 0xfe, 0x13, 0xc0, 0x8a, 0x2d, 0x05, 0xb8, 0x05,
+	0xf5, 0x78, 0xd9, 0x06, 0x1d, 0xd0, 0x2d, 0x31, 0x3d, 0x5e, 0xe2, 0x57, 0xd0, 0xb8, 0x11, 0xd9,
+	0xa7, 0x64, 0xb3, 0x6a, 0xeb, 0x1d, 0xd0, 0x6d, 0xbd, 0xf9, 0xbf, 0xf7, 0x98, 0xd6, 0xf3, 0x45,
+	0xf6, 0x21, 0xd9, 0xac, 0xd8, 0x83, 0x06, 0xbf, 0x83, 0x8d, 0xa2, 0x9c, 0x65, 0x77, 0xa9, 0x68,
+	0x97, 0x94, 0xe7, 0xf8, 0x1f, 0x1e, 0x7e, 0x97, 0x0a, 0x56, 0x2f, 0xc4, 0x12, 0xe0, 0x33, 0x68,
+	0xec, 0xd6, 0xb9, 0xad, 0xac, 0x6c, 0xf8, 0xa9, 0x6d, 0xe2, 0x29, 0x47, 0x75, 0xb7, 0x56, 0xe2,
+	0x17, 0xb0, 0x19, 0x6f, 0x67, 0x4b, 0x91, 0x6e, 0xc4, 0x62, 0x9e, 0x89, 0x65, 0xbb, 0xd2, 0x01,
+	0xdd, 0x1a, 0x6b, 0xc4, 0xdb, 0xc1, 0x5f, 0xee, 0xf4, 0x3d, 0x6c, 0x32, 0xb1, 0x10, 0xf1, 0x4e,
+	0x84, 0xd9, 0x3c, 0xbb, 0xdd, 0xe2, 0x3a, 0x34, 0x6c, 0x46, 0x09, 0xa7, 0x03, 0xa4, 0x49, 0x10,
+	0x8e, 0x6d, 0x9b, 0x86, 0x21, 0x02, 0x18, 0xc2, 0xea, 0x39, 0x71, 0x5c, 0x3a, 0x40, 0xfa, 0x49,
+	0xf9, 0xdb, 0x57, 0x13, 0x9c, 0xfe, 0x00, 0xd0, 0x28, 0xce, 0x8a, 0x0d, 0x58, 0xa2, 0xfc, 0x02,
+	0x69, 0xb8, 0x06, 0xcb, 0x23, 0xea, 0xba, 0x08, 0x48, 0xca, 0xe2, 0x36, 0xd2, 0x65, 0xcc, 0x28,
+	0x70, 0xa7, 0xc3, 0xc0, 0x47, 0x25, 0xc5, 0x86, 0x36, 0x2a, 0xcb, 0xbc, 0x61, 0x40, 0x99, 0xeb,
+	0xa0, 0x8a, 0xac, 0xbd, 0xb1, 0x67, 0x11, 0x07, 0x55, 0xa5, 0x7a, 0x48, 0x7c, 0x62, 0x5f, 0x50,
+	0x64, 0xc8, 0x1f, 0x16, 0x09, 0x2c, 0x62, 0xa1, 0x9a, 0x3a, 0x0d, 0x1d, 0x05, 0xae, 0x43, 0xd0,
+	0x91, 0x6c, 0x43, 0xbc, 0x60, 0x8a, 0x20, 0x6e, 0xc0, 0x5a, 0x30, 0xe2, 0x8e, 0xe7, 0x84, 0x1e,
+	0xaa, 0x4b, 0xde, 0x22, 0x21, 0x45, 0x0d, 0x59, 0xd9, 0x01, 0xa3, 0xa8, 0xa9, 0xd2, 0x89, 0xcf,
+	0x5d, 0x8a, 0x5a, 0x52, 0x4d, 0x98, 0xe5, 0x70, 0x36, 0xf6, 0xd0, 0x7f, 0x12, 0x59, 0x0e, 0x77,
+	0xc9, 0x94, 0x32, 0x84, 0x8a, 0x5b, 0x9d, 0xc3, 0xfa, 0x93, 0x07, 0x90, 0x5d, 0x3d, 0xe2, 0xf8,
+	0x3e, 0xe5, 0xf9, 0x40, 0x38, 0x0d, 0xb9, 0x04, 0x40, 0x5d, 0x8b, 0x39, 0x13, 0x09, 0x74, 0xd9,
+	0x63, 0x40, 0x55, 0x5d, 0x2a, 0x72, 0xce, 0x60, 0x35, 0x7f, 0x11, 0x7c, 0x04, 0x2b, 0x7e, 0x30,
+	0x9b, 0x78, 0x48, 0x53, 0x63, 0x9a, 0x78, 0xf9, 0x70, 0xc2, 0x89, 0xf7, 0x30, 0x4a, 0xcb, 0xfe,
+	0xbe, 0x37, 0xc1, 0xfd, 0xde, 0x04, 0xbf, 0xf7, 0x26, 0xf8, 0x72, 0x30, 0xb5, 0xfb, 0x83, 0xa9,
+	0xfd, 0x3a, 0x98, 0xda, 0xc7, 0x97, 0x51, 0x9c, 0x5d, 0xdd, 0x5e, 0xf6, 0x16, 0xc9, 0xba, 0xff,
+	0xfa, 0xf3, 0x48, 0x5c, 0x5f, 0xfb, 0xf9, 0x46, 0xf4, 0xe7, 0x22, 0x8a, 0xb7, 0xfd, 0xc7, 0x95,
+	0xbe, 0xac, 0xaa, 0x55, 0x7d, 0xfb, 0x67, 0x00, 0x85, 0x17, 0xbb, 0x84, 0xe7, 0x02, 0x00, 0x00,
 }
 
 func (m *Chain) Marshal() (dAtA []byte, err error) {
@@ -346,6 +357,16 @@ func (m *Chain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.IsDeprecated {
+		i--
+		if m.IsDeprecated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.VmType != 0 {
 		i = encodeVarintChains(dAtA, i, uint64(m.VmType))
 		i--
@@ -398,6 +419,9 @@ func (m *Chain) Size() (n int) {
 	if m.VmType != 0 {
 		n += 1 + sovChains(uint64(m.VmType))
 	}
+	if m.IsDeprecated {
+		n += 2
+	}
 	return n
 }
 
@@ -512,6 +536,26 @@ func (m *Chain) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsDeprecated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChains
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsDeprecated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipChains(dAtA[iNdEx:])
@@ -7,6 +7,8 @@ import (
 const (
 	// EVMSend is the gas limit required to transfer tokens on an EVM based chain
 	EVMSend = 21000
+	// EVMTokenTransfer is the gas limit required to transfer an ERC20 token on an EVM based chain
+	EVMTokenTransfer = 100000
 	// TODO: Move gas limits from pellclient to this file
 )
 
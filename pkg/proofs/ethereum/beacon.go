@@ -0,0 +1,307 @@
+package ethereum
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/bits"
+)
+
+// Beacon chain constants used to verify sync committee signatures
+// see https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/light-client/sync-protocol.md
+const (
+	// SlotsPerEpoch is the number of slots in an epoch
+	SlotsPerEpoch = 32
+
+	// EpochsPerSyncCommitteePeriod is the number of epochs a sync committee is active
+	EpochsPerSyncCommitteePeriod = 256
+
+	// SyncCommitteeSize is the number of validators in a sync committee
+	SyncCommitteeSize = 512
+
+	// CurrentSyncCommitteeGindex is the generalized index of the current sync committee in the beacon state
+	CurrentSyncCommitteeGindex = 54
+
+	// NextSyncCommitteeGindex is the generalized index of the next sync committee in the beacon state
+	NextSyncCommitteeGindex = 55
+
+	// CurrentSyncCommitteeGindexElectra is the generalized index of the current sync committee since Electra
+	CurrentSyncCommitteeGindexElectra = 86
+
+	// NextSyncCommitteeGindexElectra is the generalized index of the next sync committee since Electra
+	NextSyncCommitteeGindexElectra = 87
+
+	// FinalizedRootGindex is the generalized index of the finalized checkpoint root in the beacon state
+	FinalizedRootGindex = 105
+
+	// FinalizedRootGindexElectra is the generalized index of the finalized checkpoint root since Electra
+	FinalizedRootGindexElectra = 169
+
+	// ExecutionBlockHashGindexCapella is the generalized index of the execution block hash in the beacon block body
+	// from Capella to Deneb, the execution payload header has 15 fields
+	ExecutionBlockHashGindexCapella = 412
+
+	// ExecutionBlockHashGindexDeneb is the generalized index of the execution block hash in the beacon block body
+	// since Deneb, the execution payload header has 17 fields
+	ExecutionBlockHashGindexDeneb = 812
+)
+
+// Forks of the beacon chain, the generalized indices of the light client proofs depend on the fork
+const (
+	ForkPhase0    = "phase0"
+	ForkAltair    = "altair"
+	ForkBellatrix = "bellatrix"
+	ForkCapella   = "capella"
+	ForkDeneb     = "deneb"
+	ForkElectra   = "electra"
+	ForkFulu      = "fulu"
+)
+
+// forks are the known forks of the beacon chain in activation order
+var forks = []string{ForkPhase0, ForkAltair, ForkBellatrix, ForkCapella, ForkDeneb, ForkElectra, ForkFulu}
+
+// ForkIndex returns the activation order of the fork, false if the fork is unknown
+func ForkIndex(fork string) (int, bool) {
+	for i, name := range forks {
+		if name == fork {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// isForkActive returns true if the fork activated at or after the given fork
+func isForkActive(fork, since string) (bool, error) {
+	index, ok := ForkIndex(fork)
+	if !ok {
+		return false, fmt.Errorf("unknown fork %q", fork)
+	}
+	sinceIndex, _ := ForkIndex(since)
+	return index >= sinceIndex, nil
+}
+
+// ForkSyncCommitteeGindex returns the generalized index of the current or next sync committee in the beacon state of the fork
+func ForkSyncCommitteeGindex(fork string, next bool) (uint64, error) {
+	if active, err := isForkActive(fork, ForkAltair); err != nil || !active {
+		return 0, fmt.Errorf("no sync committee in %s beacon state", fork)
+	}
+	electra, _ := isForkActive(fork, ForkElectra)
+	switch {
+	case electra && next:
+		return NextSyncCommitteeGindexElectra, nil
+	case electra:
+		return CurrentSyncCommitteeGindexElectra, nil
+	case next:
+		return NextSyncCommitteeGindex, nil
+	default:
+		return CurrentSyncCommitteeGindex, nil
+	}
+}
+
+// ForkFinalizedRootGindex returns the generalized index of the finalized checkpoint root in the beacon state of the fork
+func ForkFinalizedRootGindex(fork string) (uint64, error) {
+	if active, err := isForkActive(fork, ForkAltair); err != nil || !active {
+		return 0, fmt.Errorf("no light client finality proof in %s beacon state", fork)
+	}
+	if electra, _ := isForkActive(fork, ForkElectra); electra {
+		return FinalizedRootGindexElectra, nil
+	}
+	return FinalizedRootGindex, nil
+}
+
+// ForkExecutionBlockHashGindex returns the generalized index of the execution block hash in the beacon block body of the fork
+func ForkExecutionBlockHashGindex(fork string) (uint64, error) {
+	if active, err := isForkActive(fork, ForkCapella); err != nil || !active {
+		return 0, fmt.Errorf("no light client execution proof in %s beacon block body", fork)
+	}
+	if deneb, _ := isForkActive(fork, ForkDeneb); deneb {
+		return ExecutionBlockHashGindexDeneb, nil
+	}
+	return ExecutionBlockHashGindexCapella, nil
+}
+
+// GindexDepth returns the length of the merkle branch of the generalized index
+func GindexDepth(gindex uint64) int {
+	if gindex == 0 {
+		return 0
+	}
+	return bits.Len64(gindex) - 1
+}
+
+// DomainSyncCommittee is the domain type of the sync committee signatures
+var DomainSyncCommittee = [4]byte{0x07, 0x00, 0x00, 0x00}
+
+// SyncCommitteePeriod returns the sync committee period of the slot
+func SyncCommitteePeriod(slot uint64) uint64 {
+	return slot / SlotsPerEpoch / EpochsPerSyncCommitteePeriod
+}
+
+// Epoch returns the epoch of the slot
+func Epoch(slot uint64) uint64 {
+	return slot / SlotsPerEpoch
+}
+
+// BeaconBlockHeaderRoot returns the SSZ hash tree root of a beacon block header
+func BeaconBlockHeaderRoot(slot, proposerIndex uint64, parentRoot, stateRoot, bodyRoot []byte) ([32]byte, error) {
+	chunks := make([][32]byte, 5)
+	chunks[0] = uint64Chunk(slot)
+	chunks[1] = uint64Chunk(proposerIndex)
+	for i, root := range [][]byte{parentRoot, stateRoot, bodyRoot} {
+		chunk, err := rootChunk(root)
+		if err != nil {
+			return [32]byte{}, err
+		}
+		chunks[i+2] = chunk
+	}
+	return merkleize(chunks), nil
+}
+
+// SyncCommitteeRoot returns the SSZ hash tree root of a sync committee
+func SyncCommitteeRoot(pubkeys [][]byte, aggregatePubkey []byte) ([32]byte, error) {
+	if len(pubkeys) != SyncCommitteeSize {
+		return [32]byte{}, fmt.Errorf("invalid sync committee size %d", len(pubkeys))
+	}
+
+	pubkeyRoots := make([][32]byte, 0, len(pubkeys))
+	for _, pubkey := range pubkeys {
+		root, err := pubkeyRoot(pubkey)
+		if err != nil {
+			return [32]byte{}, err
+		}
+		pubkeyRoots = append(pubkeyRoots, root)
+	}
+	aggregateRoot, err := pubkeyRoot(aggregatePubkey)
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	return hashPair(merkleize(pubkeyRoots), aggregateRoot), nil
+}
+
+// ComputeDomain returns the signature domain for the given domain type, fork version and genesis validators root
+func ComputeDomain(domainType [4]byte, forkVersion []byte, genesisValidatorsRoot []byte) ([32]byte, error) {
+	if len(forkVersion) != 4 {
+		return [32]byte{}, fmt.Errorf("invalid fork version length %d", len(forkVersion))
+	}
+	genesisRoot, err := rootChunk(genesisValidatorsRoot)
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	var versionChunk [32]byte
+	copy(versionChunk[:], forkVersion)
+	forkDataRoot := hashPair(versionChunk, genesisRoot)
+
+	var domain [32]byte
+	copy(domain[:4], domainType[:])
+	copy(domain[4:], forkDataRoot[:28])
+	return domain, nil
+}
+
+// ComputeSigningRoot returns the signing root of an object for the given domain
+func ComputeSigningRoot(objectRoot [32]byte, domain [32]byte) [32]byte {
+	return hashPair(objectRoot, domain)
+}
+
+// ParticipatingPubkeys returns the public keys of the sync committee members set in the participation bitvector
+func ParticipatingPubkeys(pubkeys [][]byte, bitvector []byte) ([][]byte, error) {
+	if len(bitvector)*8 != len(pubkeys) {
+		return nil, fmt.Errorf("invalid bitvector length %d for %d public keys", len(bitvector), len(pubkeys))
+	}
+
+	participants := make([][]byte, 0, len(pubkeys))
+	for i, pubkey := range pubkeys {
+		if (bitvector[i/8]>>(i%8))&1 == 1 {
+			participants = append(participants, pubkey)
+		}
+	}
+	return participants, nil
+}
+
+// VerifyMerkleBranch verifies the leaf is at the given generalized index of the tree with the given root
+func VerifyMerkleBranch(leaf [32]byte, branch [][]byte, gindex uint64, root []byte) error {
+	if gindex == 0 {
+		return errors.New("invalid generalized index 0")
+	}
+	depth := bits.Len64(gindex) - 1
+	if len(branch) != depth {
+		return fmt.Errorf("invalid branch length %d for generalized index %d", len(branch), gindex)
+	}
+
+	value := leaf
+	for i, node := range branch {
+		sibling, err := rootChunk(node)
+		if err != nil {
+			return fmt.Errorf("invalid branch node %d: %w", i, err)
+		}
+		if (gindex>>i)&1 == 1 {
+			value = hashPair(sibling, value)
+		} else {
+			value = hashPair(value, sibling)
+		}
+	}
+
+	if !bytes.Equal(value[:], root) {
+		return errors.New("merkle branch does not match root")
+	}
+	return nil
+}
+
+// pubkeyRoot returns the hash tree root of a BLS public key
+func pubkeyRoot(pubkey []byte) ([32]byte, error) {
+	if len(pubkey) != BLSPubkeyLength {
+		return [32]byte{}, fmt.Errorf("invalid public key length %d", len(pubkey))
+	}
+	var first, second [32]byte
+	copy(first[:], pubkey[:32])
+	copy(second[:], pubkey[32:])
+	return hashPair(first, second), nil
+}
+
+// uint64Chunk returns the SSZ chunk of an uint64
+func uint64Chunk(v uint64) (chunk [32]byte) {
+	binary.LittleEndian.PutUint64(chunk[:8], v)
+	return chunk
+}
+
+// rootChunk returns the SSZ chunk of a 32 bytes root
+func rootChunk(root []byte) (chunk [32]byte, err error) {
+	if len(root) != 32 {
+		return chunk, fmt.Errorf("invalid root length %d", len(root))
+	}
+	copy(chunk[:], root)
+	return chunk, nil
+}
+
+// hashPair returns the sha256 hash of the concatenation of two chunks
+func hashPair(a, b [32]byte) [32]byte {
+	var buf [64]byte
+	copy(buf[:32], a[:])
+	copy(buf[32:], b[:])
+	return sha256.Sum256(buf[:])
+}
+
+// merkleize returns the root of the merkle tree of the chunks padded with zero chunks to the next power of two
+func merkleize(chunks [][32]byte) [32]byte {
+	if len(chunks) == 0 {
+		return [32]byte{}
+	}
+
+	size := 1
+	for size < len(chunks) {
+		size <<= 1
+	}
+	layer := make([][32]byte, size)
+	copy(layer, chunks)
+
+	for len(layer) > 1 {
+		next := make([][32]byte, len(layer)/2)
+		for i := range next {
+			next[i] = hashPair(layer[2*i], layer[2*i+1])
+		}
+		layer = next
+	}
+	return layer[0]
+}
@@ -0,0 +1,163 @@
+package ethereum
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func chunk(b byte) [32]byte {
+	var c [32]byte
+	c[0] = b
+	return c
+}
+
+func TestBeaconBlockHeaderRoot(t *testing.T) {
+	parentRoot, stateRoot, bodyRoot := chunk(1), chunk(2), chunk(3)
+
+	root, err := BeaconBlockHeaderRoot(42, 7, parentRoot[:], stateRoot[:], bodyRoot[:])
+	require.NoError(t, err)
+
+	// the header is merkleized as 8 chunks, the 5 fields followed by 3 zero chunks
+	var slot, proposer [32]byte
+	binary.LittleEndian.PutUint64(slot[:], 42)
+	binary.LittleEndian.PutUint64(proposer[:], 7)
+	h := func(a, b [32]byte) [32]byte { return sha256.Sum256(append(a[:], b[:]...)) }
+	zero := [32]byte{}
+	expected := h(
+		h(h(slot, proposer), h(parentRoot, stateRoot)),
+		h(h(bodyRoot, zero), h(zero, zero)),
+	)
+	require.Equal(t, expected, root)
+
+	_, err = BeaconBlockHeaderRoot(42, 7, parentRoot[:31], stateRoot[:], bodyRoot[:])
+	require.ErrorContains(t, err, "invalid root length")
+}
+
+func TestSyncCommitteeRoot(t *testing.T) {
+	pubkeys := make([][]byte, SyncCommitteeSize)
+	for i := range pubkeys {
+		pubkeys[i] = make([]byte, BLSPubkeyLength)
+		pubkeys[i][0] = byte(i)
+	}
+	aggregate := make([]byte, BLSPubkeyLength)
+
+	root, err := SyncCommitteeRoot(pubkeys, aggregate)
+	require.NoError(t, err)
+
+	// changing a public key changes the root
+	pubkeys[511] = append([]byte{0xee}, pubkeys[511][1:]...)
+	other, err := SyncCommitteeRoot(pubkeys, aggregate)
+	require.NoError(t, err)
+	require.NotEqual(t, root, other)
+
+	_, err = SyncCommitteeRoot(pubkeys[:511], aggregate)
+	require.ErrorContains(t, err, "invalid sync committee size")
+}
+
+func TestComputeDomain(t *testing.T) {
+	genesisRoot := chunk(1)
+
+	domain, err := ComputeDomain(DomainSyncCommittee, []byte{0x03, 0x00, 0x00, 0x00}, genesisRoot[:])
+	require.NoError(t, err)
+	require.Equal(t, DomainSyncCommittee[:], domain[:4])
+
+	var version [32]byte
+	version[0] = 0x03
+	forkDataRoot := sha256.Sum256(append(version[:], genesisRoot[:]...))
+	require.Equal(t, forkDataRoot[:28], domain[4:])
+
+	_, err = ComputeDomain(DomainSyncCommittee, []byte{0x03}, genesisRoot[:])
+	require.ErrorContains(t, err, "invalid fork version length")
+}
+
+func TestSyncCommitteePeriod(t *testing.T) {
+	require.EqualValues(t, 0, SyncCommitteePeriod(8191))
+	require.EqualValues(t, 1, SyncCommitteePeriod(8192))
+	require.EqualValues(t, 256, Epoch(8192))
+}
+
+func TestParticipatingPubkeys(t *testing.T) {
+	pubkeys := make([][]byte, 16)
+	for i := range pubkeys {
+		pubkeys[i] = []byte{byte(i)}
+	}
+
+	participants, err := ParticipatingPubkeys(pubkeys, []byte{0b00000101, 0b10000000})
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{{0}, {2}, {15}}, participants)
+
+	_, err = ParticipatingPubkeys(pubkeys, []byte{0xff})
+	require.ErrorContains(t, err, "invalid bitvector length")
+}
+
+func TestVerifyMerkleBranch(t *testing.T) {
+	leaves := make([][32]byte, 8)
+	for i := range leaves {
+		leaves[i] = chunk(byte(i + 1))
+	}
+	root := merkleize(leaves)
+
+	// branch of the leaf 5 (generalized index 13)
+	branch := [][]byte{
+		leaves[4][:],
+		func() []byte { r := hashPair(leaves[6], leaves[7]); return r[:] }(),
+		func() []byte { r := merkleize(leaves[:4]); return r[:] }(),
+	}
+
+	t.Run("should verify branch", func(t *testing.T) {
+		require.NoError(t, VerifyMerkleBranch(leaves[5], branch, 13, root[:]))
+	})
+
+	t.Run("should fail with another leaf", func(t *testing.T) {
+		require.ErrorContains(t, VerifyMerkleBranch(leaves[4], branch, 13, root[:]), "does not match root")
+	})
+
+	t.Run("should fail with another index", func(t *testing.T) {
+		require.ErrorContains(t, VerifyMerkleBranch(leaves[5], branch, 12, root[:]), "does not match root")
+	})
+
+	t.Run("should fail with invalid branch length", func(t *testing.T) {
+		require.ErrorContains(t, VerifyMerkleBranch(leaves[5], branch[:2], 13, root[:]), "invalid branch length")
+	})
+}
+
+func TestForkGindex(t *testing.T) {
+	_, err := ForkSyncCommitteeGindex(ForkPhase0, false)
+	require.Error(t, err)
+	_, err = ForkSyncCommitteeGindex("unknown", false)
+	require.Error(t, err)
+
+	gindex, err := ForkSyncCommitteeGindex(ForkDeneb, true)
+	require.NoError(t, err)
+	require.EqualValues(t, NextSyncCommitteeGindex, gindex)
+	gindex, err = ForkSyncCommitteeGindex(ForkFulu, false)
+	require.NoError(t, err)
+	require.EqualValues(t, CurrentSyncCommitteeGindexElectra, gindex)
+
+	gindex, err = ForkFinalizedRootGindex(ForkAltair)
+	require.NoError(t, err)
+	require.EqualValues(t, FinalizedRootGindex, gindex)
+	gindex, err = ForkFinalizedRootGindex(ForkElectra)
+	require.NoError(t, err)
+	require.EqualValues(t, FinalizedRootGindexElectra, gindex)
+
+	_, err = ForkExecutionBlockHashGindex(ForkBellatrix)
+	require.Error(t, err)
+	gindex, err = ForkExecutionBlockHashGindex(ForkCapella)
+	require.NoError(t, err)
+	require.EqualValues(t, ExecutionBlockHashGindexCapella, gindex)
+	gindex, err = ForkExecutionBlockHashGindex(ForkElectra)
+	require.NoError(t, err)
+	require.EqualValues(t, ExecutionBlockHashGindexDeneb, gindex)
+}
+
+func TestGindexDepth(t *testing.T) {
+	require.Equal(t, 5, GindexDepth(CurrentSyncCommitteeGindex))
+	require.Equal(t, 6, GindexDepth(CurrentSyncCommitteeGindexElectra))
+	require.Equal(t, 6, GindexDepth(FinalizedRootGindex))
+	require.Equal(t, 7, GindexDepth(FinalizedRootGindexElectra))
+	require.Equal(t, 9, GindexDepth(ExecutionBlockHashGindexDeneb))
+}
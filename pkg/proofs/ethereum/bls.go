@@ -0,0 +1,123 @@
+package ethereum
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+)
+
+const (
+	// BLSPubkeyLength is the length of a compressed BLS public key
+	BLSPubkeyLength = 48
+
+	// BLSSignatureLength is the length of a compressed BLS signature
+	BLSSignatureLength = 96
+)
+
+// blsDST is the domain separation tag of the BLS signatures used by the beacon chain
+var blsDST = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+
+// FastAggregateVerify verifies the aggregate signature of a message signed by all the given public keys
+// public keys and signature are compressed points, infinity public keys are rejected
+func FastAggregateVerify(pubkeys [][]byte, message []byte, signature []byte) error {
+	aggregatePubkey, err := aggregatePubkeys(pubkeys)
+	if err != nil {
+		return err
+	}
+	return verify(&aggregatePubkey, message, signature)
+}
+
+// AggregatePubkeys returns the compressed aggregate of the given public keys
+func AggregatePubkeys(pubkeys [][]byte) ([]byte, error) {
+	aggregatePubkey, err := aggregatePubkeys(pubkeys)
+	if err != nil {
+		return nil, err
+	}
+	res := aggregatePubkey.Bytes()
+	return res[:], nil
+}
+
+// aggregatePubkeys decodes and aggregates the given public keys
+func aggregatePubkeys(pubkeys [][]byte) (bls12381.G1Affine, error) {
+	var aggregatePubkey bls12381.G1Affine
+	if len(pubkeys) == 0 {
+		return aggregatePubkey, errors.New("no public key")
+	}
+
+	var aggregate bls12381.G1Jac
+	for i, pubkey := range pubkeys {
+		var pk bls12381.G1Affine
+		if err := decodePubkey(pubkey, &pk); err != nil {
+			return aggregatePubkey, fmt.Errorf("invalid public key %d: %w", i, err)
+		}
+		aggregate.AddMixed(&pk)
+	}
+	aggregatePubkey.FromJacobian(&aggregate)
+	return aggregatePubkey, nil
+}
+
+// BLSPubkey returns the compressed public key of the secret key
+func BLSPubkey(secretKey *big.Int) []byte {
+	var pk bls12381.G1Affine
+	pk.ScalarMultiplicationBase(secretKey)
+	res := pk.Bytes()
+	return res[:]
+}
+
+// BLSSign returns the compressed signature of the message by the secret key
+func BLSSign(secretKey *big.Int, message []byte) ([]byte, error) {
+	hash, err := bls12381.HashToG2(message, blsDST)
+	if err != nil {
+		return nil, fmt.Errorf("unable to hash message: %w", err)
+	}
+	var sig bls12381.G2Affine
+	sig.ScalarMultiplication(&hash, secretKey)
+	res := sig.Bytes()
+	return res[:], nil
+}
+
+// decodePubkey decodes a compressed public key, the point must be in the subgroup and not at infinity
+func decodePubkey(pubkey []byte, pk *bls12381.G1Affine) error {
+	if len(pubkey) != BLSPubkeyLength {
+		return fmt.Errorf("invalid length %d", len(pubkey))
+	}
+	if _, err := pk.SetBytes(pubkey); err != nil {
+		return err
+	}
+	if pk.IsInfinity() {
+		return errors.New("public key at infinity")
+	}
+	return nil
+}
+
+// verify verifies the signature of the message for the given public key
+// the signature is valid if e(pk, H(m)) == e(g1, sig)
+func verify(pk *bls12381.G1Affine, message []byte, signature []byte) error {
+	if len(signature) != BLSSignatureLength {
+		return fmt.Errorf("invalid signature length %d", len(signature))
+	}
+	var sig bls12381.G2Affine
+	if _, err := sig.SetBytes(signature); err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+
+	hash, err := bls12381.HashToG2(message, blsDST)
+	if err != nil {
+		return fmt.Errorf("unable to hash message: %w", err)
+	}
+
+	_, _, g1, _ := bls12381.Generators()
+	var negG1 bls12381.G1Affine
+	negG1.Neg(&g1)
+
+	ok, err := bls12381.PairingCheck([]bls12381.G1Affine{*pk, negG1}, []bls12381.G2Affine{hash, sig})
+	if err != nil {
+		return fmt.Errorf("pairing check failed: %w", err)
+	}
+	if !ok {
+		return errors.New("invalid signature")
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package ethereum
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// test vector from the BLS tests of the consensus spec
+const (
+	vectorSecretKey = "263dbd792f5b1be47ed85f8938c0f29586af0d3ac7b977f21c278fe1462040e3"
+	vectorPubkey    = "a491d1b0ecd9bb917989f0e74f0dea0422eac4a873e5e2644f368dffb9a6e20fd6e10c1b77654d067c0618f6e5a7f79a"
+	vectorSignature = "b6ed936746e01f8ecf281f020953fbf1f01debd5657c4a383940b020b26507f6076334f91e2366c96e9ab279fb5158090352ea1c5b0c9274504f4f0e7053af24802e51e4568d164fe986834f41e55c8e850ce1f98458c0cfc9ab380b55285a55"
+)
+
+func mustDecodeHex(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return b
+}
+
+func TestBLSSign(t *testing.T) {
+	secretKey := new(big.Int).SetBytes(mustDecodeHex(t, vectorSecretKey))
+	message := make([]byte, 32)
+
+	require.Equal(t, mustDecodeHex(t, vectorPubkey), BLSPubkey(secretKey))
+
+	sig, err := BLSSign(secretKey, message)
+	require.NoError(t, err)
+	require.Equal(t, mustDecodeHex(t, vectorSignature), sig)
+}
+
+func TestFastAggregateVerify(t *testing.T) {
+	message := []byte("message")
+	secretKeys := []*big.Int{big.NewInt(42), big.NewInt(43), big.NewInt(44)}
+
+	pubkeys := make([][]byte, 0, len(secretKeys))
+	sum := big.NewInt(0)
+	for _, sk := range secretKeys {
+		pubkeys = append(pubkeys, BLSPubkey(sk))
+		sum.Add(sum, sk)
+	}
+	// the aggregate signature is the signature of the sum of the secret keys
+	sig, err := BLSSign(sum, message)
+	require.NoError(t, err)
+
+	t.Run("should verify aggregate signature", func(t *testing.T) {
+		require.NoError(t, FastAggregateVerify(pubkeys, message, sig))
+	})
+
+	t.Run("should verify spec test vector", func(t *testing.T) {
+		pubkey := mustDecodeHex(t, vectorPubkey)
+		signature := mustDecodeHex(t, vectorSignature)
+		require.NoError(t, FastAggregateVerify([][]byte{pubkey}, make([]byte, 32), signature))
+	})
+
+	t.Run("should fail if a signer is missing", func(t *testing.T) {
+		require.ErrorContains(t, FastAggregateVerify(pubkeys[:2], message, sig), "invalid signature")
+	})
+
+	t.Run("should fail with another message", func(t *testing.T) {
+		require.ErrorContains(t, FastAggregateVerify(pubkeys, []byte("other"), sig), "invalid signature")
+	})
+
+	t.Run("should fail without public key", func(t *testing.T) {
+		require.ErrorContains(t, FastAggregateVerify(nil, message, sig), "no public key")
+	})
+
+	t.Run("should fail with public key at infinity", func(t *testing.T) {
+		infinity := make([]byte, BLSPubkeyLength)
+		infinity[0] = 0xc0
+		require.ErrorContains(t, FastAggregateVerify([][]byte{infinity}, message, sig), "infinity")
+	})
+
+	t.Run("should fail with invalid signature length", func(t *testing.T) {
+		require.ErrorContains(t, FastAggregateVerify(pubkeys, message, sig[:95]), "invalid signature length")
+	})
+}
+
+func TestAggregatePubkeys(t *testing.T) {
+	pubkeys := [][]byte{BLSPubkey(big.NewInt(1)), BLSPubkey(big.NewInt(2))}
+
+	aggregate, err := AggregatePubkeys(pubkeys)
+	require.NoError(t, err)
+	require.Equal(t, BLSPubkey(big.NewInt(3)), aggregate)
+
+	_, err = AggregatePubkeys([][]byte{{0x01}})
+	require.ErrorContains(t, err, "invalid length")
+}
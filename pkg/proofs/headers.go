@@ -51,6 +51,22 @@ func (h HeaderData) ParentHash() ([]byte, error) {
 	}
 }
 
+// EthereumHeight extracts the block height from an Ethereum header
+func (h HeaderData) EthereumHeight() (int64, error) {
+	data, ok := h.Data.(*HeaderData_EthereumHeader)
+	if !ok {
+		return 0, errors.New("not an ethereum header")
+	}
+	var header ethtypes.Header
+	if err := rlp.DecodeBytes(data.EthereumHeader, &header); err != nil {
+		return 0, err
+	}
+	if header.Number == nil || !header.Number.IsInt64() {
+		return 0, errors.New("invalid block number")
+	}
+	return header.Number.Int64(), nil
+}
+
 func (h HeaderData) ValidateTimestamp(pellTime time.Time) error {
 	switch data := h.Data.(type) {
 	case *HeaderData_EthereumHeader:
@@ -39,6 +39,10 @@ func TestTrueEthereumHeader(t *testing.T) {
 
 	err = headerData.ValidateTimestamp(time.Now())
 	require.NoError(t, err)
+
+	height, err := headerData.EthereumHeight()
+	require.NoError(t, err)
+	require.EqualValues(t, 18495266, height)
 }
 
 func TestFalseEthereumHeader(t *testing.T) {
@@ -75,6 +79,9 @@ func TestNonExistentHeaderType(t *testing.T) {
 
 	err = headerData.ValidateTimestamp(time.Now())
 	require.ErrorContains(t, err, "unrecognized header type")
+
+	_, err = headerData.EthereumHeight()
+	require.ErrorContains(t, err, "not an ethereum header")
 }
 
 func createBTCClient(t *testing.T) *rpcclient.Client {
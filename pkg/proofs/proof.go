@@ -61,12 +61,7 @@ func NewBitcoinProof(txBytes []byte, path []byte, index uint) *Proof {
 func (p Proof) Verify(headerData HeaderData, txIndex int) ([]byte, error) {
 	switch proof := p.Proof.(type) {
 	case *Proof_EthereumProof:
-		ethHeaderBytes := headerData.GetEthereumHeader()
-		if ethHeaderBytes == nil {
-			return nil, errors.New("can't verify ethereum proof against non-ethereum header")
-		}
-		var ethHeader ethtypes.Header
-		err := rlp.DecodeBytes(ethHeaderBytes, &ethHeader)
+		ethHeader, err := decodeEthereumHeader(headerData)
 		if err != nil {
 			return nil, err
 		}
@@ -97,3 +92,35 @@ func (p Proof) Verify(headerData HeaderData, txIndex int) ([]byte, error) {
 		return nil, errors.New("unrecognized proof type")
 	}
 }
+
+// VerifyReceipt verifies the receipt proof against the header
+// Returns the verified receipt in bytes if the verification is successful
+// Only ethereum proofs are supported, bitcoin has no receipts
+func (p Proof) VerifyReceipt(headerData HeaderData, txIndex int) ([]byte, error) {
+	proof, ok := p.Proof.(*Proof_EthereumProof)
+	if !ok {
+		return nil, errors.New("receipt proof must be an ethereum proof")
+	}
+	ethHeader, err := decodeEthereumHeader(headerData)
+	if err != nil {
+		return nil, err
+	}
+	val, err := proof.EthereumProof.Verify(ethHeader.ReceiptHash, txIndex)
+	if err != nil {
+		return nil, NewErrInvalidProof(err)
+	}
+	return val, nil
+}
+
+// decodeEthereumHeader decodes the ethereum header of the header data
+func decodeEthereumHeader(headerData HeaderData) (*ethtypes.Header, error) {
+	ethHeaderBytes := headerData.GetEthereumHeader()
+	if ethHeaderBytes == nil {
+		return nil, errors.New("can't verify ethereum proof against non-ethereum header")
+	}
+	var ethHeader ethtypes.Header
+	if err := rlp.DecodeBytes(ethHeaderBytes, &ethHeader); err != nil {
+		return nil, err
+	}
+	return &ethHeader, nil
+}
@@ -109,6 +109,54 @@ func TestEthereumMerkleProof(t *testing.T) {
 			require.Error(t, err)
 		}
 	})
+
+	t.Run("should verify receipt proof", func(t *testing.T) {
+		var receipts types.Receipts
+		for i := 0; i < testdata.TxsCount; i++ {
+			receipt, err := testdata.ReadEthReceipt(i)
+			require.NoError(t, err)
+			receipts = append(receipts, &receipt)
+		}
+		receiptsTree := ethereum.NewTrie(receipts)
+
+		for i := range receipts {
+			proof, err := receiptsTree.GenerateProof(i)
+			require.NoError(t, err)
+
+			verified, err := NewEthereumProof(proof).VerifyReceipt(headerData, i)
+			require.NoError(t, err)
+
+			var receipt types.Receipt
+			require.NoError(t, receipt.UnmarshalBinary(verified))
+			require.Equal(t, receipts[i].CumulativeGasUsed, receipt.CumulativeGasUsed)
+		}
+
+		// a receipt proof doesn't verify against another index
+		proof, err := receiptsTree.GenerateProof(0)
+		require.NoError(t, err)
+		_, err = NewEthereumProof(proof).VerifyReceipt(headerData, 1)
+		require.Error(t, err)
+	})
+
+	t.Run("should fail to verify tx proof as receipt proof", func(t *testing.T) {
+		var txs types.Transactions
+		for i := 0; i < testdata.TxsCount; i++ {
+			tx, err := testdata.ReadEthTx(i)
+			require.NoError(t, err)
+			txs = append(txs, &tx)
+		}
+		txsTree := ethereum.NewTrie(txs)
+		proof, err := txsTree.GenerateProof(0)
+		require.NoError(t, err)
+
+		_, err = NewEthereumProof(proof).VerifyReceipt(headerData, 0)
+		require.Error(t, err)
+	})
+
+	t.Run("should fail to verify bitcoin proof as receipt proof", func(t *testing.T) {
+		_, err := NewBitcoinProof(nil, nil, 0).VerifyReceipt(headerData, 0)
+		require.Error(t, err)
+	})
 }
 
 func BitcoinMerkleProofLiveTest(t *testing.T) {
@@ -20,6 +20,15 @@ func (c *Clients) GetCrosschainFlags(ctx context.Context) (types.CrosschainFlags
 	return resp.CrosschainFlags, nil
 }
 
+// GetRestrictedAddresses returns the restricted address list used for compliance screening
+func (c *Clients) GetRestrictedAddresses(ctx context.Context) ([]string, error) {
+	resp, err := c.Relayer.RestrictedAddresses(ctx, &types.QueryRestrictedAddressesRequest{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get restricted addresses")
+	}
+	return resp.Addresses, nil
+}
+
 // GetSupportedChains returns the supported chains
 func (c *Clients) GetSupportedChains(ctx context.Context) ([]*chains.Chain, error) {
 	resp, err := c.Relayer.SupportedChains(ctx, &types.QuerySupportedChains{})
@@ -120,6 +120,23 @@ func TestPellCoreBridge_GetCrosschainFlags(t *testing.T) {
 	require.Equal(t, expectedOutput.CrosschainFlags, resp)
 }
 
+func TestPellCoreBridge_GetRestrictedAddresses(t *testing.T) {
+	ctx := context.Background()
+
+	expectedOutput := relayertypes.QueryRestrictedAddressesResponse{
+		Addresses: []string{"0x8531a5ab847ff5b22d855633c25ed1da3255247e"},
+	}
+	input := relayertypes.QueryRestrictedAddressesRequest{}
+	method := "/relayer.Query/RestrictedAddresses"
+	setupMockServer(t, relayertypes.RegisterQueryServer, method, input, expectedOutput)
+
+	client := setupPellcoreClients(t)
+
+	resp, err := client.GetRestrictedAddresses(ctx)
+	require.NoError(t, err)
+	require.Equal(t, expectedOutput.Addresses, resp)
+}
+
 func TestPellcore_GetRateLimiterFlags(t *testing.T) {
 	ctx := context.Background()
 
@@ -0,0 +1,251 @@
+// Package admin provides the authenticated http api used by operators to control a running relayer
+package admin
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/rs/zerolog"
+
+	"github.com/0xPellNetwork/aegis/relayer/config"
+	"github.com/0xPellNetwork/aegis/relayer/orchestrator"
+	"github.com/0xPellNetwork/aegis/relayer/outtxprocessor"
+)
+
+// DefaultListenAddress is the address the admin api listens on if none is configured
+const DefaultListenAddress = "127.0.0.1:8124"
+
+// Controller is the relayer component the admin requests are applied to
+type Controller interface {
+	RescanChain(chainID int64, blockNumber uint64) error
+	PauseObserver(chainID int64) error
+	ResumeObserver(chainID int64) error
+	CheckOutboundProcessed(ctx context.Context, chainID int64, nonce uint64) (orchestrator.OutboundStatus, error)
+	ActiveOutTxs() []outtxprocessor.ActiveOutTx
+	ReloadCompliance(ctx context.Context) ([]string, error)
+}
+
+var _ Controller = &orchestrator.Orchestrator{}
+
+// Server provides the http endpoints of the admin api
+type Server struct {
+	// ctx is the app context the admin requests are applied with
+	ctx        context.Context
+	controller Controller
+	token      string
+	logger     zerolog.Logger
+	s          *http.Server
+}
+
+// NewServer creates a new admin api server
+func NewServer(ctx context.Context, cfg config.AdminAPIConfig, controller Controller, logger zerolog.Logger) (*Server, error) {
+	if !cfg.IsEnabled() {
+		return nil, errors.New("admin api token is not set")
+	}
+
+	addr := cfg.ListenAddress
+	if addr == "" {
+		addr = DefaultListenAddress
+	}
+
+	as := &Server{
+		ctx:        ctx,
+		controller: controller,
+		token:      cfg.Token,
+		logger:     logger.With().Str("module", "admin").Logger(),
+	}
+	as.s = &http.Server{
+		Addr:              addr,
+		Handler:           as.Handlers(),
+		ReadTimeout:       5 * time.Second,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+	return as, nil
+}
+
+// Handlers registers the API routes and returns a new HTTP handler
+func (s *Server) Handlers() http.Handler {
+	router := mux.NewRouter()
+	router.Handle("/chains/{chain_id}/rescan/{height}", http.HandlerFunc(s.rescanHandler)).Methods(http.MethodPost)
+	router.Handle("/chains/{chain_id}/pause", http.HandlerFunc(s.pauseHandler)).Methods(http.MethodPost)
+	router.Handle("/chains/{chain_id}/resume", http.HandlerFunc(s.resumeHandler)).Methods(http.MethodPost)
+	router.Handle("/chains/{chain_id}/outbounds/{nonce}/check", http.HandlerFunc(s.checkOutboundHandler)).
+		Methods(http.MethodPost)
+	router.Handle("/outbounds/active", http.HandlerFunc(s.activeOutboundsHandler)).Methods(http.MethodGet)
+	router.Handle("/compliance/reload", http.HandlerFunc(s.reloadComplianceHandler)).Methods(http.MethodPost)
+
+	router.Use(s.authMiddleware())
+
+	return router
+}
+
+// Start starts the admin api server
+func (s *Server) Start() error {
+	s.logger.Info().Msgf("admin api listening on %s", s.s.Addr)
+	if err := s.s.ListenAndServe(); err != nil {
+		if !errors.Is(err, http.ErrServerClosed) {
+			return fmt.Errorf("fail to start admin api server: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Stop stops the admin api server
+func (s *Server) Stop() error {
+	c, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	err := s.s.Shutdown(c)
+	if err != nil {
+		s.logger.Error().Err(err).Msg("Failed to shutdown the admin api server gracefully")
+	}
+	return err
+}
+
+// rescanHandler makes the observer of a chain scan the chain again from a height
+func (s *Server) rescanHandler(w http.ResponseWriter, r *http.Request) {
+	chainID, ok := s.chainIDParam(w, r)
+	if !ok {
+		return
+	}
+	height, ok := s.uintParam(w, r, "height")
+	if !ok {
+		return
+	}
+
+	if err := s.controller.RescanChain(chainID, height); err != nil {
+		s.writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	s.writeJSON(w, map[string]any{"chain_id": chainID, "height": height})
+}
+
+// pauseHandler pauses the observer of a chain
+func (s *Server) pauseHandler(w http.ResponseWriter, r *http.Request) {
+	chainID, ok := s.chainIDParam(w, r)
+	if !ok {
+		return
+	}
+
+	if err := s.controller.PauseObserver(chainID); err != nil {
+		s.writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	s.writeJSON(w, map[string]any{"chain_id": chainID, "paused": true})
+}
+
+// resumeHandler resumes the observer of a chain
+func (s *Server) resumeHandler(w http.ResponseWriter, r *http.Request) {
+	chainID, ok := s.chainIDParam(w, r)
+	if !ok {
+		return
+	}
+
+	if err := s.controller.ResumeObserver(chainID); err != nil {
+		s.writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	s.writeJSON(w, map[string]any{"chain_id": chainID, "paused": false})
+}
+
+// checkOutboundHandler checks the outbound of a nonce on its receiver chain
+func (s *Server) checkOutboundHandler(w http.ResponseWriter, r *http.Request) {
+	chainID, ok := s.chainIDParam(w, r)
+	if !ok {
+		return
+	}
+	nonce, ok := s.uintParam(w, r, "nonce")
+	if !ok {
+		return
+	}
+
+	status, err := s.controller.CheckOutboundProcessed(s.ctx, chainID, nonce)
+	if err != nil {
+		s.writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	s.writeJSON(w, status)
+}
+
+// activeOutboundsHandler returns the outbounds being processed
+func (s *Server) activeOutboundsHandler(w http.ResponseWriter, _ *http.Request) {
+	s.writeJSON(w, s.controller.ActiveOutTxs())
+}
+
+// reloadComplianceHandler reloads the compliance config
+func (s *Server) reloadComplianceHandler(w http.ResponseWriter, _ *http.Request) {
+	providers, err := s.controller.ReloadCompliance(s.ctx)
+	if err != nil {
+		s.writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	s.writeJSON(w, map[string]any{"providers": providers})
+}
+
+// chainIDParam parses the chain id of the request path
+func (s *Server) chainIDParam(w http.ResponseWriter, r *http.Request) (int64, bool) {
+	chainID, err := strconv.ParseInt(mux.Vars(r)["chain_id"], 10, 64)
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, fmt.Errorf("invalid chain id: %w", err))
+		return 0, false
+	}
+	return chainID, true
+}
+
+// uintParam parses an unsigned integer of the request path
+func (s *Server) uintParam(w http.ResponseWriter, r *http.Request, name string) (uint64, bool) {
+	value, err := strconv.ParseUint(mux.Vars(r)[name], 10, 64)
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, fmt.Errorf("invalid %s: %w", name, err))
+		return 0, false
+	}
+	return value, true
+}
+
+func (s *Server) writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		s.logger.Error().Err(err).Msg("Failed to write response")
+	}
+}
+
+func (s *Server) writeError(w http.ResponseWriter, code int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(map[string]string{"error": err.Error()}); err != nil {
+		s.logger.Error().Err(err).Msg("Failed to write response")
+	}
+}
+
+// authMiddleware rejects the requests without the bearer token and logs the accepted ones
+func (s *Server) authMiddleware() mux.MiddlewareFunc {
+	return func(handler http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if !found || subtle.ConstantTimeCompare([]byte(token), []byte(s.token)) != 1 {
+				s.logger.Warn().
+					Str("route", r.URL.Path).
+					Str("remote", r.RemoteAddr).
+					Msg("unauthorized admin request")
+				s.writeError(w, http.StatusUnauthorized, errors.New("unauthorized"))
+				return
+			}
+
+			s.logger.Info().
+				Str("route", r.URL.Path).
+				Str("method", r.Method).
+				Str("remote", r.RemoteAddr).
+				Msg("admin request received")
+
+			handler.ServeHTTP(w, r)
+		})
+	}
+}
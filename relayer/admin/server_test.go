@@ -0,0 +1,235 @@
+package admin_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/relayer/admin"
+	"github.com/0xPellNetwork/aegis/relayer/config"
+	"github.com/0xPellNetwork/aegis/relayer/orchestrator"
+	"github.com/0xPellNetwork/aegis/relayer/outtxprocessor"
+)
+
+const testToken = "secret"
+
+type fakeController struct {
+	rescanned map[int64]uint64
+	paused    map[int64]bool
+	active    []outtxprocessor.ActiveOutTx
+	providers []string
+	err       error
+}
+
+func newFakeController() *fakeController {
+	return &fakeController{
+		rescanned: make(map[int64]uint64),
+		paused:    make(map[int64]bool),
+	}
+}
+
+func (c *fakeController) RescanChain(chainID int64, blockNumber uint64) error {
+	if c.err != nil {
+		return c.err
+	}
+	c.rescanned[chainID] = blockNumber
+	return nil
+}
+
+func (c *fakeController) PauseObserver(chainID int64) error {
+	if c.err != nil {
+		return c.err
+	}
+	c.paused[chainID] = true
+	return nil
+}
+
+func (c *fakeController) ResumeObserver(chainID int64) error {
+	if c.err != nil {
+		return c.err
+	}
+	c.paused[chainID] = false
+	return nil
+}
+
+func (c *fakeController) CheckOutboundProcessed(
+	_ context.Context,
+	chainID int64,
+	nonce uint64,
+) (orchestrator.OutboundStatus, error) {
+	if c.err != nil {
+		return orchestrator.OutboundStatus{}, c.err
+	}
+	return orchestrator.OutboundStatus{
+		ChainID:   chainID,
+		Nonce:     nonce,
+		XmsgIndex: "0x1",
+		Included:  true,
+		Confirmed: true,
+	}, nil
+}
+
+func (c *fakeController) ActiveOutTxs() []outtxprocessor.ActiveOutTx {
+	return c.active
+}
+
+func (c *fakeController) ReloadCompliance(_ context.Context) ([]string, error) {
+	return c.providers, c.err
+}
+
+func newTestServer(t *testing.T, controller admin.Controller) *admin.Server {
+	s, err := admin.NewServer(
+		context.Background(),
+		config.AdminAPIConfig{Token: testToken},
+		controller,
+		zerolog.Nop(),
+	)
+	require.NoError(t, err)
+	return s
+}
+
+func doRequest(s *admin.Server, method, path, token string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	s.Handlers().ServeHTTP(rec, req)
+	return rec
+}
+
+func TestNewServer(t *testing.T) {
+	t.Run("should fail if the token is not set", func(t *testing.T) {
+		_, err := admin.NewServer(context.Background(), config.AdminAPIConfig{}, newFakeController(), zerolog.Nop())
+		require.Error(t, err)
+	})
+}
+
+func TestServer_Auth(t *testing.T) {
+	s := newTestServer(t, newFakeController())
+
+	t.Run("should reject requests without token", func(t *testing.T) {
+		rec := doRequest(s, http.MethodGet, "/outbounds/active", "")
+		require.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("should reject requests with invalid token", func(t *testing.T) {
+		rec := doRequest(s, http.MethodGet, "/outbounds/active", "invalid")
+		require.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("should accept requests with valid token", func(t *testing.T) {
+		rec := doRequest(s, http.MethodGet, "/outbounds/active", testToken)
+		require.Equal(t, http.StatusOK, rec.Code)
+	})
+}
+
+func TestServer_Rescan(t *testing.T) {
+	t.Run("should rescan chain from height", func(t *testing.T) {
+		controller := newFakeController()
+		s := newTestServer(t, controller)
+
+		rec := doRequest(s, http.MethodPost, "/chains/1337/rescan/100", testToken)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.EqualValues(t, 100, controller.rescanned[1337])
+	})
+
+	t.Run("should fail if height is invalid", func(t *testing.T) {
+		controller := newFakeController()
+		s := newTestServer(t, controller)
+
+		rec := doRequest(s, http.MethodPost, "/chains/1337/rescan/abc", testToken)
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+		require.Empty(t, controller.rescanned)
+	})
+
+	t.Run("should fail if rescan fails", func(t *testing.T) {
+		controller := newFakeController()
+		controller.err = errors.New("observer not found")
+		s := newTestServer(t, controller)
+
+		rec := doRequest(s, http.MethodPost, "/chains/1337/rescan/100", testToken)
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+		require.Contains(t, rec.Body.String(), "observer not found")
+	})
+}
+
+func TestServer_PauseResume(t *testing.T) {
+	controller := newFakeController()
+	s := newTestServer(t, controller)
+
+	rec := doRequest(s, http.MethodPost, "/chains/1337/pause", testToken)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.True(t, controller.paused[1337])
+
+	rec = doRequest(s, http.MethodPost, "/chains/1337/resume", testToken)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.False(t, controller.paused[1337])
+
+	rec = doRequest(s, http.MethodPost, "/chains/invalid/pause", testToken)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestServer_CheckOutbound(t *testing.T) {
+	s := newTestServer(t, newFakeController())
+
+	rec := doRequest(s, http.MethodPost, "/chains/1337/outbounds/5/check", testToken)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var status orchestrator.OutboundStatus
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &status))
+	require.Equal(t, orchestrator.OutboundStatus{
+		ChainID:   1337,
+		Nonce:     5,
+		XmsgIndex: "0x1",
+		Included:  true,
+		Confirmed: true,
+	}, status)
+}
+
+func TestServer_ActiveOutbounds(t *testing.T) {
+	controller := newFakeController()
+	controller.active = []outtxprocessor.ActiveOutTx{
+		{
+			OutTxID:   outtxprocessor.ToOutTxID("0x1", 1337, 1),
+			StartTime: time.Unix(100, 0).UTC(),
+			Elapsed:   time.Minute,
+		},
+	}
+	s := newTestServer(t, controller)
+
+	rec := doRequest(s, http.MethodGet, "/outbounds/active", testToken)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var active []outtxprocessor.ActiveOutTx
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &active))
+	require.Equal(t, controller.active, active)
+}
+
+func TestServer_ReloadCompliance(t *testing.T) {
+	t.Run("should return the providers", func(t *testing.T) {
+		controller := newFakeController()
+		controller.providers = []string{"config", "file"}
+		s := newTestServer(t, controller)
+
+		rec := doRequest(s, http.MethodPost, "/compliance/reload", testToken)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Contains(t, rec.Body.String(), "file")
+	})
+
+	t.Run("should fail if reload fails", func(t *testing.T) {
+		controller := newFakeController()
+		controller.err = errors.New("invalid config")
+		s := newTestServer(t, controller)
+
+		rec := doRequest(s, http.MethodPost, "/compliance/reload", testToken)
+		require.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
@@ -91,6 +91,9 @@ type Observer struct {
 	// previous vote inbound block number.
 	lastInboundBlock uint64
 
+	// paused is set when the observer is paused through the admin API
+	paused uint32
+
 	// blockCache is the cache for blocks
 	blockCache *lru.Cache
 
@@ -286,6 +289,33 @@ func (ob *Observer) WithInboundBlock(lastBlock uint64) *Observer {
 	return ob
 }
 
+// Pause pauses the observation of the chain until Resume is called.
+func (ob *Observer) Pause() {
+	atomic.StoreUint32(&ob.paused, 1)
+}
+
+// Resume resumes the observation of the chain.
+func (ob *Observer) Resume() {
+	atomic.StoreUint32(&ob.paused, 0)
+}
+
+// IsPaused returns true if the observation of the chain is paused.
+func (ob *Observer) IsPaused() bool {
+	return atomic.LoadUint32(&ob.paused) == 1
+}
+
+// RescanFrom moves the last scanned block so that the observer scans the chain again from blockNumber.
+// A blockNumber above the last scanned block skips the blocks in between.
+func (ob *Observer) RescanFrom(blockNumber uint64) error {
+	if blockNumber == 0 {
+		return errors.New("rescan block number must be positive")
+	}
+	if lastBlock := ob.LastBlock(); lastBlock > 0 && blockNumber > lastBlock {
+		return fmt.Errorf("rescan block number %d is above the last block %d", blockNumber, lastBlock)
+	}
+	return ob.SaveScanInfo(blockNumber-1, ob.LastInboundBlock())
+}
+
 // BlockCache returns the block cache for the observer.
 func (ob *Observer) BlockCache() *lru.Cache {
 	return ob.blockCache
@@ -0,0 +1,141 @@
+package bitcoin
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcutil"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// MemoReceiverLength is the length of the receiver address at the beginning of the memo
+	// the receiver is the EVM address on PellChain
+	MemoReceiverLength = ethcommon.AddressLength
+
+	// MemoSenderLength is the length of the sender address following the receiver in the memo
+	// the sender is the EVM address the inbound is attributed to and refunded to on PellChain
+	MemoSenderLength = ethcommon.AddressLength
+
+	// MaxMemoLength is the maximum length of the memo carried by an OP_RETURN output
+	MaxMemoLength = txscript.MaxDataCarrierSize
+)
+
+// BTCInboundEvent represents an incoming transaction to the TSS address on a Bitcoin chain
+// The inbound transaction is expected to have the following outputs:
+//   - vout0: the amount sent to the TSS address (P2WPKH)
+//   - vout1: an OP_RETURN output carrying the memo
+type BTCInboundEvent struct {
+	// FromAddress is the address of the first input of the transaction
+	FromAddress string
+
+	// ToAddress is the TSS address
+	ToAddress string
+
+	// Value is the amount sent to the TSS address in satoshis
+	Value int64
+
+	// MemoBytes is the memo carried by the OP_RETURN output
+	MemoBytes []byte
+
+	// BlockNumber is the block height of the transaction
+	BlockNumber uint64
+
+	// TxHash is the hash of the transaction
+	TxHash string
+}
+
+// DecodeMemo decodes the memo into the receiver on PellChain, the EVM sender of the inbound
+// and the message to call the receiver with
+// memo layout: [20 bytes receiver][20 bytes sender][message]
+func DecodeMemo(memo []byte) (receiver ethcommon.Address, sender ethcommon.Address, message []byte, err error) {
+	if len(memo) < MemoReceiverLength+MemoSenderLength {
+		return ethcommon.Address{}, ethcommon.Address{}, nil, fmt.Errorf("memo too short: %d bytes", len(memo))
+	}
+
+	receiver = ethcommon.BytesToAddress(memo[:MemoReceiverLength])
+	if receiver == (ethcommon.Address{}) {
+		return ethcommon.Address{}, ethcommon.Address{}, nil, fmt.Errorf("invalid receiver in memo")
+	}
+
+	sender = ethcommon.BytesToAddress(memo[MemoReceiverLength : MemoReceiverLength+MemoSenderLength])
+	if sender == (ethcommon.Address{}) {
+		return ethcommon.Address{}, ethcommon.Address{}, nil, fmt.Errorf("invalid sender in memo")
+	}
+
+	return receiver, sender, memo[MemoReceiverLength+MemoSenderLength:], nil
+}
+
+// DecodeOpReturnMemo decodes the memo from the hex encoded script of an OP_RETURN output
+// returns false if the script is not a OP_RETURN script
+func DecodeOpReturnMemo(scriptHex string) ([]byte, bool, error) {
+	script, err := hex.DecodeString(scriptHex)
+	if err != nil {
+		return nil, false, fmt.Errorf("error decoding script %s: %w", scriptHex, err)
+	}
+
+	if txscript.GetScriptClass(script) != txscript.NullDataTy {
+		return nil, false, nil
+	}
+
+	pushes, err := txscript.PushedData(script)
+	if err != nil {
+		return nil, true, fmt.Errorf("error parsing OP_RETURN script %s: %w", scriptHex, err)
+	}
+
+	var memo []byte
+	for _, push := range pushes {
+		memo = append(memo, push...)
+	}
+	if len(memo) > MaxMemoLength {
+		return nil, true, fmt.Errorf("memo too long: %d bytes", len(memo))
+	}
+
+	return memo, true, nil
+}
+
+// IsPayToAddress returns true if the hex encoded script pays to the given address
+func IsPayToAddress(scriptHex string, address btcutil.Address) (bool, error) {
+	script, err := hex.DecodeString(scriptHex)
+	if err != nil {
+		return false, fmt.Errorf("error decoding script %s: %w", scriptHex, err)
+	}
+
+	expected, err := txscript.PayToAddrScript(address)
+	if err != nil {
+		return false, fmt.Errorf("error building script for address %s: %w", address.EncodeAddress(), err)
+	}
+
+	return hex.EncodeToString(expected) == hex.EncodeToString(script), nil
+}
+
+// GetSenderAddress returns the address paid by the given output script
+func GetSenderAddress(pkScript []byte, netParams *chaincfg.Params) (string, error) {
+	_, addresses, _, err := txscript.ExtractPkScriptAddrs(pkScript, netParams)
+	if err != nil {
+		return "", fmt.Errorf("error extracting address from script: %w", err)
+	}
+	if len(addresses) != 1 {
+		return "", fmt.Errorf("expected 1 address in script, got %d", len(addresses))
+	}
+
+	return addresses[0].EncodeAddress(), nil
+}
+
+// ValidateInboundTx performs sanity checks on the raw inbound transaction
+func ValidateInboundTx(tx btcjson.TxRawResult) error {
+	if len(tx.Vin) == 0 {
+		return fmt.Errorf("no input found in tx %s", tx.Txid)
+	}
+	if len(tx.Vout) < 2 {
+		return fmt.Errorf("expected at least 2 outputs in tx %s, got %d", tx.Txid, len(tx.Vout))
+	}
+	if tx.Vin[0].IsCoinBase() {
+		return fmt.Errorf("coinbase tx %s is not an inbound", tx.Txid)
+	}
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package bitcoin_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcutil"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/relayer/chains/bitcoin"
+)
+
+func testAddress(t *testing.T, b byte) *btcutil.AddressWitnessPubKeyHash {
+	addr, err := btcutil.NewAddressWitnessPubKeyHash(bytes.Repeat([]byte{b}, 20), &chaincfg.RegressionNetParams)
+	require.NoError(t, err)
+	return addr
+}
+
+func TestDecodeMemo(t *testing.T) {
+	receiver := ethcommon.HexToAddress("0x8531a5aB847ff5B22D855633C25ED1DA3255247e")
+	sender := ethcommon.HexToAddress("0x27104b8dB4aEdDb054fCed87c346C0758Ff5dFB1")
+	memo := append(receiver.Bytes(), sender.Bytes()...)
+
+	t.Run("should decode memo with receiver and sender only", func(t *testing.T) {
+		gotReceiver, gotSender, message, err := bitcoin.DecodeMemo(memo)
+		require.NoError(t, err)
+		require.Equal(t, receiver, gotReceiver)
+		require.Equal(t, sender, gotSender)
+		require.Empty(t, message)
+	})
+
+	t.Run("should decode memo with receiver, sender and message", func(t *testing.T) {
+		gotReceiver, gotSender, message, err := bitcoin.DecodeMemo(append(memo, []byte("hello")...))
+		require.NoError(t, err)
+		require.Equal(t, receiver, gotReceiver)
+		require.Equal(t, sender, gotSender)
+		require.Equal(t, []byte("hello"), message)
+	})
+
+	t.Run("should fail if memo is too short", func(t *testing.T) {
+		_, _, _, err := bitcoin.DecodeMemo(memo[:39])
+		require.ErrorContains(t, err, "memo too short")
+	})
+
+	t.Run("should fail if memo has no sender", func(t *testing.T) {
+		_, _, _, err := bitcoin.DecodeMemo(receiver.Bytes())
+		require.ErrorContains(t, err, "memo too short")
+	})
+
+	t.Run("should fail if receiver is zero address", func(t *testing.T) {
+		_, _, _, err := bitcoin.DecodeMemo(append(make([]byte, 20), sender.Bytes()...))
+		require.ErrorContains(t, err, "invalid receiver")
+	})
+
+	t.Run("should fail if sender is zero address", func(t *testing.T) {
+		_, _, _, err := bitcoin.DecodeMemo(append(receiver.Bytes(), make([]byte, 20)...))
+		require.ErrorContains(t, err, "invalid sender")
+	})
+}
+
+func TestDecodeOpReturnMemo(t *testing.T) {
+	t.Run("should decode memo from OP_RETURN script", func(t *testing.T) {
+		script, err := txscript.NullDataScript([]byte("memo"))
+		require.NoError(t, err)
+
+		memo, found, err := bitcoin.DecodeOpReturnMemo(hex.EncodeToString(script))
+		require.NoError(t, err)
+		require.True(t, found)
+		require.Equal(t, []byte("memo"), memo)
+	})
+
+	t.Run("should return not found for non OP_RETURN script", func(t *testing.T) {
+		script, err := txscript.PayToAddrScript(testAddress(t, 1))
+		require.NoError(t, err)
+
+		_, found, err := bitcoin.DecodeOpReturnMemo(hex.EncodeToString(script))
+		require.NoError(t, err)
+		require.False(t, found)
+	})
+
+	t.Run("should fail for invalid hex", func(t *testing.T) {
+		_, _, err := bitcoin.DecodeOpReturnMemo("invalid")
+		require.Error(t, err)
+	})
+}
+
+func TestIsPayToAddress(t *testing.T) {
+	tssAddress := testAddress(t, 1)
+	script, err := txscript.PayToAddrScript(tssAddress)
+	require.NoError(t, err)
+
+	isToTSS, err := bitcoin.IsPayToAddress(hex.EncodeToString(script), tssAddress)
+	require.NoError(t, err)
+	require.True(t, isToTSS)
+
+	isToTSS, err = bitcoin.IsPayToAddress(hex.EncodeToString(script), testAddress(t, 2))
+	require.NoError(t, err)
+	require.False(t, isToTSS)
+
+	_, err = bitcoin.IsPayToAddress("invalid", tssAddress)
+	require.Error(t, err)
+}
+
+func TestGetSenderAddress(t *testing.T) {
+	sender := testAddress(t, 3)
+	script, err := txscript.PayToAddrScript(sender)
+	require.NoError(t, err)
+
+	got, err := bitcoin.GetSenderAddress(script, &chaincfg.RegressionNetParams)
+	require.NoError(t, err)
+	require.Equal(t, sender.EncodeAddress(), got)
+
+	nullData, err := txscript.NullDataScript([]byte("memo"))
+	require.NoError(t, err)
+	_, err = bitcoin.GetSenderAddress(nullData, &chaincfg.RegressionNetParams)
+	require.Error(t, err)
+}
+
+func TestValidateInboundTx(t *testing.T) {
+	validTx := btcjson.TxRawResult{
+		Txid: "tx",
+		Vin:  []btcjson.Vin{{Txid: "prev"}},
+		Vout: []btcjson.Vout{{}, {}},
+	}
+	require.NoError(t, bitcoin.ValidateInboundTx(validTx))
+
+	noInput := validTx
+	noInput.Vin = nil
+	require.Error(t, bitcoin.ValidateInboundTx(noInput))
+
+	oneOutput := validTx
+	oneOutput.Vout = []btcjson.Vout{{}}
+	require.Error(t, bitcoin.ValidateInboundTx(oneOutput))
+
+	coinbase := validTx
+	coinbase.Vin = []btcjson.Vin{{Coinbase: "03"}}
+	require.Error(t, bitcoin.ValidateInboundTx(coinbase))
+}
@@ -0,0 +1,447 @@
+package observer
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"cosmossdk.io/math"
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcutil"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+
+	"github.com/0xPellNetwork/aegis/pkg/bg"
+	"github.com/0xPellNetwork/aegis/pkg/ticker"
+	"github.com/0xPellNetwork/aegis/relayer/chains/bitcoin"
+	"github.com/0xPellNetwork/aegis/relayer/chains/interfaces"
+	"github.com/0xPellNetwork/aegis/relayer/compliance"
+	pctx "github.com/0xPellNetwork/aegis/relayer/context"
+	"github.com/0xPellNetwork/aegis/relayer/metrics"
+	"github.com/0xPellNetwork/aegis/relayer/pellcore"
+	clienttypes "github.com/0xPellNetwork/aegis/relayer/types"
+	pevmtypes "github.com/0xPellNetwork/aegis/x/pevm/types"
+	xmsgtypes "github.com/0xPellNetwork/aegis/x/xmsg/types"
+)
+
+// WatchInTx watches bitcoin chain for incoming txs to the TSS address and post votes to pellcore
+func (ob *ChainClient) WatchInTx(ctx context.Context) error {
+	sampledLogger := ob.Logger().Inbound.Sample(&zerolog.BasicSampler{N: 10})
+	interval := ticker.SecondsFromUint64(ob.GetChainParams().InTxTicker)
+	task := func(ctx context.Context, t *ticker.Ticker) error {
+		return ob.watchInboundOnce(ctx, t, sampledLogger)
+	}
+
+	t := ticker.New(interval, task)
+
+	bg.Work(ctx, func(_ context.Context) error {
+		<-ob.StopChannel()
+		t.Stop()
+		ob.Logger().Inbound.Info().Msg("WatchInbound stopped")
+		return nil
+	})
+
+	ob.Logger().Inbound.Info().Msgf("WatchInbound started")
+
+	return t.Run(ctx)
+}
+
+// watchInboundOnce observes the inbound txs once if inbound observation is enabled
+func (ob *ChainClient) watchInboundOnce(ctx context.Context, t *ticker.Ticker, sampledLogger zerolog.Logger) error {
+	app, err := pctx.FromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !app.PellCoreContext().IsInboundObservationEnabled(ob.GetChainParams()) {
+		ob.Logger().Inbound.Warn().Msg("WatchInbound: inbound observation is disabled")
+		return nil
+	}
+
+	if ob.IsPaused() {
+		sampledLogger.Info().Msg("WatchInbound: observer is paused")
+		return nil
+	}
+
+	if err := ob.ObserveInTx(ctx, sampledLogger); err != nil {
+		ob.Logger().Inbound.Err(err).Msg("WatchInbound: observeInbound error")
+	}
+
+	newInterval := ticker.SecondsFromUint64(ob.GetChainParams().InTxTicker)
+	t.SetInterval(newInterval)
+
+	return nil
+}
+
+// ObserveInTx scans the confirmed blocks for incoming txs to the TSS address and posts votes to pellcore
+func (ob *ChainClient) ObserveInTx(ctx context.Context, sampledLogger zerolog.Logger) error {
+	// get and update latest block height
+	blockCount, err := ob.btcClient.GetBlockCount()
+	if err != nil {
+		return errors.Wrap(err, "observeInTX: error getting block count")
+	}
+	if blockCount < 0 {
+		return fmt.Errorf("observeInTX: block count is negative: %d", blockCount)
+	}
+
+	// #nosec G701 checked positive
+	blockNumber := uint64(blockCount)
+	if blockNumber < ob.LastBlock() {
+		return fmt.Errorf("observeInTX: block number should not decrease: current %d last %d", blockNumber, ob.LastBlock())
+	}
+	ob.WithLastBlock(blockNumber)
+
+	// skip if current height is too low
+	if blockNumber < ob.GetChainParams().ConfirmationCount {
+		return fmt.Errorf("observeInTX: skipping observer, current block number %d is too low", blockNumber)
+	}
+	confirmedBlockNum := blockNumber - ob.GetChainParams().ConfirmationCount
+
+	// skip if no new block is confirmed
+	lastScanned := ob.LastBlockScanned()
+	if lastScanned >= confirmedBlockNum {
+		sampledLogger.Info().Msgf("observeInTX: skipping observer, no new block is produced for chain %d", ob.Chain().Id)
+		return nil
+	}
+
+	tssAddress, err := ob.TSSAddress()
+	if err != nil {
+		return errors.Wrap(err, "observeInTX: error getting TSS address")
+	}
+
+	toBlock := lastScanned + blocksPerScan
+	if toBlock > confirmedBlockNum {
+		toBlock = confirmedBlockNum
+	}
+
+	for height := lastScanned + 1; height <= toBlock; height++ {
+		if err := ob.observeBlock(ctx, height, tssAddress); err != nil {
+			return errors.Wrapf(err, "observeInTX: error observing block %d", height)
+		}
+
+		metrics.LastScannedBlockNumber.WithLabelValues(ob.Chain().ChainName()).Set(float64(height))
+		if err := ob.SaveScanInfo(height, ob.LastInboundBlock()); err != nil {
+			ob.Logger().Inbound.Error().Err(err).Msgf("observeInTX: error writing last scanned block %d to db", height)
+		}
+	}
+
+	return nil
+}
+
+// observeBlock posts votes for all the incoming txs to the TSS address in the given block
+func (ob *ChainClient) observeBlock(ctx context.Context, height uint64, tssAddress btcutil.Address) error {
+	// #nosec G701 always in range
+	blockHash, err := ob.btcClient.GetBlockHash(int64(height))
+	if err != nil {
+		return errors.Wrap(err, "error getting block hash")
+	}
+
+	block, err := ob.btcClient.GetBlockVerboseTx(blockHash)
+	if err != nil {
+		return errors.Wrapf(err, "error getting block %s", blockHash)
+	}
+
+	events, err := FilterAndParseIncomingTx(ob.btcClient, block.Tx, height, tssAddress, ob.Logger().Inbound, ob.netParams)
+	if err != nil {
+		return err
+	}
+
+	// the block is observed again once the rate converting the deposits to apell is set
+	if len(events) > 0 && !ob.GetChainParams().HasGasTokenPellRate() {
+		return fmt.Errorf("no gas token pell rate set for chain %d", ob.Chain().Id)
+	}
+
+	for _, event := range events {
+		msg := ob.GetInboundVoteMessageFromBtcEvent(event)
+		if msg == nil {
+			continue
+		}
+		if _, err := ob.PostVoteInbound(ctx, msg, pellcore.PostVoteInboundExecutionGasLimit); err != nil {
+			return errors.Wrapf(err, "error posting vote for intx %s", event.TxHash)
+		}
+	}
+
+	if len(events) > 0 {
+		ob.Logger().Inbound.Info().Msgf("observeBlock: detected %d inbound txs in block %d for chain %d", len(events), height, ob.Chain().Id)
+	}
+
+	return nil
+}
+
+// WatchIntxTracker gets a list of Inbound tracker suggestions from pell-core at each tick and tries to check if the in-tx was confirmed.
+// If it was, it tries to broadcast the confirmation vote. If this pell client has previously broadcast the vote, the tx would be rejected
+func (ob *ChainClient) WatchIntxTracker(ctx context.Context) error {
+	app, err := pctx.FromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	ticker, err := clienttypes.NewDynamicTicker(
+		fmt.Sprintf("BTC_WatchInboundTracker_%d", ob.Chain().Id),
+		ob.GetChainParams().InTxTicker,
+	)
+	if err != nil {
+		ob.Logger().Inbound.Err(err).Msg("error creating ticker")
+		return err
+	}
+	defer ticker.Stop()
+
+	ob.Logger().Inbound.Info().Msgf("Inbound tracker watcher started for chain %d", ob.Chain().Id)
+	for {
+		select {
+		case <-ticker.C():
+			if !app.PellCoreContext().IsInboundObservationEnabled(ob.GetChainParams()) || ob.IsPaused() {
+				continue
+			}
+			err := ob.ObserveIntxTrackers(ctx)
+			if err != nil {
+				ob.Logger().Inbound.Err(err).Msg("ProcessInboundTrackers error")
+			}
+			ticker.UpdateInterval(ob.GetChainParams().InTxTicker, ob.Logger().Inbound)
+		case <-ob.StopChannel():
+			ob.Logger().Inbound.Info().Msgf("WatchInboundTracker stopped for chain %d", ob.Chain().Id)
+			return nil
+		}
+	}
+}
+
+// ObserveIntxTrackers observes the inbound trackers for the chain
+func (ob *ChainClient) ObserveIntxTrackers(ctx context.Context) error {
+	trackers, err := ob.PellcoreClient().GetInboundTrackersForChain(ctx, ob.Chain().Id)
+	if err != nil {
+		return err
+	}
+
+	tssAddress, err := ob.TSSAddress()
+	if err != nil {
+		return errors.Wrap(err, "error getting TSS address")
+	}
+
+	for _, tracker := range trackers {
+		ob.Logger().Inbound.Info().Msgf("checking tracker for intx %s chain %d", tracker.TxHash, ob.Chain().Id)
+
+		msg, err := ob.CheckReceiptForBtcTxHash(tracker.TxHash, tssAddress)
+		if err != nil {
+			return errors.Wrapf(err, "error checking intx %s chain %d", tracker.TxHash, ob.Chain().Id)
+		}
+		if msg == nil {
+			continue
+		}
+
+		if _, err := ob.PostVoteInbound(ctx, msg, pellcore.PostVoteInboundExecutionGasLimit); err != nil {
+			return errors.Wrapf(err, "error posting vote msg for intx %s chain %d", tracker.TxHash, ob.Chain().Id)
+		}
+	}
+
+	return nil
+}
+
+// CheckReceiptForBtcTxHash checks the given tx is a confirmed incoming tx to the TSS address and builds its vote message
+func (ob *ChainClient) CheckReceiptForBtcTxHash(txHash string, tssAddress btcutil.Address) (*xmsgtypes.MsgVoteOnObservedInboundTx, error) {
+	hash, err := chainhash.NewHashFromStr(txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := ob.btcClient.GetRawTransactionVerbose(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	if tx.Confirmations < ob.GetChainParams().ConfirmationCount {
+		return nil, fmt.Errorf("intx %s has not enough confirmations: %d", txHash, tx.Confirmations)
+	}
+
+	blockHash, err := chainhash.NewHashFromStr(tx.BlockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := ob.btcClient.GetBlockVerbose(blockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	// #nosec G701 always positive
+	event, err := GetBtcEvent(ob.btcClient, *tx, tssAddress, uint64(block.Height), ob.Logger().Inbound, ob.netParams)
+	if err != nil {
+		return nil, err
+	}
+	if event == nil {
+		return nil, fmt.Errorf("intx %s is not an incoming tx to the TSS address", txHash)
+	}
+	if !ob.GetChainParams().HasGasTokenPellRate() {
+		return nil, fmt.Errorf("no gas token pell rate set for chain %d", ob.Chain().Id)
+	}
+
+	return ob.GetInboundVoteMessageFromBtcEvent(event), nil
+}
+
+// GetInboundVoteMessageFromBtcEvent builds the inbound vote message from the bitcoin event
+// the deposited satoshis are converted to apell with the gas token pell rate of the chain params and sent to the
+// receiver of the memo on PellChain, with the rest of the memo as message
+// the inbound is attributed to the EVM sender of the memo, which is also the refund address
+// returns nil if the event should not be voted
+func (ob *ChainClient) GetInboundVoteMessageFromBtcEvent(event *bitcoin.BTCInboundEvent) *xmsgtypes.MsgVoteOnObservedInboundTx {
+	receiver, sender, message, err := bitcoin.DecodeMemo(event.MemoBytes)
+	if err != nil {
+		ob.Logger().Inbound.Warn().Err(err).Msgf("GetInboundVoteMessageFromBtcEvent: invalid memo in intx %s", event.TxHash)
+		return nil
+	}
+
+	// compliance check
+	if compliance.ScreenTx(ob.Logger().Inbound, ob.Logger().Compliance, compliance.Tx{
+		ChainID:    ob.Chain().Id,
+		Identifier: event.TxHash,
+		Sender:     event.FromAddress,
+		Receiver:   receiver.Hex(),
+		Token:      "BTC",
+	}) {
+		return nil
+	}
+
+	paramType := pevmtypes.Transfer
+	if len(message) > 0 {
+		paramType = pevmtypes.ReceiveCall
+	}
+
+	pellValue, ok := ob.GetChainParams().GasTokenToApell(math.NewInt(event.Value))
+	if !ok {
+		ob.Logger().Inbound.Error().Msgf("GetInboundVoteMessageFromBtcEvent: no gas token pell rate set for chain %d", ob.Chain().Id)
+		return nil
+	}
+
+	pellCoreChainID := ob.PellcoreClient().Chain().Id
+	inboundPellTx := xmsgtypes.InboundPellEvent{
+		PellData: &xmsgtypes.InboundPellEvent_PellSent{
+			PellSent: &xmsgtypes.PellSent{
+				TxOrigin:            sender.Hex(),
+				Sender:              sender.Hex(),
+				ReceiverChainId:     pellCoreChainID,
+				Receiver:            receiver.Hex(),
+				Message:             base64.StdEncoding.EncodeToString(message),
+				PellParams:          paramType.String(),
+				PellValue:           math.NewUintFromBigInt(pellValue.BigInt()),
+				DestinationGasLimit: math.NewUint(pevmtypes.PellSentDefaultDestinationGasLimit),
+			},
+		},
+	}
+
+	return pellcore.GetInBoundVoteMessage(
+		sender.Hex(),
+		ob.Chain().Id,
+		sender.Hex(),
+		receiver.Hex(),
+		pellCoreChainID,
+		event.TxHash,
+		event.BlockNumber,
+		pevmtypes.PellSentDefaultDestinationGasLimit,
+		ob.PellcoreClient().GetKeys().GetOperatorAddress().String(),
+		0,
+		inboundPellTx,
+	)
+}
+
+// FilterAndParseIncomingTx returns the incoming txs to the TSS address among the given txs of a block
+func FilterAndParseIncomingTx(
+	rpcClient interfaces.BTCRPCClient,
+	txs []btcjson.TxRawResult,
+	blockNumber uint64,
+	tssAddress btcutil.Address,
+	logger zerolog.Logger,
+	netParams *chaincfg.Params,
+) ([]*bitcoin.BTCInboundEvent, error) {
+	events := make([]*bitcoin.BTCInboundEvent, 0)
+	for idx, tx := range txs {
+		// the first tx is the coinbase tx
+		if idx == 0 {
+			continue
+		}
+
+		event, err := GetBtcEvent(rpcClient, tx, tssAddress, blockNumber, logger, netParams)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error getting btc event for tx %s in block %d", tx.Txid, blockNumber)
+		}
+		if event != nil {
+			events = append(events, event)
+			logger.Info().Msgf("FilterAndParseIncomingTx: found btc event for tx %s in block %d", tx.Txid, blockNumber)
+		}
+	}
+
+	return events, nil
+}
+
+// GetBtcEvent returns the bitcoin event of the tx if it is an incoming tx to the TSS address carrying a memo
+// returns nil if the tx is not an incoming tx, errors are only returned on RPC failures
+func GetBtcEvent(
+	rpcClient interfaces.BTCRPCClient,
+	tx btcjson.TxRawResult,
+	tssAddress btcutil.Address,
+	blockNumber uint64,
+	logger zerolog.Logger,
+	netParams *chaincfg.Params,
+) (*bitcoin.BTCInboundEvent, error) {
+	if err := bitcoin.ValidateInboundTx(tx); err != nil {
+		return nil, nil
+	}
+
+	// the first output must pay to the TSS address
+	vout0 := tx.Vout[0]
+	isToTSS, err := bitcoin.IsPayToAddress(vout0.ScriptPubKey.Hex, tssAddress)
+	if err != nil {
+		logger.Warn().Err(err).Msgf("GetBtcEvent: invalid first output in tx %s", tx.Txid)
+		return nil, nil
+	}
+	if !isToTSS {
+		return nil, nil
+	}
+
+	value, err := btcutil.NewAmount(vout0.Value)
+	if err != nil || value <= 0 {
+		logger.Warn().Msgf("GetBtcEvent: invalid amount %f sent to TSS in tx %s", vout0.Value, tx.Txid)
+		return nil, nil
+	}
+
+	// the second output must carry the memo
+	memo, found, err := bitcoin.DecodeOpReturnMemo(tx.Vout[1].ScriptPubKey.Hex)
+	if err != nil {
+		logger.Warn().Err(err).Msgf("GetBtcEvent: invalid memo in tx %s", tx.Txid)
+		return nil, nil
+	}
+	if !found {
+		logger.Warn().Msgf("GetBtcEvent: no memo found in tx %s sent to TSS", tx.Txid)
+		return nil, nil
+	}
+
+	// the sender is the address paid by the output spent by the first input
+	vin0 := tx.Vin[0]
+	prevHash, err := chainhash.NewHashFromStr(vin0.Txid)
+	if err != nil {
+		logger.Warn().Err(err).Msgf("GetBtcEvent: invalid input tx hash in tx %s", tx.Txid)
+		return nil, nil
+	}
+	prevTx, err := rpcClient.GetRawTransaction(prevHash)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting input tx %s", vin0.Txid)
+	}
+	if int(vin0.Vout) >= len(prevTx.MsgTx().TxOut) {
+		logger.Warn().Msgf("GetBtcEvent: input index %d out of range for input tx %s", vin0.Vout, vin0.Txid)
+		return nil, nil
+	}
+	fromAddress, err := bitcoin.GetSenderAddress(prevTx.MsgTx().TxOut[vin0.Vout].PkScript, netParams)
+	if err != nil {
+		logger.Warn().Err(err).Msgf("GetBtcEvent: unable to get sender of tx %s", tx.Txid)
+		return nil, nil
+	}
+
+	return &bitcoin.BTCInboundEvent{
+		FromAddress: fromAddress,
+		ToAddress:   tssAddress.EncodeAddress(),
+		Value:       int64(value),
+		MemoBytes:   memo,
+		BlockNumber: blockNumber,
+		TxHash:      tx.Txid,
+	}, nil
+}
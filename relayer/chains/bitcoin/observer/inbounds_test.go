@@ -0,0 +1,464 @@
+package observer_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/relayer/chains/bitcoin"
+	btcobserver "github.com/0xPellNetwork/aegis/relayer/chains/bitcoin/observer"
+	"github.com/0xPellNetwork/aegis/relayer/testutils/stub"
+	"github.com/0xPellNetwork/aegis/testutil/sample"
+	pevmtypes "github.com/0xPellNetwork/aegis/x/pevm/types"
+	relayertypes "github.com/0xPellNetwork/aegis/x/relayer/types"
+	xmsgtypes "github.com/0xPellNetwork/aegis/x/xmsg/types"
+)
+
+var (
+	testReceiver = ethcommon.HexToAddress("0x8531a5aB847ff5B22D855633C25ED1DA3255247e")
+	testSender   = ethcommon.HexToAddress("0x27104b8dB4aEdDb054fCed87c346C0758Ff5dFB1")
+)
+
+// testMemo returns a memo for the test receiver and sender followed by the given message
+func testMemo(message []byte) []byte {
+	memo := append(append([]byte{}, testReceiver.Bytes()...), testSender.Bytes()...)
+	return append(memo, message...)
+}
+
+// btcChainParams returns supported chain params of the bitcoin chain converting 1 BTC to 10000 PELL
+func btcChainParams(chainID int64) relayertypes.ChainParams {
+	params := *sample.ChainParamsSupported_pell(chainID)
+	params.GasTokenPellRate = math.LegacyNewDec(1e14)
+	return params
+}
+
+// tssAddressRegtest returns the P2WPKH address of the mock TSS on regtest
+func tssAddressRegtest(t *testing.T) *btcutil.AddressWitnessPubKeyHash {
+	tss := stub.NewTSSMainnet()
+	addr, err := btcutil.NewAddressWitnessPubKeyHash(
+		btcutil.Hash160(tss.PubKeyCompressedBytes()),
+		&chaincfg.RegressionNetParams,
+	)
+	require.NoError(t, err)
+	return addr
+}
+
+// senderAddressRegtest returns a P2WPKH sender address on regtest
+func senderAddressRegtest(t *testing.T) *btcutil.AddressWitnessPubKeyHash {
+	addr, err := btcutil.NewAddressWitnessPubKeyHash(bytes.Repeat([]byte{0x11}, 20), &chaincfg.RegressionNetParams)
+	require.NoError(t, err)
+	return addr
+}
+
+// createPrevTx creates the tx spent by the inbound tx, its first output pays to the sender
+func createPrevTx(t *testing.T, sender btcutil.Address) *btcutil.Tx {
+	pkScript, err := txscript.PayToAddrScript(sender)
+	require.NoError(t, err)
+
+	msgTx := wire.NewMsgTx(wire.TxVersion)
+	msgTx.AddTxOut(wire.NewTxOut(200000, pkScript))
+	return btcutil.NewTx(msgTx)
+}
+
+// createInboundTx creates an inbound tx sending the given amount (in BTC) to the TSS address with the given memo
+func createInboundTx(
+	t *testing.T,
+	prevTx *btcutil.Tx,
+	to btcutil.Address,
+	amount float64,
+	memo []byte,
+) btcjson.TxRawResult {
+	toScript, err := txscript.PayToAddrScript(to)
+	require.NoError(t, err)
+	memoScript, err := txscript.NullDataScript(memo)
+	require.NoError(t, err)
+
+	return btcjson.TxRawResult{
+		Txid: sample.Hash().Hex()[2:],
+		Vin: []btcjson.Vin{
+			{Txid: prevTx.Hash().String(), Vout: 0},
+		},
+		Vout: []btcjson.Vout{
+			{Value: amount, N: 0, ScriptPubKey: btcjson.ScriptPubKeyResult{Hex: hex.EncodeToString(toScript)}},
+			{Value: 0, N: 1, ScriptPubKey: btcjson.ScriptPubKeyResult{Hex: hex.EncodeToString(memoScript)}},
+		},
+	}
+}
+
+// createBlock creates a verbose block with a coinbase tx followed by the given txs
+func createBlock(height int64, txs ...btcjson.TxRawResult) *btcjson.GetBlockVerboseTxResult {
+	coinbase := btcjson.TxRawResult{
+		Txid: sample.Hash().Hex()[2:],
+		Vin:  []btcjson.Vin{{Coinbase: "03"}},
+	}
+	return &btcjson.GetBlockVerboseTxResult{
+		Hash:   sample.Hash().Hex()[2:],
+		Height: height,
+		Tx:     append([]btcjson.TxRawResult{coinbase}, txs...),
+	}
+}
+
+func Test_GetBtcEvent(t *testing.T) {
+	tssAddress := tssAddressRegtest(t)
+	sender := senderAddressRegtest(t)
+	memo := testMemo([]byte("hello"))
+	netParams := &chaincfg.RegressionNetParams
+
+	t.Run("should get btc event from inbound tx", func(t *testing.T) {
+		prevTx := createPrevTx(t, sender)
+		tx := createInboundTx(t, prevTx, tssAddress, 0.001, memo)
+		rpcClient := stub.NewMockBTCRPCClient().WithRawTransaction(prevTx)
+
+		event, err := btcobserver.GetBtcEvent(rpcClient, tx, tssAddress, 100, zerolog.Nop(), netParams)
+		require.NoError(t, err)
+		require.Equal(t, &bitcoin.BTCInboundEvent{
+			FromAddress: sender.EncodeAddress(),
+			ToAddress:   tssAddress.EncodeAddress(),
+			Value:       100000,
+			MemoBytes:   memo,
+			BlockNumber: 100,
+			TxHash:      tx.Txid,
+		}, event)
+	})
+
+	t.Run("should skip tx not sent to TSS address", func(t *testing.T) {
+		prevTx := createPrevTx(t, sender)
+		tx := createInboundTx(t, prevTx, sender, 0.001, memo)
+		rpcClient := stub.NewMockBTCRPCClient().WithRawTransaction(prevTx)
+
+		event, err := btcobserver.GetBtcEvent(rpcClient, tx, tssAddress, 100, zerolog.Nop(), netParams)
+		require.NoError(t, err)
+		require.Nil(t, event)
+	})
+
+	t.Run("should skip tx without memo", func(t *testing.T) {
+		prevTx := createPrevTx(t, sender)
+		tx := createInboundTx(t, prevTx, tssAddress, 0.001, memo)
+		tx.Vout[1] = tx.Vout[0]
+		rpcClient := stub.NewMockBTCRPCClient().WithRawTransaction(prevTx)
+
+		event, err := btcobserver.GetBtcEvent(rpcClient, tx, tssAddress, 100, zerolog.Nop(), netParams)
+		require.NoError(t, err)
+		require.Nil(t, event)
+	})
+
+	t.Run("should skip tx with zero amount", func(t *testing.T) {
+		prevTx := createPrevTx(t, sender)
+		tx := createInboundTx(t, prevTx, tssAddress, 0, memo)
+		rpcClient := stub.NewMockBTCRPCClient().WithRawTransaction(prevTx)
+
+		event, err := btcobserver.GetBtcEvent(rpcClient, tx, tssAddress, 100, zerolog.Nop(), netParams)
+		require.NoError(t, err)
+		require.Nil(t, event)
+	})
+
+	t.Run("should fail if input tx can't be fetched", func(t *testing.T) {
+		prevTx := createPrevTx(t, sender)
+		tx := createInboundTx(t, prevTx, tssAddress, 0.001, memo)
+		rpcClient := stub.NewMockBTCRPCClient()
+
+		_, err := btcobserver.GetBtcEvent(rpcClient, tx, tssAddress, 100, zerolog.Nop(), netParams)
+		require.ErrorContains(t, err, "error getting input tx")
+	})
+}
+
+func Test_FilterAndParseIncomingTx(t *testing.T) {
+	tssAddress := tssAddressRegtest(t)
+	sender := senderAddressRegtest(t)
+	memo := testMemo(nil)
+
+	prevTx := createPrevTx(t, sender)
+	inboundTx := createInboundTx(t, prevTx, tssAddress, 0.002, memo)
+	otherTx := createInboundTx(t, prevTx, sender, 0.002, memo)
+	block := createBlock(100, otherTx, inboundTx)
+
+	rpcClient := stub.NewMockBTCRPCClient().WithRawTransaction(prevTx)
+	events, err := btcobserver.FilterAndParseIncomingTx(
+		rpcClient,
+		block.Tx,
+		100,
+		tssAddress,
+		zerolog.Nop(),
+		&chaincfg.RegressionNetParams,
+	)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, inboundTx.Txid, events[0].TxHash)
+	require.EqualValues(t, 200000, events[0].Value)
+}
+
+func Test_GetInboundVoteMessageFromBtcEvent(t *testing.T) {
+	chain := btcRegtestChain(t)
+	params := btcChainParams(chain.Id)
+	ob, _ := MockBTCClient(t, chain, nil, nil, nil, params)
+
+	t.Run("should build vote message for transfer", func(t *testing.T) {
+		event := &bitcoin.BTCInboundEvent{
+			FromAddress: senderAddressRegtest(t).EncodeAddress(),
+			ToAddress:   tssAddressRegtest(t).EncodeAddress(),
+			Value:       100000,
+			MemoBytes:   testMemo(nil),
+			BlockNumber: 100,
+			TxHash:      sample.Hash().Hex()[2:],
+		}
+
+		msg := ob.GetInboundVoteMessageFromBtcEvent(event)
+		require.NotNil(t, msg)
+		require.Equal(t, chain.Id, msg.SenderChainId)
+		require.Equal(t, event.TxHash, msg.InTxHash)
+		require.Equal(t, event.BlockNumber, msg.InBlockHeight)
+
+		pellSent, ok := msg.PellTx.PellData.(*xmsgtypes.InboundPellEvent_PellSent)
+		require.True(t, ok)
+		require.Equal(t, testSender.Hex(), msg.Sender)
+		require.Equal(t, testSender.Hex(), msg.TxOrigin)
+		require.Equal(t, testSender.Hex(), pellSent.PellSent.Sender)
+		require.Equal(t, testSender.Hex(), pellSent.PellSent.TxOrigin)
+		require.Equal(t, testReceiver.Hex(), pellSent.PellSent.Receiver)
+		require.Equal(t, pevmtypes.Transfer.String(), pellSent.PellSent.PellParams)
+
+		// 0.001 BTC is 10 PELL at the gas token pell rate
+		require.Equal(t, "10000000000000000000", pellSent.PellSent.PellValue.String())
+	})
+
+	t.Run("should build vote message for call with message", func(t *testing.T) {
+		event := &bitcoin.BTCInboundEvent{
+			FromAddress: senderAddressRegtest(t).EncodeAddress(),
+			ToAddress:   tssAddressRegtest(t).EncodeAddress(),
+			Value:       100000,
+			MemoBytes:   testMemo([]byte("hello")),
+			BlockNumber: 100,
+			TxHash:      sample.Hash().Hex()[2:],
+		}
+
+		msg := ob.GetInboundVoteMessageFromBtcEvent(event)
+		require.NotNil(t, msg)
+
+		pellSent, ok := msg.PellTx.PellData.(*xmsgtypes.InboundPellEvent_PellSent)
+		require.True(t, ok)
+		require.Equal(t, pevmtypes.ReceiveCall.String(), pellSent.PellSent.PellParams)
+		require.Equal(t, base64.StdEncoding.EncodeToString([]byte("hello")), pellSent.PellSent.Message)
+	})
+
+	t.Run("should return nil for invalid memo", func(t *testing.T) {
+		event := &bitcoin.BTCInboundEvent{
+			FromAddress: senderAddressRegtest(t).EncodeAddress(),
+			Value:       100000,
+			MemoBytes:   []byte("short"),
+			TxHash:      sample.Hash().Hex()[2:],
+		}
+		require.Nil(t, ob.GetInboundVoteMessageFromBtcEvent(event))
+	})
+
+	t.Run("should return nil without gas token pell rate", func(t *testing.T) {
+		ob, _ := MockBTCClient(t, chain, nil, nil, nil, *sample.ChainParamsSupported_pell(chain.Id))
+		event := &bitcoin.BTCInboundEvent{
+			FromAddress: senderAddressRegtest(t).EncodeAddress(),
+			Value:       100000,
+			MemoBytes:   testMemo(nil),
+			TxHash:      sample.Hash().Hex()[2:],
+		}
+		require.Nil(t, ob.GetInboundVoteMessageFromBtcEvent(event))
+	})
+
+	t.Run("should return nil for memo without sender", func(t *testing.T) {
+		event := &bitcoin.BTCInboundEvent{
+			FromAddress: senderAddressRegtest(t).EncodeAddress(),
+			Value:       100000,
+			MemoBytes:   append(testReceiver.Bytes(), make([]byte, 20)...),
+			TxHash:      sample.Hash().Hex()[2:],
+		}
+		require.Nil(t, ob.GetInboundVoteMessageFromBtcEvent(event))
+	})
+}
+
+func Test_ObserveInTx(t *testing.T) {
+	chain := btcRegtestChain(t)
+	params := btcChainParams(chain.Id)
+	params.ConfirmationCount = 2
+	params.StartBlockHeight = 0
+
+	tssAddress := tssAddressRegtest(t)
+	sender := senderAddressRegtest(t)
+
+	t.Run("should post votes for inbound txs in confirmed blocks", func(t *testing.T) {
+		prevTx := createPrevTx(t, sender)
+		inboundTx := createInboundTx(t, prevTx, tssAddress, 0.001, testMemo(nil))
+
+		rpcClient := stub.NewMockBTCRPCClient().WithBlockCount(100)
+		pellcoreClient := stub.NewMockPellCoreBridge()
+		ob, ctx := MockBTCClient(t, chain, rpcClient, pellcoreClient, nil, params)
+		require.EqualValues(t, 100, ob.LastBlockScanned())
+
+		// produce 3 new blocks, only the first one is confirmed
+		rpcClient.WithBlockCount(103).
+			WithBlockVerboseTx(createBlock(101, inboundTx)).
+			WithRawTransaction(prevTx)
+
+		err := ob.ObserveInTx(ctx, zerolog.Nop())
+		require.NoError(t, err)
+		require.EqualValues(t, 101, ob.LastBlockScanned())
+		require.EqualValues(t, 103, ob.LastBlock())
+
+		votes := pellcoreClient.GetInboundVotes()
+		require.Len(t, votes, 1)
+		require.Equal(t, inboundTx.Txid, votes[0].InTxHash)
+		require.EqualValues(t, 101, votes[0].InBlockHeight)
+	})
+
+	t.Run("should not scan if no new block is confirmed", func(t *testing.T) {
+		rpcClient := stub.NewMockBTCRPCClient().WithBlockCount(100)
+		pellcoreClient := stub.NewMockPellCoreBridge()
+		ob, ctx := MockBTCClient(t, chain, rpcClient, pellcoreClient, nil, params)
+
+		rpcClient.WithBlockCount(101)
+		err := ob.ObserveInTx(ctx, zerolog.Nop())
+		require.NoError(t, err)
+		require.EqualValues(t, 100, ob.LastBlockScanned())
+		require.Empty(t, pellcoreClient.GetInboundVotes())
+	})
+
+	t.Run("should fail if block can't be fetched", func(t *testing.T) {
+		rpcClient := stub.NewMockBTCRPCClient().WithBlockCount(100)
+		ob, ctx := MockBTCClient(t, chain, rpcClient, nil, nil, params)
+
+		rpcClient.WithBlockCount(103)
+		err := ob.ObserveInTx(ctx, zerolog.Nop())
+		require.ErrorContains(t, err, "error observing block 101")
+		require.EqualValues(t, 100, ob.LastBlockScanned())
+	})
+
+	t.Run("should fail if no gas token pell rate is set", func(t *testing.T) {
+		prevTx := createPrevTx(t, sender)
+		inboundTx := createInboundTx(t, prevTx, tssAddress, 0.001, testMemo(nil))
+
+		noRateParams := params
+		noRateParams.GasTokenPellRate = math.LegacyDec{}
+		rpcClient := stub.NewMockBTCRPCClient().WithBlockCount(100)
+		pellcoreClient := stub.NewMockPellCoreBridge()
+		ob, ctx := MockBTCClient(t, chain, rpcClient, pellcoreClient, nil, noRateParams)
+
+		rpcClient.WithBlockCount(103).
+			WithBlockVerboseTx(createBlock(101, inboundTx)).
+			WithRawTransaction(prevTx)
+
+		err := ob.ObserveInTx(ctx, zerolog.Nop())
+		require.ErrorContains(t, err, "no gas token pell rate")
+		require.EqualValues(t, 100, ob.LastBlockScanned())
+		require.Empty(t, pellcoreClient.GetInboundVotes())
+	})
+
+	t.Run("should fail if pellcore bridge is paused", func(t *testing.T) {
+		prevTx := createPrevTx(t, sender)
+		inboundTx := createInboundTx(t, prevTx, tssAddress, 0.001, testMemo(nil))
+
+		rpcClient := stub.NewMockBTCRPCClient().WithBlockCount(100)
+		pellcoreClient := stub.NewMockPellCoreBridge()
+		ob, ctx := MockBTCClient(t, chain, rpcClient, pellcoreClient, nil, params)
+
+		rpcClient.WithBlockCount(103).
+			WithBlockVerboseTx(createBlock(101, inboundTx)).
+			WithRawTransaction(prevTx)
+		pellcoreClient.Pause()
+
+		err := ob.ObserveInTx(ctx, zerolog.Nop())
+		require.ErrorContains(t, err, stub.ErrMsgPaused)
+		require.EqualValues(t, 100, ob.LastBlockScanned())
+	})
+}
+
+func Test_ObserveIntxTrackers(t *testing.T) {
+	chain := btcRegtestChain(t)
+	params := btcChainParams(chain.Id)
+	params.ConfirmationCount = 2
+
+	tssAddress := tssAddressRegtest(t)
+	sender := senderAddressRegtest(t)
+
+	prevTx := createPrevTx(t, sender)
+	inboundTx := createInboundTx(t, prevTx, tssAddress, 0.001, testMemo(nil))
+	block := createBlock(90, inboundTx)
+	inboundTx.BlockHash = block.Hash
+	inboundTx.Confirmations = 10
+
+	t.Run("should post vote for tracked inbound tx", func(t *testing.T) {
+		rpcClient := stub.NewMockBTCRPCClient().
+			WithBlockCount(100).
+			WithBlockVerboseTx(block).
+			WithRawTransactionVerbose(&inboundTx).
+			WithRawTransaction(prevTx)
+		pellcoreClient := stub.NewMockPellCoreBridge().WithInboundTrackers([]xmsgtypes.InTxTracker{
+			{ChainId: chain.Id, TxHash: inboundTx.Txid},
+		})
+		ob, ctx := MockBTCClient(t, chain, rpcClient, pellcoreClient, nil, params)
+
+		err := ob.ObserveIntxTrackers(ctx)
+		require.NoError(t, err)
+
+		votes := pellcoreClient.GetInboundVotes()
+		require.Len(t, votes, 1)
+		require.Equal(t, inboundTx.Txid, votes[0].InTxHash)
+		require.EqualValues(t, 90, votes[0].InBlockHeight)
+	})
+
+	t.Run("should fail if tracked tx is not confirmed", func(t *testing.T) {
+		unconfirmedTx := inboundTx
+		unconfirmedTx.Txid = sample.Hash().Hex()[2:]
+		unconfirmedTx.Confirmations = 1
+
+		rpcClient := stub.NewMockBTCRPCClient().
+			WithBlockCount(100).
+			WithBlockVerboseTx(block).
+			WithRawTransactionVerbose(&unconfirmedTx)
+		pellcoreClient := stub.NewMockPellCoreBridge().WithInboundTrackers([]xmsgtypes.InTxTracker{
+			{ChainId: chain.Id, TxHash: unconfirmedTx.Txid},
+		})
+		ob, ctx := MockBTCClient(t, chain, rpcClient, pellcoreClient, nil, params)
+
+		err := ob.ObserveIntxTrackers(ctx)
+		require.ErrorContains(t, err, "not enough confirmations")
+		require.Empty(t, pellcoreClient.GetInboundVotes())
+	})
+
+	t.Run("should fail if tracked tx is not an inbound", func(t *testing.T) {
+		otherTx := createInboundTx(t, prevTx, sender, 0.001, testMemo(nil))
+		otherTx.BlockHash = block.Hash
+		otherTx.Confirmations = 10
+
+		rpcClient := stub.NewMockBTCRPCClient().
+			WithBlockCount(100).
+			WithBlockVerboseTx(block).
+			WithRawTransactionVerbose(&otherTx)
+		pellcoreClient := stub.NewMockPellCoreBridge().WithInboundTrackers([]xmsgtypes.InTxTracker{
+			{ChainId: chain.Id, TxHash: otherTx.Txid},
+		})
+		ob, ctx := MockBTCClient(t, chain, rpcClient, pellcoreClient, nil, params)
+
+		err := ob.ObserveIntxTrackers(ctx)
+		require.ErrorContains(t, err, "is not an incoming tx to the TSS address")
+	})
+}
+
+func Test_CheckReceiptForBtcTxHash(t *testing.T) {
+	chain := btcRegtestChain(t)
+	params := *sample.ChainParamsSupported_pell(chain.Id)
+	ob, _ := MockBTCClient(t, chain, nil, nil, nil, params)
+
+	_, err := ob.CheckReceiptForBtcTxHash(sample.Hash().Hex()+"00", tssAddressRegtest(t))
+	require.ErrorIs(t, err, chainhash.ErrHashStrSize)
+
+	_, err = ob.CheckReceiptForBtcTxHash(sample.Hash().Hex()[2:], tssAddressRegtest(t))
+	require.ErrorContains(t, err, "no transaction found")
+}
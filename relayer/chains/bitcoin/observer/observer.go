@@ -0,0 +1,163 @@
+package observer
+
+import (
+	"context"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+
+	"github.com/0xPellNetwork/aegis/pkg/bg"
+	"github.com/0xPellNetwork/aegis/pkg/chains"
+	"github.com/0xPellNetwork/aegis/relayer/chains/base"
+	"github.com/0xPellNetwork/aegis/relayer/chains/interfaces"
+	pctx "github.com/0xPellNetwork/aegis/relayer/context"
+	"github.com/0xPellNetwork/aegis/relayer/db"
+	clientlogs "github.com/0xPellNetwork/aegis/relayer/logs"
+	"github.com/0xPellNetwork/aegis/relayer/metrics"
+	relayertypes "github.com/0xPellNetwork/aegis/x/relayer/types"
+	xmsgtypes "github.com/0xPellNetwork/aegis/x/xmsg/types"
+)
+
+const (
+	// defaultAlertLatency is the default alert latency (in seconds) for unit tests
+	defaultAlertLatency = 60
+
+	// blocksPerScan is the maximum number of blocks scanned in one go
+	blocksPerScan = 10
+)
+
+var _ interfaces.ChainClient = &ChainClient{}
+
+// ChainClient represents the chain observer for a Bitcoin chain
+// it observes the incoming transactions to the TSS address and votes them on PellChain
+type ChainClient struct {
+	// base.Observer implements the base chain observer
+	base.Observer
+
+	// btcClient is the Bitcoin RPC client for the observed chain
+	btcClient interfaces.BTCRPCClient
+
+	// netParams contains the Bitcoin network parameters of the observed chain
+	netParams *chaincfg.Params
+}
+
+// NewBitcoinChainClient returns a new observer for the given Bitcoin chain
+func NewBitcoinChainClient(
+	ctx context.Context,
+	chain chains.Chain,
+	chainParams relayertypes.ChainParams,
+	btcClient interfaces.BTCRPCClient,
+	pellcoreClient interfaces.PellCoreBridger,
+	tss interfaces.TSSSigner,
+	database *db.DB,
+	logger clientlogs.Logger,
+	ts *metrics.TelemetryServer,
+) (*ChainClient, error) {
+	appContext, err := pctx.FromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get app context")
+	}
+
+	netParams, err := chain.BitcoinNetParams()
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to get bitcoin net params for chain %d", chain.Id)
+	}
+
+	// create base observer
+	baseObserver, err := base.NewObserver(
+		appContext.PellCoreContext(),
+		chain,
+		chainParams,
+		pellcoreClient,
+		tss,
+		base.DefaultBlockCacheSize,
+		base.DefaultHeaderCacheSize,
+		defaultAlertLatency,
+		ts,
+		database,
+		logger,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create base observer")
+	}
+
+	ob := &ChainClient{
+		Observer:  *baseObserver,
+		btcClient: btcClient,
+		netParams: netParams,
+	}
+
+	// load last block scanned
+	if err = ob.LoadLastBlockScanned(ctx); err != nil {
+		return nil, errors.Wrap(err, "unable to load last block scanned")
+	}
+
+	return ob, nil
+}
+
+// WithBtcClient attaches a new btc client to the chain client
+func (ob *ChainClient) WithBtcClient(client interfaces.BTCRPCClient) {
+	ob.btcClient = client
+}
+
+// SetChainParams sets the chain params for the chain client
+func (ob *ChainClient) SetChainParams(params relayertypes.ChainParams) {
+	ob.WithChainParams(params)
+}
+
+// GetChainParams returns the chain params for the chain client
+func (ob *ChainClient) GetChainParams() relayertypes.ChainParams {
+	return ob.ChainParams()
+}
+
+// Start all observation routines for the bitcoin chain
+func (ob *ChainClient) Start(ctx context.Context) {
+	if noop := ob.Observer.Start(); noop {
+		ob.Logger().Chain.Info().Msgf("observer is already started for chain %d", ob.Chain().Id)
+		return
+	}
+
+	ob.Logger().Chain.Info().Msgf("observer is starting for chain %d", ob.Chain().Id)
+
+	bg.Work(ctx, ob.WatchInTx, bg.WithName("WatchInbound"), bg.WithLogger(ob.Logger().Inbound))
+	bg.Work(ctx, ob.WatchIntxTracker, bg.WithName("WatchInboundTracker"), bg.WithLogger(ob.Logger().Inbound))
+}
+
+// IsOutboundProcessed returns false as outbound transactions are not supported on bitcoin chains yet
+func (ob *ChainClient) IsOutboundProcessed(_ context.Context, xmsg *xmsgtypes.Xmsg, logger zerolog.Logger) (bool, bool, error) {
+	logger.Warn().Msgf("IsOutboundProcessed: outbound not supported for bitcoin chain %d, xmsg %s", ob.Chain().Id, xmsg.Index)
+	return false, false, nil
+}
+
+// TSSAddress returns the P2WPKH address of the TSS on the observed chain
+func (ob *ChainClient) TSSAddress() (*btcutil.AddressWitnessPubKeyHash, error) {
+	return btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(ob.TSS().PubKeyCompressedBytes()), ob.netParams)
+}
+
+// LoadLastBlockScanned loads the last scanned block from the database
+func (ob *ChainClient) LoadLastBlockScanned(_ context.Context) error {
+	if err := ob.Observer.LoadBlockScanInfo(ob.Logger().Chain); err != nil {
+		return errors.Wrapf(err, "error LoadLastBlockScanned for chain %d", ob.Chain().Id)
+	}
+
+	// observer will scan from the last block when 'lastBlockScanned == 0', this happens when:
+	// 1. environment variable is set explicitly to "latest"
+	// 2. environment variable is empty and last scanned block is not found in DB
+	if ob.LastBlockScanned() == 0 {
+		blockNumber, err := ob.btcClient.GetBlockCount()
+		if err != nil {
+			return errors.Wrapf(err, "error GetBlockCount for chain %d", ob.Chain().Id)
+		}
+
+		// #nosec G701 always positive
+		height := uint64(blockNumber)
+		if ob.ChainParams().StartBlockHeight > 0 && ob.ChainParams().StartBlockHeight < height {
+			height = ob.ChainParams().StartBlockHeight
+		}
+		ob.WithLastBlockScanned(height)
+	}
+
+	return nil
+}
@@ -0,0 +1,167 @@
+package observer_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/pkg/chains"
+	btcobserver "github.com/0xPellNetwork/aegis/relayer/chains/bitcoin/observer"
+	"github.com/0xPellNetwork/aegis/relayer/chains/interfaces"
+	"github.com/0xPellNetwork/aegis/relayer/config"
+	pctx "github.com/0xPellNetwork/aegis/relayer/context"
+	"github.com/0xPellNetwork/aegis/relayer/db"
+	clientlogs "github.com/0xPellNetwork/aegis/relayer/logs"
+	"github.com/0xPellNetwork/aegis/relayer/testutils/stub"
+	"github.com/0xPellNetwork/aegis/testutil/sample"
+	relayertypes "github.com/0xPellNetwork/aegis/x/relayer/types"
+)
+
+// btcRegtestChain returns the bitcoin regtest chain used in unit tests
+func btcRegtestChain(t *testing.T) chains.Chain {
+	chain, found := chains.GetChainByChainId(18444)
+	require.True(t, found)
+	return chain
+}
+
+// getAppContext creates an app context for unit tests
+func getAppContext(t *testing.T, btcChain chains.Chain, btcChainParams *relayertypes.ChainParams) *pctx.AppContext {
+	require.Equal(t, btcChain.Id, btcChainParams.ChainId, "chain id mismatch between chain and params")
+
+	// create config
+	cfg := config.NewConfig()
+	cfg.BitcoinConfig = config.BTCConfig{
+		RPCUsername: "user",
+		RPCPassword: "pass",
+		RPCHost:     "localhost:18443",
+		RPCParams:   "regtest",
+	}
+
+	// create app context
+	appCtx := pctx.NewAppContext(cfg, zerolog.Nop())
+
+	// feed chain params
+	err := appCtx.Update(
+		relayertypes.Keygen{},
+		[]chains.Chain{btcChain},
+		nil,
+		btcChainParams,
+		"",
+		*sample.CrosschainFlags_pell(),
+		sample.VerificationFlags(),
+		true,
+		zerolog.Logger{},
+	)
+	require.NoError(t, err)
+
+	return appCtx
+}
+
+// MockBTCClient creates a mock ChainClient with custom chain, TSS, params etc
+func MockBTCClient(
+	t *testing.T,
+	chain chains.Chain,
+	btcClient interfaces.BTCRPCClient,
+	pellcoreClient interfaces.PellCoreBridger,
+	tss interfaces.TSSSigner,
+	params relayertypes.ChainParams,
+) (*btcobserver.ChainClient, context.Context) {
+	// use default mock btc client if not provided
+	if btcClient == nil {
+		btcClient = stub.NewMockBTCRPCClient().WithBlockCount(100)
+	}
+
+	// use default mock bridge if not provided
+	if pellcoreClient == nil {
+		pellcoreClient = stub.NewMockPellCoreBridge()
+	}
+
+	// use default mock tss if not provided
+	if tss == nil {
+		tss = stub.NewTSSMainnet()
+	}
+
+	// create app context
+	appCtx := getAppContext(t, chain, &params)
+	ctx := pctx.WithAppContext(context.Background(), appCtx)
+
+	database, err := db.NewFromSqliteInMemory(true)
+	require.NoError(t, err)
+
+	testLogger := zerolog.New(zerolog.NewTestWriter(t))
+	logger := clientlogs.Logger{Std: testLogger, Compliance: testLogger}
+
+	// create chain client
+	client, err := btcobserver.NewBitcoinChainClient(
+		ctx,
+		chain,
+		params,
+		btcClient,
+		pellcoreClient,
+		tss,
+		database,
+		logger,
+		nil,
+	)
+	require.NoError(t, err)
+
+	return client, ctx
+}
+
+func Test_NewBitcoinChainClient(t *testing.T) {
+	chain := btcRegtestChain(t)
+	params := *sample.ChainParamsSupported_pell(chain.Id)
+	params.StartBlockHeight = 0
+
+	t.Run("should create bitcoin chain client and start from latest block", func(t *testing.T) {
+		btcClient := stub.NewMockBTCRPCClient().WithBlockCount(100)
+		ob, _ := MockBTCClient(t, chain, btcClient, nil, nil, params)
+		require.Equal(t, chain, ob.Chain())
+		require.Equal(t, params, ob.GetChainParams())
+		require.EqualValues(t, 100, ob.LastBlockScanned())
+	})
+
+	t.Run("should start from start block height if lower than latest block", func(t *testing.T) {
+		params := params
+		params.StartBlockHeight = 50
+
+		btcClient := stub.NewMockBTCRPCClient().WithBlockCount(100)
+		ob, _ := MockBTCClient(t, chain, btcClient, nil, nil, params)
+		require.EqualValues(t, 50, ob.LastBlockScanned())
+	})
+
+	t.Run("should fail for non bitcoin chain", func(t *testing.T) {
+		evmChain := chains.EthChain()
+		evmParams := *sample.ChainParamsSupported_pell(evmChain.Id)
+
+		appCtx := getAppContext(t, chain, &params)
+		ctx := pctx.WithAppContext(context.Background(), appCtx)
+		database, err := db.NewFromSqliteInMemory(true)
+		require.NoError(t, err)
+
+		_, err = btcobserver.NewBitcoinChainClient(
+			ctx,
+			evmChain,
+			evmParams,
+			stub.NewMockBTCRPCClient(),
+			stub.NewMockPellCoreBridge(),
+			stub.NewTSSMainnet(),
+			database,
+			clientlogs.DefaultLogger(),
+			nil,
+		)
+		require.ErrorContains(t, err, "unable to get bitcoin net params")
+	})
+}
+
+func Test_TSSAddress(t *testing.T) {
+	chain := btcRegtestChain(t)
+	params := *sample.ChainParamsSupported_pell(chain.Id)
+
+	ob, _ := MockBTCClient(t, chain, nil, nil, nil, params)
+	tssAddress, err := ob.TSSAddress()
+	require.NoError(t, err)
+	require.Contains(t, tssAddress.EncodeAddress(), "bcrt1")
+}
@@ -14,7 +14,6 @@ import (
 	"github.com/0xPellNetwork/aegis/relayer/chains/evm"
 	"github.com/0xPellNetwork/aegis/relayer/chains/interfaces"
 	"github.com/0xPellNetwork/aegis/relayer/compliance"
-	"github.com/0xPellNetwork/aegis/relayer/config"
 	"github.com/0xPellNetwork/aegis/relayer/pellcore"
 	xmsgtypes "github.com/0xPellNetwork/aegis/x/xmsg/types"
 )
@@ -108,9 +107,12 @@ func (h *WithdrawalQueuedHandler) BuildInboundVoteMsg(
 	sender common.Address,
 ) *xmsgtypes.MsgVoteOnObservedInboundTx {
 	// compliance check
-	if config.ContainRestrictedAddress(sender.Hex()) {
-		compliance.PrintComplianceLog(h.InBoundLogger, h.ComplianceLogger,
-			false, h.ChainId, event.Raw.TxHash.Hex(), sender.Hex(), "", "ERC20")
+	if compliance.ScreenTx(h.InBoundLogger, h.ComplianceLogger, compliance.Tx{
+		ChainID:    h.ChainId,
+		Identifier: event.Raw.TxHash.Hex(),
+		Sender:     sender.Hex(),
+		Token:      "ERC20",
+	}) {
 		return nil
 	}
 	inboundPellTx := xmsgtypes.InboundPellEvent{
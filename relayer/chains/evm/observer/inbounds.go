@@ -55,6 +55,11 @@ func (ob *ChainClient) watchInboundOnce(ctx context.Context, t *ticker.Ticker, s
 		return nil
 	}
 
+	if ob.IsPaused() {
+		sampledLogger.Info().Msg("WatchInbound: observer is paused")
+		return nil
+	}
+
 	if err := ob.observeInTX(ctx, sampledLogger); err != nil {
 		ob.Logger().Inbound.Err(err).Msg("WatchInbound: observeInbound error")
 	}
@@ -88,7 +93,7 @@ func (ob *ChainClient) WatchIntxTracker(ctx context.Context) error {
 	for {
 		select {
 		case <-ticker.C():
-			if !app.PellCoreContext().IsInboundObservationEnabled(ob.GetChainParams()) {
+			if !app.PellCoreContext().IsInboundObservationEnabled(ob.GetChainParams()) || ob.IsPaused() {
 				continue
 			}
 			err := ob.ObserveIntxTrackers(ctx)
@@ -3,6 +3,7 @@ package observer
 import (
 	"context"
 	"fmt"
+	"math/big"
 
 	"github.com/pkg/errors"
 
@@ -61,13 +62,43 @@ func (ob *ChainClient) PostGasPrice(ctx context.Context) error {
 		return errors.Wrap(err, "unable to get block number")
 	}
 
+	// EIP-1559 FEES
+	priorityFee, baseFee, err := ob.determineDynamicFees(ctx, gasPrice)
+	if err != nil {
+		return errors.Wrap(err, "unable to determine dynamic fees")
+	}
+
 	// SUPPLY
 	supply := "100" // lockedAmount on ETH, totalSupply on other chains
 
-	_, err = ob.PellcoreClient().PostGasPrice(ctx, ob.Chain(), gasPrice.Uint64(), supply, blockNum)
+	_, err = ob.PellcoreClient().PostGasPrice(ctx, ob.Chain(), gasPrice.Uint64(), priorityFee.Uint64(), baseFee.Uint64(), supply, blockNum)
 	if err != nil {
 		return errors.Wrap(err, "unable to post vote for gas price")
 	}
 
 	return nil
 }
+
+// determineDynamicFees returns the priority fee and the base fee of the chain.
+// Both are zero if the latest block has no base fee, i.e. the chain does not support EIP-1559.
+func (ob *ChainClient) determineDynamicFees(ctx context.Context, gasPrice *big.Int) (*big.Int, *big.Int, error) {
+	header, err := ob.evmClient.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "unable to get latest block header")
+	}
+	if header.BaseFee == nil {
+		return big.NewInt(0), big.NewInt(0), nil
+	}
+
+	priorityFee, err := ob.evmClient.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "unable to suggest gas tip cap")
+	}
+
+	// the priority fee is part of the gas price
+	if priorityFee.Cmp(gasPrice) > 0 {
+		priorityFee = new(big.Int).Set(gasPrice)
+	}
+
+	return priorityFee, header.BaseFee, nil
+}
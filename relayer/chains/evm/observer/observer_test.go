@@ -61,6 +61,7 @@ func getAppContext(
 		relayertypes.Keygen{},
 		[]chains.Chain{evmChain},
 		evmChainParamsMap,
+		nil,
 		"",
 		*sample.CrosschainFlags_pell(),
 		sample.VerificationFlags(),
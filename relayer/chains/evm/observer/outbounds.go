@@ -52,6 +52,10 @@ func (ob *ChainClient) WatchOutTx(ctx context.Context) error {
 				sampledLogger.Info().Msgf("WatchOutTx: outbound observation is disabled for chain %d", ob.Chain().Id)
 				continue
 			}
+			if ob.IsPaused() {
+				sampledLogger.Info().Msgf("WatchOutTx: observer is paused for chain %d", ob.Chain().Id)
+				continue
+			}
 
 			// process outbound trackers
 			err := ob.ProcessOutboundTrackers(ctx)
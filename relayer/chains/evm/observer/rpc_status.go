@@ -31,8 +31,27 @@ func (ob *ChainClient) WatchRPCStatus(ctx context.Context) error {
 }
 
 // checkRPCStatus checks the RPC status of the EVM chain
+// if the client is a pool of providers, the health of every provider is checked and the pool fails over to a healthy one
 func (ob *ChainClient) checkRPCStatus(ctx context.Context) {
-	blockTime, err := rpc.CheckRPCStatus(ctx, ob.evmClient)
+	var (
+		blockTime time.Time
+		err       error
+	)
+	if pool, ok := ob.evmClient.(*rpc.Pool); ok {
+		blockTime, err = pool.CheckHealth(ctx, rpc.RPCAlertLatency)
+		for _, status := range pool.Statuses() {
+			healthy := 0.0
+			if status.Healthy {
+				healthy = 1
+			}
+			metrics.RPCProviderStatus.WithLabelValues(fmt.Sprint(ob.Chain().Id), metrics.EndpointHost(status.Endpoint)).Set(healthy)
+			if !status.Healthy {
+				ob.Logger().Chain.Warn().Str("error", status.LastError).Msgf("watchRPCStatus rpc provider %s is unhealthy", metrics.EndpointHost(status.Endpoint))
+			}
+		}
+	} else {
+		blockTime, err = rpc.CheckRPCStatus(ctx, ob.evmClient)
+	}
 	if err != nil {
 		metrics.RPCNodeStatus.WithLabelValues(fmt.Sprint(ob.Chain().Id)).Set(0)
 		ob.Logger().Chain.Error().Err(err).Msg("watchRPCStatus checkRPCStatus failed")
@@ -0,0 +1,578 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+	jsonrpc "github.com/onrik/ethrpc"
+	"github.com/pkg/errors"
+
+	"github.com/0xPellNetwork/aegis/relayer/chains/interfaces"
+	"github.com/0xPellNetwork/aegis/relayer/metrics"
+)
+
+// providerCallTimeout is the timeout of the calls made to all providers at once
+// it bounds the health checks and the quorum reads so a hanging provider can't stall them
+const providerCallTimeout = 30 * time.Second
+
+var (
+	_ interfaces.EVMRPCClient     = &Pool{}
+	_ interfaces.EVMJSONRPCClient = &Pool{}
+
+	// ErrQuorumNotReached is returned when not enough providers agree on the result of a critical read
+	ErrQuorumNotReached = errors.New("rpc quorum not reached")
+)
+
+// Provider is an EVM RPC client of the pool identified by its endpoint
+// JSONClient is the JSON RPC client of the same endpoint, it is optional
+type Provider struct {
+	Endpoint   string
+	Client     interfaces.EVMRPCClient
+	JSONClient interfaces.EVMJSONRPCClient
+}
+
+// ProviderStatus is the health status of a provider of the pool
+type ProviderStatus struct {
+	Endpoint  string
+	Healthy   bool
+	Active    bool
+	LastError string
+}
+
+// providerState is a provider of the pool along with its health
+type providerState struct {
+	Provider
+	healthy bool
+	lastErr error
+}
+
+// Pool is an EVM RPC client backed by several providers of the same chain
+//   - calls go to the active provider and fail over to the other providers when it is unreachable
+//   - receipts and block headers can require the agreement of a quorum of providers
+type Pool struct {
+	mu        sync.RWMutex
+	providers []*providerState
+	active    int
+	quorum    int
+}
+
+// NewPool returns a new pool of providers, the first provider is the initial active provider
+// quorum is the number of providers that must agree on receipts and block headers, 0 or 1 disables quorum reads
+func NewPool(providers []Provider, quorum int) (*Pool, error) {
+	if len(providers) == 0 {
+		return nil, errors.New("at least one provider is required")
+	}
+	if quorum < 0 || quorum > len(providers) {
+		return nil, fmt.Errorf("invalid quorum %d for %d providers", quorum, len(providers))
+	}
+
+	states := make([]*providerState, 0, len(providers))
+	for _, provider := range providers {
+		if provider.Client == nil {
+			return nil, fmt.Errorf("nil client for provider %s", metrics.EndpointHost(provider.Endpoint))
+		}
+		states = append(states, &providerState{Provider: provider, healthy: true})
+	}
+
+	return &Pool{
+		providers: states,
+		quorum:    quorum,
+	}, nil
+}
+
+// DialPool dials the given endpoints and returns a pool of the providers, the first endpoint is the initial active provider
+func DialPool(endpoints []string, quorum int) (*Pool, error) {
+	providers := make([]Provider, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		httpClient, err := metrics.GetInstrumentedHTTPClient(endpoint)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to get instrumented HTTP client")
+		}
+
+		rpcClient, err := ethrpc.DialHTTPWithClient(endpoint, httpClient)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to dial EVM client (endpoint %q)", endpoint)
+		}
+		providers = append(providers, Provider{
+			Endpoint:   endpoint,
+			Client:     ethclient.NewClient(rpcClient),
+			JSONClient: jsonrpc.NewEthRPC(endpoint, jsonrpc.WithHttpClient(httpClient)),
+		})
+	}
+
+	return NewPool(providers, quorum)
+}
+
+// Quorum returns the number of providers that must agree on receipts and block headers
+func (p *Pool) Quorum() int {
+	return p.quorum
+}
+
+// ActiveEndpoint returns the endpoint of the active provider
+func (p *Pool) ActiveEndpoint() string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.providers[p.active].Endpoint
+}
+
+// Statuses returns the health status of the providers in config order
+func (p *Pool) Statuses() []ProviderStatus {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	statuses := make([]ProviderStatus, 0, len(p.providers))
+	for i, provider := range p.providers {
+		status := ProviderStatus{
+			Endpoint: provider.Endpoint,
+			Healthy:  provider.healthy,
+			Active:   i == p.active,
+		}
+		if provider.lastErr != nil {
+			status.LastError = provider.lastErr.Error()
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+// CheckHealth checks the RPC status of every provider and selects the first healthy provider in config order
+// a provider is unhealthy if the RPC status check fails or if its latest block is older than alertLatency
+// it returns the latest block time of the active provider, or an error if no provider is healthy
+func (p *Pool) CheckHealth(ctx context.Context, alertLatency time.Duration) (time.Time, error) {
+	type result struct {
+		blockTime time.Time
+		err       error
+	}
+
+	p.mu.RLock()
+	providers := make([]*providerState, len(p.providers))
+	copy(providers, p.providers)
+	p.mu.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, providerCallTimeout)
+	defer cancel()
+
+	results := make([]result, len(providers))
+	var wg sync.WaitGroup
+	for i, provider := range providers {
+		wg.Add(1)
+		go func(i int, client interfaces.EVMRPCClient) {
+			defer wg.Done()
+			blockTime, err := CheckRPCStatus(ctx, client)
+			if err == nil && alertLatency > 0 && time.Since(blockTime) > alertLatency {
+				err = fmt.Errorf("latest block %s is older than %s", blockTime, alertLatency)
+			}
+			results[i] = result{blockTime: blockTime, err: err}
+		}(i, provider.Client)
+	}
+	wg.Wait()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	active := -1
+	for i, provider := range p.providers {
+		provider.healthy = results[i].err == nil
+		provider.lastErr = results[i].err
+		if provider.healthy && active == -1 {
+			active = i
+		}
+	}
+	if active == -1 {
+		return time.Time{}, errors.Wrap(p.providers[p.active].lastErr, "no healthy rpc provider")
+	}
+	p.active = active
+
+	return results[active].blockTime, nil
+}
+
+// candidates returns the providers in the order they should be tried
+// the active provider goes first, followed by the healthy and then the unhealthy providers in config order
+func (p *Pool) candidates() []*providerState {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	candidates := make([]*providerState, 0, len(p.providers))
+	candidates = append(candidates, p.providers[p.active])
+	for _, healthy := range []bool{true, false} {
+		for i, provider := range p.providers {
+			if i != p.active && provider.healthy == healthy {
+				candidates = append(candidates, provider)
+			}
+		}
+	}
+	return candidates
+}
+
+// markHealthy marks the provider as healthy and makes it active if the active provider is unhealthy
+func (p *Pool) markHealthy(provider *providerState) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	provider.healthy = true
+	provider.lastErr = nil
+	if !p.providers[p.active].healthy {
+		for i := range p.providers {
+			if p.providers[i] == provider {
+				p.active = i
+				break
+			}
+		}
+	}
+}
+
+// markUnhealthy marks the provider as unhealthy
+func (p *Pool) markUnhealthy(provider *providerState, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	provider.healthy = false
+	provider.lastErr = err
+}
+
+// isFailoverError returns true if the call should be retried on another provider
+// not found results, JSON-RPC errors returned by the node and cancelled contexts are final
+func isFailoverError(ctx context.Context, err error) bool {
+	if ctx.Err() != nil || errors.Is(err, ethereum.NotFound) {
+		return false
+	}
+	var rpcErr ethrpc.Error
+	return !errors.As(err, &rpcErr)
+}
+
+// call calls fn on the active provider and fails over to the other providers if the provider is unreachable
+func call[T any](ctx context.Context, p *Pool, method string, fn func(interfaces.EVMRPCClient) (T, error)) (T, error) {
+	var (
+		zero T
+		errs []string
+	)
+	for _, provider := range p.candidates() {
+		res, err := fn(provider.Client)
+		if err == nil {
+			p.markHealthy(provider)
+			return res, nil
+		}
+		if !isFailoverError(ctx, err) {
+			return zero, err
+		}
+		p.markUnhealthy(provider, err)
+		errs = append(errs, fmt.Sprintf("%s: %s", metrics.EndpointHost(provider.Endpoint), err.Error()))
+	}
+	return zero, fmt.Errorf("%s failed on all rpc providers: %s", method, strings.Join(errs, "; "))
+}
+
+// jsonCall calls fn on the JSON RPC client of the active provider and fails over to the other providers
+// if the provider is unreachable, JSON-RPC errors returned by the node are final
+func jsonCall[T any](p *Pool, method string, fn func(interfaces.EVMJSONRPCClient) (T, error)) (T, error) {
+	var (
+		zero T
+		errs []string
+	)
+	for _, provider := range p.candidates() {
+		if provider.JSONClient == nil {
+			continue
+		}
+		res, err := fn(provider.JSONClient)
+		if err == nil {
+			p.markHealthy(provider)
+			return res, nil
+		}
+		var ethErr jsonrpc.EthError
+		if errors.As(err, &ethErr) {
+			return zero, err
+		}
+		p.markUnhealthy(provider, err)
+		errs = append(errs, fmt.Sprintf("%s: %s", metrics.EndpointHost(provider.Endpoint), err.Error()))
+	}
+	if len(errs) == 0 {
+		return zero, fmt.Errorf("%s: no json rpc provider", method)
+	}
+	return zero, fmt.Errorf("%s failed on all rpc providers: %s", method, strings.Join(errs, "; "))
+}
+
+// quorumCall calls fn on all providers and returns the result as soon as the quorum of providers agree on it
+// results are compared by the given key, a not found result is a valid answer providers can agree on
+// the calls are bounded by providerCallTimeout and the pending calls are cancelled once the outcome is known
+func quorumCall[T any](
+	ctx context.Context,
+	p *Pool,
+	method string,
+	fn func(context.Context, interfaces.EVMRPCClient) (T, error),
+	key func(T) (ethcommon.Hash, error),
+) (T, error) {
+	var zero T
+	if p.quorum <= 1 {
+		return call(ctx, p, method, func(c interfaces.EVMRPCClient) (T, error) {
+			return fn(ctx, c)
+		})
+	}
+
+	type result struct {
+		provider *providerState
+		res      T
+		err      error
+	}
+
+	callCtx, cancel := context.WithTimeout(ctx, providerCallTimeout)
+	defer cancel()
+
+	// buffered so the pending calls don't block once the outcome is known
+	candidates := p.candidates()
+	results := make(chan result, len(candidates))
+	for _, provider := range candidates {
+		go func(provider *providerState) {
+			res, err := fn(callCtx, provider.Client)
+			results <- result{provider: provider, res: res, err: err}
+		}(provider)
+	}
+
+	var (
+		notFound int
+		maxVotes int
+		votes    = make(map[ethcommon.Hash]int)
+		errs     []string
+	)
+	for pending := len(candidates); pending > 0; pending-- {
+		r := <-results
+		switch {
+		case r.err == nil:
+			p.markHealthy(r.provider)
+			k, err := key(r.res)
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("%s: %s", metrics.EndpointHost(r.provider.Endpoint), err.Error()))
+				break
+			}
+			votes[k]++
+			if votes[k] == p.quorum {
+				return r.res, nil
+			}
+			if votes[k] > maxVotes {
+				maxVotes = votes[k]
+			}
+		case errors.Is(r.err, ethereum.NotFound):
+			p.markHealthy(r.provider)
+			notFound++
+			if notFound == p.quorum {
+				return zero, r.err
+			}
+		default:
+			if isFailoverError(ctx, r.err) {
+				p.markUnhealthy(r.provider, r.err)
+			}
+			errs = append(errs, fmt.Sprintf("%s: %s", metrics.EndpointHost(r.provider.Endpoint), r.err.Error()))
+		}
+
+		// stop waiting if the remaining providers can't reach the quorum
+		if remaining := pending - 1; maxVotes+remaining < p.quorum && notFound+remaining < p.quorum {
+			break
+		}
+	}
+
+	return zero, errors.Wrapf(
+		ErrQuorumNotReached,
+		"%s: %d of %d providers required, errors: [%s]",
+		method,
+		p.quorum,
+		len(candidates),
+		strings.Join(errs, "; "),
+	)
+}
+
+// receiptKey returns the key used to compare receipts returned by different providers
+func receiptKey(receipt *ethtypes.Receipt) (ethcommon.Hash, error) {
+	if receipt == nil {
+		return ethcommon.Hash{}, errors.New("nil receipt")
+	}
+	encoded, err := receipt.MarshalBinary()
+	if err != nil {
+		return ethcommon.Hash{}, errors.Wrap(err, "unable to encode receipt")
+	}
+	return crypto.Keccak256Hash(receipt.TxHash.Bytes(), receipt.BlockHash.Bytes(), encoded), nil
+}
+
+// headerKey returns the key used to compare block headers returned by different providers
+func headerKey(header *ethtypes.Header) (ethcommon.Hash, error) {
+	if header == nil {
+		return ethcommon.Hash{}, errors.New("nil header")
+	}
+	return header.Hash(), nil
+}
+
+// TransactionReceipt returns the receipt of the tx, it requires the quorum of providers to agree on the receipt
+func (p *Pool) TransactionReceipt(ctx context.Context, txHash ethcommon.Hash) (*ethtypes.Receipt, error) {
+	return quorumCall(ctx, p, "TransactionReceipt", func(ctx context.Context, c interfaces.EVMRPCClient) (*ethtypes.Receipt, error) {
+		return c.TransactionReceipt(ctx, txHash)
+	}, receiptKey)
+}
+
+// HeaderByNumber returns the block header at the given height
+// it requires the quorum of providers to agree on the header unless the latest header is requested
+func (p *Pool) HeaderByNumber(ctx context.Context, number *big.Int) (*ethtypes.Header, error) {
+	// providers are not expected to agree on the latest header
+	if number == nil {
+		return call(ctx, p, "HeaderByNumber", func(c interfaces.EVMRPCClient) (*ethtypes.Header, error) {
+			return c.HeaderByNumber(ctx, number)
+		})
+	}
+	return quorumCall(ctx, p, "HeaderByNumber", func(ctx context.Context, c interfaces.EVMRPCClient) (*ethtypes.Header, error) {
+		return c.HeaderByNumber(ctx, number)
+	}, headerKey)
+}
+
+// ChainID returns the chain id reported by the providers
+func (p *Pool) ChainID(ctx context.Context) (*big.Int, error) {
+	return call(ctx, p, "ChainID", func(c interfaces.EVMRPCClient) (*big.Int, error) {
+		getter, ok := c.(interface {
+			ChainID(ctx context.Context) (*big.Int, error)
+		})
+		if !ok {
+			return nil, errors.New("client does not support ChainID")
+		}
+		return getter.ChainID(ctx)
+	})
+}
+
+// CodeAt returns the code of the given account
+func (p *Pool) CodeAt(ctx context.Context, contract ethcommon.Address, blockNumber *big.Int) ([]byte, error) {
+	return call(ctx, p, "CodeAt", func(c interfaces.EVMRPCClient) ([]byte, error) {
+		return c.CodeAt(ctx, contract, blockNumber)
+	})
+}
+
+// CallContract executes a contract call
+func (p *Pool) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	return call(ctx, p, "CallContract", func(c interfaces.EVMRPCClient) ([]byte, error) {
+		return c.CallContract(ctx, msg, blockNumber)
+	})
+}
+
+// PendingCodeAt returns the code of the given account in the pending state
+func (p *Pool) PendingCodeAt(ctx context.Context, account ethcommon.Address) ([]byte, error) {
+	return call(ctx, p, "PendingCodeAt", func(c interfaces.EVMRPCClient) ([]byte, error) {
+		return c.PendingCodeAt(ctx, account)
+	})
+}
+
+// PendingNonceAt returns the pending nonce of the given account
+func (p *Pool) PendingNonceAt(ctx context.Context, account ethcommon.Address) (uint64, error) {
+	return call(ctx, p, "PendingNonceAt", func(c interfaces.EVMRPCClient) (uint64, error) {
+		return c.PendingNonceAt(ctx, account)
+	})
+}
+
+// SuggestGasPrice returns the suggested gas price
+func (p *Pool) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return call(ctx, p, "SuggestGasPrice", func(c interfaces.EVMRPCClient) (*big.Int, error) {
+		return c.SuggestGasPrice(ctx)
+	})
+}
+
+// SuggestGasTipCap returns the suggested priority fee
+func (p *Pool) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	return call(ctx, p, "SuggestGasTipCap", func(c interfaces.EVMRPCClient) (*big.Int, error) {
+		return c.SuggestGasTipCap(ctx)
+	})
+}
+
+// EstimateGas estimates the gas needed to execute the call
+func (p *Pool) EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error) {
+	return call(ctx, p, "EstimateGas", func(c interfaces.EVMRPCClient) (uint64, error) {
+		return c.EstimateGas(ctx, msg)
+	})
+}
+
+// SendTransaction broadcasts the signed tx, resending the same signed tx to another provider is safe
+func (p *Pool) SendTransaction(ctx context.Context, tx *ethtypes.Transaction) error {
+	_, err := call(ctx, p, "SendTransaction", func(c interfaces.EVMRPCClient) (struct{}, error) {
+		return struct{}{}, c.SendTransaction(ctx, tx)
+	})
+	return err
+}
+
+// FilterLogs returns the logs matching the filter query
+func (p *Pool) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]ethtypes.Log, error) {
+	return call(ctx, p, "FilterLogs", func(c interfaces.EVMRPCClient) ([]ethtypes.Log, error) {
+		return c.FilterLogs(ctx, query)
+	})
+}
+
+// SubscribeFilterLogs subscribes to the logs matching the filter query
+func (p *Pool) SubscribeFilterLogs(
+	ctx context.Context,
+	query ethereum.FilterQuery,
+	ch chan<- ethtypes.Log,
+) (ethereum.Subscription, error) {
+	return call(ctx, p, "SubscribeFilterLogs", func(c interfaces.EVMRPCClient) (ethereum.Subscription, error) {
+		return c.SubscribeFilterLogs(ctx, query, ch)
+	})
+}
+
+// BlockNumber returns the latest block number
+func (p *Pool) BlockNumber(ctx context.Context) (uint64, error) {
+	return call(ctx, p, "BlockNumber", func(c interfaces.EVMRPCClient) (uint64, error) {
+		return c.BlockNumber(ctx)
+	})
+}
+
+// BlockByNumber returns the block at the given height
+func (p *Pool) BlockByNumber(ctx context.Context, number *big.Int) (*ethtypes.Block, error) {
+	return call(ctx, p, "BlockByNumber", func(c interfaces.EVMRPCClient) (*ethtypes.Block, error) {
+		return c.BlockByNumber(ctx, number)
+	})
+}
+
+// TransactionByHash returns the tx with the given hash
+func (p *Pool) TransactionByHash(ctx context.Context, hash ethcommon.Hash) (*ethtypes.Transaction, bool, error) {
+	type txResult struct {
+		tx        *ethtypes.Transaction
+		isPending bool
+	}
+	res, err := call(ctx, p, "TransactionByHash", func(c interfaces.EVMRPCClient) (txResult, error) {
+		tx, isPending, err := c.TransactionByHash(ctx, hash)
+		return txResult{tx: tx, isPending: isPending}, err
+	})
+	return res.tx, res.isPending, err
+}
+
+// TransactionSender returns the sender of the tx included in the given block
+func (p *Pool) TransactionSender(
+	ctx context.Context,
+	tx *ethtypes.Transaction,
+	block ethcommon.Hash,
+	index uint,
+) (ethcommon.Address, error) {
+	return call(ctx, p, "TransactionSender", func(c interfaces.EVMRPCClient) (ethcommon.Address, error) {
+		return c.TransactionSender(ctx, tx, block, index)
+	})
+}
+
+// BalanceAt returns the balance of the given account
+func (p *Pool) BalanceAt(ctx context.Context, account ethcommon.Address, blockNumber *big.Int) (*big.Int, error) {
+	return call(ctx, p, "BalanceAt", func(c interfaces.EVMRPCClient) (*big.Int, error) {
+		return c.BalanceAt(ctx, account, blockNumber)
+	})
+}
+
+// EthGetBlockByNumber returns the block of the given number through the JSON RPC clients
+func (p *Pool) EthGetBlockByNumber(number int, withTransactions bool) (*jsonrpc.Block, error) {
+	return jsonCall(p, "EthGetBlockByNumber", func(c interfaces.EVMJSONRPCClient) (*jsonrpc.Block, error) {
+		return c.EthGetBlockByNumber(number, withTransactions)
+	})
+}
+
+// EthGetTransactionByHash returns the tx with the given hash through the JSON RPC clients
+func (p *Pool) EthGetTransactionByHash(hash string) (*jsonrpc.Transaction, error) {
+	return jsonCall(p, "EthGetTransactionByHash", func(c interfaces.EVMJSONRPCClient) (*jsonrpc.Transaction, error) {
+		return c.EthGetTransactionByHash(hash)
+	})
+}
@@ -0,0 +1,335 @@
+package rpc_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/onrik/ethrpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/relayer/chains/evm/rpc"
+	"github.com/0xPellNetwork/aegis/relayer/testutils/stub"
+)
+
+// fakeClient is an EVM RPC client returning fixed results
+type fakeClient struct {
+	*stub.MockEvmClient
+	err         error
+	blockNumber uint64
+	blockTime   time.Time
+	receipt     *ethtypes.Receipt
+	calls       int
+
+	// hang blocks the calls until the context is done
+	hang bool
+}
+
+func newFakeClient(blockNumber uint64) *fakeClient {
+	return &fakeClient{
+		MockEvmClient: stub.NewMockEvmClient(),
+		blockNumber:   blockNumber,
+		blockTime:     time.Now(),
+	}
+}
+
+func (c *fakeClient) BlockNumber(_ context.Context) (uint64, error) {
+	c.calls++
+	return c.blockNumber, c.err
+}
+
+func (c *fakeClient) SuggestGasPrice(_ context.Context) (*big.Int, error) {
+	return big.NewInt(1), c.err
+}
+
+func (c *fakeClient) HeaderByNumber(_ context.Context, number *big.Int) (*ethtypes.Header, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	header := &ethtypes.Header{
+		Number: new(big.Int).SetUint64(c.blockNumber),
+		// #nosec G115 test only
+		Time: uint64(c.blockTime.Unix()),
+	}
+	if number != nil {
+		header.Number = number
+	}
+	return header, nil
+}
+
+func (c *fakeClient) TransactionReceipt(ctx context.Context, _ ethcommon.Hash) (*ethtypes.Receipt, error) {
+	if c.hang {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	if c.err != nil {
+		return nil, c.err
+	}
+	if c.receipt == nil {
+		return nil, ethereum.NotFound
+	}
+	return c.receipt, nil
+}
+
+// fakeJSONClient is an EVM JSON RPC client returning a fixed transaction
+type fakeJSONClient struct {
+	*stub.MockJSONRPCClient
+	err   error
+	tx    *ethrpc.Transaction
+	calls int
+}
+
+func (c *fakeJSONClient) EthGetTransactionByHash(_ string) (*ethrpc.Transaction, error) {
+	c.calls++
+	return c.tx, c.err
+}
+
+func newPool(t *testing.T, quorum int, clients ...*fakeClient) *rpc.Pool {
+	providers := make([]rpc.Provider, 0, len(clients))
+	for i, client := range clients {
+		providers = append(providers, rpc.Provider{
+			Endpoint: "http://provider" + string(rune('0'+i)),
+			Client:   client,
+		})
+	}
+	pool, err := rpc.NewPool(providers, quorum)
+	require.NoError(t, err)
+	return pool
+}
+
+func TestNewPool(t *testing.T) {
+	t.Run("should fail without provider", func(t *testing.T) {
+		_, err := rpc.NewPool(nil, 0)
+		require.Error(t, err)
+	})
+
+	t.Run("should fail if quorum exceeds the number of providers", func(t *testing.T) {
+		_, err := rpc.NewPool([]rpc.Provider{{Endpoint: "http://a", Client: newFakeClient(1)}}, 2)
+		require.Error(t, err)
+	})
+
+	t.Run("should fail with nil client", func(t *testing.T) {
+		_, err := rpc.NewPool([]rpc.Provider{{Endpoint: "http://a"}}, 0)
+		require.Error(t, err)
+	})
+}
+
+func TestPool_Failover(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should use the first provider if healthy", func(t *testing.T) {
+		primary, fallback := newFakeClient(100), newFakeClient(200)
+		pool := newPool(t, 0, primary, fallback)
+
+		bn, err := pool.BlockNumber(ctx)
+		require.NoError(t, err)
+		require.EqualValues(t, 100, bn)
+		require.Equal(t, 0, fallback.calls)
+	})
+
+	t.Run("should fail over to the next provider if unreachable", func(t *testing.T) {
+		primary, fallback := newFakeClient(100), newFakeClient(200)
+		primary.err = errors.New("connection refused")
+		pool := newPool(t, 0, primary, fallback)
+
+		bn, err := pool.BlockNumber(ctx)
+		require.NoError(t, err)
+		require.EqualValues(t, 200, bn)
+		require.Equal(t, "http://provider1", pool.ActiveEndpoint())
+
+		// the fallback provider stays active
+		_, err = pool.BlockNumber(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, primary.calls)
+		require.Equal(t, 2, fallback.calls)
+
+		statuses := pool.Statuses()
+		require.False(t, statuses[0].Healthy)
+		require.Equal(t, "connection refused", statuses[0].LastError)
+		require.True(t, statuses[1].Healthy)
+		require.True(t, statuses[1].Active)
+	})
+
+	t.Run("should not fail over on not found", func(t *testing.T) {
+		primary, fallback := newFakeClient(100), newFakeClient(200)
+		fallback.receipt = &ethtypes.Receipt{}
+		pool := newPool(t, 0, primary, fallback)
+
+		_, err := pool.TransactionReceipt(ctx, ethcommon.Hash{})
+		require.ErrorIs(t, err, ethereum.NotFound)
+		require.Equal(t, "http://provider0", pool.ActiveEndpoint())
+	})
+
+	t.Run("should fail if all providers are unreachable", func(t *testing.T) {
+		primary, fallback := newFakeClient(100), newFakeClient(200)
+		primary.err = errors.New("connection refused")
+		fallback.err = errors.New("timeout")
+		pool := newPool(t, 0, primary, fallback)
+
+		_, err := pool.BlockNumber(ctx)
+		require.ErrorContains(t, err, "connection refused")
+		require.ErrorContains(t, err, "timeout")
+	})
+}
+
+func TestPool_CheckHealth(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should select the first healthy provider", func(t *testing.T) {
+		primary, fallback := newFakeClient(100), newFakeClient(200)
+		primary.err = errors.New("connection refused")
+		pool := newPool(t, 0, primary, fallback)
+
+		_, err := pool.CheckHealth(ctx, rpc.RPCAlertLatency)
+		require.NoError(t, err)
+		require.Equal(t, "http://provider1", pool.ActiveEndpoint())
+
+		// fail back to the primary provider once recovered
+		primary.err = nil
+		_, err = pool.CheckHealth(ctx, rpc.RPCAlertLatency)
+		require.NoError(t, err)
+		require.Equal(t, "http://provider0", pool.ActiveEndpoint())
+	})
+
+	t.Run("should mark lagging provider as unhealthy", func(t *testing.T) {
+		primary, fallback := newFakeClient(100), newFakeClient(200)
+		primary.blockTime = time.Now().Add(-2 * rpc.RPCAlertLatency)
+		pool := newPool(t, 0, primary, fallback)
+
+		_, err := pool.CheckHealth(ctx, rpc.RPCAlertLatency)
+		require.NoError(t, err)
+		require.Equal(t, "http://provider1", pool.ActiveEndpoint())
+		require.False(t, pool.Statuses()[0].Healthy)
+	})
+
+	t.Run("should fail if no provider is healthy", func(t *testing.T) {
+		primary := newFakeClient(100)
+		primary.err = errors.New("connection refused")
+		pool := newPool(t, 0, primary)
+
+		_, err := pool.CheckHealth(ctx, rpc.RPCAlertLatency)
+		require.ErrorContains(t, err, "no healthy rpc provider")
+	})
+}
+
+func TestPool_Quorum(t *testing.T) {
+	ctx := context.Background()
+	receipt := &ethtypes.Receipt{
+		Status:      ethtypes.ReceiptStatusSuccessful,
+		TxHash:      ethcommon.HexToHash("0x01"),
+		BlockHash:   ethcommon.HexToHash("0x02"),
+		BlockNumber: big.NewInt(100),
+	}
+	forged := &ethtypes.Receipt{
+		Status:      ethtypes.ReceiptStatusSuccessful,
+		TxHash:      ethcommon.HexToHash("0x01"),
+		BlockHash:   ethcommon.HexToHash("0x03"),
+		BlockNumber: big.NewInt(100),
+	}
+
+	t.Run("should return receipt agreed by the quorum", func(t *testing.T) {
+		a, b, c := newFakeClient(100), newFakeClient(100), newFakeClient(100)
+		a.receipt, b.receipt, c.receipt = forged, receipt, receipt
+		pool := newPool(t, 2, a, b, c)
+
+		res, err := pool.TransactionReceipt(ctx, receipt.TxHash)
+		require.NoError(t, err)
+		require.Equal(t, receipt.BlockHash, res.BlockHash)
+	})
+
+	t.Run("should not wait for the other providers once the quorum agrees", func(t *testing.T) {
+		a, b, c := newFakeClient(100), newFakeClient(100), newFakeClient(100)
+		a.hang = true
+		b.receipt, c.receipt = receipt, receipt
+		pool := newPool(t, 2, a, b, c)
+
+		ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+
+		res, err := pool.TransactionReceipt(ctx, receipt.TxHash)
+		require.NoError(t, err)
+		require.Equal(t, receipt.BlockHash, res.BlockHash)
+		require.NoError(t, ctx.Err())
+	})
+
+	t.Run("should fail if providers disagree", func(t *testing.T) {
+		a, b, c := newFakeClient(100), newFakeClient(100), newFakeClient(100)
+		a.receipt, b.receipt = forged, receipt
+		c.err = errors.New("connection refused")
+		pool := newPool(t, 2, a, b, c)
+
+		_, err := pool.TransactionReceipt(ctx, receipt.TxHash)
+		require.ErrorIs(t, err, rpc.ErrQuorumNotReached)
+	})
+
+	t.Run("should return not found if the quorum does not find the receipt", func(t *testing.T) {
+		a, b := newFakeClient(100), newFakeClient(100)
+		pool := newPool(t, 2, a, b)
+
+		_, err := pool.TransactionReceipt(ctx, receipt.TxHash)
+		require.ErrorIs(t, err, ethereum.NotFound)
+	})
+
+	t.Run("should require the quorum to agree on block headers", func(t *testing.T) {
+		a, b := newFakeClient(100), newFakeClient(100)
+		b.blockTime = a.blockTime.Add(time.Hour)
+		pool := newPool(t, 2, a, b)
+
+		_, err := pool.HeaderByNumber(ctx, big.NewInt(100))
+		require.ErrorIs(t, err, rpc.ErrQuorumNotReached)
+
+		b.blockTime = a.blockTime
+		header, err := pool.HeaderByNumber(ctx, big.NewInt(100))
+		require.NoError(t, err)
+		require.EqualValues(t, 100, header.Number.Uint64())
+	})
+}
+
+func TestPool_JSONRPCFailover(t *testing.T) {
+	newJSONPool := func(t *testing.T, clients ...*fakeJSONClient) *rpc.Pool {
+		providers := make([]rpc.Provider, 0, len(clients))
+		for i, client := range clients {
+			providers = append(providers, rpc.Provider{
+				Endpoint:   "http://provider" + string(rune('0'+i)),
+				Client:     newFakeClient(100),
+				JSONClient: client,
+			})
+		}
+		pool, err := rpc.NewPool(providers, 0)
+		require.NoError(t, err)
+		return pool
+	}
+
+	t.Run("should fail over to the next provider if unreachable", func(t *testing.T) {
+		primary := &fakeJSONClient{err: errors.New("connection refused")}
+		fallback := &fakeJSONClient{tx: &ethrpc.Transaction{Hash: "0x1"}}
+		pool := newJSONPool(t, primary, fallback)
+
+		tx, err := pool.EthGetTransactionByHash("0x1")
+		require.NoError(t, err)
+		require.Equal(t, "0x1", tx.Hash)
+		require.Equal(t, "http://provider1", pool.ActiveEndpoint())
+	})
+
+	t.Run("should not fail over on node error", func(t *testing.T) {
+		primary := &fakeJSONClient{err: ethrpc.EthError{Code: -32000, Message: "invalid argument"}}
+		fallback := &fakeJSONClient{tx: &ethrpc.Transaction{Hash: "0x1"}}
+		pool := newJSONPool(t, primary, fallback)
+
+		_, err := pool.EthGetTransactionByHash("0x1")
+		require.ErrorContains(t, err, "invalid argument")
+		require.Equal(t, 0, fallback.calls)
+	})
+
+	t.Run("should fail without json rpc client", func(t *testing.T) {
+		pool := newPool(t, 0, newFakeClient(100))
+
+		_, err := pool.EthGetTransactionByHash("0x1")
+		require.ErrorContains(t, err, "no json rpc provider")
+	})
+}
@@ -110,17 +110,22 @@ func gasFromXmsg(logger zerolog.Logger, xmsg *types.Xmsg) (Gas, error) {
 		return Gas{}, errors.New(fmt.Sprintf("unable to parse gasPrice: %s", params.OutboundTxGasPrice))
 	}
 
-	// TODO: fix me
+	// the priority fee is empty for chains without EIP-1559
 	priorityFee := big.NewInt(0)
-	// priorityFee, err := bigIntFromString(params.GasPriorityFee)
-	// switch {
-	// case err != nil:
-	// 	return Gas{}, errors.Wrap(err, "unable to parse priorityFee")
-	// case gasPrice.Cmp(priorityFee) == -1:
-	// 	return Gas{}, fmt.Errorf("gasPrice (%d) is less than priorityFee (%d)", gasPrice.Int64(), priorityFee.Int64())
-	// }
-
-	logger.Info().Uint64("xmsg.gas_limit", limit).Uint64("xmsg.gas_price", gasPrice.Uint64())
+	if params.OutboundTxGasPriorityFee != "" {
+		priorityFee, valid = new(big.Int).SetString(params.OutboundTxGasPriorityFee, 10)
+		switch {
+		case !valid || priorityFee.Sign() == -1:
+			return Gas{}, fmt.Errorf("unable to parse priorityFee: %s", params.OutboundTxGasPriorityFee)
+		case gasPrice.Cmp(priorityFee) == -1:
+			return Gas{}, fmt.Errorf("gasPrice (%d) is less than priorityFee (%d)", gasPrice.Int64(), priorityFee.Int64())
+		}
+	}
+
+	logger.Info().
+		Uint64("xmsg.gas_limit", limit).
+		Uint64("xmsg.gas_price", gasPrice.Uint64()).
+		Uint64("xmsg.gas_priority_fee", priorityFee.Uint64())
 
 	return Gas{
 		Limit:       limit,
@@ -2,11 +2,13 @@ package signer
 
 import (
 	"github.com/0xPellNetwork/contracts/pkg/contracts/pell_evm/connector/pellconnector.sol"
+	"github.com/0xPellNetwork/contracts/pkg/contracts/service_evm/tokens/pell.sol"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 )
 
 var (
 	connectorABI *abi.ABI
+	pellTokenABI *abi.ABI
 )
 
 func init() {
@@ -15,4 +17,8 @@ func init() {
 	if err != nil {
 		panic(err)
 	}
+	pellTokenABI, err = pell.PELLMetaData.GetAbi()
+	if err != nil {
+		panic(err)
+	}
 }
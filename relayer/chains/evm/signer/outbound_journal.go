@@ -0,0 +1,170 @@
+package signer
+
+import (
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+
+	"github.com/0xPellNetwork/aegis/relayer/db"
+	clienttypes "github.com/0xPellNetwork/aegis/relayer/types"
+	xmsgtypes "github.com/0xPellNetwork/aegis/x/xmsg/types"
+)
+
+// outboundJournal persists the outbounds signed by the signer by nonce.
+// After a restart, the pending outbounds of the journal are rebroadcasted instead of being signed again.
+// A nil journal records nothing.
+type outboundJournal struct {
+	db      *db.DB
+	chainID int64
+}
+
+func newOutboundJournal(database *db.DB, chainID int64) *outboundJournal {
+	if database == nil {
+		return nil
+	}
+	return &outboundJournal{
+		db:      database,
+		chainID: chainID,
+	}
+}
+
+// get returns the journal entry of the nonce
+func (j *outboundJournal) get(nonce uint64) (*clienttypes.OutboundJournalSQLType, bool, error) {
+	if j == nil {
+		return nil, false, nil
+	}
+
+	var entry clienttypes.OutboundJournalSQLType
+	err := j.db.Client().Where("chain_id = ? AND nonce = ?", j.chainID, nonce).First(&entry).Error
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, false, nil
+	case err != nil:
+		return nil, false, errors.Wrapf(err, "unable to get journal entry of nonce %d", nonce)
+	}
+	return &entry, true, nil
+}
+
+// record stores the signed outbound of the inflight entry before it is broadcasted.
+// the broadcast attempts are kept if the same transaction is recorded again.
+func (j *outboundJournal) record(inflight inflightTx) error {
+	if j == nil {
+		return nil
+	}
+
+	entry, err := clienttypes.ToOutboundJournalSQLType(
+		j.chainID,
+		inflight.tx,
+		inflight.xmsgIndex,
+		inflight.height,
+		inflight.replacements,
+	)
+	if err != nil {
+		return errors.Wrapf(err, "unable to encode outbound %s", inflight.tx.Hash().Hex())
+	}
+
+	prev, found, err := j.get(inflight.tx.Nonce())
+	if err != nil {
+		return err
+	}
+	if found {
+		entry.Model = prev.Model
+		if prev.TxHash == entry.TxHash {
+			entry.Status = prev.Status
+			entry.BroadcastAttempts = prev.BroadcastAttempts
+			entry.LastError = prev.LastError
+		}
+	}
+
+	return j.db.Client().Save(entry).Error
+}
+
+// recordBroadcast records a broadcast attempt of a signed outbound
+func (j *outboundJournal) recordBroadcast(tx *ethtypes.Transaction, broadcastErr error) error {
+	entry, found, err := j.get(tx.Nonce())
+	if err != nil || !found || entry.TxHash != tx.Hash().Hex() {
+		return err
+	}
+
+	entry.BroadcastAttempts++
+	if broadcastErr != nil {
+		entry.Status = clienttypes.OutboundJournalStatusFailed
+		entry.LastError = broadcastErr.Error()
+	} else {
+		entry.Status = clienttypes.OutboundJournalStatusBroadcasted
+		entry.LastError = ""
+	}
+
+	return j.db.Client().Save(entry).Error
+}
+
+// markProcessed records that the outbound of the nonce has been included on the external chain
+func (j *outboundJournal) markProcessed(nonce uint64) error {
+	if j == nil {
+		return nil
+	}
+
+	return j.db.Client().
+		Model(&clienttypes.OutboundJournalSQLType{}).
+		Where("chain_id = ? AND nonce = ?", j.chainID, nonce).
+		Update("status", clienttypes.OutboundJournalStatusProcessed).Error
+}
+
+// pending returns the inflight entry of the pending outbound of the nonce signed for the xmsg
+func (j *outboundJournal) pending(nonce uint64, xmsgIndex string) (inflightTx, bool, error) {
+	entry, found, err := j.get(nonce)
+	if err != nil || !found || !entry.IsPending() || entry.XmsgIndex != xmsgIndex {
+		return inflightTx{}, false, err
+	}
+
+	tx, err := entry.Tx()
+	if err != nil {
+		return inflightTx{}, false, errors.Wrapf(err, "unable to decode journal entry of nonce %d", nonce)
+	}
+
+	return inflightTx{
+		tx:           tx,
+		xmsgIndex:    entry.XmsgIndex,
+		height:       entry.Height,
+		replacements: entry.Replacements,
+	}, true, nil
+}
+
+// setJournal attaches the journal stored in the database of the chain to the signer
+func (signer *Signer) setJournal(database *db.DB) {
+	signer.Lock()
+	defer signer.Unlock()
+	if signer.journal == nil {
+		signer.journal = newOutboundJournal(database, signer.Chain().Id)
+	}
+}
+
+// getJournal returns the journal of the signer, nil if no journal is attached
+func (signer *Signer) getJournal() *outboundJournal {
+	signer.Lock()
+	defer signer.Unlock()
+	return signer.journal
+}
+
+// restoreInflightTx restores the pending outbound of the xmsg from the journal if the signer does not track it,
+// so that an outbound signed before a restart is rebroadcasted instead of being signed again
+func (signer *Signer) restoreInflightTx(xmsg *xmsgtypes.Xmsg, logger zerolog.Logger) {
+	nonce := xmsg.GetCurrentOutTxParam().OutboundTxTssNonce
+	if _, found := signer.inflight.get(nonce); found {
+		return
+	}
+
+	inflight, found, err := signer.getJournal().pending(nonce, xmsg.Index)
+	if err != nil {
+		logger.Warn().Err(err).Msgf("unable to restore outbound nonce %d from journal", nonce)
+		return
+	}
+	if !found {
+		return
+	}
+
+	signer.inflight.set(inflight)
+	logger.Info().Msgf("restored outbound %s nonce %d keysign height %d from journal",
+		inflight.tx.Hash().Hex(), nonce, inflight.height)
+}
@@ -0,0 +1,157 @@
+package signer
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/relayer/db"
+	clienttypes "github.com/0xPellNetwork/aegis/relayer/types"
+	"github.com/0xPellNetwork/aegis/testutil/sample"
+	xmsgtypes "github.com/0xPellNetwork/aegis/x/xmsg/types"
+)
+
+func newTestJournal(t *testing.T) *outboundJournal {
+	database, err := db.NewFromSqliteInMemory(true)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, database.Close()) })
+	return newOutboundJournal(database, 56)
+}
+
+func TestOutboundJournal(t *testing.T) {
+	t.Run("nil journal should record nothing", func(t *testing.T) {
+		journal := newOutboundJournal(nil, 56)
+		require.Nil(t, journal)
+
+		tx := legacyTx(1, 100)
+		require.NoError(t, journal.record(inflightTx{tx: tx, xmsgIndex: "xmsg", height: 1000}))
+		require.NoError(t, journal.recordBroadcast(tx, nil))
+		require.NoError(t, journal.markProcessed(1))
+
+		_, found, err := journal.pending(1, "xmsg")
+		require.NoError(t, err)
+		require.False(t, found)
+	})
+
+	t.Run("should restore pending outbound", func(t *testing.T) {
+		journal := newTestJournal(t)
+		tx := legacyTx(1, 100)
+		require.NoError(t, journal.record(inflightTx{tx: tx, xmsgIndex: "xmsg", height: 1000, replacements: 2}))
+
+		inflight, found, err := journal.pending(1, "xmsg")
+		require.NoError(t, err)
+		require.True(t, found)
+		require.Equal(t, tx.Hash(), inflight.tx.Hash())
+		require.Equal(t, "xmsg", inflight.xmsgIndex)
+		require.EqualValues(t, 1000, inflight.height)
+		require.EqualValues(t, 2, inflight.replacements)
+
+		// another xmsg or nonce is not restored
+		_, found, err = journal.pending(1, "other")
+		require.NoError(t, err)
+		require.False(t, found)
+		_, found, err = journal.pending(2, "xmsg")
+		require.NoError(t, err)
+		require.False(t, found)
+	})
+
+	t.Run("should not restore processed outbound", func(t *testing.T) {
+		journal := newTestJournal(t)
+		require.NoError(t, journal.record(inflightTx{tx: legacyTx(1, 100), xmsgIndex: "xmsg", height: 1000}))
+		require.NoError(t, journal.markProcessed(1))
+
+		entry, found, err := journal.get(1)
+		require.NoError(t, err)
+		require.True(t, found)
+		require.Equal(t, clienttypes.OutboundJournalStatusProcessed, entry.Status)
+
+		_, found, err = journal.pending(1, "xmsg")
+		require.NoError(t, err)
+		require.False(t, found)
+	})
+
+	t.Run("should record broadcast attempts", func(t *testing.T) {
+		journal := newTestJournal(t)
+		tx := legacyTx(1, 100)
+		require.NoError(t, journal.record(inflightTx{tx: tx, xmsgIndex: "xmsg", height: 1000}))
+
+		require.NoError(t, journal.recordBroadcast(tx, errors.New("connection refused")))
+		entry, _, err := journal.get(1)
+		require.NoError(t, err)
+		require.Equal(t, clienttypes.OutboundJournalStatusFailed, entry.Status)
+		require.EqualValues(t, 1, entry.BroadcastAttempts)
+		require.Equal(t, "connection refused", entry.LastError)
+
+		require.NoError(t, journal.recordBroadcast(tx, nil))
+		entry, _, err = journal.get(1)
+		require.NoError(t, err)
+		require.Equal(t, clienttypes.OutboundJournalStatusBroadcasted, entry.Status)
+		require.EqualValues(t, 2, entry.BroadcastAttempts)
+		require.Empty(t, entry.LastError)
+
+		// recording the same transaction again keeps the attempts
+		require.NoError(t, journal.record(inflightTx{tx: tx, xmsgIndex: "xmsg", height: 1000}))
+		entry, _, err = journal.get(1)
+		require.NoError(t, err)
+		require.EqualValues(t, 2, entry.BroadcastAttempts)
+
+		// broadcast of a transaction that is not journaled is ignored
+		require.NoError(t, journal.recordBroadcast(legacyTx(1, 120), nil))
+		entry, _, err = journal.get(1)
+		require.NoError(t, err)
+		require.Equal(t, tx.Hash().Hex(), entry.TxHash)
+		require.EqualValues(t, 2, entry.BroadcastAttempts)
+	})
+
+	t.Run("should replace outbound of the same nonce", func(t *testing.T) {
+		journal := newTestJournal(t)
+		require.NoError(t, journal.record(inflightTx{tx: legacyTx(1, 100), xmsgIndex: "xmsg", height: 1000}))
+		require.NoError(t, journal.recordBroadcast(legacyTx(1, 100), nil))
+
+		replacement := legacyTx(1, 120)
+		require.NoError(t, journal.record(inflightTx{tx: replacement, xmsgIndex: "xmsg", height: 1100, replacements: 1}))
+
+		entry, found, err := journal.get(1)
+		require.NoError(t, err)
+		require.True(t, found)
+		require.Equal(t, replacement.Hash().Hex(), entry.TxHash)
+		require.Equal(t, clienttypes.OutboundJournalStatusSigned, entry.Status)
+		require.EqualValues(t, 0, entry.BroadcastAttempts)
+		require.EqualValues(t, 1, entry.Replacements)
+
+		var count int64
+		require.NoError(t, journal.db.Client().Model(&clienttypes.OutboundJournalSQLType{}).Count(&count).Error)
+		require.EqualValues(t, 1, count)
+	})
+}
+
+func TestSigner_RestoreInflightTx(t *testing.T) {
+	signer, err := getNewEvmSigner(nil)
+	require.NoError(t, err)
+
+	database, err := db.NewFromSqliteInMemory(true)
+	require.NoError(t, err)
+	signer.setJournal(database)
+
+	xmsg := sample.Xmsg_pell(t, "xmsg")
+	xmsg.OutboundTxParams = []*xmsgtypes.OutboundTxParams{{OutboundTxTssNonce: 1}}
+
+	t.Run("should not restore without journal entry", func(t *testing.T) {
+		signer.restoreInflightTx(xmsg, zerolog.Nop())
+		_, found := signer.inflight.get(1)
+		require.False(t, found)
+	})
+
+	t.Run("should restore journal entry of the xmsg", func(t *testing.T) {
+		tx := legacyTx(1, 100)
+		require.NoError(t, signer.getJournal().record(inflightTx{tx: tx, xmsgIndex: xmsg.Index, height: 1000}))
+
+		signer.restoreInflightTx(xmsg, zerolog.Nop())
+		inflight, found := signer.inflight.get(1)
+		require.True(t, found)
+		require.Equal(t, tx.Hash(), inflight.tx.Hash())
+		require.EqualValues(t, 1000, inflight.height)
+	})
+}
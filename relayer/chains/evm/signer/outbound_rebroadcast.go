@@ -0,0 +1,294 @@
+package signer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/rs/zerolog"
+
+	"github.com/0xPellNetwork/aegis/relayer/config"
+)
+
+// MinGasBumpPercent is the minimum gas price increase accepted by the EVM nodes to replace a pending transaction
+const MinGasBumpPercent = 10
+
+// RebroadcastConfig defines how the signer replaces the stuck outbounds of a chain.
+//
+// A replacement is signed by TSS keysign, so the config must be the same for all the relayers,
+// otherwise the signers would sign different transactions and the keysign would fail.
+type RebroadcastConfig struct {
+	// GasBumpPercent is the percentage by which the gas price of a stuck outbound is increased
+	GasBumpPercent uint64
+
+	// MaxGasPrice is the maximum gas price of a replacement, nil means no cap
+	MaxGasPrice *big.Int
+
+	// ReplaceAfterBlocks is the number of pell blocks after which a pending outbound is replaced, 0 disables it
+	ReplaceAfterBlocks uint64
+}
+
+// NewRebroadcastConfig returns the rebroadcast config of the chain, default values are used for unset fields
+func NewRebroadcastConfig(cfg config.EVMConfig) RebroadcastConfig {
+	res := RebroadcastConfig{
+		GasBumpPercent:     cfg.GasBumpPercent,
+		ReplaceAfterBlocks: cfg.ReplaceAfterBlocks,
+	}
+	if res.GasBumpPercent == 0 {
+		res.GasBumpPercent = config.DefaultGasBumpPercent
+	}
+	if res.ReplaceAfterBlocks == 0 {
+		res.ReplaceAfterBlocks = config.DefaultReplaceAfterBlocks
+	}
+	if cfg.MaxGasPrice > 0 {
+		res.MaxGasPrice = new(big.Int).SetUint64(cfg.MaxGasPrice)
+	}
+	return res
+}
+
+// bumpPercent returns the gas bump percentage, never lower than the one required by the nodes
+func (c RebroadcastConfig) bumpPercent() uint64 {
+	if c.GasBumpPercent < MinGasBumpPercent {
+		return MinGasBumpPercent
+	}
+	return c.GasBumpPercent
+}
+
+// canBump returns true if the gas price of the transaction can be bumped without exceeding the max gas price
+func (c RebroadcastConfig) canBump(tx *ethtypes.Transaction) bool {
+	return c.MaxGasPrice == nil || c.MaxGasPrice.Cmp(bumpByPercent(tx.GasFeeCap(), c.bumpPercent())) >= 0
+}
+
+// inflightTx is an outbound broadcasted by the signer that is not processed yet
+type inflightTx struct {
+	tx        *ethtypes.Transaction
+	xmsgIndex string
+
+	// height is the pell height of the keysign of the first transaction with the same gas
+	height uint64
+
+	// replacements is the number of times the outbound has been replaced
+	replacements uint64
+}
+
+// inflightTracker tracks the inflight outbounds by nonce
+type inflightTracker struct {
+	mu  sync.Mutex
+	txs map[uint64]inflightTx
+}
+
+func newInflightTracker() *inflightTracker {
+	return &inflightTracker{
+		txs: make(map[uint64]inflightTx),
+	}
+}
+
+// get returns the inflight outbound of the nonce
+func (t *inflightTracker) get(nonce uint64) (inflightTx, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	inflight, found := t.txs[nonce]
+	return inflight, found
+}
+
+// add records a broadcasted outbound and returns the inflight entry.
+// the keysign height is kept if the same transaction is broadcasted again.
+func (t *inflightTracker) add(tx *ethtypes.Transaction, xmsgIndex string, height uint64) inflightTx {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	inflight := t.nextLocked(tx, xmsgIndex, height)
+	t.txs[tx.Nonce()] = inflight
+	return inflight
+}
+
+// next returns the inflight entry the outbound would be recorded with, without recording it
+func (t *inflightTracker) next(tx *ethtypes.Transaction, xmsgIndex string, height uint64) inflightTx {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.nextLocked(tx, xmsgIndex, height)
+}
+
+func (t *inflightTracker) nextLocked(tx *ethtypes.Transaction, xmsgIndex string, height uint64) inflightTx {
+	inflight := inflightTx{
+		tx:        tx,
+		xmsgIndex: xmsgIndex,
+		height:    height,
+	}
+	if prev, found := t.txs[tx.Nonce()]; found && prev.xmsgIndex == xmsgIndex {
+		if prev.tx.Hash() == tx.Hash() {
+			return prev
+		}
+		inflight.replacements = prev.replacements + 1
+	}
+	return inflight
+}
+
+// set records an inflight outbound as is, it is used to restore the outbounds of the journal
+func (t *inflightTracker) set(inflight inflightTx) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.txs[inflight.tx.Nonce()] = inflight
+}
+
+// remove forgets the inflight outbound of the nonce
+func (t *inflightTracker) remove(nonce uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.txs, nonce)
+}
+
+// inflightAction is the action to take on an inflight outbound
+type inflightAction int
+
+const (
+	// inflightActionNone means there is no inflight outbound, a new one is signed
+	inflightActionNone inflightAction = iota
+
+	// inflightActionWait means the inflight outbound is pending and must be waited for
+	inflightActionWait
+
+	// inflightActionRebroadcast means the inflight outbound has been dropped and is broadcasted again
+	inflightActionRebroadcast
+
+	// inflightActionReplace means the inflight outbound is stuck and is replaced with bumped gas
+	inflightActionReplace
+)
+
+func (a inflightAction) String() string {
+	switch a {
+	case inflightActionNone:
+		return "none"
+	case inflightActionWait:
+		return "wait"
+	case inflightActionRebroadcast:
+		return "rebroadcast"
+	case inflightActionReplace:
+		return "replace"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(a))
+	}
+}
+
+// decideInflightAction decides what to do with the inflight outbound at the given pell height.
+// the outbound is replaced if the gas of the xmsg has been increased on pell chain enough for the nodes
+// to accept the replacement, or if it is pending for ReplaceAfterBlocks since its keysign and the gas can still be bumped.
+// the keysign height is the same for all the relayers, so they all sign the same replacement.
+// otherwise the signed outbound is broadcasted again as is if it is no longer known by the node.
+func decideInflightAction(
+	cfg RebroadcastConfig,
+	inflight inflightTx,
+	xmsgGas Gas,
+	height uint64,
+	dropped bool,
+) inflightAction {
+	if isReplacementGas(inflight.tx, xmsgGas) {
+		return inflightActionReplace
+	}
+	if cfg.ReplaceAfterBlocks > 0 && height >= inflight.height+cfg.ReplaceAfterBlocks && cfg.canBump(inflight.tx) {
+		return inflightActionReplace
+	}
+	if dropped {
+		return inflightActionRebroadcast
+	}
+	return inflightActionWait
+}
+
+// checkInflightTx returns the inflight outbound of the nonce and the action to take on it
+func (signer *Signer) checkInflightTx(
+	ctx context.Context,
+	nonce uint64,
+	xmsgGas Gas,
+	height uint64,
+	logger zerolog.Logger,
+) (inflightTx, inflightAction) {
+	inflight, found := signer.inflight.get(nonce)
+	if !found {
+		return inflightTx{}, inflightActionNone
+	}
+
+	dropped := false
+	_, _, err := signer.client.TransactionByHash(ctx, inflight.tx.Hash())
+	switch {
+	case err == ethereum.NotFound:
+		dropped = true
+	case err != nil:
+		// the node is unreachable, the outbound will be checked again on next schedule
+		logger.Warn().Err(err).Msgf("unable to get inflight outbound %s", inflight.tx.Hash().Hex())
+		return inflight, inflightActionWait
+	}
+
+	action := decideInflightAction(signer.rebroadcastCfg, inflight, xmsgGas, height, dropped)
+	logger.Info().Msgf("inflight outbound %s nonce %d keysign height %d replacements %d: %s",
+		inflight.tx.Hash().Hex(), nonce, inflight.height, inflight.replacements, action)
+
+	return inflight, action
+}
+
+// isReplacementGas returns true if the gas is increased enough from the gas of the transaction to replace it.
+// the nodes require both the gas price and the priority fee of a dynamic fee transaction to be increased.
+func isReplacementGas(tx *ethtypes.Transaction, gas Gas) bool {
+	if gas.Price == nil || gas.Price.Cmp(bumpByPercent(tx.GasFeeCap(), MinGasBumpPercent)) < 0 {
+		return false
+	}
+	if tx.Type() == ethtypes.DynamicFeeTxType {
+		return gas.PriorityFee != nil && gas.PriorityFee.Cmp(bumpByPercent(tx.GasTipCap(), MinGasBumpPercent)) >= 0
+	}
+	return true
+}
+
+// bumpGas returns the gas of the replacement of the given transaction.
+// the gas price and priority fee are increased by the bump percentage, and at least to the given gas.
+// it fails if the bumped gas price exceeds the max gas price.
+func bumpGas(cfg RebroadcastConfig, tx *ethtypes.Transaction, gas Gas) (Gas, error) {
+	pct := cfg.bumpPercent()
+
+	minPrice := bumpByPercent(tx.GasFeeCap(), pct)
+	price := maxBigInt(gas.Price, minPrice)
+	if cfg.MaxGasPrice != nil && price.Cmp(cfg.MaxGasPrice) > 0 {
+		if cfg.MaxGasPrice.Cmp(minPrice) < 0 {
+			return Gas{}, fmt.Errorf("bumped gas price %s exceeds max gas price %s", minPrice, cfg.MaxGasPrice)
+		}
+		price = new(big.Int).Set(cfg.MaxGasPrice)
+	}
+
+	priorityFee := big.NewInt(0)
+	if tx.Type() == ethtypes.DynamicFeeTxType {
+		minPriorityFee := bumpByPercent(tx.GasTipCap(), pct)
+		if minPriorityFee.Cmp(price) > 0 {
+			return Gas{}, fmt.Errorf("bumped priority fee %s exceeds gas price %s", minPriorityFee, price)
+		}
+		priorityFee = maxBigInt(gas.PriorityFee, minPriorityFee)
+		if priorityFee.Cmp(price) > 0 {
+			priorityFee = new(big.Int).Set(price)
+		}
+	}
+
+	return Gas{
+		Limit:       gas.Limit,
+		Price:       price,
+		PriorityFee: priorityFee,
+	}, nil
+}
+
+// bumpByPercent returns value increased by pct percent, rounded up
+func bumpByPercent(value *big.Int, pct uint64) *big.Int {
+	res := new(big.Int).Mul(value, new(big.Int).SetUint64(100+pct))
+	res.Add(res, big.NewInt(99))
+	return res.Div(res, big.NewInt(100))
+}
+
+// maxBigInt returns a copy of the greatest value, nil values are ignored
+func maxBigInt(a, b *big.Int) *big.Int {
+	switch {
+	case a == nil:
+		return new(big.Int).Set(b)
+	case b == nil || a.Cmp(b) >= 0:
+		return new(big.Int).Set(a)
+	default:
+		return new(big.Int).Set(b)
+	}
+}
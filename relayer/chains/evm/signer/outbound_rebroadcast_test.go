@@ -0,0 +1,284 @@
+package signer
+
+import (
+	"math/big"
+	"testing"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/relayer/config"
+)
+
+func legacyTx(nonce uint64, gasPrice int64) *ethtypes.Transaction {
+	return ethtypes.NewTx(&ethtypes.LegacyTx{
+		Nonce:    nonce,
+		To:       &ethcommon.Address{},
+		Gas:      MIN_GAS_LIMIT,
+		GasPrice: big.NewInt(gasPrice),
+	})
+}
+
+func dynamicFeeTx(nonce uint64, gasFeeCap, gasTipCap int64) *ethtypes.Transaction {
+	return ethtypes.NewTx(&ethtypes.DynamicFeeTx{
+		Nonce:     nonce,
+		To:        &ethcommon.Address{},
+		Gas:       MIN_GAS_LIMIT,
+		GasFeeCap: big.NewInt(gasFeeCap),
+		GasTipCap: big.NewInt(gasTipCap),
+	})
+}
+
+func legacyGas(price int64) Gas {
+	return Gas{
+		Limit:       MIN_GAS_LIMIT,
+		Price:       big.NewInt(price),
+		PriorityFee: big.NewInt(0),
+	}
+}
+
+func TestNewRebroadcastConfig(t *testing.T) {
+	t.Run("should use default values", func(t *testing.T) {
+		cfg := NewRebroadcastConfig(config.EVMConfig{})
+		require.Equal(t, config.DefaultGasBumpPercent, cfg.GasBumpPercent)
+		require.Equal(t, config.DefaultReplaceAfterBlocks, cfg.ReplaceAfterBlocks)
+		require.Nil(t, cfg.MaxGasPrice)
+	})
+
+	t.Run("should use configured values", func(t *testing.T) {
+		cfg := NewRebroadcastConfig(config.EVMConfig{
+			GasBumpPercent:     50,
+			MaxGasPrice:        1000,
+			ReplaceAfterBlocks: 10,
+		})
+		require.EqualValues(t, 50, cfg.GasBumpPercent)
+		require.EqualValues(t, 10, cfg.ReplaceAfterBlocks)
+		require.EqualValues(t, 1000, cfg.MaxGasPrice.Int64())
+	})
+}
+
+func TestBumpGas(t *testing.T) {
+	t.Run("should bump legacy gas price", func(t *testing.T) {
+		cfg := RebroadcastConfig{GasBumpPercent: 20}
+		gas, err := bumpGas(cfg, legacyTx(1, 100), legacyGas(100))
+		require.NoError(t, err)
+		require.True(t, gas.isLegacy())
+		require.EqualValues(t, 120, gas.Price.Int64())
+		require.EqualValues(t, MIN_GAS_LIMIT, gas.Limit)
+	})
+
+	t.Run("should bump at least by the minimum percentage", func(t *testing.T) {
+		cfg := RebroadcastConfig{GasBumpPercent: 1}
+		gas, err := bumpGas(cfg, legacyTx(1, 101), legacyGas(0))
+		require.NoError(t, err)
+		require.EqualValues(t, 112, gas.Price.Int64())
+	})
+
+	t.Run("should use the xmsg gas price if higher", func(t *testing.T) {
+		cfg := RebroadcastConfig{GasBumpPercent: 20}
+		gas, err := bumpGas(cfg, legacyTx(1, 100), legacyGas(200))
+		require.NoError(t, err)
+		require.EqualValues(t, 200, gas.Price.Int64())
+	})
+
+	t.Run("should bump EIP-1559 fees", func(t *testing.T) {
+		cfg := RebroadcastConfig{GasBumpPercent: 20}
+		gas, err := bumpGas(cfg, dynamicFeeTx(1, 100, 10), legacyGas(100))
+		require.NoError(t, err)
+		require.False(t, gas.isLegacy())
+		require.EqualValues(t, 120, gas.Price.Int64())
+		require.EqualValues(t, 12, gas.PriorityFee.Int64())
+		require.NoError(t, gas.validate())
+	})
+
+	t.Run("should cap gas price", func(t *testing.T) {
+		cfg := RebroadcastConfig{GasBumpPercent: 20, MaxGasPrice: big.NewInt(150)}
+		gas, err := bumpGas(cfg, legacyTx(1, 100), legacyGas(200))
+		require.NoError(t, err)
+		require.EqualValues(t, 150, gas.Price.Int64())
+	})
+
+	t.Run("should cap priority fee to gas price", func(t *testing.T) {
+		cfg := RebroadcastConfig{GasBumpPercent: 20, MaxGasPrice: big.NewInt(130)}
+		gas := Gas{Limit: MIN_GAS_LIMIT, Price: big.NewInt(200), PriorityFee: big.NewInt(200)}
+		gas, err := bumpGas(cfg, dynamicFeeTx(1, 100, 10), gas)
+		require.NoError(t, err)
+		require.EqualValues(t, 130, gas.Price.Int64())
+		require.EqualValues(t, 130, gas.PriorityFee.Int64())
+	})
+
+	t.Run("should fail if the bumped gas price exceeds the cap", func(t *testing.T) {
+		cfg := RebroadcastConfig{GasBumpPercent: 20, MaxGasPrice: big.NewInt(115)}
+		_, err := bumpGas(cfg, legacyTx(1, 100), legacyGas(100))
+		require.ErrorContains(t, err, "exceeds max gas price")
+	})
+}
+
+func TestIsReplacementGas(t *testing.T) {
+	t.Run("should replace legacy tx with gas price increased by the minimum percentage", func(t *testing.T) {
+		require.True(t, isReplacementGas(legacyTx(1, 100), legacyGas(110)))
+		require.True(t, isReplacementGas(legacyTx(1, 100), legacyGas(200)))
+	})
+
+	t.Run("should not replace legacy tx with gas price increased by less than the minimum percentage", func(t *testing.T) {
+		require.False(t, isReplacementGas(legacyTx(1, 100), legacyGas(109)))
+		require.False(t, isReplacementGas(legacyTx(1, 100), legacyGas(100)))
+		require.False(t, isReplacementGas(legacyTx(1, 101), legacyGas(111)))
+	})
+
+	t.Run("should require the priority fee of EIP-1559 tx to be increased", func(t *testing.T) {
+		gas := Gas{Limit: MIN_GAS_LIMIT, Price: big.NewInt(120), PriorityFee: big.NewInt(11)}
+		require.True(t, isReplacementGas(dynamicFeeTx(1, 100, 10), gas))
+
+		gas.PriorityFee = big.NewInt(10)
+		require.False(t, isReplacementGas(dynamicFeeTx(1, 100, 10), gas))
+	})
+}
+
+func TestDecideInflightAction(t *testing.T) {
+	inflight := inflightTx{tx: legacyTx(1, 100), height: 1000}
+	cfg := RebroadcastConfig{GasBumpPercent: 20, ReplaceAfterBlocks: 100}
+
+	tests := []struct {
+		name     string
+		cfg      RebroadcastConfig
+		xmsgGas  Gas
+		height   uint64
+		dropped  bool
+		expected inflightAction
+	}{
+		{
+			name:     "should wait for pending outbound",
+			cfg:      cfg,
+			xmsgGas:  legacyGas(100),
+			height:   1099,
+			expected: inflightActionWait,
+		},
+		{
+			name:     "should rebroadcast dropped outbound",
+			cfg:      cfg,
+			xmsgGas:  legacyGas(100),
+			height:   1099,
+			dropped:  true,
+			expected: inflightActionRebroadcast,
+		},
+		{
+			name:     "should replace stuck outbound",
+			cfg:      cfg,
+			xmsgGas:  legacyGas(100),
+			height:   1100,
+			expected: inflightActionReplace,
+		},
+		{
+			name:     "should replace dropped outbound if stuck",
+			cfg:      cfg,
+			xmsgGas:  legacyGas(100),
+			height:   1100,
+			dropped:  true,
+			expected: inflightActionReplace,
+		},
+		{
+			name:     "should not replace stuck outbound if disabled",
+			cfg:      RebroadcastConfig{GasBumpPercent: 20},
+			xmsgGas:  legacyGas(100),
+			height:   5000,
+			expected: inflightActionWait,
+		},
+		{
+			name:     "should not replace stuck outbound at the max gas price",
+			cfg:      RebroadcastConfig{GasBumpPercent: 20, ReplaceAfterBlocks: 100, MaxGasPrice: big.NewInt(115)},
+			xmsgGas:  legacyGas(100),
+			height:   1100,
+			dropped:  true,
+			expected: inflightActionRebroadcast,
+		},
+		{
+			name:     "should replace outbound if the xmsg gas price increased",
+			cfg:      cfg,
+			xmsgGas:  legacyGas(110),
+			height:   1001,
+			expected: inflightActionReplace,
+		},
+		{
+			name:     "should replace dropped outbound if the xmsg gas price increased",
+			cfg:      cfg,
+			xmsgGas:  legacyGas(110),
+			height:   1001,
+			dropped:  true,
+			expected: inflightActionReplace,
+		},
+		{
+			name:     "should wait if the xmsg gas price increase would not be accepted by the nodes",
+			cfg:      cfg,
+			xmsgGas:  legacyGas(105),
+			height:   1001,
+			expected: inflightActionWait,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			action := decideInflightAction(tt.cfg, inflight, tt.xmsgGas, tt.height, tt.dropped)
+			require.Equal(t, tt.expected, action)
+		})
+	}
+}
+
+func TestInflightTracker(t *testing.T) {
+	t.Run("should track broadcasted outbound", func(t *testing.T) {
+		tracker := newInflightTracker()
+		_, found := tracker.get(1)
+		require.False(t, found)
+
+		tx := legacyTx(1, 100)
+		tracker.add(tx, "xmsg", 1000)
+		inflight, found := tracker.get(1)
+		require.True(t, found)
+		require.Equal(t, tx.Hash(), inflight.tx.Hash())
+		require.EqualValues(t, 1000, inflight.height)
+		require.EqualValues(t, 0, inflight.replacements)
+
+		tracker.remove(1)
+		_, found = tracker.get(1)
+		require.False(t, found)
+	})
+
+	t.Run("should keep keysign height on rebroadcast", func(t *testing.T) {
+		tracker := newInflightTracker()
+		tx := legacyTx(1, 100)
+		tracker.add(tx, "xmsg", 1000)
+
+		inflight := tracker.add(tx, "xmsg", 1050)
+		require.EqualValues(t, 1000, inflight.height)
+		require.EqualValues(t, 0, inflight.replacements)
+	})
+
+	t.Run("should count replacements", func(t *testing.T) {
+		tracker := newInflightTracker()
+		tracker.add(legacyTx(1, 100), "xmsg", 1000)
+		tracker.add(legacyTx(1, 120), "xmsg", 1100)
+		inflight := tracker.add(legacyTx(1, 144), "xmsg", 1200)
+		require.EqualValues(t, 1200, inflight.height)
+		require.EqualValues(t, 2, inflight.replacements)
+
+		// another xmsg with the same nonce resets the count
+		inflight = tracker.add(legacyTx(1, 100), "other", 1300)
+		require.EqualValues(t, 0, inflight.replacements)
+	})
+
+	t.Run("should compute next entry without recording it", func(t *testing.T) {
+		tracker := newInflightTracker()
+		tracker.add(legacyTx(1, 100), "xmsg", 1000)
+
+		next := tracker.next(legacyTx(1, 120), "xmsg", 1100)
+		require.EqualValues(t, 1, next.replacements)
+		inflight, _ := tracker.get(1)
+		require.Equal(t, legacyTx(1, 100).Hash(), inflight.tx.Hash())
+
+		tracker.set(next)
+		inflight, _ = tracker.get(1)
+		require.Equal(t, legacyTx(1, 120).Hash(), inflight.tx.Hash())
+		require.EqualValues(t, 1, inflight.replacements)
+	})
+}
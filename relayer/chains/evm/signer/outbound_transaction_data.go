@@ -40,6 +40,9 @@ type OutBoundTransactionData struct {
 	outboundParams *types.OutboundTxParams
 
 	pellTxData *types.InboundPellEvent
+
+	// pellToken field is the address of the pell token contract on the destination chain
+	pellToken ethcommon.Address
 }
 
 // NewOutBoundTransactionData populates transaction input fields parsed from the xmsg and other parameters
@@ -79,6 +82,11 @@ func NewOutBoundTransactionData(
 		return nil, false, errors.Wrap(err, "unable to get xmsg index")
 	}
 
+	var pellToken ethcommon.Address
+	if evmClient != nil {
+		pellToken = ethcommon.HexToAddress(evmClient.GetChainParams().PellTokenContractAddress)
+	}
+
 	return &OutBoundTransactionData{
 		srcChainID: big.NewInt(xmsg.InboundTxParams.SenderChainId),
 		sender:     ethcommon.HexToAddress(xmsg.InboundTxParams.Sender),
@@ -96,6 +104,8 @@ func NewOutBoundTransactionData(
 		outboundParams: outboundParams,
 
 		pellTxData: xmsg.InboundTxParams.InboundPellTx,
+
+		pellToken: pellToken,
 	}, false, nil
 }
 
@@ -33,6 +33,8 @@ func (signer *Signer) SignConnectorTx(ctx context.Context, txData *OutBoundTrans
 		return signer.signConnectorTxOnReceive(ctx, pellSent, txData, paramType)
 	case types.Transfer:
 		return signer.signTransferTx(ctx, pellSent, txData)
+	case types.PellTokenTransfer:
+		return signer.signPellTokenTransferTx(ctx, pellSent, txData)
 	default:
 		// TODO: Remove this block in the next release
 		// This block is maintained for backward compatibility.
@@ -213,3 +215,40 @@ func (signer *Signer) signTransferTx(
 
 	return tx, nil
 }
+
+// function transfer(
+//
+//	address to,
+//	uint256 amount
+//
+// )
+func (signer *Signer) signPellTokenTransferTx(
+	ctx context.Context,
+	pellSent *xmsgtypes.PellSent,
+	txData *OutBoundTransactionData,
+) (*ethtypes.Transaction, error) {
+	if txData.pellToken == (ethcommon.Address{}) {
+		return nil, fmt.Errorf("pell token contract address not set for chain %d", txData.toChainID)
+	}
+
+	data, err := pellTokenABI.Pack("transfer", ethcommon.HexToAddress(pellSent.Receiver), pellSent.PellValue.BigInt())
+	if err != nil {
+		return nil, errors.Wrap(err, "pell token pack error")
+	}
+
+	tx, _, _, err := signer.Sign(
+		ctx,
+		data,
+		txData.pellToken,
+		zeroValue,
+		txData.gas,
+		txData.nonce,
+		txData.height,
+		txData.outboundParams.TssPubkey,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("sign error: %w", err)
+	}
+
+	return tx, nil
+}
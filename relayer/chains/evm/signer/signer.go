@@ -12,8 +12,6 @@ import (
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
-	"github.com/ethereum/go-ethereum/ethclient"
-	ethrpc "github.com/ethereum/go-ethereum/rpc"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
@@ -21,6 +19,7 @@ import (
 	"github.com/0xPellNetwork/aegis/pkg/chains"
 	"github.com/0xPellNetwork/aegis/relayer/chains/base"
 	"github.com/0xPellNetwork/aegis/relayer/chains/evm/observer"
+	"github.com/0xPellNetwork/aegis/relayer/chains/evm/rpc"
 	"github.com/0xPellNetwork/aegis/relayer/chains/interfaces"
 	"github.com/0xPellNetwork/aegis/relayer/compliance"
 	pctx "github.com/0xPellNetwork/aegis/relayer/context"
@@ -61,12 +60,23 @@ type Signer struct {
 
 	// mu protects below fields from concurrent access
 	pellConnectorAddress ethcommon.Address
+
+	// rebroadcastCfg defines how stuck outbounds are replaced
+	rebroadcastCfg RebroadcastConfig
+
+	// inflight tracks the outbounds broadcasted by the signer by nonce
+	inflight *inflightTracker
+
+	// journal persists the signed outbounds in the database of the chain
+	journal *outboundJournal
 }
 
 func NewEVMSigner(
 	ctx context.Context,
 	chain chains.Chain,
-	endpoint string,
+	endpoints []string,
+	rpcQuorum int,
+	rebroadcastCfg RebroadcastConfig,
 	tss interfaces.TSSSigner,
 	pellConnectorAddress ethcommon.Address,
 	logger clientlogs.Logger,
@@ -80,7 +90,7 @@ func NewEVMSigner(
 	// create base signer
 	baseSigner := base.NewSigner(chain, tss, appContext.PellCoreContext(), ts, logger)
 
-	client, ethSigner, err := getEVMRPC(ctx, endpoint)
+	client, ethSigner, err := getEVMRPC(ctx, endpoints, rpcQuorum)
 	if err != nil {
 		return nil, err
 	}
@@ -90,6 +100,8 @@ func NewEVMSigner(
 		client:               client,
 		ethSigner:            ethSigner,
 		pellConnectorAddress: pellConnectorAddress,
+		rebroadcastCfg:       rebroadcastCfg,
+		inflight:             newInflightTracker(),
 	}, nil
 }
 
@@ -256,6 +268,11 @@ func (signer *Signer) TryProcessOutTx_i(
 		logger.Error().Msg("chain client is not an EVMChainClient")
 		return
 	}
+
+	// Restore the outbound signed before a restart so that it is rebroadcasted instead of signed again
+	signer.setJournal(evmClient.DB())
+	signer.restoreInflightTx(xmsg, logger)
+
 	skipTx, err := signer.IsOutboundProcessed(ctx, xmsg, evmClient, logger)
 	if err != nil {
 		logger.Err(err).Msg("error setting up transaction input fields")
@@ -275,10 +292,32 @@ func (signer *Signer) TryProcessOutTx_i(
 		return
 	}
 
-	// Get destination chain for logging
-	toChain, exist := chains.GetChainByChainId(txData.toChainID.Int64())
-	if !exist {
-		logger.Error().Err(err).Msgf("error getting toChain %d", txData.toChainID.Int64())
+	// Rebroadcast the inflight outbound if it has been dropped,
+	// or replace it with bumped gas if it is stuck or the gas of the xmsg has been increased on pell chain
+	inflight, action := signer.checkInflightTx(ctx, params.OutboundTxTssNonce, txData.gas, height, logger)
+	switch action {
+	case inflightActionWait:
+		return
+	case inflightActionRebroadcast:
+		signer.BroadcastOutTx(ctx, inflight.tx, xmsg, logger, myID, pellBridge, txData)
+		return
+	case inflightActionReplace:
+		gas, err := bumpGas(signer.rebroadcastCfg, inflight.tx, txData.gas)
+		if err != nil {
+			// the outbound can't be replaced above the max gas price, it is broadcasted again as signed
+			logger.Err(err).Msgf("unable to replace inflight outbound %s", inflight.tx.Hash().Hex())
+			signer.BroadcastOutTx(ctx, inflight.tx, xmsg, logger, myID, pellBridge, txData)
+			return
+		}
+		logger.Info().Msgf("replace inflight outbound %s gas price %s => %s, priority fee %s => %s",
+			inflight.tx.Hash().Hex(), inflight.tx.GasFeeCap(), gas.Price, inflight.tx.GasTipCap(), gas.PriorityFee)
+		txData.gas = gas
+	}
+
+	// the destination chain is the chain of the signer, as loaded from the chain registry
+	toChain := signer.Chain()
+	if toChain.Id != txData.toChainID.Int64() {
+		logger.Error().Msgf("outbound chain %d is not the signer chain %d", txData.toChainID.Int64(), toChain.Id)
 		return
 	}
 
@@ -325,6 +364,10 @@ func (signer *Signer) IsOutboundProcessed(
 		return true, errors.New("IsOutboundProcessed failed")
 	}
 	if included || confirmed {
+		signer.inflight.remove(xmsg.GetCurrentOutTxParam().OutboundTxTssNonce)
+		if err := signer.getJournal().markProcessed(xmsg.GetCurrentOutTxParam().OutboundTxTssNonce); err != nil {
+			logger.Warn().Err(err).Msg("unable to mark outbound as processed in journal")
+		}
 		logger.Info().Msgf("Xmsg already processed; exit signer")
 		return true, nil
 	}
@@ -336,6 +379,11 @@ func (signer *Signer) IsOutboundProcessed(
 		return true, fmt.Errorf("cannot convert gas price  %s ", xmsg.GetCurrentOutTxParam().OutboundTxGasPrice)
 	}
 	pendingTx := evmClient.GetPendingTx(nonce)
+
+	// The pending transaction broadcasted by this signer is rebroadcasted or replaced by checkInflightTx
+	if inflight, found := signer.inflight.get(nonce); found && pendingTx != nil && inflight.tx.Hash() == pendingTx.Hash() {
+		return false, nil
+	}
 	if pendingTx != nil {
 		if gasPrice.Cmp(pendingTx.GasPrice()) > 0 {
 			logger.Info().Msgf("replace pending outTx %s nonce %d using gas price %d", pendingTx.Hash().Hex(), nonce, gasPrice)
@@ -359,9 +407,7 @@ func (signer *Signer) SignOutboundFromXmsg(
 	toChain int64,
 ) (*ethtypes.Transaction, error) {
 	// compliance check goes first
-	if compliance.IsXmsgRestricted(xmsg) {
-		compliance.PrintComplianceLog(logger, signer.Logger().Compliance,
-			true, evmClient.Chain().Id, xmsg.Index, xmsg.InboundTxParams.Sender, txData.to.Hex(), "")
+	if compliance.ScreenXmsg(logger, signer.Logger().Compliance, evmClient.Chain().Id, xmsg) {
 		return signer.SignCancelTx(ctx, txData) // cancel the tx
 	} else if IsSenderPellChain(xmsg, pellBridge) {
 		logger.Info().Msgf("SignOutboundTx: %d => %d, nonce %d, gasPrice %d",
@@ -395,13 +441,19 @@ func (signer *Signer) BroadcastOutTx(
 	pellBridge interfaces.PellCoreBridger,
 	txData *OutBoundTransactionData) {
 	// Get destination chain for logging
-	toChain, _ := chains.GetChainByChainId(txData.toChainID.Int64())
+	toChain := signer.Chain()
 	if tx == nil {
 		logger.Warn().Msgf("BroadcastOutTx: no tx to broadcast %s", xmsg.Index)
 	} else {
 		// Try to broadcast transaction
 		outTxHash := tx.Hash().Hex()
 		logger.Info().Msgf("BroadcastOutTx: on chain %s nonce %d, outTxHash %s signer %s", signer.Chain(), xmsg.GetCurrentOutTxParam().OutboundTxTssNonce, outTxHash, myID)
+
+		// Journal the signed outbound before broadcasting it so that it survives a restart
+		journal := signer.getJournal()
+		if err := journal.record(signer.inflight.next(tx, xmsg.Index, txData.height)); err != nil {
+			logger.Warn().Err(err).Msgf("BroadcastOutTx: unable to journal outbound %s", outTxHash)
+		}
 		//if len(signers) == 0 || myid == signers[send.OutboundTxParams.Broadcaster] || myid == signers[int(send.OutboundTxParams.Broadcaster+1)%len(signers)] {
 		backOff := broadcastBackoff
 		// retry loop: 1s, 2s, 4s, 8s, 16s in case of RPC error
@@ -410,6 +462,9 @@ func (signer *Signer) BroadcastOutTx(
 			// #nosec G404 randomness is not a security issue here
 			time.Sleep(backOff)
 			err := signer.Broadcast(tx)
+			if journalErr := journal.recordBroadcast(tx, err); journalErr != nil {
+				logger.Warn().Err(journalErr).Msgf("BroadcastOutTx: unable to journal broadcast of outbound %s", outTxHash)
+			}
 			if err != nil {
 				log.Warn().
 					Err(err).
@@ -422,6 +477,7 @@ func (signer *Signer) BroadcastOutTx(
 					toChain.String(),
 					outTxHash)
 				if report {
+					signer.inflight.add(tx, xmsg.Index, txData.height)
 					signer.reportToOutTxTracker(ctx, pellBridge, toChain.Id, tx.Nonce(), outTxHash, logger)
 				}
 				if !retry {
@@ -433,6 +489,11 @@ func (signer *Signer) BroadcastOutTx(
 			logger.Info().Msgf("Broadcast success: nonce %d to chain %s outTxHash %s", xmsg.GetCurrentOutTxParam().OutboundTxTssNonce, toChain.ChainName(), outTxHash)
 			logger.Info().Msgf("BroadcastOutbound: broadcasted tx %s on chain %d nonce %d signer %s",
 				outTxHash, toChain.Id, xmsg.GetCurrentOutTxParam().OutboundTxTssNonce, myID)
+			inflight := signer.inflight.add(tx, xmsg.Index, txData.height)
+			if inflight.replacements > 0 {
+				logger.Info().Msgf("BroadcastOutbound: replacement %d of outbound nonce %d is %s",
+					inflight.replacements, tx.Nonce(), outTxHash)
+			}
 			signer.reportToOutTxTracker(ctx, pellBridge, toChain.Id, tx.Nonce(), outTxHash, logger)
 			break // successful broadcast; no need to retry
 		}
@@ -451,25 +512,19 @@ func (signer *Signer) EvmSigner() ethtypes.Signer {
 
 // ________________________
 
-// getEVMRPC is a helper function to set up the client and signer, also initializes a mock client for unit tests
-func getEVMRPC(ctx context.Context, endpoint string) (interfaces.EVMRPCClient, ethtypes.Signer, error) {
-	if endpoint == stub.EVMRPCEnabled {
+// getEVMRPC returns a pool of the EVM RPC endpoints and the tx signer of the chain
+func getEVMRPC(ctx context.Context, endpoints []string, rpcQuorum int) (interfaces.EVMRPCClient, ethtypes.Signer, error) {
+	if len(endpoints) == 1 && endpoints[0] == stub.EVMRPCEnabled {
 		chainID := big.NewInt(chains.BscMainnetChain().Id)
 		ethSigner := ethtypes.NewLondonSigner(chainID)
 		client := &stub.MockEvmClient{}
 		return client, ethSigner, nil
 	}
 
-	httpClient, err := metrics.GetInstrumentedHTTPClient(endpoint)
-	if err != nil {
-		return nil, nil, errors.Wrap(err, "unable to get instrumented HTTP client")
-	}
-
-	rpcClient, err := ethrpc.DialHTTPWithClient(endpoint, httpClient)
+	client, err := rpc.DialPool(endpoints, rpcQuorum)
 	if err != nil {
-		return nil, nil, errors.Wrapf(err, "unable to dial EVM client (endpoint %q)", endpoint)
+		return nil, nil, errors.Wrap(err, "unable to create EVM RPC pool")
 	}
-	client := ethclient.NewClient(rpcClient)
 
 	chainID, err := client.ChainID(ctx)
 	if err != nil {
@@ -51,7 +51,9 @@ func getNewEvmSigner(tss interfaces.TSSSigner) (*Signer, error) {
 	return NewEVMSigner(
 		ctx,
 		chains.BscMainnetChain(),
-		stub.EVMRPCEnabled,
+		[]string{stub.EVMRPCEnabled},
+		0,
+		NewRebroadcastConfig(config.EVMConfig{}),
 		tss,
 		connectorAddress,
 		logger,
@@ -44,6 +44,10 @@ type ChainClient interface {
 	GetChainParams() relayertypes.ChainParams
 	OutboundID(nonce uint64) string
 	WatchIntxTracker(ctx context.Context) error
+	Pause()
+	Resume()
+	IsPaused() bool
+	RescanFrom(blockNumber uint64) error
 }
 
 // ChainSigner is the interface to sign transactions for a chain
@@ -74,6 +78,8 @@ type PellcoreVoter interface {
 		ctx context.Context,
 		chain chains.Chain,
 		gasPrice uint64,
+		priorityFee uint64,
+		baseFee uint64,
 		supply string,
 		blockNum uint64,
 	) (string, error)
@@ -152,6 +158,7 @@ type PellCoreBridger interface {
 	GetAllOutTxTrackerByChain(ctx context.Context, chainID int64, order Order) ([]xmsgtypes.OutTxTracker, error)
 	GetCrosschainFlags(ctx context.Context) (relayertypes.CrosschainFlags, error)
 	GetRateLimiterFlags(ctx context.Context) (xmsgtypes.RateLimiterFlags, error)
+	GetRestrictedAddresses(ctx context.Context) ([]string, error)
 	GetObserverList(ctx context.Context) ([]string, error)
 	GetPellHotKeyBalance(ctx context.Context) (sdkmath.Int, error)
 	GetInboundTrackersForChain(ctx context.Context, chainID int64) ([]xmsgtypes.InTxTracker, error)
@@ -0,0 +1,60 @@
+package compliance
+
+import (
+	"github.com/rs/zerolog"
+)
+
+// Tx describes a tx screened for compliance
+type Tx struct {
+	// Outbound is true for outbound txs (xmsg), false for inbound txs (intx)
+	Outbound bool
+
+	// ChainID is the id of the external chain of the tx
+	ChainID int64
+
+	// Identifier is the xmsg index for outbound txs and the intx hash for inbound txs
+	Identifier string
+
+	Sender   string
+	Receiver string
+	Token    string
+}
+
+// direction returns the direction of the tx recorded in the audit log
+func (tx Tx) direction() string {
+	if tx.Outbound {
+		return "outbound"
+	}
+	return "inbound"
+}
+
+// RecordDecision records the screening decision of the tx
+// the full decision is recorded as a structured event in the audit log, blocked txs are also reported in the module log
+func RecordDecision(logger, auditLogger zerolog.Logger, tx Tx, decision Decision, blocked bool) {
+	event := auditLogger.Info()
+	if blocked || decision.Verdict != VerdictAllowed {
+		event = auditLogger.Warn()
+	}
+	event.
+		Str("direction", tx.direction()).
+		Int64("chain", tx.ChainID).
+		Str("identifier", tx.Identifier).
+		Str("sender", tx.Sender).
+		Str("receiver", tx.Receiver).
+		Str("token", tx.Token).
+		Str("verdict", string(decision.Verdict)).
+		Bool("blocked", blocked).
+		Str("provider", decision.Provider).
+		Str("restricted_address", decision.Address).
+		Strs("errors", decision.Errors).
+		Msg("compliance screening")
+
+	if blocked {
+		logger.Warn().
+			Str("direction", tx.direction()).
+			Int64("chain", tx.ChainID).
+			Str("identifier", tx.Identifier).
+			Str("verdict", string(decision.Verdict)).
+			Msg("tx blocked by compliance screening, see compliance log for details")
+	}
+}
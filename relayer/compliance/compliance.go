@@ -1,40 +1,78 @@
 package compliance
 
 import (
+	"sync"
+
+	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
 
 	"github.com/0xPellNetwork/aegis/relayer/config"
 	xmsgtypes "github.com/0xPellNetwork/aegis/x/xmsg/types"
 )
 
+var (
+	screenerLock sync.RWMutex
+
+	// screener is the screener used by the relayer, it defaults to the restricted addresses of the config file
+	screener = NewScreener(false, NewConfigProvider())
+)
+
+// SetScreener sets the screener used by the relayer
+func SetScreener(s *Screener) {
+	screenerLock.Lock()
+	defer screenerLock.Unlock()
+	screener = s
+}
+
+// GetScreener returns the screener used by the relayer
+func GetScreener() *Screener {
+	screenerLock.RLock()
+	defer screenerLock.RUnlock()
+	return screener
+}
+
+// ReloadScreener reloads the compliance config of the relayer config file and replaces the screener used by the relayer
+func ReloadScreener(pellCoreHome string, client RestrictedAddressesGetter, logger zerolog.Logger) (*Screener, error) {
+	cfg, err := config.Load(pellCoreHome)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to load config")
+	}
+
+	s, err := NewScreenerFromConfig(cfg.ComplianceConfig, client, logger)
+	if err != nil {
+		return nil, err
+	}
+	SetScreener(s)
+
+	return s, nil
+}
+
 // IsXmsgRestricted returns true if the xmsg involves restricted addresses
 func IsXmsgRestricted(xmsg *xmsgtypes.Xmsg) bool {
+	s := GetScreener()
 	sender := xmsg.InboundTxParams.Sender
 	receiver := xmsg.GetCurrentOutTxParam().Receiver
-	return config.ContainRestrictedAddress(sender, receiver)
+	return s.Screen(sender, receiver).IsRestricted(s.FailClosed())
 }
 
-// PrintComplianceLog prints compliance log with fields [chain, xmsg/intx, chain, sender, receiver, token]
-func PrintComplianceLog(
-	logger1 zerolog.Logger,
-	logger2 zerolog.Logger,
-	outbound bool,
-	chainID int64,
-	identifier, sender, receiver, token string) {
-	var logMsg string
-	var logWithFields1 zerolog.Logger
-	var logWithFields2 zerolog.Logger
-	if outbound {
-		// we print xmsg for outbound tx
-		logMsg = "Restricted address detected in xmsg"
-		logWithFields1 = logger1.With().Int64("chain", chainID).Str("xmsg", identifier).Str("sender", sender).Str("receiver", receiver).Str("token", token).Logger()
-		logWithFields2 = logger2.With().Int64("chain", chainID).Str("xmsg", identifier).Str("sender", sender).Str("receiver", receiver).Str("token", token).Logger()
-	} else {
-		// we print intx for inbound tx
-		logMsg = "Restricted address detected in intx"
-		logWithFields1 = logger1.With().Int64("chain", chainID).Str("intx", identifier).Str("sender", sender).Str("receiver", receiver).Str("token", token).Logger()
-		logWithFields2 = logger2.With().Int64("chain", chainID).Str("intx", identifier).Str("sender", sender).Str("receiver", receiver).Str("token", token).Logger()
-	}
-	logWithFields1.Warn().Msg(logMsg)
-	logWithFields2.Warn().Msg(logMsg)
+// ScreenTx screens the sender and receiver of the tx and records the decision in the audit log
+// returns true if the tx must be blocked
+func ScreenTx(logger, auditLogger zerolog.Logger, tx Tx) bool {
+	s := GetScreener()
+	decision := s.Screen(tx.Sender, tx.Receiver)
+	blocked := decision.IsRestricted(s.FailClosed())
+	RecordDecision(logger, auditLogger, tx, decision, blocked)
+	return blocked
+}
+
+// ScreenXmsg screens the sender and receiver of the outbound of the xmsg and records the decision in the audit log
+// returns true if the xmsg must be cancelled
+func ScreenXmsg(logger, auditLogger zerolog.Logger, chainID int64, xmsg *xmsgtypes.Xmsg) bool {
+	return ScreenTx(logger, auditLogger, Tx{
+		Outbound:   true,
+		ChainID:    chainID,
+		Identifier: xmsg.Index,
+		Sender:     xmsg.InboundTxParams.Sender,
+		Receiver:   xmsg.GetCurrentOutTxParam().Receiver,
+	})
 }
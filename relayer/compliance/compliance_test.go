@@ -1,14 +1,17 @@
 package compliance
 
 import (
+	"bytes"
 	"testing"
 
 	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/require"
 
 	"github.com/0xPellNetwork/aegis/pkg/chains"
 	"github.com/0xPellNetwork/aegis/relayer/config"
 	"github.com/0xPellNetwork/aegis/relayer/testutils"
+	"github.com/0xPellNetwork/aegis/testutil/sample"
 )
 
 func TestXmsgRestricted(t *testing.T) {
@@ -50,3 +53,72 @@ func TestXmsgRestricted(t *testing.T) {
 		require.False(t, IsXmsgRestricted(xmsg))
 	})
 }
+
+func TestScreenTx(t *testing.T) {
+	sender := sample.EthAddress().Hex()
+	receiver := sample.EthAddress().Hex()
+	tx := Tx{
+		ChainID:    chains.EthChain().Id,
+		Identifier: sample.Hash().Hex(),
+		Sender:     sender,
+		Receiver:   receiver,
+		Token:      "ERC20",
+	}
+
+	t.Cleanup(func() { SetScreener(NewScreener(false, NewConfigProvider())) })
+
+	t.Run("should allow tx and record decision in audit log", func(t *testing.T) {
+		SetScreener(NewScreener(false, newMockProvider("mock")))
+
+		var logs, auditLogs bytes.Buffer
+		require.False(t, ScreenTx(zerolog.New(&logs), zerolog.New(&auditLogs), tx))
+		require.Empty(t, logs.String())
+		require.Contains(t, auditLogs.String(), `"verdict":"allowed"`)
+		require.Contains(t, auditLogs.String(), `"direction":"inbound"`)
+		require.Contains(t, auditLogs.String(), tx.Identifier)
+	})
+
+	t.Run("should block tx and record decision in both logs", func(t *testing.T) {
+		SetScreener(NewScreener(false, newMockProvider("mock", receiver)))
+
+		var logs, auditLogs bytes.Buffer
+		require.True(t, ScreenTx(zerolog.New(&logs), zerolog.New(&auditLogs), tx))
+		require.Contains(t, logs.String(), "tx blocked by compliance screening")
+		require.NotContains(t, logs.String(), receiver)
+		require.Contains(t, auditLogs.String(), `"verdict":"restricted"`)
+		require.Contains(t, auditLogs.String(), `"provider":"mock"`)
+		require.Contains(t, auditLogs.String(), `"restricted_address":"`+receiver+`"`)
+	})
+
+	t.Run("should allow tx on screening failure if fail open", func(t *testing.T) {
+		SetScreener(NewScreener(false, newFailingProvider("failing")))
+
+		var logs, auditLogs bytes.Buffer
+		require.False(t, ScreenTx(zerolog.New(&logs), zerolog.New(&auditLogs), tx))
+		require.Contains(t, auditLogs.String(), `"verdict":"screening_failed"`)
+		require.Contains(t, auditLogs.String(), `"blocked":false`)
+	})
+
+	t.Run("should block tx on screening failure if fail closed", func(t *testing.T) {
+		SetScreener(NewScreener(true, newFailingProvider("failing")))
+
+		var logs, auditLogs bytes.Buffer
+		require.True(t, ScreenTx(zerolog.New(&logs), zerolog.New(&auditLogs), tx))
+		require.Contains(t, auditLogs.String(), `"verdict":"screening_failed"`)
+		require.Contains(t, auditLogs.String(), `"blocked":true`)
+	})
+}
+
+func TestScreenXmsg(t *testing.T) {
+	chain := chains.EthChain()
+	xmsg := testutils.LoadXmsgByNonce(t, chain.Id, 6270)
+
+	t.Cleanup(func() { SetScreener(NewScreener(false, NewConfigProvider())) })
+
+	SetScreener(NewScreener(false, newMockProvider("mock", xmsg.GetCurrentOutTxParam().Receiver)))
+
+	var logs, auditLogs bytes.Buffer
+	require.True(t, ScreenXmsg(zerolog.New(&logs), zerolog.New(&auditLogs), chain.Id, xmsg))
+	require.Contains(t, auditLogs.String(), `"direction":"outbound"`)
+	require.Contains(t, auditLogs.String(), xmsg.Index)
+}
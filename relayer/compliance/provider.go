@@ -0,0 +1,49 @@
+package compliance
+
+import (
+	"github.com/0xPellNetwork/aegis/relayer/config"
+)
+
+const (
+	// ProviderNameConfig is the name of the provider screening against the relayer config file
+	ProviderNameConfig = "config"
+
+	// ProviderNameFile is the name of the provider screening against a hot-reloaded file
+	ProviderNameFile = "file"
+
+	// ProviderNameChain is the name of the provider screening against the restricted address list of PellChain
+	ProviderNameChain = "chain"
+
+	// ProviderNameHTTP is the name of the provider screening through an HTTP endpoint
+	ProviderNameHTTP = "http"
+)
+
+// Provider screens addresses for compliance
+type Provider interface {
+	// Name returns the name of the provider, it is recorded in the audit log
+	Name() string
+
+	// IsRestricted returns true if the address is restricted
+	// Note: the address can be either an ETH or a BTC address
+	IsRestricted(address string) (bool, error)
+}
+
+var _ Provider = ConfigProvider{}
+
+// ConfigProvider screens addresses against the restricted addresses of the relayer config file
+type ConfigProvider struct{}
+
+// NewConfigProvider returns a new config provider
+func NewConfigProvider() ConfigProvider {
+	return ConfigProvider{}
+}
+
+// Name returns the name of the provider
+func (ConfigProvider) Name() string {
+	return ProviderNameConfig
+}
+
+// IsRestricted returns true if the address is in the restricted addresses of the relayer config file
+func (ConfigProvider) IsRestricted(address string) (bool, error) {
+	return config.ContainRestrictedAddress(address), nil
+}
@@ -0,0 +1,127 @@
+package compliance
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+const (
+	// DefaultChainRefreshInterval is the default interval at which the restricted address list is fetched from PellChain
+	DefaultChainRefreshInterval = 30 * time.Second
+
+	// chainQueryTimeout is the timeout of the query of the restricted address list
+	chainQueryTimeout = 10 * time.Second
+)
+
+// RestrictedAddressesGetter fetches the restricted address list maintained on PellChain
+type RestrictedAddressesGetter interface {
+	GetRestrictedAddresses(ctx context.Context) ([]string, error)
+}
+
+var _ Provider = &ChainProvider{}
+
+// ChainProvider screens addresses against the restricted address list maintained on PellChain
+// the list is fetched periodically, the last fetched list is used if PellChain can't be reached
+// and the screening fails until a list has been fetched
+type ChainProvider struct {
+	client          RestrictedAddressesGetter
+	refreshInterval time.Duration
+	logger          zerolog.Logger
+
+	mu          sync.RWMutex
+	addresses   map[string]bool
+	lastRefresh time.Time
+	lastAttempt time.Time
+
+	// now returns the current time, it can be overridden in tests
+	now func() time.Time
+}
+
+// NewChainProvider returns a new chain provider
+// the list is fetched lazily on the first screening
+func NewChainProvider(client RestrictedAddressesGetter, refreshInterval time.Duration, logger zerolog.Logger) *ChainProvider {
+	if refreshInterval <= 0 {
+		refreshInterval = DefaultChainRefreshInterval
+	}
+
+	return &ChainProvider{
+		client:          client,
+		refreshInterval: refreshInterval,
+		logger:          logger.With().Str("module", "compliance").Str("provider", ProviderNameChain).Logger(),
+		now:             time.Now,
+	}
+}
+
+// Name returns the name of the provider
+func (p *ChainProvider) Name() string {
+	return ProviderNameChain
+}
+
+// IsRestricted returns true if the address is in the restricted address list of PellChain
+// an error is returned as long as the list has never been fetched
+func (p *ChainProvider) IsRestricted(address string) (bool, error) {
+	if err := p.refreshIfStale(); err != nil {
+		return false, err
+	}
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if p.addresses == nil {
+		return false, errors.New("restricted address list not fetched from pellcore yet")
+	}
+	return p.addresses[normalizeAddress(address)], nil
+}
+
+// refreshIfStale fetches the restricted address list if the refresh interval has elapsed
+// a failed fetch is not attempted again before the next interval
+func (p *ChainProvider) refreshIfStale() error {
+	p.mu.RLock()
+	now := p.now()
+	stale := now.Sub(p.lastRefresh) >= p.refreshInterval && now.Sub(p.lastAttempt) >= p.refreshInterval
+	loaded := p.addresses != nil
+	p.mu.RUnlock()
+
+	if !stale {
+		return nil
+	}
+
+	err := p.Refresh(context.Background())
+	if err != nil && loaded {
+		// keep using the last fetched list
+		p.logger.Error().Err(err).Msg("unable to refresh restricted address list, using last fetched list")
+		return nil
+	}
+
+	return err
+}
+
+// Refresh fetches the restricted address list from PellChain
+func (p *ChainProvider) Refresh(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, chainQueryTimeout)
+	defer cancel()
+
+	p.mu.Lock()
+	p.lastAttempt = p.now()
+	p.mu.Unlock()
+
+	list, err := p.client.GetRestrictedAddresses(ctx)
+	if err != nil {
+		return errors.Wrap(err, "unable to get restricted addresses from pellcore")
+	}
+
+	addresses := make(map[string]bool, len(list))
+	for _, address := range list {
+		addresses[normalizeAddress(address)] = true
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.addresses = addresses
+	p.lastRefresh = p.now()
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package compliance
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// mockRestrictedAddressesGetter returns a static restricted address list
+type mockRestrictedAddressesGetter struct {
+	addresses []string
+	err       error
+	calls     int
+}
+
+func (m *mockRestrictedAddressesGetter) GetRestrictedAddresses(_ context.Context) ([]string, error) {
+	m.calls++
+	return m.addresses, m.err
+}
+
+func TestChainProvider(t *testing.T) {
+	address1 := "0x8531a5aB847ff5B22D855633C25ED1DA3255247e"
+	address2 := "0x27104b8dB4aEdDb054fCed87c346C0758Ff5dFB1"
+
+	t.Run("should screen against the on-chain list", func(t *testing.T) {
+		client := &mockRestrictedAddressesGetter{addresses: []string{"0x8531a5ab847ff5b22d855633c25ed1da3255247e"}}
+		p := NewChainProvider(client, time.Minute, zerologNop)
+
+		restricted, err := p.IsRestricted(address1)
+		require.NoError(t, err)
+		require.True(t, restricted)
+
+		restricted, err = p.IsRestricted(address2)
+		require.NoError(t, err)
+		require.False(t, restricted)
+
+		// the list is fetched once per interval
+		require.Equal(t, 1, client.calls)
+	})
+
+	t.Run("should refresh the list after the interval", func(t *testing.T) {
+		client := &mockRestrictedAddressesGetter{addresses: []string{address1}}
+		p := NewChainProvider(client, time.Minute, zerologNop)
+		now := time.Now()
+		p.now = func() time.Time { return now }
+
+		restricted, err := p.IsRestricted(address2)
+		require.NoError(t, err)
+		require.False(t, restricted)
+
+		client.addresses = []string{address2}
+		now = now.Add(2 * time.Minute)
+
+		restricted, err = p.IsRestricted(address2)
+		require.NoError(t, err)
+		require.True(t, restricted)
+		require.Equal(t, 2, client.calls)
+	})
+
+	t.Run("should fail if the list has never been fetched", func(t *testing.T) {
+		client := &mockRestrictedAddressesGetter{err: errors.New("pellcore unavailable")}
+		p := NewChainProvider(client, time.Minute, zerologNop)
+
+		now := time.Now()
+		p.now = func() time.Time { return now }
+
+		_, err := p.IsRestricted(address1)
+		require.ErrorContains(t, err, "pellcore unavailable")
+
+		// not retried before the next interval but still failing
+		_, err = p.IsRestricted(address1)
+		require.ErrorContains(t, err, "not fetched")
+		require.Equal(t, 1, client.calls)
+
+		// retried after the interval
+		client.err = nil
+		client.addresses = []string{address1}
+		now = now.Add(2 * time.Minute)
+
+		restricted, err := p.IsRestricted(address1)
+		require.NoError(t, err)
+		require.True(t, restricted)
+		require.Equal(t, 2, client.calls)
+	})
+
+	t.Run("should keep using the last fetched list on failure", func(t *testing.T) {
+		client := &mockRestrictedAddressesGetter{addresses: []string{address1}}
+		p := NewChainProvider(client, time.Minute, zerologNop)
+		now := time.Now()
+		p.now = func() time.Time { return now }
+		require.NoError(t, p.Refresh(context.Background()))
+
+		client.err = errors.New("pellcore unavailable")
+		now = now.Add(2 * time.Minute)
+
+		restricted, err := p.IsRestricted(address1)
+		require.NoError(t, err)
+		require.True(t, restricted)
+		require.Equal(t, 2, client.calls)
+
+		// the failed refresh is retried after the interval
+		client.err = nil
+		client.addresses = []string{address2}
+		now = now.Add(2 * time.Minute)
+
+		restricted, err = p.IsRestricted(address2)
+		require.NoError(t, err)
+		require.True(t, restricted)
+		require.Equal(t, 3, client.calls)
+	})
+}
@@ -0,0 +1,159 @@
+package compliance
+
+import (
+	"encoding/csv"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+// DefaultFileReloadInterval is the default interval at which the restricted address file is checked for changes
+const DefaultFileReloadInterval = 10 * time.Second
+
+var _ Provider = &FileProvider{}
+
+// FileProvider screens addresses against a file listing the restricted addresses
+// the file is reloaded when it changes, so the list can be updated without restarting the relayer
+//
+// The file is a CSV file where the first column is the address, other columns are ignored.
+// A plain list with one address per line is also accepted. Empty lines and lines starting with '#' are ignored.
+type FileProvider struct {
+	path           string
+	reloadInterval time.Duration
+	logger         zerolog.Logger
+
+	mu        sync.RWMutex
+	addresses map[string]bool
+	modTime   time.Time
+	lastCheck time.Time
+
+	// now returns the current time, it can be overridden in tests
+	now func() time.Time
+}
+
+// NewFileProvider returns a new file provider, the file is loaded on creation
+func NewFileProvider(path string, reloadInterval time.Duration, logger zerolog.Logger) (*FileProvider, error) {
+	if reloadInterval <= 0 {
+		reloadInterval = DefaultFileReloadInterval
+	}
+
+	p := &FileProvider{
+		path:           path,
+		reloadInterval: reloadInterval,
+		logger:         logger.With().Str("module", "compliance").Str("provider", ProviderNameFile).Logger(),
+		now:            time.Now,
+	}
+	if err := p.reload(); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// Name returns the name of the provider
+func (p *FileProvider) Name() string {
+	return ProviderNameFile
+}
+
+// IsRestricted returns true if the address is listed in the file
+func (p *FileProvider) IsRestricted(address string) (bool, error) {
+	p.reloadIfChanged()
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.addresses[normalizeAddress(address)], nil
+}
+
+// reloadIfChanged reloads the file if it has been modified since the last load
+// the previous list is kept if the file can't be reloaded
+func (p *FileProvider) reloadIfChanged() {
+	p.mu.Lock()
+	now := p.now()
+	if now.Sub(p.lastCheck) < p.reloadInterval {
+		p.mu.Unlock()
+		return
+	}
+	p.lastCheck = now
+	modTime := p.modTime
+	p.mu.Unlock()
+
+	info, err := os.Stat(p.path)
+	if err != nil {
+		p.logger.Error().Err(err).Msgf("unable to stat restricted address file %s", p.path)
+		return
+	}
+	if info.ModTime().Equal(modTime) {
+		return
+	}
+
+	if err := p.reload(); err != nil {
+		p.logger.Error().Err(err).Msgf("unable to reload restricted address file %s", p.path)
+		return
+	}
+}
+
+// reload loads the addresses of the file
+func (p *FileProvider) reload() error {
+	file, err := os.Open(p.path)
+	if err != nil {
+		return errors.Wrapf(err, "unable to open restricted address file %s", p.path)
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return errors.Wrapf(err, "unable to stat restricted address file %s", p.path)
+	}
+
+	addresses, err := parseAddressFile(file)
+	if err != nil {
+		return errors.Wrapf(err, "unable to parse restricted address file %s", p.path)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.addresses = addresses
+	p.modTime = info.ModTime()
+	p.lastCheck = p.now()
+
+	p.logger.Info().Msgf("loaded %d restricted addresses from %s", len(addresses), p.path)
+	return nil
+}
+
+// parseAddressFile parses the restricted addresses of a CSV file
+func parseAddressFile(r io.Reader) (map[string]bool, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	addresses := make(map[string]bool)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		address := normalizeAddress(record[0])
+		// skip empty lines and header
+		if address == "" || address == "address" {
+			continue
+		}
+		addresses[address] = true
+	}
+
+	return addresses, nil
+}
+
+// normalizeAddress returns the normalized form of an address used for comparison
+func normalizeAddress(address string) string {
+	return strings.ToLower(strings.TrimSpace(address))
+}
@@ -0,0 +1,105 @@
+package compliance
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+var zerologNop = zerolog.Nop()
+
+// writeAddressFile writes a restricted address file with the given lines and returns its path
+func writeAddressFile(t *testing.T, lines ...string) string {
+	path := filepath.Join(t.TempDir(), "restricted.csv")
+	require.NoError(t, os.WriteFile(path, []byte(strings.Join(lines, "\n")), 0600))
+	return path
+}
+
+func TestFileProvider(t *testing.T) {
+	address1 := "0x8531a5aB847ff5B22D855633C25ED1DA3255247e"
+	address2 := "0x27104b8dB4aEdDb054fCed87c346C0758Ff5dFB1"
+	btcAddress := "bc1qxy2kgdygjrsqtzq2n0yrf2493p83kkfjhx0wlh"
+
+	t.Run("should load plain list of addresses", func(t *testing.T) {
+		path := writeAddressFile(t, "# restricted addresses", address1, "", btcAddress)
+		p, err := NewFileProvider(path, time.Minute, zerologNop)
+		require.NoError(t, err)
+
+		for address, expected := range map[string]bool{
+			address1:                  true,
+			strings.ToLower(address1): true,
+			btcAddress:                true,
+			address2:                  false,
+		} {
+			restricted, err := p.IsRestricted(address)
+			require.NoError(t, err)
+			require.Equal(t, expected, restricted, address)
+		}
+	})
+
+	t.Run("should load csv with header and extra columns", func(t *testing.T) {
+		path := writeAddressFile(t, "address,label", address1+",sanctioned", address2+", hacker")
+		p, err := NewFileProvider(path, time.Minute, zerologNop)
+		require.NoError(t, err)
+
+		restricted, err := p.IsRestricted(address2)
+		require.NoError(t, err)
+		require.True(t, restricted)
+
+		restricted, err = p.IsRestricted("sanctioned")
+		require.NoError(t, err)
+		require.False(t, restricted)
+	})
+
+	t.Run("should fail if file does not exist", func(t *testing.T) {
+		_, err := NewFileProvider(filepath.Join(t.TempDir(), "missing.csv"), time.Minute, zerologNop)
+		require.ErrorContains(t, err, "unable to open restricted address file")
+	})
+
+	t.Run("should reload file on change after reload interval", func(t *testing.T) {
+		path := writeAddressFile(t, address1)
+		p, err := NewFileProvider(path, time.Minute, zerologNop)
+		require.NoError(t, err)
+
+		now := time.Now()
+		p.now = func() time.Time { return now }
+
+		// update the file
+		require.NoError(t, os.WriteFile(path, []byte(address2), 0600))
+		require.NoError(t, os.Chtimes(path, now.Add(time.Second), now.Add(time.Second)))
+
+		// not reloaded before the interval
+		restricted, err := p.IsRestricted(address2)
+		require.NoError(t, err)
+		require.False(t, restricted)
+
+		// reloaded after the interval
+		now = now.Add(2 * time.Minute)
+		restricted, err = p.IsRestricted(address2)
+		require.NoError(t, err)
+		require.True(t, restricted)
+
+		restricted, err = p.IsRestricted(address1)
+		require.NoError(t, err)
+		require.False(t, restricted)
+	})
+
+	t.Run("should keep previous list if file is removed", func(t *testing.T) {
+		path := writeAddressFile(t, address1)
+		p, err := NewFileProvider(path, time.Minute, zerologNop)
+		require.NoError(t, err)
+
+		now := time.Now()
+		p.now = func() time.Time { return now.Add(2 * time.Minute) }
+		require.NoError(t, os.Remove(path))
+
+		restricted, err := p.IsRestricted(address1)
+		require.NoError(t, err)
+		require.True(t, restricted)
+	})
+}
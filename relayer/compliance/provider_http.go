@@ -0,0 +1,135 @@
+package compliance
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultHTTPCacheTTL is the default duration during which a screening result is cached
+	DefaultHTTPCacheTTL = 10 * time.Minute
+
+	// DefaultHTTPTimeout is the default timeout of a screening request
+	DefaultHTTPTimeout = 5 * time.Second
+)
+
+// HTTPScreeningResponse is the response of the screening endpoint
+type HTTPScreeningResponse struct {
+	Restricted bool `json:"restricted"`
+}
+
+// httpCacheEntry is a cached screening result
+type httpCacheEntry struct {
+	restricted bool
+	expiry     time.Time
+}
+
+var _ Provider = &HTTPProvider{}
+
+// HTTPProvider screens addresses through an HTTP screening endpoint
+// the endpoint is queried with GET <endpoint>?address=<address> and returns {"restricted": bool}
+// screening results are cached for the configured TTL, errors are not cached
+type HTTPProvider struct {
+	endpoint string
+	client   *http.Client
+	cacheTTL time.Duration
+
+	mu    sync.Mutex
+	cache map[string]httpCacheEntry
+
+	// now returns the current time, it can be overridden in tests
+	now func() time.Time
+}
+
+// NewHTTPProvider returns a new HTTP provider
+func NewHTTPProvider(endpoint string, cacheTTL time.Duration) (*HTTPProvider, error) {
+	if _, err := url.ParseRequestURI(endpoint); err != nil {
+		return nil, errors.Wrapf(err, "invalid screening endpoint %s", endpoint)
+	}
+	if cacheTTL <= 0 {
+		cacheTTL = DefaultHTTPCacheTTL
+	}
+
+	return &HTTPProvider{
+		endpoint: endpoint,
+		client:   &http.Client{Timeout: DefaultHTTPTimeout},
+		cacheTTL: cacheTTL,
+		cache:    make(map[string]httpCacheEntry),
+		now:      time.Now,
+	}, nil
+}
+
+// Name returns the name of the provider
+func (p *HTTPProvider) Name() string {
+	return ProviderNameHTTP
+}
+
+// IsRestricted returns true if the screening endpoint restricts the address
+func (p *HTTPProvider) IsRestricted(address string) (bool, error) {
+	address = normalizeAddress(address)
+
+	if restricted, found := p.getCached(address); found {
+		return restricted, nil
+	}
+
+	restricted, err := p.query(address)
+	if err != nil {
+		return false, err
+	}
+
+	p.mu.Lock()
+	p.cache[address] = httpCacheEntry{restricted: restricted, expiry: p.now().Add(p.cacheTTL)}
+	p.mu.Unlock()
+
+	return restricted, nil
+}
+
+// getCached returns the cached screening result of the address if not expired
+func (p *HTTPProvider) getCached(address string) (bool, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	entry, found := p.cache[address]
+	if !found {
+		return false, false
+	}
+	if !p.now().Before(entry.expiry) {
+		delete(p.cache, address)
+		return false, false
+	}
+	return entry.restricted, true
+}
+
+// query queries the screening endpoint for the address
+func (p *HTTPProvider) query(address string) (bool, error) {
+	reqURL, err := url.Parse(p.endpoint)
+	if err != nil {
+		return false, err
+	}
+	query := reqURL.Query()
+	query.Set("address", address)
+	reqURL.RawQuery = query.Encode()
+
+	resp, err := p.client.Get(reqURL.String())
+	if err != nil {
+		return false, errors.Wrap(err, "screening request failed")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("screening request failed with status %d", resp.StatusCode)
+	}
+
+	var res HTTPScreeningResponse
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return false, errors.Wrap(err, "unable to decode screening response")
+	}
+
+	return res.Restricted, nil
+}
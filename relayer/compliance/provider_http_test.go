@@ -0,0 +1,85 @@
+package compliance
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newScreeningServer creates a screening endpoint restricting the given address and counting the requests
+func newScreeningServer(t *testing.T, restrictedAddress string, status int, requests *int) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		if status != http.StatusOK {
+			w.WriteHeader(status)
+			return
+		}
+		res := HTTPScreeningResponse{Restricted: r.URL.Query().Get("address") == restrictedAddress}
+		require.NoError(t, json.NewEncoder(w).Encode(res))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestHTTPProvider(t *testing.T) {
+	address1 := "0x8531a5aB847ff5B22D855633C25ED1DA3255247e"
+	address2 := "0x27104b8dB4aEdDb054fCed87c346C0758Ff5dFB1"
+
+	t.Run("should screen through the endpoint", func(t *testing.T) {
+		var requests int
+		server := newScreeningServer(t, "0x8531a5ab847ff5b22d855633c25ed1da3255247e", http.StatusOK, &requests)
+		p, err := NewHTTPProvider(server.URL, time.Minute)
+		require.NoError(t, err)
+
+		restricted, err := p.IsRestricted(address1)
+		require.NoError(t, err)
+		require.True(t, restricted)
+
+		restricted, err = p.IsRestricted(address2)
+		require.NoError(t, err)
+		require.False(t, restricted)
+		require.Equal(t, 2, requests)
+	})
+
+	t.Run("should cache results until expiry", func(t *testing.T) {
+		var requests int
+		server := newScreeningServer(t, "", http.StatusOK, &requests)
+		p, err := NewHTTPProvider(server.URL, time.Minute)
+		require.NoError(t, err)
+		now := time.Now()
+		p.now = func() time.Time { return now }
+
+		for i := 0; i < 3; i++ {
+			_, err = p.IsRestricted(address1)
+			require.NoError(t, err)
+		}
+		require.Equal(t, 1, requests)
+
+		now = now.Add(2 * time.Minute)
+		_, err = p.IsRestricted(address1)
+		require.NoError(t, err)
+		require.Equal(t, 2, requests)
+	})
+
+	t.Run("should fail and not cache on endpoint error", func(t *testing.T) {
+		var requests int
+		server := newScreeningServer(t, "", http.StatusInternalServerError, &requests)
+		p, err := NewHTTPProvider(server.URL, time.Minute)
+		require.NoError(t, err)
+
+		_, err = p.IsRestricted(address1)
+		require.ErrorContains(t, err, "status 500")
+		_, err = p.IsRestricted(address1)
+		require.Error(t, err)
+		require.Equal(t, 2, requests)
+	})
+
+	t.Run("should fail on invalid endpoint", func(t *testing.T) {
+		_, err := NewHTTPProvider("invalid", time.Minute)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,151 @@
+package compliance
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+
+	"github.com/0xPellNetwork/aegis/relayer/config"
+)
+
+// Verdict is the outcome of a compliance screening
+type Verdict string
+
+const (
+	// VerdictAllowed means no address is restricted
+	VerdictAllowed Verdict = "allowed"
+
+	// VerdictRestricted means at least one address is restricted
+	VerdictRestricted Verdict = "restricted"
+
+	// VerdictScreeningFailed means the screening failed for at least one provider and no address is restricted
+	VerdictScreeningFailed Verdict = "screening_failed"
+)
+
+// Decision is the result of the screening of a set of addresses
+type Decision struct {
+	// Verdict is the outcome of the screening
+	Verdict Verdict
+
+	// Provider is the name of the provider that restricted the address, empty if not restricted
+	Provider string
+
+	// Address is the restricted address, empty if not restricted
+	Address string
+
+	// Errors contains the screening errors of the providers
+	Errors []string
+}
+
+// IsRestricted returns true if the screened tx must be blocked
+// a failed screening blocks the tx only if the screener fails closed
+func (d Decision) IsRestricted(failClosed bool) bool {
+	switch d.Verdict {
+	case VerdictRestricted:
+		return true
+	case VerdictScreeningFailed:
+		return failClosed
+	default:
+		return false
+	}
+}
+
+// Screener screens addresses against a list of providers
+// an address is restricted as soon as one of the providers restricts it
+type Screener struct {
+	providers []Provider
+
+	// failClosed defines whether the txs are blocked when the screening fails
+	failClosed bool
+}
+
+// NewScreener returns a new screener with the given providers
+func NewScreener(failClosed bool, providers ...Provider) *Screener {
+	return &Screener{
+		providers:  providers,
+		failClosed: failClosed,
+	}
+}
+
+// NewScreenerFromConfig returns a new screener with the providers enabled in the compliance config
+// the restricted addresses of the config file are always screened
+func NewScreenerFromConfig(
+	cfg config.ComplianceConfig,
+	client RestrictedAddressesGetter,
+	logger zerolog.Logger,
+) (*Screener, error) {
+	providers := []Provider{NewConfigProvider()}
+
+	if cfg.RestrictedAddressesFile != "" {
+		fileProvider, err := NewFileProvider(config.GetPath(cfg.RestrictedAddressesFile), DefaultFileReloadInterval, logger)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to create file provider")
+		}
+		providers = append(providers, fileProvider)
+	}
+
+	if cfg.OnChainRestrictedAddresses {
+		if client == nil {
+			return nil, errors.New("pellcore client is required to screen against the on-chain restricted address list")
+		}
+		providers = append(providers, NewChainProvider(client, DefaultChainRefreshInterval, logger))
+	}
+
+	if cfg.ScreeningEndpoint != "" {
+		// #nosec G701 always in range
+		httpProvider, err := NewHTTPProvider(cfg.ScreeningEndpoint, time.Duration(cfg.ScreeningCacheTTL)*time.Second)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to create http provider")
+		}
+		providers = append(providers, httpProvider)
+	}
+
+	return NewScreener(cfg.FailClosed, providers...), nil
+}
+
+// FailClosed returns true if the txs are blocked when the screening fails
+func (s *Screener) FailClosed() bool {
+	return s.failClosed
+}
+
+// Providers returns the names of the providers of the screener
+func (s *Screener) Providers() []string {
+	names := make([]string, 0, len(s.providers))
+	for _, p := range s.providers {
+		names = append(names, p.Name())
+	}
+	return names
+}
+
+// Screen screens the given addresses, empty addresses are ignored
+func (s *Screener) Screen(addresses ...string) Decision {
+	var errs []string
+	for _, address := range addresses {
+		if strings.TrimSpace(address) == "" {
+			continue
+		}
+		for _, p := range s.providers {
+			restricted, err := p.IsRestricted(address)
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("%s: %s", p.Name(), err.Error()))
+				continue
+			}
+			if restricted {
+				return Decision{
+					Verdict:  VerdictRestricted,
+					Provider: p.Name(),
+					Address:  address,
+					Errors:   errs,
+				}
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return Decision{Verdict: VerdictScreeningFailed, Errors: errs}
+	}
+	return Decision{Verdict: VerdictAllowed}
+}
@@ -0,0 +1,130 @@
+package compliance
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/relayer/config"
+	"github.com/0xPellNetwork/aegis/testutil/sample"
+)
+
+// mockProvider is a provider restricting a static list of addresses
+type mockProvider struct {
+	name       string
+	restricted map[string]bool
+	err        error
+}
+
+func newMockProvider(name string, restricted ...string) *mockProvider {
+	p := &mockProvider{name: name, restricted: make(map[string]bool)}
+	for _, address := range restricted {
+		p.restricted[strings.ToLower(address)] = true
+	}
+	return p
+}
+
+func newFailingProvider(name string) *mockProvider {
+	return &mockProvider{name: name, err: errors.New("provider unavailable")}
+}
+
+func (p *mockProvider) Name() string {
+	return p.name
+}
+
+func (p *mockProvider) IsRestricted(address string) (bool, error) {
+	if p.err != nil {
+		return false, p.err
+	}
+	return p.restricted[strings.ToLower(address)], nil
+}
+
+func TestScreener_Screen(t *testing.T) {
+	address1 := sample.EthAddress().Hex()
+	address2 := sample.EthAddress().Hex()
+
+	t.Run("should allow addresses not restricted", func(t *testing.T) {
+		s := NewScreener(false, newMockProvider("mock1"), newMockProvider("mock2"))
+		require.Equal(t, Decision{Verdict: VerdictAllowed}, s.Screen(address1, address2))
+	})
+
+	t.Run("should restrict address restricted by any provider", func(t *testing.T) {
+		s := NewScreener(false, newMockProvider("mock1"), newMockProvider("mock2", strings.ToLower(address2)))
+		require.Equal(t, Decision{
+			Verdict:  VerdictRestricted,
+			Provider: "mock2",
+			Address:  address2,
+		}, s.Screen(address1, address2))
+	})
+
+	t.Run("should ignore empty addresses", func(t *testing.T) {
+		s := NewScreener(false, newMockProvider("mock", ""))
+		require.Equal(t, Decision{Verdict: VerdictAllowed}, s.Screen("", " "))
+	})
+
+	t.Run("should report screening failure if no provider restricts the address", func(t *testing.T) {
+		s := NewScreener(false, newFailingProvider("failing"), newMockProvider("mock"))
+		decision := s.Screen(address1)
+		require.Equal(t, VerdictScreeningFailed, decision.Verdict)
+		require.Equal(t, []string{"failing: provider unavailable"}, decision.Errors)
+	})
+
+	t.Run("should restrict address even if another provider fails", func(t *testing.T) {
+		s := NewScreener(false, newFailingProvider("failing"), newMockProvider("mock", address1))
+		decision := s.Screen(address1)
+		require.Equal(t, VerdictRestricted, decision.Verdict)
+		require.Equal(t, "mock", decision.Provider)
+		require.Len(t, decision.Errors, 1)
+	})
+}
+
+func TestDecision_IsRestricted(t *testing.T) {
+	require.False(t, Decision{Verdict: VerdictAllowed}.IsRestricted(true))
+	require.True(t, Decision{Verdict: VerdictRestricted}.IsRestricted(false))
+	require.False(t, Decision{Verdict: VerdictScreeningFailed}.IsRestricted(false))
+	require.True(t, Decision{Verdict: VerdictScreeningFailed}.IsRestricted(true))
+}
+
+func TestNewScreenerFromConfig(t *testing.T) {
+	t.Run("should create screener with config provider only by default", func(t *testing.T) {
+		s, err := NewScreenerFromConfig(config.ComplianceConfig{}, nil, zerologNop)
+		require.NoError(t, err)
+		require.Equal(t, []string{ProviderNameConfig}, s.Providers())
+		require.False(t, s.FailClosed())
+	})
+
+	t.Run("should create screener with all providers", func(t *testing.T) {
+		s, err := NewScreenerFromConfig(config.ComplianceConfig{
+			RestrictedAddressesFile:    writeAddressFile(t, "0x8531a5aB847ff5B22D855633C25ED1DA3255247e"),
+			OnChainRestrictedAddresses: true,
+			ScreeningEndpoint:          "http://localhost:8080/screen",
+			FailClosed:                 true,
+		}, &mockRestrictedAddressesGetter{}, zerologNop)
+		require.NoError(t, err)
+		require.Equal(t, []string{ProviderNameConfig, ProviderNameFile, ProviderNameChain, ProviderNameHTTP}, s.Providers())
+		require.True(t, s.FailClosed())
+	})
+
+	t.Run("should fail if file can't be loaded", func(t *testing.T) {
+		_, err := NewScreenerFromConfig(config.ComplianceConfig{
+			RestrictedAddressesFile: "/non/existent/file.csv",
+		}, nil, zerologNop)
+		require.ErrorContains(t, err, "unable to create file provider")
+	})
+
+	t.Run("should fail if on-chain list is enabled without client", func(t *testing.T) {
+		_, err := NewScreenerFromConfig(config.ComplianceConfig{
+			OnChainRestrictedAddresses: true,
+		}, nil, zerologNop)
+		require.ErrorContains(t, err, "pellcore client is required")
+	})
+
+	t.Run("should fail if screening endpoint is invalid", func(t *testing.T) {
+		_, err := NewScreenerFromConfig(config.ComplianceConfig{
+			ScreeningEndpoint: "invalid",
+		}, nil, zerologNop)
+		require.ErrorContains(t, err, "unable to create http provider")
+	})
+}
@@ -20,6 +20,12 @@ const (
 	// and the latest indexed block height on chain. The scanning process will stop when
 	// this threshold is exceeded to prevent invalid votes.
 	DefaultMaxLatestIndexedBlockGap = uint64(2000)
+
+	// DefaultGasBumpPercent is the default percentage by which the gas price of a stuck outbound is increased
+	DefaultGasBumpPercent = uint64(20)
+
+	// DefaultReplaceAfterBlocks is the default number of pell blocks after which a pending outbound is replaced
+	DefaultReplaceAfterBlocks = uint64(100)
 )
 
 func GetPellConnectorABI() string {
@@ -28,13 +28,42 @@ type ClientConfiguration struct {
 }
 
 type EVMConfig struct {
-	Chain            chains.Chain
-	Endpoint         string
+	Chain    chains.Chain
+	Endpoint string
+	// Endpoints are the fallback RPC endpoints of the chain, the relayer fails over to them
+	// in the given order when the primary endpoint is unhealthy
+	Endpoints        []string
 	ForceStartHeight uint64 // If it is not equal to 0.  scan starts from this height
 	// MaxLatestIndexedBlockGap defines the maximum allowed gap between the current block
 	// and the latest indexed block height on chain. The scanning process will stop when
 	// this threshold is exceeded to prevent invalid votes.
 	MaxLatestIndexedBlockGap uint64
+	// RPCQuorum is the number of endpoints that must return the same receipt or block header
+	// before the relayer votes on it, 0 or 1 means a single endpoint is trusted
+	RPCQuorum int
+	// GasBumpPercent is the percentage by which the gas price of a stuck outbound is increased when it is replaced,
+	// the nodes require at least 10%. It must be the same for all the relayers since replacements are signed by TSS
+	GasBumpPercent uint64
+	// MaxGasPrice is the maximum gas price in wei of a replacement outbound, 0 means no cap
+	MaxGasPrice uint64
+	// ReplaceAfterBlocks is the number of pell blocks after which a pending outbound is replaced with bumped gas.
+	// It must be the same for all the relayers since replacements are signed by TSS
+	ReplaceAfterBlocks uint64
+}
+
+// GetEndpoints returns the primary endpoint followed by the fallback endpoints, empty and duplicated endpoints are ignored
+func (c EVMConfig) GetEndpoints() []string {
+	seen := make(map[string]bool)
+	endpoints := make([]string, 0, len(c.Endpoints)+1)
+	for _, endpoint := range append([]string{c.Endpoint}, c.Endpoints...) {
+		endpoint = strings.TrimSpace(endpoint)
+		if endpoint == "" || seen[endpoint] {
+			continue
+		}
+		seen[endpoint] = true
+		endpoints = append(endpoints, endpoint)
+	}
+	return endpoints
 }
 
 type BTCConfig struct {
@@ -48,6 +77,34 @@ type BTCConfig struct {
 type ComplianceConfig struct {
 	LogPath             string   `json:"LogPath"`
 	RestrictedAddresses []string `json:"RestrictedAddresses"`
+
+	// RestrictedAddressesFile is the path of a CSV file listing restricted addresses, the file is reloaded on change
+	RestrictedAddressesFile string `json:"RestrictedAddressesFile"`
+
+	// OnChainRestrictedAddresses enables the screening against the restricted address list maintained on PellChain
+	OnChainRestrictedAddresses bool `json:"OnChainRestrictedAddresses"`
+
+	// ScreeningEndpoint is the url of an HTTP screening endpoint
+	ScreeningEndpoint string `json:"ScreeningEndpoint"`
+
+	// ScreeningCacheTTL is the duration (in seconds) during which the results of the screening endpoint are cached
+	ScreeningCacheTTL uint64 `json:"ScreeningCacheTTL"`
+
+	// FailClosed blocks the txs when the screening fails, the txs are allowed otherwise
+	FailClosed bool `json:"FailClosed"`
+}
+
+type AdminAPIConfig struct {
+	// ListenAddress is the address the admin api listens on, it defaults to the loopback interface
+	ListenAddress string `json:"ListenAddress"`
+
+	// Token is the bearer token authenticating the admin requests, the admin api is disabled if empty
+	Token string `json:"Token"`
+}
+
+// IsEnabled returns true if the admin api is enabled
+func (c AdminAPIConfig) IsEnabled() bool {
+	return c.Token != ""
 }
 
 // Config is the config for PellClient
@@ -80,6 +137,8 @@ type Config struct {
 	PellTxMsgLength uint8               `json:"PellTxMsgLength"`
 	// compliance config
 	ComplianceConfig ComplianceConfig `json:"ComplianceConfig"`
+	// admin api config
+	AdminAPIConfig AdminAPIConfig `json:"AdminAPIConfig"`
 }
 
 func NewConfig() Config {
@@ -1 +1,28 @@
 package config_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/relayer/config"
+)
+
+func TestEVMConfig_GetEndpoints(t *testing.T) {
+	t.Run("should return primary endpoint followed by fallback endpoints", func(t *testing.T) {
+		cfg := config.EVMConfig{
+			Endpoint:  "http://primary",
+			Endpoints: []string{"http://fallback1", " http://primary ", "", "http://fallback2", "http://fallback1"},
+		}
+		require.Equal(t, []string{"http://primary", "http://fallback1", "http://fallback2"}, cfg.GetEndpoints())
+	})
+
+	t.Run("should return fallback endpoints if primary endpoint is not set", func(t *testing.T) {
+		cfg := config.EVMConfig{Endpoints: []string{"http://fallback"}}
+		require.Equal(t, []string{"http://fallback"}, cfg.GetEndpoints())
+	})
+
+	t.Run("should return empty list if no endpoint is set", func(t *testing.T) {
+		require.Empty(t, config.EVMConfig{}.GetEndpoints())
+	})
+}
@@ -57,13 +57,14 @@ func (a *AppContext) Update(
 	keygen relayertypes.Keygen,
 	newChains []chains.Chain,
 	evmChainParams map[int64]*relayertypes.ChainParams,
+	btcChainParams *relayertypes.ChainParams,
 	tssPubKey string,
 	crosschainFlags relayertypes.CrosschainFlags,
 	verificationFlags lightclienttypes.VerificationFlags,
 	init bool,
 	logger zerolog.Logger,
 ) error {
-	return a.coreContext.Update(keygen, newChains, evmChainParams, tssPubKey, crosschainFlags, verificationFlags, init, logger)
+	return a.coreContext.Update(keygen, newChains, evmChainParams, btcChainParams, tssPubKey, crosschainFlags, verificationFlags, init, logger)
 }
 
 // WithAppContext applied AppContext to standard Go context.Context.
@@ -79,6 +79,13 @@ func (c *PellCoreContext) GetEnabledChains() []chains.Chain {
 	return copiedChains
 }
 
+// GetEnabledChain returns the enabled chain with the given chain id, the chain is resolved from the chain registry of pellcore
+func (c *PellCoreContext) GetEnabledChain(chainID int64) (chains.Chain, bool) {
+	c.coreContextLock.RLock()
+	defer c.coreContextLock.RUnlock()
+	return chains.GetChainFromList(c.chainsEnabled, chainID)
+}
+
 func (c *PellCoreContext) GetEVMChainParams(chainID int64) (*relayertypes.ChainParams, bool) {
 	c.coreContextLock.RLock()
 	defer c.coreContextLock.RUnlock()
@@ -99,6 +106,24 @@ func (c *PellCoreContext) GetAllEVMChainParams() map[int64]*relayertypes.ChainPa
 	return copied
 }
 
+// GetBTCChainParams returns the bitcoin chain and its params if bitcoin is enabled
+func (c *PellCoreContext) GetBTCChainParams() (chains.Chain, *relayertypes.ChainParams, bool) {
+	c.coreContextLock.RLock()
+	defer c.coreContextLock.RUnlock()
+
+	// bitcoin is not enabled
+	if c.bitcoinChainParams == nil {
+		return chains.Chain{}, nil, false
+	}
+
+	chain, found := chains.GetChainFromList(c.chainsEnabled, c.bitcoinChainParams.ChainId)
+	if !found {
+		return chains.Chain{}, nil, false
+	}
+
+	return chain, c.bitcoinChainParams, true
+}
+
 func (c *PellCoreContext) GetCrossChainFlags() relayertypes.CrosschainFlags {
 	c.coreContextLock.RLock()
 	defer c.coreContextLock.RUnlock()
@@ -111,26 +136,34 @@ func (c *PellCoreContext) GetVerificationFlags() lightclienttypes.VerificationFl
 	return c.verificationFlags
 }
 
-// IsOutboundObservationEnabled returns true if the chain is supported and outbound flag is enabled
+// IsOutboundObservationEnabled returns true if the chain is supported, outbound flag is enabled
+// and outbound is not paused for the chain
 func (c *PellCoreContext) IsOutboundObservationEnabled(chainParams relayertypes.ChainParams) bool {
 	return c.isObservationEnabled(chainParams, func(f relayertypes.CrosschainFlags) bool {
-		return f.IsOutboundEnabled
+		return f.IsOutboundEnabled && !f.IsChainOutboundPaused(chainParams.ChainId)
 	})
 }
 
-// IsInboundObservationEnabled returns true if the chain is supported and inbound flag is enabled
+// IsInboundObservationEnabled returns true if the chain is supported, inbound flag is enabled
+// and inbound is not paused for the chain
 func (c *PellCoreContext) IsInboundObservationEnabled(chainParams relayertypes.ChainParams) bool {
 	return c.isObservationEnabled(chainParams, func(f relayertypes.CrosschainFlags) bool {
-		return f.IsInboundEnabled
+		return f.IsInboundEnabled && !f.IsChainInboundPaused(chainParams.ChainId)
 	})
 }
 
+// IsSigningEnabled returns true if signing is not paused for the chain
+func (c *PellCoreContext) IsSigningEnabled(chainID int64) bool {
+	return !c.GetCrossChainFlags().IsChainSigningPaused(chainID)
+}
+
 // Update updates core context and params for all chains
 // this must be the ONLY function that writes to core context
 func (c *PellCoreContext) Update(
 	keygen relayertypes.Keygen,
 	newChains []chains.Chain,
 	evmChainParams map[int64]*relayertypes.ChainParams,
+	btcChainParams *relayertypes.ChainParams,
 	tssPubKey string,
 	crosschainFlags relayertypes.CrosschainFlags,
 	verificationFlags lightclienttypes.VerificationFlags,
@@ -183,6 +216,11 @@ func (c *PellCoreContext) Update(
 		c.evmChainParams[params.ChainId] = params
 	}
 
+	// update core params for bitcoin if we have a config in file
+	if btcChainParams != nil && c.bitcoinChainParams != nil {
+		c.bitcoinChainParams = btcChainParams
+	}
+
 	if tssPubKey != "" {
 		c.currentTssPubkey = tssPubKey
 	}
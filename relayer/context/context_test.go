@@ -46,6 +46,7 @@ func getTestCoreContext(
 		relayertypes.Keygen{},
 		[]chains.Chain{evmChain},
 		evmChainParamsMap,
+		nil,
 		"",
 		ccFlags,
 		verificationFlags,
@@ -75,6 +76,10 @@ func TestNewPellCoreContext(t *testing.T) {
 		// assert evm chain params
 		allEVMChainParams := pellContext.GetAllEVMChainParams()
 		require.Empty(t, allEVMChainParams)
+
+		// assert btc chain params not found because bitcoin is not specified in config
+		_, _, found := pellContext.GetBTCChainParams()
+		require.False(t, found)
 	})
 
 	t.Run("should create new pell core context with config containing evm chain params", func(t *testing.T) {
@@ -149,6 +154,7 @@ func TestUpdatePellCoreContext(t *testing.T) {
 			keyGenToUpdate,
 			enabledChainsToUpdate,
 			evmChainParamsToUpdate,
+			nil,
 			tssPubKeyToUpdate,
 			*crosschainFlags,
 			verificationFlags,
@@ -212,6 +218,12 @@ func TestUpdatePellCoreContext(t *testing.T) {
 			{
 				Id: 2,
 			},
+			{
+				Id:          18444,
+				Network:     chains.NetWork_BTC,
+				NetworkType: chains.NetWorkType_PRIVNET,
+				VmType:      chains.VMType_NO_VM,
+			},
 		}
 		evmChainParamsToUpdate := map[int64]*relayertypes.ChainParams{
 			1: {
@@ -221,6 +233,9 @@ func TestUpdatePellCoreContext(t *testing.T) {
 				ChainId: 2,
 			},
 		}
+		btcChainParamsToUpdate := &relayertypes.ChainParams{
+			ChainId: 18444,
+		}
 
 		tssPubKeyToUpdate := "tsspubkeytest"
 		crosschainFlags := sample.CrosschainFlags_pell()
@@ -231,6 +246,7 @@ func TestUpdatePellCoreContext(t *testing.T) {
 			keyGenToUpdate,
 			enabledChainsToUpdate,
 			evmChainParamsToUpdate,
+			btcChainParamsToUpdate,
 			tssPubKeyToUpdate,
 			*crosschainFlags,
 			verificationFlags,
@@ -260,6 +276,12 @@ func TestUpdatePellCoreContext(t *testing.T) {
 		require.True(t, found)
 		require.Equal(t, evmChainParamsToUpdate[2], evmChainParams2)
 
+		// assert btc chain params
+		btcChain, btcChainParams, found := pellContext.GetBTCChainParams()
+		require.True(t, found)
+		require.Equal(t, enabledChainsToUpdate[2], btcChain)
+		require.Equal(t, btcChainParamsToUpdate, btcChainParams)
+
 		ccFlags := pellContext.GetCrossChainFlags()
 		require.Equal(t, ccFlags, *crosschainFlags)
 
@@ -296,6 +318,13 @@ func TestIsOutboundObservationEnabled(t *testing.T) {
 		coreCTXDisabled := getTestCoreContext(evmChain, chainParams, flagsDisabled, verificationFlags)
 		require.False(t, coreCTXDisabled.IsOutboundObservationEnabled(*chainParams))
 	})
+	t.Run("should return false if outbound is paused for the chain", func(t *testing.T) {
+		flagsPaused := ccFlags
+		flagsPaused.ChainPauses = []relayertypes.ChainPause{{ChainId: evmChain.Id, IsOutboundPaused: true}}
+		coreCTXPaused := getTestCoreContext(evmChain, chainParams, flagsPaused, verificationFlags)
+		require.False(t, coreCTXPaused.IsOutboundObservationEnabled(*chainParams))
+		require.True(t, coreCTXPaused.IsInboundObservationEnabled(*chainParams))
+	})
 }
 
 func TestIsInboundObservationEnabled(t *testing.T) {
@@ -326,4 +355,33 @@ func TestIsInboundObservationEnabled(t *testing.T) {
 		coreCTXDisabled := getTestCoreContext(evmChain, chainParams, flagsDisabled, verificationFlags)
 		require.False(t, coreCTXDisabled.IsInboundObservationEnabled(*chainParams))
 	})
+	t.Run("should return false if inbound is paused for the chain", func(t *testing.T) {
+		flagsPaused := ccFlags
+		flagsPaused.ChainPauses = []relayertypes.ChainPause{{ChainId: evmChain.Id, IsInboundPaused: true}}
+		coreCTXPaused := getTestCoreContext(evmChain, chainParams, flagsPaused, verificationFlags)
+		require.False(t, coreCTXPaused.IsInboundObservationEnabled(*chainParams))
+		require.True(t, coreCTXPaused.IsOutboundObservationEnabled(*chainParams))
+	})
+}
+
+func TestIsSigningEnabled(t *testing.T) {
+	evmChain := chains.EthChain()
+	ccFlags := *sample.CrosschainFlags_pell()
+	verificationFlags := sample.VerificationFlags()
+	chainParams := &relayertypes.ChainParams{
+		ChainId:     evmChain.Id,
+		IsSupported: true,
+	}
+
+	t.Run("should return true if signing is not paused for the chain", func(t *testing.T) {
+		coreCTX := getTestCoreContext(evmChain, chainParams, ccFlags, verificationFlags)
+		require.True(t, coreCTX.IsSigningEnabled(evmChain.Id))
+	})
+	t.Run("should return false if signing is paused for the chain", func(t *testing.T) {
+		flagsPaused := ccFlags
+		flagsPaused.ChainPauses = []relayertypes.ChainPause{{ChainId: evmChain.Id, IsSigningPaused: true}}
+		coreCTXPaused := getTestCoreContext(evmChain, chainParams, flagsPaused, verificationFlags)
+		require.False(t, coreCTXPaused.IsSigningEnabled(evmChain.Id))
+		require.True(t, coreCTXPaused.IsSigningEnabled(evmChain.Id+1))
+	})
 }
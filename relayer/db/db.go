@@ -31,6 +31,7 @@ var (
 		&types.TransactionSQLType{},
 		&types.ReceiptSQLType{},
 		&types.LastTransactionSQLType{},
+		&types.OutboundJournalSQLType{},
 	}
 )
 
@@ -127,6 +127,20 @@ var (
 		Help:      "Percentage of the rate limiter rate reached",
 	})
 
+	// ChainRateLimitExceeded is a gauge set to 1 if the rate limit of a receiver chain is exceeded
+	ChainRateLimitExceeded = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: PellClientNamespace,
+		Name:      "chain_rate_limit_exceeded",
+		Help:      "Whether the rate limit of a receiver chain is exceeded",
+	}, []string{"chain"})
+
+	// EventRateLimitExceeded is a gauge set to 1 if the rate limit of an event type is exceeded
+	EventRateLimitExceeded = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: PellClientNamespace,
+		Name:      "event_rate_limit_exceeded",
+		Help:      "Whether the rate limit of an event type is exceeded",
+	}, []string{"event_type"})
+
 	// SignLatency is a histogram of of the TSS keysign latency
 	SignLatency = promauto.NewHistogramVec(prometheus.HistogramOpts{
 		Namespace: PellClientNamespace,
@@ -170,6 +184,13 @@ var (
 		Help:      "Status of RPC nodes (1 = online, 0 = offline)",
 	}, []string{"chain"})
 
+	// RPCProviderStatus is a gauge that indicates the status of each RPC provider of a chain
+	RPCProviderStatus = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: PellClientNamespace,
+		Name:      "rpc_provider_status",
+		Help:      "Status of RPC providers (1 = healthy, 0 = unhealthy)",
+	}, []string{"chain", "host"})
+
 	// TssAddressGasBalance is a gauge that tracks the balance of TSS addresses across different chains
 	TssAddressGasBalance = promauto.NewGaugeVec(prometheus.GaugeOpts{
 		Namespace: PellClientNamespace,
@@ -223,15 +244,18 @@ func (m *Metrics) Stop() error {
 	return m.s.Shutdown(ctx)
 }
 
-// GetInstrumentedHTTPClient sets up a http client that emits prometheus metrics
-func GetInstrumentedHTTPClient(endpoint string) (*http.Client, error) {
-	host := endpoint
-	// try to parse as url (so that we do not expose auth uuid in metrics)
+// EndpointHost returns the host of the endpoint so that the auth uuid of the url is not exposed
+func EndpointHost(endpoint string) string {
 	endpointURL, err := url.Parse(endpoint)
-	if err == nil {
-		host = endpointURL.Host
+	if err != nil {
+		return endpoint
 	}
-	labels := prometheus.Labels{"host": host}
+	return endpointURL.Host
+}
+
+// GetInstrumentedHTTPClient sets up a http client that emits prometheus metrics
+func GetInstrumentedHTTPClient(endpoint string) (*http.Client, error) {
+	labels := prometheus.Labels{"host": EndpointHost(endpoint)}
 	rpcCounterMetric, err := RPCCount.CurryWith(labels)
 	if err != nil {
 		return nil, err
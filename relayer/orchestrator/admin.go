@@ -0,0 +1,105 @@
+package orchestrator
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/0xPellNetwork/aegis/relayer/compliance"
+	appcontext "github.com/0xPellNetwork/aegis/relayer/context"
+	"github.com/0xPellNetwork/aegis/relayer/outtxprocessor"
+)
+
+// OutboundStatus is the result of checking an outbound on its receiver chain
+type OutboundStatus struct {
+	ChainID   int64  `json:"chain_id"`
+	Nonce     uint64 `json:"nonce"`
+	XmsgIndex string `json:"xmsg_index"`
+	Included  bool   `json:"included"`
+	Confirmed bool   `json:"confirmed"`
+}
+
+// RescanChain makes the observer of a chain scan the chain again from blockNumber
+func (co *Orchestrator) RescanChain(chainID int64, blockNumber uint64) error {
+	ob, err := co.getObserver(chainID)
+	if err != nil {
+		return err
+	}
+	if err := ob.RescanFrom(blockNumber); err != nil {
+		return errors.Wrapf(err, "unable to rescan chain %d from block %d", chainID, blockNumber)
+	}
+
+	co.logger.Info().Msgf("RescanChain: chain %d is rescanned from block %d", chainID, blockNumber)
+	return nil
+}
+
+// PauseObserver pauses the observation and the outbound scheduling of a chain
+func (co *Orchestrator) PauseObserver(chainID int64) error {
+	ob, err := co.getObserver(chainID)
+	if err != nil {
+		return err
+	}
+	ob.Pause()
+
+	co.logger.Info().Msgf("PauseObserver: observer of chain %d is paused", chainID)
+	return nil
+}
+
+// ResumeObserver resumes the observation and the outbound scheduling of a chain
+func (co *Orchestrator) ResumeObserver(chainID int64) error {
+	ob, err := co.getObserver(chainID)
+	if err != nil {
+		return err
+	}
+	ob.Resume()
+
+	co.logger.Info().Msgf("ResumeObserver: observer of chain %d is resumed", chainID)
+	return nil
+}
+
+// CheckOutboundProcessed checks the outbound of a nonce on its receiver chain and votes it if it is confirmed
+func (co *Orchestrator) CheckOutboundProcessed(ctx context.Context, chainID int64, nonce uint64) (OutboundStatus, error) {
+	ob, err := co.getObserver(chainID)
+	if err != nil {
+		return OutboundStatus{}, err
+	}
+
+	xmsg, err := co.pellcoreClient.GetXmsgByNonce(ctx, chainID, nonce)
+	if err != nil {
+		return OutboundStatus{}, errors.Wrapf(err, "unable to get xmsg of chain %d nonce %d", chainID, nonce)
+	}
+
+	included, confirmed, err := ob.IsOutboundProcessed(ctx, xmsg, co.baseLogger.Std)
+	if err != nil {
+		return OutboundStatus{}, errors.Wrapf(err, "unable to check outbound of chain %d nonce %d", chainID, nonce)
+	}
+
+	return OutboundStatus{
+		ChainID:   chainID,
+		Nonce:     nonce,
+		XmsgIndex: xmsg.Index,
+		Included:  included,
+		Confirmed: confirmed,
+	}, nil
+}
+
+// ActiveOutTxs returns the outbounds being processed by the signers
+func (co *Orchestrator) ActiveOutTxs() []outtxprocessor.ActiveOutTx {
+	return co.outboundProc.ActiveOutTxs()
+}
+
+// ReloadCompliance reloads the compliance config from the config file and returns the enabled screening providers
+func (co *Orchestrator) ReloadCompliance(ctx context.Context) ([]string, error) {
+	app, err := appcontext.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	screener, err := compliance.ReloadScreener(app.Config().PellCoreHome, co.pellcoreClient, co.baseLogger.Std)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to reload compliance config")
+	}
+
+	co.logger.Info().Strs("providers", screener.Providers()).Msg("ReloadCompliance: compliance screening is reloaded")
+	return screener.Providers(), nil
+}
@@ -0,0 +1,50 @@
+package orchestrator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/pkg/chains"
+	"github.com/0xPellNetwork/aegis/relayer/testutils"
+	"github.com/0xPellNetwork/aegis/relayer/testutils/stub"
+	relayertypes "github.com/0xPellNetwork/aegis/x/relayer/types"
+)
+
+func Test_AdminObserverControl(t *testing.T) {
+	evmChain := chains.EthChain()
+	evmChainParams := &relayertypes.ChainParams{
+		ChainId:                          evmChain.Id,
+		ConnectorContractAddress:         testutils.StrategyManagerAddresses[evmChain.Id].Hex(),
+		DelegationManagerContractAddress: testutils.DelegationManagerAddresses[evmChain.Id].Hex(),
+	}
+
+	t.Run("should pause and resume observer", func(t *testing.T) {
+		observer := MockCoreObserver(t, evmChain, evmChainParams)
+
+		require.NoError(t, observer.PauseObserver(evmChain.Id))
+		ob, err := observer.getObserver(evmChain.Id)
+		require.NoError(t, err)
+		require.True(t, ob.IsPaused())
+
+		require.NoError(t, observer.ResumeObserver(evmChain.Id))
+		require.False(t, ob.IsPaused())
+	})
+
+	t.Run("should rescan chain from block", func(t *testing.T) {
+		observer := MockCoreObserver(t, evmChain, evmChainParams)
+
+		require.NoError(t, observer.RescanChain(evmChain.Id, 100))
+		ob, err := observer.getObserver(evmChain.Id)
+		require.NoError(t, err)
+		require.EqualValues(t, 100, ob.(*stub.EVMClient).RescanBlock)
+	})
+
+	t.Run("should fail if observer not found", func(t *testing.T) {
+		observer := MockCoreObserver(t, evmChain, evmChainParams)
+
+		require.ErrorContains(t, observer.PauseObserver(chains.BscMainnetChain().Id), "observer not found")
+		require.ErrorContains(t, observer.ResumeObserver(chains.BscMainnetChain().Id), "observer not found")
+		require.ErrorContains(t, observer.RescanChain(chains.BscMainnetChain().Id, 100), "observer not found")
+	})
+}
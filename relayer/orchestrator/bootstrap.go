@@ -3,13 +3,14 @@ package orchestrator
 import (
 	"context"
 
+	"github.com/btcsuite/btcd/rpcclient"
 	ethcommon "github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/ethclient"
-	ethrpc "github.com/ethereum/go-ethereum/rpc"
-	ethrpc2 "github.com/onrik/ethrpc"
 	"github.com/pkg/errors"
 
+	"github.com/0xPellNetwork/aegis/pkg/chains"
+	btcobserver "github.com/0xPellNetwork/aegis/relayer/chains/bitcoin/observer"
 	evmobserver "github.com/0xPellNetwork/aegis/relayer/chains/evm/observer"
+	evmrpc "github.com/0xPellNetwork/aegis/relayer/chains/evm/rpc"
 	evmsigner "github.com/0xPellNetwork/aegis/relayer/chains/evm/signer"
 	"github.com/0xPellNetwork/aegis/relayer/chains/interfaces"
 	"github.com/0xPellNetwork/aegis/relayer/config"
@@ -17,6 +18,7 @@ import (
 	"github.com/0xPellNetwork/aegis/relayer/db"
 	clientlogs "github.com/0xPellNetwork/aegis/relayer/logs"
 	"github.com/0xPellNetwork/aegis/relayer/metrics"
+	relayertypes "github.com/0xPellNetwork/aegis/x/relayer/types"
 )
 
 // CreateSignerMap creates a map of interfaces.ChainSigner (by chainID) for all chains in the config.
@@ -95,7 +97,9 @@ func syncSignerMap(
 		signer, err := evmsigner.NewEVMSigner(
 			ctx,
 			evmConfig.Chain,
-			evmConfig.Endpoint,
+			evmConfig.GetEndpoints(),
+			evmConfig.RPCQuorum,
+			evmsigner.NewRebroadcastConfig(evmConfig),
 			tss,
 			pellConnectorAddress,
 			logger,
@@ -195,20 +199,18 @@ func syncObserverMap(
 			continue
 		}
 
-		httpClient, err := metrics.GetInstrumentedHTTPClient(evmConfig.Endpoint)
-		if err != nil {
-			logger.Std.Error().Err(err).Str("rpc.endpoint", evmConfig.Endpoint).Msgf("Unable to create HTTP client")
+		endpoints := evmConfig.GetEndpoints()
+		if len(endpoints) == 0 {
+			logger.Std.Error().Msgf("No RPC endpoint configured for EVM chain %d", chainID)
 			continue
 		}
 
-		rpcClient, err := ethrpc.DialHTTPWithClient(evmConfig.Endpoint, httpClient)
+		// the pool serves both the EVM RPC and the JSON RPC calls of the chain with failover between the endpoints
+		evmClient, err := evmrpc.DialPool(endpoints, evmConfig.RPCQuorum)
 		if err != nil {
-			logger.Std.Error().Err(err).Str("rpc.endpoint", evmConfig.Endpoint).Msgf("Unable to dial EVM RPC")
+			logger.Std.Error().Err(err).Msgf("Unable to create EVM RPC pool for chain %d", chainID)
 			continue
 		}
-		evmClient := ethclient.NewClient(rpcClient)
-
-		evmJSONRPCClient := ethrpc2.NewEthRPC(evmConfig.Endpoint, ethrpc2.WithHttpClient(httpClient))
 
 		database, err := db.NewFromSqlite(dbpath, evmConfig.Chain.ChainName(), true)
 		if err != nil {
@@ -221,7 +223,7 @@ func syncObserverMap(
 			evmConfig,
 			*chainParams,
 			evmClient,
-			evmJSONRPCClient,
+			evmClient,
 			pellcoreClient,
 			tss,
 			database,
@@ -235,8 +237,77 @@ func syncObserverMap(
 		addObserver(chainID, observer)
 	}
 
+	// Bitcoin client
+	btcConfig, btcEnabled := appContext.Config().GetBTCConfig()
+	btcChain, btcChainParams, btcFound := appContext.PellCoreContext().GetBTCChainParams()
+	if btcEnabled && btcFound {
+		chainID := btcChain.Id
+		presentChainIDs = append(presentChainIDs, chainID)
+
+		if !mapHas(observers, chainID) {
+			observer, err := createBTCObserver(
+				ctx,
+				btcConfig,
+				btcChain,
+				*btcChainParams,
+				pellcoreClient,
+				tss,
+				dbpath,
+				logger,
+				ts,
+			)
+			if err != nil {
+				logger.Std.Error().Err(err).Msgf("NewBitcoinChainClient error for chain %s", btcChain.String())
+			} else {
+				addObserver(chainID, observer)
+			}
+		}
+	}
+
 	// Remove all disabled observers
 	mapDeleteMissingKeys(observers, presentChainIDs, onBeforeRemove)
 
 	return added, removed, nil
 }
+
+// createBTCObserver creates the observer for the bitcoin chain
+func createBTCObserver(
+	ctx context.Context,
+	btcConfig config.BTCConfig,
+	btcChain chains.Chain,
+	chainParams relayertypes.ChainParams,
+	pellcoreClient interfaces.PellCoreBridger,
+	tss interfaces.TSSSigner,
+	dbpath string,
+	logger clientlogs.Logger,
+	ts *metrics.TelemetryServer,
+) (*btcobserver.ChainClient, error) {
+	btcClient, err := rpcclient.New(&rpcclient.ConnConfig{
+		Host:         btcConfig.RPCHost,
+		User:         btcConfig.RPCUsername,
+		Pass:         btcConfig.RPCPassword,
+		HTTPPostMode: true,
+		DisableTLS:   true,
+		Params:       btcConfig.RPCParams,
+	}, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create rpc client for bitcoin chain")
+	}
+
+	database, err := db.NewFromSqlite(dbpath, btcChain.ChainName(), true)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to open a database for bitcoin chain %q", btcChain.ChainName())
+	}
+
+	return btcobserver.NewBitcoinChainClient(
+		ctx,
+		btcChain,
+		chainParams,
+		btcClient,
+		pellcoreClient,
+		tss,
+		database,
+		logger,
+		ts,
+	)
+}
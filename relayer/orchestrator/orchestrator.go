@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"strconv"
 	"sync"
 	"time"
 
@@ -178,7 +179,7 @@ func (co *Orchestrator) resolveSigner(coreContext *appcontext.PellCoreContext, c
 	}
 
 	// update EVM signer parameters only. BTC signer doesn't use chain parameters for now.
-	if chains.IsEVMChain(chainID) {
+	if chain, found := coreContext.GetEnabledChain(chainID); found && chain.IsEVMChain() {
 		params, found := coreContext.GetEVMChainParams(chainID)
 		if found {
 			pellConnectorAddress := ethcommon.HexToAddress(params.GetConnectorContractAddress())
@@ -214,7 +215,7 @@ func (co *Orchestrator) resolveObserver(coreContext *appcontext.PellCoreContext,
 
 	// update chain client chain parameters
 	curParams := observer.GetChainParams()
-	if chains.IsEVMChain(chainID) {
+	if chain, found := coreContext.GetEnabledChain(chainID); found && chain.IsEVMChain() {
 		evmParams, found := coreContext.GetEVMChainParams(chainID)
 		if found && !cmp.Equal(curParams, *evmParams) {
 			observer.SetChainParams(*evmParams)
@@ -222,6 +223,14 @@ func (co *Orchestrator) resolveObserver(coreContext *appcontext.PellCoreContext,
 				Interface("observer.chain_params", *evmParams).
 				Msgf("updated chain params for chainID %d, new params: %v", chainID, *evmParams)
 		}
+	} else if found && chain.IsBitcoinChain() {
+		_, btcParams, found := coreContext.GetBTCChainParams()
+		if found && !cmp.Equal(curParams, *btcParams) {
+			observer.SetChainParams(*btcParams)
+			co.logger.Info().
+				Interface("observer.chain_params", *btcParams).
+				Msgf("updated chain params for chainID %d, new params: %v", chainID, *btcParams)
+		}
 	}
 	return observer, nil
 }
@@ -253,7 +262,8 @@ func (co *Orchestrator) GetPendingXmsgsWithinRateLimit(ctx context.Context, chai
 	}
 
 	// query rate limiter input
-	resp, err := co.pellcoreClient.GetRateLimiterInput(ctx, rateLimitFlags.Window)
+	// the input covers the widest window of the global, chain and event rate limits
+	resp, err := co.pellcoreClient.GetRateLimiterInput(ctx, rateLimitFlags.MaxWindow())
 	if err != nil {
 		return nil, err
 	}
@@ -263,15 +273,33 @@ func (co *Orchestrator) GetPendingXmsgsWithinRateLimit(ctx context.Context, chai
 	}
 
 	// apply rate limiter
-	output := ratelimiter.ApplyRateLimiter(input, rateLimitFlags.Window, rateLimitFlags.Rate)
+	output := ratelimiter.ApplyRateLimiter(input, rateLimitFlags)
 
 	// set metrics
-	percentage := pellmath.Percentage(output.CurrentWithdrawRate.BigInt(), rateLimitFlags.Rate.BigInt())
-	if percentage != nil {
-		percentageFloat, _ := percentage.Float64()
-		metrics.PercentageOfRateReached.Set(percentageFloat)
-		co.logger.Sampled.Info().Msgf("current rate limiter window: %d rate: %s, percentage: %f",
-			output.CurrentWithdrawWindow, output.CurrentWithdrawRate.String(), percentageFloat)
+	if rateLimitFlags.IsGlobalRateLimitSet() {
+		percentage := pellmath.Percentage(output.CurrentWithdrawRate.BigInt(), rateLimitFlags.Rate.BigInt())
+		if percentage != nil {
+			percentageFloat, _ := percentage.Float64()
+			metrics.PercentageOfRateReached.Set(percentageFloat)
+			co.logger.Sampled.Info().Msgf("current rate limiter window: %d rate: %s, percentage: %f",
+				output.CurrentWithdrawWindow, output.CurrentWithdrawRate.String(), percentageFloat)
+		}
+	}
+	for _, status := range output.ChainRateLimits {
+		metrics.ChainRateLimitExceeded.WithLabelValues(strconv.FormatInt(status.ChainId, 10)).
+			Set(lo.Ternary(status.RateLimitExceeded, 1.0, 0.0))
+		if status.RateLimitExceeded {
+			co.logger.Sampled.Warn().Msgf("rate limit exceeded for chain %d, window: %d rate: %s",
+				status.ChainId, status.CurrentWithdrawWindow, status.CurrentWithdrawRate)
+		}
+	}
+	for _, status := range output.EventRateLimits {
+		metrics.EventRateLimitExceeded.WithLabelValues(status.EventType.String()).
+			Set(lo.Ternary(status.RateLimitExceeded, 1.0, 0.0))
+		if status.RateLimitExceeded {
+			co.logger.Sampled.Warn().Msgf("rate limit exceeded for event type %s, window: %d rate: %s",
+				status.EventType, status.CurrentWithdrawWindow, status.CurrentWithdrawRate)
+		}
 	}
 
 	return output.XmsgsMap, nil
@@ -345,6 +373,14 @@ func (co *Orchestrator) runScheduler(ctx context.Context) error {
 							continue
 						}
 
+						// outbound is not supported on bitcoin chains yet, only refresh the observer params
+						if c.IsBitcoinChain() {
+							if _, err := co.resolveObserver(coreContext, c.Id); err != nil {
+								co.logger.Error().Err(err).Msgf("startXmsgScheduler: getTargetChainOb failed for chain %d", c.Id)
+							}
+							continue
+						}
+
 						// update chain parameters for signer and chain client
 						signer, err := co.resolveSigner(coreContext, c.Id)
 						if err != nil {
@@ -358,6 +394,12 @@ func (co *Orchestrator) runScheduler(ctx context.Context) error {
 							continue
 						}
 
+						// the observer is paused through the admin api
+						if ob.IsPaused() {
+							co.logger.Sampled.Info().Msgf("startXmsgScheduler: observer is paused for chain %d", c.Id)
+							continue
+						}
+
 						// get cctxs from map and set pending transactions prometheus gauge
 						xmsgList := xmsgMap[c.Id]
 
@@ -371,10 +413,16 @@ func (co *Orchestrator) runScheduler(ctx context.Context) error {
 							continue
 						}
 
+						// the outbound keysign is paused for the chain
+						if !coreContext.IsSigningEnabled(c.Id) {
+							co.logger.Info().Msgf("startXmsgScheduler: signing is paused for chain %d", c.Id)
+							continue
+						}
+
 						// #nosec G701 range is verified
 						pellHeight := uint64(bn)
 
-						if chains.IsEVMChain(c.Id) {
+						if c.IsEVMChain() {
 							co.scheduleXmsgEVM(ctx, pellHeight, c.Id, xmsgList, ob, signer)
 						} else {
 							co.logger.Error().Msgf("startXmsgScheduler: no scheduler found chain %d", c.Id)
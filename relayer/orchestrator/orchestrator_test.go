@@ -66,6 +66,7 @@ func CreateCoreContext(
 		relayertypes.Keygen{},
 		[]chains.Chain{evmChain},
 		evmChainParamsMap,
+		nil,
 		"",
 		*ccFlags,
 		verificationFlags,
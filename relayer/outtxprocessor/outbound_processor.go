@@ -2,6 +2,7 @@ package outtxprocessor
 
 import (
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -73,6 +74,36 @@ func (outTxMan *Processor) TimeInTryProcess(outTxID string) time.Duration {
 	return 0
 }
 
+// ActiveOutTx describes an outbound being processed
+type ActiveOutTx struct {
+	OutTxID   string        `json:"out_tx_id"`
+	StartTime time.Time     `json:"start_time"`
+	Elapsed   time.Duration `json:"elapsed"`
+}
+
+// ActiveOutTxs returns the outbounds being processed, the longest running first
+func (outTxMan *Processor) ActiveOutTxs() []ActiveOutTx {
+	outTxMan.mu.Lock()
+	defer outTxMan.mu.Unlock()
+	now := time.Now()
+	active := make([]ActiveOutTx, 0, len(outTxMan.outTxActive))
+	for outTxID := range outTxMan.outTxActive {
+		startTime := outTxMan.outTxStartTime[outTxID]
+		active = append(active, ActiveOutTx{
+			OutTxID:   outTxID,
+			StartTime: startTime,
+			Elapsed:   now.Sub(startTime),
+		})
+	}
+	sort.Slice(active, func(i, j int) bool {
+		if active[i].StartTime.Equal(active[j].StartTime) {
+			return active[i].OutTxID < active[j].OutTxID
+		}
+		return active[i].StartTime.Before(active[j].StartTime)
+	})
+	return active
+}
+
 // ToOutTxID returns the outTxID for OutTxProcessorManager to track
 func ToOutTxID(index string, receiverChainID int64, nonce uint64) string {
 	return fmt.Sprintf("%s-%d-%d", index, receiverChainID, nonce)
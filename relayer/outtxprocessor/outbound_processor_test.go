@@ -1 +1,36 @@
 package outtxprocessor_test
+
+import (
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/relayer/outtxprocessor"
+)
+
+func TestProcessor_ActiveOutTxs(t *testing.T) {
+	t.Run("should return empty list if no outbound is processed", func(t *testing.T) {
+		p := outtxprocessor.NewOutTxProcessor(zerolog.Nop())
+		require.Empty(t, p.ActiveOutTxs())
+	})
+
+	t.Run("should return the active outbounds, the longest running first", func(t *testing.T) {
+		p := outtxprocessor.NewOutTxProcessor(zerolog.Nop())
+		first := outtxprocessor.ToOutTxID("0x1", 1337, 1)
+		second := outtxprocessor.ToOutTxID("0x2", 1337, 2)
+		ended := outtxprocessor.ToOutTxID("0x3", 1337, 3)
+
+		p.StartTryProcess(first)
+		p.StartTryProcess(second)
+		p.StartTryProcess(ended)
+		p.EndTryProcess(ended)
+
+		active := p.ActiveOutTxs()
+		require.Len(t, active, 2)
+		require.Equal(t, first, active[0].OutTxID)
+		require.Equal(t, second, active[1].OutTxID)
+		require.False(t, active[0].StartTime.After(active[1].StartTime))
+		require.GreaterOrEqual(t, active[0].Elapsed, active[1].Elapsed)
+	})
+}
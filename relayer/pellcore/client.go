@@ -380,7 +380,14 @@ func (b *PellCoreBridge) UpdateAppContext(ctx context.Context, appContext *pctx.
 	}
 	tssPubKey := tss.GetTssPubkey()
 
+	// supported chains are resolved from the chain registry of pellcore
+	newSupportedChains := make([]chains.Chain, len(supportedChains))
+	for i, chain := range supportedChains {
+		newSupportedChains[i] = *chain
+	}
+
 	newEVMParams := make(map[int64]*relayertypes.ChainParams)
+	var newBTCParams *relayertypes.ChainParams
 	// check and update chain params for each chain
 	for _, chainParam := range chainParams {
 		if !chainParam.GetIsSupported() {
@@ -388,26 +395,25 @@ func (b *PellCoreBridge) UpdateAppContext(ctx context.Context, appContext *pctx.
 			continue
 		}
 
-		// if chains.IsPellChain(chainParam.ChainId) {
-		// 	continue
-		// }
+		chain, found := chains.GetChainFromList(newSupportedChains, chainParam.ChainId)
+		if !found {
+			logger.Warn().Int64("chain.id", chainParam.ChainId).Msg("Skipping chain not found in chain registry")
+			continue
+		}
 
-		err := relayertypes.ValidateChainParams(chainParam)
+		err := relayertypes.ValidateChainParamsForChain(chainParam, chain)
 		if err != nil {
 			logger.Warn().Err(err).Int64("chain.id", chainParam.ChainId).Msg("Skipping invalid chain params")
 			continue
 		}
 
-		if chains.IsEVMChain(chainParam.ChainId) {
+		if chain.IsEVMChain() {
 			newEVMParams[chainParam.ChainId] = chainParam
+		} else if chain.IsBitcoinChain() {
+			newBTCParams = chainParam
 		}
 	}
 
-	newSupportedChains := make([]chains.Chain, len(supportedChains))
-	for i, chain := range supportedChains {
-		newSupportedChains[i] = *chain
-	}
-
 	verificationFlags, err := b.GetVerificationFlags(ctx)
 	if err != nil {
 		b.logger.Info().Msg("Unable to fetch verification flags from pellcore")
@@ -424,6 +430,7 @@ func (b *PellCoreBridge) UpdateAppContext(ctx context.Context, appContext *pctx.
 		keyGen,
 		newSupportedChains,
 		newEVMParams,
+		newBTCParams,
 		tssPubKey,
 		crosschainFlags,
 		verificationFlags,
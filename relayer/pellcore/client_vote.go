@@ -47,20 +47,23 @@ func (c *PellCoreBridge) PostVoteBlockHeader(
 }
 
 // PostVoteGasPrice posts a gas price vote. Returns txHash and error.
+// priorityFee and baseFee are zero for chains without EIP-1559.
 func (b *PellCoreBridge) PostGasPrice(
 	ctx context.Context,
 	chain chains.Chain,
 	gasPrice uint64,
+	priorityFee uint64,
+	baseFee uint64,
 	supply string,
 	blockNum uint64,
 ) (string, error) {
 	// apply gas price multiplier for the chain
-	multiplier := GasPriceMultiplier(chain.Id)
+	multiplier := GasPriceMultiplier(chain)
 
 	// #nosec G701 always in range
 	gasPrice = uint64(float64(gasPrice) * multiplier)
 	signerAddress := b.keys.GetOperatorAddress().String()
-	msg := types.NewMsgVoteGasPrice(signerAddress, chain.Id, gasPrice, supply, blockNum)
+	msg := types.NewMsgVoteGasPrice(signerAddress, chain.Id, gasPrice, priorityFee, baseFee, supply, blockNum)
 
 	authzMsg, authzSigner, err := WrapMessageWithAuthz(msg)
 	if err != nil {
@@ -40,9 +40,9 @@ func GetInBoundVoteMessage(
 	return msg
 }
 
-// GasPriceMultiplier returns the gas price multiplier for the given chain
-func GasPriceMultiplier(chainID int64) float64 {
-	if chains.IsEVMChain(chainID) {
+// GasPriceMultiplier returns the gas price multiplier for the given chain of the chain registry
+func GasPriceMultiplier(chain chains.Chain) float64 {
+	if chain.IsEVMChain() {
 		return clientcommon.EVMOutboundGasPriceMultiplier
 	}
 
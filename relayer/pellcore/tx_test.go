@@ -38,42 +38,49 @@ func Test_GasPriceMultiplier(t *testing.T) {
 	tt := []struct {
 		name       string
 		chainID    int64
+		vmType     chains.VMType
 		multiplier float64
 		fail       bool
 	}{
 		{
 			name:       "get Ethereum multiplier",
 			chainID:    1,
+			vmType:     chains.VMType_EVM,
 			multiplier: 1.2,
 			fail:       false,
 		},
 		{
 			name:       "get Goerli multiplier",
 			chainID:    5,
+			vmType:     chains.VMType_EVM,
 			multiplier: 1.2,
 			fail:       false,
 		},
 		{
 			name:       "get BSC multiplier",
 			chainID:    56,
+			vmType:     chains.VMType_EVM,
 			multiplier: 1.2,
 			fail:       false,
 		},
 		{
 			name:       "get BSC Testnet multiplier",
 			chainID:    97,
+			vmType:     chains.VMType_EVM,
 			multiplier: 1.2,
 			fail:       false,
 		},
 		{
 			name:       "get Polygon multiplier",
 			chainID:    137,
+			vmType:     chains.VMType_EVM,
 			multiplier: 1.2,
 			fail:       false,
 		},
 		{
 			name:       "get Mumbai Testnet multiplier",
 			chainID:    80001,
+			vmType:     chains.VMType_EVM,
 			multiplier: 1.2,
 			fail:       false,
 		},
@@ -98,7 +105,7 @@ func Test_GasPriceMultiplier(t *testing.T) {
 	}
 	for _, tc := range tt {
 		t.Run(tc.name, func(t *testing.T) {
-			multiplier := GasPriceMultiplier(tc.chainID)
+			multiplier := GasPriceMultiplier(chains.Chain{Id: tc.chainID, VmType: tc.vmType})
 			require.Equal(t, tc.multiplier, multiplier)
 		})
 	}
@@ -131,7 +138,7 @@ func TestPellCoreBridge_PostGasPrice(t *testing.T) {
 	)
 
 	t.Run("post gas price success", func(t *testing.T) {
-		hash, err := client.PostGasPrice(ctx, chains.BscMainnetChain(), 1000000, "0", 1234)
+		hash, err := client.PostGasPrice(ctx, chains.BscMainnetChain(), 1000000, 0, 0, "0", 1234)
 		// TODO: fix it
 		t.Log(hash, err)
 		//require.NoError(t, err)
@@ -26,6 +26,15 @@ type Input struct {
 
 	// the lowest height of the pending (not missed) cctxs across all chains
 	LowestPendingXmsgHeight int64
+
+	// the lowest height of the pending (not missed) cctxs of each chain
+	LowestPendingXmsgHeights map[int64]int64
+
+	// the total value of the past cctxs within the window of each chain rate limit
+	PastChainValues map[int64]sdkmath.Int
+
+	// the total value of the past cctxs within the window of each event rate limit
+	PastEventValues map[xmsgtypes.RateLimitEventType]sdkmath.Int
 }
 
 // Output is the output data for the rate limiter
@@ -41,62 +50,115 @@ type Output struct {
 
 	// wehther the current withdraw rate exceeds the given rate limit or not
 	RateLimitExceeded bool
+
+	// the current withdraw rate of each chain rate limit
+	ChainRateLimits []xmsgtypes.ChainRateLimitStatus
+
+	// the current withdraw rate of each event rate limit
+	EventRateLimits []xmsgtypes.EventRateLimitStatus
+}
+
+// parseValue parses a past xmsgs value of the gRPC response, an empty value is zero
+func parseValue(value string) (sdkmath.Int, bool) {
+	if value == "" {
+		return sdkmath.ZeroInt(), true
+	}
+	return sdkmath.NewIntFromString(value)
 }
 
 // NewInput creates a rate limiter input from gRPC response
 func NewInput(resp xmsgtypes.QueryRateLimiterInputResponse) (*Input, bool) {
-	return &Input{
-		Height:                  resp.Height,
-		XmsgsMissed:             resp.XmsgsMissed,
-		XmsgsPending:            resp.XmsgsPending,
-		PastXmsgsValue:          sdkmath.ZeroInt(),
-		PendingXmsgsValue:       sdkmath.ZeroInt(),
-		LowestPendingXmsgHeight: resp.LowestPendingXmsgHeight,
-	}, true
+	input := &Input{
+		Height:                   resp.Height,
+		XmsgsMissed:              resp.XmsgsMissed,
+		XmsgsPending:             resp.XmsgsPending,
+		PastXmsgsValue:           sdkmath.ZeroInt(),
+		PendingXmsgsValue:        sdkmath.ZeroInt(),
+		LowestPendingXmsgHeight:  resp.LowestPendingXmsgHeight,
+		LowestPendingXmsgHeights: make(map[int64]int64),
+		PastChainValues:          make(map[int64]sdkmath.Int),
+		PastEventValues:          make(map[xmsgtypes.RateLimitEventType]sdkmath.Int),
+	}
+	pastXmsgsValue, ok := parseValue(resp.PastXmsgsValue)
+	if !ok {
+		return nil, false
+	}
+	input.PastXmsgsValue = pastXmsgsValue
+	for _, value := range resp.PastChainValues {
+		pastValue, ok := parseValue(value.Value)
+		if !ok {
+			return nil, false
+		}
+		input.PastChainValues[value.ChainId] = pastValue
+	}
+	for _, value := range resp.PastEventValues {
+		pastValue, ok := parseValue(value.Value)
+		if !ok {
+			return nil, false
+		}
+		input.PastEventValues[value.EventType] = pastValue
+	}
+
+	// count the pending outgoing cctxs and find the lowest pending height of each chain
+	for _, xmsg := range resp.XmsgsPending {
+		if !xmsgtypes.IsOutgoingXmsg(xmsg) {
+			continue
+		}
+		input.PendingXmsgsValue = input.PendingXmsgsValue.Add(sdkmath.OneInt())
+
+		chainID := xmsg.GetCurrentOutTxParam().ReceiverChainId
+		// #nosec G701 always in range
+		height := int64(xmsg.InboundTxParams.InboundTxBlockHeight)
+		if lowest, found := input.LowestPendingXmsgHeights[chainID]; !found || height < lowest {
+			input.LowestPendingXmsgHeights[chainID] = height
+		}
+	}
+
+	return input, true
 }
 
 // IsRateLimiterUsable checks if the rate limiter is usable or not
+// the rate limiter is usable if it's enabled with a global rate limit or at least one chain or event rate limit
 func IsRateLimiterUsable(rateLimiterFlags xmsgtypes.RateLimiterFlags) bool {
 	if !rateLimiterFlags.Enabled {
 		return false
 	}
-	if rateLimiterFlags.Window <= 0 {
-		return false
-	}
-	if rateLimiterFlags.Rate.IsNil() {
-		return false
+	if len(rateLimiterFlags.ChainRateLimits) > 0 || len(rateLimiterFlags.EventRateLimits) > 0 {
+		return true
 	}
-	if rateLimiterFlags.Rate.IsZero() {
-		return false
-	}
-	return true
+	return rateLimiterFlags.IsGlobalRateLimitSet()
 }
 
 // ApplyRateLimiter applies the rate limiter to the input and produces output
-func ApplyRateLimiter(input *Input, window int64, rate sdkmath.Uint) *Output {
-	// block limit and the window limit in apell
-	blockLimitInApell := sdkmath.NewIntFromBigInt(rate.BigInt())
-	windowLimitInApell := blockLimitInApell.Mul(sdkmath.NewInt(window))
-
-	// invariant: for period of time >= `window`, the pellclient-side average withdraw rate should be <= `blockLimitInPell`
-	// otherwise, pellclient should wait for the average rate to drop below `blockLimitInPell`
-	withdrawWindow := window
-	withdrawLimitInApell := windowLimitInApell
-	if input.LowestPendingXmsgHeight != 0 {
-		// If [input.LowestPendingXmsgHeight, input.Height] is wider than the given `window`, we should:
-		// 1. use the wider window to calculate the average withdraw rate
-		// 2. adjust the limit proportionally to fit the wider window
-		pendingXmsgWindow := input.Height - input.LowestPendingXmsgHeight + 1
-		if pendingXmsgWindow > window {
-			withdrawWindow = pendingXmsgWindow
-			withdrawLimitInApell = blockLimitInApell.Mul(sdkmath.NewInt(pendingXmsgWindow))
+//
+// The cctxs are counted against the global rate limit, the rate limit of their receiver chain and the rate limit of
+// their event type. The missed cctxs are always scheduled, a pending cctx exceeding one of its rate limits is held
+// back with the following pending cctxs of its chain.
+func ApplyRateLimiter(input *Input, flags xmsgtypes.RateLimiterFlags) *Output {
+	// invariant: for period of time >= `window`, the pellclient-side average withdraw rate of each rate limit should be <= its rate
+	// otherwise, pellclient should wait for the average rate to drop below the rate
+	budgets := xmsgtypes.NewRateLimitBudgets(
+		flags,
+		input.Height,
+		input.LowestPendingXmsgHeight,
+		input.LowestPendingXmsgHeights,
+	)
+
+	// accumulate the past and pending cctxs' value
+	budgets.AddGlobal(input.PastXmsgsValue.Add(input.PendingXmsgsValue))
+	for chainID, value := range input.PastChainValues {
+		budgets.AddChain(chainID, value)
+	}
+	for eventType, value := range input.PastEventValues {
+		budgets.AddEvent(eventType, value)
+	}
+	for _, cctx := range input.XmsgsPending {
+		if xmsgtypes.IsOutgoingXmsg(cctx) {
+			budgets.AddChain(cctx.GetCurrentOutTxParam().ReceiverChainId, sdkmath.OneInt())
+			budgets.AddEvent(xmsgtypes.GetRateLimitEventType(cctx), xmsgtypes.GetRateLimitEventValue(cctx))
 		}
 	}
 
-	// limit exceeded or not
-	totalWithdrawInApell := input.PastXmsgsValue.Add(input.PendingXmsgsValue)
-	limitExceeded := totalWithdrawInApell.GT(withdrawLimitInApell)
-
 	// define the result cctx map to be scheduled
 	cctxMap := make(map[int64][]*xmsgtypes.Xmsg)
 
@@ -111,18 +173,23 @@ func ApplyRateLimiter(input *Input, window int64, rate sdkmath.Uint) *Output {
 		}
 	}
 
-	// schedule missed cctxs regardless of the `limitExceeded` flag
+	// schedule missed cctxs regardless of the rate limits
 	addXmsgsToMap(input.XmsgsMissed)
 
-	// schedule pending cctxs only if `limitExceeded == false`
-	if !limitExceeded {
-		addXmsgsToMap(input.XmsgsPending)
-	}
+	// schedule pending cctxs not held back by an exceeded rate limit
+	addXmsgsToMap(budgets.FilterPending(input.XmsgsPending))
 
-	return &Output{
-		XmsgsMap:              cctxMap,
-		CurrentWithdrawWindow: withdrawWindow,
-		CurrentWithdrawRate:   totalWithdrawInApell.Quo(sdkmath.NewInt(withdrawWindow)),
-		RateLimitExceeded:     limitExceeded,
+	output := &Output{
+		XmsgsMap:            cctxMap,
+		CurrentWithdrawRate: sdkmath.ZeroInt(),
+		RateLimitExceeded:   budgets.GlobalExceeded(),
+		ChainRateLimits:     budgets.ChainStatuses(),
+		EventRateLimits:     budgets.EventStatuses(),
+	}
+	if budgets.Global != nil {
+		output.CurrentWithdrawWindow = budgets.Global.CurrentWithdrawWindow()
+		output.CurrentWithdrawRate = budgets.Global.CurrentWithdrawRate()
 	}
+
+	return output
 }
@@ -31,6 +31,40 @@ func Test_NewInput(t *testing.T) {
 		require.Equal(t, response.XmsgsPending, filterInput.XmsgsPending)
 		require.Equal(t, response.LowestPendingXmsgHeight, filterInput.LowestPendingXmsgHeight)
 	})
+
+	t.Run("should create a input with past and pending values", func(t *testing.T) {
+		pellChainID := chains.PellPrivnetChain().Id
+		ethChainID := chains.EthChain().Id
+		pending := sample.CustomXmsgsInBlockRange(t, 5, 9, pellChainID, ethChainID, xmsgtypes.XmsgStatus_PENDING_OUTBOUND)
+
+		filterInput, ok := ratelimiter.NewInput(xmsgtypes.QueryRateLimiterInputResponse{
+			Height:                  10,
+			XmsgsPending:            pending,
+			LowestPendingXmsgHeight: 5,
+			PastXmsgsValue:          "3",
+			PastChainValues:         []xmsgtypes.ChainRateLimitValue{{ChainId: ethChainID, Value: "2"}},
+			PastEventValues: []xmsgtypes.EventRateLimitValue{{
+				EventType: xmsgtypes.RateLimitEventType_PELL_SENT_EVENT,
+				Value:     "1000000000000000000",
+			}},
+		})
+		require.True(t, ok)
+		require.Equal(t, sdkmath.NewInt(3), filterInput.PastXmsgsValue)
+		require.Equal(t, sdkmath.NewInt(5), filterInput.PendingXmsgsValue)
+		require.Equal(t, map[int64]int64{ethChainID: 5}, filterInput.LowestPendingXmsgHeights)
+		require.Equal(t, map[int64]sdkmath.Int{ethChainID: sdkmath.NewInt(2)}, filterInput.PastChainValues)
+		require.Equal(t, map[xmsgtypes.RateLimitEventType]sdkmath.Int{
+			xmsgtypes.RateLimitEventType_PELL_SENT_EVENT: sdkmath.NewInt(1e18),
+		}, filterInput.PastEventValues)
+	})
+
+	t.Run("should fail if a past value is not an integer", func(t *testing.T) {
+		_, ok := ratelimiter.NewInput(xmsgtypes.QueryRateLimiterInputResponse{
+			Height:         10,
+			PastXmsgsValue: "1.5",
+		})
+		require.False(t, ok)
+	})
 }
 
 func Test_IsRateLimiterUsable(t *testing.T) {
@@ -48,6 +82,36 @@ func Test_IsRateLimiterUsable(t *testing.T) {
 			},
 			expected: true,
 		},
+		{
+			name: "rate limiter is enabled with chain rate limit only",
+			flags: xmsgtypes.RateLimiterFlags{
+				Enabled: true,
+				ChainRateLimits: []xmsgtypes.ChainRateLimit{
+					{ChainId: 1, Window: 100, Rate: sdkmath.NewUint(1)},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "rate limiter is enabled with event rate limit only",
+			flags: xmsgtypes.RateLimiterFlags{
+				Enabled: true,
+				EventRateLimits: []xmsgtypes.EventRateLimit{
+					{EventType: xmsgtypes.RateLimitEventType_PELL_SENT_EVENT, Window: 100, Rate: sdkmath.NewUint(1e18)},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "rate limiter is disabled with chain rate limit",
+			flags: xmsgtypes.RateLimiterFlags{
+				Enabled: false,
+				ChainRateLimits: []xmsgtypes.ChainRateLimit{
+					{ChainId: 1, Window: 100, Rate: sdkmath.NewUint(1)},
+				},
+			},
+			expected: false,
+		},
 		{
 			name: "rate limiter is disabled",
 			flags: xmsgtypes.RateLimiterFlags{
@@ -247,7 +311,8 @@ func Test_ApplyRateLimiter(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			output := ratelimiter.ApplyRateLimiter(&tt.input, tt.window, tt.rate)
+			flags := xmsgtypes.RateLimiterFlags{Enabled: true, Window: tt.window, Rate: tt.rate}
+			output := ratelimiter.ApplyRateLimiter(&tt.input, flags)
 			require.Equal(t, tt.output.XmsgsMap, output.XmsgsMap)
 			require.Equal(t, tt.output.CurrentWithdrawWindow, output.CurrentWithdrawWindow)
 			require.Equal(t, tt.output.CurrentWithdrawRate, output.CurrentWithdrawRate)
@@ -255,3 +320,74 @@ func Test_ApplyRateLimiter(t *testing.T) {
 		})
 	}
 }
+
+func Test_ApplyRateLimiter_ChainAndEventRateLimits(t *testing.T) {
+	// define test chain ids
+	ethChainID := chains.EthChain().Id
+	bscChainID := chains.BscMainnetChain().Id
+	pellChainID := chains.PellChainMainnet().Id
+
+	// create 10 missed and 10 pending cctxs for eth and bsc chains
+	ethXmsgsMissed := sample.CustomXmsgsInBlockRange(t, 81, 90, pellChainID, ethChainID, xmsgtypes.XmsgStatus_PENDING_OUTBOUND)
+	ethXmsgsPending := sample.CustomXmsgsInBlockRange(t, 91, 100, pellChainID, ethChainID, xmsgtypes.XmsgStatus_PENDING_OUTBOUND)
+	bscXmsgsMissed := sample.CustomXmsgsInBlockRange(t, 81, 90, pellChainID, bscChainID, xmsgtypes.XmsgStatus_PENDING_OUTBOUND)
+	bscXmsgsPending := sample.CustomXmsgsInBlockRange(t, 91, 100, pellChainID, bscChainID, xmsgtypes.XmsgStatus_PENDING_OUTBOUND)
+
+	newInput := func() *ratelimiter.Input {
+		input, ok := ratelimiter.NewInput(xmsgtypes.QueryRateLimiterInputResponse{
+			Height:                  100,
+			XmsgsMissed:             xmsgkeeper.SortXmsgsByHeightAndChainID(append(append([]*xmsgtypes.Xmsg{}, ethXmsgsMissed...), bscXmsgsMissed...)),
+			XmsgsPending:            xmsgkeeper.SortXmsgsByHeightAndChainID(append(append([]*xmsgtypes.Xmsg{}, ethXmsgsPending...), bscXmsgsPending...)),
+			LowestPendingXmsgHeight: 91,
+			PastChainValues:         []xmsgtypes.ChainRateLimitValue{{ChainId: ethChainID, Value: "1"}},
+		})
+		require.True(t, ok)
+		return input
+	}
+
+	t.Run("should hold back pending cctxs of the chain exceeding its rate limit", func(t *testing.T) {
+		flags := xmsgtypes.RateLimiterFlags{
+			Enabled: true,
+			Window:  10,
+			Rate:    sdkmath.NewUint(10),
+			ChainRateLimits: []xmsgtypes.ChainRateLimit{
+				{ChainId: ethChainID, Window: 10, Rate: sdkmath.NewUint(1)},
+				{ChainId: bscChainID, Window: 10, Rate: sdkmath.NewUint(1)},
+			},
+		}
+
+		output := ratelimiter.ApplyRateLimiter(newInput(), flags)
+		require.Equal(t, map[int64][]*xmsgtypes.Xmsg{
+			ethChainID: ethXmsgsMissed,
+			bscChainID: append(append([]*xmsgtypes.Xmsg{}, bscXmsgsMissed...), bscXmsgsPending...),
+		}, output.XmsgsMap)
+		require.False(t, output.RateLimitExceeded)
+		require.Equal(t, []xmsgtypes.ChainRateLimitStatus{
+			{ChainId: ethChainID, CurrentWithdrawWindow: 10, CurrentWithdrawRate: "1", RateLimitExceeded: true},
+			{ChainId: bscChainID, CurrentWithdrawWindow: 10, CurrentWithdrawRate: "1", RateLimitExceeded: false},
+		}, output.ChainRateLimits)
+	})
+
+	t.Run("should hold back pending cctxs of the event type exceeding its rate limit", func(t *testing.T) {
+		flags := xmsgtypes.RateLimiterFlags{
+			Enabled: true,
+			EventRateLimits: []xmsgtypes.EventRateLimit{
+				{EventType: xmsgtypes.RateLimitEventType_DELEGATION_SYNC_EVENT, Window: 10, Rate: sdkmath.NewUint(1)},
+			},
+		}
+
+		output := ratelimiter.ApplyRateLimiter(newInput(), flags)
+		require.Equal(t, map[int64][]*xmsgtypes.Xmsg{
+			ethChainID: ethXmsgsMissed,
+			bscChainID: bscXmsgsMissed,
+		}, output.XmsgsMap)
+		require.False(t, output.RateLimitExceeded)
+		require.EqualValues(t, 0, output.CurrentWithdrawWindow)
+		require.Equal(t, []xmsgtypes.EventRateLimitStatus{{
+			EventType:             xmsgtypes.RateLimitEventType_DELEGATION_SYNC_EVENT,
+			CurrentWithdrawWindow: 10,
+			CurrentWithdrawRate:   "2",
+			RateLimitExceeded:     true,
+		}}, output.EventRateLimits)
+	})
+}
@@ -17,7 +17,10 @@ var _ interfaces.BTCRPCClient = &MockBTCRPCClient{}
 
 // MockBTCRPCClient is a mock implementation of the BTCRPCClient interface
 type MockBTCRPCClient struct {
-	Txs []*btcutil.Tx
+	Txs        []*btcutil.Tx
+	BlockCount int64
+	Blocks     map[int64]*btcjson.GetBlockVerboseTxResult
+	TxsVerbose map[string]*btcjson.TxRawResult
 }
 
 // NewMockBTCRPCClient creates a new mock BTC RPC client
@@ -29,6 +32,9 @@ func NewMockBTCRPCClient() *MockBTCRPCClient {
 // Reset clears the mock data
 func (c *MockBTCRPCClient) Reset() *MockBTCRPCClient {
 	c.Txs = []*btcutil.Tx{}
+	c.BlockCount = 0
+	c.Blocks = map[int64]*btcjson.GetBlockVerboseTxResult{}
+	c.TxsVerbose = map[string]*btcjson.TxRawResult{}
 	return c
 }
 
@@ -83,24 +89,48 @@ func (c *MockBTCRPCClient) GetRawTransaction(_ *chainhash.Hash) (*btcutil.Tx, er
 	return nil, errors.New("no transaction found")
 }
 
-func (c *MockBTCRPCClient) GetRawTransactionVerbose(_ *chainhash.Hash) (*btcjson.TxRawResult, error) {
-	return nil, errors.New("not implemented")
+// GetRawTransactionVerbose returns a pre-loaded verbose transaction by hash
+func (c *MockBTCRPCClient) GetRawTransactionVerbose(txHash *chainhash.Hash) (*btcjson.TxRawResult, error) {
+	if tx, found := c.TxsVerbose[txHash.String()]; found {
+		return tx, nil
+	}
+	return nil, errors.New("no transaction found")
 }
 
+// GetBlockCount returns the pre-loaded block count
 func (c *MockBTCRPCClient) GetBlockCount() (int64, error) {
-	return 0, errors.New("not implemented")
+	return c.BlockCount, nil
 }
 
-func (c *MockBTCRPCClient) GetBlockHash(_ int64) (*chainhash.Hash, error) {
-	return nil, errors.New("not implemented")
-}
-
-func (c *MockBTCRPCClient) GetBlockVerbose(_ *chainhash.Hash) (*btcjson.GetBlockVerboseResult, error) {
-	return nil, errors.New("not implemented")
+// GetBlockHash returns the hash of a pre-loaded block by height
+func (c *MockBTCRPCClient) GetBlockHash(blockHeight int64) (*chainhash.Hash, error) {
+	block, found := c.Blocks[blockHeight]
+	if !found {
+		return nil, errors.New("no block found")
+	}
+	return chainhash.NewHashFromStr(block.Hash)
 }
 
-func (c *MockBTCRPCClient) GetBlockVerboseTx(_ *chainhash.Hash) (*btcjson.GetBlockVerboseTxResult, error) {
-	return nil, errors.New("not implemented")
+// GetBlockVerbose returns a pre-loaded block by hash
+func (c *MockBTCRPCClient) GetBlockVerbose(blockHash *chainhash.Hash) (*btcjson.GetBlockVerboseResult, error) {
+	block, err := c.GetBlockVerboseTx(blockHash)
+	if err != nil {
+		return nil, err
+	}
+	return &btcjson.GetBlockVerboseResult{
+		Hash:   block.Hash,
+		Height: block.Height,
+	}, nil
+}
+
+// GetBlockVerboseTx returns a pre-loaded block by hash
+func (c *MockBTCRPCClient) GetBlockVerboseTx(blockHash *chainhash.Hash) (*btcjson.GetBlockVerboseTxResult, error) {
+	for _, block := range c.Blocks {
+		if block.Hash == blockHash.String() {
+			return block, nil
+		}
+	}
+	return nil, errors.New("no block found")
 }
 
 func (c *MockBTCRPCClient) GetBlockHeader(_ *chainhash.Hash) (*wire.BlockHeader, error) {
@@ -116,6 +146,21 @@ func (c *MockBTCRPCClient) WithRawTransaction(tx *btcutil.Tx) *MockBTCRPCClient
 	return c
 }
 
+func (c *MockBTCRPCClient) WithBlockCount(blockCount int64) *MockBTCRPCClient {
+	c.BlockCount = blockCount
+	return c
+}
+
+func (c *MockBTCRPCClient) WithBlockVerboseTx(block *btcjson.GetBlockVerboseTxResult) *MockBTCRPCClient {
+	c.Blocks[block.Height] = block
+	return c
+}
+
+func (c *MockBTCRPCClient) WithRawTransactionVerbose(tx *btcjson.TxRawResult) *MockBTCRPCClient {
+	c.TxsVerbose[tx.Txid] = tx
+	return c
+}
+
 func (c *MockBTCRPCClient) WithRawTransactions(txs []*btcutil.Tx) *MockBTCRPCClient {
 	c.Txs = append(c.Txs, txs...)
 	return c
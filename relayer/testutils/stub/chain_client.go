@@ -18,6 +18,8 @@ var _ interfaces.ChainClient = (*EVMClient)(nil)
 // EVMClient is a mock of evm chain client for testing
 type EVMClient struct {
 	ChainParams relayertypes.ChainParams
+	Paused      bool
+	RescanBlock uint64
 }
 
 func NewEVMClient(chainParams *relayertypes.ChainParams) *EVMClient {
@@ -51,3 +53,20 @@ func (s *EVMClient) OutboundID(_ uint64) string {
 func (s *EVMClient) WatchIntxTracker(_ context.Context) error {
 	return nil
 }
+
+func (s *EVMClient) Pause() {
+	s.Paused = true
+}
+
+func (s *EVMClient) Resume() {
+	s.Paused = false
+}
+
+func (s *EVMClient) IsPaused() bool {
+	return s.Paused
+}
+
+func (s *EVMClient) RescanFrom(blockNumber uint64) error {
+	s.RescanBlock = blockNumber
+	return nil
+}
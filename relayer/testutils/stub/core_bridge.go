@@ -28,6 +28,15 @@ var _ interfaces.PellCoreBridger = &MockPellCoreBridge{}
 type MockPellCoreBridge struct {
 	paused bool
 	chain  chains.Chain
+
+	// inboundVotes contains the inbound votes posted to the bridge
+	inboundVotes []*xmsgtypes.MsgVoteOnObservedInboundTx
+
+	// inboundTrackers contains the inbound trackers returned by the bridge
+	inboundTrackers []xmsgtypes.InTxTracker
+
+	// restrictedAddresses contains the restricted addresses returned by the bridge
+	restrictedAddresses []string
 }
 
 func NewMockPellCoreBridge() *MockPellCoreBridge {
@@ -41,10 +50,11 @@ func NewMockPellCoreBridge() *MockPellCoreBridge {
 	}
 }
 
-func (z *MockPellCoreBridge) PostVoteInboundEvents(ctx context.Context, _, _ uint64, _ []*xmsgtypes.MsgVoteOnObservedInboundTx) (string, string, error) {
+func (z *MockPellCoreBridge) PostVoteInboundEvents(ctx context.Context, _, _ uint64, msgs []*xmsgtypes.MsgVoteOnObservedInboundTx) (string, string, error) {
 	if z.paused {
 		return "", "", errors.New(ErrMsgPaused)
 	}
+	z.inboundVotes = append(z.inboundVotes, msgs...)
 	return "", "", nil
 }
 
@@ -55,7 +65,7 @@ func (z *MockPellCoreBridge) PostVoteOutbound(ctx context.Context, _ string, _ s
 	return sample.Hash().Hex(), "", nil
 }
 
-func (z *MockPellCoreBridge) PostGasPrice(ctx context.Context, _ chains.Chain, _ uint64, _ string, _ uint64) (string, error) {
+func (z *MockPellCoreBridge) PostGasPrice(ctx context.Context, _ chains.Chain, _ uint64, _ uint64, _ uint64, _ string, _ uint64) (string, error) {
 	if z.paused {
 		return "", errors.New(ErrMsgPaused)
 	}
@@ -227,6 +237,13 @@ func (z *MockPellCoreBridge) GetCrosschainFlags(ctx context.Context) (relayertyp
 	return relayertypes.CrosschainFlags{}, nil
 }
 
+func (z *MockPellCoreBridge) GetRestrictedAddresses(ctx context.Context) ([]string, error) {
+	if z.paused {
+		return nil, errors.New(ErrMsgPaused)
+	}
+	return z.restrictedAddresses, nil
+}
+
 func (z *MockPellCoreBridge) GetObserverList(ctx context.Context) ([]string, error) {
 	if z.paused {
 		return nil, errors.New(ErrMsgPaused)
@@ -252,7 +269,7 @@ func (z *MockPellCoreBridge) GetInboundTrackersForChain(ctx context.Context, _ i
 	if z.paused {
 		return nil, errors.New(ErrMsgPaused)
 	}
-	return []xmsgtypes.InTxTracker{}, nil
+	return append([]xmsgtypes.InTxTracker{}, z.inboundTrackers...), nil
 }
 
 func (z *MockPellCoreBridge) GetPellHotKeyBalance(ctx context.Context) (math.Int, error) {
@@ -281,3 +298,30 @@ func (z *MockPellCoreBridge) PostVoteInboundBlock(
 	}
 	return []string{}, []string{}, nil
 }
+
+// ----------------------------------------------------------------------------
+// Feed and read data of the mock pellcore bridge for testing
+// ----------------------------------------------------------------------------
+
+func (z *MockPellCoreBridge) Pause() {
+	z.paused = true
+}
+
+func (z *MockPellCoreBridge) Unpause() {
+	z.paused = false
+}
+
+// GetInboundVotes returns the inbound votes posted to the bridge
+func (z *MockPellCoreBridge) GetInboundVotes() []*xmsgtypes.MsgVoteOnObservedInboundTx {
+	return z.inboundVotes
+}
+
+func (z *MockPellCoreBridge) WithInboundTrackers(trackers []xmsgtypes.InTxTracker) *MockPellCoreBridge {
+	z.inboundTrackers = trackers
+	return z
+}
+
+func (z *MockPellCoreBridge) WithRestrictedAddresses(addresses []string) *MockPellCoreBridge {
+	z.restrictedAddresses = addresses
+	return z
+}
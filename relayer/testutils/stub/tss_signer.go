@@ -72,6 +72,7 @@ func (s *TSS) EVMAddress() ethcommon.Address {
 	return ethcommon.HexToAddress(s.evmAddress)
 }
 
+// PubKeyCompressedBytes uses the hardcoded private test key to generate the compressed public key in bytes
 func (s *TSS) PubKeyCompressedBytes() []byte {
-	return []byte{}
+	return crypto.CompressPubkey(&TestPrivateKey.PublicKey)
 }
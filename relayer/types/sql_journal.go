@@ -0,0 +1,81 @@
+package types
+
+import (
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"gorm.io/gorm"
+)
+
+// OutboundJournalStatus is the status of an outbound in the signing journal
+type OutboundJournalStatus string
+
+const (
+	// OutboundJournalStatusSigned means the outbound has been signed but not broadcasted yet
+	OutboundJournalStatusSigned OutboundJournalStatus = "signed"
+
+	// OutboundJournalStatusBroadcasted means the outbound has been accepted by the node
+	OutboundJournalStatusBroadcasted OutboundJournalStatus = "broadcasted"
+
+	// OutboundJournalStatusFailed means the last broadcast of the outbound failed
+	OutboundJournalStatusFailed OutboundJournalStatus = "failed"
+
+	// OutboundJournalStatusProcessed means the outbound has been included on the external chain
+	OutboundJournalStatusProcessed OutboundJournalStatus = "processed"
+)
+
+// OutboundJournalSQLType is a model for storing the last signed outbound of a nonce
+type OutboundJournalSQLType struct {
+	gorm.Model
+	ChainID   int64  `gorm:"uniqueIndex:idx_outbound_journal_chain_nonce"`
+	Nonce     uint64 `gorm:"uniqueIndex:idx_outbound_journal_chain_nonce"`
+	XmsgIndex string
+	TxHash    string
+
+	// RawTx is the signed transaction in its binary encoding
+	RawTx []byte
+
+	// Height is the pell height of the keysign of the first transaction with the same gas
+	Height uint64
+
+	// Replacements is the number of times the outbound has been replaced
+	Replacements uint64
+
+	Status            OutboundJournalStatus
+	BroadcastAttempts uint64
+	LastError         string
+}
+
+// IsPending returns true if the outbound of the journal entry has not been processed
+func (j OutboundJournalSQLType) IsPending() bool {
+	return j.Status != OutboundJournalStatusProcessed
+}
+
+// Tx decodes the signed transaction of the journal entry
+func (j OutboundJournalSQLType) Tx() (*ethtypes.Transaction, error) {
+	tx := &ethtypes.Transaction{}
+	err := tx.UnmarshalBinary(j.RawTx)
+	return tx, err
+}
+
+// ToOutboundJournalSQLType converts a signed outbound to an OutboundJournalSQLType
+func ToOutboundJournalSQLType(
+	chainID int64,
+	tx *ethtypes.Transaction,
+	xmsgIndex string,
+	height uint64,
+	replacements uint64,
+) (*OutboundJournalSQLType, error) {
+	raw, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return &OutboundJournalSQLType{
+		ChainID:      chainID,
+		Nonce:        tx.Nonce(),
+		XmsgIndex:    xmsgIndex,
+		TxHash:       tx.Hash().Hex(),
+		RawTx:        raw,
+		Height:       height,
+		Replacements: replacements,
+		Status:       OutboundJournalStatusSigned,
+	}, nil
+}
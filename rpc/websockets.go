@@ -407,6 +407,11 @@ func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []interface
 		return api.subscribePendingTransactions(wsConn, subID)
 	case "syncing":
 		return api.subscribeSyncing(wsConn, subID)
+	case "xmsgStatus":
+		if len(params) > 1 {
+			return api.subscribeXmsgStatus(wsConn, subID, params[1])
+		}
+		return api.subscribeXmsgStatus(wsConn, subID, nil)
 	default:
 		return nil, errors.Errorf("unsupported method %s", method)
 	}
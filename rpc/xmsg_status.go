@@ -0,0 +1,262 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+
+	"github.com/0xPellNetwork/aegis/rpc/ethereum/pubsub"
+	xmsgtypes "github.com/0xPellNetwork/aegis/x/xmsg/types"
+)
+
+// maxXmsgStatusReplayBlocks is the maximum number of blocks replayed when resuming a xmsg status subscription
+const maxXmsgStatusReplayBlocks = 10000
+
+// XmsgStatusCriteria filters the xmsg status transitions of a subscription
+type XmsgStatusCriteria struct {
+	// FromHeight is the height to resume the subscription from, 0 for new blocks only
+	FromHeight int64
+	// XmsgIndex filters the transitions of a single xmsg
+	XmsgIndex string
+	// ChainID filters the transitions of the xmsgs sent from or to a chain
+	ChainID int64
+}
+
+// XmsgStatusNotification is a xmsg status transition pushed to the subscribers
+type XmsgStatusNotification struct {
+	Height int64 `json:"height"`
+	// TxHash is the hash of the pell tx of the transition, empty for transitions of begin and end blockers
+	TxHash string                            `json:"txHash,omitempty"`
+	Event  *xmsgtypes.EventXmsgStatusChanged `json:"event"`
+}
+
+// parseXmsgStatusCriteria parses the criteria of a xmsg status subscription
+// the criteria is an object with the optional fields fromHeight, xmsgIndex and chainId
+func parseXmsgStatusCriteria(extra interface{}) (XmsgStatusCriteria, error) {
+	crit := XmsgStatusCriteria{}
+	if extra == nil {
+		return crit, nil
+	}
+	params, ok := extra.(map[string]interface{})
+	if !ok {
+		return crit, errors.New("invalid criteria")
+	}
+
+	parseInt := func(key string) (int64, error) {
+		switch v := params[key].(type) {
+		case nil:
+			return 0, nil
+		case float64:
+			return int64(v), nil
+		case string:
+			return strconv.ParseInt(v, 0, 64)
+		default:
+			return 0, errors.Errorf("invalid %s: %v", key, v)
+		}
+	}
+
+	var err error
+	if crit.FromHeight, err = parseInt("fromHeight"); err != nil {
+		return crit, errors.Wrap(err, "invalid fromHeight")
+	}
+	if crit.FromHeight < 0 {
+		return crit, errors.Errorf("invalid fromHeight %d", crit.FromHeight)
+	}
+	if crit.ChainID, err = parseInt("chainId"); err != nil {
+		return crit, errors.Wrap(err, "invalid chainId")
+	}
+	if params["xmsgIndex"] != nil {
+		if crit.XmsgIndex, ok = params["xmsgIndex"].(string); !ok {
+			return crit, errors.New("invalid xmsgIndex")
+		}
+	}
+	return crit, nil
+}
+
+// subscribeXmsgStatus pushes the xmsg status transitions matching the criteria
+// the transitions are read from the block results of each new block, so a subscription can be resumed
+// from a past height: the blocks from this height are replayed before the new blocks
+func (api *pubSubAPI) subscribeXmsgStatus(wsConn *wsConn, subID rpc.ID, extra interface{}) (pubsub.UnsubscribeFunc, error) {
+	crit, err := parseXmsgStatusCriteria(extra)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := api.clientCtx.Client.Status(context.Background())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get node status")
+	}
+	latest := status.SyncInfo.LatestBlockHeight
+	nextHeight := latest + 1
+	if crit.FromHeight > 0 {
+		if crit.FromHeight < status.SyncInfo.EarliestBlockHeight {
+			return nil, errors.Errorf("fromHeight %d is pruned, earliest height is %d", crit.FromHeight, status.SyncInfo.EarliestBlockHeight)
+		}
+		if latest-crit.FromHeight > maxXmsgStatusReplayBlocks {
+			return nil, errors.Errorf("fromHeight %d is more than %d blocks behind", crit.FromHeight, maxXmsgStatusReplayBlocks)
+		}
+		nextHeight = crit.FromHeight
+	}
+
+	sub, unsubFn, err := api.events.SubscribeNewHeads()
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating block filter")
+	}
+
+	// pushXmsgStatus pushes the transitions of the blocks until the given height
+	// the blocks whose results can't be read yet are retried on the next header
+	pushXmsgStatus := func(height int64) error {
+		for ; nextHeight <= height; nextHeight++ {
+			notifications, err := api.xmsgStatusNotifications(nextHeight, crit)
+			if err != nil {
+				api.logger.Debug("failed to read xmsg status transitions", "height", nextHeight, "error", err.Error())
+				return nil
+			}
+			for _, notification := range notifications {
+				res := &SubscriptionNotification{
+					Jsonrpc: "2.0",
+					Method:  "eth_subscription",
+					Params: &SubscriptionResult{
+						Subscription: subID,
+						Result:       notification,
+					},
+				}
+				if err := wsConn.WriteJSON(res); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	go func() {
+		headersCh := sub.Event()
+		errCh := sub.Err()
+
+		// replay the blocks since the requested height
+		err := pushXmsgStatus(latest)
+		for err == nil {
+			select {
+			case event, ok := <-headersCh:
+				if !ok {
+					return
+				}
+
+				data, ok := event.Data.(tmtypes.EventDataNewBlockHeader)
+				if !ok {
+					api.logger.Debug("event data type mismatch", "type", fmt.Sprintf("%T", event.Data))
+					continue
+				}
+				err = pushXmsgStatus(data.Header.Height)
+			case err, ok := <-errCh:
+				if !ok {
+					return
+				}
+				api.logger.Debug("dropping XmsgStatus WebSocket subscription", "subscription-id", subID, "error", err.Error())
+			}
+		}
+
+		api.logger.Error("error writing xmsg status, will drop peer", "error", err.Error())
+		try(func() {
+			if !errors.Is(err, websocket.ErrCloseSent) {
+				err = wsConn.Close()
+				if err != nil {
+					api.logger.Debug("error closing websocket peer", "error", err.Error())
+				}
+			}
+		}, api.logger, "closing websocket peer sub")
+	}()
+
+	return unsubFn, nil
+}
+
+// xmsgStatusNotifications returns the xmsg status transitions of a block matching the criteria
+// in execution order: begin blocker, txs and end blocker
+func (api *pubSubAPI) xmsgStatusNotifications(height int64, crit XmsgStatusCriteria) ([]XmsgStatusNotification, error) {
+	ctx := context.Background()
+	results, err := api.clientCtx.Client.BlockResults(ctx, &height)
+	if err != nil {
+		return nil, err
+	}
+
+	var beginBlockEvents, endBlockEvents []abci.Event
+	for _, event := range results.FinalizeBlockEvents {
+		if isBeginBlockEvent(event) {
+			beginBlockEvents = append(beginBlockEvents, event)
+		} else {
+			endBlockEvents = append(endBlockEvents, event)
+		}
+	}
+
+	var notifications []XmsgStatusNotification
+	appendNotifications := func(events []abci.Event, txHash string) error {
+		statusChanges, err := xmsgtypes.ParseXmsgStatusChangedEvents(events)
+		if err != nil {
+			return err
+		}
+		for _, statusChanged := range statusChanges {
+			if statusChanged.Matches(crit.XmsgIndex, crit.ChainID) {
+				notifications = append(notifications, XmsgStatusNotification{
+					Height: height,
+					TxHash: txHash,
+					Event:  statusChanged,
+				})
+			}
+		}
+		return nil
+	}
+
+	if err := appendNotifications(beginBlockEvents, ""); err != nil {
+		return nil, err
+	}
+
+	var block *tmtypes.Block
+	for i, txResult := range results.TxsResults {
+		if !txResult.IsOK() {
+			continue
+		}
+		// the block is only needed for the hash of the txs with transitions
+		txHash := ""
+		for _, event := range txResult.Events {
+			if event.Type != xmsgtypes.EventTypeXmsgStatusChanged {
+				continue
+			}
+			if block == nil {
+				resBlock, err := api.clientCtx.Client.Block(ctx, &height)
+				if err != nil {
+					return nil, err
+				}
+				block = resBlock.Block
+			}
+			txHash = fmt.Sprintf("%X", block.Txs[i].Hash())
+			break
+		}
+		if txHash == "" {
+			continue
+		}
+		if err := appendNotifications(txResult.Events, txHash); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := appendNotifications(endBlockEvents, ""); err != nil {
+		return nil, err
+	}
+	return notifications, nil
+}
+
+// isBeginBlockEvent returns true if the finalize block event was emitted by the begin blocker
+func isBeginBlockEvent(event abci.Event) bool {
+	for _, attr := range event.Attributes {
+		if attr.Key == "mode" {
+			return attr.Value == "BeginBlock"
+		}
+	}
+	return false
+}
@@ -49,6 +49,7 @@ func EmissionKeeperWithMockOptions(
 	authorityKeeper := initAuthorityKeeper(cdc, db, stateStore)
 
 	// Create pell keepers
+	lightclientKeeperTmp := initLightclientKeeper(cdc, db, stateStore, authorityKeeper)
 	observerKeeperTmp := initRelayerKeeper(
 		cdc,
 		db,
@@ -57,8 +58,9 @@ func EmissionKeeperWithMockOptions(
 		sdkKeepers.SlashingKeeper,
 		sdkKeepers.ParamsKeeper,
 		authorityKeeper,
-		initLightclientKeeper(cdc, db, stateStore, authorityKeeper),
+		lightclientKeeperTmp,
 	)
+	lightclientKeeperTmp.SetRelayerKeeper(observerKeeperTmp)
 
 	pellKeepers := PellKeepers{
 		ObserverKeeper: observerKeeperTmp,
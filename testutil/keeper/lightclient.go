@@ -34,13 +34,14 @@ func initLightclientKeeper(
 	db *dbm.MemDB,
 	ss store.CommitMultiStore,
 	authorityKeeper types.AuthorityKeeper,
-) keeper.Keeper {
+) *keeper.Keeper {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 	memKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
 	ss.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
 	ss.MountStoreWithDB(memKey, storetypes.StoreTypeMemory, db)
 
-	return keeper.NewKeeper(cdc, storeKey, memKey, authorityKeeper)
+	k := keeper.NewKeeper(cdc, storeKey, memKey, authorityKeeper)
+	return &k
 }
 
 // LightclientKeeperWithMocks instantiates a lightclient keeper for testing purposes with the option to mock specific keepers
@@ -58,7 +59,32 @@ func LightclientKeeperWithMocks(t testing.TB, mockOptions LightclientMockOptions
 	// Create regular keepers
 	sdkKeepers := NewSDKKeepers(cdc, amino, db, stateStore)
 
-	// Create the observer keeper
+	// Initialize mocks for mocked keepers
+	var authorityKeeper types.AuthorityKeeper = authorityKeeperTmp
+	if mockOptions.UseAuthorityMock {
+		authorityKeeper = lightclientmocks.NewLightclientAuthorityKeeper(t)
+	}
+
+	k := keeper.NewKeeper(cdc, storeKey, memStoreKey, authorityKeeper)
+
+	// Create the relayer keeper providing the chain registry
+	relayerKeeperTmp := initRelayerKeeper(
+		cdc,
+		db,
+		stateStore,
+		sdkKeepers.StakingKeeper,
+		sdkKeepers.SlashingKeeper,
+		sdkKeepers.ParamsKeeper,
+		authorityKeeperTmp,
+		&k,
+	)
+	k.SetRelayerKeeper(relayerKeeperTmp)
+	pellKeepers := PellKeepers{
+		ObserverKeeper:  relayerKeeperTmp,
+		AuthorityKeeper: &authorityKeeperTmp,
+	}
+
+	// Create the lightclient keeper
 	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
 	stateStore.MountStoreWithDB(memStoreKey, storetypes.StoreTypeMemory, nil)
 	require.NoError(t, stateStore.LoadLatestVersion())
@@ -67,21 +93,12 @@ func LightclientKeeperWithMocks(t testing.TB, mockOptions LightclientMockOptions
 
 	// Initialize modules genesis
 	sdkKeepers.InitGenesis(ctx)
+	pellKeepers.InitGenesis(ctx)
 
 	// Add a proposer to the context
 	ctx = sdkKeepers.InitBlockProposer(t, ctx)
 
-	// Initialize mocks for mocked keepers
-	var authorityKeeper types.AuthorityKeeper = authorityKeeperTmp
-	if mockOptions.UseAuthorityMock {
-		authorityKeeper = lightclientmocks.NewLightclientAuthorityKeeper(t)
-	}
-
-	k := keeper.NewKeeper(cdc, storeKey, memStoreKey, authorityKeeper)
-
-	return &k, ctx, sdkKeepers, PellKeepers{
-		AuthorityKeeper: &authorityKeeperTmp,
-	}
+	return &k, ctx, sdkKeepers, pellKeepers
 }
 
 // LightclientKeeper instantiates an lightclient keeper for testing purposes
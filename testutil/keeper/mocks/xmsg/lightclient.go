@@ -15,6 +15,34 @@ type XmsgLightclientKeeper struct {
 	mock.Mock
 }
 
+// GetBlockHeader provides a mock function with given fields: ctx, hash
+func (_m *XmsgLightclientKeeper) GetBlockHeader(ctx types.Context, hash []byte) (proofs.BlockHeader, bool) {
+	ret := _m.Called(ctx, hash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlockHeader")
+	}
+
+	var r0 proofs.BlockHeader
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(types.Context, []byte) (proofs.BlockHeader, bool)); ok {
+		return rf(ctx, hash)
+	}
+	if rf, ok := ret.Get(0).(func(types.Context, []byte) proofs.BlockHeader); ok {
+		r0 = rf(ctx, hash)
+	} else {
+		r0 = ret.Get(0).(proofs.BlockHeader)
+	}
+
+	if rf, ok := ret.Get(1).(func(types.Context, []byte) bool); ok {
+		r1 = rf(ctx, hash)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // VerifyProof provides a mock function with given fields: ctx, proof, chainID, blockHash, txIndex
 func (_m *XmsgLightclientKeeper) VerifyProof(ctx types.Context, proof *proofs.Proof, chainID int64, blockHash string, txIndex int64) ([]byte, error) {
 	ret := _m.Called(ctx, proof, chainID, blockHash, txIndex)
@@ -45,6 +73,36 @@ func (_m *XmsgLightclientKeeper) VerifyProof(ctx types.Context, proof *proofs.Pr
 	return r0, r1
 }
 
+// VerifyReceiptProof provides a mock function with given fields: ctx, proof, chainID, blockHash, txIndex
+func (_m *XmsgLightclientKeeper) VerifyReceiptProof(ctx types.Context, proof *proofs.Proof, chainID int64, blockHash string, txIndex int64) ([]byte, error) {
+	ret := _m.Called(ctx, proof, chainID, blockHash, txIndex)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyReceiptProof")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(types.Context, *proofs.Proof, int64, string, int64) ([]byte, error)); ok {
+		return rf(ctx, proof, chainID, blockHash, txIndex)
+	}
+	if rf, ok := ret.Get(0).(func(types.Context, *proofs.Proof, int64, string, int64) []byte); ok {
+		r0 = rf(ctx, proof, chainID, blockHash, txIndex)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(types.Context, *proofs.Proof, int64, string, int64) error); ok {
+		r1 = rf(ctx, proof, chainID, blockHash, txIndex)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewXmsgLightclientKeeper creates a new instance of XmsgLightclientKeeper. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewXmsgLightclientKeeper(t interface {
@@ -498,9 +498,9 @@ func (_m *XmsgPevmKeeper) GetSystemContract(ctx types.Context) (pevmtypes.System
 	return r0, r1
 }
 
-// PELLRevertAndCallContract provides a mock function with given fields: ctx, sender, to, inboundSenderChainID, destinationChainID, indexBytes
-func (_m *XmsgPevmKeeper) PELLRevertAndCallContract(ctx types.Context, sender common.Address, to common.Address, inboundSenderChainID int64, destinationChainID int64, indexBytes [32]byte) (*evmtypes.MsgEthereumTxResponse, error) {
-	ret := _m.Called(ctx, sender, to, inboundSenderChainID, destinationChainID, indexBytes)
+// PELLRevertAndCallContract provides a mock function with given fields: ctx, sender, to, inboundSenderChainID, destinationChainID, remainingPellValue, message, indexBytes
+func (_m *XmsgPevmKeeper) PELLRevertAndCallContract(ctx types.Context, sender common.Address, to common.Address, inboundSenderChainID int64, destinationChainID int64, remainingPellValue *big.Int, message []byte, indexBytes [32]byte) (*evmtypes.MsgEthereumTxResponse, error) {
+	ret := _m.Called(ctx, sender, to, inboundSenderChainID, destinationChainID, remainingPellValue, message, indexBytes)
 
 	if len(ret) == 0 {
 		panic("no return value specified for PELLRevertAndCallContract")
@@ -508,19 +508,19 @@ func (_m *XmsgPevmKeeper) PELLRevertAndCallContract(ctx types.Context, sender co
 
 	var r0 *evmtypes.MsgEthereumTxResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(types.Context, common.Address, common.Address, int64, int64, [32]byte) (*evmtypes.MsgEthereumTxResponse, error)); ok {
-		return rf(ctx, sender, to, inboundSenderChainID, destinationChainID, indexBytes)
+	if rf, ok := ret.Get(0).(func(types.Context, common.Address, common.Address, int64, int64, *big.Int, []byte, [32]byte) (*evmtypes.MsgEthereumTxResponse, error)); ok {
+		return rf(ctx, sender, to, inboundSenderChainID, destinationChainID, remainingPellValue, message, indexBytes)
 	}
-	if rf, ok := ret.Get(0).(func(types.Context, common.Address, common.Address, int64, int64, [32]byte) *evmtypes.MsgEthereumTxResponse); ok {
-		r0 = rf(ctx, sender, to, inboundSenderChainID, destinationChainID, indexBytes)
+	if rf, ok := ret.Get(0).(func(types.Context, common.Address, common.Address, int64, int64, *big.Int, []byte, [32]byte) *evmtypes.MsgEthereumTxResponse); ok {
+		r0 = rf(ctx, sender, to, inboundSenderChainID, destinationChainID, remainingPellValue, message, indexBytes)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*evmtypes.MsgEthereumTxResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(types.Context, common.Address, common.Address, int64, int64, [32]byte) error); ok {
-		r1 = rf(ctx, sender, to, inboundSenderChainID, destinationChainID, indexBytes)
+	if rf, ok := ret.Get(1).(func(types.Context, common.Address, common.Address, int64, int64, *big.Int, []byte, [32]byte) error); ok {
+		r1 = rf(ctx, sender, to, inboundSenderChainID, destinationChainID, remainingPellValue, message, indexBytes)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -307,6 +307,34 @@ func (_m *XmsgRelayerKeeper) GetBallot(ctx types.Context, index string) (relayer
 	return r0, r1
 }
 
+// GetChainByChainID provides a mock function with given fields: ctx, chainID
+func (_m *XmsgRelayerKeeper) GetChainByChainID(ctx types.Context, chainID int64) (chains.Chain, bool) {
+	ret := _m.Called(ctx, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetChainByChainID")
+	}
+
+	var r0 chains.Chain
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(types.Context, int64) (chains.Chain, bool)); ok {
+		return rf(ctx, chainID)
+	}
+	if rf, ok := ret.Get(0).(func(types.Context, int64) chains.Chain); ok {
+		r0 = rf(ctx, chainID)
+	} else {
+		r0 = ret.Get(0).(chains.Chain)
+	}
+
+	if rf, ok := ret.Get(1).(func(types.Context, int64) bool); ok {
+		r1 = rf(ctx, chainID)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // GetChainNonces provides a mock function with given fields: ctx, index
 func (_m *XmsgRelayerKeeper) GetChainNonces(ctx types.Context, index string) (relayertypes.ChainNonces, bool) {
 	ret := _m.Called(ctx, index)
@@ -707,6 +735,88 @@ func (_m *XmsgRelayerKeeper) GetTssAddress(goCtx context.Context, req *relayerty
 	return r0, r1
 }
 
+// GetTssRotation provides a mock function with given fields: ctx
+func (_m *XmsgRelayerKeeper) GetTssRotation(ctx types.Context) (relayertypes.TssRotation, bool) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTssRotation")
+	}
+
+	var r0 relayertypes.TssRotation
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(types.Context) (relayertypes.TssRotation, bool)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(types.Context) relayertypes.TssRotation); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(relayertypes.TssRotation)
+	}
+
+	if rf, ok := ret.Get(1).(func(types.Context) bool); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// IsChainInboundPaused provides a mock function with given fields: ctx, chainID
+func (_m *XmsgRelayerKeeper) IsChainInboundPaused(ctx types.Context, chainID int64) bool {
+	ret := _m.Called(ctx, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsChainInboundPaused")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(types.Context, int64) bool); ok {
+		r0 = rf(ctx, chainID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// IsChainOutboundPaused provides a mock function with given fields: ctx, chainID
+func (_m *XmsgRelayerKeeper) IsChainOutboundPaused(ctx types.Context, chainID int64) bool {
+	ret := _m.Called(ctx, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsChainOutboundPaused")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(types.Context, int64) bool); ok {
+		r0 = rf(ctx, chainID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// IsChainSigningPaused provides a mock function with given fields: ctx, chainID
+func (_m *XmsgRelayerKeeper) IsChainSigningPaused(ctx types.Context, chainID int64) bool {
+	ret := _m.Called(ctx, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsChainSigningPaused")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(types.Context, int64) bool); ok {
+		r0 = rf(ctx, chainID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // IsInboundEnabled provides a mock function with given fields: ctx
 func (_m *XmsgRelayerKeeper) IsInboundEnabled(ctx types.Context) bool {
 	ret := _m.Called(ctx)
@@ -743,6 +853,24 @@ func (_m *XmsgRelayerKeeper) IsNonTombstonedObserver(ctx types.Context, address
 	return r0
 }
 
+// IsTssRotationInProgress provides a mock function with given fields: ctx
+func (_m *XmsgRelayerKeeper) IsTssRotationInProgress(ctx types.Context) bool {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsTssRotationInProgress")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(types.Context) bool); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // RemoveAllExistingMigrators provides a mock function with given fields: ctx
 func (_m *XmsgRelayerKeeper) RemoveAllExistingMigrators(ctx types.Context) {
 	_m.Called(ctx)
@@ -773,6 +901,11 @@ func (_m *XmsgRelayerKeeper) SetFundMigrator(ctx types.Context, fm relayertypes.
 	_m.Called(ctx, fm)
 }
 
+// SetInboundEnabled provides a mock function with given fields: ctx, enabled
+func (_m *XmsgRelayerKeeper) SetInboundEnabled(ctx types.Context, enabled bool) {
+	_m.Called(ctx, enabled)
+}
+
 // SetKeygen provides a mock function with given fields: ctx, keygen
 func (_m *XmsgRelayerKeeper) SetKeygen(ctx types.Context, keygen relayertypes.Keygen) {
 	_m.Called(ctx, keygen)
@@ -813,6 +946,29 @@ func (_m *XmsgRelayerKeeper) SetTssAndUpdateNonce(ctx types.Context, tss relayer
 	_m.Called(ctx, tss)
 }
 
+// SetTssRotation provides a mock function with given fields: ctx, rotation
+func (_m *XmsgRelayerKeeper) SetTssRotation(ctx types.Context, rotation relayertypes.TssRotation) {
+	_m.Called(ctx, rotation)
+}
+
+// SetTssRotationStage provides a mock function with given fields: ctx, rotation, stage, message
+func (_m *XmsgRelayerKeeper) SetTssRotationStage(ctx types.Context, rotation relayertypes.TssRotation, stage relayertypes.TssRotationStage, message string) relayertypes.TssRotation {
+	ret := _m.Called(ctx, rotation, stage, message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetTssRotationStage")
+	}
+
+	var r0 relayertypes.TssRotation
+	if rf, ok := ret.Get(0).(func(types.Context, relayertypes.TssRotation, relayertypes.TssRotationStage, string) relayertypes.TssRotation); ok {
+		r0 = rf(ctx, rotation, stage, message)
+	} else {
+		r0 = ret.Get(0).(relayertypes.TssRotation)
+	}
+
+	return r0
+}
+
 // VoteOnAddGasTokenBallot provides a mock function with given fields: ctx, chainId, voter, voteIndex
 func (_m *XmsgRelayerKeeper) VoteOnAddGasTokenBallot(ctx types.Context, chainId int64, voter string, voteIndex uint64) (bool, bool, error) {
 	ret := _m.Called(ctx, chainId, voter, voteIndex)
@@ -108,10 +108,11 @@ func PevmKeeperWithMocks(t testing.TB, mockOptions PevmMockOptions) (*keeper.Kee
 		authorityKeeperTmp,
 		lightclientKeeperTmp,
 	)
+	lightclientKeeperTmp.SetRelayerKeeper(observerKeeperTmp)
 	pellKeepers := PellKeepers{
 		ObserverKeeper:    observerKeeperTmp,
 		AuthorityKeeper:   &authorityKeeperTmp,
-		LightclientKeeper: &lightclientKeeperTmp,
+		LightclientKeeper: lightclientKeeperTmp,
 	}
 	var observerKeeper types.RelayerKeeper = observerKeeperTmp
 	var authorityKeeper types.AuthorityKeeper = authorityKeeperTmp
@@ -17,6 +17,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/0xPellNetwork/aegis/pkg/chains"
 	relayermocks "github.com/0xPellNetwork/aegis/testutil/keeper/mocks/relayer"
 	"github.com/0xPellNetwork/aegis/x/relayer/keeper"
 	"github.com/0xPellNetwork/aegis/x/relayer/types"
@@ -126,8 +127,12 @@ func RelayerKeeperWithMocks(t testing.TB, mockOptions RelayerMockOptions) (*keep
 		authorityKeeper,
 		lightclientKeeper,
 	)
+	lightclientKeeperTmp.SetRelayerKeeper(k)
 
 	k.SetParams(ctx, types.DefaultParams())
+	for _, chain := range chains.ChainsList() {
+		k.SetChain(ctx, chain)
+	}
 
 	pevmKeeperTmp := initPevmKeeper(cdc, db, stateStore,
 		sdkKeepers.AuthKeeper, sdkKeepers.BankKeeper, sdkKeepers.EvmKeeper, k, authorityKeeper)
@@ -76,6 +76,7 @@ func XmsgKeeperWithMocks(
 		authorityKeeperTmp,
 		lightclientKeeperTmp,
 	)
+	lightclientKeeperTmp.SetRelayerKeeper(observerKeeperTmp)
 	pevmKeeperTmp := initPevmKeeper(
 		cdc,
 		db,
@@ -221,6 +222,8 @@ func MockProcessFailedOutboundForPEVMTx_pell(m *xmsgmocks.XmsgPevmKeeper, ctx sd
 		ethcommon.HexToAddress(xmsg.GetCurrentOutTxParam().Receiver),
 		xmsg.GetInboundTxParams().SenderChainId,
 		xmsg.GetCurrentOutTxParam().ReceiverChainId,
+		mock.Anything,
+		mock.Anything,
 		indexBytes,
 	).Return(nil, nil)
 }
@@ -1,8 +1,12 @@
 package sample
 
 import (
+	"crypto/sha256"
+	"math/big"
+	"sort"
 	"testing"
 
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rlp"
@@ -75,3 +79,253 @@ func Proof(t *testing.T) (*proofs.Proof, proofs.BlockHeader, string, int64, int6
 	txHash := txs[txIndex].Hash()
 	return ethProof, blockHeader, header.Hash().Hex(), int64(txIndex), chainID, txHash
 }
+
+// ReceiptProof generates a receipt proof for the tx proven by Proof
+// returns the receipt proof and the receipt
+func ReceiptProof(t *testing.T) (*proofs.Proof, *ethtypes.Receipt) {
+	var receipts ethtypes.Receipts
+	for i := 0; i < testdata.TxsCount; i++ {
+		receipt, err := testdata.ReadEthReceipt(i)
+		require.NoError(t, err)
+		receipts = append(receipts, &receipt)
+	}
+	receiptsTree := ethereum.NewTrie(receipts)
+
+	// same index as the tx proven by Proof
+	txIndex := 2
+	proof, err := receiptsTree.GenerateProof(txIndex)
+	require.NoError(t, err)
+
+	return proofs.NewEthereumProof(proof), receipts[txIndex]
+}
+
+// SyncCommittee is a sync committee of deterministic secret keys able to sign light client updates
+type SyncCommittee struct {
+	SecretKeys []*big.Int
+	Committee  lightclienttypes.SyncCommittee
+}
+
+// NewSyncCommittee returns a sync committee with secret keys derived from the seed
+func NewSyncCommittee(t *testing.T, seed int64) SyncCommittee {
+	r := newRandFromSeed(seed)
+
+	secretKeys := make([]*big.Int, 0, ethereum.SyncCommitteeSize)
+	pubkeys := make([][]byte, 0, ethereum.SyncCommitteeSize)
+	for i := 0; i < ethereum.SyncCommitteeSize; i++ {
+		sk := new(big.Int).Rand(r, fr.Modulus())
+		secretKeys = append(secretKeys, sk)
+		pubkeys = append(pubkeys, ethereum.BLSPubkey(sk))
+	}
+	aggregatePubkey, err := ethereum.AggregatePubkeys(pubkeys)
+	require.NoError(t, err)
+
+	return SyncCommittee{
+		SecretKeys: secretKeys,
+		Committee: lightclienttypes.SyncCommittee{
+			Pubkeys:         pubkeys,
+			AggregatePubkey: aggregatePubkey,
+		},
+	}
+}
+
+// BeaconConfig returns a beacon config with a single fork active from genesis
+func BeaconConfig() lightclienttypes.BeaconConfig {
+	return lightclienttypes.BeaconConfig{
+		GenesisValidatorsRoot: Hash().Bytes(),
+		Forks: []lightclienttypes.Fork{
+			{Version: []byte{0x03, 0x00, 0x00, 0x00}, Epoch: 0, Name: ethereum.ForkCapella},
+		},
+	}
+}
+
+// MerkleBranch returns a branch of random nodes for the leaf at the generalized index and the resulting root
+func MerkleBranch(leaf [32]byte, gindex uint64) ([][]byte, []byte) {
+	var branch [][]byte
+	value := leaf
+	for index := gindex; index > 1; index /= 2 {
+		sibling := Hash()
+		branch = append(branch, sibling.Bytes())
+		if index%2 == 1 {
+			value = sha256.Sum256(append(sibling.Bytes(), value[:]...))
+		} else {
+			value = sha256.Sum256(append(value[:], sibling.Bytes()...))
+		}
+	}
+	return branch, value[:]
+}
+
+// MerkleBranches returns the branches of the leaves at their generalized indices in a tree of random nodes
+// and the resulting root
+func MerkleBranches(leaves map[uint64][32]byte) (map[uint64][][]byte, []byte) {
+	nodes := make(map[uint64][32]byte)
+	var pending []uint64
+	for gindex, leaf := range leaves {
+		nodes[gindex] = leaf
+		pending = append(pending, gindex)
+	}
+
+	// the nodes are computed from the deepest index so the subtrees of the siblings are computed first
+	for len(pending) > 0 {
+		sort.Slice(pending, func(i, j int) bool { return pending[i] > pending[j] })
+		index := pending[0]
+		pending = pending[1:]
+		if index == 1 {
+			break
+		}
+		if _, found := nodes[index/2]; found {
+			continue
+		}
+		sibling, found := nodes[index^1]
+		if !found {
+			sibling = [32]byte(Hash())
+			nodes[index^1] = sibling
+		}
+		value := nodes[index]
+		if index%2 == 1 {
+			nodes[index/2] = sha256.Sum256(append(sibling[:], value[:]...))
+		} else {
+			nodes[index/2] = sha256.Sum256(append(value[:], sibling[:]...))
+		}
+		pending = append(pending, index/2)
+	}
+
+	branches := make(map[uint64][][]byte)
+	for gindex := range leaves {
+		for index := gindex; index > 1; index /= 2 {
+			sibling := nodes[index^1]
+			branches[gindex] = append(branches[gindex], sibling[:])
+		}
+	}
+	root := nodes[1]
+	return branches, root[:]
+}
+
+// Bootstrap returns a trusted beacon block header at the slot whose state commits to the sync committee
+// and the branch of the sync committee at the generalized index of the fork of the slot
+func (c SyncCommittee) Bootstrap(
+	t *testing.T,
+	config lightclienttypes.BeaconConfig,
+	slot uint64,
+) (lightclienttypes.BeaconBlockHeader, [][]byte) {
+	committeeRoot, err := c.Committee.HashTreeRoot()
+	require.NoError(t, err)
+	gindex, err := config.SyncCommitteeGindex(slot, false)
+	require.NoError(t, err)
+	branch, stateRoot := MerkleBranch(committeeRoot, gindex)
+
+	return lightclienttypes.BeaconBlockHeader{
+		Slot:          slot,
+		ProposerIndex: 42,
+		ParentRoot:    Hash().Bytes(),
+		StateRoot:     stateRoot,
+		BodyRoot:      Hash().Bytes(),
+	}, branch
+}
+
+// LightClientUpdate returns an update of the attested slot proving the finalized header two epochs before,
+// the execution block hash of the finalized header and optionally the next sync committee,
+// signed by all the members of the sync committee in the next slot.
+// the proofs use the generalized indices of the forks of the attested and finalized slots
+func (c SyncCommittee) LightClientUpdate(
+	t *testing.T,
+	config lightclienttypes.BeaconConfig,
+	attestedSlot uint64,
+	executionBlockHash []byte,
+	next *SyncCommittee,
+) lightclienttypes.LightClientUpdate {
+	finalizedSlot := uint64(1)
+	if attestedSlot > 2*ethereum.SlotsPerEpoch {
+		finalizedSlot = attestedSlot - 2*ethereum.SlotsPerEpoch
+	}
+
+	var leaf [32]byte
+	copy(leaf[:], executionBlockHash)
+	executionGindex, err := config.ExecutionBlockHashGindex(finalizedSlot)
+	require.NoError(t, err)
+	executionBranch, bodyRoot := MerkleBranch(leaf, executionGindex)
+	finalizedHeader := lightclienttypes.BeaconBlockHeader{
+		Slot:          finalizedSlot,
+		ProposerIndex: 42,
+		ParentRoot:    Hash().Bytes(),
+		StateRoot:     Hash().Bytes(),
+		BodyRoot:      bodyRoot,
+	}
+	finalizedRoot, err := finalizedHeader.HashTreeRoot()
+	require.NoError(t, err)
+
+	// the attested state commits to the finalized header and the next sync committee
+	finalizedGindex, err := config.FinalizedRootGindex(attestedSlot)
+	require.NoError(t, err)
+	nextGindex, err := config.SyncCommitteeGindex(attestedSlot, true)
+	require.NoError(t, err)
+	leaves := map[uint64][32]byte{finalizedGindex: finalizedRoot}
+	if next != nil {
+		nextRoot, err := next.Committee.HashTreeRoot()
+		require.NoError(t, err)
+		leaves[nextGindex] = nextRoot
+	}
+	branches, stateRoot := MerkleBranches(leaves)
+
+	update := lightclienttypes.LightClientUpdate{
+		AttestedHeader: lightclienttypes.BeaconBlockHeader{
+			Slot:          attestedSlot,
+			ProposerIndex: 42,
+			ParentRoot:    Hash().Bytes(),
+			StateRoot:     stateRoot,
+			BodyRoot:      Hash().Bytes(),
+		},
+		FinalizedHeader:    finalizedHeader,
+		FinalityBranch:     branches[finalizedGindex],
+		ExecutionBlockHash: executionBlockHash,
+		ExecutionBranch:    executionBranch,
+		SignatureSlot:      attestedSlot + 1,
+	}
+	if next != nil {
+		update.NextSyncCommittee = &next.Committee
+		update.NextSyncCommitteeBranch = branches[nextGindex]
+	}
+
+	update.SyncAggregate = c.Sign(t, config, update.AttestedHeader, update.SignatureSlot, ethereum.SyncCommitteeSize)
+	return update
+}
+
+// Sign returns the sync aggregate of the header signed at the signature slot by the first participants of the committee
+func (c SyncCommittee) Sign(
+	t *testing.T,
+	config lightclienttypes.BeaconConfig,
+	header lightclienttypes.BeaconBlockHeader,
+	signatureSlot uint64,
+	participants int,
+) lightclienttypes.SyncAggregate {
+	forkVersion, err := config.ForkVersion(ethereum.Epoch(signatureSlot - 1))
+	require.NoError(t, err)
+	domain, err := ethereum.ComputeDomain(ethereum.DomainSyncCommittee, forkVersion, config.GenesisValidatorsRoot)
+	require.NoError(t, err)
+	headerRoot, err := header.HashTreeRoot()
+	require.NoError(t, err)
+	signingRoot := ethereum.ComputeSigningRoot(headerRoot, domain)
+
+	// the aggregate signature is the signature by the sum of the secret keys of the participants
+	bits := make([]byte, ethereum.SyncCommitteeSize/8)
+	sum := big.NewInt(0)
+	for i := 0; i < participants; i++ {
+		bits[i/8] |= 1 << (i % 8)
+		sum.Add(sum, c.SecretKeys[i])
+	}
+	signature, err := ethereum.BLSSign(sum.Mod(sum, fr.Modulus()), signingRoot[:])
+	require.NoError(t, err)
+
+	return lightclienttypes.SyncAggregate{
+		SyncCommitteeBits:      bits,
+		SyncCommitteeSignature: signature,
+	}
+}
+
+// EthereumHeader returns the recorded ethereum header of the test data as header data along with its hash
+func EthereumHeader(t *testing.T) (proofs.HeaderData, []byte, int64) {
+	header, err := testdata.ReadEthHeader()
+	require.NoError(t, err)
+	b, err := rlp.EncodeToBytes(&header)
+	require.NoError(t, err)
+	return proofs.NewEthereumHeader(b), header.Hash().Bytes(), header.Number.Int64()
+}
@@ -162,6 +162,17 @@ func BlameRecord_pell(t *testing.T, index string) types.Blame {
 	}
 }
 
+func RelayerPenalty_pell(t *testing.T, index string) types.RelayerPenalty {
+	r := newRandFromStringSeed(t, index)
+	return types.RelayerPenalty{
+		ObserverAddress:   AccAddress(),
+		WindowStartHeight: r.Int63(),
+		BlameCount:        r.Uint64(),
+		MissedBallotCount: r.Uint64(),
+		JailCount:         r.Uint64(),
+	}
+}
+
 func BlameRecordsList_pell(t *testing.T, n int) []types.Blame {
 	blameList := make([]types.Blame, n)
 	for i := 0; i < n; i++ {
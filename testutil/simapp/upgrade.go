@@ -0,0 +1,90 @@
+package simapp
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/app"
+)
+
+// SetupAtPreviousVersion boots an app from the genesis of the release preceding the upgrade:
+// the modules whose store is added by the upgrade have no genesis and no consensus version yet,
+// and the modules migrated by the upgrade are at the consensus version they are migrated from
+func SetupAtPreviousVersion(t *testing.T, upgrade app.Upgrade) (*app.PellApp, sdk.Context) {
+	pellApp, genesisState := setup(true, 0)
+	for _, storeKey := range upgrade.StoreUpgrades.Added {
+		delete(genesisState, storeKey)
+	}
+
+	stateBytes, err := json.MarshalIndent(genesisState, "", " ")
+	require.NoError(t, err)
+
+	_, err = pellApp.InitChain(
+		&abci.RequestInitChain{
+			ChainId:         "simnet_101-1",
+			Validators:      []abci.ValidatorUpdate{},
+			ConsensusParams: defaultConsensusParams,
+			AppStateBytes:   stateBytes,
+		},
+	)
+	require.NoError(t, err)
+
+	_, err = pellApp.Commit()
+	require.NoError(t, err)
+
+	ctx := pellApp.NewUncachedContext(false, cmtproto.Header{
+		ChainID: "simnet_101-1",
+		Height:  pellApp.LastBlockHeight() + 1,
+	})
+
+	previousVM := pellApp.ModuleManager.GetVersionMap()
+	for moduleName, version := range upgrade.FromVersions {
+		previousVM[moduleName] = version
+	}
+	for moduleName := range previousVM {
+		if slices.Contains(upgrade.StoreUpgrades.Added, moduleName) {
+			delete(previousVM, moduleName)
+		}
+	}
+	require.NoError(t, pellApp.UpgradeKeeper.SetModuleVersionMap(ctx, previousVM))
+
+	return pellApp, ctx
+}
+
+// RunUpgrade boots an app at the release preceding the upgrade and applies the upgrade
+func RunUpgrade(t *testing.T, upgrade app.Upgrade) (*app.PellApp, sdk.Context) {
+	pellApp, ctx := SetupAtPreviousVersion(t, upgrade)
+	ApplyUpgrade(t, pellApp, ctx, upgrade)
+	return pellApp, ctx
+}
+
+// ApplyUpgrade applies the upgrade and checks that all the modules are at their current consensus version
+// and that the invariants hold
+func ApplyUpgrade(t *testing.T, pellApp *app.PellApp, ctx sdk.Context, upgrade app.Upgrade) {
+	plan := upgradetypes.Plan{
+		Name:   upgrade.Name,
+		Height: ctx.BlockHeight(),
+	}
+	require.NoError(t, pellApp.UpgradeKeeper.ApplyUpgrade(ctx, plan))
+
+	vm, err := pellApp.UpgradeKeeper.GetModuleVersionMap(ctx)
+	require.NoError(t, err)
+	for moduleName, version := range pellApp.ModuleManager.GetVersionMap() {
+		require.Equal(t, version, vm[moduleName], "module %s", moduleName)
+	}
+
+	done, err := pellApp.UpgradeKeeper.GetDoneHeight(ctx, upgrade.Name)
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockHeight(), done)
+
+	require.NotPanics(t, func() {
+		pellApp.CrisisKeeper.AssertInvariants(ctx)
+	})
+}
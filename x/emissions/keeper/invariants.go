@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/0xPellNetwork/aegis/cmd/pellcored/config"
+	"github.com/0xPellNetwork/aegis/x/emissions/types"
+)
+
+// RegisterInvariants registers the emissions module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "withdrawable-emissions", WithdrawableEmissionsInvariant(k))
+}
+
+// WithdrawableEmissionsInvariant checks that the undistributed observer rewards pool holds
+// the emissions withdrawable by the observers
+func WithdrawableEmissionsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		total := sdkmath.ZeroInt()
+		for _, we := range k.GetAllWithdrawableEmission(ctx) {
+			if we.Amount.IsNil() {
+				continue
+			}
+			if we.Amount.IsNegative() {
+				broken = true
+				msg += fmt.Sprintf("\tnegative withdrawable emission %s for %s\n", we.Amount, we.Address)
+				continue
+			}
+			total = total.Add(we.Amount)
+		}
+
+		balance := k.GetBankKeeper().GetBalance(ctx, types.UndistributedObserverRewardsPoolAddress, config.BaseDenom)
+		if balance.Amount.LT(total) {
+			broken = true
+			msg += fmt.Sprintf("\twithdrawable emissions %s exceed the undistributed observer rewards pool balance %s\n", total, balance.Amount)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "withdrawable-emissions", msg), broken
+	}
+}
@@ -0,0 +1,53 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/cmd/pellcored/config"
+	keepertest "github.com/0xPellNetwork/aegis/testutil/keeper"
+	"github.com/0xPellNetwork/aegis/testutil/sample"
+	"github.com/0xPellNetwork/aegis/x/emissions/keeper"
+	"github.com/0xPellNetwork/aegis/x/emissions/types"
+)
+
+func TestWithdrawableEmissionsInvariant(t *testing.T) {
+	fundPool := func(t *testing.T, ctx sdk.Context, sk keepertest.SDKKeepers, amount sdkmath.Int) {
+		coins := sdk.NewCoins(sdk.NewCoin(config.BaseDenom, amount))
+		require.NoError(t, sk.BankKeeper.MintCoins(ctx, types.ModuleName, coins))
+		require.NoError(t, sk.BankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.UndistributedObserverRewardsPool, coins))
+	}
+
+	t.Run("should hold if the pool covers the withdrawable emissions", func(t *testing.T) {
+		k, ctx, sk, _ := keepertest.EmissionsKeeper(t)
+		k.SetWithdrawableEmission(ctx, types.WithdrawableEmissions{Address: sample.AccAddress(), Amount: sdkmath.NewInt(100)})
+		k.SetWithdrawableEmission(ctx, types.WithdrawableEmissions{Address: sample.AccAddress(), Amount: sdkmath.NewInt(50)})
+		fundPool(t, ctx, sk, sdkmath.NewInt(200))
+
+		_, broken := keeper.WithdrawableEmissionsInvariant(*k)(ctx)
+		require.False(t, broken)
+	})
+
+	t.Run("should break if the withdrawable emissions exceed the pool balance", func(t *testing.T) {
+		k, ctx, sk, _ := keepertest.EmissionsKeeper(t)
+		k.SetWithdrawableEmission(ctx, types.WithdrawableEmissions{Address: sample.AccAddress(), Amount: sdkmath.NewInt(100)})
+		k.SetWithdrawableEmission(ctx, types.WithdrawableEmissions{Address: sample.AccAddress(), Amount: sdkmath.NewInt(50)})
+		fundPool(t, ctx, sk, sdkmath.NewInt(120))
+
+		msg, broken := keeper.WithdrawableEmissionsInvariant(*k)(ctx)
+		require.True(t, broken)
+		require.Contains(t, msg, "withdrawable emissions 150 exceed")
+	})
+
+	t.Run("should break for a negative withdrawable emission", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.EmissionsKeeper(t)
+		k.SetWithdrawableEmission(ctx, types.WithdrawableEmissions{Address: sample.AccAddress(), Amount: sdkmath.NewInt(-1)})
+
+		msg, broken := keeper.WithdrawableEmissionsInvariant(*k)(ctx)
+		require.True(t, broken)
+		require.Contains(t, msg, "negative withdrawable emission")
+	})
+}
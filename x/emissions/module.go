@@ -135,7 +135,9 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 }
 
 // RegisterInvariants registers the emissions module's invariants.
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	emissionskeeper.RegisterInvariants(ir, am.keeper)
+}
 
 // InitGenesis performs the emissions module's genesis initialization It returns
 // no validator updates.
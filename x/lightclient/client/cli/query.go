@@ -26,6 +26,8 @@ func GetQueryCmd(_ string) *cobra.Command {
 		CmdShowChainState(),
 		CmdListChainState(),
 		CmdShowVerificationFlags(),
+		CmdShowSyncCommitteeState(),
+		CmdListSyncCommitteeState(),
 	)
 
 	return cmd
@@ -0,0 +1,83 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/0xPellNetwork/aegis/x/lightclient/types"
+)
+
+func CmdListSyncCommitteeState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-sync-committee-state",
+		Short: "List all the sync committee states",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllSyncCommitteeStateRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.SyncCommitteeStateAll(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowSyncCommitteeState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-sync-committee-state [chain-id]",
+		Short: "Show the sync committee state of a chain from its chain id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			chainID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetSyncCommitteeStateRequest{
+				ChainId: chainID,
+			}
+
+			res, err := queryClient.SyncCommitteeState(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
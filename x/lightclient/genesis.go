@@ -21,6 +21,11 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 
 	// set verification flags
 	k.SetVerificationFlags(ctx, genState.VerificationFlags)
+
+	// set sync committee states
+	for _, elem := range genState.SyncCommitteeStates {
+		k.SetSyncCommitteeState(ctx, elem)
+	}
 }
 
 // ExportGenesis returns the lightclient module's exported genesis.
@@ -31,8 +36,9 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	}
 
 	return &types.GenesisState{
-		BlockHeaders:      k.GetAllBlockHeaders(ctx),
-		ChainStates:       k.GetAllChainStates(ctx),
-		VerificationFlags: verificationFlags,
+		BlockHeaders:        k.GetAllBlockHeaders(ctx),
+		ChainStates:         k.GetAllChainStates(ctx),
+		VerificationFlags:   verificationFlags,
+		SyncCommitteeStates: k.GetAllSyncCommitteeStates(ctx),
 	}
 }
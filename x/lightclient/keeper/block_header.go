@@ -60,6 +60,7 @@ func (k Keeper) RemoveBlockHeader(ctx sdk.Context, hash []byte) {
 // It also checks that the block header does not already exist
 // It returns an error if the block header is invalid
 // Upon success, it returns the parent hash
+// Block headers of a chain in verifying mode must be attested by its sync committee and are rejected
 func (k Keeper) CheckNewBlockHeader(
 	ctx sdk.Context,
 	chainID int64,
@@ -72,6 +73,11 @@ func (k Keeper) CheckNewBlockHeader(
 		return nil, err
 	}
 
+	// headers of a chain in verifying mode are added through light client updates
+	if _, found := k.GetSyncCommitteeState(ctx, chainID); found {
+		return nil, cosmoserrors.Wrapf(types.ErrSyncCommitteeVerificationRequired, "chain id: %d", chainID)
+	}
+
 	// check if the block header already exists
 	if _, found := k.GetBlockHeader(ctx, blockHash); found {
 		return nil, cosmoserrors.Wrap(types.ErrBlockAlreadyExist, fmt.Sprintf("block hash: %x", blockHash))
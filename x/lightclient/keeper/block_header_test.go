@@ -225,6 +225,20 @@ func TestKeeper_CheckNewBlockHeader(t *testing.T) {
 		_, err := k.CheckNewBlockHeader(ctx, bh.ChainId, bh.Hash, bh.Height, bh.Header)
 		require.ErrorIs(t, err, types.ErrNoParentHash)
 	})
+
+	t.Run("fail if the chain is verified by a sync committee", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.LightclientKeeper(t)
+
+		k.SetVerificationFlags(ctx, types.VerificationFlags{
+			EthTypeChainEnabled: true,
+		})
+
+		bh, _, _ := sepoliaBlockHeaders(t)
+		k.SetSyncCommitteeState(ctx, types.SyncCommitteeState{ChainId: bh.ChainId})
+
+		_, err := k.CheckNewBlockHeader(ctx, bh.ChainId, bh.Hash, bh.Height, bh.Header)
+		require.ErrorIs(t, err, types.ErrSyncCommitteeVerificationRequired)
+	})
 }
 
 func TestKeeper_AddBlockHeader(t *testing.T) {
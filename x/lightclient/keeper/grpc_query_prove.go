@@ -9,7 +9,6 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
-	"github.com/0xPellNetwork/aegis/pkg/chains"
 	"github.com/0xPellNetwork/aegis/pkg/proofs"
 	"github.com/0xPellNetwork/aegis/x/lightclient/types"
 )
@@ -23,7 +22,8 @@ func (k Keeper) Prove(c context.Context, req *types.QueryProveRequest) (*types.Q
 	}
 	ctx := sdk.UnwrapSDKContext(c)
 
-	blockHash, err := chains.StringToHash(req.ChainId, req.BlockHash)
+	ethTypeChain := k.isEthTypeChain(ctx, req.ChainId)
+	blockHash, err := blockHashBytes(req.ChainId, ethTypeChain, req.BlockHash)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
@@ -39,7 +39,7 @@ func (k Keeper) Prove(c context.Context, req *types.QueryProveRequest) (*types.Q
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 	if err == nil {
-		if chains.IsEVMChain(req.ChainId) {
+		if ethTypeChain {
 			var txx ethtypes.Transaction
 			err = txx.UnmarshalBinary(txBytes)
 			if err != nil {
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/0xPellNetwork/aegis/x/lightclient/types"
+)
+
+// SyncCommitteeStateAll queries all sync committee states
+func (k Keeper) SyncCommitteeStateAll(
+	c context.Context,
+	req *types.QueryAllSyncCommitteeStateRequest,
+) (*types.QuerySyncCommitteeStateAllResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	stateStore := prefix.NewStore(store, types.KeyPrefix(types.SyncCommitteeStateKey))
+
+	var states []types.SyncCommitteeState
+	pageRes, err := query.Paginate(stateStore, req.Pagination, func(_ []byte, value []byte) error {
+		var state types.SyncCommitteeState
+		if err := k.cdc.Unmarshal(value, &state); err != nil {
+			return err
+		}
+
+		states = append(states, state)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QuerySyncCommitteeStateAllResponse{SyncCommitteeStates: states, Pagination: pageRes}, nil
+}
+
+// SyncCommitteeState queries the sync committee state of a chain
+func (k Keeper) SyncCommitteeState(
+	c context.Context,
+	req *types.QueryGetSyncCommitteeStateRequest,
+) (*types.QuerySyncCommitteeStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	state, found := k.GetSyncCommitteeState(sdk.UnwrapSDKContext(c), req.ChainId)
+	if !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("not found: chain id %d", req.ChainId))
+	}
+
+	return &types.QuerySyncCommitteeStateResponse{SyncCommitteeState: &state}, nil
+}
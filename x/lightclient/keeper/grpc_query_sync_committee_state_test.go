@@ -0,0 +1,67 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/0xPellNetwork/aegis/testutil/keeper"
+	"github.com/0xPellNetwork/aegis/x/lightclient/types"
+)
+
+func TestKeeper_SyncCommitteeStateAll(t *testing.T) {
+	t.Run("should error if req is nil", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.LightclientKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+
+		res, err := k.SyncCommitteeStateAll(wctx, nil)
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should return all sync committee states", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.LightclientKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+		k.SetSyncCommitteeState(ctx, types.SyncCommitteeState{ChainId: 42})
+		k.SetSyncCommitteeState(ctx, types.SyncCommitteeState{ChainId: 43})
+
+		res, err := k.SyncCommitteeStateAll(wctx, &types.QueryAllSyncCommitteeStateRequest{})
+		require.NoError(t, err)
+		require.Len(t, res.SyncCommitteeStates, 2)
+	})
+}
+
+func TestKeeper_SyncCommitteeState(t *testing.T) {
+	t.Run("should error if req is nil", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.LightclientKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+
+		res, err := k.SyncCommitteeState(wctx, nil)
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should error if not found", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.LightclientKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+
+		res, err := k.SyncCommitteeState(wctx, &types.QueryGetSyncCommitteeStateRequest{
+			ChainId: 42,
+		})
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should return if found", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.LightclientKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+		k.SetSyncCommitteeState(ctx, types.SyncCommitteeState{ChainId: 42, Period: 10})
+
+		res, err := k.SyncCommitteeState(wctx, &types.QueryGetSyncCommitteeStateRequest{
+			ChainId: 42,
+		})
+		require.NoError(t, err)
+		require.EqualValues(t, 10, res.SyncCommitteeState.Period)
+	})
+}
@@ -17,6 +17,7 @@ type Keeper struct {
 	storeKey        storetypes.StoreKey
 	memKey          storetypes.StoreKey
 	authorityKeeper types.AuthorityKeeper
+	relayerKeeper   types.RelayerKeeper
 }
 
 // NewKeeper creates new instances of the lightclient Keeper
@@ -58,3 +59,14 @@ func (k Keeper) GetCodec() codec.Codec {
 func (k Keeper) GetAuthorityKeeper() types.AuthorityKeeper {
 	return k.authorityKeeper
 }
+
+// SetRelayerKeeper sets the relayer keeper providing the chain registry
+// It is set after the creation of the relayer keeper which depends on the lightclient keeper
+func (k *Keeper) SetRelayerKeeper(relayerKeeper types.RelayerKeeper) {
+	k.relayerKeeper = relayerKeeper
+}
+
+// GetRelayerKeeper returns the relayer keeper
+func (k Keeper) GetRelayerKeeper() types.RelayerKeeper {
+	return k.relayerKeeper
+}
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	authoritytypes "github.com/0xPellNetwork/aegis/x/authority/types"
+	"github.com/0xPellNetwork/aegis/x/lightclient/types"
+)
+
+// BootstrapSyncCommittee bootstraps the sync committee of an Ethereum chain from a trusted beacon block header.
+// Once bootstrapped, the chain is in verifying mode: its block headers must be attested by the sync committee.
+// Bootstrapping again resets the tracked sync committee. Only admin policy can bootstrap the sync committee
+func (k msgServer) BootstrapSyncCommittee(goCtx context.Context, msg *types.MsgBootstrapSyncCommittee) (
+	*types.MsgBootstrapSyncCommitteeResponse,
+	error,
+) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// check permission
+	if !k.GetAuthorityKeeper().IsAuthorized(ctx, msg.Signer, authoritytypes.PolicyType_GROUP_ADMIN) {
+		return &types.MsgBootstrapSyncCommitteeResponse{}, authoritytypes.ErrUnauthorized
+	}
+
+	state, err := types.NewSyncCommitteeState(
+		msg.ChainId,
+		msg.Config,
+		msg.Header,
+		msg.CurrentSyncCommittee,
+		msg.CurrentSyncCommitteeBranch,
+	)
+	if err != nil {
+		return &types.MsgBootstrapSyncCommitteeResponse{}, err
+	}
+	k.SetSyncCommitteeState(ctx, state)
+
+	return &types.MsgBootstrapSyncCommitteeResponse{}, nil
+}
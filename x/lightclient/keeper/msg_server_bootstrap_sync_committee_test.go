@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/pkg/chains"
+	keepertest "github.com/0xPellNetwork/aegis/testutil/keeper"
+	"github.com/0xPellNetwork/aegis/testutil/sample"
+	authoritytypes "github.com/0xPellNetwork/aegis/x/authority/types"
+	"github.com/0xPellNetwork/aegis/x/lightclient/keeper"
+	"github.com/0xPellNetwork/aegis/x/lightclient/types"
+)
+
+func TestMsgServer_BootstrapSyncCommittee(t *testing.T) {
+	committee := sample.NewSyncCommittee(t, 1)
+	chainID := chains.EthChain().Id
+
+	t.Run("admin can bootstrap the sync committee", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.LightclientKeeperWithMocks(t, keepertest.LightclientMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetLightclientAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_ADMIN, true)
+
+		header, branch := committee.Bootstrap(t, sample.BeaconConfig(), bootstrapSlot)
+		_, err := srv.BootstrapSyncCommittee(sdk.WrapSDKContext(ctx), types.NewMsgBootstrapSyncCommittee(
+			admin,
+			chainID,
+			sample.BeaconConfig(),
+			header,
+			committee.Committee,
+			branch,
+		))
+		require.NoError(t, err)
+
+		state, found := k.GetSyncCommitteeState(ctx, chainID)
+		require.True(t, found)
+		require.EqualValues(t, 10, state.Period)
+		require.Equal(t, committee.Committee, state.CurrentSyncCommittee)
+	})
+
+	t.Run("cannot bootstrap the sync committee if not admin", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.LightclientKeeperWithMocks(t, keepertest.LightclientMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetLightclientAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_ADMIN, false)
+
+		header, branch := committee.Bootstrap(t, sample.BeaconConfig(), bootstrapSlot)
+		_, err := srv.BootstrapSyncCommittee(sdk.WrapSDKContext(ctx), types.NewMsgBootstrapSyncCommittee(
+			admin,
+			chainID,
+			sample.BeaconConfig(),
+			header,
+			committee.Committee,
+			branch,
+		))
+		require.ErrorIs(t, err, authoritytypes.ErrUnauthorized)
+
+		_, found := k.GetSyncCommitteeState(ctx, chainID)
+		require.False(t, found)
+	})
+
+	t.Run("cannot bootstrap the sync committee with an invalid branch", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.LightclientKeeperWithMocks(t, keepertest.LightclientMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetLightclientAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_ADMIN, true)
+
+		header, branch := committee.Bootstrap(t, sample.BeaconConfig(), bootstrapSlot)
+		header.StateRoot = sample.Hash().Bytes()
+		_, err := srv.BootstrapSyncCommittee(sdk.WrapSDKContext(ctx), types.NewMsgBootstrapSyncCommittee(
+			admin,
+			chainID,
+			sample.BeaconConfig(),
+			header,
+			committee.Committee,
+			branch,
+		))
+		require.ErrorIs(t, err, types.ErrInvalidSyncCommittee)
+	})
+}
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"context"
+
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/0xPellNetwork/aegis/x/lightclient/types"
+)
+
+// SubmitLightClientUpdate submits a light client update signed by the sync committee of an Ethereum chain.
+// The execution block header finalized by the update is added to the block headers without voting,
+// the sync committee is rotated if the update is signed by the next sync committee.
+// The update is verified against the tracked sync committee, it can be submitted by any account
+// and the verification is charged to the sender per participating sync committee member
+func (k msgServer) SubmitLightClientUpdate(goCtx context.Context, msg *types.MsgSubmitLightClientUpdate) (
+	*types.MsgSubmitLightClientUpdateResponse,
+	error,
+) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// check verification flags are set
+	if err := k.CheckVerificationFlagsEnabled(ctx, msg.ChainId); err != nil {
+		return nil, err
+	}
+
+	// charge the signature aggregation and the pairing before the verification
+	ctx.GasMeter().ConsumeGas(
+		types.LightClientUpdateGas+uint64(msg.Update.SyncAggregate.Participants())*types.LightClientUpdateGasPerParticipant,
+		"light client update verification",
+	)
+
+	state, found := k.GetSyncCommitteeState(ctx, msg.ChainId)
+	if !found {
+		return nil, cosmoserrors.Wrapf(types.ErrSyncCommitteeNotFound, "chain id %d", msg.ChainId)
+	}
+
+	newState, rotated, err := state.ApplyUpdate(msg.Update)
+	if err != nil {
+		return nil, err
+	}
+	k.SetSyncCommitteeState(ctx, newState)
+
+	// add the finalized execution block header if not already known
+	if _, found := k.GetBlockHeader(ctx, msg.Update.ExecutionBlockHash); !found {
+		height, err := msg.ExecutionHeader.EthereumHeight()
+		if err != nil {
+			return nil, cosmoserrors.Wrap(types.ErrInvalidBlockHeader, err.Error())
+		}
+		if err := msg.ExecutionHeader.Validate(msg.Update.ExecutionBlockHash, msg.ChainId, height); err != nil {
+			return nil, cosmoserrors.Wrap(types.ErrInvalidBlockHeader, err.Error())
+		}
+		parentHash, err := msg.ExecutionHeader.ParentHash()
+		if err != nil {
+			return nil, cosmoserrors.Wrap(types.ErrNoParentHash, err.Error())
+		}
+		k.AddBlockHeader(ctx, msg.ChainId, height, msg.Update.ExecutionBlockHash, msg.ExecutionHeader, parentHash)
+	}
+
+	return &types.MsgSubmitLightClientUpdateResponse{
+		SyncCommitteeRotated: rotated,
+	}, nil
+}
@@ -0,0 +1,171 @@
+package keeper_test
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/pkg/chains"
+	"github.com/0xPellNetwork/aegis/pkg/proofs/ethereum"
+	keepertest "github.com/0xPellNetwork/aegis/testutil/keeper"
+	"github.com/0xPellNetwork/aegis/testutil/sample"
+	"github.com/0xPellNetwork/aegis/x/lightclient/keeper"
+	"github.com/0xPellNetwork/aegis/x/lightclient/types"
+)
+
+// slotsPerPeriod is the number of slots in a sync committee period
+const slotsPerPeriod = ethereum.SlotsPerEpoch * ethereum.EpochsPerSyncCommitteePeriod
+
+// bootstrapSlot is the slot of the trusted header, in the middle of period 10
+const bootstrapSlot = 10*slotsPerPeriod + 100
+
+func TestMsgServer_SubmitLightClientUpdate(t *testing.T) {
+	committee := sample.NewSyncCommittee(t, 1)
+	next := sample.NewSyncCommittee(t, 2)
+	config := sample.BeaconConfig()
+	chainID := chains.EthChain().Id
+	executionHeader, blockHash, height := sample.EthereumHeader(t)
+
+	setup := func(t *testing.T) (*keeper.Keeper, sdk.Context) {
+		k, ctx, _, _ := keepertest.LightclientKeeper(t)
+		k.SetVerificationFlags(ctx, types.VerificationFlags{
+			EthTypeChainEnabled: true,
+		})
+		header, branch := committee.Bootstrap(t, sample.BeaconConfig(), bootstrapSlot)
+		state, err := types.NewSyncCommitteeState(chainID, config, header, committee.Committee, branch)
+		require.NoError(t, err)
+		k.SetSyncCommitteeState(ctx, state)
+		return k, ctx
+	}
+
+	t.Run("should add the block header attested by the sync committee", func(t *testing.T) {
+		k, ctx := setup(t)
+		srv := keeper.NewMsgServerImpl(*k)
+
+		update := committee.LightClientUpdate(t, config, bootstrapSlot+10, blockHash, nil)
+		res, err := srv.SubmitLightClientUpdate(sdk.WrapSDKContext(ctx), types.NewMsgSubmitLightClientUpdate(
+			sample.AccAddress(),
+			chainID,
+			update,
+			executionHeader,
+		))
+		require.NoError(t, err)
+		require.False(t, res.SyncCommitteeRotated)
+
+		bh, found := k.GetBlockHeader(ctx, blockHash)
+		require.True(t, found)
+		require.Equal(t, height, bh.Height)
+		chainState, found := k.GetChainState(ctx, chainID)
+		require.True(t, found)
+		require.Equal(t, height, chainState.LatestHeight)
+
+		state, found := k.GetSyncCommitteeState(ctx, chainID)
+		require.True(t, found)
+		require.EqualValues(t, bootstrapSlot+10, state.LatestAttestedSlot)
+		require.EqualValues(t, update.FinalizedHeader.Slot, state.LatestFinalizedSlot)
+	})
+
+	t.Run("should charge the verification per sync committee participant", func(t *testing.T) {
+		k, ctx := setup(t)
+		srv := keeper.NewMsgServerImpl(*k)
+		ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+		update := committee.LightClientUpdate(t, config, bootstrapSlot+10, blockHash, nil)
+		_, err := srv.SubmitLightClientUpdate(sdk.WrapSDKContext(ctx), types.NewMsgSubmitLightClientUpdate(
+			sample.AccAddress(),
+			chainID,
+			update,
+			executionHeader,
+		))
+		require.NoError(t, err)
+		require.GreaterOrEqual(
+			t,
+			ctx.GasMeter().GasConsumed(),
+			uint64(types.LightClientUpdateGas+ethereum.SyncCommitteeSize*types.LightClientUpdateGasPerParticipant),
+		)
+	})
+
+	t.Run("should rotate the sync committee", func(t *testing.T) {
+		k, ctx := setup(t)
+		srv := keeper.NewMsgServerImpl(*k)
+
+		update := committee.LightClientUpdate(t, config, bootstrapSlot+10, blockHash, &next)
+		res, err := srv.SubmitLightClientUpdate(sdk.WrapSDKContext(ctx), types.NewMsgSubmitLightClientUpdate(
+			sample.AccAddress(),
+			chainID,
+			update,
+			executionHeader,
+		))
+		require.NoError(t, err)
+		require.False(t, res.SyncCommitteeRotated)
+
+		// the block header is already known, only the sync committee is rotated
+		update = next.LightClientUpdate(t, config, 11*slotsPerPeriod+1, blockHash, nil)
+		res, err = srv.SubmitLightClientUpdate(sdk.WrapSDKContext(ctx), types.NewMsgSubmitLightClientUpdate(
+			sample.AccAddress(),
+			chainID,
+			update,
+			executionHeader,
+		))
+		require.NoError(t, err)
+		require.True(t, res.SyncCommitteeRotated)
+
+		state, found := k.GetSyncCommitteeState(ctx, chainID)
+		require.True(t, found)
+		require.EqualValues(t, 11, state.Period)
+		require.Equal(t, next.Committee, state.CurrentSyncCommittee)
+	})
+
+	t.Run("should fail if the sync committee is not bootstrapped", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.LightclientKeeper(t)
+		k.SetVerificationFlags(ctx, types.VerificationFlags{
+			EthTypeChainEnabled: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+
+		update := committee.LightClientUpdate(t, config, bootstrapSlot+10, blockHash, nil)
+		_, err := srv.SubmitLightClientUpdate(sdk.WrapSDKContext(ctx), types.NewMsgSubmitLightClientUpdate(
+			sample.AccAddress(),
+			chainID,
+			update,
+			executionHeader,
+		))
+		require.ErrorIs(t, err, types.ErrSyncCommitteeNotFound)
+	})
+
+	t.Run("should fail if verification flag not enabled", func(t *testing.T) {
+		k, ctx := setup(t)
+		k.SetVerificationFlags(ctx, types.VerificationFlags{
+			EthTypeChainEnabled: false,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+
+		update := committee.LightClientUpdate(t, config, bootstrapSlot+10, blockHash, nil)
+		_, err := srv.SubmitLightClientUpdate(sdk.WrapSDKContext(ctx), types.NewMsgSubmitLightClientUpdate(
+			sample.AccAddress(),
+			chainID,
+			update,
+			executionHeader,
+		))
+		require.ErrorIs(t, err, types.ErrBlockHeaderVerificationDisabled)
+	})
+
+	t.Run("should fail if not signed by the sync committee", func(t *testing.T) {
+		k, ctx := setup(t)
+		srv := keeper.NewMsgServerImpl(*k)
+
+		update := next.LightClientUpdate(t, config, bootstrapSlot+10, blockHash, nil)
+		_, err := srv.SubmitLightClientUpdate(sdk.WrapSDKContext(ctx), types.NewMsgSubmitLightClientUpdate(
+			sample.AccAddress(),
+			chainID,
+			update,
+			executionHeader,
+		))
+		require.ErrorIs(t, err, types.ErrInvalidSyncCommitteeSignature)
+
+		_, found := k.GetBlockHeader(ctx, blockHash)
+		require.False(t, found)
+	})
+}
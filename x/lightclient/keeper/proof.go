@@ -4,7 +4,6 @@ import (
 	cosmoserror "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	"github.com/0xPellNetwork/aegis/pkg/chains"
 	"github.com/0xPellNetwork/aegis/pkg/proofs"
 	"github.com/0xPellNetwork/aegis/x/lightclient/types"
 )
@@ -12,25 +11,62 @@ import (
 // VerifyProof verifies the merkle proof for a given chain and block header
 // It returns the transaction bytes if the proof is valid
 func (k Keeper) VerifyProof(ctx sdk.Context, proof *proofs.Proof, chainID int64, blockHash string, txIndex int64) ([]byte, error) {
-	// check verification flags are set
-	if err := k.CheckVerificationFlagsEnabled(ctx, chainID); err != nil {
+	header, err := k.getVerifiableBlockHeader(ctx, chainID, blockHash)
+	if err != nil {
 		return nil, err
 	}
 
-	// get block header from the store
-	hashBytes, err := chains.StringToHash(chainID, blockHash)
+	// verify merkle proof
+	txBytes, err := proof.Verify(header.Header, int(txIndex))
 	if err != nil {
-		return nil, cosmoserror.Wrapf(types.ErrInvalidBlockHash, "block hash %s conversion failed %s", blockHash, err.Error())
+		return nil, cosmoserror.Wrapf(types.ErrProofVerificationFailed, "failed to verify merkle proof: %s", err.Error())
 	}
-	res, found := k.GetBlockHeader(ctx, hashBytes)
-	if !found {
-		return nil, cosmoserror.Wrapf(types.ErrBlockHeaderNotFound, "block header not found %s", blockHash)
+	return txBytes, nil
+}
+
+// VerifyReceiptProof verifies the merkle proof of a receipt for a given chain and block header
+// It returns the receipt bytes if the proof is valid
+func (k Keeper) VerifyReceiptProof(
+	ctx sdk.Context,
+	proof *proofs.Proof,
+	chainID int64,
+	blockHash string,
+	txIndex int64,
+) ([]byte, error) {
+	header, err := k.getVerifiableBlockHeader(ctx, chainID, blockHash)
+	if err != nil {
+		return nil, err
 	}
 
 	// verify merkle proof
-	txBytes, err := proof.Verify(res.Header, int(txIndex))
+	receiptBytes, err := proof.VerifyReceipt(header.Header, int(txIndex))
 	if err != nil {
-		return nil, cosmoserror.Wrapf(types.ErrProofVerificationFailed, "failed to verify merkle proof: %s", err.Error())
+		return nil, cosmoserror.Wrapf(types.ErrProofVerificationFailed, "failed to verify receipt merkle proof: %s", err.Error())
 	}
-	return txBytes, nil
+	return receiptBytes, nil
+}
+
+// getVerifiableBlockHeader returns the block header to verify proofs against
+// It returns an error if the verification flags are not enabled for the chain
+func (k Keeper) getVerifiableBlockHeader(ctx sdk.Context, chainID int64, blockHash string) (proofs.BlockHeader, error) {
+	// check verification flags are set
+	if err := k.CheckVerificationFlagsEnabled(ctx, chainID); err != nil {
+		return proofs.BlockHeader{}, err
+	}
+
+	// get block header from the store
+	hashBytes, err := blockHashBytes(chainID, k.isEthTypeChain(ctx, chainID), blockHash)
+	if err != nil {
+		return proofs.BlockHeader{}, cosmoserror.Wrapf(
+			types.ErrInvalidBlockHash,
+			"block hash %s conversion failed %s",
+			blockHash,
+			err.Error(),
+		)
+	}
+	res, found := k.GetBlockHeader(ctx, hashBytes)
+	if !found {
+		return proofs.BlockHeader{}, cosmoserror.Wrapf(types.ErrBlockHeaderNotFound, "block header not found %s", blockHash)
+	}
+	return res, nil
 }
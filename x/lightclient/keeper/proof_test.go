@@ -7,6 +7,7 @@ import (
 
 	"github.com/0xPellNetwork/aegis/pkg/chains"
 	"github.com/0xPellNetwork/aegis/pkg/proofs"
+	"github.com/0xPellNetwork/aegis/pkg/proofs/ethereum"
 	keepertest "github.com/0xPellNetwork/aegis/testutil/keeper"
 	"github.com/0xPellNetwork/aegis/testutil/sample"
 	"github.com/0xPellNetwork/aegis/x/lightclient/types"
@@ -16,7 +17,7 @@ func TestKeeper_VerifyProof(t *testing.T) {
 	t.Run("should error if verification flags not found", func(t *testing.T) {
 		k, ctx, _, _ := keepertest.LightclientKeeper(t)
 
-		_, err := k.VerifyProof(ctx, &proofs.Proof{}, chains.SepoliaChain().Id, sample.Hash().String(), 1)
+		_, err := k.VerifyProof(ctx, proofs.NewEthereumProof(ethereum.NewProof()), chains.SepoliaChain().Id, sample.Hash().String(), 1)
 		require.ErrorIs(t, err, types.ErrVerificationFlagsNotFound)
 	})
 
@@ -28,7 +29,7 @@ func TestKeeper_VerifyProof(t *testing.T) {
 			BtcTypeChainEnabled: true,
 		})
 
-		_, err := k.VerifyProof(ctx, &proofs.Proof{}, chains.SepoliaChain().Id, sample.Hash().String(), 1)
+		_, err := k.VerifyProof(ctx, proofs.NewEthereumProof(ethereum.NewProof()), chains.SepoliaChain().Id, sample.Hash().String(), 1)
 		require.ErrorIs(t, err, types.ErrBlockHeaderVerificationDisabled)
 	})
 
@@ -52,7 +53,7 @@ func TestKeeper_VerifyProof(t *testing.T) {
 			BtcTypeChainEnabled: true,
 		})
 
-		_, err := k.VerifyProof(ctx, &proofs.Proof{}, chains.SepoliaChain().Id, sample.Hash().String(), 1)
+		_, err := k.VerifyProof(ctx, proofs.NewEthereumProof(ethereum.NewProof()), chains.SepoliaChain().Id, sample.Hash().String(), 1)
 		require.ErrorIs(t, err, types.ErrBlockHeaderNotFound)
 	})
 
@@ -90,3 +91,58 @@ func TestKeeper_VerifyProof(t *testing.T) {
 		require.NotNil(t, txBytes)
 	})
 }
+
+func TestKeeper_VerifyReceiptProof(t *testing.T) {
+	t.Run("should error if verification not enabled for evm chain", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.LightclientKeeper(t)
+
+		k.SetVerificationFlags(ctx, types.VerificationFlags{
+			EthTypeChainEnabled: false,
+			BtcTypeChainEnabled: true,
+		})
+
+		_, err := k.VerifyReceiptProof(ctx, proofs.NewEthereumProof(ethereum.NewProof()), chains.SepoliaChain().Id, sample.Hash().String(), 1)
+		require.ErrorIs(t, err, types.ErrBlockHeaderVerificationDisabled)
+	})
+
+	t.Run("should error if block header not found", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.LightclientKeeper(t)
+
+		k.SetVerificationFlags(ctx, types.VerificationFlags{
+			EthTypeChainEnabled: true,
+		})
+
+		_, err := k.VerifyReceiptProof(ctx, proofs.NewEthereumProof(ethereum.NewProof()), chains.SepoliaChain().Id, sample.Hash().String(), 1)
+		require.ErrorIs(t, err, types.ErrBlockHeaderNotFound)
+	})
+
+	t.Run("should fail if a tx proof is provided", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.LightclientKeeper(t)
+
+		proof, blockHeader, blockHash, txIndex, chainID, _ := sample.Proof(t)
+
+		k.SetVerificationFlags(ctx, types.VerificationFlags{
+			EthTypeChainEnabled: true,
+		})
+		k.SetBlockHeader(ctx, blockHeader)
+
+		_, err := k.VerifyReceiptProof(ctx, proof, chainID, blockHash, txIndex)
+		require.ErrorIs(t, err, types.ErrProofVerificationFailed)
+	})
+
+	t.Run("can verify a receipt proof", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.LightclientKeeper(t)
+
+		_, blockHeader, blockHash, txIndex, chainID, _ := sample.Proof(t)
+		proof, _ := sample.ReceiptProof(t)
+
+		k.SetVerificationFlags(ctx, types.VerificationFlags{
+			EthTypeChainEnabled: true,
+		})
+		k.SetBlockHeader(ctx, blockHeader)
+
+		receiptBytes, err := k.VerifyReceiptProof(ctx, proof, chainID, blockHash, txIndex)
+		require.NoError(t, err)
+		require.NotNil(t, receiptBytes)
+	})
+}
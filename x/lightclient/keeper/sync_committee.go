@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/0xPellNetwork/aegis/x/lightclient/types"
+)
+
+// GetAllSyncCommitteeStates returns all sync committee states
+func (k Keeper) GetAllSyncCommitteeStates(ctx sdk.Context) (list []types.SyncCommitteeState) {
+	p := types.KeyPrefix(fmt.Sprint(types.SyncCommitteeStateKey))
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), p)
+
+	iterator := store.Iterator(nil, nil)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.SyncCommitteeState
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return list
+}
+
+// SetSyncCommitteeState set the sync committee state of a chain in the store
+func (k Keeper) SetSyncCommitteeState(ctx sdk.Context, state types.SyncCommitteeState) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SyncCommitteeStateKey))
+	b := k.cdc.MustMarshal(&state)
+	key := strconv.FormatInt(state.ChainId, 10)
+	store.Set(types.KeyPrefix(key), b)
+}
+
+// GetSyncCommitteeState returns the sync committee state of a chain
+// a chain with a sync committee state is in verifying mode
+func (k Keeper) GetSyncCommitteeState(ctx sdk.Context, chainID int64) (val types.SyncCommitteeState, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SyncCommitteeStateKey))
+
+	b := store.Get(types.KeyPrefix(strconv.FormatInt(chainID, 10)))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
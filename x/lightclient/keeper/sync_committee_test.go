@@ -0,0 +1,33 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/0xPellNetwork/aegis/testutil/keeper"
+	"github.com/0xPellNetwork/aegis/x/lightclient/types"
+)
+
+// TestKeeper_GetSyncCommitteeState tests get, and set sync committee state
+func TestKeeper_GetSyncCommitteeState(t *testing.T) {
+	k, ctx, _, _ := keepertest.LightclientKeeper(t)
+	_, found := k.GetSyncCommitteeState(ctx, 42)
+	require.False(t, found)
+
+	state := types.SyncCommitteeState{ChainId: 42, Period: 10}
+	k.SetSyncCommitteeState(ctx, state)
+	res, found := k.GetSyncCommitteeState(ctx, 42)
+	require.True(t, found)
+	require.Equal(t, state.Period, res.Period)
+}
+
+func TestKeeper_GetAllSyncCommitteeStates(t *testing.T) {
+	k, ctx, _, _ := keepertest.LightclientKeeper(t)
+	k.SetSyncCommitteeState(ctx, types.SyncCommitteeState{ChainId: 42})
+	k.SetSyncCommitteeState(ctx, types.SyncCommitteeState{ChainId: 43})
+	k.SetSyncCommitteeState(ctx, types.SyncCommitteeState{ChainId: 44})
+
+	list := k.GetAllSyncCommitteeStates(ctx)
+	require.Len(t, list, 3)
+}
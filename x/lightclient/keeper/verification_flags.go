@@ -1,11 +1,13 @@
 package keeper
 
 import (
+	"fmt"
+
 	cosmoserrors "cosmossdk.io/errors"
 	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
 
-	"github.com/0xPellNetwork/aegis/pkg/chains"
 	"github.com/0xPellNetwork/aegis/x/lightclient/types"
 )
 
@@ -30,6 +32,7 @@ func (k Keeper) GetVerificationFlags(ctx sdk.Context) (val types.VerificationFla
 }
 
 // CheckVerificationFlagsEnabled checks for a specific chain if the verification flags are enabled
+// The chain type is looked up in the chain registry of the relayer module
 // It returns an error if the chain is not enabled
 func (k Keeper) CheckVerificationFlagsEnabled(ctx sdk.Context, chainID int64) error {
 	verificationFlags, found := k.GetVerificationFlags(ctx)
@@ -38,7 +41,7 @@ func (k Keeper) CheckVerificationFlagsEnabled(ctx sdk.Context, chainID int64) er
 	}
 
 	// check if the chain is enabled for the specific type
-	if chains.IsEVMChain(chainID) {
+	if k.isEthTypeChain(ctx, chainID) {
 		if !verificationFlags.EthTypeChainEnabled {
 			return cosmoserrors.Wrapf(
 				types.ErrBlockHeaderVerificationDisabled,
@@ -56,3 +59,17 @@ func (k Keeper) CheckVerificationFlagsEnabled(ctx sdk.Context, chainID int64) er
 
 	return nil
 }
+
+// isEthTypeChain returns true if the chain is registered in the chain registry as an EVM chain
+func (k Keeper) isEthTypeChain(ctx sdk.Context, chainID int64) bool {
+	chain, found := k.relayerKeeper.GetChainByChainID(ctx, chainID)
+	return found && chain.IsEVMChain()
+}
+
+// blockHashBytes converts the block hash of a chain to bytes, ethTypeChain is set for EVM chains
+func blockHashBytes(chainID int64, ethTypeChain bool, blockHash string) ([]byte, error) {
+	if ethTypeChain {
+		return ethcommon.HexToHash(blockHash).Bytes(), nil
+	}
+	return nil, fmt.Errorf("cannot convert hash to bytes for chain %d", chainID)
+}
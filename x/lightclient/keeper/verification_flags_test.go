@@ -46,6 +46,26 @@ func TestKeeper_CheckVerificationFlagsEnabled(t *testing.T) {
 		require.ErrorContains(t, err, "doesn't support block header verification")
 	})
 
+	t.Run("can check verification flags for a chain of the chain registry", func(t *testing.T) {
+		k, ctx, _, pk := keepertest.LightclientKeeper(t)
+		k.SetVerificationFlags(ctx, types.VerificationFlags{
+			EthTypeChainEnabled: true,
+		})
+
+		err := k.CheckVerificationFlagsEnabled(ctx, 1000)
+		require.ErrorContains(t, err, "doesn't support block header verification")
+
+		// the chain type is resolved from the chain registry once the chain is registered
+		pk.ObserverKeeper.SetChain(ctx, chains.Chain{
+			Id:          1000,
+			Network:     chains.NetWork_ETH,
+			NetworkType: chains.NetWorkType_TESTNET,
+			VmType:      chains.VMType_EVM,
+		})
+		err = k.CheckVerificationFlagsEnabled(ctx, 1000)
+		require.NoError(t, err)
+	})
+
 	t.Run("can check verification flags with bitcoin enabled", func(t *testing.T) {
 		k, ctx, _, _ := keepertest.LightclientKeeper(t)
 		k.SetVerificationFlags(ctx, types.VerificationFlags{
@@ -9,11 +9,15 @@ import (
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateVerificationFlags{}, "lightclient/UpdateVerificationFlags", nil)
+	cdc.RegisterConcrete(&MsgBootstrapSyncCommittee{}, "lightclient/BootstrapSyncCommittee", nil)
+	cdc.RegisterConcrete(&MsgSubmitLightClientUpdate{}, "lightclient/SubmitLightClientUpdate", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateVerificationFlags{},
+		&MsgBootstrapSyncCommittee{},
+		&MsgSubmitLightClientUpdate{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
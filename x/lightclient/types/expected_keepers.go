@@ -3,9 +3,14 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/0xPellNetwork/aegis/pkg/chains"
 	authoritytypes "github.com/0xPellNetwork/aegis/x/authority/types"
 )
 
 type AuthorityKeeper interface {
 	IsAuthorized(ctx sdk.Context, address string, policyType authoritytypes.PolicyType) bool
 }
+
+type RelayerKeeper interface {
+	GetChainByChainID(ctx sdk.Context, chainID int64) (chains.Chain, bool)
+}
@@ -9,8 +9,9 @@ import (
 // DefaultGenesis returns the default lightclient genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		BlockHeaders: []proofs.BlockHeader{},
-		ChainStates:  []ChainState{},
+		BlockHeaders:        []proofs.BlockHeader{},
+		ChainStates:         []ChainState{},
+		SyncCommitteeStates: []SyncCommitteeState{},
 		VerificationFlags: VerificationFlags{
 			EthTypeChainEnabled: false,
 			BtcTypeChainEnabled: false,
@@ -37,5 +38,13 @@ func (gs GenesisState) Validate() error {
 		ChainStateMap[elem.ChainId] = true
 	}
 
+	syncCommitteeStateMap := make(map[int64]bool)
+	for _, elem := range gs.SyncCommitteeStates {
+		if _, ok := syncCommitteeStateMap[elem.ChainId]; ok {
+			return fmt.Errorf("duplicated chain id for sync committee states")
+		}
+		syncCommitteeStateMap[elem.ChainId] = true
+	}
+
 	return nil
 }
@@ -26,9 +26,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the lightclient module's genesis state.
 type GenesisState struct {
-	BlockHeaders      []proofs.BlockHeader `protobuf:"bytes,1,rep,name=block_headers,json=blockHeaders,proto3" json:"block_headers"`
-	ChainStates       []ChainState         `protobuf:"bytes,2,rep,name=chain_states,json=chainStates,proto3" json:"chain_states"`
-	VerificationFlags VerificationFlags    `protobuf:"bytes,3,opt,name=verification_flags,json=verificationFlags,proto3" json:"verification_flags"`
+	BlockHeaders        []proofs.BlockHeader `protobuf:"bytes,1,rep,name=block_headers,json=blockHeaders,proto3" json:"block_headers"`
+	ChainStates         []ChainState         `protobuf:"bytes,2,rep,name=chain_states,json=chainStates,proto3" json:"chain_states"`
+	VerificationFlags   VerificationFlags    `protobuf:"bytes,3,opt,name=verification_flags,json=verificationFlags,proto3" json:"verification_flags"`
+	SyncCommitteeStates []SyncCommitteeState `protobuf:"bytes,4,rep,name=sync_committee_states,json=syncCommitteeStates,proto3" json:"sync_committee_states"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -85,6 +86,13 @@ func (m *GenesisState) GetVerificationFlags() VerificationFlags {
 	return VerificationFlags{}
 }
 
+func (m *GenesisState) GetSyncCommitteeStates() []SyncCommitteeState {
+	if m != nil {
+		return m.SyncCommitteeStates
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "lightclient.GenesisState")
 }
@@ -92,27 +100,29 @@ func init() {
 func init() { proto.RegisterFile("lightclient/genesis.proto", fileDescriptor_645b5300b371cd43) }
 
 var fileDescriptor_645b5300b371cd43 = []byte{
-	// 310 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0xc1, 0x4a, 0xc3, 0x30,
-	0x1c, 0xc6, 0x1b, 0x27, 0x1e, 0xda, 0x79, 0xb0, 0x08, 0x9b, 0x03, 0xe3, 0x10, 0x0f, 0x3b, 0x35,
-	0x52, 0x5f, 0x40, 0x2a, 0xa8, 0x07, 0x11, 0x71, 0xe0, 0xc1, 0x4b, 0x49, 0x63, 0x9a, 0x86, 0xc6,
-	0xa6, 0x34, 0x71, 0xce, 0xb7, 0xf0, 0xb1, 0x76, 0xdc, 0xd1, 0x93, 0x48, 0x7b, 0xf2, 0x2d, 0xa4,
-	0x6d, 0xd0, 0xc8, 0x4e, 0x2d, 0xdf, 0xef, 0xfb, 0x7f, 0xff, 0x2f, 0x7f, 0xf7, 0x40, 0x70, 0x96,
-	0x69, 0x22, 0x38, 0x2d, 0x34, 0x62, 0xb4, 0xa0, 0x8a, 0xab, 0xa0, 0xac, 0xa4, 0x96, 0xbe, 0x67,
-	0xa1, 0xc9, 0x3e, 0x93, 0x4c, 0x76, 0x3a, 0x6a, 0xff, 0x7a, 0xcb, 0xe4, 0xd0, 0x9e, 0x26, 0x19,
-	0xe6, 0x45, 0xac, 0x34, 0xd6, 0xd4, 0xe0, 0x13, 0x1b, 0x2f, 0x68, 0xc5, 0x53, 0x4e, 0xb0, 0xe6,
-	0xb2, 0x88, 0x53, 0x81, 0x99, 0xd9, 0x33, 0x19, 0x95, 0x39, 0x43, 0x65, 0x25, 0x65, 0xaa, 0xcc,
-	0xa7, 0x07, 0xc7, 0xdf, 0xc0, 0x1d, 0x5e, 0xf5, 0x95, 0xe6, 0x6d, 0xaa, 0x1f, 0xb9, 0xbb, 0x89,
-	0x90, 0x24, 0x8f, 0x33, 0x8a, 0x9f, 0x68, 0xa5, 0xc6, 0x60, 0x3a, 0x98, 0x79, 0xe1, 0x28, 0x28,
-	0x73, 0x16, 0x98, 0xd1, 0xa8, 0x35, 0x5c, 0x77, 0x3c, 0xda, 0x5e, 0x7d, 0x1e, 0x39, 0xf7, 0xc3,
-	0xe4, 0x4f, 0x52, 0xfe, 0xb9, 0x3b, 0xb4, 0x8a, 0xaa, 0xf1, 0x96, 0x89, 0xb0, 0xaa, 0x06, 0x17,
-	0xad, 0xa1, 0x5b, 0x69, 0x22, 0x3c, 0xf2, 0xab, 0x28, 0x7f, 0xee, 0xfa, 0x9b, 0x6f, 0x19, 0x0f,
-	0xa6, 0x60, 0xe6, 0x85, 0xf0, 0x5f, 0xce, 0x83, 0x65, 0xbb, 0x6c, 0x5d, 0x26, 0x6e, 0x6f, 0xb1,
-	0x01, 0x6e, 0x56, 0x35, 0x04, 0xeb, 0x1a, 0x82, 0xaf, 0x1a, 0x82, 0xf7, 0x06, 0x3a, 0xeb, 0x06,
-	0x3a, 0x1f, 0x0d, 0x74, 0x1e, 0x43, 0xc6, 0x75, 0xf6, 0x92, 0x04, 0x44, 0x3e, 0xa3, 0xd3, 0xe5,
-	0x1d, 0x15, 0xe2, 0x96, 0xea, 0x57, 0x59, 0xe5, 0x08, 0x53, 0xc6, 0x15, 0x5a, 0x22, 0xfb, 0xca,
-	0xfa, 0xad, 0xa4, 0x2a, 0xd9, 0xe9, 0x0e, 0x78, 0xf6, 0x13, 0x00, 0x00, 0xff, 0xff, 0xd9, 0x5e,
-	0x9e, 0x19, 0xde, 0x01, 0x00, 0x00,
+	// 351 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0xc1, 0x4e, 0xfa, 0x40,
+	0x10, 0xc6, 0x5b, 0x20, 0xff, 0x43, 0xcb, 0xff, 0x60, 0xd5, 0x80, 0x24, 0x2e, 0xc4, 0x78, 0xe0,
+	0xd4, 0x35, 0xf8, 0x02, 0xa6, 0x24, 0xea, 0xc1, 0x18, 0x23, 0x89, 0x89, 0x5e, 0x9a, 0x76, 0x5d,
+	0xb6, 0x9b, 0x96, 0x6e, 0xd3, 0x59, 0x11, 0xde, 0xc2, 0x87, 0xf2, 0xc0, 0x91, 0xa3, 0x27, 0x63,
+	0xe0, 0x45, 0x4c, 0xcb, 0xa2, 0x5b, 0x39, 0xb5, 0x99, 0xef, 0x37, 0xdf, 0x7c, 0xb3, 0x63, 0x1d,
+	0x25, 0x9c, 0x45, 0x92, 0x24, 0x9c, 0xa6, 0x12, 0x33, 0x9a, 0x52, 0xe0, 0xe0, 0x66, 0xb9, 0x90,
+	0xc2, 0xb1, 0x35, 0xa9, 0x73, 0xc0, 0x04, 0x13, 0x65, 0x1d, 0x17, 0x7f, 0x1b, 0xa4, 0x73, 0xac,
+	0x77, 0x93, 0x28, 0xe0, 0xa9, 0x0f, 0x32, 0x90, 0x54, 0xc9, 0x3d, 0x5d, 0x86, 0x79, 0x4a, 0x7c,
+	0x22, 0x26, 0x13, 0x2e, 0x25, 0xdd, 0x12, 0xa7, 0x3a, 0x31, 0xa5, 0x39, 0x1f, 0x73, 0x12, 0x48,
+	0x2e, 0x52, 0x7f, 0x9c, 0x04, 0x4c, 0x25, 0xe9, 0xb4, 0xb2, 0x98, 0xe1, 0x2c, 0x17, 0x62, 0x0c,
+	0xea, 0xb3, 0x11, 0x4e, 0xde, 0x6b, 0x56, 0xf3, 0x6a, 0x13, 0x7a, 0x54, 0xcc, 0x75, 0x3c, 0xeb,
+	0x7f, 0x98, 0x08, 0x12, 0xfb, 0x11, 0x0d, 0x9e, 0x69, 0x0e, 0x6d, 0xb3, 0x57, 0xef, 0xdb, 0x83,
+	0x96, 0x9b, 0xc5, 0xcc, 0x55, 0xad, 0x5e, 0x01, 0x5c, 0x97, 0xba, 0xd7, 0x58, 0x7c, 0x76, 0x8d,
+	0xfb, 0x66, 0xf8, 0x5b, 0x02, 0xe7, 0xc2, 0x6a, 0x6a, 0xab, 0x40, 0xbb, 0xa6, 0x2c, 0xb4, 0xa8,
+	0xee, 0xb0, 0x00, 0xca, 0x91, 0xca, 0xc2, 0x26, 0x3f, 0x15, 0x70, 0x46, 0x96, 0xb3, 0xbb, 0x4b,
+	0xbb, 0xde, 0x33, 0xfb, 0xf6, 0x00, 0x55, 0x7c, 0x1e, 0x34, 0xec, 0xb2, 0xa0, 0x94, 0xdd, 0xde,
+	0xf4, 0xaf, 0xe0, 0x3c, 0x5a, 0x87, 0xd5, 0x27, 0xdc, 0xe6, 0x6b, 0x94, 0xf9, 0xba, 0x15, 0xdf,
+	0xd1, 0x3c, 0x25, 0xc3, 0x2d, 0xa8, 0xe7, 0xdc, 0x87, 0x1d, 0x05, 0xbc, 0x9b, 0xc5, 0x0a, 0x99,
+	0xcb, 0x15, 0x32, 0xbf, 0x56, 0xc8, 0x7c, 0x5b, 0x23, 0x63, 0xb9, 0x46, 0xc6, 0xc7, 0x1a, 0x19,
+	0x4f, 0x03, 0xc6, 0x65, 0xf4, 0x12, 0xba, 0x44, 0x4c, 0xf0, 0xd9, 0xec, 0x8e, 0x26, 0xc9, 0x2d,
+	0x95, 0xaf, 0x22, 0x8f, 0x71, 0x40, 0x19, 0x07, 0x3c, 0xc3, 0xfa, 0x01, 0xe5, 0x3c, 0xa3, 0x10,
+	0xfe, 0x2b, 0x6f, 0x73, 0xfe, 0x3d, 0x00, 0xed, 0x4a, 0x91, 0x48, 0x5b, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -135,6 +145,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SyncCommitteeStates) > 0 {
+		for iNdEx := len(m.SyncCommitteeStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SyncCommitteeStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size, err := m.VerificationFlags.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -207,6 +231,12 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.VerificationFlags.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.SyncCommitteeStates) > 0 {
+		for _, e := range m.SyncCommitteeStates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -346,6 +376,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncCommitteeStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SyncCommitteeStates = append(m.SyncCommitteeStates, SyncCommitteeState{})
+			if err := m.SyncCommitteeStates[len(m.SyncCommitteeStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -65,6 +65,16 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicate sync committee state is invalid",
+			genState: &types.GenesisState{
+				SyncCommitteeStates: []types.SyncCommitteeState{
+					{ChainId: chains.EthChain().Id},
+					{ChainId: chains.EthChain().Id},
+				},
+			},
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
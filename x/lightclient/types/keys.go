@@ -18,9 +18,18 @@ const (
 )
 
 const (
-	BlockHeaderKey       = "BlockHeader-value-"
-	ChainStateKey        = "ChainState-value-"
-	VerificationFlagsKey = "VerificationFlags-value-"
+	BlockHeaderKey        = "BlockHeader-value-"
+	ChainStateKey         = "ChainState-value-"
+	VerificationFlagsKey  = "VerificationFlags-value-"
+	SyncCommitteeStateKey = "SyncCommitteeState-value-"
+)
+
+const (
+	// LightClientUpdateGas is the gas charged for the verification of a light client update
+	LightClientUpdateGas = 200_000
+
+	// LightClientUpdateGasPerParticipant is the gas charged for each sync committee member aggregated in the signature
+	LightClientUpdateGasPerParticipant = 2_000
 )
 
 func KeyPrefix(p string) []byte {
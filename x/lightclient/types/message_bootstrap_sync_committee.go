@@ -0,0 +1,86 @@
+package types
+
+import (
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/0xPellNetwork/aegis/pkg/chains"
+	"github.com/0xPellNetwork/aegis/pkg/proofs/ethereum"
+)
+
+const (
+	TypeMsgBootstrapSyncCommittee = "bootstrap_sync_committee"
+)
+
+var _ sdk.Msg = &MsgBootstrapSyncCommittee{}
+
+func NewMsgBootstrapSyncCommittee(
+	creator string,
+	chainID int64,
+	config BeaconConfig,
+	header BeaconBlockHeader,
+	committee SyncCommittee,
+	branch [][]byte,
+) *MsgBootstrapSyncCommittee {
+	return &MsgBootstrapSyncCommittee{
+		Signer:                     creator,
+		ChainId:                    chainID,
+		Config:                     config,
+		Header:                     header,
+		CurrentSyncCommittee:       committee,
+		CurrentSyncCommitteeBranch: branch,
+	}
+}
+
+func (msg *MsgBootstrapSyncCommittee) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgBootstrapSyncCommittee) Type() string {
+	return TypeMsgBootstrapSyncCommittee
+}
+
+func (msg *MsgBootstrapSyncCommittee) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgBootstrapSyncCommittee) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgBootstrapSyncCommittee) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if !chains.IsHeaderSupportedEvmChain(msg.ChainId) {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid chain id (%d)", msg.ChainId)
+	}
+	if err := msg.Config.Validate(); err != nil {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid beacon config (%s)", err)
+	}
+	if err := msg.Header.Validate(); err != nil {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid beacon block header (%s)", err)
+	}
+	if err := msg.CurrentSyncCommittee.Validate(); err != nil {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid sync committee (%s)", err)
+	}
+	gindex, err := msg.Config.SyncCommitteeGindex(msg.Header.Slot, false)
+	if err != nil {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid sync committee branch (%s)", err)
+	}
+	if depth := ethereum.GindexDepth(gindex); len(msg.CurrentSyncCommitteeBranch) != depth {
+		return cosmoserrors.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"invalid sync committee branch length (%d), expected %d",
+			len(msg.CurrentSyncCommitteeBranch),
+			depth,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/pkg/chains"
+	"github.com/0xPellNetwork/aegis/testutil/sample"
+	"github.com/0xPellNetwork/aegis/x/lightclient/types"
+)
+
+func TestMsgBootstrapSyncCommittee_ValidateBasic(t *testing.T) {
+	committee := sample.NewSyncCommittee(t, 1)
+	header, branch := committee.Bootstrap(t, sample.BeaconConfig(), bootstrapSlot)
+	newMsg := func() *types.MsgBootstrapSyncCommittee {
+		return types.NewMsgBootstrapSyncCommittee(
+			sample.AccAddress(),
+			chains.EthChain().Id,
+			sample.BeaconConfig(),
+			header,
+			committee.Committee,
+			branch,
+		)
+	}
+
+	tests := []struct {
+		name     string
+		malleate func(msg *types.MsgBootstrapSyncCommittee)
+		err      error
+	}{
+		{
+			name:     "valid message",
+			malleate: func(msg *types.MsgBootstrapSyncCommittee) {},
+		},
+		{
+			name: "invalid address",
+			malleate: func(msg *types.MsgBootstrapSyncCommittee) {
+				msg.Signer = "invalid_address"
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "unsupported chain",
+			malleate: func(msg *types.MsgBootstrapSyncCommittee) {
+				msg.ChainId = chains.PellPrivnetChain().Id
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "invalid config",
+			malleate: func(msg *types.MsgBootstrapSyncCommittee) {
+				msg.Config.Forks = nil
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "invalid header",
+			malleate: func(msg *types.MsgBootstrapSyncCommittee) {
+				msg.Header.StateRoot = nil
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "invalid sync committee",
+			malleate: func(msg *types.MsgBootstrapSyncCommittee) {
+				msg.CurrentSyncCommittee.Pubkeys = msg.CurrentSyncCommittee.Pubkeys[1:]
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "invalid branch length",
+			malleate: func(msg *types.MsgBootstrapSyncCommittee) {
+				msg.CurrentSyncCommitteeBranch = msg.CurrentSyncCommitteeBranch[1:]
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := newMsg()
+			tt.malleate(msg)
+			err := msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgBootstrapSyncCommittee_GetSigners(t *testing.T) {
+	signer := sample.AccAddress()
+	msg := types.MsgBootstrapSyncCommittee{Signer: signer}
+	require.Equal(t, []sdk.AccAddress{sdk.MustAccAddressFromBech32(signer)}, msg.GetSigners())
+
+	msg.Signer = "invalid"
+	require.Panics(t, func() {
+		msg.GetSigners()
+	})
+}
+
+func TestMsgBootstrapSyncCommittee_Type(t *testing.T) {
+	msg := types.MsgBootstrapSyncCommittee{
+		Signer: sample.AccAddress(),
+	}
+	require.Equal(t, types.TypeMsgBootstrapSyncCommittee, msg.Type())
+}
+
+func TestMsgBootstrapSyncCommittee_Route(t *testing.T) {
+	msg := types.MsgBootstrapSyncCommittee{
+		Signer: sample.AccAddress(),
+	}
+	require.Equal(t, types.RouterKey, msg.Route())
+}
+
+func TestMsgBootstrapSyncCommittee_GetSignBytes(t *testing.T) {
+	msg := types.MsgBootstrapSyncCommittee{
+		Signer: sample.AccAddress(),
+	}
+	require.NotPanics(t, func() {
+		msg.GetSignBytes()
+	})
+}
@@ -0,0 +1,71 @@
+package types
+
+import (
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/0xPellNetwork/aegis/pkg/chains"
+	"github.com/0xPellNetwork/aegis/pkg/proofs"
+)
+
+const (
+	TypeMsgSubmitLightClientUpdate = "submit_light_client_update"
+)
+
+var _ sdk.Msg = &MsgSubmitLightClientUpdate{}
+
+func NewMsgSubmitLightClientUpdate(
+	creator string,
+	chainID int64,
+	update LightClientUpdate,
+	executionHeader proofs.HeaderData,
+) *MsgSubmitLightClientUpdate {
+	return &MsgSubmitLightClientUpdate{
+		Signer:          creator,
+		ChainId:         chainID,
+		Update:          update,
+		ExecutionHeader: executionHeader,
+	}
+}
+
+func (msg *MsgSubmitLightClientUpdate) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSubmitLightClientUpdate) Type() string {
+	return TypeMsgSubmitLightClientUpdate
+}
+
+func (msg *MsgSubmitLightClientUpdate) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSubmitLightClientUpdate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSubmitLightClientUpdate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if !chains.IsHeaderSupportedEvmChain(msg.ChainId) {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid chain id (%d)", msg.ChainId)
+	}
+	if err := msg.Update.ValidateBasic(); err != nil {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid light client update (%s)", err)
+	}
+	height, err := msg.ExecutionHeader.EthereumHeight()
+	if err != nil {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid execution header (%s)", err)
+	}
+	if err := msg.ExecutionHeader.Validate(msg.Update.ExecutionBlockHash, msg.ChainId, height); err != nil {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid execution header (%s)", err)
+	}
+	return nil
+}
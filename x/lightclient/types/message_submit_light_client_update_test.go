@@ -0,0 +1,127 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/pkg/chains"
+	"github.com/0xPellNetwork/aegis/pkg/proofs"
+	"github.com/0xPellNetwork/aegis/testutil/sample"
+	"github.com/0xPellNetwork/aegis/x/lightclient/types"
+)
+
+func TestMsgSubmitLightClientUpdate_ValidateBasic(t *testing.T) {
+	committee := sample.NewSyncCommittee(t, 1)
+	config := sample.BeaconConfig()
+	executionHeader, blockHash, _ := sample.EthereumHeader(t)
+	newMsg := func() *types.MsgSubmitLightClientUpdate {
+		return types.NewMsgSubmitLightClientUpdate(
+			sample.AccAddress(),
+			chains.EthChain().Id,
+			committee.LightClientUpdate(t, config, bootstrapSlot+10, blockHash, nil),
+			executionHeader,
+		)
+	}
+
+	tests := []struct {
+		name     string
+		malleate func(msg *types.MsgSubmitLightClientUpdate)
+		err      error
+	}{
+		{
+			name:     "valid message",
+			malleate: func(msg *types.MsgSubmitLightClientUpdate) {},
+		},
+		{
+			name: "invalid address",
+			malleate: func(msg *types.MsgSubmitLightClientUpdate) {
+				msg.Signer = "invalid_address"
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "unsupported chain",
+			malleate: func(msg *types.MsgSubmitLightClientUpdate) {
+				msg.ChainId = chains.PellPrivnetChain().Id
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "invalid update",
+			malleate: func(msg *types.MsgSubmitLightClientUpdate) {
+				msg.Update.SyncAggregate.SyncCommitteeSignature = nil
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "missing finality branch",
+			malleate: func(msg *types.MsgSubmitLightClientUpdate) {
+				msg.Update.FinalityBranch = nil
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "execution header not attested by the update",
+			malleate: func(msg *types.MsgSubmitLightClientUpdate) {
+				msg.Update.ExecutionBlockHash = sample.Hash().Bytes()
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "not an ethereum header",
+			malleate: func(msg *types.MsgSubmitLightClientUpdate) {
+				msg.ExecutionHeader = proofs.NewBitcoinHeader(sample.Hash().Bytes())
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := newMsg()
+			tt.malleate(msg)
+			err := msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgSubmitLightClientUpdate_GetSigners(t *testing.T) {
+	signer := sample.AccAddress()
+	msg := types.MsgSubmitLightClientUpdate{Signer: signer}
+	require.Equal(t, []sdk.AccAddress{sdk.MustAccAddressFromBech32(signer)}, msg.GetSigners())
+
+	msg.Signer = "invalid"
+	require.Panics(t, func() {
+		msg.GetSigners()
+	})
+}
+
+func TestMsgSubmitLightClientUpdate_Type(t *testing.T) {
+	msg := types.MsgSubmitLightClientUpdate{
+		Signer: sample.AccAddress(),
+	}
+	require.Equal(t, types.TypeMsgSubmitLightClientUpdate, msg.Type())
+}
+
+func TestMsgSubmitLightClientUpdate_Route(t *testing.T) {
+	msg := types.MsgSubmitLightClientUpdate{
+		Signer: sample.AccAddress(),
+	}
+	require.Equal(t, types.RouterKey, msg.Route())
+}
+
+func TestMsgSubmitLightClientUpdate_GetSignBytes(t *testing.T) {
+	msg := types.MsgSubmitLightClientUpdate{
+		Signer: sample.AccAddress(),
+	}
+	require.NotPanics(t, func() {
+		msg.GetSignBytes()
+	})
+}
@@ -611,6 +611,194 @@ func (m *QueryVerificationFlagsResponse) GetVerificationFlags() VerificationFlag
 	return VerificationFlags{}
 }
 
+// query all sync committee states request
+type QueryAllSyncCommitteeStateRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllSyncCommitteeStateRequest) Reset()         { *m = QueryAllSyncCommitteeStateRequest{} }
+func (m *QueryAllSyncCommitteeStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllSyncCommitteeStateRequest) ProtoMessage()    {}
+func (*QueryAllSyncCommitteeStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_03e46747c4ffba77, []int{12}
+}
+func (m *QueryAllSyncCommitteeStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllSyncCommitteeStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllSyncCommitteeStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllSyncCommitteeStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllSyncCommitteeStateRequest.Merge(m, src)
+}
+func (m *QueryAllSyncCommitteeStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllSyncCommitteeStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllSyncCommitteeStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllSyncCommitteeStateRequest proto.InternalMessageInfo
+
+func (m *QueryAllSyncCommitteeStateRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// response of query all sync committee states
+type QuerySyncCommitteeStateAllResponse struct {
+	SyncCommitteeStates []SyncCommitteeState `protobuf:"bytes,1,rep,name=sync_committee_states,json=syncCommitteeStates,proto3" json:"sync_committee_states"`
+	Pagination          *query.PageResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySyncCommitteeStateAllResponse) Reset()         { *m = QuerySyncCommitteeStateAllResponse{} }
+func (m *QuerySyncCommitteeStateAllResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySyncCommitteeStateAllResponse) ProtoMessage()    {}
+func (*QuerySyncCommitteeStateAllResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_03e46747c4ffba77, []int{13}
+}
+func (m *QuerySyncCommitteeStateAllResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySyncCommitteeStateAllResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySyncCommitteeStateAllResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySyncCommitteeStateAllResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySyncCommitteeStateAllResponse.Merge(m, src)
+}
+func (m *QuerySyncCommitteeStateAllResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySyncCommitteeStateAllResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySyncCommitteeStateAllResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySyncCommitteeStateAllResponse proto.InternalMessageInfo
+
+func (m *QuerySyncCommitteeStateAllResponse) GetSyncCommitteeStates() []SyncCommitteeState {
+	if m != nil {
+		return m.SyncCommitteeStates
+	}
+	return nil
+}
+
+func (m *QuerySyncCommitteeStateAllResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// request of query sync committee state
+type QueryGetSyncCommitteeStateRequest struct {
+	ChainId int64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *QueryGetSyncCommitteeStateRequest) Reset()         { *m = QueryGetSyncCommitteeStateRequest{} }
+func (m *QueryGetSyncCommitteeStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetSyncCommitteeStateRequest) ProtoMessage()    {}
+func (*QueryGetSyncCommitteeStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_03e46747c4ffba77, []int{14}
+}
+func (m *QueryGetSyncCommitteeStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetSyncCommitteeStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetSyncCommitteeStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetSyncCommitteeStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetSyncCommitteeStateRequest.Merge(m, src)
+}
+func (m *QueryGetSyncCommitteeStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetSyncCommitteeStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetSyncCommitteeStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetSyncCommitteeStateRequest proto.InternalMessageInfo
+
+func (m *QueryGetSyncCommitteeStateRequest) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+// response of query sync committee state
+type QuerySyncCommitteeStateResponse struct {
+	SyncCommitteeState *SyncCommitteeState `protobuf:"bytes,1,opt,name=sync_committee_state,json=syncCommitteeState,proto3" json:"sync_committee_state,omitempty"`
+}
+
+func (m *QuerySyncCommitteeStateResponse) Reset()         { *m = QuerySyncCommitteeStateResponse{} }
+func (m *QuerySyncCommitteeStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySyncCommitteeStateResponse) ProtoMessage()    {}
+func (*QuerySyncCommitteeStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_03e46747c4ffba77, []int{15}
+}
+func (m *QuerySyncCommitteeStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySyncCommitteeStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySyncCommitteeStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySyncCommitteeStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySyncCommitteeStateResponse.Merge(m, src)
+}
+func (m *QuerySyncCommitteeStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySyncCommitteeStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySyncCommitteeStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySyncCommitteeStateResponse proto.InternalMessageInfo
+
+func (m *QuerySyncCommitteeStateResponse) GetSyncCommitteeState() *SyncCommitteeState {
+	if m != nil {
+		return m.SyncCommitteeState
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryAllBlockHeaderRequest)(nil), "lightclient.QueryAllBlockHeaderRequest")
 	proto.RegisterType((*QueryBlockHeaderAllResponse)(nil), "lightclient.QueryBlockHeaderAllResponse")
@@ -624,65 +812,79 @@ func init() {
 	proto.RegisterType((*QueryProveResponse)(nil), "lightclient.QueryProveResponse")
 	proto.RegisterType((*QueryVerificationFlagsRequest)(nil), "lightclient.QueryVerificationFlagsRequest")
 	proto.RegisterType((*QueryVerificationFlagsResponse)(nil), "lightclient.QueryVerificationFlagsResponse")
+	proto.RegisterType((*QueryAllSyncCommitteeStateRequest)(nil), "lightclient.QueryAllSyncCommitteeStateRequest")
+	proto.RegisterType((*QuerySyncCommitteeStateAllResponse)(nil), "lightclient.QuerySyncCommitteeStateAllResponse")
+	proto.RegisterType((*QueryGetSyncCommitteeStateRequest)(nil), "lightclient.QueryGetSyncCommitteeStateRequest")
+	proto.RegisterType((*QuerySyncCommitteeStateResponse)(nil), "lightclient.QuerySyncCommitteeStateResponse")
 }
 
 func init() { proto.RegisterFile("lightclient/query.proto", fileDescriptor_03e46747c4ffba77) }
 
 var fileDescriptor_03e46747c4ffba77 = []byte{
-	// 843 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x4d, 0x4f, 0xdb, 0x48,
-	0x18, 0xc7, 0x63, 0x20, 0xbc, 0x4c, 0x60, 0xa5, 0x8c, 0x90, 0x12, 0xbc, 0x1b, 0x07, 0x79, 0x09,
-	0x41, 0x61, 0xb1, 0x21, 0xbb, 0x42, 0x2b, 0x56, 0x5a, 0x89, 0xac, 0x04, 0x8b, 0x54, 0x55, 0xa9,
-	0x91, 0x38, 0xf4, 0x12, 0x39, 0xce, 0xe0, 0x58, 0x31, 0x1e, 0x13, 0x4f, 0xd2, 0x20, 0xc4, 0xa5,
-	0xf7, 0x4a, 0x55, 0x7b, 0xea, 0xad, 0x97, 0x5e, 0xfa, 0x49, 0x38, 0x22, 0xf5, 0xd2, 0x53, 0x55,
-	0x41, 0xbf, 0x40, 0xbf, 0x41, 0xe5, 0xf1, 0x90, 0x8c, 0x63, 0x9b, 0xe6, 0xc0, 0x29, 0xb6, 0x9f,
-	0x99, 0xe7, 0xf9, 0xfd, 0x9f, 0x37, 0x05, 0xe4, 0x6c, 0xcb, 0x6c, 0x13, 0xc3, 0xb6, 0x90, 0x43,
-	0xd4, 0xf3, 0x1e, 0xea, 0x5e, 0x28, 0x6e, 0x17, 0x13, 0x0c, 0x33, 0x9c, 0x41, 0xac, 0x18, 0xd8,
-	0x3b, 0xc3, 0x9e, 0xda, 0xd4, 0x3d, 0x14, 0x9c, 0x52, 0xfb, 0x3b, 0x4d, 0x44, 0xf4, 0x1d, 0xd5,
-	0xd5, 0x4d, 0xcb, 0xd1, 0x89, 0x85, 0x9d, 0xe0, 0xa2, 0xb8, 0x6c, 0x62, 0x13, 0xd3, 0x47, 0xd5,
-	0x7f, 0x62, 0x5f, 0x7f, 0x33, 0x31, 0x36, 0x6d, 0xa4, 0xea, 0xae, 0xa5, 0xea, 0x8e, 0x83, 0x09,
-	0xbd, 0xe2, 0x31, 0x6b, 0x81, 0xa7, 0x30, 0xda, 0xba, 0xe5, 0x34, 0x3c, 0xa2, 0x13, 0xc4, 0xcc,
-	0x6b, 0xbc, 0xb9, 0x8f, 0xba, 0xd6, 0xa9, 0x65, 0xd0, 0xfb, 0x8d, 0x53, 0x5b, 0x37, 0xef, 0x9d,
-	0xe4, 0xdc, 0x8e, 0xa9, 0xba, 0x5d, 0x8c, 0x4f, 0x3d, 0xf6, 0x13, 0x18, 0xe4, 0x16, 0x10, 0x9f,
-	0xf9, 0xcc, 0xfb, 0xb6, 0x5d, 0xb3, 0xb1, 0xd1, 0xf9, 0x1f, 0xe9, 0x2d, 0xd4, 0xd5, 0xd0, 0x79,
-	0x0f, 0x79, 0x04, 0x1e, 0x00, 0x30, 0xd2, 0x90, 0x17, 0x56, 0x85, 0x8d, 0x4c, 0x75, 0x5d, 0x09,
-	0x04, 0x2b, 0xbe, 0x60, 0x25, 0x48, 0x0b, 0x13, 0xac, 0xd4, 0x75, 0x13, 0xb1, 0xbb, 0x1a, 0x77,
-	0x53, 0xfe, 0x28, 0x80, 0x5f, 0x69, 0x18, 0x2e, 0xc6, 0xbe, 0x6d, 0x6b, 0xc8, 0x73, 0xb1, 0xe3,
-	0x21, 0x58, 0x03, 0x4b, 0x4d, 0xdf, 0xd2, 0x68, 0x53, 0x93, 0x97, 0x17, 0x56, 0xa7, 0x37, 0x32,
-	0xd5, 0x9c, 0xe2, 0x76, 0x4c, 0x85, 0xf1, 0x72, 0x57, 0x6b, 0x33, 0xd7, 0x5f, 0x8a, 0x29, 0x6d,
-	0xb1, 0x39, 0xfa, 0xe4, 0xc1, 0xc3, 0x10, 0xeb, 0x14, 0x65, 0x2d, 0xff, 0x94, 0x35, 0x00, 0x08,
-	0xc1, 0xfe, 0xc3, 0x52, 0x72, 0x88, 0x48, 0x4c, 0x4a, 0x0a, 0x00, 0x30, 0x54, 0xdd, 0x6b, 0xd3,
-	0x94, 0x2c, 0x6a, 0x0b, 0x01, 0x88, 0xee, 0xb5, 0xe5, 0x13, 0x90, 0x1f, 0x17, 0x3a, 0x54, 0xb9,
-	0x07, 0x16, 0x79, 0x95, 0x2c, 0x9f, 0x49, 0x22, 0xb5, 0x0c, 0x27, 0x4f, 0x36, 0xc0, 0xca, 0x7d,
-	0x9d, 0xfe, 0xf3, 0x7b, 0xe0, 0xd8, 0x6f, 0x81, 0xc7, 0x2e, 0xd3, 0x07, 0x81, 0x49, 0x1f, 0x85,
-	0xe0, 0xab, 0xf4, 0x2f, 0xc8, 0x70, 0xfd, 0x37, 0xac, 0x11, 0xd7, 0x80, 0xca, 0xe8, 0x22, 0xab,
-	0x11, 0x30, 0x86, 0x5f, 0x1e, 0xaf, 0x42, 0xbb, 0x2c, 0x19, 0x87, 0x88, 0x44, 0x93, 0xb1, 0x02,
-	0xe6, 0x03, 0x4a, 0xab, 0x45, 0x53, 0x31, 0xad, 0xcd, 0xd1, 0xf7, 0xa3, 0x96, 0x7c, 0x0c, 0x72,
-	0x63, 0xf2, 0x86, 0xda, 0xfe, 0x1e, 0xd7, 0x26, 0x3c, 0xa0, 0x8d, 0x57, 0xe5, 0xf7, 0x76, 0x96,
-	0x7a, 0xad, 0x77, 0x71, 0x7f, 0x02, 0x0a, 0x98, 0x03, 0x73, 0x64, 0x10, 0xb4, 0x8f, 0x9f, 0x83,
-	0x05, 0x6d, 0x96, 0x0c, 0xfc, 0xde, 0x81, 0x65, 0x90, 0xa6, 0x6d, 0x90, 0x9f, 0xa6, 0xd1, 0xb3,
-	0x7c, 0x63, 0xd4, 0xfd, 0x1f, 0x2d, 0xb0, 0x8f, 0xf5, 0xe0, 0x0c, 0x75, 0x32, 0xea, 0x41, 0x3f,
-	0x36, 0x19, 0x34, 0x2c, 0xa7, 0x85, 0x06, 0xf9, 0x74, 0x10, 0x9b, 0x0c, 0x8e, 0xfc, 0x57, 0xb9,
-	0x02, 0x20, 0xcf, 0xca, 0xc4, 0x2f, 0x83, 0x74, 0x5f, 0xb7, 0x19, 0xe9, 0xbc, 0x16, 0xbc, 0xc8,
-	0x45, 0x50, 0xa0, 0x67, 0x4f, 0xb8, 0xa5, 0x72, 0xe0, 0xef, 0x14, 0xa6, 0x51, 0xee, 0x01, 0x29,
-	0xe9, 0x00, 0x73, 0x7c, 0x0c, 0x60, 0x74, 0x25, 0xb1, 0xe4, 0x4a, 0xa1, 0xe4, 0x46, 0x7c, 0xb0,
-	0xfe, 0xc9, 0xf6, 0xc7, 0x0d, 0xd5, 0xef, 0xb3, 0x20, 0x4d, 0xe3, 0xc2, 0x37, 0x02, 0xf8, 0x25,
-	0xbc, 0x51, 0x60, 0x39, 0xe4, 0x35, 0x79, 0xb5, 0x89, 0x1b, 0xd1, 0x83, 0xf1, 0xcb, 0x49, 0xde,
-	0x7a, 0xf9, 0xe9, 0xdb, 0xdb, 0xa9, 0x32, 0x2c, 0xa9, 0x2e, 0xb2, 0xed, 0x2d, 0x5a, 0x46, 0x95,
-	0xdf, 0xba, 0xa1, 0xd5, 0x05, 0xdf, 0x09, 0x20, 0xc3, 0x79, 0x8a, 0x23, 0x8a, 0xdd, 0x2c, 0x62,
-	0xe9, 0x41, 0xa2, 0x21, 0xce, 0x1e, 0xc5, 0xf9, 0x0b, 0x56, 0x27, 0xc2, 0x51, 0x2f, 0x47, 0x9d,
-	0x72, 0x05, 0x5f, 0x09, 0x60, 0x29, 0x34, 0xdb, 0x70, 0x3d, 0x36, 0x5f, 0x91, 0xa9, 0x12, 0x63,
-	0x54, 0xc4, 0x2e, 0x09, 0x79, 0x93, 0xe2, 0x95, 0xe0, 0xef, 0x49, 0x78, 0xdc, 0x98, 0xf9, 0x05,
-	0x04, 0x23, 0x37, 0x71, 0x30, 0x71, 0x23, 0x2e, 0xae, 0x3d, 0x04, 0x33, 0x24, 0xd9, 0xa5, 0x24,
-	0xdb, 0x50, 0x99, 0x80, 0x44, 0xbd, 0xbc, 0x9f, 0xd6, 0x2b, 0x88, 0x41, 0x9a, 0x8e, 0x07, 0x94,
-	0xa2, 0x61, 0xf8, 0x19, 0x17, 0x8b, 0x89, 0x76, 0x46, 0x50, 0xa2, 0x04, 0x45, 0x58, 0x48, 0x22,
-	0x70, 0x69, 0x9c, 0xf7, 0x02, 0xc8, 0x46, 0xfa, 0x1f, 0x56, 0xa2, 0xde, 0x93, 0x26, 0x51, 0xdc,
-	0x9c, 0xe8, 0x2c, 0xa3, 0xaa, 0x52, 0xaa, 0x3f, 0x60, 0x25, 0x89, 0x2a, 0x3a, 0xb2, 0xb5, 0x27,
-	0xd7, 0xb7, 0x92, 0x70, 0x73, 0x2b, 0x09, 0x5f, 0x6f, 0x25, 0xe1, 0xf5, 0x9d, 0x94, 0xba, 0xb9,
-	0x93, 0x52, 0x9f, 0xef, 0xa4, 0xd4, 0xf3, 0xaa, 0x69, 0x91, 0x76, 0xaf, 0xa9, 0x18, 0xf8, 0x4c,
-	0xdd, 0x1e, 0xd4, 0x91, 0x6d, 0x3f, 0x45, 0xe4, 0x05, 0xee, 0x76, 0x54, 0x1d, 0x99, 0x96, 0xa7,
-	0x0e, 0x42, 0xae, 0xc9, 0x85, 0x8b, 0xbc, 0xe6, 0x2c, 0xfd, 0xef, 0xf1, 0xe7, 0x8f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x18, 0x37, 0x63, 0x5c, 0x61, 0x09, 0x00, 0x00,
+	// 999 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0x41, 0x6f, 0xdc, 0x44,
+	0x14, 0xc7, 0xe3, 0xa6, 0xdb, 0x36, 0x6f, 0x53, 0xa4, 0x0c, 0xa9, 0x76, 0x6b, 0x88, 0x37, 0x98,
+	0xa6, 0x89, 0xd2, 0xd4, 0x6e, 0x97, 0xaa, 0x40, 0x91, 0x8a, 0x9a, 0x4a, 0x0d, 0x95, 0x10, 0x4a,
+	0x1d, 0xa9, 0x12, 0x5c, 0x56, 0xb3, 0xde, 0x89, 0xd7, 0x5a, 0xc7, 0xe3, 0xee, 0x4c, 0x96, 0x5d,
+	0x55, 0xbd, 0x70, 0x47, 0x42, 0x70, 0xe2, 0xc6, 0x85, 0x0b, 0x07, 0x2e, 0x7c, 0x89, 0x1c, 0x2b,
+	0x71, 0xe9, 0x09, 0xa1, 0x84, 0x0f, 0x82, 0x3c, 0x9e, 0xf5, 0x8e, 0xd7, 0xf6, 0x76, 0x91, 0xc2,
+	0x29, 0x6b, 0xbf, 0x99, 0xf7, 0x7e, 0xef, 0x3f, 0xcf, 0xff, 0x09, 0xd4, 0x02, 0xdf, 0xeb, 0x72,
+	0x37, 0xf0, 0x49, 0xc8, 0xed, 0x17, 0xc7, 0xa4, 0x3f, 0xb2, 0xa2, 0x3e, 0xe5, 0x14, 0x55, 0x95,
+	0x80, 0xbe, 0xed, 0x52, 0x76, 0x44, 0x99, 0xdd, 0xc6, 0x8c, 0x24, 0xab, 0xec, 0xc1, 0xdd, 0x36,
+	0xe1, 0xf8, 0xae, 0x1d, 0x61, 0xcf, 0x0f, 0x31, 0xf7, 0x69, 0x98, 0x6c, 0xd4, 0x57, 0x3d, 0xea,
+	0x51, 0xf1, 0xd3, 0x8e, 0x7f, 0xc9, 0xb7, 0xef, 0x7b, 0x94, 0x7a, 0x01, 0xb1, 0x71, 0xe4, 0xdb,
+	0x38, 0x0c, 0x29, 0x17, 0x5b, 0x98, 0x8c, 0xae, 0xa9, 0x14, 0x6e, 0x17, 0xfb, 0x61, 0x8b, 0x71,
+	0xcc, 0x89, 0x0c, 0xaf, 0xab, 0x61, 0x36, 0x0a, 0xdd, 0x96, 0x4b, 0x8f, 0x8e, 0x7c, 0xce, 0xc9,
+	0x78, 0xc5, 0x0d, 0x75, 0xc5, 0x80, 0xf4, 0xfd, 0x43, 0xdf, 0x15, 0x15, 0x5a, 0x87, 0x01, 0xf6,
+	0xc6, 0x65, 0x6a, 0x51, 0xcf, 0xb3, 0xa3, 0x3e, 0xa5, 0x87, 0x4c, 0xfe, 0x49, 0x02, 0x66, 0x07,
+	0xf4, 0x67, 0x71, 0x57, 0x8f, 0x82, 0x60, 0x37, 0xa0, 0x6e, 0xef, 0x0b, 0x82, 0x3b, 0xa4, 0xef,
+	0x90, 0x17, 0xc7, 0x84, 0x71, 0xf4, 0x04, 0x60, 0xd2, 0x65, 0x5d, 0x5b, 0xd7, 0xb6, 0xaa, 0xcd,
+	0x9b, 0x56, 0x22, 0x89, 0x15, 0x4b, 0x62, 0x25, 0xc2, 0x49, 0x49, 0xac, 0x7d, 0xec, 0x11, 0xb9,
+	0xd7, 0x51, 0x76, 0x9a, 0xbf, 0x69, 0xf0, 0x9e, 0x28, 0xa3, 0xd4, 0x78, 0x14, 0x04, 0x0e, 0x61,
+	0x11, 0x0d, 0x19, 0x41, 0xbb, 0x70, 0xb5, 0x1d, 0x47, 0x5a, 0x5d, 0x11, 0x62, 0x75, 0x6d, 0x7d,
+	0x71, 0xab, 0xda, 0xac, 0x59, 0x51, 0xcf, 0xb3, 0x24, 0xaf, 0xb2, 0x75, 0xf7, 0xe2, 0xc9, 0x5f,
+	0x8d, 0x05, 0x67, 0xb9, 0x3d, 0x79, 0xc5, 0xd0, 0x5e, 0x86, 0xf5, 0x82, 0x60, 0xdd, 0x7c, 0x2b,
+	0x6b, 0x02, 0x90, 0x81, 0xfd, 0x4c, 0x4a, 0xb2, 0x47, 0x78, 0x81, 0x24, 0x6b, 0x00, 0x12, 0x15,
+	0xb3, 0xae, 0x90, 0x64, 0xd9, 0x59, 0x4a, 0x40, 0x30, 0xeb, 0x9a, 0xcf, 0xa1, 0x3e, 0xdd, 0x68,
+	0xda, 0xe5, 0x03, 0x58, 0x56, 0xbb, 0x94, 0x7a, 0x96, 0x35, 0xe9, 0x54, 0x95, 0xf6, 0x4c, 0x17,
+	0xae, 0x8f, 0xcf, 0xe9, 0x71, 0x3c, 0x25, 0x07, 0x1c, 0x73, 0x72, 0xde, 0xc7, 0xf4, 0xab, 0x26,
+	0x5b, 0x9f, 0x94, 0x50, 0x4f, 0xe9, 0x21, 0x54, 0x95, 0x09, 0x4d, 0xcf, 0x48, 0x19, 0x40, 0x6b,
+	0xb2, 0x51, 0x9e, 0x11, 0xb8, 0xe9, 0x9b, 0xf3, 0x3b, 0xa1, 0xfb, 0x52, 0x8c, 0x3d, 0xc2, 0xf3,
+	0x62, 0x5c, 0x87, 0x2b, 0x09, 0xa5, 0xdf, 0x11, 0x52, 0x2c, 0x3a, 0x97, 0xc5, 0xf3, 0xd3, 0x8e,
+	0x79, 0x00, 0xb5, 0xa9, 0xf6, 0xd2, 0xde, 0x3e, 0x99, 0xee, 0x4d, 0x9b, 0xd1, 0x9b, 0xda, 0x55,
+	0x3c, 0xdb, 0x2b, 0x22, 0xeb, 0x7e, 0x9f, 0x0e, 0xe6, 0xa0, 0x40, 0x35, 0xb8, 0xcc, 0x87, 0xc9,
+	0xf8, 0xc4, 0x1a, 0x2c, 0x39, 0x97, 0xf8, 0x30, 0x9e, 0x1d, 0xb4, 0x09, 0x15, 0x31, 0x06, 0xf5,
+	0x45, 0x51, 0x7d, 0x45, 0x1d, 0x8c, 0xfd, 0xf8, 0x8f, 0x93, 0xc4, 0xa7, 0x66, 0xf0, 0xa2, 0x48,
+	0x32, 0x99, 0xc1, 0xb8, 0x36, 0x1f, 0xb6, 0xfc, 0xb0, 0x43, 0x86, 0xf5, 0x4a, 0x52, 0x9b, 0x0f,
+	0x9f, 0xc6, 0x8f, 0xe6, 0x36, 0x20, 0x95, 0x55, 0x36, 0xbf, 0x0a, 0x95, 0x01, 0x0e, 0x24, 0xe9,
+	0x15, 0x27, 0x79, 0x30, 0x1b, 0xb0, 0x26, 0xd6, 0x3e, 0x57, 0x4c, 0xe5, 0x49, 0xec, 0x29, 0xb2,
+	0x47, 0xf3, 0x18, 0x8c, 0xb2, 0x05, 0x32, 0xf1, 0x01, 0xa0, 0xbc, 0x25, 0x49, 0x71, 0x8d, 0x8c,
+	0xb8, 0xb9, 0x1c, 0x72, 0x7e, 0x56, 0x06, 0xd3, 0x01, 0xb3, 0x07, 0x1f, 0x8c, 0x3f, 0x85, 0x83,
+	0x51, 0xe8, 0x3e, 0x1e, 0x1b, 0xe2, 0xff, 0xf2, 0x49, 0x9c, 0x68, 0x60, 0x8a, 0x6a, 0xf9, 0x52,
+	0xea, 0xa7, 0xf1, 0x35, 0x5c, 0xcb, 0xba, 0x73, 0x32, 0x47, 0x63, 0x23, 0x6b, 0x64, 0x7a, 0xcd,
+	0xa7, 0x92, 0xcd, 0xbe, 0xcb, 0x72, 0x91, 0x73, 0xf4, 0xb5, 0x87, 0x52, 0xb7, 0x3d, 0xc2, 0xcb,
+	0x75, 0x9b, 0xf1, 0xf5, 0x70, 0x68, 0x94, 0x28, 0x91, 0xca, 0xf0, 0x0c, 0x56, 0x8b, 0x64, 0x90,
+	0xfa, 0xbf, 0x4d, 0x05, 0x07, 0xe5, 0xfb, 0x6f, 0xbe, 0x59, 0x82, 0x8a, 0x28, 0x8b, 0x7e, 0xd4,
+	0xe0, 0x9d, 0xec, 0xfd, 0x81, 0x36, 0x33, 0x19, 0xcb, 0x2f, 0x32, 0x7d, 0x2b, 0xbf, 0xb0, 0xf8,
+	0x2a, 0x32, 0x6f, 0x7f, 0xf7, 0xe7, 0x3f, 0x3f, 0x5d, 0xd8, 0x44, 0x1b, 0x76, 0x44, 0x82, 0xe0,
+	0xb6, 0x68, 0xde, 0x56, 0xef, 0xd8, 0xcc, 0x45, 0x85, 0x7e, 0xd6, 0xa0, 0xaa, 0x64, 0x2a, 0x22,
+	0x2a, 0xbc, 0x47, 0xf4, 0x8d, 0x99, 0x44, 0x29, 0xce, 0x03, 0x81, 0x73, 0x0f, 0x35, 0xe7, 0xc2,
+	0xb1, 0x5f, 0x4e, 0x7c, 0xe1, 0x15, 0xfa, 0x5e, 0x83, 0xab, 0x19, 0x27, 0x47, 0x37, 0x0b, 0xf5,
+	0xca, 0x79, 0xa8, 0x5e, 0xd0, 0x45, 0xe1, 0x95, 0x60, 0xde, 0x12, 0x78, 0x1b, 0xe8, 0xc3, 0x32,
+	0x3c, 0xc5, 0x54, 0xe3, 0x03, 0x84, 0x49, 0x9a, 0x22, 0x98, 0x22, 0x43, 0xd7, 0x6f, 0xcc, 0x82,
+	0x49, 0x49, 0xee, 0x0b, 0x92, 0x3b, 0xc8, 0x9a, 0x83, 0xc4, 0x7e, 0x39, 0x9e, 0xf1, 0x57, 0x88,
+	0x42, 0x45, 0x98, 0x21, 0x32, 0xf2, 0x65, 0x54, 0x47, 0xd7, 0x1b, 0xa5, 0x71, 0x49, 0xb0, 0x21,
+	0x08, 0x1a, 0x68, 0xad, 0x8c, 0x20, 0x12, 0x75, 0x7e, 0xd1, 0x60, 0x25, 0xe7, 0x76, 0x68, 0x3b,
+	0x9f, 0xbd, 0xcc, 0x77, 0xf5, 0x5b, 0x73, 0xad, 0x95, 0x54, 0x4d, 0x41, 0xb5, 0x83, 0xb6, 0xcb,
+	0xa8, 0xf2, 0x06, 0x8d, 0x7e, 0xd7, 0xe0, 0x5a, 0xa1, 0xdf, 0x21, 0xab, 0x70, 0x80, 0x4a, 0xed,
+	0x44, 0xb7, 0xf3, 0xeb, 0x67, 0x1a, 0xa9, 0x79, 0x4f, 0xe0, 0x5a, 0x68, 0xa7, 0x0c, 0xb7, 0xc8,
+	0x5f, 0xd0, 0x1f, 0x1a, 0xa0, 0x7c, 0xde, 0x22, 0xda, 0x59, 0xe6, 0xa7, 0xef, 0xcc, 0x43, 0x9b,
+	0xa2, 0x7e, 0x2e, 0x50, 0x3f, 0x45, 0x1f, 0xff, 0x17, 0x54, 0x65, 0xf4, 0x76, 0xbf, 0x3c, 0x39,
+	0x35, 0xb4, 0xd7, 0xa7, 0x86, 0xf6, 0xf7, 0xa9, 0xa1, 0xfd, 0x70, 0x66, 0x2c, 0xbc, 0x3e, 0x33,
+	0x16, 0xde, 0x9c, 0x19, 0x0b, 0xdf, 0x34, 0x3d, 0x9f, 0x77, 0x8f, 0xdb, 0x96, 0x4b, 0x8f, 0xec,
+	0x3b, 0xc3, 0x7d, 0x12, 0x04, 0x5f, 0x11, 0xfe, 0x2d, 0xed, 0xf7, 0x6c, 0x4c, 0x3c, 0x9f, 0xd9,
+	0xc3, 0x4c, 0x1d, 0x3e, 0x8a, 0x08, 0x6b, 0x5f, 0x12, 0xff, 0xd0, 0x7f, 0xf4, 0xef, 0x00, 0xfb,
+	0x90, 0xf8, 0xd7, 0xd8, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -709,6 +911,10 @@ type QueryClient interface {
 	Prove(ctx context.Context, in *QueryProveRequest, opts ...grpc.CallOption) (*QueryProveResponse, error)
 	// query verification flags
 	VerificationFlags(ctx context.Context, in *QueryVerificationFlagsRequest, opts ...grpc.CallOption) (*QueryVerificationFlagsResponse, error)
+	// query all sync committee states
+	SyncCommitteeStateAll(ctx context.Context, in *QueryAllSyncCommitteeStateRequest, opts ...grpc.CallOption) (*QuerySyncCommitteeStateAllResponse, error)
+	// query sync committee state by given chain id
+	SyncCommitteeState(ctx context.Context, in *QueryGetSyncCommitteeStateRequest, opts ...grpc.CallOption) (*QuerySyncCommitteeStateResponse, error)
 }
 
 type queryClient struct {
@@ -773,6 +979,24 @@ func (c *queryClient) VerificationFlags(ctx context.Context, in *QueryVerificati
 	return out, nil
 }
 
+func (c *queryClient) SyncCommitteeStateAll(ctx context.Context, in *QueryAllSyncCommitteeStateRequest, opts ...grpc.CallOption) (*QuerySyncCommitteeStateAllResponse, error) {
+	out := new(QuerySyncCommitteeStateAllResponse)
+	err := c.cc.Invoke(ctx, "/lightclient.Query/SyncCommitteeStateAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SyncCommitteeState(ctx context.Context, in *QueryGetSyncCommitteeStateRequest, opts ...grpc.CallOption) (*QuerySyncCommitteeStateResponse, error) {
+	out := new(QuerySyncCommitteeStateResponse)
+	err := c.cc.Invoke(ctx, "/lightclient.Query/SyncCommitteeState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// query all block header
@@ -787,6 +1011,10 @@ type QueryServer interface {
 	Prove(context.Context, *QueryProveRequest) (*QueryProveResponse, error)
 	// query verification flags
 	VerificationFlags(context.Context, *QueryVerificationFlagsRequest) (*QueryVerificationFlagsResponse, error)
+	// query all sync committee states
+	SyncCommitteeStateAll(context.Context, *QueryAllSyncCommitteeStateRequest) (*QuerySyncCommitteeStateAllResponse, error)
+	// query sync committee state by given chain id
+	SyncCommitteeState(context.Context, *QueryGetSyncCommitteeStateRequest) (*QuerySyncCommitteeStateResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -811,6 +1039,12 @@ func (*UnimplementedQueryServer) Prove(ctx context.Context, req *QueryProveReque
 func (*UnimplementedQueryServer) VerificationFlags(ctx context.Context, req *QueryVerificationFlagsRequest) (*QueryVerificationFlagsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VerificationFlags not implemented")
 }
+func (*UnimplementedQueryServer) SyncCommitteeStateAll(ctx context.Context, req *QueryAllSyncCommitteeStateRequest) (*QuerySyncCommitteeStateAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncCommitteeStateAll not implemented")
+}
+func (*UnimplementedQueryServer) SyncCommitteeState(ctx context.Context, req *QueryGetSyncCommitteeStateRequest) (*QuerySyncCommitteeStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncCommitteeState not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -924,6 +1158,42 @@ func _Query_VerificationFlags_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SyncCommitteeStateAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllSyncCommitteeStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SyncCommitteeStateAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lightclient.Query/SyncCommitteeStateAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SyncCommitteeStateAll(ctx, req.(*QueryAllSyncCommitteeStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SyncCommitteeState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetSyncCommitteeStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SyncCommitteeState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lightclient.Query/SyncCommitteeState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SyncCommitteeState(ctx, req.(*QueryGetSyncCommitteeStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lightclient.Query",
@@ -953,6 +1223,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "VerificationFlags",
 			Handler:    _Query_VerificationFlags_Handler,
 		},
+		{
+			MethodName: "SyncCommitteeStateAll",
+			Handler:    _Query_SyncCommitteeStateAll_Handler,
+		},
+		{
+			MethodName: "SyncCommitteeState",
+			Handler:    _Query_SyncCommitteeState_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lightclient/query.proto",
@@ -1402,90 +1680,237 @@ func (m *QueryVerificationFlagsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryAllSyncCommitteeStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryAllBlockHeaderRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QueryAllSyncCommitteeStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBlockHeaderAllResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllSyncCommitteeStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.BlockHeaders) > 0 {
-		for _, e := range m.BlockHeaders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetBlockHeaderRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.BlockHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QuerySyncCommitteeStateAllResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryBlockHeaderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BlockHeader != nil {
-		l = m.BlockHeader.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QuerySyncCommitteeStateAllResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllChainStateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySyncCommitteeStateAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryChainStateAllResponse) Size() (n int) {
-	if m == nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SyncCommitteeStates) > 0 {
+		for iNdEx := len(m.SyncCommitteeStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SyncCommitteeStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetSyncCommitteeStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSyncCommitteeStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSyncCommitteeStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySyncCommitteeStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySyncCommitteeStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySyncCommitteeStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SyncCommitteeState != nil {
+		{
+			size, err := m.SyncCommitteeState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAllBlockHeaderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlockHeaderAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BlockHeaders) > 0 {
+		for _, e := range m.BlockHeaders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetBlockHeaderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlockHeaderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeader != nil {
+		l = m.BlockHeader.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllChainStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChainStateAllResponse) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -1587,6 +2012,63 @@ func (m *QueryVerificationFlagsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryAllSyncCommitteeStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySyncCommitteeStateAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SyncCommitteeStates) > 0 {
+		for _, e := range m.SyncCommitteeStates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetSyncCommitteeStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *QuerySyncCommitteeStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SyncCommitteeState != nil {
+		l = m.SyncCommitteeState.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2721,6 +3203,367 @@ func (m *QueryVerificationFlagsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryAllSyncCommitteeStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllSyncCommitteeStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllSyncCommitteeStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySyncCommitteeStateAllResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySyncCommitteeStateAllResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySyncCommitteeStateAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncCommitteeStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SyncCommitteeStates = append(m.SyncCommitteeStates, SyncCommitteeState{})
+			if err := m.SyncCommitteeStates[len(m.SyncCommitteeStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetSyncCommitteeStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSyncCommitteeStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSyncCommitteeStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySyncCommitteeStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySyncCommitteeStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySyncCommitteeStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncCommitteeState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SyncCommitteeState == nil {
+				m.SyncCommitteeState = &SyncCommitteeState{}
+			}
+			if err := m.SyncCommitteeState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
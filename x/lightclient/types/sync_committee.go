@@ -0,0 +1,389 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"math/bits"
+
+	cosmoserrors "cosmossdk.io/errors"
+
+	"github.com/0xPellNetwork/aegis/pkg/proofs/ethereum"
+)
+
+// HashTreeRoot returns the SSZ hash tree root of the beacon block header
+func (h BeaconBlockHeader) HashTreeRoot() ([32]byte, error) {
+	return ethereum.BeaconBlockHeaderRoot(h.Slot, h.ProposerIndex, h.ParentRoot, h.StateRoot, h.BodyRoot)
+}
+
+// Validate checks the roots of the beacon block header are valid
+func (h BeaconBlockHeader) Validate() error {
+	for name, root := range map[string][]byte{
+		"parent root": h.ParentRoot,
+		"state root":  h.StateRoot,
+		"body root":   h.BodyRoot,
+	} {
+		if len(root) != 32 {
+			return fmt.Errorf("invalid %s length (%d)", name, len(root))
+		}
+	}
+	return nil
+}
+
+// HashTreeRoot returns the SSZ hash tree root of the sync committee
+func (c SyncCommittee) HashTreeRoot() ([32]byte, error) {
+	return ethereum.SyncCommitteeRoot(c.Pubkeys, c.AggregatePubkey)
+}
+
+// Validate checks the sync committee has the expected number of public keys
+// the public keys themselves are proven by the merkle branch of the sync committee
+func (c SyncCommittee) Validate() error {
+	if len(c.Pubkeys) != ethereum.SyncCommitteeSize {
+		return fmt.Errorf("invalid sync committee size (%d)", len(c.Pubkeys))
+	}
+	for i, pubkey := range c.Pubkeys {
+		if len(pubkey) != ethereum.BLSPubkeyLength {
+			return fmt.Errorf("invalid public key %d length (%d)", i, len(pubkey))
+		}
+	}
+	if len(c.AggregatePubkey) != ethereum.BLSPubkeyLength {
+		return fmt.Errorf("invalid aggregate public key length (%d)", len(c.AggregatePubkey))
+	}
+	return nil
+}
+
+// Validate checks the genesis validators root and the forks of the beacon config
+func (c BeaconConfig) Validate() error {
+	if len(c.GenesisValidatorsRoot) != 32 {
+		return fmt.Errorf("invalid genesis validators root length (%d)", len(c.GenesisValidatorsRoot))
+	}
+	if len(c.Forks) == 0 {
+		return fmt.Errorf("no fork")
+	}
+	prevIndex := -1
+	for i, fork := range c.Forks {
+		if len(fork.Version) != 4 {
+			return fmt.Errorf("invalid fork %d version length (%d)", i, len(fork.Version))
+		}
+		index, ok := ethereum.ForkIndex(fork.Name)
+		if !ok {
+			return fmt.Errorf("unknown fork %d name %q", i, fork.Name)
+		}
+		if i > 0 && (fork.Epoch <= c.Forks[i-1].Epoch || index <= prevIndex) {
+			return fmt.Errorf("forks must be sorted by activation epoch")
+		}
+		prevIndex = index
+	}
+	return nil
+}
+
+// ForkAt returns the fork active at the given epoch
+func (c BeaconConfig) ForkAt(epoch uint64) (Fork, error) {
+	var active *Fork
+	for i, fork := range c.Forks {
+		if fork.Epoch > epoch {
+			break
+		}
+		active = &c.Forks[i]
+	}
+	if active == nil {
+		return Fork{}, fmt.Errorf("no fork active at epoch %d", epoch)
+	}
+	return *active, nil
+}
+
+// ForkVersion returns the version of the fork active at the given epoch
+func (c BeaconConfig) ForkVersion(epoch uint64) ([]byte, error) {
+	fork, err := c.ForkAt(epoch)
+	if err != nil {
+		return nil, err
+	}
+	return fork.Version, nil
+}
+
+// SyncCommitteeGindex returns the generalized index of the current or next sync committee
+// in the beacon state of the slot, as defined by the fork active at the slot
+func (c BeaconConfig) SyncCommitteeGindex(slot uint64, next bool) (uint64, error) {
+	fork, err := c.ForkAt(ethereum.Epoch(slot))
+	if err != nil {
+		return 0, err
+	}
+	return ethereum.ForkSyncCommitteeGindex(fork.Name, next)
+}
+
+// FinalizedRootGindex returns the generalized index of the finalized checkpoint root
+// in the beacon state of the slot, as defined by the fork active at the slot
+func (c BeaconConfig) FinalizedRootGindex(slot uint64) (uint64, error) {
+	fork, err := c.ForkAt(ethereum.Epoch(slot))
+	if err != nil {
+		return 0, err
+	}
+	return ethereum.ForkFinalizedRootGindex(fork.Name)
+}
+
+// ExecutionBlockHashGindex returns the generalized index of the execution block hash
+// in the beacon block body of the slot, as defined by the fork active at the slot
+func (c BeaconConfig) ExecutionBlockHashGindex(slot uint64) (uint64, error) {
+	fork, err := c.ForkAt(ethereum.Epoch(slot))
+	if err != nil {
+		return 0, err
+	}
+	return ethereum.ForkExecutionBlockHashGindex(fork.Name)
+}
+
+// Participants returns the number of sync committee members participating in the signature
+func (a SyncAggregate) Participants() int {
+	participants := 0
+	for _, b := range a.SyncCommitteeBits {
+		participants += bits.OnesCount8(b)
+	}
+	return participants
+}
+
+// ValidateBasic performs a stateless validation of the light client update
+func (u LightClientUpdate) ValidateBasic() error {
+	if err := u.AttestedHeader.Validate(); err != nil {
+		return fmt.Errorf("invalid attested header: %w", err)
+	}
+	if u.SignatureSlot <= u.AttestedHeader.Slot {
+		return fmt.Errorf("signature slot %d must be after attested slot %d", u.SignatureSlot, u.AttestedHeader.Slot)
+	}
+
+	// only the execution blocks of finalized beacon blocks are added to the block headers
+	if err := u.FinalizedHeader.Validate(); err != nil {
+		return fmt.Errorf("invalid finalized header: %w", err)
+	}
+	if u.FinalizedHeader.Slot == 0 || u.FinalizedHeader.Slot > u.AttestedHeader.Slot {
+		return fmt.Errorf("finalized slot %d must be in (0, %d]", u.FinalizedHeader.Slot, u.AttestedHeader.Slot)
+	}
+	// the branches are checked against the generalized indices of the fork of their slot on verification
+	if len(u.FinalityBranch) == 0 {
+		return fmt.Errorf("missing finality branch")
+	}
+	if len(u.ExecutionBlockHash) != 32 {
+		return fmt.Errorf("invalid execution block hash length (%d)", len(u.ExecutionBlockHash))
+	}
+	if len(u.ExecutionBranch) == 0 {
+		return fmt.Errorf("missing execution branch")
+	}
+
+	if u.NextSyncCommittee != nil {
+		if err := u.NextSyncCommittee.Validate(); err != nil {
+			return fmt.Errorf("invalid next sync committee: %w", err)
+		}
+		if len(u.NextSyncCommitteeBranch) == 0 {
+			return fmt.Errorf("missing next sync committee branch")
+		}
+	} else if len(u.NextSyncCommitteeBranch) > 0 {
+		return fmt.Errorf("next sync committee branch provided without next sync committee")
+	}
+
+	if len(u.SyncAggregate.SyncCommitteeBits)*8 != ethereum.SyncCommitteeSize {
+		return fmt.Errorf("invalid sync committee bits length (%d)", len(u.SyncAggregate.SyncCommitteeBits))
+	}
+	if len(u.SyncAggregate.SyncCommitteeSignature) != ethereum.BLSSignatureLength {
+		return fmt.Errorf("invalid sync committee signature length (%d)", len(u.SyncAggregate.SyncCommitteeSignature))
+	}
+	return nil
+}
+
+// NewSyncCommitteeState returns the sync committee state of a chain bootstrapped from a trusted beacon block header
+// the current sync committee is proven against the state root of the header
+func NewSyncCommitteeState(
+	chainID int64,
+	config BeaconConfig,
+	header BeaconBlockHeader,
+	committee SyncCommittee,
+	branch [][]byte,
+) (SyncCommitteeState, error) {
+	if err := config.Validate(); err != nil {
+		return SyncCommitteeState{}, cosmoserrors.Wrap(ErrInvalidSyncCommittee, err.Error())
+	}
+	if err := header.Validate(); err != nil {
+		return SyncCommitteeState{}, cosmoserrors.Wrap(ErrInvalidSyncCommittee, err.Error())
+	}
+	if err := committee.Validate(); err != nil {
+		return SyncCommitteeState{}, cosmoserrors.Wrap(ErrInvalidSyncCommittee, err.Error())
+	}
+
+	gindex, err := config.SyncCommitteeGindex(header.Slot, false)
+	if err != nil {
+		return SyncCommitteeState{}, cosmoserrors.Wrap(ErrInvalidSyncCommittee, err.Error())
+	}
+	committeeRoot, err := committee.HashTreeRoot()
+	if err != nil {
+		return SyncCommitteeState{}, cosmoserrors.Wrap(ErrInvalidSyncCommittee, err.Error())
+	}
+	if err := ethereum.VerifyMerkleBranch(committeeRoot, branch, gindex, header.StateRoot); err != nil {
+		return SyncCommitteeState{}, cosmoserrors.Wrapf(ErrInvalidSyncCommittee, "current sync committee: %s", err)
+	}
+
+	return SyncCommitteeState{
+		ChainId:              chainID,
+		Config:               config,
+		Period:               ethereum.SyncCommitteePeriod(header.Slot),
+		CurrentSyncCommittee: committee,
+		LatestAttestedSlot:   header.Slot,
+	}, nil
+}
+
+// ApplyUpdate verifies the light client update is signed by the sync committee of its signature period
+// and returns the new state, the sync committee is rotated if the update is signed by the next sync committee
+//   - the update must be signed by at least 2/3 of the sync committee
+//   - the finalized header is proven against the state root of the attested header
+//   - the execution block hash is proven against the body root of the finalized header
+//   - the next sync committee, if any, is proven against the state root of the attested header
+func (s SyncCommitteeState) ApplyUpdate(update LightClientUpdate) (SyncCommitteeState, bool, error) {
+	if err := update.ValidateBasic(); err != nil {
+		return s, false, cosmoserrors.Wrap(ErrInvalidLightClientUpdate, err.Error())
+	}
+
+	// select the sync committee of the signature period
+	committee := s.CurrentSyncCommittee
+	rotated := false
+	signaturePeriod := ethereum.SyncCommitteePeriod(update.SignatureSlot)
+	switch {
+	case signaturePeriod == s.Period:
+	case signaturePeriod == s.Period+1 && s.NextSyncCommittee != nil:
+		committee = *s.NextSyncCommittee
+		rotated = true
+	default:
+		return s, false, cosmoserrors.Wrapf(
+			ErrSyncCommitteeNotFound,
+			"no sync committee for period %d, current period %d",
+			signaturePeriod,
+			s.Period,
+		)
+	}
+
+	// check the participation
+	participants, err := ethereum.ParticipatingPubkeys(committee.Pubkeys, update.SyncAggregate.SyncCommitteeBits)
+	if err != nil {
+		return s, false, cosmoserrors.Wrap(ErrInvalidLightClientUpdate, err.Error())
+	}
+	if len(participants)*3 < len(committee.Pubkeys)*2 {
+		return s, false, cosmoserrors.Wrapf(
+			ErrInsufficientSyncCommitteeParticipation,
+			"%d of %d sync committee members",
+			len(participants),
+			len(committee.Pubkeys),
+		)
+	}
+
+	// verify the finalized header committed by the attested state
+	gindex, err := s.Config.FinalizedRootGindex(update.AttestedHeader.Slot)
+	if err != nil {
+		return s, false, cosmoserrors.Wrap(ErrInvalidLightClientUpdate, err.Error())
+	}
+	finalizedRoot, err := update.FinalizedHeader.HashTreeRoot()
+	if err != nil {
+		return s, false, cosmoserrors.Wrap(ErrInvalidLightClientUpdate, err.Error())
+	}
+	if err := ethereum.VerifyMerkleBranch(finalizedRoot, update.FinalityBranch, gindex, update.AttestedHeader.StateRoot); err != nil {
+		return s, false, cosmoserrors.Wrapf(ErrInvalidLightClientUpdate, "finalized header: %s", err)
+	}
+
+	// verify the execution block hash of the finalized block
+	gindex, err = s.Config.ExecutionBlockHashGindex(update.FinalizedHeader.Slot)
+	if err != nil {
+		return s, false, cosmoserrors.Wrap(ErrInvalidLightClientUpdate, err.Error())
+	}
+	var executionBlockHash [32]byte
+	copy(executionBlockHash[:], update.ExecutionBlockHash)
+	if err := ethereum.VerifyMerkleBranch(executionBlockHash, update.ExecutionBranch, gindex, update.FinalizedHeader.BodyRoot); err != nil {
+		return s, false, cosmoserrors.Wrapf(ErrInvalidLightClientUpdate, "execution block hash: %s", err)
+	}
+
+	// verify the signature of the attested header
+	if err := verifySyncCommitteeSignature(s.Config, participants, update); err != nil {
+		return s, false, cosmoserrors.Wrap(ErrInvalidSyncCommitteeSignature, err.Error())
+	}
+
+	newState := s
+	if rotated {
+		newState.Period = signaturePeriod
+		newState.CurrentSyncCommittee = *s.NextSyncCommittee
+		newState.NextSyncCommittee = nil
+	}
+
+	// the attested state commits to the sync committee following the attested period
+	if update.NextSyncCommittee != nil {
+		if err := newState.applyNextSyncCommittee(update); err != nil {
+			return s, false, err
+		}
+	}
+
+	if update.AttestedHeader.Slot > newState.LatestAttestedSlot {
+		newState.LatestAttestedSlot = update.AttestedHeader.Slot
+	}
+	if update.FinalizedHeader.Slot > newState.LatestFinalizedSlot {
+		newState.LatestFinalizedSlot = update.FinalizedHeader.Slot
+	}
+
+	return newState, rotated, nil
+}
+
+// applyNextSyncCommittee verifies the next sync committee of the update and tracks it as the next sync committee
+func (s *SyncCommitteeState) applyNextSyncCommittee(update LightClientUpdate) error {
+	gindex, err := s.Config.SyncCommitteeGindex(update.AttestedHeader.Slot, true)
+	if err != nil {
+		return cosmoserrors.Wrap(ErrInvalidLightClientUpdate, err.Error())
+	}
+	nextRoot, err := update.NextSyncCommittee.HashTreeRoot()
+	if err != nil {
+		return cosmoserrors.Wrap(ErrInvalidLightClientUpdate, err.Error())
+	}
+	if err := ethereum.VerifyMerkleBranch(nextRoot, update.NextSyncCommitteeBranch, gindex, update.AttestedHeader.StateRoot); err != nil {
+		return cosmoserrors.Wrapf(ErrInvalidLightClientUpdate, "next sync committee: %s", err)
+	}
+
+	// the proven committee must match the tracked committee of the same period
+	var tracked *SyncCommittee
+	switch attestedPeriod := ethereum.SyncCommitteePeriod(update.AttestedHeader.Slot); attestedPeriod {
+	case s.Period:
+		if s.NextSyncCommittee == nil {
+			s.NextSyncCommittee = update.NextSyncCommittee
+			return nil
+		}
+		tracked = s.NextSyncCommittee
+	case s.Period - 1:
+		tracked = &s.CurrentSyncCommittee
+	default:
+		return cosmoserrors.Wrapf(
+			ErrInvalidLightClientUpdate,
+			"next sync committee of attested period %d does not follow current period %d",
+			attestedPeriod,
+			s.Period,
+		)
+	}
+
+	trackedRoot, err := tracked.HashTreeRoot()
+	if err != nil {
+		return cosmoserrors.Wrap(ErrInvalidLightClientUpdate, err.Error())
+	}
+	if !bytes.Equal(trackedRoot[:], nextRoot[:]) {
+		return cosmoserrors.Wrap(ErrInvalidLightClientUpdate, "next sync committee conflicts with tracked sync committee")
+	}
+	return nil
+}
+
+// verifySyncCommitteeSignature verifies the aggregate signature of the attested header by the participants
+func verifySyncCommitteeSignature(config BeaconConfig, participants [][]byte, update LightClientUpdate) error {
+	// the signature is produced with the fork version of the slot preceding the signature slot
+	forkVersionSlot := update.SignatureSlot - 1
+	forkVersion, err := config.ForkVersion(ethereum.Epoch(forkVersionSlot))
+	if err != nil {
+		return err
+	}
+	domain, err := ethereum.ComputeDomain(ethereum.DomainSyncCommittee, forkVersion, config.GenesisValidatorsRoot)
+	if err != nil {
+		return err
+	}
+
+	headerRoot, err := update.AttestedHeader.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	signingRoot := ethereum.ComputeSigningRoot(headerRoot, domain)
+
+	return ethereum.FastAggregateVerify(participants, signingRoot[:], update.SyncAggregate.SyncCommitteeSignature)
+}
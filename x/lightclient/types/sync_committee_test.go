@@ -0,0 +1,450 @@
+package types_test
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/pkg/proofs/ethereum"
+	"github.com/0xPellNetwork/aegis/testutil/sample"
+	"github.com/0xPellNetwork/aegis/x/lightclient/types"
+)
+
+// slotsPerPeriod is the number of slots in a sync committee period
+const slotsPerPeriod = ethereum.SlotsPerEpoch * ethereum.EpochsPerSyncCommitteePeriod
+
+// bootstrapSlot is the slot of the trusted header, in the middle of period 10
+const bootstrapSlot = 10*slotsPerPeriod + 100
+
+// electraSlot is the first slot of the electra fork of electraConfig, after the bootstrap slot
+const electraSlot = 10*slotsPerPeriod + 4*ethereum.SlotsPerEpoch
+
+// electraConfig returns a beacon config activating deneb from genesis and electra after the bootstrap slot
+func electraConfig() types.BeaconConfig {
+	config := sample.BeaconConfig()
+	config.Forks = []types.Fork{
+		{Version: []byte{0x04, 0, 0, 0}, Epoch: 0, Name: ethereum.ForkDeneb},
+		{Version: []byte{0x05, 0, 0, 0}, Epoch: ethereum.Epoch(electraSlot), Name: ethereum.ForkElectra},
+	}
+	return config
+}
+
+// readBeaconConfig reads a beacon config recorded from the beacon node of a network
+func readBeaconConfig(t *testing.T, filename string) types.BeaconConfig {
+	file, err := os.Open(filename)
+	require.NoError(t, err)
+	defer file.Close()
+
+	var recorded struct {
+		GenesisValidatorsRoot hexutil.Bytes `json:"genesis_validators_root"`
+		Forks                 []struct {
+			Name    string        `json:"name"`
+			Version hexutil.Bytes `json:"version"`
+			Epoch   uint64        `json:"epoch"`
+		} `json:"forks"`
+	}
+	require.NoError(t, json.NewDecoder(file).Decode(&recorded))
+
+	config := types.BeaconConfig{GenesisValidatorsRoot: recorded.GenesisValidatorsRoot}
+	for _, fork := range recorded.Forks {
+		config.Forks = append(config.Forks, types.Fork{Version: fork.Version, Epoch: fork.Epoch, Name: fork.Name})
+	}
+	return config
+}
+
+func bootstrapState(
+	t *testing.T,
+	config types.BeaconConfig,
+	committee sample.SyncCommittee,
+) types.SyncCommitteeState {
+	header, branch := committee.Bootstrap(t, config, bootstrapSlot)
+	state, err := types.NewSyncCommitteeState(1, config, header, committee.Committee, branch)
+	require.NoError(t, err)
+	return state
+}
+
+func TestBeaconConfig_Validate(t *testing.T) {
+	t.Run("should validate config", func(t *testing.T) {
+		require.NoError(t, sample.BeaconConfig().Validate())
+	})
+
+	t.Run("should fail if no fork", func(t *testing.T) {
+		config := sample.BeaconConfig()
+		config.Forks = nil
+		require.Error(t, config.Validate())
+	})
+
+	t.Run("should fail if forks are not sorted", func(t *testing.T) {
+		config := sample.BeaconConfig()
+		config.Forks = append(config.Forks, types.Fork{Version: []byte{0x04, 0, 0, 0}, Epoch: 0, Name: ethereum.ForkDeneb})
+		require.Error(t, config.Validate())
+	})
+
+	t.Run("should fail if unknown fork name", func(t *testing.T) {
+		config := sample.BeaconConfig()
+		config.Forks[0].Name = "unknown"
+		require.Error(t, config.Validate())
+	})
+
+	t.Run("should fail if forks are not in fork order", func(t *testing.T) {
+		config := sample.BeaconConfig()
+		config.Forks = append(config.Forks, types.Fork{Version: []byte{0x02, 0, 0, 0}, Epoch: 10, Name: ethereum.ForkBellatrix})
+		require.Error(t, config.Validate())
+	})
+
+	t.Run("should validate the recorded configs", func(t *testing.T) {
+		require.NoError(t, readBeaconConfig(t, "./testdata/beacon_config_mainnet.json").Validate())
+		require.NoError(t, readBeaconConfig(t, "./testdata/beacon_config_sepolia.json").Validate())
+	})
+
+	t.Run("should fail if invalid genesis validators root", func(t *testing.T) {
+		config := sample.BeaconConfig()
+		config.GenesisValidatorsRoot = []byte{0x01}
+		require.Error(t, config.Validate())
+	})
+}
+
+func TestBeaconConfig_ForkVersion(t *testing.T) {
+	config := types.BeaconConfig{
+		GenesisValidatorsRoot: sample.Hash().Bytes(),
+		Forks: []types.Fork{
+			{Version: []byte{0x02, 0, 0, 0}, Epoch: 10, Name: ethereum.ForkBellatrix},
+			{Version: []byte{0x03, 0, 0, 0}, Epoch: 20, Name: ethereum.ForkCapella},
+		},
+	}
+
+	_, err := config.ForkVersion(9)
+	require.Error(t, err)
+
+	version, err := config.ForkVersion(10)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x02, 0, 0, 0}, version)
+
+	version, err = config.ForkVersion(100)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x03, 0, 0, 0}, version)
+}
+
+func TestBeaconConfig_Gindex(t *testing.T) {
+	tests := []struct {
+		name         string
+		filename     string
+		denebEpoch   uint64
+		electraEpoch uint64
+		capellaEpoch uint64
+		altairEpoch  uint64
+	}{
+		{
+			name:         "mainnet",
+			filename:     "./testdata/beacon_config_mainnet.json",
+			altairEpoch:  74240,
+			capellaEpoch: 194048,
+			denebEpoch:   269568,
+			electraEpoch: 364032,
+		},
+		{
+			name:         "sepolia",
+			filename:     "./testdata/beacon_config_sepolia.json",
+			altairEpoch:  50,
+			capellaEpoch: 56832,
+			denebEpoch:   132608,
+			electraEpoch: 222464,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := readBeaconConfig(t, tt.filename)
+			firstSlot := func(epoch uint64) uint64 { return epoch * ethereum.SlotsPerEpoch }
+
+			// no light client proofs before altair
+			_, err := config.SyncCommitteeGindex(firstSlot(tt.altairEpoch)-1, false)
+			require.Error(t, err)
+			_, err = config.FinalizedRootGindex(firstSlot(tt.altairEpoch) - 1)
+			require.Error(t, err)
+
+			// the sync committees and the finalized root moved in the electra beacon state
+			gindex, err := config.SyncCommitteeGindex(firstSlot(tt.electraEpoch)-1, false)
+			require.NoError(t, err)
+			require.EqualValues(t, ethereum.CurrentSyncCommitteeGindex, gindex)
+			gindex, err = config.SyncCommitteeGindex(firstSlot(tt.electraEpoch)-1, true)
+			require.NoError(t, err)
+			require.EqualValues(t, ethereum.NextSyncCommitteeGindex, gindex)
+			gindex, err = config.FinalizedRootGindex(firstSlot(tt.electraEpoch) - 1)
+			require.NoError(t, err)
+			require.EqualValues(t, ethereum.FinalizedRootGindex, gindex)
+
+			gindex, err = config.SyncCommitteeGindex(firstSlot(tt.electraEpoch), false)
+			require.NoError(t, err)
+			require.EqualValues(t, ethereum.CurrentSyncCommitteeGindexElectra, gindex)
+			gindex, err = config.SyncCommitteeGindex(firstSlot(tt.electraEpoch), true)
+			require.NoError(t, err)
+			require.EqualValues(t, ethereum.NextSyncCommitteeGindexElectra, gindex)
+			gindex, err = config.FinalizedRootGindex(firstSlot(tt.electraEpoch))
+			require.NoError(t, err)
+			require.EqualValues(t, ethereum.FinalizedRootGindexElectra, gindex)
+
+			// the execution payload is proven from capella and moved in the deneb block body
+			_, err = config.ExecutionBlockHashGindex(firstSlot(tt.capellaEpoch) - 1)
+			require.Error(t, err)
+			gindex, err = config.ExecutionBlockHashGindex(firstSlot(tt.denebEpoch) - 1)
+			require.NoError(t, err)
+			require.EqualValues(t, ethereum.ExecutionBlockHashGindexCapella, gindex)
+			gindex, err = config.ExecutionBlockHashGindex(firstSlot(tt.denebEpoch))
+			require.NoError(t, err)
+			require.EqualValues(t, ethereum.ExecutionBlockHashGindexDeneb, gindex)
+		})
+	}
+}
+
+func TestNewSyncCommitteeState(t *testing.T) {
+	committee := sample.NewSyncCommittee(t, 1)
+
+	t.Run("should bootstrap sync committee state", func(t *testing.T) {
+		header, branch := committee.Bootstrap(t, sample.BeaconConfig(), bootstrapSlot)
+		state, err := types.NewSyncCommitteeState(1, sample.BeaconConfig(), header, committee.Committee, branch)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, state.ChainId)
+		require.EqualValues(t, 10, state.Period)
+		require.EqualValues(t, bootstrapSlot, state.LatestAttestedSlot)
+		require.Nil(t, state.NextSyncCommittee)
+	})
+
+	t.Run("should fail if the branch does not prove the committee", func(t *testing.T) {
+		header, branch := committee.Bootstrap(t, sample.BeaconConfig(), bootstrapSlot)
+		branch[0] = sample.Hash().Bytes()
+		_, err := types.NewSyncCommitteeState(1, sample.BeaconConfig(), header, committee.Committee, branch)
+		require.ErrorIs(t, err, types.ErrInvalidSyncCommittee)
+	})
+
+	t.Run("should fail if the committee is proven against another header", func(t *testing.T) {
+		header, branch := committee.Bootstrap(t, sample.BeaconConfig(), bootstrapSlot)
+		header.StateRoot = sample.Hash().Bytes()
+		_, err := types.NewSyncCommitteeState(1, sample.BeaconConfig(), header, committee.Committee, branch)
+		require.ErrorIs(t, err, types.ErrInvalidSyncCommittee)
+	})
+
+	t.Run("should fail if the branch has the depth of another fork", func(t *testing.T) {
+		config := electraConfig()
+		header, branch := committee.Bootstrap(t, config, electraSlot)
+		require.Len(t, branch, 6)
+		_, err := types.NewSyncCommitteeState(1, config, header, committee.Committee, branch)
+		require.NoError(t, err)
+
+		header, branch = committee.Bootstrap(t, sample.BeaconConfig(), electraSlot)
+		_, err = types.NewSyncCommitteeState(1, config, header, committee.Committee, branch)
+		require.ErrorIs(t, err, types.ErrInvalidSyncCommittee)
+	})
+
+	t.Run("should fail if invalid committee size", func(t *testing.T) {
+		header, branch := committee.Bootstrap(t, sample.BeaconConfig(), bootstrapSlot)
+		invalid := committee.Committee
+		invalid.Pubkeys = invalid.Pubkeys[:10]
+		_, err := types.NewSyncCommitteeState(1, sample.BeaconConfig(), header, invalid, branch)
+		require.ErrorIs(t, err, types.ErrInvalidSyncCommittee)
+	})
+}
+
+func TestSyncCommitteeState_ApplyUpdate(t *testing.T) {
+	committee := sample.NewSyncCommittee(t, 1)
+	next := sample.NewSyncCommittee(t, 2)
+	config := sample.BeaconConfig()
+	_, blockHash, _ := sample.EthereumHeader(t)
+
+	t.Run("should apply update signed by the current sync committee", func(t *testing.T) {
+		state := bootstrapState(t, config, committee)
+		update := committee.LightClientUpdate(t, config, bootstrapSlot+10, blockHash, nil)
+
+		newState, rotated, err := state.ApplyUpdate(update)
+		require.NoError(t, err)
+		require.False(t, rotated)
+		require.EqualValues(t, 10, newState.Period)
+		require.EqualValues(t, bootstrapSlot+10, newState.LatestAttestedSlot)
+		require.EqualValues(t, update.FinalizedHeader.Slot, newState.LatestFinalizedSlot)
+		require.Nil(t, newState.NextSyncCommittee)
+	})
+
+	t.Run("should track the next sync committee and rotate", func(t *testing.T) {
+		state := bootstrapState(t, config, committee)
+		update := committee.LightClientUpdate(t, config, bootstrapSlot+10, blockHash, &next)
+
+		state, rotated, err := state.ApplyUpdate(update)
+		require.NoError(t, err)
+		require.False(t, rotated)
+		require.NotNil(t, state.NextSyncCommittee)
+		require.Equal(t, next.Committee, *state.NextSyncCommittee)
+
+		// the first update of the next period is signed by the next sync committee
+		update = next.LightClientUpdate(t, config, 11*slotsPerPeriod+1, blockHash, nil)
+		state, rotated, err = state.ApplyUpdate(update)
+		require.NoError(t, err)
+		require.True(t, rotated)
+		require.EqualValues(t, 11, state.Period)
+		require.Equal(t, next.Committee, state.CurrentSyncCommittee)
+		require.Nil(t, state.NextSyncCommittee)
+		require.EqualValues(t, 11*slotsPerPeriod+1, state.LatestAttestedSlot)
+	})
+
+	t.Run("should accept a next sync committee matching the current one from the previous period", func(t *testing.T) {
+		state := bootstrapState(t, config, committee)
+		state.Period = 11
+
+		// attested in period 10, signed in period 11
+		update := committee.LightClientUpdate(t, config, 11*slotsPerPeriod-1, blockHash, &committee)
+		_, rotated, err := state.ApplyUpdate(update)
+		require.NoError(t, err)
+		require.False(t, rotated)
+	})
+
+	t.Run("should fail if the next sync committee conflicts with the tracked one", func(t *testing.T) {
+		state := bootstrapState(t, config, committee)
+		state.NextSyncCommittee = &next.Committee
+
+		other := sample.NewSyncCommittee(t, 3)
+		update := committee.LightClientUpdate(t, config, bootstrapSlot+10, blockHash, &other)
+		_, _, err := state.ApplyUpdate(update)
+		require.ErrorIs(t, err, types.ErrInvalidLightClientUpdate)
+	})
+
+	t.Run("should fail if the next sync committee is unknown", func(t *testing.T) {
+		state := bootstrapState(t, config, committee)
+		update := next.LightClientUpdate(t, config, 11*slotsPerPeriod+1, blockHash, nil)
+
+		_, _, err := state.ApplyUpdate(update)
+		require.ErrorIs(t, err, types.ErrSyncCommitteeNotFound)
+	})
+
+	t.Run("should fail if the update is from a past period", func(t *testing.T) {
+		state := bootstrapState(t, config, committee)
+		update := committee.LightClientUpdate(t, config, 9*slotsPerPeriod+1, blockHash, nil)
+
+		_, _, err := state.ApplyUpdate(update)
+		require.ErrorIs(t, err, types.ErrSyncCommitteeNotFound)
+	})
+
+	t.Run("should fail if signed by another committee", func(t *testing.T) {
+		state := bootstrapState(t, config, committee)
+		update := committee.LightClientUpdate(t, config, bootstrapSlot+10, blockHash, nil)
+		update.SyncAggregate = next.Sign(t, config, update.AttestedHeader, update.SignatureSlot, ethereum.SyncCommitteeSize)
+
+		_, _, err := state.ApplyUpdate(update)
+		require.ErrorIs(t, err, types.ErrInvalidSyncCommitteeSignature)
+	})
+
+	t.Run("should fail if the attested header is altered", func(t *testing.T) {
+		state := bootstrapState(t, config, committee)
+		update := committee.LightClientUpdate(t, config, bootstrapSlot+10, blockHash, nil)
+		update.AttestedHeader.ProposerIndex++
+
+		_, _, err := state.ApplyUpdate(update)
+		require.ErrorIs(t, err, types.ErrInvalidSyncCommitteeSignature)
+	})
+
+	t.Run("should require 2/3 of the sync committee", func(t *testing.T) {
+		state := bootstrapState(t, config, committee)
+		update := committee.LightClientUpdate(t, config, bootstrapSlot+10, blockHash, nil)
+
+		update.SyncAggregate = committee.Sign(t, config, update.AttestedHeader, update.SignatureSlot, 341)
+		_, _, err := state.ApplyUpdate(update)
+		require.ErrorIs(t, err, types.ErrInsufficientSyncCommitteeParticipation)
+
+		update.SyncAggregate = committee.Sign(t, config, update.AttestedHeader, update.SignatureSlot, 342)
+		_, _, err = state.ApplyUpdate(update)
+		require.NoError(t, err)
+	})
+
+	t.Run("should fail if the execution block hash is not proven", func(t *testing.T) {
+		state := bootstrapState(t, config, committee)
+		update := committee.LightClientUpdate(t, config, bootstrapSlot+10, blockHash, nil)
+		update.ExecutionBlockHash = sample.Hash().Bytes()
+
+		_, _, err := state.ApplyUpdate(update)
+		require.ErrorIs(t, err, types.ErrInvalidLightClientUpdate)
+	})
+
+	t.Run("should fail if the finalized header is altered", func(t *testing.T) {
+		state := bootstrapState(t, config, committee)
+		update := committee.LightClientUpdate(t, config, bootstrapSlot+10, blockHash, nil)
+		update.FinalizedHeader.ProposerIndex++
+
+		_, _, err := state.ApplyUpdate(update)
+		require.ErrorIs(t, err, types.ErrInvalidLightClientUpdate)
+	})
+
+	t.Run("should fail if the execution block hash is proven against the attested header", func(t *testing.T) {
+		state := bootstrapState(t, config, committee)
+		update := committee.LightClientUpdate(t, config, bootstrapSlot+10, blockHash, nil)
+		update.AttestedHeader.BodyRoot = update.FinalizedHeader.BodyRoot
+		update.FinalizedHeader.BodyRoot = sample.Hash().Bytes()
+		update.SyncAggregate = committee.Sign(t, config, update.AttestedHeader, update.SignatureSlot, ethereum.SyncCommitteeSize)
+
+		_, _, err := state.ApplyUpdate(update)
+		require.ErrorIs(t, err, types.ErrInvalidLightClientUpdate)
+	})
+
+	t.Run("should apply updates across the electra fork", func(t *testing.T) {
+		config := electraConfig()
+		state := bootstrapState(t, config, committee)
+
+		// attested and finalized after the electra fork, the proofs are one level deeper
+		update := committee.LightClientUpdate(t, config, electraSlot+2*ethereum.SlotsPerEpoch+10, blockHash, &next)
+		require.Len(t, update.FinalityBranch, 7)
+		require.Len(t, update.NextSyncCommitteeBranch, 6)
+		require.Len(t, update.ExecutionBranch, 9)
+
+		state, _, err := state.ApplyUpdate(update)
+		require.NoError(t, err)
+		require.Equal(t, next.Committee, *state.NextSyncCommittee)
+	})
+
+	t.Run("should fail if a branch has the depth of another fork", func(t *testing.T) {
+		config := electraConfig()
+		state := bootstrapState(t, config, committee)
+		update := committee.LightClientUpdate(t, config, electraSlot+2*ethereum.SlotsPerEpoch+10, blockHash, &next)
+
+		invalid := update
+		invalid.FinalityBranch = invalid.FinalityBranch[1:]
+		_, _, err := state.ApplyUpdate(invalid)
+		require.ErrorIs(t, err, types.ErrInvalidLightClientUpdate)
+
+		invalid = update
+		invalid.NextSyncCommitteeBranch = invalid.NextSyncCommitteeBranch[1:]
+		_, _, err = state.ApplyUpdate(invalid)
+		require.ErrorIs(t, err, types.ErrInvalidLightClientUpdate)
+
+		invalid = update
+		invalid.ExecutionBranch = invalid.ExecutionBranch[1:]
+		_, _, err = state.ApplyUpdate(invalid)
+		require.ErrorIs(t, err, types.ErrInvalidLightClientUpdate)
+	})
+
+	t.Run("should fail if the finality branch is missing", func(t *testing.T) {
+		state := bootstrapState(t, config, committee)
+		update := committee.LightClientUpdate(t, config, bootstrapSlot+10, blockHash, nil)
+		update.FinalityBranch = nil
+
+		_, _, err := state.ApplyUpdate(update)
+		require.ErrorIs(t, err, types.ErrInvalidLightClientUpdate)
+	})
+
+	t.Run("should fail if the finalized header is after the attested header", func(t *testing.T) {
+		state := bootstrapState(t, config, committee)
+		update := committee.LightClientUpdate(t, config, bootstrapSlot+10, blockHash, nil)
+		update.FinalizedHeader.Slot = update.AttestedHeader.Slot + 1
+
+		_, _, err := state.ApplyUpdate(update)
+		require.ErrorIs(t, err, types.ErrInvalidLightClientUpdate)
+	})
+
+	t.Run("should fail if the signature slot is not after the attested slot", func(t *testing.T) {
+		state := bootstrapState(t, config, committee)
+		update := committee.LightClientUpdate(t, config, bootstrapSlot+10, blockHash, nil)
+		update.SignatureSlot = update.AttestedHeader.Slot
+
+		_, _, err := state.ApplyUpdate(update)
+		require.ErrorIs(t, err, types.ErrInvalidLightClientUpdate)
+	})
+}
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	proofs "github.com/0xPellNetwork/aegis/pkg/proofs"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -119,34 +120,297 @@ func (m *MsgUpdateVerificationFlagsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateVerificationFlagsResponse proto.InternalMessageInfo
 
+// message bootstrap sync committee
+type MsgBootstrapSyncCommittee struct {
+	Signer  string       `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	ChainId int64        `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Config  BeaconConfig `protobuf:"bytes,3,opt,name=config,proto3" json:"config"`
+	// trusted beacon block header
+	Header                     BeaconBlockHeader `protobuf:"bytes,4,opt,name=header,proto3" json:"header"`
+	CurrentSyncCommittee       SyncCommittee     `protobuf:"bytes,5,opt,name=current_sync_committee,json=currentSyncCommittee,proto3" json:"current_sync_committee"`
+	CurrentSyncCommitteeBranch [][]byte          `protobuf:"bytes,6,rep,name=current_sync_committee_branch,json=currentSyncCommitteeBranch,proto3" json:"current_sync_committee_branch,omitempty"`
+}
+
+func (m *MsgBootstrapSyncCommittee) Reset()         { *m = MsgBootstrapSyncCommittee{} }
+func (m *MsgBootstrapSyncCommittee) String() string { return proto.CompactTextString(m) }
+func (*MsgBootstrapSyncCommittee) ProtoMessage()    {}
+func (*MsgBootstrapSyncCommittee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81fed8987f08d9c5, []int{2}
+}
+func (m *MsgBootstrapSyncCommittee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBootstrapSyncCommittee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBootstrapSyncCommittee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBootstrapSyncCommittee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBootstrapSyncCommittee.Merge(m, src)
+}
+func (m *MsgBootstrapSyncCommittee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBootstrapSyncCommittee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBootstrapSyncCommittee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBootstrapSyncCommittee proto.InternalMessageInfo
+
+func (m *MsgBootstrapSyncCommittee) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgBootstrapSyncCommittee) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *MsgBootstrapSyncCommittee) GetConfig() BeaconConfig {
+	if m != nil {
+		return m.Config
+	}
+	return BeaconConfig{}
+}
+
+func (m *MsgBootstrapSyncCommittee) GetHeader() BeaconBlockHeader {
+	if m != nil {
+		return m.Header
+	}
+	return BeaconBlockHeader{}
+}
+
+func (m *MsgBootstrapSyncCommittee) GetCurrentSyncCommittee() SyncCommittee {
+	if m != nil {
+		return m.CurrentSyncCommittee
+	}
+	return SyncCommittee{}
+}
+
+func (m *MsgBootstrapSyncCommittee) GetCurrentSyncCommitteeBranch() [][]byte {
+	if m != nil {
+		return m.CurrentSyncCommitteeBranch
+	}
+	return nil
+}
+
+// bootstrap sync committee response
+type MsgBootstrapSyncCommitteeResponse struct {
+}
+
+func (m *MsgBootstrapSyncCommitteeResponse) Reset()         { *m = MsgBootstrapSyncCommitteeResponse{} }
+func (m *MsgBootstrapSyncCommitteeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBootstrapSyncCommitteeResponse) ProtoMessage()    {}
+func (*MsgBootstrapSyncCommitteeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81fed8987f08d9c5, []int{3}
+}
+func (m *MsgBootstrapSyncCommitteeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBootstrapSyncCommitteeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBootstrapSyncCommitteeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBootstrapSyncCommitteeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBootstrapSyncCommitteeResponse.Merge(m, src)
+}
+func (m *MsgBootstrapSyncCommitteeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBootstrapSyncCommitteeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBootstrapSyncCommitteeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBootstrapSyncCommitteeResponse proto.InternalMessageInfo
+
+// message submit light client update
+type MsgSubmitLightClientUpdate struct {
+	Signer  string            `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	ChainId int64             `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Update  LightClientUpdate `protobuf:"bytes,3,opt,name=update,proto3" json:"update"`
+	// execution block header attested by the update
+	ExecutionHeader proofs.HeaderData `protobuf:"bytes,4,opt,name=execution_header,json=executionHeader,proto3" json:"execution_header"`
+}
+
+func (m *MsgSubmitLightClientUpdate) Reset()         { *m = MsgSubmitLightClientUpdate{} }
+func (m *MsgSubmitLightClientUpdate) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitLightClientUpdate) ProtoMessage()    {}
+func (*MsgSubmitLightClientUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81fed8987f08d9c5, []int{4}
+}
+func (m *MsgSubmitLightClientUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitLightClientUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitLightClientUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitLightClientUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitLightClientUpdate.Merge(m, src)
+}
+func (m *MsgSubmitLightClientUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitLightClientUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitLightClientUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitLightClientUpdate proto.InternalMessageInfo
+
+func (m *MsgSubmitLightClientUpdate) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgSubmitLightClientUpdate) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *MsgSubmitLightClientUpdate) GetUpdate() LightClientUpdate {
+	if m != nil {
+		return m.Update
+	}
+	return LightClientUpdate{}
+}
+
+func (m *MsgSubmitLightClientUpdate) GetExecutionHeader() proofs.HeaderData {
+	if m != nil {
+		return m.ExecutionHeader
+	}
+	return proofs.HeaderData{}
+}
+
+// submit light client update response
+type MsgSubmitLightClientUpdateResponse struct {
+	// true if the sync committee has been rotated by the update
+	SyncCommitteeRotated bool `protobuf:"varint,1,opt,name=sync_committee_rotated,json=syncCommitteeRotated,proto3" json:"sync_committee_rotated,omitempty"`
+}
+
+func (m *MsgSubmitLightClientUpdateResponse) Reset()         { *m = MsgSubmitLightClientUpdateResponse{} }
+func (m *MsgSubmitLightClientUpdateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitLightClientUpdateResponse) ProtoMessage()    {}
+func (*MsgSubmitLightClientUpdateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81fed8987f08d9c5, []int{5}
+}
+func (m *MsgSubmitLightClientUpdateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitLightClientUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitLightClientUpdateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitLightClientUpdateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitLightClientUpdateResponse.Merge(m, src)
+}
+func (m *MsgSubmitLightClientUpdateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitLightClientUpdateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitLightClientUpdateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitLightClientUpdateResponse proto.InternalMessageInfo
+
+func (m *MsgSubmitLightClientUpdateResponse) GetSyncCommitteeRotated() bool {
+	if m != nil {
+		return m.SyncCommitteeRotated
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*MsgUpdateVerificationFlags)(nil), "lightclient.MsgUpdateVerificationFlags")
 	proto.RegisterType((*MsgUpdateVerificationFlagsResponse)(nil), "lightclient.MsgUpdateVerificationFlagsResponse")
+	proto.RegisterType((*MsgBootstrapSyncCommittee)(nil), "lightclient.MsgBootstrapSyncCommittee")
+	proto.RegisterType((*MsgBootstrapSyncCommitteeResponse)(nil), "lightclient.MsgBootstrapSyncCommitteeResponse")
+	proto.RegisterType((*MsgSubmitLightClientUpdate)(nil), "lightclient.MsgSubmitLightClientUpdate")
+	proto.RegisterType((*MsgSubmitLightClientUpdateResponse)(nil), "lightclient.MsgSubmitLightClientUpdateResponse")
 }
 
 func init() { proto.RegisterFile("lightclient/tx.proto", fileDescriptor_81fed8987f08d9c5) }
 
 var fileDescriptor_81fed8987f08d9c5 = []byte{
-	// 298 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xc9, 0xc9, 0x4c, 0xcf,
-	0x28, 0x49, 0xce, 0xc9, 0x4c, 0xcd, 0x2b, 0xd1, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0xe2, 0x46, 0x12, 0x95, 0x12, 0x4f, 0xce, 0x2f, 0xce, 0xcd, 0x2f, 0xd6, 0xcf, 0x2d, 0x4e,
-	0xd7, 0x2f, 0x33, 0x04, 0x51, 0x10, 0x55, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e,
-	0x88, 0x05, 0x15, 0x55, 0x41, 0x36, 0xb1, 0x2c, 0xb5, 0x28, 0x33, 0x2d, 0x33, 0x39, 0xb1, 0x24,
-	0x33, 0x3f, 0x2f, 0x3e, 0x2d, 0x27, 0x31, 0xbd, 0x18, 0xa2, 0x4a, 0x69, 0x1a, 0x23, 0x97, 0x94,
-	0x6f, 0x71, 0x7a, 0x68, 0x41, 0x4a, 0x62, 0x49, 0x6a, 0x18, 0x92, 0x2a, 0x37, 0x90, 0x22, 0x21,
-	0x31, 0x2e, 0xb6, 0xe2, 0xcc, 0xf4, 0xbc, 0xd4, 0x22, 0x09, 0x46, 0x05, 0x46, 0x0d, 0xce, 0x20,
-	0x28, 0x4f, 0x28, 0x98, 0x4b, 0x08, 0xd3, 0x48, 0x09, 0x26, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x39,
-	0x3d, 0x24, 0x9b, 0xf5, 0x30, 0xcc, 0x74, 0x62, 0x39, 0x71, 0x4f, 0x9e, 0x21, 0x48, 0xb0, 0x0c,
-	0x5d, 0xc2, 0x8a, 0xbb, 0xe9, 0xf9, 0x06, 0x2d, 0xa8, 0x0d, 0x4a, 0x2a, 0x5c, 0x4a, 0xb8, 0xdd,
-	0x15, 0x94, 0x5a, 0x5c, 0x90, 0x9f, 0x57, 0x9c, 0x6a, 0x54, 0xc5, 0xc5, 0xec, 0x5b, 0x9c, 0x2e,
-	0x54, 0xcc, 0x25, 0x8e, 0xcb, 0x07, 0xea, 0x28, 0xae, 0xc1, 0x6d, 0xa4, 0x94, 0x3e, 0x91, 0x0a,
-	0x61, 0x76, 0x3b, 0xf9, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72,
-	0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x51,
-	0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0xbe, 0x41, 0x45, 0x40, 0x6a, 0x4e,
-	0x8e, 0x5f, 0x6a, 0x49, 0x79, 0x7e, 0x51, 0xb6, 0x7e, 0x62, 0x6a, 0x7a, 0x66, 0xb1, 0x7e, 0x85,
-	0x3e, 0x4a, 0x6c, 0x57, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0xe3, 0xc3, 0x18, 0x10, 0x00, 0x00,
-	0xff, 0xff, 0xfb, 0x04, 0x53, 0xaa, 0x09, 0x02, 0x00, 0x00,
+	// 613 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4d, 0x8f, 0xd2, 0x40,
+	0x18, 0xa6, 0xcb, 0x5a, 0xd7, 0x59, 0x13, 0xb5, 0x21, 0xd0, 0x6d, 0x62, 0x45, 0xdc, 0xe8, 0xc6,
+	0x43, 0xab, 0x68, 0x62, 0x62, 0xf6, 0x62, 0x31, 0x7e, 0x24, 0x8b, 0x31, 0x25, 0xee, 0x61, 0x2f,
+	0x64, 0x18, 0x86, 0x61, 0x42, 0xdb, 0x69, 0x3a, 0x03, 0xc2, 0xd5, 0x5f, 0xe0, 0xc9, 0xdf, 0xe1,
+	0xcf, 0xd8, 0xe3, 0x1e, 0x3d, 0x19, 0x85, 0x83, 0x27, 0xff, 0x83, 0x61, 0x3a, 0x10, 0x0a, 0x34,
+	0x21, 0x9e, 0xe6, 0xe3, 0x7d, 0x9e, 0xf7, 0xe3, 0x79, 0xe7, 0x1d, 0x50, 0x0a, 0x28, 0xe9, 0x0b,
+	0x14, 0x50, 0x1c, 0x09, 0x57, 0x8c, 0x9d, 0x38, 0x61, 0x82, 0x19, 0x87, 0x2b, 0xb7, 0x56, 0x05,
+	0x31, 0x1e, 0x32, 0xee, 0x86, 0x9c, 0xb8, 0xa3, 0xa7, 0xf3, 0x25, 0x45, 0x59, 0x25, 0xc2, 0x08,
+	0x93, 0x5b, 0x77, 0xbe, 0x53, 0xb7, 0xd5, 0x55, 0x8f, 0x7c, 0x12, 0xa1, 0x36, 0x62, 0x61, 0x48,
+	0x85, 0xc0, 0x58, 0x21, 0x8e, 0x57, 0x11, 0x23, 0x9c, 0xd0, 0x1e, 0x45, 0x50, 0x50, 0x16, 0xb5,
+	0x7b, 0x01, 0x24, 0x5c, 0xa1, 0x2a, 0xf1, 0x80, 0xb8, 0x71, 0xc2, 0x58, 0x8f, 0xab, 0x25, 0x35,
+	0xd4, 0xbe, 0x69, 0xc0, 0x6a, 0x72, 0xf2, 0x29, 0xee, 0x42, 0x81, 0xcf, 0x57, 0xe8, 0x6f, 0xe6,
+	0x6c, 0xa3, 0x0c, 0x74, 0x4e, 0x49, 0x84, 0x13, 0x53, 0xab, 0x6a, 0x27, 0x37, 0x7c, 0x75, 0x32,
+	0x5a, 0xc0, 0xd8, 0x8c, 0x65, 0xee, 0x55, 0xb5, 0x93, 0xc3, 0xba, 0xed, 0xac, 0xa4, 0xe4, 0x6c,
+	0xf8, 0xf4, 0xf6, 0x2f, 0x7f, 0xde, 0x2b, 0xf8, 0x77, 0x46, 0xeb, 0x86, 0x97, 0x87, 0x5f, 0xfe,
+	0x7c, 0x7f, 0xac, 0x22, 0xd4, 0x8e, 0x41, 0x2d, 0x3f, 0x2f, 0x1f, 0xf3, 0x98, 0x45, 0x1c, 0xd7,
+	0xfe, 0xee, 0x81, 0xa3, 0x26, 0x27, 0x1e, 0x63, 0x82, 0x8b, 0x04, 0xc6, 0xad, 0x49, 0x84, 0x1a,
+	0x0b, 0x85, 0x72, 0xb3, 0x3f, 0x02, 0x07, 0xa8, 0x0f, 0x69, 0xd4, 0xa6, 0x5d, 0x99, 0x73, 0xd1,
+	0xbf, 0x2e, 0xcf, 0xef, 0xbb, 0xc6, 0x0b, 0xa0, 0x23, 0x16, 0xf5, 0x28, 0x31, 0x8b, 0xb2, 0x98,
+	0xa3, 0x4c, 0x31, 0x1e, 0x86, 0x88, 0x45, 0x0d, 0x09, 0x50, 0x75, 0x28, 0xb8, 0x71, 0x0a, 0xf4,
+	0x3e, 0x86, 0x5d, 0x9c, 0x98, 0xfb, 0x5b, 0x54, 0x48, 0x89, 0x5e, 0xc0, 0xd0, 0xe0, 0x9d, 0x44,
+	0x2d, 0xd8, 0x29, 0xc7, 0x38, 0x07, 0x65, 0x34, 0x4c, 0x12, 0x1c, 0x89, 0x76, 0xb6, 0xcb, 0xe6,
+	0x35, 0xe9, 0xcd, 0xca, 0x78, 0xcb, 0x54, 0xa9, 0x3c, 0x95, 0x14, 0x3f, 0xab, 0xc0, 0x2b, 0x70,
+	0x77, 0xbb, 0xdf, 0x76, 0x27, 0x81, 0x11, 0xea, 0x9b, 0x7a, 0xb5, 0x78, 0x72, 0xd3, 0xb7, 0xb6,
+	0x91, 0x3d, 0x89, 0xc8, 0x76, 0xe5, 0x01, 0xb8, 0x9f, 0x2b, 0xf7, 0xb2, 0x29, 0xb3, 0xf4, 0x4d,
+	0xb5, 0x86, 0x9d, 0x90, 0x8a, 0xb3, 0x79, 0xde, 0x0d, 0x99, 0x77, 0xda, 0xcc, 0xff, 0xe9, 0xca,
+	0x29, 0xd0, 0x87, 0x92, 0x6c, 0x16, 0xb7, 0x88, 0xbb, 0x11, 0x62, 0x21, 0x6e, 0xca, 0x31, 0xde,
+	0x82, 0xdb, 0x78, 0x8c, 0xd1, 0x50, 0xbe, 0xd4, 0x4c, 0x93, 0xca, 0x4e, 0x3c, 0x20, 0x8e, 0x1a,
+	0x88, 0xb4, 0x31, 0xaf, 0xa1, 0x80, 0x8a, 0x7f, 0x6b, 0xc9, 0x4a, 0x4d, 0x59, 0x29, 0x2e, 0x40,
+	0x2d, 0xbf, 0xc8, 0x85, 0x16, 0xc6, 0x73, 0x50, 0x5e, 0x13, 0x3e, 0x61, 0x02, 0x0a, 0xdc, 0x95,
+	0xc5, 0x1f, 0xf8, 0x25, 0x9e, 0x91, 0x30, 0xb5, 0xd5, 0x7f, 0xef, 0x81, 0x62, 0x93, 0x13, 0x83,
+	0x83, 0x4a, 0xde, 0x64, 0x3e, 0xca, 0x48, 0x90, 0x3f, 0x2a, 0x96, 0xbb, 0x23, 0x70, 0x99, 0x72,
+	0x0c, 0xca, 0x39, 0xf3, 0xf4, 0x70, 0xdd, 0xd5, 0x76, 0x9c, 0xe5, 0xec, 0x86, 0x5b, 0x46, 0xe4,
+	0xa0, 0x92, 0xf7, 0x58, 0x36, 0xca, 0xcc, 0x01, 0x5a, 0xee, 0x8e, 0xc0, 0x45, 0x50, 0xef, 0xec,
+	0x72, 0x6a, 0x6b, 0x57, 0x53, 0x5b, 0xfb, 0x35, 0xb5, 0xb5, 0xaf, 0x33, 0xbb, 0x70, 0x35, 0xb3,
+	0x0b, 0x3f, 0x66, 0x76, 0xe1, 0xa2, 0x4e, 0xa8, 0xe8, 0x0f, 0x3b, 0x0e, 0x62, 0xa1, 0xfb, 0x64,
+	0xfc, 0x11, 0x07, 0xc1, 0x07, 0x2c, 0x3e, 0xb3, 0x64, 0xe0, 0x42, 0x4c, 0x28, 0x77, 0xc7, 0x6e,
+	0xe6, 0x9f, 0x9f, 0xc4, 0x98, 0x77, 0x74, 0xf9, 0x9d, 0x3e, 0xfb, 0x37, 0x00, 0xf4, 0x8f, 0xeb,
+	0x1e, 0x03, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -163,6 +427,10 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// update verification flags
 	UpdateVerificationFlags(ctx context.Context, in *MsgUpdateVerificationFlags, opts ...grpc.CallOption) (*MsgUpdateVerificationFlagsResponse, error)
+	// bootstrap the sync committee of an Ethereum chain from a trusted beacon block header
+	BootstrapSyncCommittee(ctx context.Context, in *MsgBootstrapSyncCommittee, opts ...grpc.CallOption) (*MsgBootstrapSyncCommitteeResponse, error)
+	// submit a light client update signed by the sync committee
+	SubmitLightClientUpdate(ctx context.Context, in *MsgSubmitLightClientUpdate, opts ...grpc.CallOption) (*MsgSubmitLightClientUpdateResponse, error)
 }
 
 type msgClient struct {
@@ -182,10 +450,32 @@ func (c *msgClient) UpdateVerificationFlags(ctx context.Context, in *MsgUpdateVe
 	return out, nil
 }
 
+func (c *msgClient) BootstrapSyncCommittee(ctx context.Context, in *MsgBootstrapSyncCommittee, opts ...grpc.CallOption) (*MsgBootstrapSyncCommitteeResponse, error) {
+	out := new(MsgBootstrapSyncCommitteeResponse)
+	err := c.cc.Invoke(ctx, "/lightclient.Msg/BootstrapSyncCommittee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitLightClientUpdate(ctx context.Context, in *MsgSubmitLightClientUpdate, opts ...grpc.CallOption) (*MsgSubmitLightClientUpdateResponse, error) {
+	out := new(MsgSubmitLightClientUpdateResponse)
+	err := c.cc.Invoke(ctx, "/lightclient.Msg/SubmitLightClientUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// update verification flags
 	UpdateVerificationFlags(context.Context, *MsgUpdateVerificationFlags) (*MsgUpdateVerificationFlagsResponse, error)
+	// bootstrap the sync committee of an Ethereum chain from a trusted beacon block header
+	BootstrapSyncCommittee(context.Context, *MsgBootstrapSyncCommittee) (*MsgBootstrapSyncCommitteeResponse, error)
+	// submit a light client update signed by the sync committee
+	SubmitLightClientUpdate(context.Context, *MsgSubmitLightClientUpdate) (*MsgSubmitLightClientUpdateResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -195,6 +485,12 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateVerificationFlags(ctx context.Context, req *MsgUpdateVerificationFlags) (*MsgUpdateVerificationFlagsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateVerificationFlags not implemented")
 }
+func (*UnimplementedMsgServer) BootstrapSyncCommittee(ctx context.Context, req *MsgBootstrapSyncCommittee) (*MsgBootstrapSyncCommitteeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BootstrapSyncCommittee not implemented")
+}
+func (*UnimplementedMsgServer) SubmitLightClientUpdate(ctx context.Context, req *MsgSubmitLightClientUpdate) (*MsgSubmitLightClientUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitLightClientUpdate not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -218,6 +514,42 @@ func _Msg_UpdateVerificationFlags_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_BootstrapSyncCommittee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBootstrapSyncCommittee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BootstrapSyncCommittee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lightclient.Msg/BootstrapSyncCommittee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BootstrapSyncCommittee(ctx, req.(*MsgBootstrapSyncCommittee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitLightClientUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitLightClientUpdate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitLightClientUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lightclient.Msg/SubmitLightClientUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitLightClientUpdate(ctx, req.(*MsgSubmitLightClientUpdate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lightclient.Msg",
@@ -227,6 +559,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateVerificationFlags",
 			Handler:    _Msg_UpdateVerificationFlags_Handler,
 		},
+		{
+			MethodName: "BootstrapSyncCommittee",
+			Handler:    _Msg_BootstrapSyncCommittee_Handler,
+		},
+		{
+			MethodName: "SubmitLightClientUpdate",
+			Handler:    _Msg_SubmitLightClientUpdate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lightclient/tx.proto",
@@ -295,39 +635,293 @@ func (m *MsgUpdateVerificationFlagsResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgBootstrapSyncCommittee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgUpdateVerificationFlags) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgBootstrapSyncCommittee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBootstrapSyncCommittee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.CurrentSyncCommitteeBranch) > 0 {
+		for iNdEx := len(m.CurrentSyncCommitteeBranch) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CurrentSyncCommitteeBranch[iNdEx])
+			copy(dAtA[i:], m.CurrentSyncCommitteeBranch[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.CurrentSyncCommitteeBranch[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	l = m.VerificationFlags.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	{
+		size, err := m.CurrentSyncCommittee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateVerificationFlagsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgBootstrapSyncCommitteeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
+}
+
+func (m *MsgBootstrapSyncCommitteeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBootstrapSyncCommitteeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitLightClientUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitLightClientUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitLightClientUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ExecutionHeader.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Update.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitLightClientUpdateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitLightClientUpdateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitLightClientUpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SyncCommitteeRotated {
+		i--
+		if m.SyncCommitteeRotated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateVerificationFlags) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.VerificationFlags.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateVerificationFlagsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBootstrapSyncCommittee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovTx(uint64(m.ChainId))
+	}
+	l = m.Config.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Header.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.CurrentSyncCommittee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.CurrentSyncCommitteeBranch) > 0 {
+		for _, b := range m.CurrentSyncCommitteeBranch {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgBootstrapSyncCommitteeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitLightClientUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovTx(uint64(m.ChainId))
+	}
+	l = m.Update.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ExecutionHeader.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSubmitLightClientUpdateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SyncCommitteeRotated {
+		n += 2
+	}
+	return n
 }
 
 func sovTx(x uint64) (n int) {
@@ -501,6 +1095,525 @@ func (m *MsgUpdateVerificationFlagsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgBootstrapSyncCommittee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBootstrapSyncCommittee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBootstrapSyncCommittee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSyncCommittee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentSyncCommittee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSyncCommitteeBranch", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentSyncCommitteeBranch = append(m.CurrentSyncCommitteeBranch, make([]byte, postIndex-iNdEx))
+			copy(m.CurrentSyncCommitteeBranch[len(m.CurrentSyncCommitteeBranch)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBootstrapSyncCommitteeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBootstrapSyncCommitteeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBootstrapSyncCommitteeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitLightClientUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitLightClientUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitLightClientUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Update.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExecutionHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitLightClientUpdateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitLightClientUpdateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitLightClientUpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncCommitteeRotated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SyncCommitteeRotated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,23 +1,96 @@
 package keeper
 
 import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	"github.com/0xPellNetwork/aegis/cmd/pellcored/config"
+	"github.com/0xPellNetwork/aegis/x/pevm/types"
 )
 
 // PELLRevertAndCallContract deposits native PELL to the sender address if its account or if the account does not exist yet
-// If it's not an account it calls onRevert function of the connector contract and provides the sender address as the pellTxSenderAddress.The amount of tokens is minted to the pevm module account, wrapped and sent to the contract
+// If it's not an account it calls onRevert function of the connector contract and provides the sender address as the pellTxSenderAddress.
+// The PELL locked in the connector by the original send is unlocked to the pevm module account and sent to the sender or along with the call
 func (k Keeper) PELLRevertAndCallContract(ctx sdk.Context,
 	sender ethcommon.Address,
 	to ethcommon.Address,
 	inboundSenderChainID int64,
 	destinationChainID int64,
+	remainingPellValue *big.Int,
+	message []byte,
 	indexBytes [32]byte) (*evmtypes.MsgEthereumTxResponse, error) {
+	if remainingPellValue == nil {
+		remainingPellValue = big.NewInt(0)
+	}
+
 	acc := k.evmKeeper.GetAccount(ctx, sender)
-	if acc == nil || !acc.IsContract() {
+	isContract := acc != nil && acc.IsContract()
+	if !isContract && remainingPellValue.Sign() == 0 {
+		return nil, nil
+	}
+
+	connectorAddress, err := k.GetPellConnectorContractAddress(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// the value sent to the failed destination is locked in the connector, it is unlocked to the module account
+	// to be sent back to the sender, the supply does not change
+	coins := sdk.NewCoins(sdk.NewCoin(config.BaseDenom, math.NewIntFromBigInt(remainingPellValue)))
+	if !coins.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, connectorAddress.Bytes(), types.ModuleName, coins); err != nil {
+			return nil, fmt.Errorf("failed to unlock coins from the connector: %s", err.Error())
+		}
+	}
+
+	if !isContract {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender.Bytes(), coins); err != nil {
+			return nil, fmt.Errorf("failed to send coins: %s", err.Error())
+		}
 		return nil, nil
 	}
+
 	// Call onRevert function of the connector contract. The connector contract will then call the onRevert function of the pellTxSender contract which is the sender address
-	return nil, nil
+
+	k.Logger(ctx).Info("pevm call pell connector onRevert", "from", types.ModuleAddressEVM,
+		"to", connectorAddress, "sender", sender, "value", remainingPellValue, "destinationAddress", to)
+
+	//  function onRevert(
+	//    address pellTxSenderAddress,
+	//    uint256 sourceChainId,
+	//    bytes calldata destinationAddress,
+	//    uint256 destinationChainId,
+	//    uint256 remainingPellValue,
+	//    bytes calldata message,
+	//    bytes32 internalSendHash
+	//  )
+	res, err := k.CallEVM(
+		ctx,
+		*pellConnectorMetaDataABI,
+		types.ModuleAddressEVM,
+		connectorAddress,
+		remainingPellValue,
+		types.PEVMGasLimit,
+		true,
+		false,
+		"onRevert",
+		sender,
+		big.NewInt(inboundSenderChainID),
+		to.Bytes(),
+		big.NewInt(destinationChainID),
+		remainingPellValue,
+		message,
+		indexBytes,
+	)
+	if err == nil && res != nil && res.Failed() {
+		err = errors.New(res.VmError)
+	}
+
+	return res, err
 }
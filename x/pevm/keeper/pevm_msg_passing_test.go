@@ -0,0 +1,154 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/cmd/pellcored/config"
+	"github.com/0xPellNetwork/aegis/pkg/chains"
+	keepertest "github.com/0xPellNetwork/aegis/testutil/keeper"
+	pevmmocks "github.com/0xPellNetwork/aegis/testutil/keeper/mocks/pevm"
+	"github.com/0xPellNetwork/aegis/testutil/sample"
+	"github.com/0xPellNetwork/aegis/x/pevm/types"
+)
+
+func TestKeeper_PELLRevertAndCallContract(t *testing.T) {
+	var indexBytes [32]byte
+	copy(indexBytes[:], sample.Bytes())
+	senderChainID := chains.PellChainMainnet().Id
+	destinationChainID := chains.GoerliChain().Id
+
+	setupContractCall := func(ctx sdk.Context, mockEVMKeeper *keepertest.PevmMockEVMKeeper, mockAuthKeeper *pevmmocks.PevmAccountKeeper) {
+		mockEVMKeeper.On("ChainID").Maybe().Return(big.NewInt(1))
+		mockEVMKeeper.On("WithChainID", mock.Anything).Maybe().Return(ctx)
+		mockEVMKeeper.On("PellChainID").Maybe().Return(big.NewInt(1))
+		mockEVMKeeper.On("SetBlockBloomTransient", mock.Anything).Maybe()
+		mockEVMKeeper.On("SetLogSizeTransient", mock.Anything).Maybe()
+		mockEVMKeeper.On("GetLogSizeTransient", mock.Anything, mock.Anything).Maybe()
+		mockAuthKeeper.On("GetSequence", mock.Anything, mock.Anything).Return(uint64(1), nil)
+	}
+
+	t.Run("should do nothing for an account sender without value", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.PevmKeeperWithMocks(t, keepertest.PevmMockOptions{
+			UseBankMock: true,
+			UseEVMMock:  true,
+		})
+		mockEVMKeeper := keepertest.GetPevmEVMMock(t, k)
+		mockBankKeeper := keepertest.GetPevmBankMock(t, k)
+
+		sender := sample.EthAddress()
+		mockEVMKeeper.On("GetAccount", mock.Anything, sender).Return(statedb.NewEmptyAccount())
+
+		res, err := k.PELLRevertAndCallContract(ctx, sender, sample.EthAddress(), senderChainID, destinationChainID, nil, nil, indexBytes)
+		require.NoError(t, err)
+		require.Nil(t, res)
+		mockBankKeeper.AssertNotCalled(t, "SendCoinsFromAccountToModule", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should refund the value locked in the connector to an account sender", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.PevmKeeperWithMocks(t, keepertest.PevmMockOptions{
+			UseBankMock: true,
+			UseEVMMock:  true,
+		})
+		mockEVMKeeper := keepertest.GetPevmEVMMock(t, k)
+		mockBankKeeper := keepertest.GetPevmBankMock(t, k)
+		connector := sample.EthAddress()
+		k.SetSystemContract(ctx, types.SystemContract{Connector: connector.Hex()})
+
+		sender := sample.EthAddress()
+		coins := sdk.NewCoins(sdk.NewCoin(config.BaseDenom, math.NewInt(42)))
+		mockEVMKeeper.On("GetAccount", mock.Anything, sender).Return(nil)
+		mockBankKeeper.On("SendCoinsFromAccountToModule", mock.Anything, sdk.AccAddress(connector.Bytes()), types.ModuleName, coins).Return(nil).Once()
+		mockBankKeeper.On("SendCoinsFromModuleToAccount", mock.Anything, types.ModuleName, sdk.AccAddress(sender.Bytes()), coins).Return(nil).Once()
+
+		res, err := k.PELLRevertAndCallContract(ctx, sender, sample.EthAddress(), senderChainID, destinationChainID, big.NewInt(42), nil, indexBytes)
+		require.NoError(t, err)
+		require.Nil(t, res)
+		mockBankKeeper.AssertNotCalled(t, "MintCoins", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should fail if the connector does not hold the value", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.PevmKeeperWithMocks(t, keepertest.PevmMockOptions{
+			UseBankMock: true,
+			UseEVMMock:  true,
+		})
+		mockEVMKeeper := keepertest.GetPevmEVMMock(t, k)
+		mockBankKeeper := keepertest.GetPevmBankMock(t, k)
+		k.SetSystemContract(ctx, types.SystemContract{Connector: sample.EthAddress().Hex()})
+
+		sender := sample.EthAddress()
+		mockEVMKeeper.On("GetAccount", mock.Anything, sender).Return(nil)
+		mockBankKeeper.On("SendCoinsFromAccountToModule", mock.Anything, mock.Anything, types.ModuleName, mock.Anything).
+			Return(sdkerrors.ErrInsufficientFunds).Once()
+
+		_, err := k.PELLRevertAndCallContract(ctx, sender, sample.EthAddress(), senderChainID, destinationChainID, big.NewInt(42), nil, indexBytes)
+		require.ErrorContains(t, err, "failed to unlock coins from the connector")
+		mockBankKeeper.AssertNotCalled(t, "SendCoinsFromModuleToAccount", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should fail for a contract sender if the connector is not set", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.PevmKeeperWithMocks(t, keepertest.PevmMockOptions{
+			UseBankMock: true,
+			UseEVMMock:  true,
+		})
+		mockEVMKeeper := keepertest.GetPevmEVMMock(t, k)
+
+		sender := sample.EthAddress()
+		mockEVMKeeper.On("GetAccount", mock.Anything, sender).Return(&statedb.Account{CodeHash: sample.Bytes()})
+
+		_, err := k.PELLRevertAndCallContract(ctx, sender, sample.EthAddress(), senderChainID, destinationChainID, big.NewInt(42), nil, indexBytes)
+		require.ErrorIs(t, err, types.ErrStateVariableNotFound)
+	})
+
+	t.Run("should call the connector onRevert for a contract sender", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.PevmKeeperWithMocks(t, keepertest.PevmMockOptions{
+			UseBankMock:    true,
+			UseAccountMock: true,
+			UseEVMMock:     true,
+		})
+		mockEVMKeeper := keepertest.GetPevmEVMMock(t, k)
+		mockBankKeeper := keepertest.GetPevmBankMock(t, k)
+		mockAuthKeeper := keepertest.GetPevmAccountMock(t, k)
+		connector := sample.EthAddress()
+		k.SetSystemContract(ctx, types.SystemContract{Connector: connector.Hex()})
+
+		sender := sample.EthAddress()
+		coins := sdk.NewCoins(sdk.NewCoin(config.BaseDenom, math.NewInt(42)))
+		mockEVMKeeper.On("GetAccount", mock.Anything, sender).Return(&statedb.Account{CodeHash: sample.Bytes()})
+		mockBankKeeper.On("SendCoinsFromAccountToModule", mock.Anything, sdk.AccAddress(connector.Bytes()), types.ModuleName, coins).Return(nil).Once()
+		setupContractCall(ctx, mockEVMKeeper, mockAuthKeeper)
+		mockEVMKeeper.MockEVMSuccessCallOnce()
+
+		res, err := k.PELLRevertAndCallContract(ctx, sender, sample.EthAddress(), senderChainID, destinationChainID, big.NewInt(42), []byte("message"), indexBytes)
+		require.NoError(t, err)
+		require.NotNil(t, res)
+		mockBankKeeper.AssertNotCalled(t, "SendCoinsFromModuleToAccount", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should fail if the connector onRevert reverts", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.PevmKeeperWithMocks(t, keepertest.PevmMockOptions{
+			UseBankMock:    true,
+			UseAccountMock: true,
+			UseEVMMock:     true,
+		})
+		mockEVMKeeper := keepertest.GetPevmEVMMock(t, k)
+		mockAuthKeeper := keepertest.GetPevmAccountMock(t, k)
+		k.SetSystemContract(ctx, types.SystemContract{Connector: sample.EthAddress().Hex()})
+
+		sender := sample.EthAddress()
+		mockEVMKeeper.On("GetAccount", mock.Anything, sender).Return(&statedb.Account{CodeHash: sample.Bytes()})
+		setupContractCall(ctx, mockEVMKeeper, mockAuthKeeper)
+		mockEVMKeeper.MockEVMSuccessCallOnceWithReturn(&evmtypes.MsgEthereumTxResponse{VmError: "execution reverted"})
+
+		_, err := k.PELLRevertAndCallContract(ctx, sender, sample.EthAddress(), senderChainID, destinationChainID, nil, []byte("message"), indexBytes)
+		require.ErrorContains(t, err, "execution reverted")
+	})
+}
@@ -15,6 +15,9 @@ const (
 	ReceiveCall PellSentParamType = iota
 	RevertableCall
 	Transfer
+	// PellTokenTransfer is an ERC20 transfer of the pell token, it is only created by the tss fund migrations
+	// and is never parsed from a contract event
+	PellTokenTransfer
 )
 
 // String returns a string representation of PellSentParamType.
@@ -26,6 +29,8 @@ func (p PellSentParamType) String() string {
 		return "1"
 	case Transfer:
 		return "2"
+	case PellTokenTransfer:
+		return "3"
 	default:
 		return "Unknown"
 	}
@@ -40,6 +45,8 @@ func PellSentParamTypeFromString(s string) (PellSentParamType, error) {
 		return RevertableCall, nil
 	case "2":
 		return Transfer, nil
+	case "3":
+		return PellTokenTransfer, nil
 	default:
 		return 0, fmt.Errorf("invalid PellSentParamType string: %s", s)
 	}
@@ -77,7 +84,7 @@ func (p PellSentParamType) MethodName() (string, error) {
 		return "receiveCall", nil
 	case RevertableCall:
 		return "onReceive", nil
-	case Transfer:
+	case Transfer, PellTokenTransfer:
 		return "", nil
 	default:
 		return "", fmt.Errorf("invalid PellSentParamType: %d", p)
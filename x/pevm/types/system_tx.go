@@ -5,4 +5,5 @@ const (
 	SystemTxTypeSyncDelegationShares   uint8 = 1
 	SystemTxTypeSyncOperatorRegistered uint8 = 2
 	SystemTxTypeSyncDVSGroup           uint8 = 3
+	SystemTxTypeEjectOperators         uint8 = 4
 )
@@ -10,6 +10,12 @@ import (
 )
 
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	// penalize the observers that did not vote on the matured ballots
+	k.AddMissedBallotsToObservers(ctx)
+
+	// lift the chain pauses expired at the current height
+	k.RemoveExpiredChainPauses(ctx)
+
 	lastBlockObserverCount, found := k.GetLastObserverCount(ctx)
 	if !found {
 		ctx.Logger().Error("LastBlockObserverCount not found at height", ctx.BlockHeight())
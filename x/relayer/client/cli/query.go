@@ -25,11 +25,17 @@ func GetQueryCmd(_ string) *cobra.Command {
 		CmdBallotByIdentifier(),
 		CmdObserverSet(),
 		CmdGetSupportedChains(),
+		CmdGetChainList(),
+		CmdListRestrictedAddresses(),
 		CmdGetChainParamsForChain(),
 		CmdGetChainParams(),
 		CmdListNodeAccount(),
 		CmdShowNodeAccount(),
+		CmdListRelayerPenalty(),
+		CmdShowRelayerPenalty(),
 		CmdShowCrosschainFlags(),
+		CmdListChainPause(),
+		CmdShowChainPause(),
 		CmdShowKeygen(),
 		CmdShowObserverCount(),
 		CmdBlameByIdentifier(),
@@ -37,6 +43,7 @@ func GetQueryCmd(_ string) *cobra.Command {
 		CmdGetBlameByChainAndNonce(),
 		CmdGetTssAddress(),
 		CmdListTssHistory(),
+		CmdShowTssRotation(),
 		CmdShowTSS(),
 		CmdGetTssAddressByFinalizedPellHeight(),
 		CmdListChainNonces(),
@@ -0,0 +1,68 @@
+package cli
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/0xPellNetwork/aegis/x/relayer/types"
+)
+
+func CmdListChainPause() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-chain-pause",
+		Short: "list the inbound, outbound and signing pauses of all chains",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ChainPauseAll(context.Background(), &types.QueryAllChainPauseRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowChainPause() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-chain-pause [chain-id]",
+		Short: "shows the inbound, outbound and signing pause of a chain",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			chainID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryChainPauseRequest{
+				ChainId: chainID,
+			}
+
+			res, err := queryClient.ChainPause(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/0xPellNetwork/aegis/x/relayer/types"
+)
+
+func CmdListRelayerPenalty() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-relayer-penalty",
+		Short: "list the blame and missed ballot records of all observers",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllRelayerPenaltyRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.RelayerPenaltyAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowRelayerPenalty() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-relayer-penalty [observer_address]",
+		Short: "shows the blame and missed ballot record of an observer",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryRelayerPenaltyRequest{
+				ObserverAddress: args[0],
+			}
+
+			res, err := queryClient.RelayerPenalty(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,36 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/0xPellNetwork/aegis/x/relayer/types"
+)
+
+func CmdListRestrictedAddresses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-restricted-addresses",
+		Short: "list the restricted addresses used for compliance screening",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryRestrictedAddressesRequest{}
+
+			res, err := queryClient.RestrictedAddresses(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
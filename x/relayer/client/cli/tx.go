@@ -30,6 +30,14 @@ func GetTxCmd() *cobra.Command {
 		CmdEncode(),
 		CmdResetChainNonces(),
 		CmdVoteTSS(),
+		CmdAddChain(),
+		CmdUpdateChain(),
+		CmdDeprecateChain(),
+		CmdUpdateRestrictedAddresses(),
+		CmdUnjailObserver(),
+		CmdUpdateChainPause(),
+		CmdStartTssRotation(),
+		CmdCancelTssRotation(),
 		CmdDeleteBallot(), // TODO: remove this after the next upgrade
 	)
 
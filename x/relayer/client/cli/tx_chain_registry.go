@@ -0,0 +1,142 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/0xPellNetwork/aegis/pkg/chains"
+	"github.com/0xPellNetwork/aegis/x/relayer/types"
+)
+
+func CmdAddChain() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "add-chain [chain-id] [network] [network-type] [vm-type]",
+		Short:   "Broadcast message to add a chain to the chain registry",
+		Example: "pellcored tx relayer add-chain 1000 ETH TESTNET EVM",
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			chain, err := parseChain(args)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddChain(
+				clientCtx.GetFromAddress().String(),
+				chain,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdUpdateChain() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-chain [chain-id] [network] [network-type] [vm-type]",
+		Short:   "Broadcast message to update a chain of the chain registry",
+		Example: "pellcored tx relayer update-chain 1000 ETH MAINNET EVM",
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			chain, err := parseChain(args)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateChain(
+				clientCtx.GetFromAddress().String(),
+				chain,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdDeprecateChain() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deprecate-chain [chain-id]",
+		Short: "Broadcast message to deprecate a chain of the chain registry",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			chainID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDeprecateChain(
+				clientCtx.GetFromAddress().String(),
+				chainID,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseChain parses a chain from the [chain-id] [network] [network-type] [vm-type] arguments
+func parseChain(args []string) (chains.Chain, error) {
+	chainID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return chains.Chain{}, err
+	}
+
+	network, ok := chains.NetWork_value[args[1]]
+	if !ok {
+		return chains.Chain{}, fmt.Errorf("invalid network %s", args[1])
+	}
+
+	networkType, ok := chains.NetWorkType_value[args[2]]
+	if !ok {
+		return chains.Chain{}, fmt.Errorf("invalid network type %s", args[2])
+	}
+
+	vmType, ok := chains.VMType_value[args[3]]
+	if !ok {
+		return chains.Chain{}, fmt.Errorf("invalid vm type %s", args[3])
+	}
+
+	return chains.Chain{
+		Id:          chainID,
+		Network:     chains.NetWork(network),
+		NetworkType: chains.NetWorkType(networkType),
+		VmType:      chains.VMType(vmType),
+	}, nil
+}
@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/0xPellNetwork/aegis/x/relayer/types"
+)
+
+func CmdStartTssRotation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "start-tss-rotation [keygen-block] [migrations]",
+		Short: "Broadcast message to schedule a keygen and rotate the TSS once the new key is generated",
+		Long: `Broadcast message to schedule a keygen and rotate the TSS once the new key is generated.
+The migrations are the comma separated amounts migrated to the new TSS on each supported chain, in the form chain-id:amount or chain-id:amount:pell-amount to also migrate the pell token.`,
+		Example: "pellcored tx relayer start-tss-rotation 100000 11155111:1000000000000000000:5000000000000000000,97:2000000000000000000",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			keygenBlock, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			migrations, err := parseTssRotationMigrations(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgStartTssRotation(clientCtx.GetFromAddress().String(), keygenBlock, migrations)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdCancelTssRotation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-tss-rotation",
+		Short: "Broadcast message to cancel a TSS rotation before the funds are migrated",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelTssRotation(clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseTssRotationMigrations parses the migrations in the form chain-id:amount[:pell-amount],chain-id:amount[:pell-amount]
+func parseTssRotationMigrations(arg string) ([]types.TssRotationMigration, error) {
+	var migrations []types.TssRotationMigration
+	for _, item := range strings.Split(arg, ",") {
+		parts := strings.Split(strings.TrimSpace(item), ":")
+		if len(parts) != 2 && len(parts) != 3 {
+			return nil, fmt.Errorf("invalid migration %s, expected chain-id:amount[:pell-amount]", item)
+		}
+		chainID, err := strconv.ParseInt(parts[0], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		amount, err := sdkmath.ParseUint(parts[1])
+		if err != nil {
+			return nil, err
+		}
+		pellAmount := sdkmath.ZeroUint()
+		if len(parts) == 3 {
+			if pellAmount, err = sdkmath.ParseUint(parts[2]); err != nil {
+				return nil, err
+			}
+		}
+		migrations = append(migrations, types.TssRotationMigration{ChainId: chainID, Amount: amount, PellAmount: pellAmount})
+	}
+	return migrations, nil
+}
@@ -0,0 +1,68 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/0xPellNetwork/aegis/x/relayer/types"
+)
+
+const flagExpiryHeight = "expiry-height"
+
+func CmdUpdateChainPause() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-chain-pause [chain-id] [inbound-paused] [outbound-paused] [signing-paused]",
+		Short:   "Broadcast message to pause or resume inbound, outbound and signing for a single chain",
+		Example: "pellcored tx relayer update-chain-pause 11155111 true true true --expiry-height 100000",
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			chainID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			isInboundPaused, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+			isOutboundPaused, err := strconv.ParseBool(args[2])
+			if err != nil {
+				return err
+			}
+			isSigningPaused, err := strconv.ParseBool(args[3])
+			if err != nil {
+				return err
+			}
+			expiryHeight, err := cmd.Flags().GetInt64(flagExpiryHeight)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateChainPause(
+				clientCtx.GetFromAddress().String(),
+				chainID,
+				isInboundPaused,
+				isOutboundPaused,
+				isSigningPaused,
+				expiryHeight,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Int64(flagExpiryHeight, 0, "block height at which the pause expires, 0 if the pause never expires")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/0xPellNetwork/aegis/x/relayer/types"
+)
+
+const (
+	flagAddRestrictedAddresses    = "add"
+	flagRemoveRestrictedAddresses = "remove"
+)
+
+func CmdUpdateRestrictedAddresses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-restricted-addresses",
+		Short:   "Broadcast message to add and remove addresses from the restricted address list",
+		Example: "pellcored tx relayer update-restricted-addresses --add 0x8531a5aB847ff5B22D855633C25ED1DA3255247e --remove 0x27104b8dB4aEdDb054fCed87c346C0758Ff5dFB1",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			added, err := cmd.Flags().GetStringSlice(flagAddRestrictedAddresses)
+			if err != nil {
+				return err
+			}
+			removed, err := cmd.Flags().GetStringSlice(flagRemoveRestrictedAddresses)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateRestrictedAddresses(
+				clientCtx.GetFromAddress().String(),
+				trimAddresses(added),
+				trimAddresses(removed),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(flagAddRestrictedAddresses, nil, "comma separated list of addresses to add to the restricted address list")
+	cmd.Flags().StringSlice(flagRemoveRestrictedAddresses, nil, "comma separated list of addresses to remove from the restricted address list")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// trimAddresses trims the spaces around the given addresses
+func trimAddresses(addresses []string) []string {
+	trimmed := make([]string, 0, len(addresses))
+	for _, address := range addresses {
+		trimmed = append(trimmed, strings.TrimSpace(address))
+	}
+	return trimmed
+}
@@ -17,6 +17,15 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		observerCount = uint64(len(genState.Observers.RelayerList))
 	}
 
+	// if the chain registry is defined set it, otherwise seed it with the default chains
+	chainList := genState.ChainList
+	if len(chainList) == 0 {
+		chainList = chains.ChainsList()
+	}
+	for _, chain := range chainList {
+		k.SetChain(ctx, chain)
+	}
+
 	// if chain params are defined set them
 	if len(genState.ChainParamsList.ChainParams) > 0 {
 		k.SetChainParamsList(ctx, genState.ChainParamsList)
@@ -57,6 +66,7 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		if genState.CrosschainFlags.GasPriceIncreaseFlags != nil {
 			crosschainFlags.GasPriceIncreaseFlags = genState.CrosschainFlags.GasPriceIncreaseFlags
 		}
+		crosschainFlags.ChainPauses = genState.CrosschainFlags.ChainPauses
 		k.SetCrosschainFlags(ctx, *crosschainFlags)
 	} else {
 		k.SetCrosschainFlags(ctx, *types.DefaultCrosschainFlags())
@@ -102,7 +112,7 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 			k.SetPendingNonces(ctx, pendingNonce)
 		}
 	} else {
-		for _, chain := range chains.ChainsList() {
+		for _, chain := range k.GetChainList(ctx) {
 			if genState.Tss != nil {
 				k.SetPendingNonces(ctx, types.PendingNonces{
 					NonceLow:  0,
@@ -133,6 +143,18 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		k.SetNonceToXmsg(ctx, elem)
 	}
 
+	for _, address := range genState.RestrictedAddresses {
+		k.SetRestrictedAddress(ctx, address)
+	}
+
+	for _, penalty := range genState.RelayerPenalties {
+		k.SetRelayerPenalty(ctx, penalty)
+	}
+
+	if genState.TssRotation != nil {
+		k.SetTssRotation(ctx, *genState.TssRotation)
+	}
+
 }
 
 // ExportGenesis returns the observer module's exported genesis.
@@ -184,6 +206,12 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		pendingNonces = p
 	}
 
+	var tssRotation *types.TssRotation
+	rotation, found := k.GetTssRotation(ctx)
+	if found {
+		tssRotation = &rotation
+	}
+
 	os := types.RelayerSet{}
 	observers, found := k.GetObserverSet(ctx)
 	if found {
@@ -191,20 +219,24 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	}
 
 	return &types.GenesisState{
-		Ballots:           k.GetAllBallots(ctx),
-		ChainParamsList:   chainParams,
-		Observers:         os,
-		Params:            &params,
-		NodeAccountList:   nodeAccounts,
-		CrosschainFlags:   cf,
-		Keygen:            kn,
-		LastObserverCount: oc,
-		Tss:               tss,
-		PendingNonces:     pendingNonces,
-		TssHistory:        k.GetAllTSS(ctx),
-		TssFundMigrators:  k.GetAllTssFundMigrators(ctx),
-		BlameList:         k.GetAllBlame(ctx),
-		ChainNonces:       k.GetAllChainNonces(ctx),
-		NonceToXmsg:       k.GetAllNonceToXmsg(ctx),
+		Ballots:             k.GetAllBallots(ctx),
+		ChainParamsList:     chainParams,
+		Observers:           os,
+		Params:              &params,
+		NodeAccountList:     nodeAccounts,
+		CrosschainFlags:     cf,
+		Keygen:              kn,
+		LastObserverCount:   oc,
+		Tss:                 tss,
+		PendingNonces:       pendingNonces,
+		TssHistory:          k.GetAllTSS(ctx),
+		TssFundMigrators:    k.GetAllTssFundMigrators(ctx),
+		BlameList:           k.GetAllBlame(ctx),
+		ChainNonces:         k.GetAllChainNonces(ctx),
+		NonceToXmsg:         k.GetAllNonceToXmsg(ctx),
+		ChainList:           k.GetChainList(ctx),
+		RestrictedAddresses: k.GetAllRestrictedAddresses(ctx),
+		RelayerPenalties:    k.GetAllRelayerPenalties(ctx),
+		TssRotation:         tssRotation,
 	}
 }
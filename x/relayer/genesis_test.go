@@ -1,10 +1,13 @@
 package relayer_test
 
 import (
+	"sort"
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 
+	"github.com/0xPellNetwork/aegis/pkg/chains"
 	keepertest "github.com/0xPellNetwork/aegis/testutil/keeper"
 	"github.com/0xPellNetwork/aegis/testutil/nullify"
 	"github.com/0xPellNetwork/aegis/testutil/sample"
@@ -16,6 +19,11 @@ func TestGenesis(t *testing.T) {
 	t.Run("genState fields defined", func(t *testing.T) {
 		params := types.DefaultParams()
 		tss := sample.Tss_pell()
+		// the chain registry is exported sorted by chain id
+		chainList := chains.ChainsList()
+		sort.Slice(chainList, func(i, j int) bool { return chainList[i].Id < chainList[j].Id })
+		crosschainFlags := types.DefaultCrosschainFlags()
+		crosschainFlags.SetChainPause(types.ChainPause{ChainId: chains.SepoliaChain().Id, IsSigningPaused: true})
 		genesisState := types.GenesisState{
 			Params:    &params,
 			Tss:       &tss,
@@ -31,7 +39,7 @@ func TestGenesis(t *testing.T) {
 				sample.NodeAccount_pell(),
 				sample.NodeAccount_pell(),
 			},
-			CrosschainFlags:   types.DefaultCrosschainFlags(),
+			CrosschainFlags:   crosschainFlags,
 			Keygen:            sample.Keygen_pell(t),
 			ChainParamsList:   sample.ChainParamsList_pell(),
 			LastObserverCount: sample.LastObserverCount_pell(10),
@@ -44,6 +52,21 @@ func TestGenesis(t *testing.T) {
 			PendingNonces: sample.PendingNoncesList_pell(t, "sample", 20),
 			NonceToXmsg:   sample.NonceToXmsgList_pell(t, "sample", 20),
 			TssHistory:    []types.TSS{sample.Tss_pell()},
+			ChainList:     chainList,
+			// the restricted addresses are exported in normalized form sorted by address
+			RestrictedAddresses: []string{
+				"0x27104b8db4aeddb054fced87c346c0758ff5dfb1",
+				"0x8531a5ab847ff5b22d855633c25ed1da3255247e",
+			},
+			RelayerPenalties: []types.RelayerPenalty{sample.RelayerPenalty_pell(t, "0")},
+			TssRotation: &types.TssRotation{
+				Stage:        types.TssRotationStage_TSS_ROTATION_MIGRATING,
+				OldTssPubkey: sample.Tss_pell().TssPubkey,
+				NewTssPubkey: sample.Tss_pell().TssPubkey,
+				Migrations: []types.TssRotationMigration{
+					{ChainId: chains.SepoliaChain().Id, Amount: sdkmath.NewUint(1000), MigrationXmsgIndex: "index"},
+				},
+			},
 		}
 
 		// Init and export
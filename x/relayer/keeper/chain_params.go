@@ -42,7 +42,7 @@ func (k Keeper) GetChainParamsByChainID(ctx sdk.Context, chainID int64) (*types.
 }
 
 // GetSupportedChainFromChainID returns the chain from the chain id
-// it returns nil if the chain doesn't exist or is not supported
+// it returns nil if the chain doesn't exist in the chain registry, is deprecated or is not supported
 func (k Keeper) GetSupportedChainFromChainID(ctx sdk.Context, chainID int64) *chains.Chain {
 	cpl, found := k.GetChainParamsList(ctx)
 	if !found {
@@ -51,8 +51,8 @@ func (k Keeper) GetSupportedChainFromChainID(ctx sdk.Context, chainID int64) *ch
 
 	for _, cp := range cpl.ChainParams {
 		if cp.ChainId == chainID && cp.IsSupported {
-			chain, exist := chains.GetChainByChainId(cp.ChainId)
-			if !exist {
+			chain, exist := k.GetChainByChainID(ctx, cp.ChainId)
+			if !exist || chain.IsDeprecated {
 				return nil
 			}
 
@@ -72,10 +72,9 @@ func (k Keeper) GetSupportedChains(ctx sdk.Context) []*chains.Chain {
 	var c []*chains.Chain
 	for _, cp := range cpl.ChainParams {
 		if cp.IsSupported {
-			if chain, exist := chains.GetChainByChainId(cp.ChainId); exist {
+			if chain, exist := k.GetChainByChainID(ctx, cp.ChainId); exist && !chain.IsDeprecated {
 				c = append(c, &chain)
 			}
-
 		}
 	}
 	return c
@@ -106,4 +106,46 @@ func TestKeeper_GetSupportedChains(t *testing.T) {
 		require.EqualValues(t, supported3.Id, supportedChains[2].Id)
 		require.EqualValues(t, supported4.Id, supportedChains[3].Id)
 	})
+
+	t.Run("return list containing chains added to the chain registry", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeper(t)
+
+		newChain := chains.Chain{
+			Id:          1000,
+			Network:     chains.NetWork_ETH,
+			NetworkType: chains.NetWorkType_TESTNET,
+			VmType:      chains.VMType_EVM,
+		}
+		k.SetChain(ctx, newChain)
+		k.SetChainParamsList(ctx, types.ChainParamsList{
+			ChainParams: []*types.ChainParams{sample.ChainParamsSupported_pell(newChain.Id)},
+		})
+
+		supportedChains := k.GetSupportedChains(ctx)
+		require.Len(t, supportedChains, 1)
+		require.Equal(t, newChain, *supportedChains[0])
+		require.Equal(t, newChain, *k.GetSupportedChainFromChainID(ctx, newChain.Id))
+	})
+
+	t.Run("return list without deprecated chains", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeper(t)
+
+		externalChainList := chains.ExternalChainList()
+		supported := externalChainList[0]
+		deprecated := externalChainList[1]
+		deprecated.IsDeprecated = true
+		k.SetChain(ctx, deprecated)
+
+		k.SetChainParamsList(ctx, types.ChainParamsList{
+			ChainParams: []*types.ChainParams{
+				sample.ChainParamsSupported_pell(supported.Id),
+				sample.ChainParamsSupported_pell(deprecated.Id),
+			},
+		})
+
+		supportedChains := k.GetSupportedChains(ctx)
+		require.Len(t, supportedChains, 1)
+		require.EqualValues(t, supported.Id, supportedChains[0].Id)
+		require.Nil(t, k.GetSupportedChainFromChainID(ctx, deprecated.Id))
+	})
 }
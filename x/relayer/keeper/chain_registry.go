@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/0xPellNetwork/aegis/pkg/chains"
+	"github.com/0xPellNetwork/aegis/x/relayer/types"
+)
+
+// Chain registry methods
+// The chain registry stores every chain known by the network, it is seeded from the default chain list at genesis
+// and then managed through the admin policy
+
+// SetChain sets a chain in the chain registry
+func (k Keeper) SetChain(ctx sdk.Context, chain chains.Chain) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ChainRegistryKey))
+	b := k.cdc.MustMarshal(&chain)
+	store.Set(types.KeyPrefix(fmt.Sprint(chain.Id)), b)
+}
+
+// GetChainByChainID returns a chain from the chain registry from its chain id
+func (k Keeper) GetChainByChainID(ctx sdk.Context, chainID int64) (val chains.Chain, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ChainRegistryKey))
+
+	b := store.Get(types.KeyPrefix(fmt.Sprint(chainID)))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetChainList returns all the chains of the chain registry sorted by chain id, deprecated chains included
+func (k Keeper) GetChainList(ctx sdk.Context) (list []chains.Chain) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ChainRegistryKey))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var val chains.Chain
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	sort.SliceStable(list, func(i, j int) bool {
+		return list[i].Id < list[j].Id
+	})
+	return
+}
+
+// IsEVMChain returns true if the chain is registered in the chain registry as an EVM chain
+func (k Keeper) IsEVMChain(ctx sdk.Context, chainID int64) bool {
+	chain, found := k.GetChainByChainID(ctx, chainID)
+	return found && chain.IsEVMChain()
+}
@@ -0,0 +1,60 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/pkg/chains"
+	keepertest "github.com/0xPellNetwork/aegis/testutil/keeper"
+)
+
+func TestKeeper_GetChainByChainID(t *testing.T) {
+	t.Run("should return the chain seeded from the default list", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeper(t)
+		chain, found := k.GetChainByChainID(ctx, chains.SepoliaChain().Id)
+		require.True(t, found)
+		require.Equal(t, chains.SepoliaChain(), chain)
+	})
+
+	t.Run("should return a chain added to the registry", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeper(t)
+		newChain := chains.Chain{
+			Id:          1000,
+			Network:     chains.NetWork_ETH,
+			NetworkType: chains.NetWorkType_TESTNET,
+			VmType:      chains.VMType_EVM,
+		}
+		_, found := k.GetChainByChainID(ctx, newChain.Id)
+		require.False(t, found)
+
+		k.SetChain(ctx, newChain)
+		chain, found := k.GetChainByChainID(ctx, newChain.Id)
+		require.True(t, found)
+		require.Equal(t, newChain, chain)
+	})
+}
+
+func TestKeeper_GetChainList(t *testing.T) {
+	k, ctx, _, _ := keepertest.RelayerKeeper(t)
+	list := k.GetChainList(ctx)
+	require.Len(t, list, len(chains.ChainsList()))
+	for i := 1; i < len(list); i++ {
+		require.Less(t, list[i-1].Id, list[i].Id)
+	}
+}
+
+func TestKeeper_IsEVMChain(t *testing.T) {
+	k, ctx, _, _ := keepertest.RelayerKeeper(t)
+	require.True(t, k.IsEVMChain(ctx, chains.SepoliaChain().Id))
+	require.False(t, k.IsEVMChain(ctx, 18332))
+	require.False(t, k.IsEVMChain(ctx, 1000))
+
+	k.SetChain(ctx, chains.Chain{
+		Id:          1000,
+		Network:     chains.NetWork_ETH,
+		NetworkType: chains.NetWorkType_TESTNET,
+		VmType:      chains.VMType_EVM,
+	})
+	require.True(t, k.IsEVMChain(ctx, 1000))
+}
@@ -35,6 +35,16 @@ func (k Keeper) IsInboundEnabled(ctx sdk.Context) (found bool) {
 	return flags.IsInboundEnabled
 }
 
+// SetInboundEnabled enables or disables inbound in the crosschain flags
+func (k Keeper) SetInboundEnabled(ctx sdk.Context, enabled bool) {
+	flags, found := k.GetCrosschainFlags(ctx)
+	if !found {
+		flags = *types.DefaultCrosschainFlags()
+	}
+	flags.IsInboundEnabled = enabled
+	k.SetCrosschainFlags(ctx, flags)
+}
+
 func (k Keeper) IsOutboundEnabled(ctx sdk.Context) (found bool) {
 	flags, found := k.GetCrosschainFlags(ctx)
 	if !found {
@@ -43,6 +53,51 @@ func (k Keeper) IsOutboundEnabled(ctx sdk.Context) (found bool) {
 	return flags.IsOutboundEnabled
 }
 
+// IsChainInboundPaused returns true if inbound is paused for the chain
+func (k Keeper) IsChainInboundPaused(ctx sdk.Context, chainID int64) bool {
+	flags, found := k.GetCrosschainFlags(ctx)
+	return found && flags.IsChainInboundPaused(chainID)
+}
+
+// IsChainOutboundPaused returns true if outbound is paused for the chain
+func (k Keeper) IsChainOutboundPaused(ctx sdk.Context, chainID int64) bool {
+	flags, found := k.GetCrosschainFlags(ctx)
+	return found && flags.IsChainOutboundPaused(chainID)
+}
+
+// IsChainSigningPaused returns true if signing is paused for the chain
+func (k Keeper) IsChainSigningPaused(ctx sdk.Context, chainID int64) bool {
+	flags, found := k.GetCrosschainFlags(ctx)
+	return found && flags.IsChainSigningPaused(chainID)
+}
+
+// SetChainPause sets the pause of a chain in the crosschain flags, the pause is removed if nothing is paused
+func (k Keeper) SetChainPause(ctx sdk.Context, pause types.ChainPause) {
+	flags, found := k.GetCrosschainFlags(ctx)
+	if !found {
+		flags = *types.DefaultCrosschainFlags()
+	}
+	flags.SetChainPause(pause)
+	k.SetCrosschainFlags(ctx, flags)
+}
+
+// RemoveExpiredChainPauses removes the chain pauses expired at the current height
+func (k Keeper) RemoveExpiredChainPauses(ctx sdk.Context) {
+	flags, found := k.GetCrosschainFlags(ctx)
+	if !found || len(flags.ChainPauses) == 0 {
+		return
+	}
+	expired := flags.RemoveExpiredChainPauses(ctx.BlockHeight())
+	if len(expired) == 0 {
+		return
+	}
+	k.SetCrosschainFlags(ctx, flags)
+	for _, pause := range expired {
+		EmitEventChainPauseExpired(ctx, pause)
+		k.Logger(ctx).Info("chain pause expired", "chain_id", pause.ChainId, "expiry_height", pause.ExpiryHeight)
+	}
+}
+
 // RemoveCrosschainFlags removes crosschain flags from the store
 func (k Keeper) RemoveCrosschainFlags(ctx sdk.Context) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CrosschainFlagsKey))
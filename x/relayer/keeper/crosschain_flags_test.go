@@ -82,3 +82,46 @@ func TestKeeper_DisableInboundOnly(t *testing.T) {
 	enabled = k.IsInboundEnabled(ctx)
 	require.False(t, enabled)
 }
+
+func TestKeeper_ChainPause(t *testing.T) {
+	t.Run("should return false if flags not found", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeper(t)
+
+		require.False(t, k.IsChainInboundPaused(ctx, 1))
+		require.False(t, k.IsChainOutboundPaused(ctx, 1))
+		require.False(t, k.IsChainSigningPaused(ctx, 1))
+	})
+
+	t.Run("should set and remove chain pause", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeper(t)
+
+		k.SetChainPause(ctx, types.ChainPause{ChainId: 1, IsInboundPaused: true, IsSigningPaused: true})
+		require.True(t, k.IsChainInboundPaused(ctx, 1))
+		require.False(t, k.IsChainOutboundPaused(ctx, 1))
+		require.True(t, k.IsChainSigningPaused(ctx, 1))
+		require.False(t, k.IsChainInboundPaused(ctx, 2))
+
+		// the global flags are kept
+		require.True(t, k.IsInboundEnabled(ctx))
+		require.True(t, k.IsOutboundEnabled(ctx))
+
+		k.SetChainPause(ctx, types.ChainPause{ChainId: 1})
+		require.False(t, k.IsChainInboundPaused(ctx, 1))
+		require.False(t, k.IsChainSigningPaused(ctx, 1))
+	})
+
+	t.Run("should remove expired chain pauses", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeper(t)
+		height := ctx.BlockHeight()
+
+		k.SetChainPause(ctx, types.ChainPause{ChainId: 1, IsInboundPaused: true, ExpiryHeight: height + 10})
+		k.SetChainPause(ctx, types.ChainPause{ChainId: 2, IsOutboundPaused: true})
+
+		k.RemoveExpiredChainPauses(ctx.WithBlockHeight(height + 9))
+		require.True(t, k.IsChainInboundPaused(ctx, 1))
+
+		k.RemoveExpiredChainPauses(ctx.WithBlockHeight(height + 10))
+		require.False(t, k.IsChainInboundPaused(ctx, 1))
+		require.True(t, k.IsChainOutboundPaused(ctx, 2))
+	})
+}
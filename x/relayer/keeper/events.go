@@ -55,3 +55,76 @@ func EmitEventAddObserver(ctx sdk.Context, observerCount uint64, operatorAddress
 		ctx.Logger().Error("Error emitting EmitEventAddObserver :", err)
 	}
 }
+
+func EmitEventRelayerPenaltyUpdated(ctx sdk.Context, penalty types.RelayerPenalty) {
+	err := ctx.EventManager().EmitTypedEvents(&types.EventRelayerPenaltyUpdated{
+		ObserverAddress:   penalty.ObserverAddress,
+		WindowStartHeight: penalty.WindowStartHeight,
+		BlameCount:        penalty.BlameCount,
+		MissedBallotCount: penalty.MissedBallotCount,
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting EventRelayerPenaltyUpdated :", err)
+	}
+}
+
+func EmitEventRelayerJailed(ctx sdk.Context, penalty types.RelayerPenalty, reason string) {
+	err := ctx.EventManager().EmitTypedEvents(&types.EventRelayerJailed{
+		ObserverAddress:   penalty.ObserverAddress,
+		Reason:            reason,
+		BlameCount:        penalty.BlameCount,
+		MissedBallotCount: penalty.MissedBallotCount,
+		JailedUntilHeight: penalty.JailedUntilHeight,
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting EventRelayerJailed :", err)
+	}
+}
+
+func EmitEventRelayerUnjailed(ctx sdk.Context, observerAddress string) {
+	err := ctx.EventManager().EmitTypedEvents(&types.EventRelayerUnjailed{
+		MsgTypeUrl:      sdk.MsgTypeURL(&types.MsgUnjailObserver{}),
+		ObserverAddress: observerAddress,
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting EventRelayerUnjailed :", err)
+	}
+}
+
+func EmitEventChainPauseUpdated(ctx sdk.Context, signer string, pause types.ChainPause) {
+	err := ctx.EventManager().EmitTypedEvents(&types.EventChainPauseUpdated{
+		MsgTypeUrl:       sdk.MsgTypeURL(&types.MsgUpdateChainPause{}),
+		Signer:           signer,
+		ChainId:          pause.ChainId,
+		IsInboundPaused:  pause.IsInboundPaused,
+		IsOutboundPaused: pause.IsOutboundPaused,
+		IsSigningPaused:  pause.IsSigningPaused,
+		ExpiryHeight:     pause.ExpiryHeight,
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting EventChainPauseUpdated :", err)
+	}
+}
+
+func EmitEventChainPauseExpired(ctx sdk.Context, pause types.ChainPause) {
+	err := ctx.EventManager().EmitTypedEvents(&types.EventChainPauseExpired{
+		ChainId:      pause.ChainId,
+		ExpiryHeight: pause.ExpiryHeight,
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting EventChainPauseExpired :", err)
+	}
+}
+
+func EmitEventTssRotationUpdated(ctx sdk.Context, rotation types.TssRotation) {
+	err := ctx.EventManager().EmitTypedEvents(&types.EventTssRotationUpdated{
+		Stage:        rotation.Stage.String(),
+		OldTssPubkey: rotation.OldTssPubkey,
+		NewTssPubkey: rotation.NewTssPubkey,
+		KeygenBlock:  rotation.KeygenBlock,
+		Message:      rotation.Message,
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting EventTssRotationUpdated :", err)
+	}
+}
@@ -0,0 +1,20 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/0xPellNetwork/aegis/x/relayer/types"
+)
+
+// ChainList returns all the chains of the chain registry, including deprecated ones
+func (k Keeper) ChainList(goCtx context.Context, req *types.QueryChainListRequest) (*types.QueryChainListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryChainListResponse{Chains: k.GetChainList(ctx)}, nil
+}
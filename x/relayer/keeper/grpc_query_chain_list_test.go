@@ -0,0 +1,37 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/pkg/chains"
+	keepertest "github.com/0xPellNetwork/aegis/testutil/keeper"
+	"github.com/0xPellNetwork/aegis/x/relayer/types"
+)
+
+func TestKeeper_ChainList(t *testing.T) {
+	t.Run("should error if req is nil", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+
+		res, err := k.ChainList(wctx, nil)
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should return the chains of the chain registry", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+
+		deprecated := chains.SepoliaChain()
+		deprecated.IsDeprecated = true
+		k.SetChain(ctx, deprecated)
+
+		res, err := k.ChainList(wctx, &types.QueryChainListRequest{})
+		require.NoError(t, err)
+		require.Len(t, res.Chains, len(chains.ChainsList()))
+		require.Contains(t, res.Chains, deprecated)
+	})
+}
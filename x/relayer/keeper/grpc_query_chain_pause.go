@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/0xPellNetwork/aegis/x/relayer/types"
+)
+
+// ChainPause returns the pause of a chain
+func (k Keeper) ChainPause(goCtx context.Context, req *types.QueryChainPauseRequest) (*types.QueryChainPauseResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	flags, _ := k.GetCrosschainFlags(ctx)
+	pause, found := flags.GetChainPause(req.ChainId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryChainPauseResponse{ChainPause: pause}, nil
+}
+
+// ChainPauseAll returns the pauses of all chains
+func (k Keeper) ChainPauseAll(goCtx context.Context, req *types.QueryAllChainPauseRequest) (*types.QueryAllChainPauseResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	flags, _ := k.GetCrosschainFlags(ctx)
+
+	return &types.QueryAllChainPauseResponse{ChainPauses: flags.ChainPauses}, nil
+}
@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/0xPellNetwork/aegis/testutil/keeper"
+	"github.com/0xPellNetwork/aegis/x/relayer/types"
+)
+
+func TestKeeper_ChainPauseQuery(t *testing.T) {
+	t.Run("should error if req is nil", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+
+		res, err := k.ChainPause(wctx, nil)
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should error if chain is not paused", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+
+		res, err := k.ChainPause(wctx, &types.QueryChainPauseRequest{ChainId: 1})
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should return the chain pause", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+
+		pause := types.ChainPause{ChainId: 1, IsOutboundPaused: true, ExpiryHeight: 100}
+		k.SetChainPause(ctx, pause)
+
+		res, err := k.ChainPause(wctx, &types.QueryChainPauseRequest{ChainId: 1})
+		require.NoError(t, err)
+		require.Equal(t, pause, res.ChainPause)
+	})
+}
+
+func TestKeeper_ChainPauseAll(t *testing.T) {
+	t.Run("should error if req is nil", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+
+		res, err := k.ChainPauseAll(wctx, nil)
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should return all chain pauses", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+
+		pauses := []types.ChainPause{
+			{ChainId: 1, IsInboundPaused: true},
+			{ChainId: 2, IsSigningPaused: true, ExpiryHeight: 100},
+		}
+		for _, pause := range pauses {
+			k.SetChainPause(ctx, pause)
+		}
+
+		res, err := k.ChainPauseAll(wctx, &types.QueryAllChainPauseRequest{})
+		require.NoError(t, err)
+		require.Equal(t, pauses, res.ChainPauses)
+	})
+}
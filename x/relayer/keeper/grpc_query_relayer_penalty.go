@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/0xPellNetwork/aegis/x/relayer/types"
+)
+
+// RelayerPenalty returns the penalty record of an observer
+func (k Keeper) RelayerPenalty(
+	goCtx context.Context,
+	req *types.QueryRelayerPenaltyRequest,
+) (*types.QueryRelayerPenaltyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	penalty, found := k.GetRelayerPenalty(ctx, req.ObserverAddress)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryRelayerPenaltyResponse{RelayerPenalty: penalty}, nil
+}
+
+// RelayerPenaltyAll returns the penalty records of all observers
+func (k Keeper) RelayerPenaltyAll(
+	goCtx context.Context,
+	req *types.QueryAllRelayerPenaltyRequest,
+) (*types.QueryAllRelayerPenaltyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var penalties []types.RelayerPenalty
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RelayerPenaltyKey))
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var penalty types.RelayerPenalty
+		if err := k.cdc.Unmarshal(value, &penalty); err != nil {
+			return err
+		}
+		penalties = append(penalties, penalty)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllRelayerPenaltyResponse{RelayerPenalties: penalties, Pagination: pageRes}, nil
+}
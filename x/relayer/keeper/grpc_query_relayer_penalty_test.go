@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/0xPellNetwork/aegis/testutil/keeper"
+	"github.com/0xPellNetwork/aegis/testutil/sample"
+	"github.com/0xPellNetwork/aegis/x/relayer/types"
+)
+
+func TestKeeper_RelayerPenalty(t *testing.T) {
+	t.Run("should error if req is nil", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+
+		res, err := k.RelayerPenalty(wctx, nil)
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should error if not found", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+
+		res, err := k.RelayerPenalty(wctx, &types.QueryRelayerPenaltyRequest{
+			ObserverAddress: sample.AccAddress(),
+		})
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should return the penalty record", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+
+		penalty := sample.RelayerPenalty_pell(t, "0")
+		k.SetRelayerPenalty(ctx, penalty)
+
+		res, err := k.RelayerPenalty(wctx, &types.QueryRelayerPenaltyRequest{
+			ObserverAddress: penalty.ObserverAddress,
+		})
+		require.NoError(t, err)
+		require.Equal(t, penalty, res.RelayerPenalty)
+	})
+}
+
+func TestKeeper_RelayerPenaltyAll(t *testing.T) {
+	t.Run("should error if req is nil", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+
+		res, err := k.RelayerPenaltyAll(wctx, nil)
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should return all penalty records", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+
+		penalties := []types.RelayerPenalty{
+			sample.RelayerPenalty_pell(t, "0"),
+			sample.RelayerPenalty_pell(t, "1"),
+			sample.RelayerPenalty_pell(t, "2"),
+		}
+		for _, penalty := range penalties {
+			k.SetRelayerPenalty(ctx, penalty)
+		}
+
+		res, err := k.RelayerPenaltyAll(wctx, &types.QueryAllRelayerPenaltyRequest{})
+		require.NoError(t, err)
+		require.ElementsMatch(t, penalties, res.RelayerPenalties)
+	})
+}
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/0xPellNetwork/aegis/x/relayer/types"
+)
+
+// RestrictedAddresses returns the restricted address list used for compliance screening
+func (k Keeper) RestrictedAddresses(
+	goCtx context.Context,
+	req *types.QueryRestrictedAddressesRequest,
+) (*types.QueryRestrictedAddressesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryRestrictedAddressesResponse{Addresses: k.GetAllRestrictedAddresses(ctx)}, nil
+}
@@ -0,0 +1,36 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/0xPellNetwork/aegis/testutil/keeper"
+	"github.com/0xPellNetwork/aegis/testutil/sample"
+	"github.com/0xPellNetwork/aegis/x/relayer/types"
+)
+
+func TestKeeper_RestrictedAddresses(t *testing.T) {
+	t.Run("should error if req is nil", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+
+		res, err := k.RestrictedAddresses(wctx, nil)
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should return the restricted addresses", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+
+		address := sample.EthAddress().Hex()
+		k.SetRestrictedAddress(ctx, address)
+
+		res, err := k.RestrictedAddresses(wctx, &types.QueryRestrictedAddressesRequest{})
+		require.NoError(t, err)
+		require.Equal(t, []string{strings.ToLower(address)}, res.Addresses)
+	})
+}
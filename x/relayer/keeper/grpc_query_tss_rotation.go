@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/0xPellNetwork/aegis/x/relayer/types"
+)
+
+// TssRotation returns the progress of the current or last tss rotation
+func (k Keeper) TssRotation(c context.Context, req *types.QueryTssRotationRequest) (*types.QueryTssRotationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	rotation, found := k.GetTssRotation(ctx)
+	if !found {
+		return nil, status.Error(codes.NotFound, "tss rotation not found")
+	}
+	return &types.QueryTssRotationResponse{TssRotation: rotation}, nil
+}
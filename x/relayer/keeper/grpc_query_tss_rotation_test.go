@@ -0,0 +1,40 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/0xPellNetwork/aegis/testutil/keeper"
+	"github.com/0xPellNetwork/aegis/x/relayer/types"
+)
+
+func TestKeeper_TssRotation(t *testing.T) {
+	t.Run("should error if req is nil", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeper(t)
+		res, err := k.TssRotation(ctx, nil)
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should error if no rotation", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeper(t)
+		res, err := k.TssRotation(ctx, &types.QueryTssRotationRequest{})
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should return the rotation", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeper(t)
+		rotation := types.TssRotation{
+			Stage:        types.TssRotationStage_TSS_ROTATION_MIGRATING,
+			OldTssPubkey: "old",
+			NewTssPubkey: "new",
+		}
+		k.SetTssRotation(ctx, rotation)
+
+		res, err := k.TssRotation(ctx, &types.QueryTssRotationRequest{})
+		require.NoError(t, err)
+		require.Equal(t, rotation, res.TssRotation)
+	})
+}
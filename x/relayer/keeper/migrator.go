@@ -1,5 +1,12 @@
 package keeper
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/0xPellNetwork/aegis/pkg/chains"
+	"github.com/0xPellNetwork/aegis/x/relayer/types"
+)
+
 // Migrator is a struct for handling in-place store migrations.
 type Migrator struct {
 	observerKeeper Keeper
@@ -11,3 +18,35 @@ func NewMigrator(keeper Keeper) Migrator {
 		observerKeeper: keeper,
 	}
 }
+
+// Migrate7to8 migrates the store from consensus version 7 to 8
+// it seeds the chain registry with the default chains if it is empty
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	if len(m.observerKeeper.GetChainList(ctx)) > 0 {
+		return nil
+	}
+	for _, chain := range chains.ChainsList() {
+		m.observerKeeper.SetChain(ctx, chain)
+	}
+	return nil
+}
+
+// Migrate8to9 migrates the store from consensus version 8 to 9
+// it sets the default observer jailing params, the params are not stored on the existing chains
+func (m Migrator) Migrate8to9(ctx sdk.Context) error {
+	params := m.observerKeeper.GetParamsIfExists(ctx)
+	if params.PenaltyWindowBlocks == 0 {
+		params.PenaltyWindowBlocks = types.DefaultPenaltyWindowBlocks
+	}
+	if params.MaxBlamesPerWindow == 0 {
+		params.MaxBlamesPerWindow = types.DefaultMaxBlamesPerWindow
+	}
+	if params.MaxMissedBallotsPerWindow == 0 {
+		params.MaxMissedBallotsPerWindow = types.DefaultMaxMissedBallotsPerWindow
+	}
+	if params.JailDurationBlocks == 0 {
+		params.JailDurationBlocks = types.DefaultJailDurationBlocks
+	}
+	m.observerKeeper.SetParams(ctx, params)
+	return nil
+}
@@ -0,0 +1,58 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/pkg/chains"
+	keepertest "github.com/0xPellNetwork/aegis/testutil/keeper"
+	"github.com/0xPellNetwork/aegis/x/relayer/keeper"
+	"github.com/0xPellNetwork/aegis/x/relayer/types"
+)
+
+func TestMigrator_Migrate7to8(t *testing.T) {
+	t.Run("should not overwrite existing chain registry", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeper(t)
+		newChain := sampleNewChain()
+		k.SetChain(ctx, newChain)
+
+		m := keeper.NewMigrator(*k)
+		require.NoError(t, m.Migrate7to8(ctx))
+
+		chain, found := k.GetChainByChainID(ctx, newChain.Id)
+		require.True(t, found)
+		require.Equal(t, newChain, chain)
+		require.Len(t, k.GetChainList(ctx), len(chains.ChainsList())+1)
+	})
+}
+
+func TestMigrator_Migrate8to9(t *testing.T) {
+	t.Run("should set the default jailing params", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeper(t)
+		params := types.DefaultParams()
+		params.PenaltyWindowBlocks = 0
+		params.MaxBlamesPerWindow = 0
+		params.MaxMissedBallotsPerWindow = 0
+		params.JailDurationBlocks = 0
+		k.SetParams(ctx, params)
+
+		require.NoError(t, keeper.NewMigrator(*k).Migrate8to9(ctx))
+
+		params = k.GetParamsIfExists(ctx)
+		require.Equal(t, types.DefaultPenaltyWindowBlocks, params.PenaltyWindowBlocks)
+		require.Equal(t, types.DefaultMaxBlamesPerWindow, params.MaxBlamesPerWindow)
+		require.Equal(t, types.DefaultMaxMissedBallotsPerWindow, params.MaxMissedBallotsPerWindow)
+		require.Equal(t, types.DefaultJailDurationBlocks, params.JailDurationBlocks)
+	})
+
+	t.Run("should keep the jailing params set by governance", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeper(t)
+		params := types.DefaultParams()
+		params.MaxBlamesPerWindow = 42
+		k.SetParams(ctx, params)
+
+		require.NoError(t, keeper.NewMigrator(*k).Migrate8to9(ctx))
+		require.EqualValues(t, 42, k.GetParamsIfExists(ctx).MaxBlamesPerWindow)
+	})
+}
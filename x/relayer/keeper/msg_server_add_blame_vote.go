@@ -54,5 +54,6 @@ func (k msgServer) AddBlameVote(goCtx context.Context, vote *types.MsgAddBlameVo
 	// ******************************************************************************
 
 	k.SetBlame(ctx, vote.BlameInfo)
+	k.AddBlamesToObservers(ctx, vote.BlameInfo)
 	return &types.MsgAddBlameVoteResponse{}, nil
 }
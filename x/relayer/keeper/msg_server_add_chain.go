@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	authoritytypes "github.com/0xPellNetwork/aegis/x/authority/types"
+	"github.com/0xPellNetwork/aegis/x/relayer/types"
+)
+
+// AddChain adds a chain to the chain registry.
+// The chain can then be supported by upserting its chain params.
+// Only the admin policy account is authorized to broadcast this message.
+func (k msgServer) AddChain(goCtx context.Context, msg *types.MsgAddChain) (*types.MsgAddChainResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// check permission
+	if !k.GetAuthorityKeeper().IsAuthorized(ctx, msg.Signer, authoritytypes.PolicyType_GROUP_ADMIN) {
+		return &types.MsgAddChainResponse{}, authoritytypes.ErrUnauthorized
+	}
+
+	if _, found := k.GetChainByChainID(ctx, msg.Chain.Id); found {
+		return &types.MsgAddChainResponse{}, cosmoserrors.Wrapf(types.ErrChainAlreadyExists, "chain id %d", msg.Chain.Id)
+	}
+
+	k.SetChain(ctx, msg.Chain)
+
+	return &types.MsgAddChainResponse{}, nil
+}
@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/pkg/chains"
+	keepertest "github.com/0xPellNetwork/aegis/testutil/keeper"
+	"github.com/0xPellNetwork/aegis/testutil/sample"
+	authoritytypes "github.com/0xPellNetwork/aegis/x/authority/types"
+	"github.com/0xPellNetwork/aegis/x/relayer/keeper"
+	"github.com/0xPellNetwork/aegis/x/relayer/types"
+)
+
+// sampleNewChain returns a chain that is not part of the default chain list
+func sampleNewChain() chains.Chain {
+	return chains.Chain{
+		Id:          1000,
+		Network:     chains.NetWork_ETH,
+		NetworkType: chains.NetWorkType_TESTNET,
+		VmType:      chains.VMType_EVM,
+	}
+}
+
+func TestMsgServer_AddChain(t *testing.T) {
+	t.Run("can add a chain", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeperWithMocks(t, keepertest.RelayerMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetRelayerAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_ADMIN, true)
+
+		_, err := srv.AddChain(sdk.WrapSDKContext(ctx), types.NewMsgAddChain(admin, sampleNewChain()))
+		require.NoError(t, err)
+
+		chain, found := k.GetChainByChainID(ctx, sampleNewChain().Id)
+		require.True(t, found)
+		require.Equal(t, sampleNewChain(), chain)
+		require.True(t, k.IsEVMChain(ctx, chain.Id))
+	})
+
+	t.Run("cannot add a chain if not authorized", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeperWithMocks(t, keepertest.RelayerMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetRelayerAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_ADMIN, false)
+
+		_, err := srv.AddChain(sdk.WrapSDKContext(ctx), types.NewMsgAddChain(admin, sampleNewChain()))
+		require.ErrorIs(t, err, authoritytypes.ErrUnauthorized)
+
+		_, found := k.GetChainByChainID(ctx, sampleNewChain().Id)
+		require.False(t, found)
+	})
+
+	t.Run("cannot add a chain already in the chain registry", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeperWithMocks(t, keepertest.RelayerMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetRelayerAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_ADMIN, true)
+
+		_, err := srv.AddChain(sdk.WrapSDKContext(ctx), types.NewMsgAddChain(admin, chains.SepoliaChain()))
+		require.ErrorIs(t, err, types.ErrChainAlreadyExists)
+	})
+}
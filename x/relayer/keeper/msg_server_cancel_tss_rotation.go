@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	authoritytypes "github.com/0xPellNetwork/aegis/x/authority/types"
+	"github.com/0xPellNetwork/aegis/x/relayer/types"
+)
+
+// CancelTssRotation cancels a TSS rotation before the funds are migrated.
+// Inbound is enabled again if it was disabled by the rotation.
+//
+// Authorized: emergency policy group.
+func (k msgServer) CancelTssRotation(goCtx context.Context, msg *types.MsgCancelTssRotation) (*types.MsgCancelTssRotationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// check permission
+	if !k.GetAuthorityKeeper().IsAuthorized(ctx, msg.Signer, authoritytypes.PolicyType_GROUP_EMERGENCY) {
+		return &types.MsgCancelTssRotationResponse{}, authoritytypes.ErrUnauthorized
+	}
+
+	rotation, found := k.GetTssRotation(ctx)
+	if !found || !rotation.IsInProgress() {
+		return nil, types.ErrTssRotationNotFound
+	}
+	if !rotation.IsCancellable() {
+		return nil, cosmoserrors.Wrapf(
+			types.ErrInvalidTssRotation,
+			"cannot cancel the rotation at stage %s",
+			rotation.Stage.String(),
+		)
+	}
+
+	if rotation.InboundDisabledByRotation {
+		k.SetInboundEnabled(ctx, true)
+		rotation.InboundDisabledByRotation = false
+	}
+	k.SetTssRotationStage(ctx, rotation, types.TssRotationStage_TSS_ROTATION_CANCELLED, "cancelled by "+msg.Signer)
+
+	return &types.MsgCancelTssRotationResponse{}, nil
+}
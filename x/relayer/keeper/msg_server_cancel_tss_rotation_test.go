@@ -0,0 +1,101 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/0xPellNetwork/aegis/testutil/keeper"
+	"github.com/0xPellNetwork/aegis/testutil/sample"
+	authoritytypes "github.com/0xPellNetwork/aegis/x/authority/types"
+	"github.com/0xPellNetwork/aegis/x/relayer/keeper"
+	"github.com/0xPellNetwork/aegis/x/relayer/types"
+)
+
+func TestMsgServer_CancelTssRotation(t *testing.T) {
+	t.Run("should cancel the rotation and enable inbound", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeperWithMocks(t, keepertest.RelayerMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetRelayerAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_EMERGENCY, true)
+
+		k.SetInboundEnabled(ctx, false)
+		k.SetTssRotation(ctx, types.TssRotation{
+			Stage:                     types.TssRotationStage_TSS_ROTATION_DRAINING,
+			InboundDisabledByRotation: true,
+		})
+
+		_, err := srv.CancelTssRotation(sdk.WrapSDKContext(ctx), types.NewMsgCancelTssRotation(admin))
+		require.NoError(t, err)
+
+		rotation, _ := k.GetTssRotation(ctx)
+		require.Equal(t, types.TssRotationStage_TSS_ROTATION_CANCELLED, rotation.Stage)
+		require.False(t, rotation.InboundDisabledByRotation)
+		require.True(t, k.IsInboundEnabled(ctx))
+	})
+
+	t.Run("should not enable inbound disabled before the rotation", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeperWithMocks(t, keepertest.RelayerMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetRelayerAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_EMERGENCY, true)
+
+		k.SetInboundEnabled(ctx, false)
+		k.SetTssRotation(ctx, types.TssRotation{Stage: types.TssRotationStage_TSS_ROTATION_DRAINING})
+
+		_, err := srv.CancelTssRotation(sdk.WrapSDKContext(ctx), types.NewMsgCancelTssRotation(admin))
+		require.NoError(t, err)
+		require.False(t, k.IsInboundEnabled(ctx))
+	})
+
+	t.Run("should fail if not authorized", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeperWithMocks(t, keepertest.RelayerMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetRelayerAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_EMERGENCY, false)
+
+		_, err := srv.CancelTssRotation(sdk.WrapSDKContext(ctx), types.NewMsgCancelTssRotation(admin))
+		require.ErrorIs(t, err, authoritytypes.ErrUnauthorized)
+	})
+
+	t.Run("should fail if no rotation in progress", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeperWithMocks(t, keepertest.RelayerMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetRelayerAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_EMERGENCY, true)
+
+		_, err := srv.CancelTssRotation(sdk.WrapSDKContext(ctx), types.NewMsgCancelTssRotation(admin))
+		require.ErrorIs(t, err, types.ErrTssRotationNotFound)
+
+		k.SetTssRotation(ctx, types.TssRotation{Stage: types.TssRotationStage_TSS_ROTATION_COMPLETED})
+		_, err = srv.CancelTssRotation(sdk.WrapSDKContext(ctx), types.NewMsgCancelTssRotation(admin))
+		require.ErrorIs(t, err, types.ErrTssRotationNotFound)
+	})
+
+	t.Run("should fail if funds are being migrated", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeperWithMocks(t, keepertest.RelayerMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetRelayerAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_EMERGENCY, true)
+		k.SetTssRotation(ctx, types.TssRotation{Stage: types.TssRotationStage_TSS_ROTATION_MIGRATING})
+
+		_, err := srv.CancelTssRotation(sdk.WrapSDKContext(ctx), types.NewMsgCancelTssRotation(admin))
+		require.ErrorIs(t, err, types.ErrInvalidTssRotation)
+	})
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	authoritytypes "github.com/0xPellNetwork/aegis/x/authority/types"
+	"github.com/0xPellNetwork/aegis/x/relayer/types"
+)
+
+// DeprecateChain deprecates a chain of the chain registry.
+// The chain is kept in the registry to resolve historical data but is no longer supported,
+// its chain params are marked as unsupported.
+// Only the admin policy account is authorized to broadcast this message.
+func (k msgServer) DeprecateChain(goCtx context.Context, msg *types.MsgDeprecateChain) (*types.MsgDeprecateChainResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// check permission
+	if !k.GetAuthorityKeeper().IsAuthorized(ctx, msg.Signer, authoritytypes.PolicyType_GROUP_ADMIN) {
+		return &types.MsgDeprecateChainResponse{}, authoritytypes.ErrUnauthorized
+	}
+
+	chain, found := k.GetChainByChainID(ctx, msg.ChainId)
+	if !found {
+		return &types.MsgDeprecateChainResponse{}, cosmoserrors.Wrapf(types.ErrChainNotFound, "chain id %d", msg.ChainId)
+	}
+	if chain.IsDeprecated {
+		return &types.MsgDeprecateChainResponse{}, cosmoserrors.Wrapf(types.ErrChainDeprecated, "chain id %d", msg.ChainId)
+	}
+	if chain.IsPellChain() {
+		return &types.MsgDeprecateChainResponse{}, cosmoserrors.Wrapf(types.ErrInvalidChain, "pell chain %d can't be deprecated", msg.ChainId)
+	}
+
+	chain.IsDeprecated = true
+	k.SetChain(ctx, chain)
+
+	// the chain is no longer supported
+	if chainParamsList, found := k.GetChainParamsList(ctx); found {
+		for _, cp := range chainParamsList.ChainParams {
+			if cp.ChainId == chain.Id {
+				cp.IsSupported = false
+			}
+		}
+		k.SetChainParamsList(ctx, chainParamsList)
+	}
+
+	return &types.MsgDeprecateChainResponse{}, nil
+}
@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/pkg/chains"
+	keepertest "github.com/0xPellNetwork/aegis/testutil/keeper"
+	"github.com/0xPellNetwork/aegis/testutil/sample"
+	authoritytypes "github.com/0xPellNetwork/aegis/x/authority/types"
+	"github.com/0xPellNetwork/aegis/x/relayer/keeper"
+	"github.com/0xPellNetwork/aegis/x/relayer/types"
+)
+
+func TestMsgServer_DeprecateChain(t *testing.T) {
+	t.Run("can deprecate a chain", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeperWithMocks(t, keepertest.RelayerMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetRelayerAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_ADMIN, true)
+
+		chainID := chains.SepoliaChain().Id
+		k.SetChainParamsList(ctx, types.ChainParamsList{
+			ChainParams: []*types.ChainParams{sample.ChainParamsSupported_pell(chainID)},
+		})
+		require.NotNil(t, k.GetSupportedChainFromChainID(ctx, chainID))
+
+		_, err := srv.DeprecateChain(sdk.WrapSDKContext(ctx), types.NewMsgDeprecateChain(admin, chainID))
+		require.NoError(t, err)
+
+		// the chain can still be resolved
+		chain, found := k.GetChainByChainID(ctx, chainID)
+		require.True(t, found)
+		require.True(t, chain.IsDeprecated)
+
+		// the chain is no longer supported
+		require.Nil(t, k.GetSupportedChainFromChainID(ctx, chainID))
+		chainParams, found := k.GetChainParamsByChainID(ctx, chainID)
+		require.True(t, found)
+		require.False(t, chainParams.IsSupported)
+	})
+
+	t.Run("cannot deprecate a chain if not authorized", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeperWithMocks(t, keepertest.RelayerMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetRelayerAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_ADMIN, false)
+
+		_, err := srv.DeprecateChain(sdk.WrapSDKContext(ctx), types.NewMsgDeprecateChain(admin, chains.SepoliaChain().Id))
+		require.ErrorIs(t, err, authoritytypes.ErrUnauthorized)
+	})
+
+	t.Run("cannot deprecate a chain not in the chain registry", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeperWithMocks(t, keepertest.RelayerMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetRelayerAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_ADMIN, true)
+
+		_, err := srv.DeprecateChain(sdk.WrapSDKContext(ctx), types.NewMsgDeprecateChain(admin, sampleNewChain().Id))
+		require.ErrorIs(t, err, types.ErrChainNotFound)
+	})
+
+	t.Run("cannot deprecate a chain twice", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeperWithMocks(t, keepertest.RelayerMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetRelayerAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_ADMIN, true)
+
+		chainID := chains.SepoliaChain().Id
+		_, err := srv.DeprecateChain(sdk.WrapSDKContext(ctx), types.NewMsgDeprecateChain(admin, chainID))
+		require.NoError(t, err)
+
+		_, err = srv.DeprecateChain(sdk.WrapSDKContext(ctx), types.NewMsgDeprecateChain(admin, chainID))
+		require.ErrorIs(t, err, types.ErrChainDeprecated)
+	})
+
+	t.Run("cannot deprecate pell chain", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeperWithMocks(t, keepertest.RelayerMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetRelayerAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_ADMIN, true)
+
+		_, err := srv.DeprecateChain(sdk.WrapSDKContext(ctx), types.NewMsgDeprecateChain(admin, chains.PellPrivnetChain().Id))
+		require.ErrorIs(t, err, types.ErrInvalidChain)
+	})
+}
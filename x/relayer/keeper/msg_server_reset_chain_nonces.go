@@ -5,7 +5,6 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	"github.com/0xPellNetwork/aegis/pkg/chains"
 	authoritytypes "github.com/0xPellNetwork/aegis/x/authority/types"
 	"github.com/0xPellNetwork/aegis/x/relayer/types"
 )
@@ -22,7 +21,7 @@ func (k msgServer) ResetChainNonces(goCtx context.Context, msg *types.MsgResetCh
 		return nil, types.ErrTssNotFound
 	}
 
-	chain, exist := chains.GetChainByChainId(msg.ChainId)
+	chain, exist := k.GetChainByChainID(ctx, msg.ChainId)
 	if !exist {
 		return nil, types.ErrSupportedChains
 	}
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"context"
+	"sort"
+
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	authoritytypes "github.com/0xPellNetwork/aegis/x/authority/types"
+	"github.com/0xPellNetwork/aegis/x/relayer/types"
+)
+
+// StartTssRotation schedules a keygen and starts a TSS rotation. Once the new key is generated,
+// the rotation disables inbound, waits for the pending outbounds of the current TSS to be processed,
+// migrates the funds of every chain to the new TSS and sets the new TSS as current TSS.
+//
+// A migration amount must be defined for every supported foreign chain. The gas token and the pell token
+// balances are migrated separately, the pell token is only migrated if a pell amount is defined.
+//
+// Authorized: admin policy group 2.
+func (k msgServer) StartTssRotation(goCtx context.Context, msg *types.MsgStartTssRotation) (*types.MsgStartTssRotationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// check permission
+	if !k.GetAuthorityKeeper().IsAuthorized(ctx, msg.Signer, authoritytypes.PolicyType_GROUP_ADMIN) {
+		return &types.MsgStartTssRotationResponse{}, authoritytypes.ErrUnauthorized
+	}
+
+	if k.IsTssRotationInProgress(ctx) {
+		return nil, types.ErrTssRotationInProgress
+	}
+
+	tss, found := k.GetTSS(ctx)
+	if !found {
+		return nil, types.ErrTssNotFound
+	}
+
+	// each supported foreign chain must have its funds migrated
+	supportedChains := make(map[int64]bool)
+	for _, chain := range k.GetSupportedForeignChains(ctx) {
+		supportedChains[chain.Id] = true
+	}
+	for _, migration := range msg.Migrations {
+		if !supportedChains[migration.ChainId] {
+			return nil, cosmoserrors.Wrapf(types.ErrInvalidTssRotation, "chain %d is not supported", migration.ChainId)
+		}
+		if !migration.HasPellMigration() {
+			continue
+		}
+		chainParams, found := k.GetChainParamsByChainID(ctx, migration.ChainId)
+		if !found || chainParams.PellTokenContractAddress == "" {
+			return nil, cosmoserrors.Wrapf(
+				types.ErrInvalidTssRotation,
+				"pell token contract not set for chain %d",
+				migration.ChainId,
+			)
+		}
+	}
+	if len(msg.Migrations) != len(supportedChains) {
+		return nil, cosmoserrors.Wrapf(
+			types.ErrInvalidTssRotation,
+			"a migration must be defined for each of the %d supported chains",
+			len(supportedChains),
+		)
+	}
+
+	if _, err := k.ScheduleKeygen(ctx, msg.KeygenBlock); err != nil {
+		return nil, err
+	}
+
+	migrations := append([]types.TssRotationMigration{}, msg.Migrations...)
+	sort.SliceStable(migrations, func(i, j int) bool { return migrations[i].ChainId < migrations[j].ChainId })
+	rotation := types.TssRotation{
+		OldTssPubkey: tss.TssPubkey,
+		KeygenBlock:  msg.KeygenBlock,
+		StartHeight:  ctx.BlockHeight(),
+		Migrations:   migrations,
+	}
+	k.SetTssRotationStage(ctx, rotation, types.TssRotationStage_TSS_ROTATION_KEYGEN, "keygen scheduled")
+
+	return &types.MsgStartTssRotationResponse{}, nil
+}
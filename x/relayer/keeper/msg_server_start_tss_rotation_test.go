@@ -0,0 +1,195 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/pkg/chains"
+	keepertest "github.com/0xPellNetwork/aegis/testutil/keeper"
+	"github.com/0xPellNetwork/aegis/testutil/sample"
+	authoritytypes "github.com/0xPellNetwork/aegis/x/authority/types"
+	"github.com/0xPellNetwork/aegis/x/relayer/keeper"
+	"github.com/0xPellNetwork/aegis/x/relayer/types"
+)
+
+func TestMsgServer_StartTssRotation(t *testing.T) {
+	t.Run("should start a tss rotation", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeperWithMocks(t, keepertest.RelayerMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetRelayerAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_ADMIN, true)
+
+		tss := sample.Tss_pell()
+		k.SetTSS(ctx, tss)
+		k.SetKeygen(ctx, types.Keygen{Status: types.KeygenStatus_SUCCESS})
+		migration := setTssRotationChain(ctx, *k)
+
+		keygenBlock := ctx.BlockHeight() + 100
+		_, err := srv.StartTssRotation(
+			sdk.WrapSDKContext(ctx),
+			types.NewMsgStartTssRotation(admin, keygenBlock, []types.TssRotationMigration{migration}),
+		)
+		require.NoError(t, err)
+
+		rotation, found := k.GetTssRotation(ctx)
+		require.True(t, found)
+		require.Equal(t, types.TssRotation{
+			Stage:        types.TssRotationStage_TSS_ROTATION_KEYGEN,
+			OldTssPubkey: tss.TssPubkey,
+			KeygenBlock:  keygenBlock,
+			StartHeight:  ctx.BlockHeight(),
+			StageHeight:  ctx.BlockHeight(),
+			Migrations:   []types.TssRotationMigration{migration},
+			Message:      "keygen scheduled",
+		}, rotation)
+
+		keygen, _ := k.GetKeygen(ctx)
+		require.Equal(t, types.KeygenStatus_PENDING, keygen.Status)
+		require.Equal(t, keygenBlock, keygen.BlockNumber)
+
+		// the keygen can't be updated manually during the rotation
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_EMERGENCY, true)
+		_, err = srv.UpdateKeygen(sdk.WrapSDKContext(ctx), &types.MsgUpdateKeygen{Signer: admin, Block: keygenBlock + 1})
+		require.ErrorIs(t, err, types.ErrTssRotationInProgress)
+	})
+
+	t.Run("should fail if not authorized", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeperWithMocks(t, keepertest.RelayerMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetRelayerAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_ADMIN, false)
+
+		_, err := srv.StartTssRotation(sdk.WrapSDKContext(ctx), types.NewMsgStartTssRotation(admin, 100, nil))
+		require.ErrorIs(t, err, authoritytypes.ErrUnauthorized)
+	})
+
+	t.Run("should fail if a rotation is in progress", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeperWithMocks(t, keepertest.RelayerMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetRelayerAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_ADMIN, true)
+		k.SetTssRotation(ctx, types.TssRotation{Stage: types.TssRotationStage_TSS_ROTATION_DRAINING})
+
+		_, err := srv.StartTssRotation(sdk.WrapSDKContext(ctx), types.NewMsgStartTssRotation(admin, 100, nil))
+		require.ErrorIs(t, err, types.ErrTssRotationInProgress)
+	})
+
+	t.Run("should fail if tss not found", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeperWithMocks(t, keepertest.RelayerMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetRelayerAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_ADMIN, true)
+
+		_, err := srv.StartTssRotation(sdk.WrapSDKContext(ctx), types.NewMsgStartTssRotation(admin, 100, nil))
+		require.ErrorIs(t, err, types.ErrTssNotFound)
+	})
+
+	t.Run("should fail if a supported chain has no migration", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeperWithMocks(t, keepertest.RelayerMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetRelayerAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_ADMIN, true)
+		k.SetTSS(ctx, sample.Tss_pell())
+		k.SetKeygen(ctx, types.Keygen{})
+		setTssRotationChain(ctx, *k)
+
+		_, err := srv.StartTssRotation(sdk.WrapSDKContext(ctx), types.NewMsgStartTssRotation(admin, ctx.BlockHeight()+100, nil))
+		require.ErrorIs(t, err, types.ErrInvalidTssRotation)
+	})
+
+	t.Run("should fail if a migration chain is not supported", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeperWithMocks(t, keepertest.RelayerMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetRelayerAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_ADMIN, true)
+		k.SetTSS(ctx, sample.Tss_pell())
+		k.SetKeygen(ctx, types.Keygen{})
+		migration := setTssRotationChain(ctx, *k)
+
+		_, err := srv.StartTssRotation(sdk.WrapSDKContext(ctx), types.NewMsgStartTssRotation(
+			admin,
+			ctx.BlockHeight()+100,
+			[]types.TssRotationMigration{
+				migration,
+				{ChainId: chains.GoerliChain().Id, Amount: sdkmath.NewUint(1000)},
+			},
+		))
+		require.ErrorIs(t, err, types.ErrInvalidTssRotation)
+	})
+
+	t.Run("should fail if the pell token contract is not set for a pell token migration", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeperWithMocks(t, keepertest.RelayerMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetRelayerAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_ADMIN, true)
+		k.SetTSS(ctx, sample.Tss_pell())
+		k.SetKeygen(ctx, types.Keygen{})
+		migration := setTssRotationChain(ctx, *k)
+		k.SetChainParamsList(ctx, types.ChainParamsList{ChainParams: []*types.ChainParams{
+			{ChainId: migration.ChainId, IsSupported: true},
+		}})
+
+		_, err := srv.StartTssRotation(sdk.WrapSDKContext(ctx), types.NewMsgStartTssRotation(
+			admin,
+			ctx.BlockHeight()+100,
+			[]types.TssRotationMigration{migration},
+		))
+		require.ErrorIs(t, err, types.ErrInvalidTssRotation)
+
+		// the gas token can still be migrated alone
+		migration.PellAmount = sdkmath.ZeroUint()
+		_, err = srv.StartTssRotation(sdk.WrapSDKContext(ctx), types.NewMsgStartTssRotation(
+			admin,
+			ctx.BlockHeight()+100,
+			[]types.TssRotationMigration{migration},
+		))
+		require.NoError(t, err)
+	})
+
+	t.Run("should fail if keygen block too low", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeperWithMocks(t, keepertest.RelayerMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetRelayerAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_ADMIN, true)
+		k.SetTSS(ctx, sample.Tss_pell())
+		k.SetKeygen(ctx, types.Keygen{})
+		migration := setTssRotationChain(ctx, *k)
+
+		_, err := srv.StartTssRotation(sdk.WrapSDKContext(ctx), types.NewMsgStartTssRotation(
+			admin,
+			ctx.BlockHeight()+1,
+			[]types.TssRotationMigration{migration},
+		))
+		require.ErrorIs(t, err, types.ErrKeygenBlockTooLow)
+
+		_, found := k.GetTssRotation(ctx)
+		require.False(t, found)
+	})
+}
@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/0xPellNetwork/aegis/x/relayer/types"
+)
+
+// UnjailObserver unjails an observer jailed for blames or missed ballots once the jail duration
+// of the params has elapsed. The observer can vote again and is part of the TSS party of the next keygen.
+//
+// Only the jailed observer is authorized to broadcast this message.
+func (k msgServer) UnjailObserver(goCtx context.Context, msg *types.MsgUnjailObserver) (*types.MsgUnjailObserverResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.IsAddressPartOfObserverSet(ctx, msg.Signer) {
+		return nil, types.ErrNotObserver
+	}
+
+	if err := k.Keeper.UnjailObserver(ctx, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	EmitEventRelayerUnjailed(ctx, msg.Signer)
+
+	return &types.MsgUnjailObserverResponse{}, nil
+}
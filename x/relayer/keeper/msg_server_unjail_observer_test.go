@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/0xPellNetwork/aegis/testutil/keeper"
+	"github.com/0xPellNetwork/aegis/testutil/sample"
+	"github.com/0xPellNetwork/aegis/x/relayer/keeper"
+	"github.com/0xPellNetwork/aegis/x/relayer/types"
+)
+
+func TestMsgServer_UnjailObserver(t *testing.T) {
+	t.Run("should unjail observer after the jail duration", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+
+		observer := sample.AccAddress()
+		k.SetObserverSet(ctx, types.RelayerSet{RelayerList: []string{observer}})
+		penalty := types.NewRelayerPenalty(observer, ctx.BlockHeight())
+		penalty.Jail(ctx.BlockHeight())
+		k.SetRelayerPenalty(ctx, penalty)
+		require.False(t, k.IsNonTombstonedObserver(ctx, observer))
+
+		_, err := srv.UnjailObserver(sdk.WrapSDKContext(ctx), types.NewMsgUnjailObserver(observer))
+		require.NoError(t, err)
+		require.False(t, k.IsObserverJailed(ctx, observer))
+	})
+
+	t.Run("should fail if the jail duration has not elapsed", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+
+		observer := sample.AccAddress()
+		k.SetObserverSet(ctx, types.RelayerSet{RelayerList: []string{observer}})
+		penalty := types.NewRelayerPenalty(observer, ctx.BlockHeight())
+		penalty.Jail(ctx.BlockHeight() + 1)
+		k.SetRelayerPenalty(ctx, penalty)
+
+		_, err := srv.UnjailObserver(sdk.WrapSDKContext(ctx), types.NewMsgUnjailObserver(observer))
+		require.ErrorIs(t, err, types.ErrJailDurationNotElapsed)
+		require.True(t, k.IsObserverJailed(ctx, observer))
+	})
+
+	t.Run("should fail if the observer is not jailed", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+
+		observer := sample.AccAddress()
+		k.SetObserverSet(ctx, types.RelayerSet{RelayerList: []string{observer}})
+
+		_, err := srv.UnjailObserver(sdk.WrapSDKContext(ctx), types.NewMsgUnjailObserver(observer))
+		require.ErrorIs(t, err, types.ErrObserverNotJailed)
+	})
+
+	t.Run("should fail if the signer is not an observer", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+
+		_, err := srv.UnjailObserver(sdk.WrapSDKContext(ctx), types.NewMsgUnjailObserver(sample.AccAddress()))
+		require.ErrorIs(t, err, types.ErrNotObserver)
+	})
+}
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"context"
+
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	authoritytypes "github.com/0xPellNetwork/aegis/x/authority/types"
+	"github.com/0xPellNetwork/aegis/x/relayer/types"
+)
+
+// UpdateChain updates a chain of the chain registry.
+// Deprecated chains can't be updated.
+// Only the admin policy account is authorized to broadcast this message.
+func (k msgServer) UpdateChain(goCtx context.Context, msg *types.MsgUpdateChain) (*types.MsgUpdateChainResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// check permission
+	if !k.GetAuthorityKeeper().IsAuthorized(ctx, msg.Signer, authoritytypes.PolicyType_GROUP_ADMIN) {
+		return &types.MsgUpdateChainResponse{}, authoritytypes.ErrUnauthorized
+	}
+
+	chain, found := k.GetChainByChainID(ctx, msg.Chain.Id)
+	if !found {
+		return &types.MsgUpdateChainResponse{}, cosmoserrors.Wrapf(types.ErrChainNotFound, "chain id %d", msg.Chain.Id)
+	}
+	if chain.IsDeprecated {
+		return &types.MsgUpdateChainResponse{}, cosmoserrors.Wrapf(types.ErrChainDeprecated, "chain id %d", msg.Chain.Id)
+	}
+
+	k.SetChain(ctx, msg.Chain)
+
+	return &types.MsgUpdateChainResponse{}, nil
+}
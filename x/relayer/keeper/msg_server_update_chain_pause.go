@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	authoritytypes "github.com/0xPellNetwork/aegis/x/authority/types"
+	"github.com/0xPellNetwork/aegis/x/relayer/types"
+)
+
+// UpdateChainPause pauses or resumes inbound, outbound and signing for a single chain of the chain registry.
+// The pause replaces the current pause of the chain, it is removed when nothing is paused and
+// is lifted automatically at the expiry height if defined.
+//
+// Pausing outbound or signing doesn't block the creation of the outbounds to the chain, they stay pending:
+// the relayer neither signs nor observes them and their gas price is not bumped until the pause is lifted.
+//
+// Authorized: emergency policy group to pause a chain, operational policy group to resume
+// anything paused or to make the pause expire earlier. A message can't both lift and extend the pause.
+func (k msgServer) UpdateChainPause(goCtx context.Context, msg *types.MsgUpdateChainPause) (*types.MsgUpdateChainPauseResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	flags, found := k.GetCrosschainFlags(ctx)
+	if !found {
+		flags = *types.DefaultCrosschainFlags()
+	}
+	pause := msg.ChainPause()
+	current, _ := flags.GetChainPause(msg.ChainId)
+
+	// lifting and extending a pause are authorized by different policies and can't be mixed
+	if current.IsLiftedBy(pause) && current.IsExtendedBy(pause) {
+		return &types.MsgUpdateChainPauseResponse{}, cosmoserrors.Wrapf(
+			types.ErrInvalidChainPause,
+			"pause of chain %d can't be lifted and extended at once",
+			msg.ChainId,
+		)
+	}
+
+	// check permission
+	policyType := authoritytypes.PolicyType_GROUP_EMERGENCY
+	if current.IsLiftedBy(pause) {
+		policyType = authoritytypes.PolicyType_GROUP_OPERATIONAL
+	}
+	if !k.GetAuthorityKeeper().IsAuthorized(ctx, msg.Signer, policyType) {
+		return &types.MsgUpdateChainPauseResponse{}, authoritytypes.ErrUnauthorized
+	}
+
+	if pause.IsPaused() {
+		if _, found := k.GetChainByChainID(ctx, msg.ChainId); !found {
+			return &types.MsgUpdateChainPauseResponse{}, cosmoserrors.Wrapf(types.ErrChainNotFound, "chain id %d", msg.ChainId)
+		}
+		if pause.ExpiryHeight != 0 && pause.ExpiryHeight <= ctx.BlockHeight() {
+			return &types.MsgUpdateChainPauseResponse{}, cosmoserrors.Wrapf(
+				types.ErrInvalidChainPause,
+				"expiry height %d must be greater than current height %d",
+				pause.ExpiryHeight,
+				ctx.BlockHeight(),
+			)
+		}
+	}
+
+	flags.SetChainPause(pause)
+	k.SetCrosschainFlags(ctx, flags)
+
+	EmitEventChainPauseUpdated(ctx, msg.Signer, pause)
+
+	return &types.MsgUpdateChainPauseResponse{}, nil
+}
@@ -0,0 +1,163 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/pkg/chains"
+	keepertest "github.com/0xPellNetwork/aegis/testutil/keeper"
+	"github.com/0xPellNetwork/aegis/testutil/sample"
+	authoritytypes "github.com/0xPellNetwork/aegis/x/authority/types"
+	"github.com/0xPellNetwork/aegis/x/relayer/keeper"
+	"github.com/0xPellNetwork/aegis/x/relayer/types"
+)
+
+func TestMsgServer_UpdateChainPause(t *testing.T) {
+	t.Run("can pause a chain", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeperWithMocks(t, keepertest.RelayerMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetRelayerAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_EMERGENCY, true)
+
+		chainID := chains.SepoliaChain().Id
+		_, err := srv.UpdateChainPause(
+			sdk.WrapSDKContext(ctx),
+			types.NewMsgUpdateChainPause(admin, chainID, true, false, true, ctx.BlockHeight()+100),
+		)
+		require.NoError(t, err)
+
+		require.True(t, k.IsChainInboundPaused(ctx, chainID))
+		require.False(t, k.IsChainOutboundPaused(ctx, chainID))
+		require.True(t, k.IsChainSigningPaused(ctx, chainID))
+
+		// the pause shows up in the crosschain flags
+		flags, found := k.GetCrosschainFlags(ctx)
+		require.True(t, found)
+		require.True(t, flags.IsInboundEnabled)
+		require.Equal(t, []types.ChainPause{{
+			ChainId:         chainID,
+			IsInboundPaused: true,
+			IsSigningPaused: true,
+			ExpiryHeight:    ctx.BlockHeight() + 100,
+		}}, flags.ChainPauses)
+	})
+
+	t.Run("can resume a chain with operational policy", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeperWithMocks(t, keepertest.RelayerMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetRelayerAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_OPERATIONAL, true)
+
+		chainID := chains.SepoliaChain().Id
+		k.SetChainPause(ctx, types.ChainPause{ChainId: chainID, IsInboundPaused: true})
+
+		_, err := srv.UpdateChainPause(
+			sdk.WrapSDKContext(ctx),
+			types.NewMsgUpdateChainPause(admin, chainID, false, false, false, 0),
+		)
+		require.NoError(t, err)
+		require.False(t, k.IsChainInboundPaused(ctx, chainID))
+	})
+
+	t.Run("cannot resume a chain with emergency policy", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeperWithMocks(t, keepertest.RelayerMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetRelayerAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_OPERATIONAL, false)
+
+		chainID := chains.SepoliaChain().Id
+		k.SetChainPause(ctx, types.ChainPause{ChainId: chainID, IsInboundPaused: true})
+
+		_, err := srv.UpdateChainPause(
+			sdk.WrapSDKContext(ctx),
+			types.NewMsgUpdateChainPause(admin, chainID, false, false, false, 0),
+		)
+		require.ErrorIs(t, err, authoritytypes.ErrUnauthorized)
+		require.True(t, k.IsChainInboundPaused(ctx, chainID))
+	})
+
+	t.Run("cannot lift and extend a pause at once", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeperWithMocks(t, keepertest.RelayerMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+
+		admin := sample.AccAddress()
+		chainID := chains.SepoliaChain().Id
+		k.SetChainPause(ctx, types.ChainPause{ChainId: chainID, IsInboundPaused: true})
+
+		// resume inbound while pausing outbound
+		_, err := srv.UpdateChainPause(
+			sdk.WrapSDKContext(ctx),
+			types.NewMsgUpdateChainPause(admin, chainID, false, true, false, 0),
+		)
+		require.ErrorIs(t, err, types.ErrInvalidChainPause)
+		require.True(t, k.IsChainInboundPaused(ctx, chainID))
+		require.False(t, k.IsChainOutboundPaused(ctx, chainID))
+	})
+
+	t.Run("cannot pause a chain if not authorized", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeperWithMocks(t, keepertest.RelayerMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetRelayerAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_EMERGENCY, false)
+
+		_, err := srv.UpdateChainPause(
+			sdk.WrapSDKContext(ctx),
+			types.NewMsgUpdateChainPause(admin, chains.SepoliaChain().Id, true, true, true, 0),
+		)
+		require.ErrorIs(t, err, authoritytypes.ErrUnauthorized)
+	})
+
+	t.Run("cannot pause a chain not in the chain registry", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeperWithMocks(t, keepertest.RelayerMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetRelayerAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_EMERGENCY, true)
+
+		_, err := srv.UpdateChainPause(
+			sdk.WrapSDKContext(ctx),
+			types.NewMsgUpdateChainPause(admin, 424242, true, false, false, 0),
+		)
+		require.ErrorIs(t, err, types.ErrChainNotFound)
+	})
+
+	t.Run("cannot pause a chain with a past expiry height", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeperWithMocks(t, keepertest.RelayerMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetRelayerAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_EMERGENCY, true)
+
+		_, err := srv.UpdateChainPause(
+			sdk.WrapSDKContext(ctx),
+			types.NewMsgUpdateChainPause(admin, chains.SepoliaChain().Id, true, false, false, ctx.BlockHeight()),
+		)
+		require.ErrorIs(t, err, types.ErrInvalidChainPause)
+	})
+}
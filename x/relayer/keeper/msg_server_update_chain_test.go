@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/pkg/chains"
+	keepertest "github.com/0xPellNetwork/aegis/testutil/keeper"
+	"github.com/0xPellNetwork/aegis/testutil/sample"
+	authoritytypes "github.com/0xPellNetwork/aegis/x/authority/types"
+	"github.com/0xPellNetwork/aegis/x/relayer/keeper"
+	"github.com/0xPellNetwork/aegis/x/relayer/types"
+)
+
+func TestMsgServer_UpdateChain(t *testing.T) {
+	t.Run("can update a chain", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeperWithMocks(t, keepertest.RelayerMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetRelayerAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_ADMIN, true)
+
+		k.SetChain(ctx, sampleNewChain())
+		updated := sampleNewChain()
+		updated.NetworkType = chains.NetWorkType_MAINNET
+
+		_, err := srv.UpdateChain(sdk.WrapSDKContext(ctx), types.NewMsgUpdateChain(admin, updated))
+		require.NoError(t, err)
+
+		chain, found := k.GetChainByChainID(ctx, updated.Id)
+		require.True(t, found)
+		require.Equal(t, updated, chain)
+	})
+
+	t.Run("cannot update a chain if not authorized", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeperWithMocks(t, keepertest.RelayerMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetRelayerAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_ADMIN, false)
+
+		_, err := srv.UpdateChain(sdk.WrapSDKContext(ctx), types.NewMsgUpdateChain(admin, chains.SepoliaChain()))
+		require.ErrorIs(t, err, authoritytypes.ErrUnauthorized)
+	})
+
+	t.Run("cannot update a chain not in the chain registry", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeperWithMocks(t, keepertest.RelayerMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetRelayerAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_ADMIN, true)
+
+		_, err := srv.UpdateChain(sdk.WrapSDKContext(ctx), types.NewMsgUpdateChain(admin, sampleNewChain()))
+		require.ErrorIs(t, err, types.ErrChainNotFound)
+	})
+
+	t.Run("cannot update a deprecated chain", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeperWithMocks(t, keepertest.RelayerMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetRelayerAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_ADMIN, true)
+
+		deprecated := sampleNewChain()
+		deprecated.IsDeprecated = true
+		k.SetChain(ctx, deprecated)
+
+		_, err := srv.UpdateChain(sdk.WrapSDKContext(ctx), types.NewMsgUpdateChain(admin, sampleNewChain()))
+		require.ErrorIs(t, err, types.ErrChainDeprecated)
+	})
+}
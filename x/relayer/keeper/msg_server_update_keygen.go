@@ -21,27 +21,14 @@ func (k msgServer) UpdateKeygen(goCtx context.Context, msg *types.MsgUpdateKeyge
 		return &types.MsgUpdateKeygenResponse{}, authoritytypes.ErrUnauthorized
 	}
 
-	keygen, found := k.GetKeygen(ctx)
-	if !found {
-		return nil, types.ErrKeygenNotFound
-	}
-	if msg.Block <= (ctx.BlockHeight() + 10) {
-		return nil, types.ErrKeygenBlockTooLow
+	// the keygen of a tss rotation is managed by the rotation
+	if k.IsTssRotationInProgress(ctx) {
+		return nil, types.ErrTssRotationInProgress
 	}
 
-	nodeAccountList := k.GetAllNodeAccount(ctx)
-	granteePubKeys := make([]string, len(nodeAccountList))
-	for i, nodeAccount := range nodeAccountList {
-		granteePubKeys[i] = nodeAccount.GranteePubkey.Secp256k1.String()
+	if _, err := k.ScheduleKeygen(ctx, msg.Block); err != nil {
+		return nil, err
 	}
 
-	// update keygen
-	keygen.GranteePubkeys = granteePubKeys
-	keygen.BlockNumber = msg.Block
-	keygen.Status = types.KeygenStatus_PENDING
-	k.SetKeygen(ctx, keygen)
-
-	EmitEventKeyGenBlockUpdated(ctx, &keygen)
-
 	return &types.MsgUpdateKeygenResponse{}, nil
 }
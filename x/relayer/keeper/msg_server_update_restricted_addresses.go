@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	authoritytypes "github.com/0xPellNetwork/aegis/x/authority/types"
+	"github.com/0xPellNetwork/aegis/x/relayer/types"
+)
+
+// UpdateRestrictedAddresses adds and removes addresses from the restricted address list used by the relayers
+// for compliance screening.
+// Only the emergency policy account is authorized to broadcast this message.
+func (k msgServer) UpdateRestrictedAddresses(
+	goCtx context.Context,
+	msg *types.MsgUpdateRestrictedAddresses,
+) (*types.MsgUpdateRestrictedAddressesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// check permission
+	if !k.GetAuthorityKeeper().IsAuthorized(ctx, msg.Signer, authoritytypes.PolicyType_GROUP_EMERGENCY) {
+		return &types.MsgUpdateRestrictedAddressesResponse{}, authoritytypes.ErrUnauthorized
+	}
+
+	for _, address := range msg.RemovedAddresses {
+		k.RemoveRestrictedAddress(ctx, address)
+	}
+	for _, address := range msg.AddedAddresses {
+		k.SetRestrictedAddress(ctx, address)
+	}
+
+	err := ctx.EventManager().EmitTypedEvents(&types.EventRestrictedAddressesUpdated{
+		MsgTypeUrl:       sdk.MsgTypeURL(&types.MsgUpdateRestrictedAddresses{}),
+		AddedAddresses:   msg.AddedAddresses,
+		RemovedAddresses: msg.RemovedAddresses,
+		Signer:           msg.Signer,
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting EventRestrictedAddressesUpdated :", err)
+	}
+
+	return &types.MsgUpdateRestrictedAddressesResponse{}, nil
+}
@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/0xPellNetwork/aegis/testutil/keeper"
+	"github.com/0xPellNetwork/aegis/testutil/sample"
+	authoritytypes "github.com/0xPellNetwork/aegis/x/authority/types"
+	"github.com/0xPellNetwork/aegis/x/relayer/keeper"
+	"github.com/0xPellNetwork/aegis/x/relayer/types"
+)
+
+func TestMsgServer_UpdateRestrictedAddresses(t *testing.T) {
+	t.Run("can add and remove restricted addresses", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeperWithMocks(t, keepertest.RelayerMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetRelayerAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_EMERGENCY, true)
+
+		address1 := sample.EthAddress().Hex()
+		address2 := sample.EthAddress().Hex()
+		k.SetRestrictedAddress(ctx, address2)
+
+		_, err := srv.UpdateRestrictedAddresses(
+			sdk.WrapSDKContext(ctx),
+			types.NewMsgUpdateRestrictedAddresses(admin, []string{address1}, []string{address2}),
+		)
+		require.NoError(t, err)
+		require.True(t, k.IsRestrictedAddress(ctx, address1))
+		require.False(t, k.IsRestrictedAddress(ctx, address2))
+	})
+
+	t.Run("removing an address not in the list is a noop", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeperWithMocks(t, keepertest.RelayerMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetRelayerAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_EMERGENCY, true)
+
+		_, err := srv.UpdateRestrictedAddresses(
+			sdk.WrapSDKContext(ctx),
+			types.NewMsgUpdateRestrictedAddresses(admin, nil, []string{sample.EthAddress().Hex()}),
+		)
+		require.NoError(t, err)
+		require.Empty(t, k.GetAllRestrictedAddresses(ctx))
+	})
+
+	t.Run("cannot update restricted addresses if not authorized", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeperWithMocks(t, keepertest.RelayerMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetRelayerAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_EMERGENCY, false)
+
+		address := sample.EthAddress().Hex()
+		_, err := srv.UpdateRestrictedAddresses(
+			sdk.WrapSDKContext(ctx),
+			types.NewMsgUpdateRestrictedAddresses(admin, []string{address}, nil),
+		)
+		require.ErrorIs(t, err, authoritytypes.ErrUnauthorized)
+		require.False(t, k.IsRestrictedAddress(ctx, address))
+	})
+}
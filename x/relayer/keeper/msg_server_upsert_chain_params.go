@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	cosmoserrors "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	authoritytypes "github.com/0xPellNetwork/aegis/x/authority/types"
@@ -20,6 +21,18 @@ func (k msgServer) UpsertChainParams(goCtx context.Context, msg *types.MsgUpsert
 		return &types.MsgUpsertChainParamsResponse{}, authoritytypes.ErrUnauthorized
 	}
 
+	// the chain must be registered in the chain registry
+	chain, found := k.GetChainByChainID(ctx, msg.ChainParams.ChainId)
+	if !found {
+		return &types.MsgUpsertChainParamsResponse{}, cosmoserrors.Wrapf(types.ErrChainNotFound, "chain id %d", msg.ChainParams.ChainId)
+	}
+	if chain.IsDeprecated && msg.ChainParams.IsSupported {
+		return &types.MsgUpsertChainParamsResponse{}, cosmoserrors.Wrapf(types.ErrChainDeprecated, "chain id %d cannot be supported", chain.Id)
+	}
+	if err := types.ValidateChainParamsForChain(msg.ChainParams, chain); err != nil {
+		return &types.MsgUpsertChainParamsResponse{}, cosmoserrors.Wrap(types.ErrInvalidChainParams, err.Error())
+	}
+
 	tmpCtx, commit := ctx.CacheContext()
 
 	if msg.ChainParams.GatewayEvmContractAddress != "" {
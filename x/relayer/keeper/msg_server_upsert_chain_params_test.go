@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
+	"github.com/0xPellNetwork/aegis/pkg/chains"
 	keepertest "github.com/0xPellNetwork/aegis/testutil/keeper"
 	"github.com/0xPellNetwork/aegis/testutil/sample"
 	authoritytypes "github.com/0xPellNetwork/aegis/x/authority/types"
@@ -119,4 +120,71 @@ func TestMsgServer_UpsertChainParams(t *testing.T) {
 		})
 		require.ErrorIs(t, err, authoritytypes.ErrUnauthorized)
 	})
+
+	t.Run("cannot update chain params if chain not in chain registry", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeperWithMocks(t, keepertest.RelayerMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetRelayerAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_OPERATIONAL, true)
+
+		_, err := srv.UpsertChainParams(sdk.WrapSDKContext(ctx), &types.MsgUpsertChainParams{
+			Signer:      admin,
+			ChainParams: sample.ChainParams_pell(1000),
+		})
+		require.ErrorIs(t, err, types.ErrChainNotFound)
+	})
+
+	t.Run("can update chain params for a chain added to the chain registry", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeperWithMocks(t, keepertest.RelayerMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetRelayerAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_OPERATIONAL, true)
+
+		k.SetChain(ctx, chains.Chain{
+			Id:          1000,
+			Network:     chains.NetWork_ETH,
+			NetworkType: chains.NetWorkType_TESTNET,
+			VmType:      chains.VMType_EVM,
+		})
+
+		chainParams := sample.ChainParamsSupported_pell(1000)
+		_, err := srv.UpsertChainParams(sdk.WrapSDKContext(ctx), &types.MsgUpsertChainParams{
+			Signer:      admin,
+			ChainParams: chainParams,
+		})
+		require.NoError(t, err)
+
+		chainParamsFound, found := k.GetChainParamsByChainID(ctx, 1000)
+		require.True(t, found)
+		require.Equal(t, chainParams, chainParamsFound)
+	})
+
+	t.Run("cannot support a deprecated chain", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeperWithMocks(t, keepertest.RelayerMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetRelayerAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_OPERATIONAL, true)
+
+		chain := externalChainList[0]
+		chain.IsDeprecated = true
+		k.SetChain(ctx, chain)
+
+		_, err := srv.UpsertChainParams(sdk.WrapSDKContext(ctx), &types.MsgUpsertChainParams{
+			Signer:      admin,
+			ChainParams: sample.ChainParamsSupported_pell(chain.Id),
+		})
+		require.ErrorIs(t, err, types.ErrChainDeprecated)
+	})
 }
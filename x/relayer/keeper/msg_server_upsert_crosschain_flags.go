@@ -13,6 +13,8 @@ import (
 //
 // Aurthorized: admin policy group 1 (except enabling/disabled
 // inbounds/outbounds and gas price increase), admin policy group 2 (all).
+//
+// The flags can't be updated while a tss rotation is in progress.
 func (k msgServer) UpsertCrosschainFlags(goCtx context.Context, msg *types.MsgUpsertCrosschainFlags) (*types.MsgUpsertCrosschainFlagsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -21,6 +23,11 @@ func (k msgServer) UpsertCrosschainFlags(goCtx context.Context, msg *types.MsgUp
 		return &types.MsgUpsertCrosschainFlagsResponse{}, authoritytypes.ErrUnauthorized
 	}
 
+	// the crosschain flags can't be changed while a tss rotation is in progress
+	if k.IsTssRotationInProgress(ctx) {
+		return nil, types.ErrTssRotationInProgress
+	}
+
 	// check if the value exists
 	flags, isFound := k.GetCrosschainFlags(ctx)
 	if !isFound {
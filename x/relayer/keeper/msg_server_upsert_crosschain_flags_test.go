@@ -178,4 +178,28 @@ func TestMsgServer_UpsertCrosschainFlags(t *testing.T) {
 		require.Error(t, err)
 		require.Equal(t, authoritytypes.ErrUnauthorized, err)
 	})
+
+	t.Run("cannot update crosschain flags if a tss rotation is in progress", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeperWithMocks(t, keepertest.RelayerMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetRelayerAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_OPERATIONAL, true)
+		k.SetTssRotation(ctx, types.TssRotation{Stage: types.TssRotationStage_TSS_ROTATION_DRAINING})
+		k.DisableInboundOnly(ctx)
+
+		_, err := srv.UpsertCrosschainFlags(sdk.WrapSDKContext(ctx), &types.MsgUpsertCrosschainFlags{
+			Signer:            admin,
+			IsInboundEnabled:  true,
+			IsOutboundEnabled: true,
+		})
+		require.ErrorIs(t, err, types.ErrTssRotationInProgress)
+
+		flags, found := k.GetCrosschainFlags(ctx)
+		require.True(t, found)
+		require.False(t, flags.IsInboundEnabled)
+	})
 }
@@ -32,6 +32,9 @@ func (k msgServer) VoteTSS(goCtx context.Context, msg *types.MsgVoteTSS) (*types
 	if !found {
 		return nil, errorsmod.Wrap(sdkerrors.ErrorInvalidSigner, fmt.Sprintf("signer %s does not have a node account set", msg.Signer))
 	}
+	if k.IsObserverJailed(ctx, msg.Signer) {
+		return nil, errorsmod.Wrap(types.ErrObserverJailed, msg.Signer)
+	}
 	// no need to create a ballot if keygen does not exist
 	keygen, found := k.GetKeygen(ctx)
 	if !found {
@@ -52,7 +55,7 @@ func (k msgServer) VoteTSS(goCtx context.Context, msg *types.MsgVoteTSS) (*types
 		// if ballot does not exist, create a new ballot
 		var voterList []string
 
-		for _, nodeAccount := range k.GetAllNodeAccount(ctx) {
+		for _, nodeAccount := range k.GetAllActiveNodeAccount(ctx) {
 			voterList = append(voterList, nodeAccount.Operator)
 		}
 		ballot = types.Ballot{
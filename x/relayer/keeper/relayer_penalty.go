@@ -0,0 +1,147 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/0xPellNetwork/aegis/x/relayer/types"
+)
+
+// SetRelayerPenalty sets the penalty record of an observer
+func (k Keeper) SetRelayerPenalty(ctx sdk.Context, penalty types.RelayerPenalty) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RelayerPenaltyKey))
+	b := k.cdc.MustMarshal(&penalty)
+	store.Set([]byte(penalty.ObserverAddress), b)
+}
+
+// GetRelayerPenalty returns the penalty record of an observer
+func (k Keeper) GetRelayerPenalty(ctx sdk.Context, observerAddress string) (val types.RelayerPenalty, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RelayerPenaltyKey))
+	b := store.Get([]byte(observerAddress))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllRelayerPenalties returns the penalty records of all observers
+func (k Keeper) GetAllRelayerPenalties(ctx sdk.Context) (list []types.RelayerPenalty) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RelayerPenaltyKey))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RelayerPenalty
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+	return
+}
+
+// IsObserverJailed returns true if the observer is jailed for blames or missed ballots
+func (k Keeper) IsObserverJailed(ctx sdk.Context, observerAddress string) bool {
+	penalty, found := k.GetRelayerPenalty(ctx, observerAddress)
+	return found && penalty.Jailed
+}
+
+// GetAllActiveNodeAccount returns the node accounts of the observers that are not jailed,
+// jailed observers are excluded from the TSS party
+func (k Keeper) GetAllActiveNodeAccount(ctx sdk.Context) (list []types.NodeAccount) {
+	for _, nodeAccount := range k.GetAllNodeAccount(ctx) {
+		if k.IsObserverJailed(ctx, nodeAccount.Operator) {
+			continue
+		}
+		list = append(list, nodeAccount)
+	}
+	return
+}
+
+// AddBlamesToObservers records a blame for each observer blamed in a finalized blame.
+// Blamed nodes are identified by the grantee public key of their node account.
+func (k Keeper) AddBlamesToObservers(ctx sdk.Context, blame types.Blame) {
+	if len(blame.Nodes) == 0 {
+		return
+	}
+
+	operators := make(map[string]string)
+	for _, nodeAccount := range k.GetAllNodeAccount(ctx) {
+		if nodeAccount.GranteePubkey == nil {
+			continue
+		}
+		operators[nodeAccount.GranteePubkey.Secp256k1.String()] = nodeAccount.Operator
+	}
+
+	// an observer is blamed once per blame even if listed several times
+	blamed := make(map[string]bool)
+	for _, node := range blame.Nodes {
+		if node == nil {
+			continue
+		}
+		operator, found := operators[node.PubKey]
+		if !found || blamed[operator] {
+			continue
+		}
+		blamed[operator] = true
+		k.addObserverPenalty(ctx, operator, 1, 0)
+	}
+}
+
+// AddMissedBallotsToObservers records a missed ballot for each observer that did not vote
+// on the ballots maturing at the current height
+func (k Keeper) AddMissedBallotsToObservers(ctx sdk.Context) {
+	for _, ballotIdentifier := range k.GetMaturedBallotList(ctx) {
+		ballot, found := k.GetBallot(ctx, ballotIdentifier)
+		if !found {
+			continue
+		}
+		for i, voter := range ballot.VoterList {
+			if i >= len(ballot.Votes) || ballot.Votes[i] != types.VoteType_NOT_YET_VOTED {
+				continue
+			}
+			if !k.IsAddressPartOfObserverSet(ctx, voter) {
+				continue
+			}
+			k.addObserverPenalty(ctx, voter, 0, 1)
+		}
+	}
+}
+
+// addObserverPenalty adds blames and missed ballots to the penalty record of the observer in the current window
+// and jails the observer if the thresholds are reached. Jailed observers are not penalized further.
+func (k Keeper) addObserverPenalty(ctx sdk.Context, observerAddress string, blames, missedBallots uint64) {
+	penalty, found := k.GetRelayerPenalty(ctx, observerAddress)
+	if !found {
+		penalty = types.NewRelayerPenalty(observerAddress, ctx.BlockHeight())
+	}
+	if penalty.Jailed {
+		return
+	}
+
+	params := k.GetParamsIfExists(ctx)
+	penalty.RollWindow(ctx.BlockHeight(), params.PenaltyWindowBlocks)
+	penalty.BlameCount += blames
+	penalty.MissedBallotCount += missedBallots
+	EmitEventRelayerPenaltyUpdated(ctx, penalty)
+
+	if reason, jail := penalty.JailReason(params); jail {
+		penalty.Jail(ctx.BlockHeight() + params.JailDurationBlocks)
+		EmitEventRelayerJailed(ctx, penalty, reason)
+		k.Logger(ctx).Info("observer jailed", "observer", observerAddress, "reason", reason,
+			"jailed_until_height", penalty.JailedUntilHeight)
+	}
+
+	k.SetRelayerPenalty(ctx, penalty)
+}
+
+// UnjailObserver unjails an observer once the jail duration has elapsed
+func (k Keeper) UnjailObserver(ctx sdk.Context, observerAddress string) error {
+	penalty, found := k.GetRelayerPenalty(ctx, observerAddress)
+	if !found {
+		return types.ErrObserverNotJailed
+	}
+	if err := penalty.Unjail(ctx.BlockHeight()); err != nil {
+		return err
+	}
+	k.SetRelayerPenalty(ctx, penalty)
+	return nil
+}
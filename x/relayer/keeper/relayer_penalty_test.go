@@ -0,0 +1,199 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/0xPellNetwork/aegis/testutil/keeper"
+	"github.com/0xPellNetwork/aegis/testutil/sample"
+	"github.com/0xPellNetwork/aegis/x/relayer/keeper"
+	"github.com/0xPellNetwork/aegis/x/relayer/types"
+)
+
+// setPenaltyParams sets the penalty params with a window of 100 blocks and a jail duration of 50 blocks
+func setPenaltyParams(ctx sdk.Context, k keeper.Keeper, maxBlames, maxMissedBallots uint64) {
+	params := types.DefaultParams()
+	params.PenaltyWindowBlocks = 100
+	params.MaxBlamesPerWindow = maxBlames
+	params.MaxMissedBallotsPerWindow = maxMissedBallots
+	params.JailDurationBlocks = 50
+	k.SetParams(ctx, params)
+}
+
+// setBlamedNodeAccount sets a node account for the observer and returns its grantee public key
+func setBlamedNodeAccount(ctx sdk.Context, k keeper.Keeper, observer string) string {
+	nodeAccount := sample.NodeAccount_pell()
+	nodeAccount.Operator = observer
+	k.SetNodeAccount(ctx, *nodeAccount)
+	return nodeAccount.GranteePubkey.Secp256k1.String()
+}
+
+func TestKeeper_GetRelayerPenalty(t *testing.T) {
+	k, ctx, _, _ := keepertest.RelayerKeeper(t)
+
+	penalties := []types.RelayerPenalty{
+		sample.RelayerPenalty_pell(t, "0"),
+		sample.RelayerPenalty_pell(t, "1"),
+	}
+	for _, penalty := range penalties {
+		k.SetRelayerPenalty(ctx, penalty)
+	}
+
+	penalty, found := k.GetRelayerPenalty(ctx, penalties[0].ObserverAddress)
+	require.True(t, found)
+	require.Equal(t, penalties[0], penalty)
+
+	_, found = k.GetRelayerPenalty(ctx, sample.AccAddress())
+	require.False(t, found)
+
+	require.ElementsMatch(t, penalties, k.GetAllRelayerPenalties(ctx))
+}
+
+func TestKeeper_AddBlamesToObservers(t *testing.T) {
+	t.Run("should record a blame for each blamed observer", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeper(t)
+		setPenaltyParams(ctx, *k, 10, 0)
+		observer1, observer2 := sample.AccAddress(), sample.AccAddress()
+		pubkey1 := setBlamedNodeAccount(ctx, *k, observer1)
+		setBlamedNodeAccount(ctx, *k, observer2)
+
+		k.AddBlamesToObservers(ctx, types.Blame{
+			Index: "blame",
+			// the same node listed twice is blamed once, unknown nodes are ignored
+			Nodes: []*types.Node{{PubKey: pubkey1}, {PubKey: pubkey1}, {PubKey: "unknown"}},
+		})
+
+		penalty, found := k.GetRelayerPenalty(ctx, observer1)
+		require.True(t, found)
+		require.EqualValues(t, 1, penalty.BlameCount)
+		require.EqualValues(t, ctx.BlockHeight(), penalty.WindowStartHeight)
+		require.False(t, penalty.Jailed)
+
+		_, found = k.GetRelayerPenalty(ctx, observer2)
+		require.False(t, found)
+	})
+
+	t.Run("should jail observer when the blame threshold is reached", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeper(t)
+		setPenaltyParams(ctx, *k, 2, 0)
+		observer := sample.AccAddress()
+		k.SetObserverSet(ctx, types.RelayerSet{RelayerList: []string{observer}})
+		pubkey := setBlamedNodeAccount(ctx, *k, observer)
+		blame := types.Blame{Nodes: []*types.Node{{PubKey: pubkey}}}
+
+		k.AddBlamesToObservers(ctx, blame)
+		require.False(t, k.IsObserverJailed(ctx, observer))
+
+		k.AddBlamesToObservers(ctx, blame)
+		require.True(t, k.IsObserverJailed(ctx, observer))
+		require.False(t, k.IsNonTombstonedObserver(ctx, observer))
+
+		penalty, _ := k.GetRelayerPenalty(ctx, observer)
+		require.EqualValues(t, 2, penalty.BlameCount)
+		require.EqualValues(t, 1, penalty.JailCount)
+		require.EqualValues(t, ctx.BlockHeight()+50, penalty.JailedUntilHeight)
+
+		// jailed observers are excluded from the TSS party
+		require.Empty(t, k.GetAllActiveNodeAccount(ctx))
+
+		// jailed observers are not penalized further
+		k.AddBlamesToObservers(ctx, blame)
+		penalty, _ = k.GetRelayerPenalty(ctx, observer)
+		require.EqualValues(t, 2, penalty.BlameCount)
+	})
+
+	t.Run("should reset blames in a new window", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeper(t)
+		setPenaltyParams(ctx, *k, 2, 0)
+		observer := sample.AccAddress()
+		pubkey := setBlamedNodeAccount(ctx, *k, observer)
+		blame := types.Blame{Nodes: []*types.Node{{PubKey: pubkey}}}
+
+		k.AddBlamesToObservers(ctx, blame)
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 100)
+		k.AddBlamesToObservers(ctx, blame)
+
+		penalty, _ := k.GetRelayerPenalty(ctx, observer)
+		require.False(t, penalty.Jailed)
+		require.EqualValues(t, 1, penalty.BlameCount)
+		require.EqualValues(t, ctx.BlockHeight(), penalty.WindowStartHeight)
+	})
+}
+
+func TestKeeper_AddMissedBallotsToObservers(t *testing.T) {
+	t.Run("should record missed ballots and jail observer", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeper(t)
+		setPenaltyParams(ctx, *k, 0, 2)
+		observer1, observer2 := sample.AccAddress(), sample.AccAddress()
+		k.SetObserverSet(ctx, types.RelayerSet{RelayerList: []string{observer1, observer2}})
+
+		// set two ballots maturing at current height where observer1 did not vote
+		maturityBlocks := k.GetParamsIfExists(ctx).BallotMaturityBlocks
+		for _, index := range []string{"ballot1", "ballot2"} {
+			ballot := types.Ballot{
+				BallotIdentifier:     index,
+				VoterList:            []string{observer1, observer2},
+				Votes:                []types.VoteType{types.VoteType_NOT_YET_VOTED, types.VoteType_SUCCESS_OBSERVATION},
+				BallotCreationHeight: ctx.BlockHeight() - maturityBlocks,
+			}
+			k.SetBallot(ctx, &ballot)
+			k.AddBallotToList(ctx, ballot)
+		}
+
+		k.AddMissedBallotsToObservers(ctx)
+
+		penalty, found := k.GetRelayerPenalty(ctx, observer1)
+		require.True(t, found)
+		require.EqualValues(t, 2, penalty.MissedBallotCount)
+		require.True(t, penalty.Jailed)
+
+		_, found = k.GetRelayerPenalty(ctx, observer2)
+		require.False(t, found)
+	})
+
+	t.Run("should ignore voters not in the observer set", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeper(t)
+		setPenaltyParams(ctx, *k, 0, 2)
+		observer := sample.AccAddress()
+
+		maturityBlocks := k.GetParamsIfExists(ctx).BallotMaturityBlocks
+		ballot := types.Ballot{
+			BallotIdentifier:     "ballot",
+			VoterList:            []string{observer},
+			Votes:                []types.VoteType{types.VoteType_NOT_YET_VOTED},
+			BallotCreationHeight: ctx.BlockHeight() - maturityBlocks,
+		}
+		k.SetBallot(ctx, &ballot)
+		k.AddBallotToList(ctx, ballot)
+
+		k.AddMissedBallotsToObservers(ctx)
+
+		_, found := k.GetRelayerPenalty(ctx, observer)
+		require.False(t, found)
+	})
+}
+
+func TestKeeper_UnjailObserver(t *testing.T) {
+	t.Run("should unjail observer after the jail duration", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeper(t)
+		observer := sample.AccAddress()
+		penalty := types.NewRelayerPenalty(observer, ctx.BlockHeight())
+		penalty.Jail(ctx.BlockHeight() + 10)
+		k.SetRelayerPenalty(ctx, penalty)
+
+		err := k.UnjailObserver(ctx, observer)
+		require.ErrorIs(t, err, types.ErrJailDurationNotElapsed)
+
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+		require.NoError(t, k.UnjailObserver(ctx, observer))
+		require.False(t, k.IsObserverJailed(ctx, observer))
+	})
+
+	t.Run("should fail if observer has no penalty record", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeper(t)
+		err := k.UnjailObserver(ctx, sample.AccAddress())
+		require.ErrorIs(t, err, types.ErrObserverNotJailed)
+	})
+}
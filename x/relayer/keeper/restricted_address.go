@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/0xPellNetwork/aegis/x/relayer/types"
+)
+
+// Restricted address list methods
+// The restricted address list is used by the relayers for compliance screening of inbound and outbound txs,
+// it is managed through the emergency policy
+
+// SetRestrictedAddress adds an address to the restricted address list
+func (k Keeper) SetRestrictedAddress(ctx sdk.Context, address string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RestrictedAddressKey))
+	store.Set([]byte(types.NormalizeRestrictedAddress(address)), []byte{1})
+}
+
+// RemoveRestrictedAddress removes an address from the restricted address list
+func (k Keeper) RemoveRestrictedAddress(ctx sdk.Context, address string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RestrictedAddressKey))
+	store.Delete([]byte(types.NormalizeRestrictedAddress(address)))
+}
+
+// IsRestrictedAddress returns true if the address is in the restricted address list
+func (k Keeper) IsRestrictedAddress(ctx sdk.Context, address string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RestrictedAddressKey))
+	return store.Has([]byte(types.NormalizeRestrictedAddress(address)))
+}
+
+// GetAllRestrictedAddresses returns all the addresses of the restricted address list in normalized form
+func (k Keeper) GetAllRestrictedAddresses(ctx sdk.Context) (list []string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RestrictedAddressKey))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		list = append(list, string(iterator.Key()))
+	}
+	return
+}
@@ -0,0 +1,42 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/0xPellNetwork/aegis/testutil/keeper"
+	"github.com/0xPellNetwork/aegis/testutil/sample"
+)
+
+func TestKeeper_RestrictedAddress(t *testing.T) {
+	t.Run("should add and remove restricted addresses", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeper(t)
+		address := sample.EthAddress().Hex()
+		require.False(t, k.IsRestrictedAddress(ctx, address))
+
+		k.SetRestrictedAddress(ctx, address)
+		require.True(t, k.IsRestrictedAddress(ctx, address))
+		require.True(t, k.IsRestrictedAddress(ctx, strings.ToLower(address)))
+
+		k.RemoveRestrictedAddress(ctx, strings.ToUpper(address[2:]))
+		require.True(t, k.IsRestrictedAddress(ctx, address))
+
+		k.RemoveRestrictedAddress(ctx, strings.ToLower(address))
+		require.False(t, k.IsRestrictedAddress(ctx, address))
+	})
+
+	t.Run("should get all restricted addresses in normalized form", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeper(t)
+		require.Empty(t, k.GetAllRestrictedAddresses(ctx))
+
+		address1 := sample.EthAddress().Hex()
+		address2 := sample.EthAddress().Hex()
+		k.SetRestrictedAddress(ctx, address1)
+		k.SetRestrictedAddress(ctx, address2)
+		k.SetRestrictedAddress(ctx, address2)
+
+		require.ElementsMatch(t, []string{strings.ToLower(address1), strings.ToLower(address2)}, k.GetAllRestrictedAddresses(ctx))
+	})
+}
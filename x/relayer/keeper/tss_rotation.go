@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/0xPellNetwork/aegis/x/relayer/types"
+)
+
+// SetTssRotation sets the progress of the current or last tss rotation
+func (k Keeper) SetTssRotation(ctx sdk.Context, rotation types.TssRotation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TssRotationKey))
+	b := k.cdc.MustMarshal(&rotation)
+	store.Set([]byte{0}, b)
+}
+
+// GetTssRotation returns the progress of the current or last tss rotation
+func (k Keeper) GetTssRotation(ctx sdk.Context) (val types.TssRotation, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TssRotationKey))
+	b := store.Get([]byte{0})
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// IsTssRotationInProgress returns true if a tss rotation has not reached a final stage
+func (k Keeper) IsTssRotationInProgress(ctx sdk.Context) bool {
+	rotation, found := k.GetTssRotation(ctx)
+	return found && rotation.IsInProgress()
+}
+
+// SetTssRotationStage moves the rotation to the given stage and stores it
+func (k Keeper) SetTssRotationStage(
+	ctx sdk.Context,
+	rotation types.TssRotation,
+	stage types.TssRotationStage,
+	message string,
+) types.TssRotation {
+	rotation.SetStage(stage, ctx.BlockHeight(), message)
+	k.SetTssRotation(ctx, rotation)
+
+	EmitEventTssRotationUpdated(ctx, rotation)
+	k.Logger(ctx).Info("tss rotation updated",
+		"stage", rotation.Stage.String(),
+		"old_tss_pubkey", rotation.OldTssPubkey,
+		"new_tss_pubkey", rotation.NewTssPubkey,
+		"message", message,
+	)
+	return rotation
+}
+
+// ScheduleKeygen schedules a keygen at the given block with the active node accounts
+// and sets the keygen status to pending
+func (k Keeper) ScheduleKeygen(ctx sdk.Context, block int64) (types.Keygen, error) {
+	keygen, found := k.GetKeygen(ctx)
+	if !found {
+		return keygen, types.ErrKeygenNotFound
+	}
+	if block <= (ctx.BlockHeight() + 10) {
+		return keygen, types.ErrKeygenBlockTooLow
+	}
+
+	// jailed observers are excluded from the TSS party
+	nodeAccountList := k.GetAllActiveNodeAccount(ctx)
+	granteePubKeys := make([]string, len(nodeAccountList))
+	for i, nodeAccount := range nodeAccountList {
+		granteePubKeys[i] = nodeAccount.GranteePubkey.Secp256k1.String()
+	}
+
+	// update keygen
+	keygen.GranteePubkeys = granteePubKeys
+	keygen.BlockNumber = block
+	keygen.Status = types.KeygenStatus_PENDING
+	k.SetKeygen(ctx, keygen)
+
+	EmitEventKeyGenBlockUpdated(ctx, &keygen)
+
+	return keygen, nil
+}
@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/pkg/chains"
+	keepertest "github.com/0xPellNetwork/aegis/testutil/keeper"
+	"github.com/0xPellNetwork/aegis/testutil/sample"
+	"github.com/0xPellNetwork/aegis/x/relayer/keeper"
+	"github.com/0xPellNetwork/aegis/x/relayer/types"
+)
+
+// setTssRotationChain sets sepolia as the only supported foreign chain and returns its migration
+func setTssRotationChain(ctx sdk.Context, k keeper.Keeper) types.TssRotationMigration {
+	chainID := chains.SepoliaChain().Id
+	k.SetChainParamsList(ctx, types.ChainParamsList{ChainParams: []*types.ChainParams{
+		{ChainId: chainID, IsSupported: true, PellTokenContractAddress: sample.EthAddress().Hex()},
+	}})
+	return types.TssRotationMigration{ChainId: chainID, Amount: sdkmath.NewUint(1000), PellAmount: sdkmath.NewUint(500)}
+}
+
+func TestKeeper_GetTssRotation(t *testing.T) {
+	k, ctx, _, _ := keepertest.RelayerKeeper(t)
+
+	_, found := k.GetTssRotation(ctx)
+	require.False(t, found)
+	require.False(t, k.IsTssRotationInProgress(ctx))
+
+	rotation := types.TssRotation{
+		OldTssPubkey: sample.Tss_pell().TssPubkey,
+		KeygenBlock:  100,
+		Migrations:   []types.TssRotationMigration{{ChainId: 1, Amount: sdkmath.NewUint(10), PellAmount: sdkmath.NewUint(5)}},
+	}
+	k.SetTssRotation(ctx, rotation)
+
+	got, found := k.GetTssRotation(ctx)
+	require.True(t, found)
+	require.Equal(t, rotation, got)
+	require.True(t, k.IsTssRotationInProgress(ctx))
+}
+
+func TestKeeper_SetTssRotationStage(t *testing.T) {
+	k, ctx, _, _ := keepertest.RelayerKeeper(t)
+
+	rotation := k.SetTssRotationStage(ctx, types.TssRotation{}, types.TssRotationStage_TSS_ROTATION_COMPLETED, "done")
+	require.Equal(t, types.TssRotationStage_TSS_ROTATION_COMPLETED, rotation.Stage)
+	require.Equal(t, ctx.BlockHeight(), rotation.StageHeight)
+
+	got, found := k.GetTssRotation(ctx)
+	require.True(t, found)
+	require.Equal(t, rotation, got)
+	require.False(t, k.IsTssRotationInProgress(ctx))
+}
+
+func TestKeeper_ScheduleKeygen(t *testing.T) {
+	t.Run("should fail if keygen not found", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeper(t)
+		_, err := k.ScheduleKeygen(ctx, ctx.BlockHeight()+100)
+		require.ErrorIs(t, err, types.ErrKeygenNotFound)
+	})
+
+	t.Run("should fail if block too low", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeper(t)
+		k.SetKeygen(ctx, types.Keygen{})
+		_, err := k.ScheduleKeygen(ctx, ctx.BlockHeight()+10)
+		require.ErrorIs(t, err, types.ErrKeygenBlockTooLow)
+	})
+
+	t.Run("should schedule keygen with active node accounts", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeper(t)
+		k.SetKeygen(ctx, types.Keygen{Status: types.KeygenStatus_SUCCESS})
+		nodeAccount := sample.NodeAccount_pell()
+		k.SetNodeAccount(ctx, *nodeAccount)
+
+		keygen, err := k.ScheduleKeygen(ctx, ctx.BlockHeight()+100)
+		require.NoError(t, err)
+		require.Equal(t, types.KeygenStatus_PENDING, keygen.Status)
+		require.Equal(t, ctx.BlockHeight()+100, keygen.BlockNumber)
+		require.Equal(t, []string{nodeAccount.GranteePubkey.Secp256k1.String()}, keygen.GranteePubkeys)
+
+		stored, _ := k.GetKeygen(ctx)
+		require.Equal(t, keygen, stored)
+	})
+}
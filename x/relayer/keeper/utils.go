@@ -33,13 +33,16 @@ func (k Keeper) CheckIfFinalizingVote(ctx sdk.Context, ballot types.Ballot) (typ
 }
 
 // IsNonTombstonedObserver checks whether a signer is authorized to sign
-// This function checks if the signer is present in the observer set
-// and also checks if the signer is not tombstoned
+// This function checks if the signer is present in the observer set,
+// is not jailed for blames or missed ballots and is not tombstoned
 func (k Keeper) IsNonTombstonedObserver(ctx sdk.Context, address string) bool {
 	isPresentInMapper := k.IsAddressPartOfObserverSet(ctx, address)
 	if !isPresentInMapper {
 		return false
 	}
+	if k.IsObserverJailed(ctx, address) {
+		return false
+	}
 	isTombstoned, err := k.IsOperatorTombstoned(ctx, address)
 	if err != nil || isTombstoned {
 		return false
@@ -65,11 +68,19 @@ func (k Keeper) FindBallot(
 			return types.Ballot{}, false, types.ErrSupportedChains
 		}
 
+		// jailed observers can't vote
+		voterList := make([]string, 0, len(observerSet.RelayerList))
+		for _, observer := range observerSet.RelayerList {
+			if !k.IsObserverJailed(ctx, observer) {
+				voterList = append(voterList, observer)
+			}
+		}
+
 		ballot = types.Ballot{
 			Index:                "",
 			BallotIdentifier:     index,
-			VoterList:            observerSet.RelayerList,
-			Votes:                types.CreateVotes(len(observerSet.RelayerList)),
+			VoterList:            voterList,
+			Votes:                types.CreateVotes(len(voterList)),
 			ObservationType:      observationType,
 			BallotThreshold:      cp.BallotThreshold,
 			BallotStatus:         types.BallotStatus_BALLOT_IN_PROGRESS,
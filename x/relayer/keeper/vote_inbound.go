@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 
+	cosmoserrors "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/0xPellNetwork/aegis/pkg/coin"
@@ -36,6 +37,9 @@ func (k Keeper) VoteOnInboundBallot(
 			types.ObservationType_IN_BOUND_TX.String()), types.ErrSupportedChains.Error(),
 		)
 	}
+	if k.IsChainInboundPaused(ctx, senderChainID) {
+		return false, false, cosmoserrors.Wrapf(types.ErrChainInboundPaused, "chain id %d", senderChainID)
+	}
 
 	// checks the voter is authorized to vote on the observation chain
 	if ok := k.IsNonTombstonedObserver(ctx, voter); !ok {
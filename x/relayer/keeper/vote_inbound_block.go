@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 
+	cosmoserrors "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/0xPellNetwork/aegis/x/relayer/types"
@@ -33,6 +34,9 @@ func (k Keeper) VoteOnInboundBlockBallot(
 			types.ObservationType_IN_BOUND_BLOCK.String(), types.ErrSupportedChains.Error(),
 		)
 	}
+	if k.IsChainInboundPaused(ctx, chainId) {
+		return false, false, cosmoserrors.Wrapf(types.ErrChainInboundPaused, "chain id %d", chainId)
+	}
 
 	// checks the voter is authorized to vote on the observation chain
 	if ok := k.IsNonTombstonedObserver(ctx, voter); !ok {
@@ -79,6 +79,29 @@ func TestKeeper_VoteOnInboundBallot(t *testing.T) {
 		require.ErrorContains(t, err, types.ErrSupportedChains.Error())
 	})
 
+	t.Run("fail if inbound is paused for the sender chain", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.RelayerKeeper(t)
+
+		k.SetCrosschainFlags(ctx, types.CrosschainFlags{
+			IsInboundEnabled: true,
+			ChainPauses: []types.ChainPause{
+				{ChainId: getValidEthChainIDWithIndex(t, 0), IsInboundPaused: true},
+			},
+		})
+		setSupportedChain(ctx, *k, getValidEthChainIDWithIndex(t, 0))
+
+		_, _, err := k.VoteOnInboundBallot(
+			ctx,
+			getValidEthChainIDWithIndex(t, 0),
+			chains.PellPrivnetChain().Id,
+			coin.CoinType_ERC20,
+			sample.AccAddress(),
+			"index",
+			"inTxHash",
+		)
+		require.ErrorIs(t, err, types.ErrChainInboundPaused)
+	})
+
 	t.Run("fail if not authorized", func(t *testing.T) {
 		k, ctx, _, _ := keepertest.RelayerKeeper(t)
 
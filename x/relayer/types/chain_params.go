@@ -22,26 +22,24 @@ var (
 	DefaultBallotThreshold       = math.LegacyMustNewDecFromStr("0.66")
 )
 
-// Validate checks all chain params correspond to a chain and there is no duplicate chain id
-func (cpl ChainParamsList) Validate() error {
-	// check all chain params correspond to a chain
-	chainMap := make(map[int64]struct{})
+// Validate checks all chain params correspond to a chain of the chain list and there is no duplicate chain id
+func (cpl ChainParamsList) Validate(chainList []chains.Chain) error {
 	existingChainMap := make(map[int64]struct{})
 
-	externalChainList := chains.ChainsList()
-	for _, chain := range externalChainList {
-		chainMap[chain.Id] = struct{}{}
-	}
-
 	// validate the chain params and check for duplicates
 	for _, chainParam := range cpl.ChainParams {
-		if err := ValidateChainParams(chainParam); err != nil {
-			return err
+		if chainParam == nil {
+			return fmt.Errorf("chain params cannot be nil")
 		}
 
-		if _, ok := chainMap[chainParam.ChainId]; !ok {
+		chain, ok := chains.GetChainFromList(chainList, chainParam.ChainId)
+		if !ok {
 			return fmt.Errorf("chain id %d not found in chain list", chainParam.ChainId)
 		}
+		if err := ValidateChainParamsForChain(chainParam, chain); err != nil {
+			return err
+		}
+
 		if _, ok := existingChainMap[chainParam.ChainId]; ok {
 			return fmt.Errorf("duplicated chain id %d found", chainParam.ChainId)
 		}
@@ -50,17 +48,34 @@ func (cpl ChainParamsList) Validate() error {
 	return nil
 }
 
+// ValidateChainParamsForChain checks the chain params against the chain from the chain registry they are set for
+func ValidateChainParamsForChain(params *ChainParams, chain chains.Chain) error {
+	if err := ValidateChainParams(params); err != nil {
+		return err
+	}
+	if params.ChainId != chain.Id {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "chain params for chain %d set for chain %d", params.ChainId, chain.Id)
+	}
+
+	if chain.IsEVMChain() && !chain.IsPellChain() {
+		if params.DelegationManagerContractAddress == "" && params.ConnectorContractAddress == "" {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid both stake layer contracts and service layer contracts")
+		}
+	}
+	return nil
+}
+
 // ValidateChainParams performs some basic checks on chain params
+// checks depending on the chain from the chain registry are performed by ValidateChainParamsForChain
 func ValidateChainParams(params *ChainParams) error {
 	if params == nil {
 		return fmt.Errorf("chain params cannot be nil")
 	}
-	chain, exist := chains.GetChainByChainId(params.ChainId)
-	if !exist {
+	if params.ChainId <= 0 {
 		return fmt.Errorf("ChainId %d not supported", params.ChainId)
 	}
 	// pell chain skips the rest of the checks for now
-	if chain.IsPellChain() {
+	if chains.IsPellChain(params.ChainId) {
 		return nil
 	}
 
@@ -83,29 +98,22 @@ func ValidateChainParams(params *ChainParams) error {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "OutboundTxScheduleLookahead %d out of range", params.OutboundTxScheduleLookahead)
 	}
 
-	if chains.IsEVMChain(params.ChainId) {
-		if params.DelegationManagerContractAddress == "" && params.ConnectorContractAddress == "" {
-			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid both stake layer contracts and service layer contracts")
+	if params.DelegationManagerContractAddress != "" {
+		if !validChainContractAddress(params.StrategyManagerContractAddress) {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid StrategyManagerContractAddress %s", params.StrategyManagerContractAddress)
 		}
-
-		if params.DelegationManagerContractAddress != "" {
-			if !validChainContractAddress(params.StrategyManagerContractAddress) {
-				return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid StrategyManagerContractAddress %s", params.StrategyManagerContractAddress)
-			}
-			if !validChainContractAddress(params.DelegationManagerContractAddress) {
-				return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid DelegationManagerContractAddress %s", params.DelegationManagerContractAddress)
-			}
+		if !validChainContractAddress(params.DelegationManagerContractAddress) {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid DelegationManagerContractAddress %s", params.DelegationManagerContractAddress)
 		}
+	}
 
-		if params.ConnectorContractAddress != "" {
-			if !validChainContractAddress(params.ConnectorContractAddress) {
-				return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid ConnectorContractAddress %s", params.ConnectorContractAddress)
-			}
-			if !validChainContractAddress(params.OmniOperatorSharesManagerContractAddress) {
-				return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid OmniOperatorSharesManagerContractAddress %s", params.OmniOperatorSharesManagerContractAddress)
-			}
+	if params.ConnectorContractAddress != "" {
+		if !validChainContractAddress(params.ConnectorContractAddress) {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid ConnectorContractAddress %s", params.ConnectorContractAddress)
+		}
+		if !validChainContractAddress(params.OmniOperatorSharesManagerContractAddress) {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid OmniOperatorSharesManagerContractAddress %s", params.OmniOperatorSharesManagerContractAddress)
 		}
-
 	}
 
 	if params.BallotThreshold.IsNil() || params.BallotThreshold.GT(sdkmath.LegacyOneDec()) {
@@ -116,9 +124,27 @@ func ValidateChainParams(params *ChainParams) error {
 		return ErrParamsMinObserverDelegation
 	}
 
+	if !params.GasTokenPellRate.IsNil() && params.GasTokenPellRate.IsNegative() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "GasTokenPellRate %s is negative", params.GasTokenPellRate)
+	}
+
 	return nil
 }
 
+// HasGasTokenPellRate returns true if a rate converting the gas token of the chain to apell is set
+func (m ChainParams) HasGasTokenPellRate() bool {
+	return !m.GasTokenPellRate.IsNil() && m.GasTokenPellRate.IsPositive()
+}
+
+// GasTokenToApell converts an amount in the smallest unit of the gas token of the chain to apell
+// returns false if no gas token pell rate is set for the chain
+func (m ChainParams) GasTokenToApell(amount sdkmath.Int) (sdkmath.Int, bool) {
+	if !m.HasGasTokenPellRate() {
+		return sdkmath.ZeroInt(), false
+	}
+	return m.GasTokenPellRate.MulInt(amount).TruncateInt(), true
+}
+
 func validChainContractAddress(address string) bool {
 	if !strings.HasPrefix(address, "0x") {
 		return false
@@ -9,30 +9,80 @@ import (
 	"github.com/stretchr/testify/suite"
 	. "gopkg.in/check.v1"
 
+	"github.com/0xPellNetwork/aegis/pkg/chains"
 	"github.com/0xPellNetwork/aegis/x/relayer/types"
 )
 
 func TestChainParamsList_Validate(t *testing.T) {
 	t.Run("should return no error for default list", func(t *testing.T) {
 		list := types.GetDefaultChainParams()
-		err := list.Validate()
+		err := list.Validate(chains.ChainsList())
 		require.NoError(t, err)
 	})
 
 	t.Run("should return error for invalid chain id", func(t *testing.T) {
 		list := types.GetDefaultChainParams()
 		list.ChainParams[0].ChainId = 999
-		err := list.Validate()
+		err := list.Validate(chains.ChainsList())
 		require.Error(t, err)
 	})
 
 	t.Run("should return error for duplicated chain ID", func(t *testing.T) {
 		list := types.GetDefaultChainParams()
 		list.ChainParams = append(list.ChainParams, list.ChainParams[0])
-		err := list.Validate()
+		err := list.Validate(chains.ChainsList())
 		require.Error(t, err)
 		require.Contains(t, err.Error(), "duplicated chain id")
 	})
+
+	t.Run("should return no error for chain added to the chain list", func(t *testing.T) {
+		newChain := chains.Chain{
+			Id:          1000,
+			Network:     chains.NetWork_ETH,
+			NetworkType: chains.NetWorkType_TESTNET,
+			VmType:      chains.VMType_EVM,
+		}
+		list := types.GetDefaultChainParams()
+		list.ChainParams[0].ChainId = newChain.Id
+		err := list.Validate(append(chains.ChainsList(), newChain))
+		require.NoError(t, err)
+	})
+}
+
+func TestValidateChainParamsForChain(t *testing.T) {
+	t.Run("should return no error for valid chain params", func(t *testing.T) {
+		params := types.GetDefaultGoerliLocalnetChainParams()
+		err := types.ValidateChainParamsForChain(params, chains.GoerliLocalnetChain())
+		require.NoError(t, err)
+	})
+
+	t.Run("should return error if chain id mismatch", func(t *testing.T) {
+		params := types.GetDefaultGoerliLocalnetChainParams()
+		err := types.ValidateChainParamsForChain(params, chains.SepoliaChain())
+		require.Error(t, err)
+	})
+
+	t.Run("should return error if evm chain has no contracts", func(t *testing.T) {
+		params := types.GetDefaultGoerliLocalnetChainParams()
+		params.DelegationManagerContractAddress = ""
+		params.ConnectorContractAddress = ""
+		err := types.ValidateChainParamsForChain(params, chains.GoerliLocalnetChain())
+		require.Error(t, err)
+	})
+
+	t.Run("should return no error if non evm chain has no contracts", func(t *testing.T) {
+		params := types.GetDefaultGoerliLocalnetChainParams()
+		params.ChainId = 18332
+		params.DelegationManagerContractAddress = ""
+		params.ConnectorContractAddress = ""
+		err := types.ValidateChainParamsForChain(params, chains.Chain{
+			Id:          18332,
+			Network:     chains.NetWork_BTC,
+			NetworkType: chains.NetWorkType_TESTNET,
+			VmType:      chains.VMType_NO_VM,
+		})
+		require.NoError(t, err)
+	})
 }
 
 type UpdateChainParamsSuite struct {
@@ -53,6 +103,23 @@ func TestChainParamsEqual(t *testing.T) {
 	require.False(t, cmp.Equal(*params.ChainParams[0], *params.ChainParams[1]))
 }
 
+func TestChainParams_GasTokenToApell(t *testing.T) {
+	t.Run("should not convert without rate", func(t *testing.T) {
+		_, ok := types.ChainParams{}.GasTokenToApell(sdkmath.NewInt(100))
+		require.False(t, ok)
+
+		_, ok = types.ChainParams{GasTokenPellRate: sdkmath.LegacyZeroDec()}.GasTokenToApell(sdkmath.NewInt(100))
+		require.False(t, ok)
+	})
+
+	t.Run("should convert with the rate and truncate", func(t *testing.T) {
+		params := types.ChainParams{GasTokenPellRate: sdkmath.LegacyMustNewDecFromStr("2.5")}
+		apell, ok := params.GasTokenToApell(sdkmath.NewInt(3))
+		require.True(t, ok)
+		require.Equal(t, sdkmath.NewInt(7), apell)
+	})
+}
+
 func (s *UpdateChainParamsSuite) SetupTest() {
 	s.evmParams = &types.ChainParams{
 		ConfirmationCount:                        1,
@@ -142,6 +209,17 @@ func (s *UpdateChainParamsSuite) TestCoreContractAddresses() {
 	require.NotNil(s.T(), err)
 }
 
+func (s *UpdateChainParamsSuite) TestGasTokenPellRate() {
+	copy := *s.btcParams
+	copy.GasTokenPellRate = sdkmath.LegacyNewDec(1e16)
+	err := types.ValidateChainParams(&copy)
+	require.Nil(s.T(), err)
+
+	copy.GasTokenPellRate = sdkmath.LegacyNewDec(-1)
+	err = types.ValidateChainParams(&copy)
+	require.NotNil(s.T(), err)
+}
+
 func (s *UpdateChainParamsSuite) Validate(params *types.ChainParams) {
 	copy := *params
 	copy.ConfirmationCount = 0
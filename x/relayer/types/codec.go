@@ -18,6 +18,14 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateObserver{}, "relayer/UpdateObserver", nil)
 	cdc.RegisterConcrete(&MsgResetChainNonces{}, "relayer/ResetChainNonces", nil)
 	cdc.RegisterConcrete(&MsgVoteTSS{}, "relayer/VoteTSS", nil)
+	cdc.RegisterConcrete(&MsgAddChain{}, "relayer/AddChain", nil)
+	cdc.RegisterConcrete(&MsgUpdateChain{}, "relayer/UpdateChain", nil)
+	cdc.RegisterConcrete(&MsgDeprecateChain{}, "relayer/DeprecateChain", nil)
+	cdc.RegisterConcrete(&MsgUpdateRestrictedAddresses{}, "relayer/UpdateRestrictedAddresses", nil)
+	cdc.RegisterConcrete(&MsgUnjailObserver{}, "relayer/UnjailObserver", nil)
+	cdc.RegisterConcrete(&MsgUpdateChainPause{}, "relayer/UpdateChainPause", nil)
+	cdc.RegisterConcrete(&MsgStartTssRotation{}, "relayer/StartTssRotation", nil)
+	cdc.RegisterConcrete(&MsgCancelTssRotation{}, "relayer/CancelTssRotation", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -32,6 +40,14 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgUpdateObserver{},
 		&MsgResetChainNonces{},
 		&MsgVoteTSS{},
+		&MsgAddChain{},
+		&MsgUpdateChain{},
+		&MsgDeprecateChain{},
+		&MsgUpdateRestrictedAddresses{},
+		&MsgUnjailObserver{},
+		&MsgUpdateChainPause{},
+		&MsgStartTssRotation{},
+		&MsgCancelTssRotation{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
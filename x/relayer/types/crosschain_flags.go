@@ -1,6 +1,10 @@
 package types
 
-import "time"
+import (
+	"fmt"
+	"sort"
+	"time"
+)
 
 var DefaultGasPriceIncreaseFlags = GasPriceIncreaseFlags{
 	// EpochLength is the number of blocks in an epoch before triggering a gas price increase
@@ -39,3 +43,125 @@ func DefaultCrosschainFlags() *CrosschainFlags {
 		BlockHeaderVerificationFlags: &DefaultBlockHeaderVerificationFlags,
 	}
 }
+
+// IsPaused returns true if inbound, outbound or signing is paused for the chain
+func (m ChainPause) IsPaused() bool {
+	return m.IsInboundPaused || m.IsOutboundPaused || m.IsSigningPaused
+}
+
+// IsExpired returns true if the pause has expired at the given height
+func (m ChainPause) IsExpired(height int64) bool {
+	return m.ExpiryHeight > 0 && height >= m.ExpiryHeight
+}
+
+// IsLiftedBy returns true if the next pause resumes anything paused by the current pause
+// or makes the pause expire earlier
+func (m ChainPause) IsLiftedBy(next ChainPause) bool {
+	if (m.IsInboundPaused && !next.IsInboundPaused) ||
+		(m.IsOutboundPaused && !next.IsOutboundPaused) ||
+		(m.IsSigningPaused && !next.IsSigningPaused) {
+		return true
+	}
+	if !m.IsPaused() || next.ExpiryHeight == 0 {
+		return false
+	}
+	return m.ExpiryHeight == 0 || next.ExpiryHeight < m.ExpiryHeight
+}
+
+// IsExtendedBy returns true if the next pause pauses anything not paused by the current pause
+// or makes the pause last longer
+func (m ChainPause) IsExtendedBy(next ChainPause) bool {
+	if (!m.IsInboundPaused && next.IsInboundPaused) ||
+		(!m.IsOutboundPaused && next.IsOutboundPaused) ||
+		(!m.IsSigningPaused && next.IsSigningPaused) {
+		return true
+	}
+	if !m.IsPaused() || !next.IsPaused() || m.ExpiryHeight == 0 {
+		return false
+	}
+	return next.ExpiryHeight == 0 || next.ExpiryHeight > m.ExpiryHeight
+}
+
+// Validate checks the chain pause is valid
+func (m ChainPause) Validate() error {
+	if m.ChainId <= 0 {
+		return fmt.Errorf("invalid chain id %d", m.ChainId)
+	}
+	if m.ExpiryHeight < 0 {
+		return fmt.Errorf("invalid expiry height %d for chain %d", m.ExpiryHeight, m.ChainId)
+	}
+	return nil
+}
+
+// ValidateChainPauses checks the chain pauses are valid and defined once per chain
+func (m CrosschainFlags) ValidateChainPauses() error {
+	chainIDs := make(map[int64]bool)
+	for _, pause := range m.ChainPauses {
+		if err := pause.Validate(); err != nil {
+			return err
+		}
+		if chainIDs[pause.ChainId] {
+			return fmt.Errorf("duplicated chain pause for chain %d", pause.ChainId)
+		}
+		chainIDs[pause.ChainId] = true
+	}
+	return nil
+}
+
+// GetChainPause returns the pause of the chain
+func (m CrosschainFlags) GetChainPause(chainID int64) (ChainPause, bool) {
+	for _, pause := range m.ChainPauses {
+		if pause.ChainId == chainID {
+			return pause, true
+		}
+	}
+	return ChainPause{}, false
+}
+
+// SetChainPause sets the pause of the chain, the pause is removed if nothing is paused.
+// The chain pauses are kept sorted by chain id.
+func (m *CrosschainFlags) SetChainPause(pause ChainPause) {
+	var pauses []ChainPause
+	for _, p := range m.ChainPauses {
+		if p.ChainId != pause.ChainId {
+			pauses = append(pauses, p)
+		}
+	}
+	if pause.IsPaused() {
+		pauses = append(pauses, pause)
+	}
+	sort.SliceStable(pauses, func(i, j int) bool { return pauses[i].ChainId < pauses[j].ChainId })
+	m.ChainPauses = pauses
+}
+
+// RemoveExpiredChainPauses removes the chain pauses expired at the given height and returns them
+func (m *CrosschainFlags) RemoveExpiredChainPauses(height int64) (expired []ChainPause) {
+	var pauses []ChainPause
+	for _, pause := range m.ChainPauses {
+		if pause.IsExpired(height) {
+			expired = append(expired, pause)
+			continue
+		}
+		pauses = append(pauses, pause)
+	}
+	m.ChainPauses = pauses
+	return expired
+}
+
+// IsChainInboundPaused returns true if inbound is paused for the chain
+func (m CrosschainFlags) IsChainInboundPaused(chainID int64) bool {
+	pause, found := m.GetChainPause(chainID)
+	return found && pause.IsInboundPaused
+}
+
+// IsChainOutboundPaused returns true if outbound is paused for the chain
+func (m CrosschainFlags) IsChainOutboundPaused(chainID int64) bool {
+	pause, found := m.GetChainPause(chainID)
+	return found && pause.IsOutboundPaused
+}
+
+// IsChainSigningPaused returns true if signing is paused for the chain
+func (m CrosschainFlags) IsChainSigningPaused(chainID int64) bool {
+	pause, found := m.GetChainPause(chainID)
+	return found && pause.IsSigningPaused
+}
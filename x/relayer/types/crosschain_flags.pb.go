@@ -168,6 +168,8 @@ type CrosschainFlags struct {
 	GasPriceIncreaseFlags *GasPriceIncreaseFlags `protobuf:"bytes,3,opt,name=gas_price_increase_flags,json=gasPriceIncreaseFlags,proto3" json:"gas_price_increase_flags,omitempty"`
 	// Deprecated(v16): Use VerificationFlags in the lightclient module instead
 	BlockHeaderVerificationFlags *BlockHeaderVerificationFlags `protobuf:"bytes,4,opt,name=block_header_verification_flags,json=blockHeaderVerificationFlags,proto3" json:"block_header_verification_flags,omitempty"`
+	// per chain pauses of inbound, outbound and signing
+	ChainPauses []ChainPause `protobuf:"bytes,5,rep,name=chain_pauses,json=chainPauses,proto3" json:"chain_pauses"`
 }
 
 func (m *CrosschainFlags) Reset()         { *m = CrosschainFlags{} }
@@ -231,6 +233,91 @@ func (m *CrosschainFlags) GetBlockHeaderVerificationFlags() *BlockHeaderVerifica
 	return nil
 }
 
+func (m *CrosschainFlags) GetChainPauses() []ChainPause {
+	if m != nil {
+		return m.ChainPauses
+	}
+	return nil
+}
+
+// chain pause of inbound, outbound and signing for a single chain
+type ChainPause struct {
+	ChainId          int64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	IsInboundPaused  bool  `protobuf:"varint,2,opt,name=is_inbound_paused,json=isInboundPaused,proto3" json:"is_inbound_paused,omitempty"`
+	IsOutboundPaused bool  `protobuf:"varint,3,opt,name=is_outbound_paused,json=isOutboundPaused,proto3" json:"is_outbound_paused,omitempty"`
+	IsSigningPaused  bool  `protobuf:"varint,4,opt,name=is_signing_paused,json=isSigningPaused,proto3" json:"is_signing_paused,omitempty"`
+	// block height at which the pause expires, 0 if the pause never expires
+	ExpiryHeight int64 `protobuf:"varint,5,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+}
+
+func (m *ChainPause) Reset()         { *m = ChainPause{} }
+func (m *ChainPause) String() string { return proto.CompactTextString(m) }
+func (*ChainPause) ProtoMessage()    {}
+func (*ChainPause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80b68b65f8e2ee4e, []int{3}
+}
+func (m *ChainPause) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainPause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainPause.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainPause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainPause.Merge(m, src)
+}
+func (m *ChainPause) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainPause) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainPause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainPause proto.InternalMessageInfo
+
+func (m *ChainPause) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *ChainPause) GetIsInboundPaused() bool {
+	if m != nil {
+		return m.IsInboundPaused
+	}
+	return false
+}
+
+func (m *ChainPause) GetIsOutboundPaused() bool {
+	if m != nil {
+		return m.IsOutboundPaused
+	}
+	return false
+}
+
+func (m *ChainPause) GetIsSigningPaused() bool {
+	if m != nil {
+		return m.IsSigningPaused
+	}
+	return false
+}
+
+func (m *ChainPause) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
 // legacy crosschain flags
 type LegacyCrosschainFlags struct {
 	IsInboundEnabled      bool                   `protobuf:"varint,1,opt,name=is_inbound_enabled,json=isInboundEnabled,proto3" json:"is_inbound_enabled,omitempty"`
@@ -242,7 +329,7 @@ func (m *LegacyCrosschainFlags) Reset()         { *m = LegacyCrosschainFlags{} }
 func (m *LegacyCrosschainFlags) String() string { return proto.CompactTextString(m) }
 func (*LegacyCrosschainFlags) ProtoMessage()    {}
 func (*LegacyCrosschainFlags) Descriptor() ([]byte, []int) {
-	return fileDescriptor_80b68b65f8e2ee4e, []int{3}
+	return fileDescriptor_80b68b65f8e2ee4e, []int{4}
 }
 func (m *LegacyCrosschainFlags) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -296,48 +383,56 @@ func init() {
 	proto.RegisterType((*GasPriceIncreaseFlags)(nil), "relayer.GasPriceIncreaseFlags")
 	proto.RegisterType((*BlockHeaderVerificationFlags)(nil), "relayer.BlockHeaderVerificationFlags")
 	proto.RegisterType((*CrosschainFlags)(nil), "relayer.CrosschainFlags")
+	proto.RegisterType((*ChainPause)(nil), "relayer.ChainPause")
 	proto.RegisterType((*LegacyCrosschainFlags)(nil), "relayer.LegacyCrosschainFlags")
 }
 
 func init() { proto.RegisterFile("relayer/crosschain_flags.proto", fileDescriptor_80b68b65f8e2ee4e) }
 
 var fileDescriptor_80b68b65f8e2ee4e = []byte{
-	// 550 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x54, 0xc1, 0x6e, 0xd3, 0x4a,
-	0x14, 0x8d, 0xd3, 0xbe, 0x47, 0x35, 0xa5, 0x94, 0xba, 0x04, 0xdc, 0xaa, 0x72, 0x42, 0x24, 0xa4,
-	0x08, 0x21, 0x1b, 0xb5, 0x1b, 0x24, 0x76, 0x29, 0x85, 0x06, 0x15, 0x88, 0x2c, 0x04, 0x88, 0xcd,
-	0x68, 0x3c, 0xb9, 0x19, 0x8f, 0xea, 0x78, 0xac, 0x99, 0x49, 0x71, 0xfe, 0x02, 0xb1, 0xe2, 0x03,
-	0xf8, 0x06, 0xbe, 0xa1, 0x2b, 0xd4, 0x25, 0x2b, 0x40, 0xc9, 0x8f, 0x20, 0xcf, 0x38, 0x15, 0x44,
-	0x51, 0x3f, 0x80, 0x5d, 0x72, 0xcf, 0x3d, 0xf7, 0x1e, 0x9f, 0x7b, 0x6c, 0xe4, 0x4b, 0x48, 0xc9,
-	0x04, 0x64, 0x48, 0xa5, 0x50, 0x8a, 0x26, 0x84, 0x67, 0x78, 0x98, 0x12, 0xa6, 0x82, 0x5c, 0x0a,
-	0x2d, 0xdc, 0x6b, 0x15, 0xbe, 0x7b, 0x8b, 0x09, 0x26, 0x4c, 0x2d, 0x2c, 0x7f, 0x59, 0x78, 0xd7,
-	0x67, 0x42, 0xb0, 0x14, 0x42, 0xf3, 0x2f, 0x1e, 0x0f, 0xc3, 0xc1, 0x58, 0x12, 0xcd, 0x45, 0x66,
-	0xf1, 0xf6, 0x97, 0x3a, 0x6a, 0x3c, 0x23, 0xaa, 0x2f, 0x39, 0x85, 0x5e, 0x46, 0x25, 0x10, 0x05,
-	0x4f, 0xcb, 0xf1, 0xee, 0x5d, 0x74, 0x1d, 0x72, 0x41, 0x13, 0x9c, 0x42, 0xc6, 0x74, 0xe2, 0x39,
-	0x2d, 0xa7, 0xb3, 0x12, 0xad, 0x9b, 0xda, 0x89, 0x29, 0xb9, 0xcf, 0xd1, 0x0d, 0x09, 0x5a, 0x4e,
-	0x30, 0xcf, 0x34, 0xc8, 0x33, 0x92, 0x7a, 0xf5, 0x96, 0xd3, 0x59, 0xdf, 0xdf, 0x09, 0xec, 0xd6,
-	0x60, 0xbe, 0x35, 0x78, 0x52, 0x6d, 0xed, 0xae, 0x9d, 0xff, 0x68, 0xd6, 0x3e, 0xff, 0x6c, 0x3a,
-	0xd1, 0x86, 0xa1, 0xf6, 0x2a, 0xa6, 0xfb, 0x18, 0xed, 0x32, 0xa2, 0x70, 0x5e, 0x0a, 0xc1, 0xbc,
-	0x52, 0x82, 0x73, 0x90, 0x14, 0x32, 0xed, 0xad, 0xb4, 0x9c, 0xce, 0x46, 0x74, 0x87, 0x2d, 0x28,
-	0xed, 0x5b, 0xd8, 0x3d, 0x40, 0xb7, 0x97, 0x90, 0x47, 0xa4, 0xf0, 0x56, 0x0d, 0x71, 0x7b, 0x91,
-	0xf8, 0x82, 0x14, 0xee, 0x7d, 0xb4, 0x35, 0x22, 0x05, 0xce, 0x21, 0x1b, 0xf0, 0x8c, 0xe1, 0x62,
-	0xa4, 0x98, 0xf2, 0xfe, 0x33, 0xfd, 0x9b, 0x23, 0x52, 0xf4, 0x6d, 0xfd, 0x5d, 0x59, 0x6e, 0x7f,
-	0x72, 0xd0, 0x5e, 0x37, 0x15, 0xf4, 0xf4, 0x18, 0xc8, 0x00, 0xe4, 0x1b, 0x90, 0x7c, 0xc8, 0xa9,
-	0x79, 0x24, 0xeb, 0xd6, 0x23, 0xb4, 0xc3, 0x15, 0x06, 0x9d, 0x60, 0x3d, 0xc9, 0x01, 0xdb, 0x3b,
-	0x41, 0x46, 0xe2, 0x14, 0x06, 0xc6, 0xba, 0xb5, 0xa8, 0xc1, 0xd5, 0x91, 0x4e, 0x5e, 0x4f, 0x72,
-	0x38, 0x2c, 0xd1, 0x23, 0x0b, 0x56, 0xcc, 0x58, 0xd3, 0x65, 0xcc, 0xfa, 0x9c, 0xd9, 0xd5, 0x74,
-	0x91, 0xd9, 0xfe, 0x5a, 0x47, 0x9b, 0x87, 0x97, 0xa9, 0xb0, 0x3a, 0x1e, 0x20, 0x97, 0x2b, 0xcc,
-	0xb3, 0x58, 0x8c, 0xb3, 0xc1, 0x82, 0x80, 0x9b, 0x5c, 0xf5, 0x2c, 0x30, 0xdf, 0x1d, 0xa0, 0x6d,
-	0xae, 0xb0, 0x18, 0xeb, 0xbf, 0xdb, 0xed, 0xd6, 0x2d, 0xae, 0x5e, 0x55, 0xc8, 0xbc, 0xff, 0x2d,
-	0xf2, 0x96, 0xf8, 0x6c, 0xe2, 0x68, 0x4e, 0xb4, 0xbe, 0xef, 0x07, 0x55, 0x1e, 0x83, 0xa5, 0xa9,
-	0x8a, 0x1a, 0x6c, 0x69, 0xd8, 0x52, 0xd4, 0x8c, 0x4b, 0x7b, 0x71, 0x62, 0xfc, 0xc5, 0x67, 0x7f,
-	0x18, 0x5c, 0xcd, 0x5f, 0x35, 0xf3, 0xef, 0x5d, 0xce, 0xbf, 0xea, 0x1c, 0xd1, 0x5e, 0x7c, 0x05,
-	0xda, 0xfe, 0xe6, 0xa0, 0xc6, 0x09, 0x30, 0x42, 0x27, 0xff, 0x86, 0x7d, 0xdd, 0xe3, 0xf3, 0xa9,
-	0xef, 0x5c, 0x4c, 0x7d, 0xe7, 0xd7, 0xd4, 0x77, 0x3e, 0xce, 0xfc, 0xda, 0xc5, 0xcc, 0xaf, 0x7d,
-	0x9f, 0xf9, 0xb5, 0xf7, 0x01, 0xe3, 0x3a, 0x19, 0xc7, 0x01, 0x15, 0xa3, 0xf0, 0x61, 0xd1, 0x87,
-	0x34, 0x7d, 0x09, 0xfa, 0x83, 0x90, 0xa7, 0x21, 0x01, 0xc6, 0x55, 0x58, 0x84, 0xf3, 0xef, 0x4b,
-	0x99, 0x3e, 0x15, 0xff, 0x6f, 0x5e, 0xd9, 0x83, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xc6, 0x9c,
-	0x2f, 0xac, 0x77, 0x04, 0x00, 0x00,
+	// 660 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x54, 0x41, 0x6e, 0xd3, 0x40,
+	0x14, 0x8d, 0x93, 0x40, 0xab, 0x49, 0x4b, 0xa9, 0x4b, 0x20, 0xad, 0x2a, 0xb7, 0x04, 0x21, 0x45,
+	0x08, 0xd9, 0xa8, 0xdd, 0x20, 0xc1, 0x2a, 0xa5, 0xd0, 0xa0, 0x02, 0x91, 0x41, 0x80, 0xd8, 0x8c,
+	0xc6, 0xf6, 0xef, 0x78, 0x54, 0xc7, 0xb6, 0x66, 0xc6, 0xc5, 0xb9, 0x05, 0x62, 0xc5, 0x01, 0x38,
+	0x4c, 0x57, 0xa8, 0x62, 0xc5, 0x0a, 0x50, 0x7b, 0x05, 0x0e, 0x80, 0x3c, 0x63, 0x87, 0x36, 0x8a,
+	0x7a, 0x00, 0x76, 0xf1, 0xff, 0xff, 0xbd, 0xff, 0xf3, 0xde, 0xb3, 0x91, 0xc5, 0x21, 0x22, 0x63,
+	0xe0, 0x8e, 0xcf, 0x13, 0x21, 0xfc, 0x90, 0xb0, 0x18, 0x1f, 0x44, 0x84, 0x0a, 0x3b, 0xe5, 0x89,
+	0x4c, 0xcc, 0xb9, 0xb2, 0xbf, 0x76, 0x83, 0x26, 0x34, 0x51, 0x35, 0xa7, 0xf8, 0xa5, 0xdb, 0x6b,
+	0x16, 0x4d, 0x12, 0x1a, 0x81, 0xa3, 0x9e, 0xbc, 0xec, 0xc0, 0x09, 0x32, 0x4e, 0x24, 0x4b, 0x62,
+	0xdd, 0xef, 0x7e, 0xad, 0xa3, 0xf6, 0x33, 0x22, 0x86, 0x9c, 0xf9, 0x30, 0x88, 0x7d, 0x0e, 0x44,
+	0xc0, 0xd3, 0x82, 0xde, 0xbc, 0x8d, 0x16, 0x20, 0x4d, 0xfc, 0x10, 0x47, 0x10, 0x53, 0x19, 0x76,
+	0x8c, 0x4d, 0xa3, 0xd7, 0x70, 0x5b, 0xaa, 0xb6, 0xaf, 0x4a, 0xe6, 0x73, 0x74, 0x8d, 0x83, 0xe4,
+	0x63, 0xcc, 0x62, 0x09, 0xfc, 0x88, 0x44, 0x9d, 0xfa, 0xa6, 0xd1, 0x6b, 0x6d, 0xad, 0xda, 0x7a,
+	0xab, 0x5d, 0x6d, 0xb5, 0x9f, 0x94, 0x5b, 0xfb, 0xf3, 0xc7, 0x3f, 0x37, 0x6a, 0x5f, 0x7e, 0x6d,
+	0x18, 0xee, 0xa2, 0x82, 0x0e, 0x4a, 0xa4, 0xf9, 0x08, 0xad, 0x51, 0x22, 0x70, 0x5a, 0x1c, 0x82,
+	0x59, 0x79, 0x09, 0x4e, 0x81, 0xfb, 0x10, 0xcb, 0x4e, 0x63, 0xd3, 0xe8, 0x2d, 0xba, 0xb7, 0xe8,
+	0xd4, 0xa5, 0x43, 0xdd, 0x36, 0xb7, 0xd1, 0xcd, 0x19, 0xe0, 0x11, 0xc9, 0x3b, 0x4d, 0x05, 0x5c,
+	0x99, 0x06, 0xbe, 0x20, 0xb9, 0x79, 0x0f, 0x2d, 0x8f, 0x48, 0x8e, 0x53, 0x88, 0x03, 0x16, 0x53,
+	0x9c, 0x8f, 0x04, 0x15, 0x9d, 0x2b, 0x6a, 0x7e, 0x69, 0x44, 0xf2, 0xa1, 0xae, 0xbf, 0x2f, 0xca,
+	0xdd, 0xcf, 0x06, 0x5a, 0xef, 0x47, 0x89, 0x7f, 0xb8, 0x07, 0x24, 0x00, 0xfe, 0x16, 0x38, 0x3b,
+	0x60, 0xbe, 0xfa, 0x4b, 0x5a, 0xad, 0x87, 0x68, 0x95, 0x09, 0x0c, 0x32, 0xc4, 0x72, 0x9c, 0x02,
+	0xd6, 0x3e, 0x41, 0x4c, 0xbc, 0x08, 0x02, 0x25, 0xdd, 0xbc, 0xdb, 0x66, 0x62, 0x57, 0x86, 0x6f,
+	0xc6, 0x29, 0xec, 0x14, 0xdd, 0x5d, 0xdd, 0x2c, 0x91, 0x9e, 0xf4, 0x67, 0x21, 0xeb, 0x15, 0xb2,
+	0x2f, 0xfd, 0x69, 0x64, 0xf7, 0x4f, 0x1d, 0x2d, 0xed, 0x4c, 0x52, 0xa1, 0xef, 0xb8, 0x8f, 0x4c,
+	0x26, 0x30, 0x8b, 0xbd, 0x24, 0x8b, 0x83, 0xa9, 0x03, 0xae, 0x33, 0x31, 0xd0, 0x8d, 0x6a, 0xb7,
+	0x8d, 0x56, 0x98, 0xc0, 0x49, 0x26, 0x2f, 0x8e, 0xeb, 0xad, 0xcb, 0x4c, 0xbc, 0xca, 0xe4, 0x85,
+	0xf9, 0x77, 0xa8, 0x33, 0x43, 0x67, 0x15, 0x47, 0x65, 0x51, 0x6b, 0xcb, 0xb2, 0xcb, 0x3c, 0xda,
+	0x33, 0x53, 0xe5, 0xb6, 0xe9, 0xcc, 0xb0, 0x45, 0x68, 0xc3, 0x2b, 0xe4, 0xc5, 0xa1, 0xd2, 0x17,
+	0x1f, 0x9d, 0x13, 0xb8, 0xe4, 0x6f, 0x2a, 0xfe, 0xbb, 0x13, 0xfe, 0xcb, 0xec, 0x70, 0xd7, 0xbd,
+	0xcb, 0xcc, 0x7a, 0x8c, 0x16, 0xb4, 0xcc, 0x29, 0xc9, 0x04, 0x14, 0xa6, 0x37, 0x7a, 0xad, 0xad,
+	0x95, 0x09, 0xb5, 0x52, 0x79, 0x58, 0xf4, 0xfa, 0xcd, 0x22, 0xaf, 0x6e, 0xcb, 0x9f, 0x54, 0x44,
+	0xf7, 0xbb, 0x81, 0xd0, 0xbf, 0x09, 0x73, 0x15, 0xcd, 0x6b, 0x32, 0x16, 0x94, 0xef, 0xc8, 0x9c,
+	0x7a, 0x1e, 0x04, 0x45, 0xc2, 0xce, 0x99, 0xa1, 0x96, 0x55, 0xe2, 0x2e, 0x4d, 0xbc, 0x50, 0x2c,
+	0x41, 0x69, 0xdc, 0xc4, 0x8a, 0x72, 0xb8, 0x51, 0x19, 0x57, 0x39, 0x51, 0x4e, 0x6b, 0x66, 0xc1,
+	0x68, 0x5c, 0x44, 0xb7, 0x1c, 0x6e, 0x56, 0xcc, 0xaf, 0x75, 0xbd, 0x9c, 0xbd, 0x83, 0x16, 0x21,
+	0x4f, 0x19, 0x1f, 0xe3, 0x10, 0x18, 0x0d, 0xa5, 0xca, 0x78, 0xc3, 0x5d, 0xd0, 0xc5, 0x3d, 0x55,
+	0xeb, 0x7e, 0x33, 0x50, 0x7b, 0x1f, 0x28, 0xf1, 0xc7, 0xff, 0x47, 0xa2, 0xfa, 0x7b, 0xc7, 0xa7,
+	0x96, 0x71, 0x72, 0x6a, 0x19, 0xbf, 0x4f, 0x2d, 0xe3, 0xd3, 0x99, 0x55, 0x3b, 0x39, 0xb3, 0x6a,
+	0x3f, 0xce, 0xac, 0xda, 0x07, 0x9b, 0x32, 0x19, 0x66, 0x9e, 0xed, 0x27, 0x23, 0xe7, 0x41, 0x3e,
+	0x84, 0x28, 0x7a, 0x09, 0xf2, 0x63, 0xc2, 0x0f, 0x1d, 0x02, 0x94, 0x09, 0x27, 0x77, 0xaa, 0x4f,
+	0x6e, 0xf1, 0x42, 0x0a, 0xef, 0xaa, 0xfa, 0x8a, 0x6d, 0xff, 0x1d, 0x00, 0x51, 0xb7, 0xf3, 0x86,
+	0x8a, 0x05, 0x00, 0x00,
 }
 
 func (m *GasPriceIncreaseFlags) Marshal() (dAtA []byte, err error) {
@@ -454,6 +549,20 @@ func (m *CrosschainFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ChainPauses) > 0 {
+		for iNdEx := len(m.ChainPauses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainPauses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCrosschainFlags(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.BlockHeaderVerificationFlags != nil {
 		{
 			size, err := m.BlockHeaderVerificationFlags.MarshalToSizedBuffer(dAtA[:i])
@@ -501,6 +610,69 @@ func (m *CrosschainFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ChainPause) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainPause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainPause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintCrosschainFlags(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.IsSigningPaused {
+		i--
+		if m.IsSigningPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.IsOutboundPaused {
+		i--
+		if m.IsOutboundPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.IsInboundPaused {
+		i--
+		if m.IsInboundPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintCrosschainFlags(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *LegacyCrosschainFlags) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -625,6 +797,36 @@ func (m *CrosschainFlags) Size() (n int) {
 		l = m.BlockHeaderVerificationFlags.Size()
 		n += 1 + l + sovCrosschainFlags(uint64(l))
 	}
+	if len(m.ChainPauses) > 0 {
+		for _, e := range m.ChainPauses {
+			l = e.Size()
+			n += 1 + l + sovCrosschainFlags(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChainPause) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovCrosschainFlags(uint64(m.ChainId))
+	}
+	if m.IsInboundPaused {
+		n += 2
+	}
+	if m.IsOutboundPaused {
+		n += 2
+	}
+	if m.IsSigningPaused {
+		n += 2
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovCrosschainFlags(uint64(m.ExpiryHeight))
+	}
 	return n
 }
 
@@ -1043,6 +1245,188 @@ func (m *CrosschainFlags) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainPauses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschainFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCrosschainFlags
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrosschainFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainPauses = append(m.ChainPauses, ChainPause{})
+			if err := m.ChainPauses[len(m.ChainPauses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCrosschainFlags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCrosschainFlags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainPause) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCrosschainFlags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainPause: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainPause: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschainFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsInboundPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschainFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsInboundPaused = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsOutboundPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschainFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsOutboundPaused = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsSigningPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschainFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsSigningPaused = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschainFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCrosschainFlags(dAtA[iNdEx:])
@@ -18,3 +18,176 @@ func TestDefaultDefaultCrosschainFlags(t *testing.T) {
 		BlockHeaderVerificationFlags: &types.DefaultBlockHeaderVerificationFlags,
 	}, defaultCrosschainFlags)
 }
+
+func TestChainPause_IsExpired(t *testing.T) {
+	require.False(t, types.ChainPause{ChainId: 1, IsInboundPaused: true}.IsExpired(1000))
+	require.False(t, types.ChainPause{ChainId: 1, IsInboundPaused: true, ExpiryHeight: 100}.IsExpired(99))
+	require.True(t, types.ChainPause{ChainId: 1, IsInboundPaused: true, ExpiryHeight: 100}.IsExpired(100))
+}
+
+func TestChainPause_IsLiftedBy(t *testing.T) {
+	current := types.ChainPause{ChainId: 1, IsInboundPaused: true, IsSigningPaused: true, ExpiryHeight: 100}
+
+	tests := []struct {
+		name     string
+		current  types.ChainPause
+		next     types.ChainPause
+		expected bool
+	}{
+		{
+			name:     "pausing more is not lifting",
+			current:  current,
+			next:     types.ChainPause{ChainId: 1, IsInboundPaused: true, IsOutboundPaused: true, IsSigningPaused: true, ExpiryHeight: 100},
+			expected: false,
+		},
+		{
+			name:     "extending the expiry is not lifting",
+			current:  current,
+			next:     types.ChainPause{ChainId: 1, IsInboundPaused: true, IsSigningPaused: true},
+			expected: false,
+		},
+		{
+			name:     "resuming signing is lifting",
+			current:  current,
+			next:     types.ChainPause{ChainId: 1, IsInboundPaused: true, ExpiryHeight: 100},
+			expected: true,
+		},
+		{
+			name:     "shortening the expiry is lifting",
+			current:  current,
+			next:     types.ChainPause{ChainId: 1, IsInboundPaused: true, IsSigningPaused: true, ExpiryHeight: 99},
+			expected: true,
+		},
+		{
+			name:     "setting an expiry to a pause without expiry is lifting",
+			current:  types.ChainPause{ChainId: 1, IsInboundPaused: true},
+			next:     types.ChainPause{ChainId: 1, IsInboundPaused: true, ExpiryHeight: 100},
+			expected: true,
+		},
+		{
+			name:     "pausing a chain not paused is not lifting",
+			current:  types.ChainPause{ChainId: 1},
+			next:     types.ChainPause{ChainId: 1, IsInboundPaused: true, ExpiryHeight: 100},
+			expected: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, tt.current.IsLiftedBy(tt.next))
+		})
+	}
+}
+
+func TestChainPause_IsExtendedBy(t *testing.T) {
+	current := types.ChainPause{ChainId: 1, IsInboundPaused: true, IsSigningPaused: true, ExpiryHeight: 100}
+
+	tests := []struct {
+		name     string
+		current  types.ChainPause
+		next     types.ChainPause
+		expected bool
+	}{
+		{
+			name:     "pausing more is extending",
+			current:  current,
+			next:     types.ChainPause{ChainId: 1, IsInboundPaused: true, IsOutboundPaused: true, IsSigningPaused: true, ExpiryHeight: 100},
+			expected: true,
+		},
+		{
+			name:     "removing the expiry is extending",
+			current:  current,
+			next:     types.ChainPause{ChainId: 1, IsInboundPaused: true, IsSigningPaused: true},
+			expected: true,
+		},
+		{
+			name:     "postponing the expiry is extending",
+			current:  current,
+			next:     types.ChainPause{ChainId: 1, IsInboundPaused: true, IsSigningPaused: true, ExpiryHeight: 101},
+			expected: true,
+		},
+		{
+			name:     "resuming signing is not extending",
+			current:  current,
+			next:     types.ChainPause{ChainId: 1, IsInboundPaused: true, ExpiryHeight: 100},
+			expected: false,
+		},
+		{
+			name:     "resuming everything is not extending",
+			current:  current,
+			next:     types.ChainPause{ChainId: 1},
+			expected: false,
+		},
+		{
+			name:     "pausing a chain not paused is extending",
+			current:  types.ChainPause{ChainId: 1},
+			next:     types.ChainPause{ChainId: 1, IsInboundPaused: true, ExpiryHeight: 100},
+			expected: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, tt.current.IsExtendedBy(tt.next))
+		})
+	}
+}
+
+func TestCrosschainFlags_ChainPauses(t *testing.T) {
+	t.Run("should set, get and remove chain pauses", func(t *testing.T) {
+		flags := types.DefaultCrosschainFlags()
+		flags.SetChainPause(types.ChainPause{ChainId: 2, IsInboundPaused: true})
+		flags.SetChainPause(types.ChainPause{ChainId: 1, IsOutboundPaused: true})
+		flags.SetChainPause(types.ChainPause{ChainId: 3, IsSigningPaused: true})
+
+		// sorted by chain id
+		require.Len(t, flags.ChainPauses, 3)
+		require.EqualValues(t, 1, flags.ChainPauses[0].ChainId)
+		require.EqualValues(t, 2, flags.ChainPauses[1].ChainId)
+		require.EqualValues(t, 3, flags.ChainPauses[2].ChainId)
+
+		require.True(t, flags.IsChainInboundPaused(2))
+		require.False(t, flags.IsChainInboundPaused(1))
+		require.True(t, flags.IsChainOutboundPaused(1))
+		require.True(t, flags.IsChainSigningPaused(3))
+		require.False(t, flags.IsChainSigningPaused(4))
+
+		// update the pause of a chain
+		flags.SetChainPause(types.ChainPause{ChainId: 2, IsSigningPaused: true})
+		pause, found := flags.GetChainPause(2)
+		require.True(t, found)
+		require.False(t, pause.IsInboundPaused)
+		require.True(t, pause.IsSigningPaused)
+
+		// a pause with nothing paused is removed
+		flags.SetChainPause(types.ChainPause{ChainId: 2})
+		_, found = flags.GetChainPause(2)
+		require.False(t, found)
+		require.Len(t, flags.ChainPauses, 2)
+	})
+
+	t.Run("should remove expired chain pauses", func(t *testing.T) {
+		flags := types.DefaultCrosschainFlags()
+		flags.SetChainPause(types.ChainPause{ChainId: 1, IsInboundPaused: true, ExpiryHeight: 100})
+		flags.SetChainPause(types.ChainPause{ChainId: 2, IsInboundPaused: true, ExpiryHeight: 200})
+		flags.SetChainPause(types.ChainPause{ChainId: 3, IsInboundPaused: true})
+
+		require.Empty(t, flags.RemoveExpiredChainPauses(99))
+		expired := flags.RemoveExpiredChainPauses(100)
+		require.Len(t, expired, 1)
+		require.EqualValues(t, 1, expired[0].ChainId)
+		require.Len(t, flags.ChainPauses, 2)
+	})
+
+	t.Run("should validate chain pauses", func(t *testing.T) {
+		flags := types.DefaultCrosschainFlags()
+		require.NoError(t, flags.ValidateChainPauses())
+
+		flags.ChainPauses = []types.ChainPause{{ChainId: 0, IsInboundPaused: true}}
+		require.Error(t, flags.ValidateChainPauses())
+
+		flags.ChainPauses = []types.ChainPause{{ChainId: 1, IsInboundPaused: true, ExpiryHeight: -1}}
+		require.Error(t, flags.ValidateChainPauses())
+
+		flags.ChainPauses = []types.ChainPause{{ChainId: 1, IsInboundPaused: true}, {ChainId: 1}}
+		require.Error(t, flags.ValidateChainPauses())
+	})
+}
@@ -33,4 +33,21 @@ var (
 	ErrInboundDisabled      = errorsmod.Register(ModuleName, 1132, "inbound tx processing is disabled")
 	ErrInvalidPellCoinTypes = errorsmod.Register(ModuleName, 1133, "invalid pell coin types")
 	ErrNotObserver          = errorsmod.Register(ModuleName, 1134, "sender is not an observer")
+
+	ErrChainNotFound      = errorsmod.Register(ModuleName, 1135, "chain not found in chain registry")
+	ErrChainAlreadyExists = errorsmod.Register(ModuleName, 1136, "chain already exists in chain registry")
+	ErrChainDeprecated    = errorsmod.Register(ModuleName, 1137, "chain is deprecated")
+	ErrInvalidChain       = errorsmod.Register(ModuleName, 1138, "invalid chain")
+
+	ErrObserverJailed         = errorsmod.Register(ModuleName, 1139, "observer is jailed")
+	ErrObserverNotJailed      = errorsmod.Register(ModuleName, 1140, "observer is not jailed")
+	ErrJailDurationNotElapsed = errorsmod.Register(ModuleName, 1141, "jail duration not elapsed")
+	ErrInvalidRelayerPenalty  = errorsmod.Register(ModuleName, 1142, "invalid relayer penalty")
+
+	ErrInvalidChainPause  = errorsmod.Register(ModuleName, 1143, "invalid chain pause")
+	ErrChainInboundPaused = errorsmod.Register(ModuleName, 1144, "inbound is paused for the chain")
+
+	ErrInvalidTssRotation    = errorsmod.Register(ModuleName, 1145, "invalid tss rotation")
+	ErrTssRotationInProgress = errorsmod.Register(ModuleName, 1146, "tss rotation in progress")
+	ErrTssRotationNotFound   = errorsmod.Register(ModuleName, 1147, "tss rotation not found")
 )
@@ -330,432 +330,2447 @@ func (m *EventCrosschainFlagsUpdated) GetBlockHeaderVerificationFlags() *BlockHe
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*EventBallotCreated)(nil), "relayer.EventBallotCreated")
-	proto.RegisterType((*EventKeygenBlockUpdated)(nil), "relayer.EventKeygenBlockUpdated")
-	proto.RegisterType((*EventNewRelayerAdded)(nil), "relayer.EventNewRelayerAdded")
-	proto.RegisterType((*EventCrosschainFlagsUpdated)(nil), "relayer.EventCrosschainFlagsUpdated")
+// relayer jailed event
+type EventRelayerJailed struct {
+	ObserverAddress   string `protobuf:"bytes,1,opt,name=observer_address,json=observerAddress,proto3" json:"observer_address,omitempty"`
+	Reason            string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	BlameCount        uint64 `protobuf:"varint,3,opt,name=blame_count,json=blameCount,proto3" json:"blame_count,omitempty"`
+	MissedBallotCount uint64 `protobuf:"varint,4,opt,name=missed_ballot_count,json=missedBallotCount,proto3" json:"missed_ballot_count,omitempty"`
+	JailedUntilHeight int64  `protobuf:"varint,5,opt,name=jailed_until_height,json=jailedUntilHeight,proto3" json:"jailed_until_height,omitempty"`
 }
 
-func init() { proto.RegisterFile("relayer/events.proto", fileDescriptor_161b038dc7a5246f) }
-
-var fileDescriptor_161b038dc7a5246f = []byte{
-	// 617 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcf, 0x4f, 0x14, 0x31,
-	0x14, 0x66, 0x00, 0x57, 0x29, 0x28, 0x50, 0x11, 0x06, 0xd4, 0x01, 0x37, 0x21, 0xc1, 0x68, 0x76,
-	0x8d, 0x9e, 0x34, 0x5e, 0x60, 0x83, 0x40, 0x34, 0x48, 0x36, 0xa2, 0x89, 0x97, 0xa6, 0x33, 0xf3,
-	0x98, 0x6d, 0xb6, 0xb4, 0x93, 0xb6, 0x0b, 0xec, 0x1f, 0xe0, 0xdd, 0xa3, 0x7f, 0x92, 0x47, 0x8e,
-	0x1e, 0x0d, 0xfc, 0x19, 0x7a, 0x30, 0xfd, 0xb1, 0xc3, 0x1a, 0x89, 0xd9, 0xe3, 0x7c, 0xdf, 0xf7,
-	0xfa, 0xbe, 0xf7, 0xbd, 0x76, 0xd0, 0x82, 0x02, 0x4e, 0xfb, 0xa0, 0x9a, 0x70, 0x02, 0xc2, 0xe8,
-	0x46, 0xa9, 0xa4, 0x91, 0xf8, 0x66, 0x40, 0x57, 0x92, 0x01, 0x9d, 0x29, 0xa9, 0x75, 0xd6, 0xa1,
-	0x4c, 0x90, 0x23, 0x4e, 0x8b, 0x20, 0xac, 0xff, 0x8a, 0x10, 0xde, 0xb6, 0x95, 0x5b, 0x94, 0x73,
-	0x69, 0x5a, 0x0a, 0xa8, 0x81, 0x1c, 0xaf, 0xa1, 0x99, 0x63, 0x5d, 0x10, 0xd3, 0x2f, 0x81, 0xf4,
-	0x14, 0x8f, 0xa3, 0xb5, 0x68, 0x63, 0xaa, 0x8d, 0x8e, 0x75, 0xf1, 0xa1, 0x5f, 0xc2, 0xa1, 0xe2,
-	0xf8, 0x09, 0x9a, 0x4f, 0x5d, 0x09, 0x61, 0x39, 0x08, 0xc3, 0x8e, 0x18, 0xa8, 0x78, 0xdc, 0xc9,
-	0xe6, 0x3c, 0xb1, 0x57, 0xe1, 0xf8, 0x31, 0x9a, 0x93, 0xa9, 0x06, 0x75, 0x42, 0x0d, 0x93, 0x82,
-	0x74, 0xa8, 0xee, 0xc4, 0x13, 0x4e, 0x3b, 0x3b, 0x84, 0xef, 0x52, 0xdd, 0xb1, 0xe7, 0x0e, 0x4b,
-	0x9d, 0xe3, 0x78, 0xd2, 0x9f, 0x3b, 0x44, 0xb4, 0x2c, 0x8e, 0x57, 0xd1, 0x74, 0x30, 0x61, 0x9d,
-	0xc6, 0x37, 0xbc, 0x4b, 0x0f, 0x59, 0xa3, 0xf8, 0x21, 0x42, 0x2e, 0x17, 0xcf, 0xd7, 0xd6, 0xa2,
-	0x8d, 0xc9, 0xf6, 0x94, 0x43, 0x2c, 0x5d, 0xff, 0x12, 0xa1, 0x25, 0x37, 0xfd, 0x5b, 0xe8, 0x17,
-	0x20, 0xb6, 0xb8, 0xcc, 0xba, 0x87, 0x65, 0x3e, 0x62, 0x04, 0x8f, 0xd0, 0x4c, 0xd7, 0xd5, 0x91,
-	0xd4, 0x16, 0x86, 0xe9, 0xa7, 0xbb, 0x57, 0x67, 0xe1, 0x75, 0x74, 0x27, 0x48, 0xca, 0x5e, 0xda,
-	0x85, 0xbe, 0x0e, 0x63, 0xdf, 0xf6, 0xe8, 0x81, 0x07, 0xeb, 0xdf, 0xc6, 0xd1, 0x82, 0xf3, 0xb1,
-	0x0f, 0xa7, 0x6d, 0xbf, 0xb0, 0xcd, 0x3c, 0x1f, 0xc9, 0x44, 0x15, 0x2d, 0x28, 0x42, 0xf3, 0x5c,
-	0x81, 0xd6, 0xc1, 0xc8, 0xec, 0x00, 0xdf, 0xf4, 0x30, 0x7e, 0x8d, 0x56, 0x4a, 0xe0, 0x3c, 0xe3,
-	0xcc, 0x26, 0x52, 0x28, 0x2a, 0x0c, 0x40, 0x55, 0xe4, 0x8d, 0xc5, 0x57, 0x8a, 0x1d, 0x2f, 0x18,
-	0x54, 0xbf, 0x42, 0xcb, 0xd7, 0x54, 0xfb, 0xb1, 0xc2, 0x82, 0x96, 0xfe, 0x29, 0xf6, 0x03, 0xe2,
-	0x97, 0x68, 0xb9, 0x32, 0xc9, 0xa9, 0x36, 0x3e, 0x30, 0x92, 0xc9, 0x9e, 0x30, 0x6e, 0x6b, 0x93,
-	0xed, 0xc5, 0x81, 0xe0, 0x1d, 0xd5, 0xc6, 0x85, 0xd7, 0xb2, 0x6c, 0xfd, 0xf7, 0x38, 0xba, 0xef,
-	0xa2, 0x69, 0x55, 0x17, 0xf8, 0x8d, 0xbd, 0xbf, 0xa3, 0xaf, 0xe9, 0x29, 0xc2, 0x4c, 0x13, 0x26,
-	0x52, 0xd9, 0x13, 0x39, 0x01, 0x41, 0x53, 0x0e, 0xb9, 0xcb, 0xe8, 0x56, 0x7b, 0x8e, 0xe9, 0x3d,
-	0x4f, 0x6c, 0x7b, 0x1c, 0x37, 0xd0, 0x5d, 0xa6, 0x89, 0xec, 0x99, 0xbf, 0xe5, 0x13, 0x4e, 0x3e,
-	0xcf, 0xf4, 0xfb, 0xc0, 0x0c, 0xf4, 0x9f, 0x50, 0x5c, 0x50, 0x4d, 0x4a, 0xc5, 0x32, 0x20, 0x4c,
-	0x64, 0x0a, 0xa8, 0x06, 0xff, 0xc4, 0x5c, 0x2a, 0xd3, 0xcf, 0x93, 0x46, 0x78, 0x83, 0x8d, 0x1d,
-	0xaa, 0x0f, 0xac, 0x6e, 0x2f, 0xc8, 0xdc, 0x20, 0xed, 0x7b, 0xc5, 0x75, 0x30, 0x5e, 0x44, 0x35,
-	0xcd, 0x0a, 0x01, 0x2a, 0x5c, 0xeb, 0xf0, 0x85, 0x39, 0x5a, 0xf5, 0xe9, 0x75, 0x80, 0xe6, 0xa0,
-	0xc8, 0x09, 0x28, 0x76, 0xc4, 0x32, 0xff, 0x5c, 0x7c, 0xdf, 0x9a, 0xeb, 0xbb, 0x5e, 0xf5, 0x75,
-	0x71, 0xee, 0x3a, 0xf9, 0xc7, 0x21, 0xb5, 0x6f, 0xff, 0x20, 0xfd, 0x0f, 0xbb, 0xb5, 0xfb, 0xfd,
-	0x22, 0x89, 0xce, 0x2f, 0x92, 0xe8, 0xe7, 0x45, 0x12, 0x7d, 0xbd, 0x4c, 0xc6, 0xce, 0x2f, 0x93,
-	0xb1, 0x1f, 0x97, 0xc9, 0xd8, 0xe7, 0x46, 0xc1, 0x4c, 0xa7, 0x97, 0x36, 0x32, 0x79, 0xdc, 0x7c,
-	0x76, 0x76, 0x00, 0x9c, 0xef, 0x83, 0x39, 0x95, 0xaa, 0xdb, 0xa4, 0x50, 0x30, 0xdd, 0x3c, 0x6b,
-	0x0e, 0x7e, 0x3d, 0x76, 0x37, 0x3a, 0xad, 0xb9, 0x1f, 0xce, 0x8b, 0x3f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x24, 0x02, 0x72, 0xaa, 0xb1, 0x04, 0x00, 0x00,
+func (m *EventRelayerJailed) Reset()         { *m = EventRelayerJailed{} }
+func (m *EventRelayerJailed) String() string { return proto.CompactTextString(m) }
+func (*EventRelayerJailed) ProtoMessage()    {}
+func (*EventRelayerJailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_161b038dc7a5246f, []int{4}
 }
-
-func (m *EventBallotCreated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventRelayerJailed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRelayerJailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRelayerJailed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *EventBallotCreated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventRelayerJailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRelayerJailed.Merge(m, src)
+}
+func (m *EventRelayerJailed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRelayerJailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRelayerJailed.DiscardUnknown(m)
 }
 
-func (m *EventBallotCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EventType != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.EventType))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.BallotType) > 0 {
-		i -= len(m.BallotType)
-		copy(dAtA[i:], m.BallotType)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.BallotType)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.ObservationChain) > 0 {
-		i -= len(m.ObservationChain)
-		copy(dAtA[i:], m.ObservationChain)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObservationChain)))
-		i--
-		dAtA[i] = 0x22
+var xxx_messageInfo_EventRelayerJailed proto.InternalMessageInfo
+
+func (m *EventRelayerJailed) GetObserverAddress() string {
+	if m != nil {
+		return m.ObserverAddress
 	}
-	if len(m.ObservationHash) > 0 {
-		i -= len(m.ObservationHash)
-		copy(dAtA[i:], m.ObservationHash)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObservationHash)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *EventRelayerJailed) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	if len(m.BallotIdentifier) > 0 {
-		i -= len(m.BallotIdentifier)
-		copy(dAtA[i:], m.BallotIdentifier)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.BallotIdentifier)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *EventRelayerJailed) GetBlameCount() uint64 {
+	if m != nil {
+		return m.BlameCount
 	}
-	if len(m.MsgTypeUrl) > 0 {
-		i -= len(m.MsgTypeUrl)
-		copy(dAtA[i:], m.MsgTypeUrl)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *EventRelayerJailed) GetMissedBallotCount() uint64 {
+	if m != nil {
+		return m.MissedBallotCount
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventKeygenBlockUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventRelayerJailed) GetJailedUntilHeight() int64 {
+	if m != nil {
+		return m.JailedUntilHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventKeygenBlockUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// relayer unjailed event
+type EventRelayerUnjailed struct {
+	MsgTypeUrl      string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	ObserverAddress string `protobuf:"bytes,2,opt,name=observer_address,json=observerAddress,proto3" json:"observer_address,omitempty"`
 }
 
-func (m *EventKeygenBlockUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.KeygenPubkeys) > 0 {
-		i -= len(m.KeygenPubkeys)
-		copy(dAtA[i:], m.KeygenPubkeys)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.KeygenPubkeys)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.KeygenBlock) > 0 {
-		i -= len(m.KeygenBlock)
-		copy(dAtA[i:], m.KeygenBlock)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.KeygenBlock)))
-		i--
-		dAtA[i] = 0x12
+func (m *EventRelayerUnjailed) Reset()         { *m = EventRelayerUnjailed{} }
+func (m *EventRelayerUnjailed) String() string { return proto.CompactTextString(m) }
+func (*EventRelayerUnjailed) ProtoMessage()    {}
+func (*EventRelayerUnjailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_161b038dc7a5246f, []int{5}
+}
+func (m *EventRelayerUnjailed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRelayerUnjailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRelayerUnjailed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.MsgTypeUrl) > 0 {
-		i -= len(m.MsgTypeUrl)
-		copy(dAtA[i:], m.MsgTypeUrl)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventRelayerUnjailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRelayerUnjailed.Merge(m, src)
+}
+func (m *EventRelayerUnjailed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRelayerUnjailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRelayerUnjailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRelayerUnjailed proto.InternalMessageInfo
+
+func (m *EventRelayerUnjailed) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventNewRelayerAdded) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventRelayerUnjailed) GetObserverAddress() string {
+	if m != nil {
+		return m.ObserverAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventNewRelayerAdded) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// relayer penalty updated event, emitted when a blame or a missed ballot is
+// recorded for an observer
+type EventRelayerPenaltyUpdated struct {
+	ObserverAddress   string `protobuf:"bytes,1,opt,name=observer_address,json=observerAddress,proto3" json:"observer_address,omitempty"`
+	WindowStartHeight int64  `protobuf:"varint,2,opt,name=window_start_height,json=windowStartHeight,proto3" json:"window_start_height,omitempty"`
+	BlameCount        uint64 `protobuf:"varint,3,opt,name=blame_count,json=blameCount,proto3" json:"blame_count,omitempty"`
+	MissedBallotCount uint64 `protobuf:"varint,4,opt,name=missed_ballot_count,json=missedBallotCount,proto3" json:"missed_ballot_count,omitempty"`
 }
 
-func (m *EventNewRelayerAdded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ObserverLastBlockCount != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.ObserverLastBlockCount))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.PellclientGranteePubkey) > 0 {
-		i -= len(m.PellclientGranteePubkey)
-		copy(dAtA[i:], m.PellclientGranteePubkey)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.PellclientGranteePubkey)))
-		i--
-		dAtA[i] = 0x22
+func (m *EventRelayerPenaltyUpdated) Reset()         { *m = EventRelayerPenaltyUpdated{} }
+func (m *EventRelayerPenaltyUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventRelayerPenaltyUpdated) ProtoMessage()    {}
+func (*EventRelayerPenaltyUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_161b038dc7a5246f, []int{6}
+}
+func (m *EventRelayerPenaltyUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRelayerPenaltyUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRelayerPenaltyUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.PellclientGranteeAddress) > 0 {
-		i -= len(m.PellclientGranteeAddress)
-		copy(dAtA[i:], m.PellclientGranteeAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.PellclientGranteeAddress)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *EventRelayerPenaltyUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRelayerPenaltyUpdated.Merge(m, src)
+}
+func (m *EventRelayerPenaltyUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRelayerPenaltyUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRelayerPenaltyUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRelayerPenaltyUpdated proto.InternalMessageInfo
+
+func (m *EventRelayerPenaltyUpdated) GetObserverAddress() string {
+	if m != nil {
+		return m.ObserverAddress
 	}
-	if len(m.ObserverAddress) > 0 {
-		i -= len(m.ObserverAddress)
-		copy(dAtA[i:], m.ObserverAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObserverAddress)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *EventRelayerPenaltyUpdated) GetWindowStartHeight() int64 {
+	if m != nil {
+		return m.WindowStartHeight
 	}
-	if len(m.MsgTypeUrl) > 0 {
-		i -= len(m.MsgTypeUrl)
-		copy(dAtA[i:], m.MsgTypeUrl)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *EventRelayerPenaltyUpdated) GetBlameCount() uint64 {
+	if m != nil {
+		return m.BlameCount
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventCrosschainFlagsUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventRelayerPenaltyUpdated) GetMissedBallotCount() uint64 {
+	if m != nil {
+		return m.MissedBallotCount
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventCrosschainFlagsUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// chain pause updated event
+type EventChainPauseUpdated struct {
+	MsgTypeUrl       string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	Signer           string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+	ChainId          int64  `protobuf:"varint,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	IsInboundPaused  bool   `protobuf:"varint,4,opt,name=is_inbound_paused,json=isInboundPaused,proto3" json:"is_inbound_paused,omitempty"`
+	IsOutboundPaused bool   `protobuf:"varint,5,opt,name=is_outbound_paused,json=isOutboundPaused,proto3" json:"is_outbound_paused,omitempty"`
+	IsSigningPaused  bool   `protobuf:"varint,6,opt,name=is_signing_paused,json=isSigningPaused,proto3" json:"is_signing_paused,omitempty"`
+	ExpiryHeight     int64  `protobuf:"varint,7,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
 }
 
-func (m *EventCrosschainFlagsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockHeaderVerificationFlags != nil {
-		{
-			size, err := m.BlockHeaderVerificationFlags.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.GasPriceIncreaseFlags != nil {
-		{
-			size, err := m.GasPriceIncreaseFlags.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.IsOutboundEnabled {
-		i--
-		if m.IsOutboundEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *EventChainPauseUpdated) Reset()         { *m = EventChainPauseUpdated{} }
+func (m *EventChainPauseUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventChainPauseUpdated) ProtoMessage()    {}
+func (*EventChainPauseUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_161b038dc7a5246f, []int{7}
+}
+func (m *EventChainPauseUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventChainPauseUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventChainPauseUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x18
+		return b[:n], nil
 	}
-	if m.IsInboundEnabled {
-		i--
-		if m.IsInboundEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
+}
+func (m *EventChainPauseUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventChainPauseUpdated.Merge(m, src)
+}
+func (m *EventChainPauseUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventChainPauseUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventChainPauseUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventChainPauseUpdated proto.InternalMessageInfo
+
+func (m *EventChainPauseUpdated) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
 	}
-	if len(m.MsgTypeUrl) > 0 {
-		i -= len(m.MsgTypeUrl)
-		copy(dAtA[i:], m.MsgTypeUrl)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventChainPauseUpdated) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventChainPauseUpdated) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *EventBallotCreated) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *EventChainPauseUpdated) GetIsInboundPaused() bool {
+	if m != nil {
+		return m.IsInboundPaused
 	}
-	var l int
-	_ = l
-	l = len(m.MsgTypeUrl)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return false
+}
+
+func (m *EventChainPauseUpdated) GetIsOutboundPaused() bool {
+	if m != nil {
+		return m.IsOutboundPaused
 	}
-	l = len(m.BallotIdentifier)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return false
+}
+
+func (m *EventChainPauseUpdated) GetIsSigningPaused() bool {
+	if m != nil {
+		return m.IsSigningPaused
 	}
-	l = len(m.ObservationHash)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return false
+}
+
+func (m *EventChainPauseUpdated) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
 	}
-	l = len(m.ObservationChain)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return 0
+}
+
+// chain pause expired event
+type EventChainPauseExpired struct {
+	ChainId      int64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ExpiryHeight int64 `protobuf:"varint,2,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+}
+
+func (m *EventChainPauseExpired) Reset()         { *m = EventChainPauseExpired{} }
+func (m *EventChainPauseExpired) String() string { return proto.CompactTextString(m) }
+func (*EventChainPauseExpired) ProtoMessage()    {}
+func (*EventChainPauseExpired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_161b038dc7a5246f, []int{8}
+}
+func (m *EventChainPauseExpired) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventChainPauseExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventChainPauseExpired.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.BallotType)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+}
+func (m *EventChainPauseExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventChainPauseExpired.Merge(m, src)
+}
+func (m *EventChainPauseExpired) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventChainPauseExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventChainPauseExpired.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventChainPauseExpired proto.InternalMessageInfo
+
+func (m *EventChainPauseExpired) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
 	}
-	if m.EventType != 0 {
-		n += 1 + sovEvents(uint64(m.EventType))
+	return 0
+}
+
+func (m *EventChainPauseExpired) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
 	}
-	return n
+	return 0
 }
 
-func (m *EventKeygenBlockUpdated) Size() (n int) {
-	if m == nil {
-		return 0
+type EventTssRotationUpdated struct {
+	Stage        string `protobuf:"bytes,1,opt,name=stage,proto3" json:"stage,omitempty"`
+	OldTssPubkey string `protobuf:"bytes,2,opt,name=old_tss_pubkey,json=oldTssPubkey,proto3" json:"old_tss_pubkey,omitempty"`
+	NewTssPubkey string `protobuf:"bytes,3,opt,name=new_tss_pubkey,json=newTssPubkey,proto3" json:"new_tss_pubkey,omitempty"`
+	KeygenBlock  int64  `protobuf:"varint,4,opt,name=keygen_block,json=keygenBlock,proto3" json:"keygen_block,omitempty"`
+	Message      string `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *EventTssRotationUpdated) Reset()         { *m = EventTssRotationUpdated{} }
+func (m *EventTssRotationUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventTssRotationUpdated) ProtoMessage()    {}
+func (*EventTssRotationUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_161b038dc7a5246f, []int{9}
+}
+func (m *EventTssRotationUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTssRotationUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTssRotationUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.MsgTypeUrl)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+}
+func (m *EventTssRotationUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTssRotationUpdated.Merge(m, src)
+}
+func (m *EventTssRotationUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTssRotationUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTssRotationUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTssRotationUpdated proto.InternalMessageInfo
+
+func (m *EventTssRotationUpdated) GetStage() string {
+	if m != nil {
+		return m.Stage
 	}
-	l = len(m.KeygenBlock)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return ""
+}
+
+func (m *EventTssRotationUpdated) GetOldTssPubkey() string {
+	if m != nil {
+		return m.OldTssPubkey
 	}
-	l = len(m.KeygenPubkeys)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return ""
+}
+
+func (m *EventTssRotationUpdated) GetNewTssPubkey() string {
+	if m != nil {
+		return m.NewTssPubkey
 	}
-	return n
+	return ""
 }
 
-func (m *EventNewRelayerAdded) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventTssRotationUpdated) GetKeygenBlock() int64 {
+	if m != nil {
+		return m.KeygenBlock
 	}
-	var l int
-	_ = l
-	l = len(m.MsgTypeUrl)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return 0
+}
+
+func (m *EventTssRotationUpdated) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
-	l = len(m.ObserverAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return ""
+}
+
+type EventRestrictedAddressesUpdated struct {
+	MsgTypeUrl       string   `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	AddedAddresses   []string `protobuf:"bytes,2,rep,name=added_addresses,json=addedAddresses,proto3" json:"added_addresses,omitempty"`
+	RemovedAddresses []string `protobuf:"bytes,3,rep,name=removed_addresses,json=removedAddresses,proto3" json:"removed_addresses,omitempty"`
+	Signer           string   `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *EventRestrictedAddressesUpdated) Reset()         { *m = EventRestrictedAddressesUpdated{} }
+func (m *EventRestrictedAddressesUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventRestrictedAddressesUpdated) ProtoMessage()    {}
+func (*EventRestrictedAddressesUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_161b038dc7a5246f, []int{10}
+}
+func (m *EventRestrictedAddressesUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRestrictedAddressesUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRestrictedAddressesUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.PellclientGranteeAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+}
+func (m *EventRestrictedAddressesUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRestrictedAddressesUpdated.Merge(m, src)
+}
+func (m *EventRestrictedAddressesUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRestrictedAddressesUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRestrictedAddressesUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRestrictedAddressesUpdated proto.InternalMessageInfo
+
+func (m *EventRestrictedAddressesUpdated) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
 	}
-	l = len(m.PellclientGranteePubkey)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return ""
+}
+
+func (m *EventRestrictedAddressesUpdated) GetAddedAddresses() []string {
+	if m != nil {
+		return m.AddedAddresses
 	}
-	if m.ObserverLastBlockCount != 0 {
-		n += 1 + sovEvents(uint64(m.ObserverLastBlockCount))
+	return nil
+}
+
+func (m *EventRestrictedAddressesUpdated) GetRemovedAddresses() []string {
+	if m != nil {
+		return m.RemovedAddresses
 	}
-	return n
+	return nil
 }
 
-func (m *EventCrosschainFlagsUpdated) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventRestrictedAddressesUpdated) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	var l int
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*EventBallotCreated)(nil), "relayer.EventBallotCreated")
+	proto.RegisterType((*EventKeygenBlockUpdated)(nil), "relayer.EventKeygenBlockUpdated")
+	proto.RegisterType((*EventNewRelayerAdded)(nil), "relayer.EventNewRelayerAdded")
+	proto.RegisterType((*EventCrosschainFlagsUpdated)(nil), "relayer.EventCrosschainFlagsUpdated")
+	proto.RegisterType((*EventRelayerJailed)(nil), "relayer.EventRelayerJailed")
+	proto.RegisterType((*EventRelayerUnjailed)(nil), "relayer.EventRelayerUnjailed")
+	proto.RegisterType((*EventRelayerPenaltyUpdated)(nil), "relayer.EventRelayerPenaltyUpdated")
+	proto.RegisterType((*EventChainPauseUpdated)(nil), "relayer.EventChainPauseUpdated")
+	proto.RegisterType((*EventChainPauseExpired)(nil), "relayer.EventChainPauseExpired")
+	proto.RegisterType((*EventTssRotationUpdated)(nil), "relayer.EventTssRotationUpdated")
+	proto.RegisterType((*EventRestrictedAddressesUpdated)(nil), "relayer.EventRestrictedAddressesUpdated")
+}
+
+func init() { proto.RegisterFile("relayer/events.proto", fileDescriptor_161b038dc7a5246f) }
+
+var fileDescriptor_161b038dc7a5246f = []byte{
+	// 1012 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x72, 0x23, 0x35,
+	0x17, 0x4d, 0xdb, 0x8e, 0x93, 0x28, 0x99, 0xfc, 0xf4, 0xe4, 0xcb, 0x38, 0xf9, 0xc0, 0x09, 0x66,
+	0xa6, 0x08, 0x3f, 0x65, 0x53, 0xb0, 0x82, 0x62, 0x43, 0x52, 0x61, 0x12, 0xa0, 0x06, 0x57, 0x4f,
+	0x02, 0x14, 0x9b, 0x2e, 0xb9, 0x75, 0xd3, 0x16, 0x96, 0xd5, 0x2e, 0x49, 0x8e, 0xe3, 0x07, 0x60,
+	0xcf, 0x92, 0xd7, 0x60, 0xc7, 0x23, 0xcc, 0x72, 0x96, 0xb3, 0xa4, 0x92, 0xc7, 0x80, 0x05, 0x25,
+	0x5d, 0xb5, 0xdd, 0x26, 0x2e, 0x2a, 0x53, 0x35, 0xcb, 0xbe, 0xf7, 0xe8, 0xea, 0xde, 0xa3, 0x73,
+	0xa4, 0x26, 0xdb, 0x0a, 0x04, 0x1d, 0x83, 0x6a, 0xc1, 0x15, 0x48, 0xa3, 0x9b, 0x03, 0x95, 0x99,
+	0x2c, 0x5c, 0xf2, 0xd1, 0xbd, 0x7a, 0x9e, 0x4e, 0x54, 0xa6, 0x75, 0xd2, 0xa5, 0x5c, 0xc6, 0x97,
+	0x82, 0xa6, 0x1e, 0xd8, 0xf8, 0x2b, 0x20, 0xe1, 0x89, 0x5d, 0x79, 0x44, 0x85, 0xc8, 0xcc, 0xb1,
+	0x02, 0x6a, 0x80, 0x85, 0x07, 0x64, 0xad, 0xaf, 0xd3, 0xd8, 0x8c, 0x07, 0x10, 0x0f, 0x95, 0xa8,
+	0x05, 0x07, 0xc1, 0xe1, 0x4a, 0x44, 0xfa, 0x3a, 0x3d, 0x1f, 0x0f, 0xe0, 0x42, 0x89, 0xf0, 0x43,
+	0xb2, 0xd5, 0x71, 0x4b, 0x62, 0xce, 0x40, 0x1a, 0x7e, 0xc9, 0x41, 0xd5, 0x4a, 0x0e, 0xb6, 0x89,
+	0x89, 0xb3, 0x49, 0x3c, 0x7c, 0x9f, 0x6c, 0x66, 0x1d, 0x0d, 0xea, 0x8a, 0x1a, 0x9e, 0xc9, 0xb8,
+	0x4b, 0x75, 0xb7, 0x56, 0x76, 0xd8, 0x8d, 0x42, 0xfc, 0x94, 0xea, 0xae, 0xad, 0x5b, 0x84, 0xba,
+	0x8e, 0x6b, 0x15, 0xac, 0x5b, 0x48, 0x1c, 0xdb, 0x78, 0xb8, 0x4f, 0x56, 0x7d, 0x13, 0xb6, 0xd3,
+	0xda, 0x22, 0x76, 0x89, 0x21, 0xdb, 0x68, 0xf8, 0x36, 0x21, 0x8e, 0x17, 0xcc, 0x57, 0x0f, 0x82,
+	0xc3, 0x4a, 0xb4, 0xe2, 0x22, 0x36, 0xdd, 0xf8, 0x25, 0x20, 0x8f, 0xdc, 0xf4, 0xdf, 0xc0, 0x38,
+	0x05, 0x79, 0x24, 0xb2, 0xa4, 0x77, 0x31, 0x60, 0xf7, 0xa4, 0xe0, 0x1d, 0xb2, 0xd6, 0x73, 0xeb,
+	0xe2, 0x8e, 0x5d, 0xe8, 0xa7, 0x5f, 0xed, 0x4d, 0x6b, 0x85, 0x4f, 0xc8, 0xba, 0x87, 0x0c, 0x86,
+	0x9d, 0x1e, 0x8c, 0xb5, 0x1f, 0xfb, 0x01, 0x46, 0xdb, 0x18, 0x6c, 0xfc, 0x56, 0x22, 0xdb, 0xae,
+	0x8f, 0x67, 0x30, 0x8a, 0xf0, 0xc0, 0xbe, 0x64, 0xec, 0x5e, 0x4d, 0x4c, 0xa8, 0x05, 0x15, 0x53,
+	0xc6, 0x14, 0x68, 0x5d, 0x2b, 0x15, 0xa9, 0x75, 0xa5, 0x6c, 0x38, 0xfc, 0x82, 0xec, 0x0d, 0x40,
+	0x88, 0x44, 0x70, 0xcb, 0x48, 0xaa, 0xa8, 0x34, 0x00, 0x93, 0x45, 0xd8, 0x58, 0x6d, 0x8a, 0x78,
+	0x8a, 0x80, 0x7c, 0xf5, 0xe7, 0x64, 0x77, 0xce, 0x6a, 0x1c, 0xcb, 0x1f, 0xd0, 0xa3, 0x3b, 0x8b,
+	0x71, 0xc0, 0xf0, 0x33, 0xb2, 0x3b, 0x69, 0x52, 0x50, 0x6d, 0x90, 0xb0, 0x38, 0xc9, 0x86, 0xd2,
+	0xb8, 0x53, 0xab, 0x44, 0x3b, 0x39, 0xe0, 0x5b, 0xaa, 0x8d, 0x23, 0xef, 0xd8, 0x66, 0x1b, 0x7f,
+	0x97, 0xc8, 0xff, 0x1d, 0x35, 0xc7, 0x13, 0x01, 0x7f, 0x65, 0xf5, 0x7b, 0xff, 0x63, 0xfa, 0x88,
+	0x84, 0x5c, 0xc7, 0x5c, 0x76, 0xb2, 0xa1, 0x64, 0x31, 0x48, 0xda, 0x11, 0xc0, 0x1c, 0x47, 0xcb,
+	0xd1, 0x26, 0xd7, 0x67, 0x98, 0x38, 0xc1, 0x78, 0xd8, 0x24, 0x0f, 0xb9, 0x8e, 0xb3, 0xa1, 0x99,
+	0x85, 0x97, 0x1d, 0x7c, 0x8b, 0xeb, 0xef, 0x86, 0x66, 0x06, 0xff, 0x03, 0xa9, 0xa5, 0x54, 0xc7,
+	0x03, 0xc5, 0x13, 0x88, 0xb9, 0x4c, 0x14, 0x50, 0x0d, 0x68, 0x31, 0xc7, 0xca, 0xea, 0x27, 0xf5,
+	0xa6, 0xf7, 0x60, 0xf3, 0x29, 0xd5, 0x6d, 0x8b, 0x3b, 0xf3, 0x30, 0x37, 0x48, 0xf4, 0xbf, 0x74,
+	0x5e, 0x38, 0xdc, 0x21, 0x55, 0xcd, 0x53, 0x09, 0xca, 0xcb, 0xda, 0x7f, 0x85, 0x82, 0xec, 0x23,
+	0x7b, 0x5d, 0xa0, 0x0c, 0x54, 0x7c, 0x05, 0x8a, 0x5f, 0xf2, 0x04, 0xed, 0x82, 0xfb, 0x56, 0xdd,
+	0xbe, 0x4f, 0x26, 0xfb, 0x3a, 0x3a, 0x4f, 0x1d, 0xfc, 0xfb, 0x02, 0x1a, 0xb7, 0x7f, 0xab, 0xf3,
+	0x1f, 0xd9, 0xc6, 0xab, 0xfc, 0x7e, 0xf0, 0xb2, 0xfc, 0x9a, 0x72, 0x3b, 0xf5, 0x3c, 0xd5, 0x05,
+	0xf3, 0x55, 0xb7, 0x43, 0xaa, 0x76, 0xaa, 0x4c, 0x7a, 0x59, 0xfa, 0x2f, 0xe7, 0x5d, 0x41, 0xfb,
+	0xe0, 0x55, 0x50, 0x76, 0x2a, 0x20, 0x2e, 0xe4, 0x4e, 0xde, 0x9e, 0x44, 0x9f, 0x6b, 0x0d, 0x2c,
+	0xf6, 0x1e, 0x47, 0x60, 0xc5, 0x01, 0xb7, 0x30, 0xe5, 0x6f, 0xad, 0x1c, 0xff, 0xb3, 0xeb, 0x2e,
+	0x1e, 0x4a, 0xc3, 0x45, 0xdc, 0x05, 0x9e, 0x76, 0x51, 0x5e, 0xe5, 0x68, 0x0b, 0x53, 0x17, 0x36,
+	0x73, 0xea, 0x12, 0x8d, 0x84, 0x6c, 0x17, 0x27, 0xbb, 0x90, 0x08, 0x79, 0xa3, 0x9e, 0x6b, 0xbc,
+	0x08, 0xc8, 0x5e, 0x71, 0x97, 0x36, 0x48, 0x2a, 0xcc, 0x38, 0x57, 0xef, 0x6b, 0xf0, 0xd8, 0x24,
+	0x0f, 0x47, 0x5c, 0xb2, 0x6c, 0x14, 0x6b, 0x43, 0x95, 0xc9, 0xc7, 0x2b, 0xe1, 0x78, 0x98, 0x7a,
+	0x6e, 0x33, 0x38, 0xde, 0x1b, 0xe7, 0xd7, 0x5e, 0x52, 0x3b, 0xe8, 0x44, 0x6b, 0xc2, 0x36, 0x1d,
+	0x6a, 0xb8, 0xbf, 0x09, 0xa7, 0x6a, 0x2e, 0xcd, 0xa8, 0x79, 0x97, 0x2c, 0xe3, 0xa3, 0xc4, 0xd1,
+	0x63, 0xe5, 0x68, 0xc9, 0x7d, 0x9f, 0xb1, 0xf0, 0x03, 0xb2, 0x55, 0xf0, 0xed, 0xc0, 0xee, 0xc7,
+	0x5c, 0x77, 0xcb, 0xd1, 0xc6, 0xc4, 0xb6, 0xae, 0x0d, 0xe6, 0x3d, 0x3e, 0x71, 0xad, 0x07, 0x2f,
+	0xe6, 0x1e, 0xcf, 0x4d, 0xeb, 0xd1, 0x58, 0xd9, 0x76, 0xc0, 0x65, 0x9a, 0x83, 0xab, 0x79, 0xe5,
+	0xe7, 0x18, 0xf7, 0xd8, 0x77, 0xc9, 0x03, 0xb8, 0x1e, 0x70, 0x35, 0xce, 0x09, 0x5f, 0x72, 0x5d,
+	0xae, 0x61, 0xd0, 0x4b, 0xe9, 0xc7, 0x3b, 0xcc, 0x9c, 0xd8, 0x34, 0xb0, 0x99, 0xf9, 0x82, 0xd9,
+	0xf9, 0xee, 0x54, 0x2e, 0xcd, 0xa9, 0xfc, 0x47, 0xfe, 0x42, 0x9d, 0x6b, 0x1d, 0x65, 0xc6, 0x39,
+	0x33, 0x67, 0x7d, 0x9b, 0x2c, 0x6a, 0x43, 0x53, 0xf0, 0x74, 0xe3, 0x47, 0xf8, 0x98, 0xac, 0x67,
+	0x82, 0xc5, 0x46, 0xeb, 0xfc, 0x72, 0x46, 0xc6, 0xd7, 0x32, 0xc1, 0xce, 0xb5, 0xf6, 0x37, 0xf2,
+	0x63, 0xb2, 0x2e, 0x61, 0x54, 0x44, 0xe1, 0xfd, 0xbf, 0x26, 0x61, 0x34, 0x45, 0xfd, 0xfb, 0x85,
+	0xab, 0xb8, 0x0e, 0x67, 0x5e, 0xb8, 0x1a, 0x59, 0xea, 0x83, 0xd6, 0xb6, 0x0d, 0xbc, 0xa7, 0xf2,
+	0xcf, 0xc6, 0xef, 0x01, 0xd9, 0xf7, 0xd2, 0xd7, 0x46, 0xf1, 0xc4, 0x00, 0xf3, 0x5a, 0x86, 0xd7,
+	0xb8, 0xbd, 0xdf, 0x23, 0x1b, 0xd4, 0x3e, 0x85, 0xb9, 0x3d, 0xc0, 0x5a, 0xad, 0x7c, 0xb8, 0x12,
+	0xad, 0xbb, 0xf0, 0xa4, 0xa2, 0xfd, 0x71, 0x50, 0xd0, 0xcf, 0xae, 0x66, 0xa0, 0x65, 0x07, 0xdd,
+	0xf4, 0x89, 0x29, 0x78, 0x2a, 0xc7, 0x4a, 0x51, 0x8e, 0x47, 0xa7, 0x2f, 0x6e, 0xea, 0xc1, 0xcb,
+	0x9b, 0x7a, 0xf0, 0xe7, 0x4d, 0x3d, 0xf8, 0xf5, 0xb6, 0xbe, 0xf0, 0xf2, 0xb6, 0xbe, 0xf0, 0xea,
+	0xb6, 0xbe, 0xf0, 0x53, 0x33, 0xe5, 0xa6, 0x3b, 0xec, 0x34, 0x93, 0xac, 0xdf, 0xfa, 0xf8, 0xba,
+	0x0d, 0x42, 0x3c, 0x03, 0x33, 0xca, 0x54, 0xaf, 0x45, 0x21, 0xe5, 0xba, 0x75, 0xdd, 0xca, 0xff,
+	0xb4, 0xec, 0x30, 0xba, 0x53, 0x75, 0xff, 0x57, 0x9f, 0xfe, 0x33, 0x00, 0x00, 0x5d, 0xdd, 0xb1,
+	0xa0, 0x09, 0x00, 0x00,
+}
+
+func (m *EventBallotCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBallotCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBallotCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
 	_ = l
-	l = len(m.MsgTypeUrl)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.EventType != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EventType))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.IsInboundEnabled {
-		n += 2
+	if len(m.BallotType) > 0 {
+		i -= len(m.BallotType)
+		copy(dAtA[i:], m.BallotType)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BallotType)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.IsOutboundEnabled {
-		n += 2
+	if len(m.ObservationChain) > 0 {
+		i -= len(m.ObservationChain)
+		copy(dAtA[i:], m.ObservationChain)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObservationChain)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.GasPriceIncreaseFlags != nil {
-		l = m.GasPriceIncreaseFlags.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ObservationHash) > 0 {
+		i -= len(m.ObservationHash)
+		copy(dAtA[i:], m.ObservationHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObservationHash)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.BallotIdentifier) > 0 {
+		i -= len(m.BallotIdentifier)
+		copy(dAtA[i:], m.BallotIdentifier)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BallotIdentifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventKeygenBlockUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventKeygenBlockUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventKeygenBlockUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeygenPubkeys) > 0 {
+		i -= len(m.KeygenPubkeys)
+		copy(dAtA[i:], m.KeygenPubkeys)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.KeygenPubkeys)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.KeygenBlock) > 0 {
+		i -= len(m.KeygenBlock)
+		copy(dAtA[i:], m.KeygenBlock)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.KeygenBlock)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventNewRelayerAdded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNewRelayerAdded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNewRelayerAdded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ObserverLastBlockCount != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ObserverLastBlockCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.PellclientGranteePubkey) > 0 {
+		i -= len(m.PellclientGranteePubkey)
+		copy(dAtA[i:], m.PellclientGranteePubkey)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PellclientGranteePubkey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PellclientGranteeAddress) > 0 {
+		i -= len(m.PellclientGranteeAddress)
+		copy(dAtA[i:], m.PellclientGranteeAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PellclientGranteeAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ObserverAddress) > 0 {
+		i -= len(m.ObserverAddress)
+		copy(dAtA[i:], m.ObserverAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObserverAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCrosschainFlagsUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCrosschainFlagsUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCrosschainFlagsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.BlockHeaderVerificationFlags != nil {
-		l = m.BlockHeaderVerificationFlags.Size()
-		n += 1 + l + sovEvents(uint64(l))
+		{
+			size, err := m.BlockHeaderVerificationFlags.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.GasPriceIncreaseFlags != nil {
+		{
+			size, err := m.GasPriceIncreaseFlags.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.IsOutboundEnabled {
+		i--
+		if m.IsOutboundEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.IsInboundEnabled {
+		i--
+		if m.IsInboundEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRelayerJailed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRelayerJailed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRelayerJailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.JailedUntilHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.JailedUntilHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MissedBallotCount != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MissedBallotCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BlameCount != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlameCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ObserverAddress) > 0 {
+		i -= len(m.ObserverAddress)
+		copy(dAtA[i:], m.ObserverAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObserverAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRelayerUnjailed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRelayerUnjailed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRelayerUnjailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ObserverAddress) > 0 {
+		i -= len(m.ObserverAddress)
+		copy(dAtA[i:], m.ObserverAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObserverAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRelayerPenaltyUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRelayerPenaltyUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRelayerPenaltyUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MissedBallotCount != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MissedBallotCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BlameCount != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlameCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WindowStartHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.WindowStartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ObserverAddress) > 0 {
+		i -= len(m.ObserverAddress)
+		copy(dAtA[i:], m.ObserverAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObserverAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventChainPauseUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventChainPauseUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventChainPauseUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.IsSigningPaused {
+		i--
+		if m.IsSigningPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.IsOutboundPaused {
+		i--
+		if m.IsOutboundPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.IsInboundPaused {
+		i--
+		if m.IsInboundPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventChainPauseExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventChainPauseExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventChainPauseExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTssRotationUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTssRotationUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTssRotationUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.KeygenBlock != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.KeygenBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NewTssPubkey) > 0 {
+		i -= len(m.NewTssPubkey)
+		copy(dAtA[i:], m.NewTssPubkey)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewTssPubkey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OldTssPubkey) > 0 {
+		i -= len(m.OldTssPubkey)
+		copy(dAtA[i:], m.OldTssPubkey)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OldTssPubkey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Stage) > 0 {
+		i -= len(m.Stage)
+		copy(dAtA[i:], m.Stage)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Stage)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRestrictedAddressesUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRestrictedAddressesUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRestrictedAddressesUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RemovedAddresses) > 0 {
+		for iNdEx := len(m.RemovedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemovedAddresses[iNdEx])
+			copy(dAtA[i:], m.RemovedAddresses[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.RemovedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AddedAddresses) > 0 {
+		for iNdEx := len(m.AddedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AddedAddresses[iNdEx])
+			copy(dAtA[i:], m.AddedAddresses[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.AddedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventBallotCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BallotIdentifier)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ObservationHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ObservationChain)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BallotType)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.EventType != 0 {
+		n += 1 + sovEvents(uint64(m.EventType))
+	}
+	return n
+}
+
+func (m *EventKeygenBlockUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.KeygenBlock)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.KeygenPubkeys)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventNewRelayerAdded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ObserverAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PellclientGranteeAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PellclientGranteePubkey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ObserverLastBlockCount != 0 {
+		n += 1 + sovEvents(uint64(m.ObserverLastBlockCount))
+	}
+	return n
+}
+
+func (m *EventCrosschainFlagsUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.IsInboundEnabled {
+		n += 2
+	}
+	if m.IsOutboundEnabled {
+		n += 2
+	}
+	if m.GasPriceIncreaseFlags != nil {
+		l = m.GasPriceIncreaseFlags.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.BlockHeaderVerificationFlags != nil {
+		l = m.BlockHeaderVerificationFlags.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRelayerJailed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ObserverAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.BlameCount != 0 {
+		n += 1 + sovEvents(uint64(m.BlameCount))
+	}
+	if m.MissedBallotCount != 0 {
+		n += 1 + sovEvents(uint64(m.MissedBallotCount))
+	}
+	if m.JailedUntilHeight != 0 {
+		n += 1 + sovEvents(uint64(m.JailedUntilHeight))
+	}
+	return n
+}
+
+func (m *EventRelayerUnjailed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ObserverAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRelayerPenaltyUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ObserverAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.WindowStartHeight != 0 {
+		n += 1 + sovEvents(uint64(m.WindowStartHeight))
+	}
+	if m.BlameCount != 0 {
+		n += 1 + sovEvents(uint64(m.BlameCount))
+	}
+	if m.MissedBallotCount != 0 {
+		n += 1 + sovEvents(uint64(m.MissedBallotCount))
+	}
+	return n
+}
+
+func (m *EventChainPauseUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovEvents(uint64(m.ChainId))
+	}
+	if m.IsInboundPaused {
+		n += 2
+	}
+	if m.IsOutboundPaused {
+		n += 2
+	}
+	if m.IsSigningPaused {
+		n += 2
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovEvents(uint64(m.ExpiryHeight))
+	}
+	return n
+}
+
+func (m *EventChainPauseExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovEvents(uint64(m.ChainId))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovEvents(uint64(m.ExpiryHeight))
+	}
+	return n
+}
+
+func (m *EventTssRotationUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Stage)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.OldTssPubkey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewTssPubkey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.KeygenBlock != 0 {
+		n += 1 + sovEvents(uint64(m.KeygenBlock))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRestrictedAddressesUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.AddedAddresses) > 0 {
+		for _, s := range m.AddedAddresses {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.RemovedAddresses) > 0 {
+		for _, s := range m.RemovedAddresses {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventBallotCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBallotCreated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBallotCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BallotIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservationHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObservationHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservationChain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObservationChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BallotType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventType", wireType)
+			}
+			m.EventType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventType |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventKeygenBlockUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventKeygenBlockUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventKeygenBlockUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeygenBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeygenBlock = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeygenPubkeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeygenPubkeys = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventNewRelayerAdded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventNewRelayerAdded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventNewRelayerAdded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PellclientGranteeAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PellclientGranteeAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PellclientGranteePubkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PellclientGranteePubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverLastBlockCount", wireType)
+			}
+			m.ObserverLastBlockCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObserverLastBlockCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCrosschainFlagsUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCrosschainFlagsUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCrosschainFlagsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsInboundEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsInboundEnabled = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsOutboundEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsOutboundEnabled = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceIncreaseFlags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GasPriceIncreaseFlags == nil {
+				m.GasPriceIncreaseFlags = &GasPriceIncreaseFlags{}
+			}
+			if err := m.GasPriceIncreaseFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeaderVerificationFlags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockHeaderVerificationFlags == nil {
+				m.BlockHeaderVerificationFlags = &BlockHeaderVerificationFlags{}
+			}
+			if err := m.BlockHeaderVerificationFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRelayerJailed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRelayerJailed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRelayerJailed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlameCount", wireType)
+			}
+			m.BlameCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlameCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBallotCount", wireType)
+			}
+			m.MissedBallotCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBallotCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntilHeight", wireType)
+			}
+			m.JailedUntilHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailedUntilHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *EventBallotCreated) Unmarshal(dAtA []byte) error {
+func (m *EventRelayerUnjailed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -778,10 +2793,10 @@ func (m *EventBallotCreated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventBallotCreated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRelayerUnjailed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBallotCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRelayerUnjailed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -818,7 +2833,7 @@ func (m *EventBallotCreated) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BallotIdentifier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -846,11 +2861,61 @@ func (m *EventBallotCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BallotIdentifier = string(dAtA[iNdEx:postIndex])
+			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRelayerPenaltyUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRelayerPenaltyUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRelayerPenaltyUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservationHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -878,13 +2943,203 @@ func (m *EventBallotCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObservationHash = string(dAtA[iNdEx:postIndex])
+			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStartHeight", wireType)
+			}
+			m.WindowStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowStartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlameCount", wireType)
+			}
+			m.BlameCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlameCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBallotCount", wireType)
+			}
+			m.MissedBallotCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBallotCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventChainPauseUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventChainPauseUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventChainPauseUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservationChain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsInboundPaused", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -894,29 +3149,17 @@ func (m *EventBallotCreated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ObservationChain = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.IsInboundPaused = bool(v != 0)
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BallotType", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsOutboundPaused", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -926,29 +3169,37 @@ func (m *EventBallotCreated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
+			m.IsOutboundPaused = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsSigningPaused", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.BallotType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			m.IsSigningPaused = bool(v != 0)
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
 			}
-			m.EventType = 0
+			m.ExpiryHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -958,7 +3209,7 @@ func (m *EventBallotCreated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EventType |= uint64(b&0x7F) << shift
+				m.ExpiryHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -984,7 +3235,7 @@ func (m *EventBallotCreated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventKeygenBlockUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventChainPauseExpired) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1007,17 +3258,17 @@ func (m *EventKeygenBlockUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventKeygenBlockUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventChainPauseExpired: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventKeygenBlockUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventChainPauseExpired: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var stringLen uint64
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1027,61 +3278,16 @@ func (m *EventKeygenBlockUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ChainId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeygenBlock", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.KeygenBlock = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeygenPubkeys", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
 			}
-			var stringLen uint64
+			m.ExpiryHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1091,24 +3297,11 @@ func (m *EventKeygenBlockUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ExpiryHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.KeygenPubkeys = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1130,7 +3323,7 @@ func (m *EventKeygenBlockUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventNewRelayerAdded) Unmarshal(dAtA []byte) error {
+func (m *EventTssRotationUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1153,15 +3346,15 @@ func (m *EventNewRelayerAdded) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventNewRelayerAdded: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventTssRotationUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventNewRelayerAdded: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventTssRotationUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1189,11 +3382,11 @@ func (m *EventNewRelayerAdded) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			m.Stage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldTssPubkey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1221,11 +3414,11 @@ func (m *EventNewRelayerAdded) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
+			m.OldTssPubkey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PellclientGranteeAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewTssPubkey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1253,13 +3446,13 @@ func (m *EventNewRelayerAdded) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PellclientGranteeAddress = string(dAtA[iNdEx:postIndex])
+			m.NewTssPubkey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PellclientGranteePubkey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeygenBlock", wireType)
 			}
-			var stringLen uint64
+			m.KeygenBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1269,29 +3462,16 @@ func (m *EventNewRelayerAdded) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.KeygenBlock |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PellclientGranteePubkey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObserverLastBlockCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			m.ObserverLastBlockCount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1301,11 +3481,24 @@ func (m *EventNewRelayerAdded) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ObserverLastBlockCount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1327,7 +3520,7 @@ func (m *EventNewRelayerAdded) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCrosschainFlagsUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventRestrictedAddressesUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1350,10 +3543,10 @@ func (m *EventCrosschainFlagsUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCrosschainFlagsUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRestrictedAddressesUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCrosschainFlagsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRestrictedAddressesUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1389,50 +3582,10 @@ func (m *EventCrosschainFlagsUpdated) Unmarshal(dAtA []byte) error {
 			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsInboundEnabled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsInboundEnabled = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsOutboundEnabled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsOutboundEnabled = bool(v != 0)
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceIncreaseFlags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedAddresses", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1442,31 +3595,27 @@ func (m *EventCrosschainFlagsUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GasPriceIncreaseFlags == nil {
-				m.GasPriceIncreaseFlags = &GasPriceIncreaseFlags{}
-			}
-			if err := m.GasPriceIncreaseFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AddedAddresses = append(m.AddedAddresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAddresses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1494,13 +3643,13 @@ func (m *EventCrosschainFlagsUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.RemovedAddresses = append(m.RemovedAddresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeaderVerificationFlags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1510,27 +3659,23 @@ func (m *EventCrosschainFlagsUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BlockHeaderVerificationFlags == nil {
-				m.BlockHeaderVerificationFlags = &BlockHeaderVerificationFlags{}
-			}
-			if err := m.BlockHeaderVerificationFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5,6 +5,8 @@ import (
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/0xPellNetwork/aegis/pkg/chains"
 )
 
 // DefaultGenesis returns the default observer genesis state
@@ -19,6 +21,7 @@ func DefaultGenesis() *GenesisState {
 		Keygen:            nil,
 		LastObserverCount: nil,
 		ChainNonces:       []ChainNonces{},
+		ChainList:         chains.ChainsList(),
 	}
 }
 
@@ -30,6 +33,12 @@ func (gs GenesisState) Validate() error {
 			return err
 		}
 	}
+	if gs.CrosschainFlags != nil {
+		if err := gs.CrosschainFlags.ValidateChainPauses(); err != nil {
+			return err
+		}
+	}
+
 	// Check for duplicated index in nodeAccount
 	nodeAccountIndexMap := make(map[string]bool)
 
@@ -40,11 +49,50 @@ func (gs GenesisState) Validate() error {
 		nodeAccountIndexMap[elem.GetOperator()] = true
 	}
 
-	// check for invalid chain params
-	if err := gs.ChainParamsList.Validate(); err != nil {
+	// check for invalid or duplicated chains in the chain registry
+	chainIndexMap := make(map[int64]bool)
+	for _, chain := range gs.ChainList {
+		if err := chain.Validate(); err != nil {
+			return err
+		}
+		if _, ok := chainIndexMap[chain.Id]; ok {
+			return fmt.Errorf("duplicated chain id %d in chain list", chain.Id)
+		}
+		chainIndexMap[chain.Id] = true
+	}
+
+	// check for invalid chain params, the default chains are used if the chain registry is not defined
+	chainList := gs.ChainList
+	if len(chainList) == 0 {
+		chainList = chains.ChainsList()
+	}
+	if err := gs.ChainParamsList.Validate(chainList); err != nil {
 		return err
 	}
 
+	// check for invalid or duplicated restricted addresses
+	if err := ValidateRestrictedAddresses(gs.RestrictedAddresses); err != nil {
+		return err
+	}
+
+	// check for invalid or duplicated penalty records
+	penaltyIndexMap := make(map[string]bool)
+	for _, penalty := range gs.RelayerPenalties {
+		if err := penalty.Validate(); err != nil {
+			return err
+		}
+		if _, ok := penaltyIndexMap[penalty.ObserverAddress]; ok {
+			return fmt.Errorf("duplicated penalty record for observer %s", penalty.ObserverAddress)
+		}
+		penaltyIndexMap[penalty.ObserverAddress] = true
+	}
+
+	if gs.TssRotation != nil {
+		if err := gs.TssRotation.Validate(); err != nil {
+			return err
+		}
+	}
+
 	// Check for duplicated index in chainNonces
 	chainNoncesIndexMap := make(map[string]bool)
 
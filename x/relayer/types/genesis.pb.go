@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	chains "github.com/0xPellNetwork/aegis/pkg/chains"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -25,21 +26,25 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // relayer genesis state
 type GenesisState struct {
-	Ballots           []*Ballot             `protobuf:"bytes,1,rep,name=ballots,proto3" json:"ballots,omitempty"`
-	Observers         RelayerSet            `protobuf:"bytes,2,opt,name=observers,proto3" json:"observers"`
-	NodeAccountList   []*NodeAccount        `protobuf:"bytes,3,rep,name=node_account_list,json=nodeAccountList,proto3" json:"node_account_list,omitempty"`
-	CrosschainFlags   *CrosschainFlags      `protobuf:"bytes,4,opt,name=crosschain_flags,json=crosschainFlags,proto3" json:"crosschain_flags,omitempty"`
-	Params            *Params               `protobuf:"bytes,5,opt,name=params,proto3" json:"params,omitempty"`
-	Keygen            *Keygen               `protobuf:"bytes,6,opt,name=keygen,proto3" json:"keygen,omitempty"`
-	LastObserverCount *LastRelayerCount     `protobuf:"bytes,7,opt,name=last_observer_count,json=lastObserverCount,proto3" json:"last_observer_count,omitempty"`
-	ChainParamsList   ChainParamsList       `protobuf:"bytes,8,opt,name=chain_params_list,json=chainParamsList,proto3" json:"chain_params_list"`
-	Tss               *TSS                  `protobuf:"bytes,9,opt,name=tss,proto3" json:"tss,omitempty"`
-	TssHistory        []TSS                 `protobuf:"bytes,10,rep,name=tss_history,json=tssHistory,proto3" json:"tss_history"`
-	TssFundMigrators  []TssFundMigratorInfo `protobuf:"bytes,11,rep,name=tss_fund_migrators,json=tssFundMigrators,proto3" json:"tss_fund_migrators"`
-	BlameList         []Blame               `protobuf:"bytes,12,rep,name=blame_list,json=blameList,proto3" json:"blame_list"`
-	PendingNonces     []PendingNonces       `protobuf:"bytes,13,rep,name=pending_nonces,json=pendingNonces,proto3" json:"pending_nonces"`
-	ChainNonces       []ChainNonces         `protobuf:"bytes,14,rep,name=chain_nonces,json=chainNonces,proto3" json:"chain_nonces"`
-	NonceToXmsg       []NonceToXmsg         `protobuf:"bytes,15,rep,name=nonce_to_xmsg,json=nonceToXmsg,proto3" json:"nonce_to_xmsg"`
+	Ballots             []*Ballot             `protobuf:"bytes,1,rep,name=ballots,proto3" json:"ballots,omitempty"`
+	Observers           RelayerSet            `protobuf:"bytes,2,opt,name=observers,proto3" json:"observers"`
+	NodeAccountList     []*NodeAccount        `protobuf:"bytes,3,rep,name=node_account_list,json=nodeAccountList,proto3" json:"node_account_list,omitempty"`
+	CrosschainFlags     *CrosschainFlags      `protobuf:"bytes,4,opt,name=crosschain_flags,json=crosschainFlags,proto3" json:"crosschain_flags,omitempty"`
+	Params              *Params               `protobuf:"bytes,5,opt,name=params,proto3" json:"params,omitempty"`
+	Keygen              *Keygen               `protobuf:"bytes,6,opt,name=keygen,proto3" json:"keygen,omitempty"`
+	LastObserverCount   *LastRelayerCount     `protobuf:"bytes,7,opt,name=last_observer_count,json=lastObserverCount,proto3" json:"last_observer_count,omitempty"`
+	ChainParamsList     ChainParamsList       `protobuf:"bytes,8,opt,name=chain_params_list,json=chainParamsList,proto3" json:"chain_params_list"`
+	Tss                 *TSS                  `protobuf:"bytes,9,opt,name=tss,proto3" json:"tss,omitempty"`
+	TssHistory          []TSS                 `protobuf:"bytes,10,rep,name=tss_history,json=tssHistory,proto3" json:"tss_history"`
+	TssFundMigrators    []TssFundMigratorInfo `protobuf:"bytes,11,rep,name=tss_fund_migrators,json=tssFundMigrators,proto3" json:"tss_fund_migrators"`
+	BlameList           []Blame               `protobuf:"bytes,12,rep,name=blame_list,json=blameList,proto3" json:"blame_list"`
+	PendingNonces       []PendingNonces       `protobuf:"bytes,13,rep,name=pending_nonces,json=pendingNonces,proto3" json:"pending_nonces"`
+	ChainNonces         []ChainNonces         `protobuf:"bytes,14,rep,name=chain_nonces,json=chainNonces,proto3" json:"chain_nonces"`
+	NonceToXmsg         []NonceToXmsg         `protobuf:"bytes,15,rep,name=nonce_to_xmsg,json=nonceToXmsg,proto3" json:"nonce_to_xmsg"`
+	ChainList           []chains.Chain        `protobuf:"bytes,16,rep,name=chain_list,json=chainList,proto3" json:"chain_list"`
+	RestrictedAddresses []string              `protobuf:"bytes,17,rep,name=restricted_addresses,json=restrictedAddresses,proto3" json:"restricted_addresses,omitempty"`
+	RelayerPenalties    []RelayerPenalty      `protobuf:"bytes,18,rep,name=relayer_penalties,json=relayerPenalties,proto3" json:"relayer_penalties"`
+	TssRotation         *TssRotation          `protobuf:"bytes,19,opt,name=tss_rotation,json=tssRotation,proto3" json:"tss_rotation,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -180,6 +185,34 @@ func (m *GenesisState) GetNonceToXmsg() []NonceToXmsg {
 	return nil
 }
 
+func (m *GenesisState) GetChainList() []chains.Chain {
+	if m != nil {
+		return m.ChainList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRestrictedAddresses() []string {
+	if m != nil {
+		return m.RestrictedAddresses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRelayerPenalties() []RelayerPenalty {
+	if m != nil {
+		return m.RelayerPenalties
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTssRotation() *TssRotation {
+	if m != nil {
+		return m.TssRotation
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "relayer.GenesisState")
 }
@@ -187,47 +220,55 @@ func init() {
 func init() { proto.RegisterFile("relayer/genesis.proto", fileDescriptor_debd29b7b86ba6c9) }
 
 var fileDescriptor_debd29b7b86ba6c9 = []byte{
-	// 628 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x54, 0xcf, 0x53, 0xd3, 0x4e,
-	0x14, 0x6f, 0xbf, 0xf0, 0xa5, 0xb2, 0x2d, 0x94, 0x2e, 0xe8, 0xac, 0x95, 0x89, 0x8c, 0x17, 0xf1,
-	0xd2, 0x38, 0x70, 0xf0, 0xa4, 0xa3, 0x30, 0x83, 0xa0, 0x88, 0x4c, 0xca, 0xc1, 0xf1, 0x92, 0xd9,
-	0xa6, 0x4b, 0xc8, 0x90, 0xec, 0x76, 0xf2, 0xb6, 0x4a, 0xaf, 0xfe, 0x05, 0xfe, 0x59, 0x1c, 0x39,
-	0x7a, 0x72, 0x1c, 0xfa, 0x8f, 0x38, 0xd9, 0x5f, 0x69, 0xe2, 0x29, 0xf0, 0xf9, 0xf1, 0xf6, 0xbd,
-	0xcf, 0xbe, 0x2d, 0x7a, 0x98, 0xb3, 0x94, 0xce, 0x58, 0xee, 0xc7, 0x8c, 0x33, 0x48, 0x60, 0x30,
-	0xc9, 0x85, 0x14, 0xb8, 0x65, 0xe0, 0xfe, 0x56, 0x2c, 0x62, 0xa1, 0x30, 0xbf, 0xf8, 0x4b, 0xd3,
-	0xfd, 0x2d, 0xeb, 0x1a, 0xd1, 0x34, 0x15, 0xd2, 0xa0, 0x9b, 0x0e, 0x4d, 0x69, 0xc6, 0x0c, 0xd8,
-	0xb7, 0x60, 0x74, 0x45, 0x13, 0x1e, 0x72, 0xc1, 0x23, 0x66, 0x4e, 0xe9, 0x7b, 0x8e, 0xcb, 0x05,
-	0x80, 0x16, 0x5c, 0xa6, 0x34, 0x86, 0xfa, 0x31, 0xd7, 0x6c, 0x16, 0x33, 0x5e, 0xaf, 0xc8, 0xc5,
-	0x98, 0x85, 0x34, 0x8a, 0xc4, 0x94, 0xdb, 0x16, 0x9e, 0x94, 0x1c, 0x8f, 0x58, 0x28, 0x45, 0x78,
-	0x93, 0x41, 0x5c, 0x2f, 0x37, 0xa1, 0x39, 0xcd, 0xec, 0x21, 0xdb, 0x0e, 0x65, 0x7c, 0x9c, 0xf0,
-	0xb8, 0xda, 0xa2, 0xcb, 0xc7, 0x7c, 0x0d, 0xdc, 0xb3, 0xb0, 0x04, 0xab, 0xdc, 0x59, 0x80, 0xc2,
-	0xcb, 0x29, 0x1f, 0x43, 0x98, 0x25, 0x71, 0x4e, 0xa5, 0x30, 0xa6, 0x67, 0x3f, 0x5a, 0xa8, 0xf3,
-	0x5e, 0xc7, 0x3c, 0x94, 0x54, 0x32, 0xfc, 0x02, 0xb5, 0x74, 0x80, 0x40, 0x9a, 0x3b, 0x4b, 0xbb,
-	0xed, 0xbd, 0xee, 0xc0, 0x1e, 0x73, 0xa0, 0xf0, 0xc0, 0xf2, 0xf8, 0x15, 0x5a, 0x15, 0x23, 0x60,
-	0xf9, 0x37, 0x96, 0x03, 0xf9, 0x6f, 0xa7, 0xb9, 0xdb, 0xde, 0xdb, 0x74, 0xe2, 0x40, 0x7f, 0x87,
-	0x4c, 0x1e, 0x2c, 0xdf, 0xfe, 0x7e, 0xda, 0x08, 0x4a, 0x2d, 0x7e, 0x8b, 0x7a, 0x8b, 0x39, 0x85,
-	0x69, 0x02, 0x92, 0x2c, 0xa9, 0xd3, 0xb6, 0x5c, 0x81, 0x33, 0x31, 0x66, 0xef, 0xb4, 0x20, 0xe8,
-	0xf2, 0xf2, 0x9f, 0xd3, 0x04, 0x24, 0x3e, 0x44, 0x1b, 0xf5, 0xfb, 0x21, 0xcb, 0xaa, 0x03, 0xe2,
-	0x0a, 0x1c, 0x3a, 0xc1, 0x51, 0xc1, 0x07, 0xdd, 0xa8, 0x0a, 0xe0, 0xe7, 0x68, 0x45, 0xa7, 0x4e,
-	0xfe, 0x57, 0xd6, 0x72, 0xd2, 0x73, 0x05, 0x07, 0x86, 0x2e, 0x84, 0xfa, 0xb6, 0xc9, 0x4a, 0x4d,
-	0xf8, 0x51, 0xc1, 0x81, 0xa1, 0xf1, 0x09, 0xda, 0x4c, 0x29, 0xc8, 0xd0, 0x8e, 0x1a, 0xaa, 0x8e,
-	0x49, 0x4b, 0xb9, 0x1e, 0x3b, 0xd7, 0x29, 0x05, 0x69, 0xf2, 0x39, 0x54, 0xf3, 0xf5, 0x0a, 0xd7,
-	0x67, 0x63, 0x52, 0x10, 0xfe, 0x80, 0x7a, 0x7a, 0x38, 0xdd, 0x83, 0xce, 0xe8, 0x41, 0x7d, 0xc4,
-	0x42, 0xa1, 0x9b, 0x2d, 0x62, 0x31, 0x49, 0x77, 0xa3, 0x2a, 0x8c, 0x3d, 0xb4, 0x24, 0x01, 0xc8,
-	0xaa, 0x72, 0x77, 0x9c, 0xfb, 0x62, 0x38, 0x0c, 0x0a, 0x02, 0xef, 0xa3, 0x76, 0xb1, 0x20, 0x57,
-	0x09, 0x48, 0x91, 0xcf, 0x08, 0x52, 0x37, 0x51, 0xd1, 0x99, 0xca, 0x48, 0x02, 0x1c, 0x6b, 0x15,
-	0x3e, 0x47, 0xd8, 0x6e, 0x95, 0x5b, 0x2a, 0x20, 0x6d, 0xe5, 0xdd, 0x2e, 0xbd, 0x00, 0x47, 0x53,
-	0x3e, 0xfe, 0x64, 0x04, 0x27, 0xfc, 0x52, 0x98, 0x5a, 0x1b, 0xb2, 0x4a, 0x15, 0x6d, 0x20, 0xf5,
-	0x4a, 0xf5, 0xac, 0x1d, 0x55, 0x69, 0xbd, 0xdc, 0xbe, 0x82, 0xb2, 0xbb, 0xa4, 0x74, 0x66, 0x13,
-	0xd6, 0xab, 0x8f, 0x84, 0xac, 0x29, 0xe3, 0xa3, 0xf2, 0x32, 0x35, 0x7d, 0xa6, 0x58, 0x53, 0x60,
-	0x6d, 0xb2, 0x08, 0xe2, 0xd7, 0xa8, 0xb3, 0xf8, 0x53, 0x40, 0xd6, 0x6b, 0xbb, 0xa8, 0x72, 0xae,
-	0x14, 0x68, 0x47, 0x25, 0x84, 0xdf, 0xa0, 0xb5, 0xca, 0xdb, 0x26, 0xdd, 0x7f, 0x76, 0x99, 0x47,
-	0xec, 0x42, 0x7c, 0xc9, 0x20, 0xb6, 0x7e, 0xbe, 0x00, 0x1d, 0xdf, 0xde, 0x7b, 0xcd, 0xbb, 0x7b,
-	0xaf, 0xf9, 0xe7, 0xde, 0x6b, 0xfe, 0x9c, 0x7b, 0x8d, 0xbb, 0xb9, 0xd7, 0xf8, 0x35, 0xf7, 0x1a,
-	0x5f, 0x07, 0x71, 0x22, 0xaf, 0xa6, 0xa3, 0x41, 0x24, 0x32, 0xff, 0xe5, 0xcd, 0x39, 0x4b, 0xd3,
-	0x33, 0x26, 0xbf, 0x8b, 0xfc, 0xda, 0xa7, 0x2c, 0x4e, 0xc0, 0xbf, 0xf1, 0xdd, 0x0b, 0x9f, 0x4d,
-	0x18, 0x8c, 0x56, 0xd4, 0xab, 0xde, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xe9, 0x01, 0x2d, 0x24,
-	0x43, 0x05, 0x00, 0x00,
+	// 754 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x54, 0xcd, 0x52, 0xe3, 0x46,
+	0x10, 0xb6, 0x63, 0xc2, 0xcf, 0xd8, 0x60, 0x3c, 0x76, 0xc2, 0x84, 0x10, 0xc5, 0x95, 0x4b, 0xc8,
+	0xc5, 0x4e, 0xa0, 0x2a, 0x39, 0x25, 0x15, 0xa0, 0x8a, 0x40, 0x42, 0x58, 0x97, 0xcc, 0x61, 0x6b,
+	0x2f, 0xaa, 0xb1, 0x34, 0x08, 0x95, 0xe5, 0x19, 0xd7, 0xf4, 0x78, 0x17, 0xbf, 0xc5, 0x3e, 0x16,
+	0x47, 0x8e, 0x7b, 0xda, 0xda, 0x82, 0x37, 0xd8, 0x27, 0xd8, 0xd2, 0xfc, 0x48, 0x96, 0x38, 0xc9,
+	0xfe, 0xbe, 0xaf, 0xbf, 0xe9, 0xee, 0xe9, 0x69, 0xf4, 0x8d, 0x64, 0x29, 0x5d, 0x32, 0x39, 0x8c,
+	0x19, 0x67, 0x90, 0xc0, 0x60, 0x2e, 0x85, 0x12, 0x78, 0xc3, 0xc2, 0xfb, 0xbd, 0x58, 0xc4, 0x42,
+	0x63, 0xc3, 0xec, 0x97, 0xa1, 0xf7, 0xf7, 0xe6, 0xd3, 0x78, 0x18, 0xde, 0xd1, 0x84, 0x83, 0xfd,
+	0x58, 0xa2, 0xe7, 0xec, 0x26, 0x34, 0x4d, 0x85, 0xb2, 0x68, 0x37, 0x47, 0x53, 0x3a, 0x63, 0x16,
+	0xdc, 0x77, 0xa0, 0x36, 0x08, 0xb8, 0xe0, 0x21, 0x73, 0x36, 0x5e, 0xce, 0x49, 0x01, 0x60, 0x04,
+	0xb7, 0x29, 0x8d, 0x5f, 0x1c, 0x33, 0x65, 0xcb, 0x98, 0xf1, 0xaa, 0x23, 0x17, 0x11, 0x0b, 0x68,
+	0x18, 0x8a, 0x05, 0x77, 0x29, 0x7c, 0x5f, 0x70, 0x3c, 0x64, 0x81, 0x12, 0xc1, 0xfd, 0x0c, 0xe2,
+	0xaa, 0xdd, 0x9c, 0x4a, 0x3a, 0x73, 0x87, 0x1c, 0xe4, 0x28, 0xe3, 0x51, 0xc2, 0xe3, 0x72, 0x8a,
+	0x79, 0xe3, 0xec, 0xd7, 0xc2, 0x3f, 0x54, 0xe0, 0x60, 0xce, 0x38, 0x4d, 0xd5, 0xd2, 0xd2, 0x1d,
+	0x47, 0x2b, 0x70, 0x46, 0xfd, 0x15, 0x28, 0xb8, 0x5d, 0xf0, 0x08, 0x82, 0x59, 0x12, 0x4b, 0xaa,
+	0x84, 0xac, 0xd6, 0x95, 0x29, 0xa4, 0x50, 0x54, 0x25, 0xc2, 0xd6, 0xfc, 0xd3, 0xe7, 0x4d, 0xd4,
+	0xfa, 0xc7, 0x5c, 0xdd, 0x58, 0x51, 0xc5, 0xf0, 0x2f, 0x68, 0xc3, 0xf4, 0x1e, 0x48, 0xbd, 0xdf,
+	0x38, 0x6c, 0x1e, 0xb5, 0x07, 0x2e, 0xc3, 0x53, 0x8d, 0xfb, 0x8e, 0xc7, 0x7f, 0xa0, 0x2d, 0x31,
+	0x01, 0x26, 0xdf, 0x32, 0x09, 0xe4, 0xab, 0x7e, 0xfd, 0xb0, 0x79, 0xd4, 0xcd, 0xc5, 0xbe, 0xf9,
+	0x8e, 0x99, 0x3a, 0x5d, 0x7b, 0xf8, 0xf8, 0x63, 0xcd, 0x2f, 0xb4, 0xf8, 0x6f, 0xd4, 0x59, 0x6d,
+	0x71, 0x90, 0x26, 0xa0, 0x48, 0x43, 0x9f, 0xd6, 0xcb, 0x0d, 0xae, 0x45, 0xc4, 0x4e, 0x8c, 0xc0,
+	0x6f, 0xf3, 0xe2, 0xcf, 0x55, 0x02, 0x0a, 0x9f, 0xa1, 0xdd, 0xea, 0xd5, 0x92, 0x35, 0x9d, 0x01,
+	0xc9, 0x0d, 0xce, 0x72, 0xc1, 0x79, 0xc6, 0xfb, 0xed, 0xb0, 0x0c, 0xe0, 0x9f, 0xd1, 0xba, 0xb9,
+	0x30, 0xf2, 0x75, 0xbf, 0x5e, 0xaa, 0x74, 0xa4, 0x61, 0xdf, 0xd2, 0x99, 0xd0, 0x0c, 0x0a, 0x59,
+	0xaf, 0x08, 0xff, 0xd3, 0xb0, 0x6f, 0x69, 0x7c, 0x89, 0xba, 0x29, 0x05, 0x15, 0xb8, 0x52, 0x03,
+	0x9d, 0x31, 0xd9, 0xd0, 0x51, 0xdf, 0xe5, 0x51, 0x57, 0x14, 0x94, 0xed, 0xcf, 0x99, 0xae, 0xaf,
+	0x93, 0x45, 0xbd, 0xb2, 0x41, 0x1a, 0xc2, 0xff, 0xa2, 0x8e, 0x29, 0xce, 0xe4, 0x60, 0x7a, 0xb4,
+	0x59, 0x2d, 0x31, 0x53, 0x98, 0x64, 0xb3, 0xb6, 0xd8, 0x4e, 0xb7, 0xc3, 0x32, 0x8c, 0x3d, 0xd4,
+	0x50, 0x00, 0x64, 0x4b, 0x47, 0xb7, 0xf2, 0xe8, 0x9b, 0xf1, 0xd8, 0xcf, 0x08, 0x7c, 0x8c, 0x9a,
+	0xd9, 0x68, 0xdc, 0x25, 0xa0, 0x84, 0x5c, 0x12, 0xd4, 0x6f, 0x54, 0x75, 0xd6, 0x19, 0x29, 0x80,
+	0x0b, 0xa3, 0xc2, 0x23, 0x84, 0xdd, 0xc4, 0xe5, 0x03, 0x07, 0xa4, 0xa9, 0x63, 0x0f, 0x8a, 0x58,
+	0x80, 0xf3, 0x05, 0x8f, 0xfe, 0xb7, 0x82, 0x4b, 0x7e, 0x2b, 0xac, 0xd7, 0xae, 0x2a, 0x53, 0x59,
+	0x1a, 0x48, 0x3f, 0x70, 0x53, 0x6b, 0x4b, 0x3b, 0xed, 0x14, 0xd3, 0x97, 0x51, 0x6e, 0x96, 0xb4,
+	0xce, 0x4e, 0xc2, 0x4e, 0xf9, 0x7d, 0x91, 0x6d, 0x1d, 0xf8, 0x6d, 0x71, 0x99, 0x86, 0xbe, 0xd6,
+	0xac, 0x35, 0xd8, 0x9e, 0xaf, 0x82, 0xf8, 0x4f, 0xd4, 0x5a, 0xdd, 0x22, 0x64, 0xa7, 0x32, 0x8b,
+	0xba, 0xcf, 0x25, 0x83, 0x66, 0x58, 0x40, 0xf8, 0x2f, 0xb4, 0x5d, 0x5a, 0x0b, 0xa4, 0xfd, 0x62,
+	0x96, 0x79, 0xc8, 0x6e, 0xc4, 0xeb, 0x19, 0xc4, 0x2e, 0x9e, 0x17, 0x10, 0xfe, 0x1d, 0x21, 0x73,
+	0xbc, 0x2e, 0x7c, 0x57, 0x07, 0x77, 0x06, 0xf3, 0x69, 0x3c, 0xb0, 0xcb, 0x51, 0x9f, 0xef, 0x6a,
+	0xd7, 0x98, 0xae, 0xfd, 0x37, 0xd4, 0x93, 0x0c, 0x94, 0x4c, 0x42, 0xc5, 0xa2, 0x80, 0x46, 0x91,
+	0x64, 0x00, 0x0c, 0x48, 0xa7, 0xdf, 0x38, 0xdc, 0xf2, 0xbb, 0x05, 0x77, 0xe2, 0xa8, 0x6c, 0xac,
+	0xca, 0x9b, 0x25, 0x61, 0x40, 0xb0, 0x3e, 0x71, 0xaf, 0xfa, 0x76, 0x47, 0x5a, 0xb0, 0x74, 0xf7,
+	0x25, 0x57, 0xd1, 0x84, 0x65, 0xef, 0xbf, 0xb5, 0xba, 0x51, 0x48, 0xb7, 0x5f, 0x2f, 0x55, 0x7d,
+	0x03, 0xe0, 0x5b, 0xce, 0x6f, 0xaa, 0xe2, 0xcf, 0xe9, 0xc5, 0xc3, 0x93, 0x57, 0x7f, 0x7c, 0xf2,
+	0xea, 0x9f, 0x9e, 0xbc, 0xfa, 0xfb, 0x67, 0xaf, 0xf6, 0xf8, 0xec, 0xd5, 0x3e, 0x3c, 0x7b, 0xb5,
+	0x37, 0x83, 0x38, 0x51, 0x77, 0x8b, 0xc9, 0x20, 0x14, 0xb3, 0xe1, 0xaf, 0xf7, 0x23, 0x96, 0xa6,
+	0xd7, 0x4c, 0xbd, 0x13, 0x72, 0x3a, 0xa4, 0x2c, 0x4e, 0x60, 0x78, 0x3f, 0xcc, 0x77, 0xd9, 0x72,
+	0xce, 0x60, 0xb2, 0xae, 0xb7, 0xd8, 0xf1, 0x97, 0x01, 0x00, 0x92, 0xc2, 0xd1, 0xb3, 0x87, 0x06,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -250,6 +291,63 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TssRotation != nil {
+		{
+			size, err := m.TssRotation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.RelayerPenalties) > 0 {
+		for iNdEx := len(m.RelayerPenalties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RelayerPenalties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.RestrictedAddresses) > 0 {
+		for iNdEx := len(m.RestrictedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RestrictedAddresses[iNdEx])
+			copy(dAtA[i:], m.RestrictedAddresses[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.RestrictedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.ChainList) > 0 {
+		for iNdEx := len(m.ChainList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
 	if len(m.NonceToXmsg) > 0 {
 		for iNdEx := len(m.NonceToXmsg) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -534,6 +632,28 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ChainList) > 0 {
+		for _, e := range m.ChainList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RestrictedAddresses) > 0 {
+		for _, s := range m.RestrictedAddresses {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RelayerPenalties) > 0 {
+		for _, e := range m.RelayerPenalties {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.TssRotation != nil {
+		l = m.TssRotation.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -1090,6 +1210,142 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainList = append(m.ChainList, chains.Chain{})
+			if err := m.ChainList[len(m.ChainList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestrictedAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RestrictedAddresses = append(m.RestrictedAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerPenalties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelayerPenalties = append(m.RelayerPenalties, RelayerPenalty{})
+			if err := m.RelayerPenalties[len(m.RelayerPenalties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TssRotation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TssRotation == nil {
+				m.TssRotation = &TssRotation{}
+			}
+			if err := m.TssRotation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/0xPellNetwork/aegis/pkg/chains"
 	"github.com/0xPellNetwork/aegis/testutil/sample"
 	"github.com/0xPellNetwork/aegis/x/relayer/types"
 )
@@ -22,6 +23,49 @@ func TestGenesisState_Validate(t *testing.T) {
 	chainNonce := sample.ChainNonces_pell(t, "0")
 	gsWithDuplicateChainNonces.ChainNonces = []types.ChainNonces{chainNonce, chainNonce}
 
+	gsWithDuplicateChainList := types.DefaultGenesis()
+	gsWithDuplicateChainList.ChainList = append(gsWithDuplicateChainList.ChainList, chains.EthChain())
+
+	gsWithInvalidChainList := types.DefaultGenesis()
+	gsWithInvalidChainList.ChainList = append(gsWithInvalidChainList.ChainList, chains.Chain{Id: 0})
+
+	gsWithChainParamsNotInChainList := types.DefaultGenesis()
+	gsWithChainParamsNotInChainList.ChainList = []chains.Chain{chains.EthChain()}
+	gsWithChainParamsNotInChainList.ChainParamsList.ChainParams = types.GetDefaultChainParams().ChainParams
+
+	gsWithRestrictedAddresses := types.DefaultGenesis()
+	gsWithRestrictedAddresses.RestrictedAddresses = []string{sample.EthAddress().Hex(), sample.EthAddress().Hex()}
+
+	gsWithDuplicateRestrictedAddresses := types.DefaultGenesis()
+	restrictedAddress := sample.EthAddress().Hex()
+	gsWithDuplicateRestrictedAddresses.RestrictedAddresses = []string{restrictedAddress, restrictedAddress}
+
+	gsWithRelayerPenalties := types.DefaultGenesis()
+	gsWithRelayerPenalties.RelayerPenalties = []types.RelayerPenalty{
+		sample.RelayerPenalty_pell(t, "0"),
+		sample.RelayerPenalty_pell(t, "1"),
+	}
+
+	gsWithDuplicateRelayerPenalties := types.DefaultGenesis()
+	penalty := sample.RelayerPenalty_pell(t, "0")
+	gsWithDuplicateRelayerPenalties.RelayerPenalties = []types.RelayerPenalty{penalty, penalty}
+
+	gsWithInvalidRelayerPenalty := types.DefaultGenesis()
+	invalidPenalty := sample.RelayerPenalty_pell(t, "0")
+	invalidPenalty.ObserverAddress = "invalid"
+	gsWithInvalidRelayerPenalty.RelayerPenalties = []types.RelayerPenalty{invalidPenalty}
+
+	gsWithDuplicateChainPauses := types.DefaultGenesis()
+	gsWithDuplicateChainPauses.CrosschainFlags.ChainPauses = []types.ChainPause{
+		{ChainId: 1, IsInboundPaused: true},
+		{ChainId: 1, IsSigningPaused: true},
+	}
+
+	gsWithInvalidTssRotation := types.DefaultGenesis()
+	gsWithInvalidTssRotation.TssRotation = &types.TssRotation{
+		Migrations: []types.TssRotationMigration{{ChainId: 1}},
+	}
+
 	for _, tc := range []struct {
 		desc     string
 		genState *types.GenesisState
@@ -42,6 +86,21 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: invalidChainParamsGen,
 			valid:    false,
 		},
+		{
+			desc:     "invalid genesis state duplicate chain list",
+			genState: gsWithDuplicateChainList,
+			valid:    false,
+		},
+		{
+			desc:     "invalid genesis state invalid chain in chain list",
+			genState: gsWithInvalidChainList,
+			valid:    false,
+		},
+		{
+			desc:     "invalid genesis state chain params for chain not in chain list",
+			genState: gsWithChainParamsNotInChainList,
+			valid:    false,
+		},
 		{
 			desc:     "invalid genesis state duplicate node account list",
 			genState: gsWithDuplicateNodeAccountList,
@@ -52,6 +111,41 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: gsWithDuplicateChainNonces,
 			valid:    false,
 		},
+		{
+			desc:     "valid genesis state with restricted addresses",
+			genState: gsWithRestrictedAddresses,
+			valid:    true,
+		},
+		{
+			desc:     "invalid genesis state duplicate restricted addresses",
+			genState: gsWithDuplicateRestrictedAddresses,
+			valid:    false,
+		},
+		{
+			desc:     "valid genesis state with relayer penalties",
+			genState: gsWithRelayerPenalties,
+			valid:    true,
+		},
+		{
+			desc:     "invalid genesis state duplicate relayer penalties",
+			genState: gsWithDuplicateRelayerPenalties,
+			valid:    false,
+		},
+		{
+			desc:     "invalid genesis state invalid relayer penalty",
+			genState: gsWithInvalidRelayerPenalty,
+			valid:    false,
+		},
+		{
+			desc:     "invalid genesis state duplicate chain pauses",
+			genState: gsWithDuplicateChainPauses,
+			valid:    false,
+		},
+		{
+			desc:     "invalid genesis state invalid tss rotation",
+			genState: gsWithInvalidTssRotation,
+			valid:    false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
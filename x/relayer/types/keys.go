@@ -25,6 +25,18 @@ const (
 	GroupID1Address = "pell14r8nqy53kuruf7pp6aau3d8029ncxnwer54weg"
 
 	MinObserverDelegation = "1000000000000000000"
+
+	// DefaultPenaltyWindowBlocks is the default number of blocks of the penalty window, about one day
+	DefaultPenaltyWindowBlocks = int64(14400)
+
+	// DefaultMaxBlamesPerWindow is the default number of blames in a window from which an observer is jailed
+	DefaultMaxBlamesPerWindow = uint64(50)
+
+	// DefaultMaxMissedBallotsPerWindow is the default number of missed ballots in a window from which an observer is jailed
+	DefaultMaxMissedBallotsPerWindow = uint64(1000)
+
+	// DefaultJailDurationBlocks is the default number of blocks a jailed observer must wait before being unjailed
+	DefaultJailDurationBlocks = int64(14400)
 )
 
 func GetMinObserverDelegation() (sdkmath.Int, bool) {
@@ -49,6 +61,12 @@ const (
 	// TODO change identifier for VoterKey to something more descriptive
 	VoterKey = "Voter-value-"
 
+	// ChainRegistryKey is the key prefix for the chains of the chain registry
+	ChainRegistryKey = "ChainRegistry-value-"
+
+	// RestrictedAddressKey is the key prefix for the addresses of the restricted address list
+	RestrictedAddressKey = "RestrictedAddress-value-"
+
 	// AllChainParamsKey is the ke prefix for all chain params
 	// NOTE: CoreParams is old name for AllChainParams we keep it as key value for backward compatibility
 	AllChainParamsKey = "CoreParams"
@@ -59,6 +77,11 @@ const (
 	AdminPolicyParamsKey          = "AdminParams"
 	BallotMaturityBlocksParamsKey = "BallotMaturityBlocksParams"
 
+	PenaltyWindowBlocksParamsKey       = "PenaltyWindowBlocksParams"
+	MaxBlamesPerWindowParamsKey        = "MaxBlamesPerWindowParams"
+	MaxMissedBallotsPerWindowParamsKey = "MaxMissedBallotsPerWindowParams"
+	JailDurationBlocksParamsKey        = "JailDurationBlocksParams"
+
 	// CrosschainFlagsKey is the key for the crosschain flags
 	// NOTE: PermissionFlags is old name for CrosschainFlags we keep it as key value for backward compatibility
 	CrosschainFlagsKey    = "PermissionFlags-value-"
@@ -80,7 +103,13 @@ const (
 	NonceToXmsgKeyPrefix   = "NonceToXmsg-value-"
 
 	AddPellTokenBallotPrefix = "AddPellTokenBallot-"
-	AddGasTokenBallotPrefix  = "AddGasTokenBallot-"
+
+	// RelayerPenaltyKey is the key prefix for the penalty records of the observers
+	RelayerPenaltyKey       = "RelayerPenalty-value-"
+	AddGasTokenBallotPrefix = "AddGasTokenBallot-"
+
+	// TssRotationKey is the key for the progress of the current or last tss rotation
+	TssRotationKey = "TssRotation-value-"
 )
 
 func GetBlameIndex(chainID int64, nonce uint64, digest string, height uint64) string {
@@ -5,8 +5,6 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ethereum/go-ethereum/crypto"
-
-	"github.com/0xPellNetwork/aegis/pkg/chains"
 )
 
 const TypeMsgAddBlameVote = "add_blame_vote"
@@ -34,7 +32,7 @@ func (m *MsgAddBlameVote) ValidateBasic() error {
 		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
 
-	if _, exist := chains.GetChainByChainId(m.ChainId); !exist {
+	if m.ChainId <= 0 {
 		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidChainID, "chain id (%d)", m.ChainId)
 	}
 
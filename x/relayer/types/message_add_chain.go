@@ -0,0 +1,58 @@
+package types
+
+import (
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/0xPellNetwork/aegis/pkg/chains"
+)
+
+const TypeMsgAddChain = "add_chain"
+
+var _ sdk.Msg = &MsgAddChain{}
+
+func NewMsgAddChain(creator string, chain chains.Chain) *MsgAddChain {
+	return &MsgAddChain{
+		Signer: creator,
+		Chain:  chain,
+	}
+}
+
+func (msg *MsgAddChain) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAddChain) Type() string {
+	return TypeMsgAddChain
+}
+
+func (msg *MsgAddChain) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgAddChain) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAddChain) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if err := msg.Chain.Validate(); err != nil {
+		return cosmoserrors.Wrap(ErrInvalidChain, err.Error())
+	}
+
+	// chains are deprecated through MsgDeprecateChain
+	if msg.Chain.IsDeprecated {
+		return cosmoserrors.Wrap(ErrInvalidChain, "cannot add a deprecated chain")
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCancelTssRotation = "cancel_tss_rotation"
+
+var _ sdk.Msg = &MsgCancelTssRotation{}
+
+func NewMsgCancelTssRotation(signer string) *MsgCancelTssRotation {
+	return &MsgCancelTssRotation{
+		Signer: signer,
+	}
+}
+
+func (msg *MsgCancelTssRotation) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCancelTssRotation) Type() string {
+	return TypeMsgCancelTssRotation
+}
+
+func (msg *MsgCancelTssRotation) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg *MsgCancelTssRotation) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCancelTssRotation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address (%s)", err)
+	}
+	return nil
+}
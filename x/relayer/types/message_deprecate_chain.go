@@ -0,0 +1,51 @@
+package types
+
+import (
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgDeprecateChain = "deprecate_chain"
+
+var _ sdk.Msg = &MsgDeprecateChain{}
+
+func NewMsgDeprecateChain(creator string, chainID int64) *MsgDeprecateChain {
+	return &MsgDeprecateChain{
+		Signer:  creator,
+		ChainId: chainID,
+	}
+}
+
+func (msg *MsgDeprecateChain) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgDeprecateChain) Type() string {
+	return TypeMsgDeprecateChain
+}
+
+func (msg *MsgDeprecateChain) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgDeprecateChain) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgDeprecateChain) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if msg.ChainId <= 0 {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidChainID, "invalid chain id (%d)", msg.ChainId)
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/testutil/sample"
+	"github.com/0xPellNetwork/aegis/x/relayer/types"
+)
+
+func TestMsgDeprecateChain_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *types.MsgDeprecateChain
+		err  error
+	}{
+		{
+			name: "valid message",
+			msg:  types.NewMsgDeprecateChain(sample.AccAddress(), 1000),
+		},
+		{
+			name: "invalid address",
+			msg:  types.NewMsgDeprecateChain("invalid_address", 1000),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid chain ID",
+			msg:  types.NewMsgDeprecateChain(sample.AccAddress(), 0),
+			err:  sdkerrors.ErrInvalidChainID,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgDeprecateChain_GetSigners(t *testing.T) {
+	signer := sample.AccAddress()
+	tests := []struct {
+		name   string
+		msg    types.MsgDeprecateChain
+		panics bool
+	}{
+		{
+			name: "valid signer",
+			msg: types.MsgDeprecateChain{
+				Signer: signer,
+			},
+			panics: false,
+		},
+		{
+			name: "invalid signer",
+			msg: types.MsgDeprecateChain{
+				Signer: "invalid",
+			},
+			panics: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.panics {
+				signers := tt.msg.GetSigners()
+				require.Equal(t, []sdk.AccAddress{sdk.MustAccAddressFromBech32(signer)}, signers)
+			} else {
+				require.Panics(t, func() {
+					tt.msg.GetSigners()
+				})
+			}
+		})
+	}
+}
+
+func TestMsgDeprecateChain_Type(t *testing.T) {
+	msg := types.MsgDeprecateChain{
+		Signer: sample.AccAddress(),
+	}
+	require.Equal(t, types.TypeMsgDeprecateChain, msg.Type())
+}
+
+func TestMsgDeprecateChain_Route(t *testing.T) {
+	msg := types.MsgDeprecateChain{
+		Signer: sample.AccAddress(),
+	}
+	require.Equal(t, types.RouterKey, msg.Route())
+}
+
+func TestMsgDeprecateChain_GetSignBytes(t *testing.T) {
+	msg := types.MsgDeprecateChain{
+		Signer: sample.AccAddress(),
+	}
+	require.NotPanics(t, func() {
+		msg.GetSignBytes()
+	})
+}
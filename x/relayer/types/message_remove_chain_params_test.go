@@ -40,7 +40,7 @@ func TestMsgRemoveChainParams_ValidateBasic(t *testing.T) {
 			name: "invalid chain ID",
 			msg: types.NewMsgRemoveChainParams(
 				sample.AccAddress(),
-				999,
+				0,
 			),
 			err: sdkerrors.ErrInvalidChainID,
 		},
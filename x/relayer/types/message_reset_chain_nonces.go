@@ -6,8 +6,6 @@ import (
 	cosmoserrors "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-
-	"github.com/0xPellNetwork/aegis/pkg/chains"
 )
 
 const TypeMsgResetChainNonces = "reset_chain_nonces"
@@ -50,8 +48,8 @@ func (msg *MsgResetChainNonces) ValidateBasic() error {
 		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
 
-	// Check if chain exists
-	if _, exist := chains.GetChainByChainId(msg.ChainId); !exist {
+	// the chain existence is checked against the chain registry when the message is processed
+	if msg.ChainId <= 0 {
 		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidChainID, "invalid chain id (%d)", msg.ChainId)
 	}
 
@@ -51,7 +51,7 @@ func TestMsgResetChainNonces_ValidateBasic(t *testing.T) {
 			name: "invalid chain ID",
 			msg: types.MsgResetChainNonces{
 				Signer:  sample.AccAddress(),
-				ChainId: 999,
+				ChainId: 0,
 			},
 			wantErr: true,
 		},
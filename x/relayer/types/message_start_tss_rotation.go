@@ -0,0 +1,61 @@
+package types
+
+import (
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgStartTssRotation = "start_tss_rotation"
+
+var _ sdk.Msg = &MsgStartTssRotation{}
+
+func NewMsgStartTssRotation(signer string, keygenBlock int64, migrations []TssRotationMigration) *MsgStartTssRotation {
+	return &MsgStartTssRotation{
+		Signer:      signer,
+		KeygenBlock: keygenBlock,
+		Migrations:  migrations,
+	}
+}
+
+func (msg *MsgStartTssRotation) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgStartTssRotation) Type() string {
+	return TypeMsgStartTssRotation
+}
+
+func (msg *MsgStartTssRotation) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg *MsgStartTssRotation) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgStartTssRotation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address (%s)", err)
+	}
+	if msg.KeygenBlock <= 0 {
+		return cosmoserrors.Wrapf(ErrInvalidTssRotation, "invalid keygen block (%d)", msg.KeygenBlock)
+	}
+	if len(msg.Migrations) == 0 {
+		return cosmoserrors.Wrap(ErrInvalidTssRotation, "no migration defined")
+	}
+	for _, migration := range msg.Migrations {
+		if migration.MigrationXmsgIndex != "" || migration.PellMigrationXmsgIndex != "" {
+			return cosmoserrors.Wrapf(ErrInvalidTssRotation, "migration xmsg index set for chain %d", migration.ChainId)
+		}
+	}
+	if err := ValidateTssRotationMigrations(msg.Migrations); err != nil {
+		return cosmoserrors.Wrap(ErrInvalidTssRotation, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/testutil/sample"
+	"github.com/0xPellNetwork/aegis/x/relayer/types"
+)
+
+func TestMsgStartTssRotation_ValidateBasic(t *testing.T) {
+	migrations := []types.TssRotationMigration{{ChainId: 1, Amount: sdkmath.NewUint(10)}}
+
+	tests := []struct {
+		name string
+		msg  *types.MsgStartTssRotation
+		err  bool
+	}{
+		{
+			name: "valid message",
+			msg:  types.NewMsgStartTssRotation(sample.AccAddress(), 100, migrations),
+		},
+		{
+			name: "invalid signer",
+			msg:  types.NewMsgStartTssRotation("invalid", 100, migrations),
+			err:  true,
+		},
+		{
+			name: "invalid keygen block",
+			msg:  types.NewMsgStartTssRotation(sample.AccAddress(), 0, migrations),
+			err:  true,
+		},
+		{
+			name: "no migration",
+			msg:  types.NewMsgStartTssRotation(sample.AccAddress(), 100, nil),
+			err:  true,
+		},
+		{
+			name: "duplicated migration",
+			msg:  types.NewMsgStartTssRotation(sample.AccAddress(), 100, append(migrations, migrations...)),
+			err:  true,
+		},
+		{
+			name: "migration xmsg index set",
+			msg: types.NewMsgStartTssRotation(sample.AccAddress(), 100, []types.TssRotationMigration{
+				{ChainId: 1, Amount: sdkmath.NewUint(10), MigrationXmsgIndex: "index"},
+			}),
+			err: true,
+		},
+		{
+			name: "pell migration xmsg index set",
+			msg: types.NewMsgStartTssRotation(sample.AccAddress(), 100, []types.TssRotationMigration{
+				{ChainId: 1, Amount: sdkmath.NewUint(10), PellAmount: sdkmath.NewUint(5), PellMigrationXmsgIndex: "index"},
+			}),
+			err: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgCancelTssRotation_ValidateBasic(t *testing.T) {
+	require.NoError(t, types.NewMsgCancelTssRotation(sample.AccAddress()).ValidateBasic())
+	require.Error(t, types.NewMsgCancelTssRotation("invalid").ValidateBasic())
+}
@@ -0,0 +1,57 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/testutil/sample"
+	"github.com/0xPellNetwork/aegis/x/relayer/types"
+)
+
+func TestMsgUnjailObserver_ValidateBasic(t *testing.T) {
+	t.Run("valid message", func(t *testing.T) {
+		msg := types.NewMsgUnjailObserver(sample.AccAddress())
+		require.NoError(t, msg.ValidateBasic())
+	})
+
+	t.Run("invalid address", func(t *testing.T) {
+		msg := types.NewMsgUnjailObserver("invalid_address")
+		require.ErrorIs(t, msg.ValidateBasic(), sdkerrors.ErrInvalidAddress)
+	})
+}
+
+func TestMsgUnjailObserver_GetSigners(t *testing.T) {
+	signer := sample.AccAddress()
+
+	t.Run("valid signer", func(t *testing.T) {
+		msg := types.NewMsgUnjailObserver(signer)
+		require.Equal(t, []sdk.AccAddress{sdk.MustAccAddressFromBech32(signer)}, msg.GetSigners())
+	})
+
+	t.Run("invalid signer", func(t *testing.T) {
+		msg := types.NewMsgUnjailObserver("invalid")
+		require.Panics(t, func() {
+			msg.GetSigners()
+		})
+	})
+}
+
+func TestMsgUnjailObserver_Type(t *testing.T) {
+	msg := types.NewMsgUnjailObserver(sample.AccAddress())
+	require.Equal(t, types.TypeMsgUnjailObserver, msg.Type())
+}
+
+func TestMsgUnjailObserver_Route(t *testing.T) {
+	msg := types.NewMsgUnjailObserver(sample.AccAddress())
+	require.Equal(t, types.RouterKey, msg.Route())
+}
+
+func TestMsgUnjailObserver_GetSignBytes(t *testing.T) {
+	msg := types.NewMsgUnjailObserver(sample.AccAddress())
+	require.NotPanics(t, func() {
+		msg.GetSignBytes()
+	})
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/0xPellNetwork/aegis/pkg/chains"
+)
+
+const TypeMsgUpdateChain = "update_chain"
+
+var _ sdk.Msg = &MsgUpdateChain{}
+
+func NewMsgUpdateChain(creator string, chain chains.Chain) *MsgUpdateChain {
+	return &MsgUpdateChain{
+		Signer: creator,
+		Chain:  chain,
+	}
+}
+
+func (msg *MsgUpdateChain) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateChain) Type() string {
+	return TypeMsgUpdateChain
+}
+
+func (msg *MsgUpdateChain) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpdateChain) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateChain) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if err := msg.Chain.Validate(); err != nil {
+		return cosmoserrors.Wrap(ErrInvalidChain, err.Error())
+	}
+
+	// chains are deprecated through MsgDeprecateChain
+	if msg.Chain.IsDeprecated {
+		return cosmoserrors.Wrap(ErrInvalidChain, "cannot update a chain to deprecated")
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgUpdateChainPause = "update_chain_pause"
+
+var _ sdk.Msg = &MsgUpdateChainPause{}
+
+func NewMsgUpdateChainPause(
+	signer string,
+	chainID int64,
+	isInboundPaused bool,
+	isOutboundPaused bool,
+	isSigningPaused bool,
+	expiryHeight int64,
+) *MsgUpdateChainPause {
+	return &MsgUpdateChainPause{
+		Signer:           signer,
+		ChainId:          chainID,
+		IsInboundPaused:  isInboundPaused,
+		IsOutboundPaused: isOutboundPaused,
+		IsSigningPaused:  isSigningPaused,
+		ExpiryHeight:     expiryHeight,
+	}
+}
+
+func (msg *MsgUpdateChainPause) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateChainPause) Type() string {
+	return TypeMsgUpdateChainPause
+}
+
+func (msg *MsgUpdateChainPause) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg *MsgUpdateChainPause) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateChainPause) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address (%s)", err)
+	}
+	if msg.ChainId <= 0 {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidChainID, "invalid chain id (%d)", msg.ChainId)
+	}
+	if msg.ExpiryHeight < 0 {
+		return cosmoserrors.Wrapf(ErrInvalidChainPause, "invalid expiry height (%d)", msg.ExpiryHeight)
+	}
+	return nil
+}
+
+// ChainPause returns the chain pause set by the message
+func (msg *MsgUpdateChainPause) ChainPause() ChainPause {
+	return ChainPause{
+		ChainId:          msg.ChainId,
+		IsInboundPaused:  msg.IsInboundPaused,
+		IsOutboundPaused: msg.IsOutboundPaused,
+		IsSigningPaused:  msg.IsSigningPaused,
+		ExpiryHeight:     msg.ExpiryHeight,
+	}
+}
@@ -0,0 +1,124 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/testutil/sample"
+	"github.com/0xPellNetwork/aegis/x/relayer/types"
+)
+
+func TestMsgUpdateChainPause_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *types.MsgUpdateChainPause
+		err  error
+	}{
+		{
+			name: "valid message",
+			msg:  types.NewMsgUpdateChainPause(sample.AccAddress(), 1000, true, false, true, 100),
+		},
+		{
+			name: "valid message to resume a chain",
+			msg:  types.NewMsgUpdateChainPause(sample.AccAddress(), 1000, false, false, false, 0),
+		},
+		{
+			name: "invalid address",
+			msg:  types.NewMsgUpdateChainPause("invalid_address", 1000, true, false, false, 0),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid chain ID",
+			msg:  types.NewMsgUpdateChainPause(sample.AccAddress(), 0, true, false, false, 0),
+			err:  sdkerrors.ErrInvalidChainID,
+		},
+		{
+			name: "invalid expiry height",
+			msg:  types.NewMsgUpdateChainPause(sample.AccAddress(), 1000, true, false, false, -1),
+			err:  types.ErrInvalidChainPause,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUpdateChainPause_GetSigners(t *testing.T) {
+	signer := sample.AccAddress()
+	tests := []struct {
+		name   string
+		msg    types.MsgUpdateChainPause
+		panics bool
+	}{
+		{
+			name: "valid signer",
+			msg: types.MsgUpdateChainPause{
+				Signer: signer,
+			},
+			panics: false,
+		},
+		{
+			name: "invalid signer",
+			msg: types.MsgUpdateChainPause{
+				Signer: "invalid",
+			},
+			panics: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.panics {
+				signers := tt.msg.GetSigners()
+				require.Equal(t, []sdk.AccAddress{sdk.MustAccAddressFromBech32(signer)}, signers)
+			} else {
+				require.Panics(t, func() {
+					tt.msg.GetSigners()
+				})
+			}
+		})
+	}
+}
+
+func TestMsgUpdateChainPause_Type(t *testing.T) {
+	msg := types.MsgUpdateChainPause{
+		Signer: sample.AccAddress(),
+	}
+	require.Equal(t, types.TypeMsgUpdateChainPause, msg.Type())
+}
+
+func TestMsgUpdateChainPause_Route(t *testing.T) {
+	msg := types.MsgUpdateChainPause{
+		Signer: sample.AccAddress(),
+	}
+	require.Equal(t, types.RouterKey, msg.Route())
+}
+
+func TestMsgUpdateChainPause_GetSignBytes(t *testing.T) {
+	msg := types.MsgUpdateChainPause{
+		Signer: sample.AccAddress(),
+	}
+	require.NotPanics(t, func() {
+		msg.GetSignBytes()
+	})
+}
+
+func TestMsgUpdateChainPause_ChainPause(t *testing.T) {
+	msg := types.NewMsgUpdateChainPause(sample.AccAddress(), 1000, true, false, true, 100)
+	require.Equal(t, types.ChainPause{
+		ChainId:         1000,
+		IsInboundPaused: true,
+		IsSigningPaused: true,
+		ExpiryHeight:    100,
+	}, msg.ChainPause())
+}
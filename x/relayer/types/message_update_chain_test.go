@@ -0,0 +1,120 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/pkg/chains"
+	"github.com/0xPellNetwork/aegis/testutil/sample"
+	"github.com/0xPellNetwork/aegis/x/relayer/types"
+)
+
+func TestMsgUpdateChain_ValidateBasic(t *testing.T) {
+	newChain := chains.Chain{
+		Id:          1000,
+		Network:     chains.NetWork_ETH,
+		NetworkType: chains.NetWorkType_TESTNET,
+		VmType:      chains.VMType_EVM,
+	}
+	deprecatedChain := newChain
+	deprecatedChain.IsDeprecated = true
+
+	tests := []struct {
+		name string
+		msg  *types.MsgUpdateChain
+		err  error
+	}{
+		{
+			name: "valid message",
+			msg:  types.NewMsgUpdateChain(sample.AccAddress(), newChain),
+		},
+		{
+			name: "invalid address",
+			msg:  types.NewMsgUpdateChain("invalid_address", newChain),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid chain",
+			msg:  types.NewMsgUpdateChain(sample.AccAddress(), chains.Chain{}),
+			err:  types.ErrInvalidChain,
+		},
+		{
+			name: "deprecated chain",
+			msg:  types.NewMsgUpdateChain(sample.AccAddress(), deprecatedChain),
+			err:  types.ErrInvalidChain,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUpdateChain_GetSigners(t *testing.T) {
+	signer := sample.AccAddress()
+	tests := []struct {
+		name   string
+		msg    types.MsgUpdateChain
+		panics bool
+	}{
+		{
+			name: "valid signer",
+			msg: types.MsgUpdateChain{
+				Signer: signer,
+			},
+			panics: false,
+		},
+		{
+			name: "invalid signer",
+			msg: types.MsgUpdateChain{
+				Signer: "invalid",
+			},
+			panics: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.panics {
+				signers := tt.msg.GetSigners()
+				require.Equal(t, []sdk.AccAddress{sdk.MustAccAddressFromBech32(signer)}, signers)
+			} else {
+				require.Panics(t, func() {
+					tt.msg.GetSigners()
+				})
+			}
+		})
+	}
+}
+
+func TestMsgUpdateChain_Type(t *testing.T) {
+	msg := types.MsgUpdateChain{
+		Signer: sample.AccAddress(),
+	}
+	require.Equal(t, types.TypeMsgUpdateChain, msg.Type())
+}
+
+func TestMsgUpdateChain_Route(t *testing.T) {
+	msg := types.MsgUpdateChain{
+		Signer: sample.AccAddress(),
+	}
+	require.Equal(t, types.RouterKey, msg.Route())
+}
+
+func TestMsgUpdateChain_GetSignBytes(t *testing.T) {
+	msg := types.MsgUpdateChain{
+		Signer: sample.AccAddress(),
+	}
+	require.NotPanics(t, func() {
+		msg.GetSignBytes()
+	})
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgUpdateRestrictedAddresses = "update_restricted_addresses"
+
+var _ sdk.Msg = &MsgUpdateRestrictedAddresses{}
+
+func NewMsgUpdateRestrictedAddresses(creator string, added, removed []string) *MsgUpdateRestrictedAddresses {
+	return &MsgUpdateRestrictedAddresses{
+		Signer:           creator,
+		AddedAddresses:   added,
+		RemovedAddresses: removed,
+	}
+}
+
+func (msg *MsgUpdateRestrictedAddresses) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateRestrictedAddresses) Type() string {
+	return TypeMsgUpdateRestrictedAddresses
+}
+
+func (msg *MsgUpdateRestrictedAddresses) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpdateRestrictedAddresses) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateRestrictedAddresses) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if len(msg.AddedAddresses) == 0 && len(msg.RemovedAddresses) == 0 {
+		return cosmoserrors.Wrap(sdkerrors.ErrInvalidRequest, "no address to add or remove")
+	}
+
+	// an address can't be both added and removed
+	all := append(append([]string{}, msg.AddedAddresses...), msg.RemovedAddresses...)
+	if err := ValidateRestrictedAddresses(all); err != nil {
+		return cosmoserrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/testutil/sample"
+	"github.com/0xPellNetwork/aegis/x/relayer/types"
+)
+
+func TestMsgUpdateRestrictedAddresses_ValidateBasic(t *testing.T) {
+	address1 := sample.EthAddress().Hex()
+	address2 := sample.EthAddress().Hex()
+
+	tests := []struct {
+		name string
+		msg  *types.MsgUpdateRestrictedAddresses
+		err  error
+	}{
+		{
+			name: "valid message",
+			msg:  types.NewMsgUpdateRestrictedAddresses(sample.AccAddress(), []string{address1}, []string{address2}),
+		},
+		{
+			name: "valid message with removed addresses only",
+			msg:  types.NewMsgUpdateRestrictedAddresses(sample.AccAddress(), nil, []string{address2}),
+		},
+		{
+			name: "invalid address",
+			msg:  types.NewMsgUpdateRestrictedAddresses("invalid_address", []string{address1}, nil),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "no address",
+			msg:  types.NewMsgUpdateRestrictedAddresses(sample.AccAddress(), nil, nil),
+			err:  sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "empty address",
+			msg:  types.NewMsgUpdateRestrictedAddresses(sample.AccAddress(), []string{" "}, nil),
+			err:  sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "address both added and removed",
+			msg:  types.NewMsgUpdateRestrictedAddresses(sample.AccAddress(), []string{address1}, []string{address1}),
+			err:  sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "duplicated address with different case",
+			msg: types.NewMsgUpdateRestrictedAddresses(
+				sample.AccAddress(),
+				[]string{address1, strings.ToLower(address1)},
+				nil,
+			),
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUpdateRestrictedAddresses_GetSigners(t *testing.T) {
+	signer := sample.AccAddress()
+	tests := []struct {
+		name   string
+		msg    types.MsgUpdateRestrictedAddresses
+		panics bool
+	}{
+		{
+			name: "valid signer",
+			msg: types.MsgUpdateRestrictedAddresses{
+				Signer: signer,
+			},
+			panics: false,
+		},
+		{
+			name: "invalid signer",
+			msg: types.MsgUpdateRestrictedAddresses{
+				Signer: "invalid",
+			},
+			panics: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.panics {
+				signers := tt.msg.GetSigners()
+				require.Equal(t, []sdk.AccAddress{sdk.MustAccAddressFromBech32(signer)}, signers)
+			} else {
+				require.Panics(t, func() {
+					tt.msg.GetSigners()
+				})
+			}
+		})
+	}
+}
+
+func TestMsgUpdateRestrictedAddresses_Type(t *testing.T) {
+	msg := types.MsgUpdateRestrictedAddresses{
+		Signer: sample.AccAddress(),
+	}
+	require.Equal(t, types.TypeMsgUpdateRestrictedAddresses, msg.Type())
+}
+
+func TestMsgUpdateRestrictedAddresses_Route(t *testing.T) {
+	msg := types.MsgUpdateRestrictedAddresses{
+		Signer: sample.AccAddress(),
+	}
+	require.Equal(t, types.RouterKey, msg.Route())
+}
+
+func TestMsgUpdateRestrictedAddresses_GetSignBytes(t *testing.T) {
+	msg := types.MsgUpdateRestrictedAddresses{
+		Signer: sample.AccAddress(),
+	}
+	require.NotPanics(t, func() {
+		msg.GetSignBytes()
+	})
+}
@@ -39,7 +39,12 @@ func DefaultParams() Params {
 			MinRelayerDelegation: sdkmath.LegacyMustNewDecFromStr("1000000000000000000000"), // 1000 PELL
 		}
 	}
-	return NewParams(observerParams, DefaultAdminPolicy(), 100)
+	params := NewParams(observerParams, DefaultAdminPolicy(), 100)
+	params.PenaltyWindowBlocks = DefaultPenaltyWindowBlocks
+	params.MaxBlamesPerWindow = DefaultMaxBlamesPerWindow
+	params.MaxMissedBallotsPerWindow = DefaultMaxMissedBallotsPerWindow
+	params.JailDurationBlocks = DefaultJailDurationBlocks
+	return params
 }
 
 func DefaultAdminPolicy() []*AdminPolicy {
@@ -61,12 +66,19 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyPrefix(ObserverParamsKey), &p.ObserverParams, validateVotingThresholds),
 		paramtypes.NewParamSetPair(KeyPrefix(AdminPolicyParamsKey), &p.AdminPolicy, validateAdminPolicy),
 		paramtypes.NewParamSetPair(KeyPrefix(BallotMaturityBlocksParamsKey), &p.BallotMaturityBlocks, validateBallotMaturityBlocks),
+		paramtypes.NewParamSetPair(KeyPrefix(PenaltyWindowBlocksParamsKey), &p.PenaltyWindowBlocks, validateNonNegativeBlocks),
+		paramtypes.NewParamSetPair(KeyPrefix(MaxBlamesPerWindowParamsKey), &p.MaxBlamesPerWindow, validatePenaltyThreshold),
+		paramtypes.NewParamSetPair(KeyPrefix(MaxMissedBallotsPerWindowParamsKey), &p.MaxMissedBallotsPerWindow, validatePenaltyThreshold),
+		paramtypes.NewParamSetPair(KeyPrefix(JailDurationBlocksParamsKey), &p.JailDurationBlocks, validateNonNegativeBlocks),
 	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
-	return nil
+	if err := validateNonNegativeBlocks(p.PenaltyWindowBlocks); err != nil {
+		return err
+	}
+	return validateNonNegativeBlocks(p.JailDurationBlocks)
 }
 
 // String implements the Stringer interface.
@@ -109,3 +121,24 @@ func validateBallotMaturityBlocks(i interface{}) error {
 
 	return nil
 }
+
+// validateNonNegativeBlocks validates a number of blocks
+func validateNonNegativeBlocks(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < 0 {
+		return fmt.Errorf("number of blocks cannot be negative: %d", v)
+	}
+	return nil
+}
+
+// validatePenaltyThreshold validates a jailing threshold, 0 disables jailing
+func validatePenaltyThreshold(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
@@ -130,6 +130,10 @@ type ChainParams struct {
 	PellTokenRechargeEnabled                 bool                        `protobuf:"varint,28,opt,name=pell_token_recharge_enabled,json=pellTokenRechargeEnabled,proto3" json:"pell_token_recharge_enabled,omitempty"`
 	GasTokenRechargeEnabled                  bool                        `protobuf:"varint,29,opt,name=gas_token_recharge_enabled,json=gasTokenRechargeEnabled,proto3" json:"gas_token_recharge_enabled,omitempty"`
 	ChainRegistryInteractorContractAddress   string                      `protobuf:"bytes,30,opt,name=chain_registry_interactor_contract_address,json=chainRegistryInteractorContractAddress,proto3" json:"chain_registry_interactor_contract_address,omitempty"`
+	// apell per smallest unit of the gas token of the chain (wei, satoshi),
+	// converts the gas token amounts of the chain to apell, unset if no rate is
+	// configured for the chain
+	GasTokenPellRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,31,opt,name=gas_token_pell_rate,json=gasTokenPellRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"gas_token_pell_rate"`
 }
 
 func (m *ChainParams) Reset()         { *m = ChainParams{} }
@@ -448,6 +452,18 @@ type Params struct {
 	// Deprecated(v14):Moved into the authority module
 	AdminPolicy          []*AdminPolicy `protobuf:"bytes,2,rep,name=admin_policy,json=adminPolicy,proto3" json:"admin_policy,omitempty"`
 	BallotMaturityBlocks int64          `protobuf:"varint,3,opt,name=ballot_maturity_blocks,json=ballotMaturityBlocks,proto3" json:"ballot_maturity_blocks,omitempty"`
+	// penalty_window_blocks is the number of blocks of the rolling window in
+	// which the blames and missed ballots of an observer are counted
+	PenaltyWindowBlocks int64 `protobuf:"varint,4,opt,name=penalty_window_blocks,json=penaltyWindowBlocks,proto3" json:"penalty_window_blocks,omitempty"`
+	// max_blames_per_window is the number of blames in a window from which an
+	// observer is jailed, 0 disables it
+	MaxBlamesPerWindow uint64 `protobuf:"varint,5,opt,name=max_blames_per_window,json=maxBlamesPerWindow,proto3" json:"max_blames_per_window,omitempty"`
+	// max_missed_ballots_per_window is the number of missed ballots in a window
+	// from which an observer is jailed, 0 disables it
+	MaxMissedBallotsPerWindow uint64 `protobuf:"varint,6,opt,name=max_missed_ballots_per_window,json=maxMissedBallotsPerWindow,proto3" json:"max_missed_ballots_per_window,omitempty"`
+	// jail_duration_blocks is the number of blocks a jailed observer must wait
+	// before it can be unjailed
+	JailDurationBlocks int64 `protobuf:"varint,7,opt,name=jail_duration_blocks,json=jailDurationBlocks,proto3" json:"jail_duration_blocks,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -503,6 +519,34 @@ func (m *Params) GetBallotMaturityBlocks() int64 {
 	return 0
 }
 
+func (m *Params) GetPenaltyWindowBlocks() int64 {
+	if m != nil {
+		return m.PenaltyWindowBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxBlamesPerWindow() uint64 {
+	if m != nil {
+		return m.MaxBlamesPerWindow
+	}
+	return 0
+}
+
+func (m *Params) GetMaxMissedBallotsPerWindow() uint64 {
+	if m != nil {
+		return m.MaxMissedBallotsPerWindow
+	}
+	return 0
+}
+
+func (m *Params) GetJailDurationBlocks() int64 {
+	if m != nil {
+		return m.JailDurationBlocks
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("relayer.PolicyType", PolicyType_name, PolicyType_value)
 	proto.RegisterType((*ChainParamsList)(nil), "relayer.ChainParamsList")
@@ -515,80 +559,88 @@ func init() {
 func init() { proto.RegisterFile("relayer/params.proto", fileDescriptor_ccba6a72763fc2ad) }
 
 var fileDescriptor_ccba6a72763fc2ad = []byte{
-	// 1167 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x49, 0x6f, 0xdb, 0x46,
-	0x14, 0x16, 0xbd, 0xfb, 0xc9, 0x8b, 0x4c, 0xcb, 0x12, 0x2d, 0xc7, 0xb2, 0xa2, 0x00, 0xad, 0x60,
-	0x34, 0x52, 0xeb, 0x34, 0x30, 0xba, 0x21, 0xb0, 0x9d, 0x20, 0x71, 0x61, 0xc7, 0x02, 0xad, 0x02,
-	0xad, 0x8b, 0x96, 0x1d, 0x91, 0x53, 0x72, 0x20, 0x92, 0x43, 0xcc, 0x8c, 0x6c, 0xeb, 0x0f, 0xf4,
-	0xd0, 0x53, 0x8f, 0x3d, 0xf6, 0xd0, 0x43, 0x7f, 0x45, 0xcf, 0x39, 0xe6, 0x58, 0xf4, 0x10, 0x14,
-	0xf6, 0x1f, 0x29, 0x38, 0x5c, 0xb4, 0xba, 0x30, 0xd0, 0x93, 0x38, 0xf3, 0xbe, 0xef, 0x9b, 0xb7,
-	0x69, 0xde, 0x40, 0x9e, 0x61, 0x17, 0xf5, 0x30, 0x6b, 0x04, 0x88, 0x21, 0x8f, 0xd7, 0x03, 0x46,
-	0x05, 0x55, 0xe7, 0xe3, 0xdd, 0x52, 0xde, 0xa6, 0x36, 0x95, 0x7b, 0x8d, 0xf0, 0x2b, 0x32, 0x97,
-	0x8a, 0x41, 0xc7, 0x6e, 0x98, 0x0e, 0x22, 0x3e, 0x8f, 0x7f, 0x22, 0x43, 0xf5, 0x4b, 0x58, 0x3d,
-	0x0a, 0xd7, 0x4d, 0x29, 0x76, 0x42, 0xb8, 0x50, 0xf7, 0x61, 0x49, 0x42, 0x8c, 0xe8, 0x00, 0x4d,
-	0xa9, 0x4c, 0xd7, 0xb2, 0x7b, 0xf9, 0x7a, 0x7c, 0x42, 0x7d, 0x00, 0xaf, 0x67, 0xcd, 0xfe, 0xa2,
-	0xfa, 0xf3, 0x2a, 0x64, 0x07, 0x8c, 0xea, 0x26, 0x2c, 0x44, 0x42, 0xc4, 0xd2, 0x94, 0x8a, 0x52,
-	0x9b, 0xd6, 0xe7, 0xe5, 0xfa, 0xd8, 0x52, 0x1f, 0x83, 0x6a, 0x52, 0xff, 0x47, 0xc2, 0x3c, 0x24,
-	0x08, 0xf5, 0x0d, 0x93, 0x76, 0x7d, 0xa1, 0x4d, 0x55, 0x94, 0xda, 0x8c, 0xbe, 0x36, 0x68, 0x39,
-	0x0a, 0x0d, 0x6a, 0x0d, 0x72, 0x36, 0xe2, 0x46, 0xc0, 0x88, 0x89, 0x0d, 0x41, 0xcc, 0x0e, 0x66,
-	0xda, 0xb4, 0x04, 0xaf, 0xd8, 0x88, 0x37, 0xc3, 0xed, 0x96, 0xdc, 0x55, 0x2b, 0xb0, 0x44, 0x7c,
-	0x43, 0x5c, 0x27, 0xa8, 0x19, 0x89, 0x02, 0xe2, 0xb7, 0xae, 0x63, 0x44, 0x15, 0x96, 0x69, 0x57,
-	0x0c, 0x40, 0x66, 0x25, 0x24, 0x4b, 0xbb, 0x22, 0xc5, 0x1c, 0xc0, 0x36, 0xed, 0x8a, 0x36, 0xed,
-	0xfa, 0x56, 0x08, 0xe4, 0xa6, 0x83, 0xad, 0xae, 0x8b, 0x0d, 0xe2, 0x0b, 0xcc, 0x2e, 0x91, 0xab,
-	0xcd, 0xc9, 0x70, 0x4a, 0x09, 0xa8, 0x75, 0x7d, 0x1e, 0x43, 0x8e, 0x63, 0x84, 0x7a, 0x04, 0xe5,
-	0x89, 0x12, 0x2e, 0xa5, 0x1d, 0xe4, 0x60, 0x64, 0x69, 0xf3, 0x52, 0x63, 0x6b, 0x5c, 0xe3, 0x24,
-	0x81, 0xa8, 0xaf, 0x21, 0xd7, 0x46, 0xae, 0x4b, 0x85, 0x21, 0x1c, 0x86, 0xb9, 0x43, 0x5d, 0x4b,
-	0x5b, 0xa8, 0x28, 0xb5, 0xc5, 0xc3, 0x47, 0x6f, 0xde, 0xed, 0x64, 0xfe, 0x7e, 0xb7, 0xb3, 0x65,
-	0x52, 0xee, 0x51, 0xce, 0xad, 0x4e, 0x9d, 0xd0, 0x86, 0x87, 0x84, 0x53, 0x3f, 0xc1, 0x36, 0x32,
-	0x7b, 0xcf, 0xb1, 0xa9, 0xaf, 0x46, 0xe4, 0x56, 0xc2, 0x55, 0xbf, 0x85, 0xa2, 0x47, 0x7c, 0x83,
-	0xb6, 0x39, 0x66, 0x97, 0x98, 0x19, 0x16, 0x76, 0xb1, 0x2d, 0xf3, 0xac, 0x2d, 0xde, 0x5f, 0x76,
-	0xc3, 0x23, 0xfe, 0x59, 0x2c, 0xf1, 0x3c, 0x55, 0x50, 0x1f, 0xc2, 0x12, 0xe1, 0x06, 0xef, 0x06,
-	0x01, 0x65, 0x02, 0x5b, 0x1a, 0x54, 0x94, 0xda, 0x82, 0x9e, 0x25, 0xfc, 0x3c, 0xd9, 0x52, 0x8f,
-	0xe1, 0x21, 0x17, 0x0c, 0x09, 0x6c, 0xf7, 0x0c, 0x0f, 0xf9, 0xc8, 0xc6, 0xcc, 0x30, 0xa9, 0x2f,
-	0x18, 0x32, 0x85, 0x81, 0x2c, 0x8b, 0x61, 0xce, 0xb5, 0x6c, 0xe8, 0x89, 0x5e, 0x4e, 0x80, 0xa7,
-	0x11, 0xee, 0x28, 0x86, 0x1d, 0x44, 0x28, 0xf5, 0x14, 0x1e, 0xf5, 0xbd, 0xbf, 0x5b, 0x6c, 0x49,
-	0x8a, 0x55, 0xfa, 0xd0, 0x3b, 0xe4, 0x0c, 0x78, 0x4c, 0x3d, 0x9f, 0x18, 0x34, 0xc0, 0x0c, 0x09,
-	0xca, 0x0c, 0xee, 0x20, 0x86, 0xf9, 0xdd, 0xc2, 0xcb, 0x52, 0xb8, 0x16, 0x92, 0xce, 0x62, 0xce,
-	0xb9, 0xa4, 0xdc, 0x71, 0xc0, 0xe7, 0x50, 0x32, 0xa9, 0xef, 0x63, 0x33, 0x14, 0x1f, 0x53, 0x5b,
-	0x91, 0x6a, 0x5a, 0x8a, 0x18, 0x65, 0x7f, 0x00, 0x2a, 0x17, 0x88, 0x09, 0xa3, 0xed, 0x52, 0xb3,
-	0x63, 0x38, 0x98, 0xd8, 0x8e, 0xd0, 0x56, 0x65, 0xe7, 0xe6, 0xa4, 0xe5, 0x30, 0x34, 0xbc, 0x92,
-	0xfb, 0xea, 0x16, 0x2c, 0x86, 0x7f, 0x17, 0x97, 0x78, 0x44, 0x68, 0x39, 0x09, 0x5a, 0xb0, 0x11,
-	0x3f, 0x09, 0xd7, 0xea, 0x53, 0x28, 0x5e, 0x21, 0x61, 0x3a, 0x46, 0x80, 0x5d, 0xd7, 0x10, 0xb4,
-	0x83, 0xfd, 0xe4, 0x9f, 0xb0, 0x26, 0xa1, 0x79, 0x69, 0x6e, 0x62, 0xd7, 0x6d, 0x85, 0xc6, 0xf8,
-	0x2f, 0xf1, 0x04, 0x0a, 0x11, 0x2d, 0x54, 0x1e, 0x62, 0xa9, 0x92, 0xb5, 0x2e, 0xad, 0x2f, 0x11,
-	0x1f, 0x24, 0xfd, 0x00, 0xdb, 0x03, 0xa7, 0x30, 0x6c, 0x3a, 0x88, 0xd9, 0x78, 0xa0, 0x99, 0xd7,
-	0x65, 0xd7, 0x6d, 0xc7, 0x5d, 0xb7, 0x31, 0xde, 0x75, 0xc7, 0xbe, 0xd0, 0x4b, 0x41, 0xe2, 0x8b,
-	0x1e, 0x2b, 0xf4, 0x3b, 0xfa, 0x7b, 0x78, 0xd0, 0x77, 0x68, 0xc2, 0x01, 0xf9, 0xfb, 0x1c, 0xb0,
-	0x69, 0xc7, 0x6e, 0x8f, 0xeb, 0x5f, 0x40, 0x69, 0x52, 0x04, 0xc8, 0x93, 0x17, 0xd6, 0xc6, 0x7d,
-	0xd4, 0x8b, 0x63, 0xee, 0x1f, 0x48, 0xb6, 0xfa, 0x35, 0x6c, 0x4e, 0xf0, 0x3d, 0x96, 0x2e, 0xdc,
-	0x47, 0xba, 0x30, 0xea, 0x78, 0xac, 0xfc, 0x2c, 0xcc, 0x8a, 0xc0, 0x57, 0xa8, 0x67, 0xe0, 0x4b,
-	0x6f, 0xbc, 0xdd, 0x8a, 0xb2, 0xdd, 0x36, 0x63, 0xcc, 0x8b, 0x4b, 0x6f, 0xb4, 0xdf, 0xbe, 0x80,
-	0xad, 0x81, 0xb0, 0xc7, 0xf8, 0x5a, 0xd4, 0xae, 0x69, 0x60, 0xa3, 0xf4, 0x4f, 0xa2, 0xc8, 0xf8,
-	0x15, 0x0a, 0xc6, 0xc9, 0x9b, 0x92, 0x1c, 0xba, 0x7e, 0x7e, 0x85, 0x82, 0x51, 0xea, 0x3e, 0x68,
-	0x03, 0x27, 0x07, 0x94, 0x8b, 0xfe, 0xad, 0x5b, 0x92, 0x9d, 0xb6, 0x91, 0x1e, 0xdb, 0xa4, 0x5c,
-	0xa4, 0x17, 0xee, 0x53, 0x28, 0xf6, 0xb3, 0x39, 0xcc, 0xdb, 0x8a, 0xfa, 0x3a, 0x49, 0xd6, 0x10,
-	0x6d, 0x38, 0xd2, 0xb4, 0x0a, 0xd8, 0x47, 0x6d, 0x17, 0x5b, 0xda, 0x03, 0x79, 0x89, 0x69, 0x63,
-	0x25, 0x7c, 0x11, 0xd9, 0xd5, 0xcf, 0xa0, 0x34, 0xa1, 0x86, 0x09, 0x7b, 0x5b, 0xb2, 0x8b, 0xa3,
-	0x55, 0x4a, 0xc8, 0x17, 0xb0, 0x1b, 0x0d, 0x48, 0x86, 0x6d, 0xc2, 0x05, 0xeb, 0x45, 0x2e, 0xa3,
-	0xc9, 0x77, 0x44, 0x59, 0xe6, 0xed, 0x3d, 0xc9, 0xd0, 0x63, 0xc2, 0x71, 0x8a, 0x1f, 0xc9, 0x63,
-	0xf5, 0xa7, 0x29, 0x58, 0xd6, 0xa3, 0x89, 0x1d, 0x8f, 0xe3, 0xf7, 0x61, 0x56, 0x72, 0xe5, 0x2c,
-	0xce, 0xee, 0xad, 0xd5, 0x83, 0x8e, 0x5d, 0x8f, 0x1f, 0x03, 0x72, 0x6c, 0xeb, 0x91, 0x7d, 0xe2,
-	0xd4, 0x99, 0xfe, 0x1f, 0x53, 0xe7, 0x1b, 0x28, 0x78, 0x32, 0x48, 0xe9, 0xcd, 0xe0, 0xd0, 0x99,
-	0xb9, 0xbf, 0x6a, 0xde, 0x0b, 0xa3, 0x96, 0x0a, 0xff, 0x31, 0x73, 0x66, 0xc7, 0x66, 0x4e, 0xf5,
-	0x3b, 0xc8, 0x1e, 0x58, 0x1e, 0xf1, 0x9b, 0xd4, 0x25, 0x66, 0x4f, 0xfd, 0x18, 0xb2, 0x81, 0xfc,
-	0x32, 0x44, 0x2f, 0xc0, 0x32, 0x17, 0x2b, 0x7b, 0xeb, 0xe9, 0xe3, 0x26, 0x42, 0xb5, 0x7a, 0x01,
-	0xd6, 0x21, 0x48, 0xbf, 0x55, 0x0d, 0xe6, 0x93, 0x32, 0x4c, 0xc9, 0x32, 0x24, 0xcb, 0xea, 0x9f,
-	0x0a, 0xcc, 0xc5, 0x09, 0x7e, 0x06, 0xab, 0xe9, 0x64, 0x1d, 0x7a, 0x3b, 0x15, 0x52, 0xf9, 0xa1,
-	0x8a, 0xe8, 0x2b, 0x09, 0x3c, 0x16, 0xd8, 0x87, 0x25, 0x14, 0xba, 0x6a, 0x44, 0x27, 0x6b, 0x53,
-	0x23, 0x2f, 0xaf, 0x81, 0x38, 0xf4, 0x2c, 0x1a, 0x0a, 0xaa, 0x10, 0x57, 0xcc, 0x43, 0xa2, 0xcb,
-	0x88, 0xe8, 0x45, 0x83, 0x82, 0xcb, 0xba, 0x4d, 0xeb, 0xf9, 0xc8, 0x7a, 0x1a, 0x1b, 0xe5, 0xac,
-	0xe0, 0x9f, 0xce, 0xfc, 0xfa, 0xdb, 0x4e, 0x66, 0x77, 0x17, 0xa0, 0x1f, 0xb4, 0x0a, 0x30, 0xf7,
-	0x52, 0x3f, 0xfb, 0xaa, 0xf9, 0x51, 0x2e, 0x93, 0x7e, 0xef, 0xe5, 0x94, 0xd2, 0xcc, 0x1f, 0xbf,
-	0x97, 0x95, 0xc3, 0x57, 0x6f, 0x6e, 0xca, 0xca, 0xdb, 0x9b, 0xb2, 0xf2, 0xcf, 0x4d, 0x59, 0xf9,
-	0xe5, 0xb6, 0x9c, 0x79, 0x7b, 0x5b, 0xce, 0xfc, 0x75, 0x5b, 0xce, 0x5c, 0xd4, 0x6d, 0x22, 0x9c,
-	0x6e, 0xbb, 0x6e, 0x52, 0xaf, 0xf1, 0xe1, 0x75, 0x38, 0x3c, 0x5e, 0x63, 0x71, 0x45, 0x59, 0xa7,
-	0x81, 0xc2, 0x56, 0x6d, 0x5c, 0x37, 0x92, 0x67, 0x6b, 0x98, 0x76, 0xde, 0x9e, 0x93, 0xcf, 0xcf,
-	0x27, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x50, 0x7f, 0xcf, 0xd2, 0xce, 0x0a, 0x00, 0x00,
+	// 1288 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4f, 0x4f, 0x1b, 0x47,
+	0x14, 0xc7, 0x40, 0x80, 0x3c, 0x13, 0x20, 0x8b, 0x81, 0xc5, 0x04, 0xe3, 0x10, 0xa9, 0xb5, 0xa2,
+	0xc6, 0x4e, 0x48, 0xa3, 0xa8, 0xff, 0x94, 0x02, 0x89, 0x12, 0x2a, 0x48, 0xac, 0x85, 0xaa, 0x6d,
+	0xaa, 0x76, 0x3a, 0xde, 0x9d, 0xae, 0xa7, 0xde, 0xdd, 0x59, 0xcd, 0x8c, 0xb1, 0xfd, 0x05, 0x7a,
+	0xee, 0xb1, 0xbd, 0xf5, 0xd0, 0x43, 0x3f, 0x4a, 0x8e, 0x39, 0x56, 0x3d, 0x44, 0x55, 0xf2, 0x45,
+	0xaa, 0x9d, 0x99, 0x5d, 0xff, 0xa5, 0x42, 0xea, 0xc9, 0xde, 0x79, 0xbf, 0xdf, 0x6f, 0xde, 0xbc,
+	0xf7, 0x66, 0xde, 0x83, 0x02, 0x27, 0x01, 0xee, 0x11, 0x5e, 0x8b, 0x31, 0xc7, 0xa1, 0xa8, 0xc6,
+	0x9c, 0x49, 0x66, 0xcd, 0x9b, 0xd5, 0x62, 0xc1, 0x67, 0x3e, 0x53, 0x6b, 0xb5, 0xe4, 0x9f, 0x36,
+	0x17, 0x37, 0xe2, 0x96, 0x5f, 0x73, 0x9b, 0x98, 0x46, 0xc2, 0xfc, 0x68, 0xc3, 0xee, 0x17, 0xb0,
+	0x7c, 0x98, 0x7c, 0xd7, 0x95, 0xd8, 0x31, 0x15, 0xd2, 0x7a, 0x08, 0x8b, 0x0a, 0x82, 0xf4, 0x06,
+	0x76, 0xae, 0x3c, 0x53, 0xc9, 0xef, 0x15, 0xaa, 0x66, 0x87, 0xea, 0x00, 0xde, 0xc9, 0xbb, 0xfd,
+	0x8f, 0xdd, 0x37, 0xcb, 0x90, 0x1f, 0x30, 0x5a, 0x9b, 0xb0, 0xa0, 0x85, 0xa8, 0x67, 0xe7, 0xca,
+	0xb9, 0xca, 0x8c, 0x33, 0xaf, 0xbe, 0x8f, 0x3c, 0xeb, 0x0e, 0x58, 0x2e, 0x8b, 0x7e, 0xa4, 0x3c,
+	0xc4, 0x92, 0xb2, 0x08, 0xb9, 0xac, 0x1d, 0x49, 0x7b, 0xba, 0x9c, 0xab, 0xcc, 0x3a, 0xd7, 0x07,
+	0x2d, 0x87, 0x89, 0xc1, 0xaa, 0xc0, 0x8a, 0x8f, 0x05, 0x8a, 0x39, 0x75, 0x09, 0x92, 0xd4, 0x6d,
+	0x11, 0x6e, 0xcf, 0x28, 0xf0, 0x92, 0x8f, 0x45, 0x3d, 0x59, 0x3e, 0x53, 0xab, 0x56, 0x19, 0x16,
+	0x69, 0x84, 0x64, 0x37, 0x45, 0xcd, 0x2a, 0x14, 0xd0, 0xe8, 0xac, 0x6b, 0x10, 0xbb, 0x70, 0x8d,
+	0xb5, 0xe5, 0x00, 0xe4, 0x8a, 0x82, 0xe4, 0x59, 0x5b, 0x66, 0x98, 0x7d, 0xd8, 0x66, 0x6d, 0xd9,
+	0x60, 0xed, 0xc8, 0x4b, 0x80, 0xc2, 0x6d, 0x12, 0xaf, 0x1d, 0x10, 0x44, 0x23, 0x49, 0xf8, 0x39,
+	0x0e, 0xec, 0x39, 0x75, 0x9c, 0x62, 0x0a, 0x3a, 0xeb, 0x9e, 0x1a, 0xc8, 0x91, 0x41, 0x58, 0x87,
+	0x50, 0x9a, 0x28, 0x11, 0x30, 0xd6, 0xc2, 0x4d, 0x82, 0x3d, 0x7b, 0x5e, 0x69, 0x6c, 0x8d, 0x6b,
+	0x1c, 0xa7, 0x10, 0xeb, 0x39, 0xac, 0x34, 0x70, 0x10, 0x30, 0x89, 0x64, 0x93, 0x13, 0xd1, 0x64,
+	0x81, 0x67, 0x2f, 0x94, 0x73, 0x95, 0xab, 0x07, 0xb7, 0x5e, 0xbd, 0xd9, 0x99, 0xfa, 0xfb, 0xcd,
+	0xce, 0x96, 0xcb, 0x44, 0xc8, 0x84, 0xf0, 0x5a, 0x55, 0xca, 0x6a, 0x21, 0x96, 0xcd, 0xea, 0x31,
+	0xf1, 0xb1, 0xdb, 0x7b, 0x4c, 0x5c, 0x67, 0x59, 0x93, 0xcf, 0x52, 0xae, 0xf5, 0x2d, 0x6c, 0x84,
+	0x34, 0x42, 0xac, 0x21, 0x08, 0x3f, 0x27, 0x1c, 0x79, 0x24, 0x20, 0xbe, 0x8a, 0xb3, 0x7d, 0xf5,
+	0xf2, 0xb2, 0x6b, 0x21, 0x8d, 0x5e, 0x18, 0x89, 0xc7, 0x99, 0x82, 0x75, 0x13, 0x16, 0xa9, 0x40,
+	0xa2, 0x1d, 0xc7, 0x8c, 0x4b, 0xe2, 0xd9, 0x50, 0xce, 0x55, 0x16, 0x9c, 0x3c, 0x15, 0xa7, 0xe9,
+	0x92, 0x75, 0x04, 0x37, 0x85, 0xe4, 0x58, 0x12, 0xbf, 0x87, 0x42, 0x1c, 0x61, 0x9f, 0x70, 0xe4,
+	0xb2, 0x48, 0x72, 0xec, 0x4a, 0x84, 0x3d, 0x8f, 0x13, 0x21, 0xec, 0x7c, 0xe2, 0x89, 0x53, 0x4a,
+	0x81, 0x27, 0x1a, 0x77, 0x68, 0x60, 0xfb, 0x1a, 0x65, 0x9d, 0xc0, 0xad, 0xbe, 0xf7, 0x17, 0x8b,
+	0x2d, 0x2a, 0xb1, 0x72, 0x1f, 0x7a, 0x81, 0x1c, 0x82, 0x3b, 0x2c, 0x8c, 0x28, 0x62, 0x31, 0xe1,
+	0x58, 0x32, 0x8e, 0x44, 0x13, 0x73, 0x22, 0x2e, 0x16, 0xbe, 0xa6, 0x84, 0x2b, 0x09, 0xe9, 0x85,
+	0xe1, 0x9c, 0x2a, 0xca, 0x05, 0x1b, 0x7c, 0x0a, 0x45, 0x97, 0x45, 0x11, 0x71, 0x25, 0x9b, 0xa0,
+	0xb6, 0xa4, 0xd4, 0xec, 0x0c, 0x31, 0xca, 0xfe, 0x00, 0x2c, 0x21, 0x31, 0x97, 0xa8, 0x11, 0x30,
+	0xb7, 0x85, 0x9a, 0x84, 0xfa, 0x4d, 0x69, 0x2f, 0xab, 0xca, 0x5d, 0x51, 0x96, 0x83, 0xc4, 0xf0,
+	0x4c, 0xad, 0x5b, 0x5b, 0x70, 0x35, 0xb9, 0x2e, 0x01, 0x0d, 0xa9, 0xb4, 0x57, 0x14, 0x68, 0xc1,
+	0xc7, 0xe2, 0x38, 0xf9, 0xb6, 0x1e, 0xc0, 0x46, 0x07, 0x4b, 0xb7, 0x89, 0x62, 0x12, 0x04, 0x48,
+	0xb2, 0x16, 0x89, 0xd2, 0x9b, 0x70, 0x5d, 0x41, 0x0b, 0xca, 0x5c, 0x27, 0x41, 0x70, 0x96, 0x18,
+	0xcd, 0x95, 0xb8, 0x0f, 0xeb, 0x9a, 0x96, 0x28, 0x0f, 0xb1, 0x2c, 0xc5, 0x5a, 0x55, 0xd6, 0xa7,
+	0x58, 0x0c, 0x92, 0x7e, 0x80, 0xed, 0x81, 0x5d, 0x38, 0x71, 0x9b, 0x98, 0xfb, 0x64, 0xa0, 0x98,
+	0x57, 0x55, 0xd5, 0x6d, 0x9b, 0xaa, 0x5b, 0x1b, 0xaf, 0xba, 0xa3, 0x48, 0x3a, 0xc5, 0x38, 0xf5,
+	0xc5, 0x31, 0x0a, 0xfd, 0x8a, 0xfe, 0x1e, 0x6e, 0xf4, 0x1d, 0x9a, 0xb0, 0x41, 0xe1, 0x32, 0x1b,
+	0x6c, 0xfa, 0x58, 0x5c, 0xa0, 0xff, 0x12, 0x8a, 0x93, 0x4e, 0x80, 0x43, 0xf5, 0x60, 0xad, 0x5d,
+	0x46, 0x7d, 0x63, 0xcc, 0xfd, 0x7d, 0xc5, 0xb6, 0xbe, 0x86, 0xcd, 0x09, 0xbe, 0x1b, 0xe9, 0xf5,
+	0xcb, 0x48, 0xaf, 0x8f, 0x3a, 0x6e, 0x94, 0x1f, 0x25, 0x51, 0x91, 0xa4, 0x83, 0x7b, 0x88, 0x9c,
+	0x87, 0xe3, 0xe5, 0xb6, 0xa1, 0xca, 0x6d, 0xd3, 0x60, 0x9e, 0x9c, 0x87, 0xa3, 0xf5, 0xf6, 0x19,
+	0x6c, 0x0d, 0x1c, 0x7b, 0x8c, 0x6f, 0xeb, 0x72, 0xcd, 0x0e, 0x36, 0x4a, 0xff, 0x48, 0x9f, 0x4c,
+	0x74, 0x70, 0x3c, 0x4e, 0xde, 0x54, 0xe4, 0xc4, 0xf5, 0xd3, 0x0e, 0x8e, 0x47, 0xa9, 0x0f, 0xc1,
+	0x1e, 0xd8, 0x39, 0x66, 0x42, 0xf6, 0x5f, 0xdd, 0xa2, 0xaa, 0xb4, 0xb5, 0x6c, 0xdb, 0x3a, 0x13,
+	0x32, 0x7b, 0x70, 0x1f, 0xc0, 0x46, 0x3f, 0x9a, 0xc3, 0xbc, 0x2d, 0x5d, 0xd7, 0x69, 0xb0, 0x86,
+	0x68, 0xc3, 0x27, 0xcd, 0xb2, 0x40, 0x22, 0xdc, 0x08, 0x88, 0x67, 0xdf, 0x50, 0x8f, 0x98, 0x3d,
+	0x96, 0xc2, 0x27, 0xda, 0x6e, 0x7d, 0x02, 0xc5, 0x09, 0x39, 0x4c, 0xd9, 0xdb, 0x8a, 0xbd, 0x31,
+	0x9a, 0xa5, 0x94, 0xfc, 0x12, 0x6e, 0xeb, 0x06, 0xc9, 0x89, 0x4f, 0x85, 0xe4, 0x3d, 0xed, 0x32,
+	0x9e, 0xfc, 0x46, 0x94, 0x54, 0xdc, 0xde, 0x53, 0x0c, 0xc7, 0x10, 0x8e, 0x32, 0xfc, 0x68, 0x1c,
+	0x1d, 0x58, 0x1d, 0x08, 0x47, 0x72, 0x42, 0x8e, 0x25, 0xb1, 0x77, 0x2e, 0xff, 0xcc, 0xaf, 0x64,
+	0xf1, 0x22, 0x41, 0xe0, 0x60, 0x49, 0x76, 0x7f, 0x9e, 0x86, 0x6b, 0x8e, 0x9e, 0x02, 0x4c, 0x8b,
+	0x7f, 0x1f, 0xae, 0x28, 0x7f, 0x54, 0x7f, 0xcf, 0xef, 0x5d, 0xaf, 0xc6, 0x2d, 0xbf, 0x6a, 0x06,
+	0x0c, 0x35, 0x0a, 0x38, 0xda, 0x3e, 0xb1, 0x93, 0xcd, 0xfc, 0x8f, 0x4e, 0xf6, 0x0d, 0xac, 0x87,
+	0x2a, 0x70, 0xca, 0x9b, 0xc1, 0x46, 0x36, 0x7b, 0x79, 0xd5, 0x42, 0x98, 0x44, 0x52, 0x29, 0xfc,
+	0x47, 0x1f, 0xbb, 0x32, 0xd6, 0xc7, 0x76, 0xbf, 0x83, 0xfc, 0xbe, 0x17, 0xd2, 0xa8, 0xce, 0x02,
+	0xea, 0xf6, 0xac, 0x0f, 0x21, 0x1f, 0xab, 0x7f, 0x48, 0xf6, 0x62, 0xa2, 0x62, 0xb1, 0xb4, 0xb7,
+	0x9a, 0x0d, 0x4c, 0x1a, 0x75, 0xd6, 0x8b, 0x89, 0x03, 0x71, 0xf6, 0xdf, 0xb2, 0x61, 0x3e, 0x4d,
+	0xed, 0xb4, 0x4a, 0x6d, 0xfa, 0xb9, 0xfb, 0xdb, 0x0c, 0xcc, 0x99, 0x00, 0x3f, 0x82, 0xe5, 0xac,
+	0x5b, 0x0f, 0xcd, 0x63, 0xeb, 0x99, 0xfc, 0x50, 0x46, 0x9c, 0xa5, 0x14, 0x6e, 0x04, 0x1e, 0xc2,
+	0x22, 0x4e, 0x5c, 0x45, 0x7a, 0x67, 0x7b, 0x7a, 0x64, 0x9a, 0x1b, 0x38, 0x87, 0x93, 0xc7, 0x43,
+	0x87, 0x5a, 0x37, 0x19, 0x0b, 0xb1, 0x6c, 0x73, 0x2a, 0x7b, 0xba, 0xf9, 0x08, 0x95, 0xb7, 0x19,
+	0xa7, 0xa0, 0xad, 0x27, 0xc6, 0xa8, 0xfa, 0x8f, 0xb0, 0xf6, 0x60, 0x2d, 0x26, 0x11, 0x0e, 0x64,
+	0x0f, 0x75, 0x68, 0xe4, 0xb1, 0x4e, 0x4a, 0x9a, 0x55, 0xa4, 0x55, 0x63, 0xfc, 0x4a, 0xd9, 0x0c,
+	0xe7, 0x1e, 0xac, 0x85, 0xb8, 0x8b, 0x1a, 0x01, 0x0e, 0x89, 0x40, 0x31, 0xe1, 0x86, 0x6a, 0x26,
+	0x33, 0x2b, 0xc4, 0xdd, 0x03, 0x65, 0xab, 0x13, 0xae, 0x89, 0xd6, 0xe7, 0xb0, 0x9d, 0x50, 0x42,
+	0x2a, 0x04, 0xf1, 0x90, 0xf6, 0x64, 0x88, 0x3a, 0xa7, 0xa8, 0x9b, 0x21, 0xee, 0x9e, 0x28, 0xcc,
+	0x81, 0x86, 0xf4, 0x15, 0xee, 0x42, 0xe1, 0x27, 0x4c, 0x03, 0xe4, 0xb5, 0xb9, 0x1e, 0x21, 0x8c,
+	0x9f, 0x7a, 0x2a, 0xb3, 0x12, 0xdb, 0x63, 0x63, 0xd2, 0x6e, 0x7e, 0x3c, 0xfb, 0xeb, 0xef, 0x3b,
+	0x53, 0xb7, 0x6f, 0x03, 0xf4, 0xf3, 0x69, 0x01, 0xcc, 0x3d, 0x75, 0x5e, 0x7c, 0x59, 0xbf, 0xb7,
+	0x32, 0x95, 0xfd, 0xdf, 0x5b, 0xc9, 0x15, 0x67, 0xff, 0xfc, 0xa3, 0x94, 0x3b, 0x78, 0xf6, 0xea,
+	0x6d, 0x29, 0xf7, 0xfa, 0x6d, 0x29, 0xf7, 0xcf, 0xdb, 0x52, 0xee, 0x97, 0x77, 0xa5, 0xa9, 0xd7,
+	0xef, 0x4a, 0x53, 0x7f, 0xbd, 0x2b, 0x4d, 0xbd, 0xac, 0xfa, 0x54, 0x36, 0xdb, 0x8d, 0xaa, 0xcb,
+	0xc2, 0xda, 0xdd, 0x6e, 0x72, 0xc1, 0x9e, 0x13, 0xd9, 0x61, 0xbc, 0x55, 0xc3, 0xc9, 0xcd, 0xae,
+	0x75, 0x6b, 0xe9, 0x94, 0x9f, 0x54, 0x94, 0x68, 0xcc, 0xa9, 0x69, 0xfd, 0xfe, 0xbf, 0x03, 0x00,
+	0xf4, 0x3d, 0x8e, 0xde, 0xfd, 0x0b, 0x00, 0x00,
 }
 
 func (m *ChainParamsList) Marshal() (dAtA []byte, err error) {
@@ -648,6 +700,18 @@ func (m *ChainParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.GasTokenPellRate.Size()
+		i -= size
+		if _, err := m.GasTokenPellRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xfa
 	if len(m.ChainRegistryInteractorContractAddress) > 0 {
 		i -= len(m.ChainRegistryInteractorContractAddress)
 		copy(dAtA[i:], m.ChainRegistryInteractorContractAddress)
@@ -1012,6 +1076,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.JailDurationBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.JailDurationBlocks))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxMissedBallotsPerWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxMissedBallotsPerWindow))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxBlamesPerWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxBlamesPerWindow))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PenaltyWindowBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PenaltyWindowBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.BallotMaturityBlocks != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.BallotMaturityBlocks))
 		i--
@@ -1172,6 +1256,8 @@ func (m *ChainParams) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovParams(uint64(l))
 	}
+	l = m.GasTokenPellRate.Size()
+	n += 2 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -1232,6 +1318,18 @@ func (m *Params) Size() (n int) {
 	if m.BallotMaturityBlocks != 0 {
 		n += 1 + sovParams(uint64(m.BallotMaturityBlocks))
 	}
+	if m.PenaltyWindowBlocks != 0 {
+		n += 1 + sovParams(uint64(m.PenaltyWindowBlocks))
+	}
+	if m.MaxBlamesPerWindow != 0 {
+		n += 1 + sovParams(uint64(m.MaxBlamesPerWindow))
+	}
+	if m.MaxMissedBallotsPerWindow != 0 {
+		n += 1 + sovParams(uint64(m.MaxMissedBallotsPerWindow))
+	}
+	if m.JailDurationBlocks != 0 {
+		n += 1 + sovParams(uint64(m.JailDurationBlocks))
+	}
 	return n
 }
 
@@ -2121,6 +2219,40 @@ func (m *ChainParams) Unmarshal(dAtA []byte) error {
 			}
 			m.ChainRegistryInteractorContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasTokenPellRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasTokenPellRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -2533,6 +2665,82 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PenaltyWindowBlocks", wireType)
+			}
+			m.PenaltyWindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PenaltyWindowBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlamesPerWindow", wireType)
+			}
+			m.MaxBlamesPerWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlamesPerWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMissedBallotsPerWindow", wireType)
+			}
+			m.MaxMissedBallotsPerWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMissedBallotsPerWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailDurationBlocks", wireType)
+			}
+			m.JailDurationBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailDurationBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -25,11 +25,15 @@ func TestParamSetPairs(t *testing.T) {
 	params := DefaultParams()
 	pairs := params.ParamSetPairs()
 
-	require.Equal(t, 3, len(pairs), "The number of param set pairs should match the expected count")
+	require.Equal(t, 7, len(pairs), "The number of param set pairs should match the expected count")
 
 	assertParamSetPair(t, pairs, KeyPrefix(ObserverParamsKey), &params.ObserverParams, validateVotingThresholds)
 	assertParamSetPair(t, pairs, KeyPrefix(AdminPolicyParamsKey), &params.AdminPolicy, validateAdminPolicy)
 	assertParamSetPair(t, pairs, KeyPrefix(BallotMaturityBlocksParamsKey), &params.BallotMaturityBlocks, validateBallotMaturityBlocks)
+	assertParamSetPair(t, pairs, KeyPrefix(PenaltyWindowBlocksParamsKey), &params.PenaltyWindowBlocks, validateNonNegativeBlocks)
+	assertParamSetPair(t, pairs, KeyPrefix(MaxBlamesPerWindowParamsKey), &params.MaxBlamesPerWindow, validatePenaltyThreshold)
+	assertParamSetPair(t, pairs, KeyPrefix(MaxMissedBallotsPerWindowParamsKey), &params.MaxMissedBallotsPerWindow, validatePenaltyThreshold)
+	assertParamSetPair(t, pairs, KeyPrefix(JailDurationBlocksParamsKey), &params.JailDurationBlocks, validateNonNegativeBlocks)
 }
 
 func assertParamSetPair(t *testing.T, pairs paramtypes.ParamSetPairs, key []byte, expectedValue interface{}, valFunc paramtypes.ValueValidatorFn) {
@@ -72,3 +76,16 @@ func TestValidateBallotMaturityBlocks(t *testing.T) {
 	require.Error(t, validateBallotMaturityBlocks("invalid"))
 	require.NoError(t, validateBallotMaturityBlocks(int64(1)))
 }
+
+func TestValidateNonNegativeBlocks(t *testing.T) {
+	require.Error(t, validateNonNegativeBlocks("invalid"))
+	require.Error(t, validateNonNegativeBlocks(int64(-1)))
+	require.NoError(t, validateNonNegativeBlocks(int64(0)))
+	require.NoError(t, validateNonNegativeBlocks(int64(100)))
+}
+
+func TestValidatePenaltyThreshold(t *testing.T) {
+	require.Error(t, validatePenaltyThreshold("invalid"))
+	require.NoError(t, validatePenaltyThreshold(uint64(0)))
+	require.NoError(t, validatePenaltyThreshold(uint64(10)))
+}
@@ -790,6 +790,88 @@ func (m *QueryTssHistoryResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryTssRotationRequest is the request type for the Query/TssRotation.
+type QueryTssRotationRequest struct {
+}
+
+func (m *QueryTssRotationRequest) Reset()         { *m = QueryTssRotationRequest{} }
+func (m *QueryTssRotationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTssRotationRequest) ProtoMessage()    {}
+func (*QueryTssRotationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca61efb15b91bf8d, []int{16}
+}
+func (m *QueryTssRotationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTssRotationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTssRotationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTssRotationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTssRotationRequest.Merge(m, src)
+}
+func (m *QueryTssRotationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTssRotationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTssRotationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTssRotationRequest proto.InternalMessageInfo
+
+// QueryTssRotationResponse is the response type for the Query/TssRotation.
+type QueryTssRotationResponse struct {
+	TssRotation TssRotation `protobuf:"bytes,1,opt,name=tss_rotation,json=tssRotation,proto3" json:"tss_rotation"`
+}
+
+func (m *QueryTssRotationResponse) Reset()         { *m = QueryTssRotationResponse{} }
+func (m *QueryTssRotationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTssRotationResponse) ProtoMessage()    {}
+func (*QueryTssRotationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca61efb15b91bf8d, []int{17}
+}
+func (m *QueryTssRotationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTssRotationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTssRotationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTssRotationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTssRotationResponse.Merge(m, src)
+}
+func (m *QueryTssRotationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTssRotationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTssRotationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTssRotationResponse proto.InternalMessageInfo
+
+func (m *QueryTssRotationResponse) GetTssRotation() TssRotation {
+	if m != nil {
+		return m.TssRotation
+	}
+	return TssRotation{}
+}
+
 // QueryParamsRequest is the request type for the Query/Params.
 type QueryParamsRequest struct {
 }
@@ -798,7 +880,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca61efb15b91bf8d, []int{16}
+	return fileDescriptor_ca61efb15b91bf8d, []int{18}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -837,7 +919,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca61efb15b91bf8d, []int{17}
+	return fileDescriptor_ca61efb15b91bf8d, []int{19}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -883,7 +965,7 @@ func (m *QueryHasVotedRequest) Reset()         { *m = QueryHasVotedRequest{} }
 func (m *QueryHasVotedRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryHasVotedRequest) ProtoMessage()    {}
 func (*QueryHasVotedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca61efb15b91bf8d, []int{18}
+	return fileDescriptor_ca61efb15b91bf8d, []int{20}
 }
 func (m *QueryHasVotedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -935,7 +1017,7 @@ func (m *QueryHasVotedResponse) Reset()         { *m = QueryHasVotedResponse{} }
 func (m *QueryHasVotedResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryHasVotedResponse) ProtoMessage()    {}
 func (*QueryHasVotedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca61efb15b91bf8d, []int{19}
+	return fileDescriptor_ca61efb15b91bf8d, []int{21}
 }
 func (m *QueryHasVotedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -981,7 +1063,7 @@ func (m *QueryBallotByIdentifierRequest) Reset()         { *m = QueryBallotByIde
 func (m *QueryBallotByIdentifierRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBallotByIdentifierRequest) ProtoMessage()    {}
 func (*QueryBallotByIdentifierRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca61efb15b91bf8d, []int{20}
+	return fileDescriptor_ca61efb15b91bf8d, []int{22}
 }
 func (m *QueryBallotByIdentifierRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1030,7 +1112,7 @@ func (m *QueryBallotByIdentifierResponse) Reset()         { *m = QueryBallotById
 func (m *QueryBallotByIdentifierResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBallotByIdentifierResponse) ProtoMessage()    {}
 func (*QueryBallotByIdentifierResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca61efb15b91bf8d, []int{21}
+	return fileDescriptor_ca61efb15b91bf8d, []int{23}
 }
 func (m *QueryBallotByIdentifierResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1097,7 +1179,7 @@ func (m *VoterList) Reset()         { *m = VoterList{} }
 func (m *VoterList) String() string { return proto.CompactTextString(m) }
 func (*VoterList) ProtoMessage()    {}
 func (*VoterList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca61efb15b91bf8d, []int{22}
+	return fileDescriptor_ca61efb15b91bf8d, []int{24}
 }
 func (m *VoterList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1148,7 +1230,7 @@ func (m *QueryObserverSet) Reset()         { *m = QueryObserverSet{} }
 func (m *QueryObserverSet) String() string { return proto.CompactTextString(m) }
 func (*QueryObserverSet) ProtoMessage()    {}
 func (*QueryObserverSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca61efb15b91bf8d, []int{23}
+	return fileDescriptor_ca61efb15b91bf8d, []int{25}
 }
 func (m *QueryObserverSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1186,7 +1268,7 @@ func (m *QueryObserverSetResponse) Reset()         { *m = QueryObserverSetRespon
 func (m *QueryObserverSetResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryObserverSetResponse) ProtoMessage()    {}
 func (*QueryObserverSetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca61efb15b91bf8d, []int{24}
+	return fileDescriptor_ca61efb15b91bf8d, []int{26}
 }
 func (m *QueryObserverSetResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1230,7 +1312,7 @@ func (m *QuerySupportedChains) Reset()         { *m = QuerySupportedChains{} }
 func (m *QuerySupportedChains) String() string { return proto.CompactTextString(m) }
 func (*QuerySupportedChains) ProtoMessage()    {}
 func (*QuerySupportedChains) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca61efb15b91bf8d, []int{25}
+	return fileDescriptor_ca61efb15b91bf8d, []int{27}
 }
 func (m *QuerySupportedChains) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1269,7 +1351,7 @@ func (m *QuerySupportedChainsResponse) Reset()         { *m = QuerySupportedChai
 func (m *QuerySupportedChainsResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySupportedChainsResponse) ProtoMessage()    {}
 func (*QuerySupportedChainsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca61efb15b91bf8d, []int{26}
+	return fileDescriptor_ca61efb15b91bf8d, []int{28}
 }
 func (m *QuerySupportedChainsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1305,24 +1387,22 @@ func (m *QuerySupportedChainsResponse) GetChains() []*chains.Chain {
 	return nil
 }
 
-// QueryGetChainParamsForChainRequest is the request type for the
-// Query/GetChainParamsForChain.
-type QueryGetChainParamsForChainRequest struct {
-	ChainId int64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+// QueryChainListRequest is the request type for the Query/ChainList.
+type QueryChainListRequest struct {
 }
 
-func (m *QueryGetChainParamsForChainRequest) Reset()         { *m = QueryGetChainParamsForChainRequest{} }
-func (m *QueryGetChainParamsForChainRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetChainParamsForChainRequest) ProtoMessage()    {}
-func (*QueryGetChainParamsForChainRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca61efb15b91bf8d, []int{27}
+func (m *QueryChainListRequest) Reset()         { *m = QueryChainListRequest{} }
+func (m *QueryChainListRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChainListRequest) ProtoMessage()    {}
+func (*QueryChainListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca61efb15b91bf8d, []int{29}
 }
-func (m *QueryGetChainParamsForChainRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryChainListRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetChainParamsForChainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryChainListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetChainParamsForChainRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryChainListRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1332,43 +1412,35 @@ func (m *QueryGetChainParamsForChainRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryGetChainParamsForChainRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetChainParamsForChainRequest.Merge(m, src)
+func (m *QueryChainListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChainListRequest.Merge(m, src)
 }
-func (m *QueryGetChainParamsForChainRequest) XXX_Size() int {
+func (m *QueryChainListRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetChainParamsForChainRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetChainParamsForChainRequest.DiscardUnknown(m)
+func (m *QueryChainListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChainListRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetChainParamsForChainRequest proto.InternalMessageInfo
-
-func (m *QueryGetChainParamsForChainRequest) GetChainId() int64 {
-	if m != nil {
-		return m.ChainId
-	}
-	return 0
-}
+var xxx_messageInfo_QueryChainListRequest proto.InternalMessageInfo
 
-// QueryGetChainParamsForChainResponse is the response type for the
-// Query/GetChainParamsForChain.
-type QueryGetChainParamsForChainResponse struct {
-	ChainParams *ChainParams `protobuf:"bytes,1,opt,name=chain_params,json=chainParams,proto3" json:"chain_params,omitempty"`
+// QueryChainListResponse is the response type for the Query/ChainList.
+type QueryChainListResponse struct {
+	Chains []chains.Chain `protobuf:"bytes,1,rep,name=chains,proto3" json:"chains"`
 }
 
-func (m *QueryGetChainParamsForChainResponse) Reset()         { *m = QueryGetChainParamsForChainResponse{} }
-func (m *QueryGetChainParamsForChainResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetChainParamsForChainResponse) ProtoMessage()    {}
-func (*QueryGetChainParamsForChainResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca61efb15b91bf8d, []int{28}
+func (m *QueryChainListResponse) Reset()         { *m = QueryChainListResponse{} }
+func (m *QueryChainListResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChainListResponse) ProtoMessage()    {}
+func (*QueryChainListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca61efb15b91bf8d, []int{30}
 }
-func (m *QueryGetChainParamsForChainResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryChainListResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetChainParamsForChainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryChainListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetChainParamsForChainResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryChainListResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1378,42 +1450,42 @@ func (m *QueryGetChainParamsForChainResponse) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *QueryGetChainParamsForChainResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetChainParamsForChainResponse.Merge(m, src)
+func (m *QueryChainListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChainListResponse.Merge(m, src)
 }
-func (m *QueryGetChainParamsForChainResponse) XXX_Size() int {
+func (m *QueryChainListResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetChainParamsForChainResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetChainParamsForChainResponse.DiscardUnknown(m)
+func (m *QueryChainListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChainListResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetChainParamsForChainResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryChainListResponse proto.InternalMessageInfo
 
-func (m *QueryGetChainParamsForChainResponse) GetChainParams() *ChainParams {
+func (m *QueryChainListResponse) GetChains() []chains.Chain {
 	if m != nil {
-		return m.ChainParams
+		return m.Chains
 	}
 	return nil
 }
 
-// QueryGetChainParamsRequest is the request type for the Query/GetChainParams.
-type QueryGetChainParamsRequest struct {
-	ChainId int64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+// QueryRestrictedAddressesRequest is the request type for the
+// Query/RestrictedAddresses.
+type QueryRestrictedAddressesRequest struct {
 }
 
-func (m *QueryGetChainParamsRequest) Reset()         { *m = QueryGetChainParamsRequest{} }
-func (m *QueryGetChainParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetChainParamsRequest) ProtoMessage()    {}
-func (*QueryGetChainParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca61efb15b91bf8d, []int{29}
+func (m *QueryRestrictedAddressesRequest) Reset()         { *m = QueryRestrictedAddressesRequest{} }
+func (m *QueryRestrictedAddressesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRestrictedAddressesRequest) ProtoMessage()    {}
+func (*QueryRestrictedAddressesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca61efb15b91bf8d, []int{31}
 }
-func (m *QueryGetChainParamsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryRestrictedAddressesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetChainParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryRestrictedAddressesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetChainParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryRestrictedAddressesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1423,43 +1495,36 @@ func (m *QueryGetChainParamsRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryGetChainParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetChainParamsRequest.Merge(m, src)
+func (m *QueryRestrictedAddressesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRestrictedAddressesRequest.Merge(m, src)
 }
-func (m *QueryGetChainParamsRequest) XXX_Size() int {
+func (m *QueryRestrictedAddressesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetChainParamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetChainParamsRequest.DiscardUnknown(m)
+func (m *QueryRestrictedAddressesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRestrictedAddressesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetChainParamsRequest proto.InternalMessageInfo
-
-func (m *QueryGetChainParamsRequest) GetChainId() int64 {
-	if m != nil {
-		return m.ChainId
-	}
-	return 0
-}
+var xxx_messageInfo_QueryRestrictedAddressesRequest proto.InternalMessageInfo
 
-// QueryGetChainParamsResponse is the response type for the
-// Query/GetChainParams.
-type QueryGetChainParamsResponse struct {
-	ChainParams *ChainParamsList `protobuf:"bytes,1,opt,name=chain_params,json=chainParams,proto3" json:"chain_params,omitempty"`
+// QueryRestrictedAddressesResponse is the response type for the
+// Query/RestrictedAddresses.
+type QueryRestrictedAddressesResponse struct {
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
 }
 
-func (m *QueryGetChainParamsResponse) Reset()         { *m = QueryGetChainParamsResponse{} }
-func (m *QueryGetChainParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetChainParamsResponse) ProtoMessage()    {}
-func (*QueryGetChainParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca61efb15b91bf8d, []int{30}
+func (m *QueryRestrictedAddressesResponse) Reset()         { *m = QueryRestrictedAddressesResponse{} }
+func (m *QueryRestrictedAddressesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRestrictedAddressesResponse) ProtoMessage()    {}
+func (*QueryRestrictedAddressesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca61efb15b91bf8d, []int{32}
 }
-func (m *QueryGetChainParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryRestrictedAddressesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetChainParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryRestrictedAddressesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetChainParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryRestrictedAddressesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1469,42 +1534,43 @@ func (m *QueryGetChainParamsResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryGetChainParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetChainParamsResponse.Merge(m, src)
+func (m *QueryRestrictedAddressesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRestrictedAddressesResponse.Merge(m, src)
 }
-func (m *QueryGetChainParamsResponse) XXX_Size() int {
+func (m *QueryRestrictedAddressesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetChainParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetChainParamsResponse.DiscardUnknown(m)
+func (m *QueryRestrictedAddressesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRestrictedAddressesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetChainParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryRestrictedAddressesResponse proto.InternalMessageInfo
 
-func (m *QueryGetChainParamsResponse) GetChainParams() *ChainParamsList {
+func (m *QueryRestrictedAddressesResponse) GetAddresses() []string {
 	if m != nil {
-		return m.ChainParams
+		return m.Addresses
 	}
 	return nil
 }
 
-// QueryGetNodeAccountRequest is the request type for the Query/GetNodeAccount.
-type QueryGetNodeAccountRequest struct {
-	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+// QueryRelayerPenaltyRequest is the request type for the
+// Query/RelayerPenalty.
+type QueryRelayerPenaltyRequest struct {
+	ObserverAddress string `protobuf:"bytes,1,opt,name=observer_address,json=observerAddress,proto3" json:"observer_address,omitempty"`
 }
 
-func (m *QueryGetNodeAccountRequest) Reset()         { *m = QueryGetNodeAccountRequest{} }
-func (m *QueryGetNodeAccountRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetNodeAccountRequest) ProtoMessage()    {}
-func (*QueryGetNodeAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca61efb15b91bf8d, []int{31}
+func (m *QueryRelayerPenaltyRequest) Reset()         { *m = QueryRelayerPenaltyRequest{} }
+func (m *QueryRelayerPenaltyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRelayerPenaltyRequest) ProtoMessage()    {}
+func (*QueryRelayerPenaltyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca61efb15b91bf8d, []int{33}
 }
-func (m *QueryGetNodeAccountRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryRelayerPenaltyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetNodeAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryRelayerPenaltyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetNodeAccountRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryRelayerPenaltyRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1514,43 +1580,43 @@ func (m *QueryGetNodeAccountRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryGetNodeAccountRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetNodeAccountRequest.Merge(m, src)
+func (m *QueryRelayerPenaltyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRelayerPenaltyRequest.Merge(m, src)
 }
-func (m *QueryGetNodeAccountRequest) XXX_Size() int {
+func (m *QueryRelayerPenaltyRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetNodeAccountRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetNodeAccountRequest.DiscardUnknown(m)
+func (m *QueryRelayerPenaltyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRelayerPenaltyRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetNodeAccountRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryRelayerPenaltyRequest proto.InternalMessageInfo
 
-func (m *QueryGetNodeAccountRequest) GetIndex() string {
+func (m *QueryRelayerPenaltyRequest) GetObserverAddress() string {
 	if m != nil {
-		return m.Index
+		return m.ObserverAddress
 	}
 	return ""
 }
 
-// QueryNodeAccountResponse is the response type for the
-// Query/GetNodeAccount.
-type QueryNodeAccountResponse struct {
-	NodeAccount *NodeAccount `protobuf:"bytes,1,opt,name=node_account,json=nodeAccount,proto3" json:"node_account,omitempty"`
+// QueryRelayerPenaltyResponse is the response type for the
+// Query/RelayerPenalty.
+type QueryRelayerPenaltyResponse struct {
+	RelayerPenalty RelayerPenalty `protobuf:"bytes,1,opt,name=relayer_penalty,json=relayerPenalty,proto3" json:"relayer_penalty"`
 }
 
-func (m *QueryNodeAccountResponse) Reset()         { *m = QueryNodeAccountResponse{} }
-func (m *QueryNodeAccountResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryNodeAccountResponse) ProtoMessage()    {}
-func (*QueryNodeAccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca61efb15b91bf8d, []int{32}
+func (m *QueryRelayerPenaltyResponse) Reset()         { *m = QueryRelayerPenaltyResponse{} }
+func (m *QueryRelayerPenaltyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRelayerPenaltyResponse) ProtoMessage()    {}
+func (*QueryRelayerPenaltyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca61efb15b91bf8d, []int{34}
 }
-func (m *QueryNodeAccountResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryRelayerPenaltyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryNodeAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryRelayerPenaltyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryNodeAccountResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryRelayerPenaltyResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1560,42 +1626,43 @@ func (m *QueryNodeAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *QueryNodeAccountResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryNodeAccountResponse.Merge(m, src)
+func (m *QueryRelayerPenaltyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRelayerPenaltyResponse.Merge(m, src)
 }
-func (m *QueryNodeAccountResponse) XXX_Size() int {
+func (m *QueryRelayerPenaltyResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryNodeAccountResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryNodeAccountResponse.DiscardUnknown(m)
+func (m *QueryRelayerPenaltyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRelayerPenaltyResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryNodeAccountResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryRelayerPenaltyResponse proto.InternalMessageInfo
 
-func (m *QueryNodeAccountResponse) GetNodeAccount() *NodeAccount {
+func (m *QueryRelayerPenaltyResponse) GetRelayerPenalty() RelayerPenalty {
 	if m != nil {
-		return m.NodeAccount
+		return m.RelayerPenalty
 	}
-	return nil
+	return RelayerPenalty{}
 }
 
-// QueryAllNodeAccountRequest is the request type for the Query/AllNodeAccount.
-type QueryAllNodeAccountRequest struct {
+// QueryAllRelayerPenaltyRequest is the request type for the
+// Query/RelayerPenaltyAll.
+type QueryAllRelayerPenaltyRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllNodeAccountRequest) Reset()         { *m = QueryAllNodeAccountRequest{} }
-func (m *QueryAllNodeAccountRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAllNodeAccountRequest) ProtoMessage()    {}
-func (*QueryAllNodeAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca61efb15b91bf8d, []int{33}
+func (m *QueryAllRelayerPenaltyRequest) Reset()         { *m = QueryAllRelayerPenaltyRequest{} }
+func (m *QueryAllRelayerPenaltyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRelayerPenaltyRequest) ProtoMessage()    {}
+func (*QueryAllRelayerPenaltyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca61efb15b91bf8d, []int{35}
 }
-func (m *QueryAllNodeAccountRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllRelayerPenaltyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllNodeAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllRelayerPenaltyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllNodeAccountRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllRelayerPenaltyRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1605,44 +1672,44 @@ func (m *QueryAllNodeAccountRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryAllNodeAccountRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllNodeAccountRequest.Merge(m, src)
+func (m *QueryAllRelayerPenaltyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRelayerPenaltyRequest.Merge(m, src)
 }
-func (m *QueryAllNodeAccountRequest) XXX_Size() int {
+func (m *QueryAllRelayerPenaltyRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllNodeAccountRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllNodeAccountRequest.DiscardUnknown(m)
+func (m *QueryAllRelayerPenaltyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRelayerPenaltyRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllNodeAccountRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllRelayerPenaltyRequest proto.InternalMessageInfo
 
-func (m *QueryAllNodeAccountRequest) GetPagination() *query.PageRequest {
+func (m *QueryAllRelayerPenaltyRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryNodeAccountAllResponse is the response type for the
-// Query/AllNodeAccount.
-type QueryNodeAccountAllResponse struct {
-	NodeAccount []*NodeAccount      `protobuf:"bytes,1,rep,name=node_account,json=nodeAccount,proto3" json:"node_account,omitempty"`
-	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryAllRelayerPenaltyResponse is the response type for the
+// Query/RelayerPenaltyAll.
+type QueryAllRelayerPenaltyResponse struct {
+	RelayerPenalties []RelayerPenalty    `protobuf:"bytes,1,rep,name=relayer_penalties,json=relayerPenalties,proto3" json:"relayer_penalties"`
+	Pagination       *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryNodeAccountAllResponse) Reset()         { *m = QueryNodeAccountAllResponse{} }
-func (m *QueryNodeAccountAllResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryNodeAccountAllResponse) ProtoMessage()    {}
-func (*QueryNodeAccountAllResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca61efb15b91bf8d, []int{34}
+func (m *QueryAllRelayerPenaltyResponse) Reset()         { *m = QueryAllRelayerPenaltyResponse{} }
+func (m *QueryAllRelayerPenaltyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRelayerPenaltyResponse) ProtoMessage()    {}
+func (*QueryAllRelayerPenaltyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca61efb15b91bf8d, []int{36}
 }
-func (m *QueryNodeAccountAllResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllRelayerPenaltyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryNodeAccountAllResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllRelayerPenaltyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryNodeAccountAllResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllRelayerPenaltyResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1652,49 +1719,50 @@ func (m *QueryNodeAccountAllResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryNodeAccountAllResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryNodeAccountAllResponse.Merge(m, src)
+func (m *QueryAllRelayerPenaltyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRelayerPenaltyResponse.Merge(m, src)
 }
-func (m *QueryNodeAccountAllResponse) XXX_Size() int {
+func (m *QueryAllRelayerPenaltyResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryNodeAccountAllResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryNodeAccountAllResponse.DiscardUnknown(m)
+func (m *QueryAllRelayerPenaltyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRelayerPenaltyResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryNodeAccountAllResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllRelayerPenaltyResponse proto.InternalMessageInfo
 
-func (m *QueryNodeAccountAllResponse) GetNodeAccount() []*NodeAccount {
+func (m *QueryAllRelayerPenaltyResponse) GetRelayerPenalties() []RelayerPenalty {
 	if m != nil {
-		return m.NodeAccount
+		return m.RelayerPenalties
 	}
 	return nil
 }
 
-func (m *QueryNodeAccountAllResponse) GetPagination() *query.PageResponse {
+func (m *QueryAllRelayerPenaltyResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryGetCrosschainFlagsRequest is the request type for the
-// Query/GetCrosschainFlags.
-type QueryGetCrosschainFlagsRequest struct {
+// QueryGetChainParamsForChainRequest is the request type for the
+// Query/GetChainParamsForChain.
+type QueryGetChainParamsForChainRequest struct {
+	ChainId int64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *QueryGetCrosschainFlagsRequest) Reset()         { *m = QueryGetCrosschainFlagsRequest{} }
-func (m *QueryGetCrosschainFlagsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetCrosschainFlagsRequest) ProtoMessage()    {}
-func (*QueryGetCrosschainFlagsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca61efb15b91bf8d, []int{35}
+func (m *QueryGetChainParamsForChainRequest) Reset()         { *m = QueryGetChainParamsForChainRequest{} }
+func (m *QueryGetChainParamsForChainRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetChainParamsForChainRequest) ProtoMessage()    {}
+func (*QueryGetChainParamsForChainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca61efb15b91bf8d, []int{37}
 }
-func (m *QueryGetCrosschainFlagsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetChainParamsForChainRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetCrosschainFlagsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetChainParamsForChainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetCrosschainFlagsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetChainParamsForChainRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1704,36 +1772,43 @@ func (m *QueryGetCrosschainFlagsRequest) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryGetCrosschainFlagsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetCrosschainFlagsRequest.Merge(m, src)
+func (m *QueryGetChainParamsForChainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetChainParamsForChainRequest.Merge(m, src)
 }
-func (m *QueryGetCrosschainFlagsRequest) XXX_Size() int {
+func (m *QueryGetChainParamsForChainRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetCrosschainFlagsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetCrosschainFlagsRequest.DiscardUnknown(m)
+func (m *QueryGetChainParamsForChainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetChainParamsForChainRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetCrosschainFlagsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetChainParamsForChainRequest proto.InternalMessageInfo
 
-// QueryCrosschainFlagsResponse is the response type for the
-// Query/GetCrosschainFlags.
-type QueryCrosschainFlagsResponse struct {
-	CrosschainFlags CrosschainFlags `protobuf:"bytes,1,opt,name=crosschain_flags,json=crosschainFlags,proto3" json:"crosschain_flags"`
+func (m *QueryGetChainParamsForChainRequest) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
 }
 
-func (m *QueryCrosschainFlagsResponse) Reset()         { *m = QueryCrosschainFlagsResponse{} }
-func (m *QueryCrosschainFlagsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryCrosschainFlagsResponse) ProtoMessage()    {}
-func (*QueryCrosschainFlagsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca61efb15b91bf8d, []int{36}
+// QueryGetChainParamsForChainResponse is the response type for the
+// Query/GetChainParamsForChain.
+type QueryGetChainParamsForChainResponse struct {
+	ChainParams *ChainParams `protobuf:"bytes,1,opt,name=chain_params,json=chainParams,proto3" json:"chain_params,omitempty"`
 }
-func (m *QueryCrosschainFlagsResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryGetChainParamsForChainResponse) Reset()         { *m = QueryGetChainParamsForChainResponse{} }
+func (m *QueryGetChainParamsForChainResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetChainParamsForChainResponse) ProtoMessage()    {}
+func (*QueryGetChainParamsForChainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca61efb15b91bf8d, []int{38}
+}
+func (m *QueryGetChainParamsForChainResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryCrosschainFlagsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetChainParamsForChainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryCrosschainFlagsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetChainParamsForChainResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1743,41 +1818,42 @@ func (m *QueryCrosschainFlagsResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryCrosschainFlagsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryCrosschainFlagsResponse.Merge(m, src)
+func (m *QueryGetChainParamsForChainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetChainParamsForChainResponse.Merge(m, src)
 }
-func (m *QueryCrosschainFlagsResponse) XXX_Size() int {
+func (m *QueryGetChainParamsForChainResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryCrosschainFlagsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryCrosschainFlagsResponse.DiscardUnknown(m)
+func (m *QueryGetChainParamsForChainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetChainParamsForChainResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryCrosschainFlagsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetChainParamsForChainResponse proto.InternalMessageInfo
 
-func (m *QueryCrosschainFlagsResponse) GetCrosschainFlags() CrosschainFlags {
+func (m *QueryGetChainParamsForChainResponse) GetChainParams() *ChainParams {
 	if m != nil {
-		return m.CrosschainFlags
+		return m.ChainParams
 	}
-	return CrosschainFlags{}
+	return nil
 }
 
-// QueryGetKeygenRequest is the request type for the Query/GetKeygen.
-type QueryGetKeygenRequest struct {
+// QueryGetChainParamsRequest is the request type for the Query/GetChainParams.
+type QueryGetChainParamsRequest struct {
+	ChainId int64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *QueryGetKeygenRequest) Reset()         { *m = QueryGetKeygenRequest{} }
-func (m *QueryGetKeygenRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetKeygenRequest) ProtoMessage()    {}
-func (*QueryGetKeygenRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca61efb15b91bf8d, []int{37}
+func (m *QueryGetChainParamsRequest) Reset()         { *m = QueryGetChainParamsRequest{} }
+func (m *QueryGetChainParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetChainParamsRequest) ProtoMessage()    {}
+func (*QueryGetChainParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca61efb15b91bf8d, []int{39}
 }
-func (m *QueryGetKeygenRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetChainParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetKeygenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetChainParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetKeygenRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetChainParamsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1787,35 +1863,43 @@ func (m *QueryGetKeygenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *QueryGetKeygenRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetKeygenRequest.Merge(m, src)
+func (m *QueryGetChainParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetChainParamsRequest.Merge(m, src)
 }
-func (m *QueryGetKeygenRequest) XXX_Size() int {
+func (m *QueryGetChainParamsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetKeygenRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetKeygenRequest.DiscardUnknown(m)
+func (m *QueryGetChainParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetChainParamsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetKeygenRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetChainParamsRequest proto.InternalMessageInfo
 
-// QueryKeygenResponse is the response type for the Query/GetKeygen.
-type QueryKeygenResponse struct {
-	Keygen *Keygen `protobuf:"bytes,1,opt,name=keygen,proto3" json:"keygen,omitempty"`
+func (m *QueryGetChainParamsRequest) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
 }
 
-func (m *QueryKeygenResponse) Reset()         { *m = QueryKeygenResponse{} }
-func (m *QueryKeygenResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryKeygenResponse) ProtoMessage()    {}
-func (*QueryKeygenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca61efb15b91bf8d, []int{38}
+// QueryGetChainParamsResponse is the response type for the
+// Query/GetChainParams.
+type QueryGetChainParamsResponse struct {
+	ChainParams *ChainParamsList `protobuf:"bytes,1,opt,name=chain_params,json=chainParams,proto3" json:"chain_params,omitempty"`
 }
-func (m *QueryKeygenResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryGetChainParamsResponse) Reset()         { *m = QueryGetChainParamsResponse{} }
+func (m *QueryGetChainParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetChainParamsResponse) ProtoMessage()    {}
+func (*QueryGetChainParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca61efb15b91bf8d, []int{40}
+}
+func (m *QueryGetChainParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryKeygenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetChainParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryKeygenResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetChainParamsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1825,42 +1909,42 @@ func (m *QueryKeygenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryKeygenResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryKeygenResponse.Merge(m, src)
+func (m *QueryGetChainParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetChainParamsResponse.Merge(m, src)
 }
-func (m *QueryKeygenResponse) XXX_Size() int {
+func (m *QueryGetChainParamsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryKeygenResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryKeygenResponse.DiscardUnknown(m)
+func (m *QueryGetChainParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetChainParamsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryKeygenResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetChainParamsResponse proto.InternalMessageInfo
 
-func (m *QueryKeygenResponse) GetKeygen() *Keygen {
+func (m *QueryGetChainParamsResponse) GetChainParams() *ChainParamsList {
 	if m != nil {
-		return m.Keygen
+		return m.ChainParams
 	}
 	return nil
 }
 
-// QueryShowObserverCountRequest is the request type for the
-// Query/ShowObserverCount.
-type QueryShowObserverCountRequest struct {
+// QueryGetNodeAccountRequest is the request type for the Query/GetNodeAccount.
+type QueryGetNodeAccountRequest struct {
+	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
 }
 
-func (m *QueryShowObserverCountRequest) Reset()         { *m = QueryShowObserverCountRequest{} }
-func (m *QueryShowObserverCountRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryShowObserverCountRequest) ProtoMessage()    {}
-func (*QueryShowObserverCountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca61efb15b91bf8d, []int{39}
+func (m *QueryGetNodeAccountRequest) Reset()         { *m = QueryGetNodeAccountRequest{} }
+func (m *QueryGetNodeAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetNodeAccountRequest) ProtoMessage()    {}
+func (*QueryGetNodeAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca61efb15b91bf8d, []int{41}
 }
-func (m *QueryShowObserverCountRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetNodeAccountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryShowObserverCountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetNodeAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryShowObserverCountRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetNodeAccountRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1870,36 +1954,43 @@ func (m *QueryShowObserverCountRequest) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryShowObserverCountRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryShowObserverCountRequest.Merge(m, src)
+func (m *QueryGetNodeAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetNodeAccountRequest.Merge(m, src)
 }
-func (m *QueryShowObserverCountRequest) XXX_Size() int {
+func (m *QueryGetNodeAccountRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryShowObserverCountRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryShowObserverCountRequest.DiscardUnknown(m)
+func (m *QueryGetNodeAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetNodeAccountRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryShowObserverCountRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetNodeAccountRequest proto.InternalMessageInfo
 
-// QueryShowObserverCountResponse is the response type for the
-// Query/ShowObserverCount.
-type QueryShowObserverCountResponse struct {
-	LastObserverCount *LastRelayerCount `protobuf:"bytes,1,opt,name=last_observer_count,json=lastObserverCount,proto3" json:"last_observer_count,omitempty"`
+func (m *QueryGetNodeAccountRequest) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
 }
 
-func (m *QueryShowObserverCountResponse) Reset()         { *m = QueryShowObserverCountResponse{} }
-func (m *QueryShowObserverCountResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryShowObserverCountResponse) ProtoMessage()    {}
-func (*QueryShowObserverCountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca61efb15b91bf8d, []int{40}
+// QueryNodeAccountResponse is the response type for the
+// Query/GetNodeAccount.
+type QueryNodeAccountResponse struct {
+	NodeAccount *NodeAccount `protobuf:"bytes,1,opt,name=node_account,json=nodeAccount,proto3" json:"node_account,omitempty"`
 }
-func (m *QueryShowObserverCountResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryNodeAccountResponse) Reset()         { *m = QueryNodeAccountResponse{} }
+func (m *QueryNodeAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNodeAccountResponse) ProtoMessage()    {}
+func (*QueryNodeAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca61efb15b91bf8d, []int{42}
+}
+func (m *QueryNodeAccountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryShowObserverCountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryNodeAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryShowObserverCountResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryNodeAccountResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1909,43 +2000,42 @@ func (m *QueryShowObserverCountResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryShowObserverCountResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryShowObserverCountResponse.Merge(m, src)
+func (m *QueryNodeAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNodeAccountResponse.Merge(m, src)
 }
-func (m *QueryShowObserverCountResponse) XXX_Size() int {
+func (m *QueryNodeAccountResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryShowObserverCountResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryShowObserverCountResponse.DiscardUnknown(m)
+func (m *QueryNodeAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNodeAccountResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryShowObserverCountResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryNodeAccountResponse proto.InternalMessageInfo
 
-func (m *QueryShowObserverCountResponse) GetLastObserverCount() *LastRelayerCount {
+func (m *QueryNodeAccountResponse) GetNodeAccount() *NodeAccount {
 	if m != nil {
-		return m.LastObserverCount
+		return m.NodeAccount
 	}
 	return nil
 }
 
-// QueryBlameByIdentifierRequest is the request type for the
-// Query/BlameByIdentifier.
-type QueryBlameByIdentifierRequest struct {
-	BlameIdentifier string `protobuf:"bytes,1,opt,name=blame_identifier,json=blameIdentifier,proto3" json:"blame_identifier,omitempty"`
+// QueryAllNodeAccountRequest is the request type for the Query/AllNodeAccount.
+type QueryAllNodeAccountRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryBlameByIdentifierRequest) Reset()         { *m = QueryBlameByIdentifierRequest{} }
-func (m *QueryBlameByIdentifierRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryBlameByIdentifierRequest) ProtoMessage()    {}
-func (*QueryBlameByIdentifierRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca61efb15b91bf8d, []int{41}
+func (m *QueryAllNodeAccountRequest) Reset()         { *m = QueryAllNodeAccountRequest{} }
+func (m *QueryAllNodeAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllNodeAccountRequest) ProtoMessage()    {}
+func (*QueryAllNodeAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca61efb15b91bf8d, []int{43}
 }
-func (m *QueryBlameByIdentifierRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllNodeAccountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryBlameByIdentifierRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllNodeAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryBlameByIdentifierRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllNodeAccountRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1955,43 +2045,44 @@ func (m *QueryBlameByIdentifierRequest) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryBlameByIdentifierRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryBlameByIdentifierRequest.Merge(m, src)
+func (m *QueryAllNodeAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllNodeAccountRequest.Merge(m, src)
 }
-func (m *QueryBlameByIdentifierRequest) XXX_Size() int {
+func (m *QueryAllNodeAccountRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryBlameByIdentifierRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryBlameByIdentifierRequest.DiscardUnknown(m)
+func (m *QueryAllNodeAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllNodeAccountRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryBlameByIdentifierRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllNodeAccountRequest proto.InternalMessageInfo
 
-func (m *QueryBlameByIdentifierRequest) GetBlameIdentifier() string {
+func (m *QueryAllNodeAccountRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.BlameIdentifier
+		return m.Pagination
 	}
-	return ""
+	return nil
 }
 
-// QueryBlameByIdentifierResponse is the response type for the
-// Query/BlameByIdentifier.
-type QueryBlameByIdentifierResponse struct {
-	BlameInfo *Blame `protobuf:"bytes,1,opt,name=blame_info,json=blameInfo,proto3" json:"blame_info,omitempty"`
+// QueryNodeAccountAllResponse is the response type for the
+// Query/AllNodeAccount.
+type QueryNodeAccountAllResponse struct {
+	NodeAccount []*NodeAccount      `protobuf:"bytes,1,rep,name=node_account,json=nodeAccount,proto3" json:"node_account,omitempty"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryBlameByIdentifierResponse) Reset()         { *m = QueryBlameByIdentifierResponse{} }
-func (m *QueryBlameByIdentifierResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryBlameByIdentifierResponse) ProtoMessage()    {}
-func (*QueryBlameByIdentifierResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca61efb15b91bf8d, []int{42}
+func (m *QueryNodeAccountAllResponse) Reset()         { *m = QueryNodeAccountAllResponse{} }
+func (m *QueryNodeAccountAllResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNodeAccountAllResponse) ProtoMessage()    {}
+func (*QueryNodeAccountAllResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca61efb15b91bf8d, []int{44}
 }
-func (m *QueryBlameByIdentifierResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryNodeAccountAllResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryBlameByIdentifierResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryNodeAccountAllResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryBlameByIdentifierResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryNodeAccountAllResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2001,43 +2092,49 @@ func (m *QueryBlameByIdentifierResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryBlameByIdentifierResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryBlameByIdentifierResponse.Merge(m, src)
+func (m *QueryNodeAccountAllResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNodeAccountAllResponse.Merge(m, src)
 }
-func (m *QueryBlameByIdentifierResponse) XXX_Size() int {
+func (m *QueryNodeAccountAllResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryBlameByIdentifierResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryBlameByIdentifierResponse.DiscardUnknown(m)
+func (m *QueryNodeAccountAllResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNodeAccountAllResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryBlameByIdentifierResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryNodeAccountAllResponse proto.InternalMessageInfo
 
-func (m *QueryBlameByIdentifierResponse) GetBlameInfo() *Blame {
+func (m *QueryNodeAccountAllResponse) GetNodeAccount() []*NodeAccount {
 	if m != nil {
-		return m.BlameInfo
+		return m.NodeAccount
 	}
 	return nil
 }
 
-// QueryAllBlameRecordsRequest is the request type for the
-// Query/AllBlameRecords.
-type QueryAllBlameRecordsRequest struct {
-	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *QueryNodeAccountAllResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryAllBlameRecordsRequest) Reset()         { *m = QueryAllBlameRecordsRequest{} }
-func (m *QueryAllBlameRecordsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAllBlameRecordsRequest) ProtoMessage()    {}
-func (*QueryAllBlameRecordsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca61efb15b91bf8d, []int{43}
+// QueryGetCrosschainFlagsRequest is the request type for the
+// Query/GetCrosschainFlags.
+type QueryGetCrosschainFlagsRequest struct {
 }
-func (m *QueryAllBlameRecordsRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryGetCrosschainFlagsRequest) Reset()         { *m = QueryGetCrosschainFlagsRequest{} }
+func (m *QueryGetCrosschainFlagsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCrosschainFlagsRequest) ProtoMessage()    {}
+func (*QueryGetCrosschainFlagsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca61efb15b91bf8d, []int{45}
+}
+func (m *QueryGetCrosschainFlagsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllBlameRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetCrosschainFlagsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllBlameRecordsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetCrosschainFlagsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2047,44 +2144,36 @@ func (m *QueryAllBlameRecordsRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryAllBlameRecordsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllBlameRecordsRequest.Merge(m, src)
+func (m *QueryGetCrosschainFlagsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCrosschainFlagsRequest.Merge(m, src)
 }
-func (m *QueryAllBlameRecordsRequest) XXX_Size() int {
+func (m *QueryGetCrosschainFlagsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllBlameRecordsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllBlameRecordsRequest.DiscardUnknown(m)
+func (m *QueryGetCrosschainFlagsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCrosschainFlagsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllBlameRecordsRequest proto.InternalMessageInfo
-
-func (m *QueryAllBlameRecordsRequest) GetPagination() *query.PageRequest {
-	if m != nil {
-		return m.Pagination
-	}
-	return nil
-}
+var xxx_messageInfo_QueryGetCrosschainFlagsRequest proto.InternalMessageInfo
 
-// QueryGetAllBlameRecordsResponse is the response type for the
-// Query/AllBlameRecords.
-type QueryGetAllBlameRecordsResponse struct {
-	BlameInfo  []Blame             `protobuf:"bytes,1,rep,name=blame_info,json=blameInfo,proto3" json:"blame_info"`
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryCrosschainFlagsResponse is the response type for the
+// Query/GetCrosschainFlags.
+type QueryCrosschainFlagsResponse struct {
+	CrosschainFlags CrosschainFlags `protobuf:"bytes,1,opt,name=crosschain_flags,json=crosschainFlags,proto3" json:"crosschain_flags"`
 }
 
-func (m *QueryGetAllBlameRecordsResponse) Reset()         { *m = QueryGetAllBlameRecordsResponse{} }
-func (m *QueryGetAllBlameRecordsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetAllBlameRecordsResponse) ProtoMessage()    {}
-func (*QueryGetAllBlameRecordsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca61efb15b91bf8d, []int{44}
+func (m *QueryCrosschainFlagsResponse) Reset()         { *m = QueryCrosschainFlagsResponse{} }
+func (m *QueryCrosschainFlagsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCrosschainFlagsResponse) ProtoMessage()    {}
+func (*QueryCrosschainFlagsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca61efb15b91bf8d, []int{46}
 }
-func (m *QueryGetAllBlameRecordsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryCrosschainFlagsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetAllBlameRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCrosschainFlagsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetAllBlameRecordsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCrosschainFlagsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2094,51 +2183,42 @@ func (m *QueryGetAllBlameRecordsResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *QueryGetAllBlameRecordsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetAllBlameRecordsResponse.Merge(m, src)
+func (m *QueryCrosschainFlagsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCrosschainFlagsResponse.Merge(m, src)
 }
-func (m *QueryGetAllBlameRecordsResponse) XXX_Size() int {
+func (m *QueryCrosschainFlagsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetAllBlameRecordsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetAllBlameRecordsResponse.DiscardUnknown(m)
+func (m *QueryCrosschainFlagsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCrosschainFlagsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetAllBlameRecordsResponse proto.InternalMessageInfo
-
-func (m *QueryGetAllBlameRecordsResponse) GetBlameInfo() []Blame {
-	if m != nil {
-		return m.BlameInfo
-	}
-	return nil
-}
+var xxx_messageInfo_QueryCrosschainFlagsResponse proto.InternalMessageInfo
 
-func (m *QueryGetAllBlameRecordsResponse) GetPagination() *query.PageResponse {
+func (m *QueryCrosschainFlagsResponse) GetCrosschainFlags() CrosschainFlags {
 	if m != nil {
-		return m.Pagination
+		return m.CrosschainFlags
 	}
-	return nil
+	return CrosschainFlags{}
 }
 
-// QueryBlameByChainAndNonceRequest is the request type for the
-// Query/BlameByChainAndNonce.
-type QueryBlameByChainAndNonceRequest struct {
+// QueryChainPauseRequest is the request type for the Query/ChainPause.
+type QueryChainPauseRequest struct {
 	ChainId int64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	Nonce   int64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
-func (m *QueryBlameByChainAndNonceRequest) Reset()         { *m = QueryBlameByChainAndNonceRequest{} }
-func (m *QueryBlameByChainAndNonceRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryBlameByChainAndNonceRequest) ProtoMessage()    {}
-func (*QueryBlameByChainAndNonceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca61efb15b91bf8d, []int{45}
+func (m *QueryChainPauseRequest) Reset()         { *m = QueryChainPauseRequest{} }
+func (m *QueryChainPauseRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChainPauseRequest) ProtoMessage()    {}
+func (*QueryChainPauseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca61efb15b91bf8d, []int{47}
 }
-func (m *QueryBlameByChainAndNonceRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryChainPauseRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryBlameByChainAndNonceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryChainPauseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryBlameByChainAndNonceRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryChainPauseRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2148,50 +2228,86 @@ func (m *QueryBlameByChainAndNonceRequest) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *QueryBlameByChainAndNonceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryBlameByChainAndNonceRequest.Merge(m, src)
+func (m *QueryChainPauseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChainPauseRequest.Merge(m, src)
 }
-func (m *QueryBlameByChainAndNonceRequest) XXX_Size() int {
+func (m *QueryChainPauseRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryBlameByChainAndNonceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryBlameByChainAndNonceRequest.DiscardUnknown(m)
+func (m *QueryChainPauseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChainPauseRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryBlameByChainAndNonceRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryChainPauseRequest proto.InternalMessageInfo
 
-func (m *QueryBlameByChainAndNonceRequest) GetChainId() int64 {
+func (m *QueryChainPauseRequest) GetChainId() int64 {
 	if m != nil {
 		return m.ChainId
 	}
 	return 0
 }
 
-func (m *QueryBlameByChainAndNonceRequest) GetNonce() int64 {
+// QueryChainPauseResponse is the response type for the Query/ChainPause.
+type QueryChainPauseResponse struct {
+	ChainPause ChainPause `protobuf:"bytes,1,opt,name=chain_pause,json=chainPause,proto3" json:"chain_pause"`
+}
+
+func (m *QueryChainPauseResponse) Reset()         { *m = QueryChainPauseResponse{} }
+func (m *QueryChainPauseResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChainPauseResponse) ProtoMessage()    {}
+func (*QueryChainPauseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca61efb15b91bf8d, []int{48}
+}
+func (m *QueryChainPauseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChainPauseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChainPauseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChainPauseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChainPauseResponse.Merge(m, src)
+}
+func (m *QueryChainPauseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChainPauseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChainPauseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChainPauseResponse proto.InternalMessageInfo
+
+func (m *QueryChainPauseResponse) GetChainPause() ChainPause {
 	if m != nil {
-		return m.Nonce
+		return m.ChainPause
 	}
-	return 0
+	return ChainPause{}
 }
 
-// QueryBlamesByChainAndNonceResponse is the response type for the
-// Query/BlameByChainAndNonce.
-type QueryBlamesByChainAndNonceResponse struct {
-	BlameInfo []*Blame `protobuf:"bytes,1,rep,name=blame_info,json=blameInfo,proto3" json:"blame_info,omitempty"`
+// QueryAllChainPauseRequest is the request type for the Query/ChainPauseAll.
+type QueryAllChainPauseRequest struct {
 }
 
-func (m *QueryBlamesByChainAndNonceResponse) Reset()         { *m = QueryBlamesByChainAndNonceResponse{} }
-func (m *QueryBlamesByChainAndNonceResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryBlamesByChainAndNonceResponse) ProtoMessage()    {}
-func (*QueryBlamesByChainAndNonceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca61efb15b91bf8d, []int{46}
+func (m *QueryAllChainPauseRequest) Reset()         { *m = QueryAllChainPauseRequest{} }
+func (m *QueryAllChainPauseRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllChainPauseRequest) ProtoMessage()    {}
+func (*QueryAllChainPauseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca61efb15b91bf8d, []int{49}
 }
-func (m *QueryBlamesByChainAndNonceResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllChainPauseRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryBlamesByChainAndNonceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllChainPauseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryBlamesByChainAndNonceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllChainPauseRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2201,1956 +2317,1938 @@ func (m *QueryBlamesByChainAndNonceResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryBlamesByChainAndNonceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryBlamesByChainAndNonceResponse.Merge(m, src)
+func (m *QueryAllChainPauseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllChainPauseRequest.Merge(m, src)
 }
-func (m *QueryBlamesByChainAndNonceResponse) XXX_Size() int {
+func (m *QueryAllChainPauseRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryBlamesByChainAndNonceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryBlamesByChainAndNonceResponse.DiscardUnknown(m)
+func (m *QueryAllChainPauseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllChainPauseRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryBlamesByChainAndNonceResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllChainPauseRequest proto.InternalMessageInfo
 
-func (m *QueryBlamesByChainAndNonceResponse) GetBlameInfo() []*Blame {
+// QueryAllChainPauseResponse is the response type for the Query/ChainPauseAll.
+type QueryAllChainPauseResponse struct {
+	ChainPauses []ChainPause `protobuf:"bytes,1,rep,name=chain_pauses,json=chainPauses,proto3" json:"chain_pauses"`
+}
+
+func (m *QueryAllChainPauseResponse) Reset()         { *m = QueryAllChainPauseResponse{} }
+func (m *QueryAllChainPauseResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllChainPauseResponse) ProtoMessage()    {}
+func (*QueryAllChainPauseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca61efb15b91bf8d, []int{50}
+}
+func (m *QueryAllChainPauseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllChainPauseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllChainPauseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllChainPauseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllChainPauseResponse.Merge(m, src)
+}
+func (m *QueryAllChainPauseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllChainPauseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllChainPauseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllChainPauseResponse proto.InternalMessageInfo
+
+func (m *QueryAllChainPauseResponse) GetChainPauses() []ChainPause {
 	if m != nil {
-		return m.BlameInfo
+		return m.ChainPauses
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryGetChainNoncesRequest)(nil), "relayer.QueryGetChainNoncesRequest")
-	proto.RegisterType((*QueryChainNoncesResponse)(nil), "relayer.QueryChainNoncesResponse")
-	proto.RegisterType((*QueryAllChainNoncesRequest)(nil), "relayer.QueryAllChainNoncesRequest")
-	proto.RegisterType((*QueryChainNoncesAllResponse)(nil), "relayer.QueryChainNoncesAllResponse")
-	proto.RegisterType((*QueryAllPendingNoncesRequest)(nil), "relayer.QueryAllPendingNoncesRequest")
-	proto.RegisterType((*QueryPendingNoncesAllResponse)(nil), "relayer.QueryPendingNoncesAllResponse")
-	proto.RegisterType((*QueryPendingNoncesByChainRequest)(nil), "relayer.QueryPendingNoncesByChainRequest")
-	proto.RegisterType((*QueryPendingNoncesByChainResponse)(nil), "relayer.QueryPendingNoncesByChainResponse")
-	proto.RegisterType((*QueryGetTSSRequest)(nil), "relayer.QueryGetTSSRequest")
-	proto.RegisterType((*QueryTSSResponse)(nil), "relayer.QueryTSSResponse")
-	proto.RegisterType((*QueryGetTssAddressRequest)(nil), "relayer.QueryGetTssAddressRequest")
-	proto.RegisterType((*QueryGetTssAddressResponse)(nil), "relayer.QueryGetTssAddressResponse")
-	proto.RegisterType((*QueryGetTssAddressByFinalizedHeightRequest)(nil), "relayer.QueryGetTssAddressByFinalizedHeightRequest")
-	proto.RegisterType((*QueryGetTssAddressByFinalizedHeightResponse)(nil), "relayer.QueryGetTssAddressByFinalizedHeightResponse")
-	proto.RegisterType((*QueryTssHistoryRequest)(nil), "relayer.QueryTssHistoryRequest")
-	proto.RegisterType((*QueryTssHistoryResponse)(nil), "relayer.QueryTssHistoryResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "relayer.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "relayer.QueryParamsResponse")
-	proto.RegisterType((*QueryHasVotedRequest)(nil), "relayer.QueryHasVotedRequest")
-	proto.RegisterType((*QueryHasVotedResponse)(nil), "relayer.QueryHasVotedResponse")
-	proto.RegisterType((*QueryBallotByIdentifierRequest)(nil), "relayer.QueryBallotByIdentifierRequest")
-	proto.RegisterType((*QueryBallotByIdentifierResponse)(nil), "relayer.QueryBallotByIdentifierResponse")
-	proto.RegisterType((*VoterList)(nil), "relayer.VoterList")
-	proto.RegisterType((*QueryObserverSet)(nil), "relayer.QueryObserverSet")
-	proto.RegisterType((*QueryObserverSetResponse)(nil), "relayer.QueryObserverSetResponse")
-	proto.RegisterType((*QuerySupportedChains)(nil), "relayer.QuerySupportedChains")
-	proto.RegisterType((*QuerySupportedChainsResponse)(nil), "relayer.QuerySupportedChainsResponse")
-	proto.RegisterType((*QueryGetChainParamsForChainRequest)(nil), "relayer.QueryGetChainParamsForChainRequest")
-	proto.RegisterType((*QueryGetChainParamsForChainResponse)(nil), "relayer.QueryGetChainParamsForChainResponse")
-	proto.RegisterType((*QueryGetChainParamsRequest)(nil), "relayer.QueryGetChainParamsRequest")
-	proto.RegisterType((*QueryGetChainParamsResponse)(nil), "relayer.QueryGetChainParamsResponse")
-	proto.RegisterType((*QueryGetNodeAccountRequest)(nil), "relayer.QueryGetNodeAccountRequest")
-	proto.RegisterType((*QueryNodeAccountResponse)(nil), "relayer.QueryNodeAccountResponse")
-	proto.RegisterType((*QueryAllNodeAccountRequest)(nil), "relayer.QueryAllNodeAccountRequest")
-	proto.RegisterType((*QueryNodeAccountAllResponse)(nil), "relayer.QueryNodeAccountAllResponse")
-	proto.RegisterType((*QueryGetCrosschainFlagsRequest)(nil), "relayer.QueryGetCrosschainFlagsRequest")
-	proto.RegisterType((*QueryCrosschainFlagsResponse)(nil), "relayer.QueryCrosschainFlagsResponse")
-	proto.RegisterType((*QueryGetKeygenRequest)(nil), "relayer.QueryGetKeygenRequest")
-	proto.RegisterType((*QueryKeygenResponse)(nil), "relayer.QueryKeygenResponse")
-	proto.RegisterType((*QueryShowObserverCountRequest)(nil), "relayer.QueryShowObserverCountRequest")
-	proto.RegisterType((*QueryShowObserverCountResponse)(nil), "relayer.QueryShowObserverCountResponse")
-	proto.RegisterType((*QueryBlameByIdentifierRequest)(nil), "relayer.QueryBlameByIdentifierRequest")
-	proto.RegisterType((*QueryBlameByIdentifierResponse)(nil), "relayer.QueryBlameByIdentifierResponse")
-	proto.RegisterType((*QueryAllBlameRecordsRequest)(nil), "relayer.QueryAllBlameRecordsRequest")
-	proto.RegisterType((*QueryGetAllBlameRecordsResponse)(nil), "relayer.QueryGetAllBlameRecordsResponse")
-	proto.RegisterType((*QueryBlameByChainAndNonceRequest)(nil), "relayer.QueryBlameByChainAndNonceRequest")
-	proto.RegisterType((*QueryBlamesByChainAndNonceResponse)(nil), "relayer.QueryBlamesByChainAndNonceResponse")
+// QueryGetKeygenRequest is the request type for the Query/GetKeygen.
+type QueryGetKeygenRequest struct {
 }
 
-func init() { proto.RegisterFile("relayer/query.proto", fileDescriptor_ca61efb15b91bf8d) }
-
-var fileDescriptor_ca61efb15b91bf8d = []byte{
-	// 2130 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x99, 0xcf, 0x6f, 0xdc, 0xc6,
-	0x15, 0xc7, 0x4d, 0x6f, 0x22, 0x4b, 0x23, 0x5b, 0x3f, 0xc6, 0x92, 0x2d, 0xd1, 0xca, 0x4a, 0x1e,
-	0xc9, 0x96, 0x64, 0x39, 0xcb, 0x58, 0x36, 0x1a, 0x37, 0x3f, 0x6c, 0xac, 0x94, 0xda, 0x56, 0x9b,
-	0x3a, 0xea, 0xae, 0x51, 0x20, 0x29, 0x90, 0x0d, 0x77, 0x77, 0xb4, 0xcb, 0x8a, 0x26, 0x37, 0x1c,
-	0xca, 0xf6, 0x56, 0xd0, 0xa5, 0x28, 0x0a, 0xb4, 0x28, 0xd0, 0x16, 0x2d, 0xd0, 0x9e, 0xda, 0x43,
-	0x51, 0xa0, 0x97, 0x5c, 0x8b, 0xfe, 0x09, 0x39, 0x06, 0xc8, 0xa5, 0xa7, 0xa2, 0xb0, 0x7b, 0xee,
-	0x3f, 0xd0, 0x4b, 0xc1, 0x99, 0x47, 0x2e, 0x67, 0x38, 0xa4, 0xd6, 0x81, 0x7c, 0xda, 0xe5, 0x9b,
-	0x1f, 0xef, 0x33, 0x6f, 0xde, 0xcc, 0x7c, 0x39, 0x44, 0xe7, 0x03, 0xea, 0xda, 0x7d, 0x1a, 0x58,
-	0x9f, 0x1f, 0xd0, 0xa0, 0x5f, 0xe9, 0x05, 0x7e, 0xe8, 0xe3, 0x33, 0x60, 0x34, 0xaf, 0xb5, 0x7c,
-	0xf6, 0xd8, 0x67, 0x56, 0xd3, 0x66, 0x54, 0xd4, 0xb0, 0x9e, 0xdc, 0x68, 0xd2, 0xd0, 0xbe, 0x61,
-	0xf5, 0xec, 0x8e, 0xe3, 0xd9, 0xa1, 0xe3, 0x7b, 0xa2, 0x91, 0x39, 0xd3, 0xf1, 0x3b, 0x3e, 0xff,
-	0x6b, 0x45, 0xff, 0xc0, 0xba, 0xd0, 0xf1, 0xfd, 0x8e, 0x4b, 0x2d, 0xbb, 0xe7, 0x58, 0xb6, 0xe7,
-	0xf9, 0x21, 0x6f, 0xc2, 0xa0, 0xf4, 0x62, 0x6f, 0xbf, 0x63, 0xb5, 0xba, 0xb6, 0xe3, 0x31, 0xf8,
-	0x89, 0x3b, 0x8b, 0xb1, 0x9a, 0xb6, 0xeb, 0xfa, 0x21, 0x58, 0x13, 0xd8, 0xa6, 0x6b, 0x3f, 0xa6,
-	0x60, 0x34, 0x63, 0x23, 0xef, 0xa0, 0xe1, 0xf9, 0x5e, 0x8b, 0xc6, 0xdd, 0x94, 0x93, 0xb2, 0xc0,
-	0x67, 0x4c, 0x54, 0xd8, 0x73, 0xed, 0x4e, 0xc6, 0xcd, 0x3e, 0xed, 0x77, 0xa8, 0xa7, 0xf6, 0xe8,
-	0xf9, 0x6d, 0xda, 0xb0, 0x5b, 0x2d, 0xff, 0xc0, 0x0b, 0xd5, 0x16, 0x3d, 0x3b, 0xb0, 0x1f, 0xc7,
-	0xfd, 0x2c, 0x24, 0x56, 0xea, 0xb5, 0x1d, 0xaf, 0x23, 0x53, 0xcc, 0xc6, 0xa5, 0xf0, 0x0b, 0xe6,
-	0xe9, 0xd8, 0x1c, 0x32, 0xa8, 0x49, 0x36, 0x91, 0xf9, 0x83, 0x28, 0xca, 0xf7, 0x69, 0xb8, 0x1d,
-	0xc1, 0x3e, 0xe4, 0xdd, 0xd4, 0xe8, 0xe7, 0x07, 0x94, 0x85, 0x78, 0x06, 0xbd, 0xee, 0x78, 0x6d,
-	0xfa, 0x6c, 0xce, 0x58, 0x32, 0xd6, 0xc6, 0x6a, 0xe2, 0x81, 0x7c, 0x8c, 0xe6, 0x78, 0x1b, 0xa9,
-	0x01, 0xeb, 0xf9, 0x1e, 0xa3, 0xf8, 0x7d, 0x74, 0x36, 0x1d, 0x15, 0xde, 0x70, 0x7c, 0x73, 0xa6,
-	0x12, 0x83, 0xa4, 0xda, 0x6c, 0xbd, 0xf6, 0xe5, 0xbf, 0x16, 0x4f, 0xd5, 0xc6, 0x5b, 0x03, 0x13,
-	0x69, 0x03, 0x4e, 0xd5, 0x75, 0x35, 0x38, 0xf7, 0x10, 0x1a, 0x24, 0x01, 0x74, 0x7d, 0xb5, 0x22,
-	0x32, 0xa6, 0x12, 0x65, 0x4c, 0x45, 0xe4, 0x14, 0x64, 0x4c, 0x65, 0xd7, 0xee, 0x50, 0x68, 0x5b,
-	0x4b, 0xb5, 0x24, 0x7f, 0x35, 0xd0, 0x25, 0x75, 0x04, 0x55, 0xd7, 0x2d, 0x18, 0x44, 0xe9, 0x25,
-	0x06, 0x81, 0xef, 0x4b, 0x98, 0xa7, 0x39, 0xe6, 0xea, 0xb1, 0x98, 0xc2, 0xb7, 0xc4, 0xb9, 0x87,
-	0x16, 0xe2, 0x68, 0xec, 0x8a, 0x69, 0x7e, 0x35, 0xf1, 0xf8, 0xc2, 0x40, 0x6f, 0x70, 0x47, 0x92,
-	0x97, 0x74, 0x44, 0xb6, 0xd1, 0x84, 0x9c, 0x68, 0x10, 0x93, 0x0b, 0x49, 0x4c, 0xa4, 0xa6, 0x10,
-	0x95, 0x73, 0xbd, 0xb4, 0xf1, 0xe4, 0xe2, 0xf2, 0x3e, 0x5a, 0xca, 0xe2, 0x6e, 0x89, 0xd9, 0x8c,
-	0x63, 0x33, 0x8f, 0x46, 0xc5, 0x1c, 0x3a, 0x6d, 0x1e, 0x99, 0x52, 0xed, 0x0c, 0x7f, 0xde, 0x69,
-	0x93, 0x2e, 0xba, 0x5c, 0xd0, 0xbc, 0x60, 0xc4, 0xc6, 0x4b, 0x8e, 0x98, 0xcc, 0x20, 0x1c, 0xaf,
-	0xae, 0x47, 0xf5, 0x3a, 0xa0, 0x91, 0xdb, 0x68, 0x8a, 0x5b, 0xb9, 0x09, 0xdc, 0xad, 0xa0, 0x52,
-	0xc8, 0x62, 0x1f, 0x67, 0x13, 0x1f, 0x8f, 0xea, 0x75, 0xe8, 0x39, 0x2a, 0x26, 0xdf, 0x41, 0xf3,
-	0x49, 0x7f, 0x8c, 0x55, 0xdb, 0xed, 0x80, 0xb2, 0x24, 0x1b, 0xd6, 0xd0, 0x54, 0xd3, 0x09, 0x5b,
-	0xbe, 0xe3, 0x35, 0x94, 0x91, 0x4f, 0x80, 0x7d, 0x1b, 0x02, 0x50, 0x19, 0x2c, 0xfa, 0x74, 0x37,
-	0x80, 0x32, 0x85, 0x4a, 0x34, 0xec, 0xc2, 0x92, 0x8f, 0xfe, 0x92, 0x5f, 0x1a, 0xe8, 0x5a, 0xb6,
-	0xc1, 0x56, 0xff, 0x9e, 0xe3, 0xd9, 0xae, 0xf3, 0x13, 0xda, 0x7e, 0x40, 0x9d, 0x4e, 0x37, 0x8c,
-	0x41, 0x36, 0xd1, 0xec, 0x5e, 0x5c, 0xd2, 0xe8, 0x51, 0xd7, 0x6d, 0x74, 0x79, 0x39, 0xd0, 0x9c,
-	0x4f, 0x0a, 0x77, 0xa9, 0xeb, 0x8a, 0xa6, 0x5a, 0xf8, 0xd3, 0x5a, 0xf8, 0xbb, 0x68, 0x63, 0x28,
-	0x96, 0xdc, 0xd1, 0x7c, 0x86, 0x2e, 0x88, 0xf0, 0x33, 0xf6, 0xc0, 0x61, 0xa1, 0x1f, 0xf4, 0x4f,
-	0x7a, 0x3d, 0xfd, 0xd6, 0x40, 0x17, 0x33, 0x2e, 0x80, 0xe7, 0x4d, 0x34, 0x1a, 0x32, 0xd6, 0x70,
-	0x1d, 0x16, 0xc2, 0x1a, 0xd2, 0xcd, 0xf6, 0x99, 0x90, 0xb1, 0x0f, 0x1d, 0x16, 0x9e, 0xdc, 0x9a,
-	0x89, 0x53, 0x71, 0x97, 0x9f, 0x22, 0x71, 0x2a, 0x7e, 0x80, 0xce, 0x4b, 0xd6, 0x04, 0x72, 0x44,
-	0x9c, 0x36, 0x10, 0x84, 0xc9, 0x41, 0xd2, 0x73, 0x33, 0x50, 0x42, 0x25, 0xd2, 0x45, 0x33, 0xbc,
-	0x97, 0x07, 0x36, 0xfb, 0xa1, 0x1f, 0xd2, 0x76, 0x1c, 0xcf, 0x0d, 0x34, 0x2d, 0x4e, 0xd3, 0x86,
-	0xd3, 0xa6, 0x5e, 0xe8, 0xec, 0x39, 0x34, 0x80, 0x99, 0x98, 0x12, 0x05, 0x3b, 0x89, 0x1d, 0x2f,
-	0xa3, 0x73, 0x4f, 0xfc, 0x90, 0x06, 0x0d, 0x5b, 0x4c, 0x29, 0x1f, 0xec, 0x58, 0xed, 0x2c, 0x37,
-	0xc2, 0x34, 0x93, 0x5b, 0x68, 0x56, 0xf1, 0x04, 0xc4, 0x97, 0xd0, 0x58, 0xd7, 0x66, 0x8d, 0xa8,
-	0xb2, 0xc8, 0xfa, 0xd1, 0xda, 0x68, 0x17, 0x2a, 0x91, 0xef, 0xa3, 0x32, 0x6f, 0xb5, 0xc5, 0x7d,
-	0x6e, 0xf5, 0x07, 0x5e, 0xbf, 0x09, 0x29, 0xf9, 0x9f, 0x81, 0x16, 0x73, 0xfb, 0x03, 0x9e, 0x97,
-	0x1a, 0xfa, 0x35, 0x34, 0xc2, 0x47, 0x19, 0x8d, 0x39, 0xca, 0x08, 0x9c, 0x84, 0x3b, 0xe2, 0x0f,
-	0xa2, 0x44, 0xa8, 0x41, 0x0d, 0xbc, 0x8d, 0xa6, 0xfc, 0x26, 0xa3, 0xc1, 0x13, 0x3e, 0xad, 0x8d,
-	0xb0, 0xdf, 0xa3, 0x73, 0xa5, 0x25, 0x63, 0x6d, 0x62, 0x73, 0x2e, 0x69, 0xf5, 0xd1, 0xa0, 0xc2,
-	0xa3, 0x7e, 0x8f, 0xd6, 0x26, 0x7d, 0xd9, 0x80, 0xdf, 0x41, 0xe7, 0x80, 0x8e, 0x85, 0x76, 0x78,
-	0xc0, 0xe6, 0x5e, 0xe3, 0x3d, 0xcc, 0x26, 0x3d, 0x88, 0x91, 0xd5, 0x79, 0x61, 0xed, 0x6c, 0x33,
-	0xf5, 0x44, 0x3e, 0x43, 0x63, 0x09, 0x55, 0x76, 0xd2, 0x8c, 0xec, 0xa4, 0xe1, 0x0a, 0x1a, 0x8b,
-	0x9e, 0x05, 0xeb, 0x69, 0xee, 0x69, 0x5a, 0x1a, 0x21, 0x87, 0x1c, 0x7d, 0x02, 0xff, 0x08, 0x86,
-	0xfd, 0x51, 0x0c, 0x83, 0x06, 0x75, 0x1a, 0xed, 0x99, 0x73, 0xaa, 0x2d, 0x89, 0xf5, 0x02, 0x1a,
-	0xf3, 0xc1, 0x2c, 0xce, 0xa5, 0xb1, 0xda, 0xc0, 0x40, 0x2e, 0x40, 0x72, 0xd6, 0x0f, 0x7a, 0x3d,
-	0x3f, 0x08, 0x69, 0x9b, 0x6f, 0x24, 0x8c, 0xec, 0xc0, 0xe1, 0xaa, 0xd8, 0x93, 0x5e, 0xd7, 0xd1,
-	0x88, 0x10, 0x88, 0xb0, 0x4c, 0xa7, 0x2b, 0xbd, 0xfd, 0x4e, 0x05, 0x34, 0xa3, 0x38, 0x2b, 0xa0,
-	0x02, 0xb9, 0x8b, 0x88, 0x24, 0xa2, 0xc4, 0x22, 0xb9, 0xe7, 0x07, 0xc3, 0x9e, 0x48, 0x9f, 0xa2,
-	0xe5, 0xc2, 0x0e, 0x00, 0xe9, 0xed, 0x58, 0x97, 0x48, 0x8b, 0x53, 0xd1, 0x25, 0xb0, 0x94, 0x85,
-	0x22, 0x11, 0x0f, 0xe4, 0x6d, 0x45, 0xe5, 0x49, 0x9b, 0x40, 0x11, 0xd8, 0x27, 0x20, 0x94, 0xd4,
-	0x86, 0x00, 0xf4, 0xae, 0x16, 0x68, 0x4e, 0x07, 0xc4, 0x93, 0x58, 0x82, 0x4a, 0x49, 0xcf, 0x87,
-	0x7e, 0x9b, 0x56, 0x85, 0xea, 0x2d, 0x96, 0x9e, 0x75, 0x48, 0x03, 0xa9, 0xc1, 0x20, 0x3a, 0x69,
-	0xf9, 0x9c, 0x89, 0x4e, 0xba, 0xcd, 0xb8, 0x37, 0x78, 0x48, 0x8b, 0x4e, 0x0d, 0xc8, 0x49, 0x1d,
-	0x0a, 0x7f, 0x8e, 0x45, 0x67, 0xca, 0x47, 0x5a, 0x62, 0x65, 0xf1, 0x4b, 0x43, 0xe1, 0x9f, 0xdc,
-	0x11, 0xb1, 0x04, 0xdb, 0x64, 0x34, 0xd9, 0xc9, 0xdb, 0xcb, 0xbd, 0xe8, 0xe5, 0x25, 0x3e, 0x2e,
-	0x1c, 0x58, 0x33, 0x99, 0x62, 0x18, 0xc3, 0x0e, 0x9a, 0x52, 0xdf, 0x7b, 0xb2, 0x39, 0x21, 0xb7,
-	0x85, 0xa3, 0x64, 0xb2, 0x25, 0x9b, 0xc9, 0x45, 0xd8, 0xe9, 0xef, 0xd3, 0xf0, 0x7b, 0xfc, 0x55,
-	0x29, 0x66, 0xb8, 0x03, 0x47, 0x56, 0x6c, 0x05, 0xd7, 0xab, 0x68, 0x44, 0xbc, 0x52, 0x65, 0x8e,
-	0x2c, 0xa8, 0x08, 0xc5, 0x64, 0x11, 0xb4, 0x6e, 0xbd, 0xeb, 0x3f, 0x8d, 0x77, 0x93, 0xed, 0xd4,
-	0x84, 0x93, 0x7d, 0x08, 0x83, 0xa6, 0x42, 0x32, 0xcc, 0xf3, 0xae, 0xcd, 0xc2, 0x46, 0xbc, 0xc9,
-	0x34, 0xd2, 0x09, 0x37, 0x9f, 0x38, 0xfe, 0xd0, 0x66, 0x61, 0x4d, 0xfc, 0x17, 0xed, 0xa7, 0xa3,
-	0x56, 0x52, 0x97, 0xe4, 0xbb, 0x40, 0xb3, 0x15, 0xbd, 0x5f, 0xea, 0x4e, 0xa6, 0x75, 0x34, 0xc5,
-	0xdf, 0x3d, 0xb3, 0xe7, 0xc8, 0x24, 0xb7, 0xa7, 0xce, 0xa5, 0x8f, 0xe2, 0x63, 0x2e, 0xdb, 0x57,
-	0x72, 0xae, 0x23, 0xe8, 0xcc, 0xdb, 0xf3, 0x81, 0x77, 0x62, 0xb0, 0xe9, 0x47, 0x45, 0xb5, 0x31,
-	0xd1, 0xad, 0xb7, 0xe7, 0x13, 0x0a, 0x19, 0x5b, 0x75, 0x5d, 0x51, 0x46, 0x5b, 0x7e, 0xd0, 0x66,
-	0xaf, 0x60, 0x65, 0x2c, 0xc6, 0x73, 0x9d, 0x71, 0x05, 0xe4, 0x37, 0x15, 0xf2, 0x52, 0x96, 0x1c,
-	0x32, 0x69, 0xc0, 0x7f, 0x72, 0x2b, 0xa3, 0x0e, 0x2f, 0x1c, 0x10, 0x59, 0xbe, 0xad, 0x55, 0xbd,
-	0x36, 0x57, 0xf9, 0xc7, 0xef, 0xa2, 0xd1, 0x5e, 0xc6, 0xdf, 0x21, 0x40, 0xd1, 0x8a, 0x07, 0x52,
-	0x87, 0x53, 0x83, 0x77, 0xca, 0x32, 0xbd, 0xe6, 0x4c, 0x59, 0xa9, 0x70, 0xca, 0x36, 0xbf, 0x36,
-	0xd1, 0xeb, 0xbc, 0x57, 0xdc, 0x41, 0x23, 0x62, 0xa3, 0xc5, 0x97, 0x92, 0xea, 0x59, 0x05, 0x68,
-	0x2e, 0xe8, 0x0b, 0x85, 0x77, 0x42, 0x7e, 0xfa, 0xf5, 0x7f, 0x7e, 0x77, 0x7a, 0x01, 0x9b, 0x56,
-	0xa4, 0xe3, 0xdf, 0xe4, 0x03, 0xb2, 0xe4, 0x0b, 0x09, 0xfc, 0x7b, 0x03, 0x8d, 0xc6, 0x7a, 0x0c,
-	0xbf, 0x21, 0x77, 0xa7, 0x28, 0x42, 0xb3, 0x9c, 0x57, 0x0c, 0xfe, 0x76, 0xb8, 0xbf, 0x6d, 0x5c,
-	0xd5, 0xf9, 0x4b, 0x04, 0x9e, 0x75, 0x98, 0xd1, 0x56, 0x47, 0xd6, 0xa1, 0x24, 0x44, 0x8e, 0xf0,
-	0x17, 0x06, 0xc2, 0x59, 0x81, 0x86, 0x57, 0x65, 0x82, 0x5c, 0x49, 0x68, 0xae, 0x1d, 0x5f, 0x11,
-	0xa0, 0x3f, 0xe0, 0xd0, 0x77, 0xf0, 0x7b, 0x3a, 0x68, 0x20, 0x6d, 0xf6, 0x53, 0xb0, 0x3a, 0x7e,
-	0x7c, 0x80, 0xc6, 0x53, 0xe2, 0x06, 0xcf, 0xcb, 0xee, 0x53, 0x45, 0xe6, 0xe5, 0xdc, 0xa2, 0x04,
-	0x69, 0x8d, 0x23, 0x11, 0xbc, 0xa4, 0x43, 0x4a, 0xb6, 0x2d, 0x46, 0x43, 0xfc, 0x33, 0x03, 0x4d,
-	0x2a, 0x12, 0x48, 0x9d, 0x44, 0xa5, 0xd8, 0xbc, 0x52, 0x58, 0x9c, 0x30, 0x6c, 0x70, 0x86, 0x2b,
-	0x78, 0x59, 0xc7, 0xc0, 0x14, 0x97, 0x7f, 0x37, 0xd0, 0x05, 0xbd, 0xfa, 0xc1, 0x1b, 0xb2, 0xbb,
-	0x42, 0x91, 0x65, 0x5e, 0x1f, 0xae, 0x32, 0x20, 0x56, 0x39, 0xe2, 0xbb, 0xf8, 0xdb, 0x3a, 0xc4,
-	0x0e, 0x0d, 0x1b, 0x69, 0x75, 0xd3, 0xd8, 0xf3, 0x03, 0x61, 0xb0, 0x0e, 0xe3, 0x95, 0x7e, 0x84,
-	0x7f, 0x61, 0xa0, 0x09, 0xd9, 0x0b, 0x5e, 0x2e, 0x62, 0x88, 0x41, 0x57, 0x8a, 0x2b, 0x01, 0xe0,
-	0x75, 0x0e, 0x78, 0x15, 0xaf, 0x0c, 0x03, 0x88, 0x7f, 0x6e, 0xa0, 0xf1, 0x94, 0x4c, 0xd0, 0x80,
-	0x64, 0xf5, 0x8d, 0x9a, 0x4d, 0x1a, 0x65, 0x45, 0x2c, 0x4e, 0xb1, 0x8e, 0x57, 0x75, 0x14, 0x29,
-	0x29, 0x62, 0x1d, 0x72, 0x95, 0x76, 0x14, 0x25, 0xd5, 0x84, 0x2c, 0x73, 0x54, 0x16, 0xad, 0xd6,
-	0x52, 0x83, 0xa2, 0x57, 0x4a, 0x64, 0x95, 0xe3, 0x5c, 0xc6, 0x8b, 0xc7, 0xe0, 0xe0, 0x5f, 0x1b,
-	0x68, 0x52, 0x91, 0x1b, 0xea, 0xfa, 0xcf, 0xd5, 0x3a, 0x6a, 0x96, 0xe7, 0x48, 0x9e, 0xe2, 0x19,
-	0x52, 0xc5, 0x10, 0xfe, 0x31, 0x1a, 0x11, 0x72, 0x04, 0x97, 0x33, 0x1c, 0x92, 0xcc, 0x51, 0xf7,
-	0x65, 0x59, 0xed, 0x14, 0xef, 0xcb, 0x42, 0xe8, 0xe0, 0x3f, 0x19, 0x68, 0x3a, 0xa3, 0x61, 0xf0,
-	0x55, 0x65, 0xf1, 0xe6, 0xa8, 0x20, 0x73, 0xf5, 0xd8, 0x7a, 0x80, 0x72, 0x9b, 0xa3, 0x6c, 0xe2,
-	0xb7, 0x8a, 0xb6, 0x1a, 0x8b, 0x75, 0xfd, 0xa7, 0x8a, 0x5e, 0xc2, 0x7f, 0x33, 0xd0, 0x74, 0x46,
-	0xab, 0xa8, 0x80, 0x79, 0xc2, 0x48, 0x05, 0xcc, 0x15, 0x3d, 0x64, 0x8b, 0x03, 0xbe, 0x87, 0xdf,
-	0xd1, 0x6e, 0xcf, 0xfc, 0x6c, 0x55, 0x77, 0x67, 0x45, 0x70, 0x1d, 0xe1, 0x3f, 0x18, 0x08, 0x67,
-	0xd5, 0x09, 0x5e, 0xc9, 0x24, 0xb5, 0x46, 0x27, 0xa9, 0x27, 0x49, 0xbe, 0xca, 0x21, 0x37, 0x38,
-	0xea, 0x06, 0x5e, 0xcf, 0x5b, 0xee, 0xb6, 0xeb, 0x36, 0x04, 0x5d, 0x00, 0x08, 0xff, 0x30, 0xd0,
-	0xac, 0x56, 0x41, 0xe0, 0x75, 0x6d, 0x80, 0x74, 0xda, 0xc5, 0xdc, 0xd0, 0x54, 0xcd, 0x53, 0x24,
-	0xe4, 0x3e, 0x87, 0xac, 0xe2, 0xbb, 0x85, 0xf1, 0x14, 0x59, 0x6f, 0x7b, 0x6d, 0x71, 0x7d, 0x9a,
-	0xda, 0x32, 0xad, 0x43, 0x6e, 0x39, 0xc2, 0x7f, 0x34, 0xd0, 0x39, 0xe9, 0x16, 0x0f, 0x93, 0x4c,
-	0xa4, 0x32, 0xd7, 0x9c, 0xe6, 0x72, 0x61, 0x1d, 0x60, 0xbc, 0xc3, 0x19, 0x6f, 0xe3, 0x6f, 0xe5,
-	0x05, 0x32, 0x64, 0x2c, 0x96, 0x0a, 0xd6, 0xa1, 0x7a, 0xf3, 0x78, 0x84, 0xff, 0x6b, 0xa0, 0x72,
-	0xf1, 0x05, 0x23, 0xbe, 0x59, 0xc0, 0x91, 0x77, 0x35, 0x6a, 0xde, 0x7a, 0xb9, 0x46, 0x30, 0x1a,
-	0x9b, 0x8f, 0xe6, 0x47, 0xf8, 0xe3, 0x21, 0x46, 0xd3, 0xe8, 0xf2, 0x0b, 0x47, 0xa7, 0x65, 0xbb,
-	0xd6, 0xa1, 0xf6, 0x1a, 0xf6, 0x48, 0x37, 0xe0, 0x4f, 0x51, 0xe9, 0x51, 0xbd, 0xae, 0x4a, 0x45,
-	0xe9, 0xde, 0xda, 0x54, 0x24, 0x49, 0xea, 0xfa, 0x9a, 0x2c, 0x72, 0xc2, 0x79, 0x7c, 0x51, 0x47,
-	0x18, 0x75, 0xfc, 0x14, 0xa1, 0xc1, 0x65, 0x28, 0x5e, 0x54, 0x7a, 0x52, 0x6f, 0x62, 0xcd, 0xa5,
-	0xfc, 0x0a, 0xe0, 0xf1, 0x2a, 0xf7, 0xb8, 0x84, 0xcb, 0x3a, 0x8f, 0xe1, 0xc0, 0xd5, 0xaf, 0x0c,
-	0x34, 0xa5, 0x7e, 0xd6, 0xc0, 0x57, 0x32, 0xeb, 0x56, 0xf7, 0x7d, 0xc5, 0x54, 0xb6, 0xa2, 0xbc,
-	0xaf, 0x23, 0x64, 0x9d, 0xb3, 0x2c, 0xe3, 0xcb, 0x5a, 0x95, 0x2c, 0x7d, 0x03, 0xf9, 0x8b, 0x81,
-	0x66, 0x74, 0xdf, 0x1d, 0xd4, 0xd5, 0x5a, 0xf0, 0x69, 0xc3, 0xbc, 0x36, 0x4c, 0x55, 0x40, 0xbb,
-	0xc5, 0xd1, 0x2a, 0xf8, 0xfa, 0xb1, 0x68, 0x69, 0x51, 0x13, 0x09, 0x89, 0xd4, 0x47, 0xae, 0x3c,
-	0x45, 0x23, 0x47, 0x4b, 0x11, 0x12, 0x9a, 0xaf, 0x83, 0xc5, 0x42, 0x22, 0xf5, 0x09, 0x4d, 0x12,
-	0x12, 0xf2, 0x47, 0x3a, 0x8d, 0x90, 0xd0, 0xb0, 0xac, 0xe4, 0xb2, 0x0c, 0x2d, 0x24, 0x52, 0x38,
-	0x5b, 0x0f, 0xbe, 0x7c, 0x5e, 0x36, 0xbe, 0x7a, 0x5e, 0x36, 0xfe, 0xfd, 0xbc, 0x6c, 0xfc, 0xe6,
-	0x45, 0xf9, 0xd4, 0x57, 0x2f, 0xca, 0xa7, 0xfe, 0xf9, 0xa2, 0x7c, 0xea, 0x93, 0x4a, 0xc7, 0x09,
-	0xbb, 0x07, 0xcd, 0x4a, 0xcb, 0x7f, 0x6c, 0xbd, 0xf5, 0x6c, 0x97, 0xba, 0xee, 0x43, 0x1a, 0x3e,
-	0xf5, 0x83, 0x7d, 0xcb, 0xa6, 0x1d, 0x87, 0x59, 0xcf, 0x06, 0x39, 0xd9, 0xef, 0x51, 0xd6, 0x1c,
-	0xe1, 0x9f, 0x5d, 0x6f, 0xfe, 0x3f, 0x00, 0x00, 0xff, 0xff, 0x1b, 0x28, 0x23, 0xc0, 0x06, 0x1f,
-	0x00, 0x00,
+func (m *QueryGetKeygenRequest) Reset()         { *m = QueryGetKeygenRequest{} }
+func (m *QueryGetKeygenRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetKeygenRequest) ProtoMessage()    {}
+func (*QueryGetKeygenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca61efb15b91bf8d, []int{51}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Query if a voter has voted for a ballot
-	HasVoted(ctx context.Context, in *QueryHasVotedRequest, opts ...grpc.CallOption) (*QueryHasVotedResponse, error)
-	// Queries a list of VoterByIdentifier items.
-	BallotByIdentifier(ctx context.Context, in *QueryBallotByIdentifierRequest, opts ...grpc.CallOption) (*QueryBallotByIdentifierResponse, error)
-	// Queries a list of ObserversByChainAndType items.
-	ObserverSet(ctx context.Context, in *QueryObserverSet, opts ...grpc.CallOption) (*QueryObserverSetResponse, error)
-	// Queries a list of supported chains.
-	SupportedChains(ctx context.Context, in *QuerySupportedChains, opts ...grpc.CallOption) (*QuerySupportedChainsResponse, error)
-	// Queries a list of GetChainParamsForChain items.
-	GetChainParamsForChain(ctx context.Context, in *QueryGetChainParamsForChainRequest, opts ...grpc.CallOption) (*QueryGetChainParamsForChainResponse, error)
-	// Queries a list of GetChainParams items.
-	GetChainParams(ctx context.Context, in *QueryGetChainParamsRequest, opts ...grpc.CallOption) (*QueryGetChainParamsResponse, error)
-	// Queries a nodeAccount by index.
-	NodeAccount(ctx context.Context, in *QueryGetNodeAccountRequest, opts ...grpc.CallOption) (*QueryNodeAccountResponse, error)
-	// Queries a list of nodeAccount items.
-	NodeAccountAll(ctx context.Context, in *QueryAllNodeAccountRequest, opts ...grpc.CallOption) (*QueryNodeAccountAllResponse, error)
-	// Queries the crosschain flags.
-	CrosschainFlags(ctx context.Context, in *QueryGetCrosschainFlagsRequest, opts ...grpc.CallOption) (*QueryCrosschainFlagsResponse, error)
-	// Queries a keygen by index.
-	Keygen(ctx context.Context, in *QueryGetKeygenRequest, opts ...grpc.CallOption) (*QueryKeygenResponse, error)
-	// Queries a list of ShowObserverCount items.
-	ShowObserverCount(ctx context.Context, in *QueryShowObserverCountRequest, opts ...grpc.CallOption) (*QueryShowObserverCountResponse, error)
-	// Queries a list of VoterByIdentifier items.
-	BlameByIdentifier(ctx context.Context, in *QueryBlameByIdentifierRequest, opts ...grpc.CallOption) (*QueryBlameByIdentifierResponse, error)
-	// Queries a list of VoterByIdentifier items.
-	GetAllBlameRecords(ctx context.Context, in *QueryAllBlameRecordsRequest, opts ...grpc.CallOption) (*QueryGetAllBlameRecordsResponse, error)
-	// Queries a list of VoterByIdentifier items.
-	BlamesByChainAndNonce(ctx context.Context, in *QueryBlameByChainAndNonceRequest, opts ...grpc.CallOption) (*QueryBlamesByChainAndNonceResponse, error)
-	// Queries a list of GetTssAddress items.
-	GetTssAddress(ctx context.Context, in *QueryGetTssAddressRequest, opts ...grpc.CallOption) (*QueryGetTssAddressResponse, error)
-	// Queries the TSS address by finalized height.
-	GetTssAddressByFinalizedHeight(ctx context.Context, in *QueryGetTssAddressByFinalizedHeightRequest, opts ...grpc.CallOption) (*QueryGetTssAddressByFinalizedHeightResponse, error)
-	// Queries a tSS by index.
-	TSS(ctx context.Context, in *QueryGetTSSRequest, opts ...grpc.CallOption) (*QueryTSSResponse, error)
-	// Queries a list of TSS items.
-	TssHistory(ctx context.Context, in *QueryTssHistoryRequest, opts ...grpc.CallOption) (*QueryTssHistoryResponse, error)
-	// Queries a list of pending nonces items.
-	PendingNoncesAll(ctx context.Context, in *QueryAllPendingNoncesRequest, opts ...grpc.CallOption) (*QueryPendingNoncesAllResponse, error)
-	// Queries a list of pending nonces items by chain.
-	PendingNoncesByChain(ctx context.Context, in *QueryPendingNoncesByChainRequest, opts ...grpc.CallOption) (*QueryPendingNoncesByChainResponse, error)
-	// Queries a chainNonces by index.
-	ChainNonces(ctx context.Context, in *QueryGetChainNoncesRequest, opts ...grpc.CallOption) (*QueryChainNoncesResponse, error)
-	// Queries a list of chainNonces items.
-	ChainNoncesAll(ctx context.Context, in *QueryAllChainNoncesRequest, opts ...grpc.CallOption) (*QueryChainNoncesAllResponse, error)
+func (m *QueryGetKeygenRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryGetKeygenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetKeygenRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryGetKeygenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetKeygenRequest.Merge(m, src)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/relayer.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGetKeygenRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) HasVoted(ctx context.Context, in *QueryHasVotedRequest, opts ...grpc.CallOption) (*QueryHasVotedResponse, error) {
-	out := new(QueryHasVotedResponse)
-	err := c.cc.Invoke(ctx, "/relayer.Query/HasVoted", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGetKeygenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetKeygenRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) BallotByIdentifier(ctx context.Context, in *QueryBallotByIdentifierRequest, opts ...grpc.CallOption) (*QueryBallotByIdentifierResponse, error) {
-	out := new(QueryBallotByIdentifierResponse)
-	err := c.cc.Invoke(ctx, "/relayer.Query/BallotByIdentifier", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_QueryGetKeygenRequest proto.InternalMessageInfo
 
-func (c *queryClient) ObserverSet(ctx context.Context, in *QueryObserverSet, opts ...grpc.CallOption) (*QueryObserverSetResponse, error) {
-	out := new(QueryObserverSetResponse)
-	err := c.cc.Invoke(ctx, "/relayer.Query/ObserverSet", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryKeygenResponse is the response type for the Query/GetKeygen.
+type QueryKeygenResponse struct {
+	Keygen *Keygen `protobuf:"bytes,1,opt,name=keygen,proto3" json:"keygen,omitempty"`
 }
 
-func (c *queryClient) SupportedChains(ctx context.Context, in *QuerySupportedChains, opts ...grpc.CallOption) (*QuerySupportedChainsResponse, error) {
-	out := new(QuerySupportedChainsResponse)
-	err := c.cc.Invoke(ctx, "/relayer.Query/SupportedChains", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryKeygenResponse) Reset()         { *m = QueryKeygenResponse{} }
+func (m *QueryKeygenResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryKeygenResponse) ProtoMessage()    {}
+func (*QueryKeygenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca61efb15b91bf8d, []int{52}
 }
-
-func (c *queryClient) GetChainParamsForChain(ctx context.Context, in *QueryGetChainParamsForChainRequest, opts ...grpc.CallOption) (*QueryGetChainParamsForChainResponse, error) {
-	out := new(QueryGetChainParamsForChainResponse)
-	err := c.cc.Invoke(ctx, "/relayer.Query/GetChainParamsForChain", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryKeygenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) GetChainParams(ctx context.Context, in *QueryGetChainParamsRequest, opts ...grpc.CallOption) (*QueryGetChainParamsResponse, error) {
-	out := new(QueryGetChainParamsResponse)
-	err := c.cc.Invoke(ctx, "/relayer.Query/GetChainParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryKeygenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryKeygenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) NodeAccount(ctx context.Context, in *QueryGetNodeAccountRequest, opts ...grpc.CallOption) (*QueryNodeAccountResponse, error) {
-	out := new(QueryNodeAccountResponse)
-	err := c.cc.Invoke(ctx, "/relayer.Query/NodeAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryKeygenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryKeygenResponse.Merge(m, src)
 }
-
-func (c *queryClient) NodeAccountAll(ctx context.Context, in *QueryAllNodeAccountRequest, opts ...grpc.CallOption) (*QueryNodeAccountAllResponse, error) {
-	out := new(QueryNodeAccountAllResponse)
-	err := c.cc.Invoke(ctx, "/relayer.Query/NodeAccountAll", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryKeygenResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) CrosschainFlags(ctx context.Context, in *QueryGetCrosschainFlagsRequest, opts ...grpc.CallOption) (*QueryCrosschainFlagsResponse, error) {
-	out := new(QueryCrosschainFlagsResponse)
-	err := c.cc.Invoke(ctx, "/relayer.Query/CrosschainFlags", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryKeygenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryKeygenResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Keygen(ctx context.Context, in *QueryGetKeygenRequest, opts ...grpc.CallOption) (*QueryKeygenResponse, error) {
-	out := new(QueryKeygenResponse)
-	err := c.cc.Invoke(ctx, "/relayer.Query/Keygen", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryKeygenResponse proto.InternalMessageInfo
+
+func (m *QueryKeygenResponse) GetKeygen() *Keygen {
+	if m != nil {
+		return m.Keygen
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ShowObserverCount(ctx context.Context, in *QueryShowObserverCountRequest, opts ...grpc.CallOption) (*QueryShowObserverCountResponse, error) {
-	out := new(QueryShowObserverCountResponse)
-	err := c.cc.Invoke(ctx, "/relayer.Query/ShowObserverCount", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryShowObserverCountRequest is the request type for the
+// Query/ShowObserverCount.
+type QueryShowObserverCountRequest struct {
 }
 
-func (c *queryClient) BlameByIdentifier(ctx context.Context, in *QueryBlameByIdentifierRequest, opts ...grpc.CallOption) (*QueryBlameByIdentifierResponse, error) {
-	out := new(QueryBlameByIdentifierResponse)
-	err := c.cc.Invoke(ctx, "/relayer.Query/BlameByIdentifier", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryShowObserverCountRequest) Reset()         { *m = QueryShowObserverCountRequest{} }
+func (m *QueryShowObserverCountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryShowObserverCountRequest) ProtoMessage()    {}
+func (*QueryShowObserverCountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca61efb15b91bf8d, []int{53}
+}
+func (m *QueryShowObserverCountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryShowObserverCountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryShowObserverCountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryShowObserverCountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryShowObserverCountRequest.Merge(m, src)
+}
+func (m *QueryShowObserverCountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryShowObserverCountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryShowObserverCountRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetAllBlameRecords(ctx context.Context, in *QueryAllBlameRecordsRequest, opts ...grpc.CallOption) (*QueryGetAllBlameRecordsResponse, error) {
-	out := new(QueryGetAllBlameRecordsResponse)
-	err := c.cc.Invoke(ctx, "/relayer.Query/GetAllBlameRecords", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryShowObserverCountRequest proto.InternalMessageInfo
+
+// QueryShowObserverCountResponse is the response type for the
+// Query/ShowObserverCount.
+type QueryShowObserverCountResponse struct {
+	LastObserverCount *LastRelayerCount `protobuf:"bytes,1,opt,name=last_observer_count,json=lastObserverCount,proto3" json:"last_observer_count,omitempty"`
 }
 
-func (c *queryClient) BlamesByChainAndNonce(ctx context.Context, in *QueryBlameByChainAndNonceRequest, opts ...grpc.CallOption) (*QueryBlamesByChainAndNonceResponse, error) {
-	out := new(QueryBlamesByChainAndNonceResponse)
-	err := c.cc.Invoke(ctx, "/relayer.Query/BlamesByChainAndNonce", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryShowObserverCountResponse) Reset()         { *m = QueryShowObserverCountResponse{} }
+func (m *QueryShowObserverCountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryShowObserverCountResponse) ProtoMessage()    {}
+func (*QueryShowObserverCountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca61efb15b91bf8d, []int{54}
+}
+func (m *QueryShowObserverCountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryShowObserverCountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryShowObserverCountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryShowObserverCountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryShowObserverCountResponse.Merge(m, src)
+}
+func (m *QueryShowObserverCountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryShowObserverCountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryShowObserverCountResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetTssAddress(ctx context.Context, in *QueryGetTssAddressRequest, opts ...grpc.CallOption) (*QueryGetTssAddressResponse, error) {
-	out := new(QueryGetTssAddressResponse)
-	err := c.cc.Invoke(ctx, "/relayer.Query/GetTssAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryShowObserverCountResponse proto.InternalMessageInfo
+
+func (m *QueryShowObserverCountResponse) GetLastObserverCount() *LastRelayerCount {
+	if m != nil {
+		return m.LastObserverCount
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) GetTssAddressByFinalizedHeight(ctx context.Context, in *QueryGetTssAddressByFinalizedHeightRequest, opts ...grpc.CallOption) (*QueryGetTssAddressByFinalizedHeightResponse, error) {
-	out := new(QueryGetTssAddressByFinalizedHeightResponse)
-	err := c.cc.Invoke(ctx, "/relayer.Query/GetTssAddressByFinalizedHeight", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryBlameByIdentifierRequest is the request type for the
+// Query/BlameByIdentifier.
+type QueryBlameByIdentifierRequest struct {
+	BlameIdentifier string `protobuf:"bytes,1,opt,name=blame_identifier,json=blameIdentifier,proto3" json:"blame_identifier,omitempty"`
 }
 
-func (c *queryClient) TSS(ctx context.Context, in *QueryGetTSSRequest, opts ...grpc.CallOption) (*QueryTSSResponse, error) {
-	out := new(QueryTSSResponse)
-	err := c.cc.Invoke(ctx, "/relayer.Query/TSS", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryBlameByIdentifierRequest) Reset()         { *m = QueryBlameByIdentifierRequest{} }
+func (m *QueryBlameByIdentifierRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlameByIdentifierRequest) ProtoMessage()    {}
+func (*QueryBlameByIdentifierRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca61efb15b91bf8d, []int{55}
+}
+func (m *QueryBlameByIdentifierRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlameByIdentifierRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlameByIdentifierRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryBlameByIdentifierRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlameByIdentifierRequest.Merge(m, src)
+}
+func (m *QueryBlameByIdentifierRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlameByIdentifierRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlameByIdentifierRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) TssHistory(ctx context.Context, in *QueryTssHistoryRequest, opts ...grpc.CallOption) (*QueryTssHistoryResponse, error) {
-	out := new(QueryTssHistoryResponse)
-	err := c.cc.Invoke(ctx, "/relayer.Query/TssHistory", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBlameByIdentifierRequest proto.InternalMessageInfo
+
+func (m *QueryBlameByIdentifierRequest) GetBlameIdentifier() string {
+	if m != nil {
+		return m.BlameIdentifier
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) PendingNoncesAll(ctx context.Context, in *QueryAllPendingNoncesRequest, opts ...grpc.CallOption) (*QueryPendingNoncesAllResponse, error) {
-	out := new(QueryPendingNoncesAllResponse)
-	err := c.cc.Invoke(ctx, "/relayer.Query/PendingNoncesAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QueryBlameByIdentifierResponse is the response type for the
+// Query/BlameByIdentifier.
+type QueryBlameByIdentifierResponse struct {
+	BlameInfo *Blame `protobuf:"bytes,1,opt,name=blame_info,json=blameInfo,proto3" json:"blame_info,omitempty"`
+}
+
+func (m *QueryBlameByIdentifierResponse) Reset()         { *m = QueryBlameByIdentifierResponse{} }
+func (m *QueryBlameByIdentifierResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlameByIdentifierResponse) ProtoMessage()    {}
+func (*QueryBlameByIdentifierResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca61efb15b91bf8d, []int{56}
+}
+func (m *QueryBlameByIdentifierResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlameByIdentifierResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlameByIdentifierResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryBlameByIdentifierResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlameByIdentifierResponse.Merge(m, src)
+}
+func (m *QueryBlameByIdentifierResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlameByIdentifierResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlameByIdentifierResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) PendingNoncesByChain(ctx context.Context, in *QueryPendingNoncesByChainRequest, opts ...grpc.CallOption) (*QueryPendingNoncesByChainResponse, error) {
-	out := new(QueryPendingNoncesByChainResponse)
-	err := c.cc.Invoke(ctx, "/relayer.Query/PendingNoncesByChain", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBlameByIdentifierResponse proto.InternalMessageInfo
+
+func (m *QueryBlameByIdentifierResponse) GetBlameInfo() *Blame {
+	if m != nil {
+		return m.BlameInfo
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ChainNonces(ctx context.Context, in *QueryGetChainNoncesRequest, opts ...grpc.CallOption) (*QueryChainNoncesResponse, error) {
-	out := new(QueryChainNoncesResponse)
-	err := c.cc.Invoke(ctx, "/relayer.Query/ChainNonces", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QueryAllBlameRecordsRequest is the request type for the
+// Query/AllBlameRecords.
+type QueryAllBlameRecordsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllBlameRecordsRequest) Reset()         { *m = QueryAllBlameRecordsRequest{} }
+func (m *QueryAllBlameRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllBlameRecordsRequest) ProtoMessage()    {}
+func (*QueryAllBlameRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca61efb15b91bf8d, []int{57}
+}
+func (m *QueryAllBlameRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllBlameRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllBlameRecordsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAllBlameRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllBlameRecordsRequest.Merge(m, src)
+}
+func (m *QueryAllBlameRecordsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllBlameRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllBlameRecordsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) ChainNoncesAll(ctx context.Context, in *QueryAllChainNoncesRequest, opts ...grpc.CallOption) (*QueryChainNoncesAllResponse, error) {
-	out := new(QueryChainNoncesAllResponse)
-	err := c.cc.Invoke(ctx, "/relayer.Query/ChainNoncesAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllBlameRecordsRequest proto.InternalMessageInfo
+
+func (m *QueryAllBlameRecordsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Query if a voter has voted for a ballot
-	HasVoted(context.Context, *QueryHasVotedRequest) (*QueryHasVotedResponse, error)
-	// Queries a list of VoterByIdentifier items.
-	BallotByIdentifier(context.Context, *QueryBallotByIdentifierRequest) (*QueryBallotByIdentifierResponse, error)
-	// Queries a list of ObserversByChainAndType items.
-	ObserverSet(context.Context, *QueryObserverSet) (*QueryObserverSetResponse, error)
-	// Queries a list of supported chains.
-	SupportedChains(context.Context, *QuerySupportedChains) (*QuerySupportedChainsResponse, error)
-	// Queries a list of GetChainParamsForChain items.
-	GetChainParamsForChain(context.Context, *QueryGetChainParamsForChainRequest) (*QueryGetChainParamsForChainResponse, error)
-	// Queries a list of GetChainParams items.
-	GetChainParams(context.Context, *QueryGetChainParamsRequest) (*QueryGetChainParamsResponse, error)
-	// Queries a nodeAccount by index.
-	NodeAccount(context.Context, *QueryGetNodeAccountRequest) (*QueryNodeAccountResponse, error)
-	// Queries a list of nodeAccount items.
-	NodeAccountAll(context.Context, *QueryAllNodeAccountRequest) (*QueryNodeAccountAllResponse, error)
-	// Queries the crosschain flags.
-	CrosschainFlags(context.Context, *QueryGetCrosschainFlagsRequest) (*QueryCrosschainFlagsResponse, error)
-	// Queries a keygen by index.
-	Keygen(context.Context, *QueryGetKeygenRequest) (*QueryKeygenResponse, error)
-	// Queries a list of ShowObserverCount items.
-	ShowObserverCount(context.Context, *QueryShowObserverCountRequest) (*QueryShowObserverCountResponse, error)
-	// Queries a list of VoterByIdentifier items.
-	BlameByIdentifier(context.Context, *QueryBlameByIdentifierRequest) (*QueryBlameByIdentifierResponse, error)
-	// Queries a list of VoterByIdentifier items.
-	GetAllBlameRecords(context.Context, *QueryAllBlameRecordsRequest) (*QueryGetAllBlameRecordsResponse, error)
-	// Queries a list of VoterByIdentifier items.
-	BlamesByChainAndNonce(context.Context, *QueryBlameByChainAndNonceRequest) (*QueryBlamesByChainAndNonceResponse, error)
-	// Queries a list of GetTssAddress items.
-	GetTssAddress(context.Context, *QueryGetTssAddressRequest) (*QueryGetTssAddressResponse, error)
-	// Queries the TSS address by finalized height.
-	GetTssAddressByFinalizedHeight(context.Context, *QueryGetTssAddressByFinalizedHeightRequest) (*QueryGetTssAddressByFinalizedHeightResponse, error)
-	// Queries a tSS by index.
-	TSS(context.Context, *QueryGetTSSRequest) (*QueryTSSResponse, error)
-	// Queries a list of TSS items.
-	TssHistory(context.Context, *QueryTssHistoryRequest) (*QueryTssHistoryResponse, error)
-	// Queries a list of pending nonces items.
-	PendingNoncesAll(context.Context, *QueryAllPendingNoncesRequest) (*QueryPendingNoncesAllResponse, error)
-	// Queries a list of pending nonces items by chain.
-	PendingNoncesByChain(context.Context, *QueryPendingNoncesByChainRequest) (*QueryPendingNoncesByChainResponse, error)
-	// Queries a chainNonces by index.
-	ChainNonces(context.Context, *QueryGetChainNoncesRequest) (*QueryChainNoncesResponse, error)
-	// Queries a list of chainNonces items.
-	ChainNoncesAll(context.Context, *QueryAllChainNoncesRequest) (*QueryChainNoncesAllResponse, error)
-}
-
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryGetAllBlameRecordsResponse is the response type for the
+// Query/AllBlameRecords.
+type QueryGetAllBlameRecordsResponse struct {
+	BlameInfo  []Blame             `protobuf:"bytes,1,rep,name=blame_info,json=blameInfo,proto3" json:"blame_info"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryGetAllBlameRecordsResponse) Reset()         { *m = QueryGetAllBlameRecordsResponse{} }
+func (m *QueryGetAllBlameRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAllBlameRecordsResponse) ProtoMessage()    {}
+func (*QueryGetAllBlameRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca61efb15b91bf8d, []int{58}
 }
-func (*UnimplementedQueryServer) HasVoted(ctx context.Context, req *QueryHasVotedRequest) (*QueryHasVotedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HasVoted not implemented")
+func (m *QueryGetAllBlameRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) BallotByIdentifier(ctx context.Context, req *QueryBallotByIdentifierRequest) (*QueryBallotByIdentifierResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BallotByIdentifier not implemented")
+func (m *QueryGetAllBlameRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAllBlameRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ObserverSet(ctx context.Context, req *QueryObserverSet) (*QueryObserverSetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ObserverSet not implemented")
+func (m *QueryGetAllBlameRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAllBlameRecordsResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) SupportedChains(ctx context.Context, req *QuerySupportedChains) (*QuerySupportedChainsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SupportedChains not implemented")
+func (m *QueryGetAllBlameRecordsResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) GetChainParamsForChain(ctx context.Context, req *QueryGetChainParamsForChainRequest) (*QueryGetChainParamsForChainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetChainParamsForChain not implemented")
+func (m *QueryGetAllBlameRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAllBlameRecordsResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) GetChainParams(ctx context.Context, req *QueryGetChainParamsRequest) (*QueryGetChainParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetChainParams not implemented")
+
+var xxx_messageInfo_QueryGetAllBlameRecordsResponse proto.InternalMessageInfo
+
+func (m *QueryGetAllBlameRecordsResponse) GetBlameInfo() []Blame {
+	if m != nil {
+		return m.BlameInfo
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) NodeAccount(ctx context.Context, req *QueryGetNodeAccountRequest) (*QueryNodeAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NodeAccount not implemented")
+
+func (m *QueryGetAllBlameRecordsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) NodeAccountAll(ctx context.Context, req *QueryAllNodeAccountRequest) (*QueryNodeAccountAllResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NodeAccountAll not implemented")
+
+// QueryBlameByChainAndNonceRequest is the request type for the
+// Query/BlameByChainAndNonce.
+type QueryBlameByChainAndNonceRequest struct {
+	ChainId int64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Nonce   int64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
-func (*UnimplementedQueryServer) CrosschainFlags(ctx context.Context, req *QueryGetCrosschainFlagsRequest) (*QueryCrosschainFlagsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CrosschainFlags not implemented")
+
+func (m *QueryBlameByChainAndNonceRequest) Reset()         { *m = QueryBlameByChainAndNonceRequest{} }
+func (m *QueryBlameByChainAndNonceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlameByChainAndNonceRequest) ProtoMessage()    {}
+func (*QueryBlameByChainAndNonceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca61efb15b91bf8d, []int{59}
 }
-func (*UnimplementedQueryServer) Keygen(ctx context.Context, req *QueryGetKeygenRequest) (*QueryKeygenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Keygen not implemented")
+func (m *QueryBlameByChainAndNonceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ShowObserverCount(ctx context.Context, req *QueryShowObserverCountRequest) (*QueryShowObserverCountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ShowObserverCount not implemented")
+func (m *QueryBlameByChainAndNonceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlameByChainAndNonceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) BlameByIdentifier(ctx context.Context, req *QueryBlameByIdentifierRequest) (*QueryBlameByIdentifierResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BlameByIdentifier not implemented")
+func (m *QueryBlameByChainAndNonceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlameByChainAndNonceRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) GetAllBlameRecords(ctx context.Context, req *QueryAllBlameRecordsRequest) (*QueryGetAllBlameRecordsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllBlameRecords not implemented")
+func (m *QueryBlameByChainAndNonceRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) BlamesByChainAndNonce(ctx context.Context, req *QueryBlameByChainAndNonceRequest) (*QueryBlamesByChainAndNonceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BlamesByChainAndNonce not implemented")
+func (m *QueryBlameByChainAndNonceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlameByChainAndNonceRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) GetTssAddress(ctx context.Context, req *QueryGetTssAddressRequest) (*QueryGetTssAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTssAddress not implemented")
+
+var xxx_messageInfo_QueryBlameByChainAndNonceRequest proto.InternalMessageInfo
+
+func (m *QueryBlameByChainAndNonceRequest) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) GetTssAddressByFinalizedHeight(ctx context.Context, req *QueryGetTssAddressByFinalizedHeightRequest) (*QueryGetTssAddressByFinalizedHeightResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTssAddressByFinalizedHeight not implemented")
+
+func (m *QueryBlameByChainAndNonceRequest) GetNonce() int64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) TSS(ctx context.Context, req *QueryGetTSSRequest) (*QueryTSSResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TSS not implemented")
+
+// QueryBlamesByChainAndNonceResponse is the response type for the
+// Query/BlameByChainAndNonce.
+type QueryBlamesByChainAndNonceResponse struct {
+	BlameInfo []*Blame `protobuf:"bytes,1,rep,name=blame_info,json=blameInfo,proto3" json:"blame_info,omitempty"`
 }
-func (*UnimplementedQueryServer) TssHistory(ctx context.Context, req *QueryTssHistoryRequest) (*QueryTssHistoryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TssHistory not implemented")
+
+func (m *QueryBlamesByChainAndNonceResponse) Reset()         { *m = QueryBlamesByChainAndNonceResponse{} }
+func (m *QueryBlamesByChainAndNonceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlamesByChainAndNonceResponse) ProtoMessage()    {}
+func (*QueryBlamesByChainAndNonceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca61efb15b91bf8d, []int{60}
 }
-func (*UnimplementedQueryServer) PendingNoncesAll(ctx context.Context, req *QueryAllPendingNoncesRequest) (*QueryPendingNoncesAllResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PendingNoncesAll not implemented")
+func (m *QueryBlamesByChainAndNonceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) PendingNoncesByChain(ctx context.Context, req *QueryPendingNoncesByChainRequest) (*QueryPendingNoncesByChainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PendingNoncesByChain not implemented")
+func (m *QueryBlamesByChainAndNonceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlamesByChainAndNonceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ChainNonces(ctx context.Context, req *QueryGetChainNoncesRequest) (*QueryChainNoncesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChainNonces not implemented")
+func (m *QueryBlamesByChainAndNonceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlamesByChainAndNonceResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) ChainNoncesAll(ctx context.Context, req *QueryAllChainNoncesRequest) (*QueryChainNoncesAllResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChainNoncesAll not implemented")
+func (m *QueryBlamesByChainAndNonceResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryBlamesByChainAndNonceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlamesByChainAndNonceResponse.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/relayer.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_QueryBlamesByChainAndNonceResponse proto.InternalMessageInfo
 
-func _Query_HasVoted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryHasVotedRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).HasVoted(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/relayer.Query/HasVoted",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HasVoted(ctx, req.(*QueryHasVotedRequest))
+func (m *QueryBlamesByChainAndNonceResponse) GetBlameInfo() []*Blame {
+	if m != nil {
+		return m.BlameInfo
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_BallotByIdentifier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBallotByIdentifierRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BallotByIdentifier(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/relayer.Query/BallotByIdentifier",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BallotByIdentifier(ctx, req.(*QueryBallotByIdentifierRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func init() {
+	proto.RegisterType((*QueryGetChainNoncesRequest)(nil), "relayer.QueryGetChainNoncesRequest")
+	proto.RegisterType((*QueryChainNoncesResponse)(nil), "relayer.QueryChainNoncesResponse")
+	proto.RegisterType((*QueryAllChainNoncesRequest)(nil), "relayer.QueryAllChainNoncesRequest")
+	proto.RegisterType((*QueryChainNoncesAllResponse)(nil), "relayer.QueryChainNoncesAllResponse")
+	proto.RegisterType((*QueryAllPendingNoncesRequest)(nil), "relayer.QueryAllPendingNoncesRequest")
+	proto.RegisterType((*QueryPendingNoncesAllResponse)(nil), "relayer.QueryPendingNoncesAllResponse")
+	proto.RegisterType((*QueryPendingNoncesByChainRequest)(nil), "relayer.QueryPendingNoncesByChainRequest")
+	proto.RegisterType((*QueryPendingNoncesByChainResponse)(nil), "relayer.QueryPendingNoncesByChainResponse")
+	proto.RegisterType((*QueryGetTSSRequest)(nil), "relayer.QueryGetTSSRequest")
+	proto.RegisterType((*QueryTSSResponse)(nil), "relayer.QueryTSSResponse")
+	proto.RegisterType((*QueryGetTssAddressRequest)(nil), "relayer.QueryGetTssAddressRequest")
+	proto.RegisterType((*QueryGetTssAddressResponse)(nil), "relayer.QueryGetTssAddressResponse")
+	proto.RegisterType((*QueryGetTssAddressByFinalizedHeightRequest)(nil), "relayer.QueryGetTssAddressByFinalizedHeightRequest")
+	proto.RegisterType((*QueryGetTssAddressByFinalizedHeightResponse)(nil), "relayer.QueryGetTssAddressByFinalizedHeightResponse")
+	proto.RegisterType((*QueryTssHistoryRequest)(nil), "relayer.QueryTssHistoryRequest")
+	proto.RegisterType((*QueryTssHistoryResponse)(nil), "relayer.QueryTssHistoryResponse")
+	proto.RegisterType((*QueryTssRotationRequest)(nil), "relayer.QueryTssRotationRequest")
+	proto.RegisterType((*QueryTssRotationResponse)(nil), "relayer.QueryTssRotationResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "relayer.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "relayer.QueryParamsResponse")
+	proto.RegisterType((*QueryHasVotedRequest)(nil), "relayer.QueryHasVotedRequest")
+	proto.RegisterType((*QueryHasVotedResponse)(nil), "relayer.QueryHasVotedResponse")
+	proto.RegisterType((*QueryBallotByIdentifierRequest)(nil), "relayer.QueryBallotByIdentifierRequest")
+	proto.RegisterType((*QueryBallotByIdentifierResponse)(nil), "relayer.QueryBallotByIdentifierResponse")
+	proto.RegisterType((*VoterList)(nil), "relayer.VoterList")
+	proto.RegisterType((*QueryObserverSet)(nil), "relayer.QueryObserverSet")
+	proto.RegisterType((*QueryObserverSetResponse)(nil), "relayer.QueryObserverSetResponse")
+	proto.RegisterType((*QuerySupportedChains)(nil), "relayer.QuerySupportedChains")
+	proto.RegisterType((*QuerySupportedChainsResponse)(nil), "relayer.QuerySupportedChainsResponse")
+	proto.RegisterType((*QueryChainListRequest)(nil), "relayer.QueryChainListRequest")
+	proto.RegisterType((*QueryChainListResponse)(nil), "relayer.QueryChainListResponse")
+	proto.RegisterType((*QueryRestrictedAddressesRequest)(nil), "relayer.QueryRestrictedAddressesRequest")
+	proto.RegisterType((*QueryRestrictedAddressesResponse)(nil), "relayer.QueryRestrictedAddressesResponse")
+	proto.RegisterType((*QueryRelayerPenaltyRequest)(nil), "relayer.QueryRelayerPenaltyRequest")
+	proto.RegisterType((*QueryRelayerPenaltyResponse)(nil), "relayer.QueryRelayerPenaltyResponse")
+	proto.RegisterType((*QueryAllRelayerPenaltyRequest)(nil), "relayer.QueryAllRelayerPenaltyRequest")
+	proto.RegisterType((*QueryAllRelayerPenaltyResponse)(nil), "relayer.QueryAllRelayerPenaltyResponse")
+	proto.RegisterType((*QueryGetChainParamsForChainRequest)(nil), "relayer.QueryGetChainParamsForChainRequest")
+	proto.RegisterType((*QueryGetChainParamsForChainResponse)(nil), "relayer.QueryGetChainParamsForChainResponse")
+	proto.RegisterType((*QueryGetChainParamsRequest)(nil), "relayer.QueryGetChainParamsRequest")
+	proto.RegisterType((*QueryGetChainParamsResponse)(nil), "relayer.QueryGetChainParamsResponse")
+	proto.RegisterType((*QueryGetNodeAccountRequest)(nil), "relayer.QueryGetNodeAccountRequest")
+	proto.RegisterType((*QueryNodeAccountResponse)(nil), "relayer.QueryNodeAccountResponse")
+	proto.RegisterType((*QueryAllNodeAccountRequest)(nil), "relayer.QueryAllNodeAccountRequest")
+	proto.RegisterType((*QueryNodeAccountAllResponse)(nil), "relayer.QueryNodeAccountAllResponse")
+	proto.RegisterType((*QueryGetCrosschainFlagsRequest)(nil), "relayer.QueryGetCrosschainFlagsRequest")
+	proto.RegisterType((*QueryCrosschainFlagsResponse)(nil), "relayer.QueryCrosschainFlagsResponse")
+	proto.RegisterType((*QueryChainPauseRequest)(nil), "relayer.QueryChainPauseRequest")
+	proto.RegisterType((*QueryChainPauseResponse)(nil), "relayer.QueryChainPauseResponse")
+	proto.RegisterType((*QueryAllChainPauseRequest)(nil), "relayer.QueryAllChainPauseRequest")
+	proto.RegisterType((*QueryAllChainPauseResponse)(nil), "relayer.QueryAllChainPauseResponse")
+	proto.RegisterType((*QueryGetKeygenRequest)(nil), "relayer.QueryGetKeygenRequest")
+	proto.RegisterType((*QueryKeygenResponse)(nil), "relayer.QueryKeygenResponse")
+	proto.RegisterType((*QueryShowObserverCountRequest)(nil), "relayer.QueryShowObserverCountRequest")
+	proto.RegisterType((*QueryShowObserverCountResponse)(nil), "relayer.QueryShowObserverCountResponse")
+	proto.RegisterType((*QueryBlameByIdentifierRequest)(nil), "relayer.QueryBlameByIdentifierRequest")
+	proto.RegisterType((*QueryBlameByIdentifierResponse)(nil), "relayer.QueryBlameByIdentifierResponse")
+	proto.RegisterType((*QueryAllBlameRecordsRequest)(nil), "relayer.QueryAllBlameRecordsRequest")
+	proto.RegisterType((*QueryGetAllBlameRecordsResponse)(nil), "relayer.QueryGetAllBlameRecordsResponse")
+	proto.RegisterType((*QueryBlameByChainAndNonceRequest)(nil), "relayer.QueryBlameByChainAndNonceRequest")
+	proto.RegisterType((*QueryBlamesByChainAndNonceResponse)(nil), "relayer.QueryBlamesByChainAndNonceResponse")
 }
 
-func _Query_ObserverSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryObserverSet)
-	if err := dec(in); err != nil {
+func init() { proto.RegisterFile("relayer/query.proto", fileDescriptor_ca61efb15b91bf8d) }
+
+var fileDescriptor_ca61efb15b91bf8d = []byte{
+	// 2564 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0xcd, 0x6f, 0x1c, 0x49,
+	0x15, 0x4f, 0xc7, 0xbb, 0x8e, 0xfd, 0x9c, 0xf8, 0xa3, 0xec, 0xc4, 0x76, 0xdb, 0x19, 0xdb, 0x6d,
+	0x27, 0x8e, 0xed, 0xec, 0xf4, 0xc6, 0x09, 0x24, 0x64, 0xf3, 0x81, 0xed, 0x25, 0x89, 0x97, 0x25,
+	0x6b, 0x66, 0x02, 0xd2, 0x06, 0x69, 0x67, 0xdb, 0x33, 0xe5, 0x99, 0xc6, 0x9d, 0xee, 0xd9, 0xae,
+	0x9e, 0x24, 0xc6, 0xf8, 0xc0, 0x87, 0x90, 0x40, 0x48, 0x2c, 0x02, 0x09, 0x04, 0xd2, 0x72, 0x40,
+	0x48, 0x5c, 0xf6, 0x08, 0xe2, 0x4f, 0xd8, 0xe3, 0x4a, 0x5c, 0x38, 0x21, 0x94, 0x70, 0xe6, 0x1f,
+	0xe0, 0x82, 0xba, 0xfa, 0xf5, 0x57, 0x75, 0x75, 0xcf, 0x04, 0x79, 0x4f, 0x33, 0xfd, 0xaa, 0xea,
+	0xbd, 0x5f, 0xbd, 0x7a, 0xf5, 0xea, 0xfd, 0xba, 0x1a, 0xc6, 0x5d, 0x6a, 0x19, 0x07, 0xd4, 0xd5,
+	0x3f, 0xea, 0x50, 0xf7, 0xa0, 0xdc, 0x76, 0x1d, 0xcf, 0x21, 0xa7, 0x50, 0xa8, 0xae, 0xd6, 0x1d,
+	0xf6, 0xc4, 0x61, 0xfa, 0xae, 0xc1, 0x68, 0xd0, 0x43, 0x7f, 0x7a, 0x65, 0x97, 0x7a, 0xc6, 0x15,
+	0xbd, 0x6d, 0x34, 0x4d, 0xdb, 0xf0, 0x4c, 0xc7, 0x0e, 0x06, 0xa9, 0x13, 0x4d, 0xa7, 0xe9, 0xf0,
+	0xbf, 0xba, 0xff, 0x0f, 0xa5, 0xb3, 0x4d, 0xc7, 0x69, 0x5a, 0x54, 0x37, 0xda, 0xa6, 0x6e, 0xd8,
+	0xb6, 0xe3, 0xf1, 0x21, 0x0c, 0x5b, 0x27, 0xdb, 0xfb, 0x4d, 0xbd, 0xde, 0x32, 0x4c, 0x9b, 0xe1,
+	0x4f, 0xa8, 0x2c, 0x84, 0xb5, 0x6b, 0x58, 0x96, 0xe3, 0xa1, 0x34, 0x02, 0xbb, 0x6b, 0x19, 0x4f,
+	0x28, 0x0a, 0xd5, 0x50, 0xc8, 0x15, 0xd4, 0x6c, 0xc7, 0xae, 0xd3, 0x50, 0x4d, 0x29, 0x6a, 0x73,
+	0x1d, 0xc6, 0x82, 0x0e, 0x7b, 0x96, 0xd1, 0xcc, 0x98, 0xd9, 0xa7, 0x07, 0x4d, 0x6a, 0x8b, 0x1a,
+	0x6d, 0xa7, 0x41, 0x6b, 0x46, 0xbd, 0xee, 0x74, 0x6c, 0x4f, 0x1c, 0xd1, 0x36, 0x5c, 0xe3, 0x49,
+	0xa8, 0x67, 0x36, 0x92, 0x52, 0xbb, 0x61, 0xda, 0xcd, 0x34, 0x8a, 0xb3, 0x61, 0x2b, 0xfe, 0xa2,
+	0xf8, 0xbc, 0x20, 0xae, 0xb5, 0xa9, 0x6d, 0x58, 0x1e, 0x2e, 0x82, 0x3a, 0x16, 0x36, 0x7b, 0x8c,
+	0x89, 0xc0, 0x3c, 0xc6, 0x6a, 0x2e, 0xfa, 0x32, 0x68, 0xd3, 0xd6, 0x41, 0xfd, 0xa6, 0xbf, 0x40,
+	0xf7, 0xa9, 0xb7, 0xe5, 0xcf, 0xf3, 0x21, 0x47, 0x50, 0xa1, 0x1f, 0x75, 0x28, 0xf3, 0xc8, 0x04,
+	0xbc, 0x6e, 0xda, 0x0d, 0xfa, 0x7c, 0x4a, 0x99, 0x57, 0x2e, 0x0d, 0x56, 0x82, 0x07, 0xed, 0x7d,
+	0x98, 0xe2, 0x63, 0x52, 0x03, 0x58, 0xdb, 0xb1, 0x19, 0x25, 0xb7, 0xe1, 0x74, 0xd2, 0xa1, 0x7c,
+	0xe0, 0xd0, 0xfa, 0x44, 0x39, 0x9c, 0x43, 0x62, 0xcc, 0xe6, 0x6b, 0x9f, 0xfd, 0x73, 0xee, 0x44,
+	0x65, 0xa8, 0x1e, 0x8b, 0xb4, 0x06, 0xc2, 0xd9, 0xb0, 0x2c, 0x09, 0x9c, 0x7b, 0x00, 0x71, 0xfc,
+	0xa0, 0xea, 0x8b, 0xe5, 0x20, 0xd8, 0xca, 0x7e, 0xb0, 0x95, 0x83, 0x70, 0xc4, 0x60, 0x2b, 0xef,
+	0x18, 0x4d, 0x8a, 0x63, 0x2b, 0x89, 0x91, 0xda, 0x9f, 0x14, 0x98, 0x11, 0x67, 0xb0, 0x61, 0x59,
+	0x05, 0x93, 0xe8, 0x7b, 0x85, 0x49, 0x90, 0xfb, 0x29, 0x98, 0x27, 0x39, 0xcc, 0xe5, 0xae, 0x30,
+	0x03, 0xdb, 0x29, 0x9c, 0x7b, 0x30, 0x1b, 0x7a, 0x63, 0x27, 0x88, 0x90, 0x2f, 0xc6, 0x1f, 0x9f,
+	0x2a, 0x70, 0x9e, 0x1b, 0x4a, 0x59, 0x49, 0x7a, 0x64, 0x0b, 0x86, 0xd3, 0x31, 0x8a, 0x3e, 0x39,
+	0x17, 0xf9, 0x24, 0x35, 0x14, 0xbd, 0x72, 0xa6, 0x9d, 0x14, 0x1e, 0x9f, 0x5f, 0x6e, 0xc3, 0x7c,
+	0x16, 0xee, 0x66, 0xb0, 0x9a, 0xa1, 0x6f, 0xa6, 0x61, 0x20, 0x58, 0x43, 0xb3, 0xc1, 0x3d, 0xd3,
+	0x57, 0x39, 0xc5, 0x9f, 0xb7, 0x1b, 0x5a, 0x0b, 0x16, 0x0a, 0x86, 0x17, 0xcc, 0x58, 0x79, 0xc5,
+	0x19, 0x6b, 0x13, 0x40, 0xc2, 0xdd, 0xf5, 0xa8, 0x5a, 0x45, 0x68, 0xda, 0x0d, 0x18, 0xe5, 0x52,
+	0x2e, 0x42, 0x73, 0x4b, 0xd0, 0xe7, 0xb1, 0xd0, 0xc6, 0xe9, 0xc8, 0xc6, 0xa3, 0x6a, 0x15, 0x35,
+	0xfb, 0xcd, 0xda, 0xd7, 0x60, 0x3a, 0xd2, 0xc7, 0xd8, 0x46, 0xa3, 0xe1, 0x52, 0x16, 0x45, 0xc3,
+	0x25, 0x18, 0xdd, 0x35, 0xbd, 0xba, 0x63, 0xda, 0x35, 0x61, 0xe6, 0xc3, 0x28, 0xdf, 0x42, 0x07,
+	0x94, 0x41, 0x95, 0xa9, 0x41, 0x28, 0xa3, 0xd0, 0x47, 0xbd, 0x16, 0x6e, 0x79, 0xff, 0xaf, 0xf6,
+	0x33, 0x05, 0x56, 0xb3, 0x03, 0x36, 0x0f, 0xee, 0x99, 0xb6, 0x61, 0x99, 0xdf, 0xa3, 0x8d, 0x07,
+	0xd4, 0x6c, 0xb6, 0xbc, 0x10, 0xc8, 0x3a, 0x9c, 0xdd, 0x0b, 0x5b, 0x6a, 0x6d, 0x6a, 0x59, 0xb5,
+	0x16, 0x6f, 0x47, 0x34, 0xe3, 0x51, 0xe3, 0x0e, 0xb5, 0xac, 0x60, 0xa8, 0x14, 0xfc, 0x49, 0x29,
+	0xf8, 0xbb, 0xb0, 0xd6, 0x13, 0x96, 0xdc, 0xd9, 0x7c, 0x08, 0xe7, 0x02, 0xf7, 0x33, 0xf6, 0xc0,
+	0x64, 0x9e, 0xe3, 0x1e, 0x1c, 0xf7, 0x7e, 0xfa, 0xa5, 0x02, 0x93, 0x19, 0x13, 0x88, 0xe7, 0x0d,
+	0x18, 0xf0, 0xd3, 0xb0, 0x65, 0x32, 0x0f, 0xf7, 0x90, 0x6c, 0xb5, 0x4f, 0x79, 0x8c, 0xbd, 0x6b,
+	0x32, 0xef, 0xf8, 0xf6, 0xcc, 0x74, 0x0c, 0xa9, 0x82, 0x47, 0x40, 0x18, 0x8f, 0x61, 0x3e, 0x4f,
+	0x35, 0xc5, 0xa9, 0x30, 0x79, 0x6a, 0x64, 0xf2, 0x79, 0x62, 0x4c, 0x98, 0x0a, 0xbd, 0x58, 0x14,
+	0x6d, 0x80, 0x1d, 0x7e, 0xec, 0x85, 0x06, 0xdf, 0x86, 0xf1, 0x94, 0x34, 0x72, 0x4d, 0x7f, 0x70,
+	0x3c, 0xa2, 0x95, 0x91, 0x78, 0xab, 0x71, 0x31, 0x1a, 0xc0, 0x4e, 0x5a, 0x0b, 0x26, 0xb8, 0x96,
+	0x07, 0x06, 0xfb, 0xb6, 0xe3, 0xd1, 0x46, 0xb8, 0x8a, 0x6b, 0x30, 0x16, 0x1c, 0xff, 0x35, 0xb3,
+	0x41, 0x6d, 0xcf, 0xdc, 0x33, 0xa9, 0x8b, 0xeb, 0x3f, 0x1a, 0x34, 0x6c, 0x47, 0x72, 0xb2, 0x08,
+	0x67, 0x9e, 0x3a, 0x1e, 0x75, 0x6b, 0x46, 0x10, 0x48, 0xdc, 0xc5, 0x83, 0x95, 0xd3, 0x5c, 0x88,
+	0xc1, 0xa5, 0x5d, 0x83, 0xb3, 0x82, 0x25, 0x44, 0x3c, 0x03, 0x83, 0x2d, 0x83, 0xd5, 0xfc, 0xce,
+	0xc1, 0x5e, 0x1b, 0xa8, 0x0c, 0xb4, 0xb0, 0x93, 0xf6, 0x0d, 0x28, 0xf1, 0x51, 0x9b, 0xdc, 0xe6,
+	0xe6, 0x41, 0x6c, 0xf5, 0xff, 0x41, 0xaa, 0xfd, 0x57, 0x81, 0xb9, 0x5c, 0x7d, 0x88, 0xe7, 0x95,
+	0xa6, 0xbe, 0x0a, 0xfd, 0x7c, 0x96, 0xfe, 0x9c, 0xfd, 0x38, 0x24, 0x91, 0xbb, 0x7d, 0xfc, 0xae,
+	0x1f, 0x7e, 0x15, 0xec, 0x41, 0xb6, 0x60, 0xd4, 0xd9, 0x65, 0xd4, 0x7d, 0xca, 0x97, 0xb5, 0xe6,
+	0x1d, 0xb4, 0xe9, 0x54, 0xdf, 0xbc, 0x72, 0x69, 0x78, 0x7d, 0x2a, 0x1a, 0xf5, 0x5e, 0xdc, 0xe1,
+	0xd1, 0x41, 0x9b, 0x56, 0x46, 0x9c, 0xb4, 0x80, 0xdc, 0x84, 0x33, 0x88, 0x8e, 0x79, 0x86, 0xd7,
+	0x61, 0x53, 0xaf, 0x71, 0x0d, 0x67, 0x23, 0x0d, 0xc1, 0xcc, 0xaa, 0xbc, 0xb1, 0x72, 0x7a, 0x37,
+	0xf1, 0xa4, 0x7d, 0x08, 0x83, 0x11, 0xaa, 0xec, 0xa2, 0x29, 0xd9, 0x45, 0x23, 0x65, 0x18, 0xf4,
+	0x9f, 0x03, 0xac, 0x27, 0xb9, 0xa5, 0xb1, 0xd4, 0x0c, 0x39, 0xc8, 0x81, 0xa7, 0xf8, 0x4f, 0x23,
+	0x98, 0x95, 0x83, 0x69, 0x50, 0xb7, 0x4a, 0xfd, 0x4c, 0x3d, 0x25, 0xca, 0x22, 0x5f, 0xcf, 0xc2,
+	0xa0, 0x83, 0xe2, 0xe0, 0x34, 0x1c, 0xac, 0xc4, 0x02, 0xed, 0x1c, 0x06, 0x67, 0xb5, 0xd3, 0x6e,
+	0x3b, 0xae, 0x47, 0x1b, 0x3c, 0x7d, 0x31, 0x6d, 0x1b, 0x66, 0x65, 0xf2, 0x48, 0xeb, 0x0a, 0xf4,
+	0x07, 0x15, 0x2d, 0x26, 0x87, 0xb1, 0x72, 0x7b, 0xbf, 0x59, 0x0e, 0x44, 0x41, 0xdd, 0x51, 0xc1,
+	0x0e, 0xda, 0x24, 0x46, 0x25, 0x97, 0xf2, 0xd5, 0xc2, 0xed, 0xb5, 0x0d, 0xe7, 0xc4, 0x06, 0xd4,
+	0xae, 0x77, 0xd5, 0x1e, 0xee, 0x31, 0xb4, 0xb1, 0x80, 0x31, 0x57, 0xa1, 0xcc, 0x73, 0xcd, 0xba,
+	0x47, 0x1b, 0xe8, 0xde, 0xa8, 0x08, 0xd1, 0xbe, 0x0a, 0xf3, 0xf9, 0x5d, 0x62, 0x5f, 0x19, 0xa1,
+	0x30, 0xf4, 0x55, 0x24, 0xd0, 0xee, 0xe3, 0x71, 0x54, 0x09, 0x16, 0x67, 0x27, 0xa8, 0x67, 0xc3,
+	0x4d, 0xb2, 0x12, 0x86, 0x5e, 0x66, 0xbd, 0x47, 0x42, 0x79, 0xb8, 0x4f, 0x29, 0xcc, 0x48, 0x15,
+	0x21, 0x8a, 0x7b, 0x30, 0x22, 0xd4, 0xcc, 0x98, 0x68, 0x26, 0xa3, 0xb8, 0x48, 0x8f, 0x44, 0x67,
+	0x0c, 0xbb, 0x29, 0xa9, 0xd6, 0xc4, 0x6a, 0x89, 0x17, 0x48, 0x32, 0xc8, 0xc7, 0x75, 0x8e, 0xfc,
+	0x45, 0x81, 0x52, 0x9e, 0x25, 0x9c, 0xd3, 0x3b, 0x30, 0x96, 0x9e, 0x93, 0x19, 0xd5, 0x66, 0x5d,
+	0x66, 0x35, 0x9a, 0x9a, 0x95, 0x79, 0x9c, 0xf5, 0xd9, 0x5d, 0xd0, 0x52, 0xa4, 0x22, 0x48, 0xdf,
+	0xf7, 0x1c, 0xb7, 0xd7, 0x0a, 0xed, 0x03, 0x58, 0x2c, 0x54, 0x80, 0x93, 0xbf, 0x1e, 0xd6, 0xe9,
+	0xa9, 0x63, 0x43, 0xa8, 0xd3, 0xf1, 0x90, 0x19, 0xaa, 0xc7, 0x0f, 0xda, 0x75, 0x81, 0xf5, 0xa4,
+	0x8e, 0xa7, 0x22, 0x60, 0x8f, 0x61, 0x46, 0x3a, 0x10, 0x01, 0xbd, 0x25, 0x05, 0x34, 0x25, 0x03,
+	0xc4, 0xf7, 0x65, 0x0a, 0x54, 0x82, 0x8a, 0x3d, 0x74, 0x1a, 0x74, 0x23, 0x20, 0x90, 0xc5, 0x54,
+	0xac, 0x8a, 0x09, 0x2a, 0x35, 0x20, 0xf6, 0x4e, 0x92, 0x89, 0x66, 0xbc, 0x93, 0x1c, 0x33, 0x64,
+	0xc7, 0x0f, 0x49, 0x12, 0x26, 0x01, 0x72, 0x5c, 0xc1, 0xfd, 0x87, 0x90, 0x84, 0x25, 0x6c, 0x24,
+	0x29, 0x47, 0x16, 0x7e, 0x5f, 0x4f, 0xf0, 0x8f, 0x2f, 0x8c, 0xe7, 0xa1, 0x14, 0x2d, 0x76, 0xf4,
+	0x22, 0xe0, 0x9e, 0xff, 0x1e, 0x20, 0xcc, 0x7d, 0x26, 0x66, 0xf3, 0x4c, 0x33, 0xce, 0x61, 0x1b,
+	0x46, 0xc5, 0x57, 0x08, 0xd9, 0x98, 0x48, 0x8f, 0xc5, 0xdd, 0x39, 0x52, 0x4f, 0x8b, 0xb5, 0xab,
+	0xc9, 0xa4, 0xbe, 0x63, 0x74, 0x18, 0xed, 0x21, 0x5c, 0xbf, 0x05, 0x93, 0x99, 0x41, 0x08, 0xed,
+	0x26, 0x0c, 0x85, 0xa1, 0xda, 0x61, 0x14, 0x51, 0x8d, 0x8b, 0x91, 0xda, 0x61, 0x14, 0x01, 0x41,
+	0x3d, 0x92, 0x68, 0x33, 0x30, 0x9d, 0x62, 0xe9, 0x49, 0x38, 0xda, 0x63, 0x50, 0x65, 0x8d, 0x68,
+	0xf6, 0x56, 0xbc, 0x43, 0x3a, 0x2c, 0x4a, 0x55, 0x05, 0x76, 0x87, 0x62, 0xbb, 0xf1, 0x91, 0x77,
+	0x9f, 0x7a, 0x5f, 0xe7, 0xaf, 0x5e, 0x42, 0xa3, 0x77, 0xb0, 0xa2, 0x0c, 0xa5, 0x68, 0x6d, 0x19,
+	0xfa, 0x83, 0x57, 0x34, 0x99, 0x8a, 0x12, 0x3b, 0x62, 0xb3, 0x36, 0x87, 0x29, 0xbd, 0xda, 0x72,
+	0x9e, 0x85, 0x87, 0xfd, 0x56, 0x22, 0xea, 0xb5, 0x7d, 0x28, 0xe5, 0x75, 0x88, 0xd6, 0x7a, 0xdc,
+	0x32, 0x98, 0x57, 0x8b, 0x0e, 0xab, 0xe4, 0xae, 0x9b, 0x8e, 0x0c, 0xbf, 0x6b, 0xf8, 0xe7, 0x31,
+	0xff, 0x1f, 0x8c, 0x1f, 0xf3, 0x47, 0xa5, 0x54, 0x6a, 0xef, 0x20, 0x9a, 0x4d, 0xff, 0x7d, 0x95,
+	0xac, 0x70, 0x5c, 0x81, 0x51, 0xfe, 0x2e, 0x2b, 0x5b, 0xe6, 0x8d, 0x70, 0x79, 0x3c, 0x42, 0x7b,
+	0x2f, 0xac, 0x42, 0xb3, 0xba, 0xa2, 0xb2, 0x1b, 0x50, 0x99, 0xbd, 0xe7, 0x20, 0xde, 0xe1, 0xb8,
+	0x26, 0xf3, 0x9b, 0x2a, 0x83, 0x81, 0x5a, 0x7b, 0xcf, 0x89, 0x0e, 0xd9, 0x0d, 0xcb, 0x0a, 0xda,
+	0x68, 0xdd, 0x71, 0x1b, 0xec, 0x0b, 0x48, 0x0f, 0x73, 0xe1, 0x5a, 0x67, 0x4c, 0x21, 0xf2, 0xab,
+	0x02, 0xf2, 0xbe, 0x2c, 0x72, 0x8c, 0xa2, 0x18, 0xff, 0xf1, 0xa5, 0x87, 0x2a, 0x16, 0x3e, 0xe8,
+	0x59, 0x1e, 0xb9, 0x1b, 0x76, 0x83, 0x53, 0xff, 0xee, 0x7b, 0xd3, 0x4f, 0xe8, 0xfc, 0xc5, 0x02,
+	0xd2, 0xdc, 0xe0, 0x41, 0xab, 0x82, 0x16, 0x2b, 0x65, 0x19, 0xad, 0x39, 0x4b, 0xd6, 0x57, 0xb8,
+	0x64, 0xeb, 0x9f, 0x68, 0xf0, 0x3a, 0xd7, 0x4a, 0x9a, 0xd0, 0x1f, 0x9c, 0x36, 0x64, 0x26, 0xea,
+	0x9e, 0x25, 0x68, 0xea, 0xac, 0xbc, 0x31, 0xb0, 0xae, 0x69, 0x3f, 0xfc, 0xfb, 0xbf, 0x7f, 0x75,
+	0x72, 0x96, 0xa8, 0xba, 0x4f, 0xee, 0xdf, 0xe0, 0x13, 0xd2, 0xd3, 0x2f, 0x38, 0xc9, 0xaf, 0x15,
+	0x18, 0x08, 0xe9, 0x12, 0x39, 0x9f, 0x56, 0x27, 0x10, 0x36, 0xb5, 0x94, 0xd7, 0x8c, 0xf6, 0xb6,
+	0xb9, 0xbd, 0x2d, 0xb2, 0x21, 0xb3, 0x17, 0xf1, 0x2f, 0xfd, 0x30, 0x43, 0x7d, 0x8e, 0xf4, 0xc3,
+	0x14, 0x4f, 0x38, 0x22, 0x9f, 0x2a, 0x40, 0xb2, 0xfc, 0x89, 0x2c, 0xa7, 0x11, 0xe4, 0x32, 0x36,
+	0xf5, 0x52, 0xf7, 0x8e, 0x08, 0xfa, 0x6d, 0x0e, 0xfa, 0x0e, 0xb9, 0x25, 0x03, 0x8d, 0x48, 0x77,
+	0x0f, 0x12, 0x60, 0x65, 0xf8, 0x49, 0x07, 0x86, 0x12, 0xdc, 0x83, 0x4c, 0xa7, 0xcd, 0x27, 0x9a,
+	0xd4, 0x85, 0xdc, 0xa6, 0x08, 0xd2, 0x25, 0x0e, 0x49, 0x23, 0xf3, 0x32, 0x48, 0x51, 0xda, 0x62,
+	0xd4, 0x23, 0x3f, 0x56, 0x60, 0x44, 0x60, 0x28, 0xe2, 0x22, 0x0a, 0xcd, 0xea, 0x85, 0xc2, 0xe6,
+	0x08, 0xc3, 0x1a, 0xc7, 0x70, 0x81, 0x2c, 0xca, 0x30, 0x30, 0xc1, 0x24, 0x83, 0xc1, 0x88, 0xc3,
+	0x10, 0x21, 0x4a, 0x44, 0xd6, 0xa3, 0xce, 0xe5, 0xb6, 0xa3, 0xe9, 0x0b, 0xdc, 0xf4, 0x1c, 0x39,
+	0x2f, 0x33, 0x5d, 0x8f, 0xec, 0xfc, 0x5e, 0x81, 0x71, 0x09, 0x97, 0x21, 0xc2, 0xd2, 0xe7, 0x33,
+	0x22, 0x75, 0xa5, 0x87, 0x9e, 0x88, 0x49, 0xe7, 0x98, 0x56, 0xc8, 0xb2, 0x0c, 0x93, 0x2b, 0x41,
+	0xf1, 0x3b, 0x05, 0x86, 0xd3, 0xe5, 0x3c, 0x59, 0x14, 0xcd, 0x49, 0x28, 0x89, 0xba, 0x54, 0xdc,
+	0x09, 0xe1, 0xdc, 0xe6, 0x70, 0xae, 0x93, 0x2f, 0xc9, 0xe1, 0x24, 0xc7, 0xe8, 0x87, 0x22, 0x2b,
+	0x3b, 0x22, 0x1f, 0x2b, 0x30, 0x96, 0xd6, 0xbc, 0x61, 0x59, 0xe4, 0x62, 0xda, 0x74, 0x1e, 0x6b,
+	0x52, 0x97, 0xbb, 0xf6, 0x43, 0x94, 0xab, 0x1c, 0xe5, 0x12, 0xd1, 0xba, 0xa3, 0x24, 0x3f, 0x52,
+	0x00, 0xe2, 0x9a, 0x82, 0xc8, 0x82, 0x24, 0x59, 0xbd, 0xa8, 0xf3, 0xf9, 0x1d, 0xd0, 0xfa, 0x3a,
+	0xb7, 0x7e, 0x99, 0xac, 0xe6, 0x86, 0x51, 0x50, 0xdb, 0xe8, 0x87, 0x61, 0xe6, 0x3f, 0x22, 0x3f,
+	0x50, 0xe0, 0x4c, 0xac, 0xca, 0x77, 0x8a, 0x96, 0x99, 0x6c, 0x16, 0xcb, 0x62, 0x61, 0x1f, 0x84,
+	0xb3, 0xcc, 0xe1, 0x2c, 0x90, 0xb9, 0x2e, 0x70, 0xc8, 0x5f, 0x15, 0x38, 0x27, 0xe7, 0x53, 0x64,
+	0x2d, 0x6d, 0xa8, 0x90, 0xb6, 0xa9, 0x97, 0x7b, 0xeb, 0x8c, 0xf0, 0x36, 0x38, 0xbc, 0xb7, 0xc8,
+	0x57, 0x64, 0xf0, 0x9a, 0xd4, 0xab, 0x25, 0xf9, 0x52, 0x6d, 0xcf, 0x71, 0x03, 0x41, 0xd2, 0x79,
+	0x3f, 0x55, 0x60, 0x38, 0x6d, 0x45, 0x0c, 0x79, 0x29, 0x8d, 0x53, 0x97, 0x8a, 0x3b, 0x21, 0xc0,
+	0xcb, 0x1c, 0xe0, 0x45, 0xb2, 0xd4, 0x0b, 0x40, 0xf2, 0x13, 0x05, 0x86, 0x12, 0xc4, 0x43, 0x02,
+	0x24, 0xcb, 0x98, 0xc4, 0xd4, 0x2c, 0xe1, 0x6a, 0xc5, 0x79, 0x20, 0x41, 0x6e, 0xf4, 0x43, 0xce,
+	0xfb, 0x8e, 0xfc, 0x0c, 0x3d, 0x9c, 0x26, 0x4e, 0x24, 0x1b, 0x2e, 0x12, 0x2c, 0x4b, 0xb9, 0x58,
+	0x12, 0xdc, 0xab, 0x38, 0xa8, 0x92, 0x5c, 0xeb, 0x17, 0x0a, 0x8c, 0x08, 0x04, 0x86, 0x2c, 0x67,
+	0xfd, 0x2e, 0x65, 0x4f, 0xe2, 0x91, 0x91, 0x43, 0xa2, 0x8a, 0x57, 0x48, 0xa4, 0x57, 0xe4, 0xbb,
+	0xd0, 0x1f, 0xd4, 0xf6, 0xe2, 0x81, 0x21, 0x72, 0x06, 0xb1, 0xc8, 0x49, 0x53, 0x87, 0xe2, 0x22,
+	0x27, 0x60, 0x0d, 0xe4, 0x13, 0x05, 0xc6, 0x32, 0x84, 0x40, 0xcc, 0x77, 0x79, 0x94, 0x42, 0x5d,
+	0xee, 0xda, 0x0f, 0xa1, 0xdc, 0xe0, 0x50, 0xd6, 0xc9, 0x9b, 0x45, 0xe7, 0xb6, 0xce, 0x5a, 0xce,
+	0x33, 0x81, 0x7c, 0x90, 0x3f, 0x2b, 0x30, 0x96, 0x29, 0xfc, 0x45, 0x80, 0x79, 0x2c, 0x43, 0x5d,
+	0xee, 0xda, 0x0f, 0x01, 0x6e, 0x72, 0x80, 0xb7, 0xc8, 0x4d, 0x69, 0xad, 0xc3, 0x0b, 0x55, 0xb1,
+	0xd4, 0x11, 0xd8, 0xcb, 0x11, 0xf9, 0x8d, 0x02, 0x24, 0x5b, 0xea, 0x93, 0xa5, 0x4c, 0x50, 0x4b,
+	0x48, 0x87, 0x58, 0x96, 0xe5, 0x53, 0x06, 0xed, 0x0a, 0x87, 0xba, 0x46, 0x56, 0xf2, 0xb6, 0xbb,
+	0x61, 0x59, 0xb5, 0x00, 0x9d, 0x8b, 0x10, 0xfe, 0xa6, 0xc0, 0x59, 0x69, 0x39, 0x4e, 0x56, 0xa4,
+	0x0e, 0x92, 0x11, 0x01, 0x75, 0x4d, 0xd2, 0x35, 0xaf, 0xbc, 0xd7, 0xee, 0x73, 0x90, 0x1b, 0xe4,
+	0x6e, 0xa1, 0x3f, 0x83, 0xa8, 0x37, 0xec, 0x46, 0x70, 0x41, 0x99, 0x48, 0x99, 0xfa, 0x21, 0x97,
+	0x1c, 0x91, 0xdf, 0x2a, 0x70, 0x26, 0x75, 0x4f, 0x26, 0x9e, 0x3b, 0xb2, 0x8b, 0x44, 0x75, 0xb1,
+	0xb0, 0x0f, 0x62, 0xbc, 0xc3, 0x31, 0xde, 0x20, 0x5f, 0xce, 0x73, 0xa4, 0x7f, 0x6d, 0x84, 0x95,
+	0x81, 0x7e, 0x28, 0xde, 0xed, 0x1d, 0x91, 0xff, 0x28, 0x50, 0x2a, 0xbe, 0xc2, 0x23, 0x57, 0x0b,
+	0x70, 0xe4, 0x5d, 0x3e, 0xaa, 0xd7, 0x5e, 0x6d, 0x10, 0xce, 0xc6, 0xe0, 0xb3, 0xf9, 0x0e, 0x79,
+	0xbf, 0x87, 0xd9, 0xd4, 0x5a, 0xfc, 0x4a, 0xcf, 0xac, 0x1b, 0x96, 0x7e, 0x28, 0xbd, 0xe8, 0x3c,
+	0x92, 0x4d, 0xf8, 0x03, 0xe8, 0x7b, 0x54, 0xad, 0x92, 0x99, 0x2c, 0xbe, 0xe8, 0x66, 0x58, 0x15,
+	0xea, 0xfb, 0xc4, 0x05, 0xb1, 0x36, 0xc7, 0x11, 0x4e, 0x93, 0x49, 0x19, 0x42, 0x5f, 0xf1, 0x33,
+	0x80, 0xf8, 0xba, 0x51, 0x2c, 0x74, 0x32, 0x77, 0x9d, 0xea, 0x7c, 0x7e, 0x07, 0xb4, 0x78, 0x91,
+	0x5b, 0x9c, 0x27, 0x25, 0x99, 0x45, 0x2f, 0x36, 0xf5, 0x7d, 0x18, 0x4a, 0xdc, 0x02, 0x92, 0xac,
+	0x62, 0xe1, 0xbe, 0x51, 0x5d, 0x28, 0xe8, 0xd1, 0x0b, 0x55, 0x49, 0x5e, 0x48, 0x92, 0x9f, 0x2b,
+	0x30, 0x2a, 0x7e, 0xb6, 0x40, 0x2e, 0x64, 0xb2, 0x86, 0xec, 0xfb, 0x09, 0x55, 0x48, 0x84, 0x79,
+	0x5f, 0x3f, 0x68, 0x2b, 0x1c, 0xcd, 0x22, 0x59, 0x90, 0x12, 0xde, 0xe4, 0x28, 0xf2, 0x47, 0x05,
+	0x26, 0x64, 0xdf, 0x15, 0x88, 0xb9, 0xa2, 0xe0, 0xd3, 0x05, 0x75, 0xb5, 0x97, 0xae, 0x08, 0xed,
+	0x1a, 0x87, 0x56, 0x26, 0x97, 0xbb, 0x42, 0x4b, 0x96, 0x54, 0x7e, 0x19, 0x93, 0xf8, 0x88, 0x25,
+	0xaf, 0x9e, 0x4a, 0x7b, 0x6b, 0x41, 0x52, 0x1a, 0xa7, 0xbf, 0xfe, 0x29, 0x2e, 0x63, 0x12, 0x9f,
+	0xc8, 0xa4, 0xca, 0x98, 0xf4, 0x47, 0x38, 0x24, 0xa7, 0xea, 0x4d, 0x63, 0x59, 0xca, 0xc5, 0xd2,
+	0x73, 0x19, 0x93, 0x80, 0xb3, 0xf9, 0xe0, 0xb3, 0x17, 0x25, 0xe5, 0xf3, 0x17, 0x25, 0xe5, 0x5f,
+	0x2f, 0x4a, 0xca, 0xc7, 0x2f, 0x4b, 0x27, 0x3e, 0x7f, 0x59, 0x3a, 0xf1, 0x8f, 0x97, 0xa5, 0x13,
+	0x8f, 0xcb, 0x4d, 0xd3, 0x6b, 0x75, 0x76, 0xcb, 0x75, 0xe7, 0x89, 0xfe, 0xe6, 0x73, 0xff, 0x83,
+	0x85, 0x87, 0xd4, 0x7b, 0xe6, 0xb8, 0xfb, 0xba, 0x41, 0x9b, 0x26, 0xd3, 0x9f, 0xc7, 0x51, 0x79,
+	0xd0, 0xa6, 0x6c, 0xb7, 0x9f, 0x7f, 0x56, 0x75, 0xf5, 0x7f, 0x03, 0x00, 0x49, 0x2f, 0x9a, 0x36,
+	0x21, 0x27, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Query if a voter has voted for a ballot
+	HasVoted(ctx context.Context, in *QueryHasVotedRequest, opts ...grpc.CallOption) (*QueryHasVotedResponse, error)
+	// Queries a list of VoterByIdentifier items.
+	BallotByIdentifier(ctx context.Context, in *QueryBallotByIdentifierRequest, opts ...grpc.CallOption) (*QueryBallotByIdentifierResponse, error)
+	// Queries a list of ObserversByChainAndType items.
+	ObserverSet(ctx context.Context, in *QueryObserverSet, opts ...grpc.CallOption) (*QueryObserverSetResponse, error)
+	// Queries a list of supported chains.
+	SupportedChains(ctx context.Context, in *QuerySupportedChains, opts ...grpc.CallOption) (*QuerySupportedChainsResponse, error)
+	// Queries all chains in the chain registry, including deprecated ones
+	ChainList(ctx context.Context, in *QueryChainListRequest, opts ...grpc.CallOption) (*QueryChainListResponse, error)
+	// Queries the restricted address list used for compliance screening
+	RestrictedAddresses(ctx context.Context, in *QueryRestrictedAddressesRequest, opts ...grpc.CallOption) (*QueryRestrictedAddressesResponse, error)
+	// Queries the penalty record of an observer
+	RelayerPenalty(ctx context.Context, in *QueryRelayerPenaltyRequest, opts ...grpc.CallOption) (*QueryRelayerPenaltyResponse, error)
+	// Queries the penalty records of all observers
+	RelayerPenaltyAll(ctx context.Context, in *QueryAllRelayerPenaltyRequest, opts ...grpc.CallOption) (*QueryAllRelayerPenaltyResponse, error)
+	// Queries the pause of a chain
+	ChainPause(ctx context.Context, in *QueryChainPauseRequest, opts ...grpc.CallOption) (*QueryChainPauseResponse, error)
+	// Queries the pauses of all chains
+	ChainPauseAll(ctx context.Context, in *QueryAllChainPauseRequest, opts ...grpc.CallOption) (*QueryAllChainPauseResponse, error)
+	// Queries a list of GetChainParamsForChain items.
+	GetChainParamsForChain(ctx context.Context, in *QueryGetChainParamsForChainRequest, opts ...grpc.CallOption) (*QueryGetChainParamsForChainResponse, error)
+	// Queries a list of GetChainParams items.
+	GetChainParams(ctx context.Context, in *QueryGetChainParamsRequest, opts ...grpc.CallOption) (*QueryGetChainParamsResponse, error)
+	// Queries a nodeAccount by index.
+	NodeAccount(ctx context.Context, in *QueryGetNodeAccountRequest, opts ...grpc.CallOption) (*QueryNodeAccountResponse, error)
+	// Queries a list of nodeAccount items.
+	NodeAccountAll(ctx context.Context, in *QueryAllNodeAccountRequest, opts ...grpc.CallOption) (*QueryNodeAccountAllResponse, error)
+	// Queries the crosschain flags.
+	CrosschainFlags(ctx context.Context, in *QueryGetCrosschainFlagsRequest, opts ...grpc.CallOption) (*QueryCrosschainFlagsResponse, error)
+	// Queries a keygen by index.
+	Keygen(ctx context.Context, in *QueryGetKeygenRequest, opts ...grpc.CallOption) (*QueryKeygenResponse, error)
+	// Queries a list of ShowObserverCount items.
+	ShowObserverCount(ctx context.Context, in *QueryShowObserverCountRequest, opts ...grpc.CallOption) (*QueryShowObserverCountResponse, error)
+	// Queries a list of VoterByIdentifier items.
+	BlameByIdentifier(ctx context.Context, in *QueryBlameByIdentifierRequest, opts ...grpc.CallOption) (*QueryBlameByIdentifierResponse, error)
+	// Queries a list of VoterByIdentifier items.
+	GetAllBlameRecords(ctx context.Context, in *QueryAllBlameRecordsRequest, opts ...grpc.CallOption) (*QueryGetAllBlameRecordsResponse, error)
+	// Queries a list of VoterByIdentifier items.
+	BlamesByChainAndNonce(ctx context.Context, in *QueryBlameByChainAndNonceRequest, opts ...grpc.CallOption) (*QueryBlamesByChainAndNonceResponse, error)
+	// Queries a list of GetTssAddress items.
+	GetTssAddress(ctx context.Context, in *QueryGetTssAddressRequest, opts ...grpc.CallOption) (*QueryGetTssAddressResponse, error)
+	// Queries the TSS address by finalized height.
+	GetTssAddressByFinalizedHeight(ctx context.Context, in *QueryGetTssAddressByFinalizedHeightRequest, opts ...grpc.CallOption) (*QueryGetTssAddressByFinalizedHeightResponse, error)
+	// Queries a tSS by index.
+	TSS(ctx context.Context, in *QueryGetTSSRequest, opts ...grpc.CallOption) (*QueryTSSResponse, error)
+	// Queries a list of TSS items.
+	TssHistory(ctx context.Context, in *QueryTssHistoryRequest, opts ...grpc.CallOption) (*QueryTssHistoryResponse, error)
+	// Queries the progress of the current or last tss rotation.
+	TssRotation(ctx context.Context, in *QueryTssRotationRequest, opts ...grpc.CallOption) (*QueryTssRotationResponse, error)
+	// Queries a list of pending nonces items.
+	PendingNoncesAll(ctx context.Context, in *QueryAllPendingNoncesRequest, opts ...grpc.CallOption) (*QueryPendingNoncesAllResponse, error)
+	// Queries a list of pending nonces items by chain.
+	PendingNoncesByChain(ctx context.Context, in *QueryPendingNoncesByChainRequest, opts ...grpc.CallOption) (*QueryPendingNoncesByChainResponse, error)
+	// Queries a chainNonces by index.
+	ChainNonces(ctx context.Context, in *QueryGetChainNoncesRequest, opts ...grpc.CallOption) (*QueryChainNoncesResponse, error)
+	// Queries a list of chainNonces items.
+	ChainNoncesAll(ctx context.Context, in *QueryAllChainNoncesRequest, opts ...grpc.CallOption) (*QueryChainNoncesAllResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/relayer.Query/Params", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ObserverSet(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/relayer.Query/ObserverSet",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ObserverSet(ctx, req.(*QueryObserverSet))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_SupportedChains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySupportedChains)
-	if err := dec(in); err != nil {
+func (c *queryClient) HasVoted(ctx context.Context, in *QueryHasVotedRequest, opts ...grpc.CallOption) (*QueryHasVotedResponse, error) {
+	out := new(QueryHasVotedResponse)
+	err := c.cc.Invoke(ctx, "/relayer.Query/HasVoted", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).SupportedChains(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/relayer.Query/SupportedChains",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SupportedChains(ctx, req.(*QuerySupportedChains))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_GetChainParamsForChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetChainParamsForChainRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) BallotByIdentifier(ctx context.Context, in *QueryBallotByIdentifierRequest, opts ...grpc.CallOption) (*QueryBallotByIdentifierResponse, error) {
+	out := new(QueryBallotByIdentifierResponse)
+	err := c.cc.Invoke(ctx, "/relayer.Query/BallotByIdentifier", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetChainParamsForChain(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/relayer.Query/GetChainParamsForChain",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetChainParamsForChain(ctx, req.(*QueryGetChainParamsForChainRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_GetChainParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetChainParamsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) ObserverSet(ctx context.Context, in *QueryObserverSet, opts ...grpc.CallOption) (*QueryObserverSetResponse, error) {
+	out := new(QueryObserverSetResponse)
+	err := c.cc.Invoke(ctx, "/relayer.Query/ObserverSet", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetChainParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/relayer.Query/GetChainParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetChainParams(ctx, req.(*QueryGetChainParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_NodeAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetNodeAccountRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) SupportedChains(ctx context.Context, in *QuerySupportedChains, opts ...grpc.CallOption) (*QuerySupportedChainsResponse, error) {
+	out := new(QuerySupportedChainsResponse)
+	err := c.cc.Invoke(ctx, "/relayer.Query/SupportedChains", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).NodeAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/relayer.Query/NodeAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).NodeAccount(ctx, req.(*QueryGetNodeAccountRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_NodeAccountAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllNodeAccountRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) ChainList(ctx context.Context, in *QueryChainListRequest, opts ...grpc.CallOption) (*QueryChainListResponse, error) {
+	out := new(QueryChainListResponse)
+	err := c.cc.Invoke(ctx, "/relayer.Query/ChainList", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).NodeAccountAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/relayer.Query/NodeAccountAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).NodeAccountAll(ctx, req.(*QueryAllNodeAccountRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_CrosschainFlags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetCrosschainFlagsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) RestrictedAddresses(ctx context.Context, in *QueryRestrictedAddressesRequest, opts ...grpc.CallOption) (*QueryRestrictedAddressesResponse, error) {
+	out := new(QueryRestrictedAddressesResponse)
+	err := c.cc.Invoke(ctx, "/relayer.Query/RestrictedAddresses", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).CrosschainFlags(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/relayer.Query/CrosschainFlags",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CrosschainFlags(ctx, req.(*QueryGetCrosschainFlagsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_Keygen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetKeygenRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) RelayerPenalty(ctx context.Context, in *QueryRelayerPenaltyRequest, opts ...grpc.CallOption) (*QueryRelayerPenaltyResponse, error) {
+	out := new(QueryRelayerPenaltyResponse)
+	err := c.cc.Invoke(ctx, "/relayer.Query/RelayerPenalty", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Keygen(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/relayer.Query/Keygen",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Keygen(ctx, req.(*QueryGetKeygenRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_ShowObserverCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryShowObserverCountRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) RelayerPenaltyAll(ctx context.Context, in *QueryAllRelayerPenaltyRequest, opts ...grpc.CallOption) (*QueryAllRelayerPenaltyResponse, error) {
+	out := new(QueryAllRelayerPenaltyResponse)
+	err := c.cc.Invoke(ctx, "/relayer.Query/RelayerPenaltyAll", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ShowObserverCount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/relayer.Query/ShowObserverCount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ShowObserverCount(ctx, req.(*QueryShowObserverCountRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_BlameByIdentifier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBlameByIdentifierRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) ChainPause(ctx context.Context, in *QueryChainPauseRequest, opts ...grpc.CallOption) (*QueryChainPauseResponse, error) {
+	out := new(QueryChainPauseResponse)
+	err := c.cc.Invoke(ctx, "/relayer.Query/ChainPause", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).BlameByIdentifier(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/relayer.Query/BlameByIdentifier",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BlameByIdentifier(ctx, req.(*QueryBlameByIdentifierRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_GetAllBlameRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllBlameRecordsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) ChainPauseAll(ctx context.Context, in *QueryAllChainPauseRequest, opts ...grpc.CallOption) (*QueryAllChainPauseResponse, error) {
+	out := new(QueryAllChainPauseResponse)
+	err := c.cc.Invoke(ctx, "/relayer.Query/ChainPauseAll", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetAllBlameRecords(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/relayer.Query/GetAllBlameRecords",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetAllBlameRecords(ctx, req.(*QueryAllBlameRecordsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_BlamesByChainAndNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBlameByChainAndNonceRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) GetChainParamsForChain(ctx context.Context, in *QueryGetChainParamsForChainRequest, opts ...grpc.CallOption) (*QueryGetChainParamsForChainResponse, error) {
+	out := new(QueryGetChainParamsForChainResponse)
+	err := c.cc.Invoke(ctx, "/relayer.Query/GetChainParamsForChain", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).BlamesByChainAndNonce(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/relayer.Query/BlamesByChainAndNonce",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BlamesByChainAndNonce(ctx, req.(*QueryBlameByChainAndNonceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_GetTssAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetTssAddressRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) GetChainParams(ctx context.Context, in *QueryGetChainParamsRequest, opts ...grpc.CallOption) (*QueryGetChainParamsResponse, error) {
+	out := new(QueryGetChainParamsResponse)
+	err := c.cc.Invoke(ctx, "/relayer.Query/GetChainParams", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetTssAddress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/relayer.Query/GetTssAddress",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetTssAddress(ctx, req.(*QueryGetTssAddressRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_GetTssAddressByFinalizedHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetTssAddressByFinalizedHeightRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) NodeAccount(ctx context.Context, in *QueryGetNodeAccountRequest, opts ...grpc.CallOption) (*QueryNodeAccountResponse, error) {
+	out := new(QueryNodeAccountResponse)
+	err := c.cc.Invoke(ctx, "/relayer.Query/NodeAccount", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetTssAddressByFinalizedHeight(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/relayer.Query/GetTssAddressByFinalizedHeight",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetTssAddressByFinalizedHeight(ctx, req.(*QueryGetTssAddressByFinalizedHeightRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_TSS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetTSSRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) NodeAccountAll(ctx context.Context, in *QueryAllNodeAccountRequest, opts ...grpc.CallOption) (*QueryNodeAccountAllResponse, error) {
+	out := new(QueryNodeAccountAllResponse)
+	err := c.cc.Invoke(ctx, "/relayer.Query/NodeAccountAll", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).TSS(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/relayer.Query/TSS",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TSS(ctx, req.(*QueryGetTSSRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_TssHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTssHistoryRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) CrosschainFlags(ctx context.Context, in *QueryGetCrosschainFlagsRequest, opts ...grpc.CallOption) (*QueryCrosschainFlagsResponse, error) {
+	out := new(QueryCrosschainFlagsResponse)
+	err := c.cc.Invoke(ctx, "/relayer.Query/CrosschainFlags", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).TssHistory(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/relayer.Query/TssHistory",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TssHistory(ctx, req.(*QueryTssHistoryRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_PendingNoncesAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllPendingNoncesRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) Keygen(ctx context.Context, in *QueryGetKeygenRequest, opts ...grpc.CallOption) (*QueryKeygenResponse, error) {
+	out := new(QueryKeygenResponse)
+	err := c.cc.Invoke(ctx, "/relayer.Query/Keygen", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).PendingNoncesAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/relayer.Query/PendingNoncesAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PendingNoncesAll(ctx, req.(*QueryAllPendingNoncesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_PendingNoncesByChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPendingNoncesByChainRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) ShowObserverCount(ctx context.Context, in *QueryShowObserverCountRequest, opts ...grpc.CallOption) (*QueryShowObserverCountResponse, error) {
+	out := new(QueryShowObserverCountResponse)
+	err := c.cc.Invoke(ctx, "/relayer.Query/ShowObserverCount", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).PendingNoncesByChain(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/relayer.Query/PendingNoncesByChain",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PendingNoncesByChain(ctx, req.(*QueryPendingNoncesByChainRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_ChainNonces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetChainNoncesRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) BlameByIdentifier(ctx context.Context, in *QueryBlameByIdentifierRequest, opts ...grpc.CallOption) (*QueryBlameByIdentifierResponse, error) {
+	out := new(QueryBlameByIdentifierResponse)
+	err := c.cc.Invoke(ctx, "/relayer.Query/BlameByIdentifier", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ChainNonces(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/relayer.Query/ChainNonces",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ChainNonces(ctx, req.(*QueryGetChainNoncesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_ChainNoncesAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllChainNoncesRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) GetAllBlameRecords(ctx context.Context, in *QueryAllBlameRecordsRequest, opts ...grpc.CallOption) (*QueryGetAllBlameRecordsResponse, error) {
+	out := new(QueryGetAllBlameRecordsResponse)
+	err := c.cc.Invoke(ctx, "/relayer.Query/GetAllBlameRecords", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ChainNoncesAll(ctx, in)
+	return out, nil
+}
+
+func (c *queryClient) BlamesByChainAndNonce(ctx context.Context, in *QueryBlameByChainAndNonceRequest, opts ...grpc.CallOption) (*QueryBlamesByChainAndNonceResponse, error) {
+	out := new(QueryBlamesByChainAndNonceResponse)
+	err := c.cc.Invoke(ctx, "/relayer.Query/BlamesByChainAndNonce", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/relayer.Query/ChainNoncesAll",
+	return out, nil
+}
+
+func (c *queryClient) GetTssAddress(ctx context.Context, in *QueryGetTssAddressRequest, opts ...grpc.CallOption) (*QueryGetTssAddressResponse, error) {
+	out := new(QueryGetTssAddressResponse)
+	err := c.cc.Invoke(ctx, "/relayer.Query/GetTssAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ChainNoncesAll(ctx, req.(*QueryAllChainNoncesRequest))
+	return out, nil
+}
+
+func (c *queryClient) GetTssAddressByFinalizedHeight(ctx context.Context, in *QueryGetTssAddressByFinalizedHeightRequest, opts ...grpc.CallOption) (*QueryGetTssAddressByFinalizedHeightResponse, error) {
+	out := new(QueryGetTssAddressByFinalizedHeightResponse)
+	err := c.cc.Invoke(ctx, "/relayer.Query/GetTssAddressByFinalizedHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "relayer.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "HasVoted",
-			Handler:    _Query_HasVoted_Handler,
-		},
-		{
-			MethodName: "BallotByIdentifier",
-			Handler:    _Query_BallotByIdentifier_Handler,
-		},
-		{
-			MethodName: "ObserverSet",
-			Handler:    _Query_ObserverSet_Handler,
-		},
-		{
-			MethodName: "SupportedChains",
-			Handler:    _Query_SupportedChains_Handler,
-		},
-		{
-			MethodName: "GetChainParamsForChain",
-			Handler:    _Query_GetChainParamsForChain_Handler,
-		},
-		{
-			MethodName: "GetChainParams",
-			Handler:    _Query_GetChainParams_Handler,
-		},
-		{
-			MethodName: "NodeAccount",
-			Handler:    _Query_NodeAccount_Handler,
-		},
-		{
-			MethodName: "NodeAccountAll",
-			Handler:    _Query_NodeAccountAll_Handler,
-		},
-		{
-			MethodName: "CrosschainFlags",
-			Handler:    _Query_CrosschainFlags_Handler,
-		},
-		{
-			MethodName: "Keygen",
-			Handler:    _Query_Keygen_Handler,
-		},
-		{
-			MethodName: "ShowObserverCount",
-			Handler:    _Query_ShowObserverCount_Handler,
-		},
-		{
-			MethodName: "BlameByIdentifier",
-			Handler:    _Query_BlameByIdentifier_Handler,
-		},
-		{
-			MethodName: "GetAllBlameRecords",
-			Handler:    _Query_GetAllBlameRecords_Handler,
-		},
-		{
-			MethodName: "BlamesByChainAndNonce",
-			Handler:    _Query_BlamesByChainAndNonce_Handler,
-		},
-		{
-			MethodName: "GetTssAddress",
-			Handler:    _Query_GetTssAddress_Handler,
-		},
-		{
-			MethodName: "GetTssAddressByFinalizedHeight",
-			Handler:    _Query_GetTssAddressByFinalizedHeight_Handler,
-		},
-		{
-			MethodName: "TSS",
-			Handler:    _Query_TSS_Handler,
-		},
-		{
-			MethodName: "TssHistory",
-			Handler:    _Query_TssHistory_Handler,
-		},
-		{
-			MethodName: "PendingNoncesAll",
-			Handler:    _Query_PendingNoncesAll_Handler,
-		},
-		{
-			MethodName: "PendingNoncesByChain",
-			Handler:    _Query_PendingNoncesByChain_Handler,
-		},
-		{
-			MethodName: "ChainNonces",
-			Handler:    _Query_ChainNonces_Handler,
-		},
-		{
-			MethodName: "ChainNoncesAll",
-			Handler:    _Query_ChainNoncesAll_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "relayer/query.proto",
-}
-
-func (m *QueryGetChainNoncesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) TSS(ctx context.Context, in *QueryGetTSSRequest, opts ...grpc.CallOption) (*QueryTSSResponse, error) {
+	out := new(QueryTSSResponse)
+	err := c.cc.Invoke(ctx, "/relayer.Query/TSS", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetChainNoncesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGetChainNoncesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryChainNoncesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) TssHistory(ctx context.Context, in *QueryTssHistoryRequest, opts ...grpc.CallOption) (*QueryTssHistoryResponse, error) {
+	out := new(QueryTssHistoryResponse)
+	err := c.cc.Invoke(ctx, "/relayer.Query/TssHistory", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryChainNoncesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryChainNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.ChainNonces.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAllChainNoncesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) TssRotation(ctx context.Context, in *QueryTssRotationRequest, opts ...grpc.CallOption) (*QueryTssRotationResponse, error) {
+	out := new(QueryTssRotationResponse)
+	err := c.cc.Invoke(ctx, "/relayer.Query/TssRotation", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAllChainNoncesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryAllChainNoncesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) PendingNoncesAll(ctx context.Context, in *QueryAllPendingNoncesRequest, opts ...grpc.CallOption) (*QueryPendingNoncesAllResponse, error) {
+	out := new(QueryPendingNoncesAllResponse)
+	err := c.cc.Invoke(ctx, "/relayer.Query/PendingNoncesAll", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryChainNoncesAllResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PendingNoncesByChain(ctx context.Context, in *QueryPendingNoncesByChainRequest, opts ...grpc.CallOption) (*QueryPendingNoncesByChainResponse, error) {
+	out := new(QueryPendingNoncesByChainResponse)
+	err := c.cc.Invoke(ctx, "/relayer.Query/PendingNoncesByChain", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryChainNoncesAllResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryChainNoncesAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ChainNonces) > 0 {
-		for iNdEx := len(m.ChainNonces) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ChainNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) ChainNonces(ctx context.Context, in *QueryGetChainNoncesRequest, opts ...grpc.CallOption) (*QueryChainNoncesResponse, error) {
+	out := new(QueryChainNoncesResponse)
+	err := c.cc.Invoke(ctx, "/relayer.Query/ChainNonces", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAllPendingNoncesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ChainNoncesAll(ctx context.Context, in *QueryAllChainNoncesRequest, opts ...grpc.CallOption) (*QueryChainNoncesAllResponse, error) {
+	out := new(QueryChainNoncesAllResponse)
+	err := c.cc.Invoke(ctx, "/relayer.Query/ChainNoncesAll", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAllPendingNoncesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Query if a voter has voted for a ballot
+	HasVoted(context.Context, *QueryHasVotedRequest) (*QueryHasVotedResponse, error)
+	// Queries a list of VoterByIdentifier items.
+	BallotByIdentifier(context.Context, *QueryBallotByIdentifierRequest) (*QueryBallotByIdentifierResponse, error)
+	// Queries a list of ObserversByChainAndType items.
+	ObserverSet(context.Context, *QueryObserverSet) (*QueryObserverSetResponse, error)
+	// Queries a list of supported chains.
+	SupportedChains(context.Context, *QuerySupportedChains) (*QuerySupportedChainsResponse, error)
+	// Queries all chains in the chain registry, including deprecated ones
+	ChainList(context.Context, *QueryChainListRequest) (*QueryChainListResponse, error)
+	// Queries the restricted address list used for compliance screening
+	RestrictedAddresses(context.Context, *QueryRestrictedAddressesRequest) (*QueryRestrictedAddressesResponse, error)
+	// Queries the penalty record of an observer
+	RelayerPenalty(context.Context, *QueryRelayerPenaltyRequest) (*QueryRelayerPenaltyResponse, error)
+	// Queries the penalty records of all observers
+	RelayerPenaltyAll(context.Context, *QueryAllRelayerPenaltyRequest) (*QueryAllRelayerPenaltyResponse, error)
+	// Queries the pause of a chain
+	ChainPause(context.Context, *QueryChainPauseRequest) (*QueryChainPauseResponse, error)
+	// Queries the pauses of all chains
+	ChainPauseAll(context.Context, *QueryAllChainPauseRequest) (*QueryAllChainPauseResponse, error)
+	// Queries a list of GetChainParamsForChain items.
+	GetChainParamsForChain(context.Context, *QueryGetChainParamsForChainRequest) (*QueryGetChainParamsForChainResponse, error)
+	// Queries a list of GetChainParams items.
+	GetChainParams(context.Context, *QueryGetChainParamsRequest) (*QueryGetChainParamsResponse, error)
+	// Queries a nodeAccount by index.
+	NodeAccount(context.Context, *QueryGetNodeAccountRequest) (*QueryNodeAccountResponse, error)
+	// Queries a list of nodeAccount items.
+	NodeAccountAll(context.Context, *QueryAllNodeAccountRequest) (*QueryNodeAccountAllResponse, error)
+	// Queries the crosschain flags.
+	CrosschainFlags(context.Context, *QueryGetCrosschainFlagsRequest) (*QueryCrosschainFlagsResponse, error)
+	// Queries a keygen by index.
+	Keygen(context.Context, *QueryGetKeygenRequest) (*QueryKeygenResponse, error)
+	// Queries a list of ShowObserverCount items.
+	ShowObserverCount(context.Context, *QueryShowObserverCountRequest) (*QueryShowObserverCountResponse, error)
+	// Queries a list of VoterByIdentifier items.
+	BlameByIdentifier(context.Context, *QueryBlameByIdentifierRequest) (*QueryBlameByIdentifierResponse, error)
+	// Queries a list of VoterByIdentifier items.
+	GetAllBlameRecords(context.Context, *QueryAllBlameRecordsRequest) (*QueryGetAllBlameRecordsResponse, error)
+	// Queries a list of VoterByIdentifier items.
+	BlamesByChainAndNonce(context.Context, *QueryBlameByChainAndNonceRequest) (*QueryBlamesByChainAndNonceResponse, error)
+	// Queries a list of GetTssAddress items.
+	GetTssAddress(context.Context, *QueryGetTssAddressRequest) (*QueryGetTssAddressResponse, error)
+	// Queries the TSS address by finalized height.
+	GetTssAddressByFinalizedHeight(context.Context, *QueryGetTssAddressByFinalizedHeightRequest) (*QueryGetTssAddressByFinalizedHeightResponse, error)
+	// Queries a tSS by index.
+	TSS(context.Context, *QueryGetTSSRequest) (*QueryTSSResponse, error)
+	// Queries a list of TSS items.
+	TssHistory(context.Context, *QueryTssHistoryRequest) (*QueryTssHistoryResponse, error)
+	// Queries the progress of the current or last tss rotation.
+	TssRotation(context.Context, *QueryTssRotationRequest) (*QueryTssRotationResponse, error)
+	// Queries a list of pending nonces items.
+	PendingNoncesAll(context.Context, *QueryAllPendingNoncesRequest) (*QueryPendingNoncesAllResponse, error)
+	// Queries a list of pending nonces items by chain.
+	PendingNoncesByChain(context.Context, *QueryPendingNoncesByChainRequest) (*QueryPendingNoncesByChainResponse, error)
+	// Queries a chainNonces by index.
+	ChainNonces(context.Context, *QueryGetChainNoncesRequest) (*QueryChainNoncesResponse, error)
+	// Queries a list of chainNonces items.
+	ChainNoncesAll(context.Context, *QueryAllChainNoncesRequest) (*QueryChainNoncesAllResponse, error)
 }
 
-func (m *QueryAllPendingNoncesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryPendingNoncesAllResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-
-func (m *QueryPendingNoncesAllResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) HasVoted(ctx context.Context, req *QueryHasVotedRequest) (*QueryHasVotedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HasVoted not implemented")
 }
-
-func (m *QueryPendingNoncesAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.PendingNonces) > 0 {
-		for iNdEx := len(m.PendingNonces) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PendingNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) BallotByIdentifier(ctx context.Context, req *QueryBallotByIdentifierRequest) (*QueryBallotByIdentifierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BallotByIdentifier not implemented")
 }
-
-func (m *QueryPendingNoncesByChainRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) ObserverSet(ctx context.Context, req *QueryObserverSet) (*QueryObserverSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ObserverSet not implemented")
 }
-
-func (m *QueryPendingNoncesByChainRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) SupportedChains(ctx context.Context, req *QuerySupportedChains) (*QuerySupportedChainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupportedChains not implemented")
 }
-
-func (m *QueryPendingNoncesByChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) ChainList(ctx context.Context, req *QueryChainListRequest) (*QueryChainListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChainList not implemented")
 }
-
-func (m *QueryPendingNoncesByChainResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) RestrictedAddresses(ctx context.Context, req *QueryRestrictedAddressesRequest) (*QueryRestrictedAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestrictedAddresses not implemented")
 }
-
-func (m *QueryPendingNoncesByChainResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) RelayerPenalty(ctx context.Context, req *QueryRelayerPenaltyRequest) (*QueryRelayerPenaltyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RelayerPenalty not implemented")
 }
-
-func (m *QueryPendingNoncesByChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.PendingNonces.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) RelayerPenaltyAll(ctx context.Context, req *QueryAllRelayerPenaltyRequest) (*QueryAllRelayerPenaltyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RelayerPenaltyAll not implemented")
 }
-
-func (m *QueryGetTSSRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) ChainPause(ctx context.Context, req *QueryChainPauseRequest) (*QueryChainPauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChainPause not implemented")
 }
-
-func (m *QueryGetTSSRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) ChainPauseAll(ctx context.Context, req *QueryAllChainPauseRequest) (*QueryAllChainPauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChainPauseAll not implemented")
 }
-
-func (m *QueryGetTSSRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) GetChainParamsForChain(ctx context.Context, req *QueryGetChainParamsForChainRequest) (*QueryGetChainParamsForChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChainParamsForChain not implemented")
 }
-
-func (m *QueryTSSResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) GetChainParams(ctx context.Context, req *QueryGetChainParamsRequest) (*QueryGetChainParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChainParams not implemented")
 }
-
-func (m *QueryTSSResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) NodeAccount(ctx context.Context, req *QueryGetNodeAccountRequest) (*QueryNodeAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NodeAccount not implemented")
 }
-
-func (m *QueryTSSResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Tss.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) NodeAccountAll(ctx context.Context, req *QueryAllNodeAccountRequest) (*QueryNodeAccountAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NodeAccountAll not implemented")
 }
-
-func (m *QueryGetTssAddressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) CrosschainFlags(ctx context.Context, req *QueryGetCrosschainFlagsRequest) (*QueryCrosschainFlagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CrosschainFlags not implemented")
 }
-
-func (m *QueryGetTssAddressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) Keygen(ctx context.Context, req *QueryGetKeygenRequest) (*QueryKeygenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Keygen not implemented")
 }
-
-func (m *QueryGetTssAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BitcoinChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BitcoinChainId))
-		i--
-		dAtA[i] = 0x8
+func (*UnimplementedQueryServer) ShowObserverCount(ctx context.Context, req *QueryShowObserverCountRequest) (*QueryShowObserverCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShowObserverCount not implemented")
+}
+func (*UnimplementedQueryServer) BlameByIdentifier(ctx context.Context, req *QueryBlameByIdentifierRequest) (*QueryBlameByIdentifierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlameByIdentifier not implemented")
+}
+func (*UnimplementedQueryServer) GetAllBlameRecords(ctx context.Context, req *QueryAllBlameRecordsRequest) (*QueryGetAllBlameRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllBlameRecords not implemented")
+}
+func (*UnimplementedQueryServer) BlamesByChainAndNonce(ctx context.Context, req *QueryBlameByChainAndNonceRequest) (*QueryBlamesByChainAndNonceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlamesByChainAndNonce not implemented")
+}
+func (*UnimplementedQueryServer) GetTssAddress(ctx context.Context, req *QueryGetTssAddressRequest) (*QueryGetTssAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTssAddress not implemented")
+}
+func (*UnimplementedQueryServer) GetTssAddressByFinalizedHeight(ctx context.Context, req *QueryGetTssAddressByFinalizedHeightRequest) (*QueryGetTssAddressByFinalizedHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTssAddressByFinalizedHeight not implemented")
+}
+func (*UnimplementedQueryServer) TSS(ctx context.Context, req *QueryGetTSSRequest) (*QueryTSSResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TSS not implemented")
+}
+func (*UnimplementedQueryServer) TssHistory(ctx context.Context, req *QueryTssHistoryRequest) (*QueryTssHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TssHistory not implemented")
+}
+func (*UnimplementedQueryServer) TssRotation(ctx context.Context, req *QueryTssRotationRequest) (*QueryTssRotationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TssRotation not implemented")
+}
+func (*UnimplementedQueryServer) PendingNoncesAll(ctx context.Context, req *QueryAllPendingNoncesRequest) (*QueryPendingNoncesAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingNoncesAll not implemented")
+}
+func (*UnimplementedQueryServer) PendingNoncesByChain(ctx context.Context, req *QueryPendingNoncesByChainRequest) (*QueryPendingNoncesByChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingNoncesByChain not implemented")
+}
+func (*UnimplementedQueryServer) ChainNonces(ctx context.Context, req *QueryGetChainNoncesRequest) (*QueryChainNoncesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChainNonces not implemented")
+}
+func (*UnimplementedQueryServer) ChainNoncesAll(ctx context.Context, req *QueryAllChainNoncesRequest) (*QueryChainNoncesAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChainNoncesAll not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relayer.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetTssAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_HasVoted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHasVotedRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).HasVoted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relayer.Query/HasVoted",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HasVoted(ctx, req.(*QueryHasVotedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetTssAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_BallotByIdentifier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBallotByIdentifierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BallotByIdentifier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relayer.Query/BallotByIdentifier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BallotByIdentifier(ctx, req.(*QueryBallotByIdentifierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetTssAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Eth) > 0 {
-		i -= len(m.Eth)
-		copy(dAtA[i:], m.Eth)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Eth)))
-		i--
-		dAtA[i] = 0xa
+func _Query_ObserverSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryObserverSet)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).ObserverSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relayer.Query/ObserverSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ObserverSet(ctx, req.(*QueryObserverSet))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetTssAddressByFinalizedHeightRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_SupportedChains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupportedChains)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).SupportedChains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relayer.Query/SupportedChains",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SupportedChains(ctx, req.(*QuerySupportedChains))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetTssAddressByFinalizedHeightRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_ChainList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChainListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChainList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relayer.Query/ChainList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChainList(ctx, req.(*QueryChainListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetTssAddressByFinalizedHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BitcoinChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BitcoinChainId))
-		i--
-		dAtA[i] = 0x10
+func _Query_RestrictedAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRestrictedAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.FinalizedPellHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.FinalizedPellHeight))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(QueryServer).RestrictedAddresses(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relayer.Query/RestrictedAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RestrictedAddresses(ctx, req.(*QueryRestrictedAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetTssAddressByFinalizedHeightResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_RelayerPenalty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRelayerPenaltyRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).RelayerPenalty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relayer.Query/RelayerPenalty",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RelayerPenalty(ctx, req.(*QueryRelayerPenaltyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetTssAddressByFinalizedHeightResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_RelayerPenaltyAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllRelayerPenaltyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RelayerPenaltyAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relayer.Query/RelayerPenaltyAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RelayerPenaltyAll(ctx, req.(*QueryAllRelayerPenaltyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetTssAddressByFinalizedHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Eth) > 0 {
-		i -= len(m.Eth)
-		copy(dAtA[i:], m.Eth)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Eth)))
-		i--
-		dAtA[i] = 0xa
+func _Query_ChainPause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChainPauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).ChainPause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relayer.Query/ChainPause",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChainPause(ctx, req.(*QueryChainPauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTssHistoryRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ChainPauseAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllChainPauseRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ChainPauseAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relayer.Query/ChainPauseAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChainPauseAll(ctx, req.(*QueryAllChainPauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTssHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryTssHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_GetChainParamsForChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetChainParamsForChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetChainParamsForChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relayer.Query/GetChainParamsForChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetChainParamsForChain(ctx, req.(*QueryGetChainParamsForChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTssHistoryResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetChainParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetChainParamsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryTssHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryTssHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).GetChainParams(ctx, in)
 	}
-	if len(m.TssList) > 0 {
-		for iNdEx := len(m.TssList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TssList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relayer.Query/GetChainParams",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetChainParams(ctx, req.(*QueryGetChainParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_NodeAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetNodeAccountRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).NodeAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relayer.Query/NodeAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NodeAccount(ctx, req.(*QueryGetNodeAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_NodeAccountAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllNodeAccountRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(QueryServer).NodeAccountAll(ctx, in)
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relayer.Query/NodeAccountAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NodeAccountAll(ctx, req.(*QueryAllNodeAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHasVotedRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_CrosschainFlags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetCrosschainFlagsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).CrosschainFlags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relayer.Query/CrosschainFlags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CrosschainFlags(ctx, req.(*QueryGetCrosschainFlagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHasVotedRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_Keygen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetKeygenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Keygen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relayer.Query/Keygen",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Keygen(ctx, req.(*QueryGetKeygenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHasVotedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.VoterAddress) > 0 {
-		i -= len(m.VoterAddress)
-		copy(dAtA[i:], m.VoterAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.VoterAddress)))
-		i--
-		dAtA[i] = 0x12
+func _Query_ShowObserverCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryShowObserverCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.BallotIdentifier) > 0 {
-		i -= len(m.BallotIdentifier)
-		copy(dAtA[i:], m.BallotIdentifier)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BallotIdentifier)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).ShowObserverCount(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relayer.Query/ShowObserverCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ShowObserverCount(ctx, req.(*QueryShowObserverCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHasVotedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_BlameByIdentifier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlameByIdentifierRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).BlameByIdentifier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relayer.Query/BlameByIdentifier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlameByIdentifier(ctx, req.(*QueryBlameByIdentifierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHasVotedResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryHasVotedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.HasVoted {
-		i--
-		if m.HasVoted {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+func _Query_GetAllBlameRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllBlameRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetAllBlameRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relayer.Query/GetAllBlameRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAllBlameRecords(ctx, req.(*QueryAllBlameRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBallotByIdentifierRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_BlamesByChainAndNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlameByChainAndNonceRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryBallotByIdentifierRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).BlamesByChainAndNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relayer.Query/BlamesByChainAndNonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlamesByChainAndNonce(ctx, req.(*QueryBlameByChainAndNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBallotByIdentifierRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BallotIdentifier) > 0 {
-		i -= len(m.BallotIdentifier)
-		copy(dAtA[i:], m.BallotIdentifier)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BallotIdentifier)))
-		i--
-		dAtA[i] = 0xa
+func _Query_GetTssAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetTssAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetTssAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relayer.Query/GetTssAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTssAddress(ctx, req.(*QueryGetTssAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBallotByIdentifierResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetTssAddressByFinalizedHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetTssAddressByFinalizedHeightRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetTssAddressByFinalizedHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relayer.Query/GetTssAddressByFinalizedHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTssAddressByFinalizedHeight(ctx, req.(*QueryGetTssAddressByFinalizedHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBallotByIdentifierResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_TSS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetTSSRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TSS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relayer.Query/TSS",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TSS(ctx, req.(*QueryGetTSSRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBallotByIdentifierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BallotStatus != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BallotStatus))
-		i--
-		dAtA[i] = 0x20
+func _Query_TssHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTssHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ObservationType != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ObservationType))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(QueryServer).TssHistory(ctx, in)
 	}
-	if len(m.Voters) > 0 {
-		for iNdEx := len(m.Voters) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Voters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relayer.Query/TssHistory",
 	}
-	if len(m.BallotIdentifier) > 0 {
-		i -= len(m.BallotIdentifier)
-		copy(dAtA[i:], m.BallotIdentifier)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BallotIdentifier)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TssHistory(ctx, req.(*QueryTssHistoryRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *VoterList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_TssRotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTssRotationRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).TssRotation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relayer.Query/TssRotation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TssRotation(ctx, req.(*QueryTssRotationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *VoterList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_PendingNoncesAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPendingNoncesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingNoncesAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relayer.Query/PendingNoncesAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingNoncesAll(ctx, req.(*QueryAllPendingNoncesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *VoterList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.VoteType != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.VoteType))
-		i--
-		dAtA[i] = 0x10
+func _Query_PendingNoncesByChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingNoncesByChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.VoterAddress) > 0 {
-		i -= len(m.VoterAddress)
-		copy(dAtA[i:], m.VoterAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.VoterAddress)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).PendingNoncesByChain(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relayer.Query/PendingNoncesByChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingNoncesByChain(ctx, req.(*QueryPendingNoncesByChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryObserverSet) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ChainNonces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetChainNoncesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ChainNonces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relayer.Query/ChainNonces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChainNonces(ctx, req.(*QueryGetChainNoncesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryObserverSet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_ChainNoncesAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllChainNoncesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChainNoncesAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relayer.Query/ChainNoncesAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChainNoncesAll(ctx, req.(*QueryAllChainNoncesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryObserverSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "relayer.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "HasVoted",
+			Handler:    _Query_HasVoted_Handler,
+		},
+		{
+			MethodName: "BallotByIdentifier",
+			Handler:    _Query_BallotByIdentifier_Handler,
+		},
+		{
+			MethodName: "ObserverSet",
+			Handler:    _Query_ObserverSet_Handler,
+		},
+		{
+			MethodName: "SupportedChains",
+			Handler:    _Query_SupportedChains_Handler,
+		},
+		{
+			MethodName: "ChainList",
+			Handler:    _Query_ChainList_Handler,
+		},
+		{
+			MethodName: "RestrictedAddresses",
+			Handler:    _Query_RestrictedAddresses_Handler,
+		},
+		{
+			MethodName: "RelayerPenalty",
+			Handler:    _Query_RelayerPenalty_Handler,
+		},
+		{
+			MethodName: "RelayerPenaltyAll",
+			Handler:    _Query_RelayerPenaltyAll_Handler,
+		},
+		{
+			MethodName: "ChainPause",
+			Handler:    _Query_ChainPause_Handler,
+		},
+		{
+			MethodName: "ChainPauseAll",
+			Handler:    _Query_ChainPauseAll_Handler,
+		},
+		{
+			MethodName: "GetChainParamsForChain",
+			Handler:    _Query_GetChainParamsForChain_Handler,
+		},
+		{
+			MethodName: "GetChainParams",
+			Handler:    _Query_GetChainParams_Handler,
+		},
+		{
+			MethodName: "NodeAccount",
+			Handler:    _Query_NodeAccount_Handler,
+		},
+		{
+			MethodName: "NodeAccountAll",
+			Handler:    _Query_NodeAccountAll_Handler,
+		},
+		{
+			MethodName: "CrosschainFlags",
+			Handler:    _Query_CrosschainFlags_Handler,
+		},
+		{
+			MethodName: "Keygen",
+			Handler:    _Query_Keygen_Handler,
+		},
+		{
+			MethodName: "ShowObserverCount",
+			Handler:    _Query_ShowObserverCount_Handler,
+		},
+		{
+			MethodName: "BlameByIdentifier",
+			Handler:    _Query_BlameByIdentifier_Handler,
+		},
+		{
+			MethodName: "GetAllBlameRecords",
+			Handler:    _Query_GetAllBlameRecords_Handler,
+		},
+		{
+			MethodName: "BlamesByChainAndNonce",
+			Handler:    _Query_BlamesByChainAndNonce_Handler,
+		},
+		{
+			MethodName: "GetTssAddress",
+			Handler:    _Query_GetTssAddress_Handler,
+		},
+		{
+			MethodName: "GetTssAddressByFinalizedHeight",
+			Handler:    _Query_GetTssAddressByFinalizedHeight_Handler,
+		},
+		{
+			MethodName: "TSS",
+			Handler:    _Query_TSS_Handler,
+		},
+		{
+			MethodName: "TssHistory",
+			Handler:    _Query_TssHistory_Handler,
+		},
+		{
+			MethodName: "TssRotation",
+			Handler:    _Query_TssRotation_Handler,
+		},
+		{
+			MethodName: "PendingNoncesAll",
+			Handler:    _Query_PendingNoncesAll_Handler,
+		},
+		{
+			MethodName: "PendingNoncesByChain",
+			Handler:    _Query_PendingNoncesByChain_Handler,
+		},
+		{
+			MethodName: "ChainNonces",
+			Handler:    _Query_ChainNonces_Handler,
+		},
+		{
+			MethodName: "ChainNoncesAll",
+			Handler:    _Query_ChainNoncesAll_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "relayer/query.proto",
 }
 
-func (m *QueryObserverSetResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetChainNoncesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4160,29 +4258,27 @@ func (m *QueryObserverSetResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryObserverSetResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetChainNoncesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryObserverSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetChainNoncesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Observers) > 0 {
-		for iNdEx := len(m.Observers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Observers[iNdEx])
-			copy(dAtA[i:], m.Observers[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Observers[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySupportedChains) Marshal() (dAtA []byte, err error) {
+func (m *QueryChainNoncesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4192,20 +4288,30 @@ func (m *QuerySupportedChains) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySupportedChains) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryChainNoncesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySupportedChains) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryChainNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.ChainNonces.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySupportedChainsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllChainNoncesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4215,34 +4321,32 @@ func (m *QuerySupportedChainsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySupportedChainsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllChainNoncesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySupportedChainsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllChainNoncesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Chains) > 0 {
-		for iNdEx := len(m.Chains) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Chains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetChainParamsForChainRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryChainNoncesAllResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4252,25 +4356,46 @@ func (m *QueryGetChainParamsForChainRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetChainParamsForChainRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryChainNoncesAllResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetChainParamsForChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryChainNoncesAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainNonces) > 0 {
+		for iNdEx := len(m.ChainNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetChainParamsForChainResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllPendingNoncesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4280,19 +4405,19 @@ func (m *QueryGetChainParamsForChainResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetChainParamsForChainResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllPendingNoncesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetChainParamsForChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllPendingNoncesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ChainParams != nil {
+	if m.Pagination != nil {
 		{
-			size, err := m.ChainParams.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4305,7 +4430,7 @@ func (m *QueryGetChainParamsForChainResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetChainParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryPendingNoncesAllResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4315,47 +4440,19 @@ func (m *QueryGetChainParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetChainParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPendingNoncesAllResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetChainParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPendingNoncesAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryGetChainParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetChainParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGetChainParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ChainParams != nil {
+	if m.Pagination != nil {
 		{
-			size, err := m.ChainParams.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4363,12 +4460,26 @@ func (m *QueryGetChainParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if len(m.PendingNonces) > 0 {
+		for iNdEx := len(m.PendingNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetNodeAccountRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryPendingNoncesByChainRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4378,27 +4489,25 @@ func (m *QueryGetNodeAccountRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetNodeAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPendingNoncesByChainRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetNodeAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPendingNoncesByChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryNodeAccountResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPendingNoncesByChainResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4408,32 +4517,30 @@ func (m *QueryNodeAccountResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryNodeAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPendingNoncesByChainResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryNodeAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPendingNoncesByChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.NodeAccount != nil {
-		{
-			size, err := m.NodeAccount.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	{
+		size, err := m.PendingNonces.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0xa
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllNodeAccountRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetTSSRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4443,32 +4550,20 @@ func (m *QueryAllNodeAccountRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllNodeAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetTSSRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllNodeAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetTSSRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryNodeAccountAllResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryTSSResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4478,46 +4573,30 @@ func (m *QueryNodeAccountAllResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryNodeAccountAllResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTSSResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryNodeAccountAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTSSResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.NodeAccount) > 0 {
-		for iNdEx := len(m.NodeAccount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.NodeAccount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size, err := m.Tss.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetCrosschainFlagsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetTssAddressRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4527,20 +4606,25 @@ func (m *QueryGetCrosschainFlagsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetCrosschainFlagsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetTssAddressRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetCrosschainFlagsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetTssAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.BitcoinChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BitcoinChainId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCrosschainFlagsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetTssAddressResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4550,30 +4634,27 @@ func (m *QueryCrosschainFlagsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryCrosschainFlagsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetTssAddressResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCrosschainFlagsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetTssAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.CrosschainFlags.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if len(m.Eth) > 0 {
+		i -= len(m.Eth)
+		copy(dAtA[i:], m.Eth)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Eth)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetKeygenRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetTssAddressByFinalizedHeightRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4583,20 +4664,30 @@ func (m *QueryGetKeygenRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetKeygenRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetTssAddressByFinalizedHeightRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetKeygenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetTssAddressByFinalizedHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.BitcoinChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BitcoinChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FinalizedPellHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FinalizedPellHeight))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryKeygenResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetTssAddressByFinalizedHeightResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4606,32 +4697,27 @@ func (m *QueryKeygenResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryKeygenResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetTssAddressByFinalizedHeightResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryKeygenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetTssAddressByFinalizedHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Keygen != nil {
-		{
-			size, err := m.Keygen.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if len(m.Eth) > 0 {
+		i -= len(m.Eth)
+		copy(dAtA[i:], m.Eth)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Eth)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryShowObserverCountRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryTssHistoryRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4641,20 +4727,32 @@ func (m *QueryShowObserverCountRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryShowObserverCountRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTssHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryShowObserverCountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTssHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryShowObserverCountResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryTssHistoryResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4664,19 +4762,19 @@ func (m *QueryShowObserverCountResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryShowObserverCountResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTssHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryShowObserverCountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTssHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LastObserverCount != nil {
+	if m.Pagination != nil {
 		{
-			size, err := m.LastObserverCount.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4684,12 +4782,26 @@ func (m *QueryShowObserverCountResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if len(m.TssList) > 0 {
+		for iNdEx := len(m.TssList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TssList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBlameByIdentifierRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryTssRotationRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4699,27 +4811,20 @@ func (m *QueryBlameByIdentifierRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBlameByIdentifierRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTssRotationRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBlameByIdentifierRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTssRotationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BlameIdentifier) > 0 {
-		i -= len(m.BlameIdentifier)
-		copy(dAtA[i:], m.BlameIdentifier)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlameIdentifier)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBlameByIdentifierResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryTssRotationResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4729,32 +4834,30 @@ func (m *QueryBlameByIdentifierResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBlameByIdentifierResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTssRotationResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBlameByIdentifierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTssRotationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BlameInfo != nil {
-		{
-			size, err := m.BlameInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	{
+		size, err := m.TssRotation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0xa
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllBlameRecordsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4764,32 +4867,53 @@ func (m *QueryAllBlameRecordsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllBlameRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllBlameRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0xa
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetAllBlameRecordsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryHasVotedRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4799,46 +4923,34 @@ func (m *QueryGetAllBlameRecordsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetAllBlameRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryHasVotedRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetAllBlameRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryHasVotedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if len(m.VoterAddress) > 0 {
+		i -= len(m.VoterAddress)
+		copy(dAtA[i:], m.VoterAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VoterAddress)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.BlameInfo) > 0 {
-		for iNdEx := len(m.BlameInfo) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BlameInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if len(m.BallotIdentifier) > 0 {
+		i -= len(m.BallotIdentifier)
+		copy(dAtA[i:], m.BallotIdentifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BallotIdentifier)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBlameByChainAndNonceRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryHasVotedResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4848,30 +4960,30 @@ func (m *QueryBlameByChainAndNonceRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBlameByChainAndNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryHasVotedResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBlameByChainAndNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryHasVotedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Nonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+	if m.HasVoted {
 		i--
-		dAtA[i] = 0x10
-	}
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		if m.HasVoted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBlamesByChainAndNonceResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryBallotByIdentifierRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4881,20 +4993,60 @@ func (m *QueryBlamesByChainAndNonceResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryBlamesByChainAndNonceResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryBallotByIdentifierRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBlamesByChainAndNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryBallotByIdentifierRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BlameInfo) > 0 {
-		for iNdEx := len(m.BlameInfo) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.BallotIdentifier) > 0 {
+		i -= len(m.BallotIdentifier)
+		copy(dAtA[i:], m.BallotIdentifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BallotIdentifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBallotByIdentifierResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBallotByIdentifierResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBallotByIdentifierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BallotStatus != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BallotStatus))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ObservationType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ObservationType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Voters) > 0 {
+		for iNdEx := len(m.Voters) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.BlameInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Voters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4902,657 +5054,3047 @@ func (m *QueryBlamesByChainAndNonceResponse) MarshalToSizedBuffer(dAtA []byte) (
 				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
 		}
 	}
+	if len(m.BallotIdentifier) > 0 {
+		i -= len(m.BallotIdentifier)
+		copy(dAtA[i:], m.BallotIdentifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BallotIdentifier)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *VoterList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryGetChainNoncesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *VoterList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoterList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.VoteType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VoteType))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.VoterAddress) > 0 {
+		i -= len(m.VoterAddress)
+		copy(dAtA[i:], m.VoterAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VoterAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryChainNoncesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryObserverSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.ChainNonces.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllChainNoncesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryObserverSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryObserverSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryChainNoncesAllResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryObserverSetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryObserverSetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryObserverSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ChainNonces) > 0 {
-		for _, e := range m.ChainNonces {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Observers) > 0 {
+		for iNdEx := len(m.Observers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Observers[iNdEx])
+			copy(dAtA[i:], m.Observers[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Observers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllPendingNoncesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySupportedChains) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupportedChains) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupportedChains) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPendingNoncesAllResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySupportedChainsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupportedChainsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupportedChainsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.PendingNonces) > 0 {
-		for _, e := range m.PendingNonces {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Chains) > 0 {
+		for iNdEx := len(m.Chains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Chains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPendingNoncesByChainRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
+func (m *QueryChainListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPendingNoncesByChainResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.PendingNonces.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryChainListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetTSSRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryChainListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTSSResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryChainListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Tss.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetTssAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BitcoinChainId != 0 {
-		n += 1 + sovQuery(uint64(m.BitcoinChainId))
-	}
-	return n
+func (m *QueryChainListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetTssAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryChainListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Eth)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Chains) > 0 {
+		for iNdEx := len(m.Chains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Chains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetTssAddressByFinalizedHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.FinalizedPellHeight != 0 {
-		n += 1 + sovQuery(uint64(m.FinalizedPellHeight))
-	}
-	if m.BitcoinChainId != 0 {
-		n += 1 + sovQuery(uint64(m.BitcoinChainId))
+func (m *QueryRestrictedAddressesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetTssAddressByFinalizedHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryRestrictedAddressesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRestrictedAddressesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Eth)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTssHistoryRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRestrictedAddressesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRestrictedAddressesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRestrictedAddressesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTssHistoryResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRelayerPenaltyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRelayerPenaltyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRelayerPenaltyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.TssList) > 0 {
-		for _, e := range m.TssList {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ObserverAddress) > 0 {
+		i -= len(m.ObserverAddress)
+		copy(dAtA[i:], m.ObserverAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObserverAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRelayerPenaltyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryRelayerPenaltyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryHasVotedRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryRelayerPenaltyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.BallotIdentifier)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.VoterAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.RelayerPenalty.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryHasVotedResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.HasVoted {
-		n += 2
+func (m *QueryAllRelayerPenaltyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryBallotByIdentifierRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.BallotIdentifier)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryAllRelayerPenaltyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBallotByIdentifierResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllRelayerPenaltyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.BallotIdentifier)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.Voters) > 0 {
-		for _, e := range m.Voters {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.ObservationType != 0 {
-		n += 1 + sovQuery(uint64(m.ObservationType))
-	}
-	if m.BallotStatus != 0 {
-		n += 1 + sovQuery(uint64(m.BallotStatus))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *VoterList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.VoterAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.VoteType != 0 {
-		n += 1 + sovQuery(uint64(m.VoteType))
+func (m *QueryAllRelayerPenaltyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryObserverSet) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryAllRelayerPenaltyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryObserverSetResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllRelayerPenaltyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Observers) > 0 {
-		for _, s := range m.Observers {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.RelayerPenalties) > 0 {
+		for iNdEx := len(m.RelayerPenalties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RelayerPenalties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySupportedChains) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetChainParamsForChainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QuerySupportedChainsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Chains) > 0 {
-		for _, e := range m.Chains {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func (m *QueryGetChainParamsForChainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetChainParamsForChainRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetChainParamsForChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetChainParamsForChainResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetChainParamsForChainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetChainParamsForChainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetChainParamsForChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.ChainParams != nil {
-		l = m.ChainParams.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.ChainParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetChainParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetChainParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetChainParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetChainParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetChainParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetChainParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetChainParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetChainParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.ChainParams != nil {
-		l = m.ChainParams.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.ChainParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetNodeAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetNodeAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetNodeAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetNodeAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryNodeAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryNodeAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNodeAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNodeAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.NodeAccount != nil {
-		l = m.NodeAccount.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.NodeAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllNodeAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllNodeAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllNodeAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllNodeAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryNodeAccountAllResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryNodeAccountAllResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNodeAccountAllResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNodeAccountAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.NodeAccount) > 0 {
-		for _, e := range m.NodeAccount {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.NodeAccount) > 0 {
+		for iNdEx := len(m.NodeAccount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NodeAccount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetCrosschainFlagsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetCrosschainFlagsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryCrosschainFlagsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetCrosschainFlagsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCrosschainFlagsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.CrosschainFlags.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetKeygenRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCrosschainFlagsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryKeygenResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryCrosschainFlagsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCrosschainFlagsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Keygen != nil {
-		l = m.Keygen.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.CrosschainFlags.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryShowObserverCountRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryChainPauseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryShowObserverCountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryChainPauseRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChainPauseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.LastObserverCount != nil {
-		l = m.LastObserverCount.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBlameByIdentifierRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryChainPauseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChainPauseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChainPauseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.BlameIdentifier)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.ChainPause.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBlameByIdentifierResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllChainPauseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllChainPauseRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllChainPauseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BlameInfo != nil {
-		l = m.BlameInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllBlameRecordsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllChainPauseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllChainPauseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllChainPauseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ChainPauses) > 0 {
+		for iNdEx := len(m.ChainPauses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainPauses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetAllBlameRecordsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetKeygenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetKeygenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetKeygenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.BlameInfo) > 0 {
-		for _, e := range m.BlameInfo {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBlameByChainAndNonceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryKeygenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryKeygenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryKeygenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
-	}
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
+	if m.Keygen != nil {
+		{
+			size, err := m.Keygen.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBlamesByChainAndNonceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryShowObserverCountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryShowObserverCountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryShowObserverCountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.BlameInfo) > 0 {
-		for _, e := range m.BlameInfo {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryShowObserverCountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryShowObserverCountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryShowObserverCountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastObserverCount != nil {
+		{
+			size, err := m.LastObserverCount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlameByIdentifierRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlameByIdentifierRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlameByIdentifierRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlameIdentifier) > 0 {
+		i -= len(m.BlameIdentifier)
+		copy(dAtA[i:], m.BlameIdentifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlameIdentifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlameByIdentifierResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlameByIdentifierResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlameByIdentifierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlameInfo != nil {
+		{
+			size, err := m.BlameInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllBlameRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllBlameRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllBlameRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAllBlameRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAllBlameRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAllBlameRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BlameInfo) > 0 {
+		for iNdEx := len(m.BlameInfo) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlameInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlameByChainAndNonceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlameByChainAndNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlameByChainAndNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlamesByChainAndNonceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlamesByChainAndNonceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlamesByChainAndNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlameInfo) > 0 {
+		for iNdEx := len(m.BlameInfo) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlameInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryGetChainNoncesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChainNoncesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ChainNonces.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllChainNoncesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChainNoncesAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ChainNonces) > 0 {
+		for _, e := range m.ChainNonces {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllPendingNoncesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingNoncesAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingNonces) > 0 {
+		for _, e := range m.PendingNonces {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingNoncesByChainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *QueryPendingNoncesByChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PendingNonces.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetTSSRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTSSResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Tss.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetTssAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BitcoinChainId != 0 {
+		n += 1 + sovQuery(uint64(m.BitcoinChainId))
+	}
+	return n
+}
+
+func (m *QueryGetTssAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Eth)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetTssAddressByFinalizedHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FinalizedPellHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FinalizedPellHeight))
+	}
+	if m.BitcoinChainId != 0 {
+		n += 1 + sovQuery(uint64(m.BitcoinChainId))
+	}
+	return n
+}
+
+func (m *QueryGetTssAddressByFinalizedHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Eth)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTssHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTssHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TssList) > 0 {
+		for _, e := range m.TssList {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTssRotationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTssRotationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TssRotation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryHasVotedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BallotIdentifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.VoterAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHasVotedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HasVoted {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryBallotByIdentifierRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BallotIdentifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBallotByIdentifierResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BallotIdentifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Voters) > 0 {
+		for _, e := range m.Voters {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.ObservationType != 0 {
+		n += 1 + sovQuery(uint64(m.ObservationType))
+	}
+	if m.BallotStatus != 0 {
+		n += 1 + sovQuery(uint64(m.BallotStatus))
+	}
+	return n
+}
+
+func (m *VoterList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VoterAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.VoteType != 0 {
+		n += 1 + sovQuery(uint64(m.VoteType))
+	}
+	return n
+}
+
+func (m *QueryObserverSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryObserverSetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Observers) > 0 {
+		for _, s := range m.Observers {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySupportedChains) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySupportedChainsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Chains) > 0 {
+		for _, e := range m.Chains {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryChainListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryChainListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Chains) > 0 {
+		for _, e := range m.Chains {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRestrictedAddressesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRestrictedAddressesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRelayerPenaltyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ObserverAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRelayerPenaltyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RelayerPenalty.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllRelayerPenaltyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllRelayerPenaltyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RelayerPenalties) > 0 {
+		for _, e := range m.RelayerPenalties {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetChainParamsForChainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *QueryGetChainParamsForChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainParams != nil {
+		l = m.ChainParams.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetChainParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *QueryGetChainParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainParams != nil {
+		l = m.ChainParams.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetNodeAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNodeAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NodeAccount != nil {
+		l = m.NodeAccount.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllNodeAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNodeAccountAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.NodeAccount) > 0 {
+		for _, e := range m.NodeAccount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetCrosschainFlagsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCrosschainFlagsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CrosschainFlags.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryChainPauseRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *QueryChainPauseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ChainPause.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllChainPauseRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllChainPauseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ChainPauses) > 0 {
+		for _, e := range m.ChainPauses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetKeygenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryKeygenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Keygen != nil {
+		l = m.Keygen.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryShowObserverCountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryShowObserverCountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastObserverCount != nil {
+		l = m.LastObserverCount.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlameByIdentifierRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlameIdentifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlameByIdentifierResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlameInfo != nil {
+		l = m.BlameInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllBlameRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAllBlameRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BlameInfo) > 0 {
+		for _, e := range m.BlameInfo {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlameByChainAndNonceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryBlamesByChainAndNonceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BlameInfo) > 0 {
+		for _, e := range m.BlameInfo {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryGetChainNoncesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetChainNoncesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetChainNoncesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChainNoncesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChainNoncesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChainNoncesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ChainNonces.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllChainNoncesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllChainNoncesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllChainNoncesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChainNoncesAllResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChainNoncesAllResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChainNoncesAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainNonces = append(m.ChainNonces, ChainNonces{})
+			if err := m.ChainNonces[len(m.ChainNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPendingNoncesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPendingNoncesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPendingNoncesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingNoncesAllResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingNoncesAllResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingNoncesAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingNonces = append(m.PendingNonces, PendingNonces{})
+			if err := m.PendingNonces[len(m.PendingNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingNoncesByChainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingNoncesByChainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingNoncesByChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingNoncesByChainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingNoncesByChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingNoncesByChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PendingNonces.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTSSRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTSSRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTSSRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTSSResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTSSResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTSSResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tss", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tss.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTssAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTssAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTssAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BitcoinChainId", wireType)
+			}
+			m.BitcoinChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BitcoinChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTssAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTssAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTssAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eth", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Eth = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryGetChainNoncesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetTssAddressByFinalizedHeightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5575,17 +8117,17 @@ func (m *QueryGetChainNoncesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetChainNoncesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetTssAddressByFinalizedHeightRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetChainNoncesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetTssAddressByFinalizedHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedPellHeight", wireType)
 			}
-			var stringLen uint64
+			m.FinalizedPellHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5595,24 +8137,30 @@ func (m *QueryGetChainNoncesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.FinalizedPellHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BitcoinChainId", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.BitcoinChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BitcoinChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5634,7 +8182,7 @@ func (m *QueryGetChainNoncesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryChainNoncesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetTssAddressByFinalizedHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5657,17 +8205,17 @@ func (m *QueryChainNoncesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryChainNoncesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetTssAddressByFinalizedHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryChainNoncesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetTssAddressByFinalizedHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainNonces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Eth", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5677,24 +8225,23 @@ func (m *QueryChainNoncesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ChainNonces.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Eth = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5717,7 +8264,7 @@ func (m *QueryChainNoncesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllChainNoncesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTssHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5740,10 +8287,10 @@ func (m *QueryAllChainNoncesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllChainNoncesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTssHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllChainNoncesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTssHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5803,7 +8350,7 @@ func (m *QueryAllChainNoncesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryChainNoncesAllResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTssHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5826,15 +8373,15 @@ func (m *QueryChainNoncesAllResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryChainNoncesAllResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTssHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryChainNoncesAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTssHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainNonces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TssList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5861,8 +8408,8 @@ func (m *QueryChainNoncesAllResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainNonces = append(m.ChainNonces, ChainNonces{})
-			if err := m.ChainNonces[len(m.ChainNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TssList = append(m.TssList, TSS{})
+			if err := m.TssList[len(m.TssList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5892,16 +8439,199 @@ func (m *QueryChainNoncesAllResponse) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTssRotationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTssRotationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTssRotationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTssRotationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTssRotationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTssRotationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TssRotation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TssRotation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5923,7 +8653,7 @@ func (m *QueryChainNoncesAllResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllPendingNoncesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5946,15 +8676,15 @@ func (m *QueryAllPendingNoncesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPendingNoncesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPendingNoncesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5981,10 +8711,7 @@ func (m *QueryAllPendingNoncesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6009,7 +8736,7 @@ func (m *QueryAllPendingNoncesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPendingNoncesAllResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHasVotedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6032,17 +8759,17 @@ func (m *QueryPendingNoncesAllResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPendingNoncesAllResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHasVotedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPendingNoncesAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHasVotedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingNonces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotIdentifier", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6052,31 +8779,29 @@ func (m *QueryPendingNoncesAllResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingNonces = append(m.PendingNonces, PendingNonces{})
-			if err := m.PendingNonces[len(m.PendingNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BallotIdentifier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VoterAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6086,27 +8811,23 @@ func (m *QueryPendingNoncesAllResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.VoterAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6129,7 +8850,7 @@ func (m *QueryPendingNoncesAllResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPendingNoncesByChainRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHasVotedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6152,17 +8873,17 @@ func (m *QueryPendingNoncesByChainRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPendingNoncesByChainRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHasVotedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPendingNoncesByChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHasVotedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasVoted", wireType)
 			}
-			m.ChainId = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6172,11 +8893,12 @@ func (m *QueryPendingNoncesByChainRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.HasVoted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6198,7 +8920,7 @@ func (m *QueryPendingNoncesByChainRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPendingNoncesByChainResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBallotByIdentifierRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6221,17 +8943,17 @@ func (m *QueryPendingNoncesByChainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPendingNoncesByChainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBallotByIdentifierRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPendingNoncesByChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBallotByIdentifierRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingNonces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotIdentifier", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6241,24 +8963,23 @@ func (m *QueryPendingNoncesByChainResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PendingNonces.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BallotIdentifier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6281,7 +9002,7 @@ func (m *QueryPendingNoncesByChainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetTSSRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBallotByIdentifierResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6304,65 +9025,47 @@ func (m *QueryGetTSSRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTSSRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBallotByIdentifierResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTSSRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBallotByIdentifierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotIdentifier", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryTSSResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTSSResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTSSResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.BallotIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tss", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6389,65 +9092,35 @@ func (m *QueryTSSResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Tss.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Voters = append(m.Voters, &VoterList{})
+			if err := m.Voters[len(m.Voters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetTssAddressRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservationType", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTssAddressRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTssAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ObservationType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservationType |= ObservationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BitcoinChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotStatus", wireType)
 			}
-			m.BitcoinChainId = 0
+			m.BallotStatus = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6457,7 +9130,7 @@ func (m *QueryGetTssAddressRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BitcoinChainId |= int64(b&0x7F) << shift
+				m.BallotStatus |= BallotStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6483,7 +9156,7 @@ func (m *QueryGetTssAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetTssAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *VoterList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6506,15 +9179,15 @@ func (m *QueryGetTssAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTssAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: VoterList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTssAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VoterList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Eth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VoterAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6542,8 +9215,27 @@ func (m *QueryGetTssAddressResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Eth = string(dAtA[iNdEx:postIndex])
+			m.VoterAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteType", wireType)
+			}
+			m.VoteType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VoteType |= VoteType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6565,7 +9257,7 @@ func (m *QueryGetTssAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetTssAddressByFinalizedHeightRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryObserverSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6588,50 +9280,12 @@ func (m *QueryGetTssAddressByFinalizedHeightRequest) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTssAddressByFinalizedHeightRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryObserverSet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTssAddressByFinalizedHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryObserverSet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedPellHeight", wireType)
-			}
-			m.FinalizedPellHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FinalizedPellHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BitcoinChainId", wireType)
-			}
-			m.BitcoinChainId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BitcoinChainId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6653,7 +9307,7 @@ func (m *QueryGetTssAddressByFinalizedHeightRequest) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *QueryGetTssAddressByFinalizedHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryObserverSetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6676,15 +9330,15 @@ func (m *QueryGetTssAddressByFinalizedHeightResponse) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTssAddressByFinalizedHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryObserverSetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTssAddressByFinalizedHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryObserverSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Eth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Observers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6712,7 +9366,7 @@ func (m *QueryGetTssAddressByFinalizedHeightResponse) Unmarshal(dAtA []byte) err
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Eth = string(dAtA[iNdEx:postIndex])
+			m.Observers = append(m.Observers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6735,7 +9389,7 @@ func (m *QueryGetTssAddressByFinalizedHeightResponse) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *QueryTssHistoryRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySupportedChains) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6758,48 +9412,12 @@ func (m *QueryTssHistoryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTssHistoryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySupportedChains: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTssHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySupportedChains: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6821,7 +9439,7 @@ func (m *QueryTssHistoryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTssHistoryResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySupportedChainsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6844,49 +9462,15 @@ func (m *QueryTssHistoryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTssHistoryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySupportedChainsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTssHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySupportedChainsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TssList", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TssList = append(m.TssList, TSS{})
-			if err := m.TssList[len(m.TssList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chains", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6913,10 +9497,8 @@ func (m *QueryTssHistoryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Chains = append(m.Chains, &chains.Chain{})
+			if err := m.Chains[len(m.Chains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6941,7 +9523,7 @@ func (m *QueryTssHistoryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryChainListRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6964,10 +9546,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChainListRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChainListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6991,7 +9573,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryChainListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7014,15 +9596,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChainListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChainListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chains", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7049,7 +9631,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Chains = append(m.Chains, chains.Chain{})
+			if err := m.Chains[len(m.Chains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7074,7 +9657,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHasVotedRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRestrictedAddressesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7097,47 +9680,65 @@ func (m *QueryHasVotedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHasVotedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRestrictedAddressesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHasVotedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRestrictedAddressesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BallotIdentifier", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRestrictedAddressesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BallotIdentifier = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRestrictedAddressesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRestrictedAddressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoterAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7165,7 +9766,7 @@ func (m *QueryHasVotedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VoterAddress = string(dAtA[iNdEx:postIndex])
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7188,7 +9789,7 @@ func (m *QueryHasVotedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHasVotedResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRelayerPenaltyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7211,17 +9812,17 @@ func (m *QueryHasVotedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHasVotedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRelayerPenaltyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHasVotedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRelayerPenaltyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasVoted", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7231,12 +9832,24 @@ func (m *QueryHasVotedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.HasVoted = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7258,7 +9871,7 @@ func (m *QueryHasVotedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBallotByIdentifierRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRelayerPenaltyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7281,17 +9894,17 @@ func (m *QueryBallotByIdentifierRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBallotByIdentifierRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRelayerPenaltyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBallotByIdentifierRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRelayerPenaltyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BallotIdentifier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerPenalty", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7301,23 +9914,24 @@ func (m *QueryBallotByIdentifierRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BallotIdentifier = string(dAtA[iNdEx:postIndex])
+			if err := m.RelayerPenalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7340,7 +9954,7 @@ func (m *QueryBallotByIdentifierRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBallotByIdentifierResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllRelayerPenaltyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7363,47 +9977,15 @@ func (m *QueryBallotByIdentifierResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBallotByIdentifierResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllRelayerPenaltyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBallotByIdentifierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllRelayerPenaltyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BallotIdentifier", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BallotIdentifier = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7430,49 +10012,13 @@ func (m *QueryBallotByIdentifierResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voters = append(m.Voters, &VoterList{})
-			if err := m.Voters[len(m.Voters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservationType", wireType)
-			}
-			m.ObservationType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ObservationType |= ObservationType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BallotStatus", wireType)
-			}
-			m.BallotStatus = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BallotStatus |= BallotStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7494,7 +10040,7 @@ func (m *QueryBallotByIdentifierResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VoterList) Unmarshal(dAtA []byte) error {
+func (m *QueryAllRelayerPenaltyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7517,17 +10063,17 @@ func (m *VoterList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VoterList: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllRelayerPenaltyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VoterList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllRelayerPenaltyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoterAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerPenalties", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7537,29 +10083,31 @@ func (m *VoterList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VoterAddress = string(dAtA[iNdEx:postIndex])
+			m.RelayerPenalties = append(m.RelayerPenalties, RelayerPenalty{})
+			if err := m.RelayerPenalties[len(m.RelayerPenalties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteType", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.VoteType = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7569,11 +10117,28 @@ func (m *VoterList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VoteType |= VoteType(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7595,7 +10160,7 @@ func (m *VoterList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryObserverSet) Unmarshal(dAtA []byte) error {
+func (m *QueryGetChainParamsForChainRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7618,12 +10183,31 @@ func (m *QueryObserverSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryObserverSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetChainParamsForChainRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryObserverSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetChainParamsForChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7645,7 +10229,7 @@ func (m *QueryObserverSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryObserverSetResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetChainParamsForChainResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7668,17 +10252,17 @@ func (m *QueryObserverSetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryObserverSetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetChainParamsForChainResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryObserverSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetChainParamsForChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Observers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainParams", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7688,23 +10272,27 @@ func (m *QueryObserverSetResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Observers = append(m.Observers, string(dAtA[iNdEx:postIndex]))
+			if m.ChainParams == nil {
+				m.ChainParams = &ChainParams{}
+			}
+			if err := m.ChainParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7727,7 +10315,7 @@ func (m *QueryObserverSetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySupportedChains) Unmarshal(dAtA []byte) error {
+func (m *QueryGetChainParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7750,12 +10338,31 @@ func (m *QuerySupportedChains) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySupportedChains: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetChainParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySupportedChains: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetChainParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7777,7 +10384,7 @@ func (m *QuerySupportedChains) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySupportedChainsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetChainParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7800,15 +10407,15 @@ func (m *QuerySupportedChainsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySupportedChainsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetChainParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySupportedChainsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetChainParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chains", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainParams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7835,8 +10442,10 @@ func (m *QuerySupportedChainsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Chains = append(m.Chains, &chains.Chain{})
-			if err := m.Chains[len(m.Chains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ChainParams == nil {
+				m.ChainParams = &ChainParamsList{}
+			}
+			if err := m.ChainParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7861,7 +10470,7 @@ func (m *QuerySupportedChainsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetChainParamsForChainRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetNodeAccountRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7884,17 +10493,17 @@ func (m *QueryGetChainParamsForChainRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetChainParamsForChainRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetNodeAccountRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetChainParamsForChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetNodeAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			m.ChainId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7904,11 +10513,24 @@ func (m *QueryGetChainParamsForChainRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7930,7 +10552,7 @@ func (m *QueryGetChainParamsForChainRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetChainParamsForChainResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNodeAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7953,15 +10575,15 @@ func (m *QueryGetChainParamsForChainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetChainParamsForChainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNodeAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetChainParamsForChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNodeAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeAccount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7988,10 +10610,10 @@ func (m *QueryGetChainParamsForChainResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ChainParams == nil {
-				m.ChainParams = &ChainParams{}
+			if m.NodeAccount == nil {
+				m.NodeAccount = &NodeAccount{}
 			}
-			if err := m.ChainParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NodeAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8016,7 +10638,7 @@ func (m *QueryGetChainParamsForChainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetChainParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllNodeAccountRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8039,17 +10661,17 @@ func (m *QueryGetChainParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetChainParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllNodeAccountRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetChainParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllNodeAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.ChainId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8059,11 +10681,28 @@ func (m *QueryGetChainParamsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8085,7 +10724,7 @@ func (m *QueryGetChainParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetChainParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNodeAccountAllResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8108,15 +10747,15 @@ func (m *QueryGetChainParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetChainParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNodeAccountAllResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetChainParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNodeAccountAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeAccount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8143,10 +10782,44 @@ func (m *QueryGetChainParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ChainParams == nil {
-				m.ChainParams = &ChainParamsList{}
+			m.NodeAccount = append(m.NodeAccount, &NodeAccount{})
+			if err := m.NodeAccount[len(m.NodeAccount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.ChainParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8171,7 +10844,7 @@ func (m *QueryGetChainParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetNodeAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetCrosschainFlagsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8194,44 +10867,12 @@ func (m *QueryGetNodeAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetNodeAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetCrosschainFlagsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetNodeAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetCrosschainFlagsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Index = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8253,7 +10894,7 @@ func (m *QueryGetNodeAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNodeAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCrosschainFlagsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8276,15 +10917,15 @@ func (m *QueryNodeAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNodeAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCrosschainFlagsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNodeAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCrosschainFlagsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CrosschainFlags", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8311,10 +10952,7 @@ func (m *QueryNodeAccountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NodeAccount == nil {
-				m.NodeAccount = &NodeAccount{}
-			}
-			if err := m.NodeAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CrosschainFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8339,7 +10977,7 @@ func (m *QueryNodeAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllNodeAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryChainPauseRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8362,17 +11000,17 @@ func (m *QueryAllNodeAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllNodeAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChainPauseRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllNodeAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChainPauseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var msglen int
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8382,28 +11020,11 @@ func (m *QueryAllNodeAccountRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ChainId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8425,7 +11046,7 @@ func (m *QueryAllNodeAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNodeAccountAllResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryChainPauseResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8448,49 +11069,15 @@ func (m *QueryNodeAccountAllResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNodeAccountAllResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChainPauseResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNodeAccountAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChainPauseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeAccount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NodeAccount = append(m.NodeAccount, &NodeAccount{})
-			if err := m.NodeAccount[len(m.NodeAccount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainPause", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8517,10 +11104,7 @@ func (m *QueryNodeAccountAllResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ChainPause.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8545,7 +11129,7 @@ func (m *QueryNodeAccountAllResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetCrosschainFlagsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllChainPauseRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8568,10 +11152,10 @@ func (m *QueryGetCrosschainFlagsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetCrosschainFlagsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllChainPauseRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetCrosschainFlagsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllChainPauseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -8595,7 +11179,7 @@ func (m *QueryGetCrosschainFlagsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCrosschainFlagsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllChainPauseResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8618,15 +11202,15 @@ func (m *QueryCrosschainFlagsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCrosschainFlagsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllChainPauseResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCrosschainFlagsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllChainPauseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CrosschainFlags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainPauses", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8653,7 +11237,8 @@ func (m *QueryCrosschainFlagsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CrosschainFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ChainPauses = append(m.ChainPauses, ChainPause{})
+			if err := m.ChainPauses[len(m.ChainPauses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,83 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// JailReasonBlames is the jail reason of an observer blamed too many times in a window
+	JailReasonBlames = "blames"
+
+	// JailReasonMissedBallots is the jail reason of an observer missing too many ballots in a window
+	JailReasonMissedBallots = "missed_ballots"
+)
+
+// NewRelayerPenalty returns an empty penalty record for the observer with a window starting at the given height
+func NewRelayerPenalty(observerAddress string, height int64) RelayerPenalty {
+	return RelayerPenalty{
+		ObserverAddress:   observerAddress,
+		WindowStartHeight: height,
+	}
+}
+
+// Validate checks the penalty record is valid
+func (m RelayerPenalty) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(m.ObserverAddress); err != nil {
+		return fmt.Errorf("invalid observer address %s: %w", m.ObserverAddress, err)
+	}
+	if m.WindowStartHeight < 0 {
+		return fmt.Errorf("invalid window start height %d", m.WindowStartHeight)
+	}
+	if !m.Jailed && m.JailedUntilHeight != 0 {
+		return fmt.Errorf("jailed until height %d set for non jailed observer", m.JailedUntilHeight)
+	}
+	return nil
+}
+
+// RollWindow starts a new window at the given height if the current window is over,
+// the counts of the previous window are reset. A zero window never ends.
+func (m *RelayerPenalty) RollWindow(height int64, windowBlocks int64) {
+	if windowBlocks <= 0 || height < m.WindowStartHeight+windowBlocks {
+		return
+	}
+	m.WindowStartHeight = height
+	m.BlameCount = 0
+	m.MissedBallotCount = 0
+}
+
+// JailReason returns the reason to jail the observer if the counts of the window reached the thresholds
+func (m RelayerPenalty) JailReason(params Params) (string, bool) {
+	switch {
+	case params.MaxBlamesPerWindow > 0 && m.BlameCount >= params.MaxBlamesPerWindow:
+		return JailReasonBlames, true
+	case params.MaxMissedBallotsPerWindow > 0 && m.MissedBallotCount >= params.MaxMissedBallotsPerWindow:
+		return JailReasonMissedBallots, true
+	default:
+		return "", false
+	}
+}
+
+// Jail jails the observer until the given height
+func (m *RelayerPenalty) Jail(jailedUntilHeight int64) {
+	m.Jailed = true
+	m.JailedUntilHeight = jailedUntilHeight
+	m.JailCount++
+}
+
+// Unjail unjails the observer and starts a new window at the given height
+func (m *RelayerPenalty) Unjail(height int64) error {
+	if !m.Jailed {
+		return ErrObserverNotJailed
+	}
+	if height < m.JailedUntilHeight {
+		return fmt.Errorf("%w: observer can be unjailed from height %d", ErrJailDurationNotElapsed, m.JailedUntilHeight)
+	}
+	m.Jailed = false
+	m.JailedUntilHeight = 0
+	m.WindowStartHeight = height
+	m.BlameCount = 0
+	m.MissedBallotCount = 0
+	return nil
+}
@@ -0,0 +1,549 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: relayer/relayer_penalty.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RelayerPenalty is the rolling record of the blames and missed ballots of an
+// observer, an observer is jailed when the counts of the window exceed the
+// thresholds of the params
+type RelayerPenalty struct {
+	ObserverAddress string `protobuf:"bytes,1,opt,name=observer_address,json=observerAddress,proto3" json:"observer_address,omitempty"`
+	// window_start_height is the height at which the current window started
+	WindowStartHeight int64 `protobuf:"varint,2,opt,name=window_start_height,json=windowStartHeight,proto3" json:"window_start_height,omitempty"`
+	// blame_count is the number of keysign and keygen blames in the window
+	BlameCount uint64 `protobuf:"varint,3,opt,name=blame_count,json=blameCount,proto3" json:"blame_count,omitempty"`
+	// missed_ballot_count is the number of matured ballots not voted in the
+	// window
+	MissedBallotCount uint64 `protobuf:"varint,4,opt,name=missed_ballot_count,json=missedBallotCount,proto3" json:"missed_ballot_count,omitempty"`
+	Jailed            bool   `protobuf:"varint,5,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	// jailed_until_height is the height from which the observer can be unjailed
+	JailedUntilHeight int64 `protobuf:"varint,6,opt,name=jailed_until_height,json=jailedUntilHeight,proto3" json:"jailed_until_height,omitempty"`
+	// jail_count is the number of times the observer has been jailed
+	JailCount uint64 `protobuf:"varint,7,opt,name=jail_count,json=jailCount,proto3" json:"jail_count,omitempty"`
+}
+
+func (m *RelayerPenalty) Reset()         { *m = RelayerPenalty{} }
+func (m *RelayerPenalty) String() string { return proto.CompactTextString(m) }
+func (*RelayerPenalty) ProtoMessage()    {}
+func (*RelayerPenalty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66974f870c832186, []int{0}
+}
+func (m *RelayerPenalty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayerPenalty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayerPenalty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RelayerPenalty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayerPenalty.Merge(m, src)
+}
+func (m *RelayerPenalty) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayerPenalty) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayerPenalty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayerPenalty proto.InternalMessageInfo
+
+func (m *RelayerPenalty) GetObserverAddress() string {
+	if m != nil {
+		return m.ObserverAddress
+	}
+	return ""
+}
+
+func (m *RelayerPenalty) GetWindowStartHeight() int64 {
+	if m != nil {
+		return m.WindowStartHeight
+	}
+	return 0
+}
+
+func (m *RelayerPenalty) GetBlameCount() uint64 {
+	if m != nil {
+		return m.BlameCount
+	}
+	return 0
+}
+
+func (m *RelayerPenalty) GetMissedBallotCount() uint64 {
+	if m != nil {
+		return m.MissedBallotCount
+	}
+	return 0
+}
+
+func (m *RelayerPenalty) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
+	}
+	return false
+}
+
+func (m *RelayerPenalty) GetJailedUntilHeight() int64 {
+	if m != nil {
+		return m.JailedUntilHeight
+	}
+	return 0
+}
+
+func (m *RelayerPenalty) GetJailCount() uint64 {
+	if m != nil {
+		return m.JailCount
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*RelayerPenalty)(nil), "relayer.RelayerPenalty")
+}
+
+func init() { proto.RegisterFile("relayer/relayer_penalty.proto", fileDescriptor_66974f870c832186) }
+
+var fileDescriptor_66974f870c832186 = []byte{
+	// 306 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x34, 0x91, 0x4f, 0x4e, 0x02, 0x31,
+	0x14, 0x87, 0x29, 0x20, 0x48, 0x4d, 0x54, 0xc6, 0xc4, 0xcc, 0x86, 0x3a, 0x71, 0x35, 0x6e, 0x66,
+	0x4c, 0x3c, 0x81, 0xb8, 0x61, 0x65, 0xc8, 0x18, 0x37, 0x6e, 0x9a, 0x0e, 0xf3, 0x02, 0xd5, 0x32,
+	0x25, 0x6d, 0x11, 0xb8, 0x85, 0x77, 0xf0, 0x32, 0x2e, 0x59, 0xba, 0x34, 0x70, 0x11, 0xd3, 0x3f,
+	0xb3, 0xea, 0xeb, 0xf7, 0xfd, 0xf2, 0xde, 0x4b, 0x8b, 0x47, 0x0a, 0x04, 0xdb, 0x81, 0xca, 0xc3,
+	0x49, 0x57, 0x50, 0x33, 0x61, 0x76, 0xd9, 0x4a, 0x49, 0x23, 0xa3, 0x7e, 0xc0, 0xb7, 0xdf, 0x6d,
+	0x7c, 0x5e, 0xf8, 0x7a, 0xea, 0x13, 0xd1, 0x1d, 0xbe, 0x94, 0xa5, 0x06, 0xf5, 0x09, 0x8a, 0xb2,
+	0xaa, 0x52, 0xa0, 0x75, 0x8c, 0x12, 0x94, 0x0e, 0x8a, 0x8b, 0x86, 0x3f, 0x7a, 0x1c, 0x65, 0xf8,
+	0x6a, 0xc3, 0xeb, 0x4a, 0x6e, 0xa8, 0x36, 0x4c, 0x19, 0xba, 0x00, 0x3e, 0x5f, 0x98, 0xb8, 0x9d,
+	0xa0, 0xb4, 0x53, 0x0c, 0xbd, 0x7a, 0xb1, 0x66, 0xe2, 0x44, 0x74, 0x83, 0xcf, 0x4a, 0xc1, 0x96,
+	0x40, 0x67, 0x72, 0x5d, 0x9b, 0xb8, 0x93, 0xa0, 0xb4, 0x5b, 0x60, 0x87, 0x9e, 0x2c, 0xb1, 0x0d,
+	0x97, 0x5c, 0x6b, 0xa8, 0x68, 0xc9, 0x84, 0x90, 0x26, 0x04, 0xbb, 0x2e, 0x38, 0xf4, 0x6a, 0xec,
+	0x8c, 0xcf, 0x5f, 0xe3, 0xde, 0x3b, 0xe3, 0x02, 0xaa, 0xf8, 0x24, 0x41, 0xe9, 0x69, 0x11, 0x6e,
+	0xb6, 0x8f, 0xaf, 0xe8, 0xba, 0x36, 0x5c, 0x34, 0x8b, 0xf5, 0xfc, 0x62, 0x5e, 0xbd, 0x5a, 0x13,
+	0x16, 0x1b, 0x61, 0x6c, 0x61, 0x18, 0xd7, 0x77, 0xe3, 0x06, 0x96, 0xb8, 0x31, 0xe3, 0xc9, 0xcf,
+	0x81, 0xa0, 0xfd, 0x81, 0xa0, 0xbf, 0x03, 0x41, 0x5f, 0x47, 0xd2, 0xda, 0x1f, 0x49, 0xeb, 0xf7,
+	0x48, 0x5a, 0x6f, 0xd9, 0x9c, 0x9b, 0xc5, 0xba, 0xcc, 0x66, 0x72, 0x99, 0xdf, 0x6f, 0xa7, 0x20,
+	0xc4, 0x33, 0x98, 0x8d, 0x54, 0x1f, 0x39, 0x83, 0x39, 0xd7, 0xf9, 0xb6, 0xf9, 0x80, 0xdc, 0xec,
+	0x56, 0xa0, 0xcb, 0x9e, 0x7b, 0xff, 0x87, 0xff, 0x01, 0x00, 0x64, 0x48, 0xea, 0xa0, 0xa0, 0x01,
+	0x00, 0x00,
+}
+
+func (m *RelayerPenalty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayerPenalty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayerPenalty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.JailCount != 0 {
+		i = encodeVarintRelayerPenalty(dAtA, i, uint64(m.JailCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.JailedUntilHeight != 0 {
+		i = encodeVarintRelayerPenalty(dAtA, i, uint64(m.JailedUntilHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MissedBallotCount != 0 {
+		i = encodeVarintRelayerPenalty(dAtA, i, uint64(m.MissedBallotCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BlameCount != 0 {
+		i = encodeVarintRelayerPenalty(dAtA, i, uint64(m.BlameCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WindowStartHeight != 0 {
+		i = encodeVarintRelayerPenalty(dAtA, i, uint64(m.WindowStartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ObserverAddress) > 0 {
+		i -= len(m.ObserverAddress)
+		copy(dAtA[i:], m.ObserverAddress)
+		i = encodeVarintRelayerPenalty(dAtA, i, uint64(len(m.ObserverAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRelayerPenalty(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRelayerPenalty(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RelayerPenalty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ObserverAddress)
+	if l > 0 {
+		n += 1 + l + sovRelayerPenalty(uint64(l))
+	}
+	if m.WindowStartHeight != 0 {
+		n += 1 + sovRelayerPenalty(uint64(m.WindowStartHeight))
+	}
+	if m.BlameCount != 0 {
+		n += 1 + sovRelayerPenalty(uint64(m.BlameCount))
+	}
+	if m.MissedBallotCount != 0 {
+		n += 1 + sovRelayerPenalty(uint64(m.MissedBallotCount))
+	}
+	if m.Jailed {
+		n += 2
+	}
+	if m.JailedUntilHeight != 0 {
+		n += 1 + sovRelayerPenalty(uint64(m.JailedUntilHeight))
+	}
+	if m.JailCount != 0 {
+		n += 1 + sovRelayerPenalty(uint64(m.JailCount))
+	}
+	return n
+}
+
+func sovRelayerPenalty(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRelayerPenalty(x uint64) (n int) {
+	return sovRelayerPenalty(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RelayerPenalty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRelayerPenalty
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelayerPenalty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelayerPenalty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayerPenalty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelayerPenalty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayerPenalty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStartHeight", wireType)
+			}
+			m.WindowStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayerPenalty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowStartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlameCount", wireType)
+			}
+			m.BlameCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayerPenalty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlameCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBallotCount", wireType)
+			}
+			m.MissedBallotCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayerPenalty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBallotCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayerPenalty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntilHeight", wireType)
+			}
+			m.JailedUntilHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayerPenalty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailedUntilHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailCount", wireType)
+			}
+			m.JailCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayerPenalty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRelayerPenalty(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRelayerPenalty
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRelayerPenalty(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRelayerPenalty
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRelayerPenalty
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRelayerPenalty
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRelayerPenalty
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRelayerPenalty
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRelayerPenalty
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRelayerPenalty        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRelayerPenalty          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRelayerPenalty = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,125 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/testutil/sample"
+	"github.com/0xPellNetwork/aegis/x/relayer/types"
+)
+
+func TestRelayerPenalty_Validate(t *testing.T) {
+	t.Run("should validate penalty record", func(t *testing.T) {
+		require.NoError(t, sample.RelayerPenalty_pell(t, "0").Validate())
+		require.NoError(t, types.NewRelayerPenalty(sample.AccAddress(), 10).Validate())
+	})
+
+	t.Run("should fail if invalid observer address", func(t *testing.T) {
+		penalty := types.NewRelayerPenalty("invalid", 10)
+		require.Error(t, penalty.Validate())
+	})
+
+	t.Run("should fail if negative window start height", func(t *testing.T) {
+		penalty := types.NewRelayerPenalty(sample.AccAddress(), -1)
+		require.Error(t, penalty.Validate())
+	})
+
+	t.Run("should fail if jailed until height set for non jailed observer", func(t *testing.T) {
+		penalty := types.NewRelayerPenalty(sample.AccAddress(), 10)
+		penalty.JailedUntilHeight = 100
+		require.Error(t, penalty.Validate())
+	})
+}
+
+func TestRelayerPenalty_RollWindow(t *testing.T) {
+	t.Run("should keep counts within the window", func(t *testing.T) {
+		penalty := types.NewRelayerPenalty(sample.AccAddress(), 100)
+		penalty.BlameCount = 2
+		penalty.MissedBallotCount = 3
+
+		penalty.RollWindow(149, 50)
+		require.EqualValues(t, 100, penalty.WindowStartHeight)
+		require.EqualValues(t, 2, penalty.BlameCount)
+		require.EqualValues(t, 3, penalty.MissedBallotCount)
+	})
+
+	t.Run("should reset counts when the window is over", func(t *testing.T) {
+		penalty := types.NewRelayerPenalty(sample.AccAddress(), 100)
+		penalty.BlameCount = 2
+		penalty.MissedBallotCount = 3
+
+		penalty.RollWindow(150, 50)
+		require.EqualValues(t, 150, penalty.WindowStartHeight)
+		require.EqualValues(t, 0, penalty.BlameCount)
+		require.EqualValues(t, 0, penalty.MissedBallotCount)
+	})
+
+	t.Run("should never reset counts with a zero window", func(t *testing.T) {
+		penalty := types.NewRelayerPenalty(sample.AccAddress(), 100)
+		penalty.BlameCount = 2
+
+		penalty.RollWindow(1000000, 0)
+		require.EqualValues(t, 100, penalty.WindowStartHeight)
+		require.EqualValues(t, 2, penalty.BlameCount)
+	})
+}
+
+func TestRelayerPenalty_JailReason(t *testing.T) {
+	params := types.DefaultParams()
+	params.MaxBlamesPerWindow = 3
+	params.MaxMissedBallotsPerWindow = 10
+
+	penalty := types.NewRelayerPenalty(sample.AccAddress(), 100)
+	penalty.BlameCount = 2
+	penalty.MissedBallotCount = 9
+	_, jail := penalty.JailReason(params)
+	require.False(t, jail)
+
+	penalty.BlameCount = 3
+	reason, jail := penalty.JailReason(params)
+	require.True(t, jail)
+	require.Equal(t, types.JailReasonBlames, reason)
+
+	penalty.BlameCount = 0
+	penalty.MissedBallotCount = 10
+	reason, jail = penalty.JailReason(params)
+	require.True(t, jail)
+	require.Equal(t, types.JailReasonMissedBallots, reason)
+
+	// zero thresholds disable jailing
+	params.MaxBlamesPerWindow = 0
+	params.MaxMissedBallotsPerWindow = 0
+	penalty.BlameCount = 1000
+	_, jail = penalty.JailReason(params)
+	require.False(t, jail)
+}
+
+func TestRelayerPenalty_Unjail(t *testing.T) {
+	t.Run("should unjail after the jail duration", func(t *testing.T) {
+		penalty := types.NewRelayerPenalty(sample.AccAddress(), 100)
+		penalty.BlameCount = 5
+		penalty.Jail(200)
+		require.True(t, penalty.Jailed)
+		require.EqualValues(t, 1, penalty.JailCount)
+
+		require.NoError(t, penalty.Unjail(200))
+		require.False(t, penalty.Jailed)
+		require.EqualValues(t, 0, penalty.JailedUntilHeight)
+		require.EqualValues(t, 200, penalty.WindowStartHeight)
+		require.EqualValues(t, 0, penalty.BlameCount)
+		require.EqualValues(t, 1, penalty.JailCount)
+	})
+
+	t.Run("should fail if the jail duration has not elapsed", func(t *testing.T) {
+		penalty := types.NewRelayerPenalty(sample.AccAddress(), 100)
+		penalty.Jail(200)
+		require.ErrorIs(t, penalty.Unjail(199), types.ErrJailDurationNotElapsed)
+		require.True(t, penalty.Jailed)
+	})
+
+	t.Run("should fail if the observer is not jailed", func(t *testing.T) {
+		penalty := types.NewRelayerPenalty(sample.AccAddress(), 100)
+		require.ErrorIs(t, penalty.Unjail(200), types.ErrObserverNotJailed)
+	})
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MaxRestrictedAddressLength is the maximum length of an address in the restricted address list
+const MaxRestrictedAddressLength = 128
+
+// NormalizeRestrictedAddress returns the normalized form of an address of the restricted address list
+// Note: the list contains both ETH and BTC addresses, addresses are compared case-insensitively
+func NormalizeRestrictedAddress(address string) string {
+	return strings.ToLower(strings.TrimSpace(address))
+}
+
+// ValidateRestrictedAddresses checks the addresses are valid and not duplicated
+func ValidateRestrictedAddresses(addresses []string) error {
+	seen := make(map[string]bool, len(addresses))
+	for _, address := range addresses {
+		normalized := NormalizeRestrictedAddress(address)
+		if normalized == "" {
+			return fmt.Errorf("empty restricted address")
+		}
+		if len(normalized) > MaxRestrictedAddressLength {
+			return fmt.Errorf("restricted address %s is too long", address)
+		}
+		if strings.ContainsAny(normalized, " \t\n\r,") {
+			return fmt.Errorf("invalid restricted address %s", address)
+		}
+		if seen[normalized] {
+			return fmt.Errorf("duplicated restricted address %s", address)
+		}
+		seen[normalized] = true
+	}
+	return nil
+}
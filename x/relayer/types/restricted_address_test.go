@@ -0,0 +1,64 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/x/relayer/types"
+)
+
+func TestNormalizeRestrictedAddress(t *testing.T) {
+	require.Equal(t, "0x8531a5ab847ff5b22d855633c25ed1da3255247e",
+		types.NormalizeRestrictedAddress(" 0x8531a5aB847ff5B22D855633C25ED1DA3255247e "))
+	require.Equal(t, "bc1qxy2kgdygjrsqtzq2n0yrf2493p83kkfjhx0wlh",
+		types.NormalizeRestrictedAddress("bc1qxy2kgdygjrsqtzq2n0yrf2493p83kkfjhx0wlh"))
+}
+
+func TestValidateRestrictedAddresses(t *testing.T) {
+	tests := []struct {
+		name      string
+		addresses []string
+		errMsg    string
+	}{
+		{
+			name:      "valid addresses",
+			addresses: []string{"0x8531a5aB847ff5B22D855633C25ED1DA3255247e", "bc1qxy2kgdygjrsqtzq2n0yrf2493p83kkfjhx0wlh"},
+		},
+		{
+			name:      "empty list",
+			addresses: nil,
+		},
+		{
+			name:      "empty address",
+			addresses: []string{""},
+			errMsg:    "empty restricted address",
+		},
+		{
+			name:      "address too long",
+			addresses: []string{strings.Repeat("a", types.MaxRestrictedAddressLength+1)},
+			errMsg:    "too long",
+		},
+		{
+			name:      "address with separator",
+			addresses: []string{"0x1,0x2"},
+			errMsg:    "invalid restricted address",
+		},
+		{
+			name:      "duplicated address",
+			addresses: []string{"0x8531a5aB847ff5B22D855633C25ED1DA3255247e", "0x8531a5ab847ff5b22d855633c25ed1da3255247e"},
+			errMsg:    "duplicated restricted address",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := types.ValidateRestrictedAddresses(tt.addresses)
+			if tt.errMsg != "" {
+				require.ErrorContains(t, err, tt.errMsg)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
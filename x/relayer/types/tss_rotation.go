@@ -0,0 +1,71 @@
+package types
+
+import (
+	"fmt"
+)
+
+// IsInProgress returns true if the rotation has not reached a final stage
+func (m TssRotation) IsInProgress() bool {
+	switch m.Stage {
+	case TssRotationStage_TSS_ROTATION_KEYGEN,
+		TssRotationStage_TSS_ROTATION_DRAINING,
+		TssRotationStage_TSS_ROTATION_MIGRATING:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsCancellable returns true if the rotation can be cancelled, a rotation can't be cancelled once
+// the funds are being migrated
+func (m TssRotation) IsCancellable() bool {
+	return m.Stage == TssRotationStage_TSS_ROTATION_KEYGEN || m.Stage == TssRotationStage_TSS_ROTATION_DRAINING
+}
+
+// SetStage sets the stage of the rotation at the given height
+func (m *TssRotation) SetStage(stage TssRotationStage, height int64, message string) {
+	m.Stage = stage
+	m.StageHeight = height
+	m.Message = message
+}
+
+// GetMigration returns the migration of the chain
+func (m TssRotation) GetMigration(chainID int64) (TssRotationMigration, bool) {
+	for _, migration := range m.Migrations {
+		if migration.ChainId == chainID {
+			return migration, true
+		}
+	}
+	return TssRotationMigration{}, false
+}
+
+// HasPellMigration returns true if the pell token balance is migrated on the chain
+func (m TssRotationMigration) HasPellMigration() bool {
+	return !m.PellAmount.IsNil() && !m.PellAmount.IsZero()
+}
+
+// Validate checks the rotation is valid
+func (m TssRotation) Validate() error {
+	if _, ok := TssRotationStage_name[int32(m.Stage)]; !ok {
+		return fmt.Errorf("invalid tss rotation stage %d", m.Stage)
+	}
+	return ValidateTssRotationMigrations(m.Migrations)
+}
+
+// ValidateTssRotationMigrations checks the migrations are valid and defined once per chain
+func ValidateTssRotationMigrations(migrations []TssRotationMigration) error {
+	chainIDs := make(map[int64]bool)
+	for _, migration := range migrations {
+		if migration.ChainId <= 0 {
+			return fmt.Errorf("invalid chain id %d", migration.ChainId)
+		}
+		if migration.Amount.IsNil() || migration.Amount.IsZero() {
+			return fmt.Errorf("invalid migration amount for chain %d", migration.ChainId)
+		}
+		if chainIDs[migration.ChainId] {
+			return fmt.Errorf("duplicated migration for chain %d", migration.ChainId)
+		}
+		chainIDs[migration.ChainId] = true
+	}
+	return nil
+}
@@ -0,0 +1,1093 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: relayer/tss_rotation.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// stage of a tss rotation
+type TssRotationStage int32
+
+const (
+	// keygen scheduled, waiting for the new tss pubkey
+	TssRotationStage_TSS_ROTATION_KEYGEN TssRotationStage = 0
+	// inbound disabled, waiting for the pending nonces of the old tss to be processed
+	TssRotationStage_TSS_ROTATION_DRAINING TssRotationStage = 1
+	// fund migrations created, waiting for the migration xmsgs to be mined
+	TssRotationStage_TSS_ROTATION_MIGRATING TssRotationStage = 2
+	// new tss set as current tss
+	TssRotationStage_TSS_ROTATION_COMPLETED TssRotationStage = 3
+	// rotation stopped on a keygen or migration failure
+	TssRotationStage_TSS_ROTATION_FAILED TssRotationStage = 4
+	// rotation cancelled by the admin
+	TssRotationStage_TSS_ROTATION_CANCELLED TssRotationStage = 5
+)
+
+var TssRotationStage_name = map[int32]string{
+	0: "TSS_ROTATION_KEYGEN",
+	1: "TSS_ROTATION_DRAINING",
+	2: "TSS_ROTATION_MIGRATING",
+	3: "TSS_ROTATION_COMPLETED",
+	4: "TSS_ROTATION_FAILED",
+	5: "TSS_ROTATION_CANCELLED",
+}
+
+var TssRotationStage_value = map[string]int32{
+	"TSS_ROTATION_KEYGEN":    0,
+	"TSS_ROTATION_DRAINING":  1,
+	"TSS_ROTATION_MIGRATING": 2,
+	"TSS_ROTATION_COMPLETED": 3,
+	"TSS_ROTATION_FAILED":    4,
+	"TSS_ROTATION_CANCELLED": 5,
+}
+
+func (x TssRotationStage) String() string {
+	return proto.EnumName(TssRotationStage_name, int32(x))
+}
+
+func (TssRotationStage) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e08e40c840e3e14c, []int{0}
+}
+
+// amount of funds migrated from the old tss to the new tss on a chain
+type TssRotationMigration struct {
+	ChainId int64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// amount of gas token
+	Amount cosmossdk_io_math.Uint `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Uint" json:"amount"`
+	// index of the migration xmsg, set once the migration is created
+	MigrationXmsgIndex string `protobuf:"bytes,3,opt,name=migration_xmsg_index,json=migrationXmsgIndex,proto3" json:"migration_xmsg_index,omitempty"`
+	// amount of pell token, the pell token is not migrated if zero
+	PellAmount cosmossdk_io_math.Uint `protobuf:"bytes,4,opt,name=pell_amount,json=pellAmount,proto3,customtype=cosmossdk.io/math.Uint" json:"pell_amount"`
+	// index of the pell token migration xmsg, set once the migration is created
+	PellMigrationXmsgIndex string `protobuf:"bytes,5,opt,name=pell_migration_xmsg_index,json=pellMigrationXmsgIndex,proto3" json:"pell_migration_xmsg_index,omitempty"`
+}
+
+func (m *TssRotationMigration) Reset()         { *m = TssRotationMigration{} }
+func (m *TssRotationMigration) String() string { return proto.CompactTextString(m) }
+func (*TssRotationMigration) ProtoMessage()    {}
+func (*TssRotationMigration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e08e40c840e3e14c, []int{0}
+}
+func (m *TssRotationMigration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TssRotationMigration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TssRotationMigration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TssRotationMigration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TssRotationMigration.Merge(m, src)
+}
+func (m *TssRotationMigration) XXX_Size() int {
+	return m.Size()
+}
+func (m *TssRotationMigration) XXX_DiscardUnknown() {
+	xxx_messageInfo_TssRotationMigration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TssRotationMigration proto.InternalMessageInfo
+
+func (m *TssRotationMigration) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *TssRotationMigration) GetMigrationXmsgIndex() string {
+	if m != nil {
+		return m.MigrationXmsgIndex
+	}
+	return ""
+}
+
+func (m *TssRotationMigration) GetPellMigrationXmsgIndex() string {
+	if m != nil {
+		return m.PellMigrationXmsgIndex
+	}
+	return ""
+}
+
+// tss rotation progress
+type TssRotation struct {
+	Stage        TssRotationStage `protobuf:"varint,1,opt,name=stage,proto3,enum=relayer.TssRotationStage" json:"stage,omitempty"`
+	OldTssPubkey string           `protobuf:"bytes,2,opt,name=old_tss_pubkey,json=oldTssPubkey,proto3" json:"old_tss_pubkey,omitempty"`
+	NewTssPubkey string           `protobuf:"bytes,3,opt,name=new_tss_pubkey,json=newTssPubkey,proto3" json:"new_tss_pubkey,omitempty"`
+	KeygenBlock  int64            `protobuf:"varint,4,opt,name=keygen_block,json=keygenBlock,proto3" json:"keygen_block,omitempty"`
+	StartHeight  int64            `protobuf:"varint,5,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// height of the last stage transition
+	StageHeight int64                  `protobuf:"varint,6,opt,name=stage_height,json=stageHeight,proto3" json:"stage_height,omitempty"`
+	Migrations  []TssRotationMigration `protobuf:"bytes,7,rep,name=migrations,proto3" json:"migrations"`
+	// true if inbound was disabled by the rotation and must be enabled on completion
+	InboundDisabledByRotation bool `protobuf:"varint,8,opt,name=inbound_disabled_by_rotation,json=inboundDisabledByRotation,proto3" json:"inbound_disabled_by_rotation,omitempty"`
+	// reason of the failure or the last error preventing the rotation to progress
+	Message string `protobuf:"bytes,9,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *TssRotation) Reset()         { *m = TssRotation{} }
+func (m *TssRotation) String() string { return proto.CompactTextString(m) }
+func (*TssRotation) ProtoMessage()    {}
+func (*TssRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e08e40c840e3e14c, []int{1}
+}
+func (m *TssRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TssRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TssRotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TssRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TssRotation.Merge(m, src)
+}
+func (m *TssRotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *TssRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_TssRotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TssRotation proto.InternalMessageInfo
+
+func (m *TssRotation) GetStage() TssRotationStage {
+	if m != nil {
+		return m.Stage
+	}
+	return TssRotationStage_TSS_ROTATION_KEYGEN
+}
+
+func (m *TssRotation) GetOldTssPubkey() string {
+	if m != nil {
+		return m.OldTssPubkey
+	}
+	return ""
+}
+
+func (m *TssRotation) GetNewTssPubkey() string {
+	if m != nil {
+		return m.NewTssPubkey
+	}
+	return ""
+}
+
+func (m *TssRotation) GetKeygenBlock() int64 {
+	if m != nil {
+		return m.KeygenBlock
+	}
+	return 0
+}
+
+func (m *TssRotation) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *TssRotation) GetStageHeight() int64 {
+	if m != nil {
+		return m.StageHeight
+	}
+	return 0
+}
+
+func (m *TssRotation) GetMigrations() []TssRotationMigration {
+	if m != nil {
+		return m.Migrations
+	}
+	return nil
+}
+
+func (m *TssRotation) GetInboundDisabledByRotation() bool {
+	if m != nil {
+		return m.InboundDisabledByRotation
+	}
+	return false
+}
+
+func (m *TssRotation) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("relayer.TssRotationStage", TssRotationStage_name, TssRotationStage_value)
+	proto.RegisterType((*TssRotationMigration)(nil), "relayer.TssRotationMigration")
+	proto.RegisterType((*TssRotation)(nil), "relayer.TssRotation")
+}
+
+func init() { proto.RegisterFile("relayer/tss_rotation.proto", fileDescriptor_e08e40c840e3e14c) }
+
+var fileDescriptor_e08e40c840e3e14c = []byte{
+	// 596 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
+	0x1c, 0xc6, 0x9b, 0xa5, 0x5b, 0x37, 0x77, 0x9a, 0xaa, 0x30, 0x46, 0x5b, 0x41, 0x56, 0x26, 0x0e,
+	0x15, 0x87, 0x64, 0x1a, 0x12, 0x12, 0xa7, 0xa9, 0x5d, 0xcb, 0x16, 0xb1, 0x76, 0x93, 0x17, 0x24,
+	0xe0, 0x62, 0x25, 0x8d, 0xe5, 0x5a, 0x4d, 0xe2, 0x2a, 0x76, 0xb5, 0xf6, 0x2d, 0x90, 0x38, 0xf0,
+	0x02, 0x1c, 0x78, 0x05, 0xde, 0x60, 0xc7, 0x1d, 0x11, 0x87, 0x09, 0x6d, 0x2f, 0x82, 0xec, 0xa4,
+	0xa1, 0xa3, 0x3b, 0x70, 0x8b, 0xbf, 0xef, 0xf7, 0xf7, 0x27, 0x7f, 0x8e, 0x41, 0x3d, 0xc1, 0xa1,
+	0x37, 0xc3, 0x89, 0x2d, 0x38, 0x47, 0x09, 0x13, 0x9e, 0xa0, 0x2c, 0xb6, 0xc6, 0x09, 0x13, 0xcc,
+	0x28, 0x65, 0x5e, 0x7d, 0x9b, 0x30, 0xc2, 0x94, 0x66, 0xcb, 0xaf, 0xd4, 0xde, 0xfb, 0xba, 0x02,
+	0xb6, 0x5d, 0xce, 0x61, 0x36, 0xd4, 0xa3, 0x24, 0x51, 0x1f, 0x46, 0x0d, 0xac, 0x0f, 0x86, 0x1e,
+	0x8d, 0x11, 0x0d, 0xaa, 0x5a, 0x43, 0x6b, 0xea, 0xb0, 0xa4, 0xd6, 0x4e, 0x60, 0xbc, 0x06, 0x6b,
+	0x5e, 0xc4, 0x26, 0xb1, 0xa8, 0xae, 0x34, 0xb4, 0xe6, 0x46, 0xdb, 0xbc, 0xba, 0xd9, 0x2d, 0xfc,
+	0xba, 0xd9, 0xdd, 0x19, 0x30, 0x1e, 0x31, 0xce, 0x83, 0x91, 0x45, 0x99, 0x1d, 0x79, 0x62, 0x68,
+	0xbd, 0xa7, 0xb1, 0x80, 0x19, 0x6d, 0xec, 0x83, 0xed, 0x68, 0xbe, 0x3f, 0x9a, 0x46, 0x9c, 0x20,
+	0x1a, 0x07, 0x78, 0x5a, 0xd5, 0xe5, 0x2e, 0xd0, 0xc8, 0xbd, 0x0f, 0x11, 0x27, 0x8e, 0x74, 0x8c,
+	0x43, 0x50, 0x1e, 0xe3, 0x30, 0x44, 0x59, 0x5c, 0xf1, 0xbf, 0xe2, 0x80, 0x1c, 0x69, 0xa5, 0x91,
+	0x6f, 0x40, 0x4d, 0x6d, 0xf0, 0x60, 0xee, 0xaa, 0xca, 0xdd, 0x91, 0x40, 0x6f, 0x29, 0x7b, 0xef,
+	0x8b, 0x0e, 0xca, 0x0b, 0xcd, 0x18, 0x36, 0x58, 0xe5, 0xc2, 0x23, 0x58, 0xb5, 0xb1, 0x75, 0x50,
+	0xb3, 0xb2, 0x62, 0xad, 0x05, 0xe8, 0x42, 0x02, 0x30, 0xe5, 0x8c, 0x17, 0x60, 0x8b, 0x85, 0x01,
+	0x92, 0x77, 0x32, 0x9e, 0xf8, 0x23, 0x3c, 0x4b, 0xeb, 0x82, 0x9b, 0x2c, 0x0c, 0x5c, 0xce, 0xcf,
+	0x95, 0x26, 0xa9, 0x18, 0x5f, 0x2e, 0x52, 0x69, 0x1d, 0x9b, 0x31, 0xbe, 0xfc, 0x4b, 0x3d, 0x07,
+	0x9b, 0x23, 0x3c, 0x23, 0x38, 0x46, 0x7e, 0xc8, 0x06, 0x23, 0xd5, 0x84, 0x0e, 0xcb, 0xa9, 0xd6,
+	0x96, 0x92, 0x44, 0xb8, 0xf0, 0x12, 0x81, 0x86, 0x98, 0x92, 0xa1, 0x50, 0xa7, 0xd3, 0x61, 0x59,
+	0x69, 0x27, 0x4a, 0xca, 0x10, 0x82, 0xe7, 0xc8, 0x5a, 0x8e, 0x10, 0x9c, 0x21, 0x47, 0x00, 0xe4,
+	0x5d, 0xf1, 0x6a, 0xa9, 0xa1, 0x37, 0xcb, 0x07, 0xcf, 0x1e, 0x3a, 0x6a, 0xde, 0x58, 0xbb, 0x28,
+	0xef, 0x03, 0x2e, 0x8c, 0x19, 0x87, 0xe0, 0x29, 0x8d, 0x7d, 0x36, 0x89, 0x03, 0x14, 0x50, 0xee,
+	0xf9, 0x21, 0x0e, 0x90, 0x3f, 0xcb, 0xff, 0xcc, 0xea, 0x7a, 0x43, 0x6b, 0xae, 0xc3, 0x5a, 0xc6,
+	0x74, 0x32, 0xa4, 0x3d, 0xcb, 0xbb, 0xae, 0x82, 0x52, 0x84, 0x39, 0x97, 0x6d, 0x6f, 0xa8, 0x36,
+	0xe6, 0xcb, 0x97, 0x3f, 0x34, 0x50, 0xf9, 0xb7, 0x70, 0xe3, 0x09, 0x78, 0xe4, 0x5e, 0x5c, 0x20,
+	0x78, 0xe6, 0xb6, 0x5c, 0xe7, 0xac, 0x8f, 0xde, 0x75, 0x3f, 0x1e, 0x77, 0xfb, 0x95, 0x82, 0x51,
+	0x03, 0x8f, 0xef, 0x19, 0x1d, 0xd8, 0x72, 0xfa, 0x4e, 0xff, 0xb8, 0xa2, 0x19, 0x75, 0xb0, 0x73,
+	0xcf, 0xea, 0x39, 0xc7, 0xb0, 0xe5, 0x4a, 0x6f, 0x65, 0xc9, 0x3b, 0x3a, 0xeb, 0x9d, 0x9f, 0x76,
+	0xdd, 0x6e, 0xa7, 0xa2, 0x2f, 0x65, 0xbd, 0x6d, 0x39, 0xa7, 0xdd, 0x4e, 0xa5, 0xb8, 0x3c, 0xd4,
+	0xea, 0x1f, 0x75, 0x4f, 0xa5, 0xb7, 0x5a, 0x2f, 0x7e, 0xff, 0x66, 0x6a, 0xed, 0x93, 0xab, 0x5b,
+	0x53, 0xbb, 0xbe, 0x35, 0xb5, 0xdf, 0xb7, 0xa6, 0xf6, 0xf9, 0xce, 0x2c, 0x5c, 0xdf, 0x99, 0x85,
+	0x9f, 0x77, 0x66, 0xe1, 0x93, 0x45, 0xa8, 0x18, 0x4e, 0x7c, 0x6b, 0xc0, 0x22, 0x7b, 0x7f, 0x7a,
+	0x8e, 0xc3, 0xb0, 0x8f, 0xc5, 0x25, 0x4b, 0x46, 0xb6, 0x87, 0x09, 0xe5, 0xf6, 0xd4, 0xce, 0x5f,
+	0xf8, 0x6c, 0x8c, 0xb9, 0xbf, 0xa6, 0x1e, 0xef, 0xab, 0x3f, 0x03, 0x00, 0xa9, 0xde, 0xa2, 0xa6,
+	0xf9, 0x03, 0x00, 0x00,
+}
+
+func (m *TssRotationMigration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TssRotationMigration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TssRotationMigration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PellMigrationXmsgIndex) > 0 {
+		i -= len(m.PellMigrationXmsgIndex)
+		copy(dAtA[i:], m.PellMigrationXmsgIndex)
+		i = encodeVarintTssRotation(dAtA, i, uint64(len(m.PellMigrationXmsgIndex)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.PellAmount.Size()
+		i -= size
+		if _, err := m.PellAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTssRotation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.MigrationXmsgIndex) > 0 {
+		i -= len(m.MigrationXmsgIndex)
+		copy(dAtA[i:], m.MigrationXmsgIndex)
+		i = encodeVarintTssRotation(dAtA, i, uint64(len(m.MigrationXmsgIndex)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTssRotation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ChainId != 0 {
+		i = encodeVarintTssRotation(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TssRotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TssRotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TssRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintTssRotation(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.InboundDisabledByRotation {
+		i--
+		if m.InboundDisabledByRotation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Migrations) > 0 {
+		for iNdEx := len(m.Migrations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Migrations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTssRotation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.StageHeight != 0 {
+		i = encodeVarintTssRotation(dAtA, i, uint64(m.StageHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintTssRotation(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.KeygenBlock != 0 {
+		i = encodeVarintTssRotation(dAtA, i, uint64(m.KeygenBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NewTssPubkey) > 0 {
+		i -= len(m.NewTssPubkey)
+		copy(dAtA[i:], m.NewTssPubkey)
+		i = encodeVarintTssRotation(dAtA, i, uint64(len(m.NewTssPubkey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OldTssPubkey) > 0 {
+		i -= len(m.OldTssPubkey)
+		copy(dAtA[i:], m.OldTssPubkey)
+		i = encodeVarintTssRotation(dAtA, i, uint64(len(m.OldTssPubkey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Stage != 0 {
+		i = encodeVarintTssRotation(dAtA, i, uint64(m.Stage))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTssRotation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTssRotation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TssRotationMigration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovTssRotation(uint64(m.ChainId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTssRotation(uint64(l))
+	l = len(m.MigrationXmsgIndex)
+	if l > 0 {
+		n += 1 + l + sovTssRotation(uint64(l))
+	}
+	l = m.PellAmount.Size()
+	n += 1 + l + sovTssRotation(uint64(l))
+	l = len(m.PellMigrationXmsgIndex)
+	if l > 0 {
+		n += 1 + l + sovTssRotation(uint64(l))
+	}
+	return n
+}
+
+func (m *TssRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Stage != 0 {
+		n += 1 + sovTssRotation(uint64(m.Stage))
+	}
+	l = len(m.OldTssPubkey)
+	if l > 0 {
+		n += 1 + l + sovTssRotation(uint64(l))
+	}
+	l = len(m.NewTssPubkey)
+	if l > 0 {
+		n += 1 + l + sovTssRotation(uint64(l))
+	}
+	if m.KeygenBlock != 0 {
+		n += 1 + sovTssRotation(uint64(m.KeygenBlock))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovTssRotation(uint64(m.StartHeight))
+	}
+	if m.StageHeight != 0 {
+		n += 1 + sovTssRotation(uint64(m.StageHeight))
+	}
+	if len(m.Migrations) > 0 {
+		for _, e := range m.Migrations {
+			l = e.Size()
+			n += 1 + l + sovTssRotation(uint64(l))
+		}
+	}
+	if m.InboundDisabledByRotation {
+		n += 2
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovTssRotation(uint64(l))
+	}
+	return n
+}
+
+func sovTssRotation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTssRotation(x uint64) (n int) {
+	return sovTssRotation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TssRotationMigration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTssRotation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TssRotationMigration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TssRotationMigration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTssRotation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTssRotation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTssRotation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTssRotation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationXmsgIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTssRotation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTssRotation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTssRotation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MigrationXmsgIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PellAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTssRotation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTssRotation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTssRotation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PellAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PellMigrationXmsgIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTssRotation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTssRotation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTssRotation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PellMigrationXmsgIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTssRotation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTssRotation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TssRotation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTssRotation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TssRotation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TssRotation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
+			}
+			m.Stage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTssRotation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Stage |= TssRotationStage(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldTssPubkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTssRotation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTssRotation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTssRotation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldTssPubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewTssPubkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTssRotation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTssRotation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTssRotation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewTssPubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeygenBlock", wireType)
+			}
+			m.KeygenBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTssRotation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeygenBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTssRotation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StageHeight", wireType)
+			}
+			m.StageHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTssRotation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StageHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Migrations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTssRotation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTssRotation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTssRotation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Migrations = append(m.Migrations, TssRotationMigration{})
+			if err := m.Migrations[len(m.Migrations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundDisabledByRotation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTssRotation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InboundDisabledByRotation = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTssRotation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTssRotation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTssRotation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTssRotation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTssRotation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTssRotation(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTssRotation
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTssRotation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTssRotation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTssRotation
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTssRotation
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTssRotation
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTssRotation        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTssRotation          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTssRotation = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,76 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/x/relayer/types"
+)
+
+func TestTssRotation_IsInProgress(t *testing.T) {
+	tests := []struct {
+		stage       types.TssRotationStage
+		inProgress  bool
+		cancellable bool
+	}{
+		{types.TssRotationStage_TSS_ROTATION_KEYGEN, true, true},
+		{types.TssRotationStage_TSS_ROTATION_DRAINING, true, true},
+		{types.TssRotationStage_TSS_ROTATION_MIGRATING, true, false},
+		{types.TssRotationStage_TSS_ROTATION_COMPLETED, false, false},
+		{types.TssRotationStage_TSS_ROTATION_FAILED, false, false},
+		{types.TssRotationStage_TSS_ROTATION_CANCELLED, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.stage.String(), func(t *testing.T) {
+			rotation := types.TssRotation{Stage: tt.stage}
+			require.Equal(t, tt.inProgress, rotation.IsInProgress())
+			require.Equal(t, tt.cancellable, rotation.IsCancellable())
+		})
+	}
+}
+
+func TestTssRotation_SetStage(t *testing.T) {
+	rotation := types.TssRotation{Message: "keygen scheduled"}
+	rotation.SetStage(types.TssRotationStage_TSS_ROTATION_DRAINING, 42, "draining")
+	require.Equal(t, types.TssRotationStage_TSS_ROTATION_DRAINING, rotation.Stage)
+	require.EqualValues(t, 42, rotation.StageHeight)
+	require.Equal(t, "draining", rotation.Message)
+}
+
+func TestTssRotation_GetMigration(t *testing.T) {
+	rotation := types.TssRotation{Migrations: []types.TssRotationMigration{
+		{ChainId: 1, Amount: sdkmath.NewUint(10)},
+		{ChainId: 2, Amount: sdkmath.NewUint(20)},
+	}}
+	migration, found := rotation.GetMigration(2)
+	require.True(t, found)
+	require.Equal(t, sdkmath.NewUint(20), migration.Amount)
+
+	_, found = rotation.GetMigration(3)
+	require.False(t, found)
+}
+
+func TestTssRotation_Validate(t *testing.T) {
+	require.NoError(t, types.TssRotation{}.Validate())
+	require.NoError(t, types.TssRotation{Migrations: []types.TssRotationMigration{
+		{ChainId: 1, Amount: sdkmath.NewUint(10)},
+		{ChainId: 2, Amount: sdkmath.NewUint(20)},
+	}}.Validate())
+
+	require.Error(t, types.TssRotation{Stage: 100}.Validate())
+	require.Error(t, types.TssRotation{Migrations: []types.TssRotationMigration{
+		{ChainId: 0, Amount: sdkmath.NewUint(10)},
+	}}.Validate())
+	require.Error(t, types.TssRotation{Migrations: []types.TssRotationMigration{
+		{ChainId: 1, Amount: sdkmath.ZeroUint()},
+	}}.Validate())
+	require.Error(t, types.TssRotation{Migrations: []types.TssRotationMigration{
+		{ChainId: 1},
+	}}.Validate())
+	require.Error(t, types.TssRotation{Migrations: []types.TssRotationMigration{
+		{ChainId: 1, Amount: sdkmath.NewUint(10)},
+		{ChainId: 1, Amount: sdkmath.NewUint(20)},
+	}}.Validate())
+}
@@ -1094,25 +1094,24 @@ func (m *MsgVoteTSSResponse) GetKeygenSuccess() bool {
 	return false
 }
 
-// TODO: remove this after the upgrade
-// MsgDeleteBallot represents the message to delete a ballot
-type MsgDeleteBallot struct {
-	Signer      string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
-	BallotIndex string `protobuf:"bytes,2,opt,name=ballot_index,json=ballotIndex,proto3" json:"ballot_index,omitempty"`
+// MsgAddChain represents the message to add a chain to the chain registry
+type MsgAddChain struct {
+	Signer string       `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Chain  chains.Chain `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain"`
 }
 
-func (m *MsgDeleteBallot) Reset()         { *m = MsgDeleteBallot{} }
-func (m *MsgDeleteBallot) String() string { return proto.CompactTextString(m) }
-func (*MsgDeleteBallot) ProtoMessage()    {}
-func (*MsgDeleteBallot) Descriptor() ([]byte, []int) {
+func (m *MsgAddChain) Reset()         { *m = MsgAddChain{} }
+func (m *MsgAddChain) String() string { return proto.CompactTextString(m) }
+func (*MsgAddChain) ProtoMessage()    {}
+func (*MsgAddChain) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d5bacb8239055328, []int{20}
 }
-func (m *MsgDeleteBallot) XXX_Unmarshal(b []byte) error {
+func (m *MsgAddChain) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteBallot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAddChain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteBallot.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAddChain.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1122,49 +1121,48 @@ func (m *MsgDeleteBallot) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteBallot) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteBallot.Merge(m, src)
+func (m *MsgAddChain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddChain.Merge(m, src)
 }
-func (m *MsgDeleteBallot) XXX_Size() int {
+func (m *MsgAddChain) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteBallot) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteBallot.DiscardUnknown(m)
+func (m *MsgAddChain) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddChain.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteBallot proto.InternalMessageInfo
+var xxx_messageInfo_MsgAddChain proto.InternalMessageInfo
 
-func (m *MsgDeleteBallot) GetSigner() string {
+func (m *MsgAddChain) GetSigner() string {
 	if m != nil {
 		return m.Signer
 	}
 	return ""
 }
 
-func (m *MsgDeleteBallot) GetBallotIndex() string {
+func (m *MsgAddChain) GetChain() chains.Chain {
 	if m != nil {
-		return m.BallotIndex
+		return m.Chain
 	}
-	return ""
+	return chains.Chain{}
 }
 
-// TODO: remove this after the upgrade
-// MsgDeleteBallotResponse represents the response to delete a ballot
-type MsgDeleteBallotResponse struct {
+// MsgAddChainResponse represents the response to add a chain
+type MsgAddChainResponse struct {
 }
 
-func (m *MsgDeleteBallotResponse) Reset()         { *m = MsgDeleteBallotResponse{} }
-func (m *MsgDeleteBallotResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgDeleteBallotResponse) ProtoMessage()    {}
-func (*MsgDeleteBallotResponse) Descriptor() ([]byte, []int) {
+func (m *MsgAddChainResponse) Reset()         { *m = MsgAddChainResponse{} }
+func (m *MsgAddChainResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddChainResponse) ProtoMessage()    {}
+func (*MsgAddChainResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d5bacb8239055328, []int{21}
 }
-func (m *MsgDeleteBallotResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgAddChainResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteBallotResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAddChainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteBallotResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAddChainResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1174,1781 +1172,4849 @@ func (m *MsgDeleteBallotResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteBallotResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteBallotResponse.Merge(m, src)
+func (m *MsgAddChainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddChainResponse.Merge(m, src)
 }
-func (m *MsgDeleteBallotResponse) XXX_Size() int {
+func (m *MsgAddChainResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteBallotResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteBallotResponse.DiscardUnknown(m)
+func (m *MsgAddChainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddChainResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteBallotResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgAddChainResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdateObserver)(nil), "relayer.MsgUpdateObserver")
-	proto.RegisterType((*MsgUpdateObserverResponse)(nil), "relayer.MsgUpdateObserverResponse")
-	proto.RegisterType((*MsgVoteBlockHeader)(nil), "relayer.MsgVoteBlockHeader")
-	proto.RegisterType((*MsgVoteBlockHeaderResponse)(nil), "relayer.MsgVoteBlockHeaderResponse")
-	proto.RegisterType((*MsgUpsertChainParams)(nil), "relayer.MsgUpsertChainParams")
-	proto.RegisterType((*MsgUpsertChainParamsResponse)(nil), "relayer.MsgUpsertChainParamsResponse")
-	proto.RegisterType((*MsgRemoveChainParams)(nil), "relayer.MsgRemoveChainParams")
-	proto.RegisterType((*MsgRemoveChainParamsResponse)(nil), "relayer.MsgRemoveChainParamsResponse")
-	proto.RegisterType((*MsgAddObserver)(nil), "relayer.MsgAddObserver")
-	proto.RegisterType((*MsgAddObserverResponse)(nil), "relayer.MsgAddObserverResponse")
-	proto.RegisterType((*MsgAddBlameVote)(nil), "relayer.MsgAddBlameVote")
-	proto.RegisterType((*MsgAddBlameVoteResponse)(nil), "relayer.MsgAddBlameVoteResponse")
-	proto.RegisterType((*MsgUpsertCrosschainFlags)(nil), "relayer.MsgUpsertCrosschainFlags")
-	proto.RegisterType((*MsgUpsertCrosschainFlagsResponse)(nil), "relayer.MsgUpsertCrosschainFlagsResponse")
-	proto.RegisterType((*MsgUpdateKeygen)(nil), "relayer.MsgUpdateKeygen")
-	proto.RegisterType((*MsgUpdateKeygenResponse)(nil), "relayer.MsgUpdateKeygenResponse")
-	proto.RegisterType((*MsgResetChainNonces)(nil), "relayer.MsgResetChainNonces")
-	proto.RegisterType((*MsgResetChainNoncesResponse)(nil), "relayer.MsgResetChainNoncesResponse")
-	proto.RegisterType((*MsgVoteTSS)(nil), "relayer.MsgVoteTSS")
-	proto.RegisterType((*MsgVoteTSSResponse)(nil), "relayer.MsgVoteTSSResponse")
-	proto.RegisterType((*MsgDeleteBallot)(nil), "relayer.MsgDeleteBallot")
-	proto.RegisterType((*MsgDeleteBallotResponse)(nil), "relayer.MsgDeleteBallotResponse")
+// MsgUpdateChain represents the message to update a chain in the chain
+// registry
+type MsgUpdateChain struct {
+	Signer string       `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Chain  chains.Chain `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain"`
 }
 
-func init() { proto.RegisterFile("relayer/tx.proto", fileDescriptor_d5bacb8239055328) }
-
-var fileDescriptor_d5bacb8239055328 = []byte{
-	// 1292 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4b, 0x6f, 0xe3, 0xd4,
-	0x17, 0xaf, 0x27, 0x33, 0x7d, 0xdc, 0xb4, 0x69, 0xea, 0xa6, 0x6d, 0xea, 0xb6, 0x69, 0x27, 0xff,
-	0x7f, 0x51, 0x07, 0x8d, 0x92, 0x69, 0x06, 0x09, 0x69, 0x58, 0xb5, 0x33, 0x4c, 0x5b, 0x98, 0x3e,
-	0xe4, 0x94, 0xa2, 0x61, 0x63, 0xdd, 0xd8, 0x27, 0x8e, 0x89, 0xe3, 0x1b, 0xf9, 0x3a, 0x69, 0xc3,
-	0x92, 0x05, 0x12, 0x62, 0xc3, 0xb7, 0x60, 0x0b, 0x1f, 0x81, 0xdd, 0xec, 0x98, 0x25, 0x2b, 0x40,
-	0x2d, 0x12, 0x5f, 0x03, 0xdd, 0x87, 0x5d, 0x3b, 0x89, 0x3b, 0x08, 0x89, 0x95, 0xe3, 0xf3, 0xfb,
-	0xdd, 0x73, 0xcf, 0xfb, 0x38, 0x28, 0xef, 0x83, 0x8b, 0x07, 0xe0, 0x57, 0x83, 0xab, 0x4a, 0xd7,
-	0x27, 0x01, 0x51, 0xa7, 0xa4, 0x44, 0x5b, 0x31, 0x09, 0xed, 0x10, 0x5a, 0xed, 0x50, 0xbb, 0xda,
-	0xdf, 0x65, 0x0f, 0xc1, 0xd0, 0x0a, 0x36, 0xb1, 0x09, 0xff, 0x59, 0x65, 0xbf, 0xa4, 0x74, 0xa5,
-	0xdb, 0xb6, 0xab, 0x66, 0x0b, 0x3b, 0x1e, 0x95, 0x8f, 0x38, 0xd0, 0xf5, 0x09, 0x69, 0x52, 0xf9,
-	0x90, 0xc0, 0x62, 0x78, 0x77, 0xc3, 0xc5, 0x1d, 0x90, 0xc2, 0x52, 0x28, 0x34, 0x7d, 0x42, 0x29,
-	0x57, 0x64, 0x34, 0x5d, 0x6c, 0x87, 0x87, 0x0a, 0x21, 0xde, 0xc5, 0x3e, 0xee, 0x84, 0xd2, 0xa5,
-	0x50, 0x2a, 0x9f, 0x42, 0x5c, 0xfe, 0x5d, 0x41, 0x0b, 0xc7, 0xd4, 0xfe, 0xac, 0x6b, 0xe1, 0x00,
-	0x4e, 0x1b, 0x14, 0xfc, 0x3e, 0xf8, 0xea, 0x32, 0x9a, 0xa4, 0x8e, 0xed, 0x81, 0x5f, 0x54, 0xb6,
-	0x94, 0x9d, 0x19, 0x5d, 0xbe, 0xa9, 0x4f, 0x50, 0x81, 0xb8, 0x96, 0x41, 0x24, 0xcf, 0xc0, 0x96,
-	0xe5, 0x03, 0xa5, 0xc5, 0x7b, 0x9c, 0xa5, 0x12, 0xd7, 0x0a, 0x55, 0xec, 0x09, 0x84, 0x9d, 0xf0,
-	0xe0, 0x72, 0xf4, 0x44, 0x46, 0x9c, 0xf0, 0xe0, 0x72, 0xf8, 0xc4, 0x1e, 0x9a, 0xeb, 0x71, 0x6b,
-	0x0c, 0x1f, 0x30, 0x25, 0x5e, 0xf1, 0xfe, 0x96, 0xb2, 0x93, 0xab, 0xad, 0x57, 0x42, 0xc3, 0x75,
-	0xf1, 0x14, 0x26, 0xeb, 0x9c, 0xa3, 0xcf, 0xf6, 0x62, 0x6f, 0xcf, 0xb2, 0x5f, 0xff, 0xf5, 0xe3,
-	0xfb, 0xd2, 0xe6, 0xf2, 0x1a, 0x5a, 0x1d, 0x71, 0x50, 0x07, 0xda, 0x25, 0x1e, 0x85, 0xf2, 0xcf,
-	0x0a, 0x52, 0x8f, 0xa9, 0x7d, 0x41, 0x02, 0xd8, 0x77, 0x89, 0xd9, 0x3e, 0x04, 0x6c, 0x81, 0xaf,
-	0xae, 0xa2, 0x69, 0x11, 0x57, 0xc7, 0xe2, 0x11, 0xc8, 0xe8, 0x53, 0xfc, 0xfd, 0xc8, 0x52, 0x37,
-	0x10, 0x6a, 0x30, 0xa6, 0xd1, 0xc2, 0xb4, 0xc5, 0x1d, 0x9f, 0xd5, 0x67, 0xb8, 0xe4, 0x10, 0xd3,
-	0x16, 0x8b, 0x5c, 0x0b, 0x1c, 0xbb, 0x15, 0x70, 0x0f, 0x33, 0xba, 0x7c, 0x53, 0x3f, 0x60, 0x72,
-	0xa6, 0x9b, 0xbb, 0x93, 0xad, 0x2d, 0x57, 0xba, 0x6d, 0x5e, 0x2d, 0x2c, 0xd9, 0xe2, 0xd6, 0x17,
-	0x38, 0xc0, 0xfb, 0xf7, 0xdf, 0xfc, 0xb6, 0x39, 0xa1, 0x4b, 0x6e, 0x2c, 0x0f, 0x0f, 0xe2, 0x79,
-	0x48, 0x3a, 0xf8, 0x25, 0xd2, 0x46, 0x5d, 0x08, 0x3d, 0x54, 0xb7, 0x51, 0xae, 0x81, 0x5d, 0x97,
-	0x04, 0x86, 0xe9, 0x03, 0x0e, 0x40, 0x38, 0x34, 0xad, 0xcf, 0x09, 0xe9, 0x73, 0x21, 0x64, 0xb4,
-	0x3e, 0x09, 0xc0, 0x68, 0x3a, 0x1e, 0x76, 0x9d, 0xaf, 0xc0, 0xe2, 0xae, 0x4d, 0xeb, 0x73, 0x4c,
-	0xfa, 0x32, 0x14, 0x96, 0x03, 0x54, 0xe0, 0xc1, 0xa4, 0xe0, 0x07, 0xcf, 0x59, 0x44, 0xce, 0x78,
-	0x8d, 0xa5, 0x16, 0xcc, 0x87, 0x68, 0x56, 0x04, 0x52, 0xd4, 0x22, 0x57, 0x9a, 0xad, 0x15, 0xa2,
-	0x5c, 0xc6, 0x74, 0xe8, 0x59, 0xf3, 0xf6, 0x25, 0xe9, 0x61, 0x09, 0xad, 0x8f, 0xbb, 0x35, 0xca,
-	0xe2, 0x05, 0xb7, 0x4a, 0x87, 0x0e, 0xe9, 0xc3, 0x3f, 0xb1, 0x2a, 0x9e, 0xde, 0x7b, 0x89, 0xf4,
-	0x8e, 0xbb, 0x77, 0x44, 0x6f, 0x74, 0xef, 0x2f, 0x0a, 0xca, 0x1d, 0x53, 0x7b, 0xcf, 0xb2, 0xde,
-	0xd9, 0x39, 0x8f, 0x50, 0x3e, 0xa5, 0x6b, 0xe6, 0xc9, 0x50, 0x03, 0x3c, 0x43, 0xab, 0x5d, 0x70,
-	0x5d, 0xd3, 0x75, 0xc0, 0x0b, 0x0c, 0xdb, 0xc7, 0x5e, 0x00, 0x60, 0x74, 0x7b, 0x8d, 0x36, 0x0c,
-	0x64, 0xdf, 0xac, 0xdc, 0x12, 0x0e, 0x04, 0x7e, 0xc6, 0x61, 0x75, 0x17, 0x2d, 0x61, 0xcb, 0x32,
-	0x3c, 0x62, 0x81, 0x81, 0x4d, 0x93, 0xf4, 0xbc, 0xc0, 0x20, 0x9e, 0x3b, 0xe0, 0x55, 0x37, 0xad,
-	0xab, 0xd8, 0xb2, 0x4e, 0x88, 0x05, 0x7b, 0x02, 0x3a, 0xf5, 0xdc, 0x41, 0xd2, 0xe3, 0x22, 0x5a,
-	0x4e, 0x3a, 0x14, 0xf9, 0xfa, 0x8d, 0x82, 0xe6, 0x05, 0xb4, 0xcf, 0x66, 0x11, 0x2b, 0xb7, 0xbb,
-	0xda, 0xe4, 0x29, 0x6b, 0x13, 0xdc, 0x01, 0xc3, 0xf1, 0x9a, 0x44, 0xa6, 0x3d, 0x17, 0xa5, 0x9d,
-	0xab, 0x90, 0xb5, 0x3e, 0xc3, 0x79, 0x47, 0x5e, 0x93, 0xc4, 0x82, 0x97, 0x49, 0x2f, 0xf7, 0x55,
-	0xb4, 0x32, 0x64, 0x47, 0x64, 0xe3, 0x9f, 0xf7, 0x50, 0xf1, 0xb6, 0x50, 0xa2, 0xe9, 0xf8, 0x92,
-	0x0d, 0xc7, 0xd4, 0xcc, 0x3c, 0x46, 0xaa, 0x43, 0x0d, 0xc7, 0x6b, 0x90, 0x9e, 0x67, 0x19, 0xe0,
-	0xe1, 0x86, 0x1b, 0x55, 0x7f, 0xde, 0xa1, 0x47, 0x02, 0xf8, 0x58, 0xc8, 0xd5, 0x0a, 0x5a, 0x74,
-	0xa8, 0x41, 0x7a, 0x41, 0x92, 0x9e, 0xe1, 0xf4, 0x05, 0x87, 0x9e, 0x4a, 0x24, 0xe4, 0x7f, 0x8e,
-	0x8a, 0x36, 0xa6, 0x46, 0xd7, 0x77, 0x4c, 0x16, 0x0b, 0xd6, 0x83, 0x14, 0xc4, 0xb8, 0x96, 0x93,
-	0xa0, 0x14, 0x45, 0xe5, 0x00, 0xd3, 0x33, 0xc6, 0x3b, 0x92, 0x34, 0x6e, 0xb7, 0xbe, 0x64, 0x8f,
-	0x13, 0xab, 0x2e, 0xda, 0x94, 0x73, 0x88, 0xf7, 0xbb, 0xd1, 0x07, 0xdf, 0x69, 0x3a, 0x26, 0x0e,
-	0x1c, 0x22, 0xd7, 0x01, 0x9f, 0x19, 0xd9, 0xda, 0x76, 0x2c, 0xea, 0xd1, 0x78, 0xb8, 0x88, 0xb1,
-	0xc5, 0x35, 0xeb, 0x8d, 0x3b, 0xd0, 0x64, 0x06, 0xca, 0x68, 0x2b, 0x2d, 0xca, 0x51, 0x2a, 0x5e,
-	0xf1, 0x6a, 0x11, 0x53, 0xf7, 0x53, 0x18, 0xd8, 0xe0, 0xa5, 0x26, 0xa0, 0x80, 0x1e, 0xf0, 0xbb,
-	0x65, 0x2b, 0x8a, 0x97, 0x71, 0x39, 0x8f, 0x6b, 0x8b, 0x2e, 0xfa, 0x41, 0x41, 0x8b, 0xbc, 0x49,
-	0x29, 0x88, 0xd9, 0x70, 0x42, 0x3c, 0x13, 0xfe, 0x4d, 0xef, 0xab, 0xef, 0xa1, 0x79, 0x01, 0x79,
-	0x4c, 0x85, 0xe1, 0x92, 0x4b, 0x39, 0xc4, 0xe7, 0xcc, 0x48, 0xf1, 0x2b, 0x72, 0xa9, 0xee, 0xa0,
-	0x7c, 0x9c, 0xd7, 0x72, 0xec, 0x16, 0xcf, 0x65, 0x46, 0xcf, 0xdd, 0x12, 0x0f, 0x1d, 0xbb, 0x95,
-	0x74, 0x62, 0x03, 0xad, 0x8d, 0x31, 0x34, 0x72, 0xe4, 0x27, 0x05, 0x21, 0x39, 0xc7, 0xcf, 0xeb,
-	0x75, 0xb6, 0x67, 0x02, 0x4a, 0xc3, 0xb6, 0x17, 0x3e, 0xcc, 0x04, 0x94, 0xca, 0x46, 0x7f, 0x8c,
-	0xd4, 0x36, 0x0f, 0x84, 0xc1, 0x46, 0x81, 0x21, 0x77, 0x8e, 0x70, 0x28, 0x2f, 0x90, 0x33, 0x70,
-	0xdd, 0x43, 0xb1, 0x7d, 0x76, 0xd1, 0x24, 0x0d, 0x70, 0xd0, 0x13, 0x7b, 0x37, 0x57, 0x5b, 0xe5,
-	0xdb, 0x47, 0x7e, 0x83, 0xe8, 0x60, 0x82, 0xd3, 0x87, 0x3a, 0x27, 0xe8, 0x92, 0x18, 0x8b, 0xdf,
-	0xfd, 0xf4, 0x5e, 0xfc, 0xf6, 0x76, 0x7d, 0x9e, 0xd7, 0xeb, 0xff, 0xcd, 0xce, 0x61, 0x34, 0xe9,
-	0x2a, 0xed, 0x99, 0x66, 0xf8, 0xf1, 0x30, 0xad, 0xcf, 0x09, 0x69, 0x5d, 0x08, 0xcb, 0xaf, 0x79,
-	0xc5, 0xbd, 0x00, 0x17, 0x02, 0xd8, 0xe7, 0xf7, 0xa4, 0xd6, 0xc0, 0x43, 0x34, 0x2b, 0xed, 0x73,
-	0x3c, 0x0b, 0xae, 0xe4, 0x20, 0xce, 0x0a, 0xd9, 0x11, 0x13, 0x8d, 0x2b, 0xbf, 0xb8, 0xea, 0xd0,
-	0xd5, 0xda, 0x77, 0x53, 0x28, 0x73, 0x4c, 0x6d, 0xf5, 0x00, 0x65, 0xe3, 0x6b, 0x60, 0x25, 0x6a,
-	0xba, 0xe4, 0x38, 0xd5, 0x36, 0x53, 0x80, 0x28, 0x76, 0x67, 0x28, 0x37, 0xf4, 0x31, 0xa6, 0xc5,
-	0x8f, 0x24, 0x31, 0xad, 0x9c, 0x8e, 0x45, 0x1a, 0x5f, 0xa3, 0x85, 0xd1, 0x85, 0xbd, 0x91, 0x3c,
-	0x38, 0x04, 0x6b, 0xdb, 0x77, 0xc2, 0x71, 0xd5, 0xa3, 0x5b, 0x37, 0xa1, 0x7a, 0x04, 0x4e, 0xaa,
-	0x4e, 0xdd, 0xad, 0xea, 0x27, 0x68, 0x36, 0xb1, 0x6b, 0x8a, 0x43, 0x81, 0x8b, 0x10, 0x6d, 0x2b,
-	0x0d, 0x89, 0x74, 0x01, 0x5a, 0x1a, 0xbf, 0x13, 0x1e, 0x8e, 0x71, 0x33, 0x49, 0xd1, 0x1e, 0xbd,
-	0x93, 0x12, 0x37, 0x39, 0x31, 0xf0, 0x8a, 0xa3, 0xc9, 0x11, 0x48, 0xd2, 0xe4, 0x71, 0x63, 0x4d,
-	0xbd, 0x40, 0xf9, 0x91, 0x91, 0xb6, 0x9e, 0x8c, 0x5c, 0x12, 0xd5, 0xfe, 0x7f, 0x17, 0x1a, 0xe9,
-	0xad, 0xa3, 0xf9, 0xe1, 0x8f, 0xdd, 0xb5, 0xf8, 0xc1, 0x21, 0x50, 0xfb, 0xdf, 0x1d, 0x60, 0xa4,
-	0xf4, 0x23, 0x34, 0x15, 0x8e, 0xad, 0xc5, 0x61, 0xfe, 0x79, 0xbd, 0xae, 0xad, 0x8d, 0x11, 0xc6,
-	0xa3, 0x96, 0x68, 0xda, 0x44, 0xd4, 0xe2, 0x48, 0x32, 0x6a, 0xe3, 0xba, 0x71, 0xff, 0xf0, 0xcd,
-	0x75, 0x49, 0x79, 0x7b, 0x5d, 0x52, 0xfe, 0xb8, 0x2e, 0x29, 0xdf, 0xdf, 0x94, 0x26, 0xde, 0xde,
-	0x94, 0x26, 0x7e, 0xbd, 0x29, 0x4d, 0x7c, 0x51, 0xb1, 0x9d, 0xa0, 0xd5, 0x6b, 0x54, 0x4c, 0xd2,
-	0xa9, 0x3e, 0xb9, 0x62, 0xa3, 0xf1, 0x04, 0x82, 0x4b, 0xe2, 0xb7, 0xab, 0x18, 0x6c, 0x87, 0x56,
-	0xaf, 0xaa, 0xd1, 0xdf, 0xbc, 0x41, 0x17, 0x68, 0x63, 0x92, 0xff, 0x3d, 0x7a, 0xfa, 0x77, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xce, 0x2b, 0x19, 0xda, 0xfe, 0x0d, 0x00, 0x00,
+func (m *MsgUpdateChain) Reset()         { *m = MsgUpdateChain{} }
+func (m *MsgUpdateChain) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateChain) ProtoMessage()    {}
+func (*MsgUpdateChain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5bacb8239055328, []int{22}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// AddObserver adds an observer
-	AddObserver(ctx context.Context, in *MsgAddObserver, opts ...grpc.CallOption) (*MsgAddObserverResponse, error)
-	// UpdateObserver updates an observer
-	UpdateObserver(ctx context.Context, in *MsgUpdateObserver, opts ...grpc.CallOption) (*MsgUpdateObserverResponse, error)
-	// UpsertChainParams upserts chain parameters
-	UpsertChainParams(ctx context.Context, in *MsgUpsertChainParams, opts ...grpc.CallOption) (*MsgUpsertChainParamsResponse, error)
-	// RemoveChainParams removes chain parameters
-	RemoveChainParams(ctx context.Context, in *MsgRemoveChainParams, opts ...grpc.CallOption) (*MsgRemoveChainParamsResponse, error)
-	// AddBlameVote adds a blame vote
-	AddBlameVote(ctx context.Context, in *MsgAddBlameVote, opts ...grpc.CallOption) (*MsgAddBlameVoteResponse, error)
-	// UpsertCrosschainFlags upserts crosschain flags
-	UpsertCrosschainFlags(ctx context.Context, in *MsgUpsertCrosschainFlags, opts ...grpc.CallOption) (*MsgUpsertCrosschainFlagsResponse, error)
-	// UpdateKeygen updates keygen
-	UpdateKeygen(ctx context.Context, in *MsgUpdateKeygen, opts ...grpc.CallOption) (*MsgUpdateKeygenResponse, error)
-	// ResetChainNonces resets chain nonces
-	ResetChainNonces(ctx context.Context, in *MsgResetChainNonces, opts ...grpc.CallOption) (*MsgResetChainNoncesResponse, error)
-	// VoteBlockHeader votes for a block header
-	VoteBlockHeader(ctx context.Context, in *MsgVoteBlockHeader, opts ...grpc.CallOption) (*MsgVoteBlockHeaderResponse, error)
-	// VoteTSS votes for TSS
-	VoteTSS(ctx context.Context, in *MsgVoteTSS, opts ...grpc.CallOption) (*MsgVoteTSSResponse, error)
-	// DeleteBallot deletes a ballot
-	// NOTE: This is a temporary maintenance-only operation that will be removed
-	// after the next upgrade
-	DeleteBallot(ctx context.Context, in *MsgDeleteBallot, opts ...grpc.CallOption) (*MsgDeleteBallotResponse, error)
+func (m *MsgUpdateChain) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgUpdateChain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateChain.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgUpdateChain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateChain.Merge(m, src)
+}
+func (m *MsgUpdateChain) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateChain) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateChain.DiscardUnknown(m)
 }
 
-func (c *msgClient) AddObserver(ctx context.Context, in *MsgAddObserver, opts ...grpc.CallOption) (*MsgAddObserverResponse, error) {
-	out := new(MsgAddObserverResponse)
-	err := c.cc.Invoke(ctx, "/relayer.Msg/AddObserver", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateChain proto.InternalMessageInfo
+
+func (m *MsgUpdateChain) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateObserver(ctx context.Context, in *MsgUpdateObserver, opts ...grpc.CallOption) (*MsgUpdateObserverResponse, error) {
-	out := new(MsgUpdateObserverResponse)
-	err := c.cc.Invoke(ctx, "/relayer.Msg/UpdateObserver", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateChain) GetChain() chains.Chain {
+	if m != nil {
+		return m.Chain
 	}
-	return out, nil
+	return chains.Chain{}
 }
 
-func (c *msgClient) UpsertChainParams(ctx context.Context, in *MsgUpsertChainParams, opts ...grpc.CallOption) (*MsgUpsertChainParamsResponse, error) {
-	out := new(MsgUpsertChainParamsResponse)
-	err := c.cc.Invoke(ctx, "/relayer.Msg/UpsertChainParams", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgUpdateChainResponse represents the response to update a chain
+type MsgUpdateChainResponse struct {
 }
 
-func (c *msgClient) RemoveChainParams(ctx context.Context, in *MsgRemoveChainParams, opts ...grpc.CallOption) (*MsgRemoveChainParamsResponse, error) {
-	out := new(MsgRemoveChainParamsResponse)
-	err := c.cc.Invoke(ctx, "/relayer.Msg/RemoveChainParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateChainResponse) Reset()         { *m = MsgUpdateChainResponse{} }
+func (m *MsgUpdateChainResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateChainResponse) ProtoMessage()    {}
+func (*MsgUpdateChainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5bacb8239055328, []int{23}
+}
+func (m *MsgUpdateChainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateChainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateChainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUpdateChainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateChainResponse.Merge(m, src)
+}
+func (m *MsgUpdateChainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateChainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateChainResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) AddBlameVote(ctx context.Context, in *MsgAddBlameVote, opts ...grpc.CallOption) (*MsgAddBlameVoteResponse, error) {
-	out := new(MsgAddBlameVoteResponse)
-	err := c.cc.Invoke(ctx, "/relayer.Msg/AddBlameVote", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgUpdateChainResponse proto.InternalMessageInfo
+
+// MsgDeprecateChain represents the message to deprecate a chain in the chain
+// registry
+type MsgDeprecateChain struct {
+	Signer  string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	ChainId int64  `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (c *msgClient) UpsertCrosschainFlags(ctx context.Context, in *MsgUpsertCrosschainFlags, opts ...grpc.CallOption) (*MsgUpsertCrosschainFlagsResponse, error) {
-	out := new(MsgUpsertCrosschainFlagsResponse)
-	err := c.cc.Invoke(ctx, "/relayer.Msg/UpsertCrosschainFlags", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDeprecateChain) Reset()         { *m = MsgDeprecateChain{} }
+func (m *MsgDeprecateChain) String() string { return proto.CompactTextString(m) }
+func (*MsgDeprecateChain) ProtoMessage()    {}
+func (*MsgDeprecateChain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5bacb8239055328, []int{24}
+}
+func (m *MsgDeprecateChain) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeprecateChain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeprecateChain.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgDeprecateChain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeprecateChain.Merge(m, src)
+}
+func (m *MsgDeprecateChain) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeprecateChain) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeprecateChain.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateKeygen(ctx context.Context, in *MsgUpdateKeygen, opts ...grpc.CallOption) (*MsgUpdateKeygenResponse, error) {
-	out := new(MsgUpdateKeygenResponse)
-	err := c.cc.Invoke(ctx, "/relayer.Msg/UpdateKeygen", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgDeprecateChain proto.InternalMessageInfo
+
+func (m *MsgDeprecateChain) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) ResetChainNonces(ctx context.Context, in *MsgResetChainNonces, opts ...grpc.CallOption) (*MsgResetChainNoncesResponse, error) {
-	out := new(MsgResetChainNoncesResponse)
-	err := c.cc.Invoke(ctx, "/relayer.Msg/ResetChainNonces", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDeprecateChain) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) VoteBlockHeader(ctx context.Context, in *MsgVoteBlockHeader, opts ...grpc.CallOption) (*MsgVoteBlockHeaderResponse, error) {
-	out := new(MsgVoteBlockHeaderResponse)
-	err := c.cc.Invoke(ctx, "/relayer.Msg/VoteBlockHeader", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgDeprecateChainResponse represents the response to deprecate a chain
+type MsgDeprecateChainResponse struct {
 }
 
-func (c *msgClient) VoteTSS(ctx context.Context, in *MsgVoteTSS, opts ...grpc.CallOption) (*MsgVoteTSSResponse, error) {
-	out := new(MsgVoteTSSResponse)
-	err := c.cc.Invoke(ctx, "/relayer.Msg/VoteTSS", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDeprecateChainResponse) Reset()         { *m = MsgDeprecateChainResponse{} }
+func (m *MsgDeprecateChainResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeprecateChainResponse) ProtoMessage()    {}
+func (*MsgDeprecateChainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5bacb8239055328, []int{25}
+}
+func (m *MsgDeprecateChainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeprecateChainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeprecateChainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgDeprecateChainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeprecateChainResponse.Merge(m, src)
+}
+func (m *MsgDeprecateChainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeprecateChainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeprecateChainResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) DeleteBallot(ctx context.Context, in *MsgDeleteBallot, opts ...grpc.CallOption) (*MsgDeleteBallotResponse, error) {
-	out := new(MsgDeleteBallotResponse)
-	err := c.cc.Invoke(ctx, "/relayer.Msg/DeleteBallot", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgDeprecateChainResponse proto.InternalMessageInfo
+
+// MsgUpdateRestrictedAddresses represents the message to add and remove
+// addresses from the restricted address list
+type MsgUpdateRestrictedAddresses struct {
+	Signer           string   `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	AddedAddresses   []string `protobuf:"bytes,2,rep,name=added_addresses,json=addedAddresses,proto3" json:"added_addresses,omitempty"`
+	RemovedAddresses []string `protobuf:"bytes,3,rep,name=removed_addresses,json=removedAddresses,proto3" json:"removed_addresses,omitempty"`
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// AddObserver adds an observer
-	AddObserver(context.Context, *MsgAddObserver) (*MsgAddObserverResponse, error)
-	// UpdateObserver updates an observer
-	UpdateObserver(context.Context, *MsgUpdateObserver) (*MsgUpdateObserverResponse, error)
-	// UpsertChainParams upserts chain parameters
-	UpsertChainParams(context.Context, *MsgUpsertChainParams) (*MsgUpsertChainParamsResponse, error)
-	// RemoveChainParams removes chain parameters
-	RemoveChainParams(context.Context, *MsgRemoveChainParams) (*MsgRemoveChainParamsResponse, error)
-	// AddBlameVote adds a blame vote
-	AddBlameVote(context.Context, *MsgAddBlameVote) (*MsgAddBlameVoteResponse, error)
-	// UpsertCrosschainFlags upserts crosschain flags
-	UpsertCrosschainFlags(context.Context, *MsgUpsertCrosschainFlags) (*MsgUpsertCrosschainFlagsResponse, error)
-	// UpdateKeygen updates keygen
-	UpdateKeygen(context.Context, *MsgUpdateKeygen) (*MsgUpdateKeygenResponse, error)
-	// ResetChainNonces resets chain nonces
-	ResetChainNonces(context.Context, *MsgResetChainNonces) (*MsgResetChainNoncesResponse, error)
-	// VoteBlockHeader votes for a block header
-	VoteBlockHeader(context.Context, *MsgVoteBlockHeader) (*MsgVoteBlockHeaderResponse, error)
-	// VoteTSS votes for TSS
-	VoteTSS(context.Context, *MsgVoteTSS) (*MsgVoteTSSResponse, error)
-	// DeleteBallot deletes a ballot
-	// NOTE: This is a temporary maintenance-only operation that will be removed
-	// after the next upgrade
-	DeleteBallot(context.Context, *MsgDeleteBallot) (*MsgDeleteBallotResponse, error)
+func (m *MsgUpdateRestrictedAddresses) Reset()         { *m = MsgUpdateRestrictedAddresses{} }
+func (m *MsgUpdateRestrictedAddresses) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateRestrictedAddresses) ProtoMessage()    {}
+func (*MsgUpdateRestrictedAddresses) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5bacb8239055328, []int{26}
 }
-
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgUpdateRestrictedAddresses) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (*UnimplementedMsgServer) AddObserver(ctx context.Context, req *MsgAddObserver) (*MsgAddObserverResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddObserver not implemented")
+func (m *MsgUpdateRestrictedAddresses) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateRestrictedAddresses.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UpdateObserver(ctx context.Context, req *MsgUpdateObserver) (*MsgUpdateObserverResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateObserver not implemented")
+func (m *MsgUpdateRestrictedAddresses) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateRestrictedAddresses.Merge(m, src)
 }
-func (*UnimplementedMsgServer) UpsertChainParams(ctx context.Context, req *MsgUpsertChainParams) (*MsgUpsertChainParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpsertChainParams not implemented")
+func (m *MsgUpdateRestrictedAddresses) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) RemoveChainParams(ctx context.Context, req *MsgRemoveChainParams) (*MsgRemoveChainParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveChainParams not implemented")
+func (m *MsgUpdateRestrictedAddresses) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateRestrictedAddresses.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) AddBlameVote(ctx context.Context, req *MsgAddBlameVote) (*MsgAddBlameVoteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddBlameVote not implemented")
+
+var xxx_messageInfo_MsgUpdateRestrictedAddresses proto.InternalMessageInfo
+
+func (m *MsgUpdateRestrictedAddresses) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) UpsertCrosschainFlags(ctx context.Context, req *MsgUpsertCrosschainFlags) (*MsgUpsertCrosschainFlagsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpsertCrosschainFlags not implemented")
+
+func (m *MsgUpdateRestrictedAddresses) GetAddedAddresses() []string {
+	if m != nil {
+		return m.AddedAddresses
+	}
+	return nil
 }
-func (*UnimplementedMsgServer) UpdateKeygen(ctx context.Context, req *MsgUpdateKeygen) (*MsgUpdateKeygenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateKeygen not implemented")
+
+func (m *MsgUpdateRestrictedAddresses) GetRemovedAddresses() []string {
+	if m != nil {
+		return m.RemovedAddresses
+	}
+	return nil
 }
-func (*UnimplementedMsgServer) ResetChainNonces(ctx context.Context, req *MsgResetChainNonces) (*MsgResetChainNoncesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ResetChainNonces not implemented")
+
+// MsgUpdateRestrictedAddressesResponse represents the response to update the
+// restricted address list
+type MsgUpdateRestrictedAddressesResponse struct {
 }
-func (*UnimplementedMsgServer) VoteBlockHeader(ctx context.Context, req *MsgVoteBlockHeader) (*MsgVoteBlockHeaderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VoteBlockHeader not implemented")
+
+func (m *MsgUpdateRestrictedAddressesResponse) Reset()         { *m = MsgUpdateRestrictedAddressesResponse{} }
+func (m *MsgUpdateRestrictedAddressesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateRestrictedAddressesResponse) ProtoMessage()    {}
+func (*MsgUpdateRestrictedAddressesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5bacb8239055328, []int{27}
 }
-func (*UnimplementedMsgServer) VoteTSS(ctx context.Context, req *MsgVoteTSS) (*MsgVoteTSSResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VoteTSS not implemented")
+func (m *MsgUpdateRestrictedAddressesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) DeleteBallot(ctx context.Context, req *MsgDeleteBallot) (*MsgDeleteBallotResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteBallot not implemented")
+func (m *MsgUpdateRestrictedAddressesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateRestrictedAddressesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateRestrictedAddressesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateRestrictedAddressesResponse.Merge(m, src)
+}
+func (m *MsgUpdateRestrictedAddressesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateRestrictedAddressesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateRestrictedAddressesResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgUpdateRestrictedAddressesResponse proto.InternalMessageInfo
+
+// MsgUnjailObserver represents the message to unjail an observer after the
+// jail duration
+type MsgUnjailObserver struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func _Msg_AddObserver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddObserver)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddObserver(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/relayer.Msg/AddObserver",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddObserver(ctx, req.(*MsgAddObserver))
+func (m *MsgUnjailObserver) Reset()         { *m = MsgUnjailObserver{} }
+func (m *MsgUnjailObserver) String() string { return proto.CompactTextString(m) }
+func (*MsgUnjailObserver) ProtoMessage()    {}
+func (*MsgUnjailObserver) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5bacb8239055328, []int{28}
+}
+func (m *MsgUnjailObserver) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnjailObserver) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnjailObserver.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgUnjailObserver) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnjailObserver.Merge(m, src)
+}
+func (m *MsgUnjailObserver) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnjailObserver) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnjailObserver.DiscardUnknown(m)
 }
 
-func _Msg_UpdateObserver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateObserver)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateObserver(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/relayer.Msg/UpdateObserver",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateObserver(ctx, req.(*MsgUpdateObserver))
+var xxx_messageInfo_MsgUnjailObserver proto.InternalMessageInfo
+
+func (m *MsgUnjailObserver) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpsertChainParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpsertChainParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpsertChainParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/relayer.Msg/UpsertChainParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpsertChainParams(ctx, req.(*MsgUpsertChainParams))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgUnjailObserverResponse represents the response to unjail an observer
+type MsgUnjailObserverResponse struct {
 }
 
-func _Msg_RemoveChainParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveChainParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveChainParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/relayer.Msg/RemoveChainParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveChainParams(ctx, req.(*MsgRemoveChainParams))
+func (m *MsgUnjailObserverResponse) Reset()         { *m = MsgUnjailObserverResponse{} }
+func (m *MsgUnjailObserverResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnjailObserverResponse) ProtoMessage()    {}
+func (*MsgUnjailObserverResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5bacb8239055328, []int{29}
+}
+func (m *MsgUnjailObserverResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnjailObserverResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnjailObserverResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgUnjailObserverResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnjailObserverResponse.Merge(m, src)
+}
+func (m *MsgUnjailObserverResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnjailObserverResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnjailObserverResponse.DiscardUnknown(m)
 }
 
-func _Msg_AddBlameVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddBlameVote)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddBlameVote(ctx, in)
+var xxx_messageInfo_MsgUnjailObserverResponse proto.InternalMessageInfo
+
+// MsgUpdateChainPause represents the message to pause or resume inbound,
+// outbound and signing for a single chain
+type MsgUpdateChainPause struct {
+	Signer           string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	ChainId          int64  `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	IsInboundPaused  bool   `protobuf:"varint,3,opt,name=is_inbound_paused,json=isInboundPaused,proto3" json:"is_inbound_paused,omitempty"`
+	IsOutboundPaused bool   `protobuf:"varint,4,opt,name=is_outbound_paused,json=isOutboundPaused,proto3" json:"is_outbound_paused,omitempty"`
+	IsSigningPaused  bool   `protobuf:"varint,5,opt,name=is_signing_paused,json=isSigningPaused,proto3" json:"is_signing_paused,omitempty"`
+	// block height at which the pause expires, 0 if the pause never expires
+	ExpiryHeight int64 `protobuf:"varint,6,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+}
+
+func (m *MsgUpdateChainPause) Reset()         { *m = MsgUpdateChainPause{} }
+func (m *MsgUpdateChainPause) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateChainPause) ProtoMessage()    {}
+func (*MsgUpdateChainPause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5bacb8239055328, []int{30}
+}
+func (m *MsgUpdateChainPause) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateChainPause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateChainPause.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/relayer.Msg/AddBlameVote",
+}
+func (m *MsgUpdateChainPause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateChainPause.Merge(m, src)
+}
+func (m *MsgUpdateChainPause) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateChainPause) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateChainPause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateChainPause proto.InternalMessageInfo
+
+func (m *MsgUpdateChainPause) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddBlameVote(ctx, req.(*MsgAddBlameVote))
+	return ""
+}
+
+func (m *MsgUpdateChainPause) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_UpsertCrosschainFlags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpsertCrosschainFlags)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgUpdateChainPause) GetIsInboundPaused() bool {
+	if m != nil {
+		return m.IsInboundPaused
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpsertCrosschainFlags(ctx, in)
+	return false
+}
+
+func (m *MsgUpdateChainPause) GetIsOutboundPaused() bool {
+	if m != nil {
+		return m.IsOutboundPaused
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/relayer.Msg/UpsertCrosschainFlags",
+	return false
+}
+
+func (m *MsgUpdateChainPause) GetIsSigningPaused() bool {
+	if m != nil {
+		return m.IsSigningPaused
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpsertCrosschainFlags(ctx, req.(*MsgUpsertCrosschainFlags))
+	return false
+}
+
+func (m *MsgUpdateChainPause) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_UpdateKeygen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateKeygen)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateKeygen(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/relayer.Msg/UpdateKeygen",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateKeygen(ctx, req.(*MsgUpdateKeygen))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgUpdateChainPauseResponse represents the response to update a chain pause
+type MsgUpdateChainPauseResponse struct {
 }
 
-func _Msg_ResetChainNonces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgResetChainNonces)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ResetChainNonces(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/relayer.Msg/ResetChainNonces",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ResetChainNonces(ctx, req.(*MsgResetChainNonces))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgUpdateChainPauseResponse) Reset()         { *m = MsgUpdateChainPauseResponse{} }
+func (m *MsgUpdateChainPauseResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateChainPauseResponse) ProtoMessage()    {}
+func (*MsgUpdateChainPauseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5bacb8239055328, []int{31}
 }
-
-func _Msg_VoteBlockHeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgVoteBlockHeader)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).VoteBlockHeader(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/relayer.Msg/VoteBlockHeader",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).VoteBlockHeader(ctx, req.(*MsgVoteBlockHeader))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgUpdateChainPauseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Msg_VoteTSS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgVoteTSS)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).VoteTSS(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/relayer.Msg/VoteTSS",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).VoteTSS(ctx, req.(*MsgVoteTSS))
+func (m *MsgUpdateChainPauseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateChainPauseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-func _Msg_DeleteBallot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteBallot)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteBallot(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/relayer.Msg/DeleteBallot",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteBallot(ctx, req.(*MsgDeleteBallot))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgUpdateChainPauseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateChainPauseResponse.Merge(m, src)
+}
+func (m *MsgUpdateChainPauseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateChainPauseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateChainPauseResponse.DiscardUnknown(m)
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "relayer.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "AddObserver",
-			Handler:    _Msg_AddObserver_Handler,
-		},
-		{
-			MethodName: "UpdateObserver",
-			Handler:    _Msg_UpdateObserver_Handler,
-		},
-		{
-			MethodName: "UpsertChainParams",
-			Handler:    _Msg_UpsertChainParams_Handler,
-		},
-		{
-			MethodName: "RemoveChainParams",
-			Handler:    _Msg_RemoveChainParams_Handler,
-		},
-		{
-			MethodName: "AddBlameVote",
-			Handler:    _Msg_AddBlameVote_Handler,
-		},
-		{
-			MethodName: "UpsertCrosschainFlags",
-			Handler:    _Msg_UpsertCrosschainFlags_Handler,
-		},
-		{
-			MethodName: "UpdateKeygen",
-			Handler:    _Msg_UpdateKeygen_Handler,
-		},
-		{
-			MethodName: "ResetChainNonces",
-			Handler:    _Msg_ResetChainNonces_Handler,
-		},
-		{
-			MethodName: "VoteBlockHeader",
-			Handler:    _Msg_VoteBlockHeader_Handler,
-		},
-		{
-			MethodName: "VoteTSS",
-			Handler:    _Msg_VoteTSS_Handler,
-		},
-		{
-			MethodName: "DeleteBallot",
-			Handler:    _Msg_DeleteBallot_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "relayer/tx.proto",
+var xxx_messageInfo_MsgUpdateChainPauseResponse proto.InternalMessageInfo
+
+// MsgStartTssRotation represents the message to start a TSS rotation
+type MsgStartTssRotation struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// block height at which the new key is generated
+	KeygenBlock int64 `protobuf:"varint,2,opt,name=keygen_block,json=keygenBlock,proto3" json:"keygen_block,omitempty"`
+	// funds migrated from the old TSS to the new TSS, one per supported chain
+	Migrations []TssRotationMigration `protobuf:"bytes,3,rep,name=migrations,proto3" json:"migrations"`
 }
 
-func (m *MsgUpdateObserver) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgStartTssRotation) Reset()         { *m = MsgStartTssRotation{} }
+func (m *MsgStartTssRotation) String() string { return proto.CompactTextString(m) }
+func (*MsgStartTssRotation) ProtoMessage()    {}
+func (*MsgStartTssRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5bacb8239055328, []int{32}
+}
+func (m *MsgStartTssRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStartTssRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStartTssRotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgUpdateObserver) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgStartTssRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStartTssRotation.Merge(m, src)
+}
+func (m *MsgStartTssRotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStartTssRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStartTssRotation.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateObserver) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UpdateReason != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.UpdateReason))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.NewObserverAddress) > 0 {
-		i -= len(m.NewObserverAddress)
-		copy(dAtA[i:], m.NewObserverAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NewObserverAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.OldObserverAddress) > 0 {
-		i -= len(m.OldObserverAddress)
-		copy(dAtA[i:], m.OldObserverAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OldObserverAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgStartTssRotation proto.InternalMessageInfo
+
+func (m *MsgStartTssRotation) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateObserverResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgStartTssRotation) GetKeygenBlock() int64 {
+	if m != nil {
+		return m.KeygenBlock
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgUpdateObserverResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgStartTssRotation) GetMigrations() []TssRotationMigration {
+	if m != nil {
+		return m.Migrations
+	}
+	return nil
 }
 
-func (m *MsgUpdateObserverResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgStartTssRotationResponse represents the response to start a TSS rotation
+type MsgStartTssRotationResponse struct {
 }
 
-func (m *MsgVoteBlockHeader) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgStartTssRotationResponse) Reset()         { *m = MsgStartTssRotationResponse{} }
+func (m *MsgStartTssRotationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgStartTssRotationResponse) ProtoMessage()    {}
+func (*MsgStartTssRotationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5bacb8239055328, []int{33}
+}
+func (m *MsgStartTssRotationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStartTssRotationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStartTssRotationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgStartTssRotationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStartTssRotationResponse.Merge(m, src)
+}
+func (m *MsgStartTssRotationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStartTssRotationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStartTssRotationResponse.DiscardUnknown(m)
 }
 
-func (m *MsgVoteBlockHeader) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgStartTssRotationResponse proto.InternalMessageInfo
+
+// MsgCancelTssRotation represents the message to cancel a TSS rotation
+type MsgCancelTssRotation struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func (m *MsgVoteBlockHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	{
-		size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgCancelTssRotation) Reset()         { *m = MsgCancelTssRotation{} }
+func (m *MsgCancelTssRotation) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelTssRotation) ProtoMessage()    {}
+func (*MsgCancelTssRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5bacb8239055328, []int{34}
+}
+func (m *MsgCancelTssRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelTssRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelTssRotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if m.Height != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.BlockHash) > 0 {
-		i -= len(m.BlockHash)
-		copy(dAtA[i:], m.BlockHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BlockHash)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.ChainId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgVoteBlockHeaderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgCancelTssRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelTssRotation.Merge(m, src)
 }
-
-func (m *MsgVoteBlockHeaderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCancelTssRotation) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgVoteBlockHeaderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.VoteFinalized {
-		i--
-		if m.VoteFinalized {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.BallotCreated {
-		i--
-		if m.BallotCreated {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *MsgCancelTssRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelTssRotation.DiscardUnknown(m)
 }
 
-func (m *MsgUpsertChainParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCancelTssRotation proto.InternalMessageInfo
+
+func (m *MsgCancelTssRotation) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpsertChainParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgCancelTssRotationResponse represents the response to cancel a TSS rotation
+type MsgCancelTssRotationResponse struct {
 }
 
-func (m *MsgUpsertChainParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ChainParams != nil {
-		{
-			size, err := m.ChainParams.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgCancelTssRotationResponse) Reset()         { *m = MsgCancelTssRotationResponse{} }
+func (m *MsgCancelTssRotationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelTssRotationResponse) ProtoMessage()    {}
+func (*MsgCancelTssRotationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5bacb8239055328, []int{35}
+}
+func (m *MsgCancelTssRotationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelTssRotationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelTssRotationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgUpsertChainParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgCancelTssRotationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelTssRotationResponse.Merge(m, src)
 }
-
-func (m *MsgUpsertChainParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCancelTssRotationResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgUpsertChainParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgCancelTssRotationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelTssRotationResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRemoveChainParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgCancelTssRotationResponse proto.InternalMessageInfo
 
-func (m *MsgRemoveChainParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// TODO: remove this after the upgrade
+// MsgDeleteBallot represents the message to delete a ballot
+type MsgDeleteBallot struct {
+	Signer      string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	BallotIndex string `protobuf:"bytes,2,opt,name=ballot_index,json=ballotIndex,proto3" json:"ballot_index,omitempty"`
 }
 
-func (m *MsgRemoveChainParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ChainId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgDeleteBallot) Reset()         { *m = MsgDeleteBallot{} }
+func (m *MsgDeleteBallot) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteBallot) ProtoMessage()    {}
+func (*MsgDeleteBallot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5bacb8239055328, []int{36}
 }
-
-func (m *MsgRemoveChainParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgDeleteBallot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteBallot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteBallot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgRemoveChainParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgDeleteBallot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteBallot.Merge(m, src)
+}
+func (m *MsgDeleteBallot) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteBallot) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteBallot.DiscardUnknown(m)
 }
 
-func (m *MsgRemoveChainParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgDeleteBallot proto.InternalMessageInfo
+
+func (m *MsgDeleteBallot) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
 }
 
-func (m *MsgAddObserver) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgDeleteBallot) GetBallotIndex() string {
+	if m != nil {
+		return m.BallotIndex
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgAddObserver) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// TODO: remove this after the upgrade
+// MsgDeleteBallotResponse represents the response to delete a ballot
+type MsgDeleteBallotResponse struct {
 }
 
-func (m *MsgAddObserver) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AddNodeAccountOnly {
-		i--
-		if m.AddNodeAccountOnly {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *MsgDeleteBallotResponse) Reset()         { *m = MsgDeleteBallotResponse{} }
+func (m *MsgDeleteBallotResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteBallotResponse) ProtoMessage()    {}
+func (*MsgDeleteBallotResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5bacb8239055328, []int{37}
+}
+func (m *MsgDeleteBallotResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteBallotResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteBallotResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.PellclientGranteePubkey) > 0 {
-		i -= len(m.PellclientGranteePubkey)
-		copy(dAtA[i:], m.PellclientGranteePubkey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PellclientGranteePubkey)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ObserverAddress) > 0 {
-		i -= len(m.ObserverAddress)
-		copy(dAtA[i:], m.ObserverAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ObserverAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgDeleteBallotResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteBallotResponse.Merge(m, src)
+}
+func (m *MsgDeleteBallotResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteBallotResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteBallotResponse.DiscardUnknown(m)
 }
 
-func (m *MsgAddObserverResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_MsgDeleteBallotResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateObserver)(nil), "relayer.MsgUpdateObserver")
+	proto.RegisterType((*MsgUpdateObserverResponse)(nil), "relayer.MsgUpdateObserverResponse")
+	proto.RegisterType((*MsgVoteBlockHeader)(nil), "relayer.MsgVoteBlockHeader")
+	proto.RegisterType((*MsgVoteBlockHeaderResponse)(nil), "relayer.MsgVoteBlockHeaderResponse")
+	proto.RegisterType((*MsgUpsertChainParams)(nil), "relayer.MsgUpsertChainParams")
+	proto.RegisterType((*MsgUpsertChainParamsResponse)(nil), "relayer.MsgUpsertChainParamsResponse")
+	proto.RegisterType((*MsgRemoveChainParams)(nil), "relayer.MsgRemoveChainParams")
+	proto.RegisterType((*MsgRemoveChainParamsResponse)(nil), "relayer.MsgRemoveChainParamsResponse")
+	proto.RegisterType((*MsgAddObserver)(nil), "relayer.MsgAddObserver")
+	proto.RegisterType((*MsgAddObserverResponse)(nil), "relayer.MsgAddObserverResponse")
+	proto.RegisterType((*MsgAddBlameVote)(nil), "relayer.MsgAddBlameVote")
+	proto.RegisterType((*MsgAddBlameVoteResponse)(nil), "relayer.MsgAddBlameVoteResponse")
+	proto.RegisterType((*MsgUpsertCrosschainFlags)(nil), "relayer.MsgUpsertCrosschainFlags")
+	proto.RegisterType((*MsgUpsertCrosschainFlagsResponse)(nil), "relayer.MsgUpsertCrosschainFlagsResponse")
+	proto.RegisterType((*MsgUpdateKeygen)(nil), "relayer.MsgUpdateKeygen")
+	proto.RegisterType((*MsgUpdateKeygenResponse)(nil), "relayer.MsgUpdateKeygenResponse")
+	proto.RegisterType((*MsgResetChainNonces)(nil), "relayer.MsgResetChainNonces")
+	proto.RegisterType((*MsgResetChainNoncesResponse)(nil), "relayer.MsgResetChainNoncesResponse")
+	proto.RegisterType((*MsgVoteTSS)(nil), "relayer.MsgVoteTSS")
+	proto.RegisterType((*MsgVoteTSSResponse)(nil), "relayer.MsgVoteTSSResponse")
+	proto.RegisterType((*MsgAddChain)(nil), "relayer.MsgAddChain")
+	proto.RegisterType((*MsgAddChainResponse)(nil), "relayer.MsgAddChainResponse")
+	proto.RegisterType((*MsgUpdateChain)(nil), "relayer.MsgUpdateChain")
+	proto.RegisterType((*MsgUpdateChainResponse)(nil), "relayer.MsgUpdateChainResponse")
+	proto.RegisterType((*MsgDeprecateChain)(nil), "relayer.MsgDeprecateChain")
+	proto.RegisterType((*MsgDeprecateChainResponse)(nil), "relayer.MsgDeprecateChainResponse")
+	proto.RegisterType((*MsgUpdateRestrictedAddresses)(nil), "relayer.MsgUpdateRestrictedAddresses")
+	proto.RegisterType((*MsgUpdateRestrictedAddressesResponse)(nil), "relayer.MsgUpdateRestrictedAddressesResponse")
+	proto.RegisterType((*MsgUnjailObserver)(nil), "relayer.MsgUnjailObserver")
+	proto.RegisterType((*MsgUnjailObserverResponse)(nil), "relayer.MsgUnjailObserverResponse")
+	proto.RegisterType((*MsgUpdateChainPause)(nil), "relayer.MsgUpdateChainPause")
+	proto.RegisterType((*MsgUpdateChainPauseResponse)(nil), "relayer.MsgUpdateChainPauseResponse")
+	proto.RegisterType((*MsgStartTssRotation)(nil), "relayer.MsgStartTssRotation")
+	proto.RegisterType((*MsgStartTssRotationResponse)(nil), "relayer.MsgStartTssRotationResponse")
+	proto.RegisterType((*MsgCancelTssRotation)(nil), "relayer.MsgCancelTssRotation")
+	proto.RegisterType((*MsgCancelTssRotationResponse)(nil), "relayer.MsgCancelTssRotationResponse")
+	proto.RegisterType((*MsgDeleteBallot)(nil), "relayer.MsgDeleteBallot")
+	proto.RegisterType((*MsgDeleteBallotResponse)(nil), "relayer.MsgDeleteBallotResponse")
 }
 
-func (m *MsgAddObserverResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() { proto.RegisterFile("relayer/tx.proto", fileDescriptor_d5bacb8239055328) }
+
+var fileDescriptor_d5bacb8239055328 = []byte{
+	// 1723 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x4f, 0x1c, 0xc9,
+	0x15, 0x67, 0x18, 0xc0, 0xf0, 0x06, 0x06, 0x68, 0xc0, 0x0c, 0x0d, 0x0c, 0xec, 0x2c, 0x6c, 0xd8,
+	0xcd, 0x2e, 0x63, 0xe3, 0x48, 0x89, 0x6c, 0x29, 0x12, 0xe0, 0x18, 0x48, 0x8c, 0x8d, 0x7a, 0x08,
+	0x91, 0x73, 0x69, 0xd5, 0x74, 0x17, 0x3d, 0x6d, 0x7a, 0xba, 0x26, 0x5d, 0x3d, 0x7c, 0xe4, 0x14,
+	0xe5, 0x10, 0x29, 0xb7, 0x5c, 0x73, 0xcd, 0x25, 0xd7, 0xe4, 0x92, 0x7b, 0x6e, 0xbe, 0xc5, 0xc7,
+	0x9c, 0x92, 0xc8, 0x8e, 0x94, 0x7f, 0x23, 0xea, 0xaa, 0xea, 0xa2, 0x6a, 0x7a, 0x7a, 0x40, 0x96,
+	0x7c, 0xea, 0xe9, 0xf7, 0x7b, 0xf5, 0xab, 0x7a, 0xef, 0xd5, 0xfb, 0xe8, 0x81, 0x99, 0x08, 0x07,
+	0xe8, 0x06, 0x47, 0xf5, 0xf8, 0x7a, 0xbb, 0x13, 0x91, 0x98, 0x18, 0x0f, 0x84, 0xc4, 0x5c, 0x74,
+	0x08, 0x6d, 0x13, 0x5a, 0x6f, 0x53, 0xaf, 0x7e, 0xf9, 0x38, 0x79, 0x70, 0x0d, 0x73, 0xde, 0x23,
+	0x1e, 0x61, 0x3f, 0xeb, 0xc9, 0x2f, 0x21, 0x5d, 0xec, 0x5c, 0x78, 0x75, 0xa7, 0x85, 0xfc, 0x90,
+	0x8a, 0x87, 0x0a, 0x74, 0x22, 0x42, 0xce, 0xa9, 0x78, 0x08, 0x60, 0x2e, 0xdd, 0xbb, 0x19, 0xa0,
+	0x36, 0x16, 0xc2, 0x6a, 0x2a, 0x74, 0x22, 0x42, 0x29, 0x23, 0xb2, 0xcf, 0x03, 0xe4, 0xa5, 0x8b,
+	0xe6, 0x53, 0xbc, 0x83, 0x22, 0xd4, 0x4e, 0xa5, 0x0b, 0xa9, 0x54, 0x3c, 0x85, 0xd8, 0x94, 0xd6,
+	0x51, 0x6a, 0x47, 0x24, 0x46, 0xb1, 0x4f, 0x42, 0x8e, 0xd5, 0xfe, 0x5d, 0x80, 0xd9, 0x63, 0xea,
+	0xfd, 0xbc, 0xe3, 0xa2, 0x18, 0xbf, 0x6e, 0x52, 0x1c, 0x5d, 0xe2, 0xc8, 0x78, 0x08, 0x63, 0xd4,
+	0xf7, 0x42, 0x1c, 0x55, 0x0a, 0xeb, 0x85, 0xad, 0x09, 0x4b, 0xbc, 0x19, 0x8f, 0x60, 0x9e, 0x04,
+	0xae, 0x4d, 0x84, 0x9e, 0x8d, 0x5c, 0x37, 0xc2, 0x94, 0x56, 0x86, 0x99, 0x96, 0x41, 0x02, 0x37,
+	0xa5, 0xd8, 0xe5, 0x48, 0xb2, 0x22, 0xc4, 0x57, 0xd9, 0x15, 0x45, 0xbe, 0x22, 0xc4, 0x57, 0xbd,
+	0x2b, 0x76, 0x61, 0xaa, 0xcb, 0x4e, 0x63, 0x47, 0x18, 0x51, 0x12, 0x56, 0x46, 0xd6, 0x0b, 0x5b,
+	0xe5, 0x9d, 0x95, 0xed, 0xd4, 0x28, 0x8b, 0x3f, 0xf9, 0x91, 0x2d, 0xa6, 0x63, 0x4d, 0x76, 0x95,
+	0xb7, 0xa7, 0xa5, 0xdf, 0xfe, 0xef, 0x2f, 0xdf, 0x88, 0x33, 0xd7, 0x96, 0x61, 0x29, 0x63, 0xa0,
+	0x85, 0x69, 0x87, 0x84, 0x14, 0xd7, 0xfe, 0x5e, 0x00, 0xe3, 0x98, 0x7a, 0x67, 0x24, 0xc6, 0x7b,
+	0x01, 0x71, 0x2e, 0x0e, 0x31, 0x72, 0x71, 0x64, 0x2c, 0xc1, 0x38, 0xf7, 0xb9, 0xef, 0x32, 0x0f,
+	0x14, 0xad, 0x07, 0xec, 0xfd, 0xc8, 0x35, 0x56, 0x01, 0x9a, 0x89, 0xa6, 0xdd, 0x42, 0xb4, 0xc5,
+	0x0c, 0x9f, 0xb4, 0x26, 0x98, 0xe4, 0x10, 0xd1, 0x56, 0xe2, 0xb9, 0x16, 0xf6, 0xbd, 0x56, 0xcc,
+	0x2c, 0x2c, 0x5a, 0xe2, 0xcd, 0xf8, 0x41, 0x22, 0x4f, 0xb8, 0x99, 0x39, 0xa5, 0x9d, 0x87, 0xdb,
+	0x9d, 0x0b, 0x6f, 0x5b, 0x5c, 0x04, 0xbe, 0xeb, 0x73, 0x14, 0xa3, 0xbd, 0x91, 0x77, 0xff, 0x5a,
+	0x1b, 0xb2, 0x84, 0xae, 0x12, 0x87, 0x51, 0x35, 0x0e, 0xba, 0x81, 0x6f, 0xc1, 0xcc, 0x9a, 0x90,
+	0x5a, 0x68, 0x6c, 0x42, 0xb9, 0x89, 0x82, 0x80, 0xc4, 0xb6, 0x13, 0x61, 0x14, 0x63, 0x6e, 0xd0,
+	0xb8, 0x35, 0xc5, 0xa5, 0xfb, 0x5c, 0x98, 0xa8, 0x5d, 0x92, 0x18, 0xdb, 0xe7, 0x7e, 0x88, 0x02,
+	0xff, 0xd7, 0xd8, 0x65, 0xa6, 0x8d, 0x5b, 0x53, 0x89, 0xf4, 0x45, 0x2a, 0xac, 0xc5, 0x30, 0xcf,
+	0x9c, 0x49, 0x71, 0x14, 0xef, 0x27, 0x1e, 0x39, 0x61, 0xf7, 0x2f, 0xf7, 0xc2, 0xfc, 0x10, 0x26,
+	0xb9, 0x23, 0xf9, 0x3d, 0x65, 0xa4, 0xa5, 0x9d, 0x79, 0x19, 0x4b, 0x85, 0xc3, 0x2a, 0x39, 0xb7,
+	0x2f, 0xba, 0x85, 0x55, 0x58, 0xe9, 0xb7, 0xab, 0x8c, 0xe2, 0x19, 0x3b, 0x95, 0x85, 0xdb, 0xe4,
+	0x12, 0xdf, 0xe7, 0x54, 0x6a, 0x78, 0x87, 0xb5, 0xf0, 0xf6, 0xdb, 0x37, 0xc3, 0x2b, 0xf7, 0xfd,
+	0x47, 0x01, 0xca, 0xc7, 0xd4, 0xdb, 0x75, 0xdd, 0x3b, 0x33, 0xe7, 0x6b, 0x98, 0xc9, 0xc9, 0x9a,
+	0x69, 0xd2, 0x93, 0x00, 0x4f, 0x61, 0xa9, 0x83, 0x83, 0xc0, 0x09, 0x7c, 0x1c, 0xc6, 0xb6, 0x17,
+	0xa1, 0x30, 0xc6, 0xd8, 0xee, 0x74, 0x9b, 0x17, 0xf8, 0x46, 0xe4, 0xcd, 0xe2, 0xad, 0xc2, 0x01,
+	0xc7, 0x4f, 0x18, 0x6c, 0x3c, 0x86, 0x05, 0xe4, 0xba, 0x76, 0x48, 0x5c, 0x6c, 0x23, 0xc7, 0x21,
+	0xdd, 0x30, 0xb6, 0x49, 0x18, 0xdc, 0xb0, 0x5b, 0x37, 0x6e, 0x19, 0xc8, 0x75, 0x5f, 0x11, 0x17,
+	0xef, 0x72, 0xe8, 0x75, 0x18, 0xdc, 0xe8, 0x16, 0x57, 0xe0, 0xa1, 0x6e, 0x90, 0xb4, 0xf5, 0x77,
+	0x05, 0x98, 0xe6, 0xd0, 0x5e, 0x52, 0xa7, 0x92, 0xeb, 0x36, 0x28, 0x4d, 0x9e, 0x24, 0x69, 0x82,
+	0xda, 0xd8, 0xf6, 0xc3, 0x73, 0x22, 0xc2, 0x5e, 0x96, 0x61, 0x67, 0x14, 0xe2, 0xae, 0x4f, 0x30,
+	0xbd, 0xa3, 0xf0, 0x9c, 0x28, 0xce, 0x2b, 0xe6, 0x5f, 0xf7, 0x25, 0x58, 0xec, 0x39, 0x87, 0x3c,
+	0xe3, 0x7f, 0x87, 0xa1, 0x72, 0x7b, 0x51, 0x64, 0xe5, 0x7c, 0x91, 0x14, 0xce, 0xdc, 0xc8, 0x7c,
+	0x0b, 0x86, 0x4f, 0x6d, 0x3f, 0x6c, 0x92, 0x6e, 0xe8, 0xda, 0x38, 0x44, 0xcd, 0x40, 0xde, 0xfe,
+	0x19, 0x9f, 0x1e, 0x71, 0xe0, 0x27, 0x5c, 0x6e, 0x6c, 0xc3, 0x9c, 0x4f, 0x6d, 0xd2, 0x8d, 0x75,
+	0xf5, 0x22, 0x53, 0x9f, 0xf5, 0xe9, 0xeb, 0x6e, 0xac, 0xe9, 0xff, 0x02, 0x2a, 0x1e, 0xa2, 0x76,
+	0x27, 0xf2, 0x9d, 0xc4, 0x17, 0x49, 0x0e, 0x52, 0xcc, 0x4b, 0xb9, 0xa8, 0x04, 0x55, 0xe9, 0x95,
+	0x03, 0x44, 0x4f, 0x12, 0xbd, 0x23, 0xa1, 0xc6, 0xce, 0x6d, 0x2d, 0x78, 0xfd, 0xc4, 0x46, 0x00,
+	0x6b, 0xa2, 0x0e, 0xb1, 0x7c, 0xb7, 0x2f, 0x71, 0xe4, 0x9f, 0xfb, 0x0e, 0xab, 0xed, 0x82, 0x7f,
+	0x94, 0xf1, 0x6f, 0x2a, 0x5e, 0x97, 0xe5, 0xe1, 0x4c, 0xd1, 0xe6, 0xdb, 0xac, 0x34, 0x07, 0xa0,
+	0x7a, 0x04, 0x6a, 0xb0, 0x9e, 0xe7, 0x65, 0x19, 0x8a, 0x97, 0x30, 0x2d, 0xab, 0xee, 0xcf, 0xf0,
+	0x8d, 0x87, 0xc3, 0xdc, 0x00, 0xcc, 0xc3, 0x28, 0xdb, 0x5b, 0xa4, 0x22, 0x7f, 0xe9, 0x17, 0x73,
+	0x95, 0x4d, 0x6e, 0xf4, 0xe7, 0x02, 0xcc, 0xb1, 0x24, 0xa5, 0x98, 0xd7, 0x86, 0x57, 0x24, 0x74,
+	0xf0, 0xa7, 0xe4, 0xbe, 0xf1, 0x15, 0x4c, 0x73, 0x28, 0x4c, 0x28, 0xec, 0x80, 0x5c, 0x89, 0x22,
+	0x3e, 0xe5, 0x48, 0xe2, 0x97, 0xe4, 0xca, 0xd8, 0x82, 0x19, 0x55, 0xaf, 0xe5, 0x7b, 0x2d, 0x16,
+	0xcb, 0xa2, 0x55, 0xbe, 0x55, 0x3c, 0xf4, 0xbd, 0x96, 0x6e, 0xc4, 0x2a, 0x2c, 0xf7, 0x39, 0xa8,
+	0x34, 0xe4, 0xaf, 0x05, 0x00, 0x51, 0xc7, 0x4f, 0x1b, 0x8d, 0xa4, 0xcf, 0x24, 0xed, 0x5a, 0xa4,
+	0x3d, 0xb7, 0x61, 0x22, 0xa6, 0x54, 0x24, 0xfa, 0xb7, 0x60, 0x5c, 0x30, 0x47, 0xd8, 0x49, 0x29,
+	0xb0, 0x45, 0xcf, 0xe1, 0x06, 0xcd, 0x70, 0xe4, 0x04, 0x07, 0xc1, 0x21, 0x93, 0x1b, 0x8f, 0x61,
+	0x8c, 0xc6, 0x28, 0xee, 0xf2, 0xbe, 0x5b, 0xde, 0x59, 0x62, 0xdd, 0x47, 0xcc, 0x27, 0x16, 0x76,
+	0xb0, 0x7f, 0x89, 0x1b, 0x4c, 0xc1, 0x12, 0x8a, 0x8a, 0xff, 0x46, 0xf2, 0x73, 0xf1, 0xf7, 0xb7,
+	0xed, 0xf3, 0xb4, 0xd1, 0xf8, 0x3c, 0x3d, 0x27, 0x51, 0x13, 0xa6, 0xd2, 0xae, 0xe3, 0xa4, 0xc3,
+	0xc3, 0xb8, 0x35, 0xc5, 0xa5, 0x0d, 0x2e, 0xac, 0x21, 0x28, 0xf1, 0xba, 0xc0, 0x9c, 0x9b, 0x1b,
+	0xff, 0xef, 0x60, 0x94, 0xd9, 0x2d, 0x6a, 0xd2, 0xac, 0xea, 0x09, 0xb6, 0x52, 0x94, 0x25, 0xae,
+	0xa5, 0x9b, 0xbb, 0x00, 0x73, 0xca, 0x16, 0x32, 0x72, 0x2e, 0x94, 0xe5, 0xed, 0xfc, 0x7c, 0x9b,
+	0xf3, 0xd2, 0xac, 0xec, 0x22, 0xf7, 0x6f, 0xb0, 0x11, 0xee, 0x39, 0xee, 0x44, 0xd8, 0xb9, 0xf3,
+	0x08, 0xf7, 0xed, 0x7d, 0x7c, 0x6c, 0xd2, 0x49, 0xe5, 0x8e, 0x7f, 0x2c, 0xc0, 0x8a, 0x3c, 0x8c,
+	0x85, 0x69, 0x1c, 0xf9, 0x4e, 0x8c, 0x5d, 0xd1, 0xc1, 0x06, 0x64, 0xdf, 0xf7, 0x60, 0x1a, 0xb9,
+	0x2e, 0x76, 0xd3, 0x1e, 0x88, 0x93, 0x2e, 0x58, 0xdc, 0x9a, 0xb0, 0xca, 0x4c, 0x7c, 0x4b, 0xf0,
+	0x7d, 0x98, 0x8d, 0x58, 0xdf, 0x55, 0x55, 0x8b, 0x4c, 0x75, 0x46, 0x00, 0x52, 0x59, 0x3f, 0xf8,
+	0x57, 0xb0, 0x31, 0xe8, 0x68, 0xd2, 0x86, 0x1f, 0xf1, 0xc1, 0x37, 0x7c, 0x8b, 0xfc, 0xe0, 0xae,
+	0xf6, 0xdd, 0x77, 0xa2, 0xd4, 0x56, 0x4a, 0xda, 0xdf, 0x0c, 0xc3, 0x9c, 0xdc, 0x5f, 0x0c, 0x0d,
+	0x5d, 0x8a, 0x3f, 0xa5, 0x1e, 0x7d, 0x03, 0xb3, 0x4a, 0x67, 0xea, 0x24, 0x34, 0x69, 0xa7, 0x99,
+	0x96, 0x8d, 0x89, 0xb1, 0xbb, 0xa2, 0x8b, 0xc9, 0xbe, 0x24, 0x94, 0x47, 0xd2, 0x2e, 0x96, 0xb6,
+	0x25, 0xa1, 0xcd, 0x99, 0x93, 0x13, 0xf8, 0xa1, 0x97, 0x2a, 0x8f, 0xa6, 0xcc, 0x0d, 0x2e, 0x17,
+	0xba, 0x5f, 0xc2, 0x14, 0xbe, 0xee, 0xf8, 0xd1, 0x4d, 0x5a, 0x64, 0xc6, 0xd8, 0x29, 0x27, 0xb9,
+	0x90, 0x17, 0x98, 0x7e, 0x85, 0xae, 0xd7, 0x03, 0xd2, 0x43, 0x7f, 0xe2, 0x15, 0xbb, 0x11, 0xa3,
+	0x28, 0x3e, 0xa5, 0xd4, 0x12, 0x1f, 0x24, 0xb9, 0x1e, 0xfa, 0x02, 0x26, 0x45, 0xfe, 0xab, 0x6d,
+	0xa2, 0xc4, 0x65, 0xac, 0xa7, 0x19, 0xfb, 0x00, 0x6d, 0xdf, 0x8b, 0x18, 0x0f, 0xbf, 0x26, 0xa5,
+	0x9d, 0x55, 0xd9, 0xf7, 0x94, 0x4d, 0x8e, 0x53, 0x2d, 0x91, 0x68, 0xca, 0xb2, 0x7e, 0x36, 0xf4,
+	0x9e, 0x51, 0xda, 0xf0, 0x8c, 0x4d, 0x9c, 0xfb, 0x28, 0x74, 0x70, 0x70, 0x0f, 0x1b, 0xfa, 0x8d,
+	0x95, 0x99, 0xc5, 0x92, 0xfc, 0x0d, 0xeb, 0x9d, 0xcf, 0x71, 0x80, 0x63, 0xbc, 0xc7, 0x2a, 0xe6,
+	0x20, 0xdf, 0x88, 0x4a, 0xeb, 0x87, 0x2e, 0xbe, 0x16, 0x23, 0x65, 0x89, 0xcb, 0x8e, 0x12, 0x51,
+	0xbf, 0x46, 0xaa, 0x52, 0xa7, 0xbb, 0xee, 0xfc, 0x6d, 0x12, 0x8a, 0xc7, 0xd4, 0x33, 0x0e, 0xa0,
+	0xa4, 0x0e, 0xb4, 0x8b, 0xd2, 0x8d, 0xfa, 0x60, 0x68, 0xae, 0xe5, 0x00, 0xb2, 0x0b, 0x9c, 0x40,
+	0xb9, 0xe7, 0xb3, 0xd2, 0x54, 0x97, 0xe8, 0x98, 0x59, 0xcb, 0xc7, 0x24, 0xe3, 0x1b, 0x98, 0xcd,
+	0x7e, 0x7a, 0xac, 0xea, 0x0b, 0x7b, 0x60, 0x73, 0x73, 0x20, 0xac, 0x52, 0x67, 0xbf, 0x1f, 0x34,
+	0xea, 0x0c, 0x6c, 0x6e, 0x0e, 0x84, 0x25, 0xf5, 0x4f, 0x61, 0x52, 0x9b, 0x9a, 0x2b, 0x3d, 0x8e,
+	0x93, 0x88, 0xb9, 0x9e, 0x87, 0x48, 0x2e, 0x0c, 0x0b, 0xfd, 0xa7, 0xdb, 0x2f, 0xfa, 0x98, 0xa9,
+	0xab, 0x98, 0x5f, 0xdf, 0xa9, 0xa2, 0x1e, 0x59, 0x1b, 0xdd, 0x2a, 0xd9, 0xe0, 0x70, 0xc4, 0x5c,
+	0xcf, 0x43, 0x24, 0xd7, 0x19, 0xcc, 0x64, 0x86, 0xb3, 0x15, 0xdd, 0x73, 0x3a, 0x6a, 0x6e, 0x0c,
+	0x42, 0x25, 0x6f, 0x03, 0xa6, 0x7b, 0x3f, 0xdb, 0x97, 0xd5, 0x85, 0x3d, 0xa0, 0xf9, 0xe5, 0x00,
+	0x50, 0x92, 0x3e, 0x83, 0x07, 0xe9, 0x00, 0x36, 0xd7, 0xab, 0x7f, 0xda, 0x68, 0x98, 0xcb, 0x7d,
+	0x84, 0x72, 0xf1, 0x8f, 0x61, 0x5c, 0x8e, 0x1f, 0xf3, 0x3d, 0xa1, 0x64, 0x52, 0x73, 0xa5, 0x9f,
+	0x54, 0xae, 0x3f, 0x80, 0x92, 0x3a, 0x44, 0x2c, 0x66, 0x5d, 0xcb, 0x59, 0xd6, 0x72, 0x00, 0x35,
+	0xf3, 0x7a, 0xa6, 0x01, 0x2d, 0xf3, 0x74, 0xcc, 0xac, 0xe5, 0x63, 0x92, 0xf1, 0x57, 0xb0, 0x94,
+	0xdf, 0xec, 0x37, 0xb3, 0xe7, 0xe9, 0xa3, 0x66, 0x7e, 0x77, 0x2f, 0x35, 0xad, 0x7c, 0xe8, 0xcd,
+	0x59, 0x2f, 0x1f, 0x1a, 0x66, 0xd6, 0xf2, 0x31, 0xf5, 0x26, 0x66, 0xda, 0xf2, 0x4a, 0x8e, 0x2f,
+	0x19, 0x6a, 0x6e, 0x0c, 0x42, 0x55, 0xde, 0x4c, 0x33, 0xd3, 0x78, 0x7b, 0x51, 0x73, 0x63, 0x10,
+	0xaa, 0xd6, 0xa4, 0x6c, 0x87, 0xd1, 0x6a, 0x52, 0x06, 0x36, 0x37, 0x07, 0xc2, 0x6a, 0x82, 0x6b,
+	0xfd, 0xa5, 0xa2, 0xdf, 0x81, 0x5b, 0xc4, 0x5c, 0xcf, 0x43, 0x52, 0xae, 0xbd, 0xc3, 0x77, 0x1f,
+	0xaa, 0x85, 0xf7, 0x1f, 0xaa, 0x85, 0xff, 0x7c, 0xa8, 0x16, 0xfe, 0xf0, 0xb1, 0x3a, 0xf4, 0xfe,
+	0x63, 0x75, 0xe8, 0x9f, 0x1f, 0xab, 0x43, 0xbf, 0xdc, 0xf6, 0xfc, 0xb8, 0xd5, 0x6d, 0x6e, 0x3b,
+	0xa4, 0x5d, 0x7f, 0x74, 0x9d, 0x7c, 0x8f, 0xbc, 0xc2, 0xf1, 0x15, 0x89, 0x2e, 0xea, 0x08, 0x7b,
+	0x3e, 0xad, 0x5f, 0xd7, 0xe5, 0x3f, 0x93, 0x37, 0x1d, 0x4c, 0x9b, 0x63, 0xec, 0x3f, 0xc9, 0x27,
+	0xff, 0x1f, 0x00, 0x11, 0x3b, 0xa9, 0xa9, 0x8f, 0x15, 0x00, 0x00,
 }
 
-func (m *MsgAddObserverResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// AddObserver adds an observer
+	AddObserver(ctx context.Context, in *MsgAddObserver, opts ...grpc.CallOption) (*MsgAddObserverResponse, error)
+	// UpdateObserver updates an observer
+	UpdateObserver(ctx context.Context, in *MsgUpdateObserver, opts ...grpc.CallOption) (*MsgUpdateObserverResponse, error)
+	// UpsertChainParams upserts chain parameters
+	UpsertChainParams(ctx context.Context, in *MsgUpsertChainParams, opts ...grpc.CallOption) (*MsgUpsertChainParamsResponse, error)
+	// RemoveChainParams removes chain parameters
+	RemoveChainParams(ctx context.Context, in *MsgRemoveChainParams, opts ...grpc.CallOption) (*MsgRemoveChainParamsResponse, error)
+	// AddBlameVote adds a blame vote
+	AddBlameVote(ctx context.Context, in *MsgAddBlameVote, opts ...grpc.CallOption) (*MsgAddBlameVoteResponse, error)
+	// UpsertCrosschainFlags upserts crosschain flags
+	UpsertCrosschainFlags(ctx context.Context, in *MsgUpsertCrosschainFlags, opts ...grpc.CallOption) (*MsgUpsertCrosschainFlagsResponse, error)
+	// UpdateKeygen updates keygen
+	UpdateKeygen(ctx context.Context, in *MsgUpdateKeygen, opts ...grpc.CallOption) (*MsgUpdateKeygenResponse, error)
+	// ResetChainNonces resets chain nonces
+	ResetChainNonces(ctx context.Context, in *MsgResetChainNonces, opts ...grpc.CallOption) (*MsgResetChainNoncesResponse, error)
+	// VoteBlockHeader votes for a block header
+	VoteBlockHeader(ctx context.Context, in *MsgVoteBlockHeader, opts ...grpc.CallOption) (*MsgVoteBlockHeaderResponse, error)
+	// VoteTSS votes for TSS
+	VoteTSS(ctx context.Context, in *MsgVoteTSS, opts ...grpc.CallOption) (*MsgVoteTSSResponse, error)
+	// AddChain adds a chain to the chain registry
+	AddChain(ctx context.Context, in *MsgAddChain, opts ...grpc.CallOption) (*MsgAddChainResponse, error)
+	// UpdateChain updates a chain in the chain registry
+	UpdateChain(ctx context.Context, in *MsgUpdateChain, opts ...grpc.CallOption) (*MsgUpdateChainResponse, error)
+	// DeprecateChain deprecates a chain in the chain registry
+	DeprecateChain(ctx context.Context, in *MsgDeprecateChain, opts ...grpc.CallOption) (*MsgDeprecateChainResponse, error)
+	// UpdateRestrictedAddresses updates the restricted address list used for
+	// compliance screening
+	UpdateRestrictedAddresses(ctx context.Context, in *MsgUpdateRestrictedAddresses, opts ...grpc.CallOption) (*MsgUpdateRestrictedAddressesResponse, error)
+	// UnjailObserver unjails an observer jailed for blames or missed ballots
+	UnjailObserver(ctx context.Context, in *MsgUnjailObserver, opts ...grpc.CallOption) (*MsgUnjailObserverResponse, error)
+	// UpdateChainPause pauses or resumes inbound, outbound and signing for a single chain
+	UpdateChainPause(ctx context.Context, in *MsgUpdateChainPause, opts ...grpc.CallOption) (*MsgUpdateChainPauseResponse, error)
+	// StartTssRotation schedules a keygen and rotates the TSS once the new key is generated
+	StartTssRotation(ctx context.Context, in *MsgStartTssRotation, opts ...grpc.CallOption) (*MsgStartTssRotationResponse, error)
+	// CancelTssRotation cancels a TSS rotation before the funds are migrated
+	CancelTssRotation(ctx context.Context, in *MsgCancelTssRotation, opts ...grpc.CallOption) (*MsgCancelTssRotationResponse, error)
+	// DeleteBallot deletes a ballot
+	// NOTE: This is a temporary maintenance-only operation that will be removed
+	// after the next upgrade
+	DeleteBallot(ctx context.Context, in *MsgDeleteBallot, opts ...grpc.CallOption) (*MsgDeleteBallotResponse, error)
 }
 
-func (m *MsgAddBlameVote) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) AddObserver(ctx context.Context, in *MsgAddObserver, opts ...grpc.CallOption) (*MsgAddObserverResponse, error) {
+	out := new(MsgAddObserverResponse)
+	err := c.cc.Invoke(ctx, "/relayer.Msg/AddObserver", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgAddBlameVote) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateObserver(ctx context.Context, in *MsgUpdateObserver, opts ...grpc.CallOption) (*MsgUpdateObserverResponse, error) {
+	out := new(MsgUpdateObserverResponse)
+	err := c.cc.Invoke(ctx, "/relayer.Msg/UpdateObserver", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgAddBlameVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	{
-		size, err := m.BlameInfo.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if m.ChainId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x8
+func (c *msgClient) UpsertChainParams(ctx context.Context, in *MsgUpsertChainParams, opts ...grpc.CallOption) (*MsgUpsertChainParamsResponse, error) {
+	out := new(MsgUpsertChainParamsResponse)
+	err := c.cc.Invoke(ctx, "/relayer.Msg/UpsertChainParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgAddBlameVoteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RemoveChainParams(ctx context.Context, in *MsgRemoveChainParams, opts ...grpc.CallOption) (*MsgRemoveChainParamsResponse, error) {
+	out := new(MsgRemoveChainParamsResponse)
+	err := c.cc.Invoke(ctx, "/relayer.Msg/RemoveChainParams", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgAddBlameVoteResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AddBlameVote(ctx context.Context, in *MsgAddBlameVote, opts ...grpc.CallOption) (*MsgAddBlameVoteResponse, error) {
+	out := new(MsgAddBlameVoteResponse)
+	err := c.cc.Invoke(ctx, "/relayer.Msg/AddBlameVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgAddBlameVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) UpsertCrosschainFlags(ctx context.Context, in *MsgUpsertCrosschainFlags, opts ...grpc.CallOption) (*MsgUpsertCrosschainFlagsResponse, error) {
+	out := new(MsgUpsertCrosschainFlagsResponse)
+	err := c.cc.Invoke(ctx, "/relayer.Msg/UpsertCrosschainFlags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpsertCrosschainFlags) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateKeygen(ctx context.Context, in *MsgUpdateKeygen, opts ...grpc.CallOption) (*MsgUpdateKeygenResponse, error) {
+	out := new(MsgUpdateKeygenResponse)
+	err := c.cc.Invoke(ctx, "/relayer.Msg/UpdateKeygen", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpsertCrosschainFlags) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ResetChainNonces(ctx context.Context, in *MsgResetChainNonces, opts ...grpc.CallOption) (*MsgResetChainNoncesResponse, error) {
+	out := new(MsgResetChainNoncesResponse)
+	err := c.cc.Invoke(ctx, "/relayer.Msg/ResetChainNonces", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpsertCrosschainFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockHeaderVerificationFlags != nil {
-		{
-			size, err := m.BlockHeaderVerificationFlags.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.GasPriceIncreaseFlags != nil {
-		{
-			size, err := m.GasPriceIncreaseFlags.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.IsOutboundEnabled {
-		i--
-		if m.IsOutboundEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.IsInboundEnabled {
-		i--
-		if m.IsInboundEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) VoteBlockHeader(ctx context.Context, in *MsgVoteBlockHeader, opts ...grpc.CallOption) (*MsgVoteBlockHeaderResponse, error) {
+	out := new(MsgVoteBlockHeaderResponse)
+	err := c.cc.Invoke(ctx, "/relayer.Msg/VoteBlockHeader", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUpsertCrosschainFlagsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) VoteTSS(ctx context.Context, in *MsgVoteTSS, opts ...grpc.CallOption) (*MsgVoteTSSResponse, error) {
+	out := new(MsgVoteTSSResponse)
+	err := c.cc.Invoke(ctx, "/relayer.Msg/VoteTSS", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpsertCrosschainFlagsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpsertCrosschainFlagsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUpdateKeygen) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AddChain(ctx context.Context, in *MsgAddChain, opts ...grpc.CallOption) (*MsgAddChainResponse, error) {
+	out := new(MsgAddChainResponse)
+	err := c.cc.Invoke(ctx, "/relayer.Msg/AddChain", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateKeygen) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgUpdateKeygen) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Block != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Block))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) UpdateChain(ctx context.Context, in *MsgUpdateChain, opts ...grpc.CallOption) (*MsgUpdateChainResponse, error) {
+	out := new(MsgUpdateChainResponse)
+	err := c.cc.Invoke(ctx, "/relayer.Msg/UpdateChain", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUpdateKeygenResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DeprecateChain(ctx context.Context, in *MsgDeprecateChain, opts ...grpc.CallOption) (*MsgDeprecateChainResponse, error) {
+	out := new(MsgDeprecateChainResponse)
+	err := c.cc.Invoke(ctx, "/relayer.Msg/DeprecateChain", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateKeygenResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateKeygenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgResetChainNonces) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateRestrictedAddresses(ctx context.Context, in *MsgUpdateRestrictedAddresses, opts ...grpc.CallOption) (*MsgUpdateRestrictedAddressesResponse, error) {
+	out := new(MsgUpdateRestrictedAddressesResponse)
+	err := c.cc.Invoke(ctx, "/relayer.Msg/UpdateRestrictedAddresses", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgResetChainNonces) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgResetChainNonces) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ChainNonceHigh != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ChainNonceHigh))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.ChainNonceLow != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ChainNonceLow))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.ChainId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) UnjailObserver(ctx context.Context, in *MsgUnjailObserver, opts ...grpc.CallOption) (*MsgUnjailObserverResponse, error) {
+	out := new(MsgUnjailObserverResponse)
+	err := c.cc.Invoke(ctx, "/relayer.Msg/UnjailObserver", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgResetChainNoncesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateChainPause(ctx context.Context, in *MsgUpdateChainPause, opts ...grpc.CallOption) (*MsgUpdateChainPauseResponse, error) {
+	out := new(MsgUpdateChainPauseResponse)
+	err := c.cc.Invoke(ctx, "/relayer.Msg/UpdateChainPause", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgResetChainNoncesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgResetChainNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgVoteTSS) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) StartTssRotation(ctx context.Context, in *MsgStartTssRotation, opts ...grpc.CallOption) (*MsgStartTssRotationResponse, error) {
+	out := new(MsgStartTssRotationResponse)
+	err := c.cc.Invoke(ctx, "/relayer.Msg/StartTssRotation", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgVoteTSS) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgVoteTSS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Status != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.KeygenPellHeight != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.KeygenPellHeight))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.TssPubkey) > 0 {
-		i -= len(m.TssPubkey)
-		copy(dAtA[i:], m.TssPubkey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TssPubkey)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) CancelTssRotation(ctx context.Context, in *MsgCancelTssRotation, opts ...grpc.CallOption) (*MsgCancelTssRotationResponse, error) {
+	out := new(MsgCancelTssRotationResponse)
+	err := c.cc.Invoke(ctx, "/relayer.Msg/CancelTssRotation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgVoteTSSResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DeleteBallot(ctx context.Context, in *MsgDeleteBallot, opts ...grpc.CallOption) (*MsgDeleteBallotResponse, error) {
+	out := new(MsgDeleteBallotResponse)
+	err := c.cc.Invoke(ctx, "/relayer.Msg/DeleteBallot", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgVoteTSSResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgVoteTSSResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.KeygenSuccess {
-		i--
-		if m.KeygenSuccess {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.VoteFinalized {
-		i--
-		if m.VoteFinalized {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.BallotCreated {
-		i--
-		if m.BallotCreated {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// AddObserver adds an observer
+	AddObserver(context.Context, *MsgAddObserver) (*MsgAddObserverResponse, error)
+	// UpdateObserver updates an observer
+	UpdateObserver(context.Context, *MsgUpdateObserver) (*MsgUpdateObserverResponse, error)
+	// UpsertChainParams upserts chain parameters
+	UpsertChainParams(context.Context, *MsgUpsertChainParams) (*MsgUpsertChainParamsResponse, error)
+	// RemoveChainParams removes chain parameters
+	RemoveChainParams(context.Context, *MsgRemoveChainParams) (*MsgRemoveChainParamsResponse, error)
+	// AddBlameVote adds a blame vote
+	AddBlameVote(context.Context, *MsgAddBlameVote) (*MsgAddBlameVoteResponse, error)
+	// UpsertCrosschainFlags upserts crosschain flags
+	UpsertCrosschainFlags(context.Context, *MsgUpsertCrosschainFlags) (*MsgUpsertCrosschainFlagsResponse, error)
+	// UpdateKeygen updates keygen
+	UpdateKeygen(context.Context, *MsgUpdateKeygen) (*MsgUpdateKeygenResponse, error)
+	// ResetChainNonces resets chain nonces
+	ResetChainNonces(context.Context, *MsgResetChainNonces) (*MsgResetChainNoncesResponse, error)
+	// VoteBlockHeader votes for a block header
+	VoteBlockHeader(context.Context, *MsgVoteBlockHeader) (*MsgVoteBlockHeaderResponse, error)
+	// VoteTSS votes for TSS
+	VoteTSS(context.Context, *MsgVoteTSS) (*MsgVoteTSSResponse, error)
+	// AddChain adds a chain to the chain registry
+	AddChain(context.Context, *MsgAddChain) (*MsgAddChainResponse, error)
+	// UpdateChain updates a chain in the chain registry
+	UpdateChain(context.Context, *MsgUpdateChain) (*MsgUpdateChainResponse, error)
+	// DeprecateChain deprecates a chain in the chain registry
+	DeprecateChain(context.Context, *MsgDeprecateChain) (*MsgDeprecateChainResponse, error)
+	// UpdateRestrictedAddresses updates the restricted address list used for
+	// compliance screening
+	UpdateRestrictedAddresses(context.Context, *MsgUpdateRestrictedAddresses) (*MsgUpdateRestrictedAddressesResponse, error)
+	// UnjailObserver unjails an observer jailed for blames or missed ballots
+	UnjailObserver(context.Context, *MsgUnjailObserver) (*MsgUnjailObserverResponse, error)
+	// UpdateChainPause pauses or resumes inbound, outbound and signing for a single chain
+	UpdateChainPause(context.Context, *MsgUpdateChainPause) (*MsgUpdateChainPauseResponse, error)
+	// StartTssRotation schedules a keygen and rotates the TSS once the new key is generated
+	StartTssRotation(context.Context, *MsgStartTssRotation) (*MsgStartTssRotationResponse, error)
+	// CancelTssRotation cancels a TSS rotation before the funds are migrated
+	CancelTssRotation(context.Context, *MsgCancelTssRotation) (*MsgCancelTssRotationResponse, error)
+	// DeleteBallot deletes a ballot
+	// NOTE: This is a temporary maintenance-only operation that will be removed
+	// after the next upgrade
+	DeleteBallot(context.Context, *MsgDeleteBallot) (*MsgDeleteBallotResponse, error)
 }
 
-func (m *MsgDeleteBallot) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgDeleteBallot) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) AddObserver(ctx context.Context, req *MsgAddObserver) (*MsgAddObserverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddObserver not implemented")
 }
-
-func (m *MsgDeleteBallot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BallotIndex) > 0 {
-		i -= len(m.BallotIndex)
-		copy(dAtA[i:], m.BallotIndex)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BallotIndex)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) UpdateObserver(ctx context.Context, req *MsgUpdateObserver) (*MsgUpdateObserverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateObserver not implemented")
 }
-
-func (m *MsgDeleteBallotResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) UpsertChainParams(ctx context.Context, req *MsgUpsertChainParams) (*MsgUpsertChainParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertChainParams not implemented")
 }
-
-func (m *MsgDeleteBallotResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) RemoveChainParams(ctx context.Context, req *MsgRemoveChainParams) (*MsgRemoveChainParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveChainParams not implemented")
 }
-
-func (m *MsgDeleteBallotResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) AddBlameVote(ctx context.Context, req *MsgAddBlameVote) (*MsgAddBlameVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBlameVote not implemented")
+}
+func (*UnimplementedMsgServer) UpsertCrosschainFlags(ctx context.Context, req *MsgUpsertCrosschainFlags) (*MsgUpsertCrosschainFlagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertCrosschainFlags not implemented")
+}
+func (*UnimplementedMsgServer) UpdateKeygen(ctx context.Context, req *MsgUpdateKeygen) (*MsgUpdateKeygenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateKeygen not implemented")
+}
+func (*UnimplementedMsgServer) ResetChainNonces(ctx context.Context, req *MsgResetChainNonces) (*MsgResetChainNoncesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetChainNonces not implemented")
+}
+func (*UnimplementedMsgServer) VoteBlockHeader(ctx context.Context, req *MsgVoteBlockHeader) (*MsgVoteBlockHeaderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteBlockHeader not implemented")
+}
+func (*UnimplementedMsgServer) VoteTSS(ctx context.Context, req *MsgVoteTSS) (*MsgVoteTSSResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteTSS not implemented")
+}
+func (*UnimplementedMsgServer) AddChain(ctx context.Context, req *MsgAddChain) (*MsgAddChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddChain not implemented")
+}
+func (*UnimplementedMsgServer) UpdateChain(ctx context.Context, req *MsgUpdateChain) (*MsgUpdateChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChain not implemented")
+}
+func (*UnimplementedMsgServer) DeprecateChain(ctx context.Context, req *MsgDeprecateChain) (*MsgDeprecateChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeprecateChain not implemented")
+}
+func (*UnimplementedMsgServer) UpdateRestrictedAddresses(ctx context.Context, req *MsgUpdateRestrictedAddresses) (*MsgUpdateRestrictedAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRestrictedAddresses not implemented")
+}
+func (*UnimplementedMsgServer) UnjailObserver(ctx context.Context, req *MsgUnjailObserver) (*MsgUnjailObserverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnjailObserver not implemented")
+}
+func (*UnimplementedMsgServer) UpdateChainPause(ctx context.Context, req *MsgUpdateChainPause) (*MsgUpdateChainPauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChainPause not implemented")
+}
+func (*UnimplementedMsgServer) StartTssRotation(ctx context.Context, req *MsgStartTssRotation) (*MsgStartTssRotationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartTssRotation not implemented")
+}
+func (*UnimplementedMsgServer) CancelTssRotation(ctx context.Context, req *MsgCancelTssRotation) (*MsgCancelTssRotationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTssRotation not implemented")
+}
+func (*UnimplementedMsgServer) DeleteBallot(ctx context.Context, req *MsgDeleteBallot) (*MsgDeleteBallotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBallot not implemented")
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
-func (m *MsgUpdateObserver) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.OldObserverAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+
+func _Msg_AddObserver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddObserver)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.NewObserverAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).AddObserver(ctx, in)
 	}
-	if m.UpdateReason != 0 {
-		n += 1 + sovTx(uint64(m.UpdateReason))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relayer.Msg/AddObserver",
 	}
-	return n
-}
-
-func (m *MsgUpdateObserverResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddObserver(ctx, req.(*MsgAddObserver))
 	}
-	var l int
-	_ = l
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgVoteBlockHeader) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ChainId != 0 {
-		n += 1 + sovTx(uint64(m.ChainId))
+func _Msg_UpdateObserver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateObserver)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.BlockHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateObserver(ctx, in)
 	}
-	if m.Height != 0 {
-		n += 1 + sovTx(uint64(m.Height))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relayer.Msg/UpdateObserver",
 	}
-	l = m.Header.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateObserver(ctx, req.(*MsgUpdateObserver))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgVoteBlockHeaderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpsertChainParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpsertChainParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.BallotCreated {
-		n += 2
+	if interceptor == nil {
+		return srv.(MsgServer).UpsertChainParams(ctx, in)
 	}
-	if m.VoteFinalized {
-		n += 2
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relayer.Msg/UpsertChainParams",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpsertChainParams(ctx, req.(*MsgUpsertChainParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpsertChainParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_RemoveChainParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveChainParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ChainParams != nil {
-		l = m.ChainParams.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveChainParams(ctx, in)
 	}
-	return n
-}
-
-func (m *MsgUpsertChainParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relayer.Msg/RemoveChainParams",
 	}
-	var l int
-	_ = l
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveChainParams(ctx, req.(*MsgRemoveChainParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRemoveChainParams) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_AddBlameVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddBlameVote)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).AddBlameVote(ctx, in)
 	}
-	if m.ChainId != 0 {
-		n += 1 + sovTx(uint64(m.ChainId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relayer.Msg/AddBlameVote",
 	}
-	return n
-}
-
-func (m *MsgRemoveChainParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddBlameVote(ctx, req.(*MsgAddBlameVote))
 	}
-	var l int
-	_ = l
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddObserver) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_UpsertCrosschainFlags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpsertCrosschainFlags)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.ObserverAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpsertCrosschainFlags(ctx, in)
 	}
-	l = len(m.PellclientGranteePubkey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relayer.Msg/UpsertCrosschainFlags",
 	}
-	if m.AddNodeAccountOnly {
-		n += 2
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpsertCrosschainFlags(ctx, req.(*MsgUpsertCrosschainFlags))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddObserverResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateKeygen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateKeygen)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgAddBlameVote) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateKeygen(ctx, in)
 	}
-	var l int
-	_ = l
-	if m.ChainId != 0 {
-		n += 1 + sovTx(uint64(m.ChainId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relayer.Msg/UpdateKeygen",
 	}
-	l = m.BlameInfo.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateKeygen(ctx, req.(*MsgUpdateKeygen))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddBlameVoteResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ResetChainNonces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResetChainNonces)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgUpsertCrosschainFlags) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).ResetChainNonces(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relayer.Msg/ResetChainNonces",
 	}
-	if m.IsInboundEnabled {
-		n += 2
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResetChainNonces(ctx, req.(*MsgResetChainNonces))
 	}
-	if m.IsOutboundEnabled {
-		n += 2
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_VoteBlockHeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVoteBlockHeader)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.GasPriceIncreaseFlags != nil {
-		l = m.GasPriceIncreaseFlags.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).VoteBlockHeader(ctx, in)
 	}
-	if m.BlockHeaderVerificationFlags != nil {
-		l = m.BlockHeaderVerificationFlags.Size()
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relayer.Msg/VoteBlockHeader",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VoteBlockHeader(ctx, req.(*MsgVoteBlockHeader))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpsertCrosschainFlagsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_VoteTSS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVoteTSS)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).VoteTSS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relayer.Msg/VoteTSS",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VoteTSS(ctx, req.(*MsgVoteTSS))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateKeygen) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_AddChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddChain)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).AddChain(ctx, in)
 	}
-	if m.Block != 0 {
-		n += 1 + sovTx(uint64(m.Block))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relayer.Msg/AddChain",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddChain(ctx, req.(*MsgAddChain))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateKeygenResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateChain)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relayer.Msg/UpdateChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateChain(ctx, req.(*MsgUpdateChain))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgResetChainNonces) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_DeprecateChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeprecateChain)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ChainId != 0 {
-		n += 1 + sovTx(uint64(m.ChainId))
+	if interceptor == nil {
+		return srv.(MsgServer).DeprecateChain(ctx, in)
 	}
-	if m.ChainNonceLow != 0 {
-		n += 1 + sovTx(uint64(m.ChainNonceLow))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relayer.Msg/DeprecateChain",
 	}
-	if m.ChainNonceHigh != 0 {
-		n += 1 + sovTx(uint64(m.ChainNonceHigh))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeprecateChain(ctx, req.(*MsgDeprecateChain))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgResetChainNoncesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateRestrictedAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateRestrictedAddresses)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateRestrictedAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relayer.Msg/UpdateRestrictedAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateRestrictedAddresses(ctx, req.(*MsgUpdateRestrictedAddresses))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgVoteTSS) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UnjailObserver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnjailObserver)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.TssPubkey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UnjailObserver(ctx, in)
 	}
-	if m.KeygenPellHeight != 0 {
-		n += 1 + sovTx(uint64(m.KeygenPellHeight))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relayer.Msg/UnjailObserver",
 	}
-	if m.Status != 0 {
-		n += 1 + sovTx(uint64(m.Status))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnjailObserver(ctx, req.(*MsgUnjailObserver))
 	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateChainPause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateChainPause)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateChainPause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relayer.Msg/UpdateChainPause",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateChainPause(ctx, req.(*MsgUpdateChainPause))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgVoteTSSResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_StartTssRotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStartTssRotation)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.BallotCreated {
-		n += 2
+	if interceptor == nil {
+		return srv.(MsgServer).StartTssRotation(ctx, in)
 	}
-	if m.VoteFinalized {
-		n += 2
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relayer.Msg/StartTssRotation",
 	}
-	if m.KeygenSuccess {
-		n += 2
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StartTssRotation(ctx, req.(*MsgStartTssRotation))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteBallot) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CancelTssRotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelTssRotation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelTssRotation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relayer.Msg/CancelTssRotation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelTssRotation(ctx, req.(*MsgCancelTssRotation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteBallot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteBallot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteBallot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relayer.Msg/DeleteBallot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteBallot(ctx, req.(*MsgDeleteBallot))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "relayer.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddObserver",
+			Handler:    _Msg_AddObserver_Handler,
+		},
+		{
+			MethodName: "UpdateObserver",
+			Handler:    _Msg_UpdateObserver_Handler,
+		},
+		{
+			MethodName: "UpsertChainParams",
+			Handler:    _Msg_UpsertChainParams_Handler,
+		},
+		{
+			MethodName: "RemoveChainParams",
+			Handler:    _Msg_RemoveChainParams_Handler,
+		},
+		{
+			MethodName: "AddBlameVote",
+			Handler:    _Msg_AddBlameVote_Handler,
+		},
+		{
+			MethodName: "UpsertCrosschainFlags",
+			Handler:    _Msg_UpsertCrosschainFlags_Handler,
+		},
+		{
+			MethodName: "UpdateKeygen",
+			Handler:    _Msg_UpdateKeygen_Handler,
+		},
+		{
+			MethodName: "ResetChainNonces",
+			Handler:    _Msg_ResetChainNonces_Handler,
+		},
+		{
+			MethodName: "VoteBlockHeader",
+			Handler:    _Msg_VoteBlockHeader_Handler,
+		},
+		{
+			MethodName: "VoteTSS",
+			Handler:    _Msg_VoteTSS_Handler,
+		},
+		{
+			MethodName: "AddChain",
+			Handler:    _Msg_AddChain_Handler,
+		},
+		{
+			MethodName: "UpdateChain",
+			Handler:    _Msg_UpdateChain_Handler,
+		},
+		{
+			MethodName: "DeprecateChain",
+			Handler:    _Msg_DeprecateChain_Handler,
+		},
+		{
+			MethodName: "UpdateRestrictedAddresses",
+			Handler:    _Msg_UpdateRestrictedAddresses_Handler,
+		},
+		{
+			MethodName: "UnjailObserver",
+			Handler:    _Msg_UnjailObserver_Handler,
+		},
+		{
+			MethodName: "UpdateChainPause",
+			Handler:    _Msg_UpdateChainPause_Handler,
+		},
+		{
+			MethodName: "StartTssRotation",
+			Handler:    _Msg_StartTssRotation_Handler,
+		},
+		{
+			MethodName: "CancelTssRotation",
+			Handler:    _Msg_CancelTssRotation_Handler,
+		},
+		{
+			MethodName: "DeleteBallot",
+			Handler:    _Msg_DeleteBallot_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "relayer/tx.proto",
+}
+
+func (m *MsgUpdateObserver) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateObserver) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateObserver) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.UpdateReason != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UpdateReason))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.BallotIndex)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.NewObserverAddress) > 0 {
+		i -= len(m.NewObserverAddress)
+		copy(dAtA[i:], m.NewObserverAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewObserverAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.OldObserverAddress) > 0 {
+		i -= len(m.OldObserverAddress)
+		copy(dAtA[i:], m.OldObserverAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OldObserverAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteBallotResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateObserverResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateObserverResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateObserverResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgVoteBlockHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgVoteBlockHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteBlockHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVoteBlockHeaderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteBlockHeaderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteBlockHeaderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VoteFinalized {
+		i--
+		if m.VoteFinalized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BallotCreated {
+		i--
+		if m.BallotCreated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpsertChainParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpsertChainParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpsertChainParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainParams != nil {
+		{
+			size, err := m.ChainParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpsertChainParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpsertChainParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpsertChainParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveChainParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveChainParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveChainParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveChainParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveChainParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveChainParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddObserver) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddObserver) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddObserver) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AddNodeAccountOnly {
+		i--
+		if m.AddNodeAccountOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PellclientGranteePubkey) > 0 {
+		i -= len(m.PellclientGranteePubkey)
+		copy(dAtA[i:], m.PellclientGranteePubkey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PellclientGranteePubkey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ObserverAddress) > 0 {
+		i -= len(m.ObserverAddress)
+		copy(dAtA[i:], m.ObserverAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ObserverAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddObserverResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddObserverResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddObserverResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddBlameVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddBlameVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddBlameVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.BlameInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddBlameVoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddBlameVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddBlameVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpsertCrosschainFlags) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpsertCrosschainFlags) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpsertCrosschainFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeaderVerificationFlags != nil {
+		{
+			size, err := m.BlockHeaderVerificationFlags.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.GasPriceIncreaseFlags != nil {
+		{
+			size, err := m.GasPriceIncreaseFlags.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.IsOutboundEnabled {
+		i--
+		if m.IsOutboundEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.IsInboundEnabled {
+		i--
+		if m.IsInboundEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpsertCrosschainFlagsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpsertCrosschainFlagsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpsertCrosschainFlagsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateKeygen) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateKeygen) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateKeygen) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Block != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Block))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateKeygenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateKeygenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateKeygenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResetChainNonces) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResetChainNonces) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResetChainNonces) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainNonceHigh != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChainNonceHigh))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ChainNonceLow != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChainNonceLow))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResetChainNoncesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResetChainNoncesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResetChainNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVoteTSS) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteTSS) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteTSS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Status != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.KeygenPellHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.KeygenPellHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TssPubkey) > 0 {
+		i -= len(m.TssPubkey)
+		copy(dAtA[i:], m.TssPubkey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TssPubkey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVoteTSSResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteTSSResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteTSSResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.KeygenSuccess {
+		i--
+		if m.KeygenSuccess {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.VoteFinalized {
+		i--
+		if m.VoteFinalized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BallotCreated {
+		i--
+		if m.BallotCreated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddChain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddChain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Chain.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddChainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddChainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateChain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateChain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Chain.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateChainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateChainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeprecateChain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeprecateChain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeprecateChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeprecateChainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeprecateChainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeprecateChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateRestrictedAddresses) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateRestrictedAddresses) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRestrictedAddresses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemovedAddresses) > 0 {
+		for iNdEx := len(m.RemovedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemovedAddresses[iNdEx])
+			copy(dAtA[i:], m.RemovedAddresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.RemovedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AddedAddresses) > 0 {
+		for iNdEx := len(m.AddedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AddedAddresses[iNdEx])
+			copy(dAtA[i:], m.AddedAddresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AddedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateRestrictedAddressesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateRestrictedAddressesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRestrictedAddressesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnjailObserver) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnjailObserver) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnjailObserver) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnjailObserverResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnjailObserverResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnjailObserverResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateChainPause) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateChainPause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateChainPause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.IsSigningPaused {
+		i--
+		if m.IsSigningPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.IsOutboundPaused {
+		i--
+		if m.IsOutboundPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.IsInboundPaused {
+		i--
+		if m.IsInboundPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateChainPauseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateChainPauseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateChainPauseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStartTssRotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStartTssRotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStartTssRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Migrations) > 0 {
+		for iNdEx := len(m.Migrations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Migrations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.KeygenBlock != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.KeygenBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStartTssRotationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStartTssRotationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStartTssRotationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelTssRotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelTssRotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelTssRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelTssRotationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelTssRotationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelTssRotationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteBallot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteBallot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteBallot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BallotIndex) > 0 {
+		i -= len(m.BallotIndex)
+		copy(dAtA[i:], m.BallotIndex)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BallotIndex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteBallotResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteBallotResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteBallotResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateObserver) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OldObserverAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewObserverAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UpdateReason != 0 {
+		n += 1 + sovTx(uint64(m.UpdateReason))
+	}
+	return n
+}
+
+func (m *MsgUpdateObserverResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgVoteBlockHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovTx(uint64(m.ChainId))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	l = m.Header.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgVoteBlockHeaderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BallotCreated {
+		n += 2
+	}
+	if m.VoteFinalized {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgUpsertChainParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChainParams != nil {
+		l = m.ChainParams.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpsertChainParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveChainParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovTx(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *MsgRemoveChainParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddObserver) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ObserverAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PellclientGranteePubkey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AddNodeAccountOnly {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgAddObserverResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddBlameVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovTx(uint64(m.ChainId))
+	}
+	l = m.BlameInfo.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddBlameVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpsertCrosschainFlags) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.IsInboundEnabled {
+		n += 2
+	}
+	if m.IsOutboundEnabled {
+		n += 2
+	}
+	if m.GasPriceIncreaseFlags != nil {
+		l = m.GasPriceIncreaseFlags.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BlockHeaderVerificationFlags != nil {
+		l = m.BlockHeaderVerificationFlags.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpsertCrosschainFlagsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateKeygen) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Block != 0 {
+		n += 1 + sovTx(uint64(m.Block))
+	}
+	return n
+}
+
+func (m *MsgUpdateKeygenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResetChainNonces) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovTx(uint64(m.ChainId))
+	}
+	if m.ChainNonceLow != 0 {
+		n += 1 + sovTx(uint64(m.ChainNonceLow))
+	}
+	if m.ChainNonceHigh != 0 {
+		n += 1 + sovTx(uint64(m.ChainNonceHigh))
+	}
+	return n
+}
+
+func (m *MsgResetChainNoncesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgVoteTSS) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TssPubkey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.KeygenPellHeight != 0 {
+		n += 1 + sovTx(uint64(m.KeygenPellHeight))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgVoteTSSResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BallotCreated {
+		n += 2
+	}
+	if m.VoteFinalized {
+		n += 2
+	}
+	if m.KeygenSuccess {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgAddChain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Chain.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAddChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateChain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Chain.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeprecateChain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovTx(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *MsgDeprecateChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateRestrictedAddresses) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.AddedAddresses) > 0 {
+		for _, s := range m.AddedAddresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.RemovedAddresses) > 0 {
+		for _, s := range m.RemovedAddresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateRestrictedAddressesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnjailObserver) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnjailObserverResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateChainPause) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovTx(uint64(m.ChainId))
+	}
+	if m.IsInboundPaused {
+		n += 2
+	}
+	if m.IsOutboundPaused {
+		n += 2
+	}
+	if m.IsSigningPaused {
+		n += 2
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpiryHeight))
+	}
+	return n
+}
+
+func (m *MsgUpdateChainPauseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgStartTssRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.KeygenBlock != 0 {
+		n += 1 + sovTx(uint64(m.KeygenBlock))
+	}
+	if len(m.Migrations) > 0 {
+		for _, e := range m.Migrations {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgStartTssRotationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelTssRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelTssRotationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteBallot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BallotIndex)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteBallotResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateObserver) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateObserver: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateObserver: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldObserverAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldObserverAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewObserverAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewObserverAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateReason", wireType)
+			}
+			m.UpdateReason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateReason |= RelayerUpdateReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateObserverResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateObserverResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateObserverResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVoteBlockHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoteBlockHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoteBlockHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockHash == nil {
+				m.BlockHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVoteBlockHeaderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoteBlockHeaderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoteBlockHeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotCreated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BallotCreated = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteFinalized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.VoteFinalized = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpsertChainParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpsertChainParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpsertChainParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChainParams == nil {
+				m.ChainParams = &ChainParams{}
+			}
+			if err := m.ChainParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpsertChainParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpsertChainParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpsertChainParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveChainParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveChainParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveChainParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveChainParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveChainParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveChainParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddObserver) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddObserver: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddObserver: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PellclientGranteePubkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PellclientGranteePubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddNodeAccountOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AddNodeAccountOnly = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddObserverResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddObserverResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddObserverResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddBlameVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddBlameVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddBlameVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlameInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlameInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddBlameVoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddBlameVoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddBlameVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgUpdateObserver) Unmarshal(dAtA []byte) error {
+func (m *MsgUpsertCrosschainFlags) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2971,10 +6037,10 @@ func (m *MsgUpdateObserver) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateObserver: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpsertCrosschainFlags: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateObserver: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpsertCrosschainFlags: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3010,10 +6076,10 @@ func (m *MsgUpdateObserver) Unmarshal(dAtA []byte) error {
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OldObserverAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsInboundEnabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3023,29 +6089,37 @@ func (m *MsgUpdateObserver) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			m.IsInboundEnabled = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsOutboundEnabled", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.OldObserverAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			m.IsOutboundEnabled = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewObserverAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceIncreaseFlags", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3055,29 +6129,33 @@ func (m *MsgUpdateObserver) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewObserverAddress = string(dAtA[iNdEx:postIndex])
+			if m.GasPriceIncreaseFlags == nil {
+				m.GasPriceIncreaseFlags = &GasPriceIncreaseFlags{}
+			}
+			if err := m.GasPriceIncreaseFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateReason", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeaderVerificationFlags", wireType)
 			}
-			m.UpdateReason = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3087,11 +6165,28 @@ func (m *MsgUpdateObserver) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UpdateReason |= RelayerUpdateReason(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockHeaderVerificationFlags == nil {
+				m.BlockHeaderVerificationFlags = &BlockHeaderVerificationFlags{}
+			}
+			if err := m.BlockHeaderVerificationFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3113,7 +6208,7 @@ func (m *MsgUpdateObserver) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateObserverResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpsertCrosschainFlagsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3136,10 +6231,10 @@ func (m *MsgUpdateObserverResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateObserverResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpsertCrosschainFlagsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateObserverResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpsertCrosschainFlagsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3163,7 +6258,7 @@ func (m *MsgUpdateObserverResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVoteBlockHeader) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateKeygen) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3186,17 +6281,17 @@ func (m *MsgVoteBlockHeader) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVoteBlockHeader: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateKeygen: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVoteBlockHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateKeygen: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			m.ChainId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3206,16 +6301,148 @@ func (m *MsgVoteBlockHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			m.Block = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Block |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateKeygenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateKeygenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateKeygenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResetChainNonces) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResetChainNonces: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResetChainNonces: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3225,31 +6452,29 @@ func (m *MsgVoteBlockHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.BlockHash == nil {
-				m.BlockHash = []byte{}
-			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			m.Height = 0
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3259,16 +6484,16 @@ func (m *MsgVoteBlockHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				m.ChainId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainNonceLow", wireType)
 			}
-			var msglen int
+			m.ChainNonceLow = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3278,30 +6503,16 @@ func (m *MsgVoteBlockHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ChainNonceLow |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainNonceHigh", wireType)
 			}
-			var stringLen uint64
+			m.ChainNonceHigh = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3311,24 +6522,11 @@ func (m *MsgVoteBlockHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ChainNonceHigh |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3350,7 +6548,7 @@ func (m *MsgVoteBlockHeader) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVoteBlockHeaderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgResetChainNoncesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3373,52 +6571,12 @@ func (m *MsgVoteBlockHeaderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVoteBlockHeaderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResetChainNoncesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVoteBlockHeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResetChainNoncesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BallotCreated", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.BallotCreated = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteFinalized", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.VoteFinalized = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3440,7 +6598,7 @@ func (m *MsgVoteBlockHeaderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpsertChainParams) Unmarshal(dAtA []byte) error {
+func (m *MsgVoteTSS) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3463,15 +6621,15 @@ func (m *MsgUpsertChainParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpsertChainParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVoteTSS: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpsertChainParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVoteTSS: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TssPubkey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3499,13 +6657,51 @@ func (m *MsgUpsertChainParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.TssPubkey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeygenPellHeight", wireType)
+			}
+			m.KeygenPellHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeygenPellHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= chains.ReceiveStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3515,27 +6711,23 @@ func (m *MsgUpsertChainParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ChainParams == nil {
-				m.ChainParams = &ChainParams{}
-			}
-			if err := m.ChainParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3558,7 +6750,7 @@ func (m *MsgUpsertChainParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpsertChainParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgVoteTSSResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3581,12 +6773,72 @@ func (m *MsgUpsertChainParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpsertChainParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVoteTSSResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpsertChainParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVoteTSSResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotCreated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BallotCreated = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteFinalized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.VoteFinalized = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeygenSuccess", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.KeygenSuccess = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3608,7 +6860,7 @@ func (m *MsgUpsertChainParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveChainParams) Unmarshal(dAtA []byte) error {
+func (m *MsgAddChain) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3631,10 +6883,10 @@ func (m *MsgRemoveChainParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveChainParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddChain: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveChainParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddChain: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3670,10 +6922,10 @@ func (m *MsgRemoveChainParams) Unmarshal(dAtA []byte) error {
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
 			}
-			m.ChainId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3683,11 +6935,25 @@ func (m *MsgRemoveChainParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Chain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3709,7 +6975,7 @@ func (m *MsgRemoveChainParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveChainParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddChainResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3732,10 +6998,10 @@ func (m *MsgRemoveChainParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveChainParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddChainResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveChainParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3759,7 +7025,7 @@ func (m *MsgRemoveChainParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddObserver) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateChain) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3782,10 +7048,10 @@ func (m *MsgAddObserver) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddObserver: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateChain: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddObserver: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateChain: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3822,9 +7088,9 @@ func (m *MsgAddObserver) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3834,76 +7100,25 @@ func (m *MsgAddObserver) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PellclientGranteePubkey", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Chain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.PellclientGranteePubkey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddNodeAccountOnly", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.AddNodeAccountOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3925,7 +7140,7 @@ func (m *MsgAddObserver) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddObserverResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateChainResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3948,10 +7163,10 @@ func (m *MsgAddObserverResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddObserverResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateChainResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddObserverResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3975,7 +7190,7 @@ func (m *MsgAddObserverResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddBlameVote) Unmarshal(dAtA []byte) error {
+func (m *MsgDeprecateChain) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3998,36 +7213,17 @@ func (m *MsgAddBlameVote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddBlameVote: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeprecateChain: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddBlameVote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeprecateChain: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-			}
-			m.ChainId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlameInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4037,30 +7233,29 @@ func (m *MsgAddBlameVote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BlameInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var stringLen uint64
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4070,24 +7265,11 @@ func (m *MsgAddBlameVote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ChainId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4109,7 +7291,7 @@ func (m *MsgAddBlameVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddBlameVoteResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeprecateChainResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4132,10 +7314,10 @@ func (m *MsgAddBlameVoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddBlameVoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeprecateChainResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddBlameVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeprecateChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4159,7 +7341,7 @@ func (m *MsgAddBlameVoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpsertCrosschainFlags) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateRestrictedAddresses) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4182,10 +7364,10 @@ func (m *MsgUpsertCrosschainFlags) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpsertCrosschainFlags: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateRestrictedAddresses: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpsertCrosschainFlags: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateRestrictedAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4221,50 +7403,10 @@ func (m *MsgUpsertCrosschainFlags) Unmarshal(dAtA []byte) error {
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsInboundEnabled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsInboundEnabled = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsOutboundEnabled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsOutboundEnabled = bool(v != 0)
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceIncreaseFlags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedAddresses", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4274,33 +7416,29 @@ func (m *MsgUpsertCrosschainFlags) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GasPriceIncreaseFlags == nil {
-				m.GasPriceIncreaseFlags = &GasPriceIncreaseFlags{}
-			}
-			if err := m.GasPriceIncreaseFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AddedAddresses = append(m.AddedAddresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeaderVerificationFlags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAddresses", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4310,27 +7448,23 @@ func (m *MsgUpsertCrosschainFlags) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BlockHeaderVerificationFlags == nil {
-				m.BlockHeaderVerificationFlags = &BlockHeaderVerificationFlags{}
-			}
-			if err := m.BlockHeaderVerificationFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RemovedAddresses = append(m.RemovedAddresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4353,7 +7487,7 @@ func (m *MsgUpsertCrosschainFlags) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpsertCrosschainFlagsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateRestrictedAddressesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4376,10 +7510,10 @@ func (m *MsgUpsertCrosschainFlagsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpsertCrosschainFlagsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateRestrictedAddressesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpsertCrosschainFlagsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateRestrictedAddressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4403,7 +7537,7 @@ func (m *MsgUpsertCrosschainFlagsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateKeygen) Unmarshal(dAtA []byte) error {
+func (m *MsgUnjailObserver) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4426,10 +7560,10 @@ func (m *MsgUpdateKeygen) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateKeygen: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnjailObserver: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateKeygen: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnjailObserver: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4446,43 +7580,24 @@ func (m *MsgUpdateKeygen) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
-			}
-			m.Block = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Block |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4504,7 +7619,7 @@ func (m *MsgUpdateKeygen) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateKeygenResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnjailObserverResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4527,10 +7642,10 @@ func (m *MsgUpdateKeygenResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateKeygenResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnjailObserverResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateKeygenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnjailObserverResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4554,7 +7669,7 @@ func (m *MsgUpdateKeygenResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgResetChainNonces) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateChainPause) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4577,10 +7692,10 @@ func (m *MsgResetChainNonces) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgResetChainNonces: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateChainPause: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgResetChainNonces: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateChainPause: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4636,9 +7751,9 @@ func (m *MsgResetChainNonces) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainNonceLow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsInboundPaused", wireType)
 			}
-			m.ChainNonceLow = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4648,16 +7763,17 @@ func (m *MsgResetChainNonces) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainNonceLow |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.IsInboundPaused = bool(v != 0)
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainNonceHigh", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsOutboundPaused", wireType)
 			}
-			m.ChainNonceHigh = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4667,7 +7783,47 @@ func (m *MsgResetChainNonces) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainNonceHigh |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsOutboundPaused = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsSigningPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsSigningPaused = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4693,7 +7849,7 @@ func (m *MsgResetChainNonces) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgResetChainNoncesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateChainPauseResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4716,10 +7872,10 @@ func (m *MsgResetChainNoncesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgResetChainNoncesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateChainPauseResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgResetChainNoncesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateChainPauseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4743,7 +7899,7 @@ func (m *MsgResetChainNoncesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVoteTSS) Unmarshal(dAtA []byte) error {
+func (m *MsgStartTssRotation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4766,15 +7922,15 @@ func (m *MsgVoteTSS) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVoteTSS: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgStartTssRotation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVoteTSS: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgStartTssRotation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TssPubkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4802,13 +7958,13 @@ func (m *MsgVoteTSS) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TssPubkey = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeygenPellHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeygenBlock", wireType)
 			}
-			m.KeygenPellHeight = 0
+			m.KeygenBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4818,16 +7974,16 @@ func (m *MsgVoteTSS) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.KeygenPellHeight |= int64(b&0x7F) << shift
+				m.KeygenBlock |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Migrations", wireType)
 			}
-			m.Status = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4837,12 +7993,127 @@ func (m *MsgVoteTSS) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= chains.ReceiveStatus(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Migrations = append(m.Migrations, TssRotationMigration{})
+			if err := m.Migrations[len(m.Migrations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStartTssRotationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStartTssRotationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStartTssRotationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelTssRotation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelTssRotation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelTssRotation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -4895,7 +8166,7 @@ func (m *MsgVoteTSS) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVoteTSSResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelTssRotationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4918,72 +8189,12 @@ func (m *MsgVoteTSSResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVoteTSSResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelTssRotationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVoteTSSResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelTssRotationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BallotCreated", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.BallotCreated = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteFinalized", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.VoteFinalized = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeygenSuccess", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.KeygenSuccess = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
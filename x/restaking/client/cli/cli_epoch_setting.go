@@ -46,3 +46,38 @@ func CmdUpdateBlocksPerEpoch() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdUpdateSharesHistoryRetention() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-shares-history-retention [retention-epochs]",
+		Short: "update the number of epochs the operator shares history is retained",
+		Long: `update the number of epochs the operator shares history is retained.
+
+		Parameters:
+		  retention-epochs: number of epochs to retain, 0 keeps the history forever (uint64)
+		Example:
+		  pellcored tx restaking update-shares-history-retention 1000 --from mykey`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			retentionEpochs, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid retention epochs: %w", err)
+			}
+
+			msg := &restakingtypes.MsgUpdateSharesHistoryRetention{
+				Signer:          clientCtx.GetFromAddress().String(),
+				RetentionEpochs: retentionEpochs,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -29,6 +29,9 @@ func GetQueryCmd(_ string) *cobra.Command {
 	cmd.AddCommand(CmdDVSRegistryRouterList())
 	cmd.AddCommand(CmdDVSGroupDataList())
 	cmd.AddCommand(CmdDVSGroupOperatorRegistrationList())
+	cmd.AddCommand(CmdQueryOperatorSharesAtEpoch())
+	cmd.AddCommand(CmdQueryOperatorSharesDiff())
+	cmd.AddCommand(CmdQueryGroupOperatorsAtEpoch())
 
 	return cmd
 }
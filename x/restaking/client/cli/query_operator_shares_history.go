@@ -0,0 +1,149 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/0xPellNetwork/aegis/x/restaking/types"
+)
+
+const flagOperator = "operator"
+
+func CmdQueryOperatorSharesAtEpoch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "operator-shares-at-epoch [chain-id] [epoch-number]",
+		Short: "Query operator shares of a chain at an epoch",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			chainID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid chain id: %w", err)
+			}
+
+			epochNumber, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid epoch number: %w", err)
+			}
+
+			operator, err := cmd.Flags().GetString(flagOperator)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.GetOperatorSharesAtEpoch(cmd.Context(), &types.QueryOperatorSharesAtEpochRequest{
+				ChainId:     chainID,
+				Operator:    operator,
+				EpochNumber: epochNumber,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagOperator, "", "only query the shares of this operator")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryOperatorSharesDiff() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "operator-shares-diff [chain-id] [from-epoch] [to-epoch]",
+		Short: "Query operator shares of a chain changed between two epochs",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			chainID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid chain id: %w", err)
+			}
+
+			fromEpoch, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid from epoch: %w", err)
+			}
+
+			toEpoch, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid to epoch: %w", err)
+			}
+
+			operator, err := cmd.Flags().GetString(flagOperator)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.GetOperatorSharesDiff(cmd.Context(), &types.QueryOperatorSharesDiffRequest{
+				ChainId:   chainID,
+				Operator:  operator,
+				FromEpoch: fromEpoch,
+				ToEpoch:   toEpoch,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagOperator, "", "only query the shares of this operator")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryGroupOperatorsAtEpoch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "group-operators-at-epoch [registry-router-address] [group-number] [epoch-number]",
+		Short: "Query operators of a DVS group at an epoch",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupNumber, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid group number: %w", err)
+			}
+
+			epochNumber, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid epoch number: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.GetGroupOperatorsAtEpoch(cmd.Context(), &types.QueryGroupOperatorsAtEpochRequest{
+				RegistryRouterAddress: args[0],
+				GroupNumber:           uint32(groupNumber),
+				EpochNumber:           epochNumber,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
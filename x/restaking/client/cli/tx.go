@@ -21,6 +21,7 @@ func GetTxCmd() *cobra.Command {
 
 	cmd.AddCommand(CmdUpdateBlocksPerEpoch())
 	cmd.AddCommand(CmdUpsertOutboundState())
+	cmd.AddCommand(CmdUpdateSharesHistoryRetention())
 
 	return cmd
 }
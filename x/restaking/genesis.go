@@ -1,6 +1,9 @@
 package restaking
 
 import (
+	"slices"
+	"sort"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 
@@ -11,11 +14,38 @@ import (
 // InitGenesis initializes the pevm module's state from a provided genesis
 // state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	// import the shares history before the current state, the versions of an entry are recorded in epoch order
+	operatorSharesHistory := slices.Clone(genState.OperatorSharesHistory)
+	sort.SliceStable(operatorSharesHistory, func(i, j int) bool {
+		return operatorSharesHistory[i].EpochNumber < operatorSharesHistory[j].EpochNumber
+	})
+	for _, entry := range operatorSharesHistory {
+		k.SetOperatorSharesHistoryEntry(ctx, entry)
+	}
+
+	groupOperatorHistory := slices.Clone(genState.GroupOperatorHistory)
+	sort.SliceStable(groupOperatorHistory, func(i, j int) bool {
+		return groupOperatorHistory[i].EpochNumber < groupOperatorHistory[j].EpochNumber
+	})
+	for _, entry := range groupOperatorHistory {
+		k.SetGroupOperatorHistoryEntry(ctx, entry)
+	}
+
+	k.SetEpochNumber(ctx, genState.EpochNumber)
+	k.SetSharesHistoryRetention(ctx, genState.SharesHistoryRetention)
+	k.SetSharesHistoryPrunedBefore(ctx, genState.SharesHistoryPrunedBefore)
+
 	// import all operator shares
 	for _, share := range genState.OperatorShare {
 		k.SetOperatorShares(ctx, share.ChainId, share.Operator, share.Strategy, share.Shares)
 	}
 
+	// import all staker delegations, the shares of unknown stakers are attributed to the untracked staker
+	for _, delegation := range genState.StakerDelegations {
+		k.SetStakerDelegation(ctx, delegation)
+	}
+	k.BackfillStakerDelegations(ctx)
+
 	// import all registry router addresses
 	for _, registryRouter := range genState.RegistryRouterData {
 		registryRouterAddr := common.HexToAddress(registryRouter.RegistryRouterSet.RegistryRouterAddress)
@@ -121,7 +151,13 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	}
 
 	return &types.GenesisState{
-		OperatorShare:      operatorShares,
-		RegistryRouterData: registryRouterDataList,
+		OperatorShare:             operatorShares,
+		RegistryRouterData:        registryRouterDataList,
+		StakerDelegations:         k.GetAllStakerDelegations(ctx),
+		EpochNumber:               k.GetEpochNumber(ctx),
+		SharesHistoryRetention:    k.GetSharesHistoryRetention(ctx),
+		SharesHistoryPrunedBefore: k.GetSharesHistoryPrunedBefore(ctx),
+		OperatorSharesHistory:     k.GetAllOperatorSharesHistory(ctx),
+		GroupOperatorHistory:      k.GetAllGroupOperatorHistory(ctx),
 	}
 }
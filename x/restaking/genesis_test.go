@@ -298,3 +298,71 @@ func TestGenesis(t *testing.T) {
 		}
 	}
 }
+
+// TestGenesis_SharesHistory verifies that the shares history and its retention survive an export and import
+func TestGenesis_SharesHistory(t *testing.T) {
+	shares := func(amount int64) types.OperatorShares {
+		return types.OperatorShares{
+			ChainId:  1,
+			Operator: "0x1234567890123456789012345678901234567890",
+			Strategy: "strategy1",
+			Shares:   math.NewInt(amount),
+		}
+	}
+	registrations := types.GroupOperatorRegistrationListV2{
+		OperatorRegisteredInfos: []*types.GroupOperatorRegistrationV2{
+			{
+				Operator:     "0x1234567890123456789012345678901234567890",
+				OperatorId:   []byte{0x01},
+				GroupNumbers: []byte{0x01},
+			},
+		},
+	}
+
+	genesisState := types.GenesisState{
+		EpochNumber:               10,
+		SharesHistoryRetention:    5,
+		SharesHistoryPrunedBefore: 4,
+		OperatorSharesHistory: []types.OperatorSharesHistoryEntry{
+			{EpochNumber: 6, Shares: shares(2000)},
+			{EpochNumber: 3, Shares: shares(1000)},
+		},
+		GroupOperatorHistory: []types.GroupOperatorHistoryEntry{
+			{
+				RegistryRouterAddress: "0xABcdEFABcdEFabcdEfAbCdefabcdeFABcDEFabCD",
+				EpochNumber:           5,
+				Registrations:         registrations,
+			},
+		},
+	}
+	require.NoError(t, genesisState.Validate())
+
+	k, ctx := keepertest.RestakingKeeperAllMocks(t)
+	restaking.InitGenesis(ctx, *k, genesisState)
+
+	got := restaking.ExportGenesis(ctx, *k)
+	require.NotNil(t, got)
+	require.EqualValues(t, 10, got.EpochNumber)
+	require.EqualValues(t, 5, got.SharesHistoryRetention)
+	require.EqualValues(t, 4, got.SharesHistoryPrunedBefore)
+	require.Equal(t, []types.OperatorSharesHistoryEntry{
+		{EpochNumber: 3, Shares: shares(1000)},
+		{EpochNumber: 6, Shares: shares(2000)},
+	}, got.OperatorSharesHistory)
+	require.Equal(t, genesisState.GroupOperatorHistory, got.GroupOperatorHistory)
+
+	// the imported history answers the point-in-time queries
+	atEpoch, err := k.OperatorSharesAtEpoch(ctx, 1, "", 5)
+	require.NoError(t, err)
+	require.Len(t, atEpoch, 1)
+	require.EqualValues(t, 1000, atEpoch[0].Shares.Int64())
+
+	_, err = k.OperatorSharesAtEpoch(ctx, 1, "", 3)
+	require.ErrorIs(t, err, types.ErrHistoryPruned)
+
+	// the imported history is pruned once it leaves the retention window
+	k.PruneSharesHistory(ctx, 12)
+	require.Equal(t, []types.OperatorSharesHistoryEntry{
+		{EpochNumber: 6, Shares: shares(2000)},
+	}, k.GetAllOperatorSharesHistory(ctx))
+}
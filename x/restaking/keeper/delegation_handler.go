@@ -108,6 +108,7 @@ func (h DelegationHandler) handleSharesIncreasedEvent(ctx sdk.Context, epochNum
 
 	operatorShares.Shares = operatorShares.Shares.Add(sdkmath.NewIntFromBigInt(event.Shares))
 
+	h.increaseStakerDelegation(ctx, event.ChainId.Uint64(), event.Operator.Hex(), event.Strategy.Hex(), event.Staker.Hex(), sdkmath.NewIntFromBigInt(event.Shares))
 	h.SetOperatorShares(ctx, event.ChainId.Uint64(), event.Operator.Hex(), event.Strategy.Hex(), operatorShares.Shares)
 
 	// share change record
@@ -156,6 +157,10 @@ func (h DelegationHandler) handleSharesDecreasedEvent(ctx sdk.Context, epochNum
 		return fmt.Errorf("handleSharesDecreasedEvent: operator shares less than event shares")
 	}
 
+	if err := h.decreaseStakerDelegation(ctx, event.ChainId.Uint64(), event.Operator.Hex(), event.Strategy.Hex(), event.Staker.Hex(), sdkmath.NewIntFromBigInt(event.Shares)); err != nil {
+		return fmt.Errorf("handleSharesDecreasedEvent: %w", err)
+	}
+
 	operatorShares.Shares = operatorShares.Shares.Sub(sdkmath.NewIntFromBigInt(event.Shares))
 
 	h.SetOperatorShares(ctx, event.ChainId.Uint64(), event.Operator.Hex(), event.Strategy.Hex(), operatorShares.Shares)
@@ -64,14 +64,13 @@ func (k Keeper) syncSharesByEpochRange(ctx sdk.Context, blockNum uint64, chainPa
 	return xmsgIndexes, nil
 }
 
-// Synchronize all operator shares information
-func (k Keeper) syncAllShares(ctx sdk.Context, blockNum uint64, outboundState *types.EpochOutboundState, chainParams *relayertypes.ChainParams) (xmsgIndexes []string, err error) {
+// Synchronize all operator shares information as the snapshot of epochNumber
+func (k Keeper) syncAllShares(ctx sdk.Context, blockNum uint64, epochNumber uint64, outboundState *types.EpochOutboundState, chainParams *relayertypes.ChainParams) (xmsgIndexes []string, err error) {
 	operatorShares := k.GetAllShares(ctx)
 	if len(operatorShares) == 0 {
 		return nil, nil
 	}
 
-	endEpoch := k.GetEpochNumber(ctx)
 	syncIndex := 0
 
 	for i := 0; i < len(operatorShares); i += BATCH_SIZE {
@@ -87,7 +86,7 @@ func (k Keeper) syncAllShares(ctx sdk.Context, blockNum uint64, outboundState *t
 			return nil, err
 		}
 
-		xmsgIndex, err := k.processSyncEvent(ctx, endEpoch-1, blockNum, outboundState.ChainId, syncIndex, chainParams, event)
+		xmsgIndex, err := k.processSyncEvent(ctx, outboundState.ChainId, epochNumber, blockNum, syncIndex, chainParams, event)
 		if err != nil {
 			return nil, err
 		}
@@ -148,7 +147,7 @@ func (k Keeper) processSyncEvent(ctx sdk.Context, chainID, epochNumber, blockNum
 		return "", err
 	}
 
-	xmsg.SetPendingOutbound("PellConnector pell-send event setting to pending outbound directly")
+	xmsg.SetPendingOutbound(ctx, "PellConnector pell-send event setting to pending outbound directly")
 
 	// Get gas price and amount
 	gasprice, found := k.xmsgKeeper.GetGasPrice(ctx, int64(chainID))
@@ -156,7 +155,7 @@ func (k Keeper) processSyncEvent(ctx sdk.Context, chainID, epochNumber, blockNum
 		return "", fmt.Errorf("gasprice not found for %d", chainID)
 	}
 
-	xmsg.GetCurrentOutTxParam().OutboundTxGasPrice = fmt.Sprint(gasprice.Prices[gasprice.MedianIndex])
+	xmsg.GetCurrentOutTxParam().SetGasFromGasPrice(gasprice)
 
 	return xmsg.Index, k.xmsgKeeper.ProcessXmsg(ctx, *xmsg, receiverChain)
 }
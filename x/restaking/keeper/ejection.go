@@ -0,0 +1,299 @@
+package keeper
+
+import (
+	"math/big"
+	"sort"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/0xPellNetwork/aegis/pkg/utils"
+	pevmtypes "github.com/0xPellNetwork/aegis/x/pevm/types"
+	"github.com/0xPellNetwork/aegis/x/restaking/types"
+)
+
+// ejectionCandidate is a group operator whose stake is below the group minimum stake
+type ejectionCandidate struct {
+	registration *types.GroupOperatorRegistrationV2
+	stake        sdkmath.Int
+}
+
+// ProcessEjections ejects the operators whose stake fell below the minimum stake of their groups.
+// The ejected stake of a group is capped by its GroupEjectionParam within the rate limit window.
+//
+// The registrations are mirrored from the registry router on pEVM, so the ejected operators keep them and
+// are recorded as ejected from their groups instead, until the registry router registers or removes them.
+// The ejection is queued for the ejection manager of every DVS chain and delivered by DeliverPendingEjections.
+func (k Keeper) ProcessEjections(ctx sdk.Context, epochNumber uint64) {
+	registryRouters, err := k.GetAllRegistryRouterAddresses(ctx)
+	if err != nil {
+		return
+	}
+
+	for _, registryRouterAddr := range registryRouters {
+		operatorIds, ejected := k.ejectOperators(ctx, registryRouterAddr)
+		if !ejected {
+			continue
+		}
+
+		k.Logger(ctx).Info("restaking eject operators", "registryRouter", registryRouterAddr.Hex(), "epochNumber", epochNumber, "operatorIds", operatorIds)
+
+		k.queueEjection(ctx, epochNumber, registryRouterAddr, operatorIds)
+	}
+}
+
+// ejectOperators selects the operators to eject from every group of the registry router and records the ejections.
+// The operator ids are indexed by group number as expected by the ejection manager.
+func (k Keeper) ejectOperators(ctx sdk.Context, registryRouterAddr ethcommon.Address) ([][][32]byte, bool) {
+	groupList, exist := k.GetGroupDataList(ctx, registryRouterAddr)
+	if !exist {
+		return nil, false
+	}
+
+	registrations, exist := k.GetGroupOperatorRegistrationList(ctx, registryRouterAddr)
+	if !exist {
+		return nil, false
+	}
+
+	var operatorIds [][][32]byte
+	ejected := false
+
+	for _, group := range groupList.Groups {
+		groupOperatorIds := k.ejectGroupOperators(ctx, registryRouterAddr, group, registrations.OperatorRegisteredInfos)
+		if len(groupOperatorIds) == 0 {
+			continue
+		}
+
+		for uint64(len(operatorIds)) <= group.GroupNumber {
+			operatorIds = append(operatorIds, [][32]byte{})
+		}
+		operatorIds[group.GroupNumber] = groupOperatorIds
+		ejected = true
+	}
+
+	return operatorIds, ejected
+}
+
+// ejectGroupOperators ejects the operators of a group below its minimum stake, smallest stake first,
+// as long as the stake ejected within the rate limit window stays under the ejectable stake.
+func (k Keeper) ejectGroupOperators(
+	ctx sdk.Context,
+	registryRouterAddr ethcommon.Address,
+	group *types.Group,
+	registrations []*types.GroupOperatorRegistrationV2,
+) [][32]byte {
+	param := group.GroupEjectionParam
+	if param == nil || param.EjectableStakePercent == 0 || group.MinimumStake == 0 || group.GroupNumber > 0xff {
+		return nil
+	}
+
+	minimumStake := sdkmath.NewIntFromUint64(group.MinimumStake)
+	totalStake := sdkmath.ZeroInt()
+
+	var candidates []ejectionCandidate
+	for _, registration := range registrations {
+		if !containsGroup(registration.GroupNumbers, group.GroupNumber) || k.isEjectedFromGroup(ctx, registryRouterAddr, registration.OperatorId, group.GroupNumber) {
+			continue
+		}
+
+		stake := k.operatorStake(ctx, registration.Operator, group.PoolParams)
+		totalStake = totalStake.Add(stake)
+
+		if stake.LT(minimumStake) && len(registration.OperatorId) == 32 {
+			candidates = append(candidates, ejectionCandidate{registration: registration, stake: stake})
+		}
+	}
+
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if !candidates[i].stake.Equal(candidates[j].stake) {
+			return candidates[i].stake.LT(candidates[j].stake)
+		}
+		return candidates[i].registration.Operator < candidates[j].registration.Operator
+	})
+
+	// drop the ejections out of the rate limit window
+	now := ctx.BlockTime().Unix()
+	windowStart := now - int64(param.RateLimitWindow)
+
+	ejectionList := k.GetGroupEjectionList(ctx, registryRouterAddr, group.GroupNumber)
+	ejectedStake := sdkmath.ZeroInt()
+	ejections := ejectionList.Ejections[:0]
+	for _, ejection := range ejectionList.Ejections {
+		if ejection.Timestamp > windowStart {
+			ejections = append(ejections, ejection)
+			ejectedStake = ejectedStake.Add(ejection.Stake)
+		}
+	}
+
+	ejectableStake := totalStake.MulRaw(int64(param.EjectableStakePercent)).QuoRaw(types.BipsDenominator)
+
+	var operatorIds [][32]byte
+	for _, candidate := range candidates {
+		if ejectedStake.Add(candidate.stake).GT(ejectableStake) {
+			break
+		}
+
+		ejectedStake = ejectedStake.Add(candidate.stake)
+		ejections = append(ejections, types.GroupEjection{Timestamp: now, Stake: candidate.stake})
+
+		k.setEjectedFromGroup(ctx, registryRouterAddr, candidate.registration.OperatorId, group.GroupNumber)
+
+		var operatorId [32]byte
+		copy(operatorId[:], candidate.registration.OperatorId)
+		operatorIds = append(operatorIds, operatorId)
+	}
+
+	ejectionList.Ejections = ejections
+	k.SetGroupEjectionList(ctx, registryRouterAddr, group.GroupNumber, ejectionList)
+
+	return operatorIds
+}
+
+// operatorStake returns the weighted stake of an operator over the pools of a group
+func (k Keeper) operatorStake(ctx sdk.Context, operator string, poolParams []*types.PoolParams) sdkmath.Int {
+	operatorAddr := ethcommon.HexToAddress(operator).Hex()
+	stake := sdkmath.ZeroInt()
+
+	for _, pool := range poolParams {
+		shares := k.GetOperatorShares(ctx, pool.ChainId, operatorAddr, ethcommon.HexToAddress(pool.Pool).Hex())
+		if shares == nil {
+			continue
+		}
+
+		stake = stake.Add(shares.Shares.Mul(sdkmath.NewIntFromUint64(pool.Multiplier)))
+	}
+
+	return stake.Quo(sdkmath.NewIntFromBigInt(big.NewInt(types.StakeWeightingDivisor)))
+}
+
+// isEjectedFromGroup checks if an operator was ejected from the group by a previous ejection
+func (k Keeper) isEjectedFromGroup(ctx sdk.Context, registryRouterAddr ethcommon.Address, operatorId []byte, groupNumber uint64) bool {
+	ejectedOperator, found := k.GetEjectedOperator(ctx, registryRouterAddr, operatorId)
+	return found && containsGroup(ejectedOperator.GroupNumbers, groupNumber)
+}
+
+// setEjectedFromGroup records the ejection of an operator from the group
+func (k Keeper) setEjectedFromGroup(ctx sdk.Context, registryRouterAddr ethcommon.Address, operatorId []byte, groupNumber uint64) {
+	ejectedOperator, found := k.GetEjectedOperator(ctx, registryRouterAddr, operatorId)
+	if !found {
+		ejectedOperator = &types.EjectedOperator{}
+	}
+
+	ejectedOperator.GroupNumbers = append(ejectedOperator.GroupNumbers, byte(groupNumber))
+	k.SetEjectedOperator(ctx, registryRouterAddr, operatorId, ejectedOperator)
+}
+
+// queueEjection queues the ejection for every DVS chain of the registry router receiving the restaking shares.
+// All the queued copies share the same ejection id.
+func (k Keeper) queueEjection(ctx sdk.Context, epochNumber uint64, registryRouterAddr ethcommon.Address, operatorIds [][][32]byte) {
+	dvsInfoList, exist := k.GetDVSSupportedChainList(ctx, registryRouterAddr)
+	if !exist {
+		return
+	}
+
+	message, err := encodeSyncEjectOperators(operatorIds)
+	if err != nil {
+		k.Logger(ctx).Error("failed to encode eject operators", "error", err)
+		return
+	}
+
+	ejectionId := k.nextEjectionId(ctx)
+
+	for _, dvs := range dvsInfoList.DvsInfos {
+		if _, found := k.GetOutboundState(ctx, dvs.ChainId); !found {
+			continue
+		}
+
+		pendingList := k.GetPendingEjectionList(ctx, dvs.ChainId)
+		pendingList.Ejections = append(pendingList.Ejections, types.PendingEjection{
+			Id:             ejectionId,
+			EpochNumber:    epochNumber,
+			RegistryRouter: registryRouterAddr.Hex(),
+			Message:        message,
+		})
+		k.SetPendingEjectionList(ctx, dvs.ChainId, pendingList)
+	}
+}
+
+// DeliverPendingEjections sends the oldest pending ejection of every chain whose outbound is in sync, so the
+// ejections land after the shares sync of their epoch. The ejections of a chain are delivered one at a time and
+// stay queued until their xmsg is mined; a failed or timed out xmsg is sent again.
+func (k Keeper) DeliverPendingEjections(ctx sdk.Context) {
+	states, err := k.GetAllOutboundStates(ctx)
+	if err != nil {
+		return
+	}
+
+	for _, state := range states {
+		if state.OutboundStatus != types.OutboundStatus_OUTBOUND_STATUS_NORMAL {
+			continue
+		}
+
+		pendingList := k.GetPendingEjectionList(ctx, state.ChainId)
+		if len(pendingList.Ejections) == 0 {
+			continue
+		}
+
+		ejection := &pendingList.Ejections[0]
+		if ejection.XmsgIndex != "" {
+			if ctx.BlockHeight()-ejection.SentHeight < types.OutboundSyncTimeoutBlocks {
+				continue
+			}
+			k.Logger(ctx).Error("eject operators timeout", "chainId", state.ChainId, "ejectionId", ejection.Id, "xmsg", ejection.XmsgIndex)
+		}
+
+		k.deliverEjection(ctx, state.ChainId, pendingList)
+	}
+}
+
+// deliverEjection sends the oldest pending ejection of the chain to its ejection manager
+func (k Keeper) deliverEjection(ctx sdk.Context, chainId uint64, pendingList *types.PendingEjectionList) {
+	ejection := &pendingList.Ejections[0]
+	registryRouterAddr := ethcommon.HexToAddress(ejection.RegistryRouter)
+
+	dvs, found := k.GetDVSSupportedChain(ctx, registryRouterAddr, chainId)
+	if !found {
+		k.Logger(ctx).Error("drop eject operators of unsupported chain", "chainId", chainId, "registryRouter", ejection.RegistryRouter)
+		pendingList.Ejections = pendingList.Ejections[1:]
+		k.SetPendingEjectionList(ctx, chainId, pendingList)
+		return
+	}
+
+	// the attempt number is used as the event index to give every sent xmsg its own index
+	systemTxId := utils.GenerateSystemTxId(pevmtypes.SystemTxTypeEjectOperators, ejection.Id, 0)
+	attempt := ejection.Attempts
+	ejection.Attempts++
+
+	// discard the state of a partially sent xmsg on error
+	cacheCtx, commit := ctx.CacheContext()
+	xmsg, err := k.sendPellMessage(cacheCtx, types.EmptyTxOrigin, new(big.Int).SetUint64(chainId), registryRouterAddr,
+		ethcommon.HexToAddress(dvs.EjectionManager), ejection.Message, systemTxId, uint64(ctx.BlockHeight()), uint(attempt))
+	if err != nil {
+		k.Logger(ctx).Error("failed to send eject operators", "chainId", chainId, "ejectionId", ejection.Id, "error", err)
+		ejection.XmsgIndex = ""
+		k.SetPendingEjectionList(ctx, chainId, pendingList)
+		return
+	}
+	commit()
+
+	ejection.XmsgIndex = xmsg.Index
+	ejection.SentHeight = ctx.BlockHeight()
+	k.SetPendingEjectionList(ctx, chainId, pendingList)
+
+	k.Logger(ctx).Info("sent eject operators", "chainId", chainId, "ejectionId", ejection.Id, "xmsg", xmsg.Index)
+}
+
+// containsGroup checks if the group numbers of a registration include the group
+func containsGroup(groupNumbers []byte, groupNumber uint64) bool {
+	for _, number := range groupNumbers {
+		if uint64(number) == groupNumber {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,156 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/pkg/utils"
+	keepertest "github.com/0xPellNetwork/aegis/testutil/keeper"
+	pevmtypes "github.com/0xPellNetwork/aegis/x/pevm/types"
+	relayertypes "github.com/0xPellNetwork/aegis/x/relayer/types"
+	"github.com/0xPellNetwork/aegis/x/restaking/types"
+	xmsgtypes "github.com/0xPellNetwork/aegis/x/xmsg/types"
+)
+
+func TestKeeper_ProcessEjections(t *testing.T) {
+	k, ctx := keepertest.RestakingKeeper(t)
+	ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+
+	chainID := uint64(1337)
+	registryRouter := common.HexToAddress("0x0000000000000000000000000000000000000123")
+	pool := common.HexToAddress("0x0000000000000000000000000000000000000456")
+
+	require.NoError(t, k.AddRegistryRouterAddress(ctx, []common.Address{registryRouter}))
+
+	// the ejections are only queued for the chains receiving the restaking shares
+	otherChainID := uint64(1338)
+	require.NoError(t, k.SetOutboundState(ctx, &types.EpochOutboundState{ChainId: chainID, OutboundStatus: types.OutboundStatus_OUTBOUND_STATUS_SYNCING}))
+	for _, dvsChainID := range []uint64{chainID, otherChainID} {
+		require.NoError(t, k.AddDVSSupportedChain(ctx, registryRouter, &types.DVSInfo{
+			ChainId:         dvsChainID,
+			EjectionManager: common.HexToAddress("0x0000000000000000000000000000000000000789").Hex(),
+		}))
+	}
+	require.NoError(t, k.AddGroupData(ctx, registryRouter, &types.Group{
+		GroupNumber:  0,
+		MinimumStake: 100,
+		PoolParams: []*types.PoolParams{
+			{ChainId: chainID, Pool: pool.Hex(), Multiplier: types.StakeWeightingDivisor},
+		},
+		GroupEjectionParam: &types.GroupEjectionParam{
+			RateLimitWindow:       3600,
+			EjectableStakePercent: 1000, // 10%
+		},
+	}))
+
+	operators := []struct {
+		addr  common.Address
+		stake int64
+	}{
+		{common.HexToAddress("0x000000000000000000000000000000000000000a"), 1000},
+		{common.HexToAddress("0x000000000000000000000000000000000000000b"), 50},
+		{common.HexToAddress("0x000000000000000000000000000000000000000c"), 80},
+	}
+	for i, operator := range operators {
+		operatorId := make([]byte, 32)
+		operatorId[31] = byte(i + 1)
+
+		require.NoError(t, k.AddGroupOperatorRegistration(ctx, registryRouter, &types.GroupOperatorRegistrationV2{
+			Operator:     operator.addr.Hex(),
+			OperatorId:   operatorId,
+			GroupNumbers: []byte{0},
+		}))
+		k.SetOperatorShares(ctx, chainID, operator.addr.Hex(), pool.Hex(), sdkmath.NewInt(operator.stake))
+	}
+
+	ejectedOperators := func() []string {
+		list, found := k.GetGroupOperatorRegistrationList(ctx, registryRouter)
+		require.True(t, found)
+		// the registrations stay mirrored from pEVM
+		require.Len(t, list.OperatorRegisteredInfos, len(operators))
+
+		var result []string
+		for _, registration := range list.OperatorRegisteredInfos {
+			if ejected, found := k.GetEjectedOperator(ctx, registryRouter, registration.OperatorId); found {
+				require.Equal(t, []byte{0}, ejected.GroupNumbers)
+				result = append(result, registration.Operator)
+			}
+		}
+		return result
+	}
+
+	// ejectable stake is 113, only the smallest operator fits
+	k.ProcessEjections(ctx, 1)
+	require.Equal(t, []string{operators[1].addr.Hex()}, ejectedOperators())
+
+	ejections := k.GetGroupEjectionList(ctx, registryRouter, 0)
+	require.Len(t, ejections.Ejections, 1)
+	require.Equal(t, sdkmath.NewInt(50), ejections.Ejections[0].Stake)
+
+	pending := k.GetPendingEjectionList(ctx, chainID)
+	require.Len(t, pending.Ejections, 1)
+	require.Equal(t, uint64(1), pending.Ejections[0].Id)
+	require.Equal(t, uint64(1), pending.Ejections[0].EpochNumber)
+	require.Equal(t, registryRouter.Hex(), pending.Ejections[0].RegistryRouter)
+	require.NotEmpty(t, pending.Ejections[0].Message)
+	require.Empty(t, k.GetPendingEjectionList(ctx, otherChainID).Ejections)
+
+	// rate limited within the window
+	k.ProcessEjections(ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour-time.Second)), 2)
+	require.Len(t, ejectedOperators(), 1)
+	require.Len(t, k.GetPendingEjectionList(ctx, chainID).Ejections, 1)
+
+	// the window has passed, the ejected operator is not ejected again
+	k.ProcessEjections(ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour)), 3)
+	require.Equal(t, []string{operators[1].addr.Hex(), operators[2].addr.Hex()}, ejectedOperators())
+
+	ejections = k.GetGroupEjectionList(ctx, registryRouter, 0)
+	require.Len(t, ejections.Ejections, 1)
+	require.Equal(t, sdkmath.NewInt(80), ejections.Ejections[0].Stake)
+
+	pending = k.GetPendingEjectionList(ctx, chainID)
+	require.Len(t, pending.Ejections, 2)
+	require.Equal(t, uint64(2), pending.Ejections[1].Id)
+
+	// the deregistration on pEVM ends the ejection
+	require.NoError(t, k.RemoveGroupOperatorRegistration(ctx, registryRouter, operators[1].addr))
+	_, found := k.GetEjectedOperator(ctx, registryRouter, []byte{31: 2})
+	require.False(t, found)
+}
+
+func TestHooks_ProcessEjectionResult(t *testing.T) {
+	k, ctx := keepertest.RestakingKeeper(t)
+
+	chainID := uint64(1337)
+	xmsgIndex := "0x01"
+	k.SetPendingEjectionList(ctx, chainID, &types.PendingEjectionList{
+		Ejections: []types.PendingEjection{
+			{Id: 1, XmsgIndex: xmsgIndex, Attempts: 1},
+			{Id: 2},
+		},
+	})
+
+	xmsg := &xmsgtypes.Xmsg{
+		Index:            xmsgIndex,
+		InboundTxParams:  &xmsgtypes.InboundTxParams{InboundTxHash: utils.GenerateSystemTxId(pevmtypes.SystemTxTypeEjectOperators, 1, 0)},
+		OutboundTxParams: []*xmsgtypes.OutboundTxParams{{ReceiverChainId: int64(chainID)}},
+	}
+
+	// a failed xmsg releases the ejection to be sent again
+	k.Hooks().ProcessXmsgOutboundResult(ctx, xmsg, relayertypes.BallotStatus_BALLOT_FINALIZED_FAILURE_OBSERVATION)
+	pending := k.GetPendingEjectionList(ctx, chainID)
+	require.Len(t, pending.Ejections, 2)
+	require.Empty(t, pending.Ejections[0].XmsgIndex)
+
+	// a mined xmsg dequeues the ejection
+	pending.Ejections[0].XmsgIndex = xmsgIndex
+	k.SetPendingEjectionList(ctx, chainID, pending)
+	k.Hooks().ProcessXmsgOutboundResult(ctx, xmsg, relayertypes.BallotStatus_BALLOT_FINALIZED_SUCCESS_OBSERVATION)
+	pending = k.GetPendingEjectionList(ctx, chainID)
+	require.Len(t, pending.Ejections, 1)
+	require.Equal(t, uint64(2), pending.Ejections[0].Id)
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/0xPellNetwork/aegis/x/restaking/types"
+)
+
+// GetOperatorSharesAtEpoch returns the operator shares of a chain at the end of an epoch
+func (k Keeper) GetOperatorSharesAtEpoch(ctx context.Context, req *types.QueryOperatorSharesAtEpochRequest) (*types.QueryGetOperatorSharesAtEpochResponse, error) {
+	shares, err := k.OperatorSharesAtEpoch(sdk.UnwrapSDKContext(ctx), req.ChainId, req.Operator, req.EpochNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	operatorShares := make([]types.OperatorShares, 0, len(shares))
+	for _, share := range shares {
+		operatorShares = append(operatorShares, *share)
+	}
+
+	return &types.QueryGetOperatorSharesAtEpochResponse{OperatorShares: operatorShares}, nil
+}
+
+// GetOperatorSharesDiff returns the operator shares of a chain changed between two epochs
+func (k Keeper) GetOperatorSharesDiff(ctx context.Context, req *types.QueryOperatorSharesDiffRequest) (*types.QueryGetOperatorSharesDiffResponse, error) {
+	diffs, err := k.OperatorSharesDiff(sdk.UnwrapSDKContext(ctx), req.ChainId, req.Operator, req.FromEpoch, req.ToEpoch)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGetOperatorSharesDiffResponse{Diffs: diffs}, nil
+}
+
+// GetGroupOperatorsAtEpoch returns the operators of a group at the end of an epoch
+func (k Keeper) GetGroupOperatorsAtEpoch(ctx context.Context, req *types.QueryGroupOperatorsAtEpochRequest) (*types.QueryGetGroupOperatorsAtEpochResponse, error) {
+	if !common.IsHexAddress(req.RegistryRouterAddress) {
+		return nil, cosmoserrors.Wrapf(types.ErrInvalidAddress, "invalid registry router address %s", req.RegistryRouterAddress)
+	}
+
+	operators, err := k.GroupOperatorsAtEpoch(sdk.UnwrapSDKContext(ctx), common.HexToAddress(req.RegistryRouterAddress), req.GroupNumber, req.EpochNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGetGroupOperatorsAtEpochResponse{Operators: operators}, nil
+}
@@ -9,12 +9,25 @@ import (
 	"github.com/0xPellNetwork/aegis/x/restaking/types"
 )
 
-// GetOutboundStateByChainID returns the outbound state by chain id
+// GetOutboundStateByChainID returns the outbound state by chain id with its sync lag
 func (k Keeper) GetOutboundStateByChainID(ctx context.Context, req *types.QueryOutboundStateByChainIDRequest) (*types.QueryGetOutboundStateByChainIDResponse, error) {
-	outboundState, exist := k.GetOutboundState(sdk.UnwrapSDKContext(ctx), req.ChainId)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	outboundState, exist := k.GetOutboundState(sdkCtx, req.ChainId)
 	if !exist {
 		return nil, errors.New("outbound state not found")
 	}
 
-	return &types.QueryGetOutboundStateByChainIDResponse{OutboundState: outboundState}, nil
+	currentEpoch := k.GetEpochNumber(sdkCtx)
+
+	var syncLag uint64
+	if currentEpoch > 0 {
+		syncLag = outboundState.SyncLag(currentEpoch - 1)
+	}
+
+	return &types.QueryGetOutboundStateByChainIDResponse{
+		OutboundState:      outboundState,
+		CurrentEpochNumber: currentEpoch,
+		SyncLag:            syncLag,
+	}, nil
 }
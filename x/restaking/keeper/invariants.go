@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/0xPellNetwork/aegis/x/restaking/types"
+)
+
+// RegisterInvariants registers the restaking module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "operator-shares", OperatorSharesInvariant(k))
+}
+
+// OperatorSharesInvariant checks that the shares of every operator are not negative
+// and equal the sum of the shares delegated to the operator by the stakers
+func OperatorSharesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken int
+		)
+
+		delegated := make(map[string]sdkmath.Int)
+		for _, delegation := range k.GetAllStakerDelegations(ctx) {
+			if delegation.Shares.IsNil() || !delegation.Shares.IsPositive() {
+				broken++
+				msg += fmt.Sprintf("\tstaker %s: invalid shares %s delegated on chain %d to operator %s strategy %s\n",
+					delegation.Staker, delegation.Shares, delegation.ChainId, delegation.Operator, delegation.Strategy)
+				continue
+			}
+
+			key := sharesKey(delegation.ChainId, delegation.Operator, delegation.Strategy)
+			if sum, ok := delegated[key]; ok {
+				delegated[key] = sum.Add(delegation.Shares)
+			} else {
+				delegated[key] = delegation.Shares
+			}
+		}
+
+		k.IterateAllShares(ctx, func(shares *types.OperatorShares) bool {
+			key := sharesKey(shares.ChainId, shares.Operator, shares.Strategy)
+			sum, ok := delegated[key]
+			if !ok {
+				sum = sdkmath.ZeroInt()
+			}
+			delete(delegated, key)
+
+			if shares.Shares.IsNil() || shares.Shares.IsNegative() {
+				broken++
+				msg += fmt.Sprintf("\tchain %d operator %s strategy %s: invalid shares %s\n",
+					shares.ChainId, shares.Operator, shares.Strategy, shares.Shares)
+				return false
+			}
+
+			if !shares.Shares.Equal(sum) {
+				broken++
+				msg += fmt.Sprintf("\tchain %d operator %s strategy %s: shares %s differ from the delegated shares %s\n",
+					shares.ChainId, shares.Operator, shares.Strategy, shares.Shares, sum)
+			}
+			return false
+		})
+
+		// the remaining delegations are not counted in any operator shares
+		for key, sum := range delegated {
+			broken++
+			msg += fmt.Sprintf("\t%s: delegated shares %s without operator shares\n", key, sum)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "operator-shares",
+			fmt.Sprintf("found %d inconsistent operator shares\n%s", broken, msg)), broken != 0
+	}
+}
@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/0xPellNetwork/aegis/testutil/keeper"
+	"github.com/0xPellNetwork/aegis/x/restaking/keeper"
+	"github.com/0xPellNetwork/aegis/x/restaking/types"
+)
+
+const historyStaker = "0x00000000000000000000000000000000000000c1"
+
+func TestOperatorSharesInvariant(t *testing.T) {
+	chainID := uint64(1337)
+
+	delegation := func(operator, staker string, shares int64) types.StakerDelegation {
+		return types.StakerDelegation{
+			ChainId:  chainID,
+			Operator: operator,
+			Strategy: historyStrategy,
+			Staker:   staker,
+			Shares:   sdkmath.NewInt(shares),
+		}
+	}
+
+	t.Run("should hold if the shares match the delegations", func(t *testing.T) {
+		k, ctx := keepertest.RestakingKeeper(t)
+		k.SetEpochNumber(ctx, 1)
+		k.SetOperatorShares(ctx, chainID, historyOperatorA, historyStrategy, sdkmath.NewInt(100))
+		k.SetEpochNumber(ctx, 2)
+		k.SetOperatorShares(ctx, chainID, historyOperatorA, historyStrategy, sdkmath.NewInt(150))
+		k.SetOperatorShares(ctx, chainID, historyOperatorB, historyStrategy, sdkmath.NewInt(0))
+		k.SetStakerDelegation(ctx, delegation(historyOperatorA, historyStaker, 100))
+		k.SetStakerDelegation(ctx, delegation(historyOperatorA, types.UntrackedStaker, 50))
+
+		_, broken := keeper.OperatorSharesInvariant(*k)(ctx)
+		require.False(t, broken)
+	})
+
+	t.Run("should hold once the shares without delegations are backfilled", func(t *testing.T) {
+		k, ctx := keepertest.RestakingKeeper(t)
+		k.SetOperatorShares(ctx, chainID, historyOperatorA, historyStrategy, sdkmath.NewInt(150))
+		k.SetStakerDelegation(ctx, delegation(historyOperatorA, historyStaker, 100))
+
+		_, broken := keeper.OperatorSharesInvariant(*k)(ctx)
+		require.True(t, broken)
+
+		k.BackfillStakerDelegations(ctx)
+		require.Equal(t, sdkmath.NewInt(50),
+			k.GetStakerDelegation(ctx, chainID, historyOperatorA, historyStrategy, types.UntrackedStaker).Shares)
+
+		_, broken = keeper.OperatorSharesInvariant(*k)(ctx)
+		require.False(t, broken)
+	})
+
+	t.Run("should break for negative shares", func(t *testing.T) {
+		k, ctx := keepertest.RestakingKeeper(t)
+		k.SetOperatorShares(ctx, chainID, historyOperatorA, historyStrategy, sdkmath.NewInt(-1))
+
+		msg, broken := keeper.OperatorSharesInvariant(*k)(ctx)
+		require.True(t, broken)
+		require.Contains(t, msg, "invalid shares -1")
+	})
+
+	t.Run("should break if the shares differ from the delegations", func(t *testing.T) {
+		k, ctx := keepertest.RestakingKeeper(t)
+		k.SetOperatorShares(ctx, chainID, historyOperatorA, historyStrategy, sdkmath.NewInt(100))
+		k.SetStakerDelegation(ctx, delegation(historyOperatorA, historyStaker, 120))
+
+		msg, broken := keeper.OperatorSharesInvariant(*k)(ctx)
+		require.True(t, broken)
+		require.Contains(t, msg, "shares 100 differ from the delegated shares 120")
+	})
+
+	t.Run("should break for delegations without operator shares", func(t *testing.T) {
+		k, ctx := keepertest.RestakingKeeper(t)
+		k.SetStakerDelegation(ctx, delegation(historyOperatorB, historyStaker, 10))
+
+		msg, broken := keeper.OperatorSharesInvariant(*k)(ctx)
+		require.True(t, broken)
+		require.Contains(t, msg, "delegated shares 10 without operator shares")
+	})
+}
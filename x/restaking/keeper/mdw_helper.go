@@ -3,7 +3,6 @@ package keeper
 import (
 	"encoding/base64"
 	"errors"
-	"fmt"
 	"math/big"
 
 	"cosmossdk.io/math"
@@ -16,7 +15,6 @@ import (
 	"github.com/0xPellNetwork/aegis/pkg/chains"
 	pevmtypes "github.com/0xPellNetwork/aegis/x/pevm/types"
 	"github.com/0xPellNetwork/aegis/x/restaking/types"
-	"github.com/0xPellNetwork/aegis/x/xmsg/keeper"
 	xmsgtypes "github.com/0xPellNetwork/aegis/x/xmsg/types"
 )
 
@@ -29,6 +27,21 @@ func (k *Keeper) processInboundEvent(
 	receiverAddr ethcommon.Address,
 	message []byte,
 	eventLog *ethtypes.Log,
+) (*xmsgtypes.Xmsg, error) {
+	return k.sendPellMessage(ctx, txOrigin, receiverChainID, senderAddr, receiverAddr, message, eventLog.TxHash.String(), eventLog.BlockNumber, eventLog.Index)
+}
+
+// sendPellMessage creates and sends an xmsg carrying the message from the registry router to the receiver
+func (k *Keeper) sendPellMessage(
+	ctx sdk.Context,
+	txOrigin string,
+	receiverChainID *big.Int,
+	senderAddr ethcommon.Address,
+	receiverAddr ethcommon.Address,
+	message []byte,
+	inTxHash string,
+	blockNumber uint64,
+	eventIndex uint,
 ) (*xmsgtypes.Xmsg, error) {
 	if isValid := k.isWhitelistedRegistryRouter(ctx, senderAddr); !isValid {
 		return nil, errors.New("sender contract address not whitelisted")
@@ -73,10 +86,10 @@ func (k *Keeper) processInboundEvent(
 		txOrigin,
 		receiverAddr.Hex(),
 		receiverChain.Id,
-		eventLog.TxHash.String(),
-		eventLog.BlockNumber,
+		inTxHash,
+		blockNumber,
 		chainParams.GasLimit,
-		eventLog.Index,
+		eventIndex,
 		pellSent,
 	)
 
@@ -96,7 +109,7 @@ func (k *Keeper) processInboundMsg(ctx sdk.Context, msg *xmsgtypes.MsgVoteOnObse
 		return nil, err
 	}
 
-	xmsg.SetPendingOutbound("Cross-chain message ready for outbound processing from registry router")
+	xmsg.SetPendingOutbound(ctx, "Cross-chain message ready for outbound processing from registry router")
 
 	// Get gas price and amount
 	gasPrice, found := k.xmsgKeeper.GetGasPrice(ctx, receiverChain.Id)
@@ -104,8 +117,8 @@ func (k *Keeper) processInboundMsg(ctx sdk.Context, msg *xmsgtypes.MsgVoteOnObse
 		return nil, errors.New("gas price not found")
 	}
 
-	xmsg.GetCurrentOutTxParam().OutboundTxGasPrice = fmt.Sprint(gasPrice.Prices[gasPrice.MedianIndex])
-	keeper.EmitEventPellSent(ctx, xmsg)
+	xmsg.GetCurrentOutTxParam().SetGasFromGasPrice(gasPrice)
+	k.xmsgKeeper.EmitEventPellSent(ctx, xmsg)
 
 	if err := k.xmsgKeeper.ProcessXmsg(ctx, xmsg, receiverChain); err != nil {
 		return nil, err
@@ -9,6 +9,26 @@ import (
 	"github.com/0xPellNetwork/aegis/x/restaking/types"
 )
 
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	restakingKeeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{
+		restakingKeeper: keeper,
+	}
+}
+
+// Migrate1to2 migrates the store from consensus version 1 to 2
+// it backfills the shares history with the state at the upgrade epoch
+// and attributes the existing shares to the untracked staker
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.restakingKeeper.BackfillStakerDelegations(ctx)
+	return m.restakingKeeper.BackfillSharesHistory(ctx)
+}
+
 // MigrationStore is used to migrate GroupOperatorRegistrationList from v1.1 to v1.2
 // Mainly for the data of operator and group registration relationships in dvs, the old version had a bug that used uint64 data type to store BLS public key GPoint information
 // The new version has changed to sdk.Int type
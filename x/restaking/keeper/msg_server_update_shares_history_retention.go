@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	authoritytypes "github.com/0xPellNetwork/aegis/x/authority/types"
+	restakingtypes "github.com/0xPellNetwork/aegis/x/restaking/types"
+)
+
+// UpdateSharesHistoryRetention updates the number of epochs the operator shares history is retained
+func (k Keeper) UpdateSharesHistoryRetention(goCtx context.Context, msg *restakingtypes.MsgUpdateSharesHistoryRetention) (*restakingtypes.MsgUpdateSharesHistoryRetentionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.GetAuthorityKeeper().IsAuthorized(ctx, msg.Signer, authoritytypes.PolicyType_GROUP_ADMIN) {
+		return nil, errorsmod.Wrap(authoritytypes.ErrUnauthorized, "Update can only be executed by the correct policy account")
+	}
+
+	k.SetSharesHistoryRetention(ctx, msg.RetentionEpochs)
+
+	return &restakingtypes.MsgUpdateSharesHistoryRetentionResponse{}, nil
+}
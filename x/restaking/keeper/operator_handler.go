@@ -230,7 +230,7 @@ func (h OperatorHandler) syncOperatorEvent(ctx sdk.Context, blockNum uint64, eve
 
 		h.Logger(ctx).Info("syncOperatorEvent: xmsg built", "xmsg", xmsg.Index, "event", event, "chainID", state.ChainId, "systemTxId", systemTxId, "i", i)
 
-		xmsg.SetPendingOutbound("PellConnector pell-send event setting to pending outbound directly")
+		xmsg.SetPendingOutbound(ctx, "PellConnector pell-send event setting to pending outbound directly")
 
 		// Get gas price and amount
 		gasprice, found := h.xmsgKeeper.GetGasPrice(ctx, int64(state.ChainId))
@@ -239,7 +239,7 @@ func (h OperatorHandler) syncOperatorEvent(ctx sdk.Context, blockNum uint64, eve
 			continue
 		}
 
-		xmsg.GetCurrentOutTxParam().OutboundTxGasPrice = fmt.Sprintf("%d", gasprice.Prices[gasprice.MedianIndex])
+		xmsg.GetCurrentOutTxParam().SetGasFromGasPrice(gasprice)
 
 		receiverChain := h.relayerKeeper.GetSupportedChainFromChainID(ctx, int64(state.ChainId))
 		if receiverChain == nil {
@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/0xPellNetwork/aegis/x/restaking/types"
+)
+
+// ReconcileOutboundStates recovers chains whose epoch sync fell behind.
+// A sync that is still pending after OutboundSyncTimeoutBlocks is treated as failed,
+// and a failed sync is re-issued once its backoff has elapsed.
+func (k Keeper) ReconcileOutboundStates(ctx sdk.Context) {
+	states, err := k.GetAllOutboundStates(ctx)
+	if err != nil {
+		return
+	}
+
+	blockHeight := ctx.BlockHeight()
+
+	for _, state := range states {
+		switch state.OutboundStatus {
+		case types.OutboundStatus_OUTBOUND_STATUS_SYNCING:
+			if blockHeight-state.SyncStartHeight >= types.OutboundSyncTimeoutBlocks {
+				k.markOutboundSyncFailed(ctx, state, "sync timeout")
+			}
+		case types.OutboundStatus_OUTBOUND_STATUS_RECONCILING:
+			if blockHeight >= state.NextRetryHeight {
+				k.resyncOutboundState(ctx, state)
+			}
+		}
+	}
+}
+
+// markOutboundSyncFailed drops the pending sync of the chain and schedules a re-issue with backoff
+func (k Keeper) markOutboundSyncFailed(ctx sdk.Context, state *types.EpochOutboundState, reason string) {
+	if state.OutboundStatus == types.OutboundStatus_OUTBOUND_STATUS_SYNCING {
+		k.DeleteEpochOperatorSharesSyncTxs(ctx, state.ChainId, state.PendingEpochNumber)
+	}
+
+	state.RetryCount++
+	state.NextRetryHeight = ctx.BlockHeight() + types.OutboundSyncRetryBackoff(state.RetryCount)
+	state.OutboundStatus = types.OutboundStatus_OUTBOUND_STATUS_RECONCILING
+
+	k.Logger(ctx).Error("restaking outbound sync failed",
+		"chainId", state.ChainId,
+		"epochNumber", state.EpochNumber,
+		"pendingEpochNumber", state.PendingEpochNumber,
+		"retryCount", state.RetryCount,
+		"nextRetryHeight", state.NextRetryHeight,
+		"reason", reason,
+	)
+
+	state.ClearPendingSync()
+	k.SetOutboundState(ctx, state)
+}
+
+// resyncOutboundState re-issues the sync xmsgs bringing the chain up to the last closed epoch.
+// The shares are sent as absolute values, so re-sending batches that already landed is safe.
+func (k Keeper) resyncOutboundState(ctx sdk.Context, state *types.EpochOutboundState) {
+	chainParams, found := k.relayerKeeper.GetChainParamsByChainID(ctx, int64(state.ChainId))
+	if !found {
+		return
+	}
+
+	currentEpoch := k.GetEpochNumber(ctx)
+	if currentEpoch == 0 {
+		return
+	}
+	syncEpoch := currentEpoch - 1
+
+	// a large lag is cheaper to recover from the full snapshot than from the diff of every epoch
+	fullSync := state.FullSync || state.SyncLag(syncEpoch) > types.MaxReconcileDiffEpochs
+
+	// discard the xmsgs of a partially issued sync on error
+	cacheCtx, commit := ctx.CacheContext()
+
+	var xmsgIndexes []string
+	var err error
+	if fullSync {
+		xmsgIndexes, err = k.syncAllShares(cacheCtx, uint64(ctx.BlockHeight()), syncEpoch, state, chainParams)
+	} else if state.EpochNumber < syncEpoch {
+		xmsgIndexes, err = k.syncSharesByEpochRange(cacheCtx, uint64(ctx.BlockHeight()), chainParams, state.EpochNumber+1, syncEpoch, state.ChainId)
+	}
+	if err != nil {
+		k.markOutboundSyncFailed(ctx, state, err.Error())
+		return
+	}
+	commit()
+
+	k.Logger(ctx).Info("restaking outbound resync",
+		"chainId", state.ChainId,
+		"epochNumber", state.EpochNumber,
+		"syncEpoch", syncEpoch,
+		"fullSync", fullSync,
+		"xmsgIndexes", xmsgIndexes,
+	)
+
+	if len(xmsgIndexes) == 0 {
+		if state.EpochNumber < syncEpoch {
+			state.EpochNumber = syncEpoch
+		}
+		state.OutboundStatus = types.OutboundStatus_OUTBOUND_STATUS_NORMAL
+		state.FullSync = false
+		state.RetryCount = 0
+		state.NextRetryHeight = 0
+		state.ClearPendingSync()
+		k.SetOutboundState(ctx, state)
+		return
+	}
+
+	k.startOutboundSync(ctx, state, syncEpoch, xmsgIndexes, fullSync)
+}
@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/pkg/utils"
+	keepertest "github.com/0xPellNetwork/aegis/testutil/keeper"
+	pevmtypes "github.com/0xPellNetwork/aegis/x/pevm/types"
+	relayertypes "github.com/0xPellNetwork/aegis/x/relayer/types"
+	"github.com/0xPellNetwork/aegis/x/restaking/types"
+	xmsgtypes "github.com/0xPellNetwork/aegis/x/xmsg/types"
+)
+
+func syncXmsg(index string, chainID int64, epoch uint64) *xmsgtypes.Xmsg {
+	return &xmsgtypes.Xmsg{
+		Index: index,
+		InboundTxParams: &xmsgtypes.InboundTxParams{
+			InboundTxHash: utils.GenerateSystemTxId(pevmtypes.SystemTxTypeSyncDelegationShares, epoch, 0),
+		},
+		OutboundTxParams: []*xmsgtypes.OutboundTxParams{{ReceiverChainId: chainID}},
+	}
+}
+
+func TestKeeper_ProcessXmsgOutboundResult_SyncFailure(t *testing.T) {
+	k, ctx := keepertest.RestakingKeeper(t)
+	ctx = ctx.WithBlockHeight(100)
+
+	chainID := uint64(1337)
+	k.SetEpochOperatorSharesSyncTxs(ctx, chainID, 5, []string{"0x01", "0x02"})
+	require.NoError(t, k.SetOutboundState(ctx, &types.EpochOutboundState{
+		ChainId:            chainID,
+		OutboundStatus:     types.OutboundStatus_OUTBOUND_STATUS_SYNCING,
+		EpochNumber:        4,
+		PendingEpochNumber: 5,
+		SyncStartHeight:    90,
+	}))
+
+	t.Run("ignore failure of unknown xmsg", func(t *testing.T) {
+		k.Hooks().ProcessXmsgOutboundResult(ctx, syncXmsg("0x03", int64(chainID), 5), relayertypes.BallotStatus_BALLOT_FINALIZED_FAILURE_OBSERVATION)
+
+		state, found := k.GetOutboundState(ctx, chainID)
+		require.True(t, found)
+		require.Equal(t, types.OutboundStatus_OUTBOUND_STATUS_SYNCING, state.OutboundStatus)
+	})
+
+	t.Run("failed batch schedules reconciliation", func(t *testing.T) {
+		k.Hooks().ProcessXmsgOutboundResult(ctx, syncXmsg("0x02", int64(chainID), 5), relayertypes.BallotStatus_BALLOT_FINALIZED_FAILURE_OBSERVATION)
+
+		state, found := k.GetOutboundState(ctx, chainID)
+		require.True(t, found)
+		require.Equal(t, types.OutboundStatus_OUTBOUND_STATUS_RECONCILING, state.OutboundStatus)
+		require.EqualValues(t, 4, state.EpochNumber)
+		require.EqualValues(t, 1, state.RetryCount)
+		require.EqualValues(t, 100+types.OutboundSyncRetryBaseBlocks, state.NextRetryHeight)
+		require.Zero(t, state.PendingEpochNumber)
+
+		_, exist := k.GetEpochOperatorSharesSyncTxs(ctx, chainID, 5)
+		require.False(t, exist)
+	})
+}
+
+func TestKeeper_ReconcileOutboundStates(t *testing.T) {
+	t.Run("pending sync times out", func(t *testing.T) {
+		k, ctx := keepertest.RestakingKeeper(t)
+
+		chainID := uint64(1337)
+		k.SetEpochOperatorSharesSyncTxs(ctx, chainID, 5, []string{"0x01"})
+		require.NoError(t, k.SetOutboundState(ctx, &types.EpochOutboundState{
+			ChainId:            chainID,
+			OutboundStatus:     types.OutboundStatus_OUTBOUND_STATUS_SYNCING,
+			EpochNumber:        4,
+			PendingEpochNumber: 5,
+			SyncStartHeight:    10,
+		}))
+
+		k.ReconcileOutboundStates(ctx.WithBlockHeight(10 + types.OutboundSyncTimeoutBlocks - 1))
+		state, _ := k.GetOutboundState(ctx, chainID)
+		require.Equal(t, types.OutboundStatus_OUTBOUND_STATUS_SYNCING, state.OutboundStatus)
+
+		k.ReconcileOutboundStates(ctx.WithBlockHeight(10 + types.OutboundSyncTimeoutBlocks))
+		state, _ = k.GetOutboundState(ctx, chainID)
+		require.Equal(t, types.OutboundStatus_OUTBOUND_STATUS_RECONCILING, state.OutboundStatus)
+		require.EqualValues(t, 1, state.RetryCount)
+
+		_, exist := k.GetEpochOperatorSharesSyncTxs(ctx, chainID, 5)
+		require.False(t, exist)
+	})
+
+	t.Run("resync without changes returns to normal", func(t *testing.T) {
+		k, ctx := keepertest.RestakingKeeperWithMocks(t, keepertest.RestakingMockOptions{UseRelayerMock: true})
+		relayerMock := keepertest.GetRestakingRelayerMock(t, k)
+		relayerMock.On("GetChainParamsByChainID", mock.Anything, int64(1337)).
+			Return(&relayertypes.ChainParams{ChainId: 1337}, true)
+
+		chainID := uint64(1337)
+		k.SetEpochNumber(ctx, 8)
+		require.NoError(t, k.SetOutboundState(ctx, &types.EpochOutboundState{
+			ChainId:         chainID,
+			OutboundStatus:  types.OutboundStatus_OUTBOUND_STATUS_RECONCILING,
+			EpochNumber:     4,
+			RetryCount:      2,
+			NextRetryHeight: 50,
+		}))
+
+		// backoff not elapsed
+		k.ReconcileOutboundStates(ctx.WithBlockHeight(49))
+		state, _ := k.GetOutboundState(ctx, chainID)
+		require.Equal(t, types.OutboundStatus_OUTBOUND_STATUS_RECONCILING, state.OutboundStatus)
+
+		k.ReconcileOutboundStates(ctx.WithBlockHeight(50))
+		state, _ = k.GetOutboundState(ctx, chainID)
+		require.Equal(t, types.OutboundStatus_OUTBOUND_STATUS_NORMAL, state.OutboundStatus)
+		require.EqualValues(t, 7, state.EpochNumber)
+		require.Zero(t, state.RetryCount)
+		require.Zero(t, state.NextRetryHeight)
+	})
+}
+
+func TestKeeper_GetOutboundStateByChainID_SyncLag(t *testing.T) {
+	k, ctx := keepertest.RestakingKeeper(t)
+
+	k.SetEpochNumber(ctx, 10)
+	require.NoError(t, k.SetOutboundState(ctx, &types.EpochOutboundState{
+		ChainId:        1337,
+		OutboundStatus: types.OutboundStatus_OUTBOUND_STATUS_RECONCILING,
+		EpochNumber:    6,
+	}))
+
+	res, err := k.GetOutboundStateByChainID(ctx, &types.QueryOutboundStateByChainIDRequest{ChainId: 1337})
+	require.NoError(t, err)
+	require.EqualValues(t, 10, res.CurrentEpochNumber)
+	require.EqualValues(t, 3, res.SyncLag)
+
+	_, err = k.GetOutboundStateByChainID(ctx, &types.QueryOutboundStateByChainIDRequest{ChainId: 1})
+	require.Error(t, err)
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"slices"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/0xPellNetwork/aegis/pkg/utils"
@@ -17,6 +19,7 @@ var _ xmsgtypes.XmsgOutboundResultHook = Hooks{}
 // This mechanism ensures data consistency and completeness across the chain.
 func (h Hooks) ProcessXmsgOutboundResult(ctx sdk.Context, xmsg *xmsgtypes.Xmsg, ballotStatus relayertypes.BallotStatus) {
 	h.processEpochSync(ctx, xmsg, ballotStatus)
+	h.processEjectionResult(ctx, xmsg, ballotStatus)
 }
 
 // processEpochSync processes the epoch sync
@@ -60,10 +63,52 @@ func (h Hooks) processEpochSync(ctx sdk.Context, xmsg *xmsgtypes.Xmsg, ballotSta
 
 			outboundState.OutboundStatus = types.OutboundStatus_OUTBOUND_STATUS_NORMAL
 			outboundState.EpochNumber = epoch
+			outboundState.FullSync = false
+			outboundState.RetryCount = 0
+			outboundState.NextRetryHeight = 0
+			outboundState.ClearPendingSync()
 
 			h.SetOutboundState(ctx, outboundState)
 		} else {
 			h.SetEpochOperatorSharesSyncTxs(ctx, uint64(xmsg.GetCurrentOutTxParam().ReceiverChainId), epoch, syncEpochTxs.PendingXmsgIndexes)
 		}
+
+		return
+	}
+
+	// a reverted or aborted batch leaves the chain behind, schedule the reconciliation
+	if ballotStatus == relayertypes.BallotStatus_BALLOT_FINALIZED_FAILURE_OBSERVATION &&
+		outboundState.OutboundStatus == types.OutboundStatus_OUTBOUND_STATUS_SYNCING &&
+		slices.Contains(syncEpochTxs.PendingXmsgIndexes, xmsg.Index) {
+		h.markOutboundSyncFailed(ctx, outboundState, "sync xmsg "+xmsg.Index+" failed")
+	}
+}
+
+// processEjectionResult dequeues a delivered ejection, or releases a failed one to be sent again
+func (h Hooks) processEjectionResult(ctx sdk.Context, xmsg *xmsgtypes.Xmsg, ballotStatus relayertypes.BallotStatus) {
+	systemTxType, _, _, err := utils.ParseSystemTxId(xmsg.InboundTxParams.InboundTxHash)
+	if err != nil || systemTxType != pevmtypes.SystemTxTypeEjectOperators {
+		return
+	}
+
+	chainId := uint64(xmsg.GetCurrentOutTxParam().ReceiverChainId)
+	pendingList := h.GetPendingEjectionList(ctx, chainId)
+
+	for i := range pendingList.Ejections {
+		if pendingList.Ejections[i].XmsgIndex != xmsg.Index {
+			continue
+		}
+
+		switch ballotStatus {
+		case relayertypes.BallotStatus_BALLOT_FINALIZED_SUCCESS_OBSERVATION:
+			pendingList.Ejections = append(pendingList.Ejections[:i], pendingList.Ejections[i+1:]...)
+		case relayertypes.BallotStatus_BALLOT_FINALIZED_FAILURE_OBSERVATION:
+			pendingList.Ejections[i].XmsgIndex = ""
+		default:
+			return
+		}
+
+		h.SetPendingEjectionList(ctx, chainId, pendingList)
+		return
 	}
 }
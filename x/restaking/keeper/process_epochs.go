@@ -24,6 +24,8 @@ func (k Keeper) ProcessEpochs(ctx sdk.Context) {
 		"currentEpochNumber", epochNumber,
 	)
 
+	k.PruneSharesHistory(ctx, epochNumber)
+
 	chainsEpochOutboundState, err := k.GetAllOutboundStates(ctx)
 	if err != nil {
 		return
@@ -39,6 +41,7 @@ func (k Keeper) ProcessEpochs(ctx sdk.Context) {
 
 		var xmsgIndexes []string
 		var err error
+		fullSync := false
 
 		chainParams, found := k.relayerKeeper.GetChainParamsByChainID(ctx, int64(state.ChainId))
 		if !found {
@@ -47,10 +50,11 @@ func (k Keeper) ProcessEpochs(ctx sdk.Context) {
 
 		switch state.OutboundStatus {
 		case types.OutboundStatus_OUTBOUND_STATUS_INITIALIZING:
-			if xmsgIndexes, err = k.syncAllShares(ctx, uint64(blockHeight), state, chainParams); err != nil {
+			if xmsgIndexes, err = k.syncAllShares(ctx, uint64(blockHeight), epochNumber, state, chainParams); err != nil {
 				k.Logger(ctx).Error("sync all shares", "error", err)
 				continue
 			}
+			fullSync = true
 		case types.OutboundStatus_OUTBOUND_STATUS_NORMAL:
 			if xmsgIndexes, err = k.syncSharesByEpochRange(ctx, uint64(blockHeight), chainParams, uint64(state.EpochNumber)+1, uint64(epochNumber), uint64(chainParams.ChainId)); err != nil {
 				k.Logger(ctx).Error("sync shares by epoch range", "error", err)
@@ -69,8 +73,20 @@ func (k Keeper) ProcessEpochs(ctx sdk.Context) {
 		}
 
 		// TODO: call hooks maybe
-		k.SetEpochOperatorSharesSyncTxs(ctx, uint64(chainParams.ChainId), uint64(epochNumber), xmsgIndexes)
-		state.OutboundStatus = types.OutboundStatus_OUTBOUND_STATUS_SYNCING
-		k.SetOutboundState(ctx, state)
+		k.startOutboundSync(ctx, state, epochNumber, xmsgIndexes, fullSync)
 	}
+
+	// eject on the stake of the closed epoch, queued behind its shares sync
+	k.ProcessEjections(ctx, epochNumber)
+}
+
+// startOutboundSync records the sync xmsgs of the epoch and marks the chain as syncing
+func (k Keeper) startOutboundSync(ctx sdk.Context, state *types.EpochOutboundState, epochNumber uint64, xmsgIndexes []string, fullSync bool) {
+	k.SetEpochOperatorSharesSyncTxs(ctx, state.ChainId, epochNumber, xmsgIndexes)
+
+	state.OutboundStatus = types.OutboundStatus_OUTBOUND_STATUS_SYNCING
+	state.PendingEpochNumber = epochNumber
+	state.SyncStartHeight = ctx.BlockHeight()
+	state.FullSync = fullSync
+	k.SetOutboundState(ctx, state)
 }
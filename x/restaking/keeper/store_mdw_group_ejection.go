@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/0xPellNetwork/aegis/x/restaking/types"
+)
+
+// GetGroupEjectionList gets the ejections of a group within its rate limit window
+func (k Keeper) GetGroupEjectionList(ctx sdk.Context, registryRouterAddress ethcommon.Address, groupNumber uint64) *types.GroupEjectionList {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.GroupKey))
+
+	var ejectionList types.GroupEjectionList
+	if data := store.Get(types.GroupEjectionKey(registryRouterAddress, groupNumber)); data != nil {
+		k.cdc.MustUnmarshal(data, &ejectionList)
+	}
+
+	return &ejectionList
+}
+
+// SetGroupEjectionList sets the ejections of a group within its rate limit window
+func (k Keeper) SetGroupEjectionList(ctx sdk.Context, registryRouterAddress ethcommon.Address, groupNumber uint64, ejectionList *types.GroupEjectionList) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.GroupKey))
+	store.Set(types.GroupEjectionKey(registryRouterAddress, groupNumber), k.cdc.MustMarshal(ejectionList))
+}
+
+// GetEjectedOperator gets the groups an operator was ejected from
+func (k Keeper) GetEjectedOperator(ctx sdk.Context, registryRouterAddress ethcommon.Address, operatorId []byte) (*types.EjectedOperator, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.GroupKey))
+
+	data := store.Get(types.EjectedOperatorKey(registryRouterAddress, operatorId))
+	if data == nil {
+		return nil, false
+	}
+
+	var ejectedOperator types.EjectedOperator
+	k.cdc.MustUnmarshal(data, &ejectedOperator)
+	return &ejectedOperator, true
+}
+
+// SetEjectedOperator sets the groups an operator was ejected from
+func (k Keeper) SetEjectedOperator(ctx sdk.Context, registryRouterAddress ethcommon.Address, operatorId []byte, ejectedOperator *types.EjectedOperator) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.GroupKey))
+	store.Set(types.EjectedOperatorKey(registryRouterAddress, operatorId), k.cdc.MustMarshal(ejectedOperator))
+}
+
+// DeleteEjectedOperator deletes the ejection record of an operator
+func (k Keeper) DeleteEjectedOperator(ctx sdk.Context, registryRouterAddress ethcommon.Address, operatorId []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.GroupKey))
+	store.Delete(types.EjectedOperatorKey(registryRouterAddress, operatorId))
+}
+
+// GetPendingEjectionList gets the ejections waiting to be delivered to a chain
+func (k Keeper) GetPendingEjectionList(ctx sdk.Context, chainId uint64) *types.PendingEjectionList {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.GroupKey))
+
+	var pendingList types.PendingEjectionList
+	if data := store.Get(types.PendingEjectionKey(chainId)); data != nil {
+		k.cdc.MustUnmarshal(data, &pendingList)
+	}
+
+	return &pendingList
+}
+
+// SetPendingEjectionList sets the ejections waiting to be delivered to a chain
+func (k Keeper) SetPendingEjectionList(ctx sdk.Context, chainId uint64, pendingList *types.PendingEjectionList) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.GroupKey))
+
+	if len(pendingList.Ejections) == 0 {
+		store.Delete(types.PendingEjectionKey(chainId))
+		return
+	}
+
+	store.Set(types.PendingEjectionKey(chainId), k.cdc.MustMarshal(pendingList))
+}
+
+// nextEjectionId returns a new ejection id, used to build the system tx id of the ejection xmsgs
+func (k Keeper) nextEjectionId(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.GroupKey))
+
+	var id uint64
+	if data := store.Get(types.EjectionSequenceKey()); data != nil {
+		id = sdk.BigEndianToUint64(data)
+	}
+	id++
+
+	store.Set(types.EjectionSequenceKey(), sdk.Uint64ToBigEndian(id))
+	return id
+}
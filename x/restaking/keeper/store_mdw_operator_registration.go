@@ -42,10 +42,14 @@ func (k *Keeper) AddGroupOperatorRegistration(ctx sdk.Context, registryRouterAdd
 		existingList.OperatorRegisteredInfos = append(existingList.OperatorRegisteredInfos, registration)
 	}
 
+	// a registration on pEVM ends the native ejection of the operator
+	k.DeleteEjectedOperator(ctx, registryRouterAddr, registration.OperatorId)
+
 	// Store the updated list
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.GroupKey))
 	key := types.GroupOperatorKey(registryRouterAddr)
 	store.Set(key, k.cdc.MustMarshal(existingList))
+	k.setGroupOperatorHistory(ctx, registryRouterAddr, existingList)
 
 	return nil
 }
@@ -67,6 +71,7 @@ func (k *Keeper) RemoveGroupOperatorRegistration(ctx sdk.Context, registryRouter
 				existingList.OperatorRegisteredInfos[:i],
 				existingList.OperatorRegisteredInfos[i+1:]...,
 			)
+			k.DeleteEjectedOperator(ctx, registryRouterAddr, reg.OperatorId)
 			found = true
 			break
 		}
@@ -80,6 +85,7 @@ func (k *Keeper) RemoveGroupOperatorRegistration(ctx sdk.Context, registryRouter
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.GroupKey))
 	key := types.GroupOperatorKey(registryRouterAddr)
 	store.Set(key, k.cdc.MustMarshal(existingList))
+	k.setGroupOperatorHistory(ctx, registryRouterAddr, existingList)
 
 	return nil
 }
@@ -109,6 +115,8 @@ func (k *Keeper) RemoveGroupOperatorRegistrationByIds(ctx sdk.Context, registryR
 		}
 		if !matchFound {
 			newRegistrations = append(newRegistrations, reg)
+		} else {
+			k.DeleteEjectedOperator(ctx, registryRouterAddr, reg.OperatorId)
 		}
 	}
 
@@ -119,6 +127,7 @@ func (k *Keeper) RemoveGroupOperatorRegistrationByIds(ctx sdk.Context, registryR
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.GroupKey))
 	key := types.GroupOperatorKey(registryRouterAddr)
 	store.Set(key, k.cdc.MustMarshal(existingList))
+	k.setGroupOperatorHistory(ctx, registryRouterAddr, existingList)
 
 	return nil
 }
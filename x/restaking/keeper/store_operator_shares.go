@@ -51,6 +51,8 @@ func (k Keeper) SetOperatorShares(ctx sdk.Context, chainId uint64, operator, str
 
 	bz := k.cdc.MustMarshal(value)
 	operatorStore.Set([]byte(strategy), bz)
+
+	k.setOperatorSharesHistory(ctx, value)
 }
 
 // GetOperatorShares retrieves operator shares
@@ -0,0 +1,340 @@
+package keeper
+
+import (
+	"bytes"
+
+	cosmoserrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/0xPellNetwork/aegis/x/restaking/types"
+)
+
+// epochKeyLength is the length of the big endian epoch number suffix of the history keys
+const epochKeyLength = 8
+
+// splitHistoryKey splits a history key into the key of the versioned entry and its epoch
+func splitHistoryKey(key []byte) ([]byte, uint64) {
+	return key[:len(key)-epochKeyLength], sdk.BigEndianToUint64(key[len(key)-epochKeyLength:])
+}
+
+// setHistoryEntry records the value of a versioned entry at an epoch.
+// The previous version of the entry is queued to be pruned once the epoch leaves the retention window.
+func (k Keeper) setHistoryEntry(ctx sdk.Context, storePrefix, entry []byte, epochNumber uint64, value []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), storePrefix)
+	key := append(bytes.Clone(entry), sdk.Uint64ToBigEndian(epochNumber)...)
+
+	iterator := store.ReverseIterator(entry, key)
+	if iterator.Valid() {
+		queue := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.KeyHistoryPruneQueue))
+		previousKey := append(bytes.Clone(storePrefix), iterator.Key()...)
+		queue.Set(append(sdk.Uint64ToBigEndian(epochNumber), previousKey...), []byte{})
+	}
+	iterator.Close()
+
+	store.Set(key, value)
+}
+
+// setOperatorSharesHistory records the shares of a strategy at the current epoch
+func (k Keeper) setOperatorSharesHistory(ctx sdk.Context, shares *types.OperatorShares) {
+	k.setHistoryEntry(ctx,
+		types.KeyPrefix(types.KeyOperatorSharesHistory),
+		types.OperatorSharesHistoryPrefix(shares.ChainId, shares.Operator, shares.Strategy),
+		k.GetEpochNumber(ctx),
+		k.cdc.MustMarshal(shares),
+	)
+}
+
+// OperatorSharesAtEpoch returns the shares of the operators of a chain at the end of an epoch.
+// All operators of the chain are returned if operator is empty.
+func (k Keeper) OperatorSharesAtEpoch(ctx sdk.Context, chainId uint64, operator string, epochNumber uint64) ([]*types.OperatorShares, error) {
+	if err := k.checkHistoryRetained(ctx, epochNumber); err != nil {
+		return nil, err
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.KeyOperatorSharesHistory))
+	iterator := storetypes.KVStorePrefixIterator(store, types.OperatorSharesHistoryPrefix(chainId, operator, ""))
+	defer iterator.Close()
+
+	var (
+		result    []*types.OperatorShares
+		lastEntry []byte
+		latest    *types.OperatorShares
+	)
+
+	// entries of a strategy are contiguous and ordered by epoch, the last one not after epochNumber wins
+	for ; iterator.Valid(); iterator.Next() {
+		entry, epoch := splitHistoryKey(iterator.Key())
+		if !bytes.Equal(entry, lastEntry) {
+			if latest != nil {
+				result = append(result, latest)
+			}
+			lastEntry = bytes.Clone(entry)
+			latest = nil
+		}
+
+		if epoch > epochNumber {
+			continue
+		}
+
+		var shares types.OperatorShares
+		k.cdc.MustUnmarshal(iterator.Value(), &shares)
+		latest = &shares
+	}
+
+	if latest != nil {
+		result = append(result, latest)
+	}
+
+	return result, nil
+}
+
+// OperatorSharesDiff returns the shares changed between the end of fromEpoch and the end of toEpoch
+func (k Keeper) OperatorSharesDiff(ctx sdk.Context, chainId uint64, operator string, fromEpoch, toEpoch uint64) ([]types.OperatorSharesDiff, error) {
+	if fromEpoch > toEpoch {
+		return nil, cosmoserrors.Wrapf(types.ErrInvalidEpochRange, "from epoch %d is after to epoch %d", fromEpoch, toEpoch)
+	}
+
+	fromShares, err := k.OperatorSharesAtEpoch(ctx, chainId, operator, fromEpoch)
+	if err != nil {
+		return nil, err
+	}
+
+	toShares, err := k.OperatorSharesAtEpoch(ctx, chainId, operator, toEpoch)
+	if err != nil {
+		return nil, err
+	}
+
+	// both lists are sorted by operator and strategy
+	var diffs []types.OperatorSharesDiff
+	appendDiff := func(shares *types.OperatorShares, from, to sdkmath.Int) {
+		if from.Equal(to) {
+			return
+		}
+
+		diffs = append(diffs, types.OperatorSharesDiff{
+			ChainId:    shares.ChainId,
+			Operator:   shares.Operator,
+			Strategy:   shares.Strategy,
+			FromShares: from,
+			ToShares:   to,
+			Delta:      to.Sub(from),
+		})
+	}
+
+	i, j := 0, 0
+	for i < len(fromShares) || j < len(toShares) {
+		switch {
+		case j == len(toShares) || (i < len(fromShares) && compareOperator(fromShares[i], toShares[j]) < 0):
+			appendDiff(fromShares[i], fromShares[i].Shares, sdkmath.ZeroInt())
+			i++
+		case i == len(fromShares) || compareOperator(fromShares[i], toShares[j]) > 0:
+			appendDiff(toShares[j], sdkmath.ZeroInt(), toShares[j].Shares)
+			j++
+		default:
+			appendDiff(toShares[j], fromShares[i].Shares, toShares[j].Shares)
+			i++
+			j++
+		}
+	}
+
+	return diffs, nil
+}
+
+// setGroupOperatorHistory records the group operator registrations of a registry router at the current epoch
+func (k Keeper) setGroupOperatorHistory(ctx sdk.Context, registryRouterAddr ethcommon.Address, list *types.GroupOperatorRegistrationListV2) {
+	k.setHistoryEntry(ctx,
+		types.KeyPrefix(types.KeyGroupOperatorHistory),
+		types.GroupOperatorHistoryPrefix(registryRouterAddr),
+		k.GetEpochNumber(ctx),
+		k.cdc.MustMarshal(list),
+	)
+}
+
+// GetAllOperatorSharesHistory returns the shares history of all the operator strategies ordered by entry and epoch
+func (k Keeper) GetAllOperatorSharesHistory(ctx sdk.Context) []types.OperatorSharesHistoryEntry {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.KeyOperatorSharesHistory))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var history []types.OperatorSharesHistoryEntry
+	for ; iterator.Valid(); iterator.Next() {
+		_, epoch := splitHistoryKey(iterator.Key())
+
+		var shares types.OperatorShares
+		k.cdc.MustUnmarshal(iterator.Value(), &shares)
+		history = append(history, types.OperatorSharesHistoryEntry{EpochNumber: epoch, Shares: shares})
+	}
+	return history
+}
+
+// SetOperatorSharesHistoryEntry records the shares of an operator strategy at the epoch of the entry.
+// The entries of a strategy must be set in epoch order.
+func (k Keeper) SetOperatorSharesHistoryEntry(ctx sdk.Context, entry types.OperatorSharesHistoryEntry) {
+	k.setHistoryEntry(ctx,
+		types.KeyPrefix(types.KeyOperatorSharesHistory),
+		types.OperatorSharesHistoryPrefix(entry.Shares.ChainId, entry.Shares.Operator, entry.Shares.Strategy),
+		entry.EpochNumber,
+		k.cdc.MustMarshal(&entry.Shares),
+	)
+}
+
+// GetAllGroupOperatorHistory returns the group operator history of all the registry routers ordered by registry router and epoch
+func (k Keeper) GetAllGroupOperatorHistory(ctx sdk.Context) []types.GroupOperatorHistoryEntry {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.KeyGroupOperatorHistory))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var history []types.GroupOperatorHistoryEntry
+	for ; iterator.Valid(); iterator.Next() {
+		entry, epoch := splitHistoryKey(iterator.Key())
+
+		var list types.GroupOperatorRegistrationListV2
+		k.cdc.MustUnmarshal(iterator.Value(), &list)
+		history = append(history, types.GroupOperatorHistoryEntry{
+			RegistryRouterAddress: string(bytes.TrimSuffix(entry, []byte("/"))),
+			EpochNumber:           epoch,
+			Registrations:         list,
+		})
+	}
+	return history
+}
+
+// SetGroupOperatorHistoryEntry records the group operator registrations of a registry router at the epoch of the entry.
+// The entries of a registry router must be set in epoch order.
+func (k Keeper) SetGroupOperatorHistoryEntry(ctx sdk.Context, entry types.GroupOperatorHistoryEntry) {
+	k.setHistoryEntry(ctx,
+		types.KeyPrefix(types.KeyGroupOperatorHistory),
+		types.GroupOperatorHistoryPrefix(ethcommon.HexToAddress(entry.RegistryRouterAddress)),
+		entry.EpochNumber,
+		k.cdc.MustMarshal(&entry.Registrations),
+	)
+}
+
+// GroupOperatorsAtEpoch returns the operators registered to a group of a registry router at the end of an epoch
+func (k Keeper) GroupOperatorsAtEpoch(ctx sdk.Context, registryRouterAddr ethcommon.Address, groupNumber uint32, epochNumber uint64) ([]*types.GroupOperatorRegistrationV2, error) {
+	if err := k.checkHistoryRetained(ctx, epochNumber); err != nil {
+		return nil, err
+	}
+
+	store := prefix.NewStore(
+		prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.KeyGroupOperatorHistory)),
+		types.GroupOperatorHistoryPrefix(registryRouterAddr),
+	)
+
+	// the latest snapshot not after epochNumber
+	iterator := store.ReverseIterator(nil, sdk.Uint64ToBigEndian(epochNumber+1))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return nil, nil
+	}
+
+	var list types.GroupOperatorRegistrationListV2
+	k.cdc.MustUnmarshal(iterator.Value(), &list)
+
+	var operators []*types.GroupOperatorRegistrationV2
+	for _, registration := range list.OperatorRegisteredInfos {
+		if groupNumber <= 0xff && bytes.IndexByte(registration.GroupNumbers, byte(groupNumber)) >= 0 {
+			operators = append(operators, registration)
+		}
+	}
+
+	return operators, nil
+}
+
+// SetSharesHistoryRetention sets the number of epochs the history is retained, 0 keeps it forever
+func (k Keeper) SetSharesHistoryRetention(ctx sdk.Context, epochs uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(types.KeySharesHistoryRetention), sdk.Uint64ToBigEndian(epochs))
+}
+
+// GetSharesHistoryRetention gets the number of epochs the history is retained
+func (k Keeper) GetSharesHistoryRetention(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get([]byte(types.KeySharesHistoryRetention))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetSharesHistoryPrunedBefore sets the first epoch whose history is still complete
+func (k Keeper) SetSharesHistoryPrunedBefore(ctx sdk.Context, epochNumber uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(types.KeySharesHistoryPrunedBefore), sdk.Uint64ToBigEndian(epochNumber))
+}
+
+// GetSharesHistoryPrunedBefore gets the first epoch whose history is still complete
+func (k Keeper) GetSharesHistoryPrunedBefore(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get([]byte(types.KeySharesHistoryPrunedBefore))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) checkHistoryRetained(ctx sdk.Context, epochNumber uint64) error {
+	if prunedBefore := k.GetSharesHistoryPrunedBefore(ctx); epochNumber < prunedBefore {
+		return cosmoserrors.Wrapf(types.ErrHistoryPruned, "epoch %d is before the first retained epoch %d", epochNumber, prunedBefore)
+	}
+	return nil
+}
+
+// PruneSharesHistory drops the history older than the retention window ending at epochNumber.
+// The last entry before the window is kept so the values at the start of the window stay known.
+func (k Keeper) PruneSharesHistory(ctx sdk.Context, epochNumber uint64) {
+	retention := k.GetSharesHistoryRetention(ctx)
+	if retention == 0 || epochNumber <= retention {
+		return
+	}
+
+	cutoff := epochNumber - retention
+	if cutoff <= k.GetSharesHistoryPrunedBefore(ctx) {
+		return
+	}
+
+	// an entry is queued under the epoch of the version replacing it,
+	// it is obsolete once that version is before the cutoff
+	queue := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.KeyHistoryPruneQueue))
+	iterator := queue.Iterator(nil, sdk.Uint64ToBigEndian(cutoff))
+
+	var toDelete [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		toDelete = append(toDelete, bytes.Clone(iterator.Key()))
+	}
+	iterator.Close()
+
+	store := ctx.KVStore(k.storeKey)
+	for _, key := range toDelete {
+		store.Delete(key[epochKeyLength:])
+		queue.Delete(key)
+	}
+
+	k.SetSharesHistoryPrunedBefore(ctx, cutoff)
+}
+
+// BackfillSharesHistory records the current shares and group operators as the history of the current epoch.
+// The history of the earlier epochs is unknown and reported as pruned.
+func (k Keeper) BackfillSharesHistory(ctx sdk.Context) error {
+	for _, shares := range k.GetAllShares(ctx) {
+		k.setOperatorSharesHistory(ctx, shares)
+	}
+
+	registryRouters, err := k.GetAllRegistryRouterAddresses(ctx)
+	if err != nil {
+		return err
+	}
+	for _, registryRouter := range registryRouters {
+		if list, found := k.GetGroupOperatorRegistrationList(ctx, registryRouter); found {
+			k.setGroupOperatorHistory(ctx, registryRouter, list)
+		}
+	}
+
+	k.SetSharesHistoryPrunedBefore(ctx, k.GetEpochNumber(ctx))
+	return nil
+}
@@ -0,0 +1,190 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/0xPellNetwork/aegis/testutil/keeper"
+	"github.com/0xPellNetwork/aegis/x/restaking/keeper"
+	"github.com/0xPellNetwork/aegis/x/restaking/types"
+)
+
+const (
+	historyOperatorA = "0x000000000000000000000000000000000000000a"
+	historyOperatorB = "0x000000000000000000000000000000000000000b"
+	historyStrategy  = "0x0000000000000000000000000000000000000001"
+)
+
+func TestKeeper_OperatorSharesAtEpoch(t *testing.T) {
+	k, ctx := keepertest.RestakingKeeper(t)
+	chainID := uint64(1337)
+
+	k.SetEpochNumber(ctx, 1)
+	k.SetOperatorShares(ctx, chainID, historyOperatorA, historyStrategy, sdkmath.NewInt(100))
+	k.SetOperatorShares(ctx, chainID, historyOperatorA, historyStrategy, sdkmath.NewInt(150))
+	k.SetEpochNumber(ctx, 3)
+	k.SetOperatorShares(ctx, chainID, historyOperatorA, historyStrategy, sdkmath.NewInt(200))
+	k.SetOperatorShares(ctx, chainID, historyOperatorB, historyStrategy, sdkmath.NewInt(50))
+	// another chain is not returned
+	k.SetOperatorShares(ctx, 13370, historyOperatorA, historyStrategy, sdkmath.NewInt(1))
+
+	shares, err := k.OperatorSharesAtEpoch(ctx, chainID, "", 0)
+	require.NoError(t, err)
+	require.Empty(t, shares)
+
+	shares, err = k.OperatorSharesAtEpoch(ctx, chainID, "", 2)
+	require.NoError(t, err)
+	require.Len(t, shares, 1)
+	require.Equal(t, historyOperatorA, shares[0].Operator)
+	require.Equal(t, sdkmath.NewInt(150), shares[0].Shares)
+
+	shares, err = k.OperatorSharesAtEpoch(ctx, chainID, "", 3)
+	require.NoError(t, err)
+	require.Len(t, shares, 2)
+	require.Equal(t, sdkmath.NewInt(200), shares[0].Shares)
+	require.Equal(t, historyOperatorB, shares[1].Operator)
+
+	shares, err = k.OperatorSharesAtEpoch(ctx, chainID, historyOperatorB, 10)
+	require.NoError(t, err)
+	require.Len(t, shares, 1)
+	require.Equal(t, sdkmath.NewInt(50), shares[0].Shares)
+
+	t.Run("diff between epochs", func(t *testing.T) {
+		diffs, err := k.OperatorSharesDiff(ctx, chainID, "", 1, 3)
+		require.NoError(t, err)
+		require.Len(t, diffs, 2)
+		require.Equal(t, historyOperatorA, diffs[0].Operator)
+		require.Equal(t, sdkmath.NewInt(150), diffs[0].FromShares)
+		require.Equal(t, sdkmath.NewInt(200), diffs[0].ToShares)
+		require.Equal(t, sdkmath.NewInt(50), diffs[0].Delta)
+		require.Equal(t, historyOperatorB, diffs[1].Operator)
+		require.Equal(t, sdkmath.ZeroInt(), diffs[1].FromShares)
+		require.Equal(t, sdkmath.NewInt(50), diffs[1].Delta)
+
+		diffs, err = k.OperatorSharesDiff(ctx, chainID, "", 3, 5)
+		require.NoError(t, err)
+		require.Empty(t, diffs)
+
+		_, err = k.OperatorSharesDiff(ctx, chainID, "", 3, 1)
+		require.ErrorIs(t, err, types.ErrInvalidEpochRange)
+	})
+}
+
+func TestKeeper_GroupOperatorsAtEpoch(t *testing.T) {
+	k, ctx := keepertest.RestakingKeeper(t)
+	registryRouter := common.HexToAddress("0x0000000000000000000000000000000000000123")
+
+	k.SetEpochNumber(ctx, 2)
+	require.NoError(t, k.AddGroupOperatorRegistration(ctx, registryRouter, &types.GroupOperatorRegistrationV2{
+		Operator:     historyOperatorA,
+		GroupNumbers: []byte{0, 1},
+	}))
+	require.NoError(t, k.AddGroupOperatorRegistration(ctx, registryRouter, &types.GroupOperatorRegistrationV2{
+		Operator:     historyOperatorB,
+		GroupNumbers: []byte{1},
+	}))
+	k.SetEpochNumber(ctx, 5)
+	require.NoError(t, k.RemoveGroupOperatorRegistration(ctx, registryRouter, common.HexToAddress(historyOperatorA)))
+
+	operators, err := k.GroupOperatorsAtEpoch(ctx, registryRouter, 1, 1)
+	require.NoError(t, err)
+	require.Empty(t, operators)
+
+	operators, err = k.GroupOperatorsAtEpoch(ctx, registryRouter, 0, 4)
+	require.NoError(t, err)
+	require.Len(t, operators, 1)
+
+	operators, err = k.GroupOperatorsAtEpoch(ctx, registryRouter, 1, 4)
+	require.NoError(t, err)
+	require.Len(t, operators, 2)
+
+	operators, err = k.GroupOperatorsAtEpoch(ctx, registryRouter, 1, 5)
+	require.NoError(t, err)
+	require.Len(t, operators, 1)
+	require.Equal(t, historyOperatorB, operators[0].Operator)
+}
+
+func TestKeeper_PruneSharesHistory(t *testing.T) {
+	k, ctx := keepertest.RestakingKeeper(t)
+	chainID := uint64(1337)
+
+	for _, epoch := range []uint64{1, 2, 3, 6} {
+		k.SetEpochNumber(ctx, epoch)
+		k.SetOperatorShares(ctx, chainID, historyOperatorA, historyStrategy, sdkmath.NewIntFromUint64(epoch*10))
+	}
+
+	// retention disabled keeps everything
+	k.PruneSharesHistory(ctx, 6)
+	require.Zero(t, k.GetSharesHistoryPrunedBefore(ctx))
+
+	k.SetSharesHistoryRetention(ctx, 2)
+	k.PruneSharesHistory(ctx, 6)
+	require.EqualValues(t, 4, k.GetSharesHistoryPrunedBefore(ctx))
+
+	_, err := k.OperatorSharesAtEpoch(ctx, chainID, "", 3)
+	require.ErrorIs(t, err, types.ErrHistoryPruned)
+
+	shares, err := k.OperatorSharesAtEpoch(ctx, chainID, "", 4)
+	require.NoError(t, err)
+	require.Len(t, shares, 1)
+	require.Equal(t, sdkmath.NewInt(30), shares[0].Shares)
+
+	shares, err = k.OperatorSharesAtEpoch(ctx, chainID, "", 6)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(60), shares[0].Shares)
+
+	// the entry at epoch 3 is dropped once epoch 6 enters the pruned range
+	k.PruneSharesHistory(ctx, 9)
+	require.EqualValues(t, 7, k.GetSharesHistoryPrunedBefore(ctx))
+
+	shares, err = k.OperatorSharesAtEpoch(ctx, chainID, "", 7)
+	require.NoError(t, err)
+	require.Len(t, shares, 1)
+	require.Equal(t, sdkmath.NewInt(60), shares[0].Shares)
+}
+
+func TestKeeper_SharesHistoryIsolation(t *testing.T) {
+	k, ctx := keepertest.RestakingKeeper(t)
+	chainID := uint64(1337)
+
+	for _, epoch := range []uint64{1, 2, 3} {
+		k.SetEpochNumber(ctx, epoch)
+		k.SetOperatorShares(ctx, chainID, historyOperatorA, historyStrategy, sdkmath.NewIntFromUint64(epoch*10))
+	}
+
+	// the history versions are not returned as live shares
+	shares := k.GetAllShares(ctx)
+	require.Len(t, shares, 1)
+	require.Equal(t, sdkmath.NewInt(30), shares[0].Shares)
+
+	k.DeleteAllShares(ctx)
+	require.Empty(t, k.GetAllShares(ctx))
+
+	history, err := k.OperatorSharesAtEpoch(ctx, chainID, "", 2)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	require.Equal(t, sdkmath.NewInt(20), history[0].Shares)
+}
+
+func TestKeeper_BackfillSharesHistory(t *testing.T) {
+	k, ctx := keepertest.RestakingKeeper(t)
+	chainID := uint64(1337)
+
+	k.SetEpochNumber(ctx, 5)
+	k.SetOperatorShares(ctx, chainID, historyOperatorA, historyStrategy, sdkmath.NewInt(100))
+
+	require.NoError(t, keeper.NewMigrator(*k).Migrate1to2(ctx))
+	require.EqualValues(t, 5, k.GetSharesHistoryPrunedBefore(ctx))
+
+	// the epochs before the upgrade have no history
+	_, err := k.OperatorSharesAtEpoch(ctx, chainID, "", 4)
+	require.ErrorIs(t, err, types.ErrHistoryPruned)
+
+	shares, err := k.OperatorSharesAtEpoch(ctx, chainID, "", 5)
+	require.NoError(t, err)
+	require.Len(t, shares, 1)
+	require.Equal(t, sdkmath.NewInt(100), shares[0].Shares)
+}
@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"fmt"
+
+	cosmoserrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/0xPellNetwork/aegis/x/restaking/types"
+)
+
+// SetStakerDelegation stores the shares of a strategy delegated by a staker, zero shares delete the delegation
+func (k Keeper) SetStakerDelegation(ctx sdk.Context, delegation types.StakerDelegation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.KeyStakerDelegation))
+	key := append(types.StakerDelegationPrefix(delegation.ChainId, delegation.Operator, delegation.Strategy), []byte(delegation.Staker)...)
+
+	if delegation.Shares.IsZero() {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, k.cdc.MustMarshal(&delegation))
+}
+
+// GetStakerDelegation returns the shares of a strategy delegated by a staker to an operator
+func (k Keeper) GetStakerDelegation(ctx sdk.Context, chainId uint64, operator, strategy, staker string) types.StakerDelegation {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.KeyStakerDelegation))
+	key := append(types.StakerDelegationPrefix(chainId, operator, strategy), []byte(staker)...)
+
+	bz := store.Get(key)
+	if bz == nil {
+		return types.StakerDelegation{
+			ChainId:  chainId,
+			Operator: operator,
+			Strategy: strategy,
+			Staker:   staker,
+			Shares:   sdkmath.ZeroInt(),
+		}
+	}
+
+	var delegation types.StakerDelegation
+	k.cdc.MustUnmarshal(bz, &delegation)
+	return delegation
+}
+
+// GetAllStakerDelegations returns the delegations of all stakers
+func (k Keeper) GetAllStakerDelegations(ctx sdk.Context) []types.StakerDelegation {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.KeyStakerDelegation))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var delegations []types.StakerDelegation
+	for ; iterator.Valid(); iterator.Next() {
+		var delegation types.StakerDelegation
+		k.cdc.MustUnmarshal(iterator.Value(), &delegation)
+		delegations = append(delegations, delegation)
+	}
+
+	return delegations
+}
+
+// increaseStakerDelegation adds the shares delegated by a staker
+func (k Keeper) increaseStakerDelegation(ctx sdk.Context, chainId uint64, operator, strategy, staker string, shares sdkmath.Int) {
+	delegation := k.GetStakerDelegation(ctx, chainId, operator, strategy, staker)
+	delegation.Shares = delegation.Shares.Add(shares)
+	k.SetStakerDelegation(ctx, delegation)
+}
+
+// decreaseStakerDelegation removes the shares delegated by a staker.
+// The shares not tracked for the staker are taken from the shares delegated before the stakers were tracked.
+func (k Keeper) decreaseStakerDelegation(ctx sdk.Context, chainId uint64, operator, strategy, staker string, shares sdkmath.Int) error {
+	delegation := k.GetStakerDelegation(ctx, chainId, operator, strategy, staker)
+	fromStaker := sdkmath.MinInt(delegation.Shares, shares)
+	remaining := shares.Sub(fromStaker)
+
+	if remaining.IsPositive() {
+		untracked := k.GetStakerDelegation(ctx, chainId, operator, strategy, types.UntrackedStaker)
+		if staker == types.UntrackedStaker || untracked.Shares.LT(remaining) {
+			return cosmoserrors.Wrapf(types.ErrInvalidShares, "shares %s exceed the shares delegated by staker %s", shares, staker)
+		}
+		untracked.Shares = untracked.Shares.Sub(remaining)
+		k.SetStakerDelegation(ctx, untracked)
+	}
+
+	delegation.Shares = delegation.Shares.Sub(fromStaker)
+	k.SetStakerDelegation(ctx, delegation)
+	return nil
+}
+
+// BackfillStakerDelegations attributes the operator shares not covered by the delegations of the stakers
+// to the untracked staker, the stakers of the shares delegated before the stakers were tracked are unknown
+func (k Keeper) BackfillStakerDelegations(ctx sdk.Context) {
+	delegated := make(map[string]sdkmath.Int)
+	for _, delegation := range k.GetAllStakerDelegations(ctx) {
+		key := sharesKey(delegation.ChainId, delegation.Operator, delegation.Strategy)
+		if sum, ok := delegated[key]; ok {
+			delegated[key] = sum.Add(delegation.Shares)
+		} else {
+			delegated[key] = delegation.Shares
+		}
+	}
+
+	for _, shares := range k.GetAllShares(ctx) {
+		sum, ok := delegated[sharesKey(shares.ChainId, shares.Operator, shares.Strategy)]
+		if !ok {
+			sum = sdkmath.ZeroInt()
+		}
+		if untracked := shares.Shares.Sub(sum); untracked.IsPositive() {
+			k.increaseStakerDelegation(ctx, shares.ChainId, shares.Operator, shares.Strategy, types.UntrackedStaker, untracked)
+		}
+	}
+}
+
+// sharesKey identifies the shares of a strategy delegated to an operator
+func sharesKey(chainId uint64, operator, strategy string) string {
+	return fmt.Sprintf("%d/%s/%s", chainId, operator, strategy)
+}
@@ -133,10 +133,17 @@ func (AppModule) QuerierRoute() string { return types.QuerierRoute }
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(err)
+	}
 }
 
-// RegisterInvariants registers the pevm module's invariants.
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+// RegisterInvariants registers the restaking module's invariants.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
 
 // InitGenesis performs the pevm module's genesis initialization It returns
 // no validator updates.
@@ -161,7 +168,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return 2 }
 
 // BeginBlock executes all ABCI BeginBlock logic respective to the pevm module.
 func (am AppModule) BeginBlock(_ context.Context) error { return nil }
@@ -169,7 +176,10 @@ func (am AppModule) BeginBlock(_ context.Context) error { return nil }
 // EndBlock executes all ABCI EndBlock logic respective to the pevm module. It
 // returns no validator updates.
 func (am AppModule) EndBlock(ctx context.Context) error {
-	am.keeper.ProcessEpochs(sdk.UnwrapSDKContext(ctx))
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	am.keeper.ProcessEpochs(sdkCtx)
+	am.keeper.ReconcileOutboundStates(sdkCtx)
+	am.keeper.DeliverPendingEjections(sdkCtx)
 
 	return nil
 }
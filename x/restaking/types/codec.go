@@ -10,12 +10,14 @@ import (
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpsertOutboundState{}, "restaking/MsgUpsertOutboundState", nil)
 	cdc.RegisterConcrete(&MsgUpdateBlocksPerEpoch{}, "restaking/MsgUpdateBlocksPerEpoch", nil)
+	cdc.RegisterConcrete(&MsgUpdateSharesHistoryRetention{}, "restaking/MsgUpdateSharesHistoryRetention", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpsertOutboundState{},
 		&MsgUpdateBlocksPerEpoch{},
+		&MsgUpdateSharesHistoryRetention{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
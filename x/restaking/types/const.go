@@ -4,4 +4,18 @@ const (
 	EmptyTxOrigin                = "0x0000000000000000000000000000000000000000"
 	MaxPoolParamsPerMsg          = 2 // Adjust this value based on your needs
 	WaitForLastChunkSyncedSecond = 30
+
+	// OutboundSyncTimeoutBlocks is the number of blocks after which a pending epoch sync is considered failed
+	OutboundSyncTimeoutBlocks = 1200
+	// OutboundSyncRetryBaseBlocks is the backoff before the first re-issue of a failed epoch sync
+	OutboundSyncRetryBaseBlocks = 10
+	// OutboundSyncRetryMaxBlocks caps the backoff between re-issues of a failed epoch sync
+	OutboundSyncRetryMaxBlocks = 1200
+	// MaxReconcileDiffEpochs is the largest epoch range rebuilt as a diff, larger lags resend the full snapshot
+	MaxReconcileDiffEpochs = 100
+
+	// StakeWeightingDivisor is the divisor applied to shares times pool multiplier to get the stake of an operator
+	StakeWeightingDivisor = 1e18
+	// BipsDenominator is the denominator of GroupEjectionParam.EjectableStakePercent
+	BipsDenominator = 10000
 )
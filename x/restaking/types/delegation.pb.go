@@ -86,6 +86,77 @@ func (m *OperatorShares) GetStrategy() string {
 	return ""
 }
 
+// StakerDelegation stores the shares of a strategy delegated by a staker to an
+// operator
+type StakerDelegation struct {
+	ChainId  uint64                `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Operator string                `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Strategy string                `protobuf:"bytes,3,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	Staker   string                `protobuf:"bytes,4,opt,name=staker,proto3" json:"staker,omitempty"`
+	Shares   cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=shares,proto3,customtype=cosmossdk.io/math.Int" json:"shares"`
+}
+
+func (m *StakerDelegation) Reset()         { *m = StakerDelegation{} }
+func (m *StakerDelegation) String() string { return proto.CompactTextString(m) }
+func (*StakerDelegation) ProtoMessage()    {}
+func (*StakerDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c86e24084b659ef, []int{1}
+}
+func (m *StakerDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StakerDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StakerDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StakerDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakerDelegation.Merge(m, src)
+}
+func (m *StakerDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *StakerDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakerDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakerDelegation proto.InternalMessageInfo
+
+func (m *StakerDelegation) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *StakerDelegation) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *StakerDelegation) GetStrategy() string {
+	if m != nil {
+		return m.Strategy
+	}
+	return ""
+}
+
+func (m *StakerDelegation) GetStaker() string {
+	if m != nil {
+		return m.Staker
+	}
+	return ""
+}
+
 // ChangedOperatorSharesSnapshot is the snapshot of changed operator shares in
 // an epoch
 type ChangedOperatorSharesSnapshot struct {
@@ -97,7 +168,7 @@ func (m *ChangedOperatorSharesSnapshot) Reset()         { *m = ChangedOperatorSh
 func (m *ChangedOperatorSharesSnapshot) String() string { return proto.CompactTextString(m) }
 func (*ChangedOperatorSharesSnapshot) ProtoMessage()    {}
 func (*ChangedOperatorSharesSnapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c86e24084b659ef, []int{1}
+	return fileDescriptor_9c86e24084b659ef, []int{2}
 }
 func (m *ChangedOperatorSharesSnapshot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -140,36 +211,108 @@ func (m *ChangedOperatorSharesSnapshot) GetOperatorShares() []*OperatorShares {
 	return nil
 }
 
+// OperatorSharesDiff is the change of the operator shares of a strategy between
+// two epochs
+type OperatorSharesDiff struct {
+	ChainId    uint64                `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Operator   string                `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Strategy   string                `protobuf:"bytes,3,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	FromShares cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=from_shares,json=fromShares,proto3,customtype=cosmossdk.io/math.Int" json:"from_shares"`
+	ToShares   cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=to_shares,json=toShares,proto3,customtype=cosmossdk.io/math.Int" json:"to_shares"`
+	Delta      cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=delta,proto3,customtype=cosmossdk.io/math.Int" json:"delta"`
+}
+
+func (m *OperatorSharesDiff) Reset()         { *m = OperatorSharesDiff{} }
+func (m *OperatorSharesDiff) String() string { return proto.CompactTextString(m) }
+func (*OperatorSharesDiff) ProtoMessage()    {}
+func (*OperatorSharesDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c86e24084b659ef, []int{3}
+}
+func (m *OperatorSharesDiff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperatorSharesDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperatorSharesDiff.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OperatorSharesDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperatorSharesDiff.Merge(m, src)
+}
+func (m *OperatorSharesDiff) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperatorSharesDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperatorSharesDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperatorSharesDiff proto.InternalMessageInfo
+
+func (m *OperatorSharesDiff) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *OperatorSharesDiff) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *OperatorSharesDiff) GetStrategy() string {
+	if m != nil {
+		return m.Strategy
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*OperatorShares)(nil), "restaking.OperatorShares")
+	proto.RegisterType((*StakerDelegation)(nil), "restaking.StakerDelegation")
 	proto.RegisterType((*ChangedOperatorSharesSnapshot)(nil), "restaking.ChangedOperatorSharesSnapshot")
+	proto.RegisterType((*OperatorSharesDiff)(nil), "restaking.OperatorSharesDiff")
 }
 
 func init() { proto.RegisterFile("restaking/delegation.proto", fileDescriptor_9c86e24084b659ef) }
 
 var fileDescriptor_9c86e24084b659ef = []byte{
-	// 326 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0x3f, 0x6b, 0x32, 0x31,
-	0x1c, 0xc7, 0x2f, 0x2a, 0x3e, 0x1a, 0x1f, 0x2c, 0x1c, 0x2d, 0x9c, 0x82, 0xa7, 0x75, 0x72, 0xba,
-	0x48, 0x4b, 0xdf, 0x80, 0x9d, 0xec, 0x60, 0xcb, 0xb9, 0x75, 0x91, 0x78, 0x17, 0x92, 0xa0, 0x97,
-	0xdf, 0x91, 0x44, 0xaa, 0x6f, 0xa0, 0x73, 0xe7, 0xbe, 0x22, 0x47, 0xc7, 0xd2, 0x41, 0x8a, 0xbe,
-	0x91, 0xe2, 0x79, 0x5a, 0xdc, 0xf2, 0xfd, 0xc3, 0x8f, 0x0f, 0xdf, 0xe0, 0xa6, 0x66, 0xc6, 0xd2,
-	0x99, 0x54, 0x9c, 0xc4, 0x6c, 0xce, 0x38, 0xb5, 0x12, 0x54, 0x90, 0x6a, 0xb0, 0xe0, 0x56, 0xcf,
-	0x59, 0xf3, 0x9a, 0x03, 0x87, 0xcc, 0x25, 0x87, 0xd7, 0xb1, 0xd0, 0xfd, 0x44, 0xb8, 0xfe, 0x9c,
-	0x32, 0x4d, 0x2d, 0xe8, 0xb1, 0xa0, 0x9a, 0x19, 0xb7, 0x81, 0x2b, 0x91, 0xa0, 0x52, 0x4d, 0x64,
-	0xec, 0xa1, 0x0e, 0xea, 0x95, 0xc2, 0x7f, 0x99, 0x1e, 0xc6, 0x6e, 0x13, 0x57, 0x20, 0x2f, 0x7b,
-	0x85, 0x0e, 0xea, 0x55, 0xc3, 0xb3, 0x3e, 0x64, 0xc6, 0x6a, 0x6a, 0x19, 0x5f, 0x79, 0xc5, 0x63,
-	0x76, 0xd2, 0xee, 0x03, 0x2e, 0x9b, 0xec, 0xb8, 0x57, 0x3a, 0x24, 0x83, 0xd6, 0x7a, 0xdb, 0x76,
-	0xbe, 0xb7, 0xed, 0x9b, 0x08, 0x4c, 0x02, 0xc6, 0xc4, 0xb3, 0x40, 0x02, 0x49, 0xa8, 0x15, 0xc1,
-	0x50, 0xd9, 0x30, 0x2f, 0x77, 0xdf, 0x11, 0x6e, 0x3d, 0x0a, 0xaa, 0x38, 0x8b, 0x2f, 0x19, 0xc7,
-	0x8a, 0xa6, 0x46, 0x80, 0x75, 0x6f, 0xf1, 0x7f, 0x96, 0x42, 0x24, 0x26, 0x6a, 0x91, 0x4c, 0x99,
-	0xce, 0x79, 0x6b, 0x99, 0x37, 0xca, 0x2c, 0x77, 0x80, 0xaf, 0x4e, 0x8c, 0x93, 0x1c, 0xa2, 0xd0,
-	0x29, 0xf6, 0x6a, 0x77, 0x8d, 0xe0, 0x3c, 0x4e, 0x70, 0x79, 0x3e, 0xac, 0xc3, 0x85, 0x1e, 0x3c,
-	0xad, 0x77, 0x3e, 0xda, 0xec, 0x7c, 0xf4, 0xb3, 0xf3, 0xd1, 0xc7, 0xde, 0x77, 0x36, 0x7b, 0xdf,
-	0xf9, 0xda, 0xfb, 0xce, 0x6b, 0x9f, 0x4b, 0x2b, 0x16, 0xd3, 0x20, 0x82, 0x84, 0xf4, 0x97, 0x2f,
-	0x6c, 0x3e, 0x1f, 0x31, 0xfb, 0x06, 0x7a, 0x46, 0x28, 0xe3, 0xd2, 0x90, 0x25, 0xf9, 0xfb, 0x1d,
-	0xbb, 0x4a, 0x99, 0x99, 0x96, 0xb3, 0xe1, 0xef, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x5b, 0xac,
-	0xa3, 0xe2, 0xb7, 0x01, 0x00, 0x00,
+	// 416 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0xbd, 0x8e, 0xd3, 0x40,
+	0x10, 0xf6, 0xe6, 0xee, 0x4c, 0xb2, 0x41, 0x07, 0xb2, 0x00, 0xf9, 0x22, 0x9d, 0x2f, 0xa4, 0x4a,
+	0x65, 0x9f, 0x38, 0xd1, 0x50, 0x50, 0x84, 0x6b, 0x8e, 0xe2, 0x40, 0x4e, 0x47, 0x63, 0x6d, 0xec,
+	0xcd, 0x7a, 0xe5, 0x9f, 0xb1, 0x76, 0xe7, 0xc4, 0xdd, 0x0b, 0x50, 0x53, 0x22, 0x9e, 0x83, 0x87,
+	0xb8, 0xf2, 0x4a, 0x44, 0x11, 0xa1, 0xe4, 0x45, 0x90, 0x7f, 0x62, 0x70, 0x47, 0x8a, 0x74, 0xfe,
+	0xe6, 0xfb, 0x66, 0xfc, 0xcd, 0xcc, 0x0e, 0x1d, 0x29, 0xae, 0x91, 0x25, 0x32, 0x17, 0x5e, 0xc4,
+	0x53, 0x2e, 0x18, 0x4a, 0xc8, 0xdd, 0x42, 0x01, 0x82, 0x35, 0x68, 0xb9, 0xd1, 0x33, 0x01, 0x02,
+	0xaa, 0xa8, 0x57, 0x7e, 0xd5, 0x82, 0xc9, 0x77, 0x42, 0x8f, 0x3f, 0x14, 0x5c, 0x31, 0x04, 0x35,
+	0x8f, 0x99, 0xe2, 0xda, 0x3a, 0xa1, 0xfd, 0x30, 0x66, 0x32, 0x0f, 0x64, 0x64, 0x93, 0x31, 0x99,
+	0x1e, 0xfa, 0x8f, 0x2a, 0x7c, 0x15, 0x59, 0x23, 0xda, 0x87, 0x46, 0x6c, 0xf7, 0xc6, 0x64, 0x3a,
+	0xf0, 0x5b, 0x5c, 0x72, 0x1a, 0x15, 0x43, 0x2e, 0xee, 0xec, 0x83, 0x9a, 0xdb, 0x62, 0xeb, 0x35,
+	0x35, 0x75, 0x55, 0xdc, 0x3e, 0x2c, 0x99, 0xd9, 0xe9, 0xfd, 0xea, 0xcc, 0xf8, 0xb5, 0x3a, 0x7b,
+	0x1e, 0x82, 0xce, 0x40, 0xeb, 0x28, 0x71, 0x25, 0x78, 0x19, 0xc3, 0xd8, 0xbd, 0xca, 0xd1, 0x6f,
+	0xc4, 0x93, 0x1f, 0x84, 0x3e, 0x9d, 0x23, 0x4b, 0xb8, 0xba, 0x6c, 0x1b, 0xdb, 0x87, 0xbd, 0x17,
+	0xd4, 0xd4, 0xd5, 0x6f, 0x6a, 0x7b, 0x7e, 0x83, 0xfe, 0xb1, 0x7d, 0xb4, 0x8b, 0xed, 0x2f, 0x84,
+	0x9e, 0xbe, 0x8b, 0x59, 0x2e, 0x78, 0xd4, 0x1d, 0xed, 0x3c, 0x67, 0x85, 0x8e, 0x01, 0xad, 0x97,
+	0xf4, 0x31, 0x2f, 0x20, 0x8c, 0x83, 0xfc, 0x26, 0x5b, 0x70, 0xd5, 0xf4, 0x31, 0xac, 0x62, 0xd7,
+	0x55, 0xc8, 0x9a, 0xd1, 0x27, 0x5b, 0xef, 0x41, 0x63, 0xa2, 0x37, 0x3e, 0x98, 0x0e, 0x5f, 0x9d,
+	0xb8, 0xed, 0x4e, 0xdd, 0x6e, 0x79, 0xff, 0x18, 0x3a, 0x78, 0xf2, 0xad, 0x47, 0xad, 0xae, 0xe4,
+	0x52, 0x2e, 0x97, 0xfb, 0x98, 0xe0, 0x5b, 0x3a, 0x5c, 0x2a, 0xc8, 0x82, 0x5d, 0xb6, 0x4c, 0xcb,
+	0x8c, 0xe6, 0xcd, 0xbd, 0xa1, 0x03, 0x84, 0x60, 0x97, 0x61, 0xf7, 0x11, 0x9a, 0xdc, 0x0b, 0x7a,
+	0x14, 0xf1, 0x14, 0x99, 0x6d, 0xfe, 0x4f, 0x5e, 0xad, 0x9d, 0xbd, 0xbf, 0x5f, 0x3b, 0xe4, 0x61,
+	0xed, 0x90, 0xdf, 0x6b, 0x87, 0x7c, 0xdd, 0x38, 0xc6, 0xc3, 0xc6, 0x31, 0x7e, 0x6e, 0x1c, 0xe3,
+	0xd3, 0xb9, 0x90, 0x18, 0xdf, 0x2c, 0xdc, 0x10, 0x32, 0xef, 0xfc, 0xf6, 0x23, 0x4f, 0xd3, 0x6b,
+	0x8e, 0x9f, 0x41, 0x25, 0x1e, 0xe3, 0x42, 0x6a, 0xef, 0xd6, 0xfb, 0x7b, 0x6f, 0x78, 0x57, 0x70,
+	0xbd, 0x30, 0xab, 0x53, 0xba, 0xf8, 0x33, 0x00, 0xb2, 0x8a, 0x4a, 0xef, 0x89, 0x03, 0x00, 0x00,
 }
 
 func (m *OperatorShares) Marshal() (dAtA []byte, err error) {
@@ -224,6 +367,65 @@ func (m *OperatorShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *StakerDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StakerDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakerDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDelegation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintDelegation(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Strategy) > 0 {
+		i -= len(m.Strategy)
+		copy(dAtA[i:], m.Strategy)
+		i = encodeVarintDelegation(dAtA, i, uint64(len(m.Strategy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintDelegation(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintDelegation(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ChangedOperatorSharesSnapshot) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -266,6 +468,78 @@ func (m *ChangedOperatorSharesSnapshot) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *OperatorSharesDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperatorSharesDiff) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperatorSharesDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Delta.Size()
+		i -= size
+		if _, err := m.Delta.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDelegation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.ToShares.Size()
+		i -= size
+		if _, err := m.ToShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDelegation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.FromShares.Size()
+		i -= size
+		if _, err := m.FromShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDelegation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Strategy) > 0 {
+		i -= len(m.Strategy)
+		copy(dAtA[i:], m.Strategy)
+		i = encodeVarintDelegation(dAtA, i, uint64(len(m.Strategy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintDelegation(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintDelegation(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintDelegation(dAtA []byte, offset int, v uint64) int {
 	offset -= sovDelegation(v)
 	base := offset
@@ -299,6 +573,32 @@ func (m *OperatorShares) Size() (n int) {
 	return n
 }
 
+func (m *StakerDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovDelegation(uint64(m.ChainId))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	l = len(m.Strategy)
+	if l > 0 {
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovDelegation(uint64(l))
+	return n
+}
+
 func (m *ChangedOperatorSharesSnapshot) Size() (n int) {
 	if m == nil {
 		return 0
@@ -317,6 +617,32 @@ func (m *ChangedOperatorSharesSnapshot) Size() (n int) {
 	return n
 }
 
+func (m *OperatorSharesDiff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovDelegation(uint64(m.ChainId))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	l = len(m.Strategy)
+	if l > 0 {
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	l = m.FromShares.Size()
+	n += 1 + l + sovDelegation(uint64(l))
+	l = m.ToShares.Size()
+	n += 1 + l + sovDelegation(uint64(l))
+	l = m.Delta.Size()
+	n += 1 + l + sovDelegation(uint64(l))
+	return n
+}
+
 func sovDelegation(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -490,7 +816,7 @@ func (m *OperatorShares) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChangedOperatorSharesSnapshot) Unmarshal(dAtA []byte) error {
+func (m *StakerDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -513,17 +839,17 @@ func (m *ChangedOperatorSharesSnapshot) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChangedOperatorSharesSnapshot: wiretype end group for non-group")
+			return fmt.Errorf("proto: StakerDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChangedOperatorSharesSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StakerDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			m.EpochNumber = 0
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDelegation
@@ -533,14 +859,213 @@ func (m *ChangedOperatorSharesSnapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochNumber |= uint64(b&0x7F) << shift
+				m.ChainId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Strategy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChangedOperatorSharesSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChangedOperatorSharesSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChangedOperatorSharesSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorShares", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -593,6 +1118,241 @@ func (m *ChangedOperatorSharesSnapshot) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *OperatorSharesDiff) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperatorSharesDiff: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperatorSharesDiff: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Strategy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FromShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ToShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delta", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Delta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipDelegation(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
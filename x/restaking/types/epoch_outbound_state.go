@@ -0,0 +1,32 @@
+package types
+
+// OutboundSyncRetryBackoff returns the number of blocks to wait before re-issuing
+// an epoch sync that failed retryCount times. The backoff doubles on every failure
+// and is capped at OutboundSyncRetryMaxBlocks.
+func OutboundSyncRetryBackoff(retryCount uint64) int64 {
+	backoff := int64(OutboundSyncRetryBaseBlocks)
+	for i := uint64(1); i < retryCount; i++ {
+		backoff *= 2
+		if backoff >= OutboundSyncRetryMaxBlocks {
+			return OutboundSyncRetryMaxBlocks
+		}
+	}
+
+	return backoff
+}
+
+// SyncLag returns the number of closed epochs that are not synced to the chain yet.
+// lastClosedEpoch is the last epoch whose shares snapshot is final.
+func (m *EpochOutboundState) SyncLag(lastClosedEpoch uint64) uint64 {
+	if m.EpochNumber >= lastClosedEpoch {
+		return 0
+	}
+
+	return lastClosedEpoch - m.EpochNumber
+}
+
+// ClearPendingSync resets the bookkeeping of the pending epoch sync.
+func (m *EpochOutboundState) ClearPendingSync() {
+	m.PendingEpochNumber = 0
+	m.SyncStartHeight = 0
+}
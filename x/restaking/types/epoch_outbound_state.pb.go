@@ -33,18 +33,22 @@ const (
 	OutboundStatus_OUTBOUND_STATUS_NORMAL OutboundStatus = 1
 	// outbound is syncing
 	OutboundStatus_OUTBOUND_STATUS_SYNCING OutboundStatus = 2
+	// outbound sync failed and is re-issued after a backoff
+	OutboundStatus_OUTBOUND_STATUS_RECONCILING OutboundStatus = 3
 )
 
 var OutboundStatus_name = map[int32]string{
 	0: "OUTBOUND_STATUS_INITIALIZING",
 	1: "OUTBOUND_STATUS_NORMAL",
 	2: "OUTBOUND_STATUS_SYNCING",
+	3: "OUTBOUND_STATUS_RECONCILING",
 }
 
 var OutboundStatus_value = map[string]int32{
 	"OUTBOUND_STATUS_INITIALIZING": 0,
 	"OUTBOUND_STATUS_NORMAL":       1,
 	"OUTBOUND_STATUS_SYNCING":      2,
+	"OUTBOUND_STATUS_RECONCILING":  3,
 }
 
 func (x OutboundStatus) String() string {
@@ -59,7 +63,18 @@ func (OutboundStatus) EnumDescriptor() ([]byte, []int) {
 type EpochOutboundState struct {
 	ChainId        uint64         `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 	OutboundStatus OutboundStatus `protobuf:"varint,2,opt,name=outbound_status,json=outboundStatus,proto3,enum=restaking.OutboundStatus" json:"outbound_status,omitempty"`
-	EpochNumber    uint64         `protobuf:"varint,3,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// last epoch synced to the chain
+	EpochNumber uint64 `protobuf:"varint,3,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// epoch of the sync xmsgs pending on the chain
+	PendingEpochNumber uint64 `protobuf:"varint,4,opt,name=pending_epoch_number,json=pendingEpochNumber,proto3" json:"pending_epoch_number,omitempty"`
+	// block height at which the pending sync xmsgs were issued
+	SyncStartHeight int64 `protobuf:"varint,5,opt,name=sync_start_height,json=syncStartHeight,proto3" json:"sync_start_height,omitempty"`
+	// whether the pending or next sync sends the full snapshot of the operator shares
+	FullSync bool `protobuf:"varint,6,opt,name=full_sync,json=fullSync,proto3" json:"full_sync,omitempty"`
+	// number of consecutive failed syncs
+	RetryCount uint64 `protobuf:"varint,7,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
+	// block height from which a failed sync is re-issued
+	NextRetryHeight int64 `protobuf:"varint,8,opt,name=next_retry_height,json=nextRetryHeight,proto3" json:"next_retry_height,omitempty"`
 }
 
 func (m *EpochOutboundState) Reset()         { *m = EpochOutboundState{} }
@@ -116,6 +131,41 @@ func (m *EpochOutboundState) GetEpochNumber() uint64 {
 	return 0
 }
 
+func (m *EpochOutboundState) GetPendingEpochNumber() uint64 {
+	if m != nil {
+		return m.PendingEpochNumber
+	}
+	return 0
+}
+
+func (m *EpochOutboundState) GetSyncStartHeight() int64 {
+	if m != nil {
+		return m.SyncStartHeight
+	}
+	return 0
+}
+
+func (m *EpochOutboundState) GetFullSync() bool {
+	if m != nil {
+		return m.FullSync
+	}
+	return false
+}
+
+func (m *EpochOutboundState) GetRetryCount() uint64 {
+	if m != nil {
+		return m.RetryCount
+	}
+	return 0
+}
+
+func (m *EpochOutboundState) GetNextRetryHeight() int64 {
+	if m != nil {
+		return m.NextRetryHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("restaking.OutboundStatus", OutboundStatus_name, OutboundStatus_value)
 	proto.RegisterType((*EpochOutboundState)(nil), "restaking.EpochOutboundState")
@@ -126,27 +176,35 @@ func init() {
 }
 
 var fileDescriptor_0937c3b6679fa926 = []byte{
-	// 317 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x29, 0x4a, 0x2d, 0x2e,
-	0x49, 0xcc, 0xce, 0xcc, 0x4b, 0xd7, 0x4f, 0x2d, 0xc8, 0x4f, 0xce, 0x88, 0xcf, 0x2f, 0x2d, 0x49,
-	0xca, 0x2f, 0xcd, 0x4b, 0x89, 0x2f, 0x2e, 0x49, 0x2c, 0x49, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0xe2, 0x84, 0xab, 0x92, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x8b, 0xea, 0x83, 0x58, 0x10,
-	0x05, 0x4a, 0xd3, 0x18, 0xb9, 0x84, 0x5c, 0x41, 0xfa, 0xfd, 0xa1, 0xda, 0x83, 0x41, 0xba, 0x85,
-	0x24, 0xb9, 0x38, 0x92, 0x33, 0x12, 0x33, 0xf3, 0xe2, 0x33, 0x53, 0x24, 0x18, 0x15, 0x18, 0x35,
-	0x58, 0x82, 0xd8, 0xc1, 0x7c, 0xcf, 0x14, 0x21, 0x27, 0x2e, 0x7e, 0x14, 0xab, 0x4a, 0x8b, 0x25,
-	0x98, 0x14, 0x18, 0x35, 0xf8, 0x8c, 0x24, 0xf5, 0xe0, 0x96, 0xe9, 0x21, 0x9b, 0x56, 0x5a, 0x1c,
-	0xc4, 0x97, 0x8f, 0xc2, 0x17, 0x52, 0xe4, 0xe2, 0x81, 0x38, 0x3a, 0xaf, 0x34, 0x37, 0x29, 0xb5,
-	0x48, 0x82, 0x19, 0x6c, 0x05, 0x37, 0x58, 0xcc, 0x0f, 0x2c, 0xa4, 0x55, 0xc8, 0xc5, 0x87, 0x6a,
-	0x88, 0x90, 0x02, 0x97, 0x8c, 0x7f, 0x68, 0x88, 0x93, 0x7f, 0xa8, 0x9f, 0x4b, 0x7c, 0x70, 0x88,
-	0x63, 0x48, 0x68, 0x70, 0xbc, 0xa7, 0x9f, 0x67, 0x88, 0xa7, 0xa3, 0x8f, 0x67, 0x94, 0xa7, 0x9f,
-	0xbb, 0x00, 0x83, 0x90, 0x14, 0x97, 0x18, 0xba, 0x0a, 0x3f, 0xff, 0x20, 0x5f, 0x47, 0x1f, 0x01,
-	0x46, 0x21, 0x69, 0x2e, 0x71, 0x74, 0xb9, 0xe0, 0x48, 0x3f, 0x67, 0x90, 0x46, 0x26, 0x29, 0x96,
-	0x15, 0x4b, 0xe4, 0x18, 0x9d, 0xbc, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1,
-	0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21,
-	0xca, 0x20, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0xdf, 0xa0, 0x22, 0x20,
-	0x35, 0x27, 0xc7, 0x2f, 0xb5, 0xa4, 0x3c, 0xbf, 0x28, 0x5b, 0x3f, 0x31, 0x35, 0x3d, 0xb3, 0x58,
-	0xbf, 0x42, 0x1f, 0x11, 0x1b, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0xe0, 0x35, 0x06,
-	0x04, 0x00, 0x00, 0xff, 0xff, 0x3d, 0xf0, 0x99, 0x01, 0xa7, 0x01, 0x00, 0x00,
+	// 442 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0xc1, 0x6e, 0xd3, 0x30,
+	0x1c, 0xc6, 0xeb, 0xb6, 0x6c, 0x9d, 0x87, 0xba, 0x61, 0x4d, 0x90, 0xb5, 0x28, 0x0b, 0x88, 0x43,
+	0xb5, 0x43, 0x53, 0xc1, 0x13, 0xb4, 0xa5, 0x82, 0xa0, 0xe2, 0xa0, 0xa4, 0x3d, 0xb0, 0x8b, 0xd5,
+	0xa6, 0x26, 0x89, 0x96, 0xd9, 0x51, 0x62, 0x8b, 0xe6, 0x2d, 0x10, 0xcf, 0xc0, 0x81, 0x47, 0xe1,
+	0xb8, 0x23, 0x27, 0x84, 0xda, 0x17, 0x41, 0x76, 0xaa, 0xd1, 0xf4, 0x96, 0xff, 0xef, 0xfb, 0xbe,
+	0x7c, 0x7f, 0x59, 0x7f, 0xf8, 0x2a, 0xa3, 0xb9, 0x58, 0xdc, 0xc6, 0x2c, 0xb4, 0x69, 0xca, 0x83,
+	0x88, 0x70, 0x29, 0x96, 0x5c, 0xb2, 0x15, 0xc9, 0xc5, 0x42, 0xd0, 0x7e, 0x9a, 0x71, 0xc1, 0xd1,
+	0xc9, 0x83, 0xab, 0x73, 0x11, 0xf2, 0x90, 0x6b, 0x6a, 0xab, 0xaf, 0xd2, 0xf0, 0xf2, 0x4f, 0x1d,
+	0xa2, 0x89, 0xca, 0xbb, 0xbb, 0xb8, 0xaf, 0xd2, 0xe8, 0x12, 0xb6, 0x82, 0x68, 0x11, 0x33, 0x12,
+	0xaf, 0x0c, 0x60, 0x81, 0x5e, 0xd3, 0x3b, 0xd6, 0xb3, 0xb3, 0x42, 0x23, 0x78, 0x56, 0xa9, 0x92,
+	0xb9, 0x51, 0xb7, 0x40, 0xaf, 0xfd, 0xfa, 0xb2, 0xff, 0x50, 0xd6, 0xdf, 0xff, 0x9b, 0xcc, 0xbd,
+	0x36, 0xaf, 0xcc, 0xe8, 0x05, 0x7c, 0x5c, 0x2e, 0xcd, 0xe4, 0xdd, 0x92, 0x66, 0x46, 0x43, 0x57,
+	0x9c, 0x6a, 0x86, 0x35, 0x42, 0x03, 0x78, 0x91, 0x52, 0xb6, 0x8a, 0x59, 0x48, 0x2a, 0xd6, 0xa6,
+	0xb6, 0xa2, 0x9d, 0x36, 0xd9, 0x4b, 0x5c, 0xc3, 0x27, 0x79, 0xc1, 0x02, 0xb5, 0x54, 0x26, 0x48,
+	0x44, 0xe3, 0x30, 0x12, 0xc6, 0x23, 0x0b, 0xf4, 0x1a, 0xde, 0x99, 0x12, 0x7c, 0xc5, 0xdf, 0x6b,
+	0x8c, 0xba, 0xf0, 0xe4, 0x8b, 0x4c, 0x12, 0xa2, 0xb8, 0x71, 0x64, 0x81, 0x5e, 0xcb, 0x6b, 0x29,
+	0xe0, 0x17, 0x2c, 0x40, 0x57, 0xf0, 0x34, 0xa3, 0x22, 0x2b, 0x48, 0xc0, 0x25, 0x13, 0xc6, 0xb1,
+	0x6e, 0x84, 0x1a, 0x8d, 0x15, 0x51, 0x4d, 0x8c, 0xae, 0x05, 0x29, 0x5d, 0xbb, 0xa6, 0x56, 0xd9,
+	0xa4, 0x04, 0x4f, 0xf1, 0xb2, 0xe9, 0xfa, 0x3b, 0x80, 0xed, 0xea, 0x6b, 0x20, 0x0b, 0x3e, 0x77,
+	0xe7, 0xb3, 0x91, 0x3b, 0xc7, 0x6f, 0x89, 0x3f, 0x1b, 0xce, 0xe6, 0x3e, 0x71, 0xb0, 0x33, 0x73,
+	0x86, 0x53, 0xe7, 0xc6, 0xc1, 0xef, 0xce, 0x6b, 0xa8, 0x03, 0x9f, 0x1e, 0x3a, 0xb0, 0xeb, 0x7d,
+	0x1c, 0x4e, 0xcf, 0x01, 0xea, 0xc2, 0x67, 0x87, 0x9a, 0xff, 0x19, 0x8f, 0x55, 0xb0, 0x8e, 0xae,
+	0x60, 0xf7, 0x50, 0xf4, 0x26, 0x63, 0x17, 0x8f, 0x9d, 0xa9, 0x32, 0x34, 0x3a, 0xcd, 0x9f, 0x3f,
+	0x4c, 0x30, 0xfa, 0xf0, 0x6b, 0x63, 0x82, 0xfb, 0x8d, 0x09, 0xfe, 0x6e, 0x4c, 0xf0, 0x6d, 0x6b,
+	0xd6, 0xee, 0xb7, 0x66, 0xed, 0xf7, 0xd6, 0xac, 0xdd, 0x0c, 0xc2, 0x58, 0x44, 0x72, 0xd9, 0x0f,
+	0xf8, 0x9d, 0x3d, 0x58, 0x7f, 0xa2, 0x49, 0x82, 0xa9, 0xf8, 0xca, 0xb3, 0x5b, 0x7b, 0x41, 0xc3,
+	0x38, 0xb7, 0xd7, 0xf6, 0xff, 0xbb, 0x13, 0x45, 0x4a, 0xf3, 0xe5, 0x91, 0x3e, 0xa4, 0x37, 0xff,
+	0x06, 0x00, 0x4d, 0xe6, 0xe0, 0x93, 0x91, 0x02, 0x00, 0x00,
 }
 
 func (m *EpochOutboundState) Marshal() (dAtA []byte, err error) {
@@ -169,6 +227,36 @@ func (m *EpochOutboundState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NextRetryHeight != 0 {
+		i = encodeVarintEpochOutboundState(dAtA, i, uint64(m.NextRetryHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.RetryCount != 0 {
+		i = encodeVarintEpochOutboundState(dAtA, i, uint64(m.RetryCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.FullSync {
+		i--
+		if m.FullSync {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SyncStartHeight != 0 {
+		i = encodeVarintEpochOutboundState(dAtA, i, uint64(m.SyncStartHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PendingEpochNumber != 0 {
+		i = encodeVarintEpochOutboundState(dAtA, i, uint64(m.PendingEpochNumber))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.EpochNumber != 0 {
 		i = encodeVarintEpochOutboundState(dAtA, i, uint64(m.EpochNumber))
 		i--
@@ -213,6 +301,21 @@ func (m *EpochOutboundState) Size() (n int) {
 	if m.EpochNumber != 0 {
 		n += 1 + sovEpochOutboundState(uint64(m.EpochNumber))
 	}
+	if m.PendingEpochNumber != 0 {
+		n += 1 + sovEpochOutboundState(uint64(m.PendingEpochNumber))
+	}
+	if m.SyncStartHeight != 0 {
+		n += 1 + sovEpochOutboundState(uint64(m.SyncStartHeight))
+	}
+	if m.FullSync {
+		n += 2
+	}
+	if m.RetryCount != 0 {
+		n += 1 + sovEpochOutboundState(uint64(m.RetryCount))
+	}
+	if m.NextRetryHeight != 0 {
+		n += 1 + sovEpochOutboundState(uint64(m.NextRetryHeight))
+	}
 	return n
 }
 
@@ -308,6 +411,102 @@ func (m *EpochOutboundState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingEpochNumber", wireType)
+			}
+			m.PendingEpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpochOutboundState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingEpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncStartHeight", wireType)
+			}
+			m.SyncStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpochOutboundState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SyncStartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FullSync", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpochOutboundState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FullSync = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryCount", wireType)
+			}
+			m.RetryCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpochOutboundState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRetryHeight", wireType)
+			}
+			m.NextRetryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpochOutboundState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRetryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEpochOutboundState(dAtA[iNdEx:])
@@ -0,0 +1,25 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/x/restaking/types"
+)
+
+func TestOutboundSyncRetryBackoff(t *testing.T) {
+	require.EqualValues(t, types.OutboundSyncRetryBaseBlocks, types.OutboundSyncRetryBackoff(0))
+	require.EqualValues(t, types.OutboundSyncRetryBaseBlocks, types.OutboundSyncRetryBackoff(1))
+	require.EqualValues(t, 2*types.OutboundSyncRetryBaseBlocks, types.OutboundSyncRetryBackoff(2))
+	require.EqualValues(t, 4*types.OutboundSyncRetryBaseBlocks, types.OutboundSyncRetryBackoff(3))
+	require.EqualValues(t, types.OutboundSyncRetryMaxBlocks, types.OutboundSyncRetryBackoff(64))
+	require.EqualValues(t, types.OutboundSyncRetryMaxBlocks, types.OutboundSyncRetryBackoff(1<<40))
+}
+
+func TestEpochOutboundState_SyncLag(t *testing.T) {
+	state := &types.EpochOutboundState{EpochNumber: 5}
+	require.EqualValues(t, 0, state.SyncLag(4))
+	require.EqualValues(t, 0, state.SyncLag(5))
+	require.EqualValues(t, 3, state.SyncLag(8))
+}
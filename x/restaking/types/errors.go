@@ -25,4 +25,7 @@ var (
 	ErrNilGasPrice            = cosmoserrors.Register(ModuleName, 1127, "nil gas price")
 	ErrCannotFindTSSKeys      = cosmoserrors.Register(ModuleName, 1122, "cannot find TSS keys")
 	ErrInvalidData            = cosmoserrors.Register(ModuleName, 1123, "invalid data")
+	ErrHistoryPruned          = cosmoserrors.Register(ModuleName, 1128, "history pruned")
+	ErrInvalidEpochRange      = cosmoserrors.Register(ModuleName, 1129, "invalid epoch range")
+	ErrInvalidShares          = cosmoserrors.Register(ModuleName, 1130, "invalid shares")
 )
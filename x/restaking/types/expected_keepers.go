@@ -87,6 +87,7 @@ type PevmKeeper interface {
 type XmsgKeeper interface {
 	GetGasPrice(ctx sdk.Context, chainID int64) (val xmsgtypes.GasPrice, found bool)
 	ProcessXmsg(ctx sdk.Context, xmsg xmsgtypes.Xmsg, receiverChain *chains.Chain) error
+	EmitEventPellSent(ctx sdk.Context, xmsg xmsgtypes.Xmsg)
 	ProcessPellSentEvent(
 		ctx sdk.Context,
 		event *pellconnector.PellConnectorPellSent,
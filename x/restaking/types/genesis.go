@@ -17,7 +17,9 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	// Check for duplicated index in foreignCoins
+	if gs.SharesHistoryPrunedBefore > gs.EpochNumber {
+		return fmt.Errorf("shares history pruned before epoch %d is after the current epoch %d", gs.SharesHistoryPrunedBefore, gs.EpochNumber)
+	}
 	return nil
 }
 
@@ -27,6 +27,15 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	OperatorShare      []OperatorShares     `protobuf:"bytes,1,rep,name=operator_share,json=operatorShare,proto3" json:"operator_share"`
 	RegistryRouterData []RegistryRouterData `protobuf:"bytes,2,rep,name=registry_router_data,json=registryRouterData,proto3" json:"registry_router_data"`
+	StakerDelegations  []StakerDelegation   `protobuf:"bytes,3,rep,name=staker_delegations,json=stakerDelegations,proto3" json:"staker_delegations"`
+	// current epoch number, the history is recorded by epoch
+	EpochNumber uint64 `protobuf:"varint,4,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// number of epochs the shares history is retained, 0 keeps it forever
+	SharesHistoryRetention uint64 `protobuf:"varint,5,opt,name=shares_history_retention,json=sharesHistoryRetention,proto3" json:"shares_history_retention,omitempty"`
+	// first epoch whose shares history is complete
+	SharesHistoryPrunedBefore uint64                       `protobuf:"varint,6,opt,name=shares_history_pruned_before,json=sharesHistoryPrunedBefore,proto3" json:"shares_history_pruned_before,omitempty"`
+	OperatorSharesHistory     []OperatorSharesHistoryEntry `protobuf:"bytes,7,rep,name=operator_shares_history,json=operatorSharesHistory,proto3" json:"operator_shares_history"`
+	GroupOperatorHistory      []GroupOperatorHistoryEntry  `protobuf:"bytes,8,rep,name=group_operator_history,json=groupOperatorHistory,proto3" json:"group_operator_history"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -76,6 +85,162 @@ func (m *GenesisState) GetRegistryRouterData() []RegistryRouterData {
 	return nil
 }
 
+func (m *GenesisState) GetStakerDelegations() []StakerDelegation {
+	if m != nil {
+		return m.StakerDelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *GenesisState) GetSharesHistoryRetention() uint64 {
+	if m != nil {
+		return m.SharesHistoryRetention
+	}
+	return 0
+}
+
+func (m *GenesisState) GetSharesHistoryPrunedBefore() uint64 {
+	if m != nil {
+		return m.SharesHistoryPrunedBefore
+	}
+	return 0
+}
+
+func (m *GenesisState) GetOperatorSharesHistory() []OperatorSharesHistoryEntry {
+	if m != nil {
+		return m.OperatorSharesHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGroupOperatorHistory() []GroupOperatorHistoryEntry {
+	if m != nil {
+		return m.GroupOperatorHistory
+	}
+	return nil
+}
+
+// OperatorSharesHistoryEntry is the shares of an operator strategy recorded at an epoch
+type OperatorSharesHistoryEntry struct {
+	EpochNumber uint64         `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	Shares      OperatorShares `protobuf:"bytes,2,opt,name=shares,proto3" json:"shares"`
+}
+
+func (m *OperatorSharesHistoryEntry) Reset()         { *m = OperatorSharesHistoryEntry{} }
+func (m *OperatorSharesHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*OperatorSharesHistoryEntry) ProtoMessage()    {}
+func (*OperatorSharesHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43a53643210a8f98, []int{1}
+}
+func (m *OperatorSharesHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperatorSharesHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperatorSharesHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OperatorSharesHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperatorSharesHistoryEntry.Merge(m, src)
+}
+func (m *OperatorSharesHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperatorSharesHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperatorSharesHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperatorSharesHistoryEntry proto.InternalMessageInfo
+
+func (m *OperatorSharesHistoryEntry) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *OperatorSharesHistoryEntry) GetShares() OperatorShares {
+	if m != nil {
+		return m.Shares
+	}
+	return OperatorShares{}
+}
+
+// GroupOperatorHistoryEntry is the group operator registrations of a registry router recorded at an epoch
+type GroupOperatorHistoryEntry struct {
+	RegistryRouterAddress string                          `protobuf:"bytes,1,opt,name=registry_router_address,json=registryRouterAddress,proto3" json:"registry_router_address,omitempty"`
+	EpochNumber           uint64                          `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	Registrations         GroupOperatorRegistrationListV2 `protobuf:"bytes,3,opt,name=registrations,proto3" json:"registrations"`
+}
+
+func (m *GroupOperatorHistoryEntry) Reset()         { *m = GroupOperatorHistoryEntry{} }
+func (m *GroupOperatorHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*GroupOperatorHistoryEntry) ProtoMessage()    {}
+func (*GroupOperatorHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43a53643210a8f98, []int{2}
+}
+func (m *GroupOperatorHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GroupOperatorHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GroupOperatorHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GroupOperatorHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupOperatorHistoryEntry.Merge(m, src)
+}
+func (m *GroupOperatorHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *GroupOperatorHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupOperatorHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GroupOperatorHistoryEntry proto.InternalMessageInfo
+
+func (m *GroupOperatorHistoryEntry) GetRegistryRouterAddress() string {
+	if m != nil {
+		return m.RegistryRouterAddress
+	}
+	return ""
+}
+
+func (m *GroupOperatorHistoryEntry) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *GroupOperatorHistoryEntry) GetRegistrations() GroupOperatorRegistrationListV2 {
+	if m != nil {
+		return m.Registrations
+	}
+	return GroupOperatorRegistrationListV2{}
+}
+
 // Genesis state legacy
 type GenesisStateLegacy struct {
 	Params        Params           `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
@@ -86,7 +251,7 @@ func (m *GenesisStateLegacy) Reset()         { *m = GenesisStateLegacy{} }
 func (m *GenesisStateLegacy) String() string { return proto.CompactTextString(m) }
 func (*GenesisStateLegacy) ProtoMessage()    {}
 func (*GenesisStateLegacy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43a53643210a8f98, []int{1}
+	return fileDescriptor_43a53643210a8f98, []int{3}
 }
 func (m *GenesisStateLegacy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -137,7 +302,7 @@ func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43a53643210a8f98, []int{2}
+	return fileDescriptor_43a53643210a8f98, []int{4}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -178,7 +343,7 @@ func (m *RegistryRouterData) Reset()         { *m = RegistryRouterData{} }
 func (m *RegistryRouterData) String() string { return proto.CompactTextString(m) }
 func (*RegistryRouterData) ProtoMessage()    {}
 func (*RegistryRouterData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43a53643210a8f98, []int{3}
+	return fileDescriptor_43a53643210a8f98, []int{5}
 }
 func (m *RegistryRouterData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -237,6 +402,8 @@ func (m *RegistryRouterData) GetGroupOperatorRegistrationList() GroupOperatorReg
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "restaking.GenesisState")
+	proto.RegisterType((*OperatorSharesHistoryEntry)(nil), "restaking.OperatorSharesHistoryEntry")
+	proto.RegisterType((*GroupOperatorHistoryEntry)(nil), "restaking.GroupOperatorHistoryEntry")
 	proto.RegisterType((*GenesisStateLegacy)(nil), "restaking.GenesisStateLegacy")
 	proto.RegisterType((*Params)(nil), "restaking.Params")
 	proto.RegisterType((*RegistryRouterData)(nil), "restaking.RegistryRouterData")
@@ -245,35 +412,49 @@ func init() {
 func init() { proto.RegisterFile("restaking/genesis.proto", fileDescriptor_43a53643210a8f98) }
 
 var fileDescriptor_43a53643210a8f98 = []byte{
-	// 445 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xc1, 0x6e, 0x13, 0x31,
-	0x10, 0x86, 0xe3, 0xb4, 0x8a, 0xa8, 0x43, 0x91, 0x6a, 0x02, 0x84, 0x88, 0x2e, 0x51, 0x4e, 0x15,
-	0x87, 0x6c, 0x15, 0x4e, 0xdc, 0x50, 0x55, 0x51, 0x81, 0x2a, 0xa8, 0x76, 0x45, 0x0f, 0x5c, 0x56,
-	0x6e, 0x77, 0xea, 0x5a, 0xdd, 0xae, 0x57, 0xf6, 0xa4, 0x74, 0x1f, 0x02, 0x89, 0x17, 0xe1, 0x3d,
-	0x7a, 0xec, 0x91, 0x13, 0xaa, 0x92, 0x17, 0x41, 0xeb, 0xf5, 0xa6, 0x56, 0x02, 0x1c, 0xb8, 0x59,
-	0xf3, 0xff, 0xff, 0xe7, 0x99, 0xd1, 0xd0, 0x67, 0x1a, 0x0c, 0xf2, 0x0b, 0x99, 0x8b, 0x50, 0x40,
-	0x0e, 0x46, 0x9a, 0x71, 0xa1, 0x15, 0x2a, 0xb6, 0xb1, 0x10, 0x06, 0x3d, 0xa1, 0x84, 0xb2, 0xd5,
-	0xb0, 0x7a, 0xd5, 0x86, 0xc1, 0xe0, 0x3e, 0x99, 0x42, 0x06, 0x82, 0xa3, 0x54, 0xb9, 0xd3, 0x9e,
-	0x78, 0x54, 0xad, 0xa6, 0x45, 0x5d, 0x1e, 0xfd, 0x20, 0xf4, 0xe1, 0x41, 0xfd, 0x4b, 0x8c, 0x1c,
-	0x81, 0xbd, 0xa3, 0x8f, 0x54, 0x01, 0x9a, 0xa3, 0xd2, 0x89, 0x39, 0xe7, 0x1a, 0xfa, 0x64, 0xb8,
-	0xb6, 0xd3, 0x9d, 0x3c, 0x1f, 0x2f, 0x00, 0xe3, 0x4f, 0xce, 0x10, 0x57, 0xba, 0xd9, 0x5b, 0xbf,
-	0xf9, 0xf5, 0xb2, 0x15, 0x6d, 0x2a, 0xbf, 0xca, 0x3e, 0xd3, 0x9e, 0x06, 0x21, 0x0d, 0xea, 0x32,
-	0xd1, 0x6a, 0x8a, 0xa0, 0x93, 0x94, 0x23, 0xef, 0xb7, 0x2d, 0x6d, 0xdb, 0xa3, 0x45, 0xce, 0x16,
-	0x59, 0xd7, 0x3e, 0x47, 0xee, 0x88, 0x4c, 0xaf, 0x28, 0xa3, 0x6f, 0x84, 0x32, 0xbf, 0xdf, 0x43,
-	0x10, 0xfc, 0xb4, 0x64, 0x21, 0xed, 0x14, 0x5c, 0xf3, 0x4b, 0xd3, 0x27, 0x43, 0xb2, 0xd3, 0x9d,
-	0x6c, 0x79, 0xfc, 0x23, 0x2b, 0x38, 0xa6, 0xb3, 0xfd, 0x61, 0xcc, 0xf6, 0xff, 0x8c, 0x39, 0x7a,
-	0x40, 0x3b, 0x35, 0x7f, 0x74, 0xd7, 0xa6, 0x6c, 0x75, 0x14, 0x16, 0xd1, 0xc7, 0xcb, 0x7b, 0x30,
-	0x80, 0xae, 0xcd, 0x17, 0x7f, 0x5d, 0x43, 0x0c, 0xe8, 0x3e, 0xdc, 0xd2, 0xcb, 0x02, 0x7b, 0x4b,
-	0x37, 0xd3, 0x2b, 0x93, 0xc8, 0xfc, 0x4c, 0x25, 0x99, 0x34, 0xd8, 0x6f, 0x5b, 0xda, 0x53, 0x8f,
-	0xb6, 0x7f, 0x1c, 0xbf, 0xcf, 0xcf, 0xd4, 0xa1, 0x34, 0x0d, 0xa7, 0x9b, 0x5e, 0x99, 0xa6, 0xc4,
-	0xde, 0x50, 0x6a, 0xaf, 0xa0, 0x8e, 0xaf, 0xd9, 0x78, 0xcf, 0x8b, 0x1f, 0x54, 0xa2, 0x17, 0xde,
-	0x10, 0x4d, 0x81, 0x95, 0x74, 0x58, 0x47, 0x17, 0xfb, 0x73, 0x0d, 0xda, 0x6b, 0xab, 0x81, 0xeb,
-	0x16, 0xf8, 0x6a, 0x19, 0xd8, 0x2c, 0x34, 0xf2, 0x02, 0x15, 0xf3, 0x78, 0xe2, 0xbe, 0xd9, 0x16,
-	0xff, 0xb2, 0xed, 0x7d, 0xb8, 0x99, 0x05, 0xe4, 0x76, 0x16, 0x90, 0xbb, 0x59, 0x40, 0xbe, 0xcf,
-	0x83, 0xd6, 0xed, 0x3c, 0x68, 0xfd, 0x9c, 0x07, 0xad, 0x2f, 0xbb, 0x42, 0xe2, 0xf9, 0xf4, 0x64,
-	0x7c, 0xaa, 0x2e, 0xc3, 0xdd, 0xeb, 0x23, 0xc8, 0xb2, 0x8f, 0x80, 0x5f, 0x95, 0xbe, 0x08, 0x79,
-	0x05, 0x09, 0xaf, 0xc3, 0xfb, 0xf3, 0xc7, 0xb2, 0x00, 0x73, 0xd2, 0xb1, 0xf7, 0xff, 0xfa, 0x77,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xd4, 0x9c, 0x87, 0xa2, 0x6e, 0x03, 0x00, 0x00,
+	// 663 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x5b, 0x4f, 0x13, 0x4f,
+	0x14, 0xef, 0x96, 0xfe, 0xfb, 0x87, 0x53, 0x30, 0x61, 0x2c, 0xb0, 0x54, 0xa8, 0xb5, 0xd1, 0x84,
+	0xf8, 0xd0, 0x25, 0x98, 0x78, 0x79, 0x52, 0x09, 0x8a, 0x1a, 0x82, 0xcd, 0x36, 0xf2, 0xe0, 0xcb,
+	0x3a, 0xed, 0x1e, 0xb6, 0x1b, 0xca, 0xce, 0x66, 0x66, 0x8a, 0xf4, 0x43, 0x98, 0xf8, 0xb1, 0x78,
+	0x32, 0x3c, 0xfa, 0x64, 0x08, 0xf5, 0x83, 0x98, 0x9d, 0xd9, 0x5d, 0xb6, 0x37, 0x63, 0x78, 0x6b,
+	0xce, 0xef, 0x72, 0xce, 0x9c, 0x4b, 0x17, 0xd6, 0x38, 0x0a, 0x49, 0x4f, 0xfc, 0xc0, 0xb3, 0x3c,
+	0x0c, 0x50, 0xf8, 0xa2, 0x11, 0x72, 0x26, 0x19, 0x59, 0x48, 0x81, 0x4a, 0xd9, 0x63, 0x1e, 0x53,
+	0x51, 0x2b, 0xfa, 0xa5, 0x09, 0x95, 0xca, 0x8d, 0xd2, 0xc5, 0x1e, 0x7a, 0x54, 0xfa, 0x2c, 0x88,
+	0xb1, 0x95, 0x8c, 0x2b, 0x67, 0xfd, 0x50, 0x87, 0xeb, 0xbf, 0x0b, 0xb0, 0xb8, 0xaf, 0xb3, 0xb4,
+	0x24, 0x95, 0x48, 0xde, 0xc2, 0x1d, 0x16, 0x22, 0xa7, 0x92, 0x71, 0x47, 0x74, 0x29, 0x47, 0xd3,
+	0xa8, 0xcd, 0x6d, 0x95, 0x76, 0xd6, 0x1b, 0xa9, 0x41, 0xe3, 0x63, 0x4c, 0x68, 0x45, 0xb8, 0xd8,
+	0x2d, 0x5c, 0xfc, 0xba, 0x9f, 0xb3, 0x97, 0x58, 0x36, 0x4a, 0x3e, 0x41, 0x99, 0xa3, 0xe7, 0x0b,
+	0xc9, 0x07, 0x0e, 0x67, 0x7d, 0x89, 0xdc, 0x71, 0xa9, 0xa4, 0x66, 0x5e, 0xb9, 0x6d, 0x66, 0xdc,
+	0xec, 0x98, 0x66, 0x2b, 0xd6, 0x1e, 0x95, 0x34, 0x76, 0x24, 0x7c, 0x02, 0x21, 0x4d, 0x20, 0x91,
+	0x2e, 0x72, 0x4b, 0x5f, 0x28, 0xcc, 0x39, 0x65, 0x7a, 0x2f, 0x63, 0xda, 0x52, 0xa4, 0xbd, 0x94,
+	0x13, 0x5b, 0x2e, 0x8b, 0xb1, 0xb8, 0x20, 0x0f, 0x60, 0x11, 0x43, 0xd6, 0xe9, 0x3a, 0x41, 0xff,
+	0xb4, 0x8d, 0xdc, 0x2c, 0xd4, 0x8c, 0xad, 0x82, 0x5d, 0x52, 0xb1, 0x43, 0x15, 0x22, 0xcf, 0xc1,
+	0x54, 0xad, 0x10, 0x4e, 0xd7, 0x17, 0x92, 0x45, 0x2f, 0x42, 0x89, 0x41, 0xa4, 0x37, 0xff, 0x53,
+	0xf4, 0x55, 0x8d, 0xbf, 0xd3, 0xb0, 0x9d, 0xa0, 0xe4, 0x25, 0x6c, 0x8c, 0x29, 0x43, 0xde, 0x0f,
+	0xd0, 0x75, 0xda, 0x78, 0xcc, 0x38, 0x9a, 0x45, 0xa5, 0x5e, 0x1f, 0x51, 0x37, 0x15, 0x63, 0x57,
+	0x11, 0x48, 0x07, 0xd6, 0x46, 0xc7, 0x91, 0x3a, 0x99, 0xff, 0xab, 0x47, 0x3f, 0x9a, 0x39, 0x97,
+	0xd8, 0xee, 0x4d, 0x20, 0xf9, 0x20, 0x7e, 0xfe, 0x0a, 0x9b, 0xc6, 0x20, 0x5f, 0x60, 0x55, 0xed,
+	0x84, 0x93, 0xa6, 0x4a, 0x72, 0xcc, 0xab, 0x1c, 0x0f, 0x33, 0x39, 0xf6, 0x23, 0x62, 0x92, 0x68,
+	0x4a, 0x8a, 0xb2, 0x37, 0x85, 0x50, 0x3f, 0x87, 0xca, 0xec, 0xe2, 0x26, 0x46, 0x60, 0x4c, 0x8e,
+	0xe0, 0x19, 0x14, 0xf5, 0xf3, 0xcd, 0x7c, 0xcd, 0xf8, 0x97, 0x75, 0x8c, 0xe9, 0xf5, 0x1f, 0x06,
+	0xac, 0xcf, 0xac, 0x99, 0x3c, 0x85, 0xb5, 0x64, 0xc9, 0x92, 0x2d, 0xa5, 0xae, 0xcb, 0x51, 0x08,
+	0x55, 0xc4, 0x82, 0xbd, 0x32, 0xba, 0x83, 0xaf, 0x35, 0x38, 0x51, 0x71, 0x7e, 0xb2, 0xe2, 0x23,
+	0x58, 0x8a, 0xb5, 0xe9, 0x92, 0x46, 0x85, 0x3f, 0x9e, 0xd5, 0x4b, 0x3b, 0x43, 0x3e, 0xf0, 0x85,
+	0x3c, 0xda, 0x49, 0x0e, 0x6b, 0xc4, 0xa6, 0xfe, 0xcd, 0x00, 0x92, 0xbd, 0xd8, 0x03, 0xf4, 0x68,
+	0x67, 0x40, 0x2c, 0x28, 0x86, 0x94, 0xd3, 0x53, 0x5d, 0x78, 0x69, 0x67, 0x39, 0x93, 0xa7, 0xa9,
+	0x80, 0xa4, 0x31, 0x9a, 0x36, 0xe5, 0xd0, 0xf3, 0xb7, 0x39, 0xf4, 0xfa, 0x3c, 0x14, 0xb5, 0x7f,
+	0xfd, 0x2a, 0x0f, 0x64, 0xf2, 0x98, 0x89, 0x0d, 0x77, 0xc7, 0x7b, 0x2c, 0x50, 0xc6, 0x65, 0x6e,
+	0xcc, 0xfc, 0x23, 0x68, 0xa1, 0x4c, 0x8e, 0x96, 0x8f, 0x03, 0xe4, 0x15, 0x2c, 0xb9, 0x67, 0xc2,
+	0xf1, 0x83, 0x63, 0xe6, 0xf4, 0x7c, 0x21, 0xe3, 0xad, 0x58, 0xcd, 0xb8, 0xed, 0x1d, 0xb5, 0xde,
+	0x07, 0xc7, 0x2c, 0x6a, 0x65, 0xec, 0x53, 0x72, 0xcf, 0x44, 0x12, 0x22, 0x2f, 0x00, 0xf4, 0xce,
+	0x2b, 0xb9, 0x9e, 0x4d, 0x79, 0x7c, 0x36, 0x19, 0xf1, 0x82, 0x97, 0x04, 0xc8, 0x00, 0x6a, 0x63,
+	0xe7, 0x92, 0x9d, 0x90, 0x36, 0x2c, 0xdc, 0x72, 0xd8, 0x9b, 0xde, 0xdf, 0x68, 0xbb, 0x1f, 0x2e,
+	0xae, 0xab, 0xc6, 0xe5, 0x75, 0xd5, 0xb8, 0xba, 0xae, 0x1a, 0xdf, 0x87, 0xd5, 0xdc, 0xe5, 0xb0,
+	0x9a, 0xfb, 0x39, 0xac, 0xe6, 0x3e, 0x6f, 0x7b, 0xbe, 0xec, 0xf6, 0xdb, 0x8d, 0x0e, 0x3b, 0xb5,
+	0xb6, 0xcf, 0x9b, 0xd8, 0xeb, 0x1d, 0xa2, 0xfc, 0xca, 0xf8, 0x89, 0x45, 0x23, 0x13, 0xeb, 0xdc,
+	0xba, 0xf9, 0x00, 0xc8, 0x41, 0x88, 0xa2, 0x5d, 0x54, 0x5f, 0x80, 0x27, 0x7f, 0x06, 0x00, 0x0f,
+	0x5f, 0xdc, 0xae, 0x70, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -296,6 +477,63 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.GroupOperatorHistory) > 0 {
+		for iNdEx := len(m.GroupOperatorHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GroupOperatorHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.OperatorSharesHistory) > 0 {
+		for iNdEx := len(m.OperatorSharesHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OperatorSharesHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.SharesHistoryPrunedBefore != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SharesHistoryPrunedBefore))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SharesHistoryRetention != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SharesHistoryRetention))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.StakerDelegations) > 0 {
+		for iNdEx := len(m.StakerDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakerDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.RegistryRouterData) > 0 {
 		for iNdEx := len(m.RegistryRouterData) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -327,6 +565,89 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OperatorSharesHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperatorSharesHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperatorSharesHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Shares.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.EpochNumber != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GroupOperatorHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupOperatorHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GroupOperatorHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Registrations.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.EpochNumber != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RegistryRouterAddress) > 0 {
+		i -= len(m.RegistryRouterAddress)
+		copy(dAtA[i:], m.RegistryRouterAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.RegistryRouterAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisStateLegacy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -489,6 +810,65 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.StakerDelegations) > 0 {
+		for _, e := range m.StakerDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovGenesis(uint64(m.EpochNumber))
+	}
+	if m.SharesHistoryRetention != 0 {
+		n += 1 + sovGenesis(uint64(m.SharesHistoryRetention))
+	}
+	if m.SharesHistoryPrunedBefore != 0 {
+		n += 1 + sovGenesis(uint64(m.SharesHistoryPrunedBefore))
+	}
+	if len(m.OperatorSharesHistory) > 0 {
+		for _, e := range m.OperatorSharesHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.GroupOperatorHistory) > 0 {
+		for _, e := range m.GroupOperatorHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OperatorSharesHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovGenesis(uint64(m.EpochNumber))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *GroupOperatorHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RegistryRouterAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovGenesis(uint64(m.EpochNumber))
+	}
+	l = m.Registrations.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -638,6 +1018,401 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerDelegations = append(m.StakerDelegations, StakerDelegation{})
+			if err := m.StakerDelegations[len(m.StakerDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesHistoryRetention", wireType)
+			}
+			m.SharesHistoryRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SharesHistoryRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesHistoryPrunedBefore", wireType)
+			}
+			m.SharesHistoryPrunedBefore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SharesHistoryPrunedBefore |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorSharesHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorSharesHistory = append(m.OperatorSharesHistory, OperatorSharesHistoryEntry{})
+			if err := m.OperatorSharesHistory[len(m.OperatorSharesHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupOperatorHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupOperatorHistory = append(m.GroupOperatorHistory, GroupOperatorHistoryEntry{})
+			if err := m.GroupOperatorHistory[len(m.GroupOperatorHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperatorSharesHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperatorSharesHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperatorSharesHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GroupOperatorHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroupOperatorHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroupOperatorHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistryRouterAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegistryRouterAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Registrations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Registrations.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
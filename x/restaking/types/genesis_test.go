@@ -24,6 +24,14 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: &types.GenesisState{},
 			valid:    true,
 		},
+		{
+			desc: "shares history pruned after the current epoch",
+			genState: &types.GenesisState{
+				EpochNumber:               3,
+				SharesHistoryPrunedBefore: 4,
+			},
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
@@ -512,6 +512,283 @@ func (m *GroupEjectionParam) GetEjectableStakePercent() uint32 {
 	return 0
 }
 
+// GroupEjection records the stake ejected from a group at a block time
+type GroupEjection struct {
+	Timestamp int64                 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Stake     cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=stake,proto3,customtype=cosmossdk.io/math.Int" json:"stake"`
+}
+
+func (m *GroupEjection) Reset()         { *m = GroupEjection{} }
+func (m *GroupEjection) String() string { return proto.CompactTextString(m) }
+func (*GroupEjection) ProtoMessage()    {}
+func (*GroupEjection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ad6432d289488c0, []int{8}
+}
+func (m *GroupEjection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GroupEjection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GroupEjection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GroupEjection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupEjection.Merge(m, src)
+}
+func (m *GroupEjection) XXX_Size() int {
+	return m.Size()
+}
+func (m *GroupEjection) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupEjection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GroupEjection proto.InternalMessageInfo
+
+func (m *GroupEjection) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+// GroupEjectionList is the list of ejections of a group within its rate limit
+// window
+type GroupEjectionList struct {
+	Ejections []GroupEjection `protobuf:"bytes,1,rep,name=ejections,proto3" json:"ejections"`
+}
+
+func (m *GroupEjectionList) Reset()         { *m = GroupEjectionList{} }
+func (m *GroupEjectionList) String() string { return proto.CompactTextString(m) }
+func (*GroupEjectionList) ProtoMessage()    {}
+func (*GroupEjectionList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ad6432d289488c0, []int{9}
+}
+func (m *GroupEjectionList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GroupEjectionList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GroupEjectionList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GroupEjectionList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupEjectionList.Merge(m, src)
+}
+func (m *GroupEjectionList) XXX_Size() int {
+	return m.Size()
+}
+func (m *GroupEjectionList) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupEjectionList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GroupEjectionList proto.InternalMessageInfo
+
+func (m *GroupEjectionList) GetEjections() []GroupEjection {
+	if m != nil {
+		return m.Ejections
+	}
+	return nil
+}
+
+// EjectedOperator records the groups an operator was ejected from by the native
+// ejection. The registration stays mirrored from the registry router on pEVM.
+type EjectedOperator struct {
+	GroupNumbers []byte `protobuf:"bytes,1,opt,name=group_numbers,json=groupNumbers,proto3" json:"group_numbers,omitempty"`
+}
+
+func (m *EjectedOperator) Reset()         { *m = EjectedOperator{} }
+func (m *EjectedOperator) String() string { return proto.CompactTextString(m) }
+func (*EjectedOperator) ProtoMessage()    {}
+func (*EjectedOperator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ad6432d289488c0, []int{10}
+}
+func (m *EjectedOperator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EjectedOperator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EjectedOperator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EjectedOperator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EjectedOperator.Merge(m, src)
+}
+func (m *EjectedOperator) XXX_Size() int {
+	return m.Size()
+}
+func (m *EjectedOperator) XXX_DiscardUnknown() {
+	xxx_messageInfo_EjectedOperator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EjectedOperator proto.InternalMessageInfo
+
+func (m *EjectedOperator) GetGroupNumbers() []byte {
+	if m != nil {
+		return m.GroupNumbers
+	}
+	return nil
+}
+
+// PendingEjection is an ejection waiting to be delivered to the ejection
+// manager of a DVS chain
+type PendingEjection struct {
+	Id             uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EpochNumber    uint64 `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	RegistryRouter string `protobuf:"bytes,3,opt,name=registry_router,json=registryRouter,proto3" json:"registry_router,omitempty"`
+	Message        []byte `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	XmsgIndex      string `protobuf:"bytes,5,opt,name=xmsg_index,json=xmsgIndex,proto3" json:"xmsg_index,omitempty"`
+	Attempts       uint32 `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	SentHeight     int64  `protobuf:"varint,7,opt,name=sent_height,json=sentHeight,proto3" json:"sent_height,omitempty"`
+}
+
+func (m *PendingEjection) Reset()         { *m = PendingEjection{} }
+func (m *PendingEjection) String() string { return proto.CompactTextString(m) }
+func (*PendingEjection) ProtoMessage()    {}
+func (*PendingEjection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ad6432d289488c0, []int{11}
+}
+func (m *PendingEjection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingEjection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingEjection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingEjection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingEjection.Merge(m, src)
+}
+func (m *PendingEjection) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingEjection) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingEjection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingEjection proto.InternalMessageInfo
+
+func (m *PendingEjection) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *PendingEjection) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *PendingEjection) GetRegistryRouter() string {
+	if m != nil {
+		return m.RegistryRouter
+	}
+	return ""
+}
+
+func (m *PendingEjection) GetMessage() []byte {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *PendingEjection) GetXmsgIndex() string {
+	if m != nil {
+		return m.XmsgIndex
+	}
+	return ""
+}
+
+func (m *PendingEjection) GetAttempts() uint32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *PendingEjection) GetSentHeight() int64 {
+	if m != nil {
+		return m.SentHeight
+	}
+	return 0
+}
+
+// PendingEjectionList is the queue of ejections of a DVS chain
+type PendingEjectionList struct {
+	Ejections []PendingEjection `protobuf:"bytes,1,rep,name=ejections,proto3" json:"ejections"`
+}
+
+func (m *PendingEjectionList) Reset()         { *m = PendingEjectionList{} }
+func (m *PendingEjectionList) String() string { return proto.CompactTextString(m) }
+func (*PendingEjectionList) ProtoMessage()    {}
+func (*PendingEjectionList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ad6432d289488c0, []int{12}
+}
+func (m *PendingEjectionList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingEjectionList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingEjectionList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingEjectionList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingEjectionList.Merge(m, src)
+}
+func (m *PendingEjectionList) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingEjectionList) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingEjectionList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingEjectionList proto.InternalMessageInfo
+
+func (m *PendingEjectionList) GetEjections() []PendingEjection {
+	if m != nil {
+		return m.Ejections
+	}
+	return nil
+}
+
 // GroupOperatorRegistrationList is a list of quorum operator registration
 type GroupOperatorRegistrationList struct {
 	OperatorRegisteredInfos []*GroupOperatorRegistration `protobuf:"bytes,1,rep,name=operator_registered_infos,json=operatorRegisteredInfos,proto3" json:"operator_registered_infos,omitempty"`
@@ -521,7 +798,7 @@ func (m *GroupOperatorRegistrationList) Reset()         { *m = GroupOperatorRegi
 func (m *GroupOperatorRegistrationList) String() string { return proto.CompactTextString(m) }
 func (*GroupOperatorRegistrationList) ProtoMessage()    {}
 func (*GroupOperatorRegistrationList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2ad6432d289488c0, []int{8}
+	return fileDescriptor_2ad6432d289488c0, []int{13}
 }
 func (m *GroupOperatorRegistrationList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -570,7 +847,7 @@ func (m *GroupOperatorRegistration) Reset()         { *m = GroupOperatorRegistra
 func (m *GroupOperatorRegistration) String() string { return proto.CompactTextString(m) }
 func (*GroupOperatorRegistration) ProtoMessage()    {}
 func (*GroupOperatorRegistration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2ad6432d289488c0, []int{9}
+	return fileDescriptor_2ad6432d289488c0, []int{14}
 }
 func (m *GroupOperatorRegistration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -644,7 +921,7 @@ func (m *PubkeyRegistrationParams) Reset()         { *m = PubkeyRegistrationPara
 func (m *PubkeyRegistrationParams) String() string { return proto.CompactTextString(m) }
 func (*PubkeyRegistrationParams) ProtoMessage()    {}
 func (*PubkeyRegistrationParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2ad6432d289488c0, []int{10}
+	return fileDescriptor_2ad6432d289488c0, []int{15}
 }
 func (m *PubkeyRegistrationParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -697,7 +974,7 @@ func (m *G1Point) Reset()         { *m = G1Point{} }
 func (m *G1Point) String() string { return proto.CompactTextString(m) }
 func (*G1Point) ProtoMessage()    {}
 func (*G1Point) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2ad6432d289488c0, []int{11}
+	return fileDescriptor_2ad6432d289488c0, []int{16}
 }
 func (m *G1Point) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -751,7 +1028,7 @@ func (m *G2Point) Reset()         { *m = G2Point{} }
 func (m *G2Point) String() string { return proto.CompactTextString(m) }
 func (*G2Point) ProtoMessage()    {}
 func (*G2Point) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2ad6432d289488c0, []int{12}
+	return fileDescriptor_2ad6432d289488c0, []int{17}
 }
 func (m *G2Point) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -803,7 +1080,7 @@ func (m *GroupOperatorRegistrationListV2) Reset()         { *m = GroupOperatorRe
 func (m *GroupOperatorRegistrationListV2) String() string { return proto.CompactTextString(m) }
 func (*GroupOperatorRegistrationListV2) ProtoMessage()    {}
 func (*GroupOperatorRegistrationListV2) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2ad6432d289488c0, []int{13}
+	return fileDescriptor_2ad6432d289488c0, []int{18}
 }
 func (m *GroupOperatorRegistrationListV2) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -852,7 +1129,7 @@ func (m *GroupOperatorRegistrationV2) Reset()         { *m = GroupOperatorRegist
 func (m *GroupOperatorRegistrationV2) String() string { return proto.CompactTextString(m) }
 func (*GroupOperatorRegistrationV2) ProtoMessage()    {}
 func (*GroupOperatorRegistrationV2) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2ad6432d289488c0, []int{14}
+	return fileDescriptor_2ad6432d289488c0, []int{19}
 }
 func (m *GroupOperatorRegistrationV2) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -926,7 +1203,7 @@ func (m *PubkeyRegistrationParamsV2) Reset()         { *m = PubkeyRegistrationPa
 func (m *PubkeyRegistrationParamsV2) String() string { return proto.CompactTextString(m) }
 func (*PubkeyRegistrationParamsV2) ProtoMessage()    {}
 func (*PubkeyRegistrationParamsV2) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2ad6432d289488c0, []int{15}
+	return fileDescriptor_2ad6432d289488c0, []int{20}
 }
 func (m *PubkeyRegistrationParamsV2) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -979,7 +1256,7 @@ func (m *G1PointV2) Reset()         { *m = G1PointV2{} }
 func (m *G1PointV2) String() string { return proto.CompactTextString(m) }
 func (*G1PointV2) ProtoMessage()    {}
 func (*G1PointV2) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2ad6432d289488c0, []int{16}
+	return fileDescriptor_2ad6432d289488c0, []int{21}
 }
 func (m *G1PointV2) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1019,7 +1296,7 @@ func (m *G2PointV2) Reset()         { *m = G2PointV2{} }
 func (m *G2PointV2) String() string { return proto.CompactTextString(m) }
 func (*G2PointV2) ProtoMessage()    {}
 func (*G2PointV2) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2ad6432d289488c0, []int{17}
+	return fileDescriptor_2ad6432d289488c0, []int{22}
 }
 func (m *G2PointV2) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1059,7 +1336,7 @@ func (m *SignatureWithSaltAndExpiry) Reset()         { *m = SignatureWithSaltAnd
 func (m *SignatureWithSaltAndExpiry) String() string { return proto.CompactTextString(m) }
 func (*SignatureWithSaltAndExpiry) ProtoMessage()    {}
 func (*SignatureWithSaltAndExpiry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2ad6432d289488c0, []int{18}
+	return fileDescriptor_2ad6432d289488c0, []int{23}
 }
 func (m *SignatureWithSaltAndExpiry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1118,7 +1395,7 @@ func (m *GroupSyncList) Reset()         { *m = GroupSyncList{} }
 func (m *GroupSyncList) String() string { return proto.CompactTextString(m) }
 func (*GroupSyncList) ProtoMessage()    {}
 func (*GroupSyncList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2ad6432d289488c0, []int{19}
+	return fileDescriptor_2ad6432d289488c0, []int{24}
 }
 func (m *GroupSyncList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1164,7 +1441,7 @@ func (m *RegistryRouterSet) Reset()         { *m = RegistryRouterSet{} }
 func (m *RegistryRouterSet) String() string { return proto.CompactTextString(m) }
 func (*RegistryRouterSet) ProtoMessage()    {}
 func (*RegistryRouterSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2ad6432d289488c0, []int{20}
+	return fileDescriptor_2ad6432d289488c0, []int{25}
 }
 func (m *RegistryRouterSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1216,6 +1493,11 @@ func init() {
 	proto.RegisterType((*OperatorSetParam)(nil), "restaking.OperatorSetParam")
 	proto.RegisterType((*PoolParams)(nil), "restaking.PoolParams")
 	proto.RegisterType((*GroupEjectionParam)(nil), "restaking.GroupEjectionParam")
+	proto.RegisterType((*GroupEjection)(nil), "restaking.GroupEjection")
+	proto.RegisterType((*GroupEjectionList)(nil), "restaking.GroupEjectionList")
+	proto.RegisterType((*EjectedOperator)(nil), "restaking.EjectedOperator")
+	proto.RegisterType((*PendingEjection)(nil), "restaking.PendingEjection")
+	proto.RegisterType((*PendingEjectionList)(nil), "restaking.PendingEjectionList")
 	proto.RegisterType((*GroupOperatorRegistrationList)(nil), "restaking.GroupOperatorRegistrationList")
 	proto.RegisterType((*GroupOperatorRegistration)(nil), "restaking.GroupOperatorRegistration")
 	proto.RegisterType((*PubkeyRegistrationParams)(nil), "restaking.PubkeyRegistrationParams")
@@ -1234,83 +1516,94 @@ func init() {
 func init() { proto.RegisterFile("restaking/group.proto", fileDescriptor_2ad6432d289488c0) }
 
 var fileDescriptor_2ad6432d289488c0 = []byte{
-	// 1204 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x51, 0x6f, 0x1b, 0x45,
-	0x10, 0xce, 0xc5, 0x6e, 0x12, 0x4f, 0xec, 0xd4, 0x5d, 0x25, 0xd4, 0x49, 0x89, 0x13, 0xae, 0x2d,
-	0x04, 0x8a, 0xec, 0xe6, 0x10, 0x7d, 0x42, 0x88, 0x14, 0xaa, 0x92, 0xaa, 0xb4, 0xd1, 0x19, 0xa5,
-	0x12, 0x3c, 0x1c, 0xe7, 0xbb, 0x8d, 0xbd, 0xf8, 0xee, 0xf6, 0x74, 0xbb, 0xd7, 0xda, 0x12, 0x2f,
-	0x20, 0xf1, 0x8a, 0xf8, 0x0f, 0xfc, 0x07, 0x7e, 0x43, 0x1f, 0xfb, 0x88, 0x90, 0x28, 0xa8, 0xf9,
-	0x23, 0x68, 0xe7, 0xf6, 0xce, 0x67, 0xa7, 0x69, 0xcb, 0x13, 0x6f, 0xb7, 0xdf, 0x7e, 0x33, 0xb3,
-	0x33, 0xfb, 0xcd, 0xdc, 0xc2, 0x46, 0x42, 0x85, 0x74, 0x47, 0x2c, 0x1a, 0x74, 0x07, 0x09, 0x4f,
-	0xe3, 0x4e, 0x9c, 0x70, 0xc9, 0x49, 0xad, 0x80, 0xb7, 0xd6, 0x07, 0x7c, 0xc0, 0x11, 0xed, 0xaa,
-	0xaf, 0x8c, 0xb0, 0x75, 0x6d, 0x6a, 0x47, 0x63, 0xee, 0x0d, 0x1d, 0x9e, 0xca, 0x3e, 0x4f, 0x23,
-	0xdf, 0x11, 0xd2, 0x95, 0x34, 0x63, 0x99, 0x16, 0x10, 0x9b, 0x0e, 0x98, 0x90, 0xc9, 0xc4, 0xe6,
-	0xa9, 0xa4, 0xc9, 0x7d, 0x26, 0x24, 0x79, 0x1b, 0x6a, 0xae, 0xef, 0x27, 0x54, 0x08, 0x2a, 0x5a,
-	0xc6, 0x6e, 0x65, 0xaf, 0x66, 0x4f, 0x01, 0xf3, 0x53, 0x58, 0xfd, 0xe2, 0xb8, 0x77, 0x18, 0x9d,
-	0x70, 0x24, 0x77, 0xa1, 0xe6, 0x3f, 0x16, 0x0e, 0x8b, 0x4e, 0x78, 0x46, 0x5e, 0xb5, 0x48, 0xa7,
-	0x08, 0xde, 0xd1, 0x54, 0x7b, 0xc5, 0x7f, 0x2c, 0xd4, 0x87, 0x30, 0xff, 0x5a, 0x84, 0x65, 0x8d,
-	0x92, 0x4d, 0x58, 0xf1, 0x86, 0x2e, 0x8b, 0x1c, 0xe6, 0xb7, 0x8c, 0x5d, 0x63, 0xaf, 0x6a, 0x2f,
-	0xe3, 0xfa, 0xd0, 0x27, 0xef, 0xc1, 0x45, 0x41, 0x93, 0xc7, 0xcc, 0xa3, 0x4e, 0xe8, 0x46, 0xee,
-	0x80, 0x26, 0xad, 0xc5, 0x5d, 0x63, 0xaf, 0x66, 0xaf, 0x69, 0xf8, 0xab, 0x0c, 0x25, 0xef, 0x43,
-	0x93, 0x7e, 0x4f, 0x3d, 0xc9, 0x78, 0x54, 0x30, 0x2b, 0xc8, 0xbc, 0x98, 0xe3, 0x39, 0xf5, 0x06,
-	0x5c, 0xf2, 0x68, 0x24, 0x13, 0x37, 0x70, 0x84, 0x37, 0xa4, 0x7e, 0x1a, 0xd0, 0xa4, 0x55, 0x45,
-	0x6e, 0x53, 0x6f, 0xf4, 0x72, 0x9c, 0x5c, 0x85, 0x86, 0x4a, 0x62, 0x1a, 0xfe, 0x02, 0x12, 0xeb,
-	0x08, 0xe6, 0x1e, 0xf7, 0xa0, 0xd9, 0x0f, 0x84, 0xe3, 0xc6, 0x23, 0x27, 0xd1, 0x85, 0x6c, 0x2d,
-	0x65, 0xc7, 0xec, 0x07, 0xe2, 0x20, 0x1e, 0xe5, 0xe5, 0x25, 0xd7, 0x61, 0x8d, 0x45, 0x3e, 0x1d,
-	0x4f, 0x79, 0xcb, 0xc8, 0x6b, 0x20, 0x5a, 0xd0, 0x3e, 0x83, 0xb5, 0xd9, 0x9b, 0x6a, 0xad, 0xec,
-	0x1a, 0x7b, 0x6b, 0xd6, 0x66, 0xa9, 0xa6, 0x0f, 0x35, 0xa1, 0x27, 0x5d, 0x99, 0x0a, 0xbb, 0xc1,
-	0x4b, 0x6b, 0x6a, 0x7e, 0x0c, 0xb5, 0xbb, 0x4a, 0x29, 0x78, 0x3b, 0x7b, 0xb0, 0x84, 0xb2, 0xc9,
-	0xaf, 0xa6, 0x59, 0x72, 0x83, 0x2c, 0x5b, 0xef, 0x9b, 0xbf, 0x2d, 0xc2, 0x05, 0x44, 0xc8, 0x3b,
-	0x50, 0x47, 0xcc, 0x89, 0xd2, 0xb0, 0x4f, 0x13, 0x7d, 0x31, 0xab, 0x88, 0x3d, 0x40, 0x88, 0x1c,
-	0x02, 0xe1, 0x31, 0x4d, 0x5c, 0xc9, 0x13, 0x47, 0x50, 0xe9, 0xc4, 0x6e, 0xe2, 0x86, 0x78, 0x3f,
-	0xab, 0xd6, 0x95, 0xf2, 0x49, 0x35, 0xa9, 0x47, 0xe5, 0x91, 0xa2, 0xd8, 0x4d, 0x3e, 0x87, 0xa8,
-	0x32, 0x87, 0x2c, 0x62, 0x61, 0x1a, 0x3a, 0x58, 0x59, 0xbc, 0xbb, 0xaa, 0x5d, 0xd7, 0x60, 0x4f,
-	0x61, 0xe4, 0x16, 0xac, 0xc6, 0x9c, 0x07, 0x59, 0x1c, 0xd1, 0xaa, 0x62, 0x2e, 0x1b, 0xa5, 0x40,
-	0x47, 0x9c, 0x07, 0xe8, 0x4f, 0xd8, 0x10, 0x17, 0xdf, 0xe4, 0x21, 0xac, 0x67, 0xa9, 0x14, 0x0a,
-	0xc9, 0x4e, 0x7a, 0x01, 0x4f, 0xba, 0x3d, 0x5f, 0x8c, 0x3b, 0x9a, 0x95, 0x9d, 0x95, 0x0c, 0xce,
-	0x60, 0xe6, 0xef, 0x06, 0x34, 0xe7, 0x93, 0x22, 0x1f, 0x02, 0x09, 0xdd, 0xb1, 0x53, 0x54, 0xc4,
-	0xe3, 0x69, 0x24, 0xb1, 0x6c, 0x0d, 0xbb, 0x19, 0xba, 0xe3, 0xdc, 0xe0, 0x73, 0x85, 0x93, 0x4f,
-	0xe0, 0xca, 0x88, 0x79, 0x23, 0xa7, 0xcf, 0x62, 0xe1, 0xf0, 0x93, 0xa9, 0x59, 0x96, 0xfe, 0x22,
-	0x9a, 0x5d, 0x56, 0x94, 0xdb, 0x2c, 0x16, 0x0f, 0x4f, 0x8a, 0x70, 0xba, 0x12, 0xad, 0x19, 0x6b,
-	0xc9, 0xa5, 0x52, 0x73, 0x51, 0xb9, 0x86, 0xbd, 0x3e, 0x35, 0xfd, 0x5a, 0x6d, 0xa2, 0x9d, 0xf9,
-	0x2d, 0xc0, 0xb4, 0x46, 0xaf, 0xea, 0x3b, 0x02, 0x55, 0x55, 0x40, 0xdd, 0x6c, 0xf8, 0x4d, 0xda,
-	0x00, 0x61, 0x1a, 0x48, 0x16, 0x07, 0x4c, 0x37, 0x57, 0xd5, 0x2e, 0x21, 0xe6, 0x18, 0xc8, 0xd9,
-	0xfa, 0x91, 0x0f, 0xe0, 0x52, 0xe2, 0x4a, 0xea, 0x04, 0x2c, 0x64, 0xd2, 0x79, 0xc2, 0x22, 0x9f,
-	0x3f, 0xd1, 0x55, 0xb9, 0xa8, 0x36, 0xee, 0x2b, 0xfc, 0x11, 0xc2, 0xe4, 0x16, 0x5c, 0xc6, 0x2b,
-	0x72, 0xfb, 0x01, 0xcd, 0xb2, 0x71, 0x62, 0x9a, 0xa8, 0x9e, 0xd4, 0x05, 0xd9, 0x28, 0xb6, 0x31,
-	0x9f, 0xa3, 0x6c, 0xd3, 0xfc, 0xd1, 0x80, 0x6d, 0x0c, 0x9d, 0x57, 0x49, 0x37, 0x92, 0xab, 0x8e,
-	0x81, 0x1d, 0xf0, 0x1d, 0x6c, 0x16, 0x15, 0xce, 0x5a, 0x8f, 0x26, 0xd4, 0x9f, 0x99, 0x57, 0xd7,
-	0xe6, 0x75, 0xf0, 0x32, 0x67, 0xf6, 0x65, 0x3e, 0x83, 0x2a, 0x2f, 0xd9, 0x40, 0xfb, 0xdb, 0x80,
-	0xcd, 0x73, 0xcd, 0xc8, 0x16, 0xac, 0xe4, 0x86, 0x98, 0x7c, 0xcd, 0x2e, 0xd6, 0x64, 0x07, 0x56,
-	0x8b, 0xb3, 0x31, 0x1f, 0x33, 0xad, 0xdb, 0x90, 0x43, 0x87, 0xbe, 0x6a, 0x8e, 0x72, 0x2b, 0x0a,
-	0xac, 0x7d, 0xdd, 0xae, 0x97, 0x7a, 0x51, 0x90, 0xb7, 0x60, 0x49, 0x70, 0x6f, 0x44, 0xa5, 0x1e,
-	0x65, 0x7a, 0x45, 0xbe, 0x84, 0x46, 0x9c, 0xf6, 0x47, 0x74, 0x92, 0xb7, 0x4d, 0xa6, 0xfa, 0xab,
-	0xe5, 0xb6, 0xc1, 0xfd, 0xf2, 0x79, 0x75, 0x13, 0xd5, 0x33, 0xcb, 0x6c, 0x65, 0xfe, 0x00, 0xad,
-	0xf3, 0x98, 0x6a, 0xfe, 0xeb, 0x28, 0x83, 0x7d, 0x4c, 0x70, 0x76, 0xfe, 0xdf, 0xdd, 0x3f, 0xe2,
-	0x2c, 0x92, 0xf6, 0x4a, 0x46, 0xba, 0xbb, 0x5f, 0x36, 0xb0, 0xf4, 0xc8, 0x98, 0x31, 0xb0, 0x66,
-	0x0d, 0x2c, 0xf3, 0x3a, 0x2c, 0x6b, 0x2f, 0xa4, 0x0e, 0xc6, 0x58, 0x0b, 0xd6, 0x18, 0xab, 0xd5,
-	0x04, 0x3d, 0x54, 0x6d, 0x63, 0x82, 0x34, 0x6b, 0x86, 0x56, 0x99, 0xa1, 0x55, 0x32, 0xda, 0xcf,
-	0x06, 0xec, 0xbc, 0x52, 0x31, 0xc7, 0x16, 0xe9, 0xbf, 0x5e, 0x33, 0xef, 0xbe, 0x89, 0x66, 0x8e,
-	0xad, 0xf3, 0x55, 0x73, 0x6a, 0xc0, 0x95, 0x57, 0x18, 0xfe, 0x8f, 0xba, 0xb9, 0xf7, 0x72, 0xdd,
-	0x5c, 0x7f, 0x03, 0xdd, 0x1c, 0x5b, 0x73, 0xca, 0xf9, 0xc9, 0x80, 0xad, 0xf3, 0xc9, 0x64, 0xbf,
-	0x2c, 0x9e, 0x0a, 0x86, 0x59, 0x3f, 0x2b, 0x9e, 0x63, 0xab, 0x24, 0x9f, 0xfd, 0xb2, 0x7c, 0xaa,
-	0x67, 0x4d, 0xac, 0x79, 0x13, 0xcb, 0xa4, 0x50, 0x2b, 0x3c, 0x91, 0x1b, 0xb9, 0x84, 0x6a, 0xb7,
-	0xb7, 0x9f, 0x3e, 0xdf, 0x59, 0xf8, 0xf3, 0xf9, 0xce, 0x86, 0xc7, 0x45, 0xc8, 0x85, 0xf0, 0x47,
-	0x1d, 0xc6, 0xbb, 0xa1, 0x2b, 0x87, 0x9d, 0xc3, 0x48, 0x2a, 0xe9, 0xdc, 0xc8, 0x15, 0xf6, 0x7a,
-	0xf2, 0x04, 0xc3, 0x58, 0x73, 0x61, 0x2a, 0xff, 0x25, 0x4c, 0xe5, 0x8d, 0xc2, 0x9c, 0xc0, 0x56,
-	0x8f, 0x0d, 0x22, 0x57, 0xa6, 0x09, 0x7d, 0xc4, 0xe4, 0xb0, 0xe7, 0x06, 0xf2, 0x20, 0xf2, 0xef,
-	0x8c, 0x63, 0x96, 0x4c, 0xd4, 0xdb, 0x4d, 0xe4, 0xbb, 0x98, 0x66, 0xdd, 0x9e, 0x02, 0x6a, 0xb8,
-	0x0b, 0x37, 0x90, 0x5a, 0x31, 0xf8, 0xad, 0x64, 0x40, 0xd1, 0x56, 0x0f, 0x76, 0xbd, 0x32, 0x3b,
-	0xd0, 0x40, 0x7d, 0xf6, 0x26, 0x91, 0x87, 0x93, 0x74, 0x1b, 0x60, 0x1c, 0x8a, 0x81, 0x83, 0x0f,
-	0x96, 0xfc, 0x5d, 0xa8, 0x90, 0x43, 0x05, 0x98, 0xbf, 0x18, 0x70, 0x69, 0xf6, 0x31, 0xd9, 0xa3,
-	0x52, 0x0d, 0xf6, 0xfc, 0xc1, 0xe3, 0x24, 0x88, 0x3a, 0xfa, 0x29, 0xa9, 0x55, 0xbd, 0x91, 0xcc,
-	0xd8, 0x1c, 0x64, 0x9b, 0xe4, 0x00, 0xb6, 0xb3, 0xdf, 0xc0, 0x79, 0xd6, 0xd9, 0xff, 0x69, 0x0b,
-	0x49, 0xf6, 0xcb, 0x5c, 0xdc, 0xbe, 0xf7, 0xf4, 0x45, 0xdb, 0x78, 0xf6, 0xa2, 0x6d, 0xfc, 0xf3,
-	0xa2, 0x6d, 0xfc, 0x7a, 0xda, 0x5e, 0x78, 0x76, 0xda, 0x5e, 0xf8, 0xe3, 0xb4, 0xbd, 0xf0, 0xcd,
-	0xcd, 0x01, 0x93, 0xc3, 0xb4, 0xdf, 0xf1, 0x78, 0xd8, 0xbd, 0x39, 0x3e, 0xa2, 0x41, 0xf0, 0x80,
-	0xca, 0x27, 0x3c, 0x19, 0x75, 0x5d, 0xe5, 0xa9, 0x3b, 0xee, 0x4e, 0x5f, 0xcf, 0x72, 0x12, 0x53,
-	0xd1, 0x5f, 0xc2, 0xf7, 0xf2, 0x47, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x49, 0x52, 0x59, 0x7a,
-	0x8f, 0x0b, 0x00, 0x00,
+	// 1385 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x4f, 0x73, 0xdb, 0x44,
+	0x14, 0x8f, 0x6c, 0xe7, 0x8f, 0x5f, 0xec, 0xc4, 0x59, 0x12, 0xea, 0xb8, 0xc4, 0x09, 0x6a, 0x4b,
+	0x03, 0x65, 0xec, 0x46, 0x1d, 0x7a, 0xea, 0x74, 0x48, 0xa0, 0xd3, 0xa6, 0x53, 0xda, 0x20, 0x43,
+	0x3a, 0x03, 0x07, 0x21, 0x5b, 0x1b, 0x79, 0xb1, 0xa4, 0xd5, 0x68, 0xd7, 0xad, 0x3d, 0xc3, 0x05,
+	0x66, 0xb8, 0x32, 0x7c, 0x07, 0xbe, 0x03, 0x9f, 0xa1, 0xc7, 0x1e, 0x19, 0x66, 0x28, 0x4c, 0xf3,
+	0x45, 0x98, 0x5d, 0xad, 0x64, 0xc9, 0x6e, 0xd2, 0x70, 0xe2, 0xa6, 0xfd, 0xed, 0xef, 0xed, 0xdb,
+	0x7d, 0xef, 0xf7, 0xde, 0xae, 0x60, 0x23, 0xc2, 0x8c, 0xdb, 0x03, 0x12, 0xb8, 0x6d, 0x37, 0xa2,
+	0xc3, 0xb0, 0x15, 0x46, 0x94, 0x53, 0x54, 0x4e, 0xe1, 0xc6, 0xba, 0x4b, 0x5d, 0x2a, 0xd1, 0xb6,
+	0xf8, 0x8a, 0x09, 0x8d, 0xab, 0x13, 0x3b, 0x1c, 0xd2, 0x5e, 0xdf, 0xa2, 0x43, 0xde, 0xa5, 0xc3,
+	0xc0, 0xb1, 0x18, 0xb7, 0x39, 0x8e, 0x59, 0xba, 0x01, 0xc8, 0xc4, 0x2e, 0x61, 0x3c, 0x1a, 0x9b,
+	0x74, 0xc8, 0x71, 0xf4, 0x88, 0x30, 0x8e, 0xde, 0x83, 0xb2, 0xed, 0x38, 0x11, 0x66, 0x0c, 0xb3,
+	0xba, 0xb6, 0x53, 0xdc, 0x2d, 0x9b, 0x13, 0x40, 0xbf, 0x0b, 0xcb, 0x9f, 0x1f, 0x77, 0x0e, 0x83,
+	0x13, 0x2a, 0xc9, 0x6d, 0x28, 0x3b, 0xcf, 0x98, 0x45, 0x82, 0x13, 0x1a, 0x93, 0x97, 0x0d, 0xd4,
+	0x4a, 0x9d, 0xb7, 0x14, 0xd5, 0x5c, 0x72, 0x9e, 0x31, 0xf1, 0xc1, 0xf4, 0xbf, 0x0a, 0xb0, 0xa8,
+	0x50, 0xb4, 0x09, 0x4b, 0xbd, 0xbe, 0x4d, 0x02, 0x8b, 0x38, 0x75, 0x6d, 0x47, 0xdb, 0x2d, 0x99,
+	0x8b, 0x72, 0x7c, 0xe8, 0xa0, 0xeb, 0xb0, 0xca, 0x70, 0xf4, 0x8c, 0xf4, 0xb0, 0xe5, 0xdb, 0x81,
+	0xed, 0xe2, 0xa8, 0x5e, 0xd8, 0xd1, 0x76, 0xcb, 0xe6, 0x8a, 0x82, 0xbf, 0x88, 0x51, 0xf4, 0x21,
+	0xd4, 0xf0, 0xf7, 0xb8, 0xc7, 0x09, 0x0d, 0x52, 0x66, 0x51, 0x32, 0x57, 0x13, 0x3c, 0xa1, 0xde,
+	0x80, 0xb5, 0x1e, 0x0e, 0x78, 0x64, 0x7b, 0x16, 0xeb, 0xf5, 0xb1, 0x33, 0xf4, 0x70, 0x54, 0x2f,
+	0x49, 0x6e, 0x4d, 0x4d, 0x74, 0x12, 0x1c, 0x5d, 0x81, 0xaa, 0x38, 0xc4, 0xc4, 0xfd, 0xbc, 0x24,
+	0x56, 0x24, 0x98, 0xac, 0xb8, 0x0b, 0xb5, 0xae, 0xc7, 0x2c, 0x3b, 0x1c, 0x58, 0x91, 0x0a, 0x64,
+	0x7d, 0x21, 0xde, 0x66, 0xd7, 0x63, 0xfb, 0xe1, 0x20, 0x09, 0x2f, 0xba, 0x06, 0x2b, 0x24, 0x70,
+	0xf0, 0x68, 0xc2, 0x5b, 0x94, 0xbc, 0xaa, 0x44, 0x53, 0xda, 0xa7, 0xb0, 0x92, 0xcf, 0x54, 0x7d,
+	0x69, 0x47, 0xdb, 0x5d, 0x31, 0x36, 0x33, 0x31, 0x7d, 0xa2, 0x08, 0x1d, 0x6e, 0xf3, 0x21, 0x33,
+	0xab, 0x34, 0x33, 0xc6, 0xfa, 0x27, 0x50, 0xbe, 0x2f, 0x94, 0x22, 0xb3, 0xb3, 0x0b, 0x0b, 0x52,
+	0x36, 0x49, 0x6a, 0x6a, 0x99, 0x65, 0x24, 0xcb, 0x54, 0xf3, 0xfa, 0x6f, 0x05, 0x98, 0x97, 0x08,
+	0x7a, 0x1f, 0x2a, 0x12, 0xb3, 0x82, 0xa1, 0xdf, 0xc5, 0x91, 0x4a, 0xcc, 0xb2, 0xc4, 0x1e, 0x4b,
+	0x08, 0x1d, 0x02, 0xa2, 0x21, 0x8e, 0x6c, 0x4e, 0x23, 0x8b, 0x61, 0x6e, 0x85, 0x76, 0x64, 0xfb,
+	0x32, 0x3f, 0xcb, 0xc6, 0xe5, 0xec, 0x4e, 0x15, 0xa9, 0x83, 0xf9, 0x91, 0xa0, 0x98, 0x35, 0x3a,
+	0x85, 0x88, 0x30, 0xfb, 0x24, 0x20, 0xfe, 0xd0, 0xb7, 0x64, 0x64, 0x65, 0xee, 0x4a, 0x66, 0x45,
+	0x81, 0x1d, 0x81, 0xa1, 0xdb, 0xb0, 0x1c, 0x52, 0xea, 0xc5, 0x7e, 0x58, 0xbd, 0x24, 0xcf, 0xb2,
+	0x91, 0x71, 0x74, 0x44, 0xa9, 0x27, 0xd7, 0x63, 0x26, 0x84, 0xe9, 0x37, 0x7a, 0x02, 0xeb, 0xf1,
+	0x51, 0x52, 0x85, 0xc4, 0x3b, 0x9d, 0x97, 0x3b, 0xdd, 0x9a, 0x0e, 0xc6, 0x3d, 0xc5, 0x8a, 0xf7,
+	0x8a, 0xdc, 0x19, 0x4c, 0xff, 0x5d, 0x83, 0xda, 0xf4, 0xa1, 0xd0, 0xc7, 0x80, 0x7c, 0x7b, 0x64,
+	0xa5, 0x11, 0xe9, 0xd1, 0x61, 0xc0, 0x65, 0xd8, 0xaa, 0x66, 0xcd, 0xb7, 0x47, 0x89, 0xc1, 0x67,
+	0x02, 0x47, 0x77, 0xe0, 0xf2, 0x80, 0xf4, 0x06, 0x56, 0x97, 0x84, 0xcc, 0xa2, 0x27, 0x13, 0xb3,
+	0xf8, 0xf8, 0x05, 0x69, 0x76, 0x49, 0x50, 0x0e, 0x48, 0xc8, 0x9e, 0x9c, 0xa4, 0xee, 0x54, 0x24,
+	0xea, 0x39, 0x6b, 0x4e, 0xb9, 0x50, 0x73, 0x1a, 0xb9, 0xaa, 0xb9, 0x3e, 0x31, 0xfd, 0x4a, 0x4c,
+	0x4a, 0x3b, 0xfd, 0x5b, 0x80, 0x49, 0x8c, 0xce, 0xab, 0x3b, 0x04, 0x25, 0x11, 0x40, 0x55, 0x6c,
+	0xf2, 0x1b, 0x35, 0x01, 0xfc, 0xa1, 0xc7, 0x49, 0xe8, 0x11, 0x55, 0x5c, 0x25, 0x33, 0x83, 0xe8,
+	0x23, 0x40, 0xb3, 0xf1, 0x43, 0x1f, 0xc1, 0x5a, 0x64, 0x73, 0x6c, 0x79, 0xc4, 0x27, 0xdc, 0x7a,
+	0x4e, 0x02, 0x87, 0x3e, 0x57, 0x51, 0x59, 0x15, 0x13, 0x8f, 0x04, 0xfe, 0x54, 0xc2, 0xe8, 0x36,
+	0x5c, 0x92, 0x29, 0xb2, 0xbb, 0x1e, 0x8e, 0x4f, 0x63, 0x85, 0x38, 0x12, 0x35, 0xa9, 0x02, 0xb2,
+	0x91, 0x4e, 0xcb, 0xf3, 0x1c, 0xc5, 0x93, 0x7a, 0x17, 0xaa, 0x39, 0xcf, 0xa2, 0x77, 0x71, 0xe2,
+	0x8b, 0xb4, 0xfa, 0xa1, 0x74, 0x56, 0x34, 0x27, 0x00, 0xba, 0x05, 0xf3, 0x93, 0x28, 0x97, 0x0f,
+	0xb6, 0x5e, 0xbc, 0xda, 0x9e, 0xfb, 0xf3, 0xd5, 0xf6, 0x46, 0x8f, 0x32, 0x9f, 0x32, 0xe6, 0x0c,
+	0x5a, 0x84, 0xb6, 0x7d, 0x9b, 0xf7, 0x5b, 0x87, 0x01, 0x37, 0x63, 0xae, 0xfe, 0x25, 0xac, 0xe5,
+	0x7c, 0xc8, 0xc2, 0xba, 0x03, 0xe5, 0x44, 0x53, 0x49, 0x6d, 0xd5, 0xcf, 0x92, 0xd3, 0x41, 0x49,
+	0xf8, 0x31, 0x27, 0x06, 0xfa, 0x6d, 0x58, 0x95, 0x93, 0xd8, 0x49, 0xb2, 0x2b, 0xea, 0x20, 0x5b,
+	0x75, 0x4c, 0x6e, 0xbe, 0x62, 0x56, 0x32, 0x65, 0xc7, 0xf4, 0x53, 0x0d, 0x56, 0x8f, 0x70, 0xe0,
+	0x90, 0xc0, 0x4d, 0x4f, 0xbc, 0x02, 0x85, 0x34, 0x8b, 0x05, 0xe2, 0x88, 0xf2, 0x8d, 0x3b, 0xbe,
+	0x2a, 0xdf, 0x42, 0x5c, 0xbe, 0x12, 0x53, 0xe5, 0x7b, 0x1d, 0x56, 0x93, 0x2e, 0x64, 0x45, 0xb2,
+	0xef, 0xab, 0x8e, 0xb9, 0x12, 0xe5, 0x6e, 0x03, 0x54, 0x87, 0x45, 0x1f, 0x33, 0x66, 0xbb, 0x58,
+	0xb6, 0xc9, 0x8a, 0x99, 0x0c, 0xd1, 0x16, 0xc0, 0xc8, 0x67, 0xae, 0x25, 0xbb, 0x97, 0x6a, 0x8d,
+	0x65, 0x81, 0x1c, 0x0a, 0x00, 0x35, 0x60, 0xc9, 0xe6, 0x1c, 0xfb, 0x21, 0x67, 0xb2, 0x1f, 0x56,
+	0xcd, 0x74, 0x8c, 0xb6, 0x61, 0x99, 0xe1, 0x80, 0x5b, 0x7d, 0x4c, 0xdc, 0x3e, 0x97, 0x6d, 0xb0,
+	0x68, 0x82, 0x80, 0x1e, 0x48, 0x44, 0xff, 0x1a, 0xde, 0x99, 0x3a, 0xa4, 0x0c, 0xf9, 0xdd, 0xd9,
+	0x90, 0x37, 0xb2, 0x2d, 0x20, 0x6f, 0x32, 0x1b, 0xf4, 0x1f, 0x35, 0xd8, 0x92, 0x79, 0x49, 0x62,
+	0xae, 0x9a, 0xae, 0x9d, 0x7a, 0xf8, 0x0e, 0x36, 0xd3, 0x6a, 0x8c, 0x23, 0x81, 0x23, 0xec, 0xe4,
+	0xee, 0xb6, 0xab, 0xd3, 0x49, 0x7e, 0xd3, 0x62, 0xe6, 0x25, 0x9a, 0x43, 0xc5, 0x2a, 0xf1, 0xe5,
+	0xf7, 0xb7, 0x06, 0x9b, 0x67, 0x9a, 0x89, 0xa8, 0x25, 0x86, 0x32, 0xa1, 0x65, 0x33, 0x1d, 0x8b,
+	0xa8, 0xa5, 0x7b, 0x23, 0x8e, 0xcc, 0x6a, 0xc5, 0x84, 0x04, 0x3a, 0x74, 0x66, 0x05, 0x54, 0x9c,
+	0x15, 0x10, 0x7a, 0x17, 0x16, 0x18, 0xed, 0x0d, 0x30, 0x57, 0xd7, 0x9e, 0x1a, 0xa1, 0x07, 0x50,
+	0x0d, 0x87, 0xdd, 0x01, 0x1e, 0x27, 0x2d, 0x36, 0xee, 0x90, 0x57, 0xb2, 0xf1, 0x95, 0xf3, 0xd9,
+	0xfd, 0xaa, 0x86, 0x5b, 0x89, 0x2d, 0xe3, 0x91, 0xfe, 0x03, 0xd4, 0xcf, 0x62, 0x8a, 0xb7, 0x82,
+	0xf2, 0xe2, 0xee, 0xc9, 0x03, 0xe6, 0xdf, 0x0a, 0xf7, 0xf7, 0x8e, 0x28, 0x09, 0xb8, 0xb9, 0x14,
+	0x93, 0xee, 0xef, 0x65, 0x0d, 0x8c, 0x7a, 0x61, 0xd6, 0xc0, 0xc8, 0x1b, 0x18, 0xfa, 0x35, 0x58,
+	0x54, 0xab, 0xa0, 0x0a, 0x68, 0x23, 0x55, 0x16, 0xda, 0x48, 0x8c, 0xc6, 0xaa, 0x14, 0xb4, 0xb1,
+	0xa4, 0x19, 0x39, 0x5a, 0x31, 0x47, 0x2b, 0xc6, 0xb4, 0x9f, 0x35, 0xd8, 0x3e, 0x57, 0x31, 0xc7,
+	0x06, 0xea, 0xbe, 0x5d, 0x33, 0x1f, 0x5c, 0x44, 0x33, 0xc7, 0xc6, 0xd9, 0xaa, 0x39, 0xd5, 0xe0,
+	0xf2, 0x39, 0x86, 0xff, 0xa3, 0x6e, 0x1e, 0xbe, 0x59, 0x37, 0xd7, 0x2e, 0xa0, 0x9b, 0x63, 0x63,
+	0x4a, 0x39, 0x3f, 0x69, 0xd0, 0x38, 0x9b, 0x8c, 0xf6, 0xb2, 0xe2, 0x29, 0x4a, 0x37, 0xeb, 0xb3,
+	0xe2, 0x39, 0x36, 0x32, 0xf2, 0xd9, 0xcb, 0xca, 0xa7, 0x34, 0x6b, 0x62, 0x4c, 0x9b, 0x18, 0x3a,
+	0x86, 0x72, 0xba, 0x12, 0xba, 0x91, 0x48, 0xe8, 0xad, 0x57, 0x85, 0x36, 0x42, 0x37, 0x62, 0xe9,
+	0x5c, 0x84, 0x3c, 0x96, 0x6e, 0x8c, 0x29, 0x37, 0xc5, 0xff, 0xe2, 0xa6, 0x78, 0x21, 0x37, 0x27,
+	0xd0, 0xe8, 0x10, 0x37, 0xb0, 0xf9, 0x30, 0xc2, 0x4f, 0x09, 0xef, 0x77, 0x6c, 0x8f, 0xef, 0x07,
+	0xce, 0xbd, 0x51, 0x48, 0xa2, 0xb1, 0xb8, 0x2b, 0x59, 0x32, 0xab, 0xae, 0x9b, 0x09, 0x20, 0x1e,
+	0x02, 0xcc, 0xf6, 0xb8, 0x52, 0x8c, 0xfc, 0x16, 0x32, 0xc0, 0xd2, 0x56, 0x3d, 0x02, 0xd4, 0x48,
+	0x6f, 0xa9, 0x6b, 0xb8, 0x33, 0x0e, 0x7a, 0xb2, 0x93, 0xe6, 0xaf, 0x07, 0xf5, 0x0f, 0x91, 0x5e,
+	0x0f, 0xfa, 0x2f, 0x1a, 0xac, 0xe5, 0x7f, 0x3c, 0x3a, 0x98, 0x8b, 0x47, 0xc0, 0xd4, 0xb5, 0x64,
+	0xa9, 0xdf, 0x0e, 0xa5, 0xea, 0x8d, 0xfc, 0xf5, 0xb4, 0x1f, 0x4f, 0xa2, 0x7d, 0xd8, 0x8a, 0x9f,
+	0x0c, 0x67, 0x59, 0xc7, 0x6f, 0x99, 0x86, 0x24, 0x99, 0x6f, 0x5a, 0xe2, 0xe0, 0xe1, 0x8b, 0xd7,
+	0x4d, 0xed, 0xe5, 0xeb, 0xa6, 0xf6, 0xcf, 0xeb, 0xa6, 0xf6, 0xeb, 0x69, 0x73, 0xee, 0xe5, 0x69,
+	0x73, 0xee, 0x8f, 0xd3, 0xe6, 0xdc, 0x37, 0x37, 0x5d, 0xc2, 0xfb, 0xc3, 0x6e, 0xab, 0x47, 0xfd,
+	0xf6, 0xcd, 0xd1, 0x11, 0xf6, 0xbc, 0xc7, 0x98, 0x3f, 0xa7, 0xd1, 0xa0, 0x6d, 0x8b, 0x95, 0xda,
+	0xa3, 0xf6, 0xe4, 0x4f, 0x8b, 0x8f, 0x43, 0xcc, 0xba, 0x0b, 0xf2, 0xdf, 0xea, 0xd6, 0xbf, 0x03,
+	0x00, 0x02, 0xbe, 0xb9, 0xd2, 0xbb, 0x0d, 0x00, 0x00,
 }
 
 func (m *RegistryRouterList) Marshal() (dAtA []byte, err error) {
@@ -1676,7 +1969,7 @@ func (m *GroupEjectionParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GroupOperatorRegistrationList) Marshal() (dAtA []byte, err error) {
+func (m *GroupEjection) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1686,20 +1979,58 @@ func (m *GroupOperatorRegistrationList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GroupOperatorRegistrationList) MarshalTo(dAtA []byte) (int, error) {
+func (m *GroupEjection) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GroupOperatorRegistrationList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GroupEjection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.OperatorRegisteredInfos) > 0 {
-		for iNdEx := len(m.OperatorRegisteredInfos) - 1; iNdEx >= 0; iNdEx-- {
+	{
+		size := m.Stake.Size()
+		i -= size
+		if _, err := m.Stake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGroup(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Timestamp != 0 {
+		i = encodeVarintGroup(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GroupEjectionList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupEjectionList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GroupEjectionList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ejections) > 0 {
+		for iNdEx := len(m.Ejections) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.OperatorRegisteredInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Ejections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1713,7 +2044,7 @@ func (m *GroupOperatorRegistrationList) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *GroupOperatorRegistration) Marshal() (dAtA []byte, err error) {
+func (m *EjectedOperator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1723,48 +2054,216 @@ func (m *GroupOperatorRegistration) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GroupOperatorRegistration) MarshalTo(dAtA []byte) (int, error) {
+func (m *EjectedOperator) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GroupOperatorRegistration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EjectedOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PubkeyParams != nil {
-		{
-			size, err := m.PubkeyParams.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGroup(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Socket) > 0 {
-		i -= len(m.Socket)
-		copy(dAtA[i:], m.Socket)
-		i = encodeVarintGroup(dAtA, i, uint64(len(m.Socket)))
-		i--
-		dAtA[i] = 0x22
-	}
 	if len(m.GroupNumbers) > 0 {
 		i -= len(m.GroupNumbers)
 		copy(dAtA[i:], m.GroupNumbers)
 		i = encodeVarintGroup(dAtA, i, uint64(len(m.GroupNumbers)))
 		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.OperatorId) > 0 {
-		i -= len(m.OperatorId)
-		copy(dAtA[i:], m.OperatorId)
-		i = encodeVarintGroup(dAtA, i, uint64(len(m.OperatorId)))
-		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingEjection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingEjection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingEjection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SentHeight != 0 {
+		i = encodeVarintGroup(dAtA, i, uint64(m.SentHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Attempts != 0 {
+		i = encodeVarintGroup(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.XmsgIndex) > 0 {
+		i -= len(m.XmsgIndex)
+		copy(dAtA[i:], m.XmsgIndex)
+		i = encodeVarintGroup(dAtA, i, uint64(len(m.XmsgIndex)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintGroup(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RegistryRouter) > 0 {
+		i -= len(m.RegistryRouter)
+		copy(dAtA[i:], m.RegistryRouter)
+		i = encodeVarintGroup(dAtA, i, uint64(len(m.RegistryRouter)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintGroup(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintGroup(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingEjectionList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingEjectionList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingEjectionList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ejections) > 0 {
+		for iNdEx := len(m.Ejections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ejections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGroup(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GroupOperatorRegistrationList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupOperatorRegistrationList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GroupOperatorRegistrationList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OperatorRegisteredInfos) > 0 {
+		for iNdEx := len(m.OperatorRegisteredInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OperatorRegisteredInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGroup(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GroupOperatorRegistration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupOperatorRegistration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GroupOperatorRegistration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PubkeyParams != nil {
+		{
+			size, err := m.PubkeyParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGroup(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Socket) > 0 {
+		i -= len(m.Socket)
+		copy(dAtA[i:], m.Socket)
+		i = encodeVarintGroup(dAtA, i, uint64(len(m.Socket)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.GroupNumbers) > 0 {
+		i -= len(m.GroupNumbers)
+		copy(dAtA[i:], m.GroupNumbers)
+		i = encodeVarintGroup(dAtA, i, uint64(len(m.GroupNumbers)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OperatorId) > 0 {
+		i -= len(m.OperatorId)
+		copy(dAtA[i:], m.OperatorId)
+		i = encodeVarintGroup(dAtA, i, uint64(len(m.OperatorId)))
+		i--
+		dAtA[i] = 0x12
 	}
 	if len(m.Operator) > 0 {
 		i -= len(m.Operator)
@@ -2443,6 +2942,96 @@ func (m *GroupEjectionParam) Size() (n int) {
 	return n
 }
 
+func (m *GroupEjection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovGroup(uint64(m.Timestamp))
+	}
+	l = m.Stake.Size()
+	n += 1 + l + sovGroup(uint64(l))
+	return n
+}
+
+func (m *GroupEjectionList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ejections) > 0 {
+		for _, e := range m.Ejections {
+			l = e.Size()
+			n += 1 + l + sovGroup(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EjectedOperator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GroupNumbers)
+	if l > 0 {
+		n += 1 + l + sovGroup(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingEjection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGroup(uint64(m.Id))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovGroup(uint64(m.EpochNumber))
+	}
+	l = len(m.RegistryRouter)
+	if l > 0 {
+		n += 1 + l + sovGroup(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovGroup(uint64(l))
+	}
+	l = len(m.XmsgIndex)
+	if l > 0 {
+		n += 1 + l + sovGroup(uint64(l))
+	}
+	if m.Attempts != 0 {
+		n += 1 + sovGroup(uint64(m.Attempts))
+	}
+	if m.SentHeight != 0 {
+		n += 1 + sovGroup(uint64(m.SentHeight))
+	}
+	return n
+}
+
+func (m *PendingEjectionList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ejections) > 0 {
+		for _, e := range m.Ejections {
+			l = e.Size()
+			n += 1 + l + sovGroup(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *GroupOperatorRegistrationList) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3734,6 +4323,585 @@ func (m *GroupEjectionParam) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GroupEjection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGroup
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroupEjection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroupEjection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGroup
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGroup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGroup(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGroup
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GroupEjectionList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGroup
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroupEjectionList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroupEjectionList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ejections", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGroup
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGroup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ejections = append(m.Ejections, GroupEjection{})
+			if err := m.Ejections[len(m.Ejections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGroup(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGroup
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EjectedOperator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGroup
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EjectedOperator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EjectedOperator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupNumbers", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGroup
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGroup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupNumbers = append(m.GroupNumbers[:0], dAtA[iNdEx:postIndex]...)
+			if m.GroupNumbers == nil {
+				m.GroupNumbers = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGroup(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGroup
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingEjection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGroup
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingEjection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingEjection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistryRouter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGroup
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGroup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegistryRouter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGroup
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGroup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = append(m.Message[:0], dAtA[iNdEx:postIndex]...)
+			if m.Message == nil {
+				m.Message = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XmsgIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGroup
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGroup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XmsgIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentHeight", wireType)
+			}
+			m.SentHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SentHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGroup(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGroup
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingEjectionList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGroup
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingEjectionList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingEjectionList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ejections", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGroup
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGroup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ejections = append(m.Ejections, PendingEjection{})
+			if err := m.Ejections[len(m.Ejections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGroup(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGroup
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GroupOperatorRegistrationList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -32,8 +32,18 @@ const (
 	KeyEpochOperatorSharesSyncTxs  = "epoch_operator_shares_sync_txs"
 	KeyEpochOperatorSharesSnapshot = "epoch_operator_shares_snapshot"
 	KeyOperator                    = "operator"
+	KeyOperatorSharesHistory       = "history/operator_shares/" // must not start with an iterated prefix such as KeyOperatorShareStore
+	KeyGroupOperatorHistory        = "history/group_operators/"
+	KeyHistoryPruneQueue           = "history/prune_queue/"
+	KeyStakerDelegation            = "staker_delegation/"
+	KeySharesHistoryRetention      = "shares_history_retention"
+	KeySharesHistoryPrunedBefore   = "shares_history_pruned_before"
 
 	GroupKey = "group_data"
+
+	// UntrackedStaker holds the delegated shares whose staker is unknown,
+	// the shares delegated before the stakers were tracked
+	UntrackedStaker = "0x0000000000000000000000000000000000000000"
 )
 
 func KeyPrefix(p string) []byte {
@@ -66,6 +76,47 @@ func GroupOperatorKey(registryRouter common.Address) []byte {
 	return KeyPrefix(fmt.Sprintf("%s-%s", "group_operator", registryRouter.Hex()))
 }
 
+// OperatorSharesHistoryPrefix returns the prefix of the shares history of a chain,
+// narrowed to an operator and a strategy when they are not empty
+func OperatorSharesHistoryPrefix(chainId uint64, operator, strategy string) []byte {
+	if operator == "" {
+		return KeyPrefix(fmt.Sprintf("%d/", chainId))
+	}
+	if strategy == "" {
+		return KeyPrefix(fmt.Sprintf("%d/%s/", chainId, operator))
+	}
+	return KeyPrefix(fmt.Sprintf("%d/%s/%s/", chainId, operator, strategy))
+}
+
+// StakerDelegationPrefix returns the prefix of the delegations of a strategy to an operator
+func StakerDelegationPrefix(chainId uint64, operator, strategy string) []byte {
+	return KeyPrefix(fmt.Sprintf("%d/%s/%s/", chainId, operator, strategy))
+}
+
+// GroupOperatorHistoryPrefix returns the prefix of the group operator history of a registry router
+func GroupOperatorHistoryPrefix(registryRouter common.Address) []byte {
+	return KeyPrefix(fmt.Sprintf("%s/", registryRouter.Hex()))
+}
+
+func GroupEjectionKey(registryRouter common.Address, groupNumber uint64) []byte {
+	return KeyPrefix(fmt.Sprintf("%s-%s-%d", "group_ejection", registryRouter.Hex(), groupNumber))
+}
+
+// EjectedOperatorKey returns the key of the groups an operator was ejected from
+func EjectedOperatorKey(registryRouter common.Address, operatorId []byte) []byte {
+	return KeyPrefix(fmt.Sprintf("%s-%s-%x", "ejected_operator", registryRouter.Hex(), operatorId))
+}
+
+// PendingEjectionKey returns the key of the ejections waiting to be delivered to a chain
+func PendingEjectionKey(chainId uint64) []byte {
+	return KeyPrefix(fmt.Sprintf("%s-%d", "pending_ejection", chainId))
+}
+
+// EjectionSequenceKey returns the key of the last ejection id
+func EjectionSequenceKey() []byte {
+	return KeyPrefix("ejection_sequence")
+}
+
 func GroupSyncKey(txHash string) []byte {
 	txHash = strings.ToLower(txHash)
 	return KeyPrefix(fmt.Sprintf("%s-%s", "group_data_sync", txHash))
@@ -258,6 +258,10 @@ func (m *QueryOutboundStateByChainIDRequest) GetChainId() uint64 {
 // response of query outbound state by chain id
 type QueryGetOutboundStateByChainIDResponse struct {
 	OutboundState *EpochOutboundState `protobuf:"bytes,1,opt,name=outbound_state,json=outboundState,proto3" json:"outbound_state,omitempty"`
+	// current epoch number of pell chain
+	CurrentEpochNumber uint64 `protobuf:"varint,2,opt,name=current_epoch_number,json=currentEpochNumber,proto3" json:"current_epoch_number,omitempty"`
+	// number of closed epochs not synced to the chain yet
+	SyncLag uint64 `protobuf:"varint,3,opt,name=sync_lag,json=syncLag,proto3" json:"sync_lag,omitempty"`
 }
 
 func (m *QueryGetOutboundStateByChainIDResponse) Reset() {
@@ -302,6 +306,20 @@ func (m *QueryGetOutboundStateByChainIDResponse) GetOutboundState() *EpochOutbou
 	return nil
 }
 
+func (m *QueryGetOutboundStateByChainIDResponse) GetCurrentEpochNumber() uint64 {
+	if m != nil {
+		return m.CurrentEpochNumber
+	}
+	return 0
+}
+
+func (m *QueryGetOutboundStateByChainIDResponse) GetSyncLag() uint64 {
+	if m != nil {
+		return m.SyncLag
+	}
+	return 0
+}
+
 // request of query dvs supported chain status
 type QueryDVSSupportedChainStatusRequest struct {
 	RegistryRouterAddress string `protobuf:"bytes,1,opt,name=registry_router_address,json=registryRouterAddress,proto3" json:"registry_router_address,omitempty"`
@@ -850,6 +868,334 @@ func (m *QueryDVSGroupOperatorRegistrationListResponse) GetOperatorRegisteredInf
 	return nil
 }
 
+// request of query operator shares at epoch, all operators of the chain are
+// returned if operator is empty
+type QueryOperatorSharesAtEpochRequest struct {
+	ChainId     uint64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Operator    string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	EpochNumber uint64 `protobuf:"varint,3,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+}
+
+func (m *QueryOperatorSharesAtEpochRequest) Reset()         { *m = QueryOperatorSharesAtEpochRequest{} }
+func (m *QueryOperatorSharesAtEpochRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOperatorSharesAtEpochRequest) ProtoMessage()    {}
+func (*QueryOperatorSharesAtEpochRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1314ec210efdaad6, []int{18}
+}
+func (m *QueryOperatorSharesAtEpochRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOperatorSharesAtEpochRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOperatorSharesAtEpochRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOperatorSharesAtEpochRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOperatorSharesAtEpochRequest.Merge(m, src)
+}
+func (m *QueryOperatorSharesAtEpochRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOperatorSharesAtEpochRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOperatorSharesAtEpochRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOperatorSharesAtEpochRequest proto.InternalMessageInfo
+
+func (m *QueryOperatorSharesAtEpochRequest) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *QueryOperatorSharesAtEpochRequest) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *QueryOperatorSharesAtEpochRequest) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+// response of query operator shares at epoch
+type QueryGetOperatorSharesAtEpochResponse struct {
+	OperatorShares []OperatorShares `protobuf:"bytes,1,rep,name=operator_shares,json=operatorShares,proto3" json:"operator_shares"`
+}
+
+func (m *QueryGetOperatorSharesAtEpochResponse) Reset()         { *m = QueryGetOperatorSharesAtEpochResponse{} }
+func (m *QueryGetOperatorSharesAtEpochResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetOperatorSharesAtEpochResponse) ProtoMessage()    {}
+func (*QueryGetOperatorSharesAtEpochResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1314ec210efdaad6, []int{19}
+}
+func (m *QueryGetOperatorSharesAtEpochResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetOperatorSharesAtEpochResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetOperatorSharesAtEpochResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetOperatorSharesAtEpochResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetOperatorSharesAtEpochResponse.Merge(m, src)
+}
+func (m *QueryGetOperatorSharesAtEpochResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetOperatorSharesAtEpochResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetOperatorSharesAtEpochResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetOperatorSharesAtEpochResponse proto.InternalMessageInfo
+
+func (m *QueryGetOperatorSharesAtEpochResponse) GetOperatorShares() []OperatorShares {
+	if m != nil {
+		return m.OperatorShares
+	}
+	return nil
+}
+
+// request of query operator shares diff between two epochs, all operators of
+// the chain are returned if operator is empty
+type QueryOperatorSharesDiffRequest struct {
+	ChainId   uint64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Operator  string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	FromEpoch uint64 `protobuf:"varint,3,opt,name=from_epoch,json=fromEpoch,proto3" json:"from_epoch,omitempty"`
+	ToEpoch   uint64 `protobuf:"varint,4,opt,name=to_epoch,json=toEpoch,proto3" json:"to_epoch,omitempty"`
+}
+
+func (m *QueryOperatorSharesDiffRequest) Reset()         { *m = QueryOperatorSharesDiffRequest{} }
+func (m *QueryOperatorSharesDiffRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOperatorSharesDiffRequest) ProtoMessage()    {}
+func (*QueryOperatorSharesDiffRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1314ec210efdaad6, []int{20}
+}
+func (m *QueryOperatorSharesDiffRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOperatorSharesDiffRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOperatorSharesDiffRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOperatorSharesDiffRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOperatorSharesDiffRequest.Merge(m, src)
+}
+func (m *QueryOperatorSharesDiffRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOperatorSharesDiffRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOperatorSharesDiffRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOperatorSharesDiffRequest proto.InternalMessageInfo
+
+func (m *QueryOperatorSharesDiffRequest) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *QueryOperatorSharesDiffRequest) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *QueryOperatorSharesDiffRequest) GetFromEpoch() uint64 {
+	if m != nil {
+		return m.FromEpoch
+	}
+	return 0
+}
+
+func (m *QueryOperatorSharesDiffRequest) GetToEpoch() uint64 {
+	if m != nil {
+		return m.ToEpoch
+	}
+	return 0
+}
+
+// response of query operator shares diff between two epochs
+type QueryGetOperatorSharesDiffResponse struct {
+	Diffs []OperatorSharesDiff `protobuf:"bytes,1,rep,name=diffs,proto3" json:"diffs"`
+}
+
+func (m *QueryGetOperatorSharesDiffResponse) Reset()         { *m = QueryGetOperatorSharesDiffResponse{} }
+func (m *QueryGetOperatorSharesDiffResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetOperatorSharesDiffResponse) ProtoMessage()    {}
+func (*QueryGetOperatorSharesDiffResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1314ec210efdaad6, []int{21}
+}
+func (m *QueryGetOperatorSharesDiffResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetOperatorSharesDiffResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetOperatorSharesDiffResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetOperatorSharesDiffResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetOperatorSharesDiffResponse.Merge(m, src)
+}
+func (m *QueryGetOperatorSharesDiffResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetOperatorSharesDiffResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetOperatorSharesDiffResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetOperatorSharesDiffResponse proto.InternalMessageInfo
+
+func (m *QueryGetOperatorSharesDiffResponse) GetDiffs() []OperatorSharesDiff {
+	if m != nil {
+		return m.Diffs
+	}
+	return nil
+}
+
+// request of query group operators at epoch
+type QueryGroupOperatorsAtEpochRequest struct {
+	RegistryRouterAddress string `protobuf:"bytes,1,opt,name=registry_router_address,json=registryRouterAddress,proto3" json:"registry_router_address,omitempty"`
+	GroupNumber           uint32 `protobuf:"varint,2,opt,name=group_number,json=groupNumber,proto3" json:"group_number,omitempty"`
+	EpochNumber           uint64 `protobuf:"varint,3,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+}
+
+func (m *QueryGroupOperatorsAtEpochRequest) Reset()         { *m = QueryGroupOperatorsAtEpochRequest{} }
+func (m *QueryGroupOperatorsAtEpochRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGroupOperatorsAtEpochRequest) ProtoMessage()    {}
+func (*QueryGroupOperatorsAtEpochRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1314ec210efdaad6, []int{22}
+}
+func (m *QueryGroupOperatorsAtEpochRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGroupOperatorsAtEpochRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGroupOperatorsAtEpochRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGroupOperatorsAtEpochRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGroupOperatorsAtEpochRequest.Merge(m, src)
+}
+func (m *QueryGroupOperatorsAtEpochRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGroupOperatorsAtEpochRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGroupOperatorsAtEpochRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGroupOperatorsAtEpochRequest proto.InternalMessageInfo
+
+func (m *QueryGroupOperatorsAtEpochRequest) GetRegistryRouterAddress() string {
+	if m != nil {
+		return m.RegistryRouterAddress
+	}
+	return ""
+}
+
+func (m *QueryGroupOperatorsAtEpochRequest) GetGroupNumber() uint32 {
+	if m != nil {
+		return m.GroupNumber
+	}
+	return 0
+}
+
+func (m *QueryGroupOperatorsAtEpochRequest) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+// response of query group operators at epoch
+type QueryGetGroupOperatorsAtEpochResponse struct {
+	Operators []*GroupOperatorRegistrationV2 `protobuf:"bytes,1,rep,name=operators,proto3" json:"operators,omitempty"`
+}
+
+func (m *QueryGetGroupOperatorsAtEpochResponse) Reset()         { *m = QueryGetGroupOperatorsAtEpochResponse{} }
+func (m *QueryGetGroupOperatorsAtEpochResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetGroupOperatorsAtEpochResponse) ProtoMessage()    {}
+func (*QueryGetGroupOperatorsAtEpochResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1314ec210efdaad6, []int{23}
+}
+func (m *QueryGetGroupOperatorsAtEpochResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetGroupOperatorsAtEpochResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetGroupOperatorsAtEpochResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetGroupOperatorsAtEpochResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetGroupOperatorsAtEpochResponse.Merge(m, src)
+}
+func (m *QueryGetGroupOperatorsAtEpochResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetGroupOperatorsAtEpochResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetGroupOperatorsAtEpochResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetGroupOperatorsAtEpochResponse proto.InternalMessageInfo
+
+func (m *QueryGetGroupOperatorsAtEpochResponse) GetOperators() []*GroupOperatorRegistrationV2 {
+	if m != nil {
+		return m.Operators
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryEpochChangedOperatorSharesSnapshotRequest)(nil), "restaking.QueryEpochChangedOperatorSharesSnapshotRequest")
 	proto.RegisterType((*QueryGetEpochChangedOperatorSharesSnapshotResponse)(nil), "restaking.QueryGetEpochChangedOperatorSharesSnapshotResponse")
@@ -869,79 +1215,103 @@ func init() {
 	proto.RegisterType((*QueryDVSGroupDataListResponse)(nil), "restaking.QueryDVSGroupDataListResponse")
 	proto.RegisterType((*QueryDVSGroupOperatorRegistrationListRequest)(nil), "restaking.QueryDVSGroupOperatorRegistrationListRequest")
 	proto.RegisterType((*QueryDVSGroupOperatorRegistrationListResponse)(nil), "restaking.QueryDVSGroupOperatorRegistrationListResponse")
+	proto.RegisterType((*QueryOperatorSharesAtEpochRequest)(nil), "restaking.QueryOperatorSharesAtEpochRequest")
+	proto.RegisterType((*QueryGetOperatorSharesAtEpochResponse)(nil), "restaking.QueryGetOperatorSharesAtEpochResponse")
+	proto.RegisterType((*QueryOperatorSharesDiffRequest)(nil), "restaking.QueryOperatorSharesDiffRequest")
+	proto.RegisterType((*QueryGetOperatorSharesDiffResponse)(nil), "restaking.QueryGetOperatorSharesDiffResponse")
+	proto.RegisterType((*QueryGroupOperatorsAtEpochRequest)(nil), "restaking.QueryGroupOperatorsAtEpochRequest")
+	proto.RegisterType((*QueryGetGroupOperatorsAtEpochResponse)(nil), "restaking.QueryGetGroupOperatorsAtEpochResponse")
 }
 
 func init() { proto.RegisterFile("restaking/query.proto", fileDescriptor_1314ec210efdaad6) }
 
 var fileDescriptor_1314ec210efdaad6 = []byte{
-	// 1067 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0x4d, 0x6f, 0xdc, 0x44,
-	0x18, 0xc7, 0xe3, 0x12, 0xd2, 0xe6, 0x49, 0x28, 0x30, 0x10, 0xb6, 0x6b, 0x25, 0xdb, 0x8d, 0x9b,
-	0x96, 0x2d, 0xed, 0xae, 0xd3, 0x8d, 0x54, 0x5a, 0x54, 0x0a, 0x4d, 0x17, 0x95, 0xa0, 0xaa, 0x05,
-	0xaf, 0x14, 0xf1, 0x72, 0xb0, 0xbc, 0xeb, 0x89, 0xbd, 0xca, 0xc6, 0xe3, 0xce, 0x8c, 0xcb, 0xee,
-	0x0d, 0xf1, 0x09, 0x90, 0x7a, 0x86, 0x1b, 0x1f, 0x80, 0x13, 0xe2, 0x1b, 0xf4, 0x58, 0x89, 0x0b,
-	0xa7, 0x0a, 0x25, 0x48, 0x7c, 0x08, 0x2e, 0xc8, 0xe3, 0x71, 0xd6, 0x36, 0xf6, 0xae, 0x23, 0x72,
-	0x89, 0xe2, 0x67, 0x9e, 0x79, 0x9e, 0xdf, 0x7f, 0xde, 0xfe, 0x09, 0xac, 0x50, 0xcc, 0xb8, 0xb5,
-	0x3f, 0xf0, 0x1c, 0xfd, 0x49, 0x80, 0xe9, 0xb8, 0xe5, 0x53, 0xc2, 0x09, 0x5a, 0x3c, 0x0e, 0xab,
-	0x6f, 0x3b, 0xc4, 0x21, 0x22, 0xaa, 0x87, 0xbf, 0x45, 0x09, 0xea, 0xaa, 0x43, 0x88, 0x33, 0xc4,
-	0xba, 0xe5, 0x0f, 0x74, 0xcb, 0xf3, 0x08, 0xb7, 0xf8, 0x80, 0x78, 0x4c, 0x8e, 0xaa, 0x93, 0xaa,
-	0x36, 0x1e, 0x62, 0x47, 0x0c, 0xca, 0xb1, 0x8d, 0xc9, 0x18, 0xf6, 0x49, 0xdf, 0x35, 0x49, 0xc0,
-	0x7b, 0x24, 0xf0, 0x6c, 0x93, 0x71, 0x8b, 0x63, 0x99, 0x95, 0xe0, 0x72, 0x28, 0x09, 0x7c, 0x19,
-	0x7e, 0x7d, 0x74, 0xc0, 0x1c, 0x3d, 0xfc, 0x11, 0x05, 0xb4, 0x2e, 0xb4, 0xbe, 0x08, 0xb9, 0x3f,
-	0x09, 0x4b, 0xdd, 0x77, 0x2d, 0xcf, 0xc1, 0xf6, 0x63, 0x1f, 0x53, 0x8b, 0x13, 0xda, 0x75, 0x2d,
-	0x8a, 0x59, 0xd7, 0xb3, 0x7c, 0xe6, 0x12, 0x6e, 0xe0, 0x27, 0x01, 0x66, 0x1c, 0xad, 0xc3, 0x72,
-	0xd4, 0xd7, 0x0b, 0x0e, 0x7a, 0x98, 0x5e, 0x50, 0xea, 0x4a, 0x63, 0xde, 0x58, 0x12, 0xb1, 0x47,
-	0x22, 0xa4, 0xfd, 0xa4, 0x40, 0x5b, 0x54, 0x7d, 0x80, 0x79, 0x99, 0xc2, 0xcc, 0x27, 0x1e, 0xc3,
-	0xc8, 0x85, 0x7a, 0x3f, 0x4a, 0x34, 0x89, 0xcc, 0x34, 0x99, 0x48, 0x35, 0x99, 0xcc, 0xbd, 0xa0,
-	0xd4, 0x5f, 0x69, 0x2c, 0xb5, 0xab, 0xad, 0x63, 0x79, 0xad, 0x74, 0xd1, 0xed, 0xf9, 0xe7, 0x2f,
-	0x2f, 0xce, 0x19, 0x6b, 0xfd, 0x69, 0x1d, 0xb5, 0x0a, 0xac, 0x4c, 0x54, 0xef, 0x78, 0x7b, 0x44,
-	0x8a, 0xd3, 0xee, 0x42, 0x35, 0x05, 0x1e, 0x8d, 0x49, 0xbe, 0x75, 0x58, 0xee, 0x0d, 0x49, 0x7f,
-	0x3f, 0xa3, 0x5c, 0xc4, 0xa4, 0xf2, 0x8f, 0x40, 0x13, 0xf3, 0x1f, 0xcb, 0x3d, 0xe9, 0x86, 0x5b,
-	0xb2, 0x3d, 0xbe, 0xef, 0x5a, 0x03, 0x6f, 0xa7, 0x13, 0x2f, 0x61, 0x15, 0xce, 0xf5, 0xc3, 0x88,
-	0x39, 0xb0, 0x65, 0x91, 0xb3, 0xe2, 0x7b, 0xc7, 0xd6, 0x3c, 0xb8, 0x12, 0x03, 0x14, 0xd5, 0x90,
-	0x34, 0x1d, 0x38, 0x9f, 0xde, 0x79, 0x51, 0x6a, 0xa9, 0xbd, 0x96, 0x58, 0x1b, 0xa1, 0x21, 0x55,
-	0xc7, 0x78, 0x8d, 0x24, 0x3f, 0xb5, 0x11, 0x5c, 0x12, 0xfd, 0x3a, 0xbb, 0xdd, 0x6e, 0xe0, 0xfb,
-	0x84, 0x72, 0x6c, 0x8b, 0x4e, 0xe1, 0x70, 0xc0, 0x62, 0xe2, 0x9b, 0x50, 0xa1, 0xd8, 0x19, 0x30,
-	0x4e, 0xc7, 0x26, 0x25, 0x01, 0xc7, 0xd4, 0xb4, 0x6c, 0x9b, 0x62, 0xc6, 0x44, 0xd7, 0x45, 0x63,
-	0x25, 0x1e, 0x36, 0xc4, 0xe8, 0xbd, 0x68, 0x30, 0xa5, 0xf4, 0x4c, 0x5a, 0xa9, 0x0b, 0x1b, 0xd3,
-	0x3b, 0x4b, 0x9d, 0x1f, 0xe7, 0xea, 0x3c, 0x9f, 0x3e, 0x03, 0x09, 0x4d, 0x01, 0xcb, 0x6a, 0xbc,
-	0x0d, 0xb5, 0xb8, 0xd3, 0x83, 0xf0, 0x2e, 0x74, 0xc7, 0x5e, 0x3f, 0x2d, 0xaf, 0x02, 0x67, 0xf9,
-	0xc8, 0x74, 0x2d, 0xe6, 0x4a, 0x39, 0x0b, 0x7c, 0xf4, 0xa9, 0xc5, 0x5c, 0xed, 0x1e, 0x5c, 0x2c,
-	0x9c, 0x2a, 0xf9, 0x6a, 0x30, 0x1f, 0xde, 0x27, 0x79, 0x32, 0xa1, 0x25, 0x2e, 0xd7, 0x97, 0x07,
-	0xcc, 0x31, 0x44, 0x5c, 0xfb, 0x06, 0xd6, 0xf3, 0x75, 0x3e, 0x1c, 0x30, 0xfe, 0x3f, 0xd7, 0x57,
-	0xfb, 0x4a, 0x9e, 0xb7, 0x82, 0xe2, 0x12, 0x71, 0x0b, 0x16, 0xed, 0xa7, 0xcc, 0x1c, 0x78, 0x7b,
-	0x84, 0xc9, 0x53, 0xf2, 0x4e, 0x62, 0xf5, 0x3a, 0xbb, 0xdd, 0xf0, 0x9c, 0x8b, 0x29, 0xe7, 0xec,
-	0xa7, 0x2c, 0xfc, 0x60, 0xda, 0xa5, 0x09, 0xb7, 0x91, 0xea, 0x9d, 0xe0, 0xd6, 0xe8, 0xa4, 0x7f,
-	0x5e, 0x92, 0xec, 0xff, 0x10, 0xde, 0xca, 0xaa, 0x63, 0x38, 0xbe, 0xcb, 0xab, 0x09, 0x92, 0x74,
-	0x8d, 0x2e, 0xe6, 0xc6, 0x9b, 0x34, 0x1b, 0xd2, 0x76, 0x61, 0x35, 0xb5, 0x27, 0x1d, 0x8b, 0x5b,
-	0xa7, 0xb1, 0x96, 0x3b, 0xb0, 0x56, 0x50, 0x57, 0xca, 0x68, 0xc0, 0x82, 0x78, 0x4b, 0x99, 0x24,
-	0x7f, 0x23, 0x41, 0x2e, 0x66, 0x18, 0x72, 0x5c, 0xdb, 0x83, 0xeb, 0xa9, 0x52, 0xf1, 0x33, 0x24,
-	0xf5, 0x89, 0xe7, 0xfc, 0x34, 0x90, 0x9f, 0x29, 0xd0, 0x2c, 0xd9, 0x48, 0x6a, 0xe8, 0x41, 0xf5,
-	0xf8, 0x6d, 0x8d, 0x6a, 0x62, 0x8a, 0xed, 0xe3, 0xa3, 0x11, 0xca, 0xba, 0x92, 0x95, 0x95, 0x57,
-	0x74, 0xb7, 0x6d, 0x54, 0x48, 0x2a, 0x1e, 0xd6, 0x11, 0x27, 0xa7, 0xfd, 0xcf, 0x32, 0xbc, 0x2a,
-	0xa8, 0xd0, 0xdf, 0x0a, 0x5c, 0x2e, 0xe5, 0x01, 0xe8, 0x76, 0xa2, 0xe9, 0xc9, 0x0c, 0x49, 0xfd,
-	0x30, 0x3b, 0xf5, 0x44, 0xae, 0xa3, 0x75, 0xbe, 0xff, 0xfd, 0xaf, 0x67, 0x67, 0xee, 0xa2, 0x3b,
-	0xba, 0x8f, 0x87, 0xc3, 0xa6, 0x78, 0x9e, 0xf4, 0xac, 0xc7, 0xce, 0xf2, 0x25, 0xf4, 0x9d, 0x02,
-	0xcb, 0x49, 0xd3, 0x40, 0xf5, 0x5c, 0x41, 0x09, 0xaf, 0x51, 0x37, 0x8a, 0xb8, 0x93, 0xa6, 0xa3,
-	0x35, 0x04, 0x9e, 0x86, 0xea, 0xd3, 0xf0, 0xc2, 0xed, 0x43, 0xbf, 0x2a, 0x50, 0x2d, 0xb4, 0x0d,
-	0xd4, 0xcc, 0x76, 0x9b, 0x6a, 0x51, 0xea, 0x8d, 0x1c, 0xb8, 0xe9, 0x86, 0xa4, 0xdd, 0x12, 0xa4,
-	0x6d, 0xb4, 0x99, 0x4f, 0x9a, 0x7e, 0xc4, 0xcd, 0xde, 0xd8, 0x8c, 0x9d, 0x01, 0xfd, 0xa6, 0x4c,
-	0xae, 0x74, 0x9e, 0x17, 0xa0, 0x56, 0x96, 0x66, 0xba, 0x5d, 0xa9, 0x7a, 0xe9, 0xfc, 0x72, 0xec,
-	0xe1, 0xeb, 0xc9, 0xe2, 0xf9, 0x92, 0x9b, 0x45, 0x68, 0x3f, 0x2b, 0x50, 0x29, 0xb0, 0x08, 0x74,
-	0x35, 0x07, 0x23, 0xdf, 0x81, 0xd4, 0xf7, 0xca, 0xa4, 0x4a, 0xd8, 0x2d, 0x01, 0xdb, 0x44, 0xd7,
-	0x8a, 0x61, 0xc5, 0x3b, 0x64, 0xb2, 0xb1, 0xd7, 0x8f, 0x39, 0x7f, 0x51, 0x40, 0x2d, 0xb6, 0x0a,
-	0x74, 0x7d, 0xe6, 0x8a, 0x25, 0xde, 0x2b, 0xb5, 0x59, 0x32, 0x5b, 0x02, 0xdf, 0x14, 0xc0, 0x9b,
-	0xa8, 0x55, 0x7e, 0x75, 0x87, 0x21, 0x54, 0x92, 0xf9, 0xbf, 0xf6, 0x92, 0xcb, 0x5c, 0x68, 0x55,
-	0xb9, 0xcc, 0xc5, 0x9e, 0x55, 0x86, 0x39, 0xfb, 0x64, 0x0b, 0xe6, 0x1f, 0x15, 0xf9, 0xb7, 0x65,
-	0xd6, 0x46, 0xd0, 0xbb, 0x45, 0x5b, 0x9c, 0x31, 0x30, 0xb5, 0x31, 0x3b, 0x51, 0x42, 0xde, 0x10,
-	0x90, 0xd7, 0xd0, 0xd5, 0x59, 0x27, 0xc1, 0xb6, 0xb8, 0x15, 0xf1, 0xbd, 0x54, 0xe0, 0x72, 0x29,
-	0xcb, 0x40, 0xef, 0x17, 0x61, 0xcc, 0x70, 0x33, 0xf5, 0xd6, 0xc9, 0x27, 0x4a, 0x3d, 0xdb, 0x42,
-	0xcf, 0x1d, 0xf4, 0xc1, 0x2c, 0x3d, 0x19, 0x0f, 0x8b, 0x4a, 0x09, 0x81, 0xdb, 0x9f, 0x3d, 0x3f,
-	0xac, 0x29, 0x2f, 0x0e, 0x6b, 0xca, 0x9f, 0x87, 0x35, 0xe5, 0x87, 0xa3, 0xda, 0xdc, 0x8b, 0xa3,
-	0xda, 0xdc, 0x1f, 0x47, 0xb5, 0xb9, 0xaf, 0x37, 0x9d, 0x01, 0x77, 0x83, 0x5e, 0xab, 0x4f, 0x0e,
-	0xf4, 0xcd, 0xd1, 0xe7, 0x78, 0x38, 0x7c, 0x84, 0xf9, 0xb7, 0x84, 0xee, 0xeb, 0x56, 0x58, 0x45,
-	0x1f, 0x25, 0x5a, 0xf1, 0xb1, 0x8f, 0x59, 0x6f, 0x41, 0xfc, 0x93, 0xb4, 0xf5, 0x6f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x9d, 0x4c, 0x70, 0x32, 0xe6, 0x0d, 0x00, 0x00,
+	// 1353 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4d, 0x6f, 0xdb, 0x46,
+	0x13, 0x36, 0x13, 0xe7, 0xc3, 0x63, 0x27, 0x79, 0xb3, 0x6f, 0x5c, 0xdb, 0x84, 0xad, 0xd8, 0x9b,
+	0x8f, 0x3a, 0x1f, 0x96, 0x1c, 0x05, 0x48, 0x93, 0x22, 0x4d, 0x1b, 0x47, 0x41, 0xe2, 0x22, 0x48,
+	0x5a, 0x0a, 0x30, 0xfa, 0x71, 0x20, 0x28, 0x71, 0x45, 0x09, 0x91, 0xb9, 0x0a, 0x77, 0x99, 0x4a,
+	0x87, 0x02, 0x45, 0xef, 0x05, 0x5a, 0xe4, 0xdc, 0x02, 0x3d, 0xf4, 0x07, 0xb4, 0x97, 0xa2, 0xe8,
+	0x1f, 0xc8, 0x31, 0x40, 0x2f, 0x3d, 0x05, 0x45, 0x5c, 0xa0, 0x7f, 0xa3, 0xd8, 0xe5, 0x52, 0x22,
+	0x29, 0x92, 0xa2, 0x9b, 0x5c, 0x0c, 0xef, 0xec, 0xec, 0xec, 0xf3, 0xcc, 0x0e, 0x67, 0x1e, 0x1b,
+	0xe6, 0x3d, 0xc2, 0xb8, 0xf5, 0xb8, 0xe3, 0x3a, 0x95, 0x27, 0x3e, 0xf1, 0x06, 0xe5, 0x9e, 0x47,
+	0x39, 0x45, 0x33, 0x43, 0xb3, 0x7e, 0xca, 0xa1, 0x0e, 0x95, 0xd6, 0x8a, 0xf8, 0x2d, 0x70, 0xd0,
+	0x97, 0x1d, 0x4a, 0x9d, 0x2e, 0xa9, 0x58, 0xbd, 0x4e, 0xc5, 0x72, 0x5d, 0xca, 0x2d, 0xde, 0xa1,
+	0x2e, 0x53, 0xbb, 0xfa, 0x28, 0xaa, 0x4d, 0xba, 0xc4, 0x91, 0x9b, 0x6a, 0xef, 0xec, 0x68, 0x8f,
+	0xf4, 0x68, 0xb3, 0x6d, 0x52, 0x9f, 0x37, 0xa8, 0xef, 0xda, 0x26, 0xe3, 0x16, 0x27, 0xca, 0x2b,
+	0x82, 0xcb, 0xf1, 0xa8, 0xdf, 0x53, 0xe6, 0x13, 0xfd, 0x5d, 0xe6, 0x54, 0xc4, 0x8f, 0xc0, 0x80,
+	0xeb, 0x50, 0xfe, 0x58, 0xe0, 0xbe, 0x2b, 0x42, 0xdd, 0x69, 0x5b, 0xae, 0x43, 0xec, 0x47, 0x3d,
+	0xe2, 0x59, 0x9c, 0x7a, 0xf5, 0xb6, 0xe5, 0x11, 0x56, 0x77, 0xad, 0x1e, 0x6b, 0x53, 0x6e, 0x90,
+	0x27, 0x3e, 0x61, 0x1c, 0xad, 0xc1, 0x5c, 0x70, 0xaf, 0xeb, 0xef, 0x36, 0x88, 0xb7, 0xa8, 0xad,
+	0x6a, 0xeb, 0xd3, 0xc6, 0xac, 0xb4, 0x3d, 0x94, 0x26, 0xfc, 0x83, 0x06, 0x55, 0x19, 0xf5, 0x1e,
+	0xe1, 0x45, 0x02, 0xb3, 0x1e, 0x75, 0x19, 0x41, 0x6d, 0x58, 0x6d, 0x06, 0x8e, 0x26, 0x55, 0x9e,
+	0x26, 0x93, 0xae, 0x26, 0x53, 0xbe, 0x8b, 0xda, 0xea, 0xc1, 0xf5, 0xd9, 0xea, 0x52, 0x79, 0x48,
+	0xaf, 0x1c, 0x0f, 0xba, 0x35, 0xfd, 0xfc, 0xe5, 0xe9, 0x29, 0x63, 0xa5, 0x99, 0x77, 0x23, 0x5e,
+	0x80, 0xf9, 0x11, 0xeb, 0x6d, 0xb7, 0x45, 0x15, 0x39, 0x7c, 0x0b, 0x96, 0x62, 0xc0, 0x83, 0x3d,
+	0x85, 0x6f, 0x0d, 0xe6, 0x1a, 0x5d, 0xda, 0x7c, 0x9c, 0x60, 0x2e, 0x6d, 0x8a, 0xf9, 0xfb, 0x80,
+	0xe5, 0xf9, 0x47, 0xea, 0x4d, 0xea, 0xe2, 0x49, 0xb6, 0x06, 0x77, 0xda, 0x56, 0xc7, 0xdd, 0xae,
+	0x85, 0x29, 0x5c, 0x82, 0xa3, 0x4d, 0x61, 0x31, 0x3b, 0xb6, 0x0a, 0x72, 0x44, 0xae, 0xb7, 0x6d,
+	0xfc, 0xbb, 0x06, 0xe7, 0x43, 0x04, 0x59, 0x41, 0x14, 0x9c, 0x1a, 0x1c, 0x8f, 0x3f, 0xbd, 0x8c,
+	0x35, 0x5b, 0x5d, 0x89, 0x24, 0x47, 0x92, 0x88, 0xc5, 0x31, 0x8e, 0xd1, 0xe8, 0x12, 0x6d, 0xc2,
+	0xa9, 0xa6, 0xef, 0x79, 0xc4, 0xe5, 0x66, 0xec, 0x59, 0x0f, 0x48, 0x5c, 0x48, 0xed, 0xdd, 0x1d,
+	0xbd, 0xae, 0x40, 0xcf, 0x06, 0x6e, 0xd3, 0xec, 0x5a, 0xce, 0xe2, 0xc1, 0x00, 0xbd, 0x58, 0x3f,
+	0xb0, 0x1c, 0xdc, 0x87, 0x33, 0x12, 0x7c, 0x6d, 0xa7, 0x5e, 0xf7, 0x7b, 0x3d, 0xea, 0x71, 0x62,
+	0x4b, 0xd8, 0xe2, 0x2e, 0x9f, 0x85, 0xfc, 0xaf, 0xc1, 0x82, 0x47, 0x9c, 0x0e, 0xe3, 0xde, 0xc0,
+	0xf4, 0xa8, 0xcf, 0x89, 0x67, 0x5a, 0xb6, 0xed, 0x11, 0xc6, 0x24, 0x85, 0x19, 0x63, 0x3e, 0xdc,
+	0x36, 0xe4, 0xee, 0xed, 0x60, 0x33, 0x96, 0xb7, 0x03, 0xf1, 0xbc, 0xb5, 0xe1, 0x6c, 0xfe, 0xcd,
+	0x2a, 0x69, 0x1f, 0xa4, 0x26, 0xed, 0x78, 0xbc, 0xa2, 0x22, 0x09, 0xf2, 0x59, 0x22, 0x61, 0xf8,
+	0x06, 0x94, 0xc2, 0x9b, 0xee, 0x89, 0x2f, 0xab, 0x3e, 0x70, 0x9b, 0x71, 0x7a, 0x0b, 0x70, 0x84,
+	0xf7, 0xcd, 0xb6, 0xc5, 0xda, 0x8a, 0xce, 0x61, 0xde, 0xbf, 0x6f, 0xb1, 0x36, 0xbe, 0x0d, 0xa7,
+	0x33, 0x8f, 0x2a, 0x7c, 0x25, 0x98, 0x16, 0x5f, 0xa7, 0xaa, 0x73, 0x28, 0x8b, 0x45, 0xf9, 0x93,
+	0x5d, 0xe6, 0x18, 0xd2, 0x8e, 0x3f, 0x87, 0xb5, 0x74, 0x9e, 0x0f, 0x3a, 0x8c, 0xbf, 0x66, 0x7e,
+	0xf1, 0xa7, 0x80, 0xf3, 0x82, 0x2b, 0x88, 0x57, 0x61, 0xc6, 0x7e, 0xca, 0xcc, 0x8e, 0xdb, 0xa2,
+	0x4c, 0x95, 0xdc, 0x5b, 0x91, 0xec, 0xd5, 0x76, 0xea, 0xe2, 0xab, 0x91, 0x47, 0x8e, 0xda, 0x4f,
+	0x99, 0x58, 0x30, 0x7c, 0x66, 0x84, 0xdb, 0x88, 0xdd, 0x1d, 0xc1, 0x8d, 0x3d, 0xc0, 0x79, 0x4e,
+	0xea, 0xfe, 0x07, 0xf0, 0xff, 0x24, 0x3b, 0x46, 0xc2, 0xce, 0xb0, 0x1c, 0x41, 0x12, 0x8f, 0x51,
+	0x27, 0xdc, 0x38, 0xe9, 0x25, 0x4d, 0x78, 0x07, 0x96, 0x63, 0x6f, 0x52, 0xb3, 0xb8, 0xf5, 0x26,
+	0x72, 0xb9, 0x0d, 0x2b, 0x19, 0x71, 0x15, 0x8d, 0x75, 0x38, 0x2c, 0x3b, 0x33, 0x53, 0xc8, 0xff,
+	0x17, 0x41, 0x2e, 0x4f, 0x18, 0x6a, 0x1f, 0xb7, 0xe0, 0x72, 0x2c, 0x54, 0xd8, 0xd4, 0x14, 0x3f,
+	0x39, 0x1c, 0xde, 0x04, 0xe4, 0x67, 0x1a, 0x6c, 0x14, 0xbc, 0x48, 0x71, 0x68, 0xc0, 0xd2, 0xb0,
+	0x53, 0x07, 0x31, 0x89, 0x47, 0xec, 0x61, 0x69, 0x08, 0x5a, 0xe7, 0x93, 0xb4, 0xd2, 0x82, 0xee,
+	0x54, 0x8d, 0x05, 0x1a, 0xb3, 0x8b, 0x38, 0x41, 0xe5, 0x7c, 0xa9, 0x2a, 0x27, 0xde, 0xca, 0x6f,
+	0x07, 0x2d, 0x69, 0x72, 0x47, 0x45, 0x3a, 0x1c, 0x0d, 0x43, 0xcb, 0xa6, 0x31, 0x63, 0x0c, 0xd7,
+	0x63, 0xb3, 0xec, 0xe0, 0xf8, 0x2c, 0x7b, 0x02, 0xe7, 0x86, 0xfd, 0x38, 0x1d, 0x81, 0xca, 0xc5,
+	0x7d, 0x38, 0x91, 0x98, 0x5a, 0x45, 0x87, 0xd5, 0x71, 0x1a, 0xb3, 0xe2, 0xef, 0x34, 0xd5, 0x62,
+	0xe2, 0xde, 0xb5, 0x4e, 0xab, 0xf5, 0x9a, 0x7c, 0x57, 0x00, 0x5a, 0x1e, 0xdd, 0x0d, 0x3a, 0xbd,
+	0x62, 0x3b, 0x23, 0x2c, 0x92, 0x8a, 0x88, 0xca, 0xa9, 0xda, 0x9c, 0x0e, 0xa2, 0x72, 0x2a, 0xb7,
+	0xb0, 0x09, 0x38, 0x3d, 0x0d, 0x01, 0x2a, 0x95, 0x83, 0x1b, 0x70, 0xc8, 0xee, 0xb4, 0x5a, 0x21,
+	0xf3, 0x95, 0x4c, 0xe6, 0xe2, 0x94, 0x62, 0x1f, 0x9c, 0xc0, 0x3f, 0x6a, 0xea, 0x9d, 0x63, 0x45,
+	0x92, 0x7c, 0xe7, 0xff, 0x3a, 0x39, 0xd6, 0x60, 0x4e, 0x7e, 0x4c, 0xd1, 0xe9, 0x76, 0xcc, 0x98,
+	0x95, 0x36, 0x35, 0xd6, 0x0a, 0xd4, 0xc2, 0xee, 0xa8, 0x16, 0x32, 0x50, 0x0e, 0x47, 0xf3, 0x4c,
+	0x98, 0xf3, 0xfd, 0x7e, 0x07, 0xa3, 0x83, 0xd5, 0x6f, 0x4e, 0xc2, 0x21, 0x79, 0x1f, 0xfa, 0x47,
+	0x83, 0x73, 0x85, 0xb4, 0x14, 0xba, 0x11, 0xb9, 0x66, 0x7f, 0xc2, 0x4e, 0x7f, 0x2f, 0x79, 0x74,
+	0x5f, 0xea, 0x0d, 0xd7, 0xbe, 0xfe, 0xe3, 0xef, 0x67, 0x07, 0x6e, 0xa1, 0x9b, 0x95, 0x1e, 0xe9,
+	0x76, 0x37, 0x64, 0x39, 0x56, 0x92, 0x5a, 0x75, 0x92, 0xbe, 0x43, 0x5f, 0x69, 0x30, 0x17, 0x15,
+	0x5f, 0x68, 0x35, 0x95, 0x50, 0x44, 0xb3, 0xe9, 0x67, 0xb3, 0x70, 0x47, 0xc5, 0x1b, 0x5e, 0x97,
+	0xf0, 0x30, 0x5a, 0xcd, 0x83, 0x27, 0x1a, 0x17, 0xfa, 0x55, 0x83, 0xa5, 0x4c, 0xf5, 0x85, 0x36,
+	0x92, 0xb7, 0xe5, 0x4a, 0x3d, 0xfd, 0x4a, 0x0a, 0xb8, 0x7c, 0x5d, 0x87, 0xaf, 0x4b, 0xa4, 0x55,
+	0xb4, 0x99, 0x8e, 0x34, 0x2e, 0x5f, 0xcc, 0xc6, 0xc0, 0x0c, 0x3b, 0x01, 0xfa, 0x4d, 0x83, 0xe5,
+	0xf4, 0x01, 0x1e, 0xa8, 0x0c, 0x54, 0x4e, 0xa2, 0xc9, 0x17, 0x6a, 0x7a, 0xa5, 0xb0, 0x7f, 0x31,
+	0xec, 0x42, 0x37, 0xb0, 0xf0, 0xbc, 0xc2, 0xcd, 0x02, 0x68, 0x3f, 0x69, 0xb0, 0x90, 0x21, 0x8e,
+	0xd0, 0x85, 0x14, 0x18, 0xe9, 0xda, 0x4b, 0xbf, 0x58, 0xc4, 0x55, 0x81, 0xbd, 0x2a, 0xc1, 0x6e,
+	0xa0, 0x4b, 0xd9, 0x60, 0x83, 0xa6, 0x21, 0xe5, 0xae, 0xc2, 0xf9, 0xb3, 0x06, 0x7a, 0xb6, 0x48,
+	0x42, 0x97, 0x27, 0x66, 0x2c, 0x32, 0xa9, 0xf5, 0x8d, 0x82, 0xde, 0x0a, 0xf0, 0x35, 0x09, 0x78,
+	0x13, 0x95, 0x8b, 0x67, 0xb7, 0x2b, 0x40, 0x45, 0x31, 0x8f, 0x0b, 0xab, 0x54, 0xcc, 0x99, 0x22,
+	0x4d, 0xdf, 0x28, 0xe8, 0x5d, 0x1c, 0x73, 0xb2, 0xa3, 0x4b, 0xcc, 0xdf, 0x6b, 0x30, 0x1f, 0x7b,
+	0xc0, 0x50, 0x40, 0xa1, 0xb7, 0xb3, 0x9e, 0x38, 0x21, 0xdd, 0xf4, 0xf5, 0xc9, 0x8e, 0x0a, 0xe4,
+	0x15, 0x09, 0xf2, 0x12, 0xba, 0x30, 0xa9, 0x12, 0x6c, 0x8b, 0x5b, 0x01, 0xbe, 0x97, 0x1a, 0x9c,
+	0x8b, 0x05, 0xcd, 0x12, 0x4b, 0xe8, 0x9d, 0x2c, 0x18, 0x13, 0x74, 0x9c, 0x7e, 0x7d, 0xff, 0x07,
+	0x15, 0x9f, 0x2d, 0xc9, 0xe7, 0x26, 0x7a, 0x77, 0x12, 0x9f, 0x84, 0x7a, 0x0b, 0x42, 0x05, 0x04,
+	0x7f, 0xd1, 0x60, 0x31, 0x4b, 0xf4, 0x8c, 0x97, 0x4c, 0x9e, 0x3a, 0xd3, 0x37, 0xd3, 0x9a, 0x60,
+	0x9e, 0x98, 0x9a, 0x54, 0x35, 0xc9, 0xf1, 0x61, 0xa9, 0x3f, 0x5e, 0x45, 0x17, 0x99, 0x4f, 0x95,
+	0x28, 0xe3, 0x3d, 0x24, 0x53, 0x5c, 0x8d, 0x57, 0x78, 0xae, 0xe8, 0xc1, 0x55, 0x89, 0xf5, 0x32,
+	0xba, 0x58, 0x0c, 0xab, 0x90, 0x3b, 0x61, 0x72, 0x53, 0x55, 0xc4, 0x78, 0x72, 0xf3, 0x24, 0x51,
+	0x6a, 0x72, 0x73, 0xd5, 0xc9, 0xa4, 0xe4, 0xc6, 0x2b, 0x63, 0x94, 0xdc, 0xad, 0x0f, 0x9f, 0xbf,
+	0x2a, 0x69, 0x2f, 0x5e, 0x95, 0xb4, 0xbf, 0x5e, 0x95, 0xb4, 0x6f, 0xf7, 0x4a, 0x53, 0x2f, 0xf6,
+	0x4a, 0x53, 0x7f, 0xee, 0x95, 0xa6, 0x3e, 0xdb, 0x74, 0x3a, 0xbc, 0xed, 0x37, 0xca, 0x4d, 0xba,
+	0x5b, 0xd9, 0xec, 0x7f, 0x44, 0xba, 0xdd, 0x87, 0x84, 0x7f, 0x41, 0xbd, 0xc7, 0x15, 0x4b, 0xd4,
+	0x55, 0xa5, 0x1f, 0x09, 0xcf, 0x07, 0x3d, 0xc2, 0x1a, 0x87, 0xe5, 0xbf, 0x9f, 0xae, 0xfe, 0x3b,
+	0x00, 0x0d, 0x92, 0x3a, 0xe3, 0x40, 0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -974,6 +1344,12 @@ type QueryClient interface {
 	QueryDVSGroupDataList(ctx context.Context, in *QueryDVSGroupDataListRequest, opts ...grpc.CallOption) (*QueryDVSGroupDataListResponse, error)
 	// Queries group operator registration list
 	QueryDVSGroupOperatorRegistrationList(ctx context.Context, in *QueryDVSGroupOperatorRegistrationListRequest, opts ...grpc.CallOption) (*QueryDVSGroupOperatorRegistrationListResponse, error)
+	// Queries the operator shares at an epoch
+	GetOperatorSharesAtEpoch(ctx context.Context, in *QueryOperatorSharesAtEpochRequest, opts ...grpc.CallOption) (*QueryGetOperatorSharesAtEpochResponse, error)
+	// Queries the operator shares diff between two epochs
+	GetOperatorSharesDiff(ctx context.Context, in *QueryOperatorSharesDiffRequest, opts ...grpc.CallOption) (*QueryGetOperatorSharesDiffResponse, error)
+	// Queries the operator set of a group at an epoch
+	GetGroupOperatorsAtEpoch(ctx context.Context, in *QueryGroupOperatorsAtEpochRequest, opts ...grpc.CallOption) (*QueryGetGroupOperatorsAtEpochResponse, error)
 }
 
 type queryClient struct {
@@ -1065,6 +1441,33 @@ func (c *queryClient) QueryDVSGroupOperatorRegistrationList(ctx context.Context,
 	return out, nil
 }
 
+func (c *queryClient) GetOperatorSharesAtEpoch(ctx context.Context, in *QueryOperatorSharesAtEpochRequest, opts ...grpc.CallOption) (*QueryGetOperatorSharesAtEpochResponse, error) {
+	out := new(QueryGetOperatorSharesAtEpochResponse)
+	err := c.cc.Invoke(ctx, "/restaking.Query/GetOperatorSharesAtEpoch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetOperatorSharesDiff(ctx context.Context, in *QueryOperatorSharesDiffRequest, opts ...grpc.CallOption) (*QueryGetOperatorSharesDiffResponse, error) {
+	out := new(QueryGetOperatorSharesDiffResponse)
+	err := c.cc.Invoke(ctx, "/restaking.Query/GetOperatorSharesDiff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetGroupOperatorsAtEpoch(ctx context.Context, in *QueryGroupOperatorsAtEpochRequest, opts ...grpc.CallOption) (*QueryGetGroupOperatorsAtEpochResponse, error) {
+	out := new(QueryGetGroupOperatorsAtEpochResponse)
+	err := c.cc.Invoke(ctx, "/restaking.Query/GetGroupOperatorsAtEpoch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Queries SystemContract
@@ -1085,6 +1488,12 @@ type QueryServer interface {
 	QueryDVSGroupDataList(context.Context, *QueryDVSGroupDataListRequest) (*QueryDVSGroupDataListResponse, error)
 	// Queries group operator registration list
 	QueryDVSGroupOperatorRegistrationList(context.Context, *QueryDVSGroupOperatorRegistrationListRequest) (*QueryDVSGroupOperatorRegistrationListResponse, error)
+	// Queries the operator shares at an epoch
+	GetOperatorSharesAtEpoch(context.Context, *QueryOperatorSharesAtEpochRequest) (*QueryGetOperatorSharesAtEpochResponse, error)
+	// Queries the operator shares diff between two epochs
+	GetOperatorSharesDiff(context.Context, *QueryOperatorSharesDiffRequest) (*QueryGetOperatorSharesDiffResponse, error)
+	// Queries the operator set of a group at an epoch
+	GetGroupOperatorsAtEpoch(context.Context, *QueryGroupOperatorsAtEpochRequest) (*QueryGetGroupOperatorsAtEpochResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1118,6 +1527,15 @@ func (*UnimplementedQueryServer) QueryDVSGroupDataList(ctx context.Context, req
 func (*UnimplementedQueryServer) QueryDVSGroupOperatorRegistrationList(ctx context.Context, req *QueryDVSGroupOperatorRegistrationListRequest) (*QueryDVSGroupOperatorRegistrationListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryDVSGroupOperatorRegistrationList not implemented")
 }
+func (*UnimplementedQueryServer) GetOperatorSharesAtEpoch(ctx context.Context, req *QueryOperatorSharesAtEpochRequest) (*QueryGetOperatorSharesAtEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOperatorSharesAtEpoch not implemented")
+}
+func (*UnimplementedQueryServer) GetOperatorSharesDiff(ctx context.Context, req *QueryOperatorSharesDiffRequest) (*QueryGetOperatorSharesDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOperatorSharesDiff not implemented")
+}
+func (*UnimplementedQueryServer) GetGroupOperatorsAtEpoch(ctx context.Context, req *QueryGroupOperatorsAtEpochRequest) (*QueryGetGroupOperatorsAtEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupOperatorsAtEpoch not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1285,34 +1703,88 @@ func _Query_QueryDVSGroupOperatorRegistrationList_Handler(srv interface{}, ctx c
 	return interceptor(ctx, in, info, handler)
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "restaking.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetEpochChangedOperatorSharesSnapshot",
-			Handler:    _Query_GetEpochChangedOperatorSharesSnapshot_Handler,
-		},
-		{
-			MethodName: "GetEpochInfo",
-			Handler:    _Query_GetEpochInfo_Handler,
-		},
-		{
-			MethodName: "GetOutboundStateByChainID",
-			Handler:    _Query_GetOutboundStateByChainID_Handler,
-		},
-		{
-			MethodName: "QueryDVSSupportedChainStatus",
-			Handler:    _Query_QueryDVSSupportedChainStatus_Handler,
-		},
-		{
-			MethodName: "QueryDVSGroupSyncStatus",
-			Handler:    _Query_QueryDVSGroupSyncStatus_Handler,
-		},
-		{
-			MethodName: "QueryDVSSupportedChainList",
-			Handler:    _Query_QueryDVSSupportedChainList_Handler,
+func _Query_GetOperatorSharesAtEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOperatorSharesAtEpochRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetOperatorSharesAtEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/restaking.Query/GetOperatorSharesAtEpoch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetOperatorSharesAtEpoch(ctx, req.(*QueryOperatorSharesAtEpochRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetOperatorSharesDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOperatorSharesDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetOperatorSharesDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/restaking.Query/GetOperatorSharesDiff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetOperatorSharesDiff(ctx, req.(*QueryOperatorSharesDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetGroupOperatorsAtEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGroupOperatorsAtEpochRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetGroupOperatorsAtEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/restaking.Query/GetGroupOperatorsAtEpoch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetGroupOperatorsAtEpoch(ctx, req.(*QueryGroupOperatorsAtEpochRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "restaking.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetEpochChangedOperatorSharesSnapshot",
+			Handler:    _Query_GetEpochChangedOperatorSharesSnapshot_Handler,
+		},
+		{
+			MethodName: "GetEpochInfo",
+			Handler:    _Query_GetEpochInfo_Handler,
+		},
+		{
+			MethodName: "GetOutboundStateByChainID",
+			Handler:    _Query_GetOutboundStateByChainID_Handler,
+		},
+		{
+			MethodName: "QueryDVSSupportedChainStatus",
+			Handler:    _Query_QueryDVSSupportedChainStatus_Handler,
+		},
+		{
+			MethodName: "QueryDVSGroupSyncStatus",
+			Handler:    _Query_QueryDVSGroupSyncStatus_Handler,
+		},
+		{
+			MethodName: "QueryDVSSupportedChainList",
+			Handler:    _Query_QueryDVSSupportedChainList_Handler,
 		},
 		{
 			MethodName: "QueryDVSRegistryRouterList",
@@ -1326,6 +1798,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "QueryDVSGroupOperatorRegistrationList",
 			Handler:    _Query_QueryDVSGroupOperatorRegistrationList_Handler,
 		},
+		{
+			MethodName: "GetOperatorSharesAtEpoch",
+			Handler:    _Query_GetOperatorSharesAtEpoch_Handler,
+		},
+		{
+			MethodName: "GetOperatorSharesDiff",
+			Handler:    _Query_GetOperatorSharesDiff_Handler,
+		},
+		{
+			MethodName: "GetGroupOperatorsAtEpoch",
+			Handler:    _Query_GetGroupOperatorsAtEpoch_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "restaking/query.proto",
@@ -1495,6 +1979,16 @@ func (m *QueryGetOutboundStateByChainIDResponse) MarshalToSizedBuffer(dAtA []byt
 	_ = i
 	var l int
 	_ = l
+	if m.SyncLag != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SyncLag))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CurrentEpochNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentEpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.OutboundState != nil {
 		{
 			size, err := m.OutboundState.MarshalToSizedBuffer(dAtA[:i])
@@ -1899,136 +2393,378 @@ func (m *QueryDVSGroupOperatorRegistrationListResponse) MarshalToSizedBuffer(dAt
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryOperatorSharesAtEpochRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryEpochChangedOperatorSharesSnapshotRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryOperatorSharesAtEpochRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperatorSharesAtEpochRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.EpochNumber != 0 {
-		n += 1 + sovQuery(uint64(m.EpochNumber))
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
-}
-
-func (m *QueryGetEpochChangedOperatorSharesSnapshotResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	if len(m.ChangedOperatorSharesSnapshot) > 0 {
-		for _, e := range m.ChangedOperatorSharesSnapshot {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryEpochInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetOperatorSharesAtEpochResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetEpochInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BlockNumber != 0 {
-		n += 1 + sovQuery(uint64(m.BlockNumber))
-	}
-	return n
+func (m *QueryGetOperatorSharesAtEpochResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryOutboundStateByChainIDRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetOperatorSharesAtEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
+	if len(m.OperatorShares) > 0 {
+		for iNdEx := len(m.OperatorShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OperatorShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetOutboundStateByChainIDResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.OutboundState != nil {
-		l = m.OutboundState.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryOperatorSharesDiffRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryDVSSupportedChainStatusRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryOperatorSharesDiffRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperatorSharesDiffRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RegistryRouterAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ToEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FromEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
 	}
 	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDVSSupportedChainStatusResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.OutboundState != 0 {
-		n += 1 + sovQuery(uint64(m.OutboundState))
+func (m *QueryGetOperatorSharesDiffResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryDVSGroupSyncStatusRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetOperatorSharesDiffResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetOperatorSharesDiffResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.TxHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Diffs) > 0 {
+		for iNdEx := len(m.Diffs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Diffs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDVSGroupSyncStatusResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
+func (m *QueryGroupOperatorsAtEpochRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupOperatorsAtEpochRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupOperatorsAtEpochRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GroupNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GroupNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RegistryRouterAddress) > 0 {
+		i -= len(m.RegistryRouterAddress)
+		copy(dAtA[i:], m.RegistryRouterAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RegistryRouterAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetGroupOperatorsAtEpochResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetGroupOperatorsAtEpochResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetGroupOperatorsAtEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operators) > 0 {
+		for iNdEx := len(m.Operators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Operators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryEpochChangedOperatorSharesSnapshotRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNumber))
+	}
+	return n
+}
+
+func (m *QueryGetEpochChangedOperatorSharesSnapshotResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ChangedOperatorSharesSnapshot) > 0 {
+		for _, e := range m.ChangedOperatorSharesSnapshot {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEpochInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetEpochInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockNumber != 0 {
+		n += 1 + sovQuery(uint64(m.BlockNumber))
+	}
+	return n
+}
+
+func (m *QueryOutboundStateByChainIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *QueryGetOutboundStateByChainIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OutboundState != nil {
+		l = m.OutboundState.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CurrentEpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentEpochNumber))
+	}
+	if m.SyncLag != 0 {
+		n += 1 + sovQuery(uint64(m.SyncLag))
+	}
+	return n
+}
+
+func (m *QueryDVSSupportedChainStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RegistryRouterAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *QueryDVSSupportedChainStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OutboundState != 0 {
+		n += 1 + sovQuery(uint64(m.OutboundState))
+	}
+	return n
+}
+
+func (m *QueryDVSGroupSyncStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDVSGroupSyncStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	if len(m.Xmsg) > 0 {
 		for _, e := range m.Xmsg {
@@ -2145,46 +2881,547 @@ func (m *QueryDVSGroupOperatorRegistrationListResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryOperatorSharesAtEpochRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNumber))
+	}
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryGetOperatorSharesAtEpochResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OperatorShares) > 0 {
+		for _, e := range m.OperatorShares {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
 }
-func (m *QueryEpochChangedOperatorSharesSnapshotRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+
+func (m *QueryOperatorSharesDiffRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FromEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.FromEpoch))
+	}
+	if m.ToEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.ToEpoch))
+	}
+	return n
+}
+
+func (m *QueryGetOperatorSharesDiffResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Diffs) > 0 {
+		for _, e := range m.Diffs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGroupOperatorsAtEpochRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RegistryRouterAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GroupNumber != 0 {
+		n += 1 + sovQuery(uint64(m.GroupNumber))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNumber))
+	}
+	return n
+}
+
+func (m *QueryGetGroupOperatorsAtEpochResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Operators) > 0 {
+		for _, e := range m.Operators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryEpochChangedOperatorSharesSnapshotRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochChangedOperatorSharesSnapshotRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochChangedOperatorSharesSnapshotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetEpochChangedOperatorSharesSnapshotResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetEpochChangedOperatorSharesSnapshotResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetEpochChangedOperatorSharesSnapshotResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangedOperatorSharesSnapshot", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChangedOperatorSharesSnapshot = append(m.ChangedOperatorSharesSnapshot, OperatorShares{})
+			if err := m.ChangedOperatorSharesSnapshot[len(m.ChangedOperatorSharesSnapshot)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetEpochInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetEpochInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetEpochInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOutboundStateByChainIDRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOutboundStateByChainIDRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOutboundStateByChainIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetOutboundStateByChainIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetOutboundStateByChainIDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetOutboundStateByChainIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OutboundState == nil {
+				m.OutboundState = &EpochOutboundState{}
+			}
+			if err := m.OutboundState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpochNumber", wireType)
+			}
+			m.CurrentEpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentEpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEpochChangedOperatorSharesSnapshotRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEpochChangedOperatorSharesSnapshotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncLag", wireType)
 			}
-			m.EpochNumber = 0
+			m.SyncLag = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2194,7 +3431,7 @@ func (m *QueryEpochChangedOperatorSharesSnapshotRequest) Unmarshal(dAtA []byte)
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochNumber |= uint64(b&0x7F) << shift
+				m.SyncLag |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2220,7 +3457,7 @@ func (m *QueryEpochChangedOperatorSharesSnapshotRequest) Unmarshal(dAtA []byte)
 	}
 	return nil
 }
-func (m *QueryGetEpochChangedOperatorSharesSnapshotResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDVSSupportedChainStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2243,17 +3480,17 @@ func (m *QueryGetEpochChangedOperatorSharesSnapshotResponse) Unmarshal(dAtA []by
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetEpochChangedOperatorSharesSnapshotResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDVSSupportedChainStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetEpochChangedOperatorSharesSnapshotResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDVSSupportedChainStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChangedOperatorSharesSnapshot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistryRouterAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2263,26 +3500,43 @@ func (m *QueryGetEpochChangedOperatorSharesSnapshotResponse) Unmarshal(dAtA []by
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChangedOperatorSharesSnapshot = append(m.ChangedOperatorSharesSnapshot, OperatorShares{})
-			if err := m.ChangedOperatorSharesSnapshot[len(m.ChangedOperatorSharesSnapshot)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RegistryRouterAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2304,7 +3558,7 @@ func (m *QueryGetEpochChangedOperatorSharesSnapshotResponse) Unmarshal(dAtA []by
 	}
 	return nil
 }
-func (m *QueryEpochInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDVSSupportedChainStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2327,12 +3581,31 @@ func (m *QueryEpochInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEpochInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDVSSupportedChainStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEpochInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDVSSupportedChainStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundState", wireType)
+			}
+			m.OutboundState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutboundState |= OutboundStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2354,7 +3627,7 @@ func (m *QueryEpochInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetEpochInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDVSGroupSyncStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2377,17 +3650,17 @@ func (m *QueryGetEpochInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetEpochInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDVSGroupSyncStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetEpochInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDVSGroupSyncStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
 			}
-			m.BlockNumber = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2397,11 +3670,24 @@ func (m *QueryGetEpochInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockNumber |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2423,7 +3709,7 @@ func (m *QueryGetEpochInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOutboundStateByChainIDRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDVSGroupSyncStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2446,17 +3732,17 @@ func (m *QueryOutboundStateByChainIDRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOutboundStateByChainIDRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDVSGroupSyncStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOutboundStateByChainIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDVSGroupSyncStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Xmsg", wireType)
 			}
-			m.ChainId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2466,11 +3752,26 @@ func (m *QueryOutboundStateByChainIDRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Xmsg = append(m.Xmsg, &types.Xmsg{})
+			if err := m.Xmsg[len(m.Xmsg)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2492,7 +3793,7 @@ func (m *QueryOutboundStateByChainIDRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetOutboundStateByChainIDResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDVSSupportedChainListRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2515,17 +3816,17 @@ func (m *QueryGetOutboundStateByChainIDResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetOutboundStateByChainIDResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDVSSupportedChainListRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetOutboundStateByChainIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDVSSupportedChainListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutboundState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistryRouterAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2535,27 +3836,23 @@ func (m *QueryGetOutboundStateByChainIDResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OutboundState == nil {
-				m.OutboundState = &EpochOutboundState{}
-			}
-			if err := m.OutboundState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RegistryRouterAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2578,7 +3875,7 @@ func (m *QueryGetOutboundStateByChainIDResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDVSSupportedChainStatusRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDVSSupportedChainListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2601,17 +3898,17 @@ func (m *QueryDVSSupportedChainStatusRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDVSSupportedChainStatusRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDVSSupportedChainListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDVSSupportedChainStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDVSSupportedChainListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegistryRouterAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DvsInfos", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2621,43 +3918,78 @@ func (m *QueryDVSSupportedChainStatusRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RegistryRouterAddress = string(dAtA[iNdEx:postIndex])
+			if m.DvsInfos == nil {
+				m.DvsInfos = &DVSInfoList{}
+			}
+			if err := m.DvsInfos.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.ChainId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChainId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDVSRegistryRouterListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDVSRegistryRouterListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDVSRegistryRouterListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2679,7 +4011,7 @@ func (m *QueryDVSSupportedChainStatusRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDVSSupportedChainStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDVSRegistryRouterListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2702,17 +4034,17 @@ func (m *QueryDVSSupportedChainStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDVSSupportedChainStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDVSRegistryRouterListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDVSSupportedChainStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDVSRegistryRouterListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutboundState", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistryRouterSet", wireType)
 			}
-			m.OutboundState = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2722,11 +4054,26 @@ func (m *QueryDVSSupportedChainStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OutboundState |= OutboundStatus(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegistryRouterSet = append(m.RegistryRouterSet, &RegistryRouterSet{})
+			if err := m.RegistryRouterSet[len(m.RegistryRouterSet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2748,7 +4095,7 @@ func (m *QueryDVSSupportedChainStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDVSGroupSyncStatusRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDVSGroupDataListRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2771,15 +4118,15 @@ func (m *QueryDVSGroupSyncStatusRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDVSGroupSyncStatusRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDVSGroupDataListRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDVSGroupSyncStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDVSGroupDataListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistryRouterAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2807,7 +4154,7 @@ func (m *QueryDVSGroupSyncStatusRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxHash = string(dAtA[iNdEx:postIndex])
+			m.RegistryRouterAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2830,7 +4177,7 @@ func (m *QueryDVSGroupSyncStatusRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDVSGroupSyncStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDVSGroupDataListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2853,15 +4200,15 @@ func (m *QueryDVSGroupSyncStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDVSGroupSyncStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDVSGroupDataListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDVSGroupSyncStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDVSGroupDataListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Xmsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2888,8 +4235,8 @@ func (m *QueryDVSGroupSyncStatusResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Xmsg = append(m.Xmsg, &types.Xmsg{})
-			if err := m.Xmsg[len(m.Xmsg)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Groups = append(m.Groups, &Group{})
+			if err := m.Groups[len(m.Groups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2914,7 +4261,7 @@ func (m *QueryDVSGroupSyncStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDVSSupportedChainListRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDVSGroupOperatorRegistrationListRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2937,10 +4284,10 @@ func (m *QueryDVSSupportedChainListRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDVSSupportedChainListRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDVSGroupOperatorRegistrationListRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDVSSupportedChainListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDVSGroupOperatorRegistrationListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2996,7 +4343,7 @@ func (m *QueryDVSSupportedChainListRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDVSSupportedChainListResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDVSGroupOperatorRegistrationListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3019,15 +4366,15 @@ func (m *QueryDVSSupportedChainListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDVSSupportedChainListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDVSGroupOperatorRegistrationListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDVSSupportedChainListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDVSGroupOperatorRegistrationListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DvsInfos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorRegisteredInfos", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3054,10 +4401,8 @@ func (m *QueryDVSSupportedChainListResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DvsInfos == nil {
-				m.DvsInfos = &DVSInfoList{}
-			}
-			if err := m.DvsInfos.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OperatorRegisteredInfos = append(m.OperatorRegisteredInfos, &GroupOperatorRegistrationV2{})
+			if err := m.OperatorRegisteredInfos[len(m.OperatorRegisteredInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3082,7 +4427,7 @@ func (m *QueryDVSSupportedChainListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDVSRegistryRouterListRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOperatorSharesAtEpochRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3105,12 +4450,82 @@ func (m *QueryDVSRegistryRouterListRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDVSRegistryRouterListRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOperatorSharesAtEpochRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDVSRegistryRouterListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOperatorSharesAtEpochRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3132,7 +4547,7 @@ func (m *QueryDVSRegistryRouterListRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDVSRegistryRouterListResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetOperatorSharesAtEpochResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3155,15 +4570,15 @@ func (m *QueryDVSRegistryRouterListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDVSRegistryRouterListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetOperatorSharesAtEpochResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDVSRegistryRouterListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetOperatorSharesAtEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegistryRouterSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorShares", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3190,8 +4605,8 @@ func (m *QueryDVSRegistryRouterListResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RegistryRouterSet = append(m.RegistryRouterSet, &RegistryRouterSet{})
-			if err := m.RegistryRouterSet[len(m.RegistryRouterSet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OperatorShares = append(m.OperatorShares, OperatorShares{})
+			if err := m.OperatorShares[len(m.OperatorShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3216,7 +4631,7 @@ func (m *QueryDVSRegistryRouterListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDVSGroupDataListRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOperatorSharesDiffRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3239,15 +4654,34 @@ func (m *QueryDVSGroupDataListRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDVSGroupDataListRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOperatorSharesDiffRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDVSGroupDataListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOperatorSharesDiffRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegistryRouterAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3275,8 +4709,46 @@ func (m *QueryDVSGroupDataListRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RegistryRouterAddress = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromEpoch", wireType)
+			}
+			m.FromEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToEpoch", wireType)
+			}
+			m.ToEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3298,7 +4770,7 @@ func (m *QueryDVSGroupDataListRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDVSGroupDataListResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetOperatorSharesDiffResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3321,15 +4793,15 @@ func (m *QueryDVSGroupDataListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDVSGroupDataListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetOperatorSharesDiffResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDVSGroupDataListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetOperatorSharesDiffResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Diffs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3356,8 +4828,8 @@ func (m *QueryDVSGroupDataListResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Groups = append(m.Groups, &Group{})
-			if err := m.Groups[len(m.Groups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Diffs = append(m.Diffs, OperatorSharesDiff{})
+			if err := m.Diffs[len(m.Diffs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3382,7 +4854,7 @@ func (m *QueryDVSGroupDataListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDVSGroupOperatorRegistrationListRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGroupOperatorsAtEpochRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3405,10 +4877,10 @@ func (m *QueryDVSGroupOperatorRegistrationListRequest) Unmarshal(dAtA []byte) er
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDVSGroupOperatorRegistrationListRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGroupOperatorsAtEpochRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDVSGroupOperatorRegistrationListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGroupOperatorsAtEpochRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3443,6 +4915,44 @@ func (m *QueryDVSGroupOperatorRegistrationListRequest) Unmarshal(dAtA []byte) er
 			}
 			m.RegistryRouterAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupNumber", wireType)
+			}
+			m.GroupNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupNumber |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3464,7 +4974,7 @@ func (m *QueryDVSGroupOperatorRegistrationListRequest) Unmarshal(dAtA []byte) er
 	}
 	return nil
 }
-func (m *QueryDVSGroupOperatorRegistrationListResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetGroupOperatorsAtEpochResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3487,15 +4997,15 @@ func (m *QueryDVSGroupOperatorRegistrationListResponse) Unmarshal(dAtA []byte) e
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDVSGroupOperatorRegistrationListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetGroupOperatorsAtEpochResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDVSGroupOperatorRegistrationListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetGroupOperatorsAtEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorRegisteredInfos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operators", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3522,8 +5032,8 @@ func (m *QueryDVSGroupOperatorRegistrationListResponse) Unmarshal(dAtA []byte) e
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorRegisteredInfos = append(m.OperatorRegisteredInfos, &GroupOperatorRegistrationV2{})
-			if err := m.OperatorRegisteredInfos[len(m.OperatorRegisteredInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Operators = append(m.Operators, &GroupOperatorRegistrationV2{})
+			if err := m.Operators[len(m.Operators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -208,40 +208,139 @@ func (m *MsgUpdateBlocksPerEpochResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateBlocksPerEpochResponse proto.InternalMessageInfo
 
+// update the number of epochs the operator shares history is retained, 0 keeps
+// the history forever
+type MsgUpdateSharesHistoryRetention struct {
+	Signer          string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	RetentionEpochs uint64 `protobuf:"varint,2,opt,name=retention_epochs,json=retentionEpochs,proto3" json:"retention_epochs,omitempty"`
+}
+
+func (m *MsgUpdateSharesHistoryRetention) Reset()         { *m = MsgUpdateSharesHistoryRetention{} }
+func (m *MsgUpdateSharesHistoryRetention) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateSharesHistoryRetention) ProtoMessage()    {}
+func (*MsgUpdateSharesHistoryRetention) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de56f1478c808cfe, []int{4}
+}
+func (m *MsgUpdateSharesHistoryRetention) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateSharesHistoryRetention) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateSharesHistoryRetention.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateSharesHistoryRetention) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateSharesHistoryRetention.Merge(m, src)
+}
+func (m *MsgUpdateSharesHistoryRetention) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateSharesHistoryRetention) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateSharesHistoryRetention.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateSharesHistoryRetention proto.InternalMessageInfo
+
+func (m *MsgUpdateSharesHistoryRetention) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgUpdateSharesHistoryRetention) GetRetentionEpochs() uint64 {
+	if m != nil {
+		return m.RetentionEpochs
+	}
+	return 0
+}
+
+// update shares history retention response
+type MsgUpdateSharesHistoryRetentionResponse struct {
+}
+
+func (m *MsgUpdateSharesHistoryRetentionResponse) Reset() {
+	*m = MsgUpdateSharesHistoryRetentionResponse{}
+}
+func (m *MsgUpdateSharesHistoryRetentionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateSharesHistoryRetentionResponse) ProtoMessage()    {}
+func (*MsgUpdateSharesHistoryRetentionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de56f1478c808cfe, []int{5}
+}
+func (m *MsgUpdateSharesHistoryRetentionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateSharesHistoryRetentionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateSharesHistoryRetentionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateSharesHistoryRetentionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateSharesHistoryRetentionResponse.Merge(m, src)
+}
+func (m *MsgUpdateSharesHistoryRetentionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateSharesHistoryRetentionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateSharesHistoryRetentionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateSharesHistoryRetentionResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpsertOutboundState)(nil), "restaking.MsgUpsertOutboundState")
 	proto.RegisterType((*MsgUpsertOutboundStateResponse)(nil), "restaking.MsgUpsertOutboundStateResponse")
 	proto.RegisterType((*MsgUpdateBlocksPerEpoch)(nil), "restaking.MsgUpdateBlocksPerEpoch")
 	proto.RegisterType((*MsgUpdateBlocksPerEpochResponse)(nil), "restaking.MsgUpdateBlocksPerEpochResponse")
+	proto.RegisterType((*MsgUpdateSharesHistoryRetention)(nil), "restaking.MsgUpdateSharesHistoryRetention")
+	proto.RegisterType((*MsgUpdateSharesHistoryRetentionResponse)(nil), "restaking.MsgUpdateSharesHistoryRetentionResponse")
 }
 
 func init() { proto.RegisterFile("restaking/tx.proto", fileDescriptor_de56f1478c808cfe) }
 
 var fileDescriptor_de56f1478c808cfe = []byte{
-	// 358 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x2a, 0x4a, 0x2d, 0x2e,
-	0x49, 0xcc, 0xce, 0xcc, 0x4b, 0xd7, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2,
-	0x84, 0x8b, 0x49, 0x89, 0x27, 0xe7, 0x17, 0xe7, 0xe6, 0x17, 0xeb, 0xe7, 0x16, 0xa7, 0xeb, 0x97,
-	0x19, 0x82, 0x28, 0x88, 0x1a, 0x29, 0x15, 0x84, 0xbe, 0xd4, 0x82, 0xfc, 0xe4, 0x8c, 0xf8, 0xfc,
-	0xd2, 0x92, 0xa4, 0xfc, 0xd2, 0xbc, 0x94, 0xf8, 0xe2, 0x92, 0xc4, 0x92, 0x54, 0x88, 0x2a, 0xa5,
-	0x66, 0x46, 0x2e, 0x31, 0xdf, 0xe2, 0xf4, 0xd0, 0x82, 0xe2, 0xd4, 0xa2, 0x12, 0x7f, 0xa8, 0x8a,
-	0x60, 0x90, 0x02, 0x21, 0x31, 0x2e, 0xb6, 0xe2, 0xcc, 0xf4, 0xbc, 0xd4, 0x22, 0x09, 0x46, 0x05,
-	0x46, 0x0d, 0xce, 0x20, 0x28, 0x4f, 0xc8, 0x85, 0x8b, 0x0f, 0xd5, 0x28, 0x09, 0x26, 0x05, 0x46,
-	0x0d, 0x6e, 0x23, 0x59, 0x3d, 0xb8, 0x8d, 0x7a, 0xae, 0x20, 0x1b, 0x51, 0x8c, 0x0b, 0xe2, 0xcd,
-	0x47, 0xe6, 0x5a, 0x71, 0x37, 0x3d, 0xdf, 0xa0, 0x05, 0x35, 0x52, 0x49, 0x81, 0x4b, 0x0e, 0xbb,
-	0x23, 0x82, 0x52, 0x8b, 0x0b, 0xf2, 0xf3, 0x8a, 0x53, 0x95, 0x32, 0xb8, 0xc4, 0xc1, 0x2a, 0x52,
-	0x12, 0x4b, 0x52, 0x9d, 0x72, 0xf2, 0x93, 0xb3, 0x8b, 0x03, 0x52, 0x8b, 0xc0, 0xb6, 0xe0, 0x74,
-	0xa7, 0x06, 0x97, 0x40, 0x12, 0x58, 0x65, 0x7c, 0x41, 0x6a, 0x51, 0x3c, 0x38, 0x0c, 0xc0, 0x2e,
-	0x65, 0x09, 0xe2, 0x4b, 0x42, 0x31, 0x01, 0xd5, 0x2d, 0x8a, 0x5c, 0xf2, 0x38, 0x6c, 0x82, 0x39,
-	0xc6, 0xe8, 0x12, 0x23, 0x17, 0xb3, 0x6f, 0x71, 0xba, 0x50, 0x32, 0x97, 0x30, 0xb6, 0x80, 0x53,
-	0x44, 0x0a, 0x08, 0xec, 0xde, 0x92, 0xd2, 0x24, 0xa8, 0x04, 0x66, 0x99, 0x50, 0x1a, 0x97, 0x08,
-	0x56, 0x6f, 0x2b, 0xa1, 0x1b, 0x81, 0xa9, 0x46, 0x4a, 0x8b, 0xb0, 0x1a, 0x98, 0x3d, 0x4e, 0x5e,
-	0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72,
-	0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x65, 0x90, 0x9e, 0x59, 0x92, 0x51,
-	0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x6f, 0x50, 0x11, 0x90, 0x9a, 0x93, 0xe3, 0x97, 0x5a, 0x52,
-	0x9e, 0x5f, 0x94, 0xad, 0x9f, 0x98, 0x9a, 0x9e, 0x59, 0xac, 0x5f, 0xa1, 0x8f, 0x94, 0x44, 0x2b,
-	0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x89, 0xcb, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x12, 0x15,
-	0x39, 0x9c, 0xbc, 0x02, 0x00, 0x00,
+	// 420 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xc1, 0x8e, 0xd2, 0x50,
+	0x14, 0x86, 0x29, 0x1a, 0x12, 0x2e, 0x11, 0x49, 0x35, 0x40, 0x1a, 0xad, 0xd0, 0x98, 0x08, 0x2c,
+	0x5a, 0xc4, 0x9d, 0x4b, 0xa2, 0x89, 0x31, 0x41, 0x49, 0x89, 0x1b, 0x37, 0x4d, 0x5b, 0x8e, 0x6d,
+	0x03, 0xf4, 0xd6, 0x7b, 0x2e, 0x0a, 0x1b, 0x17, 0xfa, 0x02, 0x3e, 0x8a, 0x8f, 0xe1, 0x92, 0xcc,
+	0x6a, 0x96, 0x13, 0x58, 0xcc, 0x6b, 0x4c, 0x28, 0x6d, 0xa7, 0x9d, 0x29, 0xc3, 0xac, 0x9a, 0x7b,
+	0xf3, 0xf5, 0xff, 0xbf, 0x9c, 0x93, 0x4b, 0x44, 0x06, 0xc8, 0xcd, 0x99, 0xe7, 0x3b, 0x1a, 0x5f,
+	0xa9, 0x01, 0xa3, 0x9c, 0x8a, 0xe5, 0xe4, 0x4e, 0x6a, 0xd8, 0x14, 0x17, 0x14, 0xb5, 0x05, 0x3a,
+	0xda, 0x8f, 0xd7, 0xfb, 0xcf, 0x81, 0x91, 0x5e, 0x5e, 0xff, 0x07, 0x01, 0xb5, 0x5d, 0x83, 0x2e,
+	0xb9, 0x45, 0x97, 0xfe, 0xd4, 0x40, 0x6e, 0x72, 0x38, 0x50, 0xca, 0x1f, 0x81, 0xd4, 0x47, 0xe8,
+	0x7c, 0x09, 0x10, 0x18, 0xff, 0x1c, 0x11, 0x93, 0x3d, 0x20, 0xd6, 0x49, 0x09, 0x3d, 0xc7, 0x07,
+	0xd6, 0x14, 0x5a, 0x42, 0xa7, 0xac, 0x47, 0x27, 0xf1, 0x1d, 0xa9, 0x66, 0xa3, 0x9a, 0xc5, 0x96,
+	0xd0, 0xa9, 0x0c, 0x9e, 0xab, 0x49, 0xa3, 0xfa, 0x7e, 0xdf, 0x98, 0x89, 0xd3, 0x1f, 0xd1, 0xf4,
+	0xf1, 0x6d, 0xe5, 0xf7, 0xe5, 0xbf, 0x5e, 0x14, 0xa9, 0xb4, 0x88, 0x9c, 0x2f, 0xa1, 0x03, 0x06,
+	0xd4, 0x47, 0x50, 0x5c, 0xd2, 0x08, 0x89, 0xa9, 0xc9, 0x61, 0x38, 0xa7, 0xf6, 0x0c, 0xc7, 0xc0,
+	0xc2, 0x96, 0xa3, 0x9e, 0x1d, 0x52, 0xb3, 0x42, 0xd2, 0x08, 0x80, 0x19, 0xe1, 0x0c, 0x42, 0xd3,
+	0x87, 0x7a, 0xd5, 0xca, 0x24, 0x64, 0x5d, 0xda, 0xe4, 0xc5, 0x91, 0xa6, 0x44, 0xe6, 0x7b, 0x0a,
+	0x99, 0xb8, 0x26, 0x03, 0xfc, 0xe0, 0x21, 0xa7, 0x6c, 0xad, 0x03, 0x07, 0x9f, 0x7b, 0xd4, 0x3f,
+	0x2a, 0xd5, 0x25, 0x35, 0x16, 0x43, 0x07, 0x27, 0x8c, 0xa4, 0x1e, 0x27, 0xf7, 0x61, 0x19, 0x66,
+	0xad, 0xba, 0xe4, 0xd5, 0x89, 0xca, 0xd8, 0x6e, 0x70, 0x56, 0x24, 0x0f, 0x46, 0xe8, 0x88, 0x36,
+	0x79, 0x92, 0xb7, 0xd6, 0x76, 0x6a, 0x4d, 0xf9, 0x43, 0x97, 0xba, 0x27, 0x91, 0xb8, 0x4c, 0xfc,
+	0x46, 0x9e, 0xe6, 0x2e, 0x45, 0xb9, 0x19, 0x71, 0x9b, 0x91, 0x7a, 0xa7, 0x99, 0xa4, 0xe7, 0x17,
+	0x79, 0x76, 0xe7, 0xbc, 0x73, 0xb3, 0xf2, 0x59, 0x69, 0x70, 0x7f, 0x36, 0xee, 0x1f, 0x7e, 0xfc,
+	0xbf, 0x95, 0x85, 0xcd, 0x56, 0x16, 0x2e, 0xb6, 0xb2, 0xf0, 0x77, 0x27, 0x17, 0x36, 0x3b, 0xb9,
+	0x70, 0xbe, 0x93, 0x0b, 0x5f, 0xfb, 0x8e, 0xc7, 0xdd, 0xa5, 0xa5, 0xda, 0x74, 0xa1, 0xf5, 0x57,
+	0x63, 0x98, 0xcf, 0x3f, 0x01, 0xff, 0x49, 0xd9, 0x4c, 0x33, 0xc1, 0xf1, 0x50, 0x5b, 0x69, 0xa9,
+	0x07, 0xbc, 0x0e, 0x00, 0xad, 0x52, 0xf8, 0xf4, 0xde, 0x5c, 0x0d, 0x00, 0x6d, 0x4a, 0x8b, 0x84,
+	0xda, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -260,6 +359,8 @@ type MsgClient interface {
 	UpsertOutboundState(ctx context.Context, in *MsgUpsertOutboundState, opts ...grpc.CallOption) (*MsgUpsertOutboundStateResponse, error)
 	// update blocks per epoch
 	UpdateBlocksPerEpoch(ctx context.Context, in *MsgUpdateBlocksPerEpoch, opts ...grpc.CallOption) (*MsgUpdateBlocksPerEpochResponse, error)
+	// update the number of epochs the operator shares history is retained
+	UpdateSharesHistoryRetention(ctx context.Context, in *MsgUpdateSharesHistoryRetention, opts ...grpc.CallOption) (*MsgUpdateSharesHistoryRetentionResponse, error)
 }
 
 type msgClient struct {
@@ -288,12 +389,23 @@ func (c *msgClient) UpdateBlocksPerEpoch(ctx context.Context, in *MsgUpdateBlock
 	return out, nil
 }
 
+func (c *msgClient) UpdateSharesHistoryRetention(ctx context.Context, in *MsgUpdateSharesHistoryRetention, opts ...grpc.CallOption) (*MsgUpdateSharesHistoryRetentionResponse, error) {
+	out := new(MsgUpdateSharesHistoryRetentionResponse)
+	err := c.cc.Invoke(ctx, "/restaking.Msg/UpdateSharesHistoryRetention", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// upsert outbound state
 	UpsertOutboundState(context.Context, *MsgUpsertOutboundState) (*MsgUpsertOutboundStateResponse, error)
 	// update blocks per epoch
 	UpdateBlocksPerEpoch(context.Context, *MsgUpdateBlocksPerEpoch) (*MsgUpdateBlocksPerEpochResponse, error)
+	// update the number of epochs the operator shares history is retained
+	UpdateSharesHistoryRetention(context.Context, *MsgUpdateSharesHistoryRetention) (*MsgUpdateSharesHistoryRetentionResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -306,6 +418,9 @@ func (*UnimplementedMsgServer) UpsertOutboundState(ctx context.Context, req *Msg
 func (*UnimplementedMsgServer) UpdateBlocksPerEpoch(ctx context.Context, req *MsgUpdateBlocksPerEpoch) (*MsgUpdateBlocksPerEpochResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateBlocksPerEpoch not implemented")
 }
+func (*UnimplementedMsgServer) UpdateSharesHistoryRetention(ctx context.Context, req *MsgUpdateSharesHistoryRetention) (*MsgUpdateSharesHistoryRetentionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSharesHistoryRetention not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -347,6 +462,24 @@ func _Msg_UpdateBlocksPerEpoch_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateSharesHistoryRetention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateSharesHistoryRetention)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateSharesHistoryRetention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/restaking.Msg/UpdateSharesHistoryRetention",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateSharesHistoryRetention(ctx, req.(*MsgUpdateSharesHistoryRetention))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "restaking.Msg",
@@ -360,6 +493,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateBlocksPerEpoch",
 			Handler:    _Msg_UpdateBlocksPerEpoch_Handler,
 		},
+		{
+			MethodName: "UpdateSharesHistoryRetention",
+			Handler:    _Msg_UpdateSharesHistoryRetention_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "restaking/tx.proto",
@@ -488,6 +625,64 @@ func (m *MsgUpdateBlocksPerEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateSharesHistoryRetention) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSharesHistoryRetention) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSharesHistoryRetention) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RetentionEpochs != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RetentionEpochs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateSharesHistoryRetentionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSharesHistoryRetentionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSharesHistoryRetentionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -550,6 +745,31 @@ func (m *MsgUpdateBlocksPerEpochResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateSharesHistoryRetention) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RetentionEpochs != 0 {
+		n += 1 + sovTx(uint64(m.RetentionEpochs))
+	}
+	return n
+}
+
+func (m *MsgUpdateSharesHistoryRetentionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -875,6 +1095,157 @@ func (m *MsgUpdateBlocksPerEpochResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateSharesHistoryRetention) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateSharesHistoryRetention: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateSharesHistoryRetention: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionEpochs", wireType)
+			}
+			m.RetentionEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetentionEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateSharesHistoryRetentionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateSharesHistoryRetentionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateSharesHistoryRetentionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
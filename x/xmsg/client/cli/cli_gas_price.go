@@ -75,6 +75,11 @@ func CmdShowGasPrice() *cobra.Command {
 
 // Transaction CLI /////////////////////////
 
+const (
+	FlagPriorityFee = "priority-fee"
+	FlagBaseFee     = "base-fee"
+)
+
 func CmdVoteGasPrice() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "vote-gas-price [chain] [price] [supply] [blockNumber]",
@@ -95,17 +100,35 @@ func CmdVoteGasPrice() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			argsPriorityFee, err := cmd.Flags().GetUint64(FlagPriorityFee)
+			if err != nil {
+				return err
+			}
+			argsBaseFee, err := cmd.Flags().GetUint64(FlagBaseFee)
+			if err != nil {
+				return err
+			}
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			msg := types.NewMsgVoteGasPrice(clientCtx.GetFromAddress().String(), argsChain, argsPrice, argsSupply, argsBlockNumber)
+			msg := types.NewMsgVoteGasPrice(
+				clientCtx.GetFromAddress().String(),
+				argsChain,
+				argsPrice,
+				argsPriorityFee,
+				argsBaseFee,
+				argsSupply,
+				argsBlockNumber,
+			)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().Uint64(FlagPriorityFee, 0, "EIP-1559 priority fee, zero for chains without EIP-1559")
+	cmd.Flags().Uint64(FlagBaseFee, 0, "EIP-1559 base fee, zero for chains without EIP-1559")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -0,0 +1,92 @@
+package cli
+
+import (
+	"strconv"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/0xPellNetwork/aegis/x/xmsg/types"
+)
+
+func CmdUpdateRechargeBudget() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-recharge-budget [chain-id] [token-type] [window] [max-amount]",
+		Short: "Set the maximum amount of PELL_TOKEN or GAS_TOKEN recharged to a chain within a window of blocks",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			chainID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			tokenType, err := types.ParseRechargeTokenType(args[1])
+			if err != nil {
+				return err
+			}
+
+			window, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			maxAmount, err := math.ParseUint(args[3])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateRechargeBudget(clientCtx.GetFromAddress().String(), types.RechargeBudget{
+				ChainId:   chainID,
+				TokenType: tokenType,
+				Window:    window,
+				MaxAmount: maxAmount,
+			})
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRemoveRechargeBudget() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-recharge-budget [chain-id] [token-type]",
+		Short: "Remove the recharge budget of PELL_TOKEN or GAS_TOKEN on a chain",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			chainID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			tokenType, err := types.ParseRechargeTokenType(args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveRechargeBudget(clientCtx.GetFromAddress().String(), chainID, tokenType)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -291,6 +291,34 @@ func CmdAbortStuckXmsg() *cobra.Command {
 	return cmd
 }
 
+func CmdRefundAbortedXmsg() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "refund-aborted-xmsg [index] [refund-address]",
+		Short: "refund the value of an aborted Xmsg, refund-address defaults to the tx origin",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			refundAddress := ""
+			if len(args) == 2 {
+				refundAddress = args[1]
+			}
+
+			msg := types.NewMsgRefundAbortedXmsg(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				refundAddress,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func getPellData(cmd *cobra.Command, args []string) (types.InboundPellEvent, error) {
 	const argsBaseParamsLen = 8
 	const argsStakerDepositedParamsLen = 4
@@ -28,6 +28,7 @@ func GetQueryCmd(_ string) *cobra.Command {
 
 		CmdListSend(),
 		CmdShowSend(),
+		CmdListXmsgsByFilter(),
 		CmdLastPellHeight(),
 		CmdInTxHashToXmsgData(),
 		CmdListInTxHashToXmsg(),
@@ -45,6 +46,11 @@ func GetQueryCmd(_ string) *cobra.Command {
 
 		CmdGetCrosschainFeeParam(),
 		CmdListCrosschainFeeParams(),
+		CmdCrosschainFeeQuote(),
+
+		CmdShowRechargeLedger(),
+		CmdListRechargeLedger(),
+		CmdListRechargeBudget(),
 	)
 
 	return cmd
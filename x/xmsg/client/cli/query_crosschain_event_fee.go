@@ -1,7 +1,9 @@
 package cli
 
 import (
+	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -72,3 +74,71 @@ func CmdListCrosschainFeeParams() *cobra.Command {
 
 	return cmd
 }
+
+const flagDestinationGasLimit = "destination-gas-limit"
+
+// CmdCrosschainFeeQuote returns the fee expected for a crosschain event
+func CmdCrosschainFeeQuote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "crosschain-fee-quote [source-chain-id] [destination-chain-id] [event-type]",
+		Short: "Query the fee expected for a crosschain event",
+		Long: `Query the fee expected for a crosschain event sent to the destination chain.
+The event type is one of DELEGATION_OPERATOR_SHARE, DELEGATION_OPERATOR_SYNC, REGISTRY_ROUTER_SYNC_GROUP, DVS_DEFAULT or PELL_SENT.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			sourceChainID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			destinationChainID, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			eventType, err := parseCrosschainFeeEventType(args[2])
+			if err != nil {
+				return err
+			}
+			gasLimit, err := cmd.Flags().GetUint64(flagDestinationGasLimit)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryCrosschainFeeQuoteRequest{
+				SourceChainId:       sourceChainID,
+				DestinationChainId:  destinationChainID,
+				EventType:           eventType,
+				DestinationGasLimit: gasLimit,
+			}
+			res, err := queryClient.CrosschainFeeQuote(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().Uint64(flagDestinationGasLimit, 0, "gas limit of the outbound tx, defaults to the gas limit of the destination chain params")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseCrosschainFeeEventType parses an event type given with or without the CROSSCHAIN_FEE_EVENT_ prefix
+func parseCrosschainFeeEventType(s string) (types.CrosschainFeeEventType, error) {
+	name := strings.ToUpper(s)
+	if !strings.HasPrefix(name, "CROSSCHAIN_FEE_EVENT_") {
+		name = "CROSSCHAIN_FEE_EVENT_" + name
+	}
+
+	eventType, ok := types.CrosschainFeeEventType_value[name]
+	if !ok || eventType == int32(types.CrosschainFeeEventType_CROSSCHAIN_FEE_EVENT_UNSPECIFIED) {
+		return 0, fmt.Errorf("invalid crosschain fee event type %s", s)
+	}
+	return types.CrosschainFeeEventType(eventType), nil
+}
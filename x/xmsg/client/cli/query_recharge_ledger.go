@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/0xPellNetwork/aegis/x/xmsg/types"
+)
+
+func CmdShowRechargeLedger() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-recharge-ledger [chain-id] [token-type]",
+		Short: "shows the recharge ledger of PELL_TOKEN or GAS_TOKEN on a chain and its budget",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			chainID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			tokenType, err := types.ParseRechargeTokenType(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.RechargeLedger(cmd.Context(), &types.QueryRechargeLedgerRequest{
+				ChainId:   chainID,
+				TokenType: tokenType,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListRechargeLedger() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-recharge-ledger",
+		Short: "list all recharge ledgers",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RechargeLedgerAll(cmd.Context(), &types.QueryAllRechargeLedgerRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListRechargeBudget() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-recharge-budget",
+		Short: "list all recharge budgets",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RechargeBudgetAll(cmd.Context(), &types.QueryAllRechargeBudgetRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
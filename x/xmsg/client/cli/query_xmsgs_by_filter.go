@@ -0,0 +1,120 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/0xPellNetwork/aegis/x/xmsg/types"
+)
+
+const (
+	FlagSender          = "sender"
+	FlagTxOrigin        = "tx-origin"
+	FlagReceiver        = "receiver"
+	FlagReceiverChainID = "receiver-chain-id"
+	FlagStatuses        = "statuses"
+	FlagEventType       = "event-type"
+	FlagMinPellHeight   = "min-pell-height"
+	FlagMaxPellHeight   = "max-pell-height"
+)
+
+func CmdListXmsgsByFilter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-xmsg-by-filter",
+		Short: "list the Xmsg matching the filters",
+		Example: `pellcored query xmsg list-xmsg-by-filter --sender 0x96B05C238b99768F349135de0653b687f9c13fEE --statuses PENDING_OUTBOUND,ABORTED
+pellcored query xmsg list-xmsg-by-filter --event-type INBOUND_EVENT_STAKER_DEPOSITED --min-pell-height 100 --max-pell-height 200`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params, err := parseXmsgsByFilterFlags(cmd)
+			if err != nil {
+				return err
+			}
+			params.Pagination = pageReq
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.XmsgsByFilter(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagSender, "", "sender of the inbound tx")
+	cmd.Flags().String(FlagTxOrigin, "", "origin of the inbound tx")
+	cmd.Flags().String(FlagReceiver, "", "receiver of an outbound tx")
+	cmd.Flags().Int64(FlagReceiverChainID, 0, "receiver chain id of an outbound tx")
+	cmd.Flags().StringSlice(FlagStatuses, nil, "comma separated list of xmsg statuses, e.g. PENDING_OUTBOUND,ABORTED")
+	cmd.Flags().String(FlagEventType, "", "type of the inbound pell event, e.g. INBOUND_EVENT_STAKER_DEPOSITED")
+	cmd.Flags().Uint64(FlagMinPellHeight, 0, "minimum pell height of the inbound tx finalization")
+	cmd.Flags().Uint64(FlagMaxPellHeight, 0, "maximum pell height of the inbound tx finalization")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseXmsgsByFilterFlags builds the filtered xmsg query request from the command flags
+func parseXmsgsByFilterFlags(cmd *cobra.Command) (*types.QueryXmsgsByFilterRequest, error) {
+	req := &types.QueryXmsgsByFilterRequest{}
+	var err error
+
+	if req.Sender, err = cmd.Flags().GetString(FlagSender); err != nil {
+		return nil, err
+	}
+	if req.TxOrigin, err = cmd.Flags().GetString(FlagTxOrigin); err != nil {
+		return nil, err
+	}
+	if req.Receiver, err = cmd.Flags().GetString(FlagReceiver); err != nil {
+		return nil, err
+	}
+	if req.ReceiverChainId, err = cmd.Flags().GetInt64(FlagReceiverChainID); err != nil {
+		return nil, err
+	}
+	if req.MinInboundPellHeight, err = cmd.Flags().GetUint64(FlagMinPellHeight); err != nil {
+		return nil, err
+	}
+	if req.MaxInboundPellHeight, err = cmd.Flags().GetUint64(FlagMaxPellHeight); err != nil {
+		return nil, err
+	}
+
+	statuses, err := cmd.Flags().GetStringSlice(FlagStatuses)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range statuses {
+		value, ok := types.XmsgStatus_value[strings.ToUpper(strings.TrimSpace(s))]
+		if !ok {
+			return nil, fmt.Errorf("invalid xmsg status %s", s)
+		}
+		req.Statuses = append(req.Statuses, types.XmsgStatus(value))
+	}
+
+	eventType, err := cmd.Flags().GetString(FlagEventType)
+	if err != nil {
+		return nil, err
+	}
+	if eventType != "" {
+		value, ok := types.InboundPellEventType_value[strings.ToUpper(eventType)]
+		if !ok {
+			return nil, fmt.Errorf("invalid inbound event type %s", eventType)
+		}
+		req.InboundEventType = types.InboundPellEventType(value)
+	}
+
+	return req, nil
+}
@@ -30,10 +30,13 @@ func GetTxCmd() *cobra.Command {
 		CmdMigrateTssFunds(),
 		CmdAddToInTxTracker(),
 		CmdAbortStuckXmsg(),
+		CmdRefundAbortedXmsg(),
 		CmdAddAllowedXmsgSender(),
 		CmdRemoveAllowedXmsgSender(),
 		CmdInboundTxAdminMaintaince(),
 		CmdUpsertCrosschainFeeParams(),
+		CmdUpdateRechargeBudget(),
+		CmdRemoveRechargeBudget(),
 	)
 
 	return cmd
@@ -53,6 +53,13 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	}
 
 	k.SetRateLimiterFlags(ctx, genState.RateLimiterFlags)
+
+	for _, elem := range genState.RechargeLedgers {
+		k.SetRechargeLedger(ctx, elem)
+	}
+	for _, elem := range genState.RechargeBudgets {
+		k.SetRechargeBudget(ctx, elem)
+	}
 }
 
 // ExportGenesis returns the xmsg module's exported genesis.
@@ -91,5 +98,8 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		genesis.RateLimiterFlags = rateLimiterFlags
 	}
 
+	genesis.RechargeLedgers = k.GetAllRechargeLedger(ctx)
+	genesis.RechargeBudgets = k.GetAllRechargeBudget(ctx)
+
 	return &genesis
 }
@@ -3,6 +3,7 @@ package xmsg_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 
 	keepertest "github.com/0xPellNetwork/aegis/testutil/keeper"
@@ -50,6 +51,22 @@ func TestGenesis(t *testing.T) {
 			sample.InTxHashToXmsg_pell(t, "0x2"),
 		},
 		RateLimiterFlags: sample.RateLimiterFlags_pell(),
+		RechargeLedgers: []types.RechargeLedger{
+			{
+				ChainId:           1,
+				TokenType:         types.RechargeTokenType_PELL_TOKEN,
+				CumulativeAmount:  math.NewUint(3000),
+				RechargeCount:     3,
+				WindowStartHeight: 100,
+				WindowAmount:      math.NewUint(1000),
+				WindowCount:       1,
+				RejectedCount:     2,
+			},
+			types.NewRechargeLedger(1, types.RechargeTokenType_GAS_TOKEN),
+		},
+		RechargeBudgets: []types.RechargeBudget{
+			{ChainId: 1, TokenType: types.RechargeTokenType_PELL_TOKEN, Window: 100, MaxAmount: math.NewUint(1000)},
+		},
 	}
 
 	// Init and export
@@ -52,7 +52,7 @@ func (k Keeper) IterateAndUpdateXmsgGasPrice(
 IterateChains:
 	for _, chain := range chains {
 		// support only external evm chains
-		if pellchains.IsEVMChain(chain.Id) && !pellchains.IsPellChain(chain.Id) {
+		if chain.IsEVMChain() && !chain.IsPellChain() {
 			res, err := k.ListPendingXmsg(sdk.UnwrapSDKContext(ctx), &types.QueryListPendingXmsgRequest{
 				ChainId: chain.Id,
 				Limit:   gasPriceIncreaseFlags.MaxPendingXmsgs,
@@ -118,8 +118,13 @@ func CheckAndUpdateXmsgGasPrice(
 		return math.ZeroUint(), math.ZeroUint(), nil
 	}
 
-	// compute gas price increase
+	// skip if the outbound can't be re-signed for the receiver chain
 	chainID := xmsg.GetCurrentOutTxParam().ReceiverChainId
+	if k.relayerKeeper.IsChainOutboundPaused(ctx, chainID) || k.relayerKeeper.IsChainSigningPaused(ctx, chainID) {
+		return math.ZeroUint(), math.ZeroUint(), nil
+	}
+
+	// compute gas price increase
 	medianGasPrice, isFound := k.GetMedianGasPriceInUint(ctx, chainID)
 	if !isFound {
 		return math.ZeroUint(), math.ZeroUint(), cosmoserrors.Wrap(
@@ -152,6 +157,10 @@ func CheckAndUpdateXmsgGasPrice(
 
 	// set new gas price and last update timestamp
 	xmsg.GetCurrentOutTxParam().OutboundTxGasPrice = newGasPrice.String()
+	// the priority fee of EIP-1559 outbounds is raised along with the max fee so the tx can be replaced
+	if priorityFee, err := math.ParseUint(xmsg.GetCurrentOutTxParam().OutboundTxGasPriorityFee); err == nil && !priorityFee.IsZero() {
+		xmsg.GetCurrentOutTxParam().OutboundTxGasPriorityFee = math.MinUint(priorityFee.Add(gasPriceIncrease), newGasPrice).String()
+	}
 	xmsg.XmsgStatus.LastUpdateTimestamp = ctx.BlockHeader().Time.Unix()
 	k.SetXmsg(ctx, xmsg)
 
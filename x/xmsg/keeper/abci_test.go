@@ -7,6 +7,7 @@ import (
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/0xPellNetwork/aegis/pkg/chains"
@@ -117,6 +118,9 @@ func TestCheckAndUpdateXmsgGasPrice(t *testing.T) {
 		medianGasPrice           uint64
 		expectedGasPriceIncrease math.Uint
 		expectedAdditionalFees   math.Uint
+		expectedPriorityFee      string
+		outboundPaused           bool
+		signingPaused            bool
 		isError                  bool
 	}{
 		{
@@ -140,6 +144,29 @@ func TestCheckAndUpdateXmsgGasPrice(t *testing.T) {
 			expectedGasPriceIncrease: math.NewUint(50),    // 100% medianGasPrice
 			expectedAdditionalFees:   math.NewUint(50000), // gasLimit * increase
 		},
+		{
+			name: "can update priority fee along with gas price",
+			xmsg: types.Xmsg{
+				Index: "a4",
+				XmsgStatus: &types.Status{
+					LastUpdateTimestamp: sampleTimestamp.Unix(),
+				},
+				OutboundTxParams: []*types.OutboundTxParams{
+					{
+						ReceiverChainId:          42,
+						OutboundTxGasLimit:       1000,
+						OutboundTxGasPrice:       "100",
+						OutboundTxGasPriorityFee: "10",
+					},
+				},
+			},
+			flags:                    relayertypes.DefaultGasPriceIncreaseFlags,
+			blockTimestamp:           retryIntervalReached,
+			medianGasPrice:           50,
+			expectedGasPriceIncrease: math.NewUint(50),    // 100% medianGasPrice
+			expectedAdditionalFees:   math.NewUint(50000), // gasLimit * increase
+			expectedPriorityFee:      "60",
+		},
 		{
 			name: "can update gas price at max limit",
 			xmsg: types.Xmsg{
@@ -281,6 +308,50 @@ func TestCheckAndUpdateXmsgGasPrice(t *testing.T) {
 			expectedGasPriceIncrease: math.NewUint(0),
 			expectedAdditionalFees:   math.NewUint(0),
 		},
+		{
+			name: "skip if outbound is paused for the chain",
+			xmsg: types.Xmsg{
+				Index: "b4",
+				XmsgStatus: &types.Status{
+					LastUpdateTimestamp: sampleTimestamp.Unix(),
+				},
+				OutboundTxParams: []*types.OutboundTxParams{
+					{
+						ReceiverChainId:    42,
+						OutboundTxGasLimit: 1000,
+						OutboundTxGasPrice: "100",
+					},
+				},
+			},
+			flags:                    relayertypes.DefaultGasPriceIncreaseFlags,
+			blockTimestamp:           retryIntervalReached,
+			medianGasPrice:           50,
+			outboundPaused:           true,
+			expectedGasPriceIncrease: math.NewUint(0),
+			expectedAdditionalFees:   math.NewUint(0),
+		},
+		{
+			name: "skip if signing is paused for the chain",
+			xmsg: types.Xmsg{
+				Index: "b5",
+				XmsgStatus: &types.Status{
+					LastUpdateTimestamp: sampleTimestamp.Unix(),
+				},
+				OutboundTxParams: []*types.OutboundTxParams{
+					{
+						ReceiverChainId:    42,
+						OutboundTxGasLimit: 1000,
+						OutboundTxGasPrice: "100",
+					},
+				},
+			},
+			flags:                    relayertypes.DefaultGasPriceIncreaseFlags,
+			blockTimestamp:           retryIntervalReached,
+			medianGasPrice:           50,
+			signingPaused:            true,
+			expectedGasPriceIncrease: math.NewUint(0),
+			expectedAdditionalFees:   math.NewUint(0),
+		},
 		{
 			name: "returns error if can't find median gas price",
 			xmsg: types.Xmsg{
@@ -307,6 +378,9 @@ func TestCheckAndUpdateXmsgGasPrice(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			k, ctx := testkeeper.XmsgKeeperAllMocks(t)
 			chainID := tc.xmsg.GetCurrentOutTxParam().ReceiverChainId
+			relayerMock := testkeeper.GetXmsgObserverMock(t, k)
+			relayerMock.On("IsChainOutboundPaused", mock.Anything, chainID).Maybe().Return(tc.outboundPaused)
+			relayerMock.On("IsChainSigningPaused", mock.Anything, chainID).Maybe().Return(tc.signingPaused)
 			previousGasPrice, err := tc.xmsg.GetCurrentOutTxParam().GetGasPrice()
 			if err != nil {
 				previousGasPrice = 0
@@ -350,6 +424,7 @@ func TestCheckAndUpdateXmsgGasPrice(t *testing.T) {
 				require.NoError(t, err)
 				require.EqualValues(t, tc.expectedGasPriceIncrease.AddUint64(previousGasPrice).Uint64(), newGasPrice, "%d - %d", tc.expectedGasPriceIncrease.Uint64(), previousGasPrice)
 				require.EqualValues(t, tc.blockTimestamp.Unix(), xmsg.XmsgStatus.LastUpdateTimestamp)
+				require.Equal(t, tc.expectedPriorityFee, xmsg.GetCurrentOutTxParam().OutboundTxGasPriorityFee)
 			}
 		})
 	}
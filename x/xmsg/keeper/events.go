@@ -3,27 +3,34 @@ package keeper
 import (
 	"strconv"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	"github.com/0xPellNetwork/aegis/pkg/chains"
 	"github.com/0xPellNetwork/aegis/x/xmsg/types"
 )
 
-func EmitEventInboundFinalized(ctx sdk.Context, xmsg *types.Xmsg) {
-	senderChain, _ := chains.GetChainByChainId(xmsg.InboundTxParams.SenderChainId)
+// chainName returns the name of a chain of the chain registry
+func (k Keeper) chainName(ctx sdk.Context, chainID int64) string {
+	chain, found := k.relayerKeeper.GetChainByChainID(ctx, chainID)
+	if !found {
+		chain.Id = chainID
+	}
+	return chain.ChainName()
+}
+
+func (k Keeper) EmitEventInboundFinalized(ctx sdk.Context, xmsg *types.Xmsg) {
 	currentOutParam := xmsg.GetCurrentOutTxParam()
-	recvChain, _ := chains.GetChainByChainId(currentOutParam.ReceiverChainId)
 
 	if err := ctx.EventManager().EmitTypedEvents(&types.EventInboundFinalized{
 		MsgTypeUrl:    sdk.MsgTypeURL(&types.MsgVoteOnObservedInboundTx{}),
 		XmsgIndex:     xmsg.Index,
 		Sender:        xmsg.InboundTxParams.Sender,
-		SenderChain:   senderChain.ChainName(),
+		SenderChain:   k.chainName(ctx, xmsg.InboundTxParams.SenderChainId),
 		TxOrgin:       xmsg.InboundTxParams.TxOrigin,
 		InTxHash:      xmsg.InboundTxParams.InboundTxHash,
 		InBlockHeight: strconv.FormatUint(xmsg.InboundTxParams.InboundTxBlockHeight, 10),
 		Receiver:      currentOutParam.Receiver,
-		ReceiverChain: recvChain.ChainName(),
+		ReceiverChain: k.chainName(ctx, currentOutParam.ReceiverChainId),
 		NewStatus:     xmsg.XmsgStatus.Status.String(),
 		StatusMessage: xmsg.XmsgStatus.StatusMessage,
 	}); err != nil {
@@ -56,20 +63,18 @@ func EmitOutboundFailure(ctx sdk.Context, oldStatus string, newStatus string, xm
 	}
 }
 
-func EmitEventPellSent(ctx sdk.Context, xmsg types.Xmsg) {
+func (k Keeper) EmitEventPellSent(ctx sdk.Context, xmsg types.Xmsg) {
 	currentOutParam := xmsg.GetCurrentOutTxParam()
-	senderChain, _ := chains.GetChainByChainId(xmsg.InboundTxParams.SenderChainId)
-	recvChain, _ := chains.GetChainByChainId(currentOutParam.ReceiverChainId)
 
 	pellSent := xmsg.InboundTxParams.InboundPellTx.GetPellSent()
 	err := ctx.EventManager().EmitTypedEvents(&types.EventPellSent{
 		MsgTypeUrl:          "/pellchain.pellcore.xmsg.internal.PellSent",
 		XmsgIndex:           xmsg.Index,
 		Sender:              xmsg.InboundTxParams.Sender,
-		SenderChain:         senderChain.ChainName(),
+		SenderChain:         k.chainName(ctx, xmsg.InboundTxParams.SenderChainId),
 		InTxHash:            xmsg.InboundTxParams.InboundTxHash,
 		Receiver:            currentOutParam.Receiver,
-		ReceiverChain:       recvChain.ChainName(),
+		ReceiverChain:       k.chainName(ctx, currentOutParam.ReceiverChainId),
 		PellTxOrigin:        pellSent.TxOrigin,
 		PellSender:          pellSent.Sender,
 		PellReceiverChainId: pellSent.ReceiverChainId,
@@ -83,6 +88,17 @@ func EmitEventPellSent(ctx sdk.Context, xmsg types.Xmsg) {
 	}
 }
 
+func EmitEventAbortedXmsgRefunded(ctx sdk.Context, xmsgIndex string, refundAddress string, amount string) {
+	if err := ctx.EventManager().EmitTypedEvents(&types.EventAbortedXmsgRefunded{
+		MsgTypeUrl:    sdk.MsgTypeURL(&types.MsgRefundAbortedXmsg{}),
+		XmsgIndex:     xmsgIndex,
+		RefundAddress: refundAddress,
+		Amount:        amount,
+	}); err != nil {
+		ctx.Logger().Error("Error emitting EventAbortedXmsgRefunded :", err)
+	}
+}
+
 func EmitEventChainIndex(ctx sdk.Context, chainId uint64, height uint64) {
 	if err := ctx.EventManager().EmitTypedEvents(&types.EventChainIndex{
 		ChainId:    chainId,
@@ -103,3 +119,31 @@ func EmitEventStatusNode(ctx sdk.Context, statusNode *types.EventStatusNode) {
 		ctx.Logger().Error("Error emitting EventChainIndex :", err)
 	}
 }
+
+func EmitEventRechargeRecorded(ctx sdk.Context, ledger types.RechargeLedger, voteIndex uint64, amount math.Uint) {
+	if err := ctx.EventManager().EmitTypedEvents(&types.EventRechargeRecorded{
+		ChainId:           ledger.ChainId,
+		TokenType:         ledger.TokenType.String(),
+		VoteIndex:         voteIndex,
+		Amount:            amount.String(),
+		CumulativeAmount:  ledger.CumulativeAmount.String(),
+		WindowAmount:      ledger.WindowAmount.String(),
+		WindowStartHeight: ledger.WindowStartHeight,
+	}); err != nil {
+		ctx.Logger().Error("Error emitting EventRechargeRecorded :", err)
+	}
+}
+
+func EmitEventRechargeBudgetExceeded(ctx sdk.Context, ledger types.RechargeLedger, budget types.RechargeBudget, voteIndex uint64, amount math.Uint) {
+	if err := ctx.EventManager().EmitTypedEvents(&types.EventRechargeBudgetExceeded{
+		ChainId:           ledger.ChainId,
+		TokenType:         ledger.TokenType.String(),
+		VoteIndex:         voteIndex,
+		Amount:            amount.String(),
+		WindowAmount:      ledger.WindowAmount.String(),
+		MaxAmount:         budget.MaxAmount.String(),
+		WindowStartHeight: ledger.WindowStartHeight,
+	}); err != nil {
+		ctx.Logger().Error("Error emitting EventRechargeBudgetExceeded :", err)
+	}
+}
@@ -117,15 +117,15 @@ func (k Keeper) ProcessPellSentEvent(
 	if err != nil {
 		return fmt.Errorf("ProcessPellSentEvent: failed to initialize xmsg: %s", err.Error())
 	}
-	xmsg.SetPendingOutbound("PellConnector pell-send event setting to pending outbound directly")
+	xmsg.SetPendingOutbound(ctx, "PellConnector pell-send event setting to pending outbound directly")
 	// Get gas price and amount
 	gasprice, found := k.GetGasPrice(ctx, receiverChain.Id)
 	if !found {
 		return fmt.Errorf("gasprice not found for %s", receiverChain)
 	}
-	xmsg.GetCurrentOutTxParam().OutboundTxGasPrice = fmt.Sprint(gasprice.Prices[gasprice.MedianIndex])
+	xmsg.GetCurrentOutTxParam().SetGasFromGasPrice(gasprice)
 
-	EmitEventPellSent(ctx, xmsg)
+	k.EmitEventPellSent(ctx, xmsg)
 	return k.ProcessXmsg(ctx, xmsg, receiverChain)
 }
 
@@ -22,16 +22,16 @@ func (k Keeper) PayGasAndUpdateXmsg(
 }
 
 // ChainGasParams returns the params to calculates the fees for gas for a chain
-// tha gas address, the gas limit, gas price and protocol flat fee are returned
+// tha gas address, the gas limit, gas price, priority fee and protocol flat fee are returned
 func (k Keeper) getChainGasParams(
 	ctx sdk.Context,
 	chainID int64,
-) (gasLimit, gasPrice math.Uint, err error) {
+) (gasLimit, gasPrice, priorityFee math.Uint, err error) {
 
 	// get the gas price
-	gasPrice, isFound := k.GetMedianGasPriceInUint(ctx, chainID)
+	gasPrice, priorityFee, isFound := k.GetMedianGasValues(ctx, chainID)
 	if !isFound {
-		return gasLimit, gasPrice, types.ErrUnableToGetGasPrice
+		return gasLimit, gasPrice, priorityFee, types.ErrUnableToGetGasPrice
 	}
 	gasLimit = math.NewUintFromBigInt(pevmtypes.PEVMGasLimit)
 
@@ -50,13 +50,17 @@ func (k Keeper) PayNativeGasAndUpdateXmsg(
 	}
 
 	// get gas params
-	_, gasPrice, err := k.getChainGasParams(ctx, chainID)
+	_, gasPrice, priorityFee, err := k.getChainGasParams(ctx, chainID)
 	if err != nil {
 		return cosmoserrors.Wrap(types.ErrCannotFindGasParams, err.Error())
 	}
 
 	// xmsg.GetCurrentOutTxParam().OutboundTxGasLimit = gasLimit.Uint64()
 	xmsg.GetCurrentOutTxParam().OutboundTxGasPrice = gasPrice.String()
+	xmsg.GetCurrentOutTxParam().OutboundTxGasPriorityFee = ""
+	if !priorityFee.IsZero() {
+		xmsg.GetCurrentOutTxParam().OutboundTxGasPriorityFee = priorityFee.String()
+	}
 
 	return nil
 }
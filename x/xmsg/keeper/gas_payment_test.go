@@ -40,6 +40,36 @@ func TestKeeper_PayGasInPellAndUpdateXmsg(t *testing.T) {
 		require.Equal(t, "2", xmsg.GetCurrentOutTxParam().OutboundTxGasPrice)
 	})
 
+	t.Run("can pay gas with eip-1559 fees", func(t *testing.T) {
+		k, ctx, _, zk := testkeeper.XmsgKeeper(t)
+		k.GetAuthKeeper().GetModuleAccount(ctx, pevmtypes.ModuleName)
+		chainID := getValidEthChainID()
+		setSupportedChain(ctx, zk, chainID)
+		k.SetGasPrice(ctx, types.GasPrice{
+			ChainId:      chainID,
+			Prices:       []uint64{gasPrice},
+			PriorityFees: []uint64{1},
+			BaseFees:     []uint64{2},
+		})
+
+		// create a xmsg
+		xmsg := types.Xmsg{
+			InboundTxParams: &types.InboundTxParams{},
+			OutboundTxParams: []*types.OutboundTxParams{
+				{
+					ReceiverChainId:    chainID,
+					OutboundTxGasLimit: 1000,
+				},
+			},
+		}
+
+		err := k.PayNativeGasAndUpdateXmsg(ctx, chainID, &xmsg)
+		require.NoError(t, err)
+		// twice the base fee on top of the priority fee
+		require.Equal(t, "5", xmsg.GetCurrentOutTxParam().OutboundTxGasPrice)
+		require.Equal(t, "1", xmsg.GetCurrentOutTxParam().OutboundTxGasPriorityFee)
+	})
+
 	t.Run("should fail if chain is not supported", func(t *testing.T) {
 		k, ctx, _, _ := testkeeper.XmsgKeeper(t)
 		xmsg := types.Xmsg{
@@ -34,8 +34,17 @@ func (k Keeper) GetMedianGasPriceInUint(ctx sdk.Context, chainID int64) (math.Ui
 	if !isFound {
 		return math.ZeroUint(), isFound
 	}
-	mi := gasPrice.MedianIndex
-	return math.NewUint(gasPrice.Prices[mi]), true
+	return math.NewUint(gasPrice.MedianGasPrice()), true
+}
+
+// GetMedianGasValues returns the gas price to use for outbound txs and the median priority fee.
+// The priority fee is zero for chains without EIP-1559.
+func (k Keeper) GetMedianGasValues(ctx sdk.Context, chainID int64) (math.Uint, math.Uint, bool) {
+	gasPrice, isFound := k.GetGasPrice(ctx, chainID)
+	if !isFound {
+		return math.ZeroUint(), math.ZeroUint(), isFound
+	}
+	return math.NewUint(gasPrice.MaxFeePerGas()), math.NewUint(gasPrice.MedianPriorityFee()), true
 }
 
 // RemoveGasPrice removes a gasPrice from the store
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/gogo/status"
 	"google.golang.org/grpc/codes"
@@ -42,3 +43,69 @@ func (k Keeper) CrosschainFeeParamByChainId(ctx context.Context, req *types.Quer
 		CrosschainFeeParam: &crosschainEventFee,
 	}, nil
 }
+
+// CrosschainFeeQuote returns the fee expected for a crosschain event sent to the destination chain:
+// the protocol fee of the event, the destination gas fee at the current median gas price and a safety margin.
+// the gas fee is converted to apell with the gas token pell rate of the destination chain params, as charged on chain.
+// the source chain is validated but doesn't affect the quote, the fees only depend on the destination chain
+func (k Keeper) CrosschainFeeQuote(ctx context.Context, req *types.QueryCrosschainFeeQuoteRequest) (*types.QueryCrosschainFeeQuoteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if req.SourceChainId == req.DestinationChainId {
+		return nil, status.Errorf(codes.InvalidArgument, "source and destination chains must differ: %d", req.SourceChainId)
+	}
+	sourceChain, found := k.relayerKeeper.GetChainByChainID(sdkCtx, req.SourceChainId)
+	if !found || (!sourceChain.IsPellChain() && k.relayerKeeper.GetSupportedChainFromChainID(sdkCtx, req.SourceChainId) == nil) {
+		return nil, status.Errorf(codes.InvalidArgument, "source chain not supported: %d", req.SourceChainId)
+	}
+	if k.relayerKeeper.GetSupportedChainFromChainID(sdkCtx, req.DestinationChainId) == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "destination chain not supported: %d", req.DestinationChainId)
+	}
+
+	feeParam, found := k.GetCrosschainEventFee(sdkCtx, req.DestinationChainId)
+	if !found || !feeParam.IsSupported {
+		return nil, status.Errorf(codes.NotFound, "crosschain event fee not found for chain id: %d", req.DestinationChainId)
+	}
+	protocolFee, err := feeParam.EventFee(req.EventType)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	chainParams, found := k.relayerKeeper.GetChainParamsByChainID(sdkCtx, req.DestinationChainId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "chain params not found for chain id: %d", req.DestinationChainId)
+	}
+	gasLimit := req.DestinationGasLimit
+	if gasLimit == 0 {
+		gasLimit = chainParams.GasLimit
+	}
+
+	gasPrice, priorityFee, found := k.GetMedianGasValues(sdkCtx, req.DestinationChainId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "gas price not found for chain id: %d", req.DestinationChainId)
+	}
+
+	gasFee := math.NewIntFromBigInt(gasPrice.BigInt()).Mul(math.NewIntFromUint64(gasLimit))
+	safetyMargin := gasFee.MulRaw(types.CrosschainFeeQuoteSafetyMarginPercent).QuoRaw(100)
+	totalGasFee := gasFee.Add(safetyMargin)
+
+	totalGasFeeApell, ok := chainParams.GasTokenToApell(totalGasFee)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "gas token pell rate not set for chain id: %d", req.DestinationChainId)
+	}
+
+	return &types.QueryCrosschainFeeQuoteResponse{
+		ProtocolFee:      protocolFee,
+		GasLimit:         gasLimit,
+		GasPrice:         gasPrice,
+		PriorityFee:      priorityFee,
+		GasFee:           gasFee,
+		SafetyMargin:     safetyMargin,
+		TotalGasFee:      totalGasFee,
+		TotalGasFeeApell: totalGasFeeApell,
+		TotalFee:         protocolFee.Add(totalGasFeeApell),
+	}, nil
+}
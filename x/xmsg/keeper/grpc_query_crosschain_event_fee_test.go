@@ -0,0 +1,144 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/pkg/chains"
+	keepertest "github.com/0xPellNetwork/aegis/testutil/keeper"
+	"github.com/0xPellNetwork/aegis/testutil/sample"
+	relayertypes "github.com/0xPellNetwork/aegis/x/relayer/types"
+	"github.com/0xPellNetwork/aegis/x/xmsg/types"
+)
+
+func TestKeeper_CrosschainFeeQuote(t *testing.T) {
+	sourceChainID := chains.PellPrivnetChain().Id
+	destinationChainID := getValidEthChainID()
+
+	setup := func(t *testing.T, gasTokenPellRate math.LegacyDec) (*types.QueryCrosschainFeeQuoteRequest, func() (*types.QueryCrosschainFeeQuoteResponse, error)) {
+		k, ctx, _, zk := keepertest.XmsgKeeper(t)
+
+		chainParams := sample.ChainParamsSupported_pell(destinationChainID)
+		chainParams.GasLimit = 100_000
+		chainParams.GasTokenPellRate = gasTokenPellRate
+		zk.ObserverKeeper.SetChainParamsList(ctx, relayertypes.ChainParamsList{
+			ChainParams: []*relayertypes.ChainParams{chainParams},
+		})
+		k.StoreCrosschainEventFee(ctx, types.CrosschainFeeParam{
+			ChainId:                         destinationChainID,
+			DelegationOperatorShareEventFee: math.NewInt(10),
+			DelegationOperatorSyncFee:       math.NewInt(20),
+			RegistryRouterSyncGroupEventFee: math.NewInt(30),
+			DvsDefaultFee:                   math.NewInt(40),
+			PellSentEventFee:                math.NewInt(50),
+			IsSupported:                     true,
+		})
+		k.SetGasPrice(ctx, types.GasPrice{
+			ChainId:      destinationChainID,
+			Prices:       []uint64{10},
+			PriorityFees: []uint64{2},
+			BaseFees:     []uint64{8},
+		})
+
+		req := &types.QueryCrosschainFeeQuoteRequest{
+			SourceChainId:      sourceChainID,
+			DestinationChainId: destinationChainID,
+			EventType:          types.CrosschainFeeEventType_CROSSCHAIN_FEE_EVENT_PELL_SENT,
+		}
+		return req, func() (*types.QueryCrosschainFeeQuoteResponse, error) {
+			return k.CrosschainFeeQuote(ctx, req)
+		}
+	}
+
+	t.Run("should error if req is nil", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.XmsgKeeper(t)
+		_, err := k.CrosschainFeeQuote(ctx, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("should quote the fee with the gas limit of the chain params", func(t *testing.T) {
+		_, quote := setup(t, math.LegacyNewDecWithPrec(5, 1))
+
+		res, err := quote()
+		require.NoError(t, err)
+		require.EqualValues(t, 50, res.ProtocolFee.Int64())
+		require.EqualValues(t, 100_000, res.GasLimit)
+		// twice the base fee on top of the priority fee
+		require.EqualValues(t, 18, res.GasPrice.Uint64())
+		require.EqualValues(t, 2, res.PriorityFee.Uint64())
+		require.EqualValues(t, 1_800_000, res.GasFee.Int64())
+		require.EqualValues(t, 360_000, res.SafetyMargin.Int64())
+		require.EqualValues(t, 2_160_000, res.TotalGasFee.Int64())
+		// the gas fee is converted at 0.5 apell per wei
+		require.EqualValues(t, 1_080_000, res.TotalGasFeeApell.Int64())
+		require.EqualValues(t, 1_080_050, res.TotalFee.Int64())
+	})
+
+	t.Run("should quote the fee with the given gas limit", func(t *testing.T) {
+		req, quote := setup(t, math.LegacyNewDecWithPrec(5, 1))
+		req.DestinationGasLimit = 1000
+		req.EventType = types.CrosschainFeeEventType_CROSSCHAIN_FEE_EVENT_DELEGATION_OPERATOR_SYNC
+
+		res, err := quote()
+		require.NoError(t, err)
+		require.EqualValues(t, 20, res.ProtocolFee.Int64())
+		require.EqualValues(t, 1000, res.GasLimit)
+		require.EqualValues(t, 18_000, res.GasFee.Int64())
+		require.EqualValues(t, 21_600, res.TotalGasFee.Int64())
+		require.EqualValues(t, 10_800, res.TotalGasFeeApell.Int64())
+		require.EqualValues(t, 10_820, res.TotalFee.Int64())
+	})
+
+	t.Run("should error if the gas token pell rate is not set", func(t *testing.T) {
+		_, quote := setup(t, math.LegacyDec{})
+
+		_, err := quote()
+		require.ErrorContains(t, err, "gas token pell rate not set")
+	})
+
+	t.Run("should error if the event type is unspecified", func(t *testing.T) {
+		req, quote := setup(t, math.LegacyNewDecWithPrec(5, 1))
+		req.EventType = types.CrosschainFeeEventType_CROSSCHAIN_FEE_EVENT_UNSPECIFIED
+
+		_, err := quote()
+		require.ErrorContains(t, err, "unknown crosschain fee event type")
+	})
+
+	t.Run("should error if the source chain is not supported", func(t *testing.T) {
+		req, quote := setup(t, math.LegacyNewDecWithPrec(5, 1))
+		req.SourceChainId = chains.BscMainnetChain().Id
+
+		_, err := quote()
+		require.ErrorContains(t, err, "source chain not supported")
+	})
+
+	t.Run("should error if the source chain is not in the chain registry", func(t *testing.T) {
+		req, quote := setup(t, math.LegacyNewDecWithPrec(5, 1))
+		req.SourceChainId = 1000
+
+		_, err := quote()
+		require.ErrorContains(t, err, "source chain not supported")
+	})
+
+	t.Run("should error if the destination chain is the source chain", func(t *testing.T) {
+		req, quote := setup(t, math.LegacyNewDecWithPrec(5, 1))
+		req.SourceChainId = destinationChainID
+
+		_, err := quote()
+		require.ErrorContains(t, err, "must differ")
+	})
+
+	t.Run("should error if the destination chain has no fee param", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.XmsgKeeper(t)
+		setSupportedChain(ctx, zk, destinationChainID)
+
+		_, err := k.CrosschainFeeQuote(ctx, &types.QueryCrosschainFeeQuoteRequest{
+			SourceChainId:      sourceChainID,
+			DestinationChainId: destinationChainID,
+			EventType:          types.CrosschainFeeEventType_CROSSCHAIN_FEE_EVENT_PELL_SENT,
+		})
+		require.ErrorContains(t, err, "crosschain event fee not found")
+	})
+}
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/0xPellNetwork/aegis/x/xmsg/types"
+)
+
+// RechargeLedger queries the recharge ledger of a token on a chain and its budget.
+// The windowed totals are reported for the window of the current block.
+func (k Keeper) RechargeLedger(c context.Context, req *types.QueryRechargeLedgerRequest) (*types.QueryRechargeLedgerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetRechargeLedger(ctx, req.ChainId, req.TokenType); !found {
+		return nil, status.Error(codes.NotFound, "recharge ledger not found")
+	}
+
+	res := &types.QueryRechargeLedgerResponse{}
+	ledger, budget, found := k.currentRechargeLedger(ctx, req.ChainId, req.TokenType)
+	res.Ledger = ledger
+	if found {
+		res.Budget = &budget
+	}
+
+	return res, nil
+}
+
+// RechargeLedgerAll queries all recharge ledgers
+func (k Keeper) RechargeLedgerAll(c context.Context, req *types.QueryAllRechargeLedgerRequest) (*types.QueryRechargeLedgerAllResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var ledgers []types.RechargeLedger
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	ledgerStore := prefix.NewStore(store, types.KeyPrefix(types.RechargeLedgerKey))
+
+	pageRes, err := query.Paginate(ledgerStore, req.Pagination, func(_ []byte, value []byte) error {
+		var ledger types.RechargeLedger
+		if err := k.cdc.Unmarshal(value, &ledger); err != nil {
+			return err
+		}
+
+		ledgers = append(ledgers, ledger)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRechargeLedgerAllResponse{Ledgers: ledgers, Pagination: pageRes}, nil
+}
+
+// RechargeBudgetAll queries all recharge budgets
+func (k Keeper) RechargeBudgetAll(c context.Context, req *types.QueryAllRechargeBudgetRequest) (*types.QueryRechargeBudgetAllResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryRechargeBudgetAllResponse{Budgets: k.GetAllRechargeBudget(ctx)}, nil
+}
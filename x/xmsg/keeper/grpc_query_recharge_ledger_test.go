@@ -0,0 +1,70 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/0xPellNetwork/aegis/testutil/keeper"
+	"github.com/0xPellNetwork/aegis/x/xmsg/types"
+)
+
+func TestKeeper_RechargeLedger(t *testing.T) {
+	t.Run("should error if req is nil", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.XmsgKeeper(t)
+
+		_, err := k.RechargeLedger(ctx, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("should error if ledger not found", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.XmsgKeeper(t)
+
+		_, err := k.RechargeLedger(ctx, &types.QueryRechargeLedgerRequest{ChainId: 1})
+		require.Error(t, err)
+	})
+
+	t.Run("should return the ledger of the current window and the budget", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.XmsgKeeper(t)
+		budget := types.RechargeBudget{ChainId: 1, TokenType: types.RechargeTokenType_GAS_TOKEN, Window: 10, MaxAmount: math.NewUint(100)}
+		k.SetRechargeBudget(ctx, budget)
+		k.RecordRecharge(ctx.WithBlockHeight(5), 1, types.RechargeTokenType_GAS_TOKEN, 1, math.NewUint(50))
+
+		res, err := k.RechargeLedger(ctx.WithBlockHeight(10), &types.QueryRechargeLedgerRequest{
+			ChainId:   1,
+			TokenType: types.RechargeTokenType_GAS_TOKEN,
+		})
+		require.NoError(t, err)
+		require.Equal(t, math.NewUint(50), res.Ledger.WindowAmount)
+		require.Equal(t, &budget, res.Budget)
+
+		// the window elapsed, only the cumulative amount remains
+		res, err = k.RechargeLedger(ctx.WithBlockHeight(15), &types.QueryRechargeLedgerRequest{
+			ChainId:   1,
+			TokenType: types.RechargeTokenType_GAS_TOKEN,
+		})
+		require.NoError(t, err)
+		require.True(t, res.Ledger.WindowAmount.IsZero())
+		require.Equal(t, math.NewUint(50), res.Ledger.CumulativeAmount)
+	})
+}
+
+func TestKeeper_RechargeLedgerAll(t *testing.T) {
+	k, ctx, _, _ := keepertest.XmsgKeeper(t)
+
+	_, err := k.RechargeLedgerAll(ctx, nil)
+	require.Error(t, err)
+
+	k.RecordRecharge(ctx, 1, types.RechargeTokenType_PELL_TOKEN, 1, math.NewUint(50))
+	k.RecordRecharge(ctx, 1, types.RechargeTokenType_GAS_TOKEN, 1, math.NewUint(50))
+	k.RecordRecharge(ctx, 2, types.RechargeTokenType_GAS_TOKEN, 1, math.NewUint(50))
+
+	res, err := k.RechargeLedgerAll(ctx, &types.QueryAllRechargeLedgerRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Ledgers, 3)
+
+	budgets, err := k.RechargeBudgetAll(ctx, &types.QueryAllRechargeBudgetRequest{})
+	require.NoError(t, err)
+	require.Empty(t, budgets.Budgets)
+}
@@ -103,6 +103,10 @@ func (k Keeper) RateLimiterInput(
 		}
 	}
 
+	// the past cctxs' value is accumulated within the window of each rate limit
+	rateLimitFlags, _ := k.GetRateLimiterFlags(ctx)
+	budgets := types.NewRateLimitBudgets(rateLimitFlags, height, 0, nil)
+
 	// define a few variables to be used in the query loops
 	totalPending := uint64(0)
 	cctxsMissed := make([]*types.Xmsg, 0)
@@ -138,6 +142,7 @@ func (k Keeper) RateLimiterInput(
 
 			// sum up the cctxs' value if the cctx is outgoing, within the window and in the past
 			if inWindow && isOutgoing && isPast {
+				budgets.AddPast(cctx)
 			}
 
 			// add cctx to corresponding list
@@ -158,6 +163,12 @@ func (k Keeper) RateLimiterInput(
 	// sort the pending cctxs order by height (first come first serve)
 	SortXmsgsByHeightAndChainID(cctxsPending)
 
+	// the past cctxs' value of the global rate limit
+	pastXmsgsValue := sdkmath.ZeroInt()
+	if budgets.Global != nil {
+		pastXmsgsValue = budgets.Global.Total()
+	}
+
 	// we take all the missed cctxs (won't be a lot) for simplicity of the query, but we only take a `limit` number of pending cctxs
 	if maxCCTXsReached(cctxsPending) {
 		cctxsPending = cctxsPending[:limit]
@@ -169,11 +180,18 @@ func (k Keeper) RateLimiterInput(
 		XmsgsPending:            cctxsPending,
 		TotalPending:            totalPending,
 		LowestPendingXmsgHeight: lowestPendingXmsgHeight,
+		PastXmsgsValue:          pastXmsgsValue.String(),
+		PastChainValues:         budgets.ChainValues(),
+		PastEventValues:         budgets.EventValues(),
 	}, nil
 }
 
 // ListPendingXmsgWithinRateLimit returns a list of pending cctxs that do not exceed the outbound rate limit
 // a limit for the number of cctxs to return can be specified or the default is MaxPendingXmsgs
+//
+// The outbound xmsgs are counted against the global rate limit, the rate limit of their receiver chain and the
+// rate limit of their event type. A pending xmsg exceeding one of these limits is held back with the following
+// pending xmsgs of its chain, so a busy chain or event type doesn't starve the others.
 func (k Keeper) ListPendingXmsgWithinRateLimit(c context.Context, req *types.QueryListPendingXmsgWithinRateLimitRequest) (res *types.QueryListPendingXmsgWithinRateLimitResponse, err error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -187,21 +205,15 @@ func (k Keeper) ListPendingXmsgWithinRateLimit(c context.Context, req *types.Que
 	ctx := sdk.UnwrapSDKContext(c)
 
 	// define a few variables to be used in the query loops
-	limitExceeded := false
 	totalPending := uint64(0)
-	totalWithdrawInApell := sdkmath.NewInt(0)
 	xmsgs := make([]*types.Xmsg, 0)
 	foreignChains := k.relayerKeeper.GetSupportedForeignChains(ctx)
 
 	// check rate limit flags to decide if we should apply rate limit
-	applyLimit := true
 	rateLimitFlags, found := k.GetRateLimiterFlags(ctx)
-	if !found || !rateLimitFlags.Enabled {
-		applyLimit = false
-	}
-	if rateLimitFlags.Rate.IsNil() || rateLimitFlags.Rate.IsZero() {
-		applyLimit = false
-	}
+	applyLimit := found && rateLimitFlags.Enabled && (rateLimitFlags.IsGlobalRateLimitSet() ||
+		len(rateLimitFlags.ChainRateLimits) > 0 ||
+		len(rateLimitFlags.EventRateLimits) > 0)
 
 	// fallback to non-rate-limited query if rate limiter is disabled
 	if !applyLimit {
@@ -229,41 +241,27 @@ func (k Keeper) ListPendingXmsgWithinRateLimit(c context.Context, req *types.Que
 		return nil, relayertypes.ErrTssNotFound
 	}
 
-	// calculate the rate limiter sliding window left boundary (inclusive)
-	leftWindowBoundary := height - rateLimitFlags.Window + 1
+	// calculate the left boundary (inclusive) of the widest rate limiter sliding window
+	leftWindowBoundary := height - rateLimitFlags.MaxWindow() + 1
 	if leftWindowBoundary < 0 {
 		leftWindowBoundary = 0
 	}
 
-	// get the conversion rates for all foreign coins
-	var blockLimitInApell sdkmath.Int
-	var windowLimitInApell sdkmath.Int
-	if applyLimit {
-		// initiate block limit and window limit in apell
-		blockLimitInApell = sdkmath.NewIntFromBigInt(rateLimitFlags.Rate.BigInt())
-		windowLimitInApell = blockLimitInApell.Mul(sdkmath.NewInt(rateLimitFlags.Window))
-	}
-
 	// the criteria to stop adding xmsgs to the rpc response
 	maxXmsgsReached := func(xmsgs []*types.Xmsg) bool {
 		// #nosec G701 len always positive
 		return uint32(len(xmsgs)) >= limit
 	}
 
-	// if a xmsg falls within the rate limiter window
+	// if a xmsg falls within the widest rate limiter window
 	isXmsgInWindow := func(xmsg *types.Xmsg) bool {
 		// #nosec G701 checked positive
 		return xmsg.InboundTxParams.InboundTxBlockHeight >= uint64(leftWindowBoundary)
 	}
 
-	// if a xmsg is an outgoing xmsg that orginates from PellChain
-	// reverted incoming xmsg has an external `SenderChainId` and should not be counted
-	isXmsgOutgoing := func(xmsg *types.Xmsg) bool {
-		return chains.IsPellChain(xmsg.InboundTxParams.SenderChainId)
-	}
-
 	// query pending nonces for each foreign chain and get the lowest height of the pending xmsgs
 	lowestPendingXmsgHeight := int64(0)
+	lowestPendingXmsgHeights := make(map[int64]int64)
 	pendingNoncesMap := make(map[int64]relayertypes.PendingNonces)
 	for _, chain := range foreignChains {
 		pendingNonces, found := k.GetRelayerKeeper().GetPendingNonces(ctx, tss.TssPubkey, chain.Id)
@@ -280,6 +278,7 @@ func (k Keeper) ListPendingXmsgWithinRateLimit(c context.Context, req *types.Que
 			}
 			// #nosec G701 len always in range
 			xmsgHeight := int64(xmsg.InboundTxParams.InboundTxBlockHeight)
+			lowestPendingXmsgHeights[chain.Id] = xmsgHeight
 			if lowestPendingXmsgHeight == 0 || xmsgHeight < lowestPendingXmsgHeight {
 				lowestPendingXmsgHeight = xmsgHeight
 			}
@@ -289,19 +288,9 @@ func (k Keeper) ListPendingXmsgWithinRateLimit(c context.Context, req *types.Que
 		return nil, status.Error(codes.Internal, "pending nonces not found")
 	}
 
-	// invariant: for period of time >= `rateLimitFlags.Window`, the pellclient-side average withdraw rate should be <= `blockLimitInPell`
-	// otherwise, this query should return empty result and wait for the average rate to drop below `blockLimitInPell`
-	withdrawWindow := rateLimitFlags.Window
-	withdrawLimitInApell := windowLimitInApell
-	if lowestPendingXmsgHeight != 0 {
-		// `pendingXmsgWindow` is the width of [lowestPendingXmsgHeight, height] window
-		// if the window can be wider than `rateLimitFlags.Window`, we should adjust the total withdraw limit proportionally
-		pendingXmsgWindow := height - lowestPendingXmsgHeight + 1
-		if pendingXmsgWindow > rateLimitFlags.Window {
-			withdrawWindow = pendingXmsgWindow
-			withdrawLimitInApell = blockLimitInApell.Mul(sdkmath.NewInt(pendingXmsgWindow))
-		}
-	}
+	// invariant: for period of time >= `window`, the pellclient-side average withdraw rate of each rate limit should be <= its rate
+	// otherwise, the xmsgs counted by the rate limit are held back until the average rate drops below the rate
+	budgets := types.NewRateLimitBudgets(rateLimitFlags, height, lowestPendingXmsgHeight, lowestPendingXmsgHeights)
 
 	// query backwards for potential missed pending xmsgs for each foreign chain
 	for _, chain := range foreignChains {
@@ -322,7 +311,6 @@ func (k Keeper) ListPendingXmsgWithinRateLimit(c context.Context, req *types.Que
 				return nil, err
 			}
 			inWindow := isXmsgInWindow(xmsg)
-			isOutgoing := isXmsgOutgoing(xmsg)
 
 			// we should at least go backwards by 1000 nonces to pick up missed pending xmsgs
 			// we might go even further back if rate limiter is enabled and the endNonce hasn't hit the left window boundary yet
@@ -331,15 +319,11 @@ func (k Keeper) ListPendingXmsgWithinRateLimit(c context.Context, req *types.Que
 				break
 			}
 			// sum up the xmsgs' value if the xmsg is outgoing and within the window
-			if inWindow && isOutgoing &&
-				rateLimitExceeded(
-					chain.Id,
-					xmsg,
-					&totalWithdrawInApell,
-					withdrawLimitInApell,
-				) {
-				limitExceeded = true
-				continue
+			if inWindow && types.IsOutgoingXmsg(xmsg) {
+				budgets.AddPast(xmsg)
+				if budgets.IsExceeded(xmsg) {
+					continue
+				}
 			}
 
 			// only take a `limit` number of pending xmsgs as result but still count the total pending xmsgs
@@ -352,45 +336,33 @@ func (k Keeper) ListPendingXmsgWithinRateLimit(c context.Context, req *types.Que
 		}
 	}
 
-	// remember the number of missed pending xmsgs
-	missedPending := len(xmsgs)
-
 	// query forwards for pending xmsgs for each foreign chain
+	pendingXmsgs := make([]*types.Xmsg, 0)
 	for _, chain := range foreignChains {
 		pendingNonces := pendingNoncesMap[chain.Id]
 
 		// #nosec G701 always in range
 		totalPending += uint64(pendingNonces.NonceHigh - pendingNonces.NonceLow)
 
-		// query the pending xmsgs in range [NonceLow, NonceHigh)
+		// query the pending xmsgs in range [NonceLow, NonceHigh) and accumulate their value
 		for nonce := pendingNonces.NonceLow; nonce < pendingNonces.NonceHigh; nonce++ {
 			xmsg, err := getXmsgByChainIDAndNonce(k, ctx, tss.TssPubkey, chain.Id, nonce)
 			if err != nil {
 				return nil, err
 			}
-			isOutgoing := isXmsgOutgoing(xmsg)
-
-			// skip the xmsg if rate limit is exceeded but still accumulate the total withdraw value
-			if isOutgoing && rateLimitExceeded(
-				chain.Id,
-				xmsg,
-				&totalWithdrawInApell,
-				withdrawLimitInApell,
-			) {
-				limitExceeded = true
-				continue
-			}
-			// only take a `limit` number of pending xmsgs as result
-			if maxXmsgsReached(xmsgs) {
-				continue
+			if types.IsOutgoingXmsg(xmsg) {
+				budgets.AddPending(xmsg)
 			}
-			xmsgs = append(xmsgs, xmsg)
+			pendingXmsgs = append(pendingXmsgs, xmsg)
 		}
 	}
 
-	// if the rate limit is exceeded, only return the missed pending xmsgs
-	if limitExceeded {
-		xmsgs = xmsgs[:missedPending]
+	// hold back the pending xmsgs exceeding the rate limits and only take a `limit` number of pending xmsgs as result
+	for _, xmsg := range budgets.FilterPending(pendingXmsgs) {
+		if maxXmsgsReached(xmsgs) {
+			break
+		}
+		xmsgs = append(xmsgs, xmsg)
 	}
 
 	// sort the xmsgs by chain ID and nonce (lower nonce holds higher priority for scheduling)
@@ -401,23 +373,21 @@ func (k Keeper) ListPendingXmsgWithinRateLimit(c context.Context, req *types.Que
 		return xmsgs[i].GetCurrentOutTxParam().ReceiverChainId < xmsgs[j].GetCurrentOutTxParam().ReceiverChainId
 	})
 
+	// the global withdraw window and rate are only reported if the global rate limit is set
+	withdrawWindow := int64(0)
+	withdrawRate := sdkmath.ZeroInt()
+	if budgets.Global != nil {
+		withdrawWindow = budgets.Global.CurrentWithdrawWindow()
+		withdrawRate = budgets.Global.CurrentWithdrawRate()
+	}
+
 	return &types.QueryListPendingXmsgWithinRateLimitResponse{
 		Xmsgs:                 xmsgs,
 		TotalPending:          totalPending,
 		CurrentWithdrawWindow: withdrawWindow,
-		CurrentWithdrawRate:   totalWithdrawInApell.Quo(sdkmath.NewInt(withdrawWindow)).String(),
-		RateLimitExceeded:     limitExceeded,
+		CurrentWithdrawRate:   withdrawRate.String(),
+		RateLimitExceeded:     budgets.GlobalExceeded(),
+		ChainRateLimits:       budgets.ChainStatuses(),
+		EventRateLimits:       budgets.EventStatuses(),
 	}, nil
 }
-
-// rateLimitExceeded accumulates the xmsg value and then checks if the rate limit is exceeded
-// returns true if the rate limit is exceeded
-func rateLimitExceeded(
-	_ int64, // chainID
-	_ *types.Xmsg, // xmsg
-	currentXmsgValue *sdkmath.Int,
-	withdrawLimitInApell sdkmath.Int,
-) bool {
-	*currentXmsgValue = currentXmsgValue.Add(sdkmath.NewInt(1))
-	return currentXmsgValue.GT(withdrawLimitInApell)
-}
@@ -370,3 +370,88 @@ func TestKeeper_ListPendingXmsgWithinRateLimit_Errors(t *testing.T) {
 		require.ErrorContains(t, err, "pending nonces not found")
 	})
 }
+
+func TestKeeper_ListPendingXmsgWithinRateLimit_ChainAndEventRateLimits(t *testing.T) {
+	// create sample TSS
+	tss := sample.Tss_pell()
+
+	// eth chain pending nonces, xmsgs in range [999, 1098] are missed and xmsgs in range [1099, 1198] are pending
+	ethPendingNonces := relayertypes.PendingNonces{
+		ChainId:   ethChainID,
+		NonceLow:  1099,
+		NonceHigh: 1199,
+		Tss:       tss.TssPubkey,
+	}
+
+	// setupKeeper sets the flags, the eth xmsgs and the pending nonces in the keeper, bsc has no pending xmsg
+	setupKeeper := func(t *testing.T, flags types.RateLimiterFlags, xmsgs ...[]*types.Xmsg) (*keeper.Keeper, sdk.Context) {
+		k, ctx, _, zk := keepertest.XmsgKeeper(t)
+		zk.ObserverKeeper.SetTSS(ctx, tss)
+		k.SetRateLimiterFlags(ctx, flags)
+		for _, list := range xmsgs {
+			setXmsgsInKeeper(ctx, *k, zk, tss, list)
+		}
+		zk.ObserverKeeper.SetPendingNonces(ctx, ethPendingNonces)
+		zk.ObserverKeeper.SetPendingNonces(ctx, relayertypes.PendingNonces{ChainId: bscChainID, Tss: tss.TssPubkey})
+		return k, ctx.WithBlockHeight(1199)
+	}
+
+	t.Run("should apply chain rate limit without global rate limit", func(t *testing.T) {
+		ethMinedXmsgs := createXmsgsWithCoinTypeAndHeightRange(t, 1, 999, pellChainID, ethChainID, types.XmsgStatus_OUTBOUND_MINED)
+		ethPendingXmsgs := createXmsgsWithCoinTypeAndHeightRange(t, 1000, 1199, pellChainID, ethChainID, types.XmsgStatus_PENDING_OUTBOUND)
+
+		k, ctx := setupKeeper(t, types.RateLimiterFlags{
+			Enabled: true,
+			ChainRateLimits: []types.ChainRateLimit{
+				{ChainId: ethChainID, Window: 100, Rate: math.NewUint(1)},
+			},
+		}, ethMinedXmsgs, ethPendingXmsgs)
+
+		res, err := k.ListPendingXmsgWithinRateLimit(ctx, &types.QueryListPendingXmsgWithinRateLimitRequest{})
+		require.NoError(t, err)
+		require.EqualValues(t, ethPendingXmsgs, res.Xmsgs)
+		require.EqualValues(t, 200, res.TotalPending)
+		require.False(t, res.RateLimitExceeded)
+		require.EqualValues(t, 0, res.CurrentWithdrawWindow)
+		require.Equal(t, []types.ChainRateLimitStatus{{
+			ChainId:               ethChainID,
+			CurrentWithdrawWindow: 100, // [LowestPendingXmsgHeight, Height] = [1100, 1199]
+			CurrentWithdrawRate:   "1",
+			RateLimitExceeded:     false,
+		}}, res.ChainRateLimits)
+	})
+
+	t.Run("should hold back pending xmsgs exceeding event rate limit", func(t *testing.T) {
+		ethMinedXmsgs := createXmsgsWithCoinTypeAndHeightRange(t, 1, 999, pellChainID, ethChainID, types.XmsgStatus_OUTBOUND_MINED)
+		ethPendingXmsgs := createXmsgsWithCoinTypeAndHeightRange(t, 1000, 1199, pellChainID, ethChainID, types.XmsgStatus_PENDING_OUTBOUND)
+
+		// the pending xmsgs are pell sent events with 1 PELL each
+		for _, xmsg := range ethPendingXmsgs {
+			xmsg.InboundTxParams.InboundPellTx = sample.InboundPellTx_PellSend_pell(sample.Rand())
+			xmsg.InboundTxParams.InboundPellTx.GetPellSent().PellValue = math.NewUint(1e18)
+		}
+
+		k, ctx := setupKeeper(t, types.RateLimiterFlags{
+			Enabled: true,
+			Window:  700,
+			Rate:    math.NewUint(10),
+			EventRateLimits: []types.EventRateLimit{
+				{EventType: types.RateLimitEventType_PELL_SENT_EVENT, Window: 100, Rate: math.NewUint(5e17)},
+			},
+		}, ethMinedXmsgs, ethPendingXmsgs)
+
+		res, err := k.ListPendingXmsgWithinRateLimit(ctx, &types.QueryListPendingXmsgWithinRateLimitRequest{})
+		require.NoError(t, err)
+
+		// only the missed xmsgs are returned
+		require.EqualValues(t, ethPendingXmsgs[:100], res.Xmsgs)
+		require.EqualValues(t, 200, res.TotalPending)
+		require.False(t, res.RateLimitExceeded)
+		require.Equal(t, []types.EventRateLimitStatus{{
+			EventType:             types.RateLimitEventType_PELL_SENT_EVENT,
+			CurrentWithdrawWindow: 100,
+			CurrentWithdrawRate:   sdkmath.NewInt(1e18).String(),
+			RateLimitExceeded:     true,
+		}}, res.EventRateLimits)
+	})
+}
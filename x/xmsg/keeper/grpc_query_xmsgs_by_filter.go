@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"sort"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/0xPellNetwork/aegis/x/xmsg/types"
+)
+
+// MaxXmsgsByFilter is the maximum number of xmsgs returned by the filtered xmsg query
+const MaxXmsgsByFilter = 1000
+
+// XmsgsByFilter returns the xmsgs matching the filters of the request.
+// The most selective filter set is resolved with the xmsg secondary indexes,
+// the other filters are checked on the xmsgs found in the index.
+func (k Keeper) XmsgsByFilter(
+	c context.Context,
+	req *types.QueryXmsgsByFilterRequest,
+) (*types.QueryXmsgsByFilterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pageReq := req.Pagination
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+	if limit > MaxXmsgsByFilter {
+		limit = MaxXmsgsByFilter
+	}
+
+	start, end, keyFilter := xmsgIndexRange(req)
+	if len(pageReq.Key) > 0 {
+		if bytes.Compare(pageReq.Key, start) < 0 || bytes.Compare(pageReq.Key, end) >= 0 {
+			return nil, status.Error(codes.InvalidArgument, "invalid pagination key")
+		}
+		// resume from the key, the key is included
+		if pageReq.Reverse {
+			end = append(bytes.Clone(pageReq.Key), 0)
+		} else {
+			start = pageReq.Key
+		}
+	}
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.XmsgIndexKey))
+	var iterator storetypes.Iterator
+	if pageReq.Reverse {
+		iterator = indexStore.ReverseIterator(start, end)
+	} else {
+		iterator = indexStore.Iterator(start, end)
+	}
+	defer iterator.Close()
+
+	var (
+		xmsgs   []*types.Xmsg
+		skipped uint64
+		pageRes = &query.PageResponse{}
+	)
+	for ; iterator.Valid(); iterator.Next() {
+		if uint64(len(xmsgs)) == limit {
+			pageRes.NextKey = bytes.Clone(iterator.Key())
+			break
+		}
+		if keyFilter != nil && !keyFilter(iterator.Key()) {
+			continue
+		}
+		xmsg, found := k.GetXmsg(ctx, string(iterator.Value()))
+		if !found || !req.Match(xmsg) {
+			continue
+		}
+		if skipped < pageReq.Offset {
+			skipped++
+			continue
+		}
+		xmsgs = append(xmsgs, &xmsg)
+	}
+
+	return &types.QueryXmsgsByFilterResponse{Xmsgs: xmsgs, Pagination: pageRes}, nil
+}
+
+// xmsgIndexRange returns the range of the xmsg index keys to iterate to resolve the filters of the request,
+// the key filter, if defined, skips the index keys of the range not matching the filters
+func xmsgIndexRange(req *types.QueryXmsgsByFilterRequest) (start, end []byte, keyFilter func([]byte) bool) {
+	valueRange := func(field string, value []byte) ([]byte, []byte, func([]byte) bool) {
+		p := types.XmsgIndexValuePrefix(field, value)
+		return p, storetypes.PrefixEndBytes(p), nil
+	}
+
+	switch {
+	case req.Sender != "":
+		return valueRange(types.XmsgIndexFieldSender, types.XmsgIndexAddressValue(req.Sender))
+	case req.TxOrigin != "":
+		return valueRange(types.XmsgIndexFieldTxOrigin, types.XmsgIndexAddressValue(req.TxOrigin))
+	case req.Receiver != "":
+		return valueRange(types.XmsgIndexFieldReceiver, types.XmsgIndexAddressValue(req.Receiver))
+	case req.MinInboundPellHeight != 0 || req.MaxInboundPellHeight != 0:
+		fieldPrefix := types.XmsgIndexFieldPrefix(types.XmsgIndexFieldPellHeight)
+		start = append(types.XmsgIndexFieldPrefix(types.XmsgIndexFieldPellHeight),
+			types.XmsgIndexPellHeightValue(req.MinInboundPellHeight)...)
+		end = storetypes.PrefixEndBytes(fieldPrefix)
+		if req.MaxInboundPellHeight != 0 && req.MaxInboundPellHeight != math.MaxUint64 {
+			end = append(types.XmsgIndexFieldPrefix(types.XmsgIndexFieldPellHeight),
+				types.XmsgIndexPellHeightValue(req.MaxInboundPellHeight+1)...)
+		}
+		return start, end, nil
+	case req.ReceiverChainId != 0:
+		return valueRange(types.XmsgIndexFieldReceiverChain, types.XmsgIndexChainValue(req.ReceiverChainId))
+	case req.InboundEventType != types.InboundPellEventType_INBOUND_EVENT_UNSPECIFIED:
+		return valueRange(types.XmsgIndexFieldEventType, []byte(req.InboundEventType.String()))
+	case len(req.Statuses) > 0:
+		// iterate from the first to the last status index and skip the statuses not requested
+		prefixes := make([][]byte, 0, len(req.Statuses))
+		for _, s := range req.Statuses {
+			prefixes = append(prefixes, types.XmsgIndexValuePrefix(types.XmsgIndexFieldStatus, []byte(s.String())))
+		}
+		sort.Slice(prefixes, func(i, j int) bool { return bytes.Compare(prefixes[i], prefixes[j]) < 0 })
+		keyFilter = func(key []byte) bool {
+			for _, p := range prefixes {
+				if bytes.HasPrefix(key, p) {
+					return true
+				}
+			}
+			return false
+		}
+		return prefixes[0], storetypes.PrefixEndBytes(prefixes[len(prefixes)-1]), keyFilter
+	default:
+		// every xmsg is indexed once by status
+		fieldPrefix := types.XmsgIndexFieldPrefix(types.XmsgIndexFieldStatus)
+		return fieldPrefix, storetypes.PrefixEndBytes(fieldPrefix), nil
+	}
+}
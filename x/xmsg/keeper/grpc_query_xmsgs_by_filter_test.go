@@ -0,0 +1,213 @@
+package keeper_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/0xPellNetwork/aegis/testutil/keeper"
+	"github.com/0xPellNetwork/aegis/testutil/sample"
+	"github.com/0xPellNetwork/aegis/x/xmsg/types"
+)
+
+// createFilterXmsg returns a xmsg with the given status and inbound finalized pell height
+func createFilterXmsg(t *testing.T, index string, status types.XmsgStatus, pellHeight uint64) types.Xmsg {
+	xmsg := *sample.Xmsg_pell(t, index)
+	xmsg.XmsgStatus.Status = status
+	xmsg.InboundTxParams.InboundTxFinalizedPellHeight = pellHeight
+	return xmsg
+}
+
+// xmsgIndexes returns the indexes of the xmsgs
+func xmsgIndexes(xmsgs []*types.Xmsg) []string {
+	indexes := make([]string, 0, len(xmsgs))
+	for _, xmsg := range xmsgs {
+		indexes = append(indexes, xmsg.Index)
+	}
+	return indexes
+}
+
+func TestKeeper_XmsgsByFilter(t *testing.T) {
+	t.Run("should fail for empty req", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.XmsgKeeper(t)
+		_, err := k.XmsgsByFilter(ctx, nil)
+		require.ErrorContains(t, err, "invalid request")
+	})
+
+	t.Run("should fail for invalid filters", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.XmsgKeeper(t)
+		_, err := k.XmsgsByFilter(ctx, &types.QueryXmsgsByFilterRequest{
+			MinInboundPellHeight: 10,
+			MaxInboundPellHeight: 5,
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("should fail for pagination key outside of the filter range", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.XmsgKeeper(t)
+		_, err := k.XmsgsByFilter(ctx, &types.QueryXmsgsByFilterRequest{
+			Sender:     sample.EthAddress().Hex(),
+			Pagination: &query.PageRequest{Key: []byte("invalid")},
+		})
+		require.ErrorContains(t, err, "invalid pagination key")
+	})
+
+	t.Run("should return xmsgs matching the filters", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.XmsgKeeper(t)
+		xmsg1 := createFilterXmsg(t, "1", types.XmsgStatus_PENDING_OUTBOUND, 10)
+		xmsg2 := createFilterXmsg(t, "2", types.XmsgStatus_OUTBOUND_MINED, 20)
+		xmsg3 := createFilterXmsg(t, "3", types.XmsgStatus_ABORTED, 30)
+		xmsg3.InboundTxParams.Sender = xmsg1.InboundTxParams.Sender
+		xmsg3.InboundTxParams.InboundPellTx = &types.InboundPellEvent{PellData: &types.InboundPellEvent_PellSent{}}
+		for _, xmsg := range []types.Xmsg{xmsg1, xmsg2, xmsg3} {
+			k.SetXmsg(ctx, xmsg)
+		}
+
+		tests := []struct {
+			name     string
+			req      *types.QueryXmsgsByFilterRequest
+			expected []string
+		}{
+			{
+				name:     "no filter",
+				req:      &types.QueryXmsgsByFilterRequest{},
+				expected: []string{xmsg1.Index, xmsg2.Index, xmsg3.Index},
+			},
+			{
+				name:     "sender",
+				req:      &types.QueryXmsgsByFilterRequest{Sender: strings.ToUpper(xmsg1.InboundTxParams.Sender)},
+				expected: []string{xmsg1.Index, xmsg3.Index},
+			},
+			{
+				name: "sender and status",
+				req: &types.QueryXmsgsByFilterRequest{
+					Sender:   xmsg1.InboundTxParams.Sender,
+					Statuses: []types.XmsgStatus{types.XmsgStatus_ABORTED},
+				},
+				expected: []string{xmsg3.Index},
+			},
+			{
+				name:     "tx origin",
+				req:      &types.QueryXmsgsByFilterRequest{TxOrigin: xmsg2.InboundTxParams.TxOrigin},
+				expected: []string{xmsg2.Index},
+			},
+			{
+				name:     "receiver of the second outbound",
+				req:      &types.QueryXmsgsByFilterRequest{Receiver: xmsg2.OutboundTxParams[1].Receiver},
+				expected: []string{xmsg2.Index},
+			},
+			{
+				name:     "receiver chain",
+				req:      &types.QueryXmsgsByFilterRequest{ReceiverChainId: xmsg1.OutboundTxParams[0].ReceiverChainId},
+				expected: []string{xmsg1.Index},
+			},
+			{
+				name: "status set",
+				req: &types.QueryXmsgsByFilterRequest{
+					Statuses: []types.XmsgStatus{types.XmsgStatus_ABORTED, types.XmsgStatus_PENDING_OUTBOUND},
+				},
+				expected: []string{xmsg1.Index, xmsg3.Index},
+			},
+			{
+				name: "inbound event type",
+				req: &types.QueryXmsgsByFilterRequest{
+					InboundEventType: types.InboundPellEventType_INBOUND_EVENT_STAKER_DELEGATED,
+				},
+				expected: []string{xmsg1.Index, xmsg2.Index},
+			},
+			{
+				name:     "pell height range",
+				req:      &types.QueryXmsgsByFilterRequest{MinInboundPellHeight: 15, MaxInboundPellHeight: 30},
+				expected: []string{xmsg2.Index, xmsg3.Index},
+			},
+			{
+				name:     "min pell height",
+				req:      &types.QueryXmsgsByFilterRequest{MinInboundPellHeight: 30},
+				expected: []string{xmsg3.Index},
+			},
+			{
+				name:     "max pell height",
+				req:      &types.QueryXmsgsByFilterRequest{MaxInboundPellHeight: 10},
+				expected: []string{xmsg1.Index},
+			},
+			{
+				name:     "no match",
+				req:      &types.QueryXmsgsByFilterRequest{Sender: sample.EthAddress().Hex()},
+				expected: []string{},
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				res, err := k.XmsgsByFilter(ctx, tt.req)
+				require.NoError(t, err)
+				require.ElementsMatch(t, tt.expected, xmsgIndexes(res.Xmsgs))
+			})
+		}
+	})
+
+	t.Run("should paginate xmsgs by pell height", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.XmsgKeeper(t)
+		var expected []string
+		for i := 0; i < 5; i++ {
+			xmsg := createFilterXmsg(t, fmt.Sprintf("%d", i), types.XmsgStatus_PENDING_OUTBOUND, uint64(i+1))
+			k.SetXmsg(ctx, xmsg)
+			expected = append(expected, xmsg.Index)
+		}
+
+		var (
+			actual []string
+			next   []byte
+		)
+		for {
+			res, err := k.XmsgsByFilter(ctx, &types.QueryXmsgsByFilterRequest{
+				MinInboundPellHeight: 1,
+				Pagination:           &query.PageRequest{Key: next, Limit: 2},
+			})
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(res.Xmsgs), 2)
+			actual = append(actual, xmsgIndexes(res.Xmsgs)...)
+			next = res.Pagination.NextKey
+			if next == nil {
+				break
+			}
+		}
+		// xmsgs are returned in the order of the pell height
+		require.Equal(t, expected, actual)
+
+		res, err := k.XmsgsByFilter(ctx, &types.QueryXmsgsByFilterRequest{
+			MinInboundPellHeight: 1,
+			Pagination:           &query.PageRequest{Offset: 1, Limit: 2, Reverse: true},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{expected[3], expected[2]}, xmsgIndexes(res.Xmsgs))
+	})
+
+	t.Run("should update the indexes when the xmsg is updated or removed", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.XmsgKeeper(t)
+		xmsg := createFilterXmsg(t, "0", types.XmsgStatus_PENDING_OUTBOUND, 10)
+		k.SetXmsg(ctx, xmsg)
+
+		xmsg.XmsgStatus.Status = types.XmsgStatus_OUTBOUND_MINED
+		k.SetXmsg(ctx, xmsg)
+
+		res, err := k.XmsgsByFilter(ctx, &types.QueryXmsgsByFilterRequest{
+			Statuses: []types.XmsgStatus{types.XmsgStatus_PENDING_OUTBOUND},
+		})
+		require.NoError(t, err)
+		require.Empty(t, res.Xmsgs)
+
+		res, err = k.XmsgsByFilter(ctx, &types.QueryXmsgsByFilterRequest{
+			Statuses: []types.XmsgStatus{types.XmsgStatus_OUTBOUND_MINED},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{xmsg.Index}, xmsgIndexes(res.Xmsgs))
+
+		k.RemoveXmsg(ctx, xmsg.Index)
+		res, err = k.XmsgsByFilter(ctx, &types.QueryXmsgsByFilterRequest{Sender: xmsg.InboundTxParams.Sender})
+		require.NoError(t, err)
+		require.Empty(t, res.Xmsgs)
+	})
+}
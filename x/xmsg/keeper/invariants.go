@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	relayertypes "github.com/0xPellNetwork/aegis/x/relayer/types"
+	"github.com/0xPellNetwork/aegis/x/xmsg/types"
+)
+
+// RegisterInvariants registers the xmsg module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "chain-nonces", ChainNoncesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "pending-outbound-nonce-to-xmsg", PendingOutboundNonceToXmsgInvariant(k))
+}
+
+// AllInvariants runs all the invariants of the xmsg module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		if res, stop := ChainNoncesInvariant(k)(ctx); stop {
+			return res, stop
+		}
+		return PendingOutboundNonceToXmsgInvariant(k)(ctx)
+	}
+}
+
+// ChainNoncesInvariant checks that the pending nonces of the current TSS match the chain nonces
+// and that the nonce of every pending outbound signed by the current TSS was assigned by the pending nonces
+func ChainNoncesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken int
+		)
+
+		tss, found := k.relayerKeeper.GetTSS(ctx)
+		if !found {
+			return sdk.FormatInvariant(types.ModuleName, "chain-nonces", "no tss set\n"), false
+		}
+
+		chainNonces := make(map[int64]relayertypes.ChainNonces)
+		for _, nonces := range k.relayerKeeper.GetAllChainNonces(ctx) {
+			chainNonces[nonces.ChainId] = nonces
+		}
+
+		allPendingNonces, err := k.relayerKeeper.GetAllPendingNonces(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "chain-nonces", fmt.Sprintf("failed to get pending nonces: %s\n", err)), true
+		}
+
+		pendingNonces := make(map[int64]relayertypes.PendingNonces)
+		for _, p := range allPendingNonces {
+			if p.Tss != tss.TssPubkey {
+				continue
+			}
+			pendingNonces[p.ChainId] = p
+
+			if p.NonceLow > p.NonceHigh {
+				broken++
+				msg += fmt.Sprintf("\tchain %d: pending nonce low %d above high %d\n", p.ChainId, p.NonceLow, p.NonceHigh)
+			}
+			// #nosec G701 always positive
+			if nonces, ok := chainNonces[p.ChainId]; ok && int64(nonces.Nonce) != p.NonceHigh {
+				broken++
+				msg += fmt.Sprintf("\tchain %d: chain nonce %d differs from pending nonce high %d\n", p.ChainId, nonces.Nonce, p.NonceHigh)
+			}
+		}
+
+		for _, xmsg := range k.GetAllXmsg(ctx) {
+			if !isPendingOutboundOfTss(xmsg, tss.TssPubkey) {
+				continue
+			}
+			outbound := xmsg.GetCurrentOutTxParam()
+			p, ok := pendingNonces[outbound.ReceiverChainId]
+			if !ok {
+				continue
+			}
+			// #nosec G701 always in range
+			if int64(outbound.OutboundTxTssNonce) >= p.NonceHigh {
+				broken++
+				msg += fmt.Sprintf("\txmsg %s: nonce %d not assigned on chain %d, pending nonce high %d\n",
+					xmsg.Index, outbound.OutboundTxTssNonce, outbound.ReceiverChainId, p.NonceHigh)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "chain-nonces",
+			fmt.Sprintf("found %d inconsistent nonces\n%s", broken, msg)), broken != 0
+	}
+}
+
+// PendingOutboundNonceToXmsgInvariant checks that every pending outbound signed by the current TSS
+// can be found from its nonce
+func PendingOutboundNonceToXmsgInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken int
+		)
+
+		tss, found := k.relayerKeeper.GetTSS(ctx)
+		if !found {
+			return sdk.FormatInvariant(types.ModuleName, "pending-outbound-nonce-to-xmsg", "no tss set\n"), false
+		}
+
+		for _, xmsg := range k.GetAllXmsg(ctx) {
+			if !isPendingOutboundOfTss(xmsg, tss.TssPubkey) {
+				continue
+			}
+			outbound := xmsg.GetCurrentOutTxParam()
+
+			// #nosec G701 always in range
+			nonceToXmsg, found := k.relayerKeeper.GetNonceToXmsg(ctx, tss.TssPubkey, outbound.ReceiverChainId, int64(outbound.OutboundTxTssNonce))
+			if !found {
+				broken++
+				msg += fmt.Sprintf("\txmsg %s: no xmsg mapped to nonce %d on chain %d\n",
+					xmsg.Index, outbound.OutboundTxTssNonce, outbound.ReceiverChainId)
+				continue
+			}
+			if nonceToXmsg.XmsgIndex != xmsg.Index {
+				broken++
+				msg += fmt.Sprintf("\txmsg %s: nonce %d on chain %d mapped to xmsg %s\n",
+					xmsg.Index, outbound.OutboundTxTssNonce, outbound.ReceiverChainId, nonceToXmsg.XmsgIndex)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "pending-outbound-nonce-to-xmsg",
+			fmt.Sprintf("found %d pending outbounds without nonce mapping\n%s", broken, msg)), broken != 0
+	}
+}
+
+// isPendingOutboundOfTss returns true if the xmsg waits for an outbound signed by the given TSS
+func isPendingOutboundOfTss(xmsg types.Xmsg, tssPubkey string) bool {
+	if xmsg.XmsgStatus == nil || len(xmsg.OutboundTxParams) == 0 {
+		return false
+	}
+	if xmsg.XmsgStatus.Status != types.XmsgStatus_PENDING_OUTBOUND {
+		return false
+	}
+	return xmsg.GetCurrentOutTxParam().TssPubkey == tssPubkey
+}
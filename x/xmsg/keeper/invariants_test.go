@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/0xPellNetwork/aegis/testutil/keeper"
+	"github.com/0xPellNetwork/aegis/testutil/sample"
+	relayertypes "github.com/0xPellNetwork/aegis/x/relayer/types"
+	"github.com/0xPellNetwork/aegis/x/xmsg/keeper"
+	"github.com/0xPellNetwork/aegis/x/xmsg/types"
+)
+
+func TestInvariants(t *testing.T) {
+	chain := getValidEthChain()
+	tss := sample.Tss_pell()
+
+	// setup sets the nonces of the chain, nonces 0 and 1 are assigned
+	setup := func(t *testing.T, chainNonce uint64) (*keeper.Keeper, sdk.Context) {
+		k, ctx, _, zk := keepertest.XmsgKeeper(t)
+		zk.ObserverKeeper.SetTSS(ctx, tss)
+		zk.ObserverKeeper.SetChainNonces(ctx, relayertypes.ChainNonces{
+			Index:   chain.ChainName(),
+			ChainId: chain.Id,
+			Nonce:   chainNonce,
+		})
+		zk.ObserverKeeper.SetPendingNonces(ctx, relayertypes.PendingNonces{
+			NonceLow:  0,
+			NonceHigh: 2,
+			ChainId:   chain.Id,
+			Tss:       tss.TssPubkey,
+		})
+		return k, ctx
+	}
+
+	pendingXmsg := func(t *testing.T, index string, nonce uint64) types.Xmsg {
+		xmsg := sample.Xmsg_pell(t, index)
+		xmsg.XmsgStatus.Status = types.XmsgStatus_PENDING_OUTBOUND
+		xmsg.GetCurrentOutTxParam().ReceiverChainId = chain.Id
+		xmsg.GetCurrentOutTxParam().OutboundTxTssNonce = nonce
+		xmsg.GetCurrentOutTxParam().TssPubkey = tss.TssPubkey
+		return *xmsg
+	}
+
+	t.Run("should hold for consistent nonces", func(t *testing.T) {
+		k, ctx := setup(t, 2)
+		k.SetXmsgAndNonceToXmsgAndInTxHashToXmsg(ctx, pendingXmsg(t, "a", 0))
+		k.SetXmsgAndNonceToXmsgAndInTxHashToXmsg(ctx, pendingXmsg(t, "b", 1))
+
+		_, broken := keeper.AllInvariants(*k)(ctx)
+		require.False(t, broken)
+	})
+
+	t.Run("should hold without tss", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.XmsgKeeper(t)
+
+		_, broken := keeper.AllInvariants(*k)(ctx)
+		require.False(t, broken)
+	})
+
+	t.Run("should break if the chain nonce differs from the pending nonces", func(t *testing.T) {
+		k, ctx := setup(t, 3)
+
+		msg, broken := keeper.ChainNoncesInvariant(*k)(ctx)
+		require.True(t, broken)
+		require.Contains(t, msg, "chain nonce 3 differs from pending nonce high 2")
+	})
+
+	t.Run("should break if a pending outbound nonce was not assigned", func(t *testing.T) {
+		k, ctx := setup(t, 2)
+		k.SetXmsgAndNonceToXmsgAndInTxHashToXmsg(ctx, pendingXmsg(t, "a", 2))
+
+		msg, broken := keeper.ChainNoncesInvariant(*k)(ctx)
+		require.True(t, broken)
+		require.Contains(t, msg, "nonce 2 not assigned")
+	})
+
+	t.Run("should break if a pending outbound has no nonce mapping", func(t *testing.T) {
+		k, ctx := setup(t, 2)
+		k.SetXmsg(ctx, pendingXmsg(t, "a", 1))
+
+		msg, broken := keeper.PendingOutboundNonceToXmsgInvariant(*k)(ctx)
+		require.True(t, broken)
+		require.Contains(t, msg, "no xmsg mapped to nonce 1")
+	})
+
+	t.Run("should break if the nonce is mapped to another xmsg", func(t *testing.T) {
+		k, ctx := setup(t, 2)
+		k.SetXmsgAndNonceToXmsgAndInTxHashToXmsg(ctx, pendingXmsg(t, "a", 1))
+		other := pendingXmsg(t, "b", 1)
+		other.XmsgStatus.Status = types.XmsgStatus_OUTBOUND_MINED
+		k.SetXmsgAndNonceToXmsgAndInTxHashToXmsg(ctx, other)
+		// the mapping is only updated for pending xmsgs, override it
+		k.GetRelayerKeeper().SetNonceToXmsg(ctx, relayertypes.NonceToXmsg{
+			ChainId:   chain.Id,
+			Nonce:     1,
+			XmsgIndex: other.Index,
+			Tss:       tss.TssPubkey,
+		})
+
+		msg, broken := keeper.PendingOutboundNonceToXmsgInvariant(*k)(ctx)
+		require.True(t, broken)
+		require.Contains(t, msg, "mapped to xmsg "+other.Index)
+	})
+
+	t.Run("should ignore the outbounds of other tss", func(t *testing.T) {
+		k, ctx := setup(t, 2)
+		xmsg := pendingXmsg(t, "a", 5)
+		xmsg.GetCurrentOutTxParam().TssPubkey = "other"
+		k.SetXmsg(ctx, xmsg)
+
+		_, broken := keeper.AllInvariants(*k)(ctx)
+		require.False(t, broken)
+	})
+}
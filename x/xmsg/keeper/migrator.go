@@ -1,5 +1,14 @@
 package keeper
 
+import (
+	"bytes"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/0xPellNetwork/aegis/x/xmsg/types"
+)
+
 // Migrator is a struct for handling in-place store migrations.
 type Migrator struct {
 	crossChainKeeper Keeper
@@ -11,3 +20,23 @@ func NewMigrator(keeper Keeper) Migrator {
 		crossChainKeeper: keeper,
 	}
 }
+
+// Migrate1to2 migrates the store from consensus version 1 to 2
+// it builds the secondary indexes of the existing xmsgs used by the filtered xmsg query
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	store := prefix.NewStore(ctx.KVStore(m.crossChainKeeper.storeKey), types.KeyPrefix(types.SendKey))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var xmsg types.Xmsg
+		m.crossChainKeeper.cdc.MustUnmarshal(iterator.Value(), &xmsg)
+
+		// the xmsgs pending in the inbound event queue are stored by event index and are not indexed
+		if !bytes.Equal(iterator.Key(), types.KeyPrefix(xmsg.Index)) {
+			continue
+		}
+		m.crossChainKeeper.setXmsgIndexes(ctx, xmsg)
+	}
+	return nil
+}
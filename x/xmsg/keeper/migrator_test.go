@@ -0,0 +1,41 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/0xPellNetwork/aegis/testutil/keeper"
+	"github.com/0xPellNetwork/aegis/x/xmsg/keeper"
+	"github.com/0xPellNetwork/aegis/x/xmsg/types"
+)
+
+func TestMigrator_Migrate1to2(t *testing.T) {
+	t.Run("should index the existing xmsgs", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.XmsgKeeper(t)
+
+		// xmsgs stored before the indexes were introduced
+		xmsg1 := createFilterXmsg(t, "1", types.XmsgStatus_PENDING_OUTBOUND, 10)
+		xmsg2 := createFilterXmsg(t, "2", types.XmsgStatus_ABORTED, 20)
+		k.SetXmsgByEventIndex(ctx, xmsg1.Index, xmsg1)
+		k.SetXmsgByEventIndex(ctx, xmsg2.Index, xmsg2)
+
+		// xmsg pending in the inbound event queue
+		pending := createFilterXmsg(t, "3", types.XmsgStatus_PENDING_OUTBOUND, 30)
+		k.SetXmsgByEventIndex(ctx, "event", pending)
+
+		res, err := k.XmsgsByFilter(ctx, &types.QueryXmsgsByFilterRequest{})
+		require.NoError(t, err)
+		require.Empty(t, res.Xmsgs)
+
+		require.NoError(t, keeper.NewMigrator(*k).Migrate1to2(ctx))
+
+		res, err = k.XmsgsByFilter(ctx, &types.QueryXmsgsByFilterRequest{})
+		require.NoError(t, err)
+		require.ElementsMatch(t, []string{xmsg1.Index, xmsg2.Index}, xmsgIndexes(res.Xmsgs))
+
+		res, err = k.XmsgsByFilter(ctx, &types.QueryXmsgsByFilterRequest{MinInboundPellHeight: 20})
+		require.NoError(t, err)
+		require.Equal(t, []string{xmsg2.Index}, xmsgIndexes(res.Xmsgs))
+	})
+}
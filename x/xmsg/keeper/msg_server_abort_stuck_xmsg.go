@@ -41,10 +41,12 @@ func (k msgServer) AbortStuckXmsg(
 		return nil, types.ErrStatusNotPending
 	}
 
+	oldStatus := xmsg.XmsgStatus.Status
 	xmsg.XmsgStatus = &types.Status{
 		Status:        types.XmsgStatus_ABORTED,
 		StatusMessage: AbortMessage,
 	}
+	types.EmitXmsgStatusChanged(ctx, oldStatus.String(), &xmsg)
 
 	k.SetXmsg(ctx, xmsg)
 
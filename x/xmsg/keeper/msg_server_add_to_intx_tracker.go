@@ -7,6 +7,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/0xPellNetwork/aegis/pkg/chains"
 	authoritytypes "github.com/0xPellNetwork/aegis/x/authority/types"
 	relayertypes "github.com/0xPellNetwork/aegis/x/relayer/types"
 	"github.com/0xPellNetwork/aegis/x/xmsg/types"
@@ -32,7 +33,7 @@ func (k msgServer) AddToInTxTracker(goCtx context.Context, msg *types.MsgAddToIn
 		}
 
 		// verify the proof and tx body
-		if err := verifyProofAndInTxBody(ctx, k, msg); err != nil {
+		if err := verifyProofAndInTxBody(ctx, k, msg, *chain); err != nil {
 			return nil, err
 		}
 	}
@@ -47,7 +48,11 @@ func (k msgServer) AddToInTxTracker(goCtx context.Context, msg *types.MsgAddToIn
 }
 
 // verifyProofAndInTxBody verifies the proof and inbound tx body
-func verifyProofAndInTxBody(ctx sdk.Context, k msgServer, msg *types.MsgAddToInTxTracker) error {
+func verifyProofAndInTxBody(ctx sdk.Context, k msgServer, msg *types.MsgAddToInTxTracker, chain chains.Chain) error {
+	if !chain.SupportMerkleProof() {
+		return errorsmod.Wrapf(types.ErrProofVerificationFail, "chain id %d does not support proof-based trackers", msg.ChainId)
+	}
+
 	txBytes, err := k.GetLightclientKeeper().VerifyProof(ctx, msg.Proof, msg.ChainId, msg.BlockHash, msg.TxIndex)
 	if err != nil {
 		return types.ErrProofVerificationFail.Wrapf(err.Error())
@@ -68,7 +73,7 @@ func verifyProofAndInTxBody(ctx sdk.Context, k msgServer, msg *types.MsgAddToInT
 		return relayertypes.ErrTssNotFound.Wrapf("tss address nil")
 	}
 
-	if err := types.VerifyInTxBody(*msg, txBytes, *chainParams, *tss); err != nil {
+	if err := types.VerifyInTxBody(*msg, chain, txBytes, *chainParams, *tss); err != nil {
 		return types.ErrTxBodyVerificationFail.Wrapf(err.Error())
 	}
 
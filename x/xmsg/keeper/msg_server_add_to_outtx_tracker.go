@@ -8,6 +8,7 @@ import (
 	cosmoserrors "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/0xPellNetwork/aegis/pkg/chains"
 	authoritytypes "github.com/0xPellNetwork/aegis/x/authority/types"
 	relayertypes "github.com/0xPellNetwork/aegis/x/relayer/types"
 	"github.com/0xPellNetwork/aegis/x/xmsg/types"
@@ -59,7 +60,7 @@ func (k msgServer) AddToOutTxTracker(goCtx context.Context, msg *types.MsgAddToO
 			return nil, cosmoserrors.Wrap(authoritytypes.ErrUnauthorized, fmt.Sprintf("Creator %s", msg.Signer))
 		}
 		// verify proof when it is provided
-		if err := verifyProofAndOutTxBody(ctx, k, msg); err != nil {
+		if err := verifyProofAndOutTxBody(ctx, k, msg, *chain); err != nil {
 			return nil, err
 		}
 
@@ -115,7 +116,7 @@ func (k msgServer) AddToOutTxTracker(goCtx context.Context, msg *types.MsgAddToO
 
 // verifyProofAndOutTxBody verifies the proof and outbound tx body
 // Precondition: the proof must be non-nil
-func verifyProofAndOutTxBody(ctx sdk.Context, k msgServer, msg *types.MsgAddToOutTxTracker) error {
+func verifyProofAndOutTxBody(ctx sdk.Context, k msgServer, msg *types.MsgAddToOutTxTracker, chain chains.Chain) error {
 	txBytes, err := k.lightclientKeeper.VerifyProof(ctx, msg.Proof, msg.ChainId, msg.BlockHash, msg.TxIndex)
 	if err != nil {
 		return types.ErrProofVerificationFail.Wrapf(err.Error())
@@ -134,7 +135,7 @@ func verifyProofAndOutTxBody(ctx sdk.Context, k msgServer, msg *types.MsgAddToOu
 		return relayertypes.ErrTssNotFound.Wrapf("tss address nil")
 	}
 
-	if err := types.VerifyOutTxBody(*msg, txBytes, *tss); err != nil {
+	if err := types.VerifyOutTxBody(*msg, chain, txBytes, *tss); err != nil {
 		return types.ErrTxBodyVerificationFail.Wrapf(err.Error())
 	}
 
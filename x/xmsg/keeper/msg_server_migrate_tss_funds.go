@@ -31,6 +31,11 @@ func (k msgServer) MigrateTssFunds(goCtx context.Context, msg *types.MsgMigrateT
 		return nil, errorsmod.Wrap(authoritytypes.ErrUnauthorized, "Update can only be executed by the correct policy account")
 	}
 
+	// the funds and the tss address are managed by the tss rotation in progress
+	if k.relayerKeeper.IsTssRotationInProgress(ctx) {
+		return nil, errorsmod.Wrap(types.ErrCannotMigrateTssFunds, "tss rotation in progress")
+	}
+
 	if k.relayerKeeper.IsInboundEnabled(ctx) {
 		return nil, errorsmod.Wrap(types.ErrCannotMigrateTssFunds, "cannot migrate funds while inbound is enabled")
 	}
@@ -75,22 +80,81 @@ func (k msgServer) MigrateTssFunds(goCtx context.Context, msg *types.MsgMigrateT
 	return &types.MsgMigrateTssFundsResponse{}, nil
 }
 
+// MigrateTSSFundsForChain creates the xmsg migrating the gas token balance of the current TSS to the latest TSS
+// on the chain and sets it as the fund migrator of the chain
 func (k Keeper) MigrateTSSFundsForChain(ctx sdk.Context, chainID int64, amount sdkmath.Uint, currentTss relayertypes.TSS, tssList []relayertypes.TSS) error {
+	// The migrate funds can be run again to update the migration xmsg index if the migration fails
+	// This should be used after carefully calculating the amount again
+	existingMigrationInfo, found := k.relayerKeeper.GetFundMigrator(ctx, chainID)
+	if found {
+		olderMigrationXmsg, found := k.GetXmsg(ctx, existingMigrationInfo.MigrationXmsgIndex)
+		if !found {
+			return errorsmod.Wrapf(types.ErrCannotFindXmsg, "cannot find existing migration xmsg but migration info is present for chainID %d , migrator info : %s", chainID, existingMigrationInfo.String())
+		}
+		if olderMigrationXmsg.XmsgStatus.Status == types.XmsgStatus_PENDING_OUTBOUND {
+			return errorsmod.Wrapf(types.ErrUnsupportedStatus, "cannot migrate funds while there are pending migrations , migrator info :  %s", existingMigrationInfo.String())
+		}
+	}
+
+	// Tss migration is a send transaction, so the gas limit is set to 21000
+	xmsg, err := k.migrateTSSBalanceForChain(ctx, chainID, amount, pevmtypes.Transfer, gas.EVMSend, currentTss, tssList)
+	if err != nil {
+		return err
+	}
+
+	k.relayerKeeper.SetFundMigrator(ctx, relayertypes.TssFundMigratorInfo{
+		ChainId:            chainID,
+		MigrationXmsgIndex: xmsg.Index,
+	})
+
+	return nil
+}
+
+// MigrateTSSPellTokenForChain creates the xmsg migrating the pell token balance of the current TSS to the latest TSS
+// on the chain, the TSS holds the pell token in addition to the gas token and the native transfer of the gas token
+// migration doesn't move it. It returns the index of the migration xmsg.
+func (k Keeper) MigrateTSSPellTokenForChain(ctx sdk.Context, chainID int64, amount sdkmath.Uint, currentTss relayertypes.TSS, tssList []relayertypes.TSS) (string, error) {
+	chainParams, found := k.relayerKeeper.GetChainParamsByChainID(ctx, chainID)
+	if !found {
+		return "", errors.New("chain params not found")
+	}
+	if chainParams.PellTokenContractAddress == "" {
+		return "", fmt.Errorf("pell token contract not set for chain %d", chainID)
+	}
+
+	xmsg, err := k.migrateTSSBalanceForChain(ctx, chainID, amount, pevmtypes.PellTokenTransfer, gas.EVMTokenTransfer, currentTss, tssList)
+	if err != nil {
+		return "", err
+	}
+	return xmsg.Index, nil
+}
+
+// migrateTSSBalanceForChain creates the xmsg transferring the amount from the current TSS to the latest TSS on the chain,
+// the param type defines if the gas token or the pell token is transferred
+func (k Keeper) migrateTSSBalanceForChain(
+	ctx sdk.Context,
+	chainID int64,
+	amount sdkmath.Uint,
+	paramType pevmtypes.PellSentParamType,
+	gasLimit uint64,
+	currentTss relayertypes.TSS,
+	tssList []relayertypes.TSS,
+) (*types.Xmsg, error) {
 	// Always migrate to the latest TSS if multiple TSS addresses have been generated
 	newTss := tssList[len(tssList)-1]
 	medianGasPrice, isFound := k.GetMedianGasPriceInUint(ctx, chainID)
 	if !isFound {
-		return types.ErrUnableToGetGasPrice
+		return nil, types.ErrUnableToGetGasPrice
 	}
 
 	currentTssAddr, err := tss.GetTssAddrEVM(currentTss.TssPubkey)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	newTssAddr, err := tss.GetTssAddrEVM(newTss.TssPubkey)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	pellSent := types.InboundPellEvent{
@@ -101,7 +165,7 @@ func (k Keeper) MigrateTSSFundsForChain(ctx sdk.Context, chainID int64, amount s
 				ReceiverChainId:     chainID,
 				Receiver:            newTssAddr.String(),
 				Message:             "",
-				PellParams:          pevmtypes.Transfer.String(),
+				PellParams:          paramType.String(),
 				PellValue:           amount,
 				DestinationGasLimit: sdkmath.NewUint(pevmtypes.PellSentDefaultDestinationGasLimit),
 			},
@@ -110,17 +174,17 @@ func (k Keeper) MigrateTSSFundsForChain(ctx sdk.Context, chainID int64, amount s
 
 	chainParams, found := k.relayerKeeper.GetChainParamsByChainID(ctx, chainID)
 	if !found {
-		return errors.New("chain params not found")
+		return nil, errors.New("chain params not found")
 	}
 
 	senderChain, err := chains.PellChainFromChainID(ctx.ChainID())
 	if err != nil {
-		return errors.New("sender chain not found")
+		return nil, errors.New("sender chain not found")
 	}
 
 	receiverChain := k.relayerKeeper.GetSupportedChainFromChainID(ctx, chainID)
 	if receiverChain == nil {
-		return errors.New("receiver chain not found")
+		return nil, errors.New("receiver chain not found")
 	}
 
 	eventLog := &ethtypes.Log{}
@@ -140,61 +204,48 @@ func (k Keeper) MigrateTSSFundsForChain(ctx sdk.Context, chainID int64, amount s
 
 	xmsg, err := k.processMigrationXmsg(ctx, msg, receiverChain, currentTss.TssPubkey)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Set the sender and receiver addresses for EVM chain
-	if chains.IsEVMChain(chainID) {
+	if receiverChain.IsEVMChain() {
 		ethAddressOld, err := pellcrypto.GetTssAddrEVM(currentTss.TssPubkey)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		ethAddressNew, err := pellcrypto.GetTssAddrEVM(newTss.TssPubkey)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		xmsg.InboundTxParams.Sender = ethAddressOld.String()
 		xmsg.GetCurrentOutTxParam().Receiver = ethAddressNew.String()
-		// Tss migration is a send transaction, so the gas limit is set to 21000
-		xmsg.GetCurrentOutTxParam().OutboundTxGasLimit = gas.EVMSend
+		xmsg.GetCurrentOutTxParam().OutboundTxGasLimit = gasLimit
 		// Multiple current gas price with standard multiplier to add some buffer
 		multipliedGasPrice, err := gas.MultiplyGasPrice(medianGasPrice, types.TssMigrationGasMultiplierEVM)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		xmsg.GetCurrentOutTxParam().OutboundTxGasPrice = multipliedGasPrice.String()
 
 	}
 
 	if xmsg.GetCurrentOutTxParam().Receiver == "" {
-		return errorsmod.Wrap(types.ErrReceiverIsEmpty, fmt.Sprintf("chain %d is not supported", chainID))
-	}
-
-	// The migrate funds can be run again to update the migration xmsg index if the migration fails
-	// This should be used after carefully calculating the amount again
-	existingMigrationInfo, found := k.relayerKeeper.GetFundMigrator(ctx, chainID)
-	if found {
-		olderMigrationXmsg, found := k.GetXmsg(ctx, existingMigrationInfo.MigrationXmsgIndex)
-		if !found {
-			return errorsmod.Wrapf(types.ErrCannotFindXmsg, "cannot find existing migration xmsg but migration info is present for chainID %d , migrator info : %s", chainID, existingMigrationInfo.String())
-		}
-		if olderMigrationXmsg.XmsgStatus.Status == types.XmsgStatus_PENDING_OUTBOUND {
-			return errorsmod.Wrapf(types.ErrUnsupportedStatus, "cannot migrate funds while there are pending migrations , migrator info :  %s", existingMigrationInfo.String())
-		}
+		return nil, errorsmod.Wrap(types.ErrReceiverIsEmpty, fmt.Sprintf("chain %d is not supported", chainID))
 	}
 
 	currCompressedPubkey, err := cosmos.GetPubKeyFromBech32(cosmos.Bech32PubKeyTypeAccPub, currentTss.TssPubkey)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	newCompressedPubkey, err := cosmos.GetPubKeyFromBech32(cosmos.Bech32PubKeyTypeAccPub, newTss.TssPubkey)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	k.Logger(ctx).Info("Migrating TSS funds",
 		"chainID", chainID,
+		"pellParams", paramType.String(),
 		"amount", amount.String(),
 		"currentTssPubkey", currentTss.TssPubkey,
 		"newTssPubkey", newTss.TssPubkey,
@@ -205,13 +256,9 @@ func (k Keeper) MigrateTSSFundsForChain(ctx sdk.Context, chainID int64, amount s
 		"xmsgIndex", xmsg.Index)
 
 	k.SetXmsgAndNonceToXmsgAndInTxHashToXmsg(ctx, *xmsg)
-	k.relayerKeeper.SetFundMigrator(ctx, relayertypes.TssFundMigratorInfo{
-		ChainId:            chainID,
-		MigrationXmsgIndex: xmsg.Index,
-	})
-	EmitEventInboundFinalized(ctx, xmsg)
+	k.EmitEventInboundFinalized(ctx, xmsg)
 
-	return nil
+	return xmsg, nil
 }
 
 func GetIndexStringForTssMigration(currentTssPubkey, newTssPubkey string, chainID int64, amount sdkmath.Uint, height int64) string {
@@ -225,7 +272,7 @@ func (k *Keeper) processMigrationXmsg(ctx sdk.Context, msg *types.MsgVoteOnObser
 		return nil, err
 	}
 
-	xmsg.SetPendingOutbound("Cross-chain message ready for outbound processing from tss funds migration")
+	xmsg.SetPendingOutbound(ctx, "Cross-chain message ready for outbound processing from tss funds migration")
 
 	// Get gas price and amount
 	gasPrice, found := k.GetGasPrice(ctx, receiverChain.Id)
@@ -233,8 +280,8 @@ func (k *Keeper) processMigrationXmsg(ctx sdk.Context, msg *types.MsgVoteOnObser
 		return nil, errors.New("gas price not found")
 	}
 
-	xmsg.GetCurrentOutTxParam().OutboundTxGasPrice = fmt.Sprint(gasPrice.Prices[gasPrice.MedianIndex])
-	EmitEventPellSent(ctx, xmsg)
+	xmsg.GetCurrentOutTxParam().SetGasFromGasPrice(gasPrice)
+	k.EmitEventPellSent(ctx, xmsg)
 
 	if err := k.ProcessXmsg(ctx, xmsg, receiverChain); err != nil {
 		return nil, err
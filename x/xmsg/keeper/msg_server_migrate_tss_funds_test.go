@@ -193,6 +193,7 @@ func TestMsgServer_MigrateTssFunds(t *testing.T) {
 		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_ADMIN, true)
 
 		observerMock := keepertest.GetXmsgObserverMock(t, k)
+		observerMock.On("IsTssRotationInProgress", mock.Anything).Return(false)
 		observerMock.On("IsInboundEnabled", mock.Anything).Return(true)
 		msgServer := keeper.NewMsgServerImpl(*k)
 		chain := getValidEthChain()
@@ -216,6 +217,7 @@ func TestMsgServer_MigrateTssFunds(t *testing.T) {
 		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_ADMIN, true)
 
 		observerMock := keepertest.GetXmsgObserverMock(t, k)
+		observerMock.On("IsTssRotationInProgress", mock.Anything).Return(false)
 		observerMock.On("IsInboundEnabled", mock.Anything).Return(false)
 		observerMock.On("GetTSS", mock.Anything).Return(relayertypes.TSS{}, false)
 
@@ -241,6 +243,7 @@ func TestMsgServer_MigrateTssFunds(t *testing.T) {
 		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_ADMIN, true)
 
 		observerMock := keepertest.GetXmsgObserverMock(t, k)
+		observerMock.On("IsTssRotationInProgress", mock.Anything).Return(false)
 		observerMock.On("IsInboundEnabled", mock.Anything).Return(false)
 		observerMock.On("GetTSS", mock.Anything).Return(sample.Tss_pell(), true)
 		observerMock.On("GetAllTSS", mock.Anything).Return([]relayertypes.TSS{})
@@ -267,6 +270,7 @@ func TestMsgServer_MigrateTssFunds(t *testing.T) {
 		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_ADMIN, true)
 
 		observerMock := keepertest.GetXmsgObserverMock(t, k)
+		observerMock.On("IsTssRotationInProgress", mock.Anything).Return(false)
 		observerMock.On("IsInboundEnabled", mock.Anything).Return(false)
 		tss := sample.Tss_pell()
 		observerMock.On("GetTSS", mock.Anything).Return(tss, true)
@@ -294,6 +298,7 @@ func TestMsgServer_MigrateTssFunds(t *testing.T) {
 		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_ADMIN, true)
 
 		observerMock := keepertest.GetXmsgObserverMock(t, k)
+		observerMock.On("IsTssRotationInProgress", mock.Anything).Return(false)
 		observerMock.On("IsInboundEnabled", mock.Anything).Return(false)
 		tss1 := sample.Tss_pell()
 		tss1.FinalizedPellHeight = 2
@@ -324,6 +329,7 @@ func TestMsgServer_MigrateTssFunds(t *testing.T) {
 		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_ADMIN, true)
 
 		observerMock := keepertest.GetXmsgObserverMock(t, k)
+		observerMock.On("IsTssRotationInProgress", mock.Anything).Return(false)
 		observerMock.On("IsInboundEnabled", mock.Anything).Return(false)
 		tss1 := sample.Tss_pell()
 		tss1.FinalizedPellHeight = 2
@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+
+	relayertypes "github.com/0xPellNetwork/aegis/x/relayer/types"
+	"github.com/0xPellNetwork/aegis/x/xmsg/types"
+)
+
+// ProveInboundTx finalizes an inbound transaction observed on a connected chain with the inclusion proofs of
+// its transaction and receipt against a block header of the lightclient module, without waiting for the ballot.
+// The proofs can only be verified if the lightclient verification flags are enabled for the sender chain, and
+// the proven block header must be the inbound block of the sender chain.
+//
+// The transaction must match the inbound hash and sender, and one of the receipt logs emitted by the chain
+// contracts must decode to the inbound pell event (PellSent or one of the restaking events). The event index
+// is not part of the proof, so the proven event must be the only one of the tx matching the pell event, and it
+// can't be processed again under another event index, through a proof or a ballot. The inbound is then
+// processed like a finalized ballot. If the inbound block is already finalized by the observers, the order of
+// its events is followed; otherwise the inbound is processed right away. Proofs are rejected while inbound is
+// paused for the sender chain.
+//
+// Only observer validators are authorized to broadcast this message. The relayer doesn't build inbound proofs,
+// the message is broadcast by observer tooling proving an inbound ahead of the observation ballot.
+func (k msgServer) ProveInboundTx(goCtx context.Context, msg *types.MsgProveInboundTx) (*types.MsgProveInboundTxResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	inbound := msg.Inbound
+
+	if ok := k.relayerKeeper.IsNonTombstonedObserver(ctx, msg.Signer); !ok {
+		return nil, relayertypes.ErrNotObserver
+	}
+	chain := k.relayerKeeper.GetSupportedChainFromChainID(ctx, inbound.SenderChainId)
+	if chain == nil {
+		return nil, cosmoserrors.Wrap(types.ErrUnsupportedChain, fmt.Sprintf("ChainID : %d ", inbound.SenderChainId))
+	}
+	if !chain.IsEVMChain() || !chain.SupportMerkleProof() {
+		return nil, cosmoserrors.Wrapf(types.ErrProofVerificationFail, "chain id %d does not support inbound proofs", inbound.SenderChainId)
+	}
+	if k.relayerKeeper.IsChainInboundPaused(ctx, inbound.SenderChainId) {
+		return nil, cosmoserrors.Wrapf(relayertypes.ErrChainInboundPaused, "chain id %d", inbound.SenderChainId)
+	}
+
+	if k.IsFinalizedInbound(ctx, inbound.InTxHash, inbound.SenderChainId, inbound.EventIndex) {
+		return nil, cosmoserrors.Wrap(
+			types.ErrObservedTxAlreadyFinalized,
+			fmt.Sprintf("InTxHash:%s, SenderChainId:%d, EventIndex:%d", inbound.InTxHash, inbound.SenderChainId, inbound.EventIndex),
+		)
+	}
+
+	// the event index is not proven, the proven event must not be processed under any other event index
+	inboundEventDigest := types.InboundEventDigest(inbound.SenderChainId, inbound.InTxHash, inbound.PellTx)
+	if _, found := k.GetProvenInbound(ctx, inboundEventDigest); found ||
+		k.isInboundEventProcessed(ctx, inbound.SenderChainId, inbound.InTxHash, inboundEventDigest) {
+		return nil, cosmoserrors.Wrap(
+			types.ErrObservedTxAlreadyFinalized,
+			fmt.Sprintf("InTxHash:%s, SenderChainId:%d, pell event already processed", inbound.InTxHash, inbound.SenderChainId),
+		)
+	}
+
+	// the proven block must be the inbound block known by the lightclient
+	header, found := k.GetLightclientKeeper().GetBlockHeader(ctx, ethcommon.HexToHash(msg.BlockHash).Bytes())
+	if !found {
+		return nil, cosmoserrors.Wrapf(types.ErrInboundProofVerificationFail, "block header %s not found", msg.BlockHash)
+	}
+	// #nosec G115 block height always positive
+	if header.ChainId != inbound.SenderChainId || uint64(header.Height) != inbound.InBlockHeight {
+		return nil, cosmoserrors.Wrapf(
+			types.ErrInboundProofVerificationFail,
+			"block header %s is block %d of chain %d, expected block %d of chain %d",
+			msg.BlockHash,
+			header.Height,
+			header.ChainId,
+			inbound.InBlockHeight,
+			inbound.SenderChainId,
+		)
+	}
+
+	if err := verifyInboundProof(ctx, k, msg); err != nil {
+		return nil, err
+	}
+
+	k.SetProvenInbound(ctx, inboundEventDigest, inbound.EventIndex)
+
+	// the block of the proven inbound may not be finalized by the observers yet, the inbound is then
+	// processed right away and its event is linked to the other events of the block once finalized
+	if _, found := k.GetEventStatusNode(ctx, inbound.Digest()); !found {
+		k.SetEventStatusNode(ctx, inbound.Digest(), types.EventStatusNode{
+			EventIndexInBlock: inbound.EventIndex,
+			Status:            types.EventStatus_PENDING,
+		})
+	}
+
+	if err := k.processInboundEvent(ctx, &inbound); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgProveInboundTxResponse{}, nil
+}
+
+// verifyInboundProof verifies the tx and receipt proofs and the inbound against the proven tx and receipt
+func verifyInboundProof(ctx sdk.Context, k msgServer, msg *types.MsgProveInboundTx) error {
+	chainID := msg.Inbound.SenderChainId
+	txBytes, err := k.GetLightclientKeeper().VerifyProof(ctx, msg.TxProof, chainID, msg.BlockHash, msg.TxIndex)
+	if err != nil {
+		return types.ErrProofVerificationFail.Wrapf(err.Error())
+	}
+	receiptBytes, err := k.GetLightclientKeeper().VerifyReceiptProof(ctx, msg.ReceiptProof, chainID, msg.BlockHash, msg.TxIndex)
+	if err != nil {
+		return types.ErrProofVerificationFail.Wrapf(err.Error())
+	}
+
+	chainParams, found := k.GetRelayerKeeper().GetChainParamsByChainID(ctx, chainID)
+	if !found || chainParams == nil {
+		return types.ErrUnsupportedChain.Wrapf("chain params not found for chain %d", chainID)
+	}
+
+	if err := types.VerifyInboundProof(msg.Inbound, txBytes, receiptBytes, *chainParams); err != nil {
+		return types.ErrInboundProofVerificationFail.Wrapf(err.Error())
+	}
+	return nil
+}
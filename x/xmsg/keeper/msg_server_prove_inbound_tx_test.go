@@ -0,0 +1,402 @@
+package keeper_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/pkg/chains"
+	"github.com/0xPellNetwork/aegis/pkg/proofs"
+	keepertest "github.com/0xPellNetwork/aegis/testutil/keeper"
+	mocks "github.com/0xPellNetwork/aegis/testutil/keeper/mocks/xmsg"
+	"github.com/0xPellNetwork/aegis/testutil/sample"
+	relayertypes "github.com/0xPellNetwork/aegis/x/relayer/types"
+	"github.com/0xPellNetwork/aegis/x/xmsg/keeper"
+	"github.com/0xPellNetwork/aegis/x/xmsg/types"
+)
+
+// proveDepositInbound returns a message proving a deposit inbound, along with the proven tx and receipt
+func proveDepositInbound(t *testing.T, chainParams relayertypes.ChainParams) (*types.MsgProveInboundTx, []byte, []byte) {
+	chainID := chainParams.ChainId
+	tx, txBytes, sender := sample.EthTxSigned(t, chainID, sample.EthAddress(), 42)
+	token, strategy := sample.EthAddress(), sample.EthAddress()
+
+	event := types.InboundEventsABI.Events["Deposit"]
+	data, err := event.Inputs.NonIndexed().Pack(sender, token, strategy, big.NewInt(1000))
+	require.NoError(t, err)
+	receipt := ethtypes.Receipt{
+		Type:   ethtypes.DynamicFeeTxType,
+		Status: ethtypes.ReceiptStatusSuccessful,
+		Logs: []*ethtypes.Log{{
+			Address: ethcommon.HexToAddress(chainParams.StrategyManagerContractAddress),
+			Topics:  []ethcommon.Hash{event.ID},
+			Data:    data,
+		}},
+	}
+	receiptBytes, err := receipt.MarshalBinary()
+	require.NoError(t, err)
+
+	inbound := types.MsgVoteOnObservedInboundTx{
+		Sender:        sender.Hex(),
+		SenderChainId: chainID,
+		TxOrigin:      sender.Hex(),
+		Receiver:      sender.Hex(),
+		ReceiverChain: chains.PellPrivnetChain().Id,
+		InTxHash:      tx.Hash().Hex(),
+		InBlockHeight: 100,
+		EventIndex:    0,
+		PellTx: &types.InboundPellEvent{
+			PellData: &types.InboundPellEvent_StakerDeposited{
+				StakerDeposited: &types.StakerDeposited{
+					Staker:   sender.Hex(),
+					Token:    token.Hex(),
+					Strategy: strategy.Hex(),
+					Shares:   sdkmath.NewUint(1000),
+				},
+			},
+		},
+	}
+	txProof, _, _, _, _, _ := sample.Proof(t)
+	receiptProof, _ := sample.ReceiptProof(t)
+	msg := types.NewMsgProveInboundTx(sample.AccAddress(), inbound, sample.Hash().Hex(), 2, txProof, receiptProof)
+
+	return msg, txBytes, receiptBytes
+}
+
+// mockInboundBlockHeader mocks the lightclient block header of the proven block
+func mockInboundBlockHeader(lightclientMock *mocks.XmsgLightclientKeeper, msg *types.MsgProveInboundTx, height int64) {
+	blockHash := ethcommon.HexToHash(msg.BlockHash).Bytes()
+	lightclientMock.On("GetBlockHeader", mock.Anything, blockHash).Return(proofs.BlockHeader{
+		Height:  height,
+		Hash:    blockHash,
+		ChainId: msg.Inbound.SenderChainId,
+	}, true)
+}
+
+// setInboundEventNode sets the status node of the inbound event, as set by the finalized block proof
+func setInboundEventNode(ctx sdk.Context, k *keeper.Keeper, msg *types.MsgProveInboundTx, prevEventIndex string) {
+	k.SetEventStatusNode(ctx, msg.Inbound.Digest(), types.EventStatusNode{
+		PrevEventIndex:    prevEventIndex,
+		EventIndexInBlock: msg.Inbound.EventIndex,
+		Status:            types.EventStatus_PENDING,
+	})
+}
+
+func TestMsgServer_ProveInboundTx(t *testing.T) {
+	sepolia := chains.SepoliaChain()
+	chainID := sepolia.Id
+	chainParams := *sample.ChainParams_pell(chainID)
+
+	t.Run("should fail if not observer", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.XmsgKeeperWithMocks(t, keepertest.XmsgMockOptions{
+			UseObserverMock: true,
+		})
+		msgServer := keeper.NewMsgServerImpl(*k)
+		msg, _, _ := proveDepositInbound(t, chainParams)
+
+		observerMock := keepertest.GetXmsgObserverMock(t, k)
+		observerMock.On("IsNonTombstonedObserver", mock.Anything, msg.Signer).Return(false)
+
+		_, err := msgServer.ProveInboundTx(ctx, msg)
+		require.ErrorIs(t, err, relayertypes.ErrNotObserver)
+	})
+
+	t.Run("should fail if chain is not supported", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.XmsgKeeperWithMocks(t, keepertest.XmsgMockOptions{
+			UseObserverMock: true,
+		})
+		msgServer := keeper.NewMsgServerImpl(*k)
+		msg, _, _ := proveDepositInbound(t, chainParams)
+
+		observerMock := keepertest.GetXmsgObserverMock(t, k)
+		observerMock.On("IsNonTombstonedObserver", mock.Anything, msg.Signer).Return(true)
+		observerMock.On("GetSupportedChainFromChainID", mock.Anything, chainID).Return(nil)
+
+		_, err := msgServer.ProveInboundTx(ctx, msg)
+		require.ErrorIs(t, err, types.ErrUnsupportedChain)
+	})
+
+	t.Run("should fail if chain doesn't support proofs", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.XmsgKeeperWithMocks(t, keepertest.XmsgMockOptions{
+			UseObserverMock: true,
+		})
+		msgServer := keeper.NewMsgServerImpl(*k)
+		msg, _, _ := proveDepositInbound(t, chainParams)
+
+		pellChain := chains.PellPrivnetChain()
+		pellChain.Id = chainID
+		observerMock := keepertest.GetXmsgObserverMock(t, k)
+		observerMock.On("IsNonTombstonedObserver", mock.Anything, msg.Signer).Return(true)
+		keepertest.MockGetSupportedChainFromChainID_pell(observerMock, &pellChain)
+
+		_, err := msgServer.ProveInboundTx(ctx, msg)
+		require.ErrorIs(t, err, types.ErrProofVerificationFail)
+	})
+
+	t.Run("should fail if inbound is paused for the chain", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.XmsgKeeperWithMocks(t, keepertest.XmsgMockOptions{
+			UseObserverMock: true,
+		})
+		msgServer := keeper.NewMsgServerImpl(*k)
+		msg, _, _ := proveDepositInbound(t, chainParams)
+
+		observerMock := keepertest.GetXmsgObserverMock(t, k)
+		observerMock.On("IsNonTombstonedObserver", mock.Anything, msg.Signer).Return(true)
+		keepertest.MockGetSupportedChainFromChainID_pell(observerMock, &sepolia)
+		observerMock.On("IsChainInboundPaused", mock.Anything, chainID).Return(true)
+
+		_, err := msgServer.ProveInboundTx(ctx, msg)
+		require.ErrorIs(t, err, relayertypes.ErrChainInboundPaused)
+	})
+
+	t.Run("should fail if inbound already finalized", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.XmsgKeeperWithMocks(t, keepertest.XmsgMockOptions{
+			UseObserverMock: true,
+		})
+		msgServer := keeper.NewMsgServerImpl(*k)
+		msg, _, _ := proveDepositInbound(t, chainParams)
+
+		observerMock := keepertest.GetXmsgObserverMock(t, k)
+		observerMock.On("IsNonTombstonedObserver", mock.Anything, msg.Signer).Return(true)
+		keepertest.MockGetSupportedChainFromChainID_pell(observerMock, &sepolia)
+		observerMock.On("IsChainInboundPaused", mock.Anything, chainID).Return(false)
+		k.AddFinalizedInbound(ctx, msg.Inbound.InTxHash, chainID, msg.Inbound.EventIndex)
+
+		_, err := msgServer.ProveInboundTx(ctx, msg)
+		require.ErrorIs(t, err, types.ErrObservedTxAlreadyFinalized)
+	})
+
+	t.Run("should fail if the pell event was proven under another event index", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.XmsgKeeperWithMocks(t, keepertest.XmsgMockOptions{
+			UseObserverMock: true,
+		})
+		msgServer := keeper.NewMsgServerImpl(*k)
+		msg, _, _ := proveDepositInbound(t, chainParams)
+
+		observerMock := keepertest.GetXmsgObserverMock(t, k)
+		observerMock.On("IsNonTombstonedObserver", mock.Anything, msg.Signer).Return(true)
+		keepertest.MockGetSupportedChainFromChainID_pell(observerMock, &sepolia)
+		observerMock.On("IsChainInboundPaused", mock.Anything, chainID).Return(false)
+
+		k.SetProvenInbound(ctx, types.InboundEventDigest(chainID, msg.Inbound.InTxHash, msg.Inbound.PellTx), msg.Inbound.EventIndex)
+		msg.Inbound.EventIndex++
+
+		_, err := msgServer.ProveInboundTx(ctx, msg)
+		require.ErrorIs(t, err, types.ErrObservedTxAlreadyFinalized)
+	})
+
+	t.Run("should fail if a xmsg was already created for the pell event", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.XmsgKeeperWithMocks(t, keepertest.XmsgMockOptions{
+			UseObserverMock: true,
+		})
+		msgServer := keeper.NewMsgServerImpl(*k)
+		msg, _, _ := proveDepositInbound(t, chainParams)
+
+		observerMock := keepertest.GetXmsgObserverMock(t, k)
+		observerMock.On("IsNonTombstonedObserver", mock.Anything, msg.Signer).Return(true)
+		keepertest.MockGetSupportedChainFromChainID_pell(observerMock, &sepolia)
+		observerMock.On("IsChainInboundPaused", mock.Anything, chainID).Return(false)
+		observerMock.On("GetTSS", mock.Anything).Return(sample.Tss_pell(), true)
+
+		xmsg, err := types.NewXmsg(ctx, msg.Inbound, sample.Tss_pell().TssPubkey)
+		require.NoError(t, err)
+		k.SetXmsgAndNonceToXmsgAndInTxHashToXmsg(ctx, xmsg)
+		msg.Inbound.EventIndex++
+
+		_, err = msgServer.ProveInboundTx(ctx, msg)
+		require.ErrorIs(t, err, types.ErrObservedTxAlreadyFinalized)
+	})
+
+	t.Run("should fail if the proven block header is not found", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.XmsgKeeperWithMocks(t, keepertest.XmsgMockOptions{
+			UseObserverMock: true,
+		})
+		msgServer := keeper.NewMsgServerImpl(*k)
+		msg, _, _ := proveDepositInbound(t, chainParams)
+
+		observerMock := keepertest.GetXmsgObserverMock(t, k)
+		observerMock.On("IsNonTombstonedObserver", mock.Anything, msg.Signer).Return(true)
+		keepertest.MockGetSupportedChainFromChainID_pell(observerMock, &sepolia)
+		observerMock.On("IsChainInboundPaused", mock.Anything, chainID).Return(false)
+
+		_, err := msgServer.ProveInboundTx(ctx, msg)
+		require.ErrorIs(t, err, types.ErrInboundProofVerificationFail)
+	})
+
+	t.Run("should fail if the proven block is not the inbound block", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.XmsgKeeperWithMocks(t, keepertest.XmsgMockOptions{
+			UseObserverMock:    true,
+			UseLightclientMock: true,
+		})
+		msgServer := keeper.NewMsgServerImpl(*k)
+		msg, _, _ := proveDepositInbound(t, chainParams)
+
+		observerMock := keepertest.GetXmsgObserverMock(t, k)
+		lightclientMock := keepertest.GetXmsgLightclientMock(t, k)
+		observerMock.On("IsNonTombstonedObserver", mock.Anything, msg.Signer).Return(true)
+		keepertest.MockGetSupportedChainFromChainID_pell(observerMock, &sepolia)
+		observerMock.On("IsChainInboundPaused", mock.Anything, chainID).Return(false)
+		mockInboundBlockHeader(lightclientMock, msg, int64(msg.Inbound.InBlockHeight)+1)
+
+		_, err := msgServer.ProveInboundTx(ctx, msg)
+		require.ErrorIs(t, err, types.ErrInboundProofVerificationFail)
+	})
+
+	t.Run("should fail if proof verification fails", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.XmsgKeeperWithMocks(t, keepertest.XmsgMockOptions{
+			UseObserverMock:    true,
+			UseLightclientMock: true,
+		})
+		msgServer := keeper.NewMsgServerImpl(*k)
+		msg, txBytes, _ := proveDepositInbound(t, chainParams)
+
+		observerMock := keepertest.GetXmsgObserverMock(t, k)
+		lightclientMock := keepertest.GetXmsgLightclientMock(t, k)
+		observerMock.On("IsNonTombstonedObserver", mock.Anything, msg.Signer).Return(true)
+		keepertest.MockGetSupportedChainFromChainID_pell(observerMock, &sepolia)
+		observerMock.On("IsChainInboundPaused", mock.Anything, chainID).Return(false)
+		lightclientMock.On("VerifyProof", mock.Anything, msg.TxProof, chainID, msg.BlockHash, msg.TxIndex).
+			Return(txBytes, nil)
+		lightclientMock.On("VerifyReceiptProof", mock.Anything, msg.ReceiptProof, chainID, msg.BlockHash, msg.TxIndex).
+			Return(nil, errors.New("verification flags disabled"))
+		mockInboundBlockHeader(lightclientMock, msg, int64(msg.Inbound.InBlockHeight))
+
+		_, err := msgServer.ProveInboundTx(ctx, msg)
+		require.ErrorIs(t, err, types.ErrProofVerificationFail)
+	})
+
+	t.Run("should fail if the receipt doesn't contain the pell event", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.XmsgKeeperWithMocks(t, keepertest.XmsgMockOptions{
+			UseObserverMock:    true,
+			UseLightclientMock: true,
+		})
+		msgServer := keeper.NewMsgServerImpl(*k)
+		msg, txBytes, receiptBytes := proveDepositInbound(t, chainParams)
+		msg.Inbound.PellTx.GetStakerDeposited().Shares = sdkmath.NewUint(1)
+
+		observerMock := keepertest.GetXmsgObserverMock(t, k)
+		lightclientMock := keepertest.GetXmsgLightclientMock(t, k)
+		observerMock.On("IsNonTombstonedObserver", mock.Anything, msg.Signer).Return(true)
+		keepertest.MockGetSupportedChainFromChainID_pell(observerMock, &sepolia)
+		observerMock.On("IsChainInboundPaused", mock.Anything, chainID).Return(false)
+		observerMock.On("GetChainParamsByChainID", mock.Anything, chainID).Return(&chainParams, true)
+		lightclientMock.On("VerifyProof", mock.Anything, msg.TxProof, chainID, msg.BlockHash, msg.TxIndex).
+			Return(txBytes, nil)
+		lightclientMock.On("VerifyReceiptProof", mock.Anything, msg.ReceiptProof, chainID, msg.BlockHash, msg.TxIndex).
+			Return(receiptBytes, nil)
+		mockInboundBlockHeader(lightclientMock, msg, int64(msg.Inbound.InBlockHeight))
+
+		_, err := msgServer.ProveInboundTx(ctx, msg)
+		require.ErrorIs(t, err, types.ErrInboundProofVerificationFail)
+	})
+
+	t.Run("should queue the proven inbound until the previous event is processed", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.XmsgKeeperWithMocks(t, keepertest.XmsgMockOptions{
+			UseObserverMock:    true,
+			UseLightclientMock: true,
+		})
+		msgServer := keeper.NewMsgServerImpl(*k)
+		msg, txBytes, receiptBytes := proveDepositInbound(t, chainParams)
+
+		observerMock := keepertest.GetXmsgObserverMock(t, k)
+		lightclientMock := keepertest.GetXmsgLightclientMock(t, k)
+		observerMock.On("IsNonTombstonedObserver", mock.Anything, msg.Signer).Return(true)
+		keepertest.MockGetSupportedChainFromChainID_pell(observerMock, &sepolia)
+		observerMock.On("IsChainInboundPaused", mock.Anything, chainID).Return(false)
+		observerMock.On("GetChainParamsByChainID", mock.Anything, chainID).Return(&chainParams, true)
+		observerMock.On("GetTSS", mock.Anything).Return(sample.Tss_pell(), true)
+		lightclientMock.On("VerifyProof", mock.Anything, msg.TxProof, chainID, msg.BlockHash, msg.TxIndex).
+			Return(txBytes, nil)
+		lightclientMock.On("VerifyReceiptProof", mock.Anything, msg.ReceiptProof, chainID, msg.BlockHash, msg.TxIndex).
+			Return(receiptBytes, nil)
+
+		prevEventIndex := sample.Hash().Hex()
+		k.SetEventStatusNode(ctx, prevEventIndex, types.EventStatusNode{Status: types.EventStatus_PENDING})
+		mockInboundBlockHeader(lightclientMock, msg, int64(msg.Inbound.InBlockHeight))
+		setInboundEventNode(ctx, k, msg, prevEventIndex)
+
+		_, err := msgServer.ProveInboundTx(ctx, msg)
+		require.NoError(t, err)
+
+		_, found := k.GetXmsgByEventIndex(ctx, msg.Inbound.Digest())
+		require.True(t, found)
+		require.False(t, k.IsFinalizedInbound(ctx, msg.Inbound.InTxHash, chainID, msg.Inbound.EventIndex))
+	})
+
+	t.Run("should not process again an event already processed", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.XmsgKeeperWithMocks(t, keepertest.XmsgMockOptions{
+			UseObserverMock:    true,
+			UseLightclientMock: true,
+		})
+		msgServer := keeper.NewMsgServerImpl(*k)
+		msg, txBytes, receiptBytes := proveDepositInbound(t, chainParams)
+
+		observerMock := keepertest.GetXmsgObserverMock(t, k)
+		lightclientMock := keepertest.GetXmsgLightclientMock(t, k)
+		observerMock.On("IsNonTombstonedObserver", mock.Anything, msg.Signer).Return(true)
+		keepertest.MockGetSupportedChainFromChainID_pell(observerMock, &sepolia)
+		observerMock.On("IsChainInboundPaused", mock.Anything, chainID).Return(false)
+		observerMock.On("GetChainParamsByChainID", mock.Anything, chainID).Return(&chainParams, true)
+		lightclientMock.On("VerifyProof", mock.Anything, msg.TxProof, chainID, msg.BlockHash, msg.TxIndex).
+			Return(txBytes, nil)
+		lightclientMock.On("VerifyReceiptProof", mock.Anything, msg.ReceiptProof, chainID, msg.BlockHash, msg.TxIndex).
+			Return(receiptBytes, nil)
+
+		mockInboundBlockHeader(lightclientMock, msg, int64(msg.Inbound.InBlockHeight))
+		k.SetEventStatusNode(ctx, msg.Inbound.Digest(), types.EventStatusNode{
+			EventIndexInBlock: msg.Inbound.EventIndex,
+			Status:            types.EventStatus_DONE,
+		})
+
+		_, err := msgServer.ProveInboundTx(ctx, msg)
+		require.NoError(t, err)
+
+		_, found := k.GetXmsgByEventIndex(ctx, msg.Inbound.Digest())
+		require.False(t, found)
+		observerMock.AssertNotCalled(t, "GetTSS", mock.Anything)
+	})
+
+	t.Run("should process the proven inbound before its block is finalized", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.XmsgKeeperWithMocks(t, keepertest.XmsgMockOptions{
+			UseObserverMock:    true,
+			UseLightclientMock: true,
+			UsePevmMock:        true,
+		})
+		msgServer := keeper.NewMsgServerImpl(*k)
+		msg, txBytes, receiptBytes := proveDepositInbound(t, chainParams)
+
+		observerMock := keepertest.GetXmsgObserverMock(t, k)
+		lightclientMock := keepertest.GetXmsgLightclientMock(t, k)
+		pevmMock := keepertest.GetXmsgPevmMock(t, k)
+		observerMock.On("IsNonTombstonedObserver", mock.Anything, msg.Signer).Return(true)
+		keepertest.MockGetSupportedChainFromChainID_pell(observerMock, &sepolia)
+		observerMock.On("IsChainInboundPaused", mock.Anything, chainID).Return(false)
+		observerMock.On("GetChainParamsByChainID", mock.Anything, chainID).Return(&chainParams, true)
+		observerMock.On("GetTSS", mock.Anything).Return(sample.Tss_pell(), true)
+		lightclientMock.On("VerifyProof", mock.Anything, msg.TxProof, chainID, msg.BlockHash, msg.TxIndex).
+			Return(txBytes, nil)
+		lightclientMock.On("VerifyReceiptProof", mock.Anything, msg.ReceiptProof, chainID, msg.BlockHash, msg.TxIndex).
+			Return(receiptBytes, nil)
+		mockInboundBlockHeader(lightclientMock, msg, int64(msg.Inbound.InBlockHeight))
+		pevmMock.On("GetPellStrategyManagerProxyContractAddress", mock.Anything).Return(sample.EthAddress(), nil)
+		pevmMock.On("CallSyncDepositStateOnPellStrategyManager",
+			mock.Anything, mock.Anything, chainID, mock.Anything, mock.Anything, mock.Anything).
+			Return(&evmtypes.MsgEthereumTxResponse{}, false, nil)
+
+		_, err := msgServer.ProveInboundTx(ctx, msg)
+		require.NoError(t, err)
+
+		require.True(t, k.IsFinalizedInbound(ctx, msg.Inbound.InTxHash, chainID, msg.Inbound.EventIndex))
+		node, found := k.GetEventStatusNode(ctx, msg.Inbound.Digest())
+		require.True(t, found)
+		require.Equal(t, types.EventStatus_DONE, node.Status)
+	})
+}
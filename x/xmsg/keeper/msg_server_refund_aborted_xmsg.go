@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	authoritytypes "github.com/0xPellNetwork/aegis/x/authority/types"
+	"github.com/0xPellNetwork/aegis/x/xmsg/types"
+)
+
+// RefundAbortedXmsg refunds the value locked by an aborted Xmsg.
+// The refund goes to the refund address of the message, or to the tx origin of the Xmsg if not provided.
+// An Xmsg can only be refunded once.
+// Authorized: admin policy group 2
+func (k msgServer) RefundAbortedXmsg(
+	goCtx context.Context,
+	msg *types.MsgRefundAbortedXmsg,
+) (*types.MsgRefundAbortedXmsgResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// check if authorized
+	if !k.GetAuthorityKeeper().IsAuthorized(ctx, msg.Signer, authoritytypes.PolicyType_GROUP_OPERATIONAL) {
+		return nil, authoritytypes.ErrUnauthorized
+	}
+
+	// check if the xmsg exists
+	xmsg, found := k.GetXmsg(ctx, msg.XmsgIndex)
+	if !found {
+		return nil, types.ErrCannotFindXmsg
+	}
+
+	// check if the xmsg is aborted
+	if xmsg.XmsgStatus.Status != types.XmsgStatus_ABORTED {
+		return nil, errorsmod.Wrap(types.ErrInvalidStatus, "Xmsg is not aborted")
+	}
+
+	// check if the xmsg is not refunded yet
+	if xmsg.XmsgStatus.IsAbortRefunded {
+		return nil, errorsmod.Wrap(types.ErrUnableProcessRefund, "Xmsg is already refunded")
+	}
+
+	refundAddress, err := GetRefundAddress(xmsg, msg.RefundAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// refund the aborted amount
+	if err := k.RefundAbortedAmountOnPellChain(ctx, xmsg, refundAddress); err != nil {
+		return nil, err
+	}
+
+	// mark the xmsg as refunded so it can't be refunded twice
+	xmsg.XmsgStatus.AbortRefunded(ctx.BlockHeader().Time.Unix())
+	k.SetXmsg(ctx, xmsg)
+
+	EmitEventAbortedXmsgRefunded(ctx, xmsg.Index, refundAddress.Hex(), xmsg.GetAbortedAmount().String())
+
+	return &types.MsgRefundAbortedXmsgResponse{}, nil
+}
@@ -0,0 +1,223 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/cmd/pellcored/config"
+	keepertest "github.com/0xPellNetwork/aegis/testutil/keeper"
+	"github.com/0xPellNetwork/aegis/testutil/sample"
+	authoritytypes "github.com/0xPellNetwork/aegis/x/authority/types"
+	xmsgkeeper "github.com/0xPellNetwork/aegis/x/xmsg/keeper"
+	xmsgtypes "github.com/0xPellNetwork/aegis/x/xmsg/types"
+)
+
+// abortedPellSentXmsg returns an aborted xmsg carrying a PellSent event with the given value
+func abortedPellSentXmsg(t *testing.T, index string, value uint64) *xmsgtypes.Xmsg {
+	xmsg := sample.Xmsg_pell(t, index)
+	xmsg.XmsgStatus = &xmsgtypes.Status{
+		Status:        xmsgtypes.XmsgStatus_ABORTED,
+		StatusMessage: "aborted",
+	}
+	xmsg.InboundTxParams.InboundPellTx = sample.InboundPellTx_PellSend_pell(sample.Rand())
+	xmsg.InboundTxParams.InboundPellTx.GetPellSent().PellValue = sdkmath.NewUint(value)
+	return xmsg
+}
+
+func TestMsgServer_RefundAbortedXmsg(t *testing.T) {
+	t.Run("can refund an aborted xmsg to the tx origin", func(t *testing.T) {
+		k, ctx, sdkk, _ := keepertest.XmsgKeeperWithMocks(t, keepertest.XmsgMockOptions{
+			UseAuthorityMock: true,
+		})
+
+		msgServer := xmsgkeeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetXmsgAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_OPERATIONAL, true)
+
+		xmsg := abortedPellSentXmsg(t, "xmsg_index", 42)
+		k.SetXmsg(ctx, *xmsg)
+
+		_, err := msgServer.RefundAbortedXmsg(ctx, &xmsgtypes.MsgRefundAbortedXmsg{
+			Signer:    admin,
+			XmsgIndex: sample.GetXmsgIndicesFromString_pell("xmsg_index"),
+		})
+		require.NoError(t, err)
+
+		xmsgFound, found := k.GetXmsg(ctx, sample.GetXmsgIndicesFromString_pell("xmsg_index"))
+		require.True(t, found)
+		require.True(t, xmsgFound.XmsgStatus.IsAbortRefunded)
+		require.Equal(t, xmsgtypes.XmsgStatus_ABORTED, xmsgFound.XmsgStatus.Status)
+
+		txOrigin := ethcommon.HexToAddress(xmsg.InboundTxParams.TxOrigin)
+		balance := sdkk.BankKeeper.GetBalance(ctx, sdk.AccAddress(txOrigin.Bytes()), config.BaseDenom)
+		require.Equal(t, int64(42), balance.Amount.Int64())
+	})
+
+	t.Run("can refund an aborted xmsg to the provided refund address", func(t *testing.T) {
+		k, ctx, sdkk, _ := keepertest.XmsgKeeperWithMocks(t, keepertest.XmsgMockOptions{
+			UseAuthorityMock: true,
+		})
+
+		msgServer := xmsgkeeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetXmsgAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_OPERATIONAL, true)
+
+		xmsg := abortedPellSentXmsg(t, "xmsg_index", 42)
+		k.SetXmsg(ctx, *xmsg)
+		refundAddress := sample.EthAddress()
+
+		_, err := msgServer.RefundAbortedXmsg(ctx, &xmsgtypes.MsgRefundAbortedXmsg{
+			Signer:        admin,
+			XmsgIndex:     sample.GetXmsgIndicesFromString_pell("xmsg_index"),
+			RefundAddress: refundAddress.Hex(),
+		})
+		require.NoError(t, err)
+
+		balance := sdkk.BankKeeper.GetBalance(ctx, sdk.AccAddress(refundAddress.Bytes()), config.BaseDenom)
+		require.Equal(t, int64(42), balance.Amount.Int64())
+
+		txOrigin := ethcommon.HexToAddress(xmsg.InboundTxParams.TxOrigin)
+		balance = sdkk.BankKeeper.GetBalance(ctx, sdk.AccAddress(txOrigin.Bytes()), config.BaseDenom)
+		require.True(t, balance.Amount.IsZero())
+	})
+
+	t.Run("cannot refund an aborted xmsg twice", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.XmsgKeeperWithMocks(t, keepertest.XmsgMockOptions{
+			UseAuthorityMock: true,
+		})
+
+		msgServer := xmsgkeeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetXmsgAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_OPERATIONAL, true)
+
+		xmsg := abortedPellSentXmsg(t, "xmsg_index", 42)
+		k.SetXmsg(ctx, *xmsg)
+
+		msg := &xmsgtypes.MsgRefundAbortedXmsg{
+			Signer:    admin,
+			XmsgIndex: sample.GetXmsgIndicesFromString_pell("xmsg_index"),
+		}
+		_, err := msgServer.RefundAbortedXmsg(ctx, msg)
+		require.NoError(t, err)
+
+		_, err = msgServer.RefundAbortedXmsg(ctx, msg)
+		require.ErrorIs(t, err, xmsgtypes.ErrUnableProcessRefund)
+	})
+
+	t.Run("cannot refund a xmsg that is not aborted", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.XmsgKeeperWithMocks(t, keepertest.XmsgMockOptions{
+			UseAuthorityMock: true,
+		})
+
+		msgServer := xmsgkeeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetXmsgAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_OPERATIONAL, true)
+
+		xmsg := abortedPellSentXmsg(t, "xmsg_index", 42)
+		xmsg.XmsgStatus.Status = xmsgtypes.XmsgStatus_PENDING_OUTBOUND
+		k.SetXmsg(ctx, *xmsg)
+
+		_, err := msgServer.RefundAbortedXmsg(ctx, &xmsgtypes.MsgRefundAbortedXmsg{
+			Signer:    admin,
+			XmsgIndex: sample.GetXmsgIndicesFromString_pell("xmsg_index"),
+		})
+		require.ErrorIs(t, err, xmsgtypes.ErrInvalidStatus)
+	})
+
+	t.Run("cannot refund a xmsg without value", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.XmsgKeeperWithMocks(t, keepertest.XmsgMockOptions{
+			UseAuthorityMock: true,
+		})
+
+		msgServer := xmsgkeeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetXmsgAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_OPERATIONAL, true)
+
+		xmsg := sample.Xmsg_pell(t, "xmsg_index")
+		xmsg.XmsgStatus = &xmsgtypes.Status{
+			Status: xmsgtypes.XmsgStatus_ABORTED,
+		}
+		k.SetXmsg(ctx, *xmsg)
+
+		_, err := msgServer.RefundAbortedXmsg(ctx, &xmsgtypes.MsgRefundAbortedXmsg{
+			Signer:    admin,
+			XmsgIndex: sample.GetXmsgIndicesFromString_pell("xmsg_index"),
+		})
+		require.ErrorIs(t, err, xmsgtypes.ErrUnableProcessRefund)
+	})
+
+	t.Run("cannot refund a xmsg if not admin", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.XmsgKeeperWithMocks(t, keepertest.XmsgMockOptions{
+			UseAuthorityMock: true,
+		})
+
+		msgServer := xmsgkeeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetXmsgAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_OPERATIONAL, false)
+
+		xmsg := abortedPellSentXmsg(t, "xmsg_index", 42)
+		k.SetXmsg(ctx, *xmsg)
+
+		_, err := msgServer.RefundAbortedXmsg(ctx, &xmsgtypes.MsgRefundAbortedXmsg{
+			Signer:    admin,
+			XmsgIndex: sample.GetXmsgIndicesFromString_pell("xmsg_index"),
+		})
+		require.ErrorIs(t, err, authoritytypes.ErrUnauthorized)
+	})
+
+	t.Run("cannot refund a xmsg if doesn't exist", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.XmsgKeeperWithMocks(t, keepertest.XmsgMockOptions{
+			UseAuthorityMock: true,
+		})
+
+		msgServer := xmsgkeeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetXmsgAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_OPERATIONAL, true)
+
+		_, err := msgServer.RefundAbortedXmsg(ctx, &xmsgtypes.MsgRefundAbortedXmsg{
+			Signer:    admin,
+			XmsgIndex: sample.GetXmsgIndicesFromString_pell("xmsg_index"),
+		})
+		require.ErrorIs(t, err, xmsgtypes.ErrCannotFindXmsg)
+	})
+}
+
+func TestGetRefundAddress(t *testing.T) {
+	t.Run("should use tx origin if refund address is empty", func(t *testing.T) {
+		xmsg := sample.Xmsg_pell(t, "xmsg_index")
+		address, err := xmsgkeeper.GetRefundAddress(*xmsg, "")
+		require.NoError(t, err)
+		require.Equal(t, ethcommon.HexToAddress(xmsg.InboundTxParams.TxOrigin), address)
+	})
+
+	t.Run("should use refund address if provided", func(t *testing.T) {
+		xmsg := sample.Xmsg_pell(t, "xmsg_index")
+		refundAddress := sample.EthAddress()
+		address, err := xmsgkeeper.GetRefundAddress(*xmsg, refundAddress.Hex())
+		require.NoError(t, err)
+		require.Equal(t, refundAddress, address)
+	})
+
+	t.Run("should fail for invalid address", func(t *testing.T) {
+		xmsg := sample.Xmsg_pell(t, "xmsg_index")
+		_, err := xmsgkeeper.GetRefundAddress(*xmsg, "invalid")
+		require.ErrorIs(t, err, xmsgtypes.ErrInvalidAddress)
+	})
+
+	t.Run("should fail for zero address", func(t *testing.T) {
+		xmsg := sample.Xmsg_pell(t, "xmsg_index")
+		_, err := xmsgkeeper.GetRefundAddress(*xmsg, ethcommon.Address{}.Hex())
+		require.ErrorIs(t, err, xmsgtypes.ErrInvalidAddress)
+	})
+}
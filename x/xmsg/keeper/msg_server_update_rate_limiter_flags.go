@@ -12,6 +12,7 @@ import (
 )
 
 // UpdateRateLimiterFlags updates the rate limiter flags.
+// The flags define the global rate limit and optional rate limits per receiver chain and per event type.
 // Authorized: admin policy operational.
 func (k msgServer) UpdateRateLimiterFlags(goCtx context.Context, msg *types.MsgUpdateRateLimiterFlags) (*types.MsgUpdateRateLimiterFlagsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
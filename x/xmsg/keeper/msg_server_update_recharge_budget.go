@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	authoritytypes "github.com/0xPellNetwork/aegis/x/authority/types"
+	"github.com/0xPellNetwork/aegis/x/xmsg/types"
+)
+
+// UpdateRechargeBudget sets the maximum amount of a token recharged to a chain within a window of blocks.
+// Recharges exceeding the budget of the current window are skipped until the window rolls.
+// Authorized: admin policy group admin.
+func (k msgServer) UpdateRechargeBudget(goCtx context.Context, msg *types.MsgUpdateRechargeBudget) (*types.MsgUpdateRechargeBudgetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.GetAuthorityKeeper().IsAuthorized(ctx, msg.Signer, authoritytypes.PolicyType_GROUP_ADMIN) {
+		return nil, errorsmod.Wrap(authoritytypes.ErrUnauthorized, fmt.Sprintf("Creator %s", msg.Signer))
+	}
+
+	if chain := k.relayerKeeper.GetSupportedChainFromChainID(ctx, msg.Budget.ChainId); chain == nil {
+		return nil, errorsmod.Wrapf(types.ErrUnsupportedChain, "ChainID : %d ", msg.Budget.ChainId)
+	}
+
+	k.SetRechargeBudget(ctx, msg.Budget)
+
+	return &types.MsgUpdateRechargeBudgetResponse{}, nil
+}
+
+// RemoveRechargeBudget removes the budget of a token on a chain, the recharges of the token are no longer capped.
+// Authorized: admin policy group admin.
+func (k msgServer) RemoveRechargeBudget(goCtx context.Context, msg *types.MsgRemoveRechargeBudget) (*types.MsgRemoveRechargeBudgetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.GetAuthorityKeeper().IsAuthorized(ctx, msg.Signer, authoritytypes.PolicyType_GROUP_ADMIN) {
+		return nil, errorsmod.Wrap(authoritytypes.ErrUnauthorized, fmt.Sprintf("Creator %s", msg.Signer))
+	}
+
+	if _, found := k.GetRechargeBudget(ctx, msg.ChainId, msg.TokenType); !found {
+		return nil, errorsmod.Wrapf(types.ErrRechargeBudgetNotFound, "chain %d token %s", msg.ChainId, msg.TokenType)
+	}
+
+	k.DeleteRechargeBudget(ctx, msg.ChainId, msg.TokenType)
+
+	return &types.MsgRemoveRechargeBudgetResponse{}, nil
+}
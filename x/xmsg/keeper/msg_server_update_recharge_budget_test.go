@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/0xPellNetwork/aegis/testutil/keeper"
+	"github.com/0xPellNetwork/aegis/testutil/sample"
+	authoritytypes "github.com/0xPellNetwork/aegis/x/authority/types"
+	"github.com/0xPellNetwork/aegis/x/xmsg/keeper"
+	"github.com/0xPellNetwork/aegis/x/xmsg/types"
+)
+
+func TestMsgServer_UpdateRechargeBudget(t *testing.T) {
+	t.Run("can update and remove a recharge budget", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.XmsgKeeperWithMocks(t, keepertest.XmsgMockOptions{
+			UseAuthorityMock: true,
+		})
+		msgServer := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		chainID := getValidEthChainID()
+		setSupportedChain(ctx, zk, chainID)
+
+		authorityMock := keepertest.GetXmsgAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_ADMIN, true)
+
+		budget := types.RechargeBudget{
+			ChainId:   chainID,
+			TokenType: types.RechargeTokenType_GAS_TOKEN,
+			Window:    100,
+			MaxAmount: math.NewUint(1000),
+		}
+		_, err := msgServer.UpdateRechargeBudget(ctx, types.NewMsgUpdateRechargeBudget(admin, budget))
+		require.NoError(t, err)
+
+		stored, found := k.GetRechargeBudget(ctx, chainID, types.RechargeTokenType_GAS_TOKEN)
+		require.True(t, found)
+		require.Equal(t, budget, stored)
+
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_ADMIN, true)
+		_, err = msgServer.RemoveRechargeBudget(ctx, types.NewMsgRemoveRechargeBudget(admin, chainID, types.RechargeTokenType_GAS_TOKEN))
+		require.NoError(t, err)
+
+		_, found = k.GetRechargeBudget(ctx, chainID, types.RechargeTokenType_GAS_TOKEN)
+		require.False(t, found)
+	})
+
+	t.Run("cannot update a recharge budget if unauthorized", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.XmsgKeeperWithMocks(t, keepertest.XmsgMockOptions{
+			UseAuthorityMock: true,
+		})
+		msgServer := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+
+		authorityMock := keepertest.GetXmsgAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_ADMIN, false)
+
+		_, err := msgServer.UpdateRechargeBudget(ctx, types.NewMsgUpdateRechargeBudget(admin, types.RechargeBudget{
+			ChainId:   getValidEthChainID(),
+			Window:    100,
+			MaxAmount: math.NewUint(1000),
+		}))
+		require.ErrorIs(t, err, authoritytypes.ErrUnauthorized)
+	})
+
+	t.Run("cannot update a recharge budget of an unsupported chain", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.XmsgKeeperWithMocks(t, keepertest.XmsgMockOptions{
+			UseAuthorityMock: true,
+		})
+		msgServer := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+
+		authorityMock := keepertest.GetXmsgAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_ADMIN, true)
+
+		_, err := msgServer.UpdateRechargeBudget(ctx, types.NewMsgUpdateRechargeBudget(admin, types.RechargeBudget{
+			ChainId:   getValidEthChainID(),
+			Window:    100,
+			MaxAmount: math.NewUint(1000),
+		}))
+		require.ErrorIs(t, err, types.ErrUnsupportedChain)
+	})
+
+	t.Run("cannot remove a recharge budget not set", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.XmsgKeeperWithMocks(t, keepertest.XmsgMockOptions{
+			UseAuthorityMock: true,
+		})
+		msgServer := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+
+		authorityMock := keepertest.GetXmsgAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_ADMIN, true)
+
+		_, err := msgServer.RemoveRechargeBudget(ctx, types.NewMsgRemoveRechargeBudget(admin, getValidEthChainID(), types.RechargeTokenType_PELL_TOKEN))
+		require.ErrorIs(t, err, types.ErrRechargeBudgetNotFound)
+	})
+}
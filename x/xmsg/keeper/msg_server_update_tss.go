@@ -20,6 +20,11 @@ func (k msgServer) UpdateTssAddress(goCtx context.Context, msg *types.MsgUpdateT
 		return nil, errorsmod.Wrap(authoritytypes.ErrUnauthorized, "Update can only be executed by the correct policy account")
 	}
 
+	// the funds and the tss address are managed by the tss rotation in progress
+	if k.relayerKeeper.IsTssRotationInProgress(ctx) {
+		return nil, errorsmod.Wrap(types.ErrUnableToUpdateTss, "tss rotation in progress")
+	}
+
 	currentTss, found := k.relayerKeeper.GetTSS(ctx)
 	if !found {
 		return nil, errorsmod.Wrap(types.ErrUnableToUpdateTss, "cannot find current TSS")
@@ -3,7 +3,6 @@ package keeper
 import (
 	"context"
 	"fmt"
-	"sort"
 	"strconv"
 
 	cosmoserrors "cosmossdk.io/errors"
@@ -14,8 +13,9 @@ import (
 )
 
 // VoteGasPrice submits information about the connected chain's gas price at a specific block
-// height. Gas price submitted by each validator is recorded separately and a
-// median index is updated.
+// height. Gas price, priority fee and base fee submitted by each validator are recorded separately
+// and the median indexes are updated. Votes recorded too many pell blocks ago are evicted and
+// votes deviating too much from the median are left out of the median.
 //
 // Only observer validators are authorized to broadcast this message.
 func (k msgServer) VoteGasPrice(goCtx context.Context, msg *types.MsgVoteGasPrice) (*types.MsgVoteGasPriceResponse, error) {
@@ -32,35 +32,14 @@ func (k msgServer) VoteGasPrice(goCtx context.Context, msg *types.MsgVoteGasPric
 	gasPrice, isFound := k.GetGasPrice(ctx, chain.Id)
 	if !isFound {
 		gasPrice = types.GasPrice{
-			Signer:      msg.Signer,
-			Index:       strconv.FormatInt(chain.Id, 10), // TODO : Not needed index set at keeper
-			ChainId:     chain.Id,
-			Prices:      []uint64{msg.Price},
-			BlockNums:   []uint64{msg.BlockNumber},
-			Signers:     []string{msg.Signer},
-			MedianIndex: 0,
+			Signer:  msg.Signer,
+			Index:   strconv.FormatInt(chain.Id, 10), // TODO : Not needed index set at keeper
+			ChainId: chain.Id,
 		}
-	} else {
-		signers := gasPrice.Signers
-		exist := false
-		for i, s := range signers {
-			if s == msg.Signer { // update existing entry
-				gasPrice.BlockNums[i] = msg.BlockNumber
-				gasPrice.Prices[i] = msg.Price
-				exist = true
-				break
-			}
-		}
-		if !exist {
-			gasPrice.Signers = append(gasPrice.Signers, msg.Signer)
-			gasPrice.BlockNums = append(gasPrice.BlockNums, msg.BlockNumber)
-			gasPrice.Prices = append(gasPrice.Prices, msg.Price)
-		}
-		// recompute the median gas price
-		mi := medianOfArray(gasPrice.Prices)
-		// #nosec G701 always positive
-		gasPrice.MedianIndex = uint64(mi)
 	}
+	// record the vote, evict the stale votes and recompute the medians without the outliers
+	// #nosec G701 always positive
+	gasPrice.SetVote(msg.Signer, uint64(ctx.BlockHeight()), msg.BlockNumber, msg.Price, msg.PriorityFee, msg.BaseFee)
 	k.SetGasPrice(ctx, gasPrice)
 
 	// reset the gas count
@@ -69,23 +48,6 @@ func (k msgServer) VoteGasPrice(goCtx context.Context, msg *types.MsgVoteGasPric
 	return &types.MsgVoteGasPriceResponse{}, nil
 }
 
-type indexValue struct {
-	Index int
-	Value uint64
-}
-
-func medianOfArray(values []uint64) int {
-	array := make([]indexValue, len(values))
-	for i, v := range values {
-		array[i] = indexValue{Index: i, Value: v}
-	}
-	sort.SliceStable(array, func(i, j int) bool {
-		return array[i].Value < array[j].Value
-	})
-	l := len(array)
-	return array[l/2].Index
-}
-
 // ResetGasMeterAndConsumeGas reset first the gas meter consumed value to zero and set it back to the new value
 // 'gasUsed'
 func (k Keeper) ResetGasMeterAndConsumeGas(ctx sdk.Context, gasUsed uint64) {
@@ -82,13 +82,16 @@ func TestMsgServer_VoteGasPrice(t *testing.T) {
 		gp, found := k.GetGasPrice(ctx, 5)
 		require.True(t, found)
 		require.Equal(t, types.GasPrice{
-			Signer:      creator,
-			Index:       "",
-			ChainId:     5,
-			Signers:     []string{creator},
-			BlockNums:   []uint64{2},
-			Prices:      []uint64{2},
-			MedianIndex: 0,
+			Signer:       creator,
+			Index:        "",
+			ChainId:      5,
+			Signers:      []string{creator},
+			PellHeights:  []uint64{1},
+			BlockNums:    []uint64{2},
+			Prices:       []uint64{2},
+			PriorityFees: []uint64{0},
+			BaseFees:     []uint64{0},
+			MedianIndex:  0,
 		}, gp)
 	})
 
@@ -124,13 +127,49 @@ func TestMsgServer_VoteGasPrice(t *testing.T) {
 		gp, found := k.GetGasPrice(ctx, 5)
 		require.True(t, found)
 		require.Equal(t, types.GasPrice{
+			Signer:                 creator,
+			Index:                  "",
+			ChainId:                5,
+			Signers:                []string{"", creator},
+			PellHeights:            []uint64{1, 1},
+			BlockNums:              []uint64{1, 2},
+			Prices:                 []uint64{1, 2},
+			PriorityFees:           []uint64{0, 0},
+			BaseFees:               []uint64{0, 0},
+			MedianIndex:            1,
+			PriorityFeeMedianIndex: 1,
+			BaseFeeMedianIndex:     1,
+		}, gp)
+	})
+
+	t.Run("should record the priority fee and base fee", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.XmsgKeeperWithMocks(t, keepertest.XmsgMockOptions{
+			UseObserverMock: true,
+		})
+
+		observerMock := keepertest.GetXmsgObserverMock(t, k)
+		observerMock.On("GetSupportedChainFromChainID", mock.Anything, mock.Anything).Return(&chains.Chain{
+			Id: 5,
+		})
+		observerMock.On("IsNonTombstonedObserver", mock.Anything, mock.Anything).Return(true)
+
+		msgServer := keeper.NewMsgServerImpl(*k)
+
+		creator := sample.AccAddress()
+		res, err := msgServer.VoteGasPrice(ctx, &types.MsgVoteGasPrice{
 			Signer:      creator,
-			Index:       "",
 			ChainId:     5,
-			Signers:     []string{creator},
-			BlockNums:   []uint64{1, 2},
-			Prices:      []uint64{1, 2},
-			MedianIndex: 1,
-		}, gp)
+			BlockNumber: 2,
+			Price:       30,
+			PriorityFee: 2,
+			BaseFee:     10,
+		})
+		require.NoError(t, err)
+		require.Empty(t, res)
+
+		gasPrice, priorityFee, found := k.GetMedianGasValues(ctx, 5)
+		require.True(t, found)
+		require.EqualValues(t, 30, gasPrice.Uint64())
+		require.EqualValues(t, 2, priorityFee.Uint64())
 	})
 }
@@ -69,6 +69,7 @@ func (k msgServer) processFinalizedBlock(ctx sdk.Context, msg *types.MsgVoteInbo
 // forming a chain of events across multiple blocks:
 // - For non-first blocks, it links the last event of the previous block to the first event of the current block
 // - Within the current block, it links all events sequentially
+// Events already processed through an inbound proof keep their DONE status.
 func (k msgServer) SetBlockEvents(ctx sdk.Context, blockProof *types.BlockProof, prevBlock *types.BlockProof) error {
 	prevEventDigest := ""
 	if blockProof.PrevBlockHeight != 0 && prevBlock != nil {
@@ -93,11 +94,17 @@ func (k msgServer) SetBlockEvents(ctx sdk.Context, blockProof *types.BlockProof,
 			nextEventIndex = blockProof.Events[i+1].Digest
 		}
 
+		// keep the status of the events already processed through an inbound proof
+		status := types.EventStatus_PENDING
+		if node, exist := k.GetEventStatusNode(ctx, event.Digest); exist {
+			status = node.Status
+		}
+
 		k.SetEventStatusNode(ctx, event.Digest, types.EventStatusNode{
 			PrevEventIndex:    prevEventDigest,
 			NextEventIndex:    nextEventIndex,
 			EventIndexInBlock: event.Index,
-			Status:            types.EventStatus_PENDING,
+			Status:            status,
 		})
 
 		prevEventDigest = event.Digest
@@ -106,10 +106,11 @@ func (k msgServer) processInboundTxBallot(ctx sdk.Context, msg *types.MsgVoteOnO
 
 // findExecutableEvents sequentially traverses event nodes starting from the given node.
 // For each subsequent event in the waiting queue (stored in XmsgByEventIndex),
-// it executes the corresponding xmsg. The traversal stops when either:
+// it executes the corresponding xmsg. Events already processed through an inbound proof, including
+// under another event index, are skipped.
+// The traversal stops when either:
 // - the next event doesn't exist
-// - the next event's status is not PENDING
-// - the xmsg for the next event index cannot be found
+// - the xmsg for the next pending event index cannot be found
 // Returns the list of executable xmsgs and their corresponding event indices in the block.
 func (k msgServer) findExecutableEvents(ctx sdk.Context, msg *types.EventStatusNode) ([]types.Xmsg, []uint64, error) {
 	res := []types.Xmsg{}
@@ -118,9 +119,13 @@ func (k msgServer) findExecutableEvents(ctx sdk.Context, msg *types.EventStatusN
 
 	for nextEventIndex != "" {
 		nextEventNode, exist := k.GetEventStatusNode(ctx, nextEventIndex)
-		if !exist || nextEventNode.Status != types.EventStatus_PENDING {
+		if !exist {
 			break
 		}
+		if nextEventNode.Status == types.EventStatus_DONE {
+			nextEventIndex = nextEventNode.NextEventIndex
+			continue
+		}
 
 		// find xmsg by pending queue
 		xmsg, exist := k.GetXmsgByEventIndex(ctx, nextEventIndex)
@@ -137,6 +142,13 @@ func (k msgServer) findExecutableEvents(ctx sdk.Context, msg *types.EventStatusN
 
 		k.DeleteXmsgByEventIndex(ctx, nextEventIndex)
 
+		// the event was already processed through an inbound proof under another event index
+		if k.isProvenUnderAnotherIndex(ctx, xmsg.InboundTxParams) {
+			k.AddFinalizedInbound(ctx, xmsg.InboundTxParams.InboundTxHash, xmsg.InboundTxParams.SenderChainId, xmsg.InboundTxParams.InboundTxEventIndex)
+			nextEventIndex = nextEventNode.NextEventIndex
+			continue
+		}
+
 		res = append(res, xmsg)
 
 		eventIndexInBlock = append(eventIndexInBlock, nextEventNode.EventIndexInBlock)
@@ -162,6 +174,12 @@ func (k msgServer) processInboundEvent(ctx sdk.Context, msg *types.MsgVoteOnObse
 		)
 	}
 
+	// the event may already have been processed through an inbound proof
+	if eventNode.Status == types.EventStatus_DONE {
+		k.Logger(ctx).Info("inbound event already processed", "event_index", msg.Digest())
+		return nil
+	}
+
 	tss, tssFound := k.relayerKeeper.GetTSS(ctx)
 	if !tssFound {
 		return types.ErrCannotFindTSSKeys
@@ -173,6 +191,17 @@ func (k msgServer) processInboundEvent(ctx sdk.Context, msg *types.MsgVoteOnObse
 		return err
 	}
 
+	// the event was already processed through an inbound proof under another event index
+	if k.isProvenUnderAnotherIndex(ctx, xmsg.InboundTxParams) {
+		k.Logger(ctx).Info("inbound event already processed through an inbound proof", "event_index", msg.Digest())
+		k.AddFinalizedInbound(ctx, msg.InTxHash, msg.SenderChainId, msg.EventIndex)
+
+		eventNode.Status = types.EventStatus_DONE
+		k.SetEventStatusNode(ctx, msg.Digest(), eventNode)
+		EmitEventStatusNode(ctx, &eventNode)
+		return k.processExecutableEvents(ctx, &eventNode)
+	}
+
 	if eventNode.PrevEventIndex != "" {
 		prevEventNode, exist := k.GetEventStatusNode(ctx, eventNode.PrevEventIndex)
 		if !exist || prevEventNode.Status != types.EventStatus_DONE {
@@ -192,8 +221,12 @@ func (k msgServer) processInboundEvent(ctx sdk.Context, msg *types.MsgVoteOnObse
 	k.SetEventStatusNode(ctx, msg.Digest(), eventNode)
 
 	EmitEventStatusNode(ctx, &eventNode)
-	// find available execute event
-	availableXmsgs, eventIndexInBlock, err := k.findExecutableEvents(ctx, &eventNode)
+	return k.processExecutableEvents(ctx, &eventNode)
+}
+
+// processExecutableEvents processes the queued events following the given processed event
+func (k msgServer) processExecutableEvents(ctx sdk.Context, eventNode *types.EventStatusNode) error {
+	availableXmsgs, eventIndexInBlock, err := k.findExecutableEvents(ctx, eventNode)
 	if err != nil {
 		return err
 	}
@@ -207,7 +240,7 @@ func (k msgServer) processInboundEvent(ctx sdk.Context, msg *types.MsgVoteOnObse
 }
 
 func (k Keeper) SaveInbound(ctx sdk.Context, xmsg *types.Xmsg, blockHeight uint64, eventIndex uint64) {
-	EmitEventInboundFinalized(ctx, xmsg)
+	k.EmitEventInboundFinalized(ctx, xmsg)
 	k.AddFinalizedInbound(ctx,
 		xmsg.GetInboundTxParams().InboundTxHash,
 		xmsg.GetInboundTxParams().SenderChainId,
@@ -111,6 +111,58 @@ func TestKeeper_VoteOnObservedInboundTx(t *testing.T) {
 		require.Equal(t, xmsg.InboundTxParams.TxFinalizationStatus, types.TxFinalizationStatus_EXECUTED)
 	})
 
+	t.Run("should skip an event proven under another event index", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.XmsgKeeper(t)
+
+		msgServer := keeper.NewMsgServerImpl(*k)
+		validatorList := setObservers(t, k, ctx, zk)
+		to, from := int64(1337), int64(186)
+		supportedChains := zk.ObserverKeeper.GetSupportedChains(ctx)
+		for _, chain := range supportedChains {
+			if chains.IsEVMChain(chain.Id) {
+				from = chain.Id
+			}
+			if chains.IsPellChain(chain.Id) {
+				to = chain.Id
+			}
+		}
+
+		zk.ObserverKeeper.SetTSS(ctx, sample.Tss_pell())
+
+		msg := sample.InboundVote_pell(from, to)
+		k.SetProvenInbound(ctx, types.InboundEventDigest(msg.SenderChainId, msg.InTxHash, msg.PellTx), msg.EventIndex+1)
+
+		for _, validatorAddr := range validatorList {
+			blockProofMsg := types.NewMsgVoteInboundBlock(
+				validatorAddr, uint64(msg.SenderChainId), 0, msg.InBlockHeight, "0x01", []*types.Event{
+					{
+						Index:     msg.EventIndex,
+						TxHash:    msg.InTxHash,
+						PellEvent: msg.PellTx,
+						Digest:    msg.Digest(),
+					},
+				},
+			)
+
+			_, err := msgServer.VoteOnObservedInboundBlock(ctx, blockProofMsg)
+			require.NoError(t, err)
+		}
+
+		for _, validatorAddr := range validatorList {
+			msg.Signer = validatorAddr
+
+			_, err := msgServer.VoteOnObservedInboundTx(ctx, &msg)
+			require.NoError(t, err)
+		}
+
+		_, found := k.GetXmsg(ctx, msg.Digest())
+		require.False(t, found)
+		require.True(t, k.IsFinalizedInbound(ctx, msg.InTxHash, msg.SenderChainId, msg.EventIndex))
+		node, found := k.GetEventStatusNode(ctx, msg.Digest())
+		require.True(t, found)
+		require.Equal(t, types.EventStatus_DONE, node.Status)
+	})
+
 	// Test execution order:
 	// 1. Block1 (contains msg1,2,3)
 	// 2. msg2    pending
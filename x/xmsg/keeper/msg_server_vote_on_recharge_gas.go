@@ -90,11 +90,24 @@ func (k msgServer) processGasRecharge(ctx sdk.Context, msg *types.MsgVoteOnGasRe
 		return types.ErrUnsupportedChain
 	}
 
+	amount, err := rechargeAmount(params.GasTokenRechargeAmount)
+	if err != nil {
+		return err
+	}
+
+	// a recharge exceeding the budget of the current window is skipped, the vote index is still consumed
+	// so that the relayers vote on the next index and the recharge resumes once the window rolls
+	if err := k.CheckRechargeBudget(ctx, msg.ChainId, types.RechargeTokenType_GAS_TOKEN, msg.VoteIndex, amount); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("processGasRecharge: recharge skipped: %s, voteIndex: %d", err.Error(), msg.VoteIndex))
+		k.SetGasRechargeOperationIndex(ctx, msg.ChainId, msg.VoteIndex)
+		return nil
+	}
+
 	// call bridge pell method on contract
 	evmTxResponse, contractCall, err := k.pevmKeeper.CallSwapOnPellGasSwap(
 		ctx,
 		msg.ChainId,
-		params.GasTokenRechargeAmount.BigInt(),
+		amount.BigInt(),
 		ethcommon.HexToAddress(tss.Eth),
 	)
 	if err != nil {
@@ -120,6 +133,11 @@ func (k msgServer) processGasRecharge(ctx sdk.Context, msg *types.MsgVoteOnGasRe
 		}
 	}
 
+	// only the recharges executed on pevm are accounted
+	if !evmTxResponse.Failed() {
+		k.RecordRecharge(ctx, msg.ChainId, types.RechargeTokenType_GAS_TOKEN, msg.VoteIndex, amount)
+	}
+
 	// save the vote data
 	k.SetGasRechargeOperationIndex(ctx, msg.ChainId, msg.VoteIndex)
 	return nil
@@ -83,12 +83,25 @@ func (k msgServer) processPellRecharge(ctx sdk.Context, msg *types.MsgVoteOnPell
 		return types.ErrUnsupportedChain
 	}
 
+	amount, err := rechargeAmount(params.PellTokenRechargeAmount)
+	if err != nil {
+		return err
+	}
+
+	// a recharge exceeding the budget of the current window is skipped, the vote index is still consumed
+	// so that the relayers vote on the next index and the recharge resumes once the window rolls
+	if err := k.CheckRechargeBudget(ctx, msg.ChainId, types.RechargeTokenType_PELL_TOKEN, msg.VoteIndex, amount); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("VoteOnPellRecharge: recharge skipped: %s, voteIndex: %d", err.Error(), msg.VoteIndex))
+		k.SetPellRechargeOperationIndex(ctx, msg.ChainId, msg.VoteIndex)
+		return nil
+	}
+
 	// call bridge pell method on contract
 	evmTxResponse, contractCall, err := k.pevmKeeper.CallBridgePellOnPellGateway(
 		ctx,
 		msg.ChainId,
 		ethcommon.HexToAddress(params.GasSwapContractAddress),
-		params.PellTokenRechargeAmount.BigInt(),
+		amount.BigInt(),
 	)
 	if err != nil {
 		return err
@@ -112,6 +125,11 @@ func (k msgServer) processPellRecharge(ctx sdk.Context, msg *types.MsgVoteOnPell
 		}
 	}
 
+	// only the recharges executed on pevm are accounted
+	if !evmTxResponse.Failed() {
+		k.RecordRecharge(ctx, msg.ChainId, types.RechargeTokenType_PELL_TOKEN, msg.VoteIndex, amount)
+	}
+
 	// save the vote data
 	k.SetPellRechargeOperationIndex(ctx, msg.ChainId, msg.VoteIndex)
 	return nil
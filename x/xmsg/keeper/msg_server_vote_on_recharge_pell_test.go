@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/0xPellNetwork/aegis/testutil/keeper"
+	"github.com/0xPellNetwork/aegis/testutil/sample"
+	"github.com/0xPellNetwork/aegis/x/xmsg/keeper"
+	"github.com/0xPellNetwork/aegis/x/xmsg/types"
+)
+
+// setupPellRechargeVote returns a keeper with mocks finalizing every pell recharge ballot of the chain
+func setupPellRechargeVote(t *testing.T, chainID int64, amount int64) (*keeper.Keeper, sdk.Context) {
+	k, ctx, _, _ := keepertest.XmsgKeeperWithMocks(t, keepertest.XmsgMockOptions{
+		UseObserverMock: true,
+		UsePevmMock:     true,
+	})
+
+	chainParams := sample.ChainParams_pell(chainID)
+	chainParams.PellTokenRechargeAmount = math.NewInt(amount)
+
+	observerMock := keepertest.GetXmsgObserverMock(t, k)
+	observerMock.On("GetSupportedChainFromChainID", mock.Anything, chainID).Return(getValidEthChain())
+	observerMock.On("IsNonTombstonedObserver", mock.Anything, mock.Anything).Return(true)
+	observerMock.On("VoteOnAddPellTokenBallot", mock.Anything, chainID, mock.Anything, mock.Anything).Return(true, true, nil)
+	observerMock.On("GetChainParamsByChainID", mock.Anything, chainID).Return(chainParams, true)
+
+	return k, ctx
+}
+
+func TestMsgServer_VoteOnPellRecharge(t *testing.T) {
+	t.Run("recharges are recorded in the ledger", func(t *testing.T) {
+		chainID := getValidEthChainID()
+		k, ctx := setupPellRechargeVote(t, chainID, 1000)
+		msgServer := keeper.NewMsgServerImpl(*k)
+
+		pevmMock := keepertest.GetXmsgPevmMock(t, k)
+		pevmMock.On("CallBridgePellOnPellGateway", mock.Anything, chainID, mock.Anything, mock.Anything).
+			Return(&evmtypes.MsgEthereumTxResponse{}, false, nil).Twice()
+
+		for voteIndex := uint64(1); voteIndex <= 2; voteIndex++ {
+			_, err := msgServer.VoteOnPellRecharge(ctx, &types.MsgVoteOnPellRecharge{
+				Signer:    sample.AccAddress(),
+				ChainId:   chainID,
+				VoteIndex: voteIndex,
+			})
+			require.NoError(t, err)
+		}
+
+		ledger, found := k.GetRechargeLedger(ctx, chainID, types.RechargeTokenType_PELL_TOKEN)
+		require.True(t, found)
+		require.Equal(t, math.NewUint(2000), ledger.CumulativeAmount)
+		require.EqualValues(t, 2, ledger.RechargeCount)
+		require.EqualValues(t, 2, ledger.LastVoteIndex)
+		require.EqualValues(t, 2, k.GetPellRechargeOperationIndex(ctx, chainID))
+		pevmMock.AssertExpectations(t)
+	})
+
+	t.Run("recharges exceeding the budget are skipped", func(t *testing.T) {
+		chainID := getValidEthChainID()
+		k, ctx := setupPellRechargeVote(t, chainID, 1000)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		k.SetRechargeBudget(ctx, types.RechargeBudget{
+			ChainId:   chainID,
+			TokenType: types.RechargeTokenType_PELL_TOKEN,
+			Window:    100,
+			MaxAmount: math.NewUint(1500),
+		})
+
+		pevmMock := keepertest.GetXmsgPevmMock(t, k)
+		pevmMock.On("CallBridgePellOnPellGateway", mock.Anything, chainID, mock.Anything, mock.Anything).
+			Return(&evmtypes.MsgEthereumTxResponse{}, false, nil).Once()
+
+		for voteIndex := uint64(1); voteIndex <= 2; voteIndex++ {
+			_, err := msgServer.VoteOnPellRecharge(ctx, &types.MsgVoteOnPellRecharge{
+				Signer:    sample.AccAddress(),
+				ChainId:   chainID,
+				VoteIndex: voteIndex,
+			})
+			require.NoError(t, err)
+		}
+
+		// the second recharge is skipped but its vote index is consumed
+		ledger, found := k.GetRechargeLedger(ctx, chainID, types.RechargeTokenType_PELL_TOKEN)
+		require.True(t, found)
+		require.Equal(t, math.NewUint(1000), ledger.CumulativeAmount)
+		require.EqualValues(t, 1, ledger.RechargeCount)
+		require.EqualValues(t, 1, ledger.RejectedCount)
+		require.EqualValues(t, 2, k.GetPellRechargeOperationIndex(ctx, chainID))
+		pevmMock.AssertExpectations(t)
+	})
+
+	t.Run("failed recharges are not recorded", func(t *testing.T) {
+		chainID := getValidEthChainID()
+		k, ctx := setupPellRechargeVote(t, chainID, 1000)
+		msgServer := keeper.NewMsgServerImpl(*k)
+
+		pevmMock := keepertest.GetXmsgPevmMock(t, k)
+		pevmMock.On("CallBridgePellOnPellGateway", mock.Anything, chainID, mock.Anything, mock.Anything).
+			Return(&evmtypes.MsgEthereumTxResponse{VmError: "reverted"}, false, nil).Once()
+
+		_, err := msgServer.VoteOnPellRecharge(ctx, &types.MsgVoteOnPellRecharge{
+			Signer:    sample.AccAddress(),
+			ChainId:   chainID,
+			VoteIndex: 1,
+		})
+		require.NoError(t, err)
+
+		_, found := k.GetRechargeLedger(ctx, chainID, types.RechargeTokenType_PELL_TOKEN)
+		require.False(t, found)
+	})
+}
@@ -110,7 +110,7 @@ SaveFailedOutbound saves a failed outbound transaction.It does the following thi
 */
 
 func (k Keeper) SaveFailedOutbound(ctx sdk.Context, xmsg *types.Xmsg, errMessage string, ballotIndex string) {
-	xmsg.SetAbort(errMessage)
+	xmsg.SetAbort(ctx, errMessage)
 	ctx.Logger().Error(errMessage)
 
 	k.SaveOutbound(ctx, xmsg, ballotIndex)
@@ -18,11 +18,11 @@ func (k Keeper) ProcessInbound(ctx sdk.Context, xmsg *types.Xmsg) {
 			k.processPEVMEvents(ctx, xmsg)
 			ctx.Logger().Debug("ProcessInbound: processPEVMEvents completed for xmsg ", xmsg.Index)
 		} else {
-			xmsg.SetAbort(fmt.Sprintf("invalid xmsg[%s]", xmsg.Index))
+			xmsg.SetAbort(ctx, fmt.Sprintf("invalid xmsg[%s]", xmsg.Index))
 			ctx.Logger().Debug("ProcessInbound: invalid xmsg ", xmsg.Index)
 		}
 	} else {
-		xmsg.SetAbort(fmt.Sprintf("invalid receiver chainID %d", xmsg.GetCurrentOutTxParam().ReceiverChainId))
+		xmsg.SetAbort(ctx, fmt.Sprintf("invalid receiver chainID %d", xmsg.GetCurrentOutTxParam().ReceiverChainId))
 	}
 }
 
@@ -43,11 +43,11 @@ func (k Keeper) processPEVMEvents(ctx sdk.Context, xmsg *types.Xmsg) {
 
 	if err != nil { // exceptional case; internal error; should abort Xmsg
 		if !isContractReverted {
-			xmsg.SetAbort(err.Error())
+			xmsg.SetAbort(ctx, err.Error())
 			return
 		}
 		// TODO: set pending reverted
-		xmsg.SetAbort(err.Error())
+		xmsg.SetAbort(ctx, err.Error())
 		return
 
 		// contract call reverted; should refund via a revert tx
@@ -97,5 +97,5 @@ func (k Keeper) processPEVMEvents(ctx sdk.Context, xmsg *types.Xmsg) {
 	}
 	// successful HandleEVMDeposit;
 	commit()
-	xmsg.SetOutBoundMined("Remote omnichain contract call completed")
+	xmsg.SetOutBoundMined(ctx, "Remote omnichain contract call completed")
 }
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"math/big"
 	"testing"
 
 	"cosmossdk.io/errors"
@@ -147,10 +148,80 @@ func TestKeeper_ProcessFailedOutbound(t *testing.T) {
 			xmsg.InboundTxParams.SenderChainId,
 			xmsg.GetCurrentOutTxParam().ReceiverChainId,
 			mock.Anything,
+			mock.Anything,
 			mock.Anything).Return(nil, errorFailedPELLRevertAndCallContract).Once()
 		err := k.ProcessFailedOutbound(ctx, xmsg)
 		require.ErrorContains(t, err, "failed PELLRevertAndCallContract")
 	})
+
+	t.Run("should revert the value minus the gas fee of the failed outbound converted to apell", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.XmsgKeeperWithMocks(t, keepertest.XmsgMockOptions{
+			UsePevmMock: true,
+		})
+		pevmMock := keepertest.GetXmsgPevmMock(t, k)
+		chainParams := sample.ChainParams_pell(chains.GoerliChain().Id)
+		chainParams.GasTokenPellRate = sdkmath.LegacyMustNewDecFromStr("1.5")
+		zk.ObserverKeeper.SetChainParamsList(ctx, relayertypes.ChainParamsList{
+			ChainParams: []*relayertypes.ChainParams{chainParams},
+		})
+		xmsg := buildXmsg(t, sample.EthAddress(), chains.GoerliChain())
+		xmsg.InboundTxParams.SenderChainId = chains.PellChainMainnet().Id
+		xmsg.GetCurrentOutTxParam().OutboundTxGasUsed = 100
+		xmsg.GetCurrentOutTxParam().OutboundTxEffectiveGasPrice = sdkmath.NewInt(2)
+		xmsg.InboundTxParams.InboundPellTx = &types.InboundPellEvent{
+			PellData: &types.InboundPellEvent_PellSent{
+				PellSent: &types.PellSent{
+					PellParams:          pevmtypes.RevertableCall.String(),
+					PellValue:           sdkmath.NewUint(1000),
+					DestinationGasLimit: sdkmath.Uint{},
+				},
+			},
+		}
+		pevmMock.On("PELLRevertAndCallContract", mock.Anything,
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+			big.NewInt(700),
+			mock.Anything,
+			mock.Anything).Return(nil, nil).Once()
+
+		err := k.ProcessFailedOutbound(ctx, xmsg)
+		require.NoError(t, err)
+		require.Equal(t, types.XmsgStatus_REVERTED, xmsg.XmsgStatus.Status)
+	})
+
+	t.Run("should revert the whole value without gas token pell rate", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.XmsgKeeperWithMocks(t, keepertest.XmsgMockOptions{
+			UsePevmMock: true,
+		})
+		pevmMock := keepertest.GetXmsgPevmMock(t, k)
+		xmsg := buildXmsg(t, sample.EthAddress(), chains.GoerliChain())
+		xmsg.InboundTxParams.SenderChainId = chains.PellChainMainnet().Id
+		xmsg.GetCurrentOutTxParam().OutboundTxGasUsed = 100
+		xmsg.GetCurrentOutTxParam().OutboundTxEffectiveGasPrice = sdkmath.NewInt(2)
+		xmsg.InboundTxParams.InboundPellTx = &types.InboundPellEvent{
+			PellData: &types.InboundPellEvent_PellSent{
+				PellSent: &types.PellSent{
+					PellParams:          pevmtypes.RevertableCall.String(),
+					PellValue:           sdkmath.NewUint(1000),
+					DestinationGasLimit: sdkmath.Uint{},
+				},
+			},
+		}
+		pevmMock.On("PELLRevertAndCallContract", mock.Anything,
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+			big.NewInt(1000),
+			mock.Anything,
+			mock.Anything).Return(nil, nil).Once()
+
+		err := k.ProcessFailedOutbound(ctx, xmsg)
+		require.NoError(t, err)
+		require.Equal(t, types.XmsgStatus_REVERTED, xmsg.XmsgStatus.Status)
+	})
 }
 
 func TestKeeper_ProcessOutbound(t *testing.T) {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	cosmoserrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	tmbytes "github.com/cometbft/cometbft/libs/bytes"
 	tmtypes "github.com/cometbft/cometbft/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -35,9 +36,9 @@ func (k Keeper) ProcessSuccessfulOutbound(ctx sdk.Context, xmsg *types.Xmsg) {
 	oldStatus := xmsg.XmsgStatus.Status
 	switch oldStatus {
 	case types.XmsgStatus_PENDING_REVERT:
-		xmsg.SetReverted("Outbound succeeded, revert executed")
+		xmsg.SetReverted(ctx, "Outbound succeeded, revert executed")
 	case types.XmsgStatus_PENDING_OUTBOUND:
-		xmsg.SetOutBoundMined("Outbound succeeded, mined")
+		xmsg.SetOutBoundMined(ctx, "Outbound succeeded, mined")
 	default:
 		return
 	}
@@ -84,14 +85,17 @@ func (k Keeper) ProcessFailedOutbound(ctx sdk.Context, xmsg *types.Xmsg) error {
 		switch paramType {
 		case pevmtypes.ReceiveCall:
 			xmsg.GetCurrentOutTxParam().TxFinalizationStatus = types.TxFinalizationStatus_EXECUTED
-			xmsg.SetAbort("Outbound failed from pell chain")
+			xmsg.SetAbort(ctx, "Outbound failed from pell chain")
 		case pevmtypes.RevertableCall:
 			if err := k.processFailedOutboundForPEVMTx(ctx, xmsg); err != nil {
 				return cosmoserrors.Wrap(err, "ProcessFailedOutboundForPEVMTx")
 			}
 		case pevmtypes.Transfer:
 			xmsg.GetCurrentOutTxParam().TxFinalizationStatus = types.TxFinalizationStatus_EXECUTED
-			xmsg.SetAbort("Outbound failed from pell chain with transfer pell params")
+			xmsg.SetAbort(ctx, "Outbound failed from pell chain with transfer pell params")
+		case pevmtypes.PellTokenTransfer:
+			xmsg.GetCurrentOutTxParam().TxFinalizationStatus = types.TxFinalizationStatus_EXECUTED
+			xmsg.SetAbort(ctx, "Outbound failed from pell chain with pell token transfer pell params")
 		default:
 			// TODO: Remove this block in the next release
 			// This block is maintained for backward compatibility.
@@ -110,22 +114,22 @@ func (k Keeper) ProcessFailedOutbound(ctx sdk.Context, xmsg *types.Xmsg) error {
 			switch lastByte {
 			case 0:
 				xmsg.GetCurrentOutTxParam().TxFinalizationStatus = types.TxFinalizationStatus_EXECUTED
-				xmsg.SetAbort("Outbound failed from pell chain")
+				xmsg.SetAbort(ctx, "Outbound failed from pell chain")
 			case 1:
 				if err := k.processFailedOutboundForPEVMTx(ctx, xmsg); err != nil {
 					return cosmoserrors.Wrap(err, "ProcessFailedOutboundForPEVMTx")
 				}
 			case 2:
 				xmsg.GetCurrentOutTxParam().TxFinalizationStatus = types.TxFinalizationStatus_EXECUTED
-				xmsg.SetAbort("Outbound failed from pell chain with transfer pell params")
+				xmsg.SetAbort(ctx, "Outbound failed from pell chain with transfer pell params")
 			}
 
 			xmsg.GetCurrentOutTxParam().TxFinalizationStatus = types.TxFinalizationStatus_EXECUTED
-			xmsg.SetAbort("Outbound failed from pell chain with unknown pell params")
+			xmsg.SetAbort(ctx, "Outbound failed from pell chain with unknown pell params")
 		}
 	} else {
 		xmsg.GetCurrentOutTxParam().TxFinalizationStatus = types.TxFinalizationStatus_EXECUTED
-		xmsg.SetAbort("Outbound failed from other chain")
+		xmsg.SetAbort(ctx, "Outbound failed from other chain")
 	}
 
 	newStatus := xmsg.XmsgStatus.Status.String()
@@ -133,14 +137,26 @@ func (k Keeper) ProcessFailedOutbound(ctx sdk.Context, xmsg *types.Xmsg) error {
 	return nil
 }
 
+// outboundGasFeeInApell returns the gas fee paid by the mined outbound tx in the gas token of its chain converted
+// to apell with the gas token pell rate of the chain params, zero if no rate is set for the chain
+func (k Keeper) outboundGasFeeInApell(ctx sdk.Context, outTxParams types.OutboundTxParams) sdkmath.Int {
+	chainParams, found := k.relayerKeeper.GetChainParamsByChainID(ctx, outTxParams.ReceiverChainId)
+	if !found {
+		return sdkmath.ZeroInt()
+	}
+	gasFee, _ := chainParams.GasTokenToApell(outTxParams.GasFee())
+	return gasFee
+}
+
 func (k Keeper) processFailedOutboundForPEVMTx(ctx sdk.Context, xmsg *types.Xmsg) error {
 	indexBytes, err := xmsg.GetXmsgIndicesBytes()
 	if err != nil {
 		// Return err to save the failed outbound ad set to aborted
 		return fmt.Errorf("failed reverting GetXmsgIndicesBytes: %s", err.Error())
 	}
-	// Finalize the older outbound tx
+	// Finalize the older outbound tx, the gas it used is paid from the value sent
 	xmsg.GetCurrentOutTxParam().TxFinalizationStatus = types.TxFinalizationStatus_EXECUTED
+	outboundGasFee := k.outboundGasFeeInApell(ctx, *xmsg.GetCurrentOutTxParam())
 
 	// create new OutboundTxParams for the revert. We use the fixed gas limit for revert when calling pEVM
 	err = xmsg.AddRevertOutbound(pevmtypes.PEVMGasLimit.Uint64())
@@ -150,7 +166,7 @@ func (k Keeper) processFailedOutboundForPEVMTx(ctx sdk.Context, xmsg *types.Xmsg
 	}
 
 	// Trying to revert the transaction this would get set to a finalized status in the same block as this does not need a TSS singing
-	xmsg.SetPendingRevert("Outbound failed, trying revert")
+	xmsg.SetPendingRevert(ctx, "Outbound failed, trying revert")
 
 	// Fetch the original sender and receiver from the Xmsg , since this is a revert the sender with be the receiver in the new tx
 	originalSender := ethcommon.HexToAddress(xmsg.InboundTxParams.Sender)
@@ -163,17 +179,28 @@ func (k Keeper) processFailedOutboundForPEVMTx(ctx sdk.Context, xmsg *types.Xmsg
 		originalReceiver = ethcommon.HexToAddress(xmsg.OutboundTxParams[0].Receiver)
 		orginalReceiverChainID = xmsg.OutboundTxParams[0].ReceiverChainId
 	}
+	// The value of the original PellSent minus the gas fee of the failed outbound and the message are handed back to the sender
+	pellSent := xmsg.InboundTxParams.InboundPellTx.GetPellSent()
+	remainingPellValue := pellSent.RemainingValue(outboundGasFee).BigInt()
+	message, err := base64.StdEncoding.DecodeString(pellSent.Message)
+	if err != nil {
+		return fmt.Errorf("failed decoding PellSent message: %s", err.Error())
+	}
+
 	// Call evm to revert the transaction
 	_, err = k.pevmKeeper.PELLRevertAndCallContract(ctx,
 		originalSender,
 		originalReceiver,
 		xmsg.InboundTxParams.SenderChainId,
-		orginalReceiverChainID, indexBytes)
+		orginalReceiverChainID,
+		remainingPellValue,
+		message,
+		indexBytes)
 	// If revert fails, we set it to abort directly there is no way to refund here as the revert failed
 	if err != nil {
 		return fmt.Errorf("failed PELLRevertAndCallContract: %s", err.Error())
 	}
-	xmsg.SetReverted("Outbound failed, revert executed")
+	xmsg.SetReverted(ctx, "Outbound failed, revert executed")
 	if len(ctx.TxBytes()) > 0 {
 		// add event for tendermint transaction hash format
 		hash := tmbytes.HexBytes(tmtypes.Tx(ctx.TxBytes()).Hash())
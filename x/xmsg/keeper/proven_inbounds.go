@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/0xPellNetwork/aegis/x/xmsg/types"
+)
+
+// SetProvenInbound records the event index under which an inbound pell event was processed through an inbound proof
+func (k Keeper) SetProvenInbound(ctx sdk.Context, inboundEventDigest string, eventIndex uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProvenInboundsKey))
+	store.Set(types.KeyPrefix(inboundEventDigest), sdk.Uint64ToBigEndian(eventIndex))
+}
+
+// GetProvenInbound returns the event index under which an inbound pell event was processed through an inbound proof
+func (k Keeper) GetProvenInbound(ctx sdk.Context, inboundEventDigest string) (uint64, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProvenInboundsKey))
+	b := store.Get(types.KeyPrefix(inboundEventDigest))
+	if b == nil {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(b), true
+}
+
+// isProvenUnderAnotherIndex returns true if the inbound pell event was processed through an inbound proof
+// under another event index, the observed event must then not be processed again
+func (k Keeper) isProvenUnderAnotherIndex(ctx sdk.Context, inboundParams *types.InboundTxParams) bool {
+	digest := types.InboundEventDigest(inboundParams.SenderChainId, inboundParams.InboundTxHash, inboundParams.InboundPellTx)
+	if digest == "" {
+		return false
+	}
+	eventIndex, found := k.GetProvenInbound(ctx, digest)
+	return found && eventIndex != inboundParams.InboundTxEventIndex
+}
+
+// isInboundEventProcessed returns true if a xmsg was already created for the inbound pell event of the tx,
+// whatever its event index
+func (k Keeper) isInboundEventProcessed(ctx sdk.Context, chainID int64, inTxHash string, inboundEventDigest string) bool {
+	inTxHashToXmsg, found := k.GetInTxHashToXmsg(ctx, inTxHash)
+	if !found {
+		return false
+	}
+	for _, xmsgIndex := range inTxHashToXmsg.XmsgIndices {
+		xmsg, found := k.GetXmsg(ctx, xmsgIndex)
+		if !found || xmsg.InboundTxParams == nil || xmsg.InboundTxParams.SenderChainId != chainID {
+			continue
+		}
+		if types.InboundEventDigest(chainID, inTxHash, xmsg.InboundTxParams.InboundPellTx) == inboundEventDigest {
+			return true
+		}
+	}
+	return false
+}
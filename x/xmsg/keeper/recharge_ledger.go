@@ -0,0 +1,158 @@
+package keeper
+
+import (
+	cosmoserrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/0xPellNetwork/aegis/x/xmsg/types"
+)
+
+// SetRechargeLedger set the recharge ledger of a token on a chain in the store
+func (k Keeper) SetRechargeLedger(ctx sdk.Context, ledger types.RechargeLedger) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RechargeLedgerKey))
+	b := k.cdc.MustMarshal(&ledger)
+	store.Set(types.RechargeKey(ledger.ChainId, ledger.TokenType), b)
+}
+
+// GetRechargeLedger returns the recharge ledger of a token on a chain
+func (k Keeper) GetRechargeLedger(ctx sdk.Context, chainID int64, tokenType types.RechargeTokenType) (val types.RechargeLedger, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RechargeLedgerKey))
+
+	b := store.Get(types.RechargeKey(chainID, tokenType))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllRechargeLedger returns all recharge ledgers
+func (k Keeper) GetAllRechargeLedger(ctx sdk.Context) (list []types.RechargeLedger) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RechargeLedgerKey))
+	iterator := store.Iterator(nil, nil)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RechargeLedger
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetRechargeBudget set the recharge budget of a token on a chain in the store
+func (k Keeper) SetRechargeBudget(ctx sdk.Context, budget types.RechargeBudget) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RechargeBudgetKey))
+	b := k.cdc.MustMarshal(&budget)
+	store.Set(types.RechargeKey(budget.ChainId, budget.TokenType), b)
+}
+
+// GetRechargeBudget returns the recharge budget of a token on a chain
+func (k Keeper) GetRechargeBudget(ctx sdk.Context, chainID int64, tokenType types.RechargeTokenType) (val types.RechargeBudget, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RechargeBudgetKey))
+
+	b := store.Get(types.RechargeKey(chainID, tokenType))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// DeleteRechargeBudget deletes the recharge budget of a token on a chain
+func (k Keeper) DeleteRechargeBudget(ctx sdk.Context, chainID int64, tokenType types.RechargeTokenType) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RechargeBudgetKey))
+	store.Delete(types.RechargeKey(chainID, tokenType))
+}
+
+// GetAllRechargeBudget returns all recharge budgets
+func (k Keeper) GetAllRechargeBudget(ctx sdk.Context) (list []types.RechargeBudget) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RechargeBudgetKey))
+	iterator := store.Iterator(nil, nil)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RechargeBudget
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// currentRechargeLedger returns the ledger of a token on a chain with its window rolled to the current block
+func (k Keeper) currentRechargeLedger(ctx sdk.Context, chainID int64, tokenType types.RechargeTokenType) (types.RechargeLedger, types.RechargeBudget, bool) {
+	ledger, found := k.GetRechargeLedger(ctx, chainID, tokenType)
+	if !found {
+		ledger = types.NewRechargeLedger(chainID, tokenType)
+	}
+
+	window := types.DefaultRechargeWindow
+	budget, budgetFound := k.GetRechargeBudget(ctx, chainID, tokenType)
+	if budgetFound {
+		window = budget.Window
+	}
+
+	ledger.RollWindow(ctx.BlockHeight(), window)
+	return ledger, budget, budgetFound
+}
+
+// CheckRechargeBudget returns ErrRechargeBudgetExceeded if recharging amount would exceed the budget of the current window.
+// The rejection is counted in the ledger and reported with an EventRechargeBudgetExceeded.
+func (k Keeper) CheckRechargeBudget(
+	ctx sdk.Context,
+	chainID int64,
+	tokenType types.RechargeTokenType,
+	voteIndex uint64,
+	amount math.Uint,
+) error {
+	ledger, budget, found := k.currentRechargeLedger(ctx, chainID, tokenType)
+	if !found || budget.Allows(ledger.WindowAmount, amount) {
+		return nil
+	}
+
+	ledger.RejectedCount++
+	k.SetRechargeLedger(ctx, ledger)
+	EmitEventRechargeBudgetExceeded(ctx, ledger, budget, voteIndex, amount)
+
+	return cosmoserrors.Wrapf(
+		types.ErrRechargeBudgetExceeded,
+		"chain %d token %s window amount %s amount %s max amount %s",
+		chainID,
+		tokenType,
+		ledger.WindowAmount,
+		amount,
+		budget.MaxAmount,
+	)
+}
+
+// RecordRecharge adds a recharge sent to the tss address of a chain to the ledger of the token
+func (k Keeper) RecordRecharge(
+	ctx sdk.Context,
+	chainID int64,
+	tokenType types.RechargeTokenType,
+	voteIndex uint64,
+	amount math.Uint,
+) types.RechargeLedger {
+	ledger, _, _ := k.currentRechargeLedger(ctx, chainID, tokenType)
+	ledger.Record(ctx.BlockHeight(), voteIndex, amount)
+	k.SetRechargeLedger(ctx, ledger)
+	EmitEventRechargeRecorded(ctx, ledger, voteIndex, amount)
+
+	return ledger
+}
+
+// rechargeAmount converts the recharge amount of the chain params to the amount recorded in the ledger
+func rechargeAmount(amount math.Int) (math.Uint, error) {
+	if amount.IsNil() || amount.IsNegative() {
+		return math.Uint{}, cosmoserrors.Wrapf(types.ErrInvalidRechargeBudget, "invalid recharge amount %s", amount)
+	}
+	return math.NewUintFromBigInt(amount.BigInt()), nil
+}
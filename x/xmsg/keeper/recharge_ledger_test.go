@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/0xPellNetwork/aegis/testutil/keeper"
+	"github.com/0xPellNetwork/aegis/x/xmsg/types"
+)
+
+func TestKeeper_RechargeLedgerStore(t *testing.T) {
+	k, ctx, _, _ := keepertest.XmsgKeeper(t)
+
+	_, found := k.GetRechargeLedger(ctx, 1, types.RechargeTokenType_PELL_TOKEN)
+	require.False(t, found)
+
+	pellLedger := types.NewRechargeLedger(1, types.RechargeTokenType_PELL_TOKEN)
+	gasLedger := types.NewRechargeLedger(1, types.RechargeTokenType_GAS_TOKEN)
+	k.SetRechargeLedger(ctx, pellLedger)
+	k.SetRechargeLedger(ctx, gasLedger)
+
+	got, found := k.GetRechargeLedger(ctx, 1, types.RechargeTokenType_PELL_TOKEN)
+	require.True(t, found)
+	require.Equal(t, pellLedger, got)
+	require.ElementsMatch(t, []types.RechargeLedger{pellLedger, gasLedger}, k.GetAllRechargeLedger(ctx))
+
+	budget := types.RechargeBudget{ChainId: 1, TokenType: types.RechargeTokenType_GAS_TOKEN, Window: 10, MaxAmount: math.NewUint(100)}
+	k.SetRechargeBudget(ctx, budget)
+	gotBudget, found := k.GetRechargeBudget(ctx, 1, types.RechargeTokenType_GAS_TOKEN)
+	require.True(t, found)
+	require.Equal(t, budget, gotBudget)
+	require.Equal(t, []types.RechargeBudget{budget}, k.GetAllRechargeBudget(ctx))
+
+	k.DeleteRechargeBudget(ctx, 1, types.RechargeTokenType_GAS_TOKEN)
+	_, found = k.GetRechargeBudget(ctx, 1, types.RechargeTokenType_GAS_TOKEN)
+	require.False(t, found)
+}
+
+func TestKeeper_CheckRechargeBudget(t *testing.T) {
+	t.Run("recharges are not capped without budget", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.XmsgKeeper(t)
+
+		require.NoError(t, k.CheckRechargeBudget(ctx, 1, types.RechargeTokenType_PELL_TOKEN, 1, math.NewUint(1e18)))
+		_, found := k.GetRechargeLedger(ctx, 1, types.RechargeTokenType_PELL_TOKEN)
+		require.False(t, found)
+	})
+
+	t.Run("recharges are rejected once the window budget is spent", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.XmsgKeeper(t)
+		ctx = ctx.WithBlockHeight(100)
+		k.SetRechargeBudget(ctx, types.RechargeBudget{
+			ChainId:   1,
+			TokenType: types.RechargeTokenType_PELL_TOKEN,
+			Window:    50,
+			MaxAmount: math.NewUint(250),
+		})
+
+		for i := uint64(1); i <= 2; i++ {
+			require.NoError(t, k.CheckRechargeBudget(ctx, 1, types.RechargeTokenType_PELL_TOKEN, i, math.NewUint(100)))
+			k.RecordRecharge(ctx, 1, types.RechargeTokenType_PELL_TOKEN, i, math.NewUint(100))
+		}
+
+		err := k.CheckRechargeBudget(ctx.WithBlockHeight(149), 1, types.RechargeTokenType_PELL_TOKEN, 3, math.NewUint(100))
+		require.ErrorIs(t, err, types.ErrRechargeBudgetExceeded)
+
+		ledger, found := k.GetRechargeLedger(ctx, 1, types.RechargeTokenType_PELL_TOKEN)
+		require.True(t, found)
+		require.EqualValues(t, 1, ledger.RejectedCount)
+		require.Equal(t, math.NewUint(200), ledger.WindowAmount)
+
+		// the budget is available again once the window rolls
+		ctx = ctx.WithBlockHeight(150)
+		require.NoError(t, k.CheckRechargeBudget(ctx, 1, types.RechargeTokenType_PELL_TOKEN, 3, math.NewUint(100)))
+		ledger = k.RecordRecharge(ctx, 1, types.RechargeTokenType_PELL_TOKEN, 3, math.NewUint(100))
+		require.EqualValues(t, 150, ledger.WindowStartHeight)
+		require.Equal(t, math.NewUint(100), ledger.WindowAmount)
+		require.Equal(t, math.NewUint(300), ledger.CumulativeAmount)
+		require.EqualValues(t, 3, ledger.RechargeCount)
+		require.EqualValues(t, 1, ledger.RejectedCount)
+		require.EqualValues(t, 3, ledger.LastVoteIndex)
+	})
+
+	t.Run("zero max amount pauses the recharges", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.XmsgKeeper(t)
+		k.SetRechargeBudget(ctx, types.RechargeBudget{
+			ChainId:   1,
+			TokenType: types.RechargeTokenType_GAS_TOKEN,
+			Window:    50,
+			MaxAmount: math.ZeroUint(),
+		})
+
+		err := k.CheckRechargeBudget(ctx, 1, types.RechargeTokenType_GAS_TOKEN, 1, math.NewUint(1))
+		require.ErrorIs(t, err, types.ErrRechargeBudgetExceeded)
+	})
+}
+
+func TestKeeper_RecordRecharge(t *testing.T) {
+	k, ctx, _, _ := keepertest.XmsgKeeper(t)
+	ctx = ctx.WithBlockHeight(10)
+
+	k.RecordRecharge(ctx, 1, types.RechargeTokenType_GAS_TOKEN, 1, math.NewUint(100))
+
+	// without budget the window defaults to DefaultRechargeWindow
+	ctx = ctx.WithBlockHeight(10 + types.DefaultRechargeWindow - 1)
+	ledger := k.RecordRecharge(ctx, 1, types.RechargeTokenType_GAS_TOKEN, 2, math.NewUint(100))
+	require.EqualValues(t, 10, ledger.WindowStartHeight)
+	require.Equal(t, math.NewUint(200), ledger.WindowAmount)
+
+	ctx = ctx.WithBlockHeight(10 + types.DefaultRechargeWindow)
+	ledger = k.RecordRecharge(ctx, 1, types.RechargeTokenType_GAS_TOKEN, 3, math.NewUint(100))
+	require.EqualValues(t, 10+types.DefaultRechargeWindow, ledger.WindowStartHeight)
+	require.Equal(t, math.NewUint(100), ledger.WindowAmount)
+	require.Equal(t, math.NewUint(300), ledger.CumulativeAmount)
+
+	// the pell ledger of the chain is independent
+	_, found := k.GetRechargeLedger(ctx, 1, types.RechargeTokenType_PELL_TOKEN)
+	require.False(t, found)
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/0xPellNetwork/aegis/cmd/pellcored/config"
+	"github.com/0xPellNetwork/aegis/x/xmsg/types"
+)
+
+// RefundAbortedAmountOnPellChain mints the value locked by an aborted xmsg and sends it to the refund address
+func (k Keeper) RefundAbortedAmountOnPellChain(ctx sdk.Context, xmsg types.Xmsg, refundAddress ethcommon.Address) error {
+	amount := xmsg.GetAbortedAmount()
+	if amount.IsZero() {
+		return errorsmod.Wrap(types.ErrUnableProcessRefund, "no amount to refund")
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(config.BaseDenom, math.NewIntFromBigInt(amount.BigInt())))
+
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return errorsmod.Wrap(types.ErrUnableProcessRefund, fmt.Sprintf("failed to mint coins: %s", err.Error()))
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sdk.AccAddress(refundAddress.Bytes()), coins); err != nil {
+		return errorsmod.Wrap(types.ErrUnableProcessRefund, fmt.Sprintf("failed to send coins: %s", err.Error()))
+	}
+
+	return nil
+}
+
+// GetRefundAddress returns the address the aborted value is refunded to.
+// The refund address of the message is used if provided, otherwise the value goes back to the tx origin of the xmsg.
+func GetRefundAddress(xmsg types.Xmsg, refundAddress string) (ethcommon.Address, error) {
+	if refundAddress == "" {
+		refundAddress = xmsg.InboundTxParams.TxOrigin
+	}
+	if !ethcommon.IsHexAddress(refundAddress) {
+		return ethcommon.Address{}, errorsmod.Wrap(types.ErrInvalidAddress, fmt.Sprintf("invalid refund address %s", refundAddress))
+	}
+
+	address := ethcommon.HexToAddress(refundAddress)
+	if address == (ethcommon.Address{}) {
+		return ethcommon.Address{}, errorsmod.Wrap(types.ErrInvalidAddress, "refund address cannot be the zero address")
+	}
+	return address, nil
+}
@@ -0,0 +1,210 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	relayertypes "github.com/0xPellNetwork/aegis/x/relayer/types"
+	"github.com/0xPellNetwork/aegis/x/xmsg/types"
+)
+
+// ProcessTssRotation moves the tss rotation in progress to its next stage once the stage conditions are met:
+// - keygen: the new tss pubkey is generated, inbound is disabled
+// - draining: the pending nonces of the old tss are processed on every chain, the fund migrations are created
+// - migrating: the migration xmsgs of the gas token and the pell token are mined, the new tss is set as current tss
+// and inbound is enabled again
+func (k Keeper) ProcessTssRotation(ctx sdk.Context) {
+	rotation, found := k.relayerKeeper.GetTssRotation(ctx)
+	if !found || !rotation.IsInProgress() {
+		return
+	}
+
+	switch rotation.Stage {
+	case relayertypes.TssRotationStage_TSS_ROTATION_KEYGEN:
+		k.processTssRotationKeygen(ctx, rotation)
+	case relayertypes.TssRotationStage_TSS_ROTATION_DRAINING:
+		k.processTssRotationDraining(ctx, rotation)
+	case relayertypes.TssRotationStage_TSS_ROTATION_MIGRATING:
+		k.processTssRotationMigrating(ctx, rotation)
+	}
+}
+
+// processTssRotationKeygen waits for the new tss pubkey and disables inbound
+func (k Keeper) processTssRotationKeygen(ctx sdk.Context, rotation relayertypes.TssRotation) {
+	keygen, found := k.relayerKeeper.GetKeygen(ctx)
+	if !found {
+		return
+	}
+
+	switch keygen.Status {
+	case relayertypes.KeygenStatus_FAILED:
+		k.relayerKeeper.SetTssRotationStage(ctx, rotation, relayertypes.TssRotationStage_TSS_ROTATION_FAILED, "keygen failed")
+		return
+	case relayertypes.KeygenStatus_PENDING:
+		return
+	}
+
+	newTss, found := latestTss(k.relayerKeeper.GetAllTSS(ctx))
+	if !found || newTss.TssPubkey == rotation.OldTssPubkey || newTss.FinalizedPellHeight < rotation.StartHeight {
+		return
+	}
+	rotation.NewTssPubkey = newTss.TssPubkey
+
+	// no new inbound is processed with the old tss while its funds are migrated
+	if k.relayerKeeper.IsInboundEnabled(ctx) {
+		k.relayerKeeper.SetInboundEnabled(ctx, false)
+		rotation.InboundDisabledByRotation = true
+	}
+
+	k.relayerKeeper.SetTssRotationStage(
+		ctx,
+		rotation,
+		relayertypes.TssRotationStage_TSS_ROTATION_DRAINING,
+		"waiting for the pending outbounds of the old tss",
+	)
+}
+
+// processTssRotationDraining waits for the pending nonces of the old tss to be processed on every chain
+// and creates the fund migrations, the migrations of all chains are created in the same block or not at all.
+// The gas token is migrated with a native transfer which doesn't move the pell token held by the tss, the pell
+// token is migrated by a separate erc20 transfer on the chains with a pell amount.
+func (k Keeper) processTssRotationDraining(ctx sdk.Context, rotation relayertypes.TssRotation) {
+	for _, migration := range rotation.Migrations {
+		pendingNonces, found := k.relayerKeeper.GetPendingNonces(ctx, rotation.OldTssPubkey, migration.ChainId)
+		if !found {
+			k.setTssRotationMessage(ctx, rotation, fmt.Sprintf("pending nonces not found for chain %d", migration.ChainId))
+			return
+		}
+		if pendingNonces.NonceLow != pendingNonces.NonceHigh {
+			k.setTssRotationMessage(ctx, rotation, fmt.Sprintf(
+				"waiting for %d pending outbounds on chain %d",
+				pendingNonces.NonceHigh-pendingNonces.NonceLow,
+				migration.ChainId,
+			))
+			return
+		}
+	}
+
+	currentTss, found := k.relayerKeeper.GetTSS(ctx)
+	if !found || currentTss.TssPubkey != rotation.OldTssPubkey {
+		k.relayerKeeper.SetTssRotationStage(ctx, rotation, relayertypes.TssRotationStage_TSS_ROTATION_FAILED,
+			"current tss is not the tss being rotated")
+		return
+	}
+
+	// funds are always migrated to the latest tss
+	tssHistory := k.relayerKeeper.GetAllTSS(ctx)
+	newTss, found := latestTss(tssHistory)
+	if !found || newTss.TssPubkey != rotation.NewTssPubkey {
+		k.relayerKeeper.SetTssRotationStage(ctx, rotation, relayertypes.TssRotationStage_TSS_ROTATION_FAILED,
+			"a newer tss has been generated")
+		return
+	}
+
+	// migrators left by a previous migration are not pending since all the nonces are processed
+	tmpCtx, commit := ctx.CacheContext()
+	k.relayerKeeper.RemoveAllExistingMigrators(tmpCtx)
+	for i, migration := range rotation.Migrations {
+		if err := k.MigrateTSSFundsForChain(tmpCtx, migration.ChainId, migration.Amount, currentTss, tssHistory); err != nil {
+			k.setTssRotationMessage(ctx, rotation, fmt.Sprintf(
+				"failed to migrate funds on chain %d: %s",
+				migration.ChainId,
+				err.Error(),
+			))
+			return
+		}
+		migrator, _ := k.relayerKeeper.GetFundMigrator(tmpCtx, migration.ChainId)
+		rotation.Migrations[i].MigrationXmsgIndex = migrator.MigrationXmsgIndex
+
+		if !migration.HasPellMigration() {
+			continue
+		}
+		pellMigrationIndex, err := k.MigrateTSSPellTokenForChain(tmpCtx, migration.ChainId, migration.PellAmount, currentTss, tssHistory)
+		if err != nil {
+			k.setTssRotationMessage(ctx, rotation, fmt.Sprintf(
+				"failed to migrate pell token on chain %d: %s",
+				migration.ChainId,
+				err.Error(),
+			))
+			return
+		}
+		rotation.Migrations[i].PellMigrationXmsgIndex = pellMigrationIndex
+	}
+	commit()
+
+	k.relayerKeeper.SetTssRotationStage(
+		ctx,
+		rotation,
+		relayertypes.TssRotationStage_TSS_ROTATION_MIGRATING,
+		"waiting for the fund migrations",
+	)
+}
+
+// processTssRotationMigrating waits for the migration xmsgs to be mined and sets the new tss as current tss
+func (k Keeper) processTssRotationMigrating(ctx sdk.Context, rotation relayertypes.TssRotation) {
+	for _, migration := range rotation.Migrations {
+		indexes := []string{migration.MigrationXmsgIndex}
+		if migration.PellMigrationXmsgIndex != "" {
+			indexes = append(indexes, migration.PellMigrationXmsgIndex)
+		}
+
+		for _, index := range indexes {
+			xmsg, found := k.GetXmsg(ctx, index)
+			if !found {
+				k.relayerKeeper.SetTssRotationStage(ctx, rotation, relayertypes.TssRotationStage_TSS_ROTATION_FAILED,
+					fmt.Sprintf("migration xmsg not found for chain %d", migration.ChainId))
+				return
+			}
+
+			switch xmsg.XmsgStatus.Status {
+			case types.XmsgStatus_OUTBOUND_MINED:
+				continue
+			case types.XmsgStatus_ABORTED, types.XmsgStatus_REVERTED:
+				k.relayerKeeper.SetTssRotationStage(ctx, rotation, relayertypes.TssRotationStage_TSS_ROTATION_FAILED,
+					fmt.Sprintf("migration xmsg %s on chain %d is %s",
+						xmsg.Index, migration.ChainId, xmsg.XmsgStatus.Status.String()))
+				return
+			default:
+				return
+			}
+		}
+	}
+
+	newTss, found := k.relayerKeeper.CheckIfTssPubkeyHasBeenGenerated(ctx, rotation.NewTssPubkey)
+	if !found {
+		k.relayerKeeper.SetTssRotationStage(ctx, rotation, relayertypes.TssRotationStage_TSS_ROTATION_FAILED,
+			"new tss not found")
+		return
+	}
+
+	k.relayerKeeper.SetTssAndUpdateNonce(ctx, newTss)
+	k.relayerKeeper.RemoveAllExistingMigrators(ctx)
+	if rotation.InboundDisabledByRotation {
+		k.relayerKeeper.SetInboundEnabled(ctx, true)
+		rotation.InboundDisabledByRotation = false
+	}
+
+	k.relayerKeeper.SetTssRotationStage(ctx, rotation, relayertypes.TssRotationStage_TSS_ROTATION_COMPLETED, "tss rotated")
+}
+
+// setTssRotationMessage updates the message of the rotation without changing its stage
+func (k Keeper) setTssRotationMessage(ctx sdk.Context, rotation relayertypes.TssRotation, message string) {
+	if rotation.Message == message {
+		return
+	}
+	rotation.Message = message
+	k.relayerKeeper.SetTssRotation(ctx, rotation)
+}
+
+// latestTss returns the last finalized tss of the tss history, the history is sorted by finalized height
+func latestTss(tssHistory []relayertypes.TSS) (relayertypes.TSS, bool) {
+	if len(tssHistory) == 0 {
+		return relayertypes.TSS{}, false
+	}
+	sort.SliceStable(tssHistory, func(i, j int) bool {
+		return tssHistory[i].FinalizedPellHeight < tssHistory[j].FinalizedPellHeight
+	})
+	return tssHistory[len(tssHistory)-1], true
+}
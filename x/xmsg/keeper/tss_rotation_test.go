@@ -0,0 +1,315 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/pkg/gas"
+	keepertest "github.com/0xPellNetwork/aegis/testutil/keeper"
+	"github.com/0xPellNetwork/aegis/testutil/sample"
+	authoritytypes "github.com/0xPellNetwork/aegis/x/authority/types"
+	pevmtypes "github.com/0xPellNetwork/aegis/x/pevm/types"
+	relayertypes "github.com/0xPellNetwork/aegis/x/relayer/types"
+	"github.com/0xPellNetwork/aegis/x/xmsg/keeper"
+	xmsgtypes "github.com/0xPellNetwork/aegis/x/xmsg/types"
+)
+
+// setupTssRotation sets a tss rotation waiting for the keygen of the new tss on a valid eth chain migrating
+// both the gas token and the pell token, it returns the rotation, the expected gas token migration xmsg index
+// and the new tss
+func setupTssRotation(
+	t *testing.T,
+	zk keepertest.PellKeepers,
+	k *keeper.Keeper,
+	ctx sdk.Context,
+) (relayertypes.TssRotation, string, relayertypes.TSS) {
+	chain := getValidEthChain()
+	amount := sdkmath.NewUintFromString("10000000000000000000")
+	migrationIndex, oldTssPubkey := setupTssMigrationParams(zk, k, ctx, *chain, amount, true, true)
+	zk.ObserverKeeper.SetInboundEnabled(ctx, true)
+	chainParams, found := zk.ObserverKeeper.GetChainParamsByChainID(ctx, chain.Id)
+	require.True(t, found)
+	chainParams.PellTokenContractAddress = sample.EthAddress().Hex()
+	zk.ObserverKeeper.SetChainParamsList(ctx, relayertypes.ChainParamsList{
+		ChainParams: []*relayertypes.ChainParams{chainParams},
+	})
+
+	newTss, found := latestTssForTest(zk, ctx)
+	require.True(t, found)
+	require.NotEqual(t, oldTssPubkey, newTss.TssPubkey)
+
+	rotation := relayertypes.TssRotation{
+		Stage:        relayertypes.TssRotationStage_TSS_ROTATION_KEYGEN,
+		OldTssPubkey: oldTssPubkey,
+		KeygenBlock:  ctx.BlockHeight() + 100,
+		StartHeight:  0,
+		Migrations: []relayertypes.TssRotationMigration{{
+			ChainId:    chain.Id,
+			Amount:     amount,
+			PellAmount: sdkmath.NewUintFromString("5000000000000000000"),
+		}},
+	}
+	zk.ObserverKeeper.SetTssRotation(ctx, rotation)
+	zk.ObserverKeeper.SetKeygen(ctx, relayertypes.Keygen{Status: relayertypes.KeygenStatus_PENDING})
+	return rotation, migrationIndex, newTss
+}
+
+// latestTssForTest returns the tss of the history with the highest finalized height
+func latestTssForTest(zk keepertest.PellKeepers, ctx sdk.Context) (relayertypes.TSS, bool) {
+	var latest relayertypes.TSS
+	found := false
+	for _, tss := range zk.ObserverKeeper.GetAllTSS(ctx) {
+		if !found || tss.FinalizedPellHeight > latest.FinalizedPellHeight {
+			latest, found = tss, true
+		}
+	}
+	return latest, found
+}
+
+func TestKeeper_ProcessTssRotation(t *testing.T) {
+	t.Run("should rotate the tss", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.XmsgKeeper(t)
+		rotation, migrationIndex, newTss := setupTssRotation(t, zk, k, ctx)
+		chainID := rotation.Migrations[0].ChainId
+
+		// keygen pending
+		k.ProcessTssRotation(ctx)
+		rotation, _ = zk.ObserverKeeper.GetTssRotation(ctx)
+		require.Equal(t, relayertypes.TssRotationStage_TSS_ROTATION_KEYGEN, rotation.Stage)
+		require.True(t, zk.ObserverKeeper.IsInboundEnabled(ctx))
+
+		// new tss generated, inbound is disabled
+		zk.ObserverKeeper.SetKeygen(ctx, relayertypes.Keygen{Status: relayertypes.KeygenStatus_SUCCESS})
+		k.ProcessTssRotation(ctx)
+		rotation, _ = zk.ObserverKeeper.GetTssRotation(ctx)
+		require.Equal(t, relayertypes.TssRotationStage_TSS_ROTATION_DRAINING, rotation.Stage)
+		require.Equal(t, newTss.TssPubkey, rotation.NewTssPubkey)
+		require.True(t, rotation.InboundDisabledByRotation)
+		require.False(t, zk.ObserverKeeper.IsInboundEnabled(ctx))
+
+		// the pending outbounds of the old tss must be processed before migrating the funds
+		zk.ObserverKeeper.SetPendingNonces(ctx, relayertypes.PendingNonces{
+			NonceLow:  1,
+			NonceHigh: 2,
+			ChainId:   chainID,
+			Tss:       rotation.OldTssPubkey,
+		})
+		k.ProcessTssRotation(ctx)
+		rotation, _ = zk.ObserverKeeper.GetTssRotation(ctx)
+		require.Equal(t, relayertypes.TssRotationStage_TSS_ROTATION_DRAINING, rotation.Stage)
+		require.Contains(t, rotation.Message, "waiting for 1 pending outbounds")
+
+		// the funds are migrated once the pending outbounds are processed
+		zk.ObserverKeeper.SetPendingNonces(ctx, relayertypes.PendingNonces{
+			NonceLow:  2,
+			NonceHigh: 2,
+			ChainId:   chainID,
+			Tss:       rotation.OldTssPubkey,
+		})
+		k.ProcessTssRotation(ctx)
+		rotation, _ = zk.ObserverKeeper.GetTssRotation(ctx)
+		require.Equal(t, relayertypes.TssRotationStage_TSS_ROTATION_MIGRATING, rotation.Stage)
+		require.Equal(t, migrationIndex, rotation.Migrations[0].MigrationXmsgIndex)
+		migrator, found := zk.ObserverKeeper.GetFundMigrator(ctx, chainID)
+		require.True(t, found)
+		require.Equal(t, migrationIndex, migrator.MigrationXmsgIndex)
+
+		// the gas token is migrated with a native transfer
+		xmsg, found := k.GetXmsg(ctx, migrationIndex)
+		require.True(t, found)
+		pellSent := xmsg.InboundTxParams.InboundPellTx.GetPellSent()
+		require.Equal(t, pevmtypes.Transfer.String(), pellSent.PellParams)
+		require.Equal(t, rotation.Migrations[0].Amount, pellSent.PellValue)
+		require.EqualValues(t, gas.EVMSend, xmsg.GetCurrentOutTxParam().OutboundTxGasLimit)
+
+		// the pell token is migrated with a separate erc20 transfer to the new tss
+		pellMigrationIndex := rotation.Migrations[0].PellMigrationXmsgIndex
+		require.NotEmpty(t, pellMigrationIndex)
+		require.NotEqual(t, migrationIndex, pellMigrationIndex)
+		pellXmsg, found := k.GetXmsg(ctx, pellMigrationIndex)
+		require.True(t, found)
+		require.Equal(t, xmsgtypes.XmsgStatus_PENDING_OUTBOUND, pellXmsg.XmsgStatus.Status)
+		pellSent = pellXmsg.InboundTxParams.InboundPellTx.GetPellSent()
+		require.Equal(t, pevmtypes.PellTokenTransfer.String(), pellSent.PellParams)
+		require.Equal(t, rotation.Migrations[0].PellAmount, pellSent.PellValue)
+		require.Equal(t, xmsg.GetCurrentOutTxParam().Receiver, pellXmsg.GetCurrentOutTxParam().Receiver)
+		require.EqualValues(t, gas.EVMTokenTransfer, pellXmsg.GetCurrentOutTxParam().OutboundTxGasLimit)
+		require.Equal(t, xmsg.GetCurrentOutTxParam().OutboundTxTssNonce+1, pellXmsg.GetCurrentOutTxParam().OutboundTxTssNonce)
+
+		// the migrations are pending
+		k.ProcessTssRotation(ctx)
+		rotation, _ = zk.ObserverKeeper.GetTssRotation(ctx)
+		require.Equal(t, relayertypes.TssRotationStage_TSS_ROTATION_MIGRATING, rotation.Stage)
+
+		// the rotation waits for the pell token migration once the gas token migration is mined
+		xmsg.XmsgStatus.Status = xmsgtypes.XmsgStatus_OUTBOUND_MINED
+		k.SetXmsg(ctx, xmsg)
+		k.ProcessTssRotation(ctx)
+		rotation, _ = zk.ObserverKeeper.GetTssRotation(ctx)
+		require.Equal(t, relayertypes.TssRotationStage_TSS_ROTATION_MIGRATING, rotation.Stage)
+
+		// the new tss is set once both migrations are mined
+		pellXmsg.XmsgStatus.Status = xmsgtypes.XmsgStatus_OUTBOUND_MINED
+		k.SetXmsg(ctx, pellXmsg)
+		k.ProcessTssRotation(ctx)
+
+		rotation, _ = zk.ObserverKeeper.GetTssRotation(ctx)
+		require.Equal(t, relayertypes.TssRotationStage_TSS_ROTATION_COMPLETED, rotation.Stage)
+		currentTss, found := zk.ObserverKeeper.GetTSS(ctx)
+		require.True(t, found)
+		require.Equal(t, newTss.TssPubkey, currentTss.TssPubkey)
+		require.Empty(t, zk.ObserverKeeper.GetAllTssFundMigrators(ctx))
+		require.True(t, zk.ObserverKeeper.IsInboundEnabled(ctx))
+	})
+
+	t.Run("should fail the rotation if keygen failed", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.XmsgKeeper(t)
+		setupTssRotation(t, zk, k, ctx)
+
+		zk.ObserverKeeper.SetKeygen(ctx, relayertypes.Keygen{Status: relayertypes.KeygenStatus_FAILED})
+		k.ProcessTssRotation(ctx)
+
+		rotation, _ := zk.ObserverKeeper.GetTssRotation(ctx)
+		require.Equal(t, relayertypes.TssRotationStage_TSS_ROTATION_FAILED, rotation.Stage)
+		require.True(t, zk.ObserverKeeper.IsInboundEnabled(ctx))
+	})
+
+	t.Run("should fail the rotation if the migration is aborted", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.XmsgKeeper(t)
+		_, migrationIndex, _ := setupTssRotation(t, zk, k, ctx)
+
+		zk.ObserverKeeper.SetKeygen(ctx, relayertypes.Keygen{Status: relayertypes.KeygenStatus_SUCCESS})
+		k.ProcessTssRotation(ctx)
+		k.ProcessTssRotation(ctx)
+		rotation, _ := zk.ObserverKeeper.GetTssRotation(ctx)
+		require.Equal(t, relayertypes.TssRotationStage_TSS_ROTATION_MIGRATING, rotation.Stage)
+
+		xmsg, found := k.GetXmsg(ctx, migrationIndex)
+		require.True(t, found)
+		xmsg.XmsgStatus.Status = xmsgtypes.XmsgStatus_ABORTED
+		k.SetXmsg(ctx, xmsg)
+		k.ProcessTssRotation(ctx)
+
+		rotation, _ = zk.ObserverKeeper.GetTssRotation(ctx)
+		require.Equal(t, relayertypes.TssRotationStage_TSS_ROTATION_FAILED, rotation.Stage)
+		currentTss, _ := zk.ObserverKeeper.GetTSS(ctx)
+		require.Equal(t, rotation.OldTssPubkey, currentTss.TssPubkey)
+		// inbound stays disabled until the funds are migrated manually
+		require.False(t, zk.ObserverKeeper.IsInboundEnabled(ctx))
+	})
+
+	t.Run("should fail the rotation if the pell token migration is aborted", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.XmsgKeeper(t)
+		_, migrationIndex, _ := setupTssRotation(t, zk, k, ctx)
+
+		zk.ObserverKeeper.SetKeygen(ctx, relayertypes.Keygen{Status: relayertypes.KeygenStatus_SUCCESS})
+		k.ProcessTssRotation(ctx)
+		k.ProcessTssRotation(ctx)
+		rotation, _ := zk.ObserverKeeper.GetTssRotation(ctx)
+		require.Equal(t, relayertypes.TssRotationStage_TSS_ROTATION_MIGRATING, rotation.Stage)
+
+		xmsg, found := k.GetXmsg(ctx, migrationIndex)
+		require.True(t, found)
+		xmsg.XmsgStatus.Status = xmsgtypes.XmsgStatus_OUTBOUND_MINED
+		k.SetXmsg(ctx, xmsg)
+		pellXmsg, found := k.GetXmsg(ctx, rotation.Migrations[0].PellMigrationXmsgIndex)
+		require.True(t, found)
+		pellXmsg.XmsgStatus.Status = xmsgtypes.XmsgStatus_ABORTED
+		k.SetXmsg(ctx, pellXmsg)
+		k.ProcessTssRotation(ctx)
+
+		rotation, _ = zk.ObserverKeeper.GetTssRotation(ctx)
+		require.Equal(t, relayertypes.TssRotationStage_TSS_ROTATION_FAILED, rotation.Stage)
+		require.Contains(t, rotation.Message, pellXmsg.Index)
+		currentTss, _ := zk.ObserverKeeper.GetTSS(ctx)
+		require.Equal(t, rotation.OldTssPubkey, currentTss.TssPubkey)
+	})
+
+	t.Run("should only migrate the gas token if no pell amount is set", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.XmsgKeeper(t)
+		rotation, migrationIndex, newTss := setupTssRotation(t, zk, k, ctx)
+		rotation.Migrations[0].PellAmount = sdkmath.ZeroUint()
+		zk.ObserverKeeper.SetTssRotation(ctx, rotation)
+
+		zk.ObserverKeeper.SetKeygen(ctx, relayertypes.Keygen{Status: relayertypes.KeygenStatus_SUCCESS})
+		k.ProcessTssRotation(ctx)
+		k.ProcessTssRotation(ctx)
+		rotation, _ = zk.ObserverKeeper.GetTssRotation(ctx)
+		require.Equal(t, relayertypes.TssRotationStage_TSS_ROTATION_MIGRATING, rotation.Stage)
+		require.Equal(t, migrationIndex, rotation.Migrations[0].MigrationXmsgIndex)
+		require.Empty(t, rotation.Migrations[0].PellMigrationXmsgIndex)
+
+		xmsg, found := k.GetXmsg(ctx, migrationIndex)
+		require.True(t, found)
+		xmsg.XmsgStatus.Status = xmsgtypes.XmsgStatus_OUTBOUND_MINED
+		k.SetXmsg(ctx, xmsg)
+		k.ProcessTssRotation(ctx)
+
+		rotation, _ = zk.ObserverKeeper.GetTssRotation(ctx)
+		require.Equal(t, relayertypes.TssRotationStage_TSS_ROTATION_COMPLETED, rotation.Stage)
+		currentTss, _ := zk.ObserverKeeper.GetTSS(ctx)
+		require.Equal(t, newTss.TssPubkey, currentTss.TssPubkey)
+	})
+
+	t.Run("should not migrate the funds if the pell token contract is not set", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.XmsgKeeper(t)
+		rotation, migrationIndex, _ := setupTssRotation(t, zk, k, ctx)
+		chainParams, _ := zk.ObserverKeeper.GetChainParamsByChainID(ctx, rotation.Migrations[0].ChainId)
+		chainParams.PellTokenContractAddress = ""
+		zk.ObserverKeeper.SetChainParamsList(ctx, relayertypes.ChainParamsList{
+			ChainParams: []*relayertypes.ChainParams{chainParams},
+		})
+
+		zk.ObserverKeeper.SetKeygen(ctx, relayertypes.Keygen{Status: relayertypes.KeygenStatus_SUCCESS})
+		k.ProcessTssRotation(ctx)
+		k.ProcessTssRotation(ctx)
+
+		// the gas token migration is not created either
+		rotation, _ = zk.ObserverKeeper.GetTssRotation(ctx)
+		require.Equal(t, relayertypes.TssRotationStage_TSS_ROTATION_DRAINING, rotation.Stage)
+		require.Contains(t, rotation.Message, "failed to migrate pell token")
+		_, found := k.GetXmsg(ctx, migrationIndex)
+		require.False(t, found)
+		_, found = zk.ObserverKeeper.GetFundMigrator(ctx, rotation.Migrations[0].ChainId)
+		require.False(t, found)
+	})
+
+	t.Run("should not change a completed rotation", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.XmsgKeeper(t)
+		rotation := relayertypes.TssRotation{Stage: relayertypes.TssRotationStage_TSS_ROTATION_COMPLETED}
+		zk.ObserverKeeper.SetTssRotation(ctx, rotation)
+
+		k.ProcessTssRotation(ctx)
+
+		got, _ := zk.ObserverKeeper.GetTssRotation(ctx)
+		require.Equal(t, rotation, got)
+	})
+
+	t.Run("should reject manual tss updates during the rotation", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.XmsgKeeperWithMocks(t, keepertest.XmsgMockOptions{
+			UseAuthorityMock: true,
+		})
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetXmsgAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_ADMIN, true)
+		_, _, newTss := setupTssRotation(t, zk, k, ctx)
+		msgServer := keeper.NewMsgServerImpl(*k)
+
+		_, err := msgServer.UpdateTssAddress(ctx, &xmsgtypes.MsgUpdateTssAddress{
+			Signer:    admin,
+			TssPubkey: newTss.TssPubkey,
+		})
+		require.ErrorIs(t, err, xmsgtypes.ErrUnableToUpdateTss)
+
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_GROUP_ADMIN, true)
+		_, err = msgServer.MigrateTssFunds(ctx, &xmsgtypes.MsgMigrateTssFunds{
+			Signer:  admin,
+			ChainId: getValidEthChainID(),
+			Amount:  sdkmath.NewUint(1000),
+		})
+		require.ErrorIs(t, err, xmsgtypes.ErrCannotMigrateTssFunds)
+	})
+}
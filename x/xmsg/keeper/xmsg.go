@@ -51,8 +51,17 @@ func (k Keeper) SetXmsgAndNonceToXmsgAndInTxHashToXmsg(ctx sdk.Context, xmsg typ
 func (k Keeper) SetXmsg(ctx sdk.Context, xmsg types.Xmsg) {
 	p := types.KeyPrefix(types.SendKey)
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), p)
+
+	// the indexed fields of the xmsg may have changed
+	if b := store.Get(types.KeyPrefix(xmsg.Index)); b != nil {
+		var old types.Xmsg
+		k.cdc.MustUnmarshal(b, &old)
+		k.removeXmsgIndexes(ctx, old)
+	}
+
 	b := k.cdc.MustMarshal(&xmsg)
 	store.Set(types.KeyPrefix(xmsg.Index), b)
+	k.setXmsgIndexes(ctx, xmsg)
 }
 
 // GetXmsg returns a send from its index
@@ -120,5 +129,10 @@ func (k Keeper) GetAllXmsg(ctx sdk.Context) (list []types.Xmsg) {
 func (k Keeper) RemoveXmsg(ctx sdk.Context, index string) {
 	p := types.KeyPrefix(types.SendKey)
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), p)
+	if b := store.Get(types.KeyPrefix(index)); b != nil {
+		var xmsg types.Xmsg
+		k.cdc.MustUnmarshal(b, &xmsg)
+		k.removeXmsgIndexes(ctx, xmsg)
+	}
 	store.Delete(types.KeyPrefix(index))
 }
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/0xPellNetwork/aegis/x/xmsg/types"
+)
+
+// setXmsgIndexes sets the secondary indexes of the xmsg used by the filtered xmsg query
+func (k Keeper) setXmsgIndexes(ctx sdk.Context, xmsg types.Xmsg) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.XmsgIndexKey))
+	for _, key := range types.XmsgIndexKeys(xmsg) {
+		store.Set(key, []byte(xmsg.Index))
+	}
+}
+
+// removeXmsgIndexes removes the secondary indexes of the xmsg
+func (k Keeper) removeXmsgIndexes(ctx sdk.Context, xmsg types.Xmsg) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.XmsgIndexKey))
+	for _, key := range types.XmsgIndexKeys(xmsg) {
+		store.Delete(key)
+	}
+}
@@ -245,3 +245,41 @@ func TestKeeper_RemoveXmsg(t *testing.T) {
 	txs = keeper.GetAllXmsg(ctx)
 	require.Equal(t, 4, len(txs))
 }
+
+func TestKeeper_SetXmsgStatusChangedEvent(t *testing.T) {
+	t.Run("should not emit status changed event when storing the xmsg", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.XmsgKeeper(t)
+		xmsg := sample.Xmsg_pell(t, "foo")
+		k.SetXmsg(ctx, *xmsg)
+
+		xmsg.XmsgStatus.Status = types.XmsgStatus_OUTBOUND_MINED
+		k.SetXmsg(ctx, *xmsg)
+
+		events, err := types.ParseXmsgStatusChangedEvents(ctx.EventManager().ABCIEvents())
+		require.NoError(t, err)
+		require.Empty(t, events)
+	})
+
+	t.Run("should emit every transition of a xmsg stored once", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.XmsgKeeper(t)
+		xmsg := sample.Xmsg_pell(t, "foo")
+		xmsg.XmsgStatus.Status = types.XmsgStatus_PENDING_OUTBOUND
+
+		xmsg.SetPendingRevert(ctx, "pending revert")
+		xmsg.SetAbort(ctx, "aborted")
+		k.SetXmsg(ctx, *xmsg)
+
+		events, err := types.ParseXmsgStatusChangedEvents(ctx.EventManager().ABCIEvents())
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+		require.Equal(t, types.XmsgStatus_PENDING_OUTBOUND.String(), events[0].OldStatus)
+		require.Equal(t, types.XmsgStatus_PENDING_REVERT.String(), events[0].NewStatus)
+		require.Equal(t, types.XmsgStatus_PENDING_REVERT.String(), events[1].OldStatus)
+		require.Equal(t, types.XmsgStatus_ABORTED.String(), events[1].NewStatus)
+		outTxParams := xmsg.GetCurrentOutTxParam()
+		require.Equal(t, outTxParams.ReceiverChainId, events[1].ReceiverChainId)
+		require.Equal(t, outTxParams.OutboundTxHash, events[1].OutboundTxHash)
+		require.Equal(t, outTxParams.OutboundTxGasUsed, events[1].OutboundGasUsed)
+		require.Equal(t, outTxParams.OutboundTxBallotIndex, events[1].OutboundBallotIndex)
+	})
+}
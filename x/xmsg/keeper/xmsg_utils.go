@@ -14,6 +14,7 @@ import (
 
 // UpdateNonce sets the Xmsg outbound nonce to the next nonce, and updates the nonce of blockchain state.
 // It also updates the PendingNonces that is used to track the unfulfilled outbound txs.
+// Outbounds to a paused chain are still created, they stay pending until the pause is lifted.
 func (k Keeper) UpdateNonce(ctx sdk.Context, receiveChainID int64, xmsg *types.Xmsg) error {
 	chain := k.GetRelayerKeeper().GetSupportedChainFromChainID(ctx, receiveChainID)
 	if chain == nil {
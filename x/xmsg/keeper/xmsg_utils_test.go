@@ -183,4 +183,36 @@ func TestKeeper_UpdateNonce(t *testing.T) {
 		err := k.UpdateNonce(ctx, 5, &xmsg)
 		require.NoError(t, err)
 	})
+
+	t.Run("should update nonces while the chain is paused", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.XmsgKeeperWithMocks(t, keepertest.XmsgMockOptions{
+			UseObserverMock: true,
+		})
+
+		observerMock := keepertest.GetXmsgObserverMock(t, k)
+		observerMock.On("GetSupportedChainFromChainID", mock.Anything, mock.Anything).Return(&chains.Chain{
+			Id: 5,
+		})
+		observerMock.On("IsChainOutboundPaused", mock.Anything, int64(5)).Maybe().Return(true)
+		observerMock.On("IsChainSigningPaused", mock.Anything, int64(5)).Maybe().Return(true)
+		observerMock.On("GetChainNonces", mock.Anything, mock.Anything).Return(relayertypes.ChainNonces{
+			Nonce: 100,
+		}, true)
+		observerMock.On("GetTSS", mock.Anything).Return(relayertypes.TSS{}, true)
+		observerMock.On("GetPendingNonces", mock.Anything, mock.Anything, mock.Anything).Return(relayertypes.PendingNonces{
+			NonceHigh: 100,
+		}, true)
+		observerMock.On("SetChainNonces", mock.Anything, mock.Anything).Once()
+		observerMock.On("SetPendingNonces", mock.Anything, mock.Anything).Once()
+
+		xmsg := types.Xmsg{
+			InboundTxParams:  &types.InboundTxParams{},
+			OutboundTxParams: []*types.OutboundTxParams{{}},
+		}
+		err := k.UpdateNonce(ctx, 5, &xmsg)
+		require.NoError(t, err)
+		require.Equal(t, uint64(100), xmsg.GetCurrentOutTxParam().OutboundTxTssNonce)
+		observerMock.AssertNotCalled(t, "IsChainOutboundPaused", mock.Anything, mock.Anything)
+		observerMock.AssertNotCalled(t, "IsChainSigningPaused", mock.Anything, mock.Anything)
+	})
 }
@@ -13,6 +13,7 @@ func GetAllAuthzPellclientTxTypes() []string {
 		sdk.MsgTypeURL(&MsgVoteOnObservedInboundTx{}),
 		sdk.MsgTypeURL(&MsgVoteOnObservedOutboundTx{}),
 		sdk.MsgTypeURL(&MsgVoteInboundBlock{}),
+		sdk.MsgTypeURL(&MsgProveInboundTx{}),
 		sdk.MsgTypeURL(&MsgAddToOutTxTracker{}),
 		sdk.MsgTypeURL(&relayertypes.MsgVoteTSS{}),
 		sdk.MsgTypeURL(&relayertypes.MsgAddBlameVote{}),
@@ -14,6 +14,7 @@ func TestGetAllAuthzPellclientTxTypes(t *testing.T) {
 		"/xmsg.MsgVoteOnObservedInboundTx",
 		"/xmsg.MsgVoteOnObservedOutboundTx",
 		"/xmsg.MsgVoteInboundBlock",
+		"/xmsg.MsgProveInboundTx",
 		"/xmsg.MsgAddToOutTxTracker",
 		"/relayer.MsgVoteTSS",
 		"/relayer.MsgAddBlameVote",
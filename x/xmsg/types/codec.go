@@ -15,11 +15,15 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgVoteOnObservedOutboundTx{}, "xmsg/VoteOnObservedOutboundTx", nil)
 	cdc.RegisterConcrete(&MsgVoteOnObservedInboundTx{}, "xmsg/VoteOnObservedInboundTx", nil)
 	cdc.RegisterConcrete(&MsgVoteInboundBlock{}, "xmsg/VoteInboundBlock", nil)
+	cdc.RegisterConcrete(&MsgProveInboundTx{}, "xmsg/ProveInboundTx", nil)
 	cdc.RegisterConcrete(&MsgMigrateTssFunds{}, "xmsg/MigrateTssFunds", nil)
 	cdc.RegisterConcrete(&MsgUpdateTssAddress{}, "xmsg/UpdateTssAddress", nil)
 	cdc.RegisterConcrete(&MsgAbortStuckXmsg{}, "xmsg/AbortStuckXmsg", nil)
+	cdc.RegisterConcrete(&MsgRefundAbortedXmsg{}, "xmsg/RefundAbortedXmsg", nil)
 	cdc.RegisterConcrete(&MsgUpdateRateLimiterFlags{}, "xmsg/UpdateRateLimiterFlags", nil)
 	cdc.RegisterConcrete(&MsgUpsertCrosschainFeeParams{}, "xmsg/UpsertCrosschainFeeParams", nil)
+	cdc.RegisterConcrete(&MsgUpdateRechargeBudget{}, "xmsg/UpdateRechargeBudget", nil)
+	cdc.RegisterConcrete(&MsgRemoveRechargeBudget{}, "xmsg/RemoveRechargeBudget", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -33,9 +37,13 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgMigrateTssFunds{},
 		&MsgUpdateTssAddress{},
 		&MsgAbortStuckXmsg{},
+		&MsgRefundAbortedXmsg{},
 		&MsgUpdateRateLimiterFlags{},
 		&MsgVoteInboundBlock{},
+		&MsgProveInboundTx{},
 		&MsgUpsertCrosschainFeeParams{},
+		&MsgUpdateRechargeBudget{},
+		&MsgRemoveRechargeBudget{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
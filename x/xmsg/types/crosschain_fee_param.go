@@ -0,0 +1,36 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// CrosschainFeeQuoteSafetyMarginPercent is the margin, in percent of the gas fee, added to fee quotes
+// to cover the gas price moves until the outbound tx is signed
+const CrosschainFeeQuoteSafetyMarginPercent = 20
+
+// EventFee returns the fee charged for the given event type
+func (m CrosschainFeeParam) EventFee(eventType CrosschainFeeEventType) (math.Int, error) {
+	var fee math.Int
+	switch eventType {
+	case CrosschainFeeEventType_CROSSCHAIN_FEE_EVENT_DELEGATION_OPERATOR_SHARE:
+		fee = m.DelegationOperatorShareEventFee
+	case CrosschainFeeEventType_CROSSCHAIN_FEE_EVENT_DELEGATION_OPERATOR_SYNC:
+		fee = m.DelegationOperatorSyncFee
+	case CrosschainFeeEventType_CROSSCHAIN_FEE_EVENT_REGISTRY_ROUTER_SYNC_GROUP:
+		fee = m.RegistryRouterSyncGroupEventFee
+	case CrosschainFeeEventType_CROSSCHAIN_FEE_EVENT_DVS_DEFAULT:
+		fee = m.DvsDefaultFee
+	case CrosschainFeeEventType_CROSSCHAIN_FEE_EVENT_PELL_SENT:
+		fee = m.PellSentEventFee
+	default:
+		return math.ZeroInt(), fmt.Errorf("unknown crosschain fee event type %s", eventType)
+	}
+
+	// fees which are not set are stored as nil
+	if fee.IsNil() {
+		return math.ZeroInt(), nil
+	}
+	return fee, nil
+}
@@ -24,6 +24,47 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Crosschain event charged with a fee from CrosschainFeeParam
+type CrosschainFeeEventType int32
+
+const (
+	CrosschainFeeEventType_CROSSCHAIN_FEE_EVENT_UNSPECIFIED CrosschainFeeEventType = 0
+	// PellDelegationManagerOperatorSharesIncreased and Decreased
+	CrosschainFeeEventType_CROSSCHAIN_FEE_EVENT_DELEGATION_OPERATOR_SHARE CrosschainFeeEventType = 1
+	// PellDelegationManagerOperatorRegistered and DetailsModified
+	CrosschainFeeEventType_CROSSCHAIN_FEE_EVENT_DELEGATION_OPERATOR_SYNC CrosschainFeeEventType = 2
+	// RegistryRouterSyncGroup
+	CrosschainFeeEventType_CROSSCHAIN_FEE_EVENT_REGISTRY_ROUTER_SYNC_GROUP CrosschainFeeEventType = 3
+	CrosschainFeeEventType_CROSSCHAIN_FEE_EVENT_DVS_DEFAULT                CrosschainFeeEventType = 4
+	CrosschainFeeEventType_CROSSCHAIN_FEE_EVENT_PELL_SENT                  CrosschainFeeEventType = 5
+)
+
+var CrosschainFeeEventType_name = map[int32]string{
+	0: "CROSSCHAIN_FEE_EVENT_UNSPECIFIED",
+	1: "CROSSCHAIN_FEE_EVENT_DELEGATION_OPERATOR_SHARE",
+	2: "CROSSCHAIN_FEE_EVENT_DELEGATION_OPERATOR_SYNC",
+	3: "CROSSCHAIN_FEE_EVENT_REGISTRY_ROUTER_SYNC_GROUP",
+	4: "CROSSCHAIN_FEE_EVENT_DVS_DEFAULT",
+	5: "CROSSCHAIN_FEE_EVENT_PELL_SENT",
+}
+
+var CrosschainFeeEventType_value = map[string]int32{
+	"CROSSCHAIN_FEE_EVENT_UNSPECIFIED":                0,
+	"CROSSCHAIN_FEE_EVENT_DELEGATION_OPERATOR_SHARE":  1,
+	"CROSSCHAIN_FEE_EVENT_DELEGATION_OPERATOR_SYNC":   2,
+	"CROSSCHAIN_FEE_EVENT_REGISTRY_ROUTER_SYNC_GROUP": 3,
+	"CROSSCHAIN_FEE_EVENT_DVS_DEFAULT":                4,
+	"CROSSCHAIN_FEE_EVENT_PELL_SENT":                  5,
+}
+
+func (x CrosschainFeeEventType) String() string {
+	return proto.EnumName(CrosschainFeeEventType_name, int32(x))
+}
+
+func (CrosschainFeeEventType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9dcb55132017eb0e, []int{0}
+}
+
 // Fee charged when constructing cross-chain xmsg from PEVM events
 type CrosschainFeeParam struct {
 	ChainId int64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
@@ -88,39 +129,49 @@ func (m *CrosschainFeeParam) GetIsSupported() bool {
 }
 
 func init() {
+	proto.RegisterEnum("xmsg.CrosschainFeeEventType", CrosschainFeeEventType_name, CrosschainFeeEventType_value)
 	proto.RegisterType((*CrosschainFeeParam)(nil), "xmsg.CrosschainFeeParam")
 }
 
 func init() { proto.RegisterFile("xmsg/crosschain_fee_param.proto", fileDescriptor_9dcb55132017eb0e) }
 
 var fileDescriptor_9dcb55132017eb0e = []byte{
-	// 402 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcd, 0x8e, 0xd3, 0x30,
-	0x14, 0x85, 0x13, 0x51, 0x66, 0x3a, 0x06, 0x04, 0x0a, 0x20, 0x75, 0x10, 0x24, 0xe5, 0x67, 0x51,
-	0x09, 0x29, 0x41, 0xe2, 0x0d, 0x06, 0x3a, 0x68, 0x24, 0x04, 0xa3, 0x74, 0xc7, 0x02, 0xcb, 0x13,
-	0xdf, 0x49, 0xac, 0x26, 0xb9, 0x96, 0xed, 0x94, 0xe6, 0x2d, 0x78, 0x06, 0x9e, 0xa6, 0xcb, 0x2e,
-	0x11, 0x8b, 0x0a, 0xb5, 0x2f, 0x82, 0x9c, 0xf4, 0x87, 0x45, 0x17, 0xd9, 0x25, 0xd7, 0x3e, 0xdf,
-	0x39, 0xc7, 0xba, 0x24, 0x98, 0x17, 0x3a, 0x8d, 0x12, 0x85, 0x5a, 0x27, 0x19, 0x13, 0x25, 0xbd,
-	0x05, 0xa0, 0x92, 0x29, 0x56, 0x84, 0x52, 0xa1, 0x41, 0xaf, 0x67, 0x2f, 0x3c, 0x7b, 0x92, 0x62,
-	0x8a, 0xcd, 0x20, 0xb2, 0x5f, 0xed, 0xd9, 0xab, 0x5f, 0x3d, 0xe2, 0x7d, 0xd8, 0x4b, 0x2f, 0x01,
-	0xae, 0xad, 0xd0, 0x3b, 0x27, 0xfd, 0x96, 0x25, 0xf8, 0xc0, 0x1d, 0xba, 0xa3, 0x3b, 0xf1, 0x69,
-	0xf3, 0x7f, 0xc5, 0xbd, 0x29, 0x79, 0xcd, 0x21, 0x87, 0x94, 0x19, 0x81, 0x25, 0x45, 0x09, 0x8a,
-	0x19, 0x54, 0x54, 0x67, 0x4c, 0x01, 0x85, 0x19, 0x94, 0xc6, 0x06, 0x18, 0xf4, 0x86, 0xee, 0xe8,
-	0xec, 0xe2, 0xc5, 0x62, 0x15, 0x38, 0x7f, 0x56, 0xc1, 0xd3, 0x04, 0x75, 0x81, 0x5a, 0xf3, 0x69,
-	0x28, 0x30, 0x2a, 0x98, 0xc9, 0xc2, 0xab, 0xd2, 0xc4, 0xc1, 0x81, 0xf4, 0x75, 0x0b, 0x9a, 0x58,
-	0xce, 0xd8, 0x62, 0x2e, 0x01, 0xbc, 0xef, 0xe4, 0xf9, 0x51, 0xb3, 0xba, 0x4c, 0x1a, 0x97, 0xbb,
-	0x5d, 0x5c, 0xce, 0x8f, 0xb8, 0xd4, 0x65, 0x62, 0xf9, 0x39, 0x79, 0xa3, 0x20, 0x15, 0xda, 0xa8,
-	0x9a, 0x2a, 0xac, 0x0c, 0x6c, 0xd9, 0xa9, 0xc2, 0x4a, 0xfe, 0xd7, 0xe6, 0xa4, 0x53, 0x9b, 0x1d,
-	0x2a, 0x6e, 0x48, 0xd6, 0xe3, 0x93, 0xe5, 0xec, 0xdb, 0x8c, 0xc9, 0x43, 0x3e, 0xd3, 0x94, 0xc3,
-	0x2d, 0xab, 0xf2, 0x16, 0x7c, 0xda, 0x05, 0xfc, 0x80, 0xcf, 0xf4, 0xc7, 0x56, 0x64, 0x31, 0x9f,
-	0xc9, 0x63, 0x09, 0x79, 0x4e, 0xb5, 0x4d, 0x77, 0xc8, 0xd8, 0xef, 0x82, 0x7a, 0x64, 0x95, 0x13,
-	0x28, 0xcd, 0x3e, 0xd4, 0x4b, 0x72, 0x5f, 0x68, 0xaa, 0x2b, 0x29, 0x51, 0x19, 0xe0, 0x83, 0xb3,
-	0xa1, 0x3b, 0xea, 0xc7, 0xf7, 0x84, 0x9e, 0xec, 0x46, 0x17, 0xe3, 0xc5, 0xda, 0x77, 0x97, 0x6b,
-	0xdf, 0xfd, 0xbb, 0xf6, 0xdd, 0x9f, 0x1b, 0xdf, 0x59, 0x6e, 0x7c, 0xe7, 0xf7, 0xc6, 0x77, 0xbe,
-	0xbd, 0x4d, 0x85, 0xc9, 0xaa, 0x9b, 0x30, 0xc1, 0x22, 0x7a, 0x37, 0xbf, 0x86, 0x3c, 0xff, 0x02,
-	0xe6, 0x07, 0xaa, 0x69, 0xc4, 0xec, 0x5b, 0x44, 0xf3, 0xa8, 0x59, 0x4e, 0x53, 0x4b, 0xd0, 0x37,
-	0x27, 0xcd, 0xca, 0xbd, 0xff, 0x17, 0x00, 0x00, 0xff, 0xff, 0xa9, 0x8d, 0x94, 0x1c, 0xb1, 0x02,
-	0x00, 0x00,
+	// 547 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xdf, 0x6e, 0xd3, 0x30,
+	0x18, 0xc5, 0x9b, 0xad, 0xdb, 0x3a, 0x03, 0x22, 0x0a, 0x7f, 0xd4, 0x4d, 0x90, 0x96, 0xb1, 0x8b,
+	0x0a, 0x44, 0x03, 0xec, 0x09, 0xba, 0xd6, 0xed, 0x22, 0x55, 0x49, 0xe4, 0xa4, 0x93, 0xc6, 0x05,
+	0x56, 0x96, 0x78, 0x69, 0xd4, 0x34, 0x8e, 0x6c, 0xb7, 0xb4, 0x6f, 0xc1, 0x33, 0x20, 0x2e, 0x10,
+	0x4f, 0xb2, 0xcb, 0x5d, 0x22, 0x2e, 0x26, 0xd4, 0xbe, 0x08, 0x72, 0xba, 0x75, 0x20, 0x75, 0x52,
+	0xef, 0x12, 0xfb, 0x3b, 0xbf, 0x73, 0x8e, 0xe4, 0x0f, 0x54, 0x26, 0x43, 0x1e, 0x19, 0x01, 0xa3,
+	0x9c, 0x07, 0x7d, 0x3f, 0x4e, 0xf1, 0x05, 0x21, 0x38, 0xf3, 0x99, 0x3f, 0xac, 0x67, 0x8c, 0x0a,
+	0xaa, 0x15, 0xe5, 0xc0, 0xfe, 0xd3, 0x88, 0x46, 0x34, 0x3f, 0x30, 0xe4, 0xd7, 0xe2, 0xee, 0xe0,
+	0x5b, 0x11, 0x68, 0xcd, 0xa5, 0xb4, 0x4d, 0x88, 0x23, 0x85, 0xda, 0x1e, 0x28, 0x2d, 0x58, 0x71,
+	0x58, 0x56, 0xaa, 0x4a, 0x6d, 0x13, 0xed, 0xe4, 0xff, 0x66, 0xa8, 0x0d, 0xc0, 0xeb, 0x90, 0x24,
+	0x24, 0xf2, 0x45, 0x4c, 0x53, 0x4c, 0x33, 0xc2, 0x7c, 0x41, 0x19, 0xe6, 0x7d, 0x9f, 0x11, 0x4c,
+	0xc6, 0x24, 0x15, 0x32, 0x40, 0xb9, 0x58, 0x55, 0x6a, 0xbb, 0xc7, 0x2f, 0x2f, 0xaf, 0x2b, 0x85,
+	0xdf, 0xd7, 0x95, 0x67, 0x01, 0xe5, 0x43, 0xca, 0x79, 0x38, 0xa8, 0xc7, 0xd4, 0x18, 0xfa, 0xa2,
+	0x5f, 0x37, 0x53, 0x81, 0x2a, 0x77, 0x24, 0xfb, 0x06, 0xe4, 0x4a, 0x0e, 0x94, 0x98, 0x36, 0x21,
+	0xda, 0x67, 0xf0, 0x62, 0xa5, 0xd9, 0x34, 0x0d, 0x72, 0x97, 0xad, 0x75, 0x5c, 0xf6, 0x56, 0xb8,
+	0x4c, 0xd3, 0x40, 0xf2, 0x13, 0x70, 0xc8, 0x48, 0x14, 0x73, 0xc1, 0xa6, 0x98, 0xd1, 0x91, 0x20,
+	0x37, 0xec, 0x88, 0xd1, 0x51, 0xf6, 0x4f, 0x9b, 0xed, 0xb5, 0xda, 0xdc, 0xa2, 0x50, 0x4e, 0x92,
+	0x1e, 0x1d, 0xc9, 0x59, 0xb6, 0x81, 0xe0, 0x71, 0x38, 0xe6, 0x38, 0x24, 0x17, 0xfe, 0x28, 0x59,
+	0x80, 0x77, 0xd6, 0x01, 0x3f, 0x0a, 0xc7, 0xbc, 0xb5, 0x10, 0x49, 0x4c, 0x17, 0x3c, 0xc9, 0x48,
+	0x92, 0x60, 0x2e, 0xd3, 0xdd, 0x65, 0x2c, 0xad, 0x83, 0x52, 0xa5, 0xd2, 0x25, 0xa9, 0x58, 0x86,
+	0x7a, 0x05, 0x1e, 0xc6, 0x1c, 0xf3, 0x51, 0x96, 0x51, 0x26, 0x48, 0x58, 0xde, 0xad, 0x2a, 0xb5,
+	0x12, 0x7a, 0x10, 0x73, 0xf7, 0xf6, 0xe8, 0xcd, 0xcf, 0x0d, 0xf0, 0xfc, 0xbf, 0x47, 0x92, 0x8b,
+	0xbd, 0x69, 0x46, 0xb4, 0x43, 0x50, 0x6d, 0x22, 0xdb, 0x75, 0x9b, 0x27, 0x0d, 0xd3, 0xc2, 0x6d,
+	0x08, 0x31, 0x3c, 0x85, 0x96, 0x87, 0x7b, 0x96, 0xeb, 0xc0, 0xa6, 0xd9, 0x36, 0x61, 0x4b, 0x2d,
+	0x68, 0x1f, 0x41, 0x7d, 0xe5, 0x54, 0x0b, 0x76, 0x61, 0xa7, 0xe1, 0x99, 0xb6, 0x85, 0x6d, 0x07,
+	0xa2, 0x86, 0x67, 0x23, 0xec, 0x9e, 0x34, 0x10, 0x54, 0x15, 0xed, 0x03, 0x78, 0xb7, 0xbe, 0xe6,
+	0xcc, 0x6a, 0xaa, 0x1b, 0xda, 0x11, 0x30, 0x56, 0x4a, 0x10, 0xec, 0x98, 0xae, 0x87, 0xce, 0x30,
+	0xb2, 0x7b, 0x1e, 0x5c, 0x8c, 0xe3, 0x0e, 0xb2, 0x7b, 0x8e, 0xba, 0x79, 0x6f, 0x83, 0xd6, 0xa9,
+	0x8b, 0x5b, 0xb0, 0xdd, 0xe8, 0x75, 0x3d, 0xb5, 0xa8, 0x1d, 0x00, 0x7d, 0xe5, 0x94, 0x03, 0xbb,
+	0x5d, 0xec, 0x42, 0xcb, 0x53, 0xb7, 0xf6, 0x8b, 0x3f, 0xbe, 0xeb, 0xca, 0x31, 0xbc, 0x9c, 0xe9,
+	0xca, 0xd5, 0x4c, 0x57, 0xfe, 0xcc, 0x74, 0xe5, 0xeb, 0x5c, 0x2f, 0x5c, 0xcd, 0xf5, 0xc2, 0xaf,
+	0xb9, 0x5e, 0xf8, 0xf4, 0x36, 0x8a, 0x45, 0x7f, 0x74, 0x5e, 0x0f, 0xe8, 0xd0, 0x78, 0x3f, 0x71,
+	0x48, 0x92, 0x58, 0x44, 0x7c, 0xa1, 0x6c, 0x60, 0xf8, 0xf2, 0xe1, 0x18, 0x13, 0x23, 0xdf, 0x64,
+	0x31, 0xcd, 0x08, 0x3f, 0xdf, 0xce, 0xf7, 0xf3, 0xe8, 0xef, 0x00, 0x31, 0x61, 0x95, 0xa7, 0xde,
+	0x03, 0x00, 0x00,
 }
 
 func (m *CrosschainFeeParam) Marshal() (dAtA []byte, err error) {
@@ -0,0 +1,37 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/x/xmsg/types"
+)
+
+func TestCrosschainFeeParam_EventFee(t *testing.T) {
+	param := types.CrosschainFeeParam{
+		ChainId:                         1,
+		DelegationOperatorShareEventFee: math.NewInt(1),
+		DelegationOperatorSyncFee:       math.NewInt(2),
+		RegistryRouterSyncGroupEventFee: math.NewInt(3),
+		DvsDefaultFee:                   math.NewInt(4),
+		IsSupported:                     true,
+	}
+
+	for eventType, expected := range map[types.CrosschainFeeEventType]int64{
+		types.CrosschainFeeEventType_CROSSCHAIN_FEE_EVENT_DELEGATION_OPERATOR_SHARE:  1,
+		types.CrosschainFeeEventType_CROSSCHAIN_FEE_EVENT_DELEGATION_OPERATOR_SYNC:   2,
+		types.CrosschainFeeEventType_CROSSCHAIN_FEE_EVENT_REGISTRY_ROUTER_SYNC_GROUP: 3,
+		types.CrosschainFeeEventType_CROSSCHAIN_FEE_EVENT_DVS_DEFAULT:                4,
+		// not set
+		types.CrosschainFeeEventType_CROSSCHAIN_FEE_EVENT_PELL_SENT: 0,
+	} {
+		fee, err := param.EventFee(eventType)
+		require.NoError(t, err, eventType.String())
+		require.EqualValues(t, expected, fee.Int64(), eventType.String())
+	}
+
+	_, err := param.EventFee(types.CrosschainFeeEventType_CROSSCHAIN_FEE_EVENT_UNSPECIFIED)
+	require.Error(t, err)
+}
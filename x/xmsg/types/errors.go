@@ -65,4 +65,13 @@ var (
 	// inbound tx related errors (1156-1158)
 	ErrInboundPrevEventNotFound = errorsmod.Register(ModuleName, 1156, "sequential error: inbound prev event not found")
 	ErrInboundPrevBlockNotFound = errorsmod.Register(ModuleName, 1157, "sequential error: inbound prev block not found")
+
+	// inbound proof related errors (1158-1159)
+	ErrInboundProofVerificationFail = errorsmod.Register(ModuleName, 1158, "inbound proof verification fail")
+	ErrInboundProofNotSupported     = errorsmod.Register(ModuleName, 1159, "inbound event not supported by inbound proof")
+
+	// recharge ledger related errors (1160-1162)
+	ErrRechargeBudgetExceeded = errorsmod.Register(ModuleName, 1160, "recharge budget exceeded")
+	ErrInvalidRechargeBudget  = errorsmod.Register(ModuleName, 1161, "invalid recharge budget")
+	ErrRechargeBudgetNotFound = errorsmod.Register(ModuleName, 1162, "recharge budget not found")
 )
@@ -434,6 +434,75 @@ func (m *EventPellSent) GetNewStatus() string {
 	return ""
 }
 
+// aborted xmsg refunded event
+type EventAbortedXmsgRefunded struct {
+	MsgTypeUrl    string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	XmsgIndex     string `protobuf:"bytes,2,opt,name=xmsg_index,json=xmsgIndex,proto3" json:"xmsg_index,omitempty"`
+	RefundAddress string `protobuf:"bytes,3,opt,name=refund_address,json=refundAddress,proto3" json:"refund_address,omitempty"`
+	Amount        string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *EventAbortedXmsgRefunded) Reset()         { *m = EventAbortedXmsgRefunded{} }
+func (m *EventAbortedXmsgRefunded) String() string { return proto.CompactTextString(m) }
+func (*EventAbortedXmsgRefunded) ProtoMessage()    {}
+func (*EventAbortedXmsgRefunded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1823f8637d92b134, []int{4}
+}
+func (m *EventAbortedXmsgRefunded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAbortedXmsgRefunded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAbortedXmsgRefunded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAbortedXmsgRefunded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAbortedXmsgRefunded.Merge(m, src)
+}
+func (m *EventAbortedXmsgRefunded) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAbortedXmsgRefunded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAbortedXmsgRefunded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAbortedXmsgRefunded proto.InternalMessageInfo
+
+func (m *EventAbortedXmsgRefunded) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *EventAbortedXmsgRefunded) GetXmsgIndex() string {
+	if m != nil {
+		return m.XmsgIndex
+	}
+	return ""
+}
+
+func (m *EventAbortedXmsgRefunded) GetRefundAddress() string {
+	if m != nil {
+		return m.RefundAddress
+	}
+	return ""
+}
+
+func (m *EventAbortedXmsgRefunded) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
 // increased xmsg gas price event
 type EventXmsgGasPriceIncreased struct {
 	XmsgIndex        string `protobuf:"bytes,1,opt,name=xmsg_index,json=xmsgIndex,proto3" json:"xmsg_index,omitempty"`
@@ -445,7 +514,7 @@ func (m *EventXmsgGasPriceIncreased) Reset()         { *m = EventXmsgGasPriceInc
 func (m *EventXmsgGasPriceIncreased) String() string { return proto.CompactTextString(m) }
 func (*EventXmsgGasPriceIncreased) ProtoMessage()    {}
 func (*EventXmsgGasPriceIncreased) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1823f8637d92b134, []int{4}
+	return fileDescriptor_1823f8637d92b134, []int{5}
 }
 func (m *EventXmsgGasPriceIncreased) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -505,7 +574,7 @@ func (m *EventChainIndex) Reset()         { *m = EventChainIndex{} }
 func (m *EventChainIndex) String() string { return proto.CompactTextString(m) }
 func (*EventChainIndex) ProtoMessage()    {}
 func (*EventChainIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1823f8637d92b134, []int{5}
+	return fileDescriptor_1823f8637d92b134, []int{6}
 }
 func (m *EventChainIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -548,61 +617,399 @@ func (m *EventChainIndex) GetCurrHeight() uint64 {
 	return 0
 }
 
+// xmsg status changed event - emitted on every status transition of a xmsg
+type EventXmsgStatusChanged struct {
+	XmsgIndex           string `protobuf:"bytes,1,opt,name=xmsg_index,json=xmsgIndex,proto3" json:"xmsg_index,omitempty"`
+	OldStatus           string `protobuf:"bytes,2,opt,name=old_status,json=oldStatus,proto3" json:"old_status,omitempty"`
+	NewStatus           string `protobuf:"bytes,3,opt,name=new_status,json=newStatus,proto3" json:"new_status,omitempty"`
+	StatusMessage       string `protobuf:"bytes,4,opt,name=status_message,json=statusMessage,proto3" json:"status_message,omitempty"`
+	SenderChainId       int64  `protobuf:"varint,5,opt,name=sender_chain_id,json=senderChainId,proto3" json:"sender_chain_id,omitempty"`
+	ReceiverChainId     int64  `protobuf:"varint,6,opt,name=receiver_chain_id,json=receiverChainId,proto3" json:"receiver_chain_id,omitempty"`
+	InboundTxHash       string `protobuf:"bytes,7,opt,name=inbound_tx_hash,json=inboundTxHash,proto3" json:"inbound_tx_hash,omitempty"`
+	InboundBallotIndex  string `protobuf:"bytes,8,opt,name=inbound_ballot_index,json=inboundBallotIndex,proto3" json:"inbound_ballot_index,omitempty"`
+	OutboundTxHash      string `protobuf:"bytes,9,opt,name=outbound_tx_hash,json=outboundTxHash,proto3" json:"outbound_tx_hash,omitempty"`
+	OutboundGasUsed     uint64 `protobuf:"varint,10,opt,name=outbound_gas_used,json=outboundGasUsed,proto3" json:"outbound_gas_used,omitempty"`
+	OutboundBallotIndex string `protobuf:"bytes,11,opt,name=outbound_ballot_index,json=outboundBallotIndex,proto3" json:"outbound_ballot_index,omitempty"`
+}
+
+func (m *EventXmsgStatusChanged) Reset()         { *m = EventXmsgStatusChanged{} }
+func (m *EventXmsgStatusChanged) String() string { return proto.CompactTextString(m) }
+func (*EventXmsgStatusChanged) ProtoMessage()    {}
+func (*EventXmsgStatusChanged) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1823f8637d92b134, []int{7}
+}
+func (m *EventXmsgStatusChanged) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventXmsgStatusChanged) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventXmsgStatusChanged.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventXmsgStatusChanged) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventXmsgStatusChanged.Merge(m, src)
+}
+func (m *EventXmsgStatusChanged) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventXmsgStatusChanged) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventXmsgStatusChanged.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventXmsgStatusChanged proto.InternalMessageInfo
+
+func (m *EventXmsgStatusChanged) GetXmsgIndex() string {
+	if m != nil {
+		return m.XmsgIndex
+	}
+	return ""
+}
+
+func (m *EventXmsgStatusChanged) GetOldStatus() string {
+	if m != nil {
+		return m.OldStatus
+	}
+	return ""
+}
+
+func (m *EventXmsgStatusChanged) GetNewStatus() string {
+	if m != nil {
+		return m.NewStatus
+	}
+	return ""
+}
+
+func (m *EventXmsgStatusChanged) GetStatusMessage() string {
+	if m != nil {
+		return m.StatusMessage
+	}
+	return ""
+}
+
+func (m *EventXmsgStatusChanged) GetSenderChainId() int64 {
+	if m != nil {
+		return m.SenderChainId
+	}
+	return 0
+}
+
+func (m *EventXmsgStatusChanged) GetReceiverChainId() int64 {
+	if m != nil {
+		return m.ReceiverChainId
+	}
+	return 0
+}
+
+func (m *EventXmsgStatusChanged) GetInboundTxHash() string {
+	if m != nil {
+		return m.InboundTxHash
+	}
+	return ""
+}
+
+func (m *EventXmsgStatusChanged) GetInboundBallotIndex() string {
+	if m != nil {
+		return m.InboundBallotIndex
+	}
+	return ""
+}
+
+func (m *EventXmsgStatusChanged) GetOutboundTxHash() string {
+	if m != nil {
+		return m.OutboundTxHash
+	}
+	return ""
+}
+
+func (m *EventXmsgStatusChanged) GetOutboundGasUsed() uint64 {
+	if m != nil {
+		return m.OutboundGasUsed
+	}
+	return 0
+}
+
+func (m *EventXmsgStatusChanged) GetOutboundBallotIndex() string {
+	if m != nil {
+		return m.OutboundBallotIndex
+	}
+	return ""
+}
+
+// recharge recorded event - emitted when a pell or gas token recharge is sent
+// to the tss address of a chain
+type EventRechargeRecorded struct {
+	ChainId           int64  `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	TokenType         string `protobuf:"bytes,2,opt,name=token_type,json=tokenType,proto3" json:"token_type,omitempty"`
+	VoteIndex         uint64 `protobuf:"varint,3,opt,name=vote_index,json=voteIndex,proto3" json:"vote_index,omitempty"`
+	Amount            string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	CumulativeAmount  string `protobuf:"bytes,5,opt,name=cumulative_amount,json=cumulativeAmount,proto3" json:"cumulative_amount,omitempty"`
+	WindowAmount      string `protobuf:"bytes,6,opt,name=window_amount,json=windowAmount,proto3" json:"window_amount,omitempty"`
+	WindowStartHeight int64  `protobuf:"varint,7,opt,name=window_start_height,json=windowStartHeight,proto3" json:"window_start_height,omitempty"`
+}
+
+func (m *EventRechargeRecorded) Reset()         { *m = EventRechargeRecorded{} }
+func (m *EventRechargeRecorded) String() string { return proto.CompactTextString(m) }
+func (*EventRechargeRecorded) ProtoMessage()    {}
+func (*EventRechargeRecorded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1823f8637d92b134, []int{8}
+}
+func (m *EventRechargeRecorded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRechargeRecorded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRechargeRecorded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRechargeRecorded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRechargeRecorded.Merge(m, src)
+}
+func (m *EventRechargeRecorded) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRechargeRecorded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRechargeRecorded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRechargeRecorded proto.InternalMessageInfo
+
+func (m *EventRechargeRecorded) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *EventRechargeRecorded) GetTokenType() string {
+	if m != nil {
+		return m.TokenType
+	}
+	return ""
+}
+
+func (m *EventRechargeRecorded) GetVoteIndex() uint64 {
+	if m != nil {
+		return m.VoteIndex
+	}
+	return 0
+}
+
+func (m *EventRechargeRecorded) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *EventRechargeRecorded) GetCumulativeAmount() string {
+	if m != nil {
+		return m.CumulativeAmount
+	}
+	return ""
+}
+
+func (m *EventRechargeRecorded) GetWindowAmount() string {
+	if m != nil {
+		return m.WindowAmount
+	}
+	return ""
+}
+
+func (m *EventRechargeRecorded) GetWindowStartHeight() int64 {
+	if m != nil {
+		return m.WindowStartHeight
+	}
+	return 0
+}
+
+// recharge budget exceeded event - emitted when a finalized recharge is
+// skipped because it would exceed the budget of the current window
+type EventRechargeBudgetExceeded struct {
+	ChainId           int64  `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	TokenType         string `protobuf:"bytes,2,opt,name=token_type,json=tokenType,proto3" json:"token_type,omitempty"`
+	VoteIndex         uint64 `protobuf:"varint,3,opt,name=vote_index,json=voteIndex,proto3" json:"vote_index,omitempty"`
+	Amount            string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	WindowAmount      string `protobuf:"bytes,5,opt,name=window_amount,json=windowAmount,proto3" json:"window_amount,omitempty"`
+	MaxAmount         string `protobuf:"bytes,6,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"`
+	WindowStartHeight int64  `protobuf:"varint,7,opt,name=window_start_height,json=windowStartHeight,proto3" json:"window_start_height,omitempty"`
+}
+
+func (m *EventRechargeBudgetExceeded) Reset()         { *m = EventRechargeBudgetExceeded{} }
+func (m *EventRechargeBudgetExceeded) String() string { return proto.CompactTextString(m) }
+func (*EventRechargeBudgetExceeded) ProtoMessage()    {}
+func (*EventRechargeBudgetExceeded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1823f8637d92b134, []int{9}
+}
+func (m *EventRechargeBudgetExceeded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRechargeBudgetExceeded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRechargeBudgetExceeded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRechargeBudgetExceeded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRechargeBudgetExceeded.Merge(m, src)
+}
+func (m *EventRechargeBudgetExceeded) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRechargeBudgetExceeded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRechargeBudgetExceeded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRechargeBudgetExceeded proto.InternalMessageInfo
+
+func (m *EventRechargeBudgetExceeded) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *EventRechargeBudgetExceeded) GetTokenType() string {
+	if m != nil {
+		return m.TokenType
+	}
+	return ""
+}
+
+func (m *EventRechargeBudgetExceeded) GetVoteIndex() uint64 {
+	if m != nil {
+		return m.VoteIndex
+	}
+	return 0
+}
+
+func (m *EventRechargeBudgetExceeded) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *EventRechargeBudgetExceeded) GetWindowAmount() string {
+	if m != nil {
+		return m.WindowAmount
+	}
+	return ""
+}
+
+func (m *EventRechargeBudgetExceeded) GetMaxAmount() string {
+	if m != nil {
+		return m.MaxAmount
+	}
+	return ""
+}
+
+func (m *EventRechargeBudgetExceeded) GetWindowStartHeight() int64 {
+	if m != nil {
+		return m.WindowStartHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*EventInboundFinalized)(nil), "xmsg.EventInboundFinalized")
 	proto.RegisterType((*EventOutboundFailure)(nil), "xmsg.EventOutboundFailure")
 	proto.RegisterType((*EventOutboundSuccess)(nil), "xmsg.EventOutboundSuccess")
 	proto.RegisterType((*EventPellSent)(nil), "xmsg.EventPellSent")
+	proto.RegisterType((*EventAbortedXmsgRefunded)(nil), "xmsg.EventAbortedXmsgRefunded")
 	proto.RegisterType((*EventXmsgGasPriceIncreased)(nil), "xmsg.EventXmsgGasPriceIncreased")
 	proto.RegisterType((*EventChainIndex)(nil), "xmsg.EventChainIndex")
+	proto.RegisterType((*EventXmsgStatusChanged)(nil), "xmsg.EventXmsgStatusChanged")
+	proto.RegisterType((*EventRechargeRecorded)(nil), "xmsg.EventRechargeRecorded")
+	proto.RegisterType((*EventRechargeBudgetExceeded)(nil), "xmsg.EventRechargeBudgetExceeded")
 }
 
 func init() { proto.RegisterFile("xmsg/events.proto", fileDescriptor_1823f8637d92b134) }
 
 var fileDescriptor_1823f8637d92b134 = []byte{
-	// 663 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x54, 0xdd, 0x4e, 0xd4, 0x40,
-	0x14, 0xa6, 0xb0, 0xee, 0xcf, 0xd9, 0x1f, 0xb4, 0x2a, 0x29, 0x44, 0x16, 0xc4, 0x3f, 0x12, 0x0d,
-	0x6b, 0xc2, 0x1b, 0x60, 0x40, 0xf6, 0x02, 0x21, 0x0b, 0x26, 0xc6, 0x9b, 0xc9, 0x6c, 0x7b, 0xec,
-	0x4e, 0x68, 0xa7, 0xcd, 0xcc, 0x14, 0x8a, 0x4f, 0x61, 0x4c, 0x7c, 0x27, 0x2f, 0xb9, 0xf4, 0xd2,
-	0xc0, 0x0b, 0xf8, 0x02, 0x26, 0x66, 0x66, 0x5a, 0x7e, 0x8a, 0x89, 0x17, 0xc6, 0x78, 0xd7, 0x7e,
-	0xe7, 0xcc, 0x99, 0xef, 0x9c, 0xef, 0x3b, 0x03, 0x77, 0xf2, 0x58, 0x86, 0x03, 0x3c, 0x42, 0xae,
-	0xe4, 0x5a, 0x2a, 0x12, 0x95, 0xb8, 0x35, 0x0d, 0xad, 0xfc, 0x9c, 0x86, 0xfb, 0x9b, 0x1a, 0x1e,
-	0xf2, 0x71, 0x92, 0xf1, 0x60, 0x8b, 0x71, 0x1a, 0xb1, 0x8f, 0x18, 0xb8, 0xcb, 0xd0, 0x89, 0x65,
-	0x48, 0xd4, 0x49, 0x8a, 0x24, 0x13, 0x91, 0xe7, 0x2c, 0x3b, 0xab, 0xad, 0x11, 0xc4, 0x32, 0x3c,
-	0x38, 0x49, 0xf1, 0xad, 0x88, 0xdc, 0x45, 0x00, 0x5d, 0x83, 0x30, 0x1e, 0x60, 0xee, 0x4d, 0x9b,
-	0x78, 0x4b, 0x23, 0x43, 0x0d, 0xb8, 0x73, 0x50, 0x97, 0xc8, 0x03, 0x14, 0xde, 0x8c, 0x09, 0x15,
-	0x7f, 0xee, 0x3c, 0x34, 0x55, 0x4e, 0x12, 0x11, 0x32, 0xee, 0xd5, 0x4c, 0xa4, 0xa1, 0xf2, 0x5d,
-	0xfd, 0xeb, 0x3e, 0x00, 0x60, 0x9c, 0xa8, 0x9c, 0x4c, 0xa8, 0x9c, 0x78, 0x75, 0x13, 0x6c, 0x32,
-	0x7e, 0x90, 0x6f, 0x53, 0x39, 0x71, 0x9f, 0xc2, 0x2c, 0xe3, 0x64, 0x1c, 0x25, 0xfe, 0x21, 0x99,
-	0x20, 0x0b, 0x27, 0xca, 0x6b, 0x98, 0x94, 0x2e, 0xe3, 0x1b, 0x1a, 0xdd, 0x36, 0xa0, 0xbb, 0x00,
-	0x4d, 0x81, 0x3e, 0xb2, 0x23, 0x14, 0x5e, 0xd3, 0xd6, 0x28, 0xff, 0xdd, 0x27, 0xd0, 0x2b, 0xbf,
-	0x89, 0x3f, 0xa1, 0x8c, 0x7b, 0x2d, 0x5b, 0xa2, 0x44, 0x5f, 0x69, 0x50, 0xb7, 0xc6, 0xf1, 0x98,
-	0x48, 0x45, 0x55, 0x26, 0xbd, 0x8e, 0x6d, 0x8d, 0xe3, 0xf1, 0xbe, 0x01, 0x74, 0x15, 0x1b, 0x22,
-	0x31, 0x4a, 0x49, 0x43, 0xf4, 0xba, 0xb6, 0x8a, 0x45, 0x77, 0x2c, 0xe8, 0x3e, 0x84, 0x8e, 0xed,
-	0xb9, 0xb8, 0xaa, 0x67, 0x92, 0xda, 0x16, 0x33, 0x17, 0xad, 0x7c, 0x71, 0xe0, 0x9e, 0x99, 0xff,
-	0x6e, 0xa6, 0xac, 0x00, 0x94, 0x45, 0x99, 0xc0, 0xbf, 0x1f, 0xff, 0x22, 0x40, 0x12, 0x05, 0x65,
-	0x0b, 0x56, 0x82, 0x56, 0x12, 0x05, 0x45, 0x0b, 0xd7, 0x3b, 0xac, 0x55, 0x3a, 0xbc, 0xc9, 0x6b,
-	0x3f, 0xf3, 0x7d, 0x94, 0xf2, 0x7f, 0xf3, 0xfa, 0x31, 0x03, 0x5d, 0xc3, 0x6b, 0x0f, 0xa3, 0x68,
-	0x1f, 0xb9, 0xfa, 0x77, 0x3e, 0xad, 0xaa, 0x57, 0xbb, 0xa1, 0x5e, 0xc5, 0xaf, 0xb7, 0x2a, 0x7e,
-	0xbd, 0xea, 0xc3, 0xfa, 0x1f, 0x7d, 0xd8, 0xf8, 0x9d, 0x0f, 0x1f, 0x43, 0x2f, 0xc5, 0x28, 0x22,
-	0x66, 0x61, 0x98, 0xde, 0x18, 0x6b, 0xe8, 0x8e, 0x46, 0x0f, 0xf2, 0x5d, 0x83, 0xb9, 0x4b, 0xd0,
-	0x36, 0x59, 0x45, 0x1b, 0xd6, 0xd1, 0x90, 0xda, 0x09, 0xe9, 0x56, 0x1e, 0x41, 0xd7, 0x24, 0x5c,
-	0xd0, 0x81, 0xcb, 0x2a, 0xa3, 0x92, 0xd2, 0x3a, 0xcc, 0x5d, 0x4b, 0xb2, 0xbc, 0x08, 0x0b, 0xbc,
-	0xf6, 0xb2, 0xb3, 0x3a, 0x33, 0xba, 0x7b, 0x35, 0xdb, 0xd0, 0x1b, 0x06, 0x7a, 0x48, 0xe6, 0x50,
-	0xb9, 0x07, 0x76, 0x55, 0x0c, 0x9d, 0x72, 0x0b, 0x4a, 0x76, 0x29, 0x15, 0x34, 0x96, 0xc5, 0xa6,
-	0x18, 0x76, 0x7b, 0x06, 0xa9, 0x48, 0xde, 0xab, 0x4a, 0xfe, 0xd9, 0x81, 0x05, 0x23, 0xf9, 0xbb,
-	0x58, 0x86, 0xaf, 0xa9, 0xdc, 0x13, 0xcc, 0xc7, 0x21, 0xf7, 0x05, 0x52, 0x89, 0x41, 0x45, 0x5d,
-	0xa7, 0xaa, 0xee, 0x0b, 0x70, 0x43, 0x2a, 0x49, 0xaa, 0x0f, 0x11, 0x56, 0x9c, 0x2a, 0x4c, 0x70,
-	0x3b, 0xac, 0x54, 0x73, 0x9f, 0xc1, 0x2c, 0x0d, 0x02, 0xa6, 0x58, 0xc2, 0x69, 0x44, 0x3e, 0x20,
-	0x96, 0x0e, 0xed, 0x5d, 0xc2, 0x5b, 0x88, 0x72, 0x65, 0x07, 0x66, 0x0d, 0x27, 0x3b, 0x07, 0x73,
-	0xd3, 0x3c, 0x34, 0x2f, 0x26, 0xa6, 0x69, 0xd4, 0x46, 0x0d, 0xbf, 0x98, 0xd2, 0x12, 0xb4, 0xfd,
-	0x4c, 0x88, 0xf2, 0xd5, 0x9a, 0x36, 0x51, 0xd0, 0x90, 0x7d, 0xb2, 0x36, 0x36, 0xbf, 0x9e, 0xf5,
-	0x9d, 0xd3, 0xb3, 0xbe, 0xf3, 0xfd, 0xac, 0xef, 0x7c, 0x3a, 0xef, 0x4f, 0x9d, 0x9e, 0xf7, 0xa7,
-	0xbe, 0x9d, 0xf7, 0xa7, 0xde, 0x3f, 0x0f, 0x99, 0x9a, 0x64, 0xe3, 0x35, 0x3f, 0x89, 0x07, 0x2f,
-	0x73, 0xed, 0xfa, 0x37, 0xa8, 0x8e, 0x13, 0x71, 0x38, 0xa0, 0x18, 0x32, 0x39, 0xc8, 0x07, 0xe6,
-	0x69, 0xd7, 0xf6, 0x97, 0xe3, 0xba, 0x79, 0xda, 0xd7, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0x44,
-	0x64, 0x4f, 0xb3, 0xef, 0x05, 0x00, 0x00,
+	// 1000 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x36, 0x25, 0x59, 0x96, 0x46, 0x7f, 0x16, 0x9d, 0x18, 0x4c, 0x5a, 0x2b, 0xae, 0xd2, 0xa6,
+	0x46, 0x52, 0x58, 0x41, 0xf3, 0x04, 0x76, 0xe0, 0x24, 0x3a, 0xa4, 0x36, 0x64, 0x07, 0x28, 0x7a,
+	0x21, 0x56, 0xe4, 0x84, 0x5a, 0x98, 0x5c, 0x0a, 0xbb, 0x4b, 0x8b, 0xe9, 0xb1, 0xc7, 0x9e, 0x8a,
+	0x02, 0xbd, 0xf4, 0x89, 0x7a, 0xcc, 0xa1, 0x87, 0x1e, 0x0b, 0xfb, 0x05, 0xfa, 0x02, 0x05, 0x8a,
+	0xdd, 0x25, 0xf5, 0x43, 0x1b, 0x35, 0xd0, 0x1f, 0xe4, 0x26, 0x7e, 0x33, 0x9a, 0xfd, 0x66, 0xe6,
+	0xfb, 0x96, 0x84, 0x6e, 0x1a, 0x89, 0x60, 0x80, 0x17, 0xc8, 0xa4, 0xd8, 0x9f, 0xf2, 0x58, 0xc6,
+	0x76, 0x45, 0x41, 0xfd, 0x3f, 0x4b, 0x70, 0xf7, 0x48, 0xc1, 0x43, 0x36, 0x8e, 0x13, 0xe6, 0xbf,
+	0xa0, 0x8c, 0x84, 0xf4, 0x5b, 0xf4, 0xed, 0x5d, 0x68, 0x46, 0x22, 0x70, 0xe5, 0xbb, 0x29, 0xba,
+	0x09, 0x0f, 0x1d, 0x6b, 0xd7, 0xda, 0xab, 0x8f, 0x20, 0x12, 0xc1, 0xd9, 0xbb, 0x29, 0xbe, 0xe1,
+	0xa1, 0xbd, 0x03, 0xa0, 0x6a, 0xb8, 0x94, 0xf9, 0x98, 0x3a, 0x25, 0x1d, 0xaf, 0x2b, 0x64, 0xa8,
+	0x00, 0x7b, 0x1b, 0xaa, 0x02, 0x99, 0x8f, 0xdc, 0x29, 0xeb, 0x50, 0xf6, 0x64, 0xdf, 0x83, 0x9a,
+	0x4c, 0xdd, 0x98, 0x07, 0x94, 0x39, 0x15, 0x1d, 0xd9, 0x90, 0xe9, 0xb1, 0x7a, 0xb4, 0x3f, 0x06,
+	0xa0, 0xcc, 0x95, 0xa9, 0x3b, 0x21, 0x62, 0xe2, 0x54, 0x75, 0xb0, 0x46, 0xd9, 0x59, 0xfa, 0x8a,
+	0x88, 0x89, 0xfd, 0x08, 0x3a, 0x94, 0xb9, 0xe3, 0x30, 0xf6, 0xce, 0xdd, 0x09, 0xd2, 0x60, 0x22,
+	0x9d, 0x0d, 0x9d, 0xd2, 0xa2, 0xec, 0x50, 0xa1, 0xaf, 0x34, 0x68, 0xdf, 0x87, 0x1a, 0x47, 0x0f,
+	0xe9, 0x05, 0x72, 0xa7, 0x66, 0x6a, 0xe4, 0xcf, 0xf6, 0x67, 0xd0, 0xce, 0x7f, 0xbb, 0xde, 0x84,
+	0x50, 0xe6, 0xd4, 0x4d, 0x89, 0x1c, 0x7d, 0xae, 0x40, 0xd5, 0x1a, 0xc3, 0x99, 0x2b, 0x24, 0x91,
+	0x89, 0x70, 0x9a, 0xa6, 0x35, 0x86, 0xb3, 0x53, 0x0d, 0xa8, 0x2a, 0x26, 0xe4, 0x46, 0x28, 0x04,
+	0x09, 0xd0, 0x69, 0x99, 0x2a, 0x06, 0x7d, 0x6d, 0x40, 0xfb, 0x13, 0x68, 0x9a, 0x9e, 0xb3, 0xa3,
+	0xda, 0x3a, 0xa9, 0x61, 0x30, 0x7d, 0x50, 0xff, 0x27, 0x0b, 0xee, 0xe8, 0xf9, 0x1f, 0x27, 0xd2,
+	0x2c, 0x80, 0xd0, 0x30, 0xe1, 0xf8, 0xef, 0xc7, 0xbf, 0x03, 0x10, 0x87, 0x7e, 0xde, 0x82, 0x59,
+	0x41, 0x3d, 0x0e, 0xfd, 0xac, 0x85, 0xd5, 0x0e, 0x2b, 0x85, 0x0e, 0xaf, 0xf3, 0x3a, 0x4d, 0x3c,
+	0x0f, 0x85, 0xf8, 0xd0, 0xbc, 0xfe, 0x28, 0x43, 0x4b, 0xf3, 0x3a, 0xc1, 0x30, 0x3c, 0x45, 0x26,
+	0xff, 0x3f, 0x9d, 0x16, 0xb7, 0x57, 0xb9, 0xb6, 0xbd, 0x82, 0x5e, 0xd7, 0x0b, 0x7a, 0x5d, 0xd6,
+	0x61, 0xf5, 0x56, 0x1d, 0x6e, 0xdc, 0xa4, 0xc3, 0x4f, 0xa1, 0x3d, 0xc5, 0x30, 0x74, 0xb5, 0x61,
+	0xa8, 0x72, 0x8c, 0x11, 0x74, 0x53, 0xa1, 0x67, 0xe9, 0xb1, 0xc6, 0xec, 0x07, 0xd0, 0xd0, 0x59,
+	0x59, 0x1b, 0x46, 0xd1, 0x30, 0x35, 0x13, 0x52, 0xad, 0x3c, 0x84, 0x96, 0x4e, 0x98, 0xd3, 0x81,
+	0x45, 0x95, 0x51, 0x4e, 0xe9, 0x19, 0x6c, 0xaf, 0x24, 0x19, 0x5e, 0x2e, 0xf5, 0x9d, 0xc6, 0xae,
+	0xb5, 0x57, 0x1e, 0x6d, 0x2d, 0x67, 0x6b, 0x7a, 0x43, 0x5f, 0x0d, 0x49, 0xff, 0x29, 0xf7, 0x81,
+	0xb1, 0x8a, 0xa6, 0x93, 0xbb, 0x20, 0x67, 0x37, 0x25, 0x9c, 0x44, 0xc2, 0x69, 0x2d, 0xd8, 0x9d,
+	0x68, 0xa4, 0xb0, 0xf2, 0x76, 0x71, 0xe5, 0x3f, 0x5b, 0xe0, 0xe8, 0x95, 0x1f, 0x8c, 0x63, 0x2e,
+	0xd1, 0xff, 0x3a, 0x12, 0xc1, 0x08, 0xdf, 0x26, 0xcc, 0xff, 0x2f, 0x6e, 0x29, 0xbd, 0x08, 0x55,
+	0xcc, 0x25, 0xbe, 0xcf, 0x51, 0xe4, 0x92, 0x6c, 0x19, 0xf4, 0xc0, 0x80, 0x4a, 0x24, 0x24, 0x8a,
+	0x13, 0x26, 0x33, 0x19, 0x64, 0x4f, 0xfd, 0x1f, 0x2d, 0xb8, 0xaf, 0xc9, 0x29, 0x56, 0x2f, 0x89,
+	0x38, 0xe1, 0xd4, 0xc3, 0x21, 0xf3, 0x38, 0x12, 0x81, 0x7e, 0xe1, 0x70, 0xab, 0x78, 0xf8, 0x17,
+	0x60, 0x07, 0x44, 0xb8, 0x53, 0xf5, 0x27, 0x97, 0x66, 0xff, 0xca, 0x38, 0x6e, 0x06, 0x85, 0x6a,
+	0xf6, 0xe7, 0xd0, 0x21, 0xbe, 0x4f, 0x25, 0x8d, 0x19, 0x09, 0xdd, 0xb7, 0x88, 0x39, 0xd7, 0xf6,
+	0x02, 0x7e, 0x81, 0x28, 0xfa, 0xaf, 0xa1, 0xa3, 0x39, 0x99, 0x25, 0xe9, 0x93, 0xee, 0x41, 0x6d,
+	0xbe, 0x4e, 0x45, 0xa3, 0x32, 0xda, 0xf0, 0xb2, 0x15, 0x3e, 0x80, 0x86, 0x97, 0x70, 0x9e, 0x5f,
+	0xa9, 0x25, 0x1d, 0x05, 0x05, 0x99, 0xfb, 0xb4, 0xff, 0x6b, 0x19, 0xb6, 0xe7, 0x3d, 0x9a, 0xa5,
+	0x3c, 0x9f, 0x10, 0x16, 0xdc, 0xde, 0xdf, 0xaa, 0xd7, 0x4b, 0x7f, 0xef, 0xf5, 0xf2, 0xed, 0xb7,
+	0x6c, 0xe5, 0xa6, 0x5b, 0xf6, 0x11, 0x74, 0x96, 0x7d, 0xaa, 0x3a, 0x5c, 0xd7, 0x82, 0x6d, 0x2d,
+	0x59, 0x75, 0xe8, 0xdb, 0x8f, 0xa1, 0x7b, 0x5d, 0xda, 0x55, 0x9d, 0xd9, 0xe1, 0x05, 0x59, 0xeb,
+	0x57, 0x8d, 0xbe, 0xf7, 0xe6, 0xee, 0x9e, 0xbf, 0x6a, 0x34, 0x9c, 0x59, 0xfc, 0x29, 0xdc, 0xc9,
+	0xf3, 0xc6, 0x24, 0x0c, 0x63, 0x99, 0x4d, 0xc2, 0xb8, 0xd4, 0xce, 0x62, 0x87, 0x3a, 0x64, 0x46,
+	0xb2, 0x07, 0x9b, 0x71, 0x22, 0x57, 0x4b, 0x1b, 0xc3, 0xb6, 0x73, 0x3c, 0xab, 0xfd, 0x18, 0xba,
+	0xf3, 0x4c, 0xa5, 0x92, 0x44, 0xa0, 0xaf, 0x8d, 0x5b, 0x19, 0x75, 0xf2, 0xc0, 0x4b, 0x22, 0xde,
+	0x28, 0x9d, 0x7d, 0x09, 0x77, 0xe7, 0xb9, 0x2b, 0x44, 0x1a, 0xba, 0xf4, 0x56, 0x1e, 0x5c, 0x62,
+	0xd2, 0xff, 0x3e, 0x7f, 0xf5, 0x8f, 0xd0, 0x9b, 0x10, 0x1e, 0xe0, 0x08, 0xbd, 0x98, 0x2b, 0x53,
+	0x15, 0xc5, 0x52, 0x5e, 0x88, 0x65, 0x07, 0x40, 0xc6, 0xe7, 0xc8, 0xb4, 0xe3, 0xf2, 0x8d, 0x6a,
+	0x44, 0xf9, 0x4d, 0x85, 0x2f, 0x62, 0x89, 0xd9, 0xe1, 0x65, 0x4d, 0xb6, 0xae, 0x90, 0xf9, 0x55,
+	0x7b, 0x93, 0x8b, 0xec, 0x27, 0xd0, 0xf5, 0x92, 0x28, 0x09, 0x89, 0xa4, 0x17, 0xe8, 0x66, 0x29,
+	0xe6, 0x3a, 0xdd, 0x5c, 0x04, 0x0e, 0x4c, 0xf2, 0x43, 0x68, 0xcd, 0x28, 0xf3, 0xe3, 0x59, 0x9e,
+	0x68, 0xee, 0xd6, 0xa6, 0x01, 0xb3, 0xa4, 0x7d, 0xd8, 0xca, 0x92, 0x84, 0x24, 0x5c, 0x2e, 0x7f,
+	0x2f, 0x94, 0x47, 0x5d, 0x13, 0x3a, 0x55, 0x91, 0x4c, 0xe3, 0xdf, 0x95, 0xe0, 0xa3, 0x95, 0x61,
+	0x1c, 0x26, 0x7e, 0x80, 0xf2, 0x28, 0xf5, 0x10, 0x3f, 0xc8, 0x48, 0xae, 0x75, 0xb9, 0x7e, 0x43,
+	0x97, 0x3b, 0x00, 0x11, 0x49, 0x57, 0xe7, 0x50, 0x8f, 0x48, 0xfa, 0xcf, 0x86, 0x70, 0x78, 0xf4,
+	0xcb, 0x65, 0xcf, 0x7a, 0x7f, 0xd9, 0xb3, 0x7e, 0xbf, 0xec, 0x59, 0x3f, 0x5c, 0xf5, 0xd6, 0xde,
+	0x5f, 0xf5, 0xd6, 0x7e, 0xbb, 0xea, 0xad, 0x7d, 0xf3, 0x24, 0xa0, 0x72, 0x92, 0x8c, 0xf7, 0xbd,
+	0x38, 0x1a, 0x3c, 0x4d, 0xd5, 0xbb, 0xf7, 0x2b, 0x94, 0xb3, 0x98, 0x9f, 0x0f, 0x08, 0x06, 0x54,
+	0x0c, 0xd2, 0x81, 0xfe, 0xc0, 0x54, 0x13, 0x10, 0xe3, 0xaa, 0xfe, 0xc0, 0x7c, 0xf6, 0xd7, 0x00,
+	0x7f, 0xb4, 0xf3, 0xa4, 0x75, 0x0a, 0x00, 0x00,
 }
 
 func (m *EventInboundFinalized) Marshal() (dAtA []byte, err error) {
@@ -926,7 +1333,7 @@ func (m *EventPellSent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventXmsgGasPriceIncreased) Marshal() (dAtA []byte, err error) {
+func (m *EventAbortedXmsgRefunded) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -936,27 +1343,78 @@ func (m *EventXmsgGasPriceIncreased) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventXmsgGasPriceIncreased) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventAbortedXmsgRefunded) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventXmsgGasPriceIncreased) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventAbortedXmsgRefunded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.AdditionalFees) > 0 {
-		i -= len(m.AdditionalFees)
-		copy(dAtA[i:], m.AdditionalFees)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.AdditionalFees)))
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x22
 	}
-	if len(m.GasPriceIncrease) > 0 {
-		i -= len(m.GasPriceIncrease)
-		copy(dAtA[i:], m.GasPriceIncrease)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.GasPriceIncrease)))
+	if len(m.RefundAddress) > 0 {
+		i -= len(m.RefundAddress)
+		copy(dAtA[i:], m.RefundAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RefundAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.XmsgIndex) > 0 {
+		i -= len(m.XmsgIndex)
+		copy(dAtA[i:], m.XmsgIndex)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.XmsgIndex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventXmsgGasPriceIncreased) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventXmsgGasPriceIncreased) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventXmsgGasPriceIncreased) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AdditionalFees) > 0 {
+		i -= len(m.AdditionalFees)
+		copy(dAtA[i:], m.AdditionalFees)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.AdditionalFees)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.GasPriceIncrease) > 0 {
+		i -= len(m.GasPriceIncrease)
+		copy(dAtA[i:], m.GasPriceIncrease)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.GasPriceIncrease)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1003,6 +1461,232 @@ func (m *EventChainIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventXmsgStatusChanged) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventXmsgStatusChanged) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventXmsgStatusChanged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OutboundBallotIndex) > 0 {
+		i -= len(m.OutboundBallotIndex)
+		copy(dAtA[i:], m.OutboundBallotIndex)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OutboundBallotIndex)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.OutboundGasUsed != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OutboundGasUsed))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.OutboundTxHash) > 0 {
+		i -= len(m.OutboundTxHash)
+		copy(dAtA[i:], m.OutboundTxHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OutboundTxHash)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.InboundBallotIndex) > 0 {
+		i -= len(m.InboundBallotIndex)
+		copy(dAtA[i:], m.InboundBallotIndex)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.InboundBallotIndex)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.InboundTxHash) > 0 {
+		i -= len(m.InboundTxHash)
+		copy(dAtA[i:], m.InboundTxHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.InboundTxHash)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ReceiverChainId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ReceiverChainId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SenderChainId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SenderChainId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.StatusMessage) > 0 {
+		i -= len(m.StatusMessage)
+		copy(dAtA[i:], m.StatusMessage)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.StatusMessage)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NewStatus) > 0 {
+		i -= len(m.NewStatus)
+		copy(dAtA[i:], m.NewStatus)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewStatus)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OldStatus) > 0 {
+		i -= len(m.OldStatus)
+		copy(dAtA[i:], m.OldStatus)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OldStatus)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.XmsgIndex) > 0 {
+		i -= len(m.XmsgIndex)
+		copy(dAtA[i:], m.XmsgIndex)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.XmsgIndex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRechargeRecorded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRechargeRecorded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRechargeRecorded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WindowStartHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.WindowStartHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.WindowAmount) > 0 {
+		i -= len(m.WindowAmount)
+		copy(dAtA[i:], m.WindowAmount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.WindowAmount)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.CumulativeAmount) > 0 {
+		i -= len(m.CumulativeAmount)
+		copy(dAtA[i:], m.CumulativeAmount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CumulativeAmount)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.VoteIndex != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.VoteIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TokenType) > 0 {
+		i -= len(m.TokenType)
+		copy(dAtA[i:], m.TokenType)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.TokenType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRechargeBudgetExceeded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRechargeBudgetExceeded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRechargeBudgetExceeded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WindowStartHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.WindowStartHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.MaxAmount) > 0 {
+		i -= len(m.MaxAmount)
+		copy(dAtA[i:], m.MaxAmount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MaxAmount)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.WindowAmount) > 0 {
+		i -= len(m.WindowAmount)
+		copy(dAtA[i:], m.WindowAmount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.WindowAmount)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.VoteIndex != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.VoteIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TokenType) > 0 {
+		i -= len(m.TokenType)
+		copy(dAtA[i:], m.TokenType)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.TokenType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -1181,6 +1865,31 @@ func (m *EventPellSent) Size() (n int) {
 	return n
 }
 
+func (m *EventAbortedXmsgRefunded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.XmsgIndex)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.RefundAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func (m *EventXmsgGasPriceIncreased) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1217,44 +1926,984 @@ func (m *EventChainIndex) Size() (n int) {
 	return n
 }
 
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *EventInboundFinalized) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+func (m *EventXmsgStatusChanged) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.XmsgIndex)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.OldStatus)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewStatus)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.StatusMessage)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.SenderChainId != 0 {
+		n += 1 + sovEvents(uint64(m.SenderChainId))
+	}
+	if m.ReceiverChainId != 0 {
+		n += 1 + sovEvents(uint64(m.ReceiverChainId))
+	}
+	l = len(m.InboundTxHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.InboundBallotIndex)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.OutboundTxHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.OutboundGasUsed != 0 {
+		n += 1 + sovEvents(uint64(m.OutboundGasUsed))
+	}
+	l = len(m.OutboundBallotIndex)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRechargeRecorded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovEvents(uint64(m.ChainId))
+	}
+	l = len(m.TokenType)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.VoteIndex != 0 {
+		n += 1 + sovEvents(uint64(m.VoteIndex))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.CumulativeAmount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.WindowAmount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.WindowStartHeight != 0 {
+		n += 1 + sovEvents(uint64(m.WindowStartHeight))
+	}
+	return n
+}
+
+func (m *EventRechargeBudgetExceeded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovEvents(uint64(m.ChainId))
+	}
+	l = len(m.TokenType)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.VoteIndex != 0 {
+		n += 1 + sovEvents(uint64(m.VoteIndex))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.WindowAmount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.MaxAmount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.WindowStartHeight != 0 {
+		n += 1 + sovEvents(uint64(m.WindowStartHeight))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventInboundFinalized) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventInboundFinalized: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventInboundFinalized: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XmsgIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XmsgIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxOrgin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxOrgin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InBlockHeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InBlockHeight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverChain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiverChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StatusMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderChain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOutboundFailure) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOutboundFailure: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOutboundFailure: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XmsgIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XmsgIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOutboundSuccess) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOutboundSuccess: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOutboundSuccess: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XmsgIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XmsgIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPellSent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPellSent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPellSent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XmsgIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventInboundFinalized: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventInboundFinalized: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.XmsgIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1282,11 +2931,11 @@ func (m *EventInboundFinalized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field XmsgIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderChain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1314,11 +2963,11 @@ func (m *EventInboundFinalized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XmsgIndex = string(dAtA[iNdEx:postIndex])
+			m.SenderChain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InTxHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1346,11 +2995,11 @@ func (m *EventInboundFinalized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.InTxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxOrgin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1378,11 +3027,11 @@ func (m *EventInboundFinalized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxOrgin = string(dAtA[iNdEx:postIndex])
+			m.Receiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InTxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverChain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1410,11 +3059,11 @@ func (m *EventInboundFinalized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InTxHash = string(dAtA[iNdEx:postIndex])
+			m.ReceiverChain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InBlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PellTxOrigin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1442,11 +3091,11 @@ func (m *EventInboundFinalized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InBlockHeight = string(dAtA[iNdEx:postIndex])
+			m.PellTxOrigin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PellSender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1474,11 +3123,11 @@ func (m *EventInboundFinalized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			m.PellSender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverChain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PellReceiver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1506,11 +3155,30 @@ func (m *EventInboundFinalized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReceiverChain = string(dAtA[iNdEx:postIndex])
+			m.PellReceiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PellReceiverChainId", wireType)
+			}
+			m.PellReceiverChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PellReceiverChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PellMessage", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1538,11 +3206,11 @@ func (m *EventInboundFinalized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewStatus = string(dAtA[iNdEx:postIndex])
+			m.PellMessage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StatusMessage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PellParams", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1570,11 +3238,11 @@ func (m *EventInboundFinalized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StatusMessage = string(dAtA[iNdEx:postIndex])
+			m.PellParams = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SenderChain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewStatus", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1602,7 +3270,7 @@ func (m *EventInboundFinalized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SenderChain = string(dAtA[iNdEx:postIndex])
+			m.NewStatus = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1625,7 +3293,7 @@ func (m *EventInboundFinalized) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventOutboundFailure) Unmarshal(dAtA []byte) error {
+func (m *EventAbortedXmsgRefunded) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1648,10 +3316,10 @@ func (m *EventOutboundFailure) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventOutboundFailure: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAbortedXmsgRefunded: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventOutboundFailure: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAbortedXmsgRefunded: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1720,7 +3388,7 @@ func (m *EventOutboundFailure) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OldStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1748,11 +3416,11 @@ func (m *EventOutboundFailure) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OldStatus = string(dAtA[iNdEx:postIndex])
+			m.RefundAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1780,7 +3448,7 @@ func (m *EventOutboundFailure) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewStatus = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1803,7 +3471,7 @@ func (m *EventOutboundFailure) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventOutboundSuccess) Unmarshal(dAtA []byte) error {
+func (m *EventXmsgGasPriceIncreased) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1826,15 +3494,15 @@ func (m *EventOutboundSuccess) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventOutboundSuccess: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventXmsgGasPriceIncreased: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventOutboundSuccess: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventXmsgGasPriceIncreased: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field XmsgIndex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1862,11 +3530,11 @@ func (m *EventOutboundSuccess) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			m.XmsgIndex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field XmsgIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceIncrease", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1894,11 +3562,11 @@ func (m *EventOutboundSuccess) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XmsgIndex = string(dAtA[iNdEx:postIndex])
+			m.GasPriceIncrease = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OldStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalFees", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1926,13 +3594,63 @@ func (m *EventOutboundSuccess) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OldStatus = string(dAtA[iNdEx:postIndex])
+			m.AdditionalFees = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewStatus", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventChainIndex) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventChainIndex: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventChainIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1942,24 +3660,30 @@ func (m *EventOutboundSuccess) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ChainId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrHeight", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.CurrHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.NewStatus = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1981,7 +3705,7 @@ func (m *EventOutboundSuccess) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventPellSent) Unmarshal(dAtA []byte) error {
+func (m *EventXmsgStatusChanged) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2004,15 +3728,15 @@ func (m *EventPellSent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventPellSent: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventXmsgStatusChanged: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventPellSent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventXmsgStatusChanged: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field XmsgIndex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2040,11 +3764,11 @@ func (m *EventPellSent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			m.XmsgIndex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field XmsgIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldStatus", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2072,11 +3796,11 @@ func (m *EventPellSent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XmsgIndex = string(dAtA[iNdEx:postIndex])
+			m.OldStatus = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewStatus", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2104,11 +3828,11 @@ func (m *EventPellSent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.NewStatus = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SenderChain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusMessage", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2136,13 +3860,13 @@ func (m *EventPellSent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SenderChain = string(dAtA[iNdEx:postIndex])
+			m.StatusMessage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InTxHash", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderChainId", wireType)
 			}
-			var stringLen uint64
+			m.SenderChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2152,27 +3876,33 @@ func (m *EventPellSent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SenderChainId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverChainId", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.ReceiverChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceiverChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.InTxHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundTxHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2200,11 +3930,11 @@ func (m *EventPellSent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			m.InboundTxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverChain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundBallotIndex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2232,11 +3962,11 @@ func (m *EventPellSent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReceiverChain = string(dAtA[iNdEx:postIndex])
+			m.InboundBallotIndex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PellTxOrigin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundTxHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2264,11 +3994,30 @@ func (m *EventPellSent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PellTxOrigin = string(dAtA[iNdEx:postIndex])
+			m.OutboundTxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundGasUsed", wireType)
+			}
+			m.OutboundGasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutboundGasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PellSender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundBallotIndex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2296,11 +4045,80 @@ func (m *EventPellSent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PellSender = string(dAtA[iNdEx:postIndex])
+			m.OutboundBallotIndex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRechargeRecorded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRechargeRecorded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRechargeRecorded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PellReceiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2328,13 +4146,13 @@ func (m *EventPellSent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PellReceiver = string(dAtA[iNdEx:postIndex])
+			m.TokenType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PellReceiverChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteIndex", wireType)
 			}
-			m.PellReceiverChainId = 0
+			m.VoteIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2344,14 +4162,14 @@ func (m *EventPellSent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PellReceiverChainId |= int64(b&0x7F) << shift
+				m.VoteIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PellMessage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2379,11 +4197,11 @@ func (m *EventPellSent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PellMessage = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PellParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2411,11 +4229,11 @@ func (m *EventPellSent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PellParams = string(dAtA[iNdEx:postIndex])
+			m.CumulativeAmount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2443,8 +4261,27 @@ func (m *EventPellSent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewStatus = string(dAtA[iNdEx:postIndex])
+			m.WindowAmount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStartHeight", wireType)
+			}
+			m.WindowStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowStartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -2466,7 +4303,7 @@ func (m *EventPellSent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventXmsgGasPriceIncreased) Unmarshal(dAtA []byte) error {
+func (m *EventRechargeBudgetExceeded) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2489,15 +4326,34 @@ func (m *EventXmsgGasPriceIncreased) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventXmsgGasPriceIncreased: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRechargeBudgetExceeded: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventXmsgGasPriceIncreased: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRechargeBudgetExceeded: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field XmsgIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2525,11 +4381,30 @@ func (m *EventXmsgGasPriceIncreased) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XmsgIndex = string(dAtA[iNdEx:postIndex])
+			m.TokenType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteIndex", wireType)
+			}
+			m.VoteIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VoteIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceIncrease", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2557,11 +4432,11 @@ func (m *EventXmsgGasPriceIncreased) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GasPriceIncrease = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2589,63 +4464,13 @@ func (m *EventXmsgGasPriceIncreased) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AdditionalFees = string(dAtA[iNdEx:postIndex])
+			m.WindowAmount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventChainIndex) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventChainIndex: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventChainIndex: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmount", wireType)
 			}
-			m.ChainId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2655,16 +4480,29 @@ func (m *EventChainIndex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStartHeight", wireType)
 			}
-			m.CurrHeight = 0
+			m.WindowStartHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2674,7 +4512,7 @@ func (m *EventChainIndex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrHeight |= uint64(b&0x7F) << shift
+				m.WindowStartHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
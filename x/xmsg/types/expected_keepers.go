@@ -31,6 +31,7 @@ type BankKeeper interface {
 	BurnCoins(ctx context.Context, name string, amt sdk.Coins) error
 	MintCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 }
 
 type RelayerKeeper interface {
@@ -43,6 +44,9 @@ type RelayerKeeper interface {
 	SetNodeAccount(ctx sdk.Context, nodeAccount relayertypes.NodeAccount)
 	IsInboundEnabled(ctx sdk.Context) (found bool)
 	GetCrosschainFlags(ctx sdk.Context) (val relayertypes.CrosschainFlags, found bool)
+	IsChainInboundPaused(ctx sdk.Context, chainID int64) bool
+	IsChainOutboundPaused(ctx sdk.Context, chainID int64) bool
+	IsChainSigningPaused(ctx sdk.Context, chainID int64) bool
 	GetKeygen(ctx sdk.Context) (val relayertypes.Keygen, found bool)
 	SetKeygen(ctx sdk.Context, keygen relayertypes.Keygen)
 	SetCrosschainFlags(ctx sdk.Context, crosschainFlags relayertypes.CrosschainFlags)
@@ -63,6 +67,16 @@ type RelayerKeeper interface {
 	GetFundMigrator(ctx sdk.Context, chainID int64) (val relayertypes.TssFundMigratorInfo, found bool)
 	GetAllTssFundMigrators(ctx sdk.Context) (fms []relayertypes.TssFundMigratorInfo)
 	RemoveAllExistingMigrators(ctx sdk.Context)
+	GetTssRotation(ctx sdk.Context) (val relayertypes.TssRotation, found bool)
+	SetTssRotation(ctx sdk.Context, rotation relayertypes.TssRotation)
+	SetTssRotationStage(
+		ctx sdk.Context,
+		rotation relayertypes.TssRotation,
+		stage relayertypes.TssRotationStage,
+		message string,
+	) relayertypes.TssRotation
+	IsTssRotationInProgress(ctx sdk.Context) bool
+	SetInboundEnabled(ctx sdk.Context, enabled bool)
 	SetChainNonces(ctx sdk.Context, chainNonces relayertypes.ChainNonces)
 	GetChainNonces(ctx sdk.Context, index string) (val relayertypes.ChainNonces, found bool)
 	GetAllChainNonces(ctx sdk.Context) (list []relayertypes.ChainNonces)
@@ -109,6 +123,7 @@ type RelayerKeeper interface {
 		voter string,
 		voteIndex uint64,
 	) (bool, bool, error)
+	GetChainByChainID(ctx sdk.Context, chainID int64) (chains.Chain, bool)
 	GetSupportedChainFromChainID(ctx sdk.Context, chainID int64) *chains.Chain
 	GetSupportedChains(ctx sdk.Context) []*chains.Chain
 	GetSupportedForeignChains(ctx sdk.Context) []*chains.Chain
@@ -123,6 +138,8 @@ type PevmKeeper interface {
 		to ethcommon.Address,
 		inboundSenderChainID int64,
 		destinationChainID int64,
+		remainingPellValue *big.Int,
+		message []byte,
 		indexBytes [32]byte) (*evmtypes.MsgEthereumTxResponse, error)
 	CallSyncDepositStateOnPellStrategyManager(
 		ctx context.Context,
@@ -179,5 +196,7 @@ type AuthorityKeeper interface {
 }
 
 type LightclientKeeper interface {
+	GetBlockHeader(ctx sdk.Context, hash []byte) (val proofs.BlockHeader, found bool)
 	VerifyProof(ctx sdk.Context, proof *proofs.Proof, chainID int64, blockHash string, txIndex int64) ([]byte, error)
+	VerifyReceiptProof(ctx sdk.Context, proof *proofs.Proof, chainID int64, blockHash string, txIndex int64) ([]byte, error)
 }
@@ -0,0 +1,200 @@
+package types
+
+import (
+	"sort"
+)
+
+const (
+	// GasPriceStaleBlocks is the number of pell blocks after which a gas price vote is evicted,
+	// counted from the pell block the vote was recorded at
+	GasPriceStaleBlocks = 1000
+
+	// GasPriceOutlierMinVotes is the minimum number of votes required to reject outliers,
+	// with fewer votes the median is not meaningful enough to judge the other votes
+	GasPriceOutlierMinVotes = 3
+
+	// GasPriceOutlierMaxDeviationPercent is the maximum deviation from the median, in percent,
+	// of a vote taken into account for the median
+	GasPriceOutlierMaxDeviationPercent = 50
+)
+
+// SetVote records the gas price vote of a signer at the pell height, replacing its previous vote.
+// Stale votes are evicted and the median indexes are recomputed.
+func (m *GasPrice) SetVote(signer string, pellHeight, blockNum, price, priorityFee, baseFee uint64) {
+	m.normalizeVotes()
+
+	exist := false
+	for i, s := range m.Signers {
+		if s == signer { // update existing entry
+			m.PellHeights[i] = pellHeight
+			m.BlockNums[i] = blockNum
+			m.Prices[i] = price
+			m.PriorityFees[i] = priorityFee
+			m.BaseFees[i] = baseFee
+			exist = true
+			break
+		}
+	}
+	if !exist {
+		m.Signers = append(m.Signers, signer)
+		m.PellHeights = append(m.PellHeights, pellHeight)
+		m.BlockNums = append(m.BlockNums, blockNum)
+		m.Prices = append(m.Prices, price)
+		m.PriorityFees = append(m.PriorityFees, priorityFee)
+		m.BaseFees = append(m.BaseFees, baseFee)
+	}
+
+	m.EvictStaleVotes(pellHeight)
+	m.UpdateMedians()
+}
+
+// EvictStaleVotes removes the votes recorded more than GasPriceStaleBlocks pell blocks before the pell height.
+// The block numbers of the observed chain are reported by the signers and are not used to judge the staleness.
+func (m *GasPrice) EvictStaleVotes(pellHeight uint64) {
+	m.normalizeVotes()
+
+	// the votes recorded before the pell heights were tracked are kept for a full period from now
+	for i, height := range m.PellHeights {
+		if height == 0 {
+			m.PellHeights[i] = pellHeight
+		}
+	}
+	if pellHeight <= GasPriceStaleBlocks {
+		return
+	}
+	threshold := pellHeight - GasPriceStaleBlocks
+
+	n := 0
+	for i := range m.Prices {
+		if m.PellHeights[i] < threshold {
+			continue
+		}
+		m.Signers[n] = m.Signers[i]
+		m.PellHeights[n] = m.PellHeights[i]
+		m.BlockNums[n] = m.BlockNums[i]
+		m.Prices[n] = m.Prices[i]
+		m.PriorityFees[n] = m.PriorityFees[i]
+		m.BaseFees[n] = m.BaseFees[i]
+		n++
+	}
+	m.Signers = m.Signers[:n]
+	m.PellHeights = m.PellHeights[:n]
+	m.BlockNums = m.BlockNums[:n]
+	m.Prices = m.Prices[:n]
+	m.PriorityFees = m.PriorityFees[:n]
+	m.BaseFees = m.BaseFees[:n]
+}
+
+// UpdateMedians recomputes the median indexes of the gas price, priority fee and base fee.
+// Votes whose gas price deviates from the median by more than GasPriceOutlierMaxDeviationPercent are rejected.
+func (m *GasPrice) UpdateMedians() {
+	m.normalizeVotes()
+	if len(m.Prices) == 0 {
+		m.MedianIndex, m.PriorityFeeMedianIndex, m.BaseFeeMedianIndex = 0, 0, 0
+		return
+	}
+
+	accepted := m.acceptedVotes()
+
+	// #nosec G701 always positive
+	m.MedianIndex = uint64(medianIndex(m.Prices, accepted))
+	// #nosec G701 always positive
+	m.PriorityFeeMedianIndex = uint64(medianIndex(m.PriorityFees, accepted))
+	// #nosec G701 always positive
+	m.BaseFeeMedianIndex = uint64(medianIndex(m.BaseFees, accepted))
+}
+
+// MedianGasPrice returns the median gas price
+func (m GasPrice) MedianGasPrice() uint64 {
+	return valueAt(m.Prices, m.MedianIndex)
+}
+
+// MedianPriorityFee returns the median priority fee, zero for chains without EIP-1559
+func (m GasPrice) MedianPriorityFee() uint64 {
+	return valueAt(m.PriorityFees, m.PriorityFeeMedianIndex)
+}
+
+// MedianBaseFee returns the median base fee, zero for chains without EIP-1559
+func (m GasPrice) MedianBaseFee() uint64 {
+	return valueAt(m.BaseFees, m.BaseFeeMedianIndex)
+}
+
+// MaxFeePerGas returns the gas price to use for outbound txs.
+// For EIP-1559 chains it covers a doubling of the base fee on top of the priority fee.
+func (m GasPrice) MaxFeePerGas() uint64 {
+	gasPrice := m.MedianGasPrice()
+
+	priorityFee := m.MedianPriorityFee()
+	if priorityFee == 0 {
+		return gasPrice
+	}
+
+	if maxFee := 2*m.MedianBaseFee() + priorityFee; maxFee > gasPrice {
+		return maxFee
+	}
+	return gasPrice
+}
+
+// acceptedVotes returns the indexes of the votes which are not outliers
+func (m GasPrice) acceptedVotes() []int {
+	all := make([]int, len(m.Prices))
+	for i := range m.Prices {
+		all[i] = i
+	}
+	if len(all) < GasPriceOutlierMinVotes {
+		return all
+	}
+
+	median := m.Prices[medianIndex(m.Prices, all)]
+	accepted := make([]int, 0, len(all))
+	for _, i := range all {
+		var deviation uint64
+		if m.Prices[i] > median {
+			deviation = m.Prices[i] - median
+		} else {
+			deviation = median - m.Prices[i]
+		}
+		// compare in the ratio form to avoid a division by a zero median
+		if deviation*100 <= median*GasPriceOutlierMaxDeviationPercent {
+			accepted = append(accepted, i)
+		}
+	}
+	return accepted
+}
+
+// normalizeVotes pads the lists aligned with the prices, the fee lists of gas prices stored
+// before the EIP-1559 fees were voted and the pell heights stored before they were tracked are filled with zeros
+func (m *GasPrice) normalizeVotes() {
+	for len(m.Signers) < len(m.Prices) {
+		m.Signers = append(m.Signers, "")
+	}
+	for len(m.PellHeights) < len(m.Prices) {
+		m.PellHeights = append(m.PellHeights, 0)
+	}
+	for len(m.BlockNums) < len(m.Prices) {
+		m.BlockNums = append(m.BlockNums, 0)
+	}
+	for len(m.PriorityFees) < len(m.Prices) {
+		m.PriorityFees = append(m.PriorityFees, 0)
+	}
+	for len(m.BaseFees) < len(m.Prices) {
+		m.BaseFees = append(m.BaseFees, 0)
+	}
+}
+
+// medianIndex returns the index of the median of the values at the given indexes
+func medianIndex(values []uint64, indexes []int) int {
+	sorted := make([]int, len(indexes))
+	copy(sorted, indexes)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return values[sorted[i]] < values[sorted[j]]
+	})
+	return sorted[len(sorted)/2]
+}
+
+func valueAt(values []uint64, index uint64) uint64 {
+	if index >= uint64(len(values)) {
+		return 0
+	}
+	return values[index]
+}
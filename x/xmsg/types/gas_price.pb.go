@@ -25,13 +25,19 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // update gas price message
 type GasPrice struct {
-	Signer      string   `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
-	Index       string   `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
-	ChainId     int64    `protobuf:"varint,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	Signers     []string `protobuf:"bytes,4,rep,name=signers,proto3" json:"signers,omitempty"`
-	BlockNums   []uint64 `protobuf:"varint,5,rep,packed,name=block_nums,json=blockNums,proto3" json:"block_nums,omitempty"`
-	Prices      []uint64 `protobuf:"varint,6,rep,packed,name=prices,proto3" json:"prices,omitempty"`
-	MedianIndex uint64   `protobuf:"varint,7,opt,name=median_index,json=medianIndex,proto3" json:"median_index,omitempty"`
+	Signer                 string   `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Index                  string   `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	ChainId                int64    `protobuf:"varint,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Signers                []string `protobuf:"bytes,4,rep,name=signers,proto3" json:"signers,omitempty"`
+	BlockNums              []uint64 `protobuf:"varint,5,rep,packed,name=block_nums,json=blockNums,proto3" json:"block_nums,omitempty"`
+	Prices                 []uint64 `protobuf:"varint,6,rep,packed,name=prices,proto3" json:"prices,omitempty"`
+	MedianIndex            uint64   `protobuf:"varint,7,opt,name=median_index,json=medianIndex,proto3" json:"median_index,omitempty"`
+	PriorityFees           []uint64 `protobuf:"varint,8,rep,packed,name=priority_fees,json=priorityFees,proto3" json:"priority_fees,omitempty"`
+	BaseFees               []uint64 `protobuf:"varint,9,rep,packed,name=base_fees,json=baseFees,proto3" json:"base_fees,omitempty"`
+	PriorityFeeMedianIndex uint64   `protobuf:"varint,10,opt,name=priority_fee_median_index,json=priorityFeeMedianIndex,proto3" json:"priority_fee_median_index,omitempty"`
+	BaseFeeMedianIndex     uint64   `protobuf:"varint,11,opt,name=base_fee_median_index,json=baseFeeMedianIndex,proto3" json:"base_fee_median_index,omitempty"`
+	// pell block heights at which the votes were recorded, used to evict the stale votes
+	PellHeights []uint64 `protobuf:"varint,12,rep,packed,name=pell_heights,json=pellHeights,proto3" json:"pell_heights,omitempty"`
 }
 
 func (m *GasPrice) Reset()         { *m = GasPrice{} }
@@ -116,6 +122,41 @@ func (m *GasPrice) GetMedianIndex() uint64 {
 	return 0
 }
 
+func (m *GasPrice) GetPriorityFees() []uint64 {
+	if m != nil {
+		return m.PriorityFees
+	}
+	return nil
+}
+
+func (m *GasPrice) GetBaseFees() []uint64 {
+	if m != nil {
+		return m.BaseFees
+	}
+	return nil
+}
+
+func (m *GasPrice) GetPriorityFeeMedianIndex() uint64 {
+	if m != nil {
+		return m.PriorityFeeMedianIndex
+	}
+	return 0
+}
+
+func (m *GasPrice) GetBaseFeeMedianIndex() uint64 {
+	if m != nil {
+		return m.BaseFeeMedianIndex
+	}
+	return 0
+}
+
+func (m *GasPrice) GetPellHeights() []uint64 {
+	if m != nil {
+		return m.PellHeights
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GasPrice)(nil), "xmsg.GasPrice")
 }
@@ -123,26 +164,32 @@ func init() {
 func init() { proto.RegisterFile("xmsg/gas_price.proto", fileDescriptor_bcdede3829ab8351) }
 
 var fileDescriptor_bcdede3829ab8351 = []byte{
-	// 294 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x34, 0x90, 0x3d, 0x4e, 0xc3, 0x30,
-	0x14, 0x80, 0x6b, 0x92, 0xfe, 0xb9, 0x4c, 0x56, 0x05, 0x06, 0x09, 0x2b, 0x30, 0x45, 0x20, 0xd5,
-	0x20, 0x36, 0x46, 0x24, 0x84, 0xba, 0x54, 0x55, 0x46, 0x96, 0x28, 0x4d, 0xac, 0xd4, 0x6a, 0x1d,
-	0x57, 0x79, 0x09, 0x84, 0x95, 0x13, 0x70, 0x14, 0x8e, 0xc1, 0xd8, 0x81, 0x81, 0x11, 0x25, 0x03,
-	0xd7, 0x40, 0x76, 0xc2, 0x64, 0x7d, 0xdf, 0xf3, 0x93, 0x3e, 0x3d, 0x3c, 0xad, 0x14, 0xa4, 0x3c,
-	0x8d, 0x20, 0xdc, 0xe5, 0x32, 0x16, 0xb3, 0x5d, 0xae, 0x0b, 0x4d, 0x5c, 0x63, 0x4f, 0x8f, 0x63,
-	0x0d, 0x4a, 0x03, 0x37, 0x3f, 0x9e, 0x6f, 0xcc, 0xd3, 0x8e, 0x2f, 0xbe, 0x10, 0x1e, 0x3d, 0x46,
-	0xb0, 0x34, 0x1b, 0xe4, 0x08, 0x0f, 0x40, 0xa6, 0x99, 0xc8, 0x29, 0xf2, 0x90, 0x3f, 0x0e, 0x3a,
-	0x22, 0x53, 0xdc, 0x97, 0x59, 0x22, 0x2a, 0x7a, 0x60, 0x75, 0x0b, 0xe4, 0x04, 0x8f, 0xe2, 0x75,
-	0x24, 0xb3, 0x50, 0x26, 0xd4, 0xf1, 0x90, 0xef, 0x04, 0x43, 0xcb, 0xf3, 0x84, 0x50, 0x3c, 0x6c,
-	0x57, 0x81, 0xba, 0x9e, 0xe3, 0x8f, 0x83, 0x7f, 0x24, 0x67, 0x18, 0xaf, 0xb6, 0x3a, 0xde, 0x84,
-	0x59, 0xa9, 0x80, 0xf6, 0x3d, 0xc7, 0x77, 0x83, 0xb1, 0x35, 0x8b, 0x52, 0x81, 0x29, 0xb0, 0xf1,
-	0x40, 0x07, 0x76, 0xd4, 0x11, 0x39, 0xc7, 0x87, 0x4a, 0x24, 0x32, 0xca, 0xc2, 0x36, 0x64, 0xe8,
-	0x21, 0xdf, 0x0d, 0x26, 0xad, 0x9b, 0x1b, 0x75, 0x37, 0x79, 0xfb, 0xfd, 0xb8, 0xec, 0x8a, 0xef,
-	0x1f, 0x3e, 0x6b, 0x86, 0xf6, 0x35, 0x43, 0x3f, 0x35, 0x43, 0xef, 0x0d, 0xeb, 0xed, 0x1b, 0xd6,
-	0xfb, 0x6e, 0x58, 0xef, 0xe9, 0x2a, 0x95, 0xc5, 0xba, 0x5c, 0xcd, 0x62, 0xad, 0xf8, 0x75, 0xb5,
-	0x14, 0xdb, 0xed, 0x42, 0x14, 0x2f, 0x3a, 0xdf, 0xf0, 0x48, 0xa4, 0x12, 0x78, 0xc5, 0xed, 0x19,
-	0x8b, 0xd7, 0x9d, 0x80, 0xd5, 0xc0, 0x1e, 0xe9, 0xf6, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xbe, 0x52,
-	0x38, 0xde, 0x5b, 0x01, 0x00, 0x00,
+	// 385 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x92, 0xbd, 0x8e, 0x9b, 0x40,
+	0x14, 0x85, 0x4d, 0xc0, 0x36, 0x0c, 0xa4, 0x19, 0x39, 0xce, 0x38, 0x51, 0x10, 0x4e, 0x1a, 0x94,
+	0x48, 0x26, 0x56, 0xaa, 0xa4, 0x8c, 0x94, 0x1f, 0x17, 0xb1, 0x2c, 0xca, 0x34, 0x88, 0x9f, 0x09,
+	0x8c, 0x0c, 0x0c, 0xe2, 0xe2, 0x2c, 0x6e, 0xf7, 0x09, 0xf6, 0x2d, 0xb6, 0xdd, 0xc7, 0xd8, 0xd2,
+	0xe5, 0x96, 0x2b, 0xbb, 0xd8, 0xd7, 0x58, 0x31, 0x60, 0x09, 0x57, 0xe8, 0x7c, 0xf7, 0x9e, 0x7b,
+	0x8e, 0x34, 0xa0, 0x49, 0x9d, 0x41, 0xec, 0xc4, 0x3e, 0x78, 0x45, 0xc9, 0x42, 0xba, 0x28, 0x4a,
+	0x5e, 0x71, 0xac, 0x34, 0xf4, 0xcd, 0xeb, 0x90, 0x43, 0xc6, 0xc1, 0x69, 0x36, 0xfe, 0x2f, 0x9b,
+	0x4f, 0x3b, 0x7e, 0x7f, 0x2b, 0x23, 0xf5, 0x97, 0x0f, 0x9b, 0xc6, 0x81, 0xa7, 0x68, 0x04, 0x2c,
+	0xce, 0x69, 0x49, 0x24, 0x4b, 0xb2, 0x35, 0xb7, 0x53, 0x78, 0x82, 0x86, 0x2c, 0x8f, 0x68, 0x4d,
+	0x5e, 0x08, 0xdc, 0x0a, 0x3c, 0x43, 0x6a, 0x98, 0xf8, 0x2c, 0xf7, 0x58, 0x44, 0x64, 0x4b, 0xb2,
+	0x65, 0x77, 0x2c, 0xf4, 0x2a, 0xc2, 0x04, 0x8d, 0x5b, 0x2b, 0x10, 0xc5, 0x92, 0x6d, 0xcd, 0x3d,
+	0x4b, 0xfc, 0x0e, 0xa1, 0x20, 0xe5, 0xe1, 0xd6, 0xcb, 0x77, 0x19, 0x90, 0xa1, 0x25, 0xdb, 0x8a,
+	0xab, 0x09, 0xb2, 0xde, 0x65, 0xd0, 0x34, 0x10, 0xe5, 0x81, 0x8c, 0xc4, 0xa8, 0x53, 0x78, 0x8e,
+	0x8c, 0x8c, 0x46, 0xcc, 0xcf, 0xbd, 0xb6, 0xc8, 0xd8, 0x92, 0x6c, 0xc5, 0xd5, 0x5b, 0xb6, 0x12,
+	0x75, 0x3e, 0xa0, 0x97, 0x45, 0xc9, 0x78, 0xc9, 0xaa, 0xbd, 0xf7, 0x8f, 0x52, 0x20, 0xaa, 0xb8,
+	0x60, 0x9c, 0xe1, 0x4f, 0x4a, 0x01, 0xbf, 0x45, 0x5a, 0xe0, 0x03, 0x6d, 0x17, 0x34, 0xb1, 0xa0,
+	0x36, 0x40, 0x0c, 0xbf, 0xa2, 0x59, 0xff, 0x82, 0x77, 0x91, 0x88, 0x44, 0xe2, 0xb4, 0x77, 0xed,
+	0x4f, 0x2f, 0x7c, 0x89, 0x5e, 0x9d, 0xef, 0x5e, 0xda, 0x74, 0x61, 0xc3, 0x5d, 0x46, 0xdf, 0x32,
+	0x47, 0x46, 0x41, 0xd3, 0xd4, 0x4b, 0x28, 0x8b, 0x93, 0x0a, 0x88, 0x21, 0xda, 0xe8, 0x0d, 0xfb,
+	0xdd, 0xa2, 0x6f, 0xfa, 0xf5, 0xd3, 0xdd, 0xc7, 0xee, 0x11, 0xbe, 0xff, 0xb8, 0x3f, 0x9a, 0xd2,
+	0xe1, 0x68, 0x4a, 0x8f, 0x47, 0x53, 0xba, 0x39, 0x99, 0x83, 0xc3, 0xc9, 0x1c, 0x3c, 0x9c, 0xcc,
+	0xc1, 0xdf, 0x4f, 0x31, 0xab, 0x92, 0x5d, 0xb0, 0x08, 0x79, 0xe6, 0x7c, 0xae, 0x37, 0x34, 0x4d,
+	0xd7, 0xb4, 0xba, 0xe2, 0xe5, 0xd6, 0xf1, 0x69, 0xcc, 0xc0, 0xa9, 0x1d, 0xf1, 0x67, 0x54, 0xfb,
+	0x82, 0x42, 0x30, 0x12, 0xef, 0xfe, 0xe5, 0x79, 0x00, 0xca, 0x5e, 0x3b, 0xaf, 0x2e, 0x02, 0x00,
+	0x00,
 }
 
 func (m *GasPrice) Marshal() (dAtA []byte, err error) {
@@ -165,15 +212,10 @@ func (m *GasPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.MedianIndex != 0 {
-		i = encodeVarintGasPrice(dAtA, i, uint64(m.MedianIndex))
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.Prices) > 0 {
-		dAtA2 := make([]byte, len(m.Prices)*10)
+	if len(m.PellHeights) > 0 {
+		dAtA2 := make([]byte, len(m.PellHeights)*10)
 		var j1 int
-		for _, num := range m.Prices {
+		for _, num := range m.PellHeights {
 			for num >= 1<<7 {
 				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
@@ -186,12 +228,22 @@ func (m *GasPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], dAtA2[:j1])
 		i = encodeVarintGasPrice(dAtA, i, uint64(j1))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x62
 	}
-	if len(m.BlockNums) > 0 {
-		dAtA4 := make([]byte, len(m.BlockNums)*10)
+	if m.BaseFeeMedianIndex != 0 {
+		i = encodeVarintGasPrice(dAtA, i, uint64(m.BaseFeeMedianIndex))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.PriorityFeeMedianIndex != 0 {
+		i = encodeVarintGasPrice(dAtA, i, uint64(m.PriorityFeeMedianIndex))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.BaseFees) > 0 {
+		dAtA4 := make([]byte, len(m.BaseFees)*10)
 		var j3 int
-		for _, num := range m.BlockNums {
+		for _, num := range m.BaseFees {
 			for num >= 1<<7 {
 				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
@@ -204,6 +256,65 @@ func (m *GasPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], dAtA4[:j3])
 		i = encodeVarintGasPrice(dAtA, i, uint64(j3))
 		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.PriorityFees) > 0 {
+		dAtA6 := make([]byte, len(m.PriorityFees)*10)
+		var j5 int
+		for _, num := range m.PriorityFees {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintGasPrice(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.MedianIndex != 0 {
+		i = encodeVarintGasPrice(dAtA, i, uint64(m.MedianIndex))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Prices) > 0 {
+		dAtA8 := make([]byte, len(m.Prices)*10)
+		var j7 int
+		for _, num := range m.Prices {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintGasPrice(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.BlockNums) > 0 {
+		dAtA10 := make([]byte, len(m.BlockNums)*10)
+		var j9 int
+		for _, num := range m.BlockNums {
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			dAtA10[j9] = uint8(num)
+			j9++
+		}
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintGasPrice(dAtA, i, uint64(j9))
+		i--
 		dAtA[i] = 0x2a
 	}
 	if len(m.Signers) > 0 {
@@ -288,6 +399,33 @@ func (m *GasPrice) Size() (n int) {
 	if m.MedianIndex != 0 {
 		n += 1 + sovGasPrice(uint64(m.MedianIndex))
 	}
+	if len(m.PriorityFees) > 0 {
+		l = 0
+		for _, e := range m.PriorityFees {
+			l += sovGasPrice(uint64(e))
+		}
+		n += 1 + sovGasPrice(uint64(l)) + l
+	}
+	if len(m.BaseFees) > 0 {
+		l = 0
+		for _, e := range m.BaseFees {
+			l += sovGasPrice(uint64(e))
+		}
+		n += 1 + sovGasPrice(uint64(l)) + l
+	}
+	if m.PriorityFeeMedianIndex != 0 {
+		n += 1 + sovGasPrice(uint64(m.PriorityFeeMedianIndex))
+	}
+	if m.BaseFeeMedianIndex != 0 {
+		n += 1 + sovGasPrice(uint64(m.BaseFeeMedianIndex))
+	}
+	if len(m.PellHeights) > 0 {
+		l = 0
+		for _, e := range m.PellHeights {
+			l += sovGasPrice(uint64(e))
+		}
+		n += 1 + sovGasPrice(uint64(l)) + l
+	}
 	return n
 }
 
@@ -612,6 +750,272 @@ func (m *GasPrice) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGasPrice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PriorityFees = append(m.PriorityFees, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGasPrice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGasPrice
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGasPrice
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PriorityFees) == 0 {
+					m.PriorityFees = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGasPrice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PriorityFees = append(m.PriorityFees, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityFees", wireType)
+			}
+		case 9:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGasPrice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.BaseFees = append(m.BaseFees, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGasPrice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGasPrice
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGasPrice
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.BaseFees) == 0 {
+					m.BaseFees = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGasPrice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.BaseFees = append(m.BaseFees, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFees", wireType)
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityFeeMedianIndex", wireType)
+			}
+			m.PriorityFeeMedianIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasPrice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriorityFeeMedianIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeMedianIndex", wireType)
+			}
+			m.BaseFeeMedianIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasPrice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeMedianIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGasPrice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PellHeights = append(m.PellHeights, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGasPrice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGasPrice
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGasPrice
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PellHeights) == 0 {
+					m.PellHeights = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGasPrice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PellHeights = append(m.PellHeights, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PellHeights", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGasPrice(dAtA[iNdEx:])
@@ -0,0 +1,138 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/x/xmsg/types"
+)
+
+func TestGasPrice_SetVote(t *testing.T) {
+	t.Run("should record the first vote", func(t *testing.T) {
+		gp := types.GasPrice{ChainId: 1}
+		gp.SetVote("a", 1, 100, 10, 2, 4)
+
+		require.Equal(t, []string{"a"}, gp.Signers)
+		require.Equal(t, []uint64{100}, gp.BlockNums)
+		require.Equal(t, []uint64{10}, gp.Prices)
+		require.Equal(t, []uint64{2}, gp.PriorityFees)
+		require.Equal(t, []uint64{4}, gp.BaseFees)
+		require.EqualValues(t, 10, gp.MedianGasPrice())
+		require.EqualValues(t, 2, gp.MedianPriorityFee())
+		require.EqualValues(t, 4, gp.MedianBaseFee())
+	})
+
+	t.Run("should replace the vote of an existing signer", func(t *testing.T) {
+		gp := types.GasPrice{ChainId: 1}
+		gp.SetVote("a", 1, 100, 10, 2, 4)
+		gp.SetVote("b", 1, 100, 12, 2, 4)
+		gp.SetVote("a", 1, 101, 14, 3, 5)
+
+		require.Equal(t, []string{"a", "b"}, gp.Signers)
+		require.Equal(t, []uint64{101, 100}, gp.BlockNums)
+		require.Equal(t, []uint64{14, 12}, gp.Prices)
+		require.Equal(t, []uint64{3, 2}, gp.PriorityFees)
+		require.EqualValues(t, 14, gp.MedianGasPrice())
+	})
+
+	t.Run("should pad the fees of votes stored without them", func(t *testing.T) {
+		gp := types.GasPrice{
+			ChainId:   1,
+			Signers:   []string{"a"},
+			BlockNums: []uint64{100},
+			Prices:    []uint64{10},
+		}
+		gp.SetVote("b", 1, 100, 12, 2, 4)
+
+		require.Equal(t, []uint64{0, 2}, gp.PriorityFees)
+		require.Equal(t, []uint64{0, 4}, gp.BaseFees)
+	})
+
+	t.Run("should evict stale votes", func(t *testing.T) {
+		gp := types.GasPrice{ChainId: 1}
+		gp.SetVote("a", 100, 100, 10, 0, 0)
+		gp.SetVote("b", 500, 100, 11, 0, 0)
+		gp.SetVote("c", 100+types.GasPriceStaleBlocks+1, 100, 12, 0, 0)
+
+		require.Equal(t, []string{"b", "c"}, gp.Signers)
+		require.Equal(t, []uint64{500, 100 + types.GasPriceStaleBlocks + 1}, gp.PellHeights)
+		require.Equal(t, []uint64{11, 12}, gp.Prices)
+		require.EqualValues(t, 12, gp.MedianGasPrice())
+	})
+
+	t.Run("should not evict votes on a self-reported block number", func(t *testing.T) {
+		gp := types.GasPrice{ChainId: 1}
+		gp.SetVote("a", 100, 100, 10, 0, 0)
+		gp.SetVote("b", 100, 100, 11, 0, 0)
+		gp.SetVote("c", 101, 1_000_000, 12, 0, 0)
+
+		require.Equal(t, []string{"a", "b", "c"}, gp.Signers)
+		require.EqualValues(t, 11, gp.MedianGasPrice())
+	})
+
+	t.Run("should keep the votes stored without pell height for a full period", func(t *testing.T) {
+		gp := types.GasPrice{
+			ChainId:   1,
+			Signers:   []string{"a"},
+			BlockNums: []uint64{100},
+			Prices:    []uint64{10},
+		}
+		gp.SetVote("b", 2000, 100, 12, 0, 0)
+		require.Equal(t, []string{"a", "b"}, gp.Signers)
+		require.Equal(t, []uint64{2000, 2000}, gp.PellHeights)
+
+		gp.SetVote("b", 2000+types.GasPriceStaleBlocks+1, 100, 12, 0, 0)
+		require.Equal(t, []string{"b"}, gp.Signers)
+	})
+
+	t.Run("should reject outliers from the median", func(t *testing.T) {
+		gp := types.GasPrice{ChainId: 1}
+		gp.SetVote("a", 1, 100, 100, 10, 40)
+		gp.SetVote("b", 1, 100, 110, 11, 45)
+		gp.SetVote("c", 1, 100, 1000, 500, 400)
+		gp.SetVote("d", 1, 100, 1, 1, 1)
+
+		// the outliers are still recorded
+		require.Len(t, gp.Prices, 4)
+		// but the median is computed over a and b only
+		require.EqualValues(t, 110, gp.MedianGasPrice())
+		require.EqualValues(t, 11, gp.MedianPriorityFee())
+		require.EqualValues(t, 45, gp.MedianBaseFee())
+	})
+
+	t.Run("should not reject outliers with too few votes", func(t *testing.T) {
+		gp := types.GasPrice{ChainId: 1}
+		gp.SetVote("a", 1, 100, 100, 0, 0)
+		gp.SetVote("b", 1, 100, 1000, 0, 0)
+
+		require.EqualValues(t, 1000, gp.MedianGasPrice())
+	})
+}
+
+func TestGasPrice_MaxFeePerGas(t *testing.T) {
+	t.Run("should return the median gas price for legacy chains", func(t *testing.T) {
+		gp := types.GasPrice{ChainId: 1}
+		gp.SetVote("a", 1, 100, 10, 0, 0)
+
+		require.EqualValues(t, 10, gp.MaxFeePerGas())
+	})
+
+	t.Run("should cover twice the base fee on top of the priority fee", func(t *testing.T) {
+		gp := types.GasPrice{ChainId: 1}
+		gp.SetVote("a", 1, 100, 10, 2, 8)
+
+		require.EqualValues(t, 18, gp.MaxFeePerGas())
+	})
+
+	t.Run("should not go below the median gas price", func(t *testing.T) {
+		gp := types.GasPrice{ChainId: 1}
+		gp.SetVote("a", 1, 100, 30, 2, 8)
+
+		require.EqualValues(t, 30, gp.MaxFeePerGas())
+	})
+
+	t.Run("should return zero without votes", func(t *testing.T) {
+		require.Zero(t, types.GasPrice{}.MaxFeePerGas())
+	})
+}
@@ -52,6 +52,34 @@ func (gs GenesisState) Validate() error {
 		gasPriceIndexMap[elem.Index] = true
 	}
 
+	// Check for duplicated and invalid recharge ledgers
+	rechargeLedgerMap := make(map[string]bool)
+	for _, elem := range gs.RechargeLedgers {
+		key := string(RechargeKey(elem.ChainId, elem.TokenType))
+		if rechargeLedgerMap[key] {
+			return fmt.Errorf("duplicated recharge ledger for chain %d token %s", elem.ChainId, elem.TokenType)
+		}
+		rechargeLedgerMap[key] = true
+
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("invalid recharge ledger for chain %d token %s: %w", elem.ChainId, elem.TokenType, err)
+		}
+	}
+
+	// Check for duplicated and invalid recharge budgets
+	rechargeBudgetMap := make(map[string]bool)
+	for _, elem := range gs.RechargeBudgets {
+		key := string(RechargeKey(elem.ChainId, elem.TokenType))
+		if rechargeBudgetMap[key] {
+			return fmt.Errorf("duplicated recharge budget for chain %d token %s", elem.ChainId, elem.TokenType)
+		}
+		rechargeBudgetMap[key] = true
+
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("invalid recharge budget for chain %d token %s: %w", elem.ChainId, elem.TokenType, err)
+		}
+	}
+
 	return gs.RateLimiterFlags.Validate()
 }
 
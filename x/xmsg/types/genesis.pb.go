@@ -33,6 +33,8 @@ type GenesisState struct {
 	InTxTrackerList     []InTxTracker      `protobuf:"bytes,6,rep,name=in_tx_tracker_list,json=inTxTrackerList,proto3" json:"in_tx_tracker_list"`
 	FinalizedInbounds   []string           `protobuf:"bytes,7,rep,name=finalized_inbounds,json=finalizedInbounds,proto3" json:"finalized_inbounds,omitempty"`
 	RateLimiterFlags    RateLimiterFlags   `protobuf:"bytes,8,opt,name=rate_limiter_flags,json=rateLimiterFlags,proto3" json:"rate_limiter_flags"`
+	RechargeLedgers     []RechargeLedger   `protobuf:"bytes,9,rep,name=recharge_ledgers,json=rechargeLedgers,proto3" json:"recharge_ledgers"`
+	RechargeBudgets     []RechargeBudget   `protobuf:"bytes,10,rep,name=recharge_budgets,json=rechargeBudgets,proto3" json:"recharge_budgets"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -124,6 +126,20 @@ func (m *GenesisState) GetRateLimiterFlags() RateLimiterFlags {
 	return RateLimiterFlags{}
 }
 
+func (m *GenesisState) GetRechargeLedgers() []RechargeLedger {
+	if m != nil {
+		return m.RechargeLedgers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRechargeBudgets() []RechargeBudget {
+	if m != nil {
+		return m.RechargeBudgets
+	}
+	return nil
+}
+
 // Remove legacy types
 type GenesisStateLegacy struct {
 	Params              *Params            `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
@@ -279,42 +295,45 @@ func init() {
 func init() { proto.RegisterFile("xmsg/genesis.proto", fileDescriptor_967d4c6075811588) }
 
 var fileDescriptor_967d4c6075811588 = []byte{
-	// 553 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcf, 0x6e, 0xd3, 0x4c,
-	0x14, 0xc5, 0xe3, 0xe6, 0xef, 0x37, 0x8d, 0xda, 0xaf, 0xd3, 0x50, 0x4c, 0x04, 0x26, 0x8a, 0x58,
-	0x44, 0x42, 0xc4, 0xa8, 0xf0, 0x04, 0x11, 0x90, 0xb6, 0x8a, 0x68, 0x64, 0xb2, 0x40, 0x6c, 0x46,
-	0x93, 0x64, 0x3a, 0x1e, 0xc5, 0xf6, 0x44, 0x9e, 0x89, 0x70, 0x79, 0x07, 0x24, 0x1e, 0xab, 0xcb,
-	0x2e, 0x59, 0x21, 0x94, 0xac, 0x79, 0x07, 0xe4, 0x3b, 0x6e, 0x1b, 0x13, 0x75, 0x51, 0x29, 0x3b,
-	0xfb, 0xfc, 0xee, 0x3d, 0xe3, 0xb9, 0xf7, 0xc8, 0x08, 0x27, 0xa1, 0xe2, 0x2e, 0x67, 0x11, 0x53,
-	0x42, 0x75, 0xe7, 0xb1, 0xd4, 0x12, 0x97, 0x52, 0xad, 0xd9, 0xe0, 0x92, 0x4b, 0x10, 0xdc, 0xf4,
-	0xc9, 0xb0, 0x66, 0xc3, 0xd4, 0x53, 0x45, 0xe6, 0xb1, 0x98, 0xb0, 0x4c, 0x7d, 0x06, 0xaa, 0x88,
-	0x88, 0x4e, 0x88, 0x4f, 0x95, 0x4f, 0xb4, 0x24, 0xa9, 0x94, 0x61, 0x7b, 0x0d, 0xeb, 0x98, 0x4e,
-	0x66, 0x2c, 0xce, 0xc8, 0x53, 0x20, 0x01, 0x55, 0x9a, 0x8c, 0x03, 0x39, 0x99, 0x11, 0x9f, 0x09,
-	0xee, 0xeb, 0x8c, 0x3e, 0x01, 0x2a, 0x17, 0x7a, 0xb3, 0xd1, 0x9c, 0x18, 0x53, 0xcd, 0x48, 0x20,
-	0x42, 0xa1, 0x59, 0x4c, 0x2e, 0x02, 0xca, 0xb3, 0x2b, 0x34, 0xf7, 0x01, 0xdf, 0x7d, 0x42, 0xfb,
-	0x7b, 0x09, 0xd5, 0xfb, 0xe6, 0x96, 0x9f, 0x34, 0xd5, 0x0c, 0xf7, 0xd1, 0x61, 0xde, 0x98, 0x04,
-	0x42, 0x69, 0xdb, 0x6a, 0x15, 0x3b, 0xbb, 0xc7, 0xb8, 0x0b, 0xad, 0xe7, 0x0b, 0x3d, 0x4a, 0x46,
-	0x06, 0xf7, 0x4a, 0x57, 0xbf, 0x9e, 0x17, 0xbc, 0xff, 0xe5, 0x9a, 0x36, 0x10, 0x4a, 0xe3, 0xb7,
-	0x68, 0xef, 0x76, 0x1c, 0xc6, 0x63, 0x07, 0x3c, 0xf6, 0x8c, 0x47, 0x9f, 0xaa, 0x61, 0x8a, 0xbc,
-	0x3a, 0xcf, 0x9e, 0xa0, 0xab, 0x85, 0xca, 0x29, 0x56, 0x76, 0x11, 0x8a, 0x91, 0x29, 0xfe, 0x1c,
-	0x2a, 0xee, 0x19, 0x80, 0xcf, 0xd0, 0xd1, 0xc6, 0x5c, 0x8c, 0x7f, 0x09, 0x5a, 0x1e, 0x99, 0x96,
-	0x01, 0x55, 0xba, 0x97, 0x96, 0x9c, 0x40, 0x85, 0x77, 0x18, 0xe4, 0x05, 0x38, 0xed, 0x1c, 0x3d,
-	0xde, 0x5c, 0x8e, 0x31, 0x2b, 0x83, 0x59, 0xc3, 0x98, 0x9d, 0x46, 0xa3, 0xe4, 0x84, 0x2a, 0x7f,
-	0x24, 0xd3, 0x2f, 0xc9, 0xae, 0x8c, 0x45, 0x4e, 0x05, 0xc3, 0x77, 0x08, 0xe7, 0xd6, 0x69, 0xbc,
-	0x2a, 0xe0, 0x75, 0x70, 0xe7, 0x95, 0x9f, 0xdd, 0xbe, 0x88, 0xf2, 0xa3, 0x7b, 0x85, 0xf0, 0x85,
-	0x88, 0x68, 0x20, 0xbe, 0xb1, 0x29, 0x11, 0xd1, 0x58, 0x2e, 0xa2, 0xa9, 0xb2, 0xab, 0xad, 0x62,
-	0xe7, 0x3f, 0xef, 0xe0, 0x96, 0x9c, 0x66, 0x00, 0x9f, 0x21, 0xbc, 0xb9, 0x70, 0xbb, 0xd6, 0xb2,
-	0x3a, 0xbb, 0xc7, 0x47, 0xe6, 0x50, 0x8f, 0x6a, 0x36, 0x30, 0xf8, 0x43, 0x4a, 0x6f, 0xb6, 0x16,
-	0xff, 0xa3, 0xb7, 0xff, 0x14, 0x11, 0x5e, 0xcf, 0xc3, 0x80, 0x71, 0x3a, 0xb9, 0xc4, 0x2f, 0x50,
-	0x65, 0x4e, 0x63, 0x1a, 0x2a, 0xdb, 0x02, 0xdb, 0xba, 0xb1, 0x1d, 0x82, 0xe6, 0x65, 0xec, 0xbe,
-	0xec, 0xec, 0x6c, 0x21, 0x3b, 0xc5, 0x87, 0x64, 0xa7, 0xf4, 0xf0, 0xec, 0x94, 0xb7, 0x99, 0x9d,
-	0xca, 0x16, 0xb3, 0x53, 0xdd, 0x4a, 0x76, 0x6a, 0xf7, 0x64, 0xa7, 0xdd, 0x46, 0x15, 0xb3, 0x44,
-	0x6c, 0xa3, 0x2a, 0x8b, 0xe8, 0x38, 0x60, 0x53, 0xd8, 0x71, 0xcd, 0xbb, 0x79, 0xed, 0xbd, 0xbf,
-	0x5a, 0x3a, 0xd6, 0xf5, 0xd2, 0xb1, 0x7e, 0x2f, 0x1d, 0xeb, 0xc7, 0xca, 0x29, 0x5c, 0xaf, 0x9c,
-	0xc2, 0xcf, 0x95, 0x53, 0xf8, 0xf2, 0x92, 0x0b, 0xed, 0x2f, 0xc6, 0xdd, 0x89, 0x0c, 0xdd, 0xd7,
-	0xc9, 0x90, 0x05, 0xc1, 0x47, 0xa6, 0xbf, 0xca, 0x78, 0xe6, 0x52, 0xc6, 0x85, 0x72, 0x13, 0xf8,
-	0xd5, 0xb8, 0xfa, 0x72, 0xce, 0xd4, 0xb8, 0x02, 0x7f, 0x9c, 0x37, 0x7f, 0x03, 0x00, 0x00, 0xff,
-	0xff, 0x02, 0xf9, 0xbe, 0xf9, 0x5b, 0x05, 0x00, 0x00,
+	// 598 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x9b, 0xf5, 0xef, 0xbc, 0x6a, 0x7f, 0xbc, 0x32, 0x42, 0x05, 0xa1, 0xaa, 0x38, 0x54,
+	0x42, 0x34, 0x68, 0xf0, 0x09, 0x2a, 0x46, 0xb7, 0xa9, 0x62, 0x55, 0xe8, 0x01, 0x71, 0xb1, 0x9c,
+	0xd4, 0x73, 0xac, 0xa6, 0x71, 0x15, 0xbb, 0x22, 0xe3, 0x53, 0x70, 0xe7, 0x0b, 0xed, 0xb8, 0x23,
+	0x27, 0x84, 0xda, 0x33, 0xdf, 0x01, 0xc5, 0x4e, 0xbb, 0xa6, 0x65, 0x87, 0x49, 0xbd, 0x25, 0xcf,
+	0xef, 0x7d, 0x1f, 0xc7, 0xef, 0xfb, 0x28, 0x00, 0xc6, 0x63, 0x41, 0x6d, 0x4a, 0x42, 0x22, 0x98,
+	0x68, 0x4f, 0x22, 0x2e, 0x39, 0x2c, 0x24, 0x5a, 0xbd, 0x46, 0x39, 0xe5, 0x4a, 0xb0, 0x93, 0x27,
+	0xcd, 0xea, 0x35, 0x5d, 0x8f, 0x05, 0x9a, 0x44, 0xcc, 0x23, 0xa9, 0xfa, 0x42, 0xa9, 0x2c, 0x44,
+	0x32, 0x46, 0x3e, 0x16, 0x3e, 0x92, 0x1c, 0x25, 0x52, 0x8a, 0xcd, 0x15, 0x2c, 0x23, 0xec, 0x8d,
+	0x48, 0x94, 0x92, 0xe7, 0x8a, 0x04, 0x58, 0x48, 0xe4, 0x06, 0xdc, 0x1b, 0x21, 0x9f, 0x30, 0xea,
+	0xcb, 0x94, 0x3e, 0x53, 0x94, 0x4f, 0xe5, 0x66, 0xa3, 0x3e, 0x31, 0xc2, 0x92, 0xa0, 0x80, 0x8d,
+	0x99, 0x24, 0x11, 0xba, 0x0e, 0x30, 0x4d, 0xaf, 0x50, 0xaf, 0x6b, 0x4c, 0x3c, 0x1f, 0x47, 0x94,
+	0xa0, 0x80, 0x0c, 0xe9, 0xb2, 0xf5, 0x40, 0xb1, 0xfb, 0xcf, 0x6b, 0xfe, 0x2c, 0x82, 0x6a, 0x57,
+	0x4f, 0xe0, 0xb3, 0xc4, 0x92, 0xc0, 0x2e, 0x38, 0xce, 0x1e, 0x8a, 0x02, 0x26, 0xa4, 0x69, 0x34,
+	0xf2, 0xad, 0xbd, 0x53, 0xd8, 0x56, 0xad, 0x57, 0x53, 0x39, 0x88, 0x07, 0x1a, 0x77, 0x0a, 0xb7,
+	0xbf, 0x5f, 0xe6, 0x9c, 0x43, 0xbe, 0xa2, 0xf5, 0x98, 0x90, 0xf0, 0x3d, 0xd8, 0x5f, 0x8e, 0x4a,
+	0x7b, 0xec, 0x28, 0x8f, 0x7d, 0xed, 0xd1, 0xc5, 0xa2, 0x9f, 0x20, 0xa7, 0x4a, 0xd3, 0x27, 0xd5,
+	0xd5, 0x00, 0xc5, 0x04, 0x0b, 0x33, 0xaf, 0x8a, 0x81, 0x2e, 0xfe, 0x32, 0x16, 0xd4, 0xd1, 0x00,
+	0x5e, 0x82, 0x93, 0x8d, 0x99, 0x69, 0xff, 0x82, 0x6a, 0x79, 0xa2, 0x5b, 0x7a, 0x58, 0xc8, 0x4e,
+	0x52, 0x72, 0xae, 0x2a, 0x9c, 0xe3, 0x20, 0x2b, 0xa8, 0xd3, 0xae, 0xc0, 0xd3, 0xcd, 0xc5, 0x69,
+	0xb3, 0xa2, 0x32, 0xab, 0x69, 0xb3, 0x8b, 0x70, 0x10, 0x9f, 0x63, 0xe1, 0x0f, 0x78, 0xf2, 0x25,
+	0xe9, 0x95, 0x21, 0xcb, 0xa8, 0xca, 0xf0, 0x03, 0x80, 0x99, 0x55, 0x6b, 0xaf, 0x92, 0xf2, 0x3a,
+	0xba, 0xf7, 0xca, 0xce, 0xee, 0x80, 0x85, 0xd9, 0xd1, 0xbd, 0x01, 0xf0, 0x9a, 0x85, 0x38, 0x60,
+	0xdf, 0xc9, 0x10, 0xb1, 0xd0, 0xe5, 0xd3, 0x70, 0x28, 0xcc, 0x72, 0x23, 0xdf, 0xda, 0x75, 0x8e,
+	0x96, 0xe4, 0x22, 0x05, 0xf0, 0x12, 0xc0, 0xcd, 0x30, 0x98, 0x95, 0x86, 0xd1, 0xda, 0x3b, 0x3d,
+	0xd1, 0x87, 0x3a, 0x58, 0x92, 0x9e, 0xc6, 0x1f, 0x13, 0xba, 0xd8, 0x5a, 0xb4, 0xa6, 0xc3, 0x33,
+	0x70, 0xb8, 0x96, 0x1c, 0x61, 0xee, 0xae, 0x8e, 0xc2, 0x49, 0x69, 0x4f, 0xc1, 0xc5, 0x0d, 0xa2,
+	0x8c, 0x9a, 0xb5, 0x71, 0xa7, 0x43, 0x4a, 0xa4, 0x30, 0xc1, 0xff, 0x6c, 0x3a, 0x0a, 0xae, 0xdb,
+	0x68, 0x55, 0x34, 0xff, 0xe6, 0x01, 0x5c, 0x4d, 0x67, 0x8f, 0x50, 0xec, 0xdd, 0xc0, 0x57, 0xa0,
+	0x34, 0xc1, 0x11, 0x1e, 0x0b, 0xd3, 0x50, 0x97, 0xac, 0x6a, 0xcf, 0xbe, 0xd2, 0x9c, 0x94, 0x3d,
+	0x94, 0xe4, 0x9d, 0x2d, 0x24, 0x39, 0xff, 0x98, 0x24, 0x17, 0x1e, 0x9f, 0xe4, 0xe2, 0x36, 0x93,
+	0x5c, 0xda, 0x62, 0x92, 0xcb, 0x5b, 0x49, 0x72, 0xe5, 0x81, 0x24, 0x37, 0x9b, 0xa0, 0xa4, 0x97,
+	0x08, 0x4d, 0x50, 0x26, 0x21, 0x76, 0x03, 0x32, 0x54, 0x3b, 0xae, 0x38, 0x8b, 0xd7, 0xce, 0xd9,
+	0xed, 0xcc, 0x32, 0xee, 0x66, 0x96, 0xf1, 0x67, 0x66, 0x19, 0x3f, 0xe6, 0x56, 0xee, 0x6e, 0x6e,
+	0xe5, 0x7e, 0xcd, 0xad, 0xdc, 0xd7, 0xd7, 0x94, 0x49, 0x7f, 0xea, 0xb6, 0x3d, 0x3e, 0xb6, 0xdf,
+	0xc6, 0x7d, 0x12, 0x04, 0x9f, 0x88, 0xfc, 0xc6, 0xa3, 0x91, 0x8d, 0x09, 0x65, 0xc2, 0x8e, 0xd5,
+	0x8f, 0xcf, 0x96, 0x37, 0x13, 0x22, 0xdc, 0x92, 0xfa, 0xff, 0xbd, 0xfb, 0x37, 0x00, 0x41, 0x9b,
+	0xa0, 0x68, 0x05, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -337,6 +356,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RechargeBudgets) > 0 {
+		for iNdEx := len(m.RechargeBudgets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RechargeBudgets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.RechargeLedgers) > 0 {
+		for iNdEx := len(m.RechargeLedgers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RechargeLedgers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	{
 		size, err := m.RateLimiterFlags.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -665,6 +712,18 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.RateLimiterFlags.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.RechargeLedgers) > 0 {
+		for _, e := range m.RechargeLedgers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RechargeBudgets) > 0 {
+		for _, e := range m.RechargeBudgets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1039,6 +1098,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RechargeLedgers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RechargeLedgers = append(m.RechargeLedgers, RechargeLedger{})
+			if err := m.RechargeLedgers[len(m.RechargeLedgers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RechargeBudgets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RechargeBudgets = append(m.RechargeBudgets, RechargeBudget{})
+			if err := m.RechargeBudgets[len(m.RechargeBudgets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 
 	"github.com/0xPellNetwork/aegis/testutil/sample"
@@ -45,6 +46,13 @@ func TestGenesisState_Validate(t *testing.T) {
 					sample.GasPrice_pell(t, "2"),
 				},
 				RateLimiterFlags: sample.RateLimiterFlags_pell(),
+				RechargeLedgers: []types.RechargeLedger{
+					types.NewRechargeLedger(1, types.RechargeTokenType_PELL_TOKEN),
+					types.NewRechargeLedger(1, types.RechargeTokenType_GAS_TOKEN),
+				},
+				RechargeBudgets: []types.RechargeBudget{
+					{ChainId: 1, TokenType: types.RechargeTokenType_PELL_TOKEN, Window: 100, MaxAmount: math.NewUint(1000)},
+				},
 			},
 			valid: true,
 		},
@@ -105,6 +113,44 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated recharge ledger",
+			genState: &types.GenesisState{
+				RechargeLedgers: []types.RechargeLedger{
+					types.NewRechargeLedger(1, types.RechargeTokenType_PELL_TOKEN),
+					types.NewRechargeLedger(1, types.RechargeTokenType_PELL_TOKEN),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid recharge ledger",
+			genState: &types.GenesisState{
+				RechargeLedgers: []types.RechargeLedger{
+					{ChainId: 1, TokenType: types.RechargeTokenType_PELL_TOKEN},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated recharge budget",
+			genState: &types.GenesisState{
+				RechargeBudgets: []types.RechargeBudget{
+					{ChainId: 1, TokenType: types.RechargeTokenType_GAS_TOKEN, Window: 100, MaxAmount: math.NewUint(1000)},
+					{ChainId: 1, TokenType: types.RechargeTokenType_GAS_TOKEN, Window: 10, MaxAmount: math.NewUint(10)},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid recharge budget",
+			genState: &types.GenesisState{
+				RechargeBudgets: []types.RechargeBudget{
+					{ChainId: 1, TokenType: types.RechargeTokenType_GAS_TOKEN, Window: 0, MaxAmount: math.NewUint(1000)},
+				},
+			},
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
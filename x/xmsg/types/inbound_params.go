@@ -2,8 +2,6 @@ package types
 
 import (
 	"fmt"
-
-	"github.com/0xPellNetwork/aegis/pkg/chains"
 )
 
 func (m InboundTxParams) Validate() error {
@@ -11,7 +9,8 @@ func (m InboundTxParams) Validate() error {
 		return fmt.Errorf("sender cannot be empty")
 	}
 
-	if _, exist := chains.GetChainByChainId(m.SenderChainId); !exist {
+	// the chain existence is checked against the chain registry when the xmsg is processed
+	if m.SenderChainId <= 0 {
 		return fmt.Errorf("invalid sender chain id %d", m.SenderChainId)
 	}
 
@@ -15,8 +15,8 @@ func TestInboundTxParams_Validate(t *testing.T) {
 	inTxParams.Sender = ""
 	require.ErrorContains(t, inTxParams.Validate(), "sender cannot be empty")
 	inTxParams = sample.InboundTxParamsValidChainID_pell(r)
-	inTxParams.SenderChainId = 1000
-	require.ErrorContains(t, inTxParams.Validate(), "invalid sender chain id 1000")
+	inTxParams.SenderChainId = 0
+	require.ErrorContains(t, inTxParams.Validate(), "invalid sender chain id 0")
 
 	inTxParams = sample.InboundTxParamsValidChainID_pell(r)
 	inTxParams.InboundTxHash = sample.Hash().String()
@@ -0,0 +1,303 @@
+package types
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	pevmtypes "github.com/0xPellNetwork/aegis/x/pevm/types"
+	relayertypes "github.com/0xPellNetwork/aegis/x/relayer/types"
+)
+
+// inboundEventsABI is the ABI of the inbound events that can be decoded from a proven receipt
+const inboundEventsABI = `[
+	{"anonymous":false,"name":"Deposit","type":"event","inputs":[
+		{"indexed":false,"name":"staker","type":"address"},
+		{"indexed":false,"name":"token","type":"address"},
+		{"indexed":false,"name":"strategy","type":"address"},
+		{"indexed":false,"name":"shares","type":"uint256"}]},
+	{"anonymous":false,"name":"StakerDelegated","type":"event","inputs":[
+		{"indexed":true,"name":"staker","type":"address"},
+		{"indexed":true,"name":"operator","type":"address"}]},
+	{"anonymous":false,"name":"StakerUndelegated","type":"event","inputs":[
+		{"indexed":true,"name":"staker","type":"address"},
+		{"indexed":true,"name":"operator","type":"address"}]},
+	{"anonymous":false,"name":"WithdrawalQueued","type":"event","inputs":[
+		{"indexed":false,"name":"withdrawalRoot","type":"bytes32"},
+		{"indexed":false,"name":"withdrawal","type":"tuple","components":[
+			{"name":"staker","type":"address"},
+			{"name":"delegatedTo","type":"address"},
+			{"name":"withdrawer","type":"address"},
+			{"name":"nonce","type":"uint256"},
+			{"name":"startTimestamp","type":"uint32"},
+			{"name":"strategies","type":"address[]"},
+			{"name":"shares","type":"uint256[]"}]}]},
+	{"anonymous":false,"name":"PellSent","type":"event","inputs":[
+		{"indexed":false,"name":"sourceTxOriginAddress","type":"address"},
+		{"indexed":true,"name":"pellTxSenderAddress","type":"address"},
+		{"indexed":true,"name":"destinationChainId","type":"uint256"},
+		{"indexed":false,"name":"destinationAddress","type":"bytes"},
+		{"indexed":false,"name":"pellValueAndGas","type":"uint256"},
+		{"indexed":false,"name":"destinationGasLimit","type":"uint256"},
+		{"indexed":false,"name":"message","type":"bytes"},
+		{"indexed":false,"name":"pellParams","type":"bytes"}]}
+]`
+
+// InboundEventsABI is the parsed ABI of the inbound events supported by inbound proofs
+var InboundEventsABI = mustParseABI(inboundEventsABI)
+
+// WithdrawalLog is the withdrawal of a WithdrawalQueued event log
+type WithdrawalLog struct {
+	Staker         ethcommon.Address
+	DelegatedTo    ethcommon.Address
+	Withdrawer     ethcommon.Address
+	Nonce          *big.Int
+	StartTimestamp uint32
+	Strategies     []ethcommon.Address
+	Shares         []*big.Int
+}
+
+// inboundLogEvent is an inbound pell event decoded from a log along with the tx origin and receiver of the inbound
+type inboundLogEvent struct {
+	pellEvent InboundPellEvent
+	txOrigin  string
+	receiver  string
+}
+
+// VerifyInboundProof verifies the inbound vote against the proven tx and receipt of the inbound tx
+// the tx must match the inbound tx hash, chain and sender, the receipt must be successful
+// and exactly one of its logs emitted by the chain contracts must decode to the inbound pell event
+func VerifyInboundProof(
+	msg MsgVoteOnObservedInboundTx,
+	txBytes []byte,
+	receiptBytes []byte,
+	chainParams relayertypes.ChainParams,
+) error {
+	if msg.PellTx == nil {
+		return fmt.Errorf("inbound pell event is missing")
+	}
+	switch msg.PellTx.PellData.(type) {
+	case *InboundPellEvent_StakerDeposited,
+		*InboundPellEvent_StakerDelegated,
+		*InboundPellEvent_StakerUndelegated,
+		*InboundPellEvent_WithdrawalQueued,
+		*InboundPellEvent_PellSent:
+	default:
+		return ErrInboundProofNotSupported
+	}
+
+	// verify the inbound tx
+	var tx ethtypes.Transaction
+	if err := tx.UnmarshalBinary(txBytes); err != nil {
+		return fmt.Errorf("failed to unmarshal transaction %s", err.Error())
+	}
+	if tx.Hash().Hex() != msg.InTxHash {
+		return fmt.Errorf("invalid hash, want tx hash %s, got %s", tx.Hash().Hex(), msg.InTxHash)
+	}
+	if tx.ChainId().Cmp(big.NewInt(msg.SenderChainId)) != 0 {
+		return fmt.Errorf("invalid chain id, want evm chain id %d, got %d", tx.ChainId(), msg.SenderChainId)
+	}
+	sender, err := ethtypes.Sender(ethtypes.LatestSignerForChainID(tx.ChainId()), &tx)
+	if err != nil {
+		return fmt.Errorf("failed to recover sender %s", err.Error())
+	}
+	if sender != ethcommon.HexToAddress(msg.Sender) {
+		return fmt.Errorf("invalid sender, want sender %s, got %s", sender.Hex(), msg.Sender)
+	}
+
+	// verify the receipt contains the inbound event
+	var receipt ethtypes.Receipt
+	if err := receipt.UnmarshalBinary(receiptBytes); err != nil {
+		return fmt.Errorf("failed to unmarshal receipt %s", err.Error())
+	}
+	if receipt.Status != ethtypes.ReceiptStatusSuccessful {
+		return fmt.Errorf("inbound tx %s failed", msg.InTxHash)
+	}
+
+	// the event index is not part of the proof, so the proven event must be the only one of the tx
+	// to match the inbound pell event
+	want, err := msg.PellTx.Marshal()
+	if err != nil {
+		return err
+	}
+	matches := 0
+	for _, log := range receipt.Logs {
+		event, err := decodeInboundLog(*log, tx.Hash(), chainParams)
+		if err != nil {
+			continue
+		}
+		got, err := event.pellEvent.Marshal()
+		if err != nil {
+			return err
+		}
+		if bytes.Equal(want, got) &&
+			strings.EqualFold(event.txOrigin, msg.TxOrigin) &&
+			strings.EqualFold(event.receiver, msg.Receiver) {
+			matches++
+		}
+	}
+	switch matches {
+	case 0:
+		return fmt.Errorf("no log of inbound tx %s matches the inbound pell event", msg.InTxHash)
+	case 1:
+		return nil
+	default:
+		return fmt.Errorf("%d logs of inbound tx %s match the inbound pell event", matches, msg.InTxHash)
+	}
+}
+
+// decodeInboundLog decodes an inbound pell event from a log of the given tx emitted by the contracts of the chain
+func decodeInboundLog(log ethtypes.Log, txHash ethcommon.Hash, chainParams relayertypes.ChainParams) (inboundLogEvent, error) {
+	if len(log.Topics) == 0 {
+		return inboundLogEvent{}, fmt.Errorf("log has no topic")
+	}
+	event, err := InboundEventsABI.EventByID(log.Topics[0])
+	if err != nil {
+		return inboundLogEvent{}, err
+	}
+
+	// inbound events are emitted by the strategy manager, the delegation manager or the connector
+	wantContract := chainParams.DelegationManagerContractAddress
+	switch event.Name {
+	case "Deposit":
+		wantContract = chainParams.StrategyManagerContractAddress
+	case "PellSent":
+		wantContract = chainParams.ConnectorContractAddress
+	}
+	if log.Address != ethcommon.HexToAddress(wantContract) {
+		return inboundLogEvent{}, fmt.Errorf("log emitted by unexpected contract %s", log.Address.Hex())
+	}
+
+	// indexed arguments are in the topics, the others in the data
+	wantTopics := 1
+	for _, input := range event.Inputs {
+		if input.Indexed {
+			wantTopics++
+		}
+	}
+	if len(log.Topics) != wantTopics {
+		return inboundLogEvent{}, fmt.Errorf("number of topics mismatch: want %d got %d", wantTopics, len(log.Topics))
+	}
+	values, err := event.Inputs.NonIndexed().Unpack(log.Data)
+	if err != nil {
+		return inboundLogEvent{}, err
+	}
+
+	switch event.Name {
+	case "Deposit":
+		staker := values[0].(ethcommon.Address)
+		return inboundLogEvent{
+			pellEvent: InboundPellEvent{
+				PellData: &InboundPellEvent_StakerDeposited{
+					StakerDeposited: &StakerDeposited{
+						Staker:   staker.Hex(),
+						Token:    values[1].(ethcommon.Address).Hex(),
+						Strategy: values[2].(ethcommon.Address).Hex(),
+						Shares:   sdkmath.NewUintFromBigInt(values[3].(*big.Int)),
+					},
+				},
+			},
+			txOrigin: staker.Hex(),
+			receiver: staker.Hex(),
+		}, nil
+	case "StakerDelegated", "StakerUndelegated":
+		staker := ethcommon.BytesToAddress(log.Topics[1].Bytes())
+		operator := ethcommon.BytesToAddress(log.Topics[2].Bytes())
+		pellEvent := InboundPellEvent{
+			PellData: &InboundPellEvent_StakerDelegated{
+				StakerDelegated: &StakerDelegated{Staker: staker.Hex(), Operator: operator.Hex()},
+			},
+		}
+		if event.Name == "StakerUndelegated" {
+			pellEvent.PellData = &InboundPellEvent_StakerUndelegated{
+				StakerUndelegated: &StakerUndelegated{Staker: staker.Hex(), Operator: operator.Hex()},
+			}
+		}
+		return inboundLogEvent{
+			pellEvent: pellEvent,
+			txOrigin:  staker.Hex(),
+			receiver:  staker.Hex(),
+		}, nil
+	case "WithdrawalQueued":
+		root := values[0].([32]byte)
+		withdrawal, ok := abi.ConvertType(values[1], WithdrawalLog{}).(WithdrawalLog)
+		if !ok {
+			return inboundLogEvent{}, fmt.Errorf("invalid withdrawal")
+		}
+		return inboundLogEvent{
+			pellEvent: InboundPellEvent{
+				PellData: &InboundPellEvent_WithdrawalQueued{
+					WithdrawalQueued: &WithdrawalQueued{
+						WithdrawalRoot: root[:],
+						Withdrawal:     withdrawal.toWithdrawal(),
+					},
+				},
+			},
+			txOrigin: withdrawal.Withdrawer.Hex(),
+			receiver: withdrawal.Staker.Hex(),
+		}, nil
+	case "PellSent":
+		// the pell event is built as by the connector event handler of the observers
+		paramType, err := pevmtypes.ToPellSentParamType(values[5].([]byte))
+		if err != nil {
+			return inboundLogEvent{}, err
+		}
+		destinationChainID := new(big.Int).SetBytes(log.Topics[2].Bytes())
+		return inboundLogEvent{
+			pellEvent: InboundPellEvent{
+				PellData: &InboundPellEvent_PellSent{
+					PellSent: &PellSent{
+						TxOrigin:            txHash.Hex(),
+						Sender:              log.Address.Hex(),
+						ReceiverChainId:     destinationChainID.Int64(),
+						Receiver:            ethcommon.BytesToAddress(values[1].([]byte)).String(),
+						Message:             base64.StdEncoding.EncodeToString(values[4].([]byte)),
+						PellParams:          paramType.String(),
+						PellValue:           sdkmath.NewUintFromBigInt(values[2].(*big.Int)),
+						DestinationGasLimit: sdkmath.NewUintFromBigInt(values[3].(*big.Int)),
+					},
+				},
+			},
+			txOrigin: ethcommon.BytesToAddress(log.Topics[1].Bytes()).Hex(),
+			receiver: log.Address.Hex(),
+		}, nil
+	default:
+		return inboundLogEvent{}, fmt.Errorf("unsupported event %s", event.Name)
+	}
+}
+
+// toWithdrawal converts the withdrawal of the log to the withdrawal of the pell event
+func (w WithdrawalLog) toWithdrawal() *Withdrawal {
+	strategies := make([]string, len(w.Strategies))
+	for i, addr := range w.Strategies {
+		strategies[i] = addr.Hex()
+	}
+	shares := make([]string, len(w.Shares))
+	for i, share := range w.Shares {
+		shares[i] = share.String()
+	}
+	return &Withdrawal{
+		Staker:         w.Staker.Hex(),
+		DelegatedTo:    w.DelegatedTo.Hex(),
+		Withdrawer:     w.Withdrawer.Hex(),
+		Nonce:          w.Nonce.String(),
+		StartTimestamp: w.StartTimestamp,
+		Strategies:     strategies,
+		Shares:         shares,
+	}
+}
+
+func mustParseABI(s string) abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(s))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
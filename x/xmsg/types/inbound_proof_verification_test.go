@@ -0,0 +1,321 @@
+package types_test
+
+import (
+	"encoding/base64"
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/pkg/chains"
+	"github.com/0xPellNetwork/aegis/testutil/sample"
+	pevmtypes "github.com/0xPellNetwork/aegis/x/pevm/types"
+	"github.com/0xPellNetwork/aegis/x/xmsg/types"
+)
+
+// inboundReceipt returns the encoded receipt of an inbound tx with the given status and logs
+func inboundReceipt(t *testing.T, status uint64, logs ...*ethtypes.Log) []byte {
+	receipt := ethtypes.Receipt{
+		Type:   ethtypes.DynamicFeeTxType,
+		Status: status,
+		Logs:   logs,
+	}
+	b, err := receipt.MarshalBinary()
+	require.NoError(t, err)
+	return b
+}
+
+// depositLog returns a Deposit log emitted by the strategy manager
+func depositLog(t *testing.T, contract, staker, token, strategy ethcommon.Address, shares int64) *ethtypes.Log {
+	event := types.InboundEventsABI.Events["Deposit"]
+	data, err := event.Inputs.NonIndexed().Pack(staker, token, strategy, big.NewInt(shares))
+	require.NoError(t, err)
+	return &ethtypes.Log{
+		Address: contract,
+		Topics:  []ethcommon.Hash{event.ID},
+		Data:    data,
+	}
+}
+
+// delegationLog returns a StakerDelegated or StakerUndelegated log emitted by the delegation manager
+func delegationLog(name string, contract, staker, operator ethcommon.Address) *ethtypes.Log {
+	return &ethtypes.Log{
+		Address: contract,
+		Topics: []ethcommon.Hash{
+			types.InboundEventsABI.Events[name].ID,
+			ethcommon.BytesToHash(staker.Bytes()),
+			ethcommon.BytesToHash(operator.Bytes()),
+		},
+	}
+}
+
+// pellSentLog returns a PellSent log emitted by the connector
+func pellSentLog(
+	t *testing.T,
+	contract, txOrigin, pellTxSender ethcommon.Address,
+	destinationChainID int64,
+	destination ethcommon.Address,
+	value int64,
+	message []byte,
+) *ethtypes.Log {
+	event := types.InboundEventsABI.Events["PellSent"]
+	data, err := event.Inputs.NonIndexed().Pack(
+		txOrigin,
+		destination.Bytes(),
+		big.NewInt(value),
+		big.NewInt(100000),
+		message,
+		[]byte{byte(pevmtypes.ReceiveCall)},
+	)
+	require.NoError(t, err)
+	return &ethtypes.Log{
+		Address: contract,
+		Topics: []ethcommon.Hash{
+			event.ID,
+			ethcommon.BytesToHash(pellTxSender.Bytes()),
+			ethcommon.BigToHash(big.NewInt(destinationChainID)),
+		},
+		Data: data,
+	}
+}
+
+func TestVerifyInboundProof(t *testing.T) {
+	chainID := chains.SepoliaChain().Id
+	chainParams := *sample.ChainParams_pell(chainID)
+	strategyManager := ethcommon.HexToAddress(chainParams.StrategyManagerContractAddress)
+	delegationManager := ethcommon.HexToAddress(chainParams.DelegationManagerContractAddress)
+	connector := ethcommon.HexToAddress(chainParams.ConnectorContractAddress)
+
+	tx, txBytes, sender := sample.EthTxSigned(t, chainID, sample.EthAddress(), 42)
+	token, strategy, operator := sample.EthAddress(), sample.EthAddress(), sample.EthAddress()
+
+	depositMsg := types.MsgVoteOnObservedInboundTx{
+		Sender:        sender.Hex(),
+		SenderChainId: chainID,
+		TxOrigin:      sender.Hex(),
+		Receiver:      sender.Hex(),
+		ReceiverChain: chains.PellPrivnetChain().Id,
+		InTxHash:      tx.Hash().Hex(),
+		PellTx: &types.InboundPellEvent{
+			PellData: &types.InboundPellEvent_StakerDeposited{
+				StakerDeposited: &types.StakerDeposited{
+					Staker:   sender.Hex(),
+					Token:    token.Hex(),
+					Strategy: strategy.Hex(),
+					Shares:   sdkmath.NewUint(1000),
+				},
+			},
+		},
+	}
+	depositReceipt := inboundReceipt(t, ethtypes.ReceiptStatusSuccessful,
+		depositLog(t, strategyManager, sender, token, strategy, 1000),
+	)
+
+	delegateMsg := depositMsg
+	delegateMsg.PellTx = &types.InboundPellEvent{
+		PellData: &types.InboundPellEvent_StakerDelegated{
+			StakerDelegated: &types.StakerDelegated{
+				Staker:   sender.Hex(),
+				Operator: operator.Hex(),
+			},
+		},
+	}
+
+	pellChainID := chains.PellPrivnetChain().Id
+	destination := sample.EthAddress()
+	pellSentMsg := depositMsg
+	pellSentMsg.TxOrigin = sender.Hex()
+	pellSentMsg.Receiver = connector.Hex()
+	pellSentMsg.PellTx = &types.InboundPellEvent{
+		PellData: &types.InboundPellEvent_PellSent{
+			PellSent: &types.PellSent{
+				TxOrigin:            tx.Hash().Hex(),
+				Sender:              connector.Hex(),
+				ReceiverChainId:     pellChainID,
+				Receiver:            destination.String(),
+				Message:             base64.StdEncoding.EncodeToString([]byte("hello")),
+				PellParams:          pevmtypes.ReceiveCall.String(),
+				PellValue:           sdkmath.NewUint(1000),
+				DestinationGasLimit: sdkmath.NewUint(100000),
+			},
+		},
+	}
+
+	// NOTE: errContains == "" means no error
+	for _, tc := range []struct {
+		desc         string
+		msg          types.MsgVoteOnObservedInboundTx
+		txBytes      []byte
+		receiptBytes []byte
+		errContains  string
+		errIs        error
+	}{
+		{
+			desc:         "valid deposit",
+			msg:          depositMsg,
+			txBytes:      txBytes,
+			receiptBytes: depositReceipt,
+		},
+		{
+			desc:    "valid delegation among other logs",
+			msg:     delegateMsg,
+			txBytes: txBytes,
+			receiptBytes: inboundReceipt(t, ethtypes.ReceiptStatusSuccessful,
+				&ethtypes.Log{Address: sample.EthAddress()},
+				delegationLog("StakerUndelegated", delegationManager, sender, operator),
+				delegationLog("StakerDelegated", delegationManager, sender, operator),
+			),
+		},
+		{
+			desc:    "valid pell sent",
+			msg:     pellSentMsg,
+			txBytes: txBytes,
+			receiptBytes: inboundReceipt(t, ethtypes.ReceiptStatusSuccessful,
+				pellSentLog(t, connector, sender, sender, pellChainID, destination, 1000, []byte("hello")),
+			),
+		},
+		{
+			desc:    "pell sent emitted by another contract",
+			msg:     pellSentMsg,
+			txBytes: txBytes,
+			receiptBytes: inboundReceipt(t, ethtypes.ReceiptStatusSuccessful,
+				pellSentLog(t, delegationManager, sender, sender, pellChainID, destination, 1000, []byte("hello")),
+			),
+			errContains: "matches the inbound pell event",
+		},
+		{
+			desc:    "pell sent value doesn't match",
+			msg:     pellSentMsg,
+			txBytes: txBytes,
+			receiptBytes: inboundReceipt(t, ethtypes.ReceiptStatusSuccessful,
+				pellSentLog(t, connector, sender, sender, pellChainID, destination, 1001, []byte("hello")),
+			),
+			errContains: "matches the inbound pell event",
+		},
+		{
+			desc: "unsupported pell event",
+			msg: func() types.MsgVoteOnObservedInboundTx {
+				msg := depositMsg
+				msg.PellTx = &types.InboundPellEvent{
+					PellData: &types.InboundPellEvent_RegisterChainDvsToPell{
+						RegisterChainDvsToPell: &types.RegisterChainDVSToPell{ChainId: uint64(chainID)},
+					},
+				}
+				return msg
+			}(),
+			txBytes:      txBytes,
+			receiptBytes: depositReceipt,
+			errIs:        types.ErrInboundProofNotSupported,
+		},
+		{
+			desc:         "txBytes can't be unmarshaled",
+			msg:          depositMsg,
+			txBytes:      []byte("invalid"),
+			receiptBytes: depositReceipt,
+			errContains:  "failed to unmarshal transaction",
+		},
+		{
+			desc: "tx hash doesn't correspond",
+			msg: func() types.MsgVoteOnObservedInboundTx {
+				msg := depositMsg
+				msg.InTxHash = sample.Hash().Hex()
+				return msg
+			}(),
+			txBytes:      txBytes,
+			receiptBytes: depositReceipt,
+			errContains:  "invalid hash",
+		},
+		{
+			desc: "chain id doesn't correspond",
+			msg: func() types.MsgVoteOnObservedInboundTx {
+				msg := depositMsg
+				msg.SenderChainId = chains.EthChain().Id
+				return msg
+			}(),
+			txBytes:      txBytes,
+			receiptBytes: depositReceipt,
+			errContains:  "invalid chain id",
+		},
+		{
+			desc: "sender doesn't correspond",
+			msg: func() types.MsgVoteOnObservedInboundTx {
+				msg := depositMsg
+				msg.Sender = sample.EthAddress().Hex()
+				return msg
+			}(),
+			txBytes:      txBytes,
+			receiptBytes: depositReceipt,
+			errContains:  "invalid sender",
+		},
+		{
+			desc:         "receiptBytes can't be unmarshaled",
+			msg:          depositMsg,
+			txBytes:      txBytes,
+			receiptBytes: []byte("invalid"),
+			errContains:  "failed to unmarshal receipt",
+		},
+		{
+			desc:    "inbound tx failed",
+			msg:     depositMsg,
+			txBytes: txBytes,
+			receiptBytes: inboundReceipt(t, ethtypes.ReceiptStatusFailed,
+				depositLog(t, strategyManager, sender, token, strategy, 1000),
+			),
+			errContains: "failed",
+		},
+		{
+			desc:    "log emitted by another contract",
+			msg:     depositMsg,
+			txBytes: txBytes,
+			receiptBytes: inboundReceipt(t, ethtypes.ReceiptStatusSuccessful,
+				depositLog(t, delegationManager, sender, token, strategy, 1000),
+			),
+			errContains: "matches the inbound pell event",
+		},
+		{
+			desc:    "log doesn't match the pell event",
+			msg:     depositMsg,
+			txBytes: txBytes,
+			receiptBytes: inboundReceipt(t, ethtypes.ReceiptStatusSuccessful,
+				depositLog(t, strategyManager, sender, token, strategy, 1001),
+			),
+			errContains: "matches the inbound pell event",
+		},
+		{
+			desc: "log doesn't match the receiver",
+			msg: func() types.MsgVoteOnObservedInboundTx {
+				msg := depositMsg
+				msg.Receiver = sample.EthAddress().Hex()
+				return msg
+			}(),
+			txBytes:      txBytes,
+			receiptBytes: depositReceipt,
+			errContains:  "matches the inbound pell event",
+		},
+		{
+			desc:    "several logs match the pell event",
+			msg:     depositMsg,
+			txBytes: txBytes,
+			receiptBytes: inboundReceipt(t, ethtypes.ReceiptStatusSuccessful,
+				depositLog(t, strategyManager, sender, token, strategy, 1000),
+				depositLog(t, strategyManager, sender, token, strategy, 1000),
+			),
+			errContains: "2 logs of inbound tx",
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := types.VerifyInboundProof(tc.msg, tc.txBytes, tc.receiptBytes, chainParams)
+			switch {
+			case tc.errIs != nil:
+				require.ErrorIs(t, err, tc.errIs)
+			case tc.errContains != "":
+				require.ErrorContains(t, err, tc.errContains)
+			default:
+				require.NoError(t, err)
+			}
+		})
+	}
+}
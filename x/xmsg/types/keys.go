@@ -49,6 +49,7 @@ const (
 	SendKey              = "Send-value-"
 	LastBlockHeightKey   = "LastBlockHeight-value-"
 	FinalizedInboundsKey = "FinalizedInbounds-value-"
+	ProvenInboundsKey    = "ProvenInbounds-value-"
 
 	GasPriceKey = "GasPrice-value-"
 
@@ -64,8 +65,14 @@ const (
 	InboundEventKey      = "Inbound-event-"
 	XmsgAllowedSenderKey = "Xmsg-allowed-sender-"
 
+	// XmsgIndexKey is the prefix of the secondary indexes of the xmsgs used by the filtered xmsg query
+	XmsgIndexKey = "Xmsg-index-"
+
 	FinalizedAddPellTokenKeyPrefix = "FinalizedAddPellTokenKey-value-"
 	FinalizedAddGasTokenKeyPrefix  = "FinalizedAddGasTokenKey-value-"
+
+	RechargeLedgerKey = "RechargeLedger-value-"
+	RechargeBudgetKey = "RechargeBudget-value-"
 )
 
 // OutTxTrackerKey returns the store key to retrieve a OutTxTracker from the index fields
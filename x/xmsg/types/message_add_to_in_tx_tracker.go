@@ -5,7 +5,6 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
-	"github.com/0xPellNetwork/aegis/pkg/chains"
 	"github.com/0xPellNetwork/aegis/pkg/coin"
 )
 
@@ -48,14 +47,11 @@ func (msg *MsgAddToInTxTracker) ValidateBasic() error {
 	if err != nil {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
-	chain, exist := chains.GetChainByChainId(msg.ChainId)
-	if !exist {
+	// the chain existence and its support of proof-based trackers are checked against the chain registry
+	// when the message is processed
+	if msg.ChainId <= 0 {
 		return errorsmod.Wrapf(ErrInvalidChainID, "chain id (%d)", msg.ChainId)
 	}
-
-	if msg.Proof != nil && !chain.SupportMerkleProof() {
-		return errorsmod.Wrapf(ErrProofVerificationFail, "chain id %d does not support proof-based trackers", msg.ChainId)
-	}
 	_, ok := coin.CoinType_value[msg.CoinType.String()]
 	if !ok {
 		return errorsmod.Wrapf(ErrProofVerificationFail, "coin-type not supported")
@@ -34,11 +34,11 @@ func TestMsgAddToInTxTracker_ValidateBasic(t *testing.T) {
 			name: "invalid chain id",
 			msg: types.NewMsgAddToInTxTracker(
 				sample.AccAddress(),
-				42,
+				0,
 				coin.CoinType_GAS,
 				"hash",
 			),
-			err: errorsmod.Wrapf(types.ErrInvalidChainID, "chain id (%d)", 42),
+			err: errorsmod.Wrapf(types.ErrInvalidChainID, "chain id (%d)", 0),
 		},
 		// TODO: current there are no non-EVM chains
 		//{
@@ -5,8 +5,6 @@ import (
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-
-	"github.com/0xPellNetwork/aegis/pkg/chains"
 )
 
 const TypeMsgMigrateTssFunds = "MigrateTssFunds"
@@ -47,7 +45,8 @@ func (msg *MsgMigrateTssFunds) ValidateBasic() error {
 	if err != nil {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
-	if _, exist := chains.GetChainByChainId(msg.ChainId); !exist {
+	// the chain existence is checked against the chain registry when the message is processed
+	if msg.ChainId <= 0 {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid chain id (%d)", msg.ChainId)
 	}
 	if msg.Amount.IsZero() {
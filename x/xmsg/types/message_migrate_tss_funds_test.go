@@ -33,7 +33,7 @@ func TestNewMsgMigrateTssFunds_ValidateBasic(t *testing.T) {
 			name: "invalid chain id",
 			msg: types.NewMsgMigrateTssFunds(
 				sample.AccAddress(),
-				999,
+				-1,
 				sdkmath.NewUintFromString("100000"),
 			),
 			error: true,
@@ -0,0 +1,82 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/0xPellNetwork/aegis/pkg/proofs"
+)
+
+const TypeMsgProveInboundTx = "ProveInboundTx"
+
+var _ sdk.Msg = &MsgProveInboundTx{}
+
+func NewMsgProveInboundTx(
+	creator string,
+	inbound MsgVoteOnObservedInboundTx,
+	blockHash string,
+	txIndex int64,
+	txProof *proofs.Proof,
+	receiptProof *proofs.Proof,
+) *MsgProveInboundTx {
+	inbound.Signer = creator
+	return &MsgProveInboundTx{
+		Signer:       creator,
+		Inbound:      inbound,
+		BlockHash:    blockHash,
+		TxIndex:      txIndex,
+		TxProof:      txProof,
+		ReceiptProof: receiptProof,
+	}
+}
+
+func (msg *MsgProveInboundTx) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgProveInboundTx) Type() string {
+	return TypeMsgProveInboundTx
+}
+
+func (msg *MsgProveInboundTx) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgProveInboundTx) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgProveInboundTx) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.Inbound.Signer != msg.Signer {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "inbound signer %s is not the creator", msg.Inbound.Signer)
+	}
+	if err := msg.Inbound.ValidateBasic(); err != nil {
+		return err
+	}
+
+	// the chain existence and its support of inbound proofs are checked against the chain registry
+	// when the message is processed
+	if msg.Inbound.SenderChainId <= 0 {
+		return errorsmod.Wrapf(ErrInvalidChainID, "chain id (%d)", msg.Inbound.SenderChainId)
+	}
+	if msg.TxProof == nil || msg.ReceiptProof == nil {
+		return errorsmod.Wrap(ErrProofVerificationFail, "tx and receipt proofs are required")
+	}
+	if msg.BlockHash == "" {
+		return errorsmod.Wrap(ErrProofVerificationFail, "block hash is required")
+	}
+	if msg.TxIndex < 0 {
+		return errorsmod.Wrapf(ErrProofVerificationFail, "invalid tx index (%d)", msg.TxIndex)
+	}
+	return nil
+}
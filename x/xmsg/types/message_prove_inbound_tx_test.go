@@ -0,0 +1,187 @@
+package types_test
+
+import (
+	"math/rand"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/pkg/chains"
+	"github.com/0xPellNetwork/aegis/testutil/sample"
+	"github.com/0xPellNetwork/aegis/x/xmsg/types"
+)
+
+func TestMsgProveInboundTx_ValidateBasic(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	signer := sample.AccAddress()
+	txProof, _, _, _, _, _ := sample.Proof(t)
+	receiptProof, _ := sample.ReceiptProof(t)
+
+	inbound := func(chainID int64) types.MsgVoteOnObservedInboundTx {
+		return *types.NewMsgVoteOnObservedInboundTx(
+			signer,
+			sample.EthAddress().Hex(),
+			chainID,
+			sample.EthAddress().Hex(),
+			sample.EthAddress().Hex(),
+			chains.PellPrivnetChain().Id,
+			sample.Hash().Hex(),
+			42,
+			42,
+			0,
+			*sample.InboundPellTx_StakerDeposited_pell(r),
+		)
+	}
+
+	tests := []struct {
+		name string
+		msg  *types.MsgProveInboundTx
+		err  error
+	}{
+		{
+			name: "valid message",
+			msg: types.NewMsgProveInboundTx(
+				signer,
+				inbound(chains.SepoliaChain().Id),
+				sample.Hash().Hex(),
+				2,
+				txProof,
+				receiptProof,
+			),
+		},
+		{
+			name: "invalid address",
+			msg: types.NewMsgProveInboundTx(
+				"invalid_address",
+				inbound(chains.SepoliaChain().Id),
+				sample.Hash().Hex(),
+				2,
+				txProof,
+				receiptProof,
+			),
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "inbound signer is not the signer",
+			msg: &types.MsgProveInboundTx{
+				Signer:       sample.AccAddress(),
+				Inbound:      inbound(chains.SepoliaChain().Id),
+				BlockHash:    sample.Hash().Hex(),
+				TxIndex:      2,
+				TxProof:      txProof,
+				ReceiptProof: receiptProof,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "invalid chain id",
+			msg: types.NewMsgProveInboundTx(
+				signer,
+				inbound(0),
+				sample.Hash().Hex(),
+				2,
+				txProof,
+				receiptProof,
+			),
+			err: types.ErrInvalidChainID,
+		},
+		{
+			name: "missing receipt proof",
+			msg: types.NewMsgProveInboundTx(
+				signer,
+				inbound(chains.SepoliaChain().Id),
+				sample.Hash().Hex(),
+				2,
+				txProof,
+				nil,
+			),
+			err: types.ErrProofVerificationFail,
+		},
+		{
+			name: "missing block hash",
+			msg: types.NewMsgProveInboundTx(
+				signer,
+				inbound(chains.SepoliaChain().Id),
+				"",
+				2,
+				txProof,
+				receiptProof,
+			),
+			err: types.ErrProofVerificationFail,
+		},
+		{
+			name: "invalid tx index",
+			msg: types.NewMsgProveInboundTx(
+				signer,
+				inbound(chains.SepoliaChain().Id),
+				sample.Hash().Hex(),
+				-1,
+				txProof,
+				receiptProof,
+			),
+			err: types.ErrProofVerificationFail,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgProveInboundTx_GetSigners(t *testing.T) {
+	signer := sample.AccAddress()
+	tests := []struct {
+		name   string
+		msg    *types.MsgProveInboundTx
+		panics bool
+	}{
+		{
+			name:   "valid signer",
+			msg:    &types.MsgProveInboundTx{Signer: signer},
+			panics: false,
+		},
+		{
+			name:   "invalid signer",
+			msg:    &types.MsgProveInboundTx{Signer: "invalid"},
+			panics: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.panics {
+				signers := tt.msg.GetSigners()
+				require.Equal(t, []sdk.AccAddress{sdk.MustAccAddressFromBech32(signer)}, signers)
+			} else {
+				require.Panics(t, func() {
+					tt.msg.GetSigners()
+				})
+			}
+		})
+	}
+}
+
+func TestMsgProveInboundTx_Type(t *testing.T) {
+	msg := types.MsgProveInboundTx{Signer: sample.AccAddress()}
+	require.Equal(t, types.TypeMsgProveInboundTx, msg.Type())
+}
+
+func TestMsgProveInboundTx_Route(t *testing.T) {
+	msg := types.MsgProveInboundTx{Signer: sample.AccAddress()}
+	require.Equal(t, types.RouterKey, msg.Route())
+}
+
+func TestMsgProveInboundTx_GetSignBytes(t *testing.T) {
+	msg := types.MsgProveInboundTx{Signer: sample.AccAddress()}
+	require.NotPanics(t, func() {
+		msg.GetSignBytes()
+	})
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+const TypeMsgRefundAbortedXmsg = "RefundAbortedXmsg"
+
+var _ sdk.Msg = &MsgRefundAbortedXmsg{}
+
+func NewMsgRefundAbortedXmsg(creator string, xmsgIndex string, refundAddress string) *MsgRefundAbortedXmsg {
+	return &MsgRefundAbortedXmsg{
+		Signer:        creator,
+		XmsgIndex:     xmsgIndex,
+		RefundAddress: refundAddress,
+	}
+}
+
+func (msg *MsgRefundAbortedXmsg) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRefundAbortedXmsg) Type() string {
+	return TypeMsgRefundAbortedXmsg
+}
+
+func (msg *MsgRefundAbortedXmsg) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRefundAbortedXmsg) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRefundAbortedXmsg) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if len(msg.XmsgIndex) != PellIndexLength {
+		return ErrInvalidIndexValue
+	}
+	if msg.RefundAddress != "" && !ethcommon.IsHexAddress(msg.RefundAddress) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid refund address (%s)", msg.RefundAddress)
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/testutil/sample"
+	"github.com/0xPellNetwork/aegis/x/xmsg/types"
+)
+
+func TestMsgRefundAbortedXmsg_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *types.MsgRefundAbortedXmsg
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  types.NewMsgRefundAbortedXmsg("invalid_address", "xmsg_index", ""),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid xmsg index",
+			msg:  types.NewMsgRefundAbortedXmsg(sample.AccAddress(), "xmsg_index", ""),
+			err:  types.ErrInvalidIndexValue,
+		},
+		{
+			name: "invalid refund address",
+			msg:  types.NewMsgRefundAbortedXmsg(sample.AccAddress(), sample.GetXmsgIndicesFromString_pell("test"), "invalid"),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "valid without refund address",
+			msg:  types.NewMsgRefundAbortedXmsg(sample.AccAddress(), sample.GetXmsgIndicesFromString_pell("test"), ""),
+			err:  nil,
+		},
+		{
+			name: "valid with refund address",
+			msg:  types.NewMsgRefundAbortedXmsg(sample.AccAddress(), sample.GetXmsgIndicesFromString_pell("test"), sample.EthAddress().Hex()),
+			err:  nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgRefundAbortedXmsg_GetSigners(t *testing.T) {
+	signer := sample.AccAddress()
+	tests := []struct {
+		name   string
+		msg    *types.MsgRefundAbortedXmsg
+		panics bool
+	}{
+		{
+			name:   "valid signer",
+			msg:    types.NewMsgRefundAbortedXmsg(signer, "xmsg_index", ""),
+			panics: false,
+		},
+		{
+			name:   "invalid signer",
+			msg:    types.NewMsgRefundAbortedXmsg("invalid", "xmsg_index", ""),
+			panics: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.panics {
+				signers := tt.msg.GetSigners()
+				require.Equal(t, []sdk.AccAddress{sdk.MustAccAddressFromBech32(signer)}, signers)
+			} else {
+				require.Panics(t, func() {
+					tt.msg.GetSigners()
+				})
+			}
+		})
+	}
+}
+
+func TestMsgRefundAbortedXmsg_Type(t *testing.T) {
+	msg := types.NewMsgRefundAbortedXmsg(sample.AccAddress(), "xmsg_index", "")
+	require.Equal(t, types.TypeMsgRefundAbortedXmsg, msg.Type())
+}
+
+func TestMsgRefundAbortedXmsg_Route(t *testing.T) {
+	msg := types.NewMsgRefundAbortedXmsg(sample.AccAddress(), "xmsg_index", "")
+	require.Equal(t, types.RouterKey, msg.Route())
+}
+
+func TestMsgRefundAbortedXmsg_GetSignBytes(t *testing.T) {
+	msg := types.NewMsgRefundAbortedXmsg(sample.AccAddress(), "xmsg_index", "")
+	require.NotPanics(t, func() {
+		msg.GetSignBytes()
+	})
+}
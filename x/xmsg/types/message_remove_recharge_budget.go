@@ -0,0 +1,53 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgRemoveRechargeBudget = "RemoveRechargeBudget"
+
+var _ sdk.Msg = &MsgRemoveRechargeBudget{}
+
+func NewMsgRemoveRechargeBudget(creator string, chainID int64, tokenType RechargeTokenType) *MsgRemoveRechargeBudget {
+	return &MsgRemoveRechargeBudget{
+		Signer:    creator,
+		ChainId:   chainID,
+		TokenType: tokenType,
+	}
+}
+
+func (msg *MsgRemoveRechargeBudget) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRemoveRechargeBudget) Type() string {
+	return TypeMsgRemoveRechargeBudget
+}
+
+func (msg *MsgRemoveRechargeBudget) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRemoveRechargeBudget) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRemoveRechargeBudget) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.ChainId <= 0 {
+		return errorsmod.Wrapf(ErrInvalidRechargeBudget, "invalid chain id %d", msg.ChainId)
+	}
+	if _, ok := RechargeTokenType_name[int32(msg.TokenType)]; !ok {
+		return errorsmod.Wrapf(ErrInvalidRechargeBudget, "invalid recharge token type %d", msg.TokenType)
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgUpdateRechargeBudget = "UpdateRechargeBudget"
+
+var _ sdk.Msg = &MsgUpdateRechargeBudget{}
+
+func NewMsgUpdateRechargeBudget(creator string, budget RechargeBudget) *MsgUpdateRechargeBudget {
+	return &MsgUpdateRechargeBudget{
+		Signer: creator,
+		Budget: budget,
+	}
+}
+
+func (msg *MsgUpdateRechargeBudget) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateRechargeBudget) Type() string {
+	return TypeMsgUpdateRechargeBudget
+}
+
+func (msg *MsgUpdateRechargeBudget) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpdateRechargeBudget) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateRechargeBudget) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := msg.Budget.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidRechargeBudget, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/testutil/sample"
+	"github.com/0xPellNetwork/aegis/x/xmsg/types"
+)
+
+func TestMsgUpdateRechargeBudget_ValidateBasic(t *testing.T) {
+	budget := types.RechargeBudget{ChainId: 1, TokenType: types.RechargeTokenType_PELL_TOKEN, Window: 100, MaxAmount: math.NewUint(1000)}
+
+	tests := []struct {
+		name string
+		msg  *types.MsgUpdateRechargeBudget
+		err  error
+	}{
+		{
+			name: "valid message",
+			msg:  types.NewMsgUpdateRechargeBudget(sample.AccAddress(), budget),
+		},
+		{
+			name: "invalid creator address",
+			msg:  types.NewMsgUpdateRechargeBudget("invalid", budget),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid budget",
+			msg: types.NewMsgUpdateRechargeBudget(sample.AccAddress(), types.RechargeBudget{
+				ChainId: 1,
+				Window:  -1,
+			}),
+			err: types.ErrInvalidRechargeBudget,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgRemoveRechargeBudget_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *types.MsgRemoveRechargeBudget
+		err  error
+	}{
+		{
+			name: "valid message",
+			msg:  types.NewMsgRemoveRechargeBudget(sample.AccAddress(), 1, types.RechargeTokenType_GAS_TOKEN),
+		},
+		{
+			name: "invalid creator address",
+			msg:  types.NewMsgRemoveRechargeBudget("invalid", 1, types.RechargeTokenType_GAS_TOKEN),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid chain id",
+			msg:  types.NewMsgRemoveRechargeBudget(sample.AccAddress(), 0, types.RechargeTokenType_GAS_TOKEN),
+			err:  types.ErrInvalidRechargeBudget,
+		},
+		{
+			name: "invalid token type",
+			msg:  types.NewMsgRemoveRechargeBudget(sample.AccAddress(), 1, 3),
+			err:  types.ErrInvalidRechargeBudget,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
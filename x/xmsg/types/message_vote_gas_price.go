@@ -10,11 +10,13 @@ import (
 
 var _ sdk.Msg = &MsgVoteGasPrice{}
 
-func NewMsgVoteGasPrice(creator string, chain int64, price uint64, supply string, blockNumber uint64) *MsgVoteGasPrice {
+func NewMsgVoteGasPrice(creator string, chain int64, price, priorityFee, baseFee uint64, supply string, blockNumber uint64) *MsgVoteGasPrice {
 	return &MsgVoteGasPrice{
 		Signer:      creator,
 		ChainId:     chain,
 		Price:       price,
+		PriorityFee: priorityFee,
+		BaseFee:     baseFee,
 		BlockNumber: blockNumber,
 		Supply:      supply,
 	}
@@ -49,5 +51,8 @@ func (msg *MsgVoteGasPrice) ValidateBasic() error {
 	if msg.ChainId < 0 {
 		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidChainID, "chain id (%d)", msg.ChainId)
 	}
+	if msg.PriorityFee > msg.Price {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidRequest, "priority fee (%d) is greater than gas price (%d)", msg.PriorityFee, msg.Price)
+	}
 	return nil
 }
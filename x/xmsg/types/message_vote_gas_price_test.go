@@ -24,6 +24,8 @@ func TestMsgVoteGasPrice_ValidateBasic(t *testing.T) {
 				"invalid",
 				1,
 				1,
+				0,
+				0,
 				"1000",
 				1,
 			),
@@ -35,17 +37,46 @@ func TestMsgVoteGasPrice_ValidateBasic(t *testing.T) {
 				sample.AccAddress(),
 				-1,
 				1,
+				0,
+				0,
 				"1000",
 				1,
 			),
 			err: sdkerrors.ErrInvalidChainID,
 		},
+		{
+			name: "priority fee greater than gas price",
+			msg: types.NewMsgVoteGasPrice(
+				sample.AccAddress(),
+				1,
+				1,
+				2,
+				0,
+				"1000",
+				1,
+			),
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "valid eip-1559 fees",
+			msg: types.NewMsgVoteGasPrice(
+				sample.AccAddress(),
+				1,
+				3,
+				1,
+				1,
+				"1000",
+				1,
+			),
+		},
 		{
 			name: "valid address",
 			msg: types.NewMsgVoteGasPrice(
 				sample.AccAddress(),
 				1,
 				1,
+				0,
+				0,
 				"1000",
 				1,
 			),
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"fmt"
+	"strings"
+
 	cosmoserrors "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -90,6 +93,17 @@ func (msg *MsgVoteOnObservedInboundTx) Digest() string {
 	return hash.Hex()
 }
 
+// InboundEventDigest returns the digest of the inbound pell event of a tx, independent of the position of
+// the event in the block. It identifies the inbounds processed through an inbound proof, whose event index
+// is not proven. An empty digest is returned if there is no pell event.
+func InboundEventDigest(chainID int64, inTxHash string, pellTx *InboundPellEvent) string {
+	if pellTx == nil {
+		return ""
+	}
+	hash := crypto.Keccak256Hash([]byte(fmt.Sprintf("%d-%s-%s", chainID, strings.ToLower(inTxHash), pellTx.String())))
+	return hash.Hex()
+}
+
 func (msg *MsgVoteOnObservedInboundTx) IsPellMsg() bool {
 	return msg.PellTx != nil && msg.PellTx.isAvailable()
 }
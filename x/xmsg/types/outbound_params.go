@@ -4,7 +4,7 @@ import (
 	"fmt"
 	"strconv"
 
-	"github.com/0xPellNetwork/aegis/pkg/chains"
+	"cosmossdk.io/math"
 )
 
 func (m OutboundTxParams) GetGasPrice() (uint64, error) {
@@ -16,11 +16,30 @@ func (m OutboundTxParams) GetGasPrice() (uint64, error) {
 	return gasPrice, nil
 }
 
+// GasFee returns the fee paid for the gas used by the mined outbound tx
+func (m OutboundTxParams) GasFee() math.Int {
+	if m.OutboundTxEffectiveGasPrice.IsNil() {
+		return math.ZeroInt()
+	}
+	return m.OutboundTxEffectiveGasPrice.Mul(math.NewIntFromUint64(m.OutboundTxGasUsed))
+}
+
+// SetGasFromGasPrice sets the gas price and the priority fee of the outbound tx from the voted gas price of the chain.
+// The priority fee is left empty for chains without EIP-1559 so the outbound tx is signed as a legacy tx.
+func (m *OutboundTxParams) SetGasFromGasPrice(gasPrice GasPrice) {
+	m.OutboundTxGasPrice = strconv.FormatUint(gasPrice.MaxFeePerGas(), 10)
+	m.OutboundTxGasPriorityFee = ""
+	if priorityFee := gasPrice.MedianPriorityFee(); priorityFee > 0 {
+		m.OutboundTxGasPriorityFee = strconv.FormatUint(priorityFee, 10)
+	}
+}
+
 func (m OutboundTxParams) Validate() error {
 	if m.Receiver == "" {
 		return fmt.Errorf("receiver cannot be empty")
 	}
-	if _, exist := chains.GetChainByChainId(m.ReceiverChainId); !exist {
+	// the chain existence is checked against the chain registry when the xmsg is processed
+	if m.ReceiverChainId <= 0 {
 		return fmt.Errorf("invalid receiver chain id %d", m.ReceiverChainId)
 	}
 
@@ -4,6 +4,7 @@ import (
 	"math/rand"
 	"testing"
 
+	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 
 	"github.com/0xPellNetwork/aegis/testutil/sample"
@@ -17,8 +18,8 @@ func TestOutboundTxParams_Validate(t *testing.T) {
 	require.ErrorContains(t, outTxParams.Validate(), "receiver cannot be empty")
 
 	outTxParams = sample.OutboundTxParamsValidChainID_pell(r)
-	outTxParams.ReceiverChainId = 1000
-	require.ErrorContains(t, outTxParams.Validate(), "invalid receiver chain id 1000")
+	outTxParams.ReceiverChainId = 0
+	require.ErrorContains(t, outTxParams.Validate(), "invalid receiver chain id 0")
 
 	outTxParams = sample.OutboundTxParamsValidChainID_pell(r)
 	outTxParams.OutboundTxBallotIndex = sample.PellIndex(t)
@@ -49,3 +50,16 @@ func TestOutboundTxParams_GetGasPrice(t *testing.T) {
 	_, err = outTxParams.GetGasPrice()
 	require.Error(t, err)
 }
+
+func TestOutboundTxParams_GasFee(t *testing.T) {
+	// #nosec G404 - random seed is not used for security purposes
+	r := rand.New(rand.NewSource(42))
+	outTxParams := sample.OutboundTxParams_pell(r)
+
+	outTxParams.OutboundTxGasUsed = 21000
+	outTxParams.OutboundTxEffectiveGasPrice = math.NewInt(10)
+	require.EqualValues(t, 210000, outTxParams.GasFee().Int64())
+
+	outTxParams.OutboundTxEffectiveGasPrice = math.Int{}
+	require.True(t, outTxParams.GasFee().IsZero())
+}
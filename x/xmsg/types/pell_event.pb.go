@@ -24,6 +24,47 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// type of the inbound pell event, the values match the fields of the pell data
+type InboundPellEventType int32
+
+const (
+	InboundPellEventType_INBOUND_EVENT_UNSPECIFIED                InboundPellEventType = 0
+	InboundPellEventType_INBOUND_EVENT_STAKER_DEPOSITED           InboundPellEventType = 1
+	InboundPellEventType_INBOUND_EVENT_STAKER_DELEGATED           InboundPellEventType = 2
+	InboundPellEventType_INBOUND_EVENT_PELL_SENT                  InboundPellEventType = 3
+	InboundPellEventType_INBOUND_EVENT_WITHDRAWAL_QUEUED          InboundPellEventType = 4
+	InboundPellEventType_INBOUND_EVENT_STAKER_UNDELEGATED         InboundPellEventType = 5
+	InboundPellEventType_INBOUND_EVENT_REGISTER_CHAIN_DVS_TO_PELL InboundPellEventType = 6
+)
+
+var InboundPellEventType_name = map[int32]string{
+	0: "INBOUND_EVENT_UNSPECIFIED",
+	1: "INBOUND_EVENT_STAKER_DEPOSITED",
+	2: "INBOUND_EVENT_STAKER_DELEGATED",
+	3: "INBOUND_EVENT_PELL_SENT",
+	4: "INBOUND_EVENT_WITHDRAWAL_QUEUED",
+	5: "INBOUND_EVENT_STAKER_UNDELEGATED",
+	6: "INBOUND_EVENT_REGISTER_CHAIN_DVS_TO_PELL",
+}
+
+var InboundPellEventType_value = map[string]int32{
+	"INBOUND_EVENT_UNSPECIFIED":                0,
+	"INBOUND_EVENT_STAKER_DEPOSITED":           1,
+	"INBOUND_EVENT_STAKER_DELEGATED":           2,
+	"INBOUND_EVENT_PELL_SENT":                  3,
+	"INBOUND_EVENT_WITHDRAWAL_QUEUED":          4,
+	"INBOUND_EVENT_STAKER_UNDELEGATED":         5,
+	"INBOUND_EVENT_REGISTER_CHAIN_DVS_TO_PELL": 6,
+}
+
+func (x InboundPellEventType) String() string {
+	return proto.EnumName(InboundPellEventType_name, int32(x))
+}
+
+func (InboundPellEventType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c08650de652ab101, []int{0}
+}
+
 // / @notice Emitted when @param staker deposit @param shares of @param token to
 // / @param strategy. event Deposit(address staker, IERC20 token, IStrategy
 // / strategy, uint256 shares);
@@ -442,7 +483,6 @@ type InboundPellEvent struct {
 	// oneof pell_data
 	//
 	// Types that are valid to be assigned to PellData:
-	//
 	//	*InboundPellEvent_StakerDeposited
 	//	*InboundPellEvent_StakerDelegated
 	//	*InboundPellEvent_PellSent
@@ -726,6 +766,7 @@ func (m *SignatureWithSaltAndExpiry) GetExpiry() uint64 {
 }
 
 func init() {
+	proto.RegisterEnum("xmsg.InboundPellEventType", InboundPellEventType_name, InboundPellEventType_value)
 	proto.RegisterType((*StakerDeposited)(nil), "xmsg.StakerDeposited")
 	proto.RegisterType((*StakerDelegated)(nil), "xmsg.StakerDelegated")
 	proto.RegisterType((*Withdrawal)(nil), "xmsg.Withdrawal")
@@ -740,67 +781,77 @@ func init() {
 func init() { proto.RegisterFile("xmsg/pell_event.proto", fileDescriptor_c08650de652ab101) }
 
 var fileDescriptor_c08650de652ab101 = []byte{
-	// 952 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xb6, 0x13, 0x37, 0xb1, 0x9f, 0xd3, 0xd8, 0x1e, 0x12, 0xd7, 0x35, 0x91, 0x13, 0xcc, 0x81,
-	0x42, 0x85, 0x5d, 0x19, 0xc1, 0x8d, 0x43, 0x43, 0xad, 0xba, 0x12, 0xd0, 0x32, 0x4e, 0x8b, 0xd4,
-	0xcb, 0x6a, 0xe2, 0x7d, 0xac, 0x97, 0xec, 0xce, 0x2c, 0x33, 0xe3, 0x1f, 0xf9, 0x2f, 0x90, 0xf8,
-	0x93, 0xb8, 0x54, 0x9c, 0x7a, 0x41, 0x42, 0x1c, 0x22, 0x94, 0xfc, 0x23, 0x68, 0x66, 0x67, 0xd7,
-	0xc6, 0x50, 0x54, 0xa9, 0x37, 0xbf, 0xef, 0xcd, 0x7c, 0x7e, 0xef, 0x7d, 0xdf, 0x9b, 0x85, 0xc3,
-	0x65, 0xac, 0x82, 0x7e, 0x82, 0x51, 0xe4, 0xe1, 0x1c, 0xb9, 0xee, 0x25, 0x52, 0x68, 0x41, 0x4a,
-	0x06, 0x6e, 0x1f, 0x04, 0x22, 0x10, 0x16, 0xe8, 0x9b, 0x5f, 0x69, 0xae, 0xfb, 0x4b, 0x11, 0x6a,
-	0x63, 0xcd, 0x2e, 0x50, 0x3e, 0xc2, 0x44, 0xa8, 0x50, 0xa3, 0x4f, 0x9a, 0xb0, 0xa3, 0x2c, 0xd4,
-	0x2a, 0x9e, 0x14, 0xef, 0x55, 0xa8, 0x8b, 0xc8, 0x01, 0xdc, 0xd2, 0xe2, 0x02, 0x79, 0x6b, 0xcb,
-	0xc2, 0x69, 0x40, 0xda, 0x50, 0x56, 0x5a, 0x32, 0x8d, 0xc1, 0x65, 0x6b, 0xdb, 0x26, 0xf2, 0x98,
-	0x7c, 0x01, 0x3b, 0x6a, 0xca, 0x24, 0xaa, 0x56, 0xc9, 0x64, 0x4e, 0x3b, 0xaf, 0xae, 0x8e, 0x0b,
-	0x7f, 0x5e, 0x1d, 0x37, 0x27, 0x42, 0xc5, 0x42, 0x29, 0xff, 0xa2, 0x17, 0x8a, 0x7e, 0xcc, 0xf4,
-	0xb4, 0xf7, 0x3c, 0xe4, 0x9a, 0xba, 0xd3, 0xdd, 0xe1, 0xaa, 0xa8, 0x08, 0x03, 0xf6, 0x7f, 0x45,
-	0xb5, 0xa1, 0x2c, 0x12, 0x94, 0x4c, 0x0b, 0xe9, 0xea, 0xca, 0xe3, 0xee, 0x55, 0x11, 0xe0, 0xfb,
-	0x50, 0x4f, 0x7d, 0xc9, 0x16, 0x2c, 0x7a, 0x23, 0xc5, 0x07, 0xb0, 0xe7, 0x67, 0xff, 0xe3, 0x69,
-	0xe1, 0x68, 0xaa, 0x39, 0x76, 0x26, 0x48, 0x07, 0x60, 0xe1, 0x88, 0x50, 0xba, 0x36, 0xd7, 0x10,
-	0x33, 0x1a, 0x2e, 0xf8, 0x04, 0xd3, 0x3e, 0x69, 0x1a, 0x90, 0x8f, 0xa0, 0xa6, 0x34, 0x93, 0xda,
-	0xd3, 0x61, 0x8c, 0x4a, 0xb3, 0x38, 0x69, 0xdd, 0x3a, 0x29, 0xde, 0xbb, 0x4d, 0xf7, 0x2d, 0x7c,
-	0x96, 0xa1, 0x86, 0xde, 0xcd, 0x2c, 0x44, 0xd5, 0xda, 0x39, 0xd9, 0x36, 0xf4, 0x2b, 0xc4, 0x56,
-	0x9e, 0xce, 0x71, 0xd7, 0xe6, 0xb2, 0x39, 0xc5, 0x50, 0x5f, 0xf5, 0xf7, 0xdd, 0x0c, 0x67, 0xe8,
-	0x9b, 0x3f, 0x5d, 0xe4, 0x98, 0x27, 0x85, 0xd0, 0xb6, 0xdd, 0x3d, 0xba, 0xbf, 0x82, 0xa9, 0x10,
-	0x9a, 0x3c, 0x58, 0xf5, 0xc4, 0x22, 0xdb, 0x74, 0x75, 0x50, 0xef, 0x19, 0xaf, 0xf4, 0x56, 0xa4,
-	0x74, 0xed, 0x4c, 0xf7, 0x31, 0x34, 0x52, 0x59, 0x9e, 0x73, 0xff, 0x9d, 0x84, 0xf9, 0x7d, 0x0b,
-	0xca, 0xcf, 0x30, 0x8a, 0xc6, 0xc8, 0x35, 0x79, 0x1f, 0x2a, 0x7a, 0xe9, 0x09, 0x19, 0x06, 0x21,
-	0x77, 0x1c, 0x65, 0xbd, 0x7c, 0x6a, 0x63, 0xcb, 0x8e, 0xdc, 0xc7, 0x8c, 0xc3, 0x45, 0xe4, 0x13,
-	0x68, 0x48, 0x9c, 0x60, 0x38, 0x47, 0xe9, 0x4d, 0xa6, 0x2c, 0xe4, 0x5e, 0xe8, 0x5b, 0x5d, 0xb6,
-	0x69, 0x2d, 0x4b, 0x7c, 0x65, 0xf0, 0x27, 0xbe, 0xa9, 0x24, 0x83, 0x9c, 0x3e, 0x79, 0x4c, 0x5a,
-	0xb0, 0x1b, 0xa3, 0x52, 0x2c, 0x40, 0x2b, 0x4d, 0x85, 0x66, 0x21, 0x39, 0x86, 0xaa, 0xdd, 0xa4,
-	0x84, 0x49, 0x16, 0x1b, 0x51, 0xac, 0xe6, 0x06, 0x7a, 0x66, 0x11, 0xf2, 0x25, 0xd8, 0xc8, 0x9b,
-	0xb3, 0x68, 0x86, 0xad, 0xdd, 0xb7, 0x32, 0x78, 0xc5, 0xdc, 0x78, 0x61, 0x2e, 0x10, 0x0a, 0x87,
-	0x3e, 0x2a, 0x1d, 0x72, 0xa6, 0x43, 0xc1, 0xbd, 0x80, 0x29, 0x2f, 0x0a, 0xe3, 0x50, 0xb7, 0xca,
-	0x6f, 0xc5, 0xf4, 0xde, 0xda, 0xe5, 0xc7, 0x4c, 0x7d, 0x6d, 0xae, 0x76, 0x7f, 0xdd, 0x86, 0xfa,
-	0x13, 0x7e, 0x2e, 0x66, 0xdc, 0x37, 0xe3, 0x1d, 0x9a, 0x47, 0x80, 0x9c, 0x42, 0x3d, 0x95, 0xc4,
-	0xf3, 0xb3, 0x15, 0xb7, 0x63, 0xae, 0x0e, 0x0e, 0x53, 0xb5, 0x37, 0xf6, 0x7f, 0x54, 0xa0, 0x35,
-	0xb5, 0xf1, 0x24, 0xac, 0x73, 0x38, 0xe1, 0x9d, 0x63, 0x36, 0x38, 0x5c, 0x72, 0x9d, 0x23, 0x33,
-	0xca, 0xa7, 0x60, 0xbb, 0xf7, 0x14, 0x72, 0x6d, 0xa5, 0xaa, 0x0e, 0xf6, 0xd3, 0xcb, 0x99, 0x15,
-	0x46, 0x05, 0x5a, 0x4e, 0x32, 0x5b, 0x0c, 0xa1, 0xb1, 0xe6, 0xe3, 0x9f, 0xac, 0xb9, 0xad, 0x7c,
-	0xd5, 0x41, 0x73, 0xd3, 0xa5, 0xa9, 0xf5, 0x47, 0x05, 0x5a, 0x5f, 0x6c, 0xae, 0xc3, 0x08, 0x88,
-	0xab, 0x7c, 0xb6, 0x32, 0xad, 0xd5, 0xba, 0x3a, 0xb8, 0xb3, 0x5e, 0xfb, 0x9a, 0xa7, 0x47, 0x05,
-	0xda, 0x50, 0xff, 0x32, 0xfa, 0x4b, 0x68, 0x4b, 0x0c, 0x42, 0xa5, 0x73, 0xcb, 0xf9, 0x73, 0xe5,
-	0x69, 0xe1, 0x99, 0x92, 0xad, 0x3f, 0xaa, 0x83, 0xa3, 0x94, 0x91, 0xba, 0x73, 0xd6, 0x81, 0x8f,
-	0x5e, 0x8c, 0xcf, 0x84, 0x69, 0x71, 0x54, 0xa0, 0x4d, 0xf9, 0x8f, 0xcc, 0x5c, 0xa5, 0x99, 0xd3,
-	0xaa, 0x9b, 0x8d, 0xcf, 0x34, 0xeb, 0xfe, 0xb6, 0x05, 0xcd, 0xff, 0x66, 0x20, 0x77, 0xa1, 0x9c,
-	0xbb, 0xdd, 0x68, 0x58, 0xa2, 0xbb, 0x13, 0xe7, 0xf2, 0xcf, 0xe1, 0x4e, 0x4a, 0x2e, 0x2f, 0x3d,
-	0x29, 0x66, 0xa6, 0x4a, 0xc1, 0xd3, 0xda, 0xd2, 0xd5, 0x39, 0xc8, 0xd2, 0xd4, 0x66, 0x9f, 0x72,
-	0xcb, 0x78, 0x1f, 0x1a, 0x13, 0xe4, 0x5a, 0xb2, 0xc8, 0x53, 0x93, 0x29, 0xfa, 0xb3, 0x28, 0x7f,
-	0xe0, 0xea, 0x2e, 0x31, 0xce, 0x70, 0xc2, 0xe0, 0xc8, 0xf4, 0x9c, 0x96, 0xc0, 0x92, 0x44, 0x0a,
-	0xb3, 0x7f, 0x2a, 0x0c, 0x38, 0xd3, 0x33, 0x89, 0x4e, 0x9e, 0x13, 0x37, 0xd6, 0x0c, 0x36, 0x3a,
-	0x8d, 0x59, 0xa4, 0x1f, 0x72, 0x7f, 0xb8, 0x4c, 0x42, 0x79, 0x49, 0xef, 0xfa, 0x73, 0x65, 0x3b,
-	0x7b, 0xe8, 0x38, 0xf2, 0xb3, 0xe4, 0x63, 0xa8, 0xe3, 0x8f, 0x38, 0xb1, 0x3b, 0x11, 0x33, 0xce,
-	0x02, 0x94, 0x6e, 0x33, 0x6b, 0x19, 0xfe, 0x4d, 0x0a, 0x93, 0x0f, 0xe1, 0xb6, 0x55, 0x29, 0x3f,
-	0x97, 0xee, 0xe8, 0x9e, 0x05, 0xdd, 0xa1, 0xee, 0x0f, 0xd0, 0x7e, 0x73, 0x21, 0xe4, 0x08, 0x2a,
-	0xab, 0xea, 0xd3, 0x67, 0x72, 0x05, 0x10, 0x02, 0x25, 0xc5, 0x22, 0x6d, 0xe7, 0xb7, 0x47, 0xed,
-	0x6f, 0xf3, 0x20, 0xa1, 0xbd, 0x6b, 0x87, 0x54, 0xa2, 0x2e, 0x3a, 0x1d, 0xbe, 0xba, 0xee, 0x14,
-	0x5f, 0x5f, 0x77, 0x8a, 0x7f, 0x5d, 0x77, 0x8a, 0x3f, 0xdf, 0x74, 0x0a, 0xaf, 0x6f, 0x3a, 0x85,
-	0x3f, 0x6e, 0x3a, 0x85, 0x97, 0xf7, 0x83, 0x50, 0x4f, 0x67, 0xe7, 0xbd, 0x89, 0x88, 0xfb, 0x0f,
-	0x96, 0x66, 0xe8, 0xdf, 0xa2, 0x5e, 0x08, 0x79, 0xd1, 0x67, 0x46, 0x90, 0xfe, 0xb2, 0x6f, 0x3f,
-	0xdb, 0xfa, 0x32, 0x41, 0x75, 0xbe, 0x63, 0x3f, 0xcb, 0x9f, 0xfd, 0x1d, 0x00, 0x00, 0xff, 0xff,
-	0x12, 0x66, 0xac, 0xc4, 0xcb, 0x07, 0x00, 0x00,
+	// 1107 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xb7, 0x1d, 0x37, 0x71, 0xc6, 0x69, 0xb3, 0x19, 0xd2, 0xc4, 0x71, 0x83, 0x13, 0x5c, 0x24,
+	0x42, 0x0b, 0x76, 0x65, 0x04, 0x37, 0x0e, 0x4e, 0xbc, 0xc4, 0x16, 0xc1, 0x49, 0xc7, 0x76, 0x22,
+	0xf5, 0x32, 0x9a, 0x78, 0x87, 0xf5, 0x92, 0xdd, 0x9d, 0x65, 0x66, 0xec, 0x38, 0xdf, 0x02, 0x89,
+	0x13, 0x77, 0x0e, 0x7c, 0x0f, 0x2e, 0x15, 0xa7, 0x5e, 0x90, 0x10, 0x87, 0x08, 0x25, 0x5f, 0x04,
+	0xcd, 0xec, 0xac, 0xff, 0x84, 0xb4, 0xaa, 0xc4, 0x6d, 0xdf, 0xef, 0xbd, 0xf9, 0xcd, 0xfb, 0xf3,
+	0x7b, 0xa3, 0x05, 0x8f, 0xc7, 0x81, 0x70, 0xab, 0x11, 0xf5, 0x7d, 0x4c, 0x47, 0x34, 0x94, 0x95,
+	0x88, 0x33, 0xc9, 0x60, 0x56, 0xc1, 0xc5, 0x75, 0x97, 0xb9, 0x4c, 0x03, 0x55, 0xf5, 0x15, 0xfb,
+	0xca, 0x3f, 0xa7, 0xc1, 0x6a, 0x47, 0x92, 0x0b, 0xca, 0x1b, 0x34, 0x62, 0xc2, 0x93, 0xd4, 0x81,
+	0x1b, 0x60, 0x51, 0x68, 0xa8, 0x90, 0xde, 0x4d, 0xef, 0x2d, 0x23, 0x63, 0xc1, 0x75, 0xf0, 0x40,
+	0xb2, 0x0b, 0x1a, 0x16, 0x32, 0x1a, 0x8e, 0x0d, 0x58, 0x04, 0x39, 0x21, 0x39, 0x91, 0xd4, 0xbd,
+	0x2a, 0x2c, 0x68, 0xc7, 0xc4, 0x86, 0x5f, 0x81, 0x45, 0x31, 0x20, 0x9c, 0x8a, 0x42, 0x56, 0x79,
+	0xf6, 0x4b, 0xaf, 0xaf, 0x77, 0x52, 0x7f, 0x5f, 0xef, 0x6c, 0xf4, 0x99, 0x08, 0x98, 0x10, 0xce,
+	0x45, 0xc5, 0x63, 0xd5, 0x80, 0xc8, 0x41, 0xa5, 0xe7, 0x85, 0x12, 0x99, 0xe8, 0xb2, 0x3d, 0x4d,
+	0xca, 0xa7, 0x2e, 0x79, 0x57, 0x52, 0x45, 0x90, 0x63, 0x11, 0xe5, 0x44, 0x32, 0x6e, 0xf2, 0x9a,
+	0xd8, 0xe5, 0xeb, 0x34, 0x00, 0x67, 0x9e, 0x1c, 0x38, 0x9c, 0x5c, 0x12, 0xff, 0xad, 0x14, 0x1f,
+	0x81, 0x15, 0x27, 0xb9, 0x07, 0x4b, 0x66, 0x68, 0xf2, 0x13, 0xac, 0xcb, 0x60, 0x09, 0x80, 0x4b,
+	0x43, 0x44, 0xb9, 0x29, 0x73, 0x06, 0x51, 0xad, 0x09, 0x59, 0xd8, 0xa7, 0x71, 0x9d, 0x28, 0x36,
+	0xe0, 0x27, 0x60, 0x55, 0x48, 0xc2, 0x25, 0x96, 0x5e, 0x40, 0x85, 0x24, 0x41, 0x54, 0x78, 0xb0,
+	0x9b, 0xde, 0x7b, 0x88, 0x1e, 0x69, 0xb8, 0x9b, 0xa0, 0x8a, 0xde, 0xf4, 0xcc, 0xa3, 0xa2, 0xb0,
+	0xb8, 0xbb, 0xa0, 0xe8, 0xa7, 0x88, 0xce, 0x3c, 0xee, 0xe3, 0x92, 0xf6, 0x25, 0x7d, 0x0a, 0x80,
+	0x35, 0xad, 0xef, 0xe5, 0x90, 0x0e, 0xa9, 0xa3, 0x2e, 0xbd, 0x9c, 0x60, 0x98, 0x33, 0x26, 0x75,
+	0xb9, 0x2b, 0xe8, 0xd1, 0x14, 0x46, 0x8c, 0x49, 0xf8, 0x62, 0x5a, 0x13, 0xf1, 0x75, 0xd1, 0xf9,
+	0x9a, 0x55, 0x51, 0x5a, 0xa9, 0x4c, 0x49, 0xd1, 0x4c, 0x4c, 0xf9, 0x10, 0xac, 0xc5, 0x63, 0xe9,
+	0x85, 0xce, 0xff, 0x1a, 0xcc, 0x9f, 0x19, 0x90, 0x3b, 0xa1, 0xbe, 0xdf, 0xa1, 0xa1, 0x84, 0x4f,
+	0xc0, 0xb2, 0x1c, 0x63, 0xc6, 0x3d, 0xd7, 0x0b, 0x0d, 0x47, 0x4e, 0x8e, 0x8f, 0xb5, 0xad, 0xd9,
+	0x69, 0xe8, 0xd0, 0x84, 0xc3, 0x58, 0xf0, 0x19, 0x58, 0xe3, 0xb4, 0x4f, 0xbd, 0x11, 0xe5, 0xb8,
+	0x3f, 0x20, 0x5e, 0x88, 0x3d, 0x47, 0xcf, 0x65, 0x01, 0xad, 0x26, 0x8e, 0x03, 0x85, 0xb7, 0x1c,
+	0x95, 0x49, 0x02, 0x99, 0xf9, 0x4c, 0x6c, 0x58, 0x00, 0x4b, 0x01, 0x15, 0x82, 0xb8, 0x54, 0x8f,
+	0x66, 0x19, 0x25, 0x26, 0xdc, 0x01, 0x79, 0xbd, 0x49, 0x11, 0xe1, 0x24, 0x50, 0x43, 0xd1, 0x33,
+	0x57, 0xd0, 0x89, 0x46, 0xe0, 0xd7, 0x40, 0x5b, 0x78, 0x44, 0xfc, 0x21, 0x2d, 0x2c, 0xbd, 0x97,
+	0xc0, 0x97, 0xd5, 0x89, 0x53, 0x75, 0x00, 0x22, 0xf0, 0xd8, 0xa1, 0x42, 0x7a, 0x21, 0x91, 0x1e,
+	0x0b, 0xb1, 0x4b, 0x04, 0xf6, 0xbd, 0xc0, 0x93, 0x85, 0xdc, 0x7b, 0x31, 0x7d, 0x30, 0x73, 0xf8,
+	0x90, 0x88, 0x23, 0x75, 0xb4, 0xfc, 0xfb, 0x02, 0xb0, 0x5a, 0xe1, 0x39, 0x1b, 0x86, 0x8e, 0x6a,
+	0xaf, 0xad, 0x1e, 0x01, 0xb8, 0x0f, 0xac, 0x78, 0x24, 0xd8, 0x49, 0x56, 0x5c, 0xb7, 0x39, 0x5f,
+	0x7b, 0x1c, 0x4f, 0xfb, 0xce, 0xfe, 0x37, 0x53, 0x68, 0x55, 0xcc, 0x43, 0x73, 0x1c, 0x66, 0xf0,
+	0x85, 0xcc, 0x7d, 0x1c, 0xc6, 0x39, 0xcb, 0x91, 0x08, 0xe5, 0x73, 0xa0, 0xab, 0xc7, 0x82, 0x86,
+	0x52, 0x8f, 0x2a, 0x5f, 0x7b, 0x14, 0x1f, 0x4e, 0xa4, 0xd0, 0x4c, 0xa1, 0x5c, 0x64, 0xbe, 0xa1,
+	0x0d, 0xd6, 0x66, 0x74, 0xfc, 0xa3, 0x16, 0xb7, 0x1e, 0x5f, 0xbe, 0xb6, 0x71, 0x57, 0xa5, 0xb1,
+	0xf4, 0x9b, 0x29, 0x64, 0x5d, 0xde, 0x5d, 0x87, 0x26, 0x80, 0x26, 0xf3, 0xe1, 0x54, 0xb4, 0x7a,
+	0xd6, 0xf9, 0xda, 0xe6, 0x6c, 0xee, 0x33, 0x9a, 0x6e, 0xa6, 0xd0, 0x9a, 0xf8, 0x8f, 0xd0, 0x5f,
+	0x81, 0x22, 0xa7, 0xae, 0x27, 0xe4, 0x44, 0x72, 0xce, 0x48, 0x60, 0xc9, 0xb0, 0x4a, 0x59, 0xeb,
+	0x23, 0x5f, 0xdb, 0x8e, 0x19, 0x91, 0x89, 0xd3, 0x0a, 0x6c, 0x9c, 0x76, 0xba, 0x4c, 0x95, 0xd8,
+	0x4c, 0xa1, 0x0d, 0x3e, 0xe7, 0x19, 0x89, 0xd8, 0xb3, 0x9f, 0x37, 0xbd, 0x71, 0x88, 0x24, 0xe5,
+	0x3f, 0x32, 0x60, 0xe3, 0x7e, 0x06, 0xb8, 0x05, 0x72, 0x13, 0xb5, 0xab, 0x19, 0x66, 0xd1, 0x52,
+	0xdf, 0xa8, 0xfc, 0x4b, 0xb0, 0x19, 0x93, 0xf3, 0x2b, 0xcc, 0xd9, 0x50, 0x65, 0xc9, 0xc2, 0x38,
+	0xb7, 0x78, 0x75, 0xd6, 0x13, 0x37, 0xd2, 0xde, 0xe3, 0x50, 0x33, 0x3e, 0x07, 0x6b, 0x7d, 0x1a,
+	0x4a, 0x4e, 0x7c, 0x2c, 0xfa, 0x03, 0xea, 0x0c, 0xfd, 0xc9, 0x03, 0x67, 0x19, 0x47, 0x27, 0xc1,
+	0x21, 0x01, 0xdb, 0xaa, 0xe6, 0x38, 0x05, 0x12, 0x45, 0x9c, 0xa9, 0xfd, 0x13, 0x9e, 0x1b, 0x12,
+	0x39, 0xe4, 0xd4, 0x8c, 0x67, 0xd7, 0xb4, 0x35, 0x81, 0xd5, 0x9c, 0x3a, 0xc4, 0x97, 0xf5, 0xd0,
+	0xb1, 0xc7, 0x91, 0xc7, 0xaf, 0xd0, 0x96, 0x33, 0x12, 0xba, 0xb2, 0xba, 0xe1, 0x98, 0xc4, 0xc2,
+	0x4f, 0x81, 0x45, 0x7f, 0xa0, 0x7d, 0xbd, 0x13, 0x01, 0x09, 0x89, 0x4b, 0xb9, 0xd9, 0xcc, 0xd5,
+	0x04, 0xff, 0x2e, 0x86, 0xe1, 0x53, 0xf0, 0x50, 0x4f, 0x69, 0x12, 0x17, 0xef, 0xe8, 0x8a, 0x06,
+	0x4d, 0x50, 0xf9, 0x7b, 0x50, 0x7c, 0x7b, 0x22, 0x70, 0x1b, 0x2c, 0x4f, 0xb3, 0x8f, 0x9f, 0xc9,
+	0x29, 0x00, 0x21, 0xc8, 0x0a, 0xe2, 0x4b, 0xdd, 0xbf, 0x15, 0xa4, 0xbf, 0xd5, 0x83, 0x44, 0xf5,
+	0x59, 0xdd, 0xa4, 0x2c, 0x32, 0xd6, 0xb3, 0x5f, 0x32, 0x60, 0xfd, 0xee, 0xea, 0x75, 0xaf, 0x22,
+	0x0a, 0x3f, 0x04, 0x5b, 0xad, 0xf6, 0xfe, 0x71, 0xaf, 0xdd, 0xc0, 0xf6, 0xa9, 0xdd, 0xee, 0xe2,
+	0x5e, 0xbb, 0x73, 0x62, 0x1f, 0xb4, 0xbe, 0x69, 0xd9, 0x0d, 0x2b, 0x05, 0xcb, 0xa0, 0x34, 0xef,
+	0xee, 0x74, 0xeb, 0xdf, 0xda, 0x08, 0x37, 0xec, 0x93, 0xe3, 0x4e, 0xab, 0x6b, 0x37, 0xac, 0xf4,
+	0x3b, 0x62, 0x8e, 0xec, 0xc3, 0xba, 0x8a, 0xc9, 0xc0, 0x27, 0x60, 0x73, 0x3e, 0xe6, 0xc4, 0x3e,
+	0x3a, 0xc2, 0x1d, 0xbb, 0xdd, 0xb5, 0x16, 0xe0, 0x53, 0xb0, 0x33, 0xef, 0x3c, 0x6b, 0x75, 0x9b,
+	0x0d, 0x54, 0x3f, 0xab, 0x1f, 0xe1, 0x97, 0x3d, 0xbb, 0x67, 0x37, 0xac, 0x2c, 0xfc, 0x18, 0xec,
+	0xde, 0x7b, 0x4b, 0xaf, 0x3d, 0xbd, 0xe7, 0x01, 0xfc, 0x0c, 0xec, 0xcd, 0x47, 0x21, 0xfb, 0xb0,
+	0xd5, 0xe9, 0xda, 0x08, 0x1f, 0x34, 0xeb, 0xad, 0x36, 0x6e, 0x9c, 0x76, 0x70, 0xf7, 0x58, 0xdf,
+	0x6e, 0x2d, 0x16, 0xb3, 0xbf, 0xfd, 0x5a, 0x4a, 0xef, 0xdb, 0xaf, 0x6f, 0x4a, 0xe9, 0x37, 0x37,
+	0xa5, 0xf4, 0x3f, 0x37, 0xa5, 0xf4, 0x4f, 0xb7, 0xa5, 0xd4, 0x9b, 0xdb, 0x52, 0xea, 0xaf, 0xdb,
+	0x52, 0xea, 0xd5, 0x73, 0xd7, 0x93, 0x83, 0xe1, 0x79, 0xa5, 0xcf, 0x82, 0xea, 0x8b, 0xb1, 0x6a,
+	0x5c, 0x9b, 0xca, 0x4b, 0xc6, 0x2f, 0xaa, 0x44, 0x89, 0xb5, 0x3a, 0xae, 0xea, 0x5f, 0x1a, 0x79,
+	0x15, 0x51, 0x71, 0xbe, 0xa8, 0x7f, 0x59, 0xbe, 0xf8, 0x77, 0x00, 0xc2, 0x7a, 0x51, 0x6b, 0xe7,
+	0x08, 0x00, 0x00,
 }
 
 func (m *StakerDeposited) Marshal() (dAtA []byte, err error) {
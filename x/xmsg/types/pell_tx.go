@@ -1,5 +1,9 @@
 package types
 
+import (
+	"cosmossdk.io/math"
+)
+
 func (p *InboundPellEvent) isAvailable() bool {
 	rc := true
 
@@ -16,3 +20,14 @@ func (p *InboundPellEvent) isAvailable() bool {
 
 	return rc
 }
+
+// RemainingValue returns the value of the PellSent left after paying the fee, zero if the fee exceeds the value
+func (m PellSent) RemainingValue(fee math.Int) math.Uint {
+	if m.PellValue.IsNil() || !fee.LT(math.NewIntFromBigInt(m.PellValue.BigInt())) {
+		return math.ZeroUint()
+	}
+	if fee.IsNegative() {
+		return m.PellValue
+	}
+	return m.PellValue.Sub(math.NewUintFromBigInt(fee.BigInt()))
+}
@@ -0,0 +1,28 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/x/xmsg/types"
+)
+
+func TestPellSent_RemainingValue(t *testing.T) {
+	t.Run("should subtract the fee from the value", func(t *testing.T) {
+		pellSent := types.PellSent{PellValue: math.NewUint(100)}
+		require.Equal(t, math.NewUint(58), pellSent.RemainingValue(math.NewInt(42)))
+	})
+
+	t.Run("should return zero if the fee exceeds the value", func(t *testing.T) {
+		pellSent := types.PellSent{PellValue: math.NewUint(100)}
+		require.True(t, pellSent.RemainingValue(math.NewInt(101)).IsZero())
+		require.True(t, pellSent.RemainingValue(math.NewInt(100)).IsZero())
+	})
+
+	t.Run("should return zero without value", func(t *testing.T) {
+		pellSent := types.PellSent{}
+		require.True(t, pellSent.RemainingValue(math.ZeroInt()).IsZero())
+	})
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -210,6 +211,143 @@ func (m *QueryCrosschainFeeParamByChainIdResponse) GetCrosschainFeeParam() *Cros
 	return nil
 }
 
+// QueryCrosschainFeeQuoteRequest is the request type for the
+// Query/CrosschainFeeQuote.
+type QueryCrosschainFeeQuoteRequest struct {
+	// chain the event is sent from, it is validated but doesn't affect the quote
+	// since the fees only depend on the destination chain
+	SourceChainId      int64                  `protobuf:"varint,1,opt,name=source_chain_id,json=sourceChainId,proto3" json:"source_chain_id,omitempty"`
+	DestinationChainId int64                  `protobuf:"varint,2,opt,name=destination_chain_id,json=destinationChainId,proto3" json:"destination_chain_id,omitempty"`
+	EventType          CrosschainFeeEventType `protobuf:"varint,3,opt,name=event_type,json=eventType,proto3,enum=xmsg.CrosschainFeeEventType" json:"event_type,omitempty"`
+	// gas limit of the outbound tx, defaults to the gas limit of the destination
+	// chain params
+	DestinationGasLimit uint64 `protobuf:"varint,4,opt,name=destination_gas_limit,json=destinationGasLimit,proto3" json:"destination_gas_limit,omitempty"`
+}
+
+func (m *QueryCrosschainFeeQuoteRequest) Reset()         { *m = QueryCrosschainFeeQuoteRequest{} }
+func (m *QueryCrosschainFeeQuoteRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCrosschainFeeQuoteRequest) ProtoMessage()    {}
+func (*QueryCrosschainFeeQuoteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cab451d68199ead4, []int{4}
+}
+func (m *QueryCrosschainFeeQuoteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCrosschainFeeQuoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCrosschainFeeQuoteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCrosschainFeeQuoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCrosschainFeeQuoteRequest.Merge(m, src)
+}
+func (m *QueryCrosschainFeeQuoteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCrosschainFeeQuoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCrosschainFeeQuoteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCrosschainFeeQuoteRequest proto.InternalMessageInfo
+
+func (m *QueryCrosschainFeeQuoteRequest) GetSourceChainId() int64 {
+	if m != nil {
+		return m.SourceChainId
+	}
+	return 0
+}
+
+func (m *QueryCrosschainFeeQuoteRequest) GetDestinationChainId() int64 {
+	if m != nil {
+		return m.DestinationChainId
+	}
+	return 0
+}
+
+func (m *QueryCrosschainFeeQuoteRequest) GetEventType() CrosschainFeeEventType {
+	if m != nil {
+		return m.EventType
+	}
+	return CrosschainFeeEventType_CROSSCHAIN_FEE_EVENT_UNSPECIFIED
+}
+
+func (m *QueryCrosschainFeeQuoteRequest) GetDestinationGasLimit() uint64 {
+	if m != nil {
+		return m.DestinationGasLimit
+	}
+	return 0
+}
+
+// QueryCrosschainFeeQuoteResponse is the response type for the
+// Query/CrosschainFeeQuote.
+type QueryCrosschainFeeQuoteResponse struct {
+	// fee charged in apell from the crosschain fee param of the destination chain
+	ProtocolFee cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=protocol_fee,json=protocolFee,proto3,customtype=cosmossdk.io/math.Int" json:"protocol_fee"`
+	GasLimit    uint64                `protobuf:"varint,2,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// max fee per gas of the destination chain at the current median gas price
+	GasPrice    cosmossdk_io_math.Uint `protobuf:"bytes,3,opt,name=gas_price,json=gasPrice,proto3,customtype=cosmossdk.io/math.Uint" json:"gas_price"`
+	PriorityFee cosmossdk_io_math.Uint `protobuf:"bytes,4,opt,name=priority_fee,json=priorityFee,proto3,customtype=cosmossdk.io/math.Uint" json:"priority_fee"`
+	// gas fee of the outbound tx in the gas token of the destination chain
+	GasFee cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=gas_fee,json=gasFee,proto3,customtype=cosmossdk.io/math.Int" json:"gas_fee"`
+	// margin covering the gas price moves until the outbound tx is signed
+	SafetyMargin cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=safety_margin,json=safetyMargin,proto3,customtype=cosmossdk.io/math.Int" json:"safety_margin"`
+	// gas fee plus the safety margin
+	TotalGasFee cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=total_gas_fee,json=totalGasFee,proto3,customtype=cosmossdk.io/math.Int" json:"total_gas_fee"`
+	// total gas fee converted to apell with the gas token pell rate of the
+	// destination chain params
+	TotalGasFeeApell cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=total_gas_fee_apell,json=totalGasFeeApell,proto3,customtype=cosmossdk.io/math.Int" json:"total_gas_fee_apell"`
+	// fee charged in apell for the event: the protocol fee plus the total gas
+	// fee in apell
+	TotalFee cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=total_fee,json=totalFee,proto3,customtype=cosmossdk.io/math.Int" json:"total_fee"`
+}
+
+func (m *QueryCrosschainFeeQuoteResponse) Reset()         { *m = QueryCrosschainFeeQuoteResponse{} }
+func (m *QueryCrosschainFeeQuoteResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCrosschainFeeQuoteResponse) ProtoMessage()    {}
+func (*QueryCrosschainFeeQuoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cab451d68199ead4, []int{5}
+}
+func (m *QueryCrosschainFeeQuoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCrosschainFeeQuoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCrosschainFeeQuoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCrosschainFeeQuoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCrosschainFeeQuoteResponse.Merge(m, src)
+}
+func (m *QueryCrosschainFeeQuoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCrosschainFeeQuoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCrosschainFeeQuoteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCrosschainFeeQuoteResponse proto.InternalMessageInfo
+
+func (m *QueryCrosschainFeeQuoteResponse) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
 // QueryListXmsgBuildersRequest is an empty message used to request the list of
 // Xmsg builders
 type QueryListAllowedXmsgSendersRequest struct {
@@ -219,7 +357,7 @@ func (m *QueryListAllowedXmsgSendersRequest) Reset()         { *m = QueryListAll
 func (m *QueryListAllowedXmsgSendersRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryListAllowedXmsgSendersRequest) ProtoMessage()    {}
 func (*QueryListAllowedXmsgSendersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cab451d68199ead4, []int{4}
+	return fileDescriptor_cab451d68199ead4, []int{6}
 }
 func (m *QueryListAllowedXmsgSendersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -259,7 +397,7 @@ func (m *QueryListAllowedXmsgSendersResponse) Reset()         { *m = QueryListAl
 func (m *QueryListAllowedXmsgSendersResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryListAllowedXmsgSendersResponse) ProtoMessage()    {}
 func (*QueryListAllowedXmsgSendersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cab451d68199ead4, []int{5}
+	return fileDescriptor_cab451d68199ead4, []int{7}
 }
 func (m *QueryListAllowedXmsgSendersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -305,7 +443,7 @@ func (m *QueryGetOutTxTrackerRequest) Reset()         { *m = QueryGetOutTxTracke
 func (m *QueryGetOutTxTrackerRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetOutTxTrackerRequest) ProtoMessage()    {}
 func (*QueryGetOutTxTrackerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cab451d68199ead4, []int{6}
+	return fileDescriptor_cab451d68199ead4, []int{8}
 }
 func (m *QueryGetOutTxTrackerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -357,7 +495,7 @@ func (m *QueryOutTxTrackerResponse) Reset()         { *m = QueryOutTxTrackerResp
 func (m *QueryOutTxTrackerResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryOutTxTrackerResponse) ProtoMessage()    {}
 func (*QueryOutTxTrackerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cab451d68199ead4, []int{7}
+	return fileDescriptor_cab451d68199ead4, []int{9}
 }
 func (m *QueryOutTxTrackerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -402,7 +540,7 @@ func (m *QueryAllOutTxTrackerRequest) Reset()         { *m = QueryAllOutTxTracke
 func (m *QueryAllOutTxTrackerRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllOutTxTrackerRequest) ProtoMessage()    {}
 func (*QueryAllOutTxTrackerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cab451d68199ead4, []int{8}
+	return fileDescriptor_cab451d68199ead4, []int{10}
 }
 func (m *QueryAllOutTxTrackerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -448,7 +586,7 @@ func (m *QueryOutTxTrackerAllResponse) Reset()         { *m = QueryOutTxTrackerA
 func (m *QueryOutTxTrackerAllResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryOutTxTrackerAllResponse) ProtoMessage()    {}
 func (*QueryOutTxTrackerAllResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cab451d68199ead4, []int{9}
+	return fileDescriptor_cab451d68199ead4, []int{11}
 }
 func (m *QueryOutTxTrackerAllResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -501,7 +639,7 @@ func (m *QueryAllOutTxTrackerByChainRequest) Reset()         { *m = QueryAllOutT
 func (m *QueryAllOutTxTrackerByChainRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllOutTxTrackerByChainRequest) ProtoMessage()    {}
 func (*QueryAllOutTxTrackerByChainRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cab451d68199ead4, []int{10}
+	return fileDescriptor_cab451d68199ead4, []int{12}
 }
 func (m *QueryAllOutTxTrackerByChainRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -554,7 +692,7 @@ func (m *QueryOutTxTrackerAllByChainResponse) Reset()         { *m = QueryOutTxT
 func (m *QueryOutTxTrackerAllByChainResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryOutTxTrackerAllByChainResponse) ProtoMessage()    {}
 func (*QueryOutTxTrackerAllByChainResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cab451d68199ead4, []int{11}
+	return fileDescriptor_cab451d68199ead4, []int{13}
 }
 func (m *QueryOutTxTrackerAllByChainResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -607,7 +745,7 @@ func (m *QueryAllInTxTrackerByChainRequest) Reset()         { *m = QueryAllInTxT
 func (m *QueryAllInTxTrackerByChainRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllInTxTrackerByChainRequest) ProtoMessage()    {}
 func (*QueryAllInTxTrackerByChainRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cab451d68199ead4, []int{12}
+	return fileDescriptor_cab451d68199ead4, []int{14}
 }
 func (m *QueryAllInTxTrackerByChainRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -660,7 +798,7 @@ func (m *QueryInTxTrackerAllByChainResponse) Reset()         { *m = QueryInTxTra
 func (m *QueryInTxTrackerAllByChainResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryInTxTrackerAllByChainResponse) ProtoMessage()    {}
 func (*QueryInTxTrackerAllByChainResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cab451d68199ead4, []int{13}
+	return fileDescriptor_cab451d68199ead4, []int{15}
 }
 func (m *QueryInTxTrackerAllByChainResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -712,7 +850,7 @@ func (m *QueryAllInTxTrackersRequest) Reset()         { *m = QueryAllInTxTracker
 func (m *QueryAllInTxTrackersRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllInTxTrackersRequest) ProtoMessage()    {}
 func (*QueryAllInTxTrackersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cab451d68199ead4, []int{14}
+	return fileDescriptor_cab451d68199ead4, []int{16}
 }
 func (m *QueryAllInTxTrackersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -758,7 +896,7 @@ func (m *QueryInTxTrackerAllResponse) Reset()         { *m = QueryInTxTrackerAll
 func (m *QueryInTxTrackerAllResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryInTxTrackerAllResponse) ProtoMessage()    {}
 func (*QueryInTxTrackerAllResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cab451d68199ead4, []int{15}
+	return fileDescriptor_cab451d68199ead4, []int{17}
 }
 func (m *QueryInTxTrackerAllResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -810,7 +948,7 @@ func (m *QueryGetInTxHashToXmsgRequest) Reset()         { *m = QueryGetInTxHashT
 func (m *QueryGetInTxHashToXmsgRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetInTxHashToXmsgRequest) ProtoMessage()    {}
 func (*QueryGetInTxHashToXmsgRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cab451d68199ead4, []int{16}
+	return fileDescriptor_cab451d68199ead4, []int{18}
 }
 func (m *QueryGetInTxHashToXmsgRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -855,7 +993,7 @@ func (m *QueryInTxHashToXmsgResponse) Reset()         { *m = QueryInTxHashToXmsg
 func (m *QueryInTxHashToXmsgResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryInTxHashToXmsgResponse) ProtoMessage()    {}
 func (*QueryInTxHashToXmsgResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cab451d68199ead4, []int{17}
+	return fileDescriptor_cab451d68199ead4, []int{19}
 }
 func (m *QueryInTxHashToXmsgResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -900,7 +1038,7 @@ func (m *QueryInTxHashToXmsgDataRequest) Reset()         { *m = QueryInTxHashToX
 func (m *QueryInTxHashToXmsgDataRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryInTxHashToXmsgDataRequest) ProtoMessage()    {}
 func (*QueryInTxHashToXmsgDataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cab451d68199ead4, []int{18}
+	return fileDescriptor_cab451d68199ead4, []int{20}
 }
 func (m *QueryInTxHashToXmsgDataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -945,7 +1083,7 @@ func (m *QueryInTxHashToXmsgDataResponse) Reset()         { *m = QueryInTxHashTo
 func (m *QueryInTxHashToXmsgDataResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryInTxHashToXmsgDataResponse) ProtoMessage()    {}
 func (*QueryInTxHashToXmsgDataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cab451d68199ead4, []int{19}
+	return fileDescriptor_cab451d68199ead4, []int{21}
 }
 func (m *QueryInTxHashToXmsgDataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -990,7 +1128,7 @@ func (m *QueryAllInTxHashToXmsgRequest) Reset()         { *m = QueryAllInTxHashT
 func (m *QueryAllInTxHashToXmsgRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllInTxHashToXmsgRequest) ProtoMessage()    {}
 func (*QueryAllInTxHashToXmsgRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cab451d68199ead4, []int{20}
+	return fileDescriptor_cab451d68199ead4, []int{22}
 }
 func (m *QueryAllInTxHashToXmsgRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1036,7 +1174,7 @@ func (m *QueryInTxHashToXmsgAllResponse) Reset()         { *m = QueryInTxHashToX
 func (m *QueryInTxHashToXmsgAllResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryInTxHashToXmsgAllResponse) ProtoMessage()    {}
 func (*QueryInTxHashToXmsgAllResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cab451d68199ead4, []int{21}
+	return fileDescriptor_cab451d68199ead4, []int{23}
 }
 func (m *QueryInTxHashToXmsgAllResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1088,7 +1226,7 @@ func (m *QueryGetGasPriceRequest) Reset()         { *m = QueryGetGasPriceRequest
 func (m *QueryGetGasPriceRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetGasPriceRequest) ProtoMessage()    {}
 func (*QueryGetGasPriceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cab451d68199ead4, []int{22}
+	return fileDescriptor_cab451d68199ead4, []int{24}
 }
 func (m *QueryGetGasPriceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1133,7 +1271,7 @@ func (m *QueryGasPriceResponse) Reset()         { *m = QueryGasPriceResponse{} }
 func (m *QueryGasPriceResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGasPriceResponse) ProtoMessage()    {}
 func (*QueryGasPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cab451d68199ead4, []int{23}
+	return fileDescriptor_cab451d68199ead4, []int{25}
 }
 func (m *QueryGasPriceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1178,7 +1316,7 @@ func (m *QueryAllGasPriceRequest) Reset()         { *m = QueryAllGasPriceRequest
 func (m *QueryAllGasPriceRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllGasPriceRequest) ProtoMessage()    {}
 func (*QueryAllGasPriceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cab451d68199ead4, []int{24}
+	return fileDescriptor_cab451d68199ead4, []int{26}
 }
 func (m *QueryAllGasPriceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1224,7 +1362,7 @@ func (m *QueryGasPriceAllResponse) Reset()         { *m = QueryGasPriceAllRespon
 func (m *QueryGasPriceAllResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGasPriceAllResponse) ProtoMessage()    {}
 func (*QueryGasPriceAllResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cab451d68199ead4, []int{25}
+	return fileDescriptor_cab451d68199ead4, []int{27}
 }
 func (m *QueryGasPriceAllResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1276,7 +1414,7 @@ func (m *QueryGetLastBlockHeightRequest) Reset()         { *m = QueryGetLastBloc
 func (m *QueryGetLastBlockHeightRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetLastBlockHeightRequest) ProtoMessage()    {}
 func (*QueryGetLastBlockHeightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cab451d68199ead4, []int{26}
+	return fileDescriptor_cab451d68199ead4, []int{28}
 }
 func (m *QueryGetLastBlockHeightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1321,7 +1459,7 @@ func (m *QueryLastBlockHeightResponse) Reset()         { *m = QueryLastBlockHeig
 func (m *QueryLastBlockHeightResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryLastBlockHeightResponse) ProtoMessage()    {}
 func (*QueryLastBlockHeightResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cab451d68199ead4, []int{27}
+	return fileDescriptor_cab451d68199ead4, []int{29}
 }
 func (m *QueryLastBlockHeightResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1366,7 +1504,7 @@ func (m *QueryAllLastBlockHeightRequest) Reset()         { *m = QueryAllLastBloc
 func (m *QueryAllLastBlockHeightRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllLastBlockHeightRequest) ProtoMessage()    {}
 func (*QueryAllLastBlockHeightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cab451d68199ead4, []int{28}
+	return fileDescriptor_cab451d68199ead4, []int{30}
 }
 func (m *QueryAllLastBlockHeightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1412,7 +1550,7 @@ func (m *QueryLastBlockHeightAllResponse) Reset()         { *m = QueryLastBlockH
 func (m *QueryLastBlockHeightAllResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryLastBlockHeightAllResponse) ProtoMessage()    {}
 func (*QueryLastBlockHeightAllResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cab451d68199ead4, []int{29}
+	return fileDescriptor_cab451d68199ead4, []int{31}
 }
 func (m *QueryLastBlockHeightAllResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1464,7 +1602,7 @@ func (m *QueryGetXmsgRequest) Reset()         { *m = QueryGetXmsgRequest{} }
 func (m *QueryGetXmsgRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetXmsgRequest) ProtoMessage()    {}
 func (*QueryGetXmsgRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cab451d68199ead4, []int{30}
+	return fileDescriptor_cab451d68199ead4, []int{32}
 }
 func (m *QueryGetXmsgRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1510,7 +1648,7 @@ func (m *QueryGetXmsgByNonceRequest) Reset()         { *m = QueryGetXmsgByNonceR
 func (m *QueryGetXmsgByNonceRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetXmsgByNonceRequest) ProtoMessage()    {}
 func (*QueryGetXmsgByNonceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cab451d68199ead4, []int{31}
+	return fileDescriptor_cab451d68199ead4, []int{33}
 }
 func (m *QueryGetXmsgByNonceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1562,7 +1700,7 @@ func (m *QueryXmsgByNonceResponse) Reset()         { *m = QueryXmsgByNonceRespon
 func (m *QueryXmsgByNonceResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryXmsgByNonceResponse) ProtoMessage()    {}
 func (*QueryXmsgByNonceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cab451d68199ead4, []int{32}
+	return fileDescriptor_cab451d68199ead4, []int{34}
 }
 func (m *QueryXmsgByNonceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1607,7 +1745,7 @@ func (m *QueryXmsgResponse) Reset()         { *m = QueryXmsgResponse{} }
 func (m *QueryXmsgResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryXmsgResponse) ProtoMessage()    {}
 func (*QueryXmsgResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cab451d68199ead4, []int{33}
+	return fileDescriptor_cab451d68199ead4, []int{35}
 }
 func (m *QueryXmsgResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1652,7 +1790,7 @@ func (m *QueryAllXmsgRequest) Reset()         { *m = QueryAllXmsgRequest{} }
 func (m *QueryAllXmsgRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllXmsgRequest) ProtoMessage()    {}
 func (*QueryAllXmsgRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cab451d68199ead4, []int{34}
+	return fileDescriptor_cab451d68199ead4, []int{36}
 }
 func (m *QueryAllXmsgRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1698,7 +1836,7 @@ func (m *QueryXmsgAllResponse) Reset()         { *m = QueryXmsgAllResponse{} }
 func (m *QueryXmsgAllResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryXmsgAllResponse) ProtoMessage()    {}
 func (*QueryXmsgAllResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cab451d68199ead4, []int{35}
+	return fileDescriptor_cab451d68199ead4, []int{37}
 }
 func (m *QueryXmsgAllResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1741,6 +1879,176 @@ func (m *QueryXmsgAllResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// request of query xmsgs by filter, empty filters are ignored and the
+// xmsgs match all the filters set
+type QueryXmsgsByFilterRequest struct {
+	// sender of the inbound tx
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// tx origin of the inbound tx
+	TxOrigin string `protobuf:"bytes,2,opt,name=tx_origin,json=txOrigin,proto3" json:"tx_origin,omitempty"`
+	// receiver of any outbound tx
+	Receiver string `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// receiver chain of any outbound tx
+	ReceiverChainId int64 `protobuf:"varint,4,opt,name=receiver_chain_id,json=receiverChainId,proto3" json:"receiver_chain_id,omitempty"`
+	// the xmsg status is one of the statuses
+	Statuses []XmsgStatus `protobuf:"varint,5,rep,packed,name=statuses,proto3,enum=xmsg.XmsgStatus" json:"statuses,omitempty"`
+	// type of the inbound pell event
+	InboundEventType InboundPellEventType `protobuf:"varint,6,opt,name=inbound_event_type,json=inboundEventType,proto3,enum=xmsg.InboundPellEventType" json:"inbound_event_type,omitempty"`
+	// inclusive range of the inbound tx finalized pell height, 0 for no bound
+	MinInboundPellHeight uint64             `protobuf:"varint,7,opt,name=min_inbound_pell_height,json=minInboundPellHeight,proto3" json:"min_inbound_pell_height,omitempty"`
+	MaxInboundPellHeight uint64             `protobuf:"varint,8,opt,name=max_inbound_pell_height,json=maxInboundPellHeight,proto3" json:"max_inbound_pell_height,omitempty"`
+	Pagination           *query.PageRequest `protobuf:"bytes,9,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryXmsgsByFilterRequest) Reset()         { *m = QueryXmsgsByFilterRequest{} }
+func (m *QueryXmsgsByFilterRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryXmsgsByFilterRequest) ProtoMessage()    {}
+func (*QueryXmsgsByFilterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cab451d68199ead4, []int{38}
+}
+func (m *QueryXmsgsByFilterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryXmsgsByFilterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryXmsgsByFilterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryXmsgsByFilterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryXmsgsByFilterRequest.Merge(m, src)
+}
+func (m *QueryXmsgsByFilterRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryXmsgsByFilterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryXmsgsByFilterRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryXmsgsByFilterRequest proto.InternalMessageInfo
+
+func (m *QueryXmsgsByFilterRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *QueryXmsgsByFilterRequest) GetTxOrigin() string {
+	if m != nil {
+		return m.TxOrigin
+	}
+	return ""
+}
+
+func (m *QueryXmsgsByFilterRequest) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *QueryXmsgsByFilterRequest) GetReceiverChainId() int64 {
+	if m != nil {
+		return m.ReceiverChainId
+	}
+	return 0
+}
+
+func (m *QueryXmsgsByFilterRequest) GetStatuses() []XmsgStatus {
+	if m != nil {
+		return m.Statuses
+	}
+	return nil
+}
+
+func (m *QueryXmsgsByFilterRequest) GetInboundEventType() InboundPellEventType {
+	if m != nil {
+		return m.InboundEventType
+	}
+	return InboundPellEventType_INBOUND_EVENT_UNSPECIFIED
+}
+
+func (m *QueryXmsgsByFilterRequest) GetMinInboundPellHeight() uint64 {
+	if m != nil {
+		return m.MinInboundPellHeight
+	}
+	return 0
+}
+
+func (m *QueryXmsgsByFilterRequest) GetMaxInboundPellHeight() uint64 {
+	if m != nil {
+		return m.MaxInboundPellHeight
+	}
+	return 0
+}
+
+func (m *QueryXmsgsByFilterRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// response of query xmsgs by filter
+type QueryXmsgsByFilterResponse struct {
+	Xmsgs      []*Xmsg             `protobuf:"bytes,1,rep,name=xmsgs,proto3" json:"xmsgs,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryXmsgsByFilterResponse) Reset()         { *m = QueryXmsgsByFilterResponse{} }
+func (m *QueryXmsgsByFilterResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryXmsgsByFilterResponse) ProtoMessage()    {}
+func (*QueryXmsgsByFilterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cab451d68199ead4, []int{39}
+}
+func (m *QueryXmsgsByFilterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryXmsgsByFilterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryXmsgsByFilterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryXmsgsByFilterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryXmsgsByFilterResponse.Merge(m, src)
+}
+func (m *QueryXmsgsByFilterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryXmsgsByFilterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryXmsgsByFilterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryXmsgsByFilterResponse proto.InternalMessageInfo
+
+func (m *QueryXmsgsByFilterResponse) GetXmsgs() []*Xmsg {
+	if m != nil {
+		return m.Xmsgs
+	}
+	return nil
+}
+
+func (m *QueryXmsgsByFilterResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // request of query list pending xmsg
 type QueryListPendingXmsgRequest struct {
 	ChainId int64  `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
@@ -1751,7 +2059,7 @@ func (m *QueryListPendingXmsgRequest) Reset()         { *m = QueryListPendingXms
 func (m *QueryListPendingXmsgRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryListPendingXmsgRequest) ProtoMessage()    {}
 func (*QueryListPendingXmsgRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cab451d68199ead4, []int{36}
+	return fileDescriptor_cab451d68199ead4, []int{40}
 }
 func (m *QueryListPendingXmsgRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1804,7 +2112,7 @@ func (m *QueryListPendingXmsgResponse) Reset()         { *m = QueryListPendingXm
 func (m *QueryListPendingXmsgResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryListPendingXmsgResponse) ProtoMessage()    {}
 func (*QueryListPendingXmsgResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cab451d68199ead4, []int{37}
+	return fileDescriptor_cab451d68199ead4, []int{41}
 }
 func (m *QueryListPendingXmsgResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1860,7 +2168,7 @@ func (m *QueryListPendingXmsgWithinRateLimitRequest) String() string {
 }
 func (*QueryListPendingXmsgWithinRateLimitRequest) ProtoMessage() {}
 func (*QueryListPendingXmsgWithinRateLimitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cab451d68199ead4, []int{38}
+	return fileDescriptor_cab451d68199ead4, []int{42}
 }
 func (m *QueryListPendingXmsgWithinRateLimitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1898,11 +2206,13 @@ func (m *QueryListPendingXmsgWithinRateLimitRequest) GetLimit() uint32 {
 
 // response of query list pending xmsg within rate limit
 type QueryListPendingXmsgWithinRateLimitResponse struct {
-	Xmsgs                 []*Xmsg `protobuf:"bytes,1,rep,name=xmsgs,proto3" json:"xmsgs,omitempty"`
-	TotalPending          uint64  `protobuf:"varint,2,opt,name=total_pending,json=totalPending,proto3" json:"total_pending,omitempty"`
-	CurrentWithdrawWindow int64   `protobuf:"varint,3,opt,name=current_withdraw_window,json=currentWithdrawWindow,proto3" json:"current_withdraw_window,omitempty"`
-	CurrentWithdrawRate   string  `protobuf:"bytes,4,opt,name=current_withdraw_rate,json=currentWithdrawRate,proto3" json:"current_withdraw_rate,omitempty"`
-	RateLimitExceeded     bool    `protobuf:"varint,5,opt,name=rate_limit_exceeded,json=rateLimitExceeded,proto3" json:"rate_limit_exceeded,omitempty"`
+	Xmsgs                 []*Xmsg                `protobuf:"bytes,1,rep,name=xmsgs,proto3" json:"xmsgs,omitempty"`
+	TotalPending          uint64                 `protobuf:"varint,2,opt,name=total_pending,json=totalPending,proto3" json:"total_pending,omitempty"`
+	CurrentWithdrawWindow int64                  `protobuf:"varint,3,opt,name=current_withdraw_window,json=currentWithdrawWindow,proto3" json:"current_withdraw_window,omitempty"`
+	CurrentWithdrawRate   string                 `protobuf:"bytes,4,opt,name=current_withdraw_rate,json=currentWithdrawRate,proto3" json:"current_withdraw_rate,omitempty"`
+	RateLimitExceeded     bool                   `protobuf:"varint,5,opt,name=rate_limit_exceeded,json=rateLimitExceeded,proto3" json:"rate_limit_exceeded,omitempty"`
+	ChainRateLimits       []ChainRateLimitStatus `protobuf:"bytes,6,rep,name=chain_rate_limits,json=chainRateLimits,proto3" json:"chain_rate_limits"`
+	EventRateLimits       []EventRateLimitStatus `protobuf:"bytes,7,rep,name=event_rate_limits,json=eventRateLimits,proto3" json:"event_rate_limits"`
 }
 
 func (m *QueryListPendingXmsgWithinRateLimitResponse) Reset() {
@@ -1913,7 +2223,7 @@ func (m *QueryListPendingXmsgWithinRateLimitResponse) String() string {
 }
 func (*QueryListPendingXmsgWithinRateLimitResponse) ProtoMessage() {}
 func (*QueryListPendingXmsgWithinRateLimitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cab451d68199ead4, []int{39}
+	return fileDescriptor_cab451d68199ead4, []int{43}
 }
 func (m *QueryListPendingXmsgWithinRateLimitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1977,6 +2287,20 @@ func (m *QueryListPendingXmsgWithinRateLimitResponse) GetRateLimitExceeded() boo
 	return false
 }
 
+func (m *QueryListPendingXmsgWithinRateLimitResponse) GetChainRateLimits() []ChainRateLimitStatus {
+	if m != nil {
+		return m.ChainRateLimits
+	}
+	return nil
+}
+
+func (m *QueryListPendingXmsgWithinRateLimitResponse) GetEventRateLimits() []EventRateLimitStatus {
+	if m != nil {
+		return m.EventRateLimits
+	}
+	return nil
+}
+
 // request of query last pell height
 type QueryLastPellHeightRequest struct {
 }
@@ -1985,7 +2309,7 @@ func (m *QueryLastPellHeightRequest) Reset()         { *m = QueryLastPellHeightR
 func (m *QueryLastPellHeightRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryLastPellHeightRequest) ProtoMessage()    {}
 func (*QueryLastPellHeightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cab451d68199ead4, []int{40}
+	return fileDescriptor_cab451d68199ead4, []int{44}
 }
 func (m *QueryLastPellHeightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2023,7 +2347,7 @@ func (m *QueryLastPellHeightResponse) Reset()         { *m = QueryLastPellHeight
 func (m *QueryLastPellHeightResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryLastPellHeightResponse) ProtoMessage()    {}
 func (*QueryLastPellHeightResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cab451d68199ead4, []int{41}
+	return fileDescriptor_cab451d68199ead4, []int{45}
 }
 func (m *QueryLastPellHeightResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2067,7 +2391,7 @@ func (m *QueryRateLimiterFlagsRequest) Reset()         { *m = QueryRateLimiterFl
 func (m *QueryRateLimiterFlagsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRateLimiterFlagsRequest) ProtoMessage()    {}
 func (*QueryRateLimiterFlagsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cab451d68199ead4, []int{42}
+	return fileDescriptor_cab451d68199ead4, []int{46}
 }
 func (m *QueryRateLimiterFlagsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2105,7 +2429,7 @@ func (m *QueryRateLimiterFlagsResponse) Reset()         { *m = QueryRateLimiterF
 func (m *QueryRateLimiterFlagsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRateLimiterFlagsResponse) ProtoMessage()    {}
 func (*QueryRateLimiterFlagsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cab451d68199ead4, []int{43}
+	return fileDescriptor_cab451d68199ead4, []int{47}
 }
 func (m *QueryRateLimiterFlagsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2150,7 +2474,7 @@ func (m *QueryChainIndexRequest) Reset()         { *m = QueryChainIndexRequest{}
 func (m *QueryChainIndexRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryChainIndexRequest) ProtoMessage()    {}
 func (*QueryChainIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cab451d68199ead4, []int{44}
+	return fileDescriptor_cab451d68199ead4, []int{48}
 }
 func (m *QueryChainIndexRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2196,7 +2520,7 @@ func (m *QueryChainIndexResponse) Reset()         { *m = QueryChainIndexResponse
 func (m *QueryChainIndexResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryChainIndexResponse) ProtoMessage()    {}
 func (*QueryChainIndexResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cab451d68199ead4, []int{45}
+	return fileDescriptor_cab451d68199ead4, []int{49}
 }
 func (m *QueryChainIndexResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2249,7 +2573,7 @@ func (m *QueryBlockProofRequest) Reset()         { *m = QueryBlockProofRequest{}
 func (m *QueryBlockProofRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBlockProofRequest) ProtoMessage()    {}
 func (*QueryBlockProofRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cab451d68199ead4, []int{46}
+	return fileDescriptor_cab451d68199ead4, []int{50}
 }
 func (m *QueryBlockProofRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2305,7 +2629,7 @@ func (m *QueryBlockProofResponse) Reset()         { *m = QueryBlockProofResponse
 func (m *QueryBlockProofResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBlockProofResponse) ProtoMessage()    {}
 func (*QueryBlockProofResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cab451d68199ead4, []int{47}
+	return fileDescriptor_cab451d68199ead4, []int{51}
 }
 func (m *QueryBlockProofResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2380,7 +2704,7 @@ func (m *QueryPellRechargeOperationIndexRequest) Reset() {
 func (m *QueryPellRechargeOperationIndexRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPellRechargeOperationIndexRequest) ProtoMessage()    {}
 func (*QueryPellRechargeOperationIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cab451d68199ead4, []int{48}
+	return fileDescriptor_cab451d68199ead4, []int{52}
 }
 func (m *QueryPellRechargeOperationIndexRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2428,7 +2752,7 @@ func (m *QueryPellRechargeOperationIndexResponse) Reset() {
 func (m *QueryPellRechargeOperationIndexResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPellRechargeOperationIndexResponse) ProtoMessage()    {}
 func (*QueryPellRechargeOperationIndexResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cab451d68199ead4, []int{49}
+	return fileDescriptor_cab451d68199ead4, []int{53}
 }
 func (m *QueryPellRechargeOperationIndexResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2480,7 +2804,7 @@ func (m *QueryGasRechargeOperationIndexRequest) Reset()         { *m = QueryGasR
 func (m *QueryGasRechargeOperationIndexRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGasRechargeOperationIndexRequest) ProtoMessage()    {}
 func (*QueryGasRechargeOperationIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cab451d68199ead4, []int{50}
+	return fileDescriptor_cab451d68199ead4, []int{54}
 }
 func (m *QueryGasRechargeOperationIndexRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2528,7 +2852,7 @@ func (m *QueryGasRechargeOperationIndexResponse) Reset() {
 func (m *QueryGasRechargeOperationIndexResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGasRechargeOperationIndexResponse) ProtoMessage()    {}
 func (*QueryGasRechargeOperationIndexResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cab451d68199ead4, []int{51}
+	return fileDescriptor_cab451d68199ead4, []int{55}
 }
 func (m *QueryGasRechargeOperationIndexResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2581,7 +2905,7 @@ func (m *QueryRateLimiterInputRequest) Reset()         { *m = QueryRateLimiterIn
 func (m *QueryRateLimiterInputRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRateLimiterInputRequest) ProtoMessage()    {}
 func (*QueryRateLimiterInputRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cab451d68199ead4, []int{52}
+	return fileDescriptor_cab451d68199ead4, []int{56}
 }
 func (m *QueryRateLimiterInputRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2631,13 +2955,20 @@ type QueryRateLimiterInputResponse struct {
 	XmsgsPending            []*Xmsg `protobuf:"bytes,3,rep,name=xmsgs_pending,json=xmsgsPending,proto3" json:"xmsgs_pending,omitempty"`
 	TotalPending            uint64  `protobuf:"varint,4,opt,name=total_pending,json=totalPending,proto3" json:"total_pending,omitempty"`
 	LowestPendingXmsgHeight int64   `protobuf:"varint,5,opt,name=lowest_pending_xmsg_height,json=lowestPendingXmsgHeight,proto3" json:"lowest_pending_xmsg_height,omitempty"`
+	// value of the past outbound xmsgs within the window of the global rate limit,
+	// as a decimal integer string
+	PastXmsgsValue string `protobuf:"bytes,6,opt,name=past_xmsgs_value,json=pastXmsgsValue,proto3" json:"past_xmsgs_value,omitempty"`
+	// value of the past outbound xmsgs within the window of each chain rate limit
+	PastChainValues []ChainRateLimitValue `protobuf:"bytes,7,rep,name=past_chain_values,json=pastChainValues,proto3" json:"past_chain_values"`
+	// value of the past outbound xmsgs within the window of each event rate limit
+	PastEventValues []EventRateLimitValue `protobuf:"bytes,8,rep,name=past_event_values,json=pastEventValues,proto3" json:"past_event_values"`
 }
 
 func (m *QueryRateLimiterInputResponse) Reset()         { *m = QueryRateLimiterInputResponse{} }
 func (m *QueryRateLimiterInputResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRateLimiterInputResponse) ProtoMessage()    {}
 func (*QueryRateLimiterInputResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cab451d68199ead4, []int{53}
+	return fileDescriptor_cab451d68199ead4, []int{57}
 }
 func (m *QueryRateLimiterInputResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2701,11 +3032,320 @@ func (m *QueryRateLimiterInputResponse) GetLowestPendingXmsgHeight() int64 {
 	return 0
 }
 
+func (m *QueryRateLimiterInputResponse) GetPastXmsgsValue() string {
+	if m != nil {
+		return m.PastXmsgsValue
+	}
+	return ""
+}
+
+func (m *QueryRateLimiterInputResponse) GetPastChainValues() []ChainRateLimitValue {
+	if m != nil {
+		return m.PastChainValues
+	}
+	return nil
+}
+
+func (m *QueryRateLimiterInputResponse) GetPastEventValues() []EventRateLimitValue {
+	if m != nil {
+		return m.PastEventValues
+	}
+	return nil
+}
+
+// request of query recharge ledger
+type QueryRechargeLedgerRequest struct {
+	ChainId   int64             `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	TokenType RechargeTokenType `protobuf:"varint,2,opt,name=token_type,json=tokenType,proto3,enum=xmsg.RechargeTokenType" json:"token_type,omitempty"`
+}
+
+func (m *QueryRechargeLedgerRequest) Reset()         { *m = QueryRechargeLedgerRequest{} }
+func (m *QueryRechargeLedgerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRechargeLedgerRequest) ProtoMessage()    {}
+func (*QueryRechargeLedgerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cab451d68199ead4, []int{58}
+}
+func (m *QueryRechargeLedgerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRechargeLedgerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRechargeLedgerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRechargeLedgerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRechargeLedgerRequest.Merge(m, src)
+}
+func (m *QueryRechargeLedgerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRechargeLedgerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRechargeLedgerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRechargeLedgerRequest proto.InternalMessageInfo
+
+func (m *QueryRechargeLedgerRequest) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *QueryRechargeLedgerRequest) GetTokenType() RechargeTokenType {
+	if m != nil {
+		return m.TokenType
+	}
+	return RechargeTokenType_PELL_TOKEN
+}
+
+// response of query recharge ledger, the budget is empty if no budget is set
+type QueryRechargeLedgerResponse struct {
+	Ledger RechargeLedger  `protobuf:"bytes,1,opt,name=ledger,proto3" json:"ledger"`
+	Budget *RechargeBudget `protobuf:"bytes,2,opt,name=budget,proto3" json:"budget,omitempty"`
+}
+
+func (m *QueryRechargeLedgerResponse) Reset()         { *m = QueryRechargeLedgerResponse{} }
+func (m *QueryRechargeLedgerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRechargeLedgerResponse) ProtoMessage()    {}
+func (*QueryRechargeLedgerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cab451d68199ead4, []int{59}
+}
+func (m *QueryRechargeLedgerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRechargeLedgerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRechargeLedgerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRechargeLedgerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRechargeLedgerResponse.Merge(m, src)
+}
+func (m *QueryRechargeLedgerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRechargeLedgerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRechargeLedgerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRechargeLedgerResponse proto.InternalMessageInfo
+
+func (m *QueryRechargeLedgerResponse) GetLedger() RechargeLedger {
+	if m != nil {
+		return m.Ledger
+	}
+	return RechargeLedger{}
+}
+
+func (m *QueryRechargeLedgerResponse) GetBudget() *RechargeBudget {
+	if m != nil {
+		return m.Budget
+	}
+	return nil
+}
+
+// request of query all recharge ledgers
+type QueryAllRechargeLedgerRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllRechargeLedgerRequest) Reset()         { *m = QueryAllRechargeLedgerRequest{} }
+func (m *QueryAllRechargeLedgerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRechargeLedgerRequest) ProtoMessage()    {}
+func (*QueryAllRechargeLedgerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cab451d68199ead4, []int{60}
+}
+func (m *QueryAllRechargeLedgerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllRechargeLedgerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllRechargeLedgerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllRechargeLedgerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRechargeLedgerRequest.Merge(m, src)
+}
+func (m *QueryAllRechargeLedgerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllRechargeLedgerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRechargeLedgerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllRechargeLedgerRequest proto.InternalMessageInfo
+
+func (m *QueryAllRechargeLedgerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// response of query all recharge ledgers
+type QueryRechargeLedgerAllResponse struct {
+	Ledgers    []RechargeLedger    `protobuf:"bytes,1,rep,name=ledgers,proto3" json:"ledgers"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRechargeLedgerAllResponse) Reset()         { *m = QueryRechargeLedgerAllResponse{} }
+func (m *QueryRechargeLedgerAllResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRechargeLedgerAllResponse) ProtoMessage()    {}
+func (*QueryRechargeLedgerAllResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cab451d68199ead4, []int{61}
+}
+func (m *QueryRechargeLedgerAllResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRechargeLedgerAllResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRechargeLedgerAllResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRechargeLedgerAllResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRechargeLedgerAllResponse.Merge(m, src)
+}
+func (m *QueryRechargeLedgerAllResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRechargeLedgerAllResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRechargeLedgerAllResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRechargeLedgerAllResponse proto.InternalMessageInfo
+
+func (m *QueryRechargeLedgerAllResponse) GetLedgers() []RechargeLedger {
+	if m != nil {
+		return m.Ledgers
+	}
+	return nil
+}
+
+func (m *QueryRechargeLedgerAllResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// request of query all recharge budgets
+type QueryAllRechargeBudgetRequest struct {
+}
+
+func (m *QueryAllRechargeBudgetRequest) Reset()         { *m = QueryAllRechargeBudgetRequest{} }
+func (m *QueryAllRechargeBudgetRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRechargeBudgetRequest) ProtoMessage()    {}
+func (*QueryAllRechargeBudgetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cab451d68199ead4, []int{62}
+}
+func (m *QueryAllRechargeBudgetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllRechargeBudgetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllRechargeBudgetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllRechargeBudgetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRechargeBudgetRequest.Merge(m, src)
+}
+func (m *QueryAllRechargeBudgetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllRechargeBudgetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRechargeBudgetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllRechargeBudgetRequest proto.InternalMessageInfo
+
+// response of query all recharge budgets
+type QueryRechargeBudgetAllResponse struct {
+	Budgets []RechargeBudget `protobuf:"bytes,1,rep,name=budgets,proto3" json:"budgets"`
+}
+
+func (m *QueryRechargeBudgetAllResponse) Reset()         { *m = QueryRechargeBudgetAllResponse{} }
+func (m *QueryRechargeBudgetAllResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRechargeBudgetAllResponse) ProtoMessage()    {}
+func (*QueryRechargeBudgetAllResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cab451d68199ead4, []int{63}
+}
+func (m *QueryRechargeBudgetAllResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRechargeBudgetAllResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRechargeBudgetAllResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRechargeBudgetAllResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRechargeBudgetAllResponse.Merge(m, src)
+}
+func (m *QueryRechargeBudgetAllResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRechargeBudgetAllResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRechargeBudgetAllResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRechargeBudgetAllResponse proto.InternalMessageInfo
+
+func (m *QueryRechargeBudgetAllResponse) GetBudgets() []RechargeBudget {
+	if m != nil {
+		return m.Budgets
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryCrosschainFeeParamsRequest)(nil), "xmsg.QueryCrosschainFeeParamsRequest")
 	proto.RegisterType((*QueryCrosschainFeeParamsResponse)(nil), "xmsg.QueryCrosschainFeeParamsResponse")
 	proto.RegisterType((*QueryCrosschainFeeParamByChainIdRequest)(nil), "xmsg.QueryCrosschainFeeParamByChainIdRequest")
 	proto.RegisterType((*QueryCrosschainFeeParamByChainIdResponse)(nil), "xmsg.QueryCrosschainFeeParamByChainIdResponse")
+	proto.RegisterType((*QueryCrosschainFeeQuoteRequest)(nil), "xmsg.QueryCrosschainFeeQuoteRequest")
+	proto.RegisterType((*QueryCrosschainFeeQuoteResponse)(nil), "xmsg.QueryCrosschainFeeQuoteResponse")
 	proto.RegisterType((*QueryListAllowedXmsgSendersRequest)(nil), "xmsg.QueryListAllowedXmsgSendersRequest")
 	proto.RegisterType((*QueryListAllowedXmsgSendersResponse)(nil), "xmsg.QueryListAllowedXmsgSendersResponse")
 	proto.RegisterType((*QueryGetOutTxTrackerRequest)(nil), "xmsg.QueryGetOutTxTrackerRequest")
@@ -2738,6 +3378,8 @@ func init() {
 	proto.RegisterType((*QueryXmsgResponse)(nil), "xmsg.QueryXmsgResponse")
 	proto.RegisterType((*QueryAllXmsgRequest)(nil), "xmsg.QueryAllXmsgRequest")
 	proto.RegisterType((*QueryXmsgAllResponse)(nil), "xmsg.QueryXmsgAllResponse")
+	proto.RegisterType((*QueryXmsgsByFilterRequest)(nil), "xmsg.QueryXmsgsByFilterRequest")
+	proto.RegisterType((*QueryXmsgsByFilterResponse)(nil), "xmsg.QueryXmsgsByFilterResponse")
 	proto.RegisterType((*QueryListPendingXmsgRequest)(nil), "xmsg.QueryListPendingXmsgRequest")
 	proto.RegisterType((*QueryListPendingXmsgResponse)(nil), "xmsg.QueryListPendingXmsgResponse")
 	proto.RegisterType((*QueryListPendingXmsgWithinRateLimitRequest)(nil), "xmsg.QueryListPendingXmsgWithinRateLimitRequest")
@@ -2756,160 +3398,218 @@ func init() {
 	proto.RegisterType((*QueryGasRechargeOperationIndexResponse)(nil), "xmsg.QueryGasRechargeOperationIndexResponse")
 	proto.RegisterType((*QueryRateLimiterInputRequest)(nil), "xmsg.QueryRateLimiterInputRequest")
 	proto.RegisterType((*QueryRateLimiterInputResponse)(nil), "xmsg.QueryRateLimiterInputResponse")
+	proto.RegisterType((*QueryRechargeLedgerRequest)(nil), "xmsg.QueryRechargeLedgerRequest")
+	proto.RegisterType((*QueryRechargeLedgerResponse)(nil), "xmsg.QueryRechargeLedgerResponse")
+	proto.RegisterType((*QueryAllRechargeLedgerRequest)(nil), "xmsg.QueryAllRechargeLedgerRequest")
+	proto.RegisterType((*QueryRechargeLedgerAllResponse)(nil), "xmsg.QueryRechargeLedgerAllResponse")
+	proto.RegisterType((*QueryAllRechargeBudgetRequest)(nil), "xmsg.QueryAllRechargeBudgetRequest")
+	proto.RegisterType((*QueryRechargeBudgetAllResponse)(nil), "xmsg.QueryRechargeBudgetAllResponse")
 }
 
 func init() { proto.RegisterFile("xmsg/query.proto", fileDescriptor_cab451d68199ead4) }
 
 var fileDescriptor_cab451d68199ead4 = []byte{
-	// 2362 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcd, 0x6f, 0x1c, 0x49,
-	0x15, 0x4f, 0x7b, 0xec, 0xac, 0xfd, 0x9c, 0xd8, 0x71, 0xf9, 0x6b, 0xdc, 0xb6, 0xc7, 0x76, 0x3b,
-	0x71, 0xbc, 0x76, 0x3c, 0xe3, 0x38, 0x6c, 0x16, 0x01, 0x89, 0xb0, 0xf3, 0xb5, 0x01, 0x6f, 0xd6,
-	0x0c, 0x41, 0x41, 0x20, 0xd4, 0xea, 0x99, 0xa9, 0xcc, 0xb4, 0xd2, 0xee, 0x9e, 0x74, 0xb7, 0xe3,
-	0xb1, 0x22, 0x2f, 0xda, 0x08, 0x2d, 0x07, 0x3e, 0xb4, 0x02, 0x4e, 0x9c, 0x10, 0x08, 0x21, 0x21,
-	0x40, 0x70, 0xe5, 0xc8, 0x85, 0x3d, 0xae, 0xc4, 0x85, 0x13, 0x42, 0x09, 0xff, 0x04, 0x37, 0xd4,
-	0x55, 0xaf, 0x67, 0xaa, 0xfa, 0x6b, 0x66, 0xc3, 0xac, 0xb4, 0xb7, 0x99, 0xfa, 0x7a, 0xbf, 0xf7,
-	0x7b, 0xaf, 0x5e, 0x55, 0xfd, 0xd4, 0x70, 0xa1, 0x75, 0xe8, 0xd5, 0x4b, 0x4f, 0x8f, 0xa8, 0x7b,
-	0x52, 0x6c, 0xba, 0x8e, 0xef, 0x90, 0xc1, 0xa0, 0x45, 0xdd, 0xa8, 0x3a, 0xde, 0xa1, 0xe3, 0x95,
-	0x2a, 0x86, 0x47, 0x79, 0x77, 0xe9, 0xd9, 0xd5, 0x0a, 0xf5, 0x8d, 0xab, 0xa5, 0xa6, 0x51, 0x37,
-	0x6d, 0xc3, 0x37, 0x1d, 0x9b, 0xcf, 0x50, 0xa7, 0xea, 0x4e, 0xdd, 0x61, 0x3f, 0x4b, 0xc1, 0x2f,
-	0x6c, 0x5d, 0xa8, 0x3b, 0x4e, 0xdd, 0xa2, 0x25, 0xa3, 0x69, 0x96, 0x0c, 0xdb, 0x76, 0x7c, 0x36,
-	0xc5, 0xc3, 0xde, 0x19, 0x66, 0xb7, 0xda, 0x30, 0x4c, 0x5b, 0x37, 0xed, 0x1a, 0x6d, 0x61, 0xfb,
-	0x12, 0x6f, 0x77, 0x1d, 0xcf, 0xe3, 0x9d, 0x8f, 0x29, 0xd5, 0x9b, 0x86, 0x6b, 0x1c, 0x86, 0xc6,
-	0xd8, 0x80, 0xba, 0xe1, 0xe9, 0x4d, 0xd7, 0xac, 0x52, 0x6c, 0x5d, 0x64, 0xad, 0xa6, 0xad, 0xfb,
-	0x2d, 0xbd, 0x61, 0x78, 0x0d, 0xdd, 0x77, 0xf4, 0xa0, 0x09, 0xbb, 0xf3, 0x42, 0xb7, 0xef, 0x1a,
-	0xd5, 0x27, 0xd4, 0x0d, 0x51, 0xb2, 0x1e, 0xcb, 0xf0, 0x7c, 0xbd, 0x62, 0x39, 0xd5, 0x27, 0x7a,
-	0x83, 0x9a, 0xf5, 0x86, 0x8f, 0xbd, 0x73, 0xac, 0xd7, 0x39, 0xf2, 0xe3, 0x13, 0xb9, 0x45, 0xd7,
-	0xf0, 0xa9, 0x6e, 0x99, 0x87, 0xa6, 0x4f, 0x5d, 0xfd, 0xb1, 0x65, 0xd4, 0x43, 0xff, 0xc6, 0x59,
-	0x77, 0x07, 0x82, 0xb6, 0x02, 0x4b, 0xdf, 0x08, 0x68, 0xbc, 0xd5, 0x76, 0xed, 0x2e, 0xa5, 0x07,
-	0x81, 0x63, 0x5e, 0x99, 0x3e, 0x3d, 0xa2, 0x9e, 0xaf, 0x35, 0x61, 0x39, 0x7d, 0x88, 0xd7, 0x74,
-	0x6c, 0x8f, 0x92, 0x7d, 0x98, 0x4e, 0x22, 0xc7, 0xcb, 0x2b, 0xcb, 0xb9, 0xf5, 0xd1, 0x9d, 0x7c,
-	0x91, 0x99, 0x8c, 0xaf, 0x50, 0x9e, 0xac, 0xc6, 0x57, 0xd5, 0x6e, 0xc3, 0xe5, 0x14, 0x8b, 0x7b,
-	0x27, 0xb7, 0x82, 0xbf, 0xf7, 0x6b, 0x08, 0x8e, 0xcc, 0xc1, 0x30, 0x46, 0xab, 0x96, 0x57, 0x96,
-	0x95, 0xf5, 0x5c, 0xf9, 0x8d, 0x2a, 0x1f, 0xa1, 0x3d, 0x83, 0xf5, 0xee, 0xab, 0x20, 0xfe, 0xaf,
-	0xc1, 0x54, 0x12, 0x7e, 0xb6, 0x64, 0x16, 0x7c, 0x12, 0x87, 0xaf, 0x5d, 0x04, 0x8d, 0xd9, 0xdd,
-	0x37, 0x3d, 0x7f, 0xd7, 0xb2, 0x9c, 0x63, 0x5a, 0xfb, 0xf6, 0xa1, 0x57, 0xff, 0x26, 0xb5, 0x6b,
-	0xd4, 0x6d, 0xb3, 0xba, 0x0b, 0xab, 0x99, 0xa3, 0x10, 0x98, 0x0a, 0xc3, 0x95, 0x23, 0xd3, 0x0a,
-	0xda, 0x18, 0x97, 0x23, 0xe5, 0xf6, 0x7f, 0xed, 0x01, 0xcc, 0xb3, 0x25, 0xee, 0x51, 0xff, 0xbd,
-	0x23, 0xff, 0x61, 0xeb, 0x21, 0xcf, 0x84, 0xee, 0xd4, 0x90, 0x29, 0x18, 0xb2, 0x1d, 0xbb, 0x4a,
-	0xf3, 0x03, 0xcb, 0xca, 0xfa, 0x60, 0x99, 0xff, 0xd1, 0xbe, 0x0b, 0x73, 0x6c, 0x3d, 0x79, 0x31,
-	0x04, 0x72, 0x13, 0xc6, 0xe4, 0x84, 0x43, 0x6e, 0x08, 0xe7, 0x46, 0x9c, 0xb3, 0x37, 0xf8, 0xf1,
-	0xbf, 0x96, 0xce, 0x94, 0xcf, 0x39, 0x42, 0x9b, 0x46, 0x11, 0xec, 0xae, 0x65, 0x25, 0x81, 0xbd,
-	0x0b, 0xd0, 0xd9, 0xc0, 0xb8, 0xf4, 0x5a, 0x91, 0xef, 0xf6, 0x62, 0xb0, 0xdb, 0x8b, 0xbc, 0x18,
-	0xe0, 0x6e, 0x2f, 0x1e, 0x18, 0x75, 0x8a, 0x73, 0xcb, 0xc2, 0x4c, 0xed, 0x77, 0x0a, 0x2c, 0xc4,
-	0x9c, 0xd8, 0xb5, 0xac, 0x4c, 0x3f, 0x72, 0xbd, 0xfb, 0x41, 0xee, 0x49, 0x40, 0x07, 0x18, 0xd0,
-	0xcb, 0x5d, 0x81, 0x72, 0xe3, 0x12, 0xd2, 0x17, 0x0a, 0xe6, 0x49, 0x84, 0x11, 0x4c, 0xce, 0x90,
-	0x98, 0x29, 0x18, 0x62, 0x51, 0xc3, 0x10, 0xf2, 0x3f, 0x11, 0xba, 0x06, 0x5e, 0x9b, 0xae, 0x3f,
-	0x2b, 0x98, 0x86, 0x11, 0xba, 0xda, 0x20, 0x90, 0xb5, 0xaf, 0xc2, 0xb8, 0xcc, 0x9a, 0xd7, 0x95,
-	0xb6, 0xf3, 0x22, 0x6d, 0x5e, 0xff, 0x78, 0xfb, 0x50, 0x81, 0x95, 0x90, 0xb7, 0xfb, 0x76, 0x1a,
-	0x6d, 0x19, 0xc9, 0xdf, 0x2f, 0xee, 0xfe, 0x10, 0x06, 0x50, 0x40, 0x91, 0x40, 0xdd, 0x0d, 0x18,
-	0x93, 0x2a, 0x7c, 0xc8, 0xdc, 0x04, 0x67, 0x4e, 0x74, 0x01, 0xf3, 0xcd, 0xb4, 0x3f, 0x0b, 0xde,
-	0x84, 0x0d, 0x28, 0xd8, 0xf4, 0xfa, 0xbd, 0x01, 0x7f, 0xab, 0xa0, 0x1d, 0x99, 0x95, 0xcf, 0x1d,
-	0x1d, 0x37, 0x60, 0x31, 0x2c, 0x9e, 0x81, 0xcd, 0x77, 0x0c, 0xaf, 0xf1, 0xd0, 0x09, 0x2a, 0x70,
-	0x48, 0xc8, 0x02, 0x40, 0xe7, 0xe0, 0x66, 0x84, 0x8c, 0x94, 0x87, 0x4d, 0x1c, 0xda, 0x66, 0x33,
-	0x3a, 0x17, 0xbd, 0xbc, 0x0b, 0x24, 0x7e, 0xea, 0x23, 0xab, 0x53, 0x1d, 0x4f, 0x3b, 0x33, 0xd1,
-	0xd9, 0x31, 0x53, 0x6a, 0xd5, 0x6e, 0x42, 0x21, 0xc1, 0xcc, 0x6d, 0xc3, 0x37, 0x7a, 0x83, 0x79,
-	0x1f, 0x8f, 0xf7, 0xa4, 0xf9, 0x08, 0x75, 0x0d, 0x86, 0x02, 0x3c, 0x61, 0x1c, 0x80, 0xa3, 0x13,
-	0x30, 0xf1, 0x6e, 0xad, 0x8e, 0x84, 0x61, 0xfe, 0xc4, 0x09, 0xeb, 0x57, 0x06, 0xfd, 0x45, 0x49,
-	0x74, 0x5a, 0x4c, 0xa2, 0x34, 0x7a, 0x73, 0x9f, 0x8e, 0xde, 0xfe, 0x65, 0x53, 0x09, 0x66, 0xc3,
-	0x6c, 0xba, 0x67, 0x78, 0x07, 0xc1, 0x15, 0x50, 0x28, 0xe0, 0xec, 0x26, 0x89, 0xb1, 0xe1, 0x7f,
-	0xb4, 0xdb, 0x30, 0xcd, 0x27, 0xb4, 0x47, 0xa3, 0x6b, 0x9b, 0x30, 0xd2, 0xbe, 0x45, 0x22, 0x89,
-	0x63, 0xdc, 0xa3, 0xf6, 0xd0, 0xe1, 0x3a, 0xfe, 0xd2, 0x0c, 0x34, 0xbb, 0x6b, 0x59, 0x51, 0xb3,
-	0xfd, 0x8a, 0xc6, 0xcf, 0x14, 0xc8, 0x4b, 0x48, 0xc5, 0x38, 0x6c, 0x01, 0xb4, 0xc1, 0x86, 0x09,
-	0x14, 0x45, 0x3b, 0x12, 0xa2, 0xed, 0xe3, 0xe6, 0xbd, 0x8e, 0x19, 0x72, 0x8f, 0xfa, 0xfb, 0x86,
-	0xe7, 0xef, 0x05, 0x57, 0xe4, 0x77, 0xd8, 0x0d, 0x39, 0x9b, 0x75, 0x03, 0x2f, 0x07, 0xb1, 0x49,
-	0xe8, 0xcf, 0x2e, 0x4c, 0xc4, 0xee, 0xdc, 0xc8, 0xdd, 0x34, 0x77, 0x2b, 0x3a, 0x73, 0xdc, 0x92,
-	0x1b, 0xb4, 0x06, 0x42, 0xdb, 0xb5, 0xac, 0x14, 0x68, 0xfd, 0x8a, 0xcc, 0x1f, 0x15, 0xdc, 0xdc,
-	0x11, 0x3b, 0x62, 0x80, 0x52, 0x1c, 0xca, 0xf5, 0xee, 0x50, 0xff, 0x82, 0xb6, 0x09, 0x93, 0x61,
-	0xd0, 0xc4, 0xb2, 0x91, 0x1c, 0xa9, 0x77, 0x41, 0x15, 0x07, 0xef, 0x9d, 0x3c, 0x08, 0xae, 0xa8,
-	0xaf, 0x7d, 0xb5, 0xfd, 0x12, 0x26, 0xb1, 0xb4, 0x16, 0x72, 0x54, 0x80, 0x41, 0xa1, 0x3a, 0x0b,
-	0xf5, 0xaf, 0xcc, 0xda, 0xb5, 0x6b, 0x30, 0xd1, 0x9e, 0xdb, 0xf3, 0xa4, 0xef, 0xa1, 0xb3, 0xbb,
-	0x96, 0xf5, 0x59, 0xd4, 0xc8, 0x0f, 0x14, 0x98, 0x6a, 0x83, 0x12, 0x03, 0xbe, 0x9c, 0x5a, 0xcd,
-	0xb1, 0x8e, 0xf7, 0x2f, 0x9e, 0xe1, 0xf3, 0x23, 0x78, 0xc1, 0x1c, 0x50, 0xbb, 0x66, 0xda, 0x75,
-	0xd1, 0xd5, 0xec, 0x18, 0xb1, 0xc7, 0x29, 0xb3, 0x7e, 0xbe, 0xcc, 0xff, 0x68, 0xd5, 0x70, 0x73,
-	0x46, 0xd7, 0x8b, 0x51, 0x9e, 0x4b, 0xa2, 0x9c, 0xac, 0xc2, 0x79, 0xdf, 0xf1, 0x0d, 0x4b, 0x6f,
-	0xf2, 0xc9, 0x98, 0x01, 0xe7, 0x58, 0x23, 0x2e, 0xa8, 0xed, 0xc1, 0x46, 0x92, 0x91, 0x47, 0xa6,
-	0xdf, 0x30, 0xed, 0xb2, 0xe1, 0xd3, 0xfd, 0x00, 0x8b, 0x90, 0x9b, 0x1c, 0xa8, 0x22, 0x02, 0xfd,
-	0x68, 0x00, 0x36, 0x7b, 0x5a, 0xa4, 0xe7, 0x98, 0xf4, 0x02, 0x9d, 0x5c, 0x87, 0xd9, 0xea, 0x91,
-	0xeb, 0x52, 0xdb, 0xd7, 0x8f, 0x4d, 0xbf, 0x51, 0x73, 0x8d, 0x63, 0xfd, 0xd8, 0xb4, 0x6b, 0xce,
-	0x71, 0x3e, 0xc7, 0xf8, 0x9d, 0xc6, 0xee, 0x47, 0xd8, 0xfb, 0x88, 0x75, 0x92, 0x1d, 0x98, 0x8e,
-	0xcd, 0x73, 0x0d, 0x9f, 0xe6, 0x07, 0xd9, 0x86, 0x9b, 0x8c, 0xcc, 0x0a, 0xf0, 0x93, 0x22, 0x4c,
-	0x76, 0x34, 0x04, 0x9d, 0xb6, 0xaa, 0x94, 0xd6, 0x68, 0x2d, 0x3f, 0xb4, 0xac, 0xac, 0x0f, 0x97,
-	0x27, 0xdc, 0xd0, 0xc5, 0x3b, 0xd8, 0xa1, 0x2d, 0xe0, 0x76, 0x0d, 0xaa, 0xc9, 0x01, 0xb5, 0x2c,
-	0xa9, 0xe2, 0x69, 0x6f, 0x85, 0x99, 0x12, 0xe9, 0x45, 0x7e, 0x66, 0xe0, 0xac, 0x50, 0x6a, 0x73,
-	0x65, 0xfc, 0xa7, 0x15, 0x30, 0x21, 0xda, 0x8c, 0x52, 0xf7, 0xae, 0x65, 0xd4, 0xdb, 0x4f, 0xe8,
-	0x27, 0x78, 0x23, 0x89, 0xf7, 0xb7, 0x5f, 0xf5, 0x24, 0xae, 0x84, 0xe0, 0xae, 0x9b, 0xe1, 0x51,
-	0x88, 0xce, 0xc5, 0x8b, 0xc2, 0x05, 0x37, 0xd2, 0xae, 0x5d, 0x83, 0x19, 0xae, 0x26, 0xb0, 0x1c,
-	0x0e, 0x6a, 0x54, 0x0f, 0x12, 0xc4, 0xb7, 0xf0, 0x7c, 0x16, 0x27, 0x21, 0xb6, 0xe8, 0xac, 0xc1,
-	0xce, 0xf6, 0x58, 0x82, 0xd1, 0x20, 0x26, 0x61, 0xb9, 0xe6, 0xb9, 0x00, 0x41, 0x13, 0x9e, 0x31,
-	0x5f, 0x47, 0x2c, 0xac, 0x4c, 0x1f, 0xb8, 0x8e, 0xf3, 0xb8, 0x87, 0x4d, 0xd7, 0x61, 0x99, 0x2f,
-	0x18, 0xb2, 0xfc, 0x77, 0x05, 0x41, 0x8a, 0xab, 0x75, 0x07, 0xb9, 0x01, 0x13, 0x4d, 0x97, 0x3e,
-	0x93, 0x4f, 0x16, 0xbe, 0xf2, 0x78, 0xd0, 0x21, 0x1e, 0x21, 0x2b, 0x70, 0x4e, 0x1a, 0x96, 0x63,
-	0xc3, 0x46, 0x2b, 0xc2, 0x90, 0x45, 0x00, 0x1c, 0x12, 0x5c, 0x63, 0x79, 0x66, 0x8e, 0xf0, 0x01,
-	0x86, 0xd7, 0x20, 0xab, 0x70, 0x96, 0x3e, 0xa3, 0xb6, 0xef, 0xe5, 0x87, 0xd8, 0x1e, 0x1a, 0xe5,
-	0xd1, 0xbb, 0x13, 0xb4, 0x95, 0xb1, 0x4b, 0xbb, 0x05, 0x6b, 0xcc, 0x91, 0x20, 0xc5, 0xca, 0xb4,
-	0xda, 0x30, 0xdc, 0x3a, 0x7d, 0xaf, 0x49, 0x5d, 0x56, 0xae, 0x7a, 0x0d, 0x59, 0x15, 0xb5, 0xa7,
-	0xac, 0x45, 0xba, 0xb3, 0xb3, 0x08, 0x2c, 0x5e, 0x5c, 0x43, 0x44, 0x5a, 0x46, 0x82, 0x16, 0xb6,
-	0x82, 0xb6, 0x07, 0x97, 0xc2, 0x3b, 0xd5, 0x6b, 0x03, 0xad, 0xa0, 0xb7, 0x19, 0x6b, 0xfc, 0xdf,
-	0x38, 0xf7, 0xe3, 0x3b, 0xf0, 0xbe, 0xdd, 0x3c, 0xca, 0xae, 0x8f, 0x41, 0xa6, 0x61, 0x5d, 0x1a,
-	0xe0, 0xfb, 0x99, 0xff, 0xd3, 0xfe, 0xab, 0xc4, 0x37, 0x2c, 0x2e, 0x97, 0x5d, 0x09, 0xc8, 0x16,
-	0x9c, 0x63, 0x85, 0x52, 0x3f, 0x34, 0x3d, 0x8f, 0xd6, 0xf2, 0x03, 0xb1, 0x42, 0x3a, 0xca, 0xfa,
-	0xdf, 0x65, 0xdd, 0xa4, 0x04, 0xe7, 0xf9, 0xf0, 0xb0, 0x9c, 0xe6, 0x62, 0xe3, 0xf9, 0x7a, 0x61,
-	0x69, 0x8d, 0xd5, 0xdf, 0xc1, 0x84, 0xfa, 0xfb, 0x65, 0x50, 0x2d, 0xe7, 0x98, 0x7a, 0x7e, 0x38,
-	0x8a, 0xbd, 0x3a, 0xc2, 0x9c, 0x1e, 0x62, 0x80, 0x67, 0xf9, 0x08, 0xe1, 0x50, 0xe0, 0xf9, 0xbd,
-	0xf3, 0x83, 0x02, 0x0c, 0x31, 0xdf, 0xc9, 0x8f, 0x15, 0x38, 0x27, 0xca, 0x25, 0x64, 0x85, 0xc3,
-	0xca, 0x90, 0xf2, 0xd4, 0x25, 0x61, 0x48, 0x92, 0x3a, 0xa7, 0x7d, 0xf1, 0xc5, 0x3f, 0xfe, 0xf3,
-	0xf3, 0x81, 0x1d, 0xb2, 0x5d, 0x6a, 0x52, 0xcb, 0xda, 0x62, 0xe1, 0x2d, 0x85, 0x2a, 0x71, 0x7b,
-	0x78, 0xe9, 0x79, 0x98, 0x07, 0xa7, 0xa5, 0xe7, 0xec, 0x62, 0x74, 0x4a, 0xbe, 0x0f, 0xe3, 0x11,
-	0xed, 0x47, 0x02, 0x94, 0x2c, 0xd7, 0xa9, 0x5a, 0x0a, 0x20, 0xe1, 0x2a, 0xa2, 0x5d, 0x62, 0x98,
-	0x96, 0xc8, 0x62, 0x26, 0x26, 0xf2, 0x1b, 0x05, 0x66, 0x92, 0xd5, 0x27, 0xb2, 0x9e, 0x0e, 0x44,
-	0x96, 0x7b, 0xd4, 0x37, 0xd3, 0xf1, 0x44, 0xf4, 0x18, 0xed, 0x0b, 0x0c, 0x56, 0x91, 0x5c, 0xc9,
-	0x84, 0x85, 0xb3, 0x90, 0xb1, 0x53, 0xf2, 0x6b, 0x05, 0xa6, 0x13, 0x75, 0x1e, 0x72, 0x59, 0x06,
-	0x99, 0x2a, 0x49, 0xa9, 0xa2, 0x37, 0x99, 0x92, 0x91, 0x76, 0x9d, 0x41, 0xdc, 0x26, 0xc5, 0x18,
-	0x44, 0xd3, 0x4e, 0x41, 0x18, 0xc4, 0x94, 0x9c, 0xc2, 0x98, 0xbc, 0x70, 0x34, 0x94, 0x09, 0xc2,
-	0x8f, 0xba, 0x92, 0x0a, 0xab, 0x87, 0x48, 0x4a, 0xda, 0xcc, 0x4f, 0x15, 0x6e, 0x5f, 0x78, 0x60,
-	0xaf, 0xca, 0xb9, 0x9d, 0x28, 0x1c, 0xc4, 0x10, 0xc4, 0xf5, 0x94, 0x8c, 0xa0, 0xc9, 0x2f, 0xfa,
-	0xd2, 0xf3, 0x8e, 0x2e, 0x70, 0x4a, 0x7e, 0xa5, 0x00, 0x89, 0x2b, 0x1f, 0xe4, 0x62, 0xaa, 0x3d,
-	0x41, 0x58, 0x51, 0x2f, 0x75, 0x19, 0x85, 0xc8, 0x6e, 0x30, 0x64, 0x6f, 0x93, 0xb7, 0x12, 0x90,
-	0x45, 0x15, 0x0a, 0xbd, 0x66, 0xf8, 0x86, 0x0c, 0xf1, 0x87, 0x0a, 0x4c, 0xc4, 0x74, 0x0e, 0x89,
-	0xb6, 0x34, 0xbd, 0x45, 0x4d, 0x77, 0x43, 0x8c, 0xdd, 0x65, 0x86, 0x6f, 0x85, 0x2c, 0x75, 0x61,
-	0x8e, 0x3c, 0x85, 0xe1, 0xf0, 0xcd, 0x4e, 0x16, 0xe5, 0xb0, 0x45, 0xb4, 0x05, 0x75, 0x5e, 0xec,
-	0x8e, 0x08, 0x18, 0xda, 0x9b, 0xcc, 0xe0, 0x2a, 0x59, 0x89, 0x19, 0x0c, 0x85, 0x80, 0x80, 0x81,
-	0x1a, 0x6d, 0x9d, 0x12, 0x07, 0x46, 0x05, 0x55, 0x41, 0xb2, 0x1a, 0x57, 0x34, 0xd4, 0x42, 0x82,
-	0x55, 0xd1, 0xd3, 0x15, 0x66, 0x78, 0x9e, 0xcc, 0xa5, 0x1a, 0x26, 0x3f, 0x51, 0x60, 0x3c, 0xf2,
-	0xe0, 0x95, 0xb2, 0x21, 0x55, 0x4f, 0x90, 0x0a, 0x5e, 0x8a, 0x7a, 0xa0, 0x6d, 0x33, 0x00, 0x1b,
-	0x64, 0x3d, 0x06, 0x20, 0xf2, 0xa6, 0x6e, 0x13, 0xf0, 0x23, 0x05, 0x48, 0xfc, 0xf5, 0x2e, 0x41,
-	0x4a, 0xd5, 0x11, 0xa4, 0x04, 0x4d, 0x97, 0x00, 0xb4, 0x75, 0x86, 0x4a, 0x23, 0xcb, 0xdd, 0x50,
-	0x11, 0x03, 0x06, 0x59, 0x26, 0xcc, 0xc9, 0x8c, 0x88, 0x39, 0x37, 0x2b, 0x74, 0x49, 0x1b, 0x34,
-	0xbd, 0x44, 0xb4, 0x70, 0x5b, 0x32, 0x87, 0x5f, 0x28, 0x00, 0x9d, 0x7b, 0x26, 0x59, 0x10, 0x96,
-	0x8b, 0x5d, 0x66, 0xd5, 0xc5, 0x94, 0x5e, 0x34, 0xf9, 0x36, 0x33, 0x79, 0x95, 0x94, 0x62, 0x26,
-	0x2b, 0xed, 0xc1, 0xd2, 0x89, 0xc7, 0x4f, 0xea, 0x53, 0xf2, 0x81, 0x02, 0xa3, 0x82, 0x10, 0x40,
-	0x96, 0xe3, 0xfe, 0xca, 0x7a, 0x83, 0x94, 0x7a, 0x09, 0x12, 0x42, 0x46, 0xe4, 0xb9, 0xf7, 0xf1,
-	0x63, 0x57, 0x87, 0x37, 0xc2, 0xcd, 0x3e, 0x27, 0x47, 0x5b, 0xa4, 0x5b, 0x8d, 0xd8, 0x15, 0xe3,
-	0xba, 0xc8, 0x6c, 0xce, 0x92, 0xe9, 0x44, 0x9b, 0xc4, 0x06, 0xe8, 0xbc, 0x3a, 0x24, 0xa2, 0x63,
-	0x2f, 0x18, 0x89, 0xe8, 0xf8, 0x53, 0x45, 0x5b, 0x65, 0x96, 0x16, 0xc9, 0x7c, 0xcc, 0x52, 0xb5,
-	0x63, 0xe1, 0x4f, 0x0a, 0xa8, 0xe9, 0x77, 0x66, 0x72, 0x45, 0x30, 0xd1, 0xf5, 0x7e, 0xae, 0x6e,
-	0xf5, 0x38, 0x1a, 0x01, 0x5e, 0x63, 0x00, 0xb7, 0xc8, 0x66, 0x0c, 0x60, 0x33, 0x1d, 0xd1, 0xef,
-	0x15, 0x98, 0x4b, 0xbd, 0x3b, 0x93, 0x4d, 0xb9, 0xd8, 0x64, 0xc3, 0xbd, 0xd2, 0xdb, 0x60, 0x44,
-	0xbb, 0xc3, 0xd0, 0x5e, 0x21, 0x1b, 0x49, 0x75, 0x2a, 0x05, 0xce, 0xfb, 0x30, 0x1e, 0x11, 0x1b,
-	0xa4, 0xa3, 0x3d, 0x59, 0x82, 0x91, 0x8b, 0x56, 0xb2, 0xaa, 0xa2, 0x5d, 0x64, 0x68, 0x0a, 0x64,
-	0x21, 0x81, 0xbb, 0x8e, 0xb1, 0xbf, 0x2a, 0x50, 0xc8, 0x56, 0x3b, 0xc8, 0x76, 0xba, 0xb1, 0x64,
-	0x75, 0x45, 0xbd, 0xfa, 0x29, 0x66, 0xf4, 0x10, 0xe9, 0x54, 0x64, 0xef, 0xc3, 0x98, 0xac, 0x3c,
-	0x48, 0x3b, 0x3e, 0x51, 0xb2, 0x90, 0xee, 0x24, 0xc9, 0xb2, 0x45, 0xc6, 0xc9, 0x6a, 0xc9, 0xd6,
-	0x3e, 0x54, 0xe0, 0x42, 0x54, 0x67, 0x20, 0x62, 0x6c, 0x52, 0x04, 0x0e, 0x75, 0x35, 0x73, 0x4c,
-	0xd7, 0xf3, 0x36, 0xaa, 0x61, 0x44, 0x81, 0xb0, 0xb7, 0x57, 0x1a, 0x10, 0xf1, 0x9d, 0x97, 0x06,
-	0x44, 0x7a, 0xbc, 0xf5, 0x06, 0x84, 0xdb, 0xfc, 0xa5, 0x02, 0x33, 0xc9, 0x1f, 0x3e, 0x48, 0x77,
-	0xfe, 0xcc, 0x2f, 0x28, 0xa4, 0x3b, 0x7f, 0xf6, 0x57, 0x14, 0xda, 0x26, 0x83, 0x76, 0x89, 0xac,
-	0xc6, 0xa0, 0x19, 0x71, 0x04, 0xbf, 0x50, 0x60, 0x32, 0xe1, 0x5b, 0x17, 0x22, 0x9e, 0xb7, 0xe9,
-	0x9f, 0xcb, 0xa8, 0x6b, 0xdd, 0x86, 0x21, 0xa6, 0x22, 0xc3, 0xb4, 0x4e, 0xd6, 0xe2, 0x55, 0x35,
-	0xe9, 0x4b, 0x1a, 0xf2, 0x37, 0x05, 0xe6, 0x33, 0x3e, 0x65, 0x21, 0x5b, 0x99, 0x76, 0xa3, 0x1f,
-	0xce, 0xa8, 0xc5, 0x5e, 0x87, 0x23, 0xdc, 0xdb, 0x0c, 0xee, 0x4d, 0xf2, 0x95, 0x9e, 0xe0, 0xea,
-	0x95, 0x13, 0x3d, 0x3c, 0xf4, 0x84, 0xe3, 0x6f, 0xef, 0xce, 0xc7, 0x2f, 0x0b, 0xca, 0x27, 0x2f,
-	0x0b, 0xca, 0xbf, 0x5f, 0x16, 0x94, 0x8f, 0x5e, 0x15, 0xce, 0x7c, 0xf2, 0xaa, 0x70, 0xe6, 0x9f,
-	0xaf, 0x0a, 0x67, 0xbe, 0xb3, 0x59, 0x37, 0xfd, 0xc6, 0x51, 0xa5, 0x58, 0x75, 0x0e, 0x4b, 0xdb,
-	0xad, 0x60, 0xf7, 0x3c, 0xa0, 0xfe, 0xb1, 0xe3, 0x3e, 0x29, 0x19, 0xb4, 0x6e, 0x7a, 0xa5, 0x16,
-	0x37, 0xe6, 0x9f, 0x34, 0xa9, 0x57, 0x39, 0xcb, 0x3e, 0x5e, 0xba, 0xf6, 0xbf, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x0f, 0x3c, 0x0d, 0xff, 0x27, 0x26, 0x00, 0x00,
+	// 3199 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xdb, 0x6f, 0x1b, 0xc7,
+	0xd5, 0xf7, 0x9a, 0xba, 0xf1, 0xe8, 0x3e, 0xba, 0xaf, 0x44, 0x4a, 0x5a, 0xd9, 0xb2, 0x62, 0xd9,
+	0xa4, 0x2d, 0x27, 0xce, 0x87, 0xe4, 0x4b, 0xf0, 0x49, 0x71, 0xe4, 0xe8, 0x8b, 0x92, 0x38, 0x8c,
+	0x93, 0x14, 0x0d, 0x02, 0x62, 0x45, 0x8e, 0xa9, 0x85, 0x57, 0xbb, 0xf4, 0xee, 0xd2, 0xa6, 0x6a,
+	0x28, 0x41, 0xf2, 0x90, 0xa0, 0xe8, 0x05, 0x45, 0xdb, 0xa7, 0xbe, 0xb4, 0x68, 0x51, 0x14, 0x28,
+	0xd2, 0xa2, 0x7d, 0x2d, 0xd0, 0x97, 0x3e, 0xa4, 0xe9, 0x5b, 0x80, 0xbe, 0x14, 0x7d, 0x08, 0x8a,
+	0xa4, 0x2f, 0x45, 0xff, 0x89, 0x62, 0x66, 0xce, 0x92, 0x33, 0x7b, 0x21, 0x19, 0x57, 0x29, 0xfa,
+	0x46, 0xce, 0xb9, 0xfd, 0xe6, 0x9c, 0x33, 0x67, 0x66, 0xce, 0x2c, 0x4c, 0x34, 0x8f, 0xfc, 0x5a,
+	0xf1, 0x5e, 0x83, 0x7a, 0xc7, 0x85, 0xba, 0xe7, 0x06, 0x2e, 0xe9, 0x63, 0x23, 0xfa, 0xc5, 0x8a,
+	0xeb, 0x1f, 0xb9, 0x7e, 0xf1, 0xc0, 0xf4, 0xa9, 0x20, 0x17, 0xef, 0x5f, 0x3d, 0xa0, 0x81, 0x79,
+	0xb5, 0x58, 0x37, 0x6b, 0x96, 0x63, 0x06, 0x96, 0xeb, 0x08, 0x09, 0x7d, 0xba, 0xe6, 0xd6, 0x5c,
+	0xfe, 0xb3, 0xc8, 0x7e, 0xe1, 0xe8, 0x52, 0xcd, 0x75, 0x6b, 0x36, 0x2d, 0x9a, 0x75, 0xab, 0x68,
+	0x3a, 0x8e, 0x1b, 0x70, 0x11, 0x1f, 0xa9, 0xb3, 0xdc, 0x6e, 0xe5, 0xd0, 0xb4, 0x9c, 0xb2, 0xe5,
+	0x54, 0x69, 0x13, 0xc7, 0x97, 0xc5, 0xb8, 0xe7, 0xfa, 0xbe, 0x20, 0xde, 0xa1, 0xb4, 0x5c, 0x37,
+	0x3d, 0xf3, 0x28, 0x34, 0xc6, 0x19, 0x6a, 0xa6, 0x5f, 0xae, 0x7b, 0x56, 0x85, 0xe2, 0x68, 0x8e,
+	0x8f, 0x5a, 0x4e, 0x39, 0x68, 0x96, 0x0f, 0x4d, 0xff, 0xb0, 0x1c, 0xb8, 0x65, 0x36, 0x84, 0xe4,
+	0x79, 0x89, 0x1c, 0x78, 0x66, 0xe5, 0x2e, 0xf5, 0x42, 0x94, 0x9c, 0x62, 0x9b, 0x7e, 0x50, 0x3e,
+	0xb0, 0xdd, 0xca, 0xdd, 0xf2, 0x21, 0xb5, 0x6a, 0x87, 0x01, 0x52, 0x17, 0x38, 0xd5, 0x6d, 0x04,
+	0x71, 0xc1, 0x19, 0x4e, 0xaa, 0x53, 0xdb, 0x2e, 0xd3, 0xfb, 0xd4, 0x09, 0x14, 0x20, 0x9e, 0x19,
+	0xd0, 0xb2, 0x6d, 0x1d, 0x59, 0x01, 0xf5, 0xca, 0x77, 0x6c, 0xb3, 0x16, 0x4e, 0x5b, 0x17, 0x64,
+	0x5a, 0x39, 0x34, 0xbd, 0x1a, 0x2d, 0xdb, 0xb4, 0x5a, 0x6b, 0x69, 0x1c, 0xe7, 0xb4, 0x36, 0x6a,
+	0x63, 0x15, 0x96, 0x5f, 0x65, 0x9e, 0x7f, 0xae, 0xe5, 0x8d, 0x5d, 0x4a, 0x6f, 0x31, 0x5f, 0xf8,
+	0x25, 0x7a, 0xaf, 0x41, 0xfd, 0xc0, 0xa8, 0xc3, 0x4a, 0x3a, 0x8b, 0x5f, 0x77, 0x1d, 0x9f, 0x92,
+	0x7d, 0x98, 0x49, 0xf2, 0xa7, 0x3f, 0xaf, 0xad, 0x64, 0x36, 0x86, 0xb7, 0xe6, 0x0b, 0xdc, 0x64,
+	0x5c, 0x43, 0x69, 0xaa, 0x12, 0xd7, 0x6a, 0xdc, 0x80, 0x0b, 0x29, 0x16, 0x77, 0x8e, 0x9f, 0x63,
+	0x7f, 0xf7, 0xaa, 0x08, 0x8e, 0x2c, 0xc0, 0x10, 0x06, 0xb8, 0x3a, 0xaf, 0xad, 0x68, 0x1b, 0x99,
+	0xd2, 0x60, 0x45, 0x70, 0x18, 0xf7, 0x61, 0xa3, 0xbb, 0x16, 0xc4, 0xff, 0xff, 0x30, 0x9d, 0x84,
+	0x9f, 0xab, 0xec, 0x04, 0x9f, 0xc4, 0xe1, 0x1b, 0xff, 0xd4, 0x20, 0x1f, 0x37, 0xfc, 0x6a, 0xc3,
+	0x0d, 0x68, 0x88, 0x7a, 0x1d, 0xc6, 0x7d, 0xb7, 0xe1, 0x55, 0x68, 0x39, 0x02, 0x7e, 0x54, 0x0c,
+	0x23, 0x3c, 0x72, 0x05, 0xa6, 0xab, 0xd4, 0x0f, 0x70, 0x29, 0xb4, 0x99, 0xcf, 0x72, 0x66, 0x22,
+	0xd1, 0x42, 0x89, 0xa7, 0x01, 0x78, 0xaa, 0x94, 0x83, 0xe3, 0x3a, 0x9d, 0xcf, 0xac, 0x68, 0x1b,
+	0x63, 0x5b, 0x4b, 0x09, 0xf0, 0x9f, 0x67, 0x4c, 0xb7, 0x8f, 0xeb, 0xb4, 0x94, 0xa5, 0xe1, 0x4f,
+	0xb2, 0x05, 0x33, 0xb2, 0x39, 0xb6, 0x00, 0x78, 0x82, 0xcd, 0xf7, 0xad, 0x68, 0x1b, 0x7d, 0xa5,
+	0x29, 0x89, 0x78, 0xd3, 0xf4, 0xf7, 0x19, 0xc9, 0xf8, 0x53, 0x1f, 0x2c, 0xa7, 0xce, 0x16, 0xbd,
+	0xfb, 0x7f, 0x30, 0xc2, 0xb3, 0xad, 0xe2, 0xda, 0xcc, 0xb7, 0x7c, 0xae, 0xd9, 0x9d, 0xdc, 0x27,
+	0x9f, 0x2d, 0x9f, 0xf9, 0xeb, 0x67, 0xcb, 0x33, 0xa2, 0x0c, 0xf8, 0xd5, 0xbb, 0x05, 0xcb, 0x2d,
+	0x1e, 0x99, 0xc1, 0x61, 0x61, 0xcf, 0x09, 0x4a, 0xc3, 0xa1, 0xc8, 0x2e, 0xa5, 0x64, 0x11, 0xb2,
+	0x6d, 0x34, 0x67, 0x39, 0x9a, 0xa1, 0x1a, 0x42, 0x20, 0x4f, 0x43, 0xb6, 0xb5, 0x56, 0xf9, 0x94,
+	0xb3, 0x3b, 0x79, 0xd4, 0x3d, 0x1b, 0xd7, 0xfd, 0xba, 0xe5, 0x04, 0x5c, 0xf8, 0x16, 0xe3, 0x27,
+	0xdb, 0x0c, 0x9b, 0xe5, 0x7a, 0x56, 0x70, 0xcc, 0xb1, 0xf5, 0xf5, 0x24, 0x3f, 0x1c, 0xca, 0x30,
+	0x70, 0xd7, 0x61, 0x90, 0xd9, 0x67, 0xd2, 0xfd, 0xbd, 0xcc, 0x6c, 0xa0, 0x66, 0xfa, 0x4c, 0x6e,
+	0x07, 0x46, 0x7d, 0xf3, 0x0e, 0x0d, 0x8e, 0xcb, 0x47, 0xa6, 0x57, 0xb3, 0x9c, 0xf9, 0x81, 0x5e,
+	0xa4, 0x47, 0x84, 0xcc, 0x4b, 0x5c, 0x84, 0x6c, 0xc3, 0x68, 0xe0, 0x06, 0xa6, 0x5d, 0x0e, 0x11,
+	0x0c, 0xf6, 0xe4, 0x5b, 0x2e, 0x73, 0x53, 0xc0, 0xd8, 0x87, 0x29, 0x45, 0x45, 0xd9, 0x64, 0x15,
+	0x67, 0x7e, 0xa8, 0x17, 0x45, 0x13, 0x92, 0xa2, 0x6d, 0x26, 0x46, 0x9e, 0x82, 0xac, 0xd0, 0xc6,
+	0xc0, 0x64, 0x7b, 0xd1, 0x31, 0xc4, 0xf9, 0x77, 0x29, 0x35, 0xce, 0x81, 0xc1, 0x53, 0x69, 0xdf,
+	0xf2, 0x83, 0x6d, 0xdb, 0x76, 0x1f, 0xd0, 0xea, 0xd7, 0x8e, 0xfc, 0xda, 0x6b, 0xd4, 0xa9, 0x52,
+	0xaf, 0x55, 0x8f, 0xb6, 0x61, 0xad, 0x23, 0x17, 0x26, 0x9d, 0x0e, 0x43, 0x07, 0x0d, 0xcb, 0x66,
+	0x63, 0xbc, 0x0a, 0x65, 0x4b, 0xad, 0xff, 0xc6, 0xcb, 0xb0, 0xc8, 0x55, 0xdc, 0xa4, 0xc1, 0x2b,
+	0x8d, 0xe0, 0x76, 0xf3, 0xb6, 0x28, 0xbb, 0xdd, 0x8b, 0x0a, 0x99, 0x86, 0x7e, 0xc7, 0x75, 0x2a,
+	0x14, 0x93, 0x50, 0xfc, 0x31, 0xde, 0x82, 0x05, 0xae, 0x4f, 0x55, 0x86, 0x40, 0x9e, 0x85, 0x31,
+	0xb5, 0xba, 0x63, 0x55, 0x21, 0x62, 0x59, 0xca, 0x32, 0x3b, 0x7d, 0xcc, 0x55, 0xa5, 0x11, 0x57,
+	0x1a, 0x33, 0x28, 0x82, 0xdd, 0xb6, 0xed, 0x24, 0xb0, 0xbb, 0x00, 0xed, 0xdd, 0x12, 0x55, 0xaf,
+	0x17, 0x84, 0xab, 0x0b, 0x6c, 0x6b, 0x2d, 0x88, 0x9d, 0x17, 0xb7, 0xd6, 0xc2, 0x2d, 0xb3, 0x16,
+	0xd6, 0xa1, 0x92, 0x24, 0x69, 0xfc, 0x42, 0x83, 0xa5, 0xd8, 0x24, 0xb6, 0x6d, 0xbb, 0xe3, 0x3c,
+	0x32, 0xbd, 0xcf, 0x83, 0xdc, 0x54, 0x80, 0x9e, 0xe5, 0x40, 0x2f, 0x74, 0x05, 0x2a, 0x8c, 0x2b,
+	0x48, 0xdf, 0xd7, 0xc0, 0x48, 0xf2, 0x08, 0x96, 0xf5, 0xd0, 0x31, 0xd3, 0xd0, 0xcf, 0xa3, 0x86,
+	0x21, 0x14, 0x7f, 0xc8, 0x6e, 0x02, 0x8a, 0x47, 0x71, 0xd7, 0x6f, 0x34, 0x4c, 0xc3, 0x88, 0xbb,
+	0x5a, 0x20, 0x5a, 0xb5, 0x6f, 0x5c, 0xf5, 0x9a, 0xdf, 0xd5, 0x6d, 0xa3, 0xb2, 0xdb, 0xfc, 0xd3,
+	0xf3, 0xdb, 0x07, 0x1a, 0xac, 0x86, 0x7e, 0xdb, 0x73, 0xd2, 0xdc, 0xd6, 0x21, 0xf9, 0x4f, 0xcb,
+	0x77, 0x1f, 0x85, 0x01, 0xdc, 0x73, 0x3a, 0xb9, 0xee, 0x19, 0x18, 0xb3, 0x9c, 0x04, 0xcf, 0x4d,
+	0x0a, 0xcf, 0xed, 0x39, 0x51, 0xc7, 0x8d, 0x58, 0xce, 0x57, 0xe1, 0x37, 0x69, 0x01, 0x4a, 0x36,
+	0xfd, 0xd3, 0x5e, 0x80, 0x3f, 0xd7, 0xd0, 0x8e, 0xea, 0x95, 0xff, 0x3a, 0x77, 0x3c, 0x03, 0xb9,
+	0xb0, 0x78, 0x32, 0x9b, 0x2f, 0x98, 0xfe, 0xe1, 0x6d, 0x97, 0x55, 0xe0, 0xd0, 0x21, 0x4b, 0x00,
+	0xed, 0x53, 0xb2, 0xd8, 0xec, 0x4b, 0x43, 0x16, 0xb2, 0xb6, 0xbc, 0x19, 0x95, 0xc5, 0x59, 0xee,
+	0x02, 0x89, 0x1f, 0xb1, 0xd1, 0xab, 0xd3, 0xed, 0x99, 0xb6, 0x25, 0x71, 0xb2, 0x63, 0x96, 0x32,
+	0x6a, 0x3c, 0x8b, 0x87, 0x30, 0x95, 0xf9, 0x86, 0x19, 0x98, 0xbd, 0xc1, 0xdc, 0x83, 0xe5, 0x54,
+	0x79, 0x84, 0xba, 0x0e, 0xfd, 0x0c, 0x4f, 0x18, 0x07, 0x10, 0xe8, 0x24, 0x4c, 0x82, 0x6c, 0xd4,
+	0x20, 0x27, 0xe7, 0x4f, 0xdc, 0x61, 0xa7, 0x95, 0x41, 0xbf, 0xd5, 0x12, 0x27, 0x2d, 0x27, 0x51,
+	0x9a, 0x7b, 0x33, 0x5f, 0xce, 0xbd, 0xa7, 0x97, 0x4d, 0x45, 0x98, 0x0b, 0xb3, 0xe9, 0x26, 0x9e,
+	0xc9, 0xa4, 0x02, 0xce, 0xaf, 0x6d, 0x18, 0x1b, 0xf1, 0xc7, 0xb8, 0x01, 0x33, 0x42, 0xa0, 0xc5,
+	0x8d, 0x53, 0xdb, 0x94, 0x8f, 0x81, 0xc2, 0x89, 0x63, 0x62, 0x46, 0x2d, 0xd6, 0xd6, 0xb1, 0xcf,
+	0x30, 0xd1, 0xec, 0xb6, 0x6d, 0x47, 0xcd, 0x9e, 0x56, 0x34, 0xbe, 0xaf, 0xc1, 0xbc, 0x82, 0x54,
+	0x8e, 0xc3, 0x65, 0x80, 0x16, 0xd8, 0x30, 0x81, 0xa2, 0x68, 0xb3, 0x21, 0xda, 0x53, 0x5c, 0xbc,
+	0xd7, 0x31, 0x43, 0x6e, 0xd2, 0x60, 0xdf, 0xf4, 0x83, 0x1d, 0x76, 0x1f, 0x7d, 0x81, 0x5f, 0x47,
+	0x3b, 0x7b, 0xdd, 0xc4, 0xc3, 0x41, 0x4c, 0x08, 0xe7, 0xb3, 0x0d, 0x93, 0xb1, 0x0b, 0x2e, 0xfa,
+	0x6e, 0x46, 0x4c, 0x2b, 0x2a, 0x39, 0x6e, 0xab, 0x03, 0xc6, 0x21, 0x42, 0xdb, 0xb6, 0xed, 0x14,
+	0x68, 0xa7, 0x15, 0x99, 0x5f, 0x69, 0xb8, 0xb8, 0x23, 0x76, 0xe4, 0x00, 0xa5, 0x4c, 0x28, 0xd3,
+	0xfb, 0x84, 0x4e, 0x2f, 0x68, 0x9b, 0x30, 0x15, 0x06, 0x4d, 0x2e, 0x1b, 0xc9, 0x91, 0x7a, 0x09,
+	0x74, 0x99, 0x79, 0xe7, 0xf8, 0x65, 0x76, 0x44, 0x7d, 0xe4, 0xa3, 0xed, 0x53, 0x98, 0xc4, 0x8a,
+	0x2e, 0xf4, 0x51, 0x1e, 0xfa, 0xa4, 0xea, 0x2c, 0xd5, 0xbf, 0x12, 0x1f, 0x37, 0xae, 0xc1, 0x64,
+	0x4b, 0xb6, 0x67, 0xa1, 0xb7, 0x71, 0xb2, 0xdb, 0xb6, 0xfd, 0x55, 0xd4, 0xc8, 0xf7, 0x34, 0x98,
+	0x6e, 0x81, 0x92, 0x03, 0xbe, 0x92, 0x5a, 0xcd, 0xb1, 0x8e, 0x9f, 0x5e, 0x3c, 0x3f, 0xce, 0xe0,
+	0x7d, 0x81, 0x69, 0xf7, 0x77, 0x8e, 0x77, 0x2d, 0x3b, 0x68, 0x1f, 0xe8, 0x67, 0x61, 0xc0, 0xe7,
+	0x77, 0x19, 0x8c, 0x2b, 0xfe, 0x63, 0x77, 0xe0, 0xa0, 0x59, 0x76, 0x3d, 0xab, 0x66, 0x09, 0xeb,
+	0xd9, 0xd2, 0x50, 0xd0, 0x7c, 0x85, 0xff, 0x67, 0xb7, 0x1d, 0x8f, 0x56, 0xa8, 0x75, 0x9f, 0x7a,
+	0xe2, 0x0a, 0x5c, 0x6a, 0xfd, 0x27, 0x17, 0x61, 0x32, 0xfc, 0xdd, 0x6e, 0x21, 0xf4, 0xf1, 0xe0,
+	0x8f, 0x87, 0x84, 0xb0, 0x7f, 0x70, 0x09, 0x86, 0xfc, 0xc0, 0x0c, 0x1a, 0x3e, 0xf5, 0xe7, 0xfb,
+	0x57, 0x32, 0x1b, 0x63, 0x5b, 0x13, 0x6d, 0x47, 0xbc, 0xc6, 0x29, 0xa5, 0x16, 0x07, 0x79, 0x81,
+	0xed, 0x26, 0x07, 0x6e, 0xc3, 0xa9, 0x96, 0xa5, 0xae, 0xc3, 0x00, 0xef, 0x3a, 0xe8, 0xe1, 0x6e,
+	0xc2, 0xe9, 0xb7, 0xa8, 0x6d, 0xb7, 0x7b, 0x0e, 0x13, 0x28, 0xd5, 0x1a, 0x21, 0x4f, 0xc0, 0xdc,
+	0x11, 0x03, 0x86, 0xda, 0x78, 0xcf, 0x0b, 0x17, 0xdd, 0x20, 0x4f, 0xc7, 0xe9, 0x23, 0xcb, 0x91,
+	0x74, 0xe1, 0x12, 0x63, 0x62, 0x66, 0x33, 0x51, 0x6c, 0x08, 0xc5, 0xcc, 0x66, 0x5c, 0x4c, 0x4d,
+	0xa6, 0xec, 0x23, 0x27, 0xd3, 0x87, 0x1a, 0x2e, 0xb6, 0x48, 0x20, 0xff, 0xf3, 0x29, 0x15, 0xde,
+	0x68, 0xd9, 0xa5, 0xf8, 0x16, 0x75, 0xaa, 0x96, 0x53, 0x93, 0x57, 0x4f, 0xe7, 0x65, 0xdf, 0x6e,
+	0xab, 0x8c, 0x96, 0xc4, 0x1f, 0xa3, 0x02, 0x4b, 0xc9, 0xfa, 0x62, 0xab, 0x38, 0x93, 0xb4, 0x8a,
+	0xc9, 0x5a, 0xd8, 0x97, 0xa8, 0x0b, 0x61, 0x2c, 0x2a, 0x23, 0x7c, 0x10, 0x15, 0x1a, 0x3b, 0x70,
+	0x31, 0xc9, 0xc8, 0x9b, 0x56, 0x70, 0x68, 0x39, 0x25, 0x33, 0xa0, 0xbc, 0xbf, 0x23, 0x95, 0x3b,
+	0x01, 0x54, 0x93, 0x81, 0x7e, 0x94, 0x81, 0xcd, 0x9e, 0x94, 0xf4, 0x1c, 0x93, 0x5e, 0xa0, 0x93,
+	0xeb, 0x30, 0x57, 0x69, 0x78, 0x1e, 0xcb, 0xf9, 0x07, 0x56, 0x70, 0x58, 0xf5, 0xcc, 0x07, 0xe5,
+	0x07, 0x96, 0x53, 0x75, 0x1f, 0xf0, 0xe5, 0x97, 0x29, 0xcd, 0x20, 0xf9, 0x4d, 0xa4, 0xbe, 0xc9,
+	0x89, 0xac, 0xc5, 0x16, 0x93, 0xf3, 0xcc, 0x00, 0xfb, 0x4e, 0xa5, 0xa9, 0x88, 0x14, 0xc3, 0x4f,
+	0x0a, 0x30, 0xd5, 0x6e, 0xf6, 0x96, 0x69, 0xb3, 0x42, 0x69, 0x95, 0x56, 0x79, 0xaf, 0x69, 0xa8,
+	0x34, 0xe9, 0x85, 0x53, 0x7c, 0x1e, 0x09, 0x64, 0x1f, 0x26, 0x45, 0xb0, 0xdb, 0x52, 0xfe, 0xfc,
+	0x00, 0x9f, 0x2e, 0x2e, 0x4a, 0x71, 0xcf, 0x0a, 0x05, 0xc5, 0xb2, 0xc6, 0x83, 0xde, 0x78, 0x45,
+	0xa1, 0xf9, 0x4c, 0x9b, 0x58, 0xdb, 0xb2, 0xb6, 0x41, 0x59, 0x1b, 0x5f, 0xc5, 0x29, 0xda, 0xa8,
+	0x42, 0xf3, 0x8d, 0x25, 0x5c, 0x30, 0x6c, 0xf3, 0x6c, 0x2f, 0xc8, 0xb0, 0xb5, 0xf3, 0x04, 0x2c,
+	0x26, 0x52, 0x31, 0x76, 0xb3, 0x30, 0x20, 0x9d, 0x2c, 0x32, 0x25, 0xfc, 0x67, 0xe4, 0x31, 0x59,
+	0x5b, 0x76, 0xa8, 0xb7, 0x6b, 0x9b, 0xb5, 0x56, 0xc7, 0xe8, 0x2e, 0xe4, 0x52, 0xe8, 0xad, 0xf6,
+	0x2f, 0x89, 0xb7, 0xd3, 0x71, 0x93, 0x99, 0x15, 0x93, 0x8c, 0xca, 0xe2, 0x04, 0x27, 0xbc, 0xc8,
+	0xb8, 0x71, 0x0d, 0x66, 0x45, 0x3f, 0x94, 0xaf, 0x2f, 0xb6, 0x25, 0xf7, 0xd0, 0xab, 0x7e, 0x1d,
+	0xe6, 0x62, 0x42, 0x88, 0x2d, 0x2a, 0xd5, 0xd7, 0x5e, 0xba, 0xcb, 0x30, 0xcc, 0xf2, 0x25, 0xac,
+	0x78, 0x22, 0x4f, 0x81, 0x0d, 0xe1, 0x91, 0xea, 0x45, 0xc4, 0xc2, 0x4f, 0x25, 0xb7, 0x3c, 0xd7,
+	0xbd, 0xd3, 0x43, 0x41, 0x68, 0x7b, 0x59, 0x28, 0x0c, 0xbd, 0xfc, 0x47, 0x0d, 0xe6, 0x62, 0xda,
+	0xba, 0x83, 0xbc, 0x08, 0x93, 0x75, 0x8f, 0xde, 0x57, 0x0f, 0x52, 0x42, 0xf3, 0x38, 0x23, 0xc8,
+	0x27, 0xa6, 0x55, 0x18, 0x51, 0xd8, 0x32, 0x9c, 0x6d, 0xf8, 0x40, 0x62, 0xc9, 0x01, 0x20, 0x0b,
+	0xbb, 0xb5, 0x89, 0x55, 0x93, 0x15, 0x0c, 0xa6, 0x7f, 0x48, 0xd6, 0x60, 0x80, 0xa7, 0x9c, 0xd8,
+	0xbd, 0x86, 0xb7, 0x86, 0xe5, 0x14, 0x45, 0x92, 0xf1, 0x1c, 0xac, 0xf3, 0x89, 0xb0, 0x14, 0x2b,
+	0xe1, 0x3b, 0xc9, 0x2b, 0x75, 0xea, 0xf1, 0x52, 0xda, 0x6b, 0xc8, 0x2a, 0x70, 0xa1, 0xab, 0x92,
+	0xee, 0xde, 0xc9, 0x01, 0x8f, 0x97, 0x78, 0x9f, 0x42, 0xb7, 0x64, 0xd9, 0x08, 0xd7, 0x60, 0xec,
+	0xc0, 0xf9, 0xf0, 0x0a, 0xf1, 0xc8, 0x40, 0x0f, 0x60, 0xbd, 0x9b, 0x8e, 0x7f, 0x1b, 0xe7, 0x7e,
+	0x7c, 0x05, 0xee, 0x39, 0xf5, 0x46, 0xe7, 0xda, 0xcd, 0x32, 0x0d, 0x6b, 0xa6, 0x78, 0xd0, 0xc0,
+	0x7f, 0xc6, 0xef, 0x33, 0x90, 0x4b, 0x51, 0xd7, 0xb9, 0x12, 0x90, 0xcb, 0x30, 0xc2, 0x8b, 0x78,
+	0xf9, 0xc8, 0xf2, 0x7d, 0xca, 0x1e, 0x4a, 0xa2, 0x45, 0x7e, 0x98, 0xd3, 0x5f, 0xe2, 0x64, 0x52,
+	0x84, 0x51, 0xc1, 0x1e, 0x96, 0xfa, 0x4c, 0x8c, 0x5f, 0xe8, 0x0b, 0xcb, 0x7e, 0x6c, 0x6f, 0xe8,
+	0x4b, 0xd8, 0x1b, 0x9e, 0x06, 0x9d, 0x75, 0xa5, 0xfd, 0x20, 0xe4, 0xe2, 0x97, 0xec, 0x30, 0xa7,
+	0xfb, 0x39, 0xe0, 0x39, 0xc1, 0x21, 0x6d, 0x58, 0x98, 0xdf, 0x1b, 0x30, 0x51, 0x67, 0xf7, 0x0e,
+	0x81, 0xeb, 0xbe, 0x69, 0x37, 0xc4, 0x81, 0x2a, 0x5b, 0x1a, 0x63, 0xe3, 0x8c, 0xd3, 0x7f, 0x83,
+	0x8d, 0x92, 0x17, 0x61, 0x92, 0x73, 0x8a, 0x90, 0x71, 0xce, 0xb0, 0x30, 0x2f, 0x24, 0x95, 0x79,
+	0x2e, 0x15, 0xd6, 0x65, 0x26, 0xc9, 0xc9, 0x7c, 0xd4, 0x6f, 0x29, 0x13, 0xa5, 0x1e, 0x95, 0x0d,
+	0xc9, 0xca, 0xd4, 0x2a, 0x1f, 0x53, 0xc6, 0xc9, 0x42, 0x99, 0xe1, 0x62, 0x91, 0x0f, 0xd3, 0x6d,
+	0x9f, 0x3f, 0x41, 0xf6, 0x50, 0x7a, 0xae, 0x03, 0x04, 0xee, 0x5d, 0xea, 0x88, 0x73, 0xe4, 0x59,
+	0x7e, 0x8e, 0x9c, 0xc3, 0xfa, 0x8b, 0xba, 0x6e, 0x33, 0xba, 0x78, 0xb8, 0x0a, 0xc2, 0x9f, 0xc6,
+	0xbb, 0xb8, 0x6f, 0x44, 0x0d, 0x62, 0xb6, 0x6c, 0xc1, 0x80, 0x78, 0x05, 0x55, 0xfb, 0x48, 0x2a,
+	0x37, 0x4e, 0x06, 0x39, 0xc9, 0x25, 0x18, 0x38, 0x68, 0x54, 0x6b, 0x34, 0x98, 0x3f, 0x9b, 0x24,
+	0xb3, 0xc3, 0x69, 0x25, 0xe4, 0x91, 0x5b, 0x3c, 0xc9, 0x93, 0x3e, 0xad, 0xeb, 0xcb, 0x8f, 0xc3,
+	0x16, 0x8f, 0x6a, 0x46, 0xbe, 0xc8, 0x3c, 0x0e, 0x83, 0x62, 0x0e, 0xbe, 0xda, 0xd7, 0x49, 0x9c,
+	0x6e, 0xc8, 0x7a, 0x7a, 0x27, 0xd1, 0xe5, 0xb8, 0x2b, 0xd0, 0x59, 0xb8, 0x1b, 0xbf, 0x11, 0x99,
+	0x81, 0xa0, 0x46, 0x66, 0x20, 0xfc, 0x9a, 0x32, 0x03, 0x21, 0x11, 0xce, 0x00, 0x59, 0xb7, 0xfe,
+	0xb1, 0x06, 0xfd, 0x5c, 0x31, 0xf9, 0xb6, 0x06, 0x23, 0x72, 0x5f, 0x9d, 0xac, 0x0a, 0xf9, 0x0e,
+	0x6f, 0x3e, 0xfa, 0xb2, 0xc4, 0x92, 0xf4, 0x8c, 0x63, 0xfc, 0xcf, 0xfb, 0x7f, 0xfe, 0xfb, 0x0f,
+	0xce, 0x6e, 0x91, 0x2b, 0xfc, 0x41, 0xfe, 0x32, 0x4f, 0xd9, 0x62, 0xf8, 0x76, 0xdf, 0x62, 0x2f,
+	0x3e, 0x0c, 0x73, 0xfb, 0xa4, 0xf8, 0x90, 0xdf, 0xa0, 0x4f, 0xc8, 0xbb, 0x30, 0x1e, 0x79, 0x24,
+	0x50, 0x00, 0x25, 0xbf, 0xeb, 0xe8, 0x46, 0x0a, 0x20, 0xc9, 0x51, 0xc6, 0x79, 0x8e, 0x69, 0x99,
+	0xe4, 0x3a, 0x62, 0x22, 0x3f, 0xd3, 0x60, 0x36, 0xf9, 0x99, 0x82, 0x6c, 0xa4, 0x03, 0x51, 0xdf,
+	0x05, 0xf4, 0xc7, 0xd2, 0xf1, 0x44, 0x1a, 0xf7, 0xc6, 0xe3, 0x1c, 0x56, 0x81, 0x5c, 0xea, 0x08,
+	0x0b, 0xa5, 0xd0, 0x63, 0x27, 0xe4, 0xa7, 0x1a, 0xcc, 0x24, 0x3e, 0x08, 0x90, 0x0b, 0x2a, 0xc8,
+	0xd4, 0xb7, 0x0b, 0x5d, 0x9e, 0x4d, 0xc7, 0xb7, 0x05, 0xe3, 0x3a, 0x87, 0x78, 0x85, 0x14, 0x62,
+	0x10, 0x2d, 0x27, 0x05, 0x21, 0x8b, 0x29, 0x39, 0x81, 0xb1, 0x3d, 0xa7, 0x53, 0x28, 0x13, 0x5e,
+	0x08, 0xf4, 0xd5, 0x54, 0x58, 0x3d, 0x44, 0x52, 0x69, 0xe2, 0x7f, 0x57, 0x13, 0xf6, 0xa5, 0x4e,
+	0xec, 0x9a, 0x9a, 0xdb, 0x89, 0x1d, 0xe6, 0x18, 0x82, 0x78, 0xe3, 0xbd, 0x43, 0xd0, 0xd4, 0xd6,
+	0x6f, 0xf1, 0x61, 0xbb, 0x81, 0x7c, 0x42, 0x7e, 0xa2, 0x01, 0x89, 0xb7, 0xc8, 0xc9, 0xb9, 0x54,
+	0x7b, 0x52, 0x07, 0x5e, 0x3f, 0xdf, 0x85, 0x0b, 0x91, 0x3d, 0xc3, 0x91, 0x3d, 0x49, 0x9e, 0x48,
+	0x40, 0x16, 0x6d, 0x65, 0x97, 0xab, 0x66, 0x60, 0xaa, 0x10, 0x3f, 0xd4, 0x60, 0x32, 0xd6, 0x10,
+	0x27, 0x6b, 0xf1, 0xb0, 0xc5, 0xdd, 0x96, 0x3e, 0x0d, 0x39, 0x76, 0x17, 0x38, 0xbe, 0x55, 0xb2,
+	0xdc, 0xc5, 0x73, 0xe4, 0x1e, 0x0c, 0x85, 0xcd, 0x5d, 0x92, 0x53, 0xc3, 0x16, 0x69, 0x42, 0xeb,
+	0x8b, 0x32, 0x39, 0xd2, 0xe9, 0x36, 0x1e, 0xe3, 0x06, 0xd7, 0xc8, 0x6a, 0xcc, 0x60, 0xd8, 0x31,
+	0x66, 0x1e, 0xa8, 0xd2, 0xe6, 0x09, 0x71, 0x61, 0x58, 0x6a, 0x3f, 0x2b, 0x56, 0xe3, 0xad, 0x6f,
+	0x3d, 0x9f, 0x60, 0x55, 0x9e, 0xe9, 0x2a, 0x37, 0xbc, 0x48, 0x16, 0x52, 0x0d, 0x93, 0xef, 0x68,
+	0x30, 0x1e, 0xe9, 0x8c, 0x2a, 0xd9, 0x90, 0xda, 0x78, 0x56, 0x0a, 0x5e, 0x4a, 0x9b, 0xd9, 0xb8,
+	0xc2, 0x01, 0x5c, 0x24, 0x1b, 0x31, 0x00, 0x91, 0xe6, 0x6b, 0xcb, 0x01, 0xdf, 0xd2, 0x80, 0xc4,
+	0xdb, 0xbc, 0x0a, 0xa4, 0xd4, 0x86, 0xb3, 0x92, 0xa0, 0xe9, 0xbd, 0x62, 0x63, 0x83, 0xa3, 0x32,
+	0xc8, 0x4a, 0x37, 0x54, 0xc4, 0x84, 0x3e, 0x9e, 0x09, 0x0b, 0xaa, 0x47, 0xe4, 0x9c, 0x9b, 0x93,
+	0x48, 0xca, 0x02, 0x4d, 0x2f, 0x11, 0x4d, 0x5c, 0x96, 0x7c, 0xc2, 0xef, 0x6b, 0x00, 0xed, 0x1b,
+	0x1a, 0x59, 0x92, 0xd4, 0xc5, 0xae, 0x81, 0x7a, 0x2e, 0x85, 0x8a, 0x26, 0x9f, 0xe4, 0x26, 0xaf,
+	0x92, 0x62, 0xcc, 0xe4, 0x41, 0x8b, 0x59, 0xd9, 0xf1, 0xc4, 0x19, 0xf7, 0x84, 0xbc, 0xa7, 0xc1,
+	0xb0, 0xd4, 0x31, 0x26, 0x2b, 0xf1, 0xf9, 0xaa, 0x8d, 0x69, 0x25, 0xf5, 0x12, 0x7a, 0xcd, 0x1d,
+	0x22, 0x2f, 0x66, 0x1f, 0xdf, 0x76, 0xcb, 0x30, 0x18, 0x2e, 0xf6, 0x05, 0x35, 0xda, 0xb2, 0xbb,
+	0xf5, 0x88, 0x5d, 0x39, 0xae, 0x39, 0x6e, 0x73, 0x8e, 0xcc, 0x24, 0xda, 0x24, 0xdf, 0x80, 0x51,
+	0xa5, 0xef, 0x47, 0x96, 0x23, 0xba, 0xa2, 0xad, 0x5d, 0x7d, 0x25, 0x9d, 0x01, 0x4d, 0xae, 0x73,
+	0x93, 0x2b, 0x24, 0x9f, 0x68, 0xb2, 0x6d, 0xca, 0x01, 0x68, 0xf7, 0x0a, 0x94, 0x20, 0xc7, 0xfa,
+	0x0e, 0x7a, 0x2e, 0x85, 0x8a, 0x26, 0xd7, 0xb8, 0xc9, 0x1c, 0x59, 0x8c, 0x99, 0xac, 0xb4, 0x2d,
+	0xfc, 0x5a, 0x03, 0x3d, 0xfd, 0xa6, 0x4b, 0x2e, 0x49, 0x26, 0xba, 0xde, 0xaa, 0xf5, 0xcb, 0x3d,
+	0x72, 0x23, 0xc0, 0x6b, 0x1c, 0xe0, 0x65, 0xb2, 0x19, 0x03, 0x58, 0x4f, 0x47, 0xf4, 0x4b, 0x0d,
+	0x16, 0x52, 0x6f, 0xbc, 0x64, 0x53, 0x2d, 0x74, 0x9d, 0xe1, 0x5e, 0xea, 0x8d, 0x19, 0xd1, 0x6e,
+	0x71, 0xb4, 0x97, 0xc8, 0xc5, 0xa4, 0x1a, 0x99, 0x02, 0xe7, 0x1d, 0x18, 0x8f, 0xb4, 0x2f, 0x95,
+	0x63, 0x45, 0x72, 0x53, 0x57, 0x37, 0x3a, 0xb1, 0x20, 0x9a, 0x73, 0x1c, 0x4d, 0x9e, 0x2c, 0x25,
+	0xf8, 0xae, 0x6d, 0xec, 0x77, 0x1a, 0xe4, 0x3b, 0xf7, 0x4f, 0xc9, 0x95, 0x74, 0x63, 0xc9, 0xfd,
+	0x5a, 0xfd, 0xea, 0x97, 0x90, 0xe8, 0x21, 0xd2, 0xa9, 0xc8, 0xde, 0x81, 0x31, 0xb5, 0x5f, 0xa8,
+	0x54, 0x9b, 0xc4, 0x46, 0xa3, 0xbe, 0xda, 0x81, 0xa3, 0xeb, 0xae, 0x6e, 0xab, 0xd6, 0x3e, 0xd0,
+	0x60, 0x22, 0xda, 0x1d, 0x24, 0x72, 0x6c, 0x52, 0xda, 0x92, 0xfa, 0x5a, 0x47, 0x9e, 0xae, 0x7b,
+	0x7d, 0xb4, 0xf3, 0x18, 0x05, 0xc2, 0x3b, 0x26, 0x69, 0x40, 0xe4, 0xee, 0x8c, 0xbe, 0xd6, 0x91,
+	0xe7, 0xcb, 0x00, 0x11, 0x36, 0x7f, 0xa4, 0xc1, 0x6c, 0xf2, 0xd7, 0x79, 0xca, 0x7d, 0xa3, 0xe3,
+	0x67, 0x7e, 0xfa, 0x63, 0x3d, 0x70, 0x22, 0xb4, 0x4d, 0x0e, 0xed, 0x3c, 0x59, 0x8b, 0x41, 0x33,
+	0xe3, 0x08, 0x7e, 0xa8, 0xc1, 0x54, 0xc2, 0xa7, 0xcc, 0x44, 0xde, 0xeb, 0xd3, 0xbf, 0x86, 0xd6,
+	0xd7, 0xbb, 0xb1, 0x21, 0xa6, 0x02, 0xc7, 0xb4, 0x41, 0xd6, 0xe3, 0x55, 0x35, 0xe9, 0x43, 0x69,
+	0xf2, 0x07, 0x0d, 0x16, 0x3b, 0x7c, 0xa9, 0x4c, 0x2e, 0x77, 0xb4, 0x1b, 0xfd, 0x2e, 0x5a, 0x2f,
+	0xf4, 0xca, 0x8e, 0x70, 0x6f, 0x70, 0xb8, 0xcf, 0x92, 0xff, 0xed, 0x09, 0x6e, 0xf9, 0xe0, 0xb8,
+	0xf5, 0x92, 0x28, 0xdf, 0x8e, 0x3e, 0xd6, 0x80, 0xc4, 0xbf, 0x03, 0x56, 0x0e, 0x5b, 0xa9, 0x1f,
+	0x45, 0xeb, 0xe7, 0xbb, 0x70, 0x21, 0xd2, 0x0a, 0x47, 0xfa, 0x36, 0x79, 0xab, 0x1b, 0xd2, 0x7b,
+	0x4c, 0xac, 0xf8, 0x30, 0xf2, 0xa1, 0xf5, 0x49, 0xf1, 0x61, 0xd2, 0x27, 0xd5, 0x27, 0xc5, 0x87,
+	0xed, 0x17, 0xcc, 0x13, 0xf2, 0x4d, 0x0d, 0xc6, 0xd4, 0x7e, 0x89, 0x52, 0x54, 0x12, 0x7b, 0x3c,
+	0xfa, 0x6a, 0x07, 0x8e, 0xae, 0x9b, 0x83, 0xa7, 0x08, 0xc8, 0x4e, 0x65, 0xf7, 0x97, 0x58, 0xb7,
+	0x27, 0x7a, 0x7f, 0x49, 0x46, 0x74, 0x2e, 0x15, 0x51, 0x6f, 0xf7, 0x17, 0x15, 0x94, 0x82, 0xa4,
+	0xd5, 0xb5, 0x49, 0x43, 0xa2, 0x34, 0x7d, 0x12, 0x91, 0xc4, 0x1a, 0x3f, 0x3d, 0x20, 0x11, 0x32,
+	0x3b, 0xcf, 0x7f, 0xf2, 0x79, 0x5e, 0xfb, 0xf4, 0xf3, 0xbc, 0xf6, 0xb7, 0xcf, 0xf3, 0xda, 0xf7,
+	0xbe, 0xc8, 0x9f, 0xf9, 0xf4, 0x8b, 0xfc, 0x99, 0xbf, 0x7c, 0x91, 0x3f, 0xf3, 0xf5, 0xcd, 0x9a,
+	0x15, 0x1c, 0x36, 0x0e, 0x0a, 0x15, 0xf7, 0xa8, 0x78, 0xa5, 0xc9, 0xca, 0xf4, 0xcb, 0x34, 0x78,
+	0xe0, 0x7a, 0x77, 0x8b, 0x26, 0xad, 0x59, 0x7e, 0xb1, 0x29, 0xf4, 0xb1, 0x30, 0xfb, 0x07, 0x03,
+	0xfc, 0x1b, 0xf3, 0x6b, 0xff, 0x1a, 0x00, 0x09, 0xab, 0x18, 0xba, 0xa2, 0x32, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2956,6 +3656,8 @@ type QueryClient interface {
 	XmsgByNonce(ctx context.Context, in *QueryGetXmsgByNonceRequest, opts ...grpc.CallOption) (*QueryXmsgByNonceResponse, error)
 	// Queries a list of send items.
 	XmsgAll(ctx context.Context, in *QueryAllXmsgRequest, opts ...grpc.CallOption) (*QueryXmsgAllResponse, error)
+	// Queries a list of xmsgs matching the filters, backed by the xmsg secondary indexes
+	XmsgsByFilter(ctx context.Context, in *QueryXmsgsByFilterRequest, opts ...grpc.CallOption) (*QueryXmsgsByFilterResponse, error)
 	// Queries a list of send items.
 	ChainIndex(ctx context.Context, in *QueryChainIndexRequest, opts ...grpc.CallOption) (*QueryChainIndexResponse, error)
 	// Queries a index for pell token vote
@@ -2978,6 +3680,14 @@ type QueryClient interface {
 	CrosschainFeeParams(ctx context.Context, in *QueryCrosschainFeeParamsRequest, opts ...grpc.CallOption) (*QueryCrosschainFeeParamsResponse, error)
 	// Queries a list of crosschain event fees by chain id.
 	CrosschainFeeParamByChainId(ctx context.Context, in *QueryCrosschainFeeParamByChainIdRequest, opts ...grpc.CallOption) (*QueryCrosschainFeeParamByChainIdResponse, error)
+	// Queries a quote of the fee charged for a crosschain event.
+	CrosschainFeeQuote(ctx context.Context, in *QueryCrosschainFeeQuoteRequest, opts ...grpc.CallOption) (*QueryCrosschainFeeQuoteResponse, error)
+	// Queries the recharge ledger of a token on a chain and its budget.
+	RechargeLedger(ctx context.Context, in *QueryRechargeLedgerRequest, opts ...grpc.CallOption) (*QueryRechargeLedgerResponse, error)
+	// Queries a list of recharge ledgers.
+	RechargeLedgerAll(ctx context.Context, in *QueryAllRechargeLedgerRequest, opts ...grpc.CallOption) (*QueryRechargeLedgerAllResponse, error)
+	// Queries the list of recharge budgets.
+	RechargeBudgetAll(ctx context.Context, in *QueryAllRechargeBudgetRequest, opts ...grpc.CallOption) (*QueryRechargeBudgetAllResponse, error)
 }
 
 type queryClient struct {
@@ -3132,6 +3842,15 @@ func (c *queryClient) XmsgAll(ctx context.Context, in *QueryAllXmsgRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) XmsgsByFilter(ctx context.Context, in *QueryXmsgsByFilterRequest, opts ...grpc.CallOption) (*QueryXmsgsByFilterResponse, error) {
+	out := new(QueryXmsgsByFilterResponse)
+	err := c.cc.Invoke(ctx, "/xmsg.Query/XmsgsByFilter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ChainIndex(ctx context.Context, in *QueryChainIndexRequest, opts ...grpc.CallOption) (*QueryChainIndexResponse, error) {
 	out := new(QueryChainIndexResponse)
 	err := c.cc.Invoke(ctx, "/xmsg.Query/ChainIndex", in, out, opts...)
@@ -3231,6 +3950,42 @@ func (c *queryClient) CrosschainFeeParamByChainId(ctx context.Context, in *Query
 	return out, nil
 }
 
+func (c *queryClient) CrosschainFeeQuote(ctx context.Context, in *QueryCrosschainFeeQuoteRequest, opts ...grpc.CallOption) (*QueryCrosschainFeeQuoteResponse, error) {
+	out := new(QueryCrosschainFeeQuoteResponse)
+	err := c.cc.Invoke(ctx, "/xmsg.Query/CrosschainFeeQuote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RechargeLedger(ctx context.Context, in *QueryRechargeLedgerRequest, opts ...grpc.CallOption) (*QueryRechargeLedgerResponse, error) {
+	out := new(QueryRechargeLedgerResponse)
+	err := c.cc.Invoke(ctx, "/xmsg.Query/RechargeLedger", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RechargeLedgerAll(ctx context.Context, in *QueryAllRechargeLedgerRequest, opts ...grpc.CallOption) (*QueryRechargeLedgerAllResponse, error) {
+	out := new(QueryRechargeLedgerAllResponse)
+	err := c.cc.Invoke(ctx, "/xmsg.Query/RechargeLedgerAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RechargeBudgetAll(ctx context.Context, in *QueryAllRechargeBudgetRequest, opts ...grpc.CallOption) (*QueryRechargeBudgetAllResponse, error) {
+	out := new(QueryRechargeBudgetAllResponse)
+	err := c.cc.Invoke(ctx, "/xmsg.Query/RechargeBudgetAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Queries a OutTxTracker by index.
@@ -3265,6 +4020,8 @@ type QueryServer interface {
 	XmsgByNonce(context.Context, *QueryGetXmsgByNonceRequest) (*QueryXmsgByNonceResponse, error)
 	// Queries a list of send items.
 	XmsgAll(context.Context, *QueryAllXmsgRequest) (*QueryXmsgAllResponse, error)
+	// Queries a list of xmsgs matching the filters, backed by the xmsg secondary indexes
+	XmsgsByFilter(context.Context, *QueryXmsgsByFilterRequest) (*QueryXmsgsByFilterResponse, error)
 	// Queries a list of send items.
 	ChainIndex(context.Context, *QueryChainIndexRequest) (*QueryChainIndexResponse, error)
 	// Queries a index for pell token vote
@@ -3287,6 +4044,14 @@ type QueryServer interface {
 	CrosschainFeeParams(context.Context, *QueryCrosschainFeeParamsRequest) (*QueryCrosschainFeeParamsResponse, error)
 	// Queries a list of crosschain event fees by chain id.
 	CrosschainFeeParamByChainId(context.Context, *QueryCrosschainFeeParamByChainIdRequest) (*QueryCrosschainFeeParamByChainIdResponse, error)
+	// Queries a quote of the fee charged for a crosschain event.
+	CrosschainFeeQuote(context.Context, *QueryCrosschainFeeQuoteRequest) (*QueryCrosschainFeeQuoteResponse, error)
+	// Queries the recharge ledger of a token on a chain and its budget.
+	RechargeLedger(context.Context, *QueryRechargeLedgerRequest) (*QueryRechargeLedgerResponse, error)
+	// Queries a list of recharge ledgers.
+	RechargeLedgerAll(context.Context, *QueryAllRechargeLedgerRequest) (*QueryRechargeLedgerAllResponse, error)
+	// Queries the list of recharge budgets.
+	RechargeBudgetAll(context.Context, *QueryAllRechargeBudgetRequest) (*QueryRechargeBudgetAllResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -3341,6 +4106,9 @@ func (*UnimplementedQueryServer) XmsgByNonce(ctx context.Context, req *QueryGetX
 func (*UnimplementedQueryServer) XmsgAll(ctx context.Context, req *QueryAllXmsgRequest) (*QueryXmsgAllResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method XmsgAll not implemented")
 }
+func (*UnimplementedQueryServer) XmsgsByFilter(ctx context.Context, req *QueryXmsgsByFilterRequest) (*QueryXmsgsByFilterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method XmsgsByFilter not implemented")
+}
 func (*UnimplementedQueryServer) ChainIndex(ctx context.Context, req *QueryChainIndexRequest) (*QueryChainIndexResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChainIndex not implemented")
 }
@@ -3374,6 +4142,18 @@ func (*UnimplementedQueryServer) CrosschainFeeParams(ctx context.Context, req *Q
 func (*UnimplementedQueryServer) CrosschainFeeParamByChainId(ctx context.Context, req *QueryCrosschainFeeParamByChainIdRequest) (*QueryCrosschainFeeParamByChainIdResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CrosschainFeeParamByChainId not implemented")
 }
+func (*UnimplementedQueryServer) CrosschainFeeQuote(ctx context.Context, req *QueryCrosschainFeeQuoteRequest) (*QueryCrosschainFeeQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CrosschainFeeQuote not implemented")
+}
+func (*UnimplementedQueryServer) RechargeLedger(ctx context.Context, req *QueryRechargeLedgerRequest) (*QueryRechargeLedgerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RechargeLedger not implemented")
+}
+func (*UnimplementedQueryServer) RechargeLedgerAll(ctx context.Context, req *QueryAllRechargeLedgerRequest) (*QueryRechargeLedgerAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RechargeLedgerAll not implemented")
+}
+func (*UnimplementedQueryServer) RechargeBudgetAll(ctx context.Context, req *QueryAllRechargeBudgetRequest) (*QueryRechargeBudgetAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RechargeBudgetAll not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -3667,6 +4447,24 @@ func _Query_XmsgAll_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_XmsgsByFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryXmsgsByFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).XmsgsByFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xmsg.Query/XmsgsByFilter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).XmsgsByFilter(ctx, req.(*QueryXmsgsByFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ChainIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryChainIndexRequest)
 	if err := dec(in); err != nil {
@@ -3865,13 +4663,85 @@ func _Query_CrosschainFeeParamByChainId_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "xmsg.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "OutTxTracker",
+func _Query_CrosschainFeeQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCrosschainFeeQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CrosschainFeeQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xmsg.Query/CrosschainFeeQuote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CrosschainFeeQuote(ctx, req.(*QueryCrosschainFeeQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RechargeLedger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRechargeLedgerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RechargeLedger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xmsg.Query/RechargeLedger",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RechargeLedger(ctx, req.(*QueryRechargeLedgerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RechargeLedgerAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllRechargeLedgerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RechargeLedgerAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xmsg.Query/RechargeLedgerAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RechargeLedgerAll(ctx, req.(*QueryAllRechargeLedgerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RechargeBudgetAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllRechargeBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RechargeBudgetAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xmsg.Query/RechargeBudgetAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RechargeBudgetAll(ctx, req.(*QueryAllRechargeBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "xmsg.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "OutTxTracker",
 			Handler:    _Query_OutTxTracker_Handler,
 		},
 		{
@@ -3934,6 +4804,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "XmsgAll",
 			Handler:    _Query_XmsgAll_Handler,
 		},
+		{
+			MethodName: "XmsgsByFilter",
+			Handler:    _Query_XmsgsByFilter_Handler,
+		},
 		{
 			MethodName: "ChainIndex",
 			Handler:    _Query_ChainIndex_Handler,
@@ -3978,6 +4852,22 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CrosschainFeeParamByChainId",
 			Handler:    _Query_CrosschainFeeParamByChainId_Handler,
 		},
+		{
+			MethodName: "CrosschainFeeQuote",
+			Handler:    _Query_CrosschainFeeQuote_Handler,
+		},
+		{
+			MethodName: "RechargeLedger",
+			Handler:    _Query_RechargeLedger_Handler,
+		},
+		{
+			MethodName: "RechargeLedgerAll",
+			Handler:    _Query_RechargeLedgerAll_Handler,
+		},
+		{
+			MethodName: "RechargeBudgetAll",
+			Handler:    _Query_RechargeBudgetAll_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "xmsg/query.proto",
@@ -4106,6 +4996,157 @@ func (m *QueryCrosschainFeeParamByChainIdResponse) MarshalToSizedBuffer(dAtA []b
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryCrosschainFeeQuoteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCrosschainFeeQuoteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCrosschainFeeQuoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DestinationGasLimit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DestinationGasLimit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EventType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EventType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DestinationChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DestinationChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SourceChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SourceChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCrosschainFeeQuoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCrosschainFeeQuoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCrosschainFeeQuoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalFee.Size()
+		i -= size
+		if _, err := m.TotalFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.TotalGasFeeApell.Size()
+		i -= size
+		if _, err := m.TotalGasFeeApell.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.TotalGasFee.Size()
+		i -= size
+		if _, err := m.TotalGasFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.SafetyMargin.Size()
+		i -= size
+		if _, err := m.SafetyMargin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.GasFee.Size()
+		i -= size
+		if _, err := m.GasFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.PriorityFee.Size()
+		i -= size
+		if _, err := m.PriorityFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.GasPrice.Size()
+		i -= size
+		if _, err := m.GasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.GasLimit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.ProtocolFee.Size()
+		i -= size
+		if _, err := m.ProtocolFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryListAllowedXmsgSendersRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5302,7 +6343,7 @@ func (m *QueryXmsgAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryListPendingXmsgRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryXmsgsByFilterRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5312,30 +6353,91 @@ func (m *QueryListPendingXmsgRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryListPendingXmsgRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryXmsgsByFilterRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryListPendingXmsgRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryXmsgsByFilterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Limit != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x4a
 	}
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+	if m.MaxInboundPellHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxInboundPellHeight))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x40
+	}
+	if m.MinInboundPellHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinInboundPellHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.InboundEventType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.InboundEventType))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Statuses) > 0 {
+		dAtA26 := make([]byte, len(m.Statuses)*10)
+		var j25 int
+		for _, num := range m.Statuses {
+			for num >= 1<<7 {
+				dAtA26[j25] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j25++
+			}
+			dAtA26[j25] = uint8(num)
+			j25++
+		}
+		i -= j25
+		copy(dAtA[i:], dAtA26[:j25])
+		i = encodeVarintQuery(dAtA, i, uint64(j25))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ReceiverChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ReceiverChainId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TxOrigin) > 0 {
+		i -= len(m.TxOrigin)
+		copy(dAtA[i:], m.TxOrigin)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxOrigin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryListPendingXmsgResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryXmsgsByFilterResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5345,25 +6447,32 @@ func (m *QueryListPendingXmsgResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryListPendingXmsgResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryXmsgsByFilterResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryListPendingXmsgResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryXmsgsByFilterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TotalPending != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TotalPending))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if len(m.Xmsg) > 0 {
-		for iNdEx := len(m.Xmsg) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Xmsgs) > 0 {
+		for iNdEx := len(m.Xmsgs) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Xmsg[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Xmsgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5377,7 +6486,7 @@ func (m *QueryListPendingXmsgResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryListPendingXmsgWithinRateLimitRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryListPendingXmsgRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5387,12 +6496,12 @@ func (m *QueryListPendingXmsgWithinRateLimitRequest) Marshal() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *QueryListPendingXmsgWithinRateLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryListPendingXmsgRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryListPendingXmsgWithinRateLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryListPendingXmsgRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5400,12 +6509,17 @@ func (m *QueryListPendingXmsgWithinRateLimitRequest) MarshalToSizedBuffer(dAtA [
 	if m.Limit != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
 		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryListPendingXmsgWithinRateLimitResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryListPendingXmsgResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5415,18 +6529,116 @@ func (m *QueryListPendingXmsgWithinRateLimitResponse) Marshal() (dAtA []byte, er
 	return dAtA[:n], nil
 }
 
-func (m *QueryListPendingXmsgWithinRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryListPendingXmsgResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryListPendingXmsgWithinRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryListPendingXmsgResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.RateLimitExceeded {
-		i--
+	if m.TotalPending != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalPending))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Xmsg) > 0 {
+		for iNdEx := len(m.Xmsg) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Xmsg[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListPendingXmsgWithinRateLimitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListPendingXmsgWithinRateLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListPendingXmsgWithinRateLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListPendingXmsgWithinRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListPendingXmsgWithinRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListPendingXmsgWithinRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EventRateLimits) > 0 {
+		for iNdEx := len(m.EventRateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EventRateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ChainRateLimits) > 0 {
+		for iNdEx := len(m.ChainRateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainRateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.RateLimitExceeded {
+		i--
 		if m.RateLimitExceeded {
 			dAtA[i] = 1
 		} else {
@@ -5904,6 +7116,41 @@ func (m *QueryRateLimiterInputResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if len(m.PastEventValues) > 0 {
+		for iNdEx := len(m.PastEventValues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PastEventValues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.PastChainValues) > 0 {
+		for iNdEx := len(m.PastChainValues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PastChainValues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.PastXmsgsValue) > 0 {
+		i -= len(m.PastXmsgsValue)
+		copy(dAtA[i:], m.PastXmsgsValue)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PastXmsgsValue)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.LowestPendingXmsgHeight != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.LowestPendingXmsgHeight))
 		i--
@@ -5950,120 +7197,391 @@ func (m *QueryRateLimiterInputResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryCrosschainFeeParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRechargeLedgerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryCrosschainFeeParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.CrosschainFeeParams) > 0 {
-		for _, e := range m.CrosschainFeeParams {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func (m *QueryRechargeLedgerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCrosschainFeeParamByChainIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryRechargeLedgerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if m.TokenType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TokenType))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCrosschainFeeParamByChainIdResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CrosschainFeeParam != nil {
-		l = m.CrosschainFeeParam.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryRechargeLedgerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryListAllowedXmsgSendersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryRechargeLedgerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryListAllowedXmsgSendersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryRechargeLedgerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Builders) > 0 {
-		for _, s := range m.Builders {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Budget != nil {
+		{
+			size, err := m.Budget.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	{
+		size, err := m.Ledger.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetOutTxTrackerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
-	}
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
+func (m *QueryAllRechargeLedgerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryOutTxTrackerResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.OutTxTracker.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryAllRechargeLedgerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllOutTxTrackerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllRechargeLedgerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRechargeLedgerAllResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRechargeLedgerAllResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRechargeLedgerAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Ledgers) > 0 {
+		for iNdEx := len(m.Ledgers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ledgers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllRechargeBudgetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRechargeBudgetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRechargeBudgetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRechargeBudgetAllResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRechargeBudgetAllResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRechargeBudgetAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Budgets) > 0 {
+		for iNdEx := len(m.Budgets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Budgets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryCrosschainFeeParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCrosschainFeeParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CrosschainFeeParams) > 0 {
+		for _, e := range m.CrosschainFeeParams {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCrosschainFeeParamByChainIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *QueryCrosschainFeeParamByChainIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CrosschainFeeParam != nil {
+		l = m.CrosschainFeeParam.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCrosschainFeeQuoteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SourceChainId != 0 {
+		n += 1 + sovQuery(uint64(m.SourceChainId))
+	}
+	if m.DestinationChainId != 0 {
+		n += 1 + sovQuery(uint64(m.DestinationChainId))
+	}
+	if m.EventType != 0 {
+		n += 1 + sovQuery(uint64(m.EventType))
+	}
+	if m.DestinationGasLimit != 0 {
+		n += 1 + sovQuery(uint64(m.DestinationGasLimit))
+	}
+	return n
+}
+
+func (m *QueryCrosschainFeeQuoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ProtocolFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.GasLimit != 0 {
+		n += 1 + sovQuery(uint64(m.GasLimit))
+	}
+	l = m.GasPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.PriorityFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.GasFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SafetyMargin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalGasFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalGasFeeApell.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryListAllowedXmsgSendersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryListAllowedXmsgSendersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Builders) > 0 {
+		for _, s := range m.Builders {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetOutTxTrackerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryOutTxTrackerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.OutTxTracker.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllOutTxTrackerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	if m.Pagination != nil {
@@ -6480,13 +7998,76 @@ func (m *QueryXmsgAllResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryListPendingXmsgRequest) Size() (n int) {
+func (m *QueryXmsgsByFilterRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ChainId != 0 {
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TxOrigin)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ReceiverChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ReceiverChainId))
+	}
+	if len(m.Statuses) > 0 {
+		l = 0
+		for _, e := range m.Statuses {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if m.InboundEventType != 0 {
+		n += 1 + sovQuery(uint64(m.InboundEventType))
+	}
+	if m.MinInboundPellHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MinInboundPellHeight))
+	}
+	if m.MaxInboundPellHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MaxInboundPellHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryXmsgsByFilterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Xmsgs) > 0 {
+		for _, e := range m.Xmsgs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListPendingXmsgRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
 		n += 1 + sovQuery(uint64(m.ChainId))
 	}
 	if m.Limit != 0 {
@@ -6550,6 +8131,18 @@ func (m *QueryListPendingXmsgWithinRateLimitResponse) Size() (n int) {
 	if m.RateLimitExceeded {
 		n += 2
 	}
+	if len(m.ChainRateLimits) > 0 {
+		for _, e := range m.ChainRateLimits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.EventRateLimits) > 0 {
+		for _, e := range m.EventRateLimits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -6760,6 +8353,108 @@ func (m *QueryRateLimiterInputResponse) Size() (n int) {
 	if m.LowestPendingXmsgHeight != 0 {
 		n += 1 + sovQuery(uint64(m.LowestPendingXmsgHeight))
 	}
+	l = len(m.PastXmsgsValue)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.PastChainValues) > 0 {
+		for _, e := range m.PastChainValues {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.PastEventValues) > 0 {
+		for _, e := range m.PastEventValues {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRechargeLedgerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.TokenType != 0 {
+		n += 1 + sovQuery(uint64(m.TokenType))
+	}
+	return n
+}
+
+func (m *QueryRechargeLedgerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Ledger.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Budget != nil {
+		l = m.Budget.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllRechargeLedgerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRechargeLedgerAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ledgers) > 0 {
+		for _, e := range m.Ledgers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllRechargeBudgetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRechargeBudgetAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Budgets) > 0 {
+		for _, e := range m.Budgets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -7058,7 +8753,7 @@ func (m *QueryCrosschainFeeParamByChainIdResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryListAllowedXmsgSendersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCrosschainFeeQuoteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7081,67 +8776,55 @@ func (m *QueryListAllowedXmsgSendersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListAllowedXmsgSendersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCrosschainFeeQuoteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListAllowedXmsgSendersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCrosschainFeeQuoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChainId", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryListAllowedXmsgSendersResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.SourceChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationChainId", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.DestinationChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListAllowedXmsgSendersResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListAllowedXmsgSendersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Builders", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventType", wireType)
 			}
-			var stringLen uint64
+			m.EventType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7151,24 +8834,30 @@ func (m *QueryListAllowedXmsgSendersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EventType |= CrosschainFeeEventType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationGasLimit", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.DestinationGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Builders = append(m.Builders, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7190,7 +8879,7 @@ func (m *QueryListAllowedXmsgSendersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetOutTxTrackerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCrosschainFeeQuoteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7213,17 +8902,17 @@ func (m *QueryGetOutTxTrackerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetOutTxTrackerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCrosschainFeeQuoteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetOutTxTrackerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCrosschainFeeQuoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFee", wireType)
 			}
-			m.ChainId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7233,16 +8922,1417 @@ func (m *QueryGetOutTxTrackerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProtocolFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriorityFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SafetyMargin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SafetyMargin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalGasFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalGasFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalGasFeeApell", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalGasFeeApell.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListAllowedXmsgSendersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListAllowedXmsgSendersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListAllowedXmsgSendersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListAllowedXmsgSendersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListAllowedXmsgSendersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListAllowedXmsgSendersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Builders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Builders = append(m.Builders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetOutTxTrackerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetOutTxTrackerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetOutTxTrackerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOutTxTrackerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOutTxTrackerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOutTxTrackerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutTxTracker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OutTxTracker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllOutTxTrackerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllOutTxTrackerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllOutTxTrackerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOutTxTrackerAllResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOutTxTrackerAllResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOutTxTrackerAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutTxTracker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutTxTracker = append(m.OutTxTracker, OutTxTracker{})
+			if err := m.OutTxTracker[len(m.OutTxTracker)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllOutTxTrackerByChainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllOutTxTrackerByChainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllOutTxTrackerByChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			m.Chain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Chain |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOutTxTrackerAllByChainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOutTxTrackerAllByChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOutTxTrackerAllByChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutTxTrackers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutTxTrackers = append(m.OutTxTrackers, OutTxTracker{})
+			if err := m.OutTxTrackers[len(m.OutTxTrackers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllInTxTrackerByChainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllInTxTrackerByChainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllInTxTrackerByChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInTxTrackerAllByChainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInTxTrackerAllByChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInTxTrackerAllByChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InTxTrackers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InTxTrackers = append(m.InTxTrackers, InTxTracker{})
+			if err := m.InTxTrackers[len(m.InTxTrackers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllInTxTrackersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllInTxTrackersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllInTxTrackersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInTxTrackerAllResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInTxTrackerAllResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInTxTrackerAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InTxTrackers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InTxTrackers = append(m.InTxTrackers, InTxTracker{})
+			if err := m.InTxTrackers[len(m.InTxTrackers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.Nonce = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7252,11 +10342,28 @@ func (m *QueryGetOutTxTrackerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7278,7 +10385,7 @@ func (m *QueryGetOutTxTrackerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOutTxTrackerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetInTxHashToXmsgRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7301,17 +10408,17 @@ func (m *QueryOutTxTrackerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOutTxTrackerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetInTxHashToXmsgRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOutTxTrackerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetInTxHashToXmsgRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutTxTracker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InTxHash", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7321,24 +10428,23 @@ func (m *QueryOutTxTrackerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OutTxTracker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.InTxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7361,7 +10467,7 @@ func (m *QueryOutTxTrackerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllOutTxTrackerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryInTxHashToXmsgResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7384,15 +10490,15 @@ func (m *QueryAllOutTxTrackerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllOutTxTrackerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInTxHashToXmsgResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllOutTxTrackerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInTxHashToXmsgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InTxHashToXmsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7419,10 +10525,7 @@ func (m *QueryAllOutTxTrackerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InTxHashToXmsg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7447,7 +10550,7 @@ func (m *QueryAllOutTxTrackerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOutTxTrackerAllResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryInTxHashToXmsgDataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7470,17 +10573,17 @@ func (m *QueryOutTxTrackerAllResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOutTxTrackerAllResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInTxHashToXmsgDataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOutTxTrackerAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInTxHashToXmsgDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutTxTracker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InTxHash", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7490,29 +10593,77 @@ func (m *QueryOutTxTrackerAllResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutTxTracker = append(m.OutTxTracker, OutTxTracker{})
-			if err := m.OutTxTracker[len(m.OutTxTracker)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.InTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInTxHashToXmsgDataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInTxHashToXmsgDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInTxHashToXmsgDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Xmsgs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7539,10 +10690,8 @@ func (m *QueryOutTxTrackerAllResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Xmsgs = append(m.Xmsgs, Xmsg{})
+			if err := m.Xmsgs[len(m.Xmsgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7567,7 +10716,7 @@ func (m *QueryOutTxTrackerAllResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllOutTxTrackerByChainRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllInTxHashToXmsgRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7590,32 +10739,13 @@ func (m *QueryAllOutTxTrackerByChainRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllOutTxTrackerByChainRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllInTxHashToXmsgRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllOutTxTrackerByChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllInTxHashToXmsgRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
-			}
-			m.Chain = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Chain |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -7672,7 +10802,7 @@ func (m *QueryAllOutTxTrackerByChainRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOutTxTrackerAllByChainResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryInTxHashToXmsgAllResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7695,15 +10825,15 @@ func (m *QueryOutTxTrackerAllByChainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOutTxTrackerAllByChainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInTxHashToXmsgAllResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOutTxTrackerAllByChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInTxHashToXmsgAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutTxTrackers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InTxHashToXmsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7730,8 +10860,8 @@ func (m *QueryOutTxTrackerAllByChainResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutTxTrackers = append(m.OutTxTrackers, OutTxTracker{})
-			if err := m.OutTxTrackers[len(m.OutTxTrackers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.InTxHashToXmsg = append(m.InTxHashToXmsg, InTxHashToXmsg{})
+			if err := m.InTxHashToXmsg[len(m.InTxHashToXmsg)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7792,7 +10922,7 @@ func (m *QueryOutTxTrackerAllByChainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllInTxTrackerByChainRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetGasPriceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7815,36 +10945,17 @@ func (m *QueryAllInTxTrackerByChainRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllInTxTrackerByChainRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetGasPriceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllInTxTrackerByChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetGasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-			}
-			m.ChainId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7854,27 +10965,23 @@ func (m *QueryAllInTxTrackerByChainRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Index = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7897,7 +11004,7 @@ func (m *QueryAllInTxTrackerByChainRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInTxTrackerAllByChainResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGasPriceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7920,49 +11027,15 @@ func (m *QueryInTxTrackerAllByChainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInTxTrackerAllByChainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGasPriceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInTxTrackerAllByChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InTxTrackers", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.InTxTrackers = append(m.InTxTrackers, InTxTracker{})
-			if err := m.InTxTrackers[len(m.InTxTrackers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7989,10 +11062,10 @@ func (m *QueryInTxTrackerAllByChainResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.GasPrice == nil {
+				m.GasPrice = &GasPrice{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8017,7 +11090,7 @@ func (m *QueryInTxTrackerAllByChainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllInTxTrackersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllGasPriceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8040,10 +11113,10 @@ func (m *QueryAllInTxTrackersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllInTxTrackersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllGasPriceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllInTxTrackersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllGasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8103,7 +11176,7 @@ func (m *QueryAllInTxTrackersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInTxTrackerAllResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGasPriceAllResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8126,15 +11199,15 @@ func (m *QueryInTxTrackerAllResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInTxTrackerAllResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGasPriceAllResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInTxTrackerAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGasPriceAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InTxTrackers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrices", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8161,8 +11234,8 @@ func (m *QueryInTxTrackerAllResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InTxTrackers = append(m.InTxTrackers, InTxTracker{})
-			if err := m.InTxTrackers[len(m.InTxTrackers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.GasPrices = append(m.GasPrices, &GasPrice{})
+			if err := m.GasPrices[len(m.GasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8223,7 +11296,7 @@ func (m *QueryInTxTrackerAllResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetInTxHashToXmsgRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetLastBlockHeightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8246,15 +11319,15 @@ func (m *QueryGetInTxHashToXmsgRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetInTxHashToXmsgRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetLastBlockHeightRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetInTxHashToXmsgRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetLastBlockHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InTxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8282,7 +11355,7 @@ func (m *QueryGetInTxHashToXmsgRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InTxHash = string(dAtA[iNdEx:postIndex])
+			m.Index = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8305,7 +11378,7 @@ func (m *QueryGetInTxHashToXmsgRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInTxHashToXmsgResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLastBlockHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8328,15 +11401,15 @@ func (m *QueryInTxHashToXmsgResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInTxHashToXmsgResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLastBlockHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInTxHashToXmsgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLastBlockHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InTxHashToXmsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBlockHeight", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8363,7 +11436,10 @@ func (m *QueryInTxHashToXmsgResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InTxHashToXmsg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LastBlockHeight == nil {
+				m.LastBlockHeight = &LastBlockHeight{}
+			}
+			if err := m.LastBlockHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8388,7 +11464,7 @@ func (m *QueryInTxHashToXmsgResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInTxHashToXmsgDataRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllLastBlockHeightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8411,17 +11487,17 @@ func (m *QueryInTxHashToXmsgDataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInTxHashToXmsgDataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllLastBlockHeightRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInTxHashToXmsgDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllLastBlockHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InTxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8431,23 +11507,27 @@ func (m *QueryInTxHashToXmsgDataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InTxHash = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8470,7 +11550,7 @@ func (m *QueryInTxHashToXmsgDataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInTxHashToXmsgDataResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLastBlockHeightAllResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8493,15 +11573,15 @@ func (m *QueryInTxHashToXmsgDataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInTxHashToXmsgDataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLastBlockHeightAllResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInTxHashToXmsgDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLastBlockHeightAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Xmsgs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBlockHeight", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8528,8 +11608,44 @@ func (m *QueryInTxHashToXmsgDataResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Xmsgs = append(m.Xmsgs, Xmsg{})
-			if err := m.Xmsgs[len(m.Xmsgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LastBlockHeight = append(m.LastBlockHeight, &LastBlockHeight{})
+			if err := m.LastBlockHeight[len(m.LastBlockHeight)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8554,7 +11670,7 @@ func (m *QueryInTxHashToXmsgDataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllInTxHashToXmsgRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetXmsgRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8577,17 +11693,17 @@ func (m *QueryAllInTxHashToXmsgRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllInTxHashToXmsgRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetXmsgRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllInTxHashToXmsgRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetXmsgRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8597,27 +11713,23 @@ func (m *QueryAllInTxHashToXmsgRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Index = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8640,7 +11752,7 @@ func (m *QueryAllInTxHashToXmsgRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInTxHashToXmsgAllResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetXmsgByNonceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8663,17 +11775,17 @@ func (m *QueryInTxHashToXmsgAllResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInTxHashToXmsgAllResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetXmsgByNonceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInTxHashToXmsgAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetXmsgByNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InTxHashToXmsg", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var msglen int
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8683,31 +11795,16 @@ func (m *QueryInTxHashToXmsgAllResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ChainId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.InTxHashToXmsg = append(m.InTxHashToXmsg, InTxHashToXmsg{})
-			if err := m.InTxHashToXmsg[len(m.InTxHashToXmsg)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			var msglen int
+			m.Nonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8717,28 +11814,11 @@ func (m *QueryInTxHashToXmsgAllResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Nonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8760,7 +11840,7 @@ func (m *QueryInTxHashToXmsgAllResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetGasPriceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryXmsgByNonceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8783,17 +11863,17 @@ func (m *QueryGetGasPriceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetGasPriceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryXmsgByNonceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetGasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryXmsgByNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Xmsg", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8803,23 +11883,27 @@ func (m *QueryGetGasPriceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
+			if m.Xmsg == nil {
+				m.Xmsg = &Xmsg{}
+			}
+			if err := m.Xmsg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8842,7 +11926,7 @@ func (m *QueryGetGasPriceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGasPriceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryXmsgResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8865,15 +11949,15 @@ func (m *QueryGasPriceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGasPriceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryXmsgResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryXmsgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Xmsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8900,10 +11984,10 @@ func (m *QueryGasPriceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GasPrice == nil {
-				m.GasPrice = &GasPrice{}
+			if m.Xmsg == nil {
+				m.Xmsg = &Xmsg{}
 			}
-			if err := m.GasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Xmsg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8928,7 +12012,7 @@ func (m *QueryGasPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllGasPriceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllXmsgRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8951,10 +12035,10 @@ func (m *QueryAllGasPriceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllGasPriceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllXmsgRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllGasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllXmsgRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9014,7 +12098,7 @@ func (m *QueryAllGasPriceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGasPriceAllResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryXmsgAllResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9037,15 +12121,15 @@ func (m *QueryGasPriceAllResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGasPriceAllResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryXmsgAllResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGasPriceAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryXmsgAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasPrices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Xmsgs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9072,8 +12156,8 @@ func (m *QueryGasPriceAllResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GasPrices = append(m.GasPrices, &GasPrice{})
-			if err := m.GasPrices[len(m.GasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Xmsgs = append(m.Xmsgs, &Xmsg{})
+			if err := m.Xmsgs[len(m.Xmsgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9134,7 +12218,7 @@ func (m *QueryGasPriceAllResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetLastBlockHeightRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryXmsgsByFilterRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9157,15 +12241,15 @@ func (m *QueryGetLastBlockHeightRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetLastBlockHeightRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryXmsgsByFilterRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetLastBlockHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryXmsgsByFilterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9193,7 +12277,252 @@ func (m *QueryGetLastBlockHeightRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxOrigin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxOrigin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverChainId", wireType)
+			}
+			m.ReceiverChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceiverChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType == 0 {
+				var v XmsgStatus
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= XmsgStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Statuses = append(m.Statuses, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Statuses) == 0 {
+					m.Statuses = make([]XmsgStatus, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v XmsgStatus
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= XmsgStatus(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Statuses = append(m.Statuses, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statuses", wireType)
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundEventType", wireType)
+			}
+			m.InboundEventType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InboundEventType |= InboundPellEventType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinInboundPellHeight", wireType)
+			}
+			m.MinInboundPellHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinInboundPellHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInboundPellHeight", wireType)
+			}
+			m.MaxInboundPellHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxInboundPellHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9216,7 +12545,7 @@ func (m *QueryGetLastBlockHeightRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLastBlockHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryXmsgsByFilterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9226,28 +12555,62 @@ func (m *QueryLastBlockHeightResponse) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryXmsgsByFilterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryXmsgsByFilterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Xmsgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Xmsgs = append(m.Xmsgs, &Xmsg{})
+			if err := m.Xmsgs[len(m.Xmsgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLastBlockHeightResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLastBlockHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastBlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9274,10 +12637,10 @@ func (m *QueryLastBlockHeightResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastBlockHeight == nil {
-				m.LastBlockHeight = &LastBlockHeight{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.LastBlockHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9302,7 +12665,7 @@ func (m *QueryLastBlockHeightResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllLastBlockHeightRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListPendingXmsgRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9325,17 +12688,17 @@ func (m *QueryAllLastBlockHeightRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllLastBlockHeightRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListPendingXmsgRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllLastBlockHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListPendingXmsgRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var msglen int
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9345,28 +12708,30 @@ func (m *QueryAllLastBlockHeightRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ChainId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9388,7 +12753,7 @@ func (m *QueryAllLastBlockHeightRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLastBlockHeightAllResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListPendingXmsgResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9411,15 +12776,15 @@ func (m *QueryLastBlockHeightAllResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLastBlockHeightAllResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListPendingXmsgResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLastBlockHeightAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListPendingXmsgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastBlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Xmsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9446,16 +12811,16 @@ func (m *QueryLastBlockHeightAllResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LastBlockHeight = append(m.LastBlockHeight, &LastBlockHeight{})
-			if err := m.LastBlockHeight[len(m.LastBlockHeight)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Xmsg = append(m.Xmsg, &Xmsg{})
+			if err := m.Xmsg[len(m.Xmsg)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPending", wireType)
 			}
-			var msglen int
+			m.TotalPending = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9465,28 +12830,11 @@ func (m *QueryLastBlockHeightAllResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TotalPending |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9508,7 +12856,7 @@ func (m *QueryLastBlockHeightAllResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetXmsgRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListPendingXmsgWithinRateLimitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9531,17 +12879,17 @@ func (m *QueryGetXmsgRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetXmsgRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListPendingXmsgWithinRateLimitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetXmsgRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListPendingXmsgWithinRateLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var stringLen uint64
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9551,24 +12899,11 @@ func (m *QueryGetXmsgRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Limit |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Index = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9590,7 +12925,7 @@ func (m *QueryGetXmsgRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetXmsgByNonceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListPendingXmsgWithinRateLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9613,17 +12948,121 @@ func (m *QueryGetXmsgByNonceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetXmsgByNonceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListPendingXmsgWithinRateLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetXmsgByNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListPendingXmsgWithinRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Xmsgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Xmsgs = append(m.Xmsgs, &Xmsg{})
+			if err := m.Xmsgs[len(m.Xmsgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPending", wireType)
+			}
+			m.TotalPending = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPending |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentWithdrawWindow", wireType)
+			}
+			m.CurrentWithdrawWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentWithdrawWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentWithdrawRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentWithdrawRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitExceeded", wireType)
 			}
-			m.ChainId = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9633,16 +13072,17 @@ func (m *QueryGetXmsgByNonceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			m.RateLimitExceeded = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainRateLimits", wireType)
 			}
-			m.Nonce = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9652,11 +13092,60 @@ func (m *QueryGetXmsgByNonceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainRateLimits = append(m.ChainRateLimits, ChainRateLimitStatus{})
+			if err := m.ChainRateLimits[len(m.ChainRateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventRateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventRateLimits = append(m.EventRateLimits, EventRateLimitStatus{})
+			if err := m.EventRateLimits[len(m.EventRateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9678,7 +13167,7 @@ func (m *QueryGetXmsgByNonceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryXmsgByNonceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLastPellHeightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9701,48 +13190,12 @@ func (m *QueryXmsgByNonceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryXmsgByNonceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLastPellHeightRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryXmsgByNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLastPellHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Xmsg", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Xmsg == nil {
-				m.Xmsg = &Xmsg{}
-			}
-			if err := m.Xmsg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9764,7 +13217,7 @@ func (m *QueryXmsgByNonceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryXmsgResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLastPellHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9787,17 +13240,17 @@ func (m *QueryXmsgResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryXmsgResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLastPellHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryXmsgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLastPellHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Xmsg", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9807,28 +13260,11 @@ func (m *QueryXmsgResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Xmsg == nil {
-				m.Xmsg = &Xmsg{}
-			}
-			if err := m.Xmsg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9850,7 +13286,7 @@ func (m *QueryXmsgResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllXmsgRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRateLimiterFlagsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9873,48 +13309,12 @@ func (m *QueryAllXmsgRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllXmsgRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRateLimiterFlagsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllXmsgRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRateLimiterFlagsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9936,7 +13336,7 @@ func (m *QueryAllXmsgRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryXmsgAllResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRateLimiterFlagsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9959,49 +13359,15 @@ func (m *QueryXmsgAllResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryXmsgAllResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRateLimiterFlagsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryXmsgAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRateLimiterFlagsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Xmsgs", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Xmsgs = append(m.Xmsgs, &Xmsg{})
-			if err := m.Xmsgs[len(m.Xmsgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimiterFlags", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10028,10 +13394,7 @@ func (m *QueryXmsgAllResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RateLimiterFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10056,7 +13419,7 @@ func (m *QueryXmsgAllResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListPendingXmsgRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryChainIndexRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10076,39 +13439,20 @@ func (m *QueryListPendingXmsgRequest) Unmarshal(dAtA []byte) error {
 				break
 			}
 		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListPendingXmsgRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListPendingXmsgRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-			}
-			m.ChainId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChainIndexRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChainIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			m.Limit = 0
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10118,7 +13462,7 @@ func (m *QueryListPendingXmsgRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= uint32(b&0x7F) << shift
+				m.ChainId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -10144,7 +13488,7 @@ func (m *QueryListPendingXmsgRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListPendingXmsgResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryChainIndexResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10167,17 +13511,17 @@ func (m *QueryListPendingXmsgResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListPendingXmsgResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChainIndexResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListPendingXmsgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChainIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Xmsg", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var msglen int
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10187,31 +13531,16 @@ func (m *QueryListPendingXmsgResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ChainId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Xmsg = append(m.Xmsg, &Xmsg{})
-			if err := m.Xmsg[len(m.Xmsg)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalPending", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrHeight", wireType)
 			}
-			m.TotalPending = 0
+			m.CurrHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10221,7 +13550,7 @@ func (m *QueryListPendingXmsgResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalPending |= uint64(b&0x7F) << shift
+				m.CurrHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -10247,7 +13576,7 @@ func (m *QueryListPendingXmsgResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListPendingXmsgWithinRateLimitRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBlockProofRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10270,17 +13599,17 @@ func (m *QueryListPendingXmsgWithinRateLimitRequest) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListPendingXmsgWithinRateLimitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlockProofRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListPendingXmsgWithinRateLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlockProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			m.Limit = 0
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10290,7 +13619,26 @@ func (m *QueryListPendingXmsgWithinRateLimitRequest) Unmarshal(dAtA []byte) erro
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= uint32(b&0x7F) << shift
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -10316,7 +13664,7 @@ func (m *QueryListPendingXmsgWithinRateLimitRequest) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *QueryListPendingXmsgWithinRateLimitResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBlockProofResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10339,17 +13687,17 @@ func (m *QueryListPendingXmsgWithinRateLimitResponse) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListPendingXmsgWithinRateLimitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlockProofResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListPendingXmsgWithinRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlockProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Xmsgs", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var msglen int
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10359,31 +13707,16 @@ func (m *QueryListPendingXmsgWithinRateLimitResponse) Unmarshal(dAtA []byte) err
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ChainId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Xmsgs = append(m.Xmsgs, &Xmsg{})
-			if err := m.Xmsgs[len(m.Xmsgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalPending", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevBlockHeight", wireType)
 			}
-			m.TotalPending = 0
+			m.PrevBlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10393,16 +13726,16 @@ func (m *QueryListPendingXmsgWithinRateLimitResponse) Unmarshal(dAtA []byte) err
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalPending |= uint64(b&0x7F) << shift
+				m.PrevBlockHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentWithdrawWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			m.CurrentWithdrawWindow = 0
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10412,14 +13745,14 @@ func (m *QueryListPendingXmsgWithinRateLimitResponse) Unmarshal(dAtA []byte) err
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrentWithdrawWindow |= int64(b&0x7F) << shift
+				m.BlockHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentWithdrawRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10447,13 +13780,13 @@ func (m *QueryListPendingXmsgWithinRateLimitResponse) Unmarshal(dAtA []byte) err
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrentWithdrawRate = string(dAtA[iNdEx:postIndex])
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitExceeded", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10463,131 +13796,26 @@ func (m *QueryListPendingXmsgWithinRateLimitResponse) Unmarshal(dAtA []byte) err
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RateLimitExceeded = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryLastPellHeightRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLastPellHeightRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLastPellHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryLastPellHeightResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLastPellHeightResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLastPellHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Events = append(m.Events, &Event{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10609,7 +13837,7 @@ func (m *QueryLastPellHeightResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRateLimiterFlagsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPellRechargeOperationIndexRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10632,12 +13860,31 @@ func (m *QueryRateLimiterFlagsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRateLimiterFlagsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPellRechargeOperationIndexRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRateLimiterFlagsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPellRechargeOperationIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10659,7 +13906,7 @@ func (m *QueryRateLimiterFlagsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRateLimiterFlagsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPellRechargeOperationIndexResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10682,17 +13929,17 @@ func (m *QueryRateLimiterFlagsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRateLimiterFlagsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPellRechargeOperationIndexResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRateLimiterFlagsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPellRechargeOperationIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RateLimiterFlags", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var msglen int
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10702,25 +13949,30 @@ func (m *QueryRateLimiterFlagsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ChainId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrIndex", wireType)
 			}
-			if err := m.RateLimiterFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.CurrIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10742,7 +13994,7 @@ func (m *QueryRateLimiterFlagsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryChainIndexRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGasRechargeOperationIndexRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10765,10 +14017,10 @@ func (m *QueryChainIndexRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryChainIndexRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGasRechargeOperationIndexRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryChainIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGasRechargeOperationIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10811,7 +14063,7 @@ func (m *QueryChainIndexRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryChainIndexResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGasRechargeOperationIndexResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10834,10 +14086,10 @@ func (m *QueryChainIndexResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryChainIndexResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGasRechargeOperationIndexResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryChainIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGasRechargeOperationIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10861,9 +14113,9 @@ func (m *QueryChainIndexResponse) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrIndex", wireType)
 			}
-			m.CurrHeight = 0
+			m.CurrIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10873,7 +14125,7 @@ func (m *QueryChainIndexResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrHeight |= uint64(b&0x7F) << shift
+				m.CurrIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -10899,7 +14151,7 @@ func (m *QueryChainIndexResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlockProofRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRateLimiterInputRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10922,17 +14174,17 @@ func (m *QueryBlockProofRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlockProofRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRateLimiterInputRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlockProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRateLimiterInputRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			m.ChainId = 0
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10942,16 +14194,16 @@ func (m *QueryBlockProofRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
+				m.Limit |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
-			m.Height = 0
+			m.Window = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10961,7 +14213,7 @@ func (m *QueryBlockProofRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				m.Window |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -10987,7 +14239,7 @@ func (m *QueryBlockProofRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlockProofResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRateLimiterInputResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11010,17 +14262,17 @@ func (m *QueryBlockProofResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlockProofResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRateLimiterInputResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlockProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRateLimiterInputResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.ChainId = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11030,16 +14282,16 @@ func (m *QueryBlockProofResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevBlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XmsgsMissed", wireType)
 			}
-			m.PrevBlockHeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11049,16 +14301,31 @@ func (m *QueryBlockProofResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PrevBlockHeight |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XmsgsMissed = append(m.XmsgsMissed, &Xmsg{})
+			if err := m.XmsgsMissed[len(m.XmsgsMissed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XmsgsPending", wireType)
 			}
-			m.BlockHeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11068,14 +14335,67 @@ func (m *QueryBlockProofResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XmsgsPending = append(m.XmsgsPending, &Xmsg{})
+			if err := m.XmsgsPending[len(m.XmsgsPending)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPending", wireType)
+			}
+			m.TotalPending = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPending |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowestPendingXmsgHeight", wireType)
+			}
+			m.LowestPendingXmsgHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowestPendingXmsgHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PastXmsgsValue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11103,11 +14423,11 @@ func (m *QueryBlockProofResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			m.PastXmsgsValue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PastChainValues", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11134,8 +14454,42 @@ func (m *QueryBlockProofResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Events = append(m.Events, &Event{})
-			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PastChainValues = append(m.PastChainValues, ChainRateLimitValue{})
+			if err := m.PastChainValues[len(m.PastChainValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PastEventValues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PastEventValues = append(m.PastEventValues, EventRateLimitValue{})
+			if err := m.PastEventValues[len(m.PastEventValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11160,7 +14514,7 @@ func (m *QueryBlockProofResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPellRechargeOperationIndexRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRechargeLedgerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11183,10 +14537,10 @@ func (m *QueryPellRechargeOperationIndexRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPellRechargeOperationIndexRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRechargeLedgerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPellRechargeOperationIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRechargeLedgerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11208,6 +14562,25 @@ func (m *QueryPellRechargeOperationIndexRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
+			}
+			m.TokenType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TokenType |= RechargeTokenType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -11229,7 +14602,7 @@ func (m *QueryPellRechargeOperationIndexRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPellRechargeOperationIndexResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRechargeLedgerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11252,17 +14625,17 @@ func (m *QueryPellRechargeOperationIndexResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPellRechargeOperationIndexResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRechargeLedgerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPellRechargeOperationIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRechargeLedgerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ledger", wireType)
 			}
-			m.ChainId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11272,16 +14645,30 @@ func (m *QueryPellRechargeOperationIndexResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Ledger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrIndex", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Budget", wireType)
 			}
-			m.CurrIndex = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11291,11 +14678,28 @@ func (m *QueryPellRechargeOperationIndexResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrIndex |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Budget == nil {
+				m.Budget = &RechargeBudget{}
+			}
+			if err := m.Budget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -11317,7 +14721,7 @@ func (m *QueryPellRechargeOperationIndexResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGasRechargeOperationIndexRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllRechargeLedgerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11340,17 +14744,17 @@ func (m *QueryGasRechargeOperationIndexRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGasRechargeOperationIndexRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllRechargeLedgerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGasRechargeOperationIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllRechargeLedgerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.ChainId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11360,11 +14764,28 @@ func (m *QueryGasRechargeOperationIndexRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -11386,7 +14807,7 @@ func (m *QueryGasRechargeOperationIndexRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGasRechargeOperationIndexResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRechargeLedgerAllResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11409,17 +14830,17 @@ func (m *QueryGasRechargeOperationIndexResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGasRechargeOperationIndexResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRechargeLedgerAllResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGasRechargeOperationIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRechargeLedgerAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ledgers", wireType)
 			}
-			m.ChainId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11429,16 +14850,31 @@ func (m *QueryGasRechargeOperationIndexResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ledgers = append(m.Ledgers, RechargeLedger{})
+			if err := m.Ledgers[len(m.Ledgers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrIndex", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.CurrIndex = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11448,11 +14884,28 @@ func (m *QueryGasRechargeOperationIndexResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrIndex |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -11474,7 +14927,7 @@ func (m *QueryGasRechargeOperationIndexResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRateLimiterInputRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllRechargeBudgetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11497,50 +14950,12 @@ func (m *QueryRateLimiterInputRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRateLimiterInputRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllRechargeBudgetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRateLimiterInputRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllRechargeBudgetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
-			}
-			m.Limit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Limit |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
-			}
-			m.Window = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Window |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -11562,7 +14977,7 @@ func (m *QueryRateLimiterInputRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRateLimiterInputResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRechargeBudgetAllResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11585,68 +15000,15 @@ func (m *QueryRateLimiterInputResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRateLimiterInputResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRechargeBudgetAllResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRateLimiterInputResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRechargeBudgetAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field XmsgsMissed", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XmsgsMissed = append(m.XmsgsMissed, &Xmsg{})
-			if err := m.XmsgsMissed[len(m.XmsgsMissed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field XmsgsPending", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Budgets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11673,49 +15035,11 @@ func (m *QueryRateLimiterInputResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XmsgsPending = append(m.XmsgsPending, &Xmsg{})
-			if err := m.XmsgsPending[len(m.XmsgsPending)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Budgets = append(m.Budgets, RechargeBudget{})
+			if err := m.Budgets[len(m.Budgets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalPending", wireType)
-			}
-			m.TotalPending = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TotalPending |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LowestPendingXmsgHeight", wireType)
-			}
-			m.LowestPendingXmsgHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LowestPendingXmsgHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,302 @@
+package types
+
+import (
+	"math"
+	"sort"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/0xPellNetwork/aegis/pkg/chains"
+)
+
+// RateLimitBudget accumulates the value of the outbound xmsgs against a rate limit
+type RateLimitBudget struct {
+	// left boundary (inclusive) of the rate limit sliding window
+	leftWindowBoundary int64
+
+	// withdrawWindow is the window used to compute the withdraw rate
+	withdrawWindow int64
+
+	// withdrawLimit is the total value allowed within the withdraw window
+	withdrawLimit sdkmath.Int
+
+	// total is the value accumulated so far
+	total sdkmath.Int
+}
+
+// NewRateLimitBudget creates a budget for the given window and rate at the given height
+// for a period of time >= `window`, the average withdraw rate should be <= `rate`, so if the pending xmsgs span
+// a wider window than `window`, the wider window is used and the limit is adjusted proportionally
+func NewRateLimitBudget(window int64, rate sdkmath.Uint, height int64, lowestPendingXmsgHeight int64) *RateLimitBudget {
+	blockLimit := sdkmath.NewIntFromBigInt(rate.BigInt())
+
+	withdrawWindow := window
+	if lowestPendingXmsgHeight != 0 {
+		pendingXmsgWindow := height - lowestPendingXmsgHeight + 1
+		if pendingXmsgWindow > window {
+			withdrawWindow = pendingXmsgWindow
+		}
+	}
+
+	leftWindowBoundary := height - window + 1
+	if leftWindowBoundary < 0 {
+		leftWindowBoundary = 0
+	}
+
+	return &RateLimitBudget{
+		leftWindowBoundary: leftWindowBoundary,
+		withdrawWindow:     withdrawWindow,
+		withdrawLimit:      blockLimit.Mul(sdkmath.NewInt(withdrawWindow)),
+		total:              sdkmath.ZeroInt(),
+	}
+}
+
+// InWindow returns true if the xmsg falls within the rate limit sliding window
+func (b *RateLimitBudget) InWindow(xmsg *Xmsg) bool {
+	// #nosec G701 checked positive
+	return xmsg.InboundTxParams.InboundTxBlockHeight >= uint64(b.leftWindowBoundary)
+}
+
+// Add accumulates the given value
+func (b *RateLimitBudget) Add(value sdkmath.Int) {
+	b.total = b.total.Add(value)
+}
+
+// Total returns the accumulated value
+func (b *RateLimitBudget) Total() sdkmath.Int {
+	return b.total
+}
+
+// Exceeded returns true if the accumulated value exceeds the withdraw limit
+func (b *RateLimitBudget) Exceeded() bool {
+	return b.total.GT(b.withdrawLimit)
+}
+
+// CurrentWithdrawWindow returns the window used to compute the withdraw rate
+func (b *RateLimitBudget) CurrentWithdrawWindow() int64 {
+	return b.withdrawWindow
+}
+
+// CurrentWithdrawRate returns the average withdraw rate (value per block) within the withdraw window
+func (b *RateLimitBudget) CurrentWithdrawRate() sdkmath.Int {
+	if b.withdrawWindow <= 0 {
+		return sdkmath.ZeroInt()
+	}
+	return b.total.Quo(sdkmath.NewInt(b.withdrawWindow))
+}
+
+// RateLimitBudgets holds the budgets of the global, chain and event rate limits of the rate limiter flags
+//
+// The global and chain rate limits count the outbound xmsgs, the event rate limits count the value of the xmsgs
+// returned by GetRateLimitEventValue. An outbound xmsg is held back if any of its budgets is exceeded.
+type RateLimitBudgets struct {
+	// Global is the budget of the global rate limit, nil if the global rate limit is not set
+	Global *RateLimitBudget
+
+	// Chains are the budgets of the chain rate limits by receiver chain id
+	Chains map[int64]*RateLimitBudget
+
+	// Events are the budgets of the event rate limits by event type
+	Events map[RateLimitEventType]*RateLimitBudget
+}
+
+// NewRateLimitBudgets creates the budgets of the rate limits defined in the flags
+// lowestPendingXmsgHeights contains the lowest height of the pending xmsgs of each receiver chain
+func NewRateLimitBudgets(
+	flags RateLimiterFlags,
+	height int64,
+	lowestPendingXmsgHeight int64,
+	lowestPendingXmsgHeights map[int64]int64,
+) *RateLimitBudgets {
+	budgets := &RateLimitBudgets{
+		Chains: make(map[int64]*RateLimitBudget),
+		Events: make(map[RateLimitEventType]*RateLimitBudget),
+	}
+
+	if flags.IsGlobalRateLimitSet() {
+		budgets.Global = NewRateLimitBudget(flags.Window, flags.Rate, height, lowestPendingXmsgHeight)
+	}
+	for _, limit := range flags.ChainRateLimits {
+		budgets.Chains[limit.ChainId] = NewRateLimitBudget(
+			limit.Window,
+			limit.Rate,
+			height,
+			lowestPendingXmsgHeights[limit.ChainId],
+		)
+	}
+	for _, limit := range flags.EventRateLimits {
+		budgets.Events[limit.EventType] = NewRateLimitBudget(limit.Window, limit.Rate, height, lowestPendingXmsgHeight)
+	}
+
+	return budgets
+}
+
+// IsOutgoingXmsg returns true if the xmsg is an outbound xmsg originating from PellChain, which is rate limited
+// reverted inbound xmsgs have an external sender chain and are not rate limited
+func IsOutgoingXmsg(xmsg *Xmsg) bool {
+	return chains.IsPellChain(xmsg.InboundTxParams.SenderChainId)
+}
+
+// AddPast accumulates a past outbound xmsg in the budgets whose sliding window contains the xmsg
+func (b *RateLimitBudgets) AddPast(xmsg *Xmsg) {
+	b.add(xmsg, true)
+}
+
+// AddPending accumulates a pending outbound xmsg in all its budgets
+func (b *RateLimitBudgets) AddPending(xmsg *Xmsg) {
+	b.add(xmsg, false)
+}
+
+// add accumulates the xmsg in its budgets, only in the budgets whose window contains the xmsg if checkWindow is set
+func (b *RateLimitBudgets) add(xmsg *Xmsg, checkWindow bool) {
+	if b.Global != nil && (!checkWindow || b.Global.InWindow(xmsg)) {
+		b.Global.Add(sdkmath.OneInt())
+	}
+	chainID := xmsg.GetCurrentOutTxParam().ReceiverChainId
+	if budget, found := b.Chains[chainID]; found && (!checkWindow || budget.InWindow(xmsg)) {
+		budget.Add(sdkmath.OneInt())
+	}
+	eventType := GetRateLimitEventType(xmsg)
+	if budget, found := b.Events[eventType]; found && (!checkWindow || budget.InWindow(xmsg)) {
+		budget.Add(GetRateLimitEventValue(xmsg))
+	}
+}
+
+// AddGlobal accumulates the given value in the global budget if any
+func (b *RateLimitBudgets) AddGlobal(value sdkmath.Int) {
+	if b.Global != nil {
+		b.Global.Add(value)
+	}
+}
+
+// AddChain accumulates the given value in the budget of the chain if any
+func (b *RateLimitBudgets) AddChain(chainID int64, value sdkmath.Int) {
+	if budget, found := b.Chains[chainID]; found {
+		budget.Add(value)
+	}
+}
+
+// AddEvent accumulates the given value in the budget of the event type if any
+func (b *RateLimitBudgets) AddEvent(eventType RateLimitEventType, value sdkmath.Int) {
+	if budget, found := b.Events[eventType]; found {
+		budget.Add(value)
+	}
+}
+
+// InWindow returns true if the xmsg falls within the sliding window of at least one of its budgets
+func (b *RateLimitBudgets) InWindow(xmsg *Xmsg) bool {
+	if b.Global != nil && b.Global.InWindow(xmsg) {
+		return true
+	}
+	if budget, found := b.Chains[xmsg.GetCurrentOutTxParam().ReceiverChainId]; found && budget.InWindow(xmsg) {
+		return true
+	}
+	if budget, found := b.Events[GetRateLimitEventType(xmsg)]; found && budget.InWindow(xmsg) {
+		return true
+	}
+	return false
+}
+
+// GlobalExceeded returns true if the global rate limit is exceeded
+func (b *RateLimitBudgets) GlobalExceeded() bool {
+	return b.Global != nil && b.Global.Exceeded()
+}
+
+// IsExceeded returns true if any of the budgets of the xmsg is exceeded
+func (b *RateLimitBudgets) IsExceeded(xmsg *Xmsg) bool {
+	if b.GlobalExceeded() {
+		return true
+	}
+	if budget, found := b.Chains[xmsg.GetCurrentOutTxParam().ReceiverChainId]; found && budget.Exceeded() {
+		return true
+	}
+	if budget, found := b.Events[GetRateLimitEventType(xmsg)]; found && budget.Exceeded() {
+		return true
+	}
+	return false
+}
+
+// FilterPending returns the pending xmsgs that are not held back by an exceeded rate limit
+// all the pending xmsgs are held back if the global rate limit is exceeded. Otherwise, as the outbound xmsgs of a
+// chain are signed in nonce order, a chain is held back from its lowest nonce outgoing xmsg with an exceeded budget.
+// The order of the given xmsgs is preserved.
+func (b *RateLimitBudgets) FilterPending(xmsgs []*Xmsg) []*Xmsg {
+	if b.GlobalExceeded() {
+		return []*Xmsg{}
+	}
+
+	// find the lowest nonce held back on each chain
+	heldNonces := make(map[int64]uint64)
+	for _, xmsg := range xmsgs {
+		if !IsOutgoingXmsg(xmsg) || !b.IsExceeded(xmsg) {
+			continue
+		}
+		chainID := xmsg.GetCurrentOutTxParam().ReceiverChainId
+		nonce := xmsg.GetCurrentOutTxParam().OutboundTxTssNonce
+		if held, found := heldNonces[chainID]; !found || nonce < held {
+			heldNonces[chainID] = nonce
+		}
+	}
+
+	filtered := make([]*Xmsg, 0, len(xmsgs))
+	for _, xmsg := range xmsgs {
+		heldNonce, found := heldNonces[xmsg.GetCurrentOutTxParam().ReceiverChainId]
+		if !found {
+			heldNonce = math.MaxUint64
+		}
+		if xmsg.GetCurrentOutTxParam().OutboundTxTssNonce < heldNonce {
+			filtered = append(filtered, xmsg)
+		}
+	}
+	return filtered
+}
+
+// ChainStatuses returns the current withdraw rate of each chain rate limit, sorted by chain id
+func (b *RateLimitBudgets) ChainStatuses() []ChainRateLimitStatus {
+	statuses := make([]ChainRateLimitStatus, 0, len(b.Chains))
+	for chainID, budget := range b.Chains {
+		statuses = append(statuses, ChainRateLimitStatus{
+			ChainId:               chainID,
+			CurrentWithdrawWindow: budget.CurrentWithdrawWindow(),
+			CurrentWithdrawRate:   budget.CurrentWithdrawRate().String(),
+			RateLimitExceeded:     budget.Exceeded(),
+		})
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].ChainId < statuses[j].ChainId })
+	return statuses
+}
+
+// EventStatuses returns the current withdraw rate of each event rate limit, sorted by event type
+func (b *RateLimitBudgets) EventStatuses() []EventRateLimitStatus {
+	statuses := make([]EventRateLimitStatus, 0, len(b.Events))
+	for eventType, budget := range b.Events {
+		statuses = append(statuses, EventRateLimitStatus{
+			EventType:             eventType,
+			CurrentWithdrawWindow: budget.CurrentWithdrawWindow(),
+			CurrentWithdrawRate:   budget.CurrentWithdrawRate().String(),
+			RateLimitExceeded:     budget.Exceeded(),
+		})
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].EventType < statuses[j].EventType })
+	return statuses
+}
+
+// ChainValues returns the accumulated value of each chain rate limit, sorted by chain id
+func (b *RateLimitBudgets) ChainValues() []ChainRateLimitValue {
+	values := make([]ChainRateLimitValue, 0, len(b.Chains))
+	for chainID, budget := range b.Chains {
+		values = append(values, ChainRateLimitValue{ChainId: chainID, Value: budget.Total().String()})
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i].ChainId < values[j].ChainId })
+	return values
+}
+
+// EventValues returns the accumulated value of each event rate limit, sorted by event type
+func (b *RateLimitBudgets) EventValues() []EventRateLimitValue {
+	values := make([]EventRateLimitValue, 0, len(b.Events))
+	for eventType, budget := range b.Events {
+		values = append(values, EventRateLimitValue{EventType: eventType, Value: budget.Total().String()})
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i].EventType < values[j].EventType })
+	return values
+}
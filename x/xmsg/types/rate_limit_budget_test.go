@@ -0,0 +1,187 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/pkg/chains"
+	"github.com/0xPellNetwork/aegis/testutil/sample"
+	"github.com/0xPellNetwork/aegis/x/xmsg/types"
+)
+
+func TestRateLimitBudget(t *testing.T) {
+	t.Run("should use the given window", func(t *testing.T) {
+		budget := types.NewRateLimitBudget(100, math.NewUint(2), 1000, 950)
+		require.EqualValues(t, 100, budget.CurrentWithdrawWindow())
+
+		budget.Add(math.NewInt(200))
+		require.False(t, budget.Exceeded())
+		require.Equal(t, math.NewInt(2), budget.CurrentWithdrawRate())
+
+		budget.Add(math.OneInt())
+		require.True(t, budget.Exceeded())
+		require.Equal(t, math.NewInt(201), budget.Total())
+	})
+
+	t.Run("should widen the window to the lowest pending xmsg height", func(t *testing.T) {
+		budget := types.NewRateLimitBudget(100, math.NewUint(2), 1000, 801)
+		require.EqualValues(t, 200, budget.CurrentWithdrawWindow())
+
+		budget.Add(math.NewInt(400))
+		require.False(t, budget.Exceeded())
+		budget.Add(math.OneInt())
+		require.True(t, budget.Exceeded())
+	})
+
+	t.Run("should check if xmsg is in window", func(t *testing.T) {
+		budget := types.NewRateLimitBudget(100, math.NewUint(2), 1000, 0)
+		xmsgs := sample.CustomXmsgsInBlockRange(t, 900, 901, chains.PellPrivnetChain().Id, 1, types.XmsgStatus_OUTBOUND_MINED)
+		require.False(t, budget.InWindow(xmsgs[0]))
+		require.True(t, budget.InWindow(xmsgs[1]))
+	})
+}
+
+func TestRateLimitBudgets(t *testing.T) {
+	pellChainID := chains.PellPrivnetChain().Id
+	ethChainID := chains.EthChain().Id
+	bscChainID := chains.BscMainnetChain().Id
+
+	// pending xmsgs at height [91, 100] for eth and bsc, all of them are delegation syncs
+	ethXmsgs := sample.CustomXmsgsInBlockRange(t, 91, 100, pellChainID, ethChainID, types.XmsgStatus_PENDING_OUTBOUND)
+	bscXmsgs := sample.CustomXmsgsInBlockRange(t, 91, 100, pellChainID, bscChainID, types.XmsgStatus_PENDING_OUTBOUND)
+	allXmsgs := append(append([]*types.Xmsg{}, ethXmsgs...), bscXmsgs...)
+
+	// addPending adds the pending xmsgs to the budgets
+	addPending := func(budgets *types.RateLimitBudgets, xmsgs []*types.Xmsg) {
+		for _, xmsg := range xmsgs {
+			budgets.AddPending(xmsg)
+		}
+	}
+
+	t.Run("should create budgets from flags", func(t *testing.T) {
+		budgets := types.NewRateLimitBudgets(types.RateLimiterFlags{
+			Enabled:         true,
+			Window:          10,
+			Rate:            math.NewUint(10),
+			ChainRateLimits: []types.ChainRateLimit{{ChainId: ethChainID, Window: 10, Rate: math.NewUint(1)}},
+			EventRateLimits: []types.EventRateLimit{
+				{EventType: types.RateLimitEventType_PELL_SENT_EVENT, Window: 10, Rate: math.NewUint(1)},
+			},
+		}, 100, 91, nil)
+		require.NotNil(t, budgets.Global)
+		require.Len(t, budgets.Chains, 1)
+		require.Len(t, budgets.Events, 1)
+
+		budgets = types.NewRateLimitBudgets(types.RateLimiterFlags{Enabled: true}, 100, 91, nil)
+		require.Nil(t, budgets.Global)
+		require.Empty(t, budgets.Chains)
+		require.Empty(t, budgets.Events)
+	})
+
+	t.Run("should schedule all pending xmsgs within rate limits", func(t *testing.T) {
+		budgets := types.NewRateLimitBudgets(types.RateLimiterFlags{
+			Enabled:         true,
+			Window:          10,
+			Rate:            math.NewUint(2),
+			ChainRateLimits: []types.ChainRateLimit{{ChainId: ethChainID, Window: 10, Rate: math.NewUint(1)}},
+		}, 100, 91, nil)
+		addPending(budgets, allXmsgs)
+
+		require.Equal(t, allXmsgs, budgets.FilterPending(allXmsgs))
+		require.Equal(t, []types.ChainRateLimitStatus{{
+			ChainId:               ethChainID,
+			CurrentWithdrawWindow: 10,
+			CurrentWithdrawRate:   "1",
+			RateLimitExceeded:     false,
+		}}, budgets.ChainStatuses())
+	})
+
+	t.Run("should hold back all pending xmsgs if global rate limit is exceeded", func(t *testing.T) {
+		budgets := types.NewRateLimitBudgets(types.RateLimiterFlags{
+			Enabled: true,
+			Window:  10,
+			Rate:    math.NewUint(1),
+		}, 100, 91, nil)
+		addPending(budgets, allXmsgs)
+
+		require.True(t, budgets.GlobalExceeded())
+		require.Empty(t, budgets.FilterPending(allXmsgs))
+	})
+
+	t.Run("should only hold back the chain exceeding its rate limit", func(t *testing.T) {
+		budgets := types.NewRateLimitBudgets(types.RateLimiterFlags{
+			Enabled: true,
+			Window:  10,
+			Rate:    math.NewUint(2),
+			ChainRateLimits: []types.ChainRateLimit{
+				{ChainId: ethChainID, Window: 5, Rate: math.NewUint(1)},
+				{ChainId: bscChainID, Window: 10, Rate: math.NewUint(1)},
+			},
+		}, 100, 91, map[int64]int64{bscChainID: 91})
+
+		// eth window is [96, 100], its 5 xmsgs within window are counted as past xmsgs
+		for _, xmsg := range ethXmsgs {
+			budgets.AddPast(xmsg)
+		}
+		require.Equal(t, math.NewInt(5), budgets.Chains[ethChainID].Total())
+
+		// add one more pending xmsg to each chain
+		budgets.AddPending(ethXmsgs[9])
+		budgets.AddPending(bscXmsgs[9])
+
+		require.False(t, budgets.GlobalExceeded())
+		require.True(t, budgets.IsExceeded(ethXmsgs[0]))
+		require.False(t, budgets.IsExceeded(bscXmsgs[0]))
+		require.Equal(t, bscXmsgs, budgets.FilterPending(allXmsgs))
+	})
+
+	t.Run("should hold back a chain from the first xmsg exceeding its event rate limit", func(t *testing.T) {
+		// make the last 5 eth xmsgs pell sent events with 1 PELL each
+		pellSentXmsgs := sample.CustomXmsgsInBlockRange(t, 91, 100, pellChainID, ethChainID, types.XmsgStatus_PENDING_OUTBOUND)
+		for _, xmsg := range pellSentXmsgs[5:] {
+			xmsg.InboundTxParams.InboundPellTx = sample.InboundPellTx_PellSend_pell(sample.Rand())
+			xmsg.InboundTxParams.InboundPellTx.GetPellSent().PellValue = math.NewUint(1e18)
+		}
+		xmsgs := append(append([]*types.Xmsg{}, pellSentXmsgs...), bscXmsgs...)
+
+		budgets := types.NewRateLimitBudgets(types.RateLimiterFlags{
+			Enabled: true,
+			EventRateLimits: []types.EventRateLimit{
+				{EventType: types.RateLimitEventType_PELL_SENT_EVENT, Window: 10, Rate: math.NewUint(4e17)},
+			},
+		}, 100, 91, nil)
+		addPending(budgets, xmsgs)
+
+		require.Equal(t, []types.EventRateLimitStatus{{
+			EventType:             types.RateLimitEventType_PELL_SENT_EVENT,
+			CurrentWithdrawWindow: 10,
+			CurrentWithdrawRate:   math.NewInt(5e17).String(),
+			RateLimitExceeded:     true,
+		}}, budgets.EventStatuses())
+
+		// the delegation syncs before the first pell sent and the bsc xmsgs are scheduled
+		expected := append(append([]*types.Xmsg{}, pellSentXmsgs[:5]...), bscXmsgs...)
+		require.Equal(t, expected, budgets.FilterPending(xmsgs))
+	})
+
+	t.Run("should report accumulated values", func(t *testing.T) {
+		budgets := types.NewRateLimitBudgets(types.RateLimiterFlags{
+			Enabled:         true,
+			ChainRateLimits: []types.ChainRateLimit{{ChainId: bscChainID, Window: 10, Rate: math.NewUint(1)}},
+			EventRateLimits: []types.EventRateLimit{
+				{EventType: types.RateLimitEventType_DELEGATION_SYNC_EVENT, Window: 10, Rate: math.NewUint(1)},
+			},
+		}, 100, 0, nil)
+		for _, xmsg := range allXmsgs {
+			budgets.AddPast(xmsg)
+		}
+
+		require.Equal(t, []types.ChainRateLimitValue{{ChainId: bscChainID, Value: "10"}}, budgets.ChainValues())
+		require.Equal(t, []types.EventRateLimitValue{{
+			EventType: types.RateLimitEventType_DELEGATION_SYNC_EVENT,
+			Value:     "20",
+		}}, budgets.EventValues())
+	})
+}
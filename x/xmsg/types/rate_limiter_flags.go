@@ -13,6 +13,46 @@ func (r RateLimiterFlags) Validate() error {
 		return fmt.Errorf("window must be positive: %d", r.Window)
 	}
 
+	// chain rate limits must be unique per chain and usable
+	chainIDs := make(map[int64]bool)
+	for _, limit := range r.ChainRateLimits {
+		if chainIDs[limit.ChainId] {
+			return fmt.Errorf("duplicated rate limit for chain %d", limit.ChainId)
+		}
+		chainIDs[limit.ChainId] = true
+
+		if err := validateRateLimit(limit.Window, limit.Rate); err != nil {
+			return fmt.Errorf("invalid rate limit for chain %d: %w", limit.ChainId, err)
+		}
+	}
+
+	// event rate limits must be unique per event type and usable
+	eventTypes := make(map[RateLimitEventType]bool)
+	for _, limit := range r.EventRateLimits {
+		if _, ok := RateLimitEventType_name[int32(limit.EventType)]; !ok {
+			return fmt.Errorf("invalid rate limit event type %d", limit.EventType)
+		}
+		if eventTypes[limit.EventType] {
+			return fmt.Errorf("duplicated rate limit for event type %s", limit.EventType)
+		}
+		eventTypes[limit.EventType] = true
+
+		if err := validateRateLimit(limit.Window, limit.Rate); err != nil {
+			return fmt.Errorf("invalid rate limit for event type %s: %w", limit.EventType, err)
+		}
+	}
+
+	return nil
+}
+
+// validateRateLimit checks that a chain or event rate limit has a positive window and rate
+func validateRateLimit(window int64, rate math.Uint) error {
+	if window <= 0 {
+		return fmt.Errorf("window must be positive: %d", window)
+	}
+	if rate.IsNil() || rate.IsZero() {
+		return fmt.Errorf("rate must be positive")
+	}
 	return nil
 }
 
@@ -20,3 +60,76 @@ func (r RateLimiterFlags) Validate() error {
 func (r RateLimiterFlags) GetConversionRate(zrc20 string) (math.LegacyDec, bool) {
 	return math.LegacyNewDec(0), false
 }
+
+// IsGlobalRateLimitSet returns true if the global window and rate are set
+func (r RateLimiterFlags) IsGlobalRateLimitSet() bool {
+	return r.Window > 0 && !r.Rate.IsNil() && !r.Rate.IsZero()
+}
+
+// GetChainRateLimit returns the rate limit of the given receiver chain
+func (r RateLimiterFlags) GetChainRateLimit(chainID int64) (ChainRateLimit, bool) {
+	for _, limit := range r.ChainRateLimits {
+		if limit.ChainId == chainID {
+			return limit, true
+		}
+	}
+	return ChainRateLimit{}, false
+}
+
+// GetEventRateLimit returns the rate limit of the given event type
+func (r RateLimiterFlags) GetEventRateLimit(eventType RateLimitEventType) (EventRateLimit, bool) {
+	for _, limit := range r.EventRateLimits {
+		if limit.EventType == eventType {
+			return limit, true
+		}
+	}
+	return EventRateLimit{}, false
+}
+
+// MaxWindow returns the widest window among the global, chain and event rate limits
+func (r RateLimiterFlags) MaxWindow() int64 {
+	window := r.Window
+	for _, limit := range r.ChainRateLimits {
+		if limit.Window > window {
+			window = limit.Window
+		}
+	}
+	for _, limit := range r.EventRateLimits {
+		if limit.Window > window {
+			window = limit.Window
+		}
+	}
+	return window
+}
+
+// GetRateLimitEventType returns the event type of the xmsg used to select its event rate limit
+func GetRateLimitEventType(xmsg *Xmsg) RateLimitEventType {
+	if xmsg.InboundTxParams == nil || xmsg.InboundTxParams.InboundPellTx == nil {
+		return RateLimitEventType_OTHER_EVENT
+	}
+
+	switch xmsg.InboundTxParams.InboundPellTx.PellData.(type) {
+	case *InboundPellEvent_PellSent:
+		return RateLimitEventType_PELL_SENT_EVENT
+	case *InboundPellEvent_StakerDeposited,
+		*InboundPellEvent_StakerDelegated,
+		*InboundPellEvent_StakerUndelegated,
+		*InboundPellEvent_WithdrawalQueued:
+		return RateLimitEventType_DELEGATION_SYNC_EVENT
+	default:
+		return RateLimitEventType_OTHER_EVENT
+	}
+}
+
+// GetRateLimitEventValue returns the value of the xmsg counted against the rate limit of its event type
+// pell sent events are counted by their pell value, other events count as one xmsg
+func GetRateLimitEventValue(xmsg *Xmsg) math.Int {
+	if GetRateLimitEventType(xmsg) != RateLimitEventType_PELL_SENT_EVENT {
+		return math.OneInt()
+	}
+	pellSent := xmsg.InboundTxParams.InboundPellTx.GetPellSent()
+	if pellSent.PellValue.IsNil() {
+		return math.ZeroInt()
+	}
+	return math.NewIntFromBigInt(pellSent.PellValue.BigInt())
+}
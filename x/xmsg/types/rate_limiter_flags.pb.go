@@ -24,6 +24,39 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// event type of the xmsgs that can be rate limited separately
+type RateLimitEventType int32
+
+const (
+	// events not listed below, rated in number of xmsgs
+	RateLimitEventType_OTHER_EVENT RateLimitEventType = 0
+	// pell sent events, rated in apell
+	RateLimitEventType_PELL_SENT_EVENT RateLimitEventType = 1
+	// delegation sync events (deposit, delegation, undelegation and withdrawal
+	// queued), rated in number of xmsgs
+	RateLimitEventType_DELEGATION_SYNC_EVENT RateLimitEventType = 2
+)
+
+var RateLimitEventType_name = map[int32]string{
+	0: "OTHER_EVENT",
+	1: "PELL_SENT_EVENT",
+	2: "DELEGATION_SYNC_EVENT",
+}
+
+var RateLimitEventType_value = map[string]int32{
+	"OTHER_EVENT":           0,
+	"PELL_SENT_EVENT":       1,
+	"DELEGATION_SYNC_EVENT": 2,
+}
+
+func (x RateLimitEventType) String() string {
+	return proto.EnumName(RateLimitEventType_name, int32(x))
+}
+
+func (RateLimitEventType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_109880666c405d93, []int{0}
+}
+
 // rate limiter setting
 type RateLimiterFlags struct {
 	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
@@ -31,6 +64,12 @@ type RateLimiterFlags struct {
 	Window int64 `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"`
 	// rate in apell per block
 	Rate cosmossdk_io_math.Uint `protobuf:"bytes,3,opt,name=rate,proto3,customtype=cosmossdk.io/math.Uint" json:"rate"`
+	// rate limits applied to the outbound xmsgs of a receiver chain in addition
+	// to the global rate limit
+	ChainRateLimits []ChainRateLimit `protobuf:"bytes,4,rep,name=chain_rate_limits,json=chainRateLimits,proto3" json:"chain_rate_limits"`
+	// rate limits applied to the outbound xmsgs of an event type in addition to
+	// the global rate limit
+	EventRateLimits []EventRateLimit `protobuf:"bytes,5,rep,name=event_rate_limits,json=eventRateLimits,proto3" json:"event_rate_limits"`
 }
 
 func (m *RateLimiterFlags) Reset()         { *m = RateLimiterFlags{} }
@@ -80,30 +119,431 @@ func (m *RateLimiterFlags) GetWindow() int64 {
 	return 0
 }
 
+func (m *RateLimiterFlags) GetChainRateLimits() []ChainRateLimit {
+	if m != nil {
+		return m.ChainRateLimits
+	}
+	return nil
+}
+
+func (m *RateLimiterFlags) GetEventRateLimits() []EventRateLimit {
+	if m != nil {
+		return m.EventRateLimits
+	}
+	return nil
+}
+
+// rate limit of the outbound xmsgs of a receiver chain
+type ChainRateLimit struct {
+	ChainId int64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// window in blocks
+	Window int64 `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"`
+	// rate in number of xmsgs per block
+	Rate cosmossdk_io_math.Uint `protobuf:"bytes,3,opt,name=rate,proto3,customtype=cosmossdk.io/math.Uint" json:"rate"`
+}
+
+func (m *ChainRateLimit) Reset()         { *m = ChainRateLimit{} }
+func (m *ChainRateLimit) String() string { return proto.CompactTextString(m) }
+func (*ChainRateLimit) ProtoMessage()    {}
+func (*ChainRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_109880666c405d93, []int{1}
+}
+func (m *ChainRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainRateLimit.Merge(m, src)
+}
+func (m *ChainRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainRateLimit proto.InternalMessageInfo
+
+func (m *ChainRateLimit) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *ChainRateLimit) GetWindow() int64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+// rate limit of the outbound xmsgs of an event type
+type EventRateLimit struct {
+	EventType RateLimitEventType `protobuf:"varint,1,opt,name=event_type,json=eventType,proto3,enum=xmsg.RateLimitEventType" json:"event_type,omitempty"`
+	// window in blocks
+	Window int64 `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"`
+	// rate per block, in apell for pell sent events and in number of xmsgs for
+	// other events
+	Rate cosmossdk_io_math.Uint `protobuf:"bytes,3,opt,name=rate,proto3,customtype=cosmossdk.io/math.Uint" json:"rate"`
+}
+
+func (m *EventRateLimit) Reset()         { *m = EventRateLimit{} }
+func (m *EventRateLimit) String() string { return proto.CompactTextString(m) }
+func (*EventRateLimit) ProtoMessage()    {}
+func (*EventRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_109880666c405d93, []int{2}
+}
+func (m *EventRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRateLimit.Merge(m, src)
+}
+func (m *EventRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRateLimit proto.InternalMessageInfo
+
+func (m *EventRateLimit) GetEventType() RateLimitEventType {
+	if m != nil {
+		return m.EventType
+	}
+	return RateLimitEventType_OTHER_EVENT
+}
+
+func (m *EventRateLimit) GetWindow() int64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+// current withdraw rate of a receiver chain
+type ChainRateLimitStatus struct {
+	ChainId               int64  `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	CurrentWithdrawWindow int64  `protobuf:"varint,2,opt,name=current_withdraw_window,json=currentWithdrawWindow,proto3" json:"current_withdraw_window,omitempty"`
+	CurrentWithdrawRate   string `protobuf:"bytes,3,opt,name=current_withdraw_rate,json=currentWithdrawRate,proto3" json:"current_withdraw_rate,omitempty"`
+	RateLimitExceeded     bool   `protobuf:"varint,4,opt,name=rate_limit_exceeded,json=rateLimitExceeded,proto3" json:"rate_limit_exceeded,omitempty"`
+}
+
+func (m *ChainRateLimitStatus) Reset()         { *m = ChainRateLimitStatus{} }
+func (m *ChainRateLimitStatus) String() string { return proto.CompactTextString(m) }
+func (*ChainRateLimitStatus) ProtoMessage()    {}
+func (*ChainRateLimitStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_109880666c405d93, []int{3}
+}
+func (m *ChainRateLimitStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainRateLimitStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainRateLimitStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainRateLimitStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainRateLimitStatus.Merge(m, src)
+}
+func (m *ChainRateLimitStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainRateLimitStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainRateLimitStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainRateLimitStatus proto.InternalMessageInfo
+
+func (m *ChainRateLimitStatus) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *ChainRateLimitStatus) GetCurrentWithdrawWindow() int64 {
+	if m != nil {
+		return m.CurrentWithdrawWindow
+	}
+	return 0
+}
+
+func (m *ChainRateLimitStatus) GetCurrentWithdrawRate() string {
+	if m != nil {
+		return m.CurrentWithdrawRate
+	}
+	return ""
+}
+
+func (m *ChainRateLimitStatus) GetRateLimitExceeded() bool {
+	if m != nil {
+		return m.RateLimitExceeded
+	}
+	return false
+}
+
+// current withdraw rate of an event type
+type EventRateLimitStatus struct {
+	EventType             RateLimitEventType `protobuf:"varint,1,opt,name=event_type,json=eventType,proto3,enum=xmsg.RateLimitEventType" json:"event_type,omitempty"`
+	CurrentWithdrawWindow int64              `protobuf:"varint,2,opt,name=current_withdraw_window,json=currentWithdrawWindow,proto3" json:"current_withdraw_window,omitempty"`
+	CurrentWithdrawRate   string             `protobuf:"bytes,3,opt,name=current_withdraw_rate,json=currentWithdrawRate,proto3" json:"current_withdraw_rate,omitempty"`
+	RateLimitExceeded     bool               `protobuf:"varint,4,opt,name=rate_limit_exceeded,json=rateLimitExceeded,proto3" json:"rate_limit_exceeded,omitempty"`
+}
+
+func (m *EventRateLimitStatus) Reset()         { *m = EventRateLimitStatus{} }
+func (m *EventRateLimitStatus) String() string { return proto.CompactTextString(m) }
+func (*EventRateLimitStatus) ProtoMessage()    {}
+func (*EventRateLimitStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_109880666c405d93, []int{4}
+}
+func (m *EventRateLimitStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRateLimitStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRateLimitStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRateLimitStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRateLimitStatus.Merge(m, src)
+}
+func (m *EventRateLimitStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRateLimitStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRateLimitStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRateLimitStatus proto.InternalMessageInfo
+
+func (m *EventRateLimitStatus) GetEventType() RateLimitEventType {
+	if m != nil {
+		return m.EventType
+	}
+	return RateLimitEventType_OTHER_EVENT
+}
+
+func (m *EventRateLimitStatus) GetCurrentWithdrawWindow() int64 {
+	if m != nil {
+		return m.CurrentWithdrawWindow
+	}
+	return 0
+}
+
+func (m *EventRateLimitStatus) GetCurrentWithdrawRate() string {
+	if m != nil {
+		return m.CurrentWithdrawRate
+	}
+	return ""
+}
+
+func (m *EventRateLimitStatus) GetRateLimitExceeded() bool {
+	if m != nil {
+		return m.RateLimitExceeded
+	}
+	return false
+}
+
+// value of the past outbound xmsgs of a receiver chain within its rate limit
+// window
+type ChainRateLimitValue struct {
+	ChainId int64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// decimal integer string
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *ChainRateLimitValue) Reset()         { *m = ChainRateLimitValue{} }
+func (m *ChainRateLimitValue) String() string { return proto.CompactTextString(m) }
+func (*ChainRateLimitValue) ProtoMessage()    {}
+func (*ChainRateLimitValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_109880666c405d93, []int{5}
+}
+func (m *ChainRateLimitValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainRateLimitValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainRateLimitValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainRateLimitValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainRateLimitValue.Merge(m, src)
+}
+func (m *ChainRateLimitValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainRateLimitValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainRateLimitValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainRateLimitValue proto.InternalMessageInfo
+
+func (m *ChainRateLimitValue) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *ChainRateLimitValue) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+// value of the past outbound xmsgs of an event type within its rate limit
+// window
+type EventRateLimitValue struct {
+	EventType RateLimitEventType `protobuf:"varint,1,opt,name=event_type,json=eventType,proto3,enum=xmsg.RateLimitEventType" json:"event_type,omitempty"`
+	// decimal integer string
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *EventRateLimitValue) Reset()         { *m = EventRateLimitValue{} }
+func (m *EventRateLimitValue) String() string { return proto.CompactTextString(m) }
+func (*EventRateLimitValue) ProtoMessage()    {}
+func (*EventRateLimitValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_109880666c405d93, []int{6}
+}
+func (m *EventRateLimitValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRateLimitValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRateLimitValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRateLimitValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRateLimitValue.Merge(m, src)
+}
+func (m *EventRateLimitValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRateLimitValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRateLimitValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRateLimitValue proto.InternalMessageInfo
+
+func (m *EventRateLimitValue) GetEventType() RateLimitEventType {
+	if m != nil {
+		return m.EventType
+	}
+	return RateLimitEventType_OTHER_EVENT
+}
+
+func (m *EventRateLimitValue) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("xmsg.RateLimitEventType", RateLimitEventType_name, RateLimitEventType_value)
 	proto.RegisterType((*RateLimiterFlags)(nil), "xmsg.RateLimiterFlags")
+	proto.RegisterType((*ChainRateLimit)(nil), "xmsg.ChainRateLimit")
+	proto.RegisterType((*EventRateLimit)(nil), "xmsg.EventRateLimit")
+	proto.RegisterType((*ChainRateLimitStatus)(nil), "xmsg.ChainRateLimitStatus")
+	proto.RegisterType((*EventRateLimitStatus)(nil), "xmsg.EventRateLimitStatus")
+	proto.RegisterType((*ChainRateLimitValue)(nil), "xmsg.ChainRateLimitValue")
+	proto.RegisterType((*EventRateLimitValue)(nil), "xmsg.EventRateLimitValue")
 }
 
 func init() { proto.RegisterFile("xmsg/rate_limiter_flags.proto", fileDescriptor_109880666c405d93) }
 
 var fileDescriptor_109880666c405d93 = []byte{
-	// 247 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xad, 0xc8, 0x2d, 0x4e,
-	0xd7, 0x2f, 0x4a, 0x2c, 0x49, 0x8d, 0xcf, 0xc9, 0xcc, 0xcd, 0x2c, 0x49, 0x2d, 0x8a, 0x4f, 0xcb,
-	0x49, 0x4c, 0x2f, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x01, 0x49, 0x4b, 0x89, 0xa4,
-	0xe7, 0xa7, 0xe7, 0x83, 0x05, 0xf4, 0x41, 0x2c, 0x88, 0x9c, 0x52, 0x05, 0x97, 0x40, 0x50, 0x62,
-	0x49, 0xaa, 0x0f, 0x44, 0x9b, 0x1b, 0x48, 0x97, 0x90, 0x04, 0x17, 0x7b, 0x6a, 0x5e, 0x62, 0x52,
-	0x4e, 0x6a, 0x8a, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x47, 0x10, 0x8c, 0x2b, 0x24, 0xc6, 0xc5, 0x56,
-	0x9e, 0x99, 0x97, 0x92, 0x5f, 0x2e, 0xc1, 0xa4, 0xc0, 0xa8, 0xc1, 0x1c, 0x04, 0xe5, 0x09, 0x19,
-	0x71, 0xb1, 0x80, 0x6c, 0x97, 0x60, 0x56, 0x60, 0xd4, 0xe0, 0x74, 0x92, 0x3b, 0x71, 0x4f, 0x9e,
-	0xe1, 0xd6, 0x3d, 0x79, 0xb1, 0xe4, 0xfc, 0xe2, 0xdc, 0xfc, 0xe2, 0xe2, 0x94, 0x6c, 0xbd, 0xcc,
-	0x7c, 0xfd, 0xdc, 0xc4, 0x92, 0x0c, 0xbd, 0xd0, 0xcc, 0xbc, 0x92, 0x20, 0xb0, 0x5a, 0x27, 0xd7,
-	0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39,
-	0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xd2, 0x4e, 0xcf, 0x2c, 0xc9, 0x28,
-	0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x37, 0xa8, 0x08, 0x48, 0xcd, 0xc9, 0xf1, 0x4b, 0x2d, 0x29,
-	0xcf, 0x2f, 0xca, 0xd6, 0x4f, 0x4c, 0x4d, 0xcf, 0x2c, 0xd6, 0xaf, 0xd0, 0x07, 0xfb, 0xb7, 0xa4,
-	0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0xec, 0x0f, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x34,
-	0x30, 0x38, 0x55, 0x04, 0x01, 0x00, 0x00,
+	// 560 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x94, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0xb3, 0x89, 0xfb, 0x27, 0x53, 0x29, 0x69, 0x37, 0x49, 0x71, 0x2b, 0xe1, 0x46, 0x39,
+	0x45, 0x20, 0xd9, 0x28, 0x48, 0x70, 0x26, 0xc5, 0x81, 0x4a, 0x51, 0x5a, 0x39, 0xa1, 0x15, 0x70,
+	0xb0, 0x1c, 0x7b, 0x71, 0xac, 0x3a, 0x76, 0xe4, 0xdd, 0xd4, 0xe9, 0x1b, 0x70, 0xe4, 0xc2, 0x1b,
+	0x70, 0xe0, 0x51, 0x7a, 0x41, 0xea, 0x11, 0x71, 0xa8, 0x50, 0x72, 0xe4, 0x25, 0xd0, 0xda, 0x4e,
+	0x82, 0x09, 0xea, 0xa1, 0xea, 0x81, 0x9b, 0x67, 0xbe, 0x9d, 0x99, 0xdf, 0x7c, 0x6b, 0x2d, 0x3c,
+	0x9c, 0x0c, 0xa9, 0xad, 0x04, 0x06, 0x23, 0xba, 0xeb, 0x0c, 0x1d, 0x46, 0x02, 0xfd, 0x83, 0x6b,
+	0xd8, 0x54, 0x1e, 0x05, 0x3e, 0xf3, 0xb1, 0xc0, 0xe5, 0xfd, 0xb2, 0xed, 0xdb, 0x7e, 0x94, 0x50,
+	0xf8, 0x57, 0xac, 0xd5, 0x3e, 0x66, 0x61, 0x5b, 0x33, 0x18, 0x69, 0xc7, 0x75, 0x2d, 0x5e, 0x86,
+	0x45, 0xd8, 0x20, 0x9e, 0xd1, 0x77, 0x89, 0x25, 0xa2, 0x2a, 0xaa, 0x6f, 0x6a, 0xf3, 0x10, 0xef,
+	0xc2, 0x7a, 0xe8, 0x78, 0x96, 0x1f, 0x8a, 0xd9, 0x2a, 0xaa, 0xe7, 0xb4, 0x24, 0xc2, 0x0d, 0x10,
+	0xf8, 0x78, 0x31, 0x57, 0x45, 0xf5, 0x7c, 0x53, 0xba, 0xba, 0x39, 0xc8, 0xfc, 0xb8, 0x39, 0xd8,
+	0x35, 0x7d, 0x3a, 0xf4, 0x29, 0xb5, 0xce, 0x65, 0xc7, 0x57, 0x86, 0x06, 0x1b, 0xc8, 0x6f, 0x1c,
+	0x8f, 0x69, 0xd1, 0x59, 0xdc, 0x82, 0x1d, 0x73, 0x60, 0x38, 0x9e, 0xbe, 0x04, 0xa7, 0xa2, 0x50,
+	0xcd, 0xd5, 0xb7, 0x1a, 0x65, 0x99, 0x23, 0xcb, 0x87, 0x5c, 0x5e, 0xd0, 0x35, 0x05, 0xde, 0x56,
+	0x2b, 0x9a, 0xa9, 0x2c, 0xe5, 0x7d, 0xc8, 0x05, 0xf1, 0x58, 0xaa, 0xcf, 0xda, 0x9f, 0x7d, 0x54,
+	0x2e, 0xaf, 0xf4, 0x21, 0xa9, 0x2c, 0xad, 0x85, 0x50, 0x48, 0x0f, 0xc4, 0x7b, 0xb0, 0x19, 0x13,
+	0x3a, 0xb1, 0x11, 0x39, 0x6d, 0x23, 0x8a, 0x8f, 0xee, 0xd5, 0x88, 0xda, 0x67, 0x04, 0x85, 0x34,
+	0x22, 0x7e, 0x0e, 0x10, 0xef, 0xc4, 0x2e, 0x47, 0x24, 0x9a, 0x5d, 0x68, 0x88, 0xf1, 0x32, 0x8b,
+	0x43, 0x51, 0x49, 0xef, 0x72, 0x44, 0xb4, 0x3c, 0x99, 0x7f, 0xde, 0x2b, 0xd7, 0x37, 0x04, 0xe5,
+	0xb4, 0x23, 0x5d, 0x66, 0xb0, 0x31, 0xbd, 0xcd, 0x97, 0x67, 0xf0, 0xc0, 0x1c, 0x07, 0x01, 0x47,
+	0x0f, 0x1d, 0x36, 0xb0, 0x02, 0x23, 0xd4, 0x53, 0x40, 0x95, 0x44, 0x3e, 0x4b, 0xd4, 0xb3, 0x39,
+	0x5f, 0x65, 0xa5, 0x6e, 0x09, 0xac, 0x95, 0xfe, 0xaa, 0xe2, 0x44, 0x58, 0x86, 0xd2, 0xf2, 0xca,
+	0x75, 0x32, 0x31, 0x09, 0xb1, 0x88, 0x25, 0x0a, 0xd1, 0x2f, 0xbb, 0x13, 0x2c, 0x7c, 0x4a, 0x84,
+	0xda, 0x2f, 0x04, 0xe5, 0xb4, 0xcf, 0xc9, 0x3e, 0x77, 0x76, 0xfb, 0x7f, 0xde, 0xb6, 0x05, 0xa5,
+	0xf4, 0xe5, 0x9d, 0x1a, 0xee, 0x98, 0xdc, 0x76, 0x77, 0x65, 0x58, 0xbb, 0xe0, 0x67, 0x22, 0xf6,
+	0xbc, 0x16, 0x07, 0x35, 0x0b, 0x4a, 0x69, 0xd3, 0xe2, 0x3e, 0x77, 0xf6, 0xec, 0x9f, 0x53, 0x1e,
+	0xbd, 0x07, 0xbc, 0x5a, 0x86, 0x8b, 0xb0, 0x75, 0xdc, 0x7b, 0xad, 0x6a, 0xba, 0x7a, 0xaa, 0x76,
+	0x7a, 0xdb, 0x19, 0x5c, 0x82, 0xe2, 0x89, 0xda, 0x6e, 0xeb, 0x5d, 0xb5, 0xd3, 0x4b, 0x92, 0x08,
+	0xef, 0x41, 0xe5, 0xa5, 0xda, 0x56, 0x5f, 0xbd, 0xe8, 0x1d, 0x1d, 0x77, 0xf4, 0xee, 0xdb, 0xce,
+	0x61, 0x22, 0x65, 0xf7, 0x85, 0xaf, 0x5f, 0x24, 0xd4, 0x54, 0xaf, 0xa6, 0x12, 0xba, 0x9e, 0x4a,
+	0xe8, 0xe7, 0x54, 0x42, 0x9f, 0x66, 0x52, 0xe6, 0x7a, 0x26, 0x65, 0xbe, 0xcf, 0xa4, 0xcc, 0xbb,
+	0xc7, 0xb6, 0xc3, 0x06, 0xe3, 0xbe, 0x6c, 0xfa, 0x43, 0xe5, 0xc9, 0xe4, 0x84, 0xb8, 0x6e, 0x87,
+	0xb0, 0xd0, 0x0f, 0xce, 0x15, 0x83, 0xd8, 0x0e, 0x55, 0x26, 0x4a, 0xf4, 0xb4, 0xf2, 0x1d, 0x69,
+	0x7f, 0x3d, 0x7a, 0x32, 0x9f, 0xfe, 0x1e, 0x00, 0x3c, 0x32, 0xfd, 0x13, 0x6f, 0x05, 0x00, 0x00,
 }
 
 func (m *RateLimiterFlags) Marshal() (dAtA []byte, err error) {
@@ -126,6 +566,34 @@ func (m *RateLimiterFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EventRateLimits) > 0 {
+		for iNdEx := len(m.EventRateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EventRateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRateLimiterFlags(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ChainRateLimits) > 0 {
+		for iNdEx := len(m.ChainRateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainRateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRateLimiterFlags(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size := m.Rate.Size()
 		i -= size
@@ -154,21 +622,277 @@ func (m *RateLimiterFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintRateLimiterFlags(dAtA []byte, offset int, v uint64) int {
-	offset -= sovRateLimiterFlags(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ChainRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *RateLimiterFlags) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ChainRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Window != 0 {
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Window != 0 {
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EventType != 0 {
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(m.EventType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChainRateLimitStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainRateLimitStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainRateLimitStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RateLimitExceeded {
+		i--
+		if m.RateLimitExceeded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.CurrentWithdrawRate) > 0 {
+		i -= len(m.CurrentWithdrawRate)
+		copy(dAtA[i:], m.CurrentWithdrawRate)
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(len(m.CurrentWithdrawRate)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CurrentWithdrawWindow != 0 {
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(m.CurrentWithdrawWindow))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRateLimitStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRateLimitStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRateLimitStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RateLimitExceeded {
+		i--
+		if m.RateLimitExceeded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.CurrentWithdrawRate) > 0 {
+		i -= len(m.CurrentWithdrawRate)
+		copy(dAtA[i:], m.CurrentWithdrawRate)
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(len(m.CurrentWithdrawRate)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CurrentWithdrawWindow != 0 {
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(m.CurrentWithdrawWindow))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EventType != 0 {
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(m.EventType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChainRateLimitValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainRateLimitValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainRateLimitValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRateLimitValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRateLimitValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRateLimitValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EventType != 0 {
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(m.EventType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRateLimiterFlags(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRateLimiterFlags(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RateLimiterFlags) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	if m.Enabled {
@@ -177,18 +901,715 @@ func (m *RateLimiterFlags) Size() (n int) {
 	if m.Window != 0 {
 		n += 1 + sovRateLimiterFlags(uint64(m.Window))
 	}
-	l = m.Rate.Size()
-	n += 1 + l + sovRateLimiterFlags(uint64(l))
-	return n
-}
+	l = m.Rate.Size()
+	n += 1 + l + sovRateLimiterFlags(uint64(l))
+	if len(m.ChainRateLimits) > 0 {
+		for _, e := range m.ChainRateLimits {
+			l = e.Size()
+			n += 1 + l + sovRateLimiterFlags(uint64(l))
+		}
+	}
+	if len(m.EventRateLimits) > 0 {
+		for _, e := range m.EventRateLimits {
+			l = e.Size()
+			n += 1 + l + sovRateLimiterFlags(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChainRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovRateLimiterFlags(uint64(m.ChainId))
+	}
+	if m.Window != 0 {
+		n += 1 + sovRateLimiterFlags(uint64(m.Window))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovRateLimiterFlags(uint64(l))
+	return n
+}
+
+func (m *EventRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventType != 0 {
+		n += 1 + sovRateLimiterFlags(uint64(m.EventType))
+	}
+	if m.Window != 0 {
+		n += 1 + sovRateLimiterFlags(uint64(m.Window))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovRateLimiterFlags(uint64(l))
+	return n
+}
+
+func (m *ChainRateLimitStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovRateLimiterFlags(uint64(m.ChainId))
+	}
+	if m.CurrentWithdrawWindow != 0 {
+		n += 1 + sovRateLimiterFlags(uint64(m.CurrentWithdrawWindow))
+	}
+	l = len(m.CurrentWithdrawRate)
+	if l > 0 {
+		n += 1 + l + sovRateLimiterFlags(uint64(l))
+	}
+	if m.RateLimitExceeded {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventRateLimitStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventType != 0 {
+		n += 1 + sovRateLimiterFlags(uint64(m.EventType))
+	}
+	if m.CurrentWithdrawWindow != 0 {
+		n += 1 + sovRateLimiterFlags(uint64(m.CurrentWithdrawWindow))
+	}
+	l = len(m.CurrentWithdrawRate)
+	if l > 0 {
+		n += 1 + l + sovRateLimiterFlags(uint64(l))
+	}
+	if m.RateLimitExceeded {
+		n += 2
+	}
+	return n
+}
+
+func (m *ChainRateLimitValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovRateLimiterFlags(uint64(m.ChainId))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovRateLimiterFlags(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRateLimitValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventType != 0 {
+		n += 1 + sovRateLimiterFlags(uint64(m.EventType))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovRateLimiterFlags(uint64(l))
+	}
+	return n
+}
+
+func sovRateLimiterFlags(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRateLimiterFlags(x uint64) (n int) {
+	return sovRateLimiterFlags(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RateLimiterFlags) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRateLimiterFlags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimiterFlags: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimiterFlags: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainRateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainRateLimits = append(m.ChainRateLimits, ChainRateLimit{})
+			if err := m.ChainRateLimits[len(m.ChainRateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventRateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventRateLimits = append(m.EventRateLimits, EventRateLimit{})
+			if err := m.EventRateLimits[len(m.EventRateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRateLimiterFlags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRateLimiterFlags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRateLimiterFlags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRateLimiterFlags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventType", wireType)
+			}
+			m.EventType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventType |= RateLimitEventType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRateLimiterFlags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainRateLimitStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRateLimiterFlags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainRateLimitStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainRateLimitStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentWithdrawWindow", wireType)
+			}
+			m.CurrentWithdrawWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentWithdrawWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentWithdrawRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentWithdrawRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitExceeded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RateLimitExceeded = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRateLimiterFlags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovRateLimiterFlags(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozRateLimiterFlags(x uint64) (n int) {
-	return sovRateLimiterFlags(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *RateLimiterFlags) Unmarshal(dAtA []byte) error {
+func (m *EventRateLimitStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -211,17 +1632,17 @@ func (m *RateLimiterFlags) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RateLimiterFlags: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRateLimitStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RateLimiterFlags: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRateLimitStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventType", wireType)
 			}
-			var v int
+			m.EventType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRateLimiterFlags
@@ -231,17 +1652,16 @@ func (m *RateLimiterFlags) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.EventType |= RateLimitEventType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Enabled = bool(v != 0)
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentWithdrawWindow", wireType)
 			}
-			m.Window = 0
+			m.CurrentWithdrawWindow = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRateLimiterFlags
@@ -251,14 +1671,14 @@ func (m *RateLimiterFlags) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Window |= int64(b&0x7F) << shift
+				m.CurrentWithdrawWindow |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentWithdrawRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -286,9 +1706,229 @@ func (m *RateLimiterFlags) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CurrentWithdrawRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitExceeded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RateLimitExceeded = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRateLimiterFlags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainRateLimitValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRateLimiterFlags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainRateLimitValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainRateLimitValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRateLimiterFlags(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRateLimitValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRateLimiterFlags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRateLimitValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRateLimitValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventType", wireType)
+			}
+			m.EventType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventType |= RateLimitEventType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
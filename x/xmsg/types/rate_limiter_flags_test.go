@@ -6,6 +6,7 @@ import (
 	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 
+	"github.com/0xPellNetwork/aegis/testutil/sample"
 	"github.com/0xPellNetwork/aegis/x/xmsg/types"
 )
 
@@ -35,6 +36,72 @@ func TestRateLimiterFlags_Validate(t *testing.T) {
 			},
 			isErr: true,
 		},
+		{
+			name: "valid chain and event rate limits",
+			flags: types.RateLimiterFlags{
+				Enabled: true,
+				ChainRateLimits: []types.ChainRateLimit{
+					{ChainId: 1, Window: 100, Rate: math.NewUint(1)},
+					{ChainId: 56, Window: 50, Rate: math.NewUint(2)},
+				},
+				EventRateLimits: []types.EventRateLimit{
+					{EventType: types.RateLimitEventType_PELL_SENT_EVENT, Window: 100, Rate: math.NewUint(1e18)},
+					{EventType: types.RateLimitEventType_DELEGATION_SYNC_EVENT, Window: 100, Rate: math.NewUint(1)},
+				},
+			},
+		},
+		{
+			name: "duplicated chain rate limit",
+			flags: types.RateLimiterFlags{
+				ChainRateLimits: []types.ChainRateLimit{
+					{ChainId: 1, Window: 100, Rate: math.NewUint(1)},
+					{ChainId: 1, Window: 50, Rate: math.NewUint(2)},
+				},
+			},
+			isErr: true,
+		},
+		{
+			name: "chain rate limit with zero window",
+			flags: types.RateLimiterFlags{
+				ChainRateLimits: []types.ChainRateLimit{{ChainId: 1, Window: 0, Rate: math.NewUint(1)}},
+			},
+			isErr: true,
+		},
+		{
+			name: "chain rate limit with nil rate",
+			flags: types.RateLimiterFlags{
+				ChainRateLimits: []types.ChainRateLimit{{ChainId: 1, Window: 100}},
+			},
+			isErr: true,
+		},
+		{
+			name: "duplicated event rate limit",
+			flags: types.RateLimiterFlags{
+				EventRateLimits: []types.EventRateLimit{
+					{EventType: types.RateLimitEventType_PELL_SENT_EVENT, Window: 100, Rate: math.NewUint(1)},
+					{EventType: types.RateLimitEventType_PELL_SENT_EVENT, Window: 50, Rate: math.NewUint(2)},
+				},
+			},
+			isErr: true,
+		},
+		{
+			name: "invalid event type",
+			flags: types.RateLimiterFlags{
+				EventRateLimits: []types.EventRateLimit{
+					{EventType: types.RateLimitEventType(42), Window: 100, Rate: math.NewUint(1)},
+				},
+			},
+			isErr: true,
+		},
+		{
+			name: "event rate limit with zero rate",
+			flags: types.RateLimiterFlags{
+				EventRateLimits: []types.EventRateLimit{
+					{EventType: types.RateLimitEventType_OTHER_EVENT, Window: 100, Rate: math.NewUint(0)},
+				},
+			},
+			isErr: true,
+		},
 	}
 
 	for _, tc := range tt {
@@ -49,3 +116,83 @@ func TestRateLimiterFlags_Validate(t *testing.T) {
 	}
 
 }
+
+func TestRateLimiterFlags_GetRateLimits(t *testing.T) {
+	flags := types.RateLimiterFlags{
+		Enabled: true,
+		Window:  42,
+		Rate:    math.NewUint(42),
+		ChainRateLimits: []types.ChainRateLimit{
+			{ChainId: 1, Window: 100, Rate: math.NewUint(1)},
+		},
+		EventRateLimits: []types.EventRateLimit{
+			{EventType: types.RateLimitEventType_PELL_SENT_EVENT, Window: 200, Rate: math.NewUint(1e18)},
+		},
+	}
+
+	t.Run("should get chain rate limit", func(t *testing.T) {
+		limit, found := flags.GetChainRateLimit(1)
+		require.True(t, found)
+		require.Equal(t, flags.ChainRateLimits[0], limit)
+
+		_, found = flags.GetChainRateLimit(2)
+		require.False(t, found)
+	})
+
+	t.Run("should get event rate limit", func(t *testing.T) {
+		limit, found := flags.GetEventRateLimit(types.RateLimitEventType_PELL_SENT_EVENT)
+		require.True(t, found)
+		require.Equal(t, flags.EventRateLimits[0], limit)
+
+		_, found = flags.GetEventRateLimit(types.RateLimitEventType_DELEGATION_SYNC_EVENT)
+		require.False(t, found)
+	})
+
+	t.Run("should get max window", func(t *testing.T) {
+		require.EqualValues(t, 200, flags.MaxWindow())
+		require.EqualValues(t, 42, types.RateLimiterFlags{Window: 42}.MaxWindow())
+	})
+
+	t.Run("should check if global rate limit is set", func(t *testing.T) {
+		require.True(t, flags.IsGlobalRateLimitSet())
+		require.False(t, types.RateLimiterFlags{Window: 42}.IsGlobalRateLimitSet())
+		require.False(t, types.RateLimiterFlags{Window: 42, Rate: math.NewUint(0)}.IsGlobalRateLimitSet())
+		require.False(t, types.RateLimiterFlags{Rate: math.NewUint(42)}.IsGlobalRateLimitSet())
+	})
+}
+
+func TestGetRateLimitEventTypeAndValue(t *testing.T) {
+	r := sample.Rand()
+
+	t.Run("pell sent is rated by pell value", func(t *testing.T) {
+		xmsg := sample.Xmsg_pell(t, "pell-sent")
+		xmsg.InboundTxParams.InboundPellTx = sample.InboundPellTx_PellSend_pell(r)
+		xmsg.InboundTxParams.InboundPellTx.GetPellSent().PellValue = math.NewUint(42)
+
+		require.Equal(t, types.RateLimitEventType_PELL_SENT_EVENT, types.GetRateLimitEventType(xmsg))
+		require.Equal(t, math.NewInt(42), types.GetRateLimitEventValue(xmsg))
+	})
+
+	t.Run("pell sent without value is rated zero", func(t *testing.T) {
+		xmsg := sample.Xmsg_pell(t, "pell-sent-nil")
+		xmsg.InboundTxParams.InboundPellTx = sample.InboundPellTx_PellSend_pell(r)
+
+		require.Equal(t, math.ZeroInt(), types.GetRateLimitEventValue(xmsg))
+	})
+
+	t.Run("delegation syncs are rated by number of xmsgs", func(t *testing.T) {
+		xmsg := sample.Xmsg_pell(t, "delegated")
+		xmsg.InboundTxParams.InboundPellTx = sample.InboundPellTx_StakerDelegated_pell(r)
+
+		require.Equal(t, types.RateLimitEventType_DELEGATION_SYNC_EVENT, types.GetRateLimitEventType(xmsg))
+		require.Equal(t, math.OneInt(), types.GetRateLimitEventValue(xmsg))
+	})
+
+	t.Run("other events are rated by number of xmsgs", func(t *testing.T) {
+		xmsg := sample.Xmsg_pell(t, "other")
+		xmsg.InboundTxParams.InboundPellTx = nil
+
+		require.Equal(t, types.RateLimitEventType_OTHER_EVENT, types.GetRateLimitEventType(xmsg))
+		require.Equal(t, math.OneInt(), types.GetRateLimitEventValue(xmsg))
+	})
+}
@@ -0,0 +1,94 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/math"
+)
+
+// DefaultRechargeWindow is the window in blocks of the ledgers of the chains without a recharge budget
+const DefaultRechargeWindow int64 = 14400
+
+// RechargeKey returns the store key of the recharge ledger and budget of a token on a chain
+func RechargeKey(chainID int64, tokenType RechargeTokenType) []byte {
+	return []byte(fmt.Sprintf("%d-%d", chainID, tokenType))
+}
+
+// ParseRechargeTokenType parses a token type from its name, e.g. PELL_TOKEN or GAS_TOKEN
+func ParseRechargeTokenType(s string) (RechargeTokenType, error) {
+	value, ok := RechargeTokenType_value[strings.ToUpper(s)]
+	if !ok {
+		return 0, fmt.Errorf("invalid recharge token type %s", s)
+	}
+	return RechargeTokenType(value), nil
+}
+
+// Validate checks that the RechargeBudget is valid
+func (b RechargeBudget) Validate() error {
+	if b.ChainId <= 0 {
+		return fmt.Errorf("invalid chain id %d", b.ChainId)
+	}
+	if _, ok := RechargeTokenType_name[int32(b.TokenType)]; !ok {
+		return fmt.Errorf("invalid recharge token type %d", b.TokenType)
+	}
+	if b.Window <= 0 {
+		return fmt.Errorf("window must be positive: %d", b.Window)
+	}
+	if b.MaxAmount.IsNil() {
+		return fmt.Errorf("max amount must be set")
+	}
+	return nil
+}
+
+// Allows returns true if recharging amount on top of windowAmount stays within the budget
+func (b RechargeBudget) Allows(windowAmount, amount math.Uint) bool {
+	return windowAmount.Add(amount).LTE(b.MaxAmount)
+}
+
+// NewRechargeLedger returns an empty ledger for a token on a chain
+func NewRechargeLedger(chainID int64, tokenType RechargeTokenType) RechargeLedger {
+	return RechargeLedger{
+		ChainId:          chainID,
+		TokenType:        tokenType,
+		CumulativeAmount: math.ZeroUint(),
+		WindowAmount:     math.ZeroUint(),
+	}
+}
+
+// Validate checks that the RechargeLedger is valid
+func (l RechargeLedger) Validate() error {
+	if _, ok := RechargeTokenType_name[int32(l.TokenType)]; !ok {
+		return fmt.Errorf("invalid recharge token type %d", l.TokenType)
+	}
+	if l.CumulativeAmount.IsNil() || l.WindowAmount.IsNil() {
+		return fmt.Errorf("amounts must be set")
+	}
+	if l.WindowAmount.GT(l.CumulativeAmount) {
+		return fmt.Errorf("window amount %s exceeds cumulative amount %s", l.WindowAmount, l.CumulativeAmount)
+	}
+	if l.WindowCount > l.RechargeCount {
+		return fmt.Errorf("window count %d exceeds recharge count %d", l.WindowCount, l.RechargeCount)
+	}
+	return nil
+}
+
+// RollWindow starts a new window at height if the current window has elapsed
+func (l *RechargeLedger) RollWindow(height, window int64) {
+	if l.WindowStartHeight > 0 && height < l.WindowStartHeight+window {
+		return
+	}
+	l.WindowStartHeight = height
+	l.WindowAmount = math.ZeroUint()
+	l.WindowCount = 0
+}
+
+// Record adds a recharge to the cumulative and windowed totals
+func (l *RechargeLedger) Record(height int64, voteIndex uint64, amount math.Uint) {
+	l.CumulativeAmount = l.CumulativeAmount.Add(amount)
+	l.RechargeCount++
+	l.WindowAmount = l.WindowAmount.Add(amount)
+	l.WindowCount++
+	l.LastRechargeHeight = height
+	l.LastVoteIndex = voteIndex
+}
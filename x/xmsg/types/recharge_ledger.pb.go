@@ -0,0 +1,971 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: xmsg/recharge_ledger.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// token recharged to the tss address of a connected chain
+type RechargeTokenType int32
+
+const (
+	// pell token bridged through the pell gateway
+	RechargeTokenType_PELL_TOKEN RechargeTokenType = 0
+	// gas token swapped through the gas swap contract
+	RechargeTokenType_GAS_TOKEN RechargeTokenType = 1
+)
+
+var RechargeTokenType_name = map[int32]string{
+	0: "PELL_TOKEN",
+	1: "GAS_TOKEN",
+}
+
+var RechargeTokenType_value = map[string]int32{
+	"PELL_TOKEN": 0,
+	"GAS_TOKEN":  1,
+}
+
+func (x RechargeTokenType) String() string {
+	return proto.EnumName(RechargeTokenType_name, int32(x))
+}
+
+func (RechargeTokenType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b78decfab881709c, []int{0}
+}
+
+// cap on the amount of a token recharged to a chain within a window
+type RechargeBudget struct {
+	ChainId   int64             `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	TokenType RechargeTokenType `protobuf:"varint,2,opt,name=token_type,json=tokenType,proto3,enum=xmsg.RechargeTokenType" json:"token_type,omitempty"`
+	// window in blocks
+	Window int64 `protobuf:"varint,3,opt,name=window,proto3" json:"window,omitempty"`
+	// maximum amount recharged within a window, zero pauses the recharges
+	MaxAmount cosmossdk_io_math.Uint `protobuf:"bytes,4,opt,name=max_amount,json=maxAmount,proto3,customtype=cosmossdk.io/math.Uint" json:"max_amount"`
+}
+
+func (m *RechargeBudget) Reset()         { *m = RechargeBudget{} }
+func (m *RechargeBudget) String() string { return proto.CompactTextString(m) }
+func (*RechargeBudget) ProtoMessage()    {}
+func (*RechargeBudget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b78decfab881709c, []int{0}
+}
+func (m *RechargeBudget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RechargeBudget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RechargeBudget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RechargeBudget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RechargeBudget.Merge(m, src)
+}
+func (m *RechargeBudget) XXX_Size() int {
+	return m.Size()
+}
+func (m *RechargeBudget) XXX_DiscardUnknown() {
+	xxx_messageInfo_RechargeBudget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RechargeBudget proto.InternalMessageInfo
+
+func (m *RechargeBudget) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *RechargeBudget) GetTokenType() RechargeTokenType {
+	if m != nil {
+		return m.TokenType
+	}
+	return RechargeTokenType_PELL_TOKEN
+}
+
+func (m *RechargeBudget) GetWindow() int64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+// accounting of the recharges of a token to a chain
+type RechargeLedger struct {
+	ChainId   int64             `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	TokenType RechargeTokenType `protobuf:"varint,2,opt,name=token_type,json=tokenType,proto3,enum=xmsg.RechargeTokenType" json:"token_type,omitempty"`
+	// amount recharged since the ledger was created
+	CumulativeAmount cosmossdk_io_math.Uint `protobuf:"bytes,3,opt,name=cumulative_amount,json=cumulativeAmount,proto3,customtype=cosmossdk.io/math.Uint" json:"cumulative_amount"`
+	// number of recharges since the ledger was created
+	RechargeCount uint64 `protobuf:"varint,4,opt,name=recharge_count,json=rechargeCount,proto3" json:"recharge_count,omitempty"`
+	// first block of the current window
+	WindowStartHeight int64 `protobuf:"varint,5,opt,name=window_start_height,json=windowStartHeight,proto3" json:"window_start_height,omitempty"`
+	// amount recharged within the current window
+	WindowAmount cosmossdk_io_math.Uint `protobuf:"bytes,6,opt,name=window_amount,json=windowAmount,proto3,customtype=cosmossdk.io/math.Uint" json:"window_amount"`
+	// number of recharges within the current window
+	WindowCount uint64 `protobuf:"varint,7,opt,name=window_count,json=windowCount,proto3" json:"window_count,omitempty"`
+	// number of recharges rejected because the budget was exceeded
+	RejectedCount      uint64 `protobuf:"varint,8,opt,name=rejected_count,json=rejectedCount,proto3" json:"rejected_count,omitempty"`
+	LastRechargeHeight int64  `protobuf:"varint,9,opt,name=last_recharge_height,json=lastRechargeHeight,proto3" json:"last_recharge_height,omitempty"`
+	LastVoteIndex      uint64 `protobuf:"varint,10,opt,name=last_vote_index,json=lastVoteIndex,proto3" json:"last_vote_index,omitempty"`
+}
+
+func (m *RechargeLedger) Reset()         { *m = RechargeLedger{} }
+func (m *RechargeLedger) String() string { return proto.CompactTextString(m) }
+func (*RechargeLedger) ProtoMessage()    {}
+func (*RechargeLedger) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b78decfab881709c, []int{1}
+}
+func (m *RechargeLedger) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RechargeLedger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RechargeLedger.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RechargeLedger) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RechargeLedger.Merge(m, src)
+}
+func (m *RechargeLedger) XXX_Size() int {
+	return m.Size()
+}
+func (m *RechargeLedger) XXX_DiscardUnknown() {
+	xxx_messageInfo_RechargeLedger.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RechargeLedger proto.InternalMessageInfo
+
+func (m *RechargeLedger) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *RechargeLedger) GetTokenType() RechargeTokenType {
+	if m != nil {
+		return m.TokenType
+	}
+	return RechargeTokenType_PELL_TOKEN
+}
+
+func (m *RechargeLedger) GetRechargeCount() uint64 {
+	if m != nil {
+		return m.RechargeCount
+	}
+	return 0
+}
+
+func (m *RechargeLedger) GetWindowStartHeight() int64 {
+	if m != nil {
+		return m.WindowStartHeight
+	}
+	return 0
+}
+
+func (m *RechargeLedger) GetWindowCount() uint64 {
+	if m != nil {
+		return m.WindowCount
+	}
+	return 0
+}
+
+func (m *RechargeLedger) GetRejectedCount() uint64 {
+	if m != nil {
+		return m.RejectedCount
+	}
+	return 0
+}
+
+func (m *RechargeLedger) GetLastRechargeHeight() int64 {
+	if m != nil {
+		return m.LastRechargeHeight
+	}
+	return 0
+}
+
+func (m *RechargeLedger) GetLastVoteIndex() uint64 {
+	if m != nil {
+		return m.LastVoteIndex
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("xmsg.RechargeTokenType", RechargeTokenType_name, RechargeTokenType_value)
+	proto.RegisterType((*RechargeBudget)(nil), "xmsg.RechargeBudget")
+	proto.RegisterType((*RechargeLedger)(nil), "xmsg.RechargeLedger")
+}
+
+func init() { proto.RegisterFile("xmsg/recharge_ledger.proto", fileDescriptor_b78decfab881709c) }
+
+var fileDescriptor_b78decfab881709c = []byte{
+	// 497 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0x41, 0x6e, 0xd3, 0x40,
+	0x14, 0x86, 0x3d, 0x24, 0xb4, 0xf5, 0x83, 0x84, 0x66, 0xa8, 0x8a, 0xc9, 0xc2, 0x0d, 0x95, 0x40,
+	0x11, 0x48, 0x76, 0x05, 0x12, 0x62, 0xc3, 0xa2, 0x89, 0x22, 0xa8, 0x1a, 0x95, 0xca, 0x0d, 0x2c,
+	0xd8, 0x58, 0x53, 0x7b, 0x64, 0x0f, 0xb1, 0x3d, 0x91, 0x3d, 0x6e, 0xdc, 0x5b, 0x70, 0x08, 0x16,
+	0x9c, 0x81, 0x13, 0x74, 0xd9, 0x25, 0x62, 0x51, 0xa1, 0xe4, 0x02, 0x1c, 0x01, 0x79, 0x3c, 0x4e,
+	0x16, 0x6c, 0xba, 0x61, 0xe7, 0xf7, 0xff, 0xef, 0x3d, 0x7d, 0xff, 0x8c, 0x07, 0xba, 0x45, 0x9c,
+	0x05, 0x76, 0x4a, 0xbd, 0x90, 0xa4, 0x01, 0x75, 0x23, 0xea, 0x07, 0x34, 0xb5, 0x66, 0x29, 0x17,
+	0x1c, 0x37, 0x4b, 0xaf, 0xbb, 0x13, 0xf0, 0x80, 0x4b, 0xc1, 0x2e, 0xbf, 0x2a, 0x6f, 0xff, 0x07,
+	0x82, 0xb6, 0xa3, 0xa6, 0x06, 0xb9, 0x1f, 0x50, 0x81, 0x1f, 0xc3, 0x96, 0x17, 0x12, 0x96, 0xb8,
+	0xcc, 0x37, 0x50, 0x0f, 0xf5, 0x1b, 0xce, 0xa6, 0xac, 0x8f, 0x7c, 0xfc, 0x1a, 0x40, 0xf0, 0x29,
+	0x4d, 0x5c, 0x71, 0x39, 0xa3, 0xc6, 0x9d, 0x1e, 0xea, 0xb7, 0x5f, 0x3e, 0xb2, 0xca, 0xf5, 0x56,
+	0xbd, 0x64, 0x52, 0xfa, 0x93, 0xcb, 0x19, 0x75, 0x74, 0x51, 0x7f, 0xe2, 0x5d, 0xd8, 0x98, 0xb3,
+	0xc4, 0xe7, 0x73, 0xa3, 0x21, 0x17, 0xaa, 0x0a, 0xbf, 0x05, 0x88, 0x49, 0xe1, 0x92, 0x98, 0xe7,
+	0x89, 0x30, 0x9a, 0x3d, 0xd4, 0xd7, 0x07, 0xe6, 0xd5, 0xcd, 0x9e, 0xf6, 0xeb, 0x66, 0x6f, 0xd7,
+	0xe3, 0x59, 0xcc, 0xb3, 0xcc, 0x9f, 0x5a, 0x8c, 0xdb, 0x31, 0x11, 0xa1, 0xf5, 0x91, 0x25, 0xc2,
+	0xd1, 0x63, 0x52, 0x1c, 0xca, 0x81, 0xfd, 0x3f, 0x8d, 0x35, 0xfc, 0x58, 0x26, 0xfe, 0x1f, 0xf0,
+	0xc7, 0xd0, 0xf1, 0xf2, 0x38, 0x8f, 0x88, 0x60, 0x17, 0xb4, 0x66, 0x6d, 0xdc, 0x8a, 0x75, 0x7b,
+	0x3d, 0x58, 0x21, 0xe3, 0xa7, 0xd0, 0x5e, 0x5d, 0x92, 0xb7, 0x4a, 0xdd, 0x74, 0x5a, 0xb5, 0x3a,
+	0x94, 0x6d, 0x16, 0x3c, 0xac, 0x8e, 0xc8, 0xcd, 0x04, 0x49, 0x85, 0x1b, 0x52, 0x16, 0x84, 0xc2,
+	0xb8, 0x2b, 0x13, 0x75, 0x2a, 0xeb, 0xac, 0x74, 0xde, 0x4b, 0x03, 0x0f, 0xa1, 0xa5, 0xfa, 0x15,
+	0xdf, 0xc6, 0xad, 0xf8, 0xee, 0x57, 0x43, 0x8a, 0xed, 0x09, 0xa8, 0x5a, 0x91, 0x6d, 0x4a, 0xb2,
+	0x7b, 0x95, 0x36, 0x5c, 0xe3, 0x7f, 0xa1, 0x9e, 0xa0, 0xbe, 0x6a, 0xda, 0xaa, 0xf1, 0x2b, 0xb5,
+	0x6a, 0x3b, 0x80, 0x9d, 0x88, 0x64, 0xc2, 0x5d, 0x45, 0x55, 0xfc, 0xba, 0xe4, 0xc7, 0xa5, 0x57,
+	0x1f, 0xb9, 0x0a, 0xf0, 0x0c, 0x1e, 0xc8, 0x89, 0x0b, 0x2e, 0xa8, 0xcb, 0x12, 0x9f, 0x16, 0x06,
+	0x54, 0x9b, 0x4b, 0xf9, 0x13, 0x17, 0xf4, 0xa8, 0x14, 0x9f, 0xbf, 0x81, 0xce, 0x3f, 0x97, 0x85,
+	0xdb, 0x00, 0xa7, 0xa3, 0xf1, 0xd8, 0x9d, 0x7c, 0x38, 0x1e, 0x9d, 0x6c, 0x6b, 0xb8, 0x05, 0xfa,
+	0xbb, 0xc3, 0x33, 0x55, 0xa2, 0x6e, 0xf3, 0xfb, 0x37, 0x13, 0x0d, 0x46, 0x57, 0x0b, 0x13, 0x5d,
+	0x2f, 0x4c, 0xf4, 0x7b, 0x61, 0xa2, 0xaf, 0x4b, 0x53, 0xbb, 0x5e, 0x9a, 0xda, 0xcf, 0xa5, 0xa9,
+	0x7d, 0x7e, 0x11, 0x30, 0x11, 0xe6, 0xe7, 0x96, 0xc7, 0x63, 0xfb, 0xa0, 0x38, 0xa5, 0x51, 0x74,
+	0x42, 0xc5, 0x9c, 0xa7, 0x53, 0x9b, 0xd0, 0x80, 0x65, 0x76, 0x61, 0xcb, 0xc7, 0x55, 0xfe, 0x36,
+	0xd9, 0xf9, 0x86, 0x7c, 0x37, 0xaf, 0xfe, 0x0e, 0x00, 0x16, 0x7c, 0x02, 0xee, 0x71, 0x03, 0x00,
+	0x00,
+}
+
+func (m *RechargeBudget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RechargeBudget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RechargeBudget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxAmount.Size()
+		i -= size
+		if _, err := m.MaxAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRechargeLedger(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Window != 0 {
+		i = encodeVarintRechargeLedger(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TokenType != 0 {
+		i = encodeVarintRechargeLedger(dAtA, i, uint64(m.TokenType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintRechargeLedger(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RechargeLedger) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RechargeLedger) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RechargeLedger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastVoteIndex != 0 {
+		i = encodeVarintRechargeLedger(dAtA, i, uint64(m.LastVoteIndex))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.LastRechargeHeight != 0 {
+		i = encodeVarintRechargeLedger(dAtA, i, uint64(m.LastRechargeHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.RejectedCount != 0 {
+		i = encodeVarintRechargeLedger(dAtA, i, uint64(m.RejectedCount))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.WindowCount != 0 {
+		i = encodeVarintRechargeLedger(dAtA, i, uint64(m.WindowCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.WindowAmount.Size()
+		i -= size
+		if _, err := m.WindowAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRechargeLedger(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.WindowStartHeight != 0 {
+		i = encodeVarintRechargeLedger(dAtA, i, uint64(m.WindowStartHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.RechargeCount != 0 {
+		i = encodeVarintRechargeLedger(dAtA, i, uint64(m.RechargeCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.CumulativeAmount.Size()
+		i -= size
+		if _, err := m.CumulativeAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRechargeLedger(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.TokenType != 0 {
+		i = encodeVarintRechargeLedger(dAtA, i, uint64(m.TokenType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintRechargeLedger(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRechargeLedger(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRechargeLedger(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RechargeBudget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovRechargeLedger(uint64(m.ChainId))
+	}
+	if m.TokenType != 0 {
+		n += 1 + sovRechargeLedger(uint64(m.TokenType))
+	}
+	if m.Window != 0 {
+		n += 1 + sovRechargeLedger(uint64(m.Window))
+	}
+	l = m.MaxAmount.Size()
+	n += 1 + l + sovRechargeLedger(uint64(l))
+	return n
+}
+
+func (m *RechargeLedger) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovRechargeLedger(uint64(m.ChainId))
+	}
+	if m.TokenType != 0 {
+		n += 1 + sovRechargeLedger(uint64(m.TokenType))
+	}
+	l = m.CumulativeAmount.Size()
+	n += 1 + l + sovRechargeLedger(uint64(l))
+	if m.RechargeCount != 0 {
+		n += 1 + sovRechargeLedger(uint64(m.RechargeCount))
+	}
+	if m.WindowStartHeight != 0 {
+		n += 1 + sovRechargeLedger(uint64(m.WindowStartHeight))
+	}
+	l = m.WindowAmount.Size()
+	n += 1 + l + sovRechargeLedger(uint64(l))
+	if m.WindowCount != 0 {
+		n += 1 + sovRechargeLedger(uint64(m.WindowCount))
+	}
+	if m.RejectedCount != 0 {
+		n += 1 + sovRechargeLedger(uint64(m.RejectedCount))
+	}
+	if m.LastRechargeHeight != 0 {
+		n += 1 + sovRechargeLedger(uint64(m.LastRechargeHeight))
+	}
+	if m.LastVoteIndex != 0 {
+		n += 1 + sovRechargeLedger(uint64(m.LastVoteIndex))
+	}
+	return n
+}
+
+func sovRechargeLedger(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRechargeLedger(x uint64) (n int) {
+	return sovRechargeLedger(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RechargeBudget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRechargeLedger
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RechargeBudget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RechargeBudget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRechargeLedger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
+			}
+			m.TokenType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRechargeLedger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TokenType |= RechargeTokenType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRechargeLedger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRechargeLedger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRechargeLedger
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRechargeLedger
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRechargeLedger(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRechargeLedger
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RechargeLedger) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRechargeLedger
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RechargeLedger: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RechargeLedger: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRechargeLedger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
+			}
+			m.TokenType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRechargeLedger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TokenType |= RechargeTokenType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRechargeLedger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRechargeLedger
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRechargeLedger
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CumulativeAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RechargeCount", wireType)
+			}
+			m.RechargeCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRechargeLedger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RechargeCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStartHeight", wireType)
+			}
+			m.WindowStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRechargeLedger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowStartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRechargeLedger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRechargeLedger
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRechargeLedger
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WindowAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowCount", wireType)
+			}
+			m.WindowCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRechargeLedger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectedCount", wireType)
+			}
+			m.RejectedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRechargeLedger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RejectedCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRechargeHeight", wireType)
+			}
+			m.LastRechargeHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRechargeLedger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastRechargeHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastVoteIndex", wireType)
+			}
+			m.LastVoteIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRechargeLedger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastVoteIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRechargeLedger(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRechargeLedger
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRechargeLedger(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRechargeLedger
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRechargeLedger
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRechargeLedger
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRechargeLedger
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRechargeLedger
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRechargeLedger
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRechargeLedger        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRechargeLedger          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRechargeLedger = fmt.Errorf("proto: unexpected end of group")
+)
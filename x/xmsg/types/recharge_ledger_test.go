@@ -0,0 +1,118 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/x/xmsg/types"
+)
+
+func TestParseRechargeTokenType(t *testing.T) {
+	tokenType, err := types.ParseRechargeTokenType("pell_token")
+	require.NoError(t, err)
+	require.Equal(t, types.RechargeTokenType_PELL_TOKEN, tokenType)
+
+	tokenType, err = types.ParseRechargeTokenType("GAS_TOKEN")
+	require.NoError(t, err)
+	require.Equal(t, types.RechargeTokenType_GAS_TOKEN, tokenType)
+
+	_, err = types.ParseRechargeTokenType("btc")
+	require.Error(t, err)
+}
+
+func TestRechargeBudget_Validate(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		budget types.RechargeBudget
+		valid  bool
+	}{
+		{
+			name:   "valid budget",
+			budget: types.RechargeBudget{ChainId: 1, TokenType: types.RechargeTokenType_GAS_TOKEN, Window: 100, MaxAmount: math.NewUint(1000)},
+			valid:  true,
+		},
+		{
+			name:   "zero max amount pauses the recharges",
+			budget: types.RechargeBudget{ChainId: 1, Window: 100, MaxAmount: math.ZeroUint()},
+			valid:  true,
+		},
+		{
+			name:   "invalid chain id",
+			budget: types.RechargeBudget{ChainId: 0, Window: 100, MaxAmount: math.NewUint(1000)},
+		},
+		{
+			name:   "invalid token type",
+			budget: types.RechargeBudget{ChainId: 1, TokenType: 5, Window: 100, MaxAmount: math.NewUint(1000)},
+		},
+		{
+			name:   "invalid window",
+			budget: types.RechargeBudget{ChainId: 1, Window: 0, MaxAmount: math.NewUint(1000)},
+		},
+		{
+			name:   "max amount not set",
+			budget: types.RechargeBudget{ChainId: 1, Window: 100},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.budget.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestRechargeBudget_Allows(t *testing.T) {
+	budget := types.RechargeBudget{ChainId: 1, Window: 100, MaxAmount: math.NewUint(1000)}
+	require.True(t, budget.Allows(math.NewUint(400), math.NewUint(600)))
+	require.False(t, budget.Allows(math.NewUint(401), math.NewUint(600)))
+
+	budget.MaxAmount = math.ZeroUint()
+	require.False(t, budget.Allows(math.ZeroUint(), math.NewUint(1)))
+}
+
+func TestRechargeLedger_RollWindowAndRecord(t *testing.T) {
+	ledger := types.NewRechargeLedger(1, types.RechargeTokenType_PELL_TOKEN)
+	require.NoError(t, ledger.Validate())
+
+	// the first recharge starts the window
+	ledger.RollWindow(10, 100)
+	ledger.Record(10, 1, math.NewUint(300))
+	require.EqualValues(t, 10, ledger.WindowStartHeight)
+
+	// recharges within the window accumulate
+	ledger.RollWindow(109, 100)
+	ledger.Record(109, 2, math.NewUint(200))
+	require.EqualValues(t, 10, ledger.WindowStartHeight)
+	require.Equal(t, math.NewUint(500), ledger.WindowAmount)
+	require.EqualValues(t, 2, ledger.WindowCount)
+
+	// a recharge after the window elapsed starts a new window
+	ledger.RollWindow(110, 100)
+	ledger.Record(110, 3, math.NewUint(100))
+	require.EqualValues(t, 110, ledger.WindowStartHeight)
+	require.Equal(t, math.NewUint(100), ledger.WindowAmount)
+	require.EqualValues(t, 1, ledger.WindowCount)
+
+	require.Equal(t, math.NewUint(600), ledger.CumulativeAmount)
+	require.EqualValues(t, 3, ledger.RechargeCount)
+	require.EqualValues(t, 110, ledger.LastRechargeHeight)
+	require.EqualValues(t, 3, ledger.LastVoteIndex)
+	require.NoError(t, ledger.Validate())
+}
+
+func TestRechargeLedger_Validate(t *testing.T) {
+	ledger := types.NewRechargeLedger(1, types.RechargeTokenType_GAS_TOKEN)
+	ledger.WindowAmount = math.NewUint(1)
+	require.Error(t, ledger.Validate())
+
+	ledger = types.NewRechargeLedger(1, types.RechargeTokenType_GAS_TOKEN)
+	ledger.WindowCount = 1
+	require.Error(t, ledger.Validate())
+
+	require.Error(t, types.RechargeLedger{ChainId: 1}.Validate())
+}
@@ -22,6 +22,13 @@ func (m *Status) ChangeStatus(newStatus XmsgStatus, msg string) {
 
 } //nolint:typecheck
 
+// AbortRefunded marks the value of an aborted xmsg as refunded
+func (m *Status) AbortRefunded(timeStamp int64) {
+	m.IsAbortRefunded = true
+	m.StatusMessage = "Xmsg aborted and refunded"
+	m.LastUpdateTimestamp = timeStamp
+}
+
 func (m *Status) ValidateTransition(newStatus XmsgStatus) bool {
 	stateTransitionMap := stateTransitionMap()
 	oldStatus := m.Status
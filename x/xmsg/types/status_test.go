@@ -81,3 +81,15 @@ func TestStatus_ChangeStatus(t *testing.T) {
 		assert.Equal(t, fmt.Sprintf("Failed to transition : OldStatus %s , NewStatus %s , MSG : %s :", types.XmsgStatus_PENDING_OUTBOUND.String(), types.XmsgStatus_PENDING_INBOUND.String(), "msg"), s.StatusMessage)
 	})
 }
+
+func TestStatus_AbortRefunded(t *testing.T) {
+	t.Run("should set abort refunded and update timestamp", func(t *testing.T) {
+		s := types.Status{Status: types.XmsgStatus_ABORTED}
+
+		s.AbortRefunded(100)
+		assert.True(t, s.IsAbortRefunded)
+		assert.Equal(t, types.XmsgStatus_ABORTED, s.Status)
+		assert.Equal(t, int64(100), s.LastUpdateTimestamp)
+		assert.Equal(t, "Xmsg aborted and refunded", s.StatusMessage)
+	})
+}
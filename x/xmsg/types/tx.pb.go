@@ -696,6 +696,8 @@ type MsgVoteGasPrice struct {
 	BlockNumber uint64 `protobuf:"varint,3,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
 	Supply      string `protobuf:"bytes,4,opt,name=supply,proto3" json:"supply,omitempty"`
 	Signer      string `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`
+	PriorityFee uint64 `protobuf:"varint,6,opt,name=priority_fee,json=priorityFee,proto3" json:"priority_fee,omitempty"`
+	BaseFee     uint64 `protobuf:"varint,7,opt,name=base_fee,json=baseFee,proto3" json:"base_fee,omitempty"`
 }
 
 func (m *MsgVoteGasPrice) Reset()         { *m = MsgVoteGasPrice{} }
@@ -766,6 +768,20 @@ func (m *MsgVoteGasPrice) GetSigner() string {
 	return ""
 }
 
+func (m *MsgVoteGasPrice) GetPriorityFee() uint64 {
+	if m != nil {
+		return m.PriorityFee
+	}
+	return 0
+}
+
+func (m *MsgVoteGasPrice) GetBaseFee() uint64 {
+	if m != nil {
+		return m.BaseFee
+	}
+	return 0
+}
+
 // MsgVoteGasPriceResponse response for voting on gas price
 type MsgVoteGasPriceResponse struct {
 }
@@ -1121,6 +1137,129 @@ func (m *MsgVoteOnObservedInboundTxResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgVoteOnObservedInboundTxResponse proto.InternalMessageInfo
 
+// MsgProveInboundTx finalize an observed inbound tx with the inclusion proofs
+// of its tx and receipt instead of the ballot
+type MsgProveInboundTx struct {
+	Signer       string                     `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Inbound      MsgVoteOnObservedInboundTx `protobuf:"bytes,2,opt,name=inbound,proto3" json:"inbound"`
+	BlockHash    string                     `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	TxIndex      int64                      `protobuf:"varint,4,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	TxProof      *proofs.Proof              `protobuf:"bytes,5,opt,name=tx_proof,json=txProof,proto3" json:"tx_proof,omitempty"`
+	ReceiptProof *proofs.Proof              `protobuf:"bytes,6,opt,name=receipt_proof,json=receiptProof,proto3" json:"receipt_proof,omitempty"`
+}
+
+func (m *MsgProveInboundTx) Reset()         { *m = MsgProveInboundTx{} }
+func (m *MsgProveInboundTx) String() string { return proto.CompactTextString(m) }
+func (*MsgProveInboundTx) ProtoMessage()    {}
+func (*MsgProveInboundTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9ea69829c73b3ce, []int{18}
+}
+func (m *MsgProveInboundTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProveInboundTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProveInboundTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgProveInboundTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProveInboundTx.Merge(m, src)
+}
+func (m *MsgProveInboundTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProveInboundTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProveInboundTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgProveInboundTx proto.InternalMessageInfo
+
+func (m *MsgProveInboundTx) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgProveInboundTx) GetInbound() MsgVoteOnObservedInboundTx {
+	if m != nil {
+		return m.Inbound
+	}
+	return MsgVoteOnObservedInboundTx{}
+}
+
+func (m *MsgProveInboundTx) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *MsgProveInboundTx) GetTxIndex() int64 {
+	if m != nil {
+		return m.TxIndex
+	}
+	return 0
+}
+
+func (m *MsgProveInboundTx) GetTxProof() *proofs.Proof {
+	if m != nil {
+		return m.TxProof
+	}
+	return nil
+}
+
+func (m *MsgProveInboundTx) GetReceiptProof() *proofs.Proof {
+	if m != nil {
+		return m.ReceiptProof
+	}
+	return nil
+}
+
+// MsgProveInboundTxResponse response for proving an observed inbound tx
+type MsgProveInboundTxResponse struct {
+}
+
+func (m *MsgProveInboundTxResponse) Reset()         { *m = MsgProveInboundTxResponse{} }
+func (m *MsgProveInboundTxResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgProveInboundTxResponse) ProtoMessage()    {}
+func (*MsgProveInboundTxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9ea69829c73b3ce, []int{19}
+}
+func (m *MsgProveInboundTxResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProveInboundTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProveInboundTxResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgProveInboundTxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProveInboundTxResponse.Merge(m, src)
+}
+func (m *MsgProveInboundTxResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProveInboundTxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProveInboundTxResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgProveInboundTxResponse proto.InternalMessageInfo
+
 // MsgAbortStuckXmsg abort a stuck xmsg
 type MsgAbortStuckXmsg struct {
 	Signer    string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
@@ -1131,7 +1270,7 @@ func (m *MsgAbortStuckXmsg) Reset()         { *m = MsgAbortStuckXmsg{} }
 func (m *MsgAbortStuckXmsg) String() string { return proto.CompactTextString(m) }
 func (*MsgAbortStuckXmsg) ProtoMessage()    {}
 func (*MsgAbortStuckXmsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9ea69829c73b3ce, []int{18}
+	return fileDescriptor_f9ea69829c73b3ce, []int{20}
 }
 func (m *MsgAbortStuckXmsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1182,7 +1321,7 @@ func (m *MsgAbortStuckXmsgResponse) Reset()         { *m = MsgAbortStuckXmsgResp
 func (m *MsgAbortStuckXmsgResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAbortStuckXmsgResponse) ProtoMessage()    {}
 func (*MsgAbortStuckXmsgResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9ea69829c73b3ce, []int{19}
+	return fileDescriptor_f9ea69829c73b3ce, []int{21}
 }
 func (m *MsgAbortStuckXmsgResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1222,7 +1361,7 @@ func (m *MsgRefundAbortedXmsg) Reset()         { *m = MsgRefundAbortedXmsg{} }
 func (m *MsgRefundAbortedXmsg) String() string { return proto.CompactTextString(m) }
 func (*MsgRefundAbortedXmsg) ProtoMessage()    {}
 func (*MsgRefundAbortedXmsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9ea69829c73b3ce, []int{20}
+	return fileDescriptor_f9ea69829c73b3ce, []int{22}
 }
 func (m *MsgRefundAbortedXmsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1280,7 +1419,7 @@ func (m *MsgRefundAbortedXmsgResponse) Reset()         { *m = MsgRefundAbortedXm
 func (m *MsgRefundAbortedXmsgResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRefundAbortedXmsgResponse) ProtoMessage()    {}
 func (*MsgRefundAbortedXmsgResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9ea69829c73b3ce, []int{21}
+	return fileDescriptor_f9ea69829c73b3ce, []int{23}
 }
 func (m *MsgRefundAbortedXmsgResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1319,7 +1458,7 @@ func (m *MsgUpdateRateLimiterFlags) Reset()         { *m = MsgUpdateRateLimiterF
 func (m *MsgUpdateRateLimiterFlags) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateRateLimiterFlags) ProtoMessage()    {}
 func (*MsgUpdateRateLimiterFlags) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9ea69829c73b3ce, []int{22}
+	return fileDescriptor_f9ea69829c73b3ce, []int{24}
 }
 func (m *MsgUpdateRateLimiterFlags) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1371,7 +1510,7 @@ func (m *MsgUpdateRateLimiterFlagsResponse) Reset()         { *m = MsgUpdateRate
 func (m *MsgUpdateRateLimiterFlagsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateRateLimiterFlagsResponse) ProtoMessage()    {}
 func (*MsgUpdateRateLimiterFlagsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9ea69829c73b3ce, []int{23}
+	return fileDescriptor_f9ea69829c73b3ce, []int{25}
 }
 func (m *MsgUpdateRateLimiterFlagsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1410,7 +1549,7 @@ func (m *MsgVoteInboundBlock) Reset()         { *m = MsgVoteInboundBlock{} }
 func (m *MsgVoteInboundBlock) String() string { return proto.CompactTextString(m) }
 func (*MsgVoteInboundBlock) ProtoMessage()    {}
 func (*MsgVoteInboundBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9ea69829c73b3ce, []int{24}
+	return fileDescriptor_f9ea69829c73b3ce, []int{26}
 }
 func (m *MsgVoteInboundBlock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1461,7 +1600,7 @@ func (m *MsgVoteOnObservedInboundBlockResponse) Reset()         { *m = MsgVoteOn
 func (m *MsgVoteOnObservedInboundBlockResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgVoteOnObservedInboundBlockResponse) ProtoMessage()    {}
 func (*MsgVoteOnObservedInboundBlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9ea69829c73b3ce, []int{25}
+	return fileDescriptor_f9ea69829c73b3ce, []int{27}
 }
 func (m *MsgVoteOnObservedInboundBlockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1500,7 +1639,7 @@ func (m *MsgAddAllowedXmsgSender) Reset()         { *m = MsgAddAllowedXmsgSender
 func (m *MsgAddAllowedXmsgSender) String() string { return proto.CompactTextString(m) }
 func (*MsgAddAllowedXmsgSender) ProtoMessage()    {}
 func (*MsgAddAllowedXmsgSender) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9ea69829c73b3ce, []int{26}
+	return fileDescriptor_f9ea69829c73b3ce, []int{28}
 }
 func (m *MsgAddAllowedXmsgSender) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1551,7 +1690,7 @@ func (m *MsgAddAllowedXmsgSenderResponse) Reset()         { *m = MsgAddAllowedXm
 func (m *MsgAddAllowedXmsgSenderResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAddAllowedXmsgSenderResponse) ProtoMessage()    {}
 func (*MsgAddAllowedXmsgSenderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9ea69829c73b3ce, []int{27}
+	return fileDescriptor_f9ea69829c73b3ce, []int{29}
 }
 func (m *MsgAddAllowedXmsgSenderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1590,7 +1729,7 @@ func (m *MsgRemoveAllowedXmsgSender) Reset()         { *m = MsgRemoveAllowedXmsg
 func (m *MsgRemoveAllowedXmsgSender) String() string { return proto.CompactTextString(m) }
 func (*MsgRemoveAllowedXmsgSender) ProtoMessage()    {}
 func (*MsgRemoveAllowedXmsgSender) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9ea69829c73b3ce, []int{28}
+	return fileDescriptor_f9ea69829c73b3ce, []int{30}
 }
 func (m *MsgRemoveAllowedXmsgSender) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1641,7 +1780,7 @@ func (m *MsgRemoveAllowedXmsgSenderResponse) Reset()         { *m = MsgRemoveAll
 func (m *MsgRemoveAllowedXmsgSenderResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRemoveAllowedXmsgSenderResponse) ProtoMessage()    {}
 func (*MsgRemoveAllowedXmsgSenderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9ea69829c73b3ce, []int{29}
+	return fileDescriptor_f9ea69829c73b3ce, []int{31}
 }
 func (m *MsgRemoveAllowedXmsgSenderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1681,7 +1820,7 @@ func (m *MsgVoteOnPellRecharge) Reset()         { *m = MsgVoteOnPellRecharge{} }
 func (m *MsgVoteOnPellRecharge) String() string { return proto.CompactTextString(m) }
 func (*MsgVoteOnPellRecharge) ProtoMessage()    {}
 func (*MsgVoteOnPellRecharge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9ea69829c73b3ce, []int{30}
+	return fileDescriptor_f9ea69829c73b3ce, []int{32}
 }
 func (m *MsgVoteOnPellRecharge) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1739,7 +1878,7 @@ func (m *MsgVoteOnPellRechargeResponse) Reset()         { *m = MsgVoteOnPellRech
 func (m *MsgVoteOnPellRechargeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgVoteOnPellRechargeResponse) ProtoMessage()    {}
 func (*MsgVoteOnPellRechargeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9ea69829c73b3ce, []int{31}
+	return fileDescriptor_f9ea69829c73b3ce, []int{33}
 }
 func (m *MsgVoteOnPellRechargeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1779,7 +1918,7 @@ func (m *MsgVoteOnGasRecharge) Reset()         { *m = MsgVoteOnGasRecharge{} }
 func (m *MsgVoteOnGasRecharge) String() string { return proto.CompactTextString(m) }
 func (*MsgVoteOnGasRecharge) ProtoMessage()    {}
 func (*MsgVoteOnGasRecharge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9ea69829c73b3ce, []int{32}
+	return fileDescriptor_f9ea69829c73b3ce, []int{34}
 }
 func (m *MsgVoteOnGasRecharge) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1837,7 +1976,7 @@ func (m *MsgVoteOnGasRechargeResponse) Reset()         { *m = MsgVoteOnGasRechar
 func (m *MsgVoteOnGasRechargeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgVoteOnGasRechargeResponse) ProtoMessage()    {}
 func (*MsgVoteOnGasRechargeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9ea69829c73b3ce, []int{33}
+	return fileDescriptor_f9ea69829c73b3ce, []int{35}
 }
 func (m *MsgVoteOnGasRechargeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1877,7 +2016,7 @@ func (m *MsgUpsertCrosschainFeeParams) Reset()         { *m = MsgUpsertCrosschai
 func (m *MsgUpsertCrosschainFeeParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpsertCrosschainFeeParams) ProtoMessage()    {}
 func (*MsgUpsertCrosschainFeeParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9ea69829c73b3ce, []int{34}
+	return fileDescriptor_f9ea69829c73b3ce, []int{36}
 }
 func (m *MsgUpsertCrosschainFeeParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1929,7 +2068,7 @@ func (m *MsgUpsertCrosschainFeeParamsResponse) Reset()         { *m = MsgUpsertC
 func (m *MsgUpsertCrosschainFeeParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpsertCrosschainFeeParamsResponse) ProtoMessage()    {}
 func (*MsgUpsertCrosschainFeeParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9ea69829c73b3ce, []int{35}
+	return fileDescriptor_f9ea69829c73b3ce, []int{37}
 }
 func (m *MsgUpsertCrosschainFeeParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1958,6 +2097,194 @@ func (m *MsgUpsertCrosschainFeeParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpsertCrosschainFeeParamsResponse proto.InternalMessageInfo
 
+// MsgUpdateRechargeBudget sets the recharge budget of a token on a chain
+type MsgUpdateRechargeBudget struct {
+	Signer string         `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Budget RechargeBudget `protobuf:"bytes,2,opt,name=budget,proto3" json:"budget"`
+}
+
+func (m *MsgUpdateRechargeBudget) Reset()         { *m = MsgUpdateRechargeBudget{} }
+func (m *MsgUpdateRechargeBudget) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateRechargeBudget) ProtoMessage()    {}
+func (*MsgUpdateRechargeBudget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9ea69829c73b3ce, []int{38}
+}
+func (m *MsgUpdateRechargeBudget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateRechargeBudget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateRechargeBudget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateRechargeBudget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateRechargeBudget.Merge(m, src)
+}
+func (m *MsgUpdateRechargeBudget) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateRechargeBudget) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateRechargeBudget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateRechargeBudget proto.InternalMessageInfo
+
+func (m *MsgUpdateRechargeBudget) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgUpdateRechargeBudget) GetBudget() RechargeBudget {
+	if m != nil {
+		return m.Budget
+	}
+	return RechargeBudget{}
+}
+
+// MsgUpdateRechargeBudgetResponse response for updating a recharge budget
+type MsgUpdateRechargeBudgetResponse struct {
+}
+
+func (m *MsgUpdateRechargeBudgetResponse) Reset()         { *m = MsgUpdateRechargeBudgetResponse{} }
+func (m *MsgUpdateRechargeBudgetResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateRechargeBudgetResponse) ProtoMessage()    {}
+func (*MsgUpdateRechargeBudgetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9ea69829c73b3ce, []int{39}
+}
+func (m *MsgUpdateRechargeBudgetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateRechargeBudgetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateRechargeBudgetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateRechargeBudgetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateRechargeBudgetResponse.Merge(m, src)
+}
+func (m *MsgUpdateRechargeBudgetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateRechargeBudgetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateRechargeBudgetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateRechargeBudgetResponse proto.InternalMessageInfo
+
+// MsgRemoveRechargeBudget removes the recharge budget of a token on a chain
+type MsgRemoveRechargeBudget struct {
+	Signer    string            `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	ChainId   int64             `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	TokenType RechargeTokenType `protobuf:"varint,3,opt,name=token_type,json=tokenType,proto3,enum=xmsg.RechargeTokenType" json:"token_type,omitempty"`
+}
+
+func (m *MsgRemoveRechargeBudget) Reset()         { *m = MsgRemoveRechargeBudget{} }
+func (m *MsgRemoveRechargeBudget) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveRechargeBudget) ProtoMessage()    {}
+func (*MsgRemoveRechargeBudget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9ea69829c73b3ce, []int{40}
+}
+func (m *MsgRemoveRechargeBudget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveRechargeBudget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveRechargeBudget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveRechargeBudget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveRechargeBudget.Merge(m, src)
+}
+func (m *MsgRemoveRechargeBudget) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveRechargeBudget) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveRechargeBudget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveRechargeBudget proto.InternalMessageInfo
+
+func (m *MsgRemoveRechargeBudget) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgRemoveRechargeBudget) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *MsgRemoveRechargeBudget) GetTokenType() RechargeTokenType {
+	if m != nil {
+		return m.TokenType
+	}
+	return RechargeTokenType_PELL_TOKEN
+}
+
+// MsgRemoveRechargeBudgetResponse response for removing a recharge budget
+type MsgRemoveRechargeBudgetResponse struct {
+}
+
+func (m *MsgRemoveRechargeBudgetResponse) Reset()         { *m = MsgRemoveRechargeBudgetResponse{} }
+func (m *MsgRemoveRechargeBudgetResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveRechargeBudgetResponse) ProtoMessage()    {}
+func (*MsgRemoveRechargeBudgetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9ea69829c73b3ce, []int{41}
+}
+func (m *MsgRemoveRechargeBudgetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveRechargeBudgetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveRechargeBudgetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveRechargeBudgetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveRechargeBudgetResponse.Merge(m, src)
+}
+func (m *MsgRemoveRechargeBudgetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveRechargeBudgetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveRechargeBudgetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveRechargeBudgetResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgInboundTxMaintenance)(nil), "xmsg.MsgInboundTxMaintenance")
 	proto.RegisterType((*MsgInboundTxMaintenanceResponse)(nil), "xmsg.MsgInboundTxMaintenanceResponse")
@@ -1977,6 +2304,8 @@ func init() {
 	proto.RegisterType((*MsgVoteOnObservedOutboundTxResponse)(nil), "xmsg.MsgVoteOnObservedOutboundTxResponse")
 	proto.RegisterType((*MsgVoteOnObservedInboundTx)(nil), "xmsg.MsgVoteOnObservedInboundTx")
 	proto.RegisterType((*MsgVoteOnObservedInboundTxResponse)(nil), "xmsg.MsgVoteOnObservedInboundTxResponse")
+	proto.RegisterType((*MsgProveInboundTx)(nil), "xmsg.MsgProveInboundTx")
+	proto.RegisterType((*MsgProveInboundTxResponse)(nil), "xmsg.MsgProveInboundTxResponse")
 	proto.RegisterType((*MsgAbortStuckXmsg)(nil), "xmsg.MsgAbortStuckXmsg")
 	proto.RegisterType((*MsgAbortStuckXmsgResponse)(nil), "xmsg.MsgAbortStuckXmsgResponse")
 	proto.RegisterType((*MsgRefundAbortedXmsg)(nil), "xmsg.MsgRefundAbortedXmsg")
@@ -1995,126 +2324,145 @@ func init() {
 	proto.RegisterType((*MsgVoteOnGasRechargeResponse)(nil), "xmsg.MsgVoteOnGasRechargeResponse")
 	proto.RegisterType((*MsgUpsertCrosschainFeeParams)(nil), "xmsg.MsgUpsertCrosschainFeeParams")
 	proto.RegisterType((*MsgUpsertCrosschainFeeParamsResponse)(nil), "xmsg.MsgUpsertCrosschainFeeParamsResponse")
+	proto.RegisterType((*MsgUpdateRechargeBudget)(nil), "xmsg.MsgUpdateRechargeBudget")
+	proto.RegisterType((*MsgUpdateRechargeBudgetResponse)(nil), "xmsg.MsgUpdateRechargeBudgetResponse")
+	proto.RegisterType((*MsgRemoveRechargeBudget)(nil), "xmsg.MsgRemoveRechargeBudget")
+	proto.RegisterType((*MsgRemoveRechargeBudgetResponse)(nil), "xmsg.MsgRemoveRechargeBudgetResponse")
 }
 
 func init() { proto.RegisterFile("xmsg/tx.proto", fileDescriptor_f9ea69829c73b3ce) }
 
 var fileDescriptor_f9ea69829c73b3ce = []byte{
-	// 1822 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4f, 0x6f, 0xdb, 0xc8,
-	0x15, 0x8f, 0xd6, 0xb6, 0x2c, 0x3d, 0xc7, 0x71, 0xcc, 0xf8, 0x8f, 0x4c, 0xdb, 0x92, 0xed, 0x6c,
-	0x12, 0x37, 0x0b, 0x58, 0x8d, 0x0b, 0xec, 0xa1, 0x40, 0x0b, 0x24, 0x69, 0x9c, 0xf5, 0x36, 0x8a,
-	0x0d, 0x5a, 0xd9, 0xb6, 0x8b, 0x16, 0x04, 0x25, 0x8e, 0x29, 0x56, 0x12, 0x47, 0xe5, 0x0c, 0xb5,
-	0xf4, 0xb1, 0x7b, 0xea, 0xa1, 0x87, 0xf6, 0xd2, 0x4b, 0x6f, 0xbd, 0x17, 0xe8, 0xc7, 0xd8, 0xe3,
-	0x1e, 0x8b, 0x1e, 0x82, 0x22, 0x39, 0xb4, 0x1f, 0xa3, 0x98, 0x37, 0xa3, 0x31, 0x49, 0x91, 0x32,
-	0x9a, 0xa2, 0x7b, 0x91, 0x38, 0xef, 0xbd, 0x99, 0xf7, 0x7b, 0x6f, 0xde, 0x3f, 0x12, 0x96, 0xe3,
-	0x21, 0xf3, 0x9a, 0x3c, 0x3e, 0x1a, 0x85, 0x94, 0x53, 0x63, 0x5e, 0x2c, 0xcd, 0xcd, 0x2e, 0x65,
-	0x43, 0xca, 0x9a, 0x82, 0x35, 0x7e, 0x22, 0xfe, 0x24, 0xdb, 0x5c, 0xf3, 0xa8, 0x47, 0xf1, 0xb1,
-	0x29, 0x9e, 0x14, 0x75, 0x73, 0xd4, 0xf7, 0x9a, 0xdd, 0x9e, 0xe3, 0x07, 0x4c, 0xfd, 0x29, 0xc6,
-	0x3d, 0x64, 0x50, 0x3f, 0xc0, 0x9f, 0xa4, 0xf4, 0x28, 0xa4, 0xf4, 0x92, 0xa9, 0x3f, 0xc5, 0xd8,
-	0x40, 0x28, 0x78, 0x80, 0xed, 0x07, 0x2e, 0x51, 0x98, 0xcc, 0x86, 0xa4, 0x87, 0x94, 0x31, 0xc9,
-	0xbc, 0x24, 0xc4, 0x1e, 0x39, 0xa1, 0x33, 0x54, 0x02, 0xeb, 0x28, 0x30, 0x22, 0x83, 0x81, 0x4d,
-	0xc6, 0x24, 0xe0, 0x8a, 0xbc, 0x8b, 0xe4, 0xd0, 0xe1, 0xc4, 0x1e, 0xf8, 0x43, 0x9f, 0x93, 0xd0,
-	0xbe, 0x1c, 0x38, 0x9e, 0x52, 0x77, 0xf0, 0xd7, 0x12, 0x6c, 0xb6, 0x98, 0x77, 0x1a, 0x74, 0x68,
-	0x14, 0xb8, 0xed, 0xb8, 0xe5, 0xf8, 0x01, 0x27, 0x81, 0x13, 0x74, 0x89, 0xb1, 0x01, 0x65, 0xe6,
-	0x7b, 0x01, 0x09, 0x6b, 0xa5, 0xbd, 0xd2, 0x61, 0xd5, 0x52, 0x2b, 0x63, 0x0b, 0x2a, 0x0a, 0x9f,
-	0x5b, 0xfb, 0x68, 0xaf, 0x74, 0x38, 0x67, 0x2d, 0xe2, 0xfa, 0xd4, 0x35, 0x1e, 0xc3, 0xea, 0x65,
-	0x48, 0x87, 0x76, 0x67, 0x40, 0xbb, 0x7d, 0xbb, 0x47, 0x7c, 0xaf, 0xc7, 0x6b, 0x73, 0x7b, 0xa5,
-	0xc3, 0x79, 0x6b, 0x45, 0x30, 0x9e, 0x09, 0xfa, 0x67, 0x48, 0x36, 0x1e, 0xc2, 0x0a, 0xa7, 0x69,
-	0xc9, 0x79, 0x94, 0x5c, 0xe6, 0x34, 0x21, 0xf7, 0xc3, 0xa5, 0xaf, 0xff, 0xf5, 0xb7, 0xc7, 0x4a,
-	0xf7, 0xc1, 0x3e, 0x34, 0x0a, 0xe0, 0x5a, 0x84, 0x8d, 0x68, 0xc0, 0xc8, 0xc1, 0xef, 0x4b, 0x60,
-	0xb4, 0x98, 0xd7, 0xf2, 0x3d, 0x61, 0x75, 0x9b, 0xb1, 0x93, 0x28, 0x70, 0xd9, 0x87, 0x58, 0xf3,
-	0x29, 0x94, 0x9d, 0x21, 0x8d, 0x02, 0x69, 0x42, 0xf5, 0x59, 0xfd, 0x9b, 0xb7, 0x8d, 0x5b, 0xff,
-	0x78, 0xdb, 0xd8, 0x90, 0x91, 0xc1, 0xdc, 0xfe, 0x91, 0x4f, 0x9b, 0x43, 0x87, 0xf7, 0x8e, 0xde,
-	0xf8, 0x01, 0xb7, 0x94, 0x74, 0x1a, 0xf1, 0x0e, 0x98, 0xd3, 0x68, 0x34, 0xd8, 0x5f, 0xc0, 0xbd,
-	0x16, 0xf3, 0xde, 0x8c, 0x5c, 0xc9, 0x7c, 0xea, 0xba, 0x21, 0x61, 0xc5, 0x60, 0x77, 0x01, 0x38,
-	0x63, 0xf6, 0x28, 0xea, 0xf4, 0xc9, 0x15, 0xc2, 0xad, 0x5a, 0x55, 0xce, 0xd8, 0x39, 0x12, 0xd2,
-	0x8a, 0x77, 0x61, 0x3b, 0xe7, 0x68, 0xad, 0xf9, 0xeb, 0x8f, 0x50, 0xf5, 0x53, 0xd7, 0x6d, 0xd3,
-	0xd3, 0xa0, 0x1d, 0xb7, 0x43, 0xa7, 0xdb, 0x27, 0xe1, 0x87, 0xf8, 0x69, 0x13, 0x16, 0x79, 0x6c,
-	0xf7, 0x1c, 0xd6, 0x93, 0x8e, 0xb2, 0xca, 0x3c, 0xfe, 0xcc, 0x61, 0x3d, 0xa3, 0x09, 0x55, 0x11,
-	0xf3, 0x36, 0xbf, 0x1a, 0x11, 0xbc, 0xdc, 0x3b, 0xc7, 0xc6, 0xd1, 0xa8, 0xef, 0x1d, 0x61, 0x26,
-	0x3c, 0xa7, 0x7e, 0xd0, 0xbe, 0x1a, 0x11, 0xab, 0xd2, 0x55, 0x4f, 0xc6, 0x23, 0x58, 0xc0, 0x6c,
-	0xa8, 0x2d, 0xec, 0x95, 0x0e, 0x97, 0x8e, 0x57, 0x51, 0x58, 0xe5, 0xc7, 0xb9, 0xf8, 0xb3, 0x24,
-	0x5f, 0x38, 0x42, 0x45, 0x8e, 0xd0, 0x5a, 0x96, 0x8e, 0x40, 0x0a, 0x2a, 0xde, 0x82, 0x0a, 0x8f,
-	0x65, 0xfe, 0xd4, 0x16, 0x25, 0x58, 0x1e, 0x9f, 0x8a, 0x65, 0x9e, 0x8f, 0xb2, 0x3e, 0xd0, 0x3e,
-	0xfa, 0x77, 0x09, 0xd6, 0x26, 0xfc, 0xb3, 0x88, 0x5f, 0x3b, 0x29, 0xe9, 0x8c, 0x52, 0xda, 0x19,
-	0x6b, 0xb0, 0x10, 0xd0, 0xa0, 0x4b, 0xd0, 0x49, 0xf3, 0x96, 0x5c, 0x14, 0xbb, 0x48, 0x5b, 0x3c,
-	0xff, 0x5f, 0x59, 0xbc, 0x30, 0xcb, 0xe2, 0x72, 0xca, 0xe2, 0xc4, 0x8d, 0x2e, 0x26, 0x6f, 0x34,
-	0xed, 0x89, 0x1f, 0xc1, 0x4e, 0x9e, 0xa5, 0x13, 0x57, 0x08, 0xf5, 0x3e, 0xb3, 0x43, 0x32, 0xa4,
-	0x63, 0x22, 0x6d, 0xae, 0x58, 0x55, 0x9f, 0x59, 0x92, 0x70, 0xf0, 0x1b, 0xd8, 0x6a, 0x31, 0x4f,
-	0xae, 0x4e, 0x42, 0x3a, 0x4c, 0x79, 0xeb, 0x03, 0x42, 0x4a, 0x7b, 0x71, 0x2e, 0xe1, 0xc5, 0x34,
-	0xe2, 0xfb, 0xb0, 0x5f, 0xa8, 0x52, 0xdf, 0xe0, 0x5f, 0x4a, 0xb0, 0xd2, 0x62, 0xde, 0x17, 0x94,
-	0x93, 0x97, 0x0e, 0x3b, 0x0f, 0xfd, 0x2e, 0xb9, 0xe1, 0xf2, 0x46, 0x42, 0x66, 0x72, 0x79, 0xb8,
-	0x30, 0xf6, 0xe1, 0xb6, 0x74, 0x7d, 0x10, 0x0d, 0x3b, 0x24, 0x54, 0x98, 0x96, 0x90, 0xf6, 0x1a,
-	0x49, 0x68, 0x62, 0x34, 0x1a, 0x0d, 0xae, 0xf0, 0x1e, 0x85, 0x89, 0xb8, 0x4a, 0x98, 0xbe, 0x50,
-	0xec, 0xfb, 0x2d, 0xac, 0xc1, 0x49, 0x8c, 0x1a, 0xff, 0xef, 0x16, 0x30, 0x42, 0x05, 0xef, 0x2c,
-	0x38, 0xeb, 0x30, 0x12, 0x8e, 0x89, 0x7b, 0x16, 0x71, 0x55, 0xff, 0x0a, 0x5d, 0xbb, 0x0d, 0x55,
-	0x51, 0xf8, 0x65, 0xb0, 0xc8, 0x3a, 0x51, 0x11, 0x04, 0x8c, 0x95, 0x27, 0x50, 0x66, 0xdc, 0xe1,
-	0x11, 0x43, 0x4b, 0xee, 0x1c, 0x6f, 0xc9, 0x9c, 0x94, 0x4d, 0xcb, 0x22, 0x5d, 0xe2, 0x8f, 0xc9,
-	0x05, 0x0a, 0x58, 0x4a, 0xd0, 0xd8, 0x83, 0xdb, 0x34, 0xe2, 0x36, 0x8f, 0x6d, 0x14, 0x43, 0x2b,
-	0xe7, 0x2c, 0xa0, 0xc2, 0xe7, 0xcf, 0x05, 0xc5, 0x78, 0x04, 0x77, 0x95, 0x84, 0xa8, 0x50, 0xf2,
-	0xf2, 0x16, 0x64, 0x3d, 0x47, 0xa9, 0x36, 0x63, 0xaf, 0x31, 0x15, 0x9a, 0xb0, 0x46, 0x95, 0x21,
-	0xb6, 0xda, 0x91, 0x48, 0xe2, 0x55, 0x7a, 0x6d, 0x64, 0x5b, 0xa6, 0xc8, 0x8f, 0x61, 0x27, 0xbb,
-	0x21, 0xd5, 0x35, 0x16, 0x51, 0x4b, 0x2d, 0xb5, 0x31, 0xd9, 0x68, 0x3e, 0x85, 0x5a, 0x76, 0xbf,
-	0xe7, 0x30, 0x3b, 0x62, 0xc4, 0xad, 0x55, 0x70, 0xef, 0x5a, 0x6a, 0xef, 0x4b, 0x87, 0xbd, 0x61,
-	0xc4, 0x35, 0x7e, 0x0d, 0xf7, 0xb3, 0xfb, 0xc8, 0xe5, 0x25, 0xe9, 0x72, 0x7f, 0x4c, 0xf0, 0x04,
-	0x19, 0x2a, 0x55, 0xec, 0x0d, 0xbb, 0xaa, 0x37, 0xac, 0x4f, 0xf7, 0x86, 0xd3, 0x80, 0x5b, 0xf5,
-	0x94, 0x86, 0x17, 0x93, 0x63, 0x74, 0x4c, 0xfe, 0xf4, 0x26, 0x5d, 0xd8, 0xbc, 0x6b, 0x80, 0x70,
-	0x8b, 0x0f, 0x7b, 0x25, 0xa4, 0x8c, 0x97, 0xb0, 0x9f, 0x3d, 0xec, 0xd2, 0xf1, 0x07, 0xc4, 0xb5,
-	0x43, 0xe2, 0x30, 0x1a, 0xd8, 0x43, 0xe6, 0xd5, 0x96, 0xd0, 0xdd, 0x3b, 0xa9, 0xa3, 0x4e, 0x50,
-	0xca, 0x42, 0xa1, 0x16, 0xf3, 0xd2, 0x51, 0xfa, 0x00, 0xee, 0xcf, 0x88, 0x44, 0x1d, 0xb1, 0x7f,
-	0x9a, 0xc3, 0x86, 0x97, 0x96, 0xd3, 0x0d, 0x1b, 0x03, 0x96, 0x04, 0x6e, 0x22, 0x60, 0x71, 0x25,
-	0xa6, 0x01, 0xf9, 0x64, 0x67, 0x4a, 0xc2, 0xb2, 0x24, 0x3f, 0x57, 0x19, 0x6a, 0x42, 0x25, 0x94,
-	0x11, 0x1a, 0xaa, 0x4a, 0xaa, 0xd7, 0xc6, 0x03, 0xb8, 0x33, 0x79, 0x4e, 0x85, 0xe9, 0xf2, 0x84,
-	0x2a, 0x23, 0x75, 0x07, 0x40, 0xf4, 0xa4, 0x38, 0x59, 0x49, 0x2b, 0x7e, 0xa0, 0xa2, 0xed, 0x21,
-	0xac, 0xf8, 0x41, 0x3a, 0xc0, 0xca, 0x32, 0x8c, 0xfd, 0x20, 0x19, 0x55, 0xdb, 0x50, 0xbd, 0xbe,
-	0x17, 0x19, 0x82, 0x15, 0x6f, 0x72, 0x03, 0xdb, 0x50, 0xe5, 0xb1, 0x4d, 0x43, 0xdf, 0xf3, 0x03,
-	0x8c, 0xb1, 0xaa, 0x55, 0xe1, 0xf1, 0x19, 0xae, 0x8d, 0x06, 0x2c, 0xe1, 0x84, 0xa6, 0xaa, 0x75,
-	0x15, 0xf7, 0x02, 0x92, 0x64, 0xc1, 0x6e, 0xc2, 0x22, 0xce, 0x71, 0x3c, 0xc6, 0x0b, 0x5f, 0x3a,
-	0xde, 0x38, 0x12, 0xb9, 0x7b, 0xa4, 0xbc, 0x78, 0x4e, 0x06, 0x83, 0x17, 0x42, 0xda, 0x2a, 0x0b,
-	0xb1, 0x54, 0x15, 0x58, 0x2a, 0xae, 0x32, 0x1f, 0xc3, 0x41, 0xf1, 0xbd, 0xe8, 0xeb, 0xfb, 0x19,
-	0xac, 0x8a, 0x3e, 0xd0, 0xa1, 0x21, 0xbf, 0xe0, 0x51, 0xb7, 0xff, 0xf3, 0x21, 0xf3, 0x66, 0x8d,
-	0x23, 0x58, 0x65, 0xa4, 0x21, 0x6a, 0x1c, 0x11, 0x94, 0x9c, 0x56, 0xbb, 0x8d, 0x1d, 0x22, 0x7d,
-	0xb0, 0xd6, 0xfa, 0x5b, 0xd9, 0x68, 0x2d, 0x72, 0x19, 0x05, 0x2e, 0xca, 0x10, 0xf7, 0x7f, 0xd0,
-	0x2c, 0x23, 0x41, 0x9c, 0x65, 0x3b, 0x72, 0xec, 0x51, 0xb1, 0xb2, 0x2c, 0xa9, 0x6a, 0x16, 0x4a,
-	0x03, 0xac, 0x63, 0x07, 0x9c, 0x82, 0x90, 0x9c, 0x2b, 0xb7, 0xf4, 0x40, 0x65, 0x39, 0x9c, 0xbc,
-	0x92, 0xf3, 0xf4, 0x89, 0x18, 0xa7, 0x0b, 0x81, 0x7e, 0x0e, 0xc6, 0xf4, 0xf0, 0x8d, 0x80, 0xf5,
-	0xb5, 0x66, 0xcf, 0x7a, 0x36, 0x2f, 0x6a, 0x89, 0x75, 0x37, 0xcc, 0xd0, 0xf3, 0xda, 0x5f, 0x3e,
-	0x1a, 0x8d, 0x79, 0x88, 0x33, 0x9e, 0xb8, 0x73, 0x75, 0xd3, 0x18, 0xc1, 0x85, 0x60, 0x9f, 0x80,
-	0x6c, 0x6a, 0xb6, 0x1c, 0x49, 0x24, 0xca, 0xbb, 0x12, 0x25, 0xee, 0x94, 0x13, 0x89, 0x1c, 0x44,
-	0xf0, 0x39, 0x8d, 0xe9, 0x11, 0x3c, 0x28, 0x0a, 0x31, 0xdc, 0xae, 0x71, 0x7d, 0x89, 0x1d, 0xef,
-	0xa9, 0xeb, 0x3e, 0x1d, 0x0c, 0xe8, 0x57, 0xd2, 0xd1, 0x17, 0xb2, 0x10, 0x14, 0x61, 0x33, 0xa1,
-	0xd2, 0x89, 0xfc, 0x81, 0x4b, 0x42, 0xe1, 0xbe, 0x39, 0x91, 0x51, 0x93, 0x75, 0xde, 0x2b, 0x42,
-	0xde, 0xd9, 0x5a, 0xfd, 0xaf, 0xb0, 0x44, 0xc9, 0xd1, 0xe1, 0xff, 0x80, 0x40, 0x66, 0x5a, 0xc1,
-	0xf1, 0x1a, 0xc4, 0x18, 0xd6, 0xb5, 0xb3, 0x44, 0x4a, 0x5b, 0xa4, 0xdb, 0x73, 0x42, 0x6f, 0xe6,
-	0x7c, 0xb2, 0x0b, 0x30, 0xa6, 0x9c, 0x24, 0xc2, 0x7e, 0xde, 0xaa, 0x8e, 0xf1, 0x7a, 0xd3, 0x93,
-	0xde, 0x5c, 0x71, 0x1d, 0x68, 0xc0, 0x6e, 0xae, 0x5e, 0x0d, 0x2c, 0xc2, 0x5c, 0x94, 0x02, 0x2f,
-	0x1d, 0xf6, 0x5d, 0xe1, 0x92, 0xf9, 0x37, 0xa5, 0x56, 0xc3, 0xfa, 0x63, 0x09, 0x05, 0xde, 0x8c,
-	0x18, 0x09, 0xf9, 0x73, 0xfd, 0x22, 0x7c, 0x42, 0xc8, 0xb9, 0x78, 0x0d, 0x2e, 0x4e, 0xc1, 0x57,
-	0xb0, 0x9e, 0xf7, 0xde, 0x2c, 0x2f, 0x71, 0xe9, 0xb8, 0x26, 0xe3, 0x7b, 0xfa, 0x44, 0xeb, 0x5e,
-	0x77, 0x5a, 0x4b, 0x1a, 0xf3, 0x43, 0xf8, 0x78, 0x16, 0xa4, 0x09, 0xf6, 0xe3, 0x3f, 0xdf, 0x86,
-	0xb9, 0x16, 0xf3, 0x8c, 0x0b, 0x58, 0x9d, 0x7e, 0x99, 0x30, 0x25, 0x80, 0xbc, 0xf1, 0xdb, 0x3c,
-	0x28, 0xe6, 0xe9, 0xd1, 0xfc, 0x1c, 0xee, 0x4e, 0xbd, 0xc5, 0x6d, 0xa5, 0xf7, 0x25, 0x58, 0xe6,
-	0x7e, 0x21, 0x4b, 0x9f, 0xd8, 0x81, 0x8d, 0x82, 0x51, 0xbe, 0xa1, 0x37, 0xe7, 0x0b, 0x98, 0x8f,
-	0x6e, 0x10, 0xd0, 0x3a, 0x7e, 0x02, 0xb7, 0x53, 0x53, 0xf9, 0xba, 0xde, 0x98, 0x24, 0x9b, 0xbb,
-	0xb9, 0x64, 0x7d, 0x4a, 0x0f, 0x6a, 0x85, 0xb3, 0xf1, 0x7e, 0x6a, 0x6b, 0x9e, 0x88, 0xf9, 0xbd,
-	0x1b, 0x45, 0xb4, 0x26, 0x17, 0xcc, 0xe2, 0xc2, 0x96, 0xf0, 0x77, 0xb6, 0xd8, 0x9a, 0x9f, 0x14,
-	0xe8, 0xc8, 0x2b, 0x8c, 0x06, 0x81, 0xcd, 0xa2, 0xc9, 0x69, 0x6f, 0xf6, 0x39, 0xed, 0xd8, 0x3c,
-	0xbc, 0x49, 0x42, 0xab, 0xf9, 0x02, 0x8c, 0x9c, 0xc2, 0xb3, 0x9d, 0xd9, 0x9f, 0x64, 0x9a, 0xf7,
-	0x67, 0x30, 0xf5, 0xb9, 0x17, 0xb0, 0x3a, 0x5d, 0x37, 0xcc, 0xcc, 0xce, 0x04, 0x2f, 0x11, 0xdf,
-	0x85, 0x89, 0x2f, 0xe2, 0x7b, 0xea, 0x03, 0xc9, 0xb5, 0xbf, 0xb3, 0xac, 0x44, 0x7c, 0x17, 0x7d,
-	0xfb, 0x30, 0x5a, 0xb0, 0x92, 0xfd, 0x3c, 0x54, 0xd3, 0xbb, 0x32, 0x1c, 0x73, 0xaf, 0x88, 0xa3,
-	0x8f, 0xfb, 0x1c, 0xee, 0x64, 0x06, 0xa6, 0xcd, 0xeb, 0x1c, 0x4b, 0x31, 0xcc, 0x46, 0x01, 0x23,
-	0x99, 0x7a, 0x05, 0x13, 0x46, 0x23, 0x63, 0x57, 0x56, 0x20, 0x91, 0x7a, 0xb3, 0xa7, 0x02, 0xe3,
-	0x97, 0xb0, 0x96, 0xdb, 0x7a, 0x77, 0x93, 0x95, 0x61, 0x8a, 0x6d, 0x3e, 0x98, 0xc9, 0x4e, 0x86,
-	0x70, 0x51, 0x67, 0xdd, 0xcb, 0x14, 0x87, 0x69, 0x1d, 0x87, 0x37, 0x49, 0x24, 0x8d, 0xc8, 0xfd,
-	0x6a, 0x79, 0x6d, 0x44, 0x1e, 0x3b, 0x61, 0xc4, 0xac, 0x8f, 0x88, 0x46, 0x1f, 0xb6, 0x8a, 0x1b,
-	0xcd, 0x41, 0xc2, 0xd1, 0x05, 0x32, 0xe6, 0xe3, 0x9b, 0x65, 0x26, 0xca, 0x9e, 0xbd, 0xf8, 0xe6,
-	0x5d, 0xbd, 0xf4, 0xed, 0xbb, 0x7a, 0xe9, 0x9f, 0xef, 0xea, 0xa5, 0x3f, 0xbc, 0xaf, 0xdf, 0xfa,
-	0xf6, 0x7d, 0xfd, 0xd6, 0xdf, 0xdf, 0xd7, 0x6f, 0x7d, 0xf9, 0x89, 0xe7, 0xf3, 0x5e, 0xd4, 0x39,
-	0xea, 0xd2, 0x61, 0xf3, 0xfb, 0xb1, 0x48, 0xb9, 0xd7, 0x84, 0x7f, 0x45, 0xc3, 0x7e, 0xd3, 0x21,
-	0x9e, 0xcf, 0x9a, 0x71, 0x53, 0x7e, 0xb9, 0xbe, 0x1a, 0x11, 0xd6, 0x29, 0xe3, 0x27, 0xdd, 0x1f,
-	0xfc, 0x27, 0x00, 0x00, 0xff, 0xff, 0x57, 0x1e, 0x97, 0x97, 0xce, 0x16, 0x00, 0x00,
+	// 2056 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0x4f, 0x73, 0x1b, 0x49,
+	0x15, 0x8f, 0x62, 0x59, 0x96, 0x9e, 0xfc, 0x27, 0x9e, 0xd8, 0x96, 0x3c, 0xb6, 0x2c, 0xdb, 0x59,
+	0xc7, 0x26, 0x4b, 0x59, 0xc4, 0x54, 0xe5, 0x40, 0x15, 0x14, 0x76, 0x88, 0xb3, 0x5e, 0xa2, 0xd8,
+	0x35, 0x56, 0x16, 0xd8, 0x82, 0x9a, 0x1a, 0x69, 0xda, 0xa3, 0x41, 0xd2, 0xb4, 0x98, 0x6e, 0x69,
+	0xe5, 0x23, 0x7b, 0xe2, 0xc0, 0x01, 0x2e, 0x1c, 0xf8, 0x0e, 0x54, 0xf1, 0x31, 0xf6, 0xb8, 0x47,
+	0x8a, 0xc3, 0x56, 0x2a, 0x39, 0xc0, 0x47, 0xe0, 0x48, 0xf5, 0x1f, 0xb5, 0x67, 0x46, 0xd3, 0x12,
+	0x84, 0x62, 0x2f, 0xd6, 0xf4, 0x7b, 0xaf, 0xfb, 0xfd, 0xde, 0xdf, 0x7e, 0x33, 0x86, 0xa5, 0x51,
+	0x8f, 0x78, 0x35, 0x3a, 0x3a, 0xee, 0x87, 0x98, 0x62, 0x23, 0xcb, 0x96, 0x66, 0xa9, 0x85, 0x49,
+	0x0f, 0x93, 0x1a, 0x63, 0x0d, 0x9f, 0xb2, 0x1f, 0xc1, 0x36, 0xd7, 0x3c, 0xec, 0x61, 0xfe, 0x58,
+	0x63, 0x4f, 0x92, 0x5a, 0xea, 0x77, 0xbc, 0x5a, 0xab, 0xed, 0xf8, 0x01, 0x91, 0x3f, 0x92, 0xf1,
+	0x90, 0x33, 0xb0, 0x1f, 0xf0, 0x3f, 0x51, 0xe9, 0x7e, 0x88, 0xf1, 0x0d, 0x91, 0x3f, 0x92, 0xb1,
+	0xc1, 0xa1, 0xf0, 0x03, 0x6c, 0x3f, 0x70, 0x91, 0xc4, 0x64, 0x56, 0x05, 0x3d, 0xc4, 0x84, 0x08,
+	0xe6, 0x0d, 0x42, 0x76, 0xdf, 0x09, 0x9d, 0x9e, 0x14, 0x58, 0xe7, 0x02, 0x7d, 0xd4, 0xed, 0xda,
+	0x68, 0x88, 0x02, 0x2a, 0xc9, 0x15, 0x4e, 0x0e, 0x1d, 0x8a, 0xec, 0xae, 0xdf, 0xf3, 0x29, 0x0a,
+	0xed, 0x9b, 0xae, 0xe3, 0x8d, 0xd5, 0x99, 0x82, 0x8d, 0x5a, 0x6d, 0x27, 0xf4, 0x90, 0xdd, 0x45,
+	0xae, 0x87, 0x42, 0xc1, 0xdb, 0xff, 0x4b, 0x06, 0x4a, 0x75, 0xe2, 0x5d, 0x04, 0x4d, 0x3c, 0x08,
+	0xdc, 0xc6, 0xa8, 0xee, 0xf8, 0x01, 0x45, 0x81, 0x13, 0xb4, 0x90, 0xb1, 0x01, 0x39, 0xe2, 0x7b,
+	0x01, 0x0a, 0xcb, 0x99, 0xdd, 0xcc, 0x51, 0xc1, 0x92, 0x2b, 0x63, 0x13, 0xf2, 0x12, 0xbb, 0x5b,
+	0xbe, 0xbf, 0x9b, 0x39, 0x9a, 0xb3, 0x16, 0xf8, 0xfa, 0xc2, 0x35, 0x9e, 0xc0, 0xea, 0x4d, 0x88,
+	0x7b, 0x76, 0xb3, 0x8b, 0x5b, 0x1d, 0xbb, 0x8d, 0x7c, 0xaf, 0x4d, 0xcb, 0x73, 0xbb, 0x99, 0xa3,
+	0xac, 0xb5, 0xc2, 0x18, 0x67, 0x8c, 0xfe, 0x09, 0x27, 0x1b, 0x8f, 0x61, 0x85, 0xe2, 0xb8, 0x64,
+	0x96, 0x4b, 0x2e, 0x51, 0x1c, 0x91, 0xfb, 0x41, 0xf1, 0xcb, 0x7f, 0xfc, 0xf5, 0x89, 0xd4, 0xbd,
+	0xbf, 0x07, 0x55, 0x0d, 0x5c, 0x0b, 0x91, 0x3e, 0x0e, 0x08, 0xda, 0xff, 0x7d, 0x06, 0x8c, 0x3a,
+	0xf1, 0xea, 0xbe, 0xc7, 0x3c, 0xd2, 0x20, 0xe4, 0x7c, 0x10, 0xb8, 0xe4, 0x43, 0xac, 0x79, 0x06,
+	0x39, 0xa7, 0x87, 0x07, 0x81, 0x30, 0xa1, 0x70, 0xb6, 0xf3, 0xd5, 0x37, 0xd5, 0x7b, 0x7f, 0xff,
+	0xa6, 0xba, 0x21, 0xb2, 0x86, 0xb8, 0x9d, 0x63, 0x1f, 0xd7, 0x7a, 0x0e, 0x6d, 0x1f, 0xbf, 0xf1,
+	0x03, 0x6a, 0x49, 0xe9, 0x38, 0xe2, 0x6d, 0x30, 0x27, 0xd1, 0x28, 0xb0, 0xbf, 0x80, 0x87, 0x75,
+	0xe2, 0xbd, 0xe9, 0xbb, 0x82, 0x79, 0xea, 0xba, 0x21, 0x22, 0x7a, 0xb0, 0x15, 0x00, 0x4a, 0x88,
+	0xdd, 0x1f, 0x34, 0x3b, 0xe8, 0x96, 0xc3, 0x2d, 0x58, 0x05, 0x4a, 0xc8, 0x15, 0x27, 0xc4, 0x15,
+	0x57, 0x60, 0x2b, 0xe5, 0x68, 0xa5, 0xf9, 0xcb, 0xfb, 0x5c, 0xf5, 0xa9, 0xeb, 0x36, 0xf0, 0x45,
+	0xd0, 0x18, 0x35, 0x42, 0xa7, 0xd5, 0x41, 0xe1, 0x87, 0xf8, 0xa9, 0x04, 0x0b, 0x74, 0x64, 0xb7,
+	0x1d, 0xd2, 0x16, 0x8e, 0xb2, 0x72, 0x74, 0xf4, 0x89, 0x43, 0xda, 0x46, 0x0d, 0x0a, 0xac, 0x1e,
+	0x6c, 0x7a, 0xdb, 0x47, 0x3c, 0xb8, 0xcb, 0x27, 0xc6, 0x71, 0xbf, 0xe3, 0x1d, 0xf3, 0x2a, 0x79,
+	0x8e, 0xfd, 0xa0, 0x71, 0xdb, 0x47, 0x56, 0xbe, 0x25, 0x9f, 0x8c, 0x43, 0x98, 0xe7, 0x95, 0x52,
+	0x9e, 0xdf, 0xcd, 0x1c, 0x15, 0x4f, 0x56, 0xb9, 0xb0, 0xac, 0x9d, 0x2b, 0xf6, 0x63, 0x09, 0x3e,
+	0x73, 0x84, 0xcc, 0x1c, 0xa6, 0x35, 0x27, 0x1c, 0xc1, 0x29, 0x5c, 0xf1, 0x26, 0xe4, 0xe9, 0x48,
+	0xd4, 0x56, 0x79, 0x41, 0x80, 0xa5, 0xa3, 0x0b, 0xb6, 0x4c, 0xf3, 0x51, 0xd2, 0x07, 0xca, 0x47,
+	0xff, 0xcc, 0xc0, 0xda, 0x98, 0x7f, 0x39, 0xa0, 0x77, 0x4e, 0x8a, 0x3a, 0x23, 0x13, 0x77, 0xc6,
+	0x1a, 0xcc, 0x07, 0x38, 0x68, 0x21, 0xee, 0xa4, 0xac, 0x25, 0x16, 0x7a, 0x17, 0x29, 0x8b, 0xb3,
+	0xff, 0x95, 0xc5, 0xf3, 0xd3, 0x2c, 0xce, 0xc5, 0x2c, 0x8e, 0x44, 0x74, 0x21, 0x1a, 0xd1, 0xb8,
+	0x27, 0x7e, 0x08, 0xdb, 0x69, 0x96, 0x8e, 0x5d, 0xc1, 0xd4, 0xfb, 0xc4, 0x0e, 0x51, 0x0f, 0x0f,
+	0x91, 0xb0, 0x39, 0x6f, 0x15, 0x7c, 0x62, 0x09, 0xc2, 0xfe, 0x6f, 0x60, 0xb3, 0x4e, 0x3c, 0xb1,
+	0x3a, 0x0f, 0x71, 0x2f, 0xe6, 0xad, 0x0f, 0x48, 0x29, 0xe5, 0xc5, 0xb9, 0x88, 0x17, 0xe3, 0x88,
+	0x1f, 0xc1, 0x9e, 0x56, 0xa5, 0x8a, 0xe0, 0xdb, 0x0c, 0xac, 0xd4, 0x89, 0xf7, 0x19, 0xa6, 0xe8,
+	0xa5, 0x43, 0xae, 0x42, 0xbf, 0x85, 0x66, 0x04, 0xaf, 0xcf, 0x64, 0xc6, 0xc1, 0xe3, 0x0b, 0x63,
+	0x0f, 0x16, 0x85, 0xeb, 0x83, 0x41, 0xaf, 0x89, 0x42, 0x89, 0xa9, 0xc8, 0x69, 0xaf, 0x39, 0x89,
+	0x9b, 0x38, 0xe8, 0xf7, 0xbb, 0xb7, 0xe5, 0xac, 0x34, 0x91, 0xaf, 0x22, 0xa6, 0xcf, 0xc7, 0x4c,
+	0xdf, 0x83, 0xc5, 0x7e, 0xe8, 0xe3, 0xd0, 0xa7, 0xb7, 0xac, 0xcb, 0xf3, 0x90, 0x65, 0xad, 0xe2,
+	0x98, 0x76, 0x8e, 0x38, 0xcc, 0xa6, 0x43, 0x10, 0x67, 0x2f, 0x70, 0xf6, 0x02, 0x5b, 0x9f, 0xa3,
+	0x84, 0x1f, 0x36, 0xa1, 0x94, 0xb0, 0x50, 0x59, 0xff, 0xbb, 0x79, 0xd8, 0x92, 0xbc, 0xcb, 0xe0,
+	0xb2, 0x49, 0x50, 0x38, 0x44, 0xee, 0xe5, 0x80, 0xca, 0xee, 0xa9, 0x0d, 0xcc, 0x16, 0x14, 0xd8,
+	0x9d, 0x21, 0x52, 0x4d, 0x74, 0x99, 0x3c, 0x23, 0xf0, 0x4c, 0x7b, 0x0a, 0x39, 0x42, 0x1d, 0x3a,
+	0x20, 0xdc, 0x0f, 0xcb, 0x27, 0x9b, 0xa2, 0xa2, 0xc5, 0x75, 0x68, 0xa1, 0x16, 0xf2, 0x87, 0xe8,
+	0x9a, 0x0b, 0x58, 0x52, 0xd0, 0xd8, 0x85, 0x45, 0x3c, 0xa0, 0x36, 0x1d, 0xd9, 0x5c, 0x8c, 0xfb,
+	0x68, 0xce, 0x02, 0xcc, 0x22, 0xf6, 0x9c, 0x51, 0x8c, 0x43, 0x78, 0x20, 0x25, 0x58, 0x7f, 0x13,
+	0xa1, 0x9f, 0x17, 0xb7, 0x01, 0x97, 0x6a, 0x10, 0xf2, 0x9a, 0x17, 0x52, 0x0d, 0xd6, 0xb0, 0x34,
+	0xc4, 0x96, 0x3b, 0x22, 0x2d, 0x60, 0x15, 0xdf, 0x19, 0xd9, 0x10, 0x05, 0xf6, 0x23, 0xd8, 0x4e,
+	0x6e, 0x88, 0xdd, 0x39, 0xc2, 0xb5, 0xe5, 0xd8, 0xc6, 0xe8, 0x35, 0xf5, 0x0c, 0xca, 0xc9, 0xfd,
+	0x9e, 0x43, 0xec, 0x01, 0x41, 0x6e, 0x39, 0xcf, 0xf7, 0xae, 0xc5, 0xf6, 0xbe, 0x74, 0xc8, 0x1b,
+	0x82, 0x5c, 0xe3, 0xd7, 0xf0, 0x28, 0xb9, 0x0f, 0xdd, 0xdc, 0xa0, 0x16, 0xf5, 0x87, 0x88, 0x9f,
+	0x20, 0x12, 0xad, 0xc0, 0x6f, 0x96, 0x8a, 0xbc, 0x59, 0xd6, 0x27, 0x6f, 0x96, 0x8b, 0x80, 0x5a,
+	0x3b, 0x31, 0x0d, 0x2f, 0xc6, 0xc7, 0xa8, 0x8c, 0xfe, 0xe9, 0x2c, 0x5d, 0x7c, 0x2c, 0x28, 0x03,
+	0x87, 0xab, 0x3f, 0xec, 0x15, 0x93, 0x32, 0x5e, 0xc2, 0x5e, 0xf2, 0xb0, 0x1b, 0xc7, 0xef, 0x22,
+	0xd7, 0x0e, 0x91, 0x43, 0x70, 0x60, 0xf7, 0x88, 0x57, 0x2e, 0x72, 0x77, 0x6f, 0xc7, 0x8e, 0x3a,
+	0xe7, 0x52, 0x16, 0x17, 0xaa, 0x13, 0x2f, 0x9e, 0xa5, 0x07, 0xf0, 0x68, 0x4a, 0x26, 0xaa, 0x8c,
+	0xfd, 0xd3, 0x1c, 0x98, 0x13, 0x72, 0x17, 0x41, 0x34, 0x61, 0x51, 0xe0, 0x46, 0x12, 0x96, 0xaf,
+	0xd8, 0x2c, 0x21, 0x9e, 0xec, 0x44, 0x43, 0x59, 0x12, 0xe4, 0xe7, 0xb2, 0xbe, 0x4d, 0xc8, 0x87,
+	0x22, 0x43, 0x43, 0xd9, 0x87, 0xd5, 0xda, 0x38, 0x80, 0xe5, 0xf1, 0x73, 0x2c, 0x4d, 0x97, 0xc6,
+	0x54, 0x91, 0xa9, 0xdb, 0x00, 0x7e, 0xa0, 0xd2, 0x4e, 0x54, 0x75, 0xde, 0x0f, 0x64, 0xb6, 0x3d,
+	0x86, 0x15, 0x3f, 0x88, 0x27, 0x98, 0x28, 0xed, 0x25, 0x3f, 0x88, 0x66, 0xd5, 0x16, 0x14, 0xee,
+	0xe2, 0x22, 0x52, 0x30, 0xef, 0x8d, 0x23, 0xb0, 0x05, 0x05, 0x3a, 0xb2, 0x71, 0xe8, 0x7b, 0x7e,
+	0xc0, 0x73, 0xac, 0x60, 0xe5, 0xe9, 0xe8, 0x92, 0xaf, 0x8d, 0x2a, 0x14, 0xf9, 0xec, 0x27, 0x7b,
+	0x7d, 0x81, 0xef, 0x05, 0x4e, 0x12, 0xed, 0xbe, 0x06, 0x0b, 0x7c, 0x42, 0xa4, 0x23, 0x1e, 0xf0,
+	0xe2, 0xc9, 0xc6, 0x31, 0xab, 0xdd, 0x63, 0xe9, 0xc5, 0x2b, 0xd4, 0xed, 0xbe, 0x60, 0xd2, 0x56,
+	0x8e, 0x89, 0xc5, 0xba, 0x40, 0x51, 0x7f, 0x3f, 0x7c, 0x04, 0xfb, 0xfa, 0xb8, 0xa8, 0xf0, 0xfd,
+	0xf9, 0x3e, 0xac, 0xd6, 0x89, 0x77, 0x15, 0xe2, 0x21, 0x8a, 0x47, 0x2d, 0xad, 0xcd, 0xfc, 0x18,
+	0x16, 0x7c, 0x21, 0xc4, 0xa3, 0x55, 0x3c, 0xd9, 0x15, 0x48, 0xf5, 0x8a, 0xce, 0xb2, 0xac, 0x50,
+	0xac, 0xf1, 0xb6, 0xc4, 0xa5, 0x38, 0x37, 0xed, 0x52, 0xcc, 0xc6, 0x2f, 0xc5, 0xef, 0x72, 0xd6,
+	0x8c, 0x61, 0x63, 0x81, 0x8e, 0xf8, 0x83, 0xf1, 0x0c, 0x44, 0x16, 0xf4, 0xa9, 0xdc, 0x92, 0xd3,
+	0x6d, 0x59, 0x94, 0x72, 0x7c, 0x15, 0x77, 0xe1, 0x16, 0x6c, 0x4e, 0xf8, 0x46, 0x79, 0xee, 0x67,
+	0xdc, 0x71, 0xa7, 0x4d, 0x1c, 0xd2, 0x6b, 0x3a, 0x68, 0x75, 0x7e, 0xde, 0x23, 0xde, 0xb4, 0x31,
+	0x90, 0xf7, 0x67, 0x61, 0x99, 0x1c, 0x03, 0x19, 0x25, 0x65, 0xc4, 0x11, 0x5a, 0xe3, 0x07, 0x2b,
+	0xad, 0xbf, 0x15, 0x03, 0x8e, 0x85, 0x6e, 0x06, 0x81, 0xcb, 0x65, 0x90, 0xfb, 0x3f, 0x68, 0x16,
+	0x35, 0xc4, 0xce, 0xb2, 0x1d, 0x31, 0x6e, 0xca, 0x98, 0x2c, 0x09, 0xaa, 0x9c, 0x41, 0xe3, 0x00,
+	0x77, 0x60, 0x3b, 0x0d, 0x42, 0x74, 0x9e, 0xdf, 0x54, 0x83, 0xac, 0xe5, 0x50, 0xf4, 0x4a, 0xbc,
+	0xe3, 0x9c, 0xb3, 0x57, 0x1c, 0x2d, 0xd0, 0x4f, 0xc1, 0x98, 0x7c, 0x21, 0x2a, 0xdf, 0x8f, 0x16,
+	0x44, 0xf2, 0x2c, 0x99, 0x5c, 0x0f, 0xc2, 0x04, 0x3d, 0x6d, 0xec, 0x48, 0x47, 0xa3, 0x30, 0xf7,
+	0xe0, 0xa1, 0x4c, 0x62, 0x19, 0x69, 0x5e, 0xfb, 0x5a, 0xb0, 0x4f, 0x41, 0x0c, 0x13, 0x32, 0xb9,
+	0x04, 0xca, 0x07, 0x02, 0x25, 0xdf, 0x29, 0x72, 0x0b, 0x9a, 0xea, 0x39, 0x8e, 0xe9, 0x10, 0x0e,
+	0x74, 0x35, 0xc3, 0xb7, 0x2b, 0x5c, 0x9f, 0xf3, 0x59, 0xe1, 0xd4, 0x75, 0x4f, 0xbb, 0x5d, 0xfc,
+	0x85, 0x70, 0xf4, 0xb5, 0x68, 0xa1, 0x3a, 0x6c, 0x26, 0xe4, 0x9b, 0x03, 0xbf, 0xeb, 0xa2, 0x90,
+	0xb9, 0x6f, 0x8e, 0xf5, 0xa2, 0xf1, 0x3a, 0xed, 0xd5, 0x2c, 0xed, 0x6c, 0xa5, 0xfe, 0x57, 0x60,
+	0xaa, 0x91, 0xed, 0xff, 0x80, 0x40, 0xf4, 0x28, 0xcd, 0xf1, 0x0a, 0xc4, 0x10, 0xd6, 0x95, 0xb3,
+	0x58, 0x33, 0xb4, 0xe4, 0x8b, 0xf1, 0xb4, 0xb9, 0xb0, 0x02, 0x30, 0xc4, 0x14, 0x45, 0xd2, 0x3e,
+	0x6b, 0x15, 0x86, 0x3c, 0xbc, 0xf1, 0x09, 0x7b, 0x4e, 0xdf, 0x41, 0xab, 0x50, 0x49, 0xd5, 0xab,
+	0x80, 0x0d, 0x60, 0x4d, 0x09, 0xbc, 0x74, 0xc8, 0xb7, 0x85, 0x4b, 0xd4, 0xdf, 0x84, 0x5a, 0x05,
+	0xeb, 0x8f, 0x19, 0x2e, 0xf0, 0xa6, 0x4f, 0x50, 0x48, 0x9f, 0xab, 0x8f, 0x13, 0xe7, 0x08, 0x5d,
+	0xb1, 0x4f, 0x13, 0xfa, 0x12, 0x7c, 0x05, 0xeb, 0x69, 0xdf, 0x32, 0x44, 0x10, 0x8b, 0x27, 0x65,
+	0x91, 0xdf, 0x93, 0x27, 0x5a, 0x0f, 0x5b, 0x93, 0x5a, 0xe2, 0x98, 0x1f, 0xc3, 0x47, 0xd3, 0x20,
+	0x29, 0xec, 0x21, 0x94, 0xee, 0x8a, 0x55, 0x1a, 0x76, 0x36, 0x70, 0x3d, 0x44, 0xb5, 0xa8, 0x4f,
+	0x20, 0xd7, 0xe4, 0x12, 0xb2, 0x0c, 0xd7, 0x64, 0xb3, 0x88, 0xed, 0x96, 0xad, 0x42, 0x4a, 0xa6,
+	0xd5, 0x41, 0x9a, 0xce, 0xa8, 0x4b, 0x4b, 0x2a, 0x53, 0xff, 0x43, 0x5c, 0x53, 0xbf, 0x53, 0x00,
+	0xc5, 0x1d, 0x24, 0xdf, 0xb3, 0xc5, 0x54, 0x5e, 0x8a, 0xc3, 0x6e, 0x30, 0x3e, 0x7f, 0xd9, 0x2e,
+	0xd0, 0xf1, 0x63, 0x1a, 0xec, 0x34, 0x48, 0x63, 0xd8, 0x27, 0xff, 0x5a, 0x86, 0xb9, 0x3a, 0xf1,
+	0x8c, 0x6b, 0x58, 0x9d, 0x7c, 0x25, 0x36, 0xd5, 0xdd, 0x3d, 0xc1, 0x33, 0xf7, 0xf5, 0x3c, 0xf5,
+	0x82, 0x79, 0x05, 0x0f, 0x26, 0xbe, 0x45, 0x6c, 0xc6, 0xf7, 0x45, 0x58, 0xe6, 0x9e, 0x96, 0xa5,
+	0x4e, 0x6c, 0xc2, 0x86, 0xe6, 0x85, 0xb4, 0xaa, 0x36, 0xa7, 0x0b, 0x98, 0x87, 0x33, 0x04, 0x94,
+	0x8e, 0x9f, 0xc0, 0x62, 0xec, 0xdd, 0x72, 0x3d, 0x36, 0xc1, 0x8c, 0xc9, 0x66, 0x25, 0x95, 0xac,
+	0x4e, 0x69, 0x43, 0x59, 0xfb, 0x8e, 0xb6, 0xa7, 0x99, 0x89, 0xee, 0x44, 0xcc, 0xef, 0xcc, 0x14,
+	0x51, 0x9a, 0x5c, 0x30, 0xf5, 0xd7, 0x44, 0xc4, 0xdf, 0xc9, 0xab, 0xcb, 0xfc, 0x78, 0xfa, 0x68,
+	0x16, 0xbb, 0x66, 0x0c, 0x04, 0x25, 0xdd, 0x04, 0x3f, 0x73, 0xc4, 0x33, 0x8f, 0x66, 0x49, 0x28,
+	0x35, 0x9f, 0xc2, 0x72, 0x62, 0xd2, 0x2c, 0xa9, 0xbd, 0x71, 0x86, 0x59, 0xd5, 0x30, 0xd4, 0x59,
+	0x9f, 0x81, 0x91, 0x72, 0x25, 0x6c, 0x25, 0xb0, 0x44, 0x99, 0xe6, 0xa3, 0x29, 0x4c, 0x75, 0xee,
+	0x35, 0xac, 0x4e, 0x76, 0x74, 0x33, 0xb1, 0x33, 0xc2, 0x33, 0xf7, 0xf5, 0xbc, 0x68, 0xad, 0x4c,
+	0x7c, 0x32, 0xbc, 0x8b, 0x5d, 0x92, 0x65, 0xee, 0x69, 0x59, 0xea, 0xc4, 0x3a, 0xac, 0x24, 0x3f,
+	0x98, 0x96, 0xd5, 0xae, 0x04, 0xc7, 0xdc, 0xd5, 0x71, 0xa2, 0x91, 0x49, 0x8c, 0xb2, 0x77, 0x91,
+	0x89, 0x33, 0xcc, 0xaa, 0x86, 0x11, 0xf5, 0xe0, 0xe4, 0x7c, 0x6a, 0x46, 0x0a, 0x34, 0xc1, 0x33,
+	0xf7, 0xf5, 0xbc, 0x68, 0x6f, 0xd0, 0x0c, 0x94, 0xd5, 0x84, 0xb3, 0x92, 0x02, 0xe6, 0xe1, 0x0c,
+	0x01, 0xa5, 0xe3, 0x97, 0xb0, 0x96, 0x3a, 0x69, 0x55, 0xa2, 0xad, 0x6b, 0x82, 0x6d, 0x1e, 0x4c,
+	0x65, 0x47, 0x6b, 0x4c, 0x37, 0x48, 0xed, 0x26, 0xba, 0xd7, 0xa4, 0x8e, 0xa3, 0x59, 0x12, 0x51,
+	0x23, 0x52, 0xff, 0x39, 0x70, 0x67, 0x44, 0x1a, 0xdb, 0x3c, 0x98, 0xca, 0x56, 0xa7, 0x77, 0x60,
+	0x53, 0x3f, 0x57, 0xec, 0x47, 0x1c, 0xad, 0x91, 0x31, 0x9f, 0xcc, 0x96, 0x89, 0x9a, 0x92, 0x3a,
+	0x09, 0x54, 0x92, 0x01, 0x8d, 0xb1, 0xcd, 0x83, 0xa9, 0xec, 0xe8, 0xe9, 0xa9, 0xf7, 0x79, 0x25,
+	0xe1, 0x6a, 0xed, 0xe9, 0xd3, 0xae, 0xde, 0xb3, 0x17, 0x5f, 0xbd, 0xdb, 0xc9, 0x7c, 0xfd, 0x6e,
+	0x27, 0xf3, 0xf6, 0xdd, 0x4e, 0xe6, 0x0f, 0xef, 0x77, 0xee, 0x7d, 0xfd, 0x7e, 0xe7, 0xde, 0xdf,
+	0xde, 0xef, 0xdc, 0xfb, 0xfc, 0x63, 0xcf, 0xa7, 0xed, 0x41, 0xf3, 0xb8, 0x85, 0x7b, 0xb5, 0xef,
+	0x8d, 0x58, 0x0f, 0x7a, 0x8d, 0xe8, 0x17, 0x38, 0xec, 0xd4, 0x1c, 0xe4, 0xf9, 0xa4, 0x36, 0xaa,
+	0x89, 0x7f, 0x7c, 0xdd, 0xf6, 0x11, 0x69, 0xe6, 0xf8, 0x7f, 0x7d, 0xbe, 0xff, 0xef, 0x01, 0x00,
+	0xf3, 0x76, 0x06, 0xf9, 0x0d, 0x1b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2143,6 +2491,8 @@ type MsgClient interface {
 	VoteOnObservedInboundBlock(ctx context.Context, in *MsgVoteInboundBlock, opts ...grpc.CallOption) (*MsgVoteOnObservedInboundBlockResponse, error)
 	// vote on observed inbound tx
 	VoteOnObservedInboundTx(ctx context.Context, in *MsgVoteOnObservedInboundTx, opts ...grpc.CallOption) (*MsgVoteOnObservedInboundTxResponse, error)
+	// prove observed inbound tx with tx and receipt inclusion proofs
+	ProveInboundTx(ctx context.Context, in *MsgProveInboundTx, opts ...grpc.CallOption) (*MsgProveInboundTxResponse, error)
 	// vote on observer recharge pell token
 	VoteOnPellRecharge(ctx context.Context, in *MsgVoteOnPellRecharge, opts ...grpc.CallOption) (*MsgVoteOnPellRechargeResponse, error)
 	// vote on observer recharge gas token
@@ -2153,6 +2503,8 @@ type MsgClient interface {
 	MigrateTssFunds(ctx context.Context, in *MsgMigrateTssFunds, opts ...grpc.CallOption) (*MsgMigrateTssFundsResponse, error)
 	// abort stuck xmsg
 	AbortStuckXmsg(ctx context.Context, in *MsgAbortStuckXmsg, opts ...grpc.CallOption) (*MsgAbortStuckXmsgResponse, error)
+	// refund aborted xmsg
+	RefundAbortedXmsg(ctx context.Context, in *MsgRefundAbortedXmsg, opts ...grpc.CallOption) (*MsgRefundAbortedXmsgResponse, error)
 	// update rate limiter flags
 	UpdateRateLimiterFlags(ctx context.Context, in *MsgUpdateRateLimiterFlags, opts ...grpc.CallOption) (*MsgUpdateRateLimiterFlagsResponse, error)
 	// add allowed xmsg sender
@@ -2163,6 +2515,10 @@ type MsgClient interface {
 	InboundTxMaintenance(ctx context.Context, in *MsgInboundTxMaintenance, opts ...grpc.CallOption) (*MsgInboundTxMaintenanceResponse, error)
 	// UpsertCrosschainFeeParams upserts crosschain fee params
 	UpsertCrosschainFeeParams(ctx context.Context, in *MsgUpsertCrosschainFeeParams, opts ...grpc.CallOption) (*MsgUpsertCrosschainFeeParamsResponse, error)
+	// update the recharge budget of a token on a chain
+	UpdateRechargeBudget(ctx context.Context, in *MsgUpdateRechargeBudget, opts ...grpc.CallOption) (*MsgUpdateRechargeBudgetResponse, error)
+	// remove the recharge budget of a token on a chain
+	RemoveRechargeBudget(ctx context.Context, in *MsgRemoveRechargeBudget, opts ...grpc.CallOption) (*MsgRemoveRechargeBudgetResponse, error)
 }
 
 type msgClient struct {
@@ -2236,6 +2592,15 @@ func (c *msgClient) VoteOnObservedInboundTx(ctx context.Context, in *MsgVoteOnOb
 	return out, nil
 }
 
+func (c *msgClient) ProveInboundTx(ctx context.Context, in *MsgProveInboundTx, opts ...grpc.CallOption) (*MsgProveInboundTxResponse, error) {
+	out := new(MsgProveInboundTxResponse)
+	err := c.cc.Invoke(ctx, "/xmsg.Msg/ProveInboundTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) VoteOnPellRecharge(ctx context.Context, in *MsgVoteOnPellRecharge, opts ...grpc.CallOption) (*MsgVoteOnPellRechargeResponse, error) {
 	out := new(MsgVoteOnPellRechargeResponse)
 	err := c.cc.Invoke(ctx, "/xmsg.Msg/VoteOnPellRecharge", in, out, opts...)
@@ -2281,6 +2646,15 @@ func (c *msgClient) AbortStuckXmsg(ctx context.Context, in *MsgAbortStuckXmsg, o
 	return out, nil
 }
 
+func (c *msgClient) RefundAbortedXmsg(ctx context.Context, in *MsgRefundAbortedXmsg, opts ...grpc.CallOption) (*MsgRefundAbortedXmsgResponse, error) {
+	out := new(MsgRefundAbortedXmsgResponse)
+	err := c.cc.Invoke(ctx, "/xmsg.Msg/RefundAbortedXmsg", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateRateLimiterFlags(ctx context.Context, in *MsgUpdateRateLimiterFlags, opts ...grpc.CallOption) (*MsgUpdateRateLimiterFlagsResponse, error) {
 	out := new(MsgUpdateRateLimiterFlagsResponse)
 	err := c.cc.Invoke(ctx, "/xmsg.Msg/UpdateRateLimiterFlags", in, out, opts...)
@@ -2326,6 +2700,24 @@ func (c *msgClient) UpsertCrosschainFeeParams(ctx context.Context, in *MsgUpsert
 	return out, nil
 }
 
+func (c *msgClient) UpdateRechargeBudget(ctx context.Context, in *MsgUpdateRechargeBudget, opts ...grpc.CallOption) (*MsgUpdateRechargeBudgetResponse, error) {
+	out := new(MsgUpdateRechargeBudgetResponse)
+	err := c.cc.Invoke(ctx, "/xmsg.Msg/UpdateRechargeBudget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveRechargeBudget(ctx context.Context, in *MsgRemoveRechargeBudget, opts ...grpc.CallOption) (*MsgRemoveRechargeBudgetResponse, error) {
+	out := new(MsgRemoveRechargeBudgetResponse)
+	err := c.cc.Invoke(ctx, "/xmsg.Msg/RemoveRechargeBudget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// add to outbound tx tracker
@@ -2342,6 +2734,8 @@ type MsgServer interface {
 	VoteOnObservedInboundBlock(context.Context, *MsgVoteInboundBlock) (*MsgVoteOnObservedInboundBlockResponse, error)
 	// vote on observed inbound tx
 	VoteOnObservedInboundTx(context.Context, *MsgVoteOnObservedInboundTx) (*MsgVoteOnObservedInboundTxResponse, error)
+	// prove observed inbound tx with tx and receipt inclusion proofs
+	ProveInboundTx(context.Context, *MsgProveInboundTx) (*MsgProveInboundTxResponse, error)
 	// vote on observer recharge pell token
 	VoteOnPellRecharge(context.Context, *MsgVoteOnPellRecharge) (*MsgVoteOnPellRechargeResponse, error)
 	// vote on observer recharge gas token
@@ -2352,6 +2746,8 @@ type MsgServer interface {
 	MigrateTssFunds(context.Context, *MsgMigrateTssFunds) (*MsgMigrateTssFundsResponse, error)
 	// abort stuck xmsg
 	AbortStuckXmsg(context.Context, *MsgAbortStuckXmsg) (*MsgAbortStuckXmsgResponse, error)
+	// refund aborted xmsg
+	RefundAbortedXmsg(context.Context, *MsgRefundAbortedXmsg) (*MsgRefundAbortedXmsgResponse, error)
 	// update rate limiter flags
 	UpdateRateLimiterFlags(context.Context, *MsgUpdateRateLimiterFlags) (*MsgUpdateRateLimiterFlagsResponse, error)
 	// add allowed xmsg sender
@@ -2362,6 +2758,10 @@ type MsgServer interface {
 	InboundTxMaintenance(context.Context, *MsgInboundTxMaintenance) (*MsgInboundTxMaintenanceResponse, error)
 	// UpsertCrosschainFeeParams upserts crosschain fee params
 	UpsertCrosschainFeeParams(context.Context, *MsgUpsertCrosschainFeeParams) (*MsgUpsertCrosschainFeeParamsResponse, error)
+	// update the recharge budget of a token on a chain
+	UpdateRechargeBudget(context.Context, *MsgUpdateRechargeBudget) (*MsgUpdateRechargeBudgetResponse, error)
+	// remove the recharge budget of a token on a chain
+	RemoveRechargeBudget(context.Context, *MsgRemoveRechargeBudget) (*MsgRemoveRechargeBudgetResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -2389,6 +2789,9 @@ func (*UnimplementedMsgServer) VoteOnObservedInboundBlock(ctx context.Context, r
 func (*UnimplementedMsgServer) VoteOnObservedInboundTx(ctx context.Context, req *MsgVoteOnObservedInboundTx) (*MsgVoteOnObservedInboundTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VoteOnObservedInboundTx not implemented")
 }
+func (*UnimplementedMsgServer) ProveInboundTx(ctx context.Context, req *MsgProveInboundTx) (*MsgProveInboundTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProveInboundTx not implemented")
+}
 func (*UnimplementedMsgServer) VoteOnPellRecharge(ctx context.Context, req *MsgVoteOnPellRecharge) (*MsgVoteOnPellRechargeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VoteOnPellRecharge not implemented")
 }
@@ -2404,6 +2807,9 @@ func (*UnimplementedMsgServer) MigrateTssFunds(ctx context.Context, req *MsgMigr
 func (*UnimplementedMsgServer) AbortStuckXmsg(ctx context.Context, req *MsgAbortStuckXmsg) (*MsgAbortStuckXmsgResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AbortStuckXmsg not implemented")
 }
+func (*UnimplementedMsgServer) RefundAbortedXmsg(ctx context.Context, req *MsgRefundAbortedXmsg) (*MsgRefundAbortedXmsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundAbortedXmsg not implemented")
+}
 func (*UnimplementedMsgServer) UpdateRateLimiterFlags(ctx context.Context, req *MsgUpdateRateLimiterFlags) (*MsgUpdateRateLimiterFlagsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateRateLimiterFlags not implemented")
 }
@@ -2419,6 +2825,12 @@ func (*UnimplementedMsgServer) InboundTxMaintenance(ctx context.Context, req *Ms
 func (*UnimplementedMsgServer) UpsertCrosschainFeeParams(ctx context.Context, req *MsgUpsertCrosschainFeeParams) (*MsgUpsertCrosschainFeeParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpsertCrosschainFeeParams not implemented")
 }
+func (*UnimplementedMsgServer) UpdateRechargeBudget(ctx context.Context, req *MsgUpdateRechargeBudget) (*MsgUpdateRechargeBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRechargeBudget not implemented")
+}
+func (*UnimplementedMsgServer) RemoveRechargeBudget(ctx context.Context, req *MsgRemoveRechargeBudget) (*MsgRemoveRechargeBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRechargeBudget not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -2550,6 +2962,24 @@ func _Msg_VoteOnObservedInboundTx_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ProveInboundTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProveInboundTx)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProveInboundTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xmsg.Msg/ProveInboundTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProveInboundTx(ctx, req.(*MsgProveInboundTx))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_VoteOnPellRecharge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgVoteOnPellRecharge)
 	if err := dec(in); err != nil {
@@ -2640,6 +3070,24 @@ func _Msg_AbortStuckXmsg_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RefundAbortedXmsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRefundAbortedXmsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RefundAbortedXmsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xmsg.Msg/RefundAbortedXmsg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RefundAbortedXmsg(ctx, req.(*MsgRefundAbortedXmsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateRateLimiterFlags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateRateLimiterFlags)
 	if err := dec(in); err != nil {
@@ -2730,6 +3178,42 @@ func _Msg_UpsertCrosschainFeeParams_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateRechargeBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateRechargeBudget)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateRechargeBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xmsg.Msg/UpdateRechargeBudget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateRechargeBudget(ctx, req.(*MsgUpdateRechargeBudget))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveRechargeBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveRechargeBudget)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveRechargeBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xmsg.Msg/RemoveRechargeBudget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveRechargeBudget(ctx, req.(*MsgRemoveRechargeBudget))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "xmsg.Msg",
@@ -2763,6 +3247,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "VoteOnObservedInboundTx",
 			Handler:    _Msg_VoteOnObservedInboundTx_Handler,
 		},
+		{
+			MethodName: "ProveInboundTx",
+			Handler:    _Msg_ProveInboundTx_Handler,
+		},
 		{
 			MethodName: "VoteOnPellRecharge",
 			Handler:    _Msg_VoteOnPellRecharge_Handler,
@@ -2783,6 +3271,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AbortStuckXmsg",
 			Handler:    _Msg_AbortStuckXmsg_Handler,
 		},
+		{
+			MethodName: "RefundAbortedXmsg",
+			Handler:    _Msg_RefundAbortedXmsg_Handler,
+		},
 		{
 			MethodName: "UpdateRateLimiterFlags",
 			Handler:    _Msg_UpdateRateLimiterFlags_Handler,
@@ -2803,6 +3295,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpsertCrosschainFeeParams",
 			Handler:    _Msg_UpsertCrosschainFeeParams_Handler,
 		},
+		{
+			MethodName: "UpdateRechargeBudget",
+			Handler:    _Msg_UpdateRechargeBudget_Handler,
+		},
+		{
+			MethodName: "RemoveRechargeBudget",
+			Handler:    _Msg_RemoveRechargeBudget_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "xmsg/tx.proto",
@@ -3285,6 +3785,16 @@ func (m *MsgVoteGasPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BaseFee != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BaseFee))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.PriorityFee != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PriorityFee))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.Signer) > 0 {
 		i -= len(m.Signer)
 		copy(dAtA[i:], m.Signer)
@@ -3572,6 +4082,105 @@ func (m *MsgVoteOnObservedInboundTxResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgProveInboundTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProveInboundTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProveInboundTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReceiptProof != nil {
+		{
+			size, err := m.ReceiptProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.TxProof != nil {
+		{
+			size, err := m.TxProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TxIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Inbound.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProveInboundTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProveInboundTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProveInboundTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgAbortStuckXmsg) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4144,65 +4753,191 @@ func (m *MsgUpsertCrosschainFeeParamsResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdateRechargeBudget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgInboundTxMaintenance) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgUpdateRechargeBudget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRechargeBudget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ChainId != 0 {
-		n += 1 + sovTx(uint64(m.ChainId))
-	}
-	if m.FromBlockHeight != 0 {
-		n += 1 + sovTx(uint64(m.FromBlockHeight))
+	{
+		size, err := m.Budget.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.ToBlockHeight != 0 {
-		n += 1 + sovTx(uint64(m.ToBlockHeight))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgInboundTxMaintenanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateRechargeBudgetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateRechargeBudgetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRechargeBudgetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgMigrateTssFunds) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRemoveRechargeBudget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveRechargeBudget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveRechargeBudget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.TokenType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TokenType))
+		i--
+		dAtA[i] = 0x18
 	}
 	if m.ChainId != 0 {
-		n += 1 + sovTx(uint64(m.ChainId))
+		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveRechargeBudgetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveRechargeBudgetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveRechargeBudgetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgInboundTxMaintenance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovTx(uint64(m.ChainId))
+	}
+	if m.FromBlockHeight != 0 {
+		n += 1 + sovTx(uint64(m.FromBlockHeight))
+	}
+	if m.ToBlockHeight != 0 {
+		n += 1 + sovTx(uint64(m.ToBlockHeight))
+	}
+	return n
+}
+
+func (m *MsgInboundTxMaintenanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMigrateTssFunds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovTx(uint64(m.ChainId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
 
 func (m *MsgMigrateTssFundsResponse) Size() (n int) {
 	if m == nil {
@@ -4379,6 +5114,12 @@ func (m *MsgVoteGasPrice) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.PriorityFee != 0 {
+		n += 1 + sovTx(uint64(m.PriorityFee))
+	}
+	if m.BaseFee != 0 {
+		n += 1 + sovTx(uint64(m.BaseFee))
+	}
 	return n
 }
 
@@ -4502,6 +5243,45 @@ func (m *MsgVoteOnObservedInboundTxResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgProveInboundTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Inbound.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TxIndex != 0 {
+		n += 1 + sovTx(uint64(m.TxIndex))
+	}
+	if m.TxProof != nil {
+		l = m.TxProof.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ReceiptProof != nil {
+		l = m.ReceiptProof.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgProveInboundTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgAbortStuckXmsg) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4748,6 +5528,58 @@ func (m *MsgUpsertCrosschainFeeParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateRechargeBudget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Budget.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateRechargeBudgetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveRechargeBudget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovTx(uint64(m.ChainId))
+	}
+	if m.TokenType != 0 {
+		n += 1 + sovTx(uint64(m.TokenType))
+	}
+	return n
+}
+
+func (m *MsgRemoveRechargeBudgetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6210,6 +7042,44 @@ func (m *MsgVoteGasPrice) Unmarshal(dAtA []byte) error {
 			}
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityFee", wireType)
+			}
+			m.PriorityFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriorityFee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			m.BaseFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7048,7 +7918,7 @@ func (m *MsgVoteOnObservedInboundTxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAbortStuckXmsg) Unmarshal(dAtA []byte) error {
+func (m *MsgProveInboundTx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7071,10 +7941,10 @@ func (m *MsgAbortStuckXmsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAbortStuckXmsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgProveInboundTx: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAbortStuckXmsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgProveInboundTx: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7111,7 +7981,40 @@ func (m *MsgAbortStuckXmsg) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field XmsgIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Inbound", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inbound.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7139,24 +8042,279 @@ func (m *MsgAbortStuckXmsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XmsgIndex = string(dAtA[iNdEx:postIndex])
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
+			m.TxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TxProof == nil {
+				m.TxProof = &proofs.Proof{}
+			}
+			if err := m.TxProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReceiptProof == nil {
+				m.ReceiptProof = &proofs.Proof{}
+			}
+			if err := m.ReceiptProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProveInboundTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProveInboundTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProveInboundTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAbortStuckXmsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAbortStuckXmsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAbortStuckXmsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XmsgIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XmsgIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
@@ -8575,6 +9733,341 @@ func (m *MsgUpsertCrosschainFeeParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateRechargeBudget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateRechargeBudget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateRechargeBudget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Budget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Budget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateRechargeBudgetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateRechargeBudgetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateRechargeBudgetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveRechargeBudget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveRechargeBudget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveRechargeBudget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
+			}
+			m.TokenType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TokenType |= RechargeTokenType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveRechargeBudgetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveRechargeBudgetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveRechargeBudgetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -11,15 +11,16 @@ import (
 	relayertypes "github.com/0xPellNetwork/aegis/x/relayer/types"
 )
 
-// VerifyInTxBody validates the tx body for a inbound tx
+// VerifyInTxBody validates the tx body for a inbound tx, the chain is the sender chain of the chain registry
 func VerifyInTxBody(
 	msg MsgAddToInTxTracker,
+	chain chains.Chain,
 	txBytes []byte,
 	chainParams relayertypes.ChainParams,
 	tss relayertypes.QueryGetTssAddressResponse,
 ) error {
 	// verify message against transaction body
-	if chains.IsEVMChain(msg.ChainId) {
+	if chain.IsEVMChain() {
 		return verifyInTxBodyEVM(msg, txBytes, chainParams, tss)
 	}
 
@@ -58,10 +59,15 @@ func verifyInTxBodyEVM(
 	return nil
 }
 
-// VerifyOutTxBody verifies the tx body for a outbound tx
-func VerifyOutTxBody(msg MsgAddToOutTxTracker, txBytes []byte, tss relayertypes.QueryGetTssAddressResponse) error {
+// VerifyOutTxBody verifies the tx body for a outbound tx, the chain is the receiver chain of the chain registry
+func VerifyOutTxBody(
+	msg MsgAddToOutTxTracker,
+	chain chains.Chain,
+	txBytes []byte,
+	tss relayertypes.QueryGetTssAddressResponse,
+) error {
 	// verify message against transaction body
-	if chains.IsEVMChain(msg.ChainId) {
+	if chain.IsEVMChain() {
 		return verifyOutTxBodyEVM(msg, txBytes, tss.Eth)
 	}
 	return fmt.Errorf("cannot verify outTx body for chain %d", msg.ChainId)
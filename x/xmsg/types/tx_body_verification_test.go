@@ -85,7 +85,8 @@ func TestVerifyInTxBody(t *testing.T) {
 		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
-			err := types.VerifyInTxBody(tc.msg, tc.txBytes, tc.chainParams, tc.tss)
+			chain, _ := chains.GetChainByChainId(tc.msg.ChainId)
+			err := types.VerifyInTxBody(tc.msg, chain, tc.txBytes, tc.chainParams, tc.tss)
 			if tc.errContains == "" {
 				require.NoError(t, err)
 			} else {
@@ -207,7 +208,8 @@ func TestVerifyOutTxBody(t *testing.T) {
 		// TODO: Implement tests for verifyOutTxBodyBTC
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
-			err := types.VerifyOutTxBody(tc.msg, tc.txBytes, tc.tss)
+			chain, _ := chains.GetChainByChainId(tc.msg.ChainId)
+			err := types.VerifyOutTxBody(tc.msg, chain, tc.txBytes, tc.tss)
 			if tc.errContains == "" {
 				require.NoError(t, err)
 			} else {
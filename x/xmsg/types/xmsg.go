@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"fmt"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	relayertypes "github.com/0xPellNetwork/aegis/x/relayer/types"
@@ -124,28 +125,50 @@ func (m *Xmsg) AddOutbound(ctx sdk.Context, msg MsgVoteOnObservedOutboundTx, bal
 }
 
 // SetAbort sets the Xmsg status to Aborted with the given error message.
-func (m Xmsg) SetAbort(message string) {
-	m.XmsgStatus.ChangeStatus(XmsgStatus_ABORTED, message)
+func (m Xmsg) SetAbort(ctx sdk.Context, message string) {
+	m.changeStatus(ctx, XmsgStatus_ABORTED, message)
 }
 
 // SetPendingRevert sets the Xmsg status to PendingRevert with the given error message.
-func (m Xmsg) SetPendingRevert(message string) {
-	m.XmsgStatus.ChangeStatus(XmsgStatus_PENDING_REVERT, message)
+func (m Xmsg) SetPendingRevert(ctx sdk.Context, message string) {
+	m.changeStatus(ctx, XmsgStatus_PENDING_REVERT, message)
 }
 
 // SetPendingOutbound sets the Xmsg status to PendingOutbound with the given error message.
-func (m Xmsg) SetPendingOutbound(message string) {
-	m.XmsgStatus.ChangeStatus(XmsgStatus_PENDING_OUTBOUND, message)
+func (m Xmsg) SetPendingOutbound(ctx sdk.Context, message string) {
+	m.changeStatus(ctx, XmsgStatus_PENDING_OUTBOUND, message)
 }
 
 // SetOutBoundMined sets the Xmsg status to OutboundMined with the given error message.
-func (m Xmsg) SetOutBoundMined(message string) {
-	m.XmsgStatus.ChangeStatus(XmsgStatus_OUTBOUND_MINED, message)
+func (m Xmsg) SetOutBoundMined(ctx sdk.Context, message string) {
+	m.changeStatus(ctx, XmsgStatus_OUTBOUND_MINED, message)
 }
 
 // SetReverted sets the Xmsg status to Reverted with the given error message.
-func (m Xmsg) SetReverted(message string) {
-	m.XmsgStatus.ChangeStatus(XmsgStatus_REVERTED, message)
+func (m Xmsg) SetReverted(ctx sdk.Context, message string) {
+	m.changeStatus(ctx, XmsgStatus_REVERTED, message)
+}
+
+// changeStatus changes the Xmsg status and emits the transition if the status changed
+func (m Xmsg) changeStatus(ctx sdk.Context, newStatus XmsgStatus, message string) {
+	oldStatus := m.XmsgStatus.GetStatus()
+	m.XmsgStatus.ChangeStatus(newStatus, message)
+	if m.XmsgStatus.GetStatus() != oldStatus {
+		EmitXmsgStatusChanged(ctx, oldStatus.String(), &m)
+	}
+}
+
+// GetAbortedAmount returns the value locked by an aborted xmsg.
+// Only PellSent events carry a value, the amount is zero for every other event.
+func (m Xmsg) GetAbortedAmount() math.Uint {
+	if m.InboundTxParams == nil || m.InboundTxParams.InboundPellTx == nil {
+		return math.ZeroUint()
+	}
+	pellSent := m.InboundTxParams.InboundPellTx.GetPellSent()
+	if pellSent == nil || pellSent.PellValue.IsNil() {
+		return math.ZeroUint()
+	}
+	return pellSent.PellValue
 }
 
 func (m Xmsg) GetXmsgIndicesBytes() ([32]byte, error) {
@@ -212,5 +235,6 @@ func NewXmsg(ctx sdk.Context, msg MsgVoteOnObservedInboundTx, tssPubkey string)
 	if err != nil {
 		return Xmsg{}, err
 	}
+	EmitXmsgStatusChanged(ctx, "", &xmsg)
 	return xmsg, nil
 }
@@ -369,6 +369,8 @@ type Status struct {
 	Status              XmsgStatus `protobuf:"varint,1,opt,name=status,proto3,enum=xmsg.XmsgStatus" json:"status,omitempty"`
 	StatusMessage       string     `protobuf:"bytes,2,opt,name=status_message,json=statusMessage,proto3" json:"status_message,omitempty"`
 	LastUpdateTimestamp int64      `protobuf:"varint,3,opt,name=last_update_timestamp,json=lastUpdateTimestamp,proto3" json:"last_update_timestamp,omitempty"`
+	// whether the value of an aborted xmsg has been refunded
+	IsAbortRefunded bool `protobuf:"varint,4,opt,name=is_abort_refunded,json=isAbortRefunded,proto3" json:"is_abort_refunded,omitempty"`
 }
 
 func (m *Status) Reset()         { *m = Status{} }
@@ -425,6 +427,13 @@ func (m *Status) GetLastUpdateTimestamp() int64 {
 	return 0
 }
 
+func (m *Status) GetIsAbortRefunded() bool {
+	if m != nil {
+		return m.IsAbortRefunded
+	}
+	return false
+}
+
 // Xmsg represent a xmsg
 type Xmsg struct {
 	Signer           string              `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
@@ -561,73 +570,74 @@ func init() {
 func init() { proto.RegisterFile("xmsg/xmsg.proto", fileDescriptor_3f500efccd9ad016) }
 
 var fileDescriptor_3f500efccd9ad016 = []byte{
-	// 1046 bytes of a gzipped FileDescriptorProto
+	// 1070 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x41, 0x6b, 0x1b, 0xc7,
 	0x17, 0xf7, 0x5a, 0xb2, 0x6c, 0x3d, 0x5b, 0x96, 0x3c, 0x96, 0x9c, 0xc5, 0x4e, 0x64, 0x61, 0xf8,
-	0xff, 0x2b, 0x5c, 0x6a, 0x35, 0x0e, 0xa5, 0x50, 0x48, 0xc0, 0x8e, 0xd6, 0x89, 0xc0, 0x91, 0xc4,
-	0x56, 0x2e, 0x21, 0x97, 0x65, 0x2d, 0x8d, 0x57, 0x83, 0x57, 0xbb, 0x42, 0x33, 0x72, 0xd6, 0x3d,
-	0x95, 0xde, 0x0b, 0x85, 0x7e, 0x83, 0xd2, 0x43, 0x8f, 0xfd, 0x18, 0x39, 0xe6, 0x58, 0x7a, 0x08,
-	0xc5, 0x3e, 0xf4, 0x33, 0xf4, 0x56, 0xe6, 0xcd, 0xac, 0xb4, 0x12, 0x6a, 0x0e, 0xbd, 0x88, 0x99,
-	0xf7, 0xde, 0xef, 0xbd, 0xdf, 0xbc, 0xf9, 0xed, 0x1b, 0x41, 0x3e, 0x1a, 0x70, 0xaf, 0x26, 0x7f,
-	0x8e, 0x86, 0xa3, 0x50, 0x84, 0x24, 0x2d, 0xd7, 0xbb, 0x0f, 0xba, 0x21, 0x1f, 0x84, 0xbc, 0x26,
-	0x9d, 0x37, 0x8f, 0x6b, 0x13, 0xf7, 0x6e, 0xd1, 0x0b, 0xbd, 0x10, 0x97, 0x35, 0xb9, 0xd2, 0xd6,
-	0x12, 0x66, 0x19, 0x52, 0xdf, 0x77, 0xe8, 0x0d, 0x0d, 0x84, 0x32, 0x1f, 0xfc, 0x94, 0x86, 0x7c,
-	0x23, 0xb8, 0x0c, 0xc7, 0x41, 0xaf, 0x13, 0xb5, 0xdd, 0x91, 0x3b, 0xe0, 0x64, 0x07, 0x32, 0x9c,
-	0x06, 0x3d, 0x3a, 0x32, 0x8d, 0x8a, 0x51, 0xcd, 0xda, 0x7a, 0x47, 0xfe, 0x0f, 0x79, 0xb5, 0x72,
-	0xba, 0x7d, 0x97, 0x05, 0x0e, 0xeb, 0x99, 0xcb, 0x15, 0xa3, 0x9a, 0xb2, 0x73, 0xca, 0xfc, 0x5c,
-	0x5a, 0x1b, 0x3d, 0xb2, 0x07, 0x59, 0x11, 0x39, 0xe1, 0x88, 0x79, 0x2c, 0x30, 0x53, 0x98, 0x62,
-	0x4d, 0x44, 0x2d, 0xdc, 0x93, 0x67, 0x90, 0x67, 0xaa, 0x9e, 0x83, 0x64, 0x44, 0x64, 0xa6, 0x2b,
-	0x46, 0x75, 0xfd, 0x78, 0xe7, 0x08, 0x8f, 0xa8, 0xc9, 0xb4, 0xa9, 0xef, 0x5b, 0x92, 0xa7, 0x9d,
-	0x63, 0x53, 0x4b, 0x27, 0x92, 0x24, 0x62, 0xbc, 0x88, 0x9c, 0xbe, 0xcb, 0xfb, 0xe6, 0x0a, 0x96,
-	0x88, 0xe3, 0x3a, 0xd1, 0x4b, 0x97, 0xf7, 0xc9, 0x17, 0xf0, 0x20, 0x11, 0x77, 0xe9, 0x87, 0xdd,
-	0x6b, 0xa7, 0x4f, 0x99, 0xd7, 0x17, 0x66, 0xa6, 0x62, 0x54, 0xd3, 0x76, 0x71, 0x12, 0x7f, 0x2a,
-	0x9d, 0x2f, 0xd1, 0x47, 0x9e, 0xc0, 0x4e, 0x02, 0x86, 0x9d, 0x72, 0x58, 0xd0, 0xa3, 0x91, 0xb9,
-	0x8a, 0xa8, 0xed, 0x09, 0x0a, 0xd9, 0x35, 0xa4, 0x6b, 0xbe, 0x96, 0xeb, 0xfb, 0x61, 0x8c, 0x5a,
-	0x43, 0x6e, 0x89, 0x5a, 0xe8, 0x54, 0xb0, 0x33, 0xa8, 0x24, 0x60, 0x57, 0x2c, 0x70, 0x7d, 0xf6,
-	0x2d, 0xd5, 0x7d, 0xd1, 0x5c, 0xb3, 0x58, 0xf5, 0xe1, 0x04, 0x7f, 0x16, 0x47, 0xc9, 0x76, 0x68,
-	0xce, 0x6d, 0xd8, 0x99, 0xe2, 0x5d, 0xc1, 0xc2, 0xc0, 0xe1, 0xc2, 0x15, 0x63, 0x6e, 0x42, 0xc5,
-	0xa8, 0x6e, 0x1e, 0xef, 0xaa, 0xce, 0x4e, 0xc0, 0x18, 0xf2, 0x35, 0x46, 0xd8, 0x45, 0xb1, 0xc0,
-	0x7a, 0xf0, 0x73, 0x06, 0x0a, 0xad, 0xb1, 0x98, 0x95, 0xc5, 0x2e, 0xac, 0x8d, 0x68, 0x97, 0xb2,
-	0x9b, 0x89, 0x30, 0x26, 0x7b, 0x72, 0x08, 0x5b, 0xf1, 0x7a, 0x5e, 0x1c, 0xf9, 0xd8, 0x11, 0xcb,
-	0xe3, 0x31, 0x94, 0x42, 0x9d, 0x5b, 0x9e, 0x5b, 0x70, 0xee, 0x04, 0x61, 0xd0, 0xa5, 0x28, 0x95,
-	0xb4, 0x4d, 0xc2, 0x49, 0xe1, 0x0e, 0xe7, 0x4d, 0xe9, 0x99, 0x87, 0x78, 0x2e, 0x77, 0x7c, 0x36,
-	0x60, 0x02, 0xa5, 0x33, 0x03, 0x79, 0xe1, 0xf2, 0x73, 0xe9, 0x59, 0x04, 0x19, 0x8e, 0x58, 0x97,
-	0x6a, 0xb5, 0xcc, 0x42, 0xda, 0xd2, 0x43, 0x9e, 0xc1, 0xc3, 0x05, 0x90, 0x70, 0xc4, 0xc4, 0xad,
-	0x73, 0x45, 0x29, 0xea, 0x26, 0x6b, 0x9b, 0xf3, 0x48, 0x0c, 0x38, 0xa3, 0x94, 0x54, 0xa1, 0x90,
-	0xc4, 0xa3, 0x36, 0x57, 0x11, 0xb3, 0x39, 0xc5, 0xa0, 0x38, 0xbf, 0x04, 0x33, 0x19, 0xb9, 0x40,
-	0x31, 0xa5, 0x29, 0x22, 0x29, 0x99, 0xa7, 0xb0, 0x97, 0x04, 0xd2, 0x48, 0xd0, 0x51, 0xe0, 0xce,
-	0xa9, 0x25, 0xc1, 0xd0, 0xd2, 0x01, 0x5a, 0x29, 0x8f, 0x00, 0x64, 0xbb, 0x87, 0xe3, 0xcb, 0x6b,
-	0x7a, 0x8b, 0xea, 0xc8, 0xda, 0x59, 0xc1, 0x79, 0x1b, 0x0d, 0x1f, 0x11, 0xd2, 0xfa, 0x7f, 0x13,
-	0x12, 0xa9, 0x41, 0x71, 0xbe, 0xa5, 0x63, 0x4e, 0x7b, 0xe6, 0x06, 0x12, 0xdd, 0x9a, 0x69, 0xe5,
-	0x05, 0xa7, 0x3d, 0xd2, 0x85, 0xfd, 0x99, 0x03, 0x5e, 0x5d, 0xd1, 0xae, 0x60, 0x37, 0x34, 0x71,
-	0x81, 0x39, 0x49, 0xfb, 0xf4, 0xd1, 0xbb, 0x0f, 0xfb, 0x4b, 0x7f, 0x7c, 0xd8, 0x2f, 0xa9, 0x31,
-	0xc8, 0x7b, 0xd7, 0x47, 0x2c, 0xac, 0x0d, 0x5c, 0xd1, 0x3f, 0x6a, 0x04, 0xc2, 0xde, 0x4b, 0xf4,
-	0x20, 0xce, 0x31, 0xb9, 0xe8, 0xfa, 0xc7, 0x8a, 0x28, 0x61, 0x6d, 0x22, 0xc1, 0x7f, 0xc9, 0x82,
-	0x0a, 0x3b, 0xf8, 0xc1, 0x80, 0x8c, 0x3e, 0x66, 0x15, 0x32, 0xba, 0x51, 0x06, 0x36, 0xaa, 0xa0,
-	0x1a, 0xf5, 0x7a, 0xc0, 0x3d, 0xdd, 0x1e, 0xed, 0x27, 0xff, 0x83, 0x4d, 0xb5, 0x72, 0x06, 0x94,
-	0x73, 0xd7, 0xa3, 0xf8, 0x95, 0x64, 0xed, 0x9c, 0xb2, 0xbe, 0x52, 0x46, 0x72, 0x0c, 0x25, 0xdf,
-	0xe5, 0xc2, 0x19, 0x0f, 0x7b, 0xae, 0xa0, 0x8e, 0x60, 0x03, 0xca, 0x85, 0x3b, 0x18, 0xe2, 0x37,
-	0x92, 0xb2, 0xb7, 0xa5, 0xf3, 0x02, 0x7d, 0x9d, 0xd8, 0x75, 0xf0, 0xb7, 0x01, 0x69, 0x59, 0x11,
-	0xe7, 0x37, 0xf3, 0x82, 0xc4, 0xfc, 0xc6, 0x1d, 0x29, 0xc2, 0x8a, 0x92, 0x98, 0x2a, 0xa9, 0x36,
-	0xe4, 0x33, 0x58, 0x97, 0x64, 0xe3, 0x9b, 0x4e, 0xe1, 0x30, 0xde, 0x50, 0x07, 0xd0, 0xe4, 0x21,
-	0x9a, 0x1c, 0x84, 0x9c, 0xc0, 0x56, 0x62, 0x68, 0x0d, 0x71, 0x34, 0xe8, 0x09, 0x5e, 0x9a, 0x99,
-	0xe0, 0xf1, 0xdc, 0xb0, 0xf3, 0x6c, 0xee, 0x7d, 0xa9, 0x03, 0x49, 0xb6, 0x5f, 0xe7, 0x58, 0xa9,
-	0xa4, 0xa6, 0xaf, 0xc0, 0xfc, 0xf0, 0xb1, 0x0b, 0xe1, 0x9c, 0xe5, 0xab, 0xf5, 0xef, 0xff, 0xfa,
-	0xed, 0x50, 0x1f, 0xed, 0xe0, 0x29, 0x90, 0x13, 0xdf, 0x0f, 0xdf, 0xd2, 0x1e, 0xf6, 0x1c, 0x9f,
-	0x23, 0x4e, 0x3e, 0x81, 0xbc, 0xab, 0xac, 0x8e, 0x7a, 0xa1, 0xe4, 0xfd, 0xa4, 0xe4, 0xf7, 0xa8,
-	0xcd, 0x3a, 0xf0, 0xf0, 0x3b, 0x03, 0x60, 0x7a, 0x59, 0x64, 0x1b, 0xf2, 0x6d, 0xab, 0x59, 0x6f,
-	0x34, 0x5f, 0x38, 0x8d, 0xe6, 0x69, 0xeb, 0xa2, 0x59, 0x2f, 0x2c, 0x91, 0x22, 0x14, 0x62, 0x63,
-	0xeb, 0xa2, 0xa3, 0xac, 0x06, 0x21, 0xb0, 0x19, 0xef, 0x9c, 0x57, 0x8d, 0xa6, 0x55, 0x2f, 0x2c,
-	0x4b, 0x5b, 0x1c, 0x69, 0x5b, 0xdf, 0x58, 0x76, 0xa7, 0x90, 0x22, 0x1b, 0xb0, 0xa6, 0xd6, 0x56,
-	0xbd, 0x90, 0x26, 0xeb, 0xb0, 0x7a, 0x72, 0xda, 0xc2, 0xcd, 0xca, 0x6e, 0xfa, 0xd7, 0x5f, 0xca,
-	0xc6, 0xe1, 0x39, 0x14, 0x17, 0x7d, 0x57, 0x64, 0x0b, 0x72, 0xcd, 0x56, 0xc7, 0x39, 0x6b, 0x34,
-	0x4f, 0xce, 0x1b, 0x6f, 0x2c, 0xc9, 0x24, 0x07, 0xd9, 0xe9, 0xd6, 0x90, 0xa9, 0xad, 0xd7, 0xd6,
-	0xf3, 0x0b, 0x99, 0x6d, 0x59, 0x65, 0x3b, 0xb5, 0xde, 0xdd, 0x95, 0x8d, 0xf7, 0x77, 0x65, 0xe3,
-	0xcf, 0xbb, 0xb2, 0xf1, 0xe3, 0x7d, 0x79, 0xe9, 0xfd, 0x7d, 0x79, 0xe9, 0xf7, 0xfb, 0xf2, 0xd2,
-	0x9b, 0x4f, 0x3d, 0x26, 0xfa, 0xe3, 0xcb, 0xa3, 0x6e, 0x38, 0xa8, 0x7d, 0x1e, 0xc9, 0x57, 0xa4,
-	0x49, 0xc5, 0xdb, 0x70, 0x74, 0x5d, 0x73, 0xa9, 0xc7, 0x78, 0x2d, 0xc2, 0x7f, 0x1a, 0x35, 0x71,
-	0x3b, 0xa4, 0xfc, 0x32, 0x83, 0x7f, 0x12, 0x9e, 0xfc, 0x13, 0x00, 0x00, 0xff, 0xff, 0x73, 0x65,
-	0xc9, 0xa6, 0x83, 0x08, 0x00, 0x00,
+	0xff, 0x2b, 0x5c, 0x6a, 0x35, 0x0e, 0xa5, 0x50, 0x48, 0xc0, 0x8e, 0xe4, 0x44, 0xe0, 0x48, 0x62,
+	0x2b, 0x97, 0x90, 0xcb, 0xb0, 0xd2, 0x8e, 0x57, 0x83, 0x57, 0x3b, 0x62, 0x67, 0xe4, 0xac, 0x7b,
+	0x2a, 0xfd, 0x04, 0x85, 0x7e, 0x83, 0xd2, 0x43, 0x8f, 0xbd, 0xf6, 0x1b, 0xe4, 0x98, 0x63, 0xe9,
+	0x21, 0x14, 0xfb, 0xd0, 0xcf, 0xd0, 0x5b, 0x99, 0x99, 0xdd, 0xd5, 0x4a, 0xb8, 0x39, 0xf4, 0x22,
+	0x66, 0x7e, 0xef, 0xfd, 0xde, 0xbc, 0xf7, 0xe6, 0x37, 0x6f, 0x05, 0xc5, 0x70, 0xcc, 0xdd, 0x86,
+	0xfc, 0x39, 0x9a, 0x04, 0x4c, 0x30, 0x94, 0x95, 0xeb, 0xdd, 0x07, 0x43, 0xc6, 0xc7, 0x8c, 0x37,
+	0xa4, 0xf1, 0xfa, 0x71, 0x23, 0x31, 0xef, 0x96, 0x5d, 0xe6, 0x32, 0xb5, 0x6c, 0xc8, 0x55, 0x84,
+	0x56, 0x54, 0x94, 0x09, 0xf1, 0x3c, 0x4c, 0xae, 0x89, 0x2f, 0x34, 0x7c, 0xf0, 0x63, 0x16, 0x8a,
+	0x6d, 0x7f, 0xc0, 0xa6, 0xbe, 0xd3, 0x0f, 0x7b, 0x76, 0x60, 0x8f, 0x39, 0xda, 0x81, 0x1c, 0x27,
+	0xbe, 0x43, 0x02, 0xd3, 0xa8, 0x19, 0xf5, 0xbc, 0x15, 0xed, 0xd0, 0xff, 0xa1, 0xa8, 0x57, 0x78,
+	0x38, 0xb2, 0xa9, 0x8f, 0xa9, 0x63, 0x2e, 0xd7, 0x8c, 0x7a, 0xc6, 0x2a, 0x68, 0xf8, 0xb9, 0x44,
+	0xdb, 0x0e, 0xda, 0x83, 0xbc, 0x08, 0x31, 0x0b, 0xa8, 0x4b, 0x7d, 0x33, 0xa3, 0x42, 0xac, 0x89,
+	0xb0, 0xab, 0xf6, 0xe8, 0x19, 0x14, 0xa9, 0x3e, 0x0f, 0xab, 0x64, 0x44, 0x68, 0x66, 0x6b, 0x46,
+	0x7d, 0xfd, 0x78, 0xe7, 0x48, 0x95, 0x18, 0x25, 0xd3, 0x23, 0x9e, 0xd7, 0x92, 0x79, 0x5a, 0x05,
+	0x3a, 0x43, 0xfa, 0xa1, 0x4c, 0x22, 0xe6, 0x8b, 0x10, 0x8f, 0x6c, 0x3e, 0x32, 0x57, 0xd4, 0x11,
+	0xb1, 0x5f, 0x3f, 0x7c, 0x69, 0xf3, 0x11, 0xfa, 0x02, 0x1e, 0xa4, 0xfc, 0x06, 0x1e, 0x1b, 0x5e,
+	0xe1, 0x11, 0xa1, 0xee, 0x48, 0x98, 0xb9, 0x9a, 0x51, 0xcf, 0x5a, 0xe5, 0xc4, 0xff, 0x54, 0x1a,
+	0x5f, 0x2a, 0x1b, 0x7a, 0x02, 0x3b, 0x29, 0x9a, 0xea, 0x14, 0xa6, 0xbe, 0x43, 0x42, 0x73, 0x55,
+	0xb1, 0xb6, 0x13, 0x96, 0xca, 0xae, 0x2d, 0x4d, 0x8b, 0x67, 0xd9, 0x9e, 0xc7, 0x62, 0xd6, 0x9a,
+	0xca, 0x2d, 0x75, 0x96, 0x32, 0x6a, 0xda, 0x19, 0xd4, 0x52, 0xb4, 0x4b, 0xea, 0xdb, 0x1e, 0xfd,
+	0x96, 0x44, 0x7d, 0x89, 0x72, 0xcd, 0xab, 0x53, 0x1f, 0x26, 0xfc, 0xb3, 0xd8, 0x4b, 0xb6, 0x23,
+	0xca, 0xb9, 0x07, 0x3b, 0x33, 0xbe, 0x2d, 0x28, 0xf3, 0x31, 0x17, 0xb6, 0x98, 0x72, 0x13, 0x6a,
+	0x46, 0x7d, 0xf3, 0x78, 0x57, 0x77, 0x36, 0x21, 0x2b, 0x97, 0xaf, 0x95, 0x87, 0x55, 0x16, 0xf7,
+	0xa0, 0x07, 0x3f, 0xe5, 0xa0, 0xd4, 0x9d, 0x8a, 0x79, 0x59, 0xec, 0xc2, 0x5a, 0x40, 0x86, 0x84,
+	0x5e, 0x27, 0xc2, 0x48, 0xf6, 0xe8, 0x10, 0xb6, 0xe2, 0xf5, 0xa2, 0x38, 0x8a, 0xb1, 0x21, 0x96,
+	0xc7, 0x63, 0xa8, 0xb0, 0xa9, 0x48, 0xea, 0x16, 0x9c, 0x63, 0x9f, 0xf9, 0x43, 0xa2, 0xa4, 0x92,
+	0xb5, 0x10, 0x4b, 0x0e, 0xee, 0x73, 0xde, 0x91, 0x96, 0x45, 0x8a, 0x6b, 0x73, 0xec, 0xd1, 0x31,
+	0x15, 0x66, 0x76, 0x91, 0xf2, 0xc2, 0xe6, 0xe7, 0xd2, 0x72, 0x1f, 0x65, 0x12, 0xd0, 0x21, 0x89,
+	0xd4, 0x32, 0x4f, 0xe9, 0x49, 0x0b, 0x7a, 0x06, 0x0f, 0xef, 0xa1, 0xb0, 0x80, 0x8a, 0x1b, 0x7c,
+	0x49, 0x88, 0xd2, 0x4d, 0xde, 0x32, 0x17, 0x99, 0xca, 0xe1, 0x8c, 0x10, 0x54, 0x87, 0x52, 0x9a,
+	0xaf, 0xb4, 0xb9, 0xaa, 0x38, 0x9b, 0x33, 0x8e, 0x12, 0xe7, 0x97, 0x60, 0xa6, 0x3d, 0xef, 0x51,
+	0x4c, 0x65, 0xc6, 0x48, 0x4b, 0xe6, 0x29, 0xec, 0xa5, 0x89, 0x24, 0x14, 0x24, 0xf0, 0xed, 0x05,
+	0xb5, 0xa4, 0x32, 0x6c, 0x45, 0x0e, 0x91, 0x52, 0x1e, 0x01, 0xc8, 0x76, 0x4f, 0xa6, 0x83, 0x2b,
+	0x72, 0xa3, 0xd4, 0x91, 0xb7, 0xf2, 0x82, 0xf3, 0x9e, 0x02, 0x3e, 0x22, 0xa4, 0xf5, 0xff, 0x26,
+	0x24, 0xd4, 0x80, 0xf2, 0x62, 0x4b, 0xa7, 0x9c, 0x38, 0xe6, 0x86, 0x4a, 0x74, 0x6b, 0xae, 0x95,
+	0x17, 0x9c, 0x38, 0x68, 0x08, 0xfb, 0x73, 0x05, 0x5e, 0x5e, 0x92, 0xa1, 0xa0, 0xd7, 0x24, 0x75,
+	0x81, 0x05, 0x99, 0xf6, 0xe9, 0xa3, 0x77, 0x1f, 0xf6, 0x97, 0xfe, 0xf8, 0xb0, 0x5f, 0xd1, 0x63,
+	0x90, 0x3b, 0x57, 0x47, 0x94, 0x35, 0xc6, 0xb6, 0x18, 0x1d, 0xb5, 0x7d, 0x61, 0xed, 0xa5, 0x7a,
+	0x10, 0xc7, 0x48, 0x2e, 0xba, 0xf9, 0xb1, 0x43, 0xb4, 0xb0, 0x36, 0x55, 0x82, 0xff, 0x12, 0x45,
+	0x29, 0xec, 0xe0, 0x37, 0x03, 0x72, 0x51, 0x99, 0x75, 0xc8, 0x45, 0x8d, 0x32, 0x54, 0xa3, 0x4a,
+	0xba, 0x51, 0xaf, 0xc7, 0xdc, 0x8d, 0xda, 0x13, 0xd9, 0xd1, 0xff, 0x60, 0x53, 0xaf, 0xf0, 0x98,
+	0x70, 0x6e, 0xbb, 0x44, 0xbd, 0x92, 0xbc, 0x55, 0xd0, 0xe8, 0x2b, 0x0d, 0xa2, 0x63, 0xa8, 0x78,
+	0x36, 0x17, 0x78, 0x3a, 0x71, 0x6c, 0x41, 0xb0, 0xa0, 0x63, 0xc2, 0x85, 0x3d, 0x9e, 0xa8, 0x37,
+	0x92, 0xb1, 0xb6, 0xa5, 0xf1, 0x42, 0xd9, 0xfa, 0xb1, 0x49, 0xbe, 0x41, 0xca, 0xb1, 0x3d, 0x60,
+	0x81, 0xc0, 0x01, 0xb9, 0x9c, 0xfa, 0x0e, 0x71, 0xd4, 0x03, 0x59, 0xb3, 0x8a, 0x94, 0x9f, 0x48,
+	0xdc, 0x8a, 0xe0, 0x83, 0xbf, 0x0d, 0xc8, 0xca, 0xec, 0xd4, 0xac, 0xa7, 0xae, 0x9f, 0x9a, 0xf5,
+	0x6a, 0x87, 0xca, 0xb0, 0xa2, 0xe5, 0xa8, 0xd3, 0xd3, 0x1b, 0xf4, 0x19, 0xac, 0xcb, 0xc2, 0x62,
+	0x55, 0x64, 0xd4, 0xe0, 0xde, 0xd0, 0xc5, 0x46, 0x85, 0x42, 0x98, 0x14, 0x8d, 0x4e, 0x60, 0x2b,
+	0x35, 0xe0, 0x26, 0x6a, 0x8c, 0x44, 0xd3, 0xbe, 0x32, 0x37, 0xed, 0xe3, 0x19, 0x63, 0x15, 0xe9,
+	0x3c, 0x80, 0x9a, 0x80, 0xd2, 0x57, 0x15, 0xc5, 0x58, 0xa9, 0x65, 0x66, 0x5f, 0x8c, 0xc5, 0x41,
+	0x65, 0x95, 0xd8, 0x02, 0xf2, 0xd5, 0xfa, 0xf7, 0x7f, 0xfd, 0x7a, 0x18, 0x95, 0x76, 0xf0, 0x14,
+	0xd0, 0x89, 0xe7, 0xb1, 0xb7, 0xc4, 0x51, 0xf7, 0xa3, 0x3e, 0x5d, 0x1c, 0x7d, 0x02, 0x45, 0x5b,
+	0xa3, 0x58, 0x7f, 0xcd, 0xe4, 0x5d, 0x66, 0xe4, 0xdb, 0x8d, 0xe0, 0xc8, 0xf1, 0xf0, 0x3b, 0x03,
+	0x60, 0x76, 0xb1, 0x68, 0x1b, 0x8a, 0xbd, 0x56, 0xa7, 0xd9, 0xee, 0xbc, 0xc0, 0xed, 0xce, 0x69,
+	0xf7, 0xa2, 0xd3, 0x2c, 0x2d, 0xa1, 0x32, 0x94, 0x62, 0xb0, 0x7b, 0xd1, 0xd7, 0xa8, 0x81, 0x10,
+	0x6c, 0xc6, 0x3b, 0xfc, 0xaa, 0xdd, 0x69, 0x35, 0x4b, 0xcb, 0x12, 0x8b, 0x3d, 0xad, 0xd6, 0x37,
+	0x2d, 0xab, 0x5f, 0xca, 0xa0, 0x0d, 0x58, 0xd3, 0xeb, 0x56, 0xb3, 0x94, 0x45, 0xeb, 0xb0, 0x7a,
+	0x72, 0xda, 0x55, 0x9b, 0x95, 0xdd, 0xec, 0x2f, 0x3f, 0x57, 0x8d, 0xc3, 0x73, 0x28, 0xdf, 0xf7,
+	0x06, 0xd1, 0x16, 0x14, 0x3a, 0xdd, 0x3e, 0x3e, 0x6b, 0x77, 0x4e, 0xce, 0xdb, 0x6f, 0x5a, 0x32,
+	0x93, 0x02, 0xe4, 0x67, 0x5b, 0x43, 0x86, 0x6e, 0xbd, 0x6e, 0x3d, 0xbf, 0x90, 0xd1, 0x96, 0x75,
+	0xb4, 0xd3, 0xd6, 0xbb, 0xdb, 0xaa, 0xf1, 0xfe, 0xb6, 0x6a, 0xfc, 0x79, 0x5b, 0x35, 0x7e, 0xb8,
+	0xab, 0x2e, 0xbd, 0xbf, 0xab, 0x2e, 0xfd, 0x7e, 0x57, 0x5d, 0x7a, 0xf3, 0xa9, 0x4b, 0xc5, 0x68,
+	0x3a, 0x38, 0x1a, 0xb2, 0x71, 0xe3, 0xf3, 0x50, 0x7e, 0x71, 0x3a, 0x44, 0xbc, 0x65, 0xc1, 0x55,
+	0xc3, 0x26, 0x2e, 0xe5, 0x8d, 0x50, 0xfd, 0x2b, 0x69, 0x88, 0x9b, 0x09, 0xe1, 0x83, 0x9c, 0xfa,
+	0x43, 0xf1, 0xe4, 0x9f, 0x01, 0x00, 0x4e, 0x83, 0xdb, 0x33, 0xaf, 0x08, 0x00, 0x00,
 }
 
 func (m *InboundTxParams) Marshal() (dAtA []byte, err error) {
@@ -848,6 +858,16 @@ func (m *Status) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.IsAbortRefunded {
+		i--
+		if m.IsAbortRefunded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.LastUpdateTimestamp != 0 {
 		i = encodeVarintXmsg(dAtA, i, uint64(m.LastUpdateTimestamp))
 		i--
@@ -1102,6 +1122,9 @@ func (m *Status) Size() (n int) {
 	if m.LastUpdateTimestamp != 0 {
 		n += 1 + sovXmsg(uint64(m.LastUpdateTimestamp))
 	}
+	if m.IsAbortRefunded {
+		n += 2
+	}
 	return n
 }
 
@@ -1974,6 +1997,26 @@ func (m *Status) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsAbortRefunded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowXmsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsAbortRefunded = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipXmsg(dAtA[iNdEx:])
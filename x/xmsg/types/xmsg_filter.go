@@ -0,0 +1,85 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Validate checks the filters of the request are valid
+func (req *QueryXmsgsByFilterRequest) Validate() error {
+	for _, status := range req.Statuses {
+		if _, ok := XmsgStatus_name[int32(status)]; !ok {
+			return fmt.Errorf("invalid xmsg status %d", status)
+		}
+	}
+	if _, ok := InboundPellEventType_name[int32(req.InboundEventType)]; !ok {
+		return fmt.Errorf("invalid inbound event type %d", req.InboundEventType)
+	}
+	if req.MaxInboundPellHeight != 0 && req.MaxInboundPellHeight < req.MinInboundPellHeight {
+		return fmt.Errorf(
+			"max inbound pell height %d lower than min inbound pell height %d",
+			req.MaxInboundPellHeight,
+			req.MinInboundPellHeight,
+		)
+	}
+	return nil
+}
+
+// Match returns true if the xmsg matches all the filters of the request
+func (req *QueryXmsgsByFilterRequest) Match(xmsg Xmsg) bool {
+	inbound := xmsg.InboundTxParams
+	if inbound == nil {
+		inbound = &InboundTxParams{}
+	}
+
+	if req.Sender != "" && !strings.EqualFold(req.Sender, inbound.Sender) {
+		return false
+	}
+	if req.TxOrigin != "" && !strings.EqualFold(req.TxOrigin, inbound.TxOrigin) {
+		return false
+	}
+	if req.MinInboundPellHeight != 0 && inbound.InboundTxFinalizedPellHeight < req.MinInboundPellHeight {
+		return false
+	}
+	if req.MaxInboundPellHeight != 0 && inbound.InboundTxFinalizedPellHeight > req.MaxInboundPellHeight {
+		return false
+	}
+	if req.InboundEventType != InboundPellEventType_INBOUND_EVENT_UNSPECIFIED &&
+		GetInboundPellEventType(&xmsg) != req.InboundEventType {
+		return false
+	}
+	if len(req.Statuses) > 0 && !req.matchStatus(xmsg.XmsgStatus.GetStatus()) {
+		return false
+	}
+	if req.Receiver != "" || req.ReceiverChainId != 0 {
+		return req.matchOutbound(xmsg)
+	}
+	return true
+}
+
+func (req *QueryXmsgsByFilterRequest) matchStatus(status XmsgStatus) bool {
+	for _, s := range req.Statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// matchOutbound returns true if any outbound tx matches the receiver filters
+func (req *QueryXmsgsByFilterRequest) matchOutbound(xmsg Xmsg) bool {
+	receiverFound := req.Receiver == ""
+	receiverChainFound := req.ReceiverChainId == 0
+	for _, outTxParams := range xmsg.OutboundTxParams {
+		if outTxParams == nil {
+			continue
+		}
+		if strings.EqualFold(req.Receiver, outTxParams.Receiver) {
+			receiverFound = true
+		}
+		if req.ReceiverChainId == outTxParams.ReceiverChainId {
+			receiverChainFound = true
+		}
+	}
+	return receiverFound && receiverChainFound
+}
@@ -0,0 +1,103 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/testutil/sample"
+	"github.com/0xPellNetwork/aegis/x/xmsg/types"
+)
+
+func TestQueryXmsgsByFilterRequest_Validate(t *testing.T) {
+	require.NoError(t, (&types.QueryXmsgsByFilterRequest{}).Validate())
+	require.NoError(t, (&types.QueryXmsgsByFilterRequest{
+		Statuses:             []types.XmsgStatus{types.XmsgStatus_ABORTED},
+		InboundEventType:     types.InboundPellEventType_INBOUND_EVENT_PELL_SENT,
+		MinInboundPellHeight: 10,
+		MaxInboundPellHeight: 10,
+	}).Validate())
+
+	require.Error(t, (&types.QueryXmsgsByFilterRequest{Statuses: []types.XmsgStatus{100}}).Validate())
+	require.Error(t, (&types.QueryXmsgsByFilterRequest{InboundEventType: 100}).Validate())
+	require.Error(t, (&types.QueryXmsgsByFilterRequest{MinInboundPellHeight: 10, MaxInboundPellHeight: 9}).Validate())
+}
+
+func TestQueryXmsgsByFilterRequest_Match(t *testing.T) {
+	xmsg := *sample.Xmsg_pell(t, "0")
+	xmsg.XmsgStatus.Status = types.XmsgStatus_PENDING_OUTBOUND
+	xmsg.InboundTxParams.InboundTxFinalizedPellHeight = 100
+	inbound := xmsg.InboundTxParams
+	outbound := xmsg.OutboundTxParams[1]
+
+	tests := []struct {
+		name     string
+		req      types.QueryXmsgsByFilterRequest
+		expected bool
+	}{
+		{
+			name:     "no filter",
+			expected: true,
+		},
+		{
+			name: "all filters match",
+			req: types.QueryXmsgsByFilterRequest{
+				Sender:               strings.ToUpper(inbound.Sender),
+				TxOrigin:             inbound.TxOrigin,
+				Receiver:             outbound.Receiver,
+				ReceiverChainId:      outbound.ReceiverChainId,
+				Statuses:             []types.XmsgStatus{types.XmsgStatus_ABORTED, types.XmsgStatus_PENDING_OUTBOUND},
+				InboundEventType:     types.InboundPellEventType_INBOUND_EVENT_STAKER_DELEGATED,
+				MinInboundPellHeight: 100,
+				MaxInboundPellHeight: 100,
+			},
+			expected: true,
+		},
+		{
+			name:     "sender mismatch",
+			req:      types.QueryXmsgsByFilterRequest{Sender: sample.EthAddress().Hex()},
+			expected: false,
+		},
+		{
+			name:     "tx origin mismatch",
+			req:      types.QueryXmsgsByFilterRequest{TxOrigin: sample.EthAddress().Hex()},
+			expected: false,
+		},
+		{
+			name:     "receiver mismatch",
+			req:      types.QueryXmsgsByFilterRequest{Receiver: sample.EthAddress().Hex()},
+			expected: false,
+		},
+		{
+			name:     "receiver chain mismatch",
+			req:      types.QueryXmsgsByFilterRequest{ReceiverChainId: 1},
+			expected: false,
+		},
+		{
+			name:     "status mismatch",
+			req:      types.QueryXmsgsByFilterRequest{Statuses: []types.XmsgStatus{types.XmsgStatus_ABORTED}},
+			expected: false,
+		},
+		{
+			name:     "event type mismatch",
+			req:      types.QueryXmsgsByFilterRequest{InboundEventType: types.InboundPellEventType_INBOUND_EVENT_PELL_SENT},
+			expected: false,
+		},
+		{
+			name:     "height lower than min",
+			req:      types.QueryXmsgsByFilterRequest{MinInboundPellHeight: 101},
+			expected: false,
+		},
+		{
+			name:     "height greater than max",
+			req:      types.QueryXmsgsByFilterRequest{MaxInboundPellHeight: 99},
+			expected: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, tt.req.Match(xmsg))
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+)
+
+// secondary index fields of the xmsgs, the index keys are "<field>/<value>/<xmsg index>"
+// and the index values are the xmsg indexes
+const (
+	XmsgIndexFieldSender        = "sender"
+	XmsgIndexFieldTxOrigin      = "tx_origin"
+	XmsgIndexFieldReceiver      = "receiver"
+	XmsgIndexFieldReceiverChain = "receiver_chain"
+	XmsgIndexFieldStatus        = "status"
+	XmsgIndexFieldEventType     = "event_type"
+	XmsgIndexFieldPellHeight    = "pell_height"
+)
+
+// XmsgIndexFieldPrefix returns the prefix of all the index keys of a field
+func XmsgIndexFieldPrefix(field string) []byte {
+	return []byte(field + "/")
+}
+
+// XmsgIndexValuePrefix returns the prefix of the index keys of a field value
+func XmsgIndexValuePrefix(field string, value []byte) []byte {
+	key := XmsgIndexFieldPrefix(field)
+	key = append(key, value...)
+	return append(key, '/')
+}
+
+// XmsgIndexPellHeightValue returns the index value of an inbound pell height,
+// the height is big endian encoded for the index keys to be sorted by height
+func XmsgIndexPellHeightValue(height uint64) []byte {
+	return binary.BigEndian.AppendUint64(nil, height)
+}
+
+// XmsgIndexChainValue returns the index value of a chain id
+func XmsgIndexChainValue(chainID int64) []byte {
+	return []byte(fmt.Sprint(chainID))
+}
+
+// XmsgIndexAddressValue returns the index value of an address, addresses are indexed lower-cased
+func XmsgIndexAddressValue(address string) []byte {
+	return []byte(strings.ToLower(address))
+}
+
+// XmsgIndexKeys returns the secondary index keys of a xmsg
+func XmsgIndexKeys(xmsg Xmsg) [][]byte {
+	index := []byte(xmsg.Index)
+	keys := make([][]byte, 0)
+	add := func(field string, value []byte) {
+		keys = append(keys, append(XmsgIndexValuePrefix(field, value), index...))
+	}
+
+	add(XmsgIndexFieldStatus, []byte(xmsg.XmsgStatus.GetStatus().String()))
+	add(XmsgIndexFieldEventType, []byte(GetInboundPellEventType(&xmsg).String()))
+
+	if xmsg.InboundTxParams != nil {
+		if xmsg.InboundTxParams.Sender != "" {
+			add(XmsgIndexFieldSender, XmsgIndexAddressValue(xmsg.InboundTxParams.Sender))
+		}
+		if xmsg.InboundTxParams.TxOrigin != "" {
+			add(XmsgIndexFieldTxOrigin, XmsgIndexAddressValue(xmsg.InboundTxParams.TxOrigin))
+		}
+		add(XmsgIndexFieldPellHeight, XmsgIndexPellHeightValue(xmsg.InboundTxParams.InboundTxFinalizedPellHeight))
+	}
+
+	// the receivers and receiver chains of all outbound txs are indexed once
+	receivers := make(map[string]bool)
+	receiverChains := make(map[int64]bool)
+	for _, outTxParams := range xmsg.OutboundTxParams {
+		if outTxParams == nil {
+			continue
+		}
+		receiver := strings.ToLower(outTxParams.Receiver)
+		if receiver != "" && !receivers[receiver] {
+			receivers[receiver] = true
+			add(XmsgIndexFieldReceiver, []byte(receiver))
+		}
+		if !receiverChains[outTxParams.ReceiverChainId] {
+			receiverChains[outTxParams.ReceiverChainId] = true
+			add(XmsgIndexFieldReceiverChain, XmsgIndexChainValue(outTxParams.ReceiverChainId))
+		}
+	}
+
+	return keys
+}
+
+// GetInboundPellEventType returns the type of the inbound pell event of the xmsg
+func GetInboundPellEventType(xmsg *Xmsg) InboundPellEventType {
+	if xmsg.InboundTxParams == nil || xmsg.InboundTxParams.InboundPellTx == nil {
+		return InboundPellEventType_INBOUND_EVENT_UNSPECIFIED
+	}
+
+	switch xmsg.InboundTxParams.InboundPellTx.PellData.(type) {
+	case *InboundPellEvent_StakerDeposited:
+		return InboundPellEventType_INBOUND_EVENT_STAKER_DEPOSITED
+	case *InboundPellEvent_StakerDelegated:
+		return InboundPellEventType_INBOUND_EVENT_STAKER_DELEGATED
+	case *InboundPellEvent_PellSent:
+		return InboundPellEventType_INBOUND_EVENT_PELL_SENT
+	case *InboundPellEvent_WithdrawalQueued:
+		return InboundPellEventType_INBOUND_EVENT_WITHDRAWAL_QUEUED
+	case *InboundPellEvent_StakerUndelegated:
+		return InboundPellEventType_INBOUND_EVENT_STAKER_UNDELEGATED
+	case *InboundPellEvent_RegisterChainDvsToPell:
+		return InboundPellEventType_INBOUND_EVENT_REGISTER_CHAIN_DVS_TO_PELL
+	default:
+		return InboundPellEventType_INBOUND_EVENT_UNSPECIFIED
+	}
+}
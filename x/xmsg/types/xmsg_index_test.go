@@ -0,0 +1,103 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/testutil/sample"
+	"github.com/0xPellNetwork/aegis/x/xmsg/types"
+)
+
+func TestXmsgIndexKeys(t *testing.T) {
+	t.Run("should return the index keys of the xmsg", func(t *testing.T) {
+		xmsg := *sample.Xmsg_pell(t, "0")
+		xmsg.XmsgStatus.Status = types.XmsgStatus_PENDING_OUTBOUND
+		xmsg.OutboundTxParams[1].Receiver = strings.ToUpper(xmsg.OutboundTxParams[0].Receiver)
+		xmsg.OutboundTxParams[1].ReceiverChainId = xmsg.OutboundTxParams[0].ReceiverChainId
+
+		keyOf := func(field string, value []byte) string {
+			return string(append(types.XmsgIndexValuePrefix(field, value), []byte(xmsg.Index)...))
+		}
+		expected := []string{
+			keyOf(types.XmsgIndexFieldStatus, []byte("PENDING_OUTBOUND")),
+			keyOf(types.XmsgIndexFieldEventType, []byte("INBOUND_EVENT_STAKER_DELEGATED")),
+			keyOf(types.XmsgIndexFieldSender, []byte(strings.ToLower(xmsg.InboundTxParams.Sender))),
+			keyOf(types.XmsgIndexFieldTxOrigin, []byte(strings.ToLower(xmsg.InboundTxParams.TxOrigin))),
+			keyOf(types.XmsgIndexFieldPellHeight, types.XmsgIndexPellHeightValue(xmsg.InboundTxParams.InboundTxFinalizedPellHeight)),
+			// the receiver and the receiver chain shared by the outbound txs are indexed once
+			keyOf(types.XmsgIndexFieldReceiver, []byte(strings.ToLower(xmsg.OutboundTxParams[0].Receiver))),
+			keyOf(types.XmsgIndexFieldReceiverChain, types.XmsgIndexChainValue(xmsg.OutboundTxParams[0].ReceiverChainId)),
+		}
+
+		keys := types.XmsgIndexKeys(xmsg)
+		actual := make([]string, 0, len(keys))
+		for _, key := range keys {
+			actual = append(actual, string(key))
+		}
+		require.ElementsMatch(t, expected, actual)
+	})
+
+	t.Run("should index xmsg without inbound and outbound params", func(t *testing.T) {
+		xmsg := types.Xmsg{Index: "index", XmsgStatus: &types.Status{Status: types.XmsgStatus_ABORTED}}
+		require.Len(t, types.XmsgIndexKeys(xmsg), 2)
+	})
+}
+
+func TestXmsgIndexPellHeightValue(t *testing.T) {
+	// the heights are sorted in the index keys
+	require.Negative(t, strings.Compare(
+		string(types.XmsgIndexPellHeightValue(255)),
+		string(types.XmsgIndexPellHeightValue(256)),
+	))
+}
+
+func TestGetInboundPellEventType(t *testing.T) {
+	tests := []struct {
+		name     string
+		event    *types.InboundPellEvent
+		expected types.InboundPellEventType
+	}{
+		{
+			name:     "no inbound event",
+			expected: types.InboundPellEventType_INBOUND_EVENT_UNSPECIFIED,
+		},
+		{
+			name:     "staker deposited",
+			event:    &types.InboundPellEvent{PellData: &types.InboundPellEvent_StakerDeposited{}},
+			expected: types.InboundPellEventType_INBOUND_EVENT_STAKER_DEPOSITED,
+		},
+		{
+			name:     "staker delegated",
+			event:    &types.InboundPellEvent{PellData: &types.InboundPellEvent_StakerDelegated{}},
+			expected: types.InboundPellEventType_INBOUND_EVENT_STAKER_DELEGATED,
+		},
+		{
+			name:     "pell sent",
+			event:    &types.InboundPellEvent{PellData: &types.InboundPellEvent_PellSent{}},
+			expected: types.InboundPellEventType_INBOUND_EVENT_PELL_SENT,
+		},
+		{
+			name:     "withdrawal queued",
+			event:    &types.InboundPellEvent{PellData: &types.InboundPellEvent_WithdrawalQueued{}},
+			expected: types.InboundPellEventType_INBOUND_EVENT_WITHDRAWAL_QUEUED,
+		},
+		{
+			name:     "staker undelegated",
+			event:    &types.InboundPellEvent{PellData: &types.InboundPellEvent_StakerUndelegated{}},
+			expected: types.InboundPellEventType_INBOUND_EVENT_STAKER_UNDELEGATED,
+		},
+		{
+			name:     "register chain dvs to pell",
+			event:    &types.InboundPellEvent{PellData: &types.InboundPellEvent_RegisterChainDvsToPell{}},
+			expected: types.InboundPellEventType_INBOUND_EVENT_REGISTER_CHAIN_DVS_TO_PELL,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			xmsg := &types.Xmsg{InboundTxParams: &types.InboundTxParams{InboundPellTx: tt.event}}
+			require.Equal(t, tt.expected, types.GetInboundPellEventType(xmsg))
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// EventTypeXmsgStatusChanged is the type of the abci event of a xmsg status transition
+var EventTypeXmsgStatusChanged = proto.MessageName(&EventXmsgStatusChanged{})
+
+// EmitXmsgStatusChanged emits the transition of the status of a xmsg, along with its latest outbound
+// the old status is empty for a new xmsg
+func EmitXmsgStatusChanged(ctx sdk.Context, oldStatus string, xmsg *Xmsg) {
+	inTxParams := xmsg.GetInboundTxParams()
+	outTxParams := xmsg.GetCurrentOutTxParam()
+	event := &EventXmsgStatusChanged{
+		XmsgIndex:           xmsg.Index,
+		OldStatus:           oldStatus,
+		NewStatus:           xmsg.XmsgStatus.GetStatus().String(),
+		StatusMessage:       xmsg.XmsgStatus.GetStatusMessage(),
+		SenderChainId:       inTxParams.GetSenderChainId(),
+		ReceiverChainId:     outTxParams.ReceiverChainId,
+		InboundTxHash:       inTxParams.GetInboundTxHash(),
+		InboundBallotIndex:  inTxParams.GetInboundTxBallotIndex(),
+		OutboundTxHash:      outTxParams.OutboundTxHash,
+		OutboundGasUsed:     outTxParams.OutboundTxGasUsed,
+		OutboundBallotIndex: outTxParams.OutboundTxBallotIndex,
+	}
+
+	if err := ctx.EventManager().EmitTypedEvents(event); err != nil {
+		ctx.Logger().Error("Error emitting EventXmsgStatusChanged :", err)
+	}
+}
+
+// ParseXmsgStatusChangedEvents returns the xmsg status transitions among the given abci events, in order
+func ParseXmsgStatusChangedEvents(events []abci.Event) ([]*EventXmsgStatusChanged, error) {
+	var res []*EventXmsgStatusChanged
+	for _, event := range events {
+		if event.Type != EventTypeXmsgStatusChanged {
+			continue
+		}
+		// the mode attribute of block events is not a json value and is not part of the typed event
+		attributes := make([]abci.EventAttribute, 0, len(event.Attributes))
+		for _, attr := range event.Attributes {
+			if attr.Key != "mode" {
+				attributes = append(attributes, attr)
+			}
+		}
+		msg, err := sdk.ParseTypedEvent(abci.Event{Type: event.Type, Attributes: attributes})
+		if err != nil {
+			return nil, err
+		}
+		statusChanged, ok := msg.(*EventXmsgStatusChanged)
+		if !ok {
+			continue
+		}
+		res = append(res, statusChanged)
+	}
+	return res, nil
+}
+
+// Matches returns true if the status transition concerns the given xmsg and chain, empty values match any
+func (e EventXmsgStatusChanged) Matches(xmsgIndex string, chainID int64) bool {
+	if xmsgIndex != "" && e.XmsgIndex != xmsgIndex {
+		return false
+	}
+	if chainID != 0 && e.SenderChainId != chainID && e.ReceiverChainId != chainID {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,70 @@
+package types_test
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/aegis/testutil/sample"
+	"github.com/0xPellNetwork/aegis/x/xmsg/types"
+)
+
+func TestParseXmsgStatusChangedEvents(t *testing.T) {
+	statusChanged := &types.EventXmsgStatusChanged{
+		XmsgIndex:       sample.Hash().Hex(),
+		OldStatus:       types.XmsgStatus_PENDING_OUTBOUND.String(),
+		NewStatus:       types.XmsgStatus_OUTBOUND_MINED.String(),
+		SenderChainId:   1,
+		ReceiverChainId: 2,
+		OutboundTxHash:  sample.Hash().Hex(),
+		OutboundGasUsed: 21000,
+	}
+	event, err := sdk.TypedEventToEvent(statusChanged)
+	require.NoError(t, err)
+
+	t.Run("should parse status changed events", func(t *testing.T) {
+		events, err := types.ParseXmsgStatusChangedEvents([]abci.Event{
+			{Type: "message", Attributes: []abci.EventAttribute{{Key: "module", Value: "xmsg"}}},
+			abci.Event(event),
+		})
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		require.Equal(t, statusChanged, events[0])
+	})
+
+	t.Run("should parse status changed events of end block", func(t *testing.T) {
+		blockEvent := abci.Event(event)
+		blockEvent.Attributes = append(blockEvent.Attributes, abci.EventAttribute{Key: "mode", Value: "EndBlock"})
+
+		events, err := types.ParseXmsgStatusChangedEvents([]abci.Event{blockEvent})
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		require.Equal(t, statusChanged, events[0])
+	})
+
+	t.Run("should fail if the event is invalid", func(t *testing.T) {
+		_, err := types.ParseXmsgStatusChangedEvents([]abci.Event{{
+			Type:       types.EventTypeXmsgStatusChanged,
+			Attributes: []abci.EventAttribute{{Key: "outbound_gas_used", Value: "invalid"}},
+		}})
+		require.Error(t, err)
+	})
+}
+
+func TestEventXmsgStatusChanged_Matches(t *testing.T) {
+	event := types.EventXmsgStatusChanged{
+		XmsgIndex:       "0x01",
+		SenderChainId:   1,
+		ReceiverChainId: 2,
+	}
+
+	require.True(t, event.Matches("", 0))
+	require.True(t, event.Matches("0x01", 0))
+	require.True(t, event.Matches("0x01", 1))
+	require.True(t, event.Matches("", 2))
+	require.False(t, event.Matches("0x02", 0))
+	require.False(t, event.Matches("", 3))
+	require.False(t, event.Matches("0x01", 3))
+}
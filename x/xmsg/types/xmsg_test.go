@@ -62,6 +62,13 @@ func Test_InitializeXmsg(t *testing.T) {
 		require.Equal(t, uint64(0), xmsg.GetCurrentOutTxParam().OutboundTxTssNonce)
 		require.Equal(t, types.XmsgStatus_PENDING_INBOUND, xmsg.XmsgStatus.Status)
 		require.Equal(t, pellTx, xmsg.GetInboundTxParams().InboundPellTx)
+
+		events, err := types.ParseXmsgStatusChangedEvents(ctx.EventManager().ABCIEvents())
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		require.Equal(t, xmsg.Index, events[0].XmsgIndex)
+		require.Empty(t, events[0].OldStatus)
+		require.Equal(t, types.XmsgStatus_PENDING_INBOUND.String(), events[0].NewStatus)
 	})
 	t.Run("should return an error if the xmsg is invalid", func(t *testing.T) {
 		_, ctx, _, _ := keepertest.XmsgKeeper(t)
@@ -112,15 +119,15 @@ func TestXmsg_Validate(t *testing.T) {
 	require.ErrorContains(t, xmsg.Validate(), "invalid index length 1")
 	xmsg = sample.Xmsg_pell(t, "foo")
 	xmsg.InboundTxParams = sample.InboundTxParamsValidChainID_pell(rand.New(rand.NewSource(42)))
-	xmsg.InboundTxParams.SenderChainId = 1000
-	require.ErrorContains(t, xmsg.Validate(), "invalid sender chain id 1000")
+	xmsg.InboundTxParams.SenderChainId = 0
+	require.ErrorContains(t, xmsg.Validate(), "invalid sender chain id 0")
 	xmsg = sample.Xmsg_pell(t, "foo")
 	xmsg.OutboundTxParams = []*types.OutboundTxParams{sample.OutboundTxParamsValidChainID_pell(rand.New(rand.NewSource(42)))}
 	xmsg.InboundTxParams = sample.InboundTxParamsValidChainID_pell(rand.New(rand.NewSource(42)))
 	xmsg.InboundTxParams.InboundTxHash = sample.Hash().String()
 	xmsg.InboundTxParams.InboundTxBallotIndex = sample.PellIndex(t)
-	xmsg.OutboundTxParams[0].ReceiverChainId = 1000
-	require.ErrorContains(t, xmsg.Validate(), "invalid receiver chain id 1000")
+	xmsg.OutboundTxParams[0].ReceiverChainId = 0
+	require.ErrorContains(t, xmsg.Validate(), "invalid receiver chain id 0")
 }
 
 func TestXmsg_GetCurrentOutTxParam(t *testing.T) {
@@ -238,40 +245,101 @@ func Test_SetRevertOutboundValues(t *testing.T) {
 }
 
 func TestXmsg_SetAbort(t *testing.T) {
+	_, ctx, _, _ := keepertest.XmsgKeeper(t)
 	xmsg := sample.Xmsg_pell(t, "test")
-	xmsg.SetAbort("test")
+	xmsg.SetAbort(ctx, "test")
 	require.Equal(t, types.XmsgStatus_ABORTED, xmsg.XmsgStatus.Status)
-	require.Equal(t, "test", "test")
+	require.Contains(t, xmsg.XmsgStatus.StatusMessage, "test")
 }
 
 func TestXmsg_SetPendingRevert(t *testing.T) {
+	_, ctx, _, _ := keepertest.XmsgKeeper(t)
 	xmsg := sample.Xmsg_pell(t, "test")
 	xmsg.XmsgStatus.Status = types.XmsgStatus_PENDING_OUTBOUND
-	xmsg.SetPendingRevert("test")
+	xmsg.SetPendingRevert(ctx, "test")
 	require.Equal(t, types.XmsgStatus_PENDING_REVERT, xmsg.XmsgStatus.Status)
 	require.Contains(t, xmsg.XmsgStatus.StatusMessage, "test")
 }
 
 func TestXmsg_SetPendingOutbound(t *testing.T) {
+	_, ctx, _, _ := keepertest.XmsgKeeper(t)
 	xmsg := sample.Xmsg_pell(t, "test")
 	xmsg.XmsgStatus.Status = types.XmsgStatus_PENDING_INBOUND
-	xmsg.SetPendingOutbound("test")
+	xmsg.SetPendingOutbound(ctx, "test")
 	require.Equal(t, types.XmsgStatus_PENDING_OUTBOUND, xmsg.XmsgStatus.Status)
 	require.Contains(t, xmsg.XmsgStatus.StatusMessage, "test")
 }
 
 func TestXmsg_SetOutBoundMined(t *testing.T) {
+	_, ctx, _, _ := keepertest.XmsgKeeper(t)
 	xmsg := sample.Xmsg_pell(t, "test")
 	xmsg.XmsgStatus.Status = types.XmsgStatus_PENDING_OUTBOUND
-	xmsg.SetOutBoundMined("test")
+	xmsg.SetOutBoundMined(ctx, "test")
 	require.Equal(t, types.XmsgStatus_OUTBOUND_MINED, xmsg.XmsgStatus.Status)
 	require.Contains(t, xmsg.XmsgStatus.StatusMessage, "test")
 }
 
 func TestXmsg_SetReverted(t *testing.T) {
+	_, ctx, _, _ := keepertest.XmsgKeeper(t)
 	xmsg := sample.Xmsg_pell(t, "test")
 	xmsg.XmsgStatus.Status = types.XmsgStatus_PENDING_REVERT
-	xmsg.SetReverted("test")
+	xmsg.SetReverted(ctx, "test")
 	require.Equal(t, types.XmsgStatus_REVERTED, xmsg.XmsgStatus.Status)
 	require.Contains(t, xmsg.XmsgStatus.StatusMessage, "test")
 }
+
+func TestXmsg_StatusChangedEvents(t *testing.T) {
+	t.Run("should emit every status transition", func(t *testing.T) {
+		_, ctx, _, _ := keepertest.XmsgKeeper(t)
+		xmsg := sample.Xmsg_pell(t, "test")
+		xmsg.XmsgStatus.Status = types.XmsgStatus_PENDING_INBOUND
+
+		xmsg.SetPendingOutbound(ctx, "pending outbound")
+		xmsg.SetPendingRevert(ctx, "pending revert")
+		xmsg.SetReverted(ctx, "reverted")
+
+		events, err := types.ParseXmsgStatusChangedEvents(ctx.EventManager().ABCIEvents())
+		require.NoError(t, err)
+		require.Len(t, events, 3)
+		require.Equal(t, types.XmsgStatus_PENDING_INBOUND.String(), events[0].OldStatus)
+		require.Equal(t, types.XmsgStatus_PENDING_OUTBOUND.String(), events[0].NewStatus)
+		require.Equal(t, types.XmsgStatus_PENDING_OUTBOUND.String(), events[1].OldStatus)
+		require.Equal(t, types.XmsgStatus_PENDING_REVERT.String(), events[1].NewStatus)
+		require.Equal(t, types.XmsgStatus_PENDING_REVERT.String(), events[2].OldStatus)
+		require.Equal(t, types.XmsgStatus_REVERTED.String(), events[2].NewStatus)
+		require.Equal(t, xmsg.Index, events[2].XmsgIndex)
+		require.Equal(t, xmsg.GetCurrentOutTxParam().ReceiverChainId, events[2].ReceiverChainId)
+	})
+
+	t.Run("should not emit if the status is unchanged", func(t *testing.T) {
+		_, ctx, _, _ := keepertest.XmsgKeeper(t)
+		xmsg := sample.Xmsg_pell(t, "test")
+		xmsg.XmsgStatus.Status = types.XmsgStatus_ABORTED
+
+		xmsg.SetAbort(ctx, "aborted again")
+
+		events, err := types.ParseXmsgStatusChangedEvents(ctx.EventManager().ABCIEvents())
+		require.NoError(t, err)
+		require.Empty(t, events)
+	})
+}
+
+func TestXmsg_GetAbortedAmount(t *testing.T) {
+	t.Run("should return pell value for pell sent event", func(t *testing.T) {
+		xmsg := sample.Xmsg_pell(t, "test")
+		xmsg.InboundTxParams.InboundPellTx = sample.InboundPellTx_PellSend_pell(sample.Rand())
+		xmsg.InboundTxParams.InboundPellTx.GetPellSent().PellValue = sdkmath.NewUint(42)
+		require.Equal(t, sdkmath.NewUint(42), xmsg.GetAbortedAmount())
+	})
+
+	t.Run("should return zero for other events", func(t *testing.T) {
+		xmsg := sample.Xmsg_pell(t, "test")
+		require.True(t, xmsg.GetAbortedAmount().IsZero())
+	})
+
+	t.Run("should return zero if inbound params are nil", func(t *testing.T) {
+		xmsg := sample.Xmsg_pell(t, "test")
+		xmsg.InboundTxParams = nil
+		require.True(t, xmsg.GetAbortedAmount().IsZero())
+	})
+}